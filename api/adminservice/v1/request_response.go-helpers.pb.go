@@ -79,35 +79,35 @@ func (this *RebuildMutableStateResponse) Equal(that interface{}) bool {
 	return proto.Equal(this, that1)
 }
 
-// Marshal an object of type ImportWorkflowExecutionRequest to the protobuf v3 wire format
-func (val *ImportWorkflowExecutionRequest) Marshal() ([]byte, error) {
+// Marshal an object of type CheckMutableStateRequest to the protobuf v3 wire format
+func (val *CheckMutableStateRequest) Marshal() ([]byte, error) {
 	return proto.Marshal(val)
 }
 
-// Unmarshal an object of type ImportWorkflowExecutionRequest from the protobuf v3 wire format
-func (val *ImportWorkflowExecutionRequest) Unmarshal(buf []byte) error {
+// Unmarshal an object of type CheckMutableStateRequest from the protobuf v3 wire format
+func (val *CheckMutableStateRequest) Unmarshal(buf []byte) error {
 	return proto.Unmarshal(buf, val)
 }
 
 // Size returns the size of the object, in bytes, once serialized
-func (val *ImportWorkflowExecutionRequest) Size() int {
+func (val *CheckMutableStateRequest) Size() int {
 	return proto.Size(val)
 }
 
-// Equal returns whether two ImportWorkflowExecutionRequest values are equivalent by recursively
+// Equal returns whether two CheckMutableStateRequest values are equivalent by recursively
 // comparing the message's fields.
 // For more information see the documentation for
 // https://pkg.go.dev/google.golang.org/protobuf/proto#Equal
-func (this *ImportWorkflowExecutionRequest) Equal(that interface{}) bool {
+func (this *CheckMutableStateRequest) Equal(that interface{}) bool {
 	if that == nil {
 		return this == nil
 	}
 
-	var that1 *ImportWorkflowExecutionRequest
+	var that1 *CheckMutableStateRequest
 	switch t := that.(type) {
-	case *ImportWorkflowExecutionRequest:
+	case *CheckMutableStateRequest:
 		that1 = t
-	case ImportWorkflowExecutionRequest:
+	case CheckMutableStateRequest:
 		that1 = &t
 	default:
 		return false
@@ -116,35 +116,35 @@ func (this *ImportWorkflowExecutionRequest) Equal(that interface{}) bool {
 	return proto.Equal(this, that1)
 }
 
-// Marshal an object of type ImportWorkflowExecutionResponse to the protobuf v3 wire format
-func (val *ImportWorkflowExecutionResponse) Marshal() ([]byte, error) {
+// Marshal an object of type CheckMutableStateResponse to the protobuf v3 wire format
+func (val *CheckMutableStateResponse) Marshal() ([]byte, error) {
 	return proto.Marshal(val)
 }
 
-// Unmarshal an object of type ImportWorkflowExecutionResponse from the protobuf v3 wire format
-func (val *ImportWorkflowExecutionResponse) Unmarshal(buf []byte) error {
+// Unmarshal an object of type CheckMutableStateResponse from the protobuf v3 wire format
+func (val *CheckMutableStateResponse) Unmarshal(buf []byte) error {
 	return proto.Unmarshal(buf, val)
 }
 
 // Size returns the size of the object, in bytes, once serialized
-func (val *ImportWorkflowExecutionResponse) Size() int {
+func (val *CheckMutableStateResponse) Size() int {
 	return proto.Size(val)
 }
 
-// Equal returns whether two ImportWorkflowExecutionResponse values are equivalent by recursively
+// Equal returns whether two CheckMutableStateResponse values are equivalent by recursively
 // comparing the message's fields.
 // For more information see the documentation for
 // https://pkg.go.dev/google.golang.org/protobuf/proto#Equal
-func (this *ImportWorkflowExecutionResponse) Equal(that interface{}) bool {
+func (this *CheckMutableStateResponse) Equal(that interface{}) bool {
 	if that == nil {
 		return this == nil
 	}
 
-	var that1 *ImportWorkflowExecutionResponse
+	var that1 *CheckMutableStateResponse
 	switch t := that.(type) {
-	case *ImportWorkflowExecutionResponse:
+	case *CheckMutableStateResponse:
 		that1 = t
-	case ImportWorkflowExecutionResponse:
+	case CheckMutableStateResponse:
 		that1 = &t
 	default:
 		return false
@@ -153,35 +153,35 @@ func (this *ImportWorkflowExecutionResponse) Equal(that interface{}) bool {
 	return proto.Equal(this, that1)
 }
 
-// Marshal an object of type DescribeMutableStateRequest to the protobuf v3 wire format
-func (val *DescribeMutableStateRequest) Marshal() ([]byte, error) {
+// Marshal an object of type MutableStateInconsistency to the protobuf v3 wire format
+func (val *MutableStateInconsistency) Marshal() ([]byte, error) {
 	return proto.Marshal(val)
 }
 
-// Unmarshal an object of type DescribeMutableStateRequest from the protobuf v3 wire format
-func (val *DescribeMutableStateRequest) Unmarshal(buf []byte) error {
+// Unmarshal an object of type MutableStateInconsistency from the protobuf v3 wire format
+func (val *MutableStateInconsistency) Unmarshal(buf []byte) error {
 	return proto.Unmarshal(buf, val)
 }
 
 // Size returns the size of the object, in bytes, once serialized
-func (val *DescribeMutableStateRequest) Size() int {
+func (val *MutableStateInconsistency) Size() int {
 	return proto.Size(val)
 }
 
-// Equal returns whether two DescribeMutableStateRequest values are equivalent by recursively
+// Equal returns whether two MutableStateInconsistency values are equivalent by recursively
 // comparing the message's fields.
 // For more information see the documentation for
 // https://pkg.go.dev/google.golang.org/protobuf/proto#Equal
-func (this *DescribeMutableStateRequest) Equal(that interface{}) bool {
+func (this *MutableStateInconsistency) Equal(that interface{}) bool {
 	if that == nil {
 		return this == nil
 	}
 
-	var that1 *DescribeMutableStateRequest
+	var that1 *MutableStateInconsistency
 	switch t := that.(type) {
-	case *DescribeMutableStateRequest:
+	case *MutableStateInconsistency:
 		that1 = t
-	case DescribeMutableStateRequest:
+	case MutableStateInconsistency:
 		that1 = &t
 	default:
 		return false
@@ -190,35 +190,35 @@ func (this *DescribeMutableStateRequest) Equal(that interface{}) bool {
 	return proto.Equal(this, that1)
 }
 
-// Marshal an object of type DescribeMutableStateResponse to the protobuf v3 wire format
-func (val *DescribeMutableStateResponse) Marshal() ([]byte, error) {
+// Marshal an object of type ImportWorkflowExecutionRequest to the protobuf v3 wire format
+func (val *ImportWorkflowExecutionRequest) Marshal() ([]byte, error) {
 	return proto.Marshal(val)
 }
 
-// Unmarshal an object of type DescribeMutableStateResponse from the protobuf v3 wire format
-func (val *DescribeMutableStateResponse) Unmarshal(buf []byte) error {
+// Unmarshal an object of type ImportWorkflowExecutionRequest from the protobuf v3 wire format
+func (val *ImportWorkflowExecutionRequest) Unmarshal(buf []byte) error {
 	return proto.Unmarshal(buf, val)
 }
 
 // Size returns the size of the object, in bytes, once serialized
-func (val *DescribeMutableStateResponse) Size() int {
+func (val *ImportWorkflowExecutionRequest) Size() int {
 	return proto.Size(val)
 }
 
-// Equal returns whether two DescribeMutableStateResponse values are equivalent by recursively
+// Equal returns whether two ImportWorkflowExecutionRequest values are equivalent by recursively
 // comparing the message's fields.
 // For more information see the documentation for
 // https://pkg.go.dev/google.golang.org/protobuf/proto#Equal
-func (this *DescribeMutableStateResponse) Equal(that interface{}) bool {
+func (this *ImportWorkflowExecutionRequest) Equal(that interface{}) bool {
 	if that == nil {
 		return this == nil
 	}
 
-	var that1 *DescribeMutableStateResponse
+	var that1 *ImportWorkflowExecutionRequest
 	switch t := that.(type) {
-	case *DescribeMutableStateResponse:
+	case *ImportWorkflowExecutionRequest:
 		that1 = t
-	case DescribeMutableStateResponse:
+	case ImportWorkflowExecutionRequest:
 		that1 = &t
 	default:
 		return false
@@ -227,35 +227,35 @@ func (this *DescribeMutableStateResponse) Equal(that interface{}) bool {
 	return proto.Equal(this, that1)
 }
 
-// Marshal an object of type DescribeHistoryHostRequest to the protobuf v3 wire format
-func (val *DescribeHistoryHostRequest) Marshal() ([]byte, error) {
+// Marshal an object of type ImportWorkflowExecutionResponse to the protobuf v3 wire format
+func (val *ImportWorkflowExecutionResponse) Marshal() ([]byte, error) {
 	return proto.Marshal(val)
 }
 
-// Unmarshal an object of type DescribeHistoryHostRequest from the protobuf v3 wire format
-func (val *DescribeHistoryHostRequest) Unmarshal(buf []byte) error {
+// Unmarshal an object of type ImportWorkflowExecutionResponse from the protobuf v3 wire format
+func (val *ImportWorkflowExecutionResponse) Unmarshal(buf []byte) error {
 	return proto.Unmarshal(buf, val)
 }
 
 // Size returns the size of the object, in bytes, once serialized
-func (val *DescribeHistoryHostRequest) Size() int {
+func (val *ImportWorkflowExecutionResponse) Size() int {
 	return proto.Size(val)
 }
 
-// Equal returns whether two DescribeHistoryHostRequest values are equivalent by recursively
+// Equal returns whether two ImportWorkflowExecutionResponse values are equivalent by recursively
 // comparing the message's fields.
 // For more information see the documentation for
 // https://pkg.go.dev/google.golang.org/protobuf/proto#Equal
-func (this *DescribeHistoryHostRequest) Equal(that interface{}) bool {
+func (this *ImportWorkflowExecutionResponse) Equal(that interface{}) bool {
 	if that == nil {
 		return this == nil
 	}
 
-	var that1 *DescribeHistoryHostRequest
+	var that1 *ImportWorkflowExecutionResponse
 	switch t := that.(type) {
-	case *DescribeHistoryHostRequest:
+	case *ImportWorkflowExecutionResponse:
 		that1 = t
-	case DescribeHistoryHostRequest:
+	case ImportWorkflowExecutionResponse:
 		that1 = &t
 	default:
 		return false
@@ -264,35 +264,35 @@ func (this *DescribeHistoryHostRequest) Equal(that interface{}) bool {
 	return proto.Equal(this, that1)
 }
 
-// Marshal an object of type DescribeHistoryHostResponse to the protobuf v3 wire format
-func (val *DescribeHistoryHostResponse) Marshal() ([]byte, error) {
+// Marshal an object of type ForkWorkflowExecutionRequest to the protobuf v3 wire format
+func (val *ForkWorkflowExecutionRequest) Marshal() ([]byte, error) {
 	return proto.Marshal(val)
 }
 
-// Unmarshal an object of type DescribeHistoryHostResponse from the protobuf v3 wire format
-func (val *DescribeHistoryHostResponse) Unmarshal(buf []byte) error {
+// Unmarshal an object of type ForkWorkflowExecutionRequest from the protobuf v3 wire format
+func (val *ForkWorkflowExecutionRequest) Unmarshal(buf []byte) error {
 	return proto.Unmarshal(buf, val)
 }
 
 // Size returns the size of the object, in bytes, once serialized
-func (val *DescribeHistoryHostResponse) Size() int {
+func (val *ForkWorkflowExecutionRequest) Size() int {
 	return proto.Size(val)
 }
 
-// Equal returns whether two DescribeHistoryHostResponse values are equivalent by recursively
+// Equal returns whether two ForkWorkflowExecutionRequest values are equivalent by recursively
 // comparing the message's fields.
 // For more information see the documentation for
 // https://pkg.go.dev/google.golang.org/protobuf/proto#Equal
-func (this *DescribeHistoryHostResponse) Equal(that interface{}) bool {
+func (this *ForkWorkflowExecutionRequest) Equal(that interface{}) bool {
 	if that == nil {
 		return this == nil
 	}
 
-	var that1 *DescribeHistoryHostResponse
+	var that1 *ForkWorkflowExecutionRequest
 	switch t := that.(type) {
-	case *DescribeHistoryHostResponse:
+	case *ForkWorkflowExecutionRequest:
 		that1 = t
-	case DescribeHistoryHostResponse:
+	case ForkWorkflowExecutionRequest:
 		that1 = &t
 	default:
 		return false
@@ -301,35 +301,35 @@ func (this *DescribeHistoryHostResponse) Equal(that interface{}) bool {
 	return proto.Equal(this, that1)
 }
 
-// Marshal an object of type CloseShardRequest to the protobuf v3 wire format
-func (val *CloseShardRequest) Marshal() ([]byte, error) {
+// Marshal an object of type ForkWorkflowExecutionResponse to the protobuf v3 wire format
+func (val *ForkWorkflowExecutionResponse) Marshal() ([]byte, error) {
 	return proto.Marshal(val)
 }
 
-// Unmarshal an object of type CloseShardRequest from the protobuf v3 wire format
-func (val *CloseShardRequest) Unmarshal(buf []byte) error {
+// Unmarshal an object of type ForkWorkflowExecutionResponse from the protobuf v3 wire format
+func (val *ForkWorkflowExecutionResponse) Unmarshal(buf []byte) error {
 	return proto.Unmarshal(buf, val)
 }
 
 // Size returns the size of the object, in bytes, once serialized
-func (val *CloseShardRequest) Size() int {
+func (val *ForkWorkflowExecutionResponse) Size() int {
 	return proto.Size(val)
 }
 
-// Equal returns whether two CloseShardRequest values are equivalent by recursively
+// Equal returns whether two ForkWorkflowExecutionResponse values are equivalent by recursively
 // comparing the message's fields.
 // For more information see the documentation for
 // https://pkg.go.dev/google.golang.org/protobuf/proto#Equal
-func (this *CloseShardRequest) Equal(that interface{}) bool {
+func (this *ForkWorkflowExecutionResponse) Equal(that interface{}) bool {
 	if that == nil {
 		return this == nil
 	}
 
-	var that1 *CloseShardRequest
+	var that1 *ForkWorkflowExecutionResponse
 	switch t := that.(type) {
-	case *CloseShardRequest:
+	case *ForkWorkflowExecutionResponse:
 		that1 = t
-	case CloseShardRequest:
+	case ForkWorkflowExecutionResponse:
 		that1 = &t
 	default:
 		return false
@@ -338,35 +338,35 @@ func (this *CloseShardRequest) Equal(that interface{}) bool {
 	return proto.Equal(this, that1)
 }
 
-// Marshal an object of type CloseShardResponse to the protobuf v3 wire format
-func (val *CloseShardResponse) Marshal() ([]byte, error) {
+// Marshal an object of type RedactWorkflowExecutionRequest to the protobuf v3 wire format
+func (val *RedactWorkflowExecutionRequest) Marshal() ([]byte, error) {
 	return proto.Marshal(val)
 }
 
-// Unmarshal an object of type CloseShardResponse from the protobuf v3 wire format
-func (val *CloseShardResponse) Unmarshal(buf []byte) error {
+// Unmarshal an object of type RedactWorkflowExecutionRequest from the protobuf v3 wire format
+func (val *RedactWorkflowExecutionRequest) Unmarshal(buf []byte) error {
 	return proto.Unmarshal(buf, val)
 }
 
 // Size returns the size of the object, in bytes, once serialized
-func (val *CloseShardResponse) Size() int {
+func (val *RedactWorkflowExecutionRequest) Size() int {
 	return proto.Size(val)
 }
 
-// Equal returns whether two CloseShardResponse values are equivalent by recursively
+// Equal returns whether two RedactWorkflowExecutionRequest values are equivalent by recursively
 // comparing the message's fields.
 // For more information see the documentation for
 // https://pkg.go.dev/google.golang.org/protobuf/proto#Equal
-func (this *CloseShardResponse) Equal(that interface{}) bool {
+func (this *RedactWorkflowExecutionRequest) Equal(that interface{}) bool {
 	if that == nil {
 		return this == nil
 	}
 
-	var that1 *CloseShardResponse
+	var that1 *RedactWorkflowExecutionRequest
 	switch t := that.(type) {
-	case *CloseShardResponse:
+	case *RedactWorkflowExecutionRequest:
 		that1 = t
-	case CloseShardResponse:
+	case RedactWorkflowExecutionRequest:
 		that1 = &t
 	default:
 		return false
@@ -375,35 +375,35 @@ func (this *CloseShardResponse) Equal(that interface{}) bool {
 	return proto.Equal(this, that1)
 }
 
-// Marshal an object of type GetShardRequest to the protobuf v3 wire format
-func (val *GetShardRequest) Marshal() ([]byte, error) {
+// Marshal an object of type RedactWorkflowExecutionResponse to the protobuf v3 wire format
+func (val *RedactWorkflowExecutionResponse) Marshal() ([]byte, error) {
 	return proto.Marshal(val)
 }
 
-// Unmarshal an object of type GetShardRequest from the protobuf v3 wire format
-func (val *GetShardRequest) Unmarshal(buf []byte) error {
+// Unmarshal an object of type RedactWorkflowExecutionResponse from the protobuf v3 wire format
+func (val *RedactWorkflowExecutionResponse) Unmarshal(buf []byte) error {
 	return proto.Unmarshal(buf, val)
 }
 
 // Size returns the size of the object, in bytes, once serialized
-func (val *GetShardRequest) Size() int {
+func (val *RedactWorkflowExecutionResponse) Size() int {
 	return proto.Size(val)
 }
 
-// Equal returns whether two GetShardRequest values are equivalent by recursively
+// Equal returns whether two RedactWorkflowExecutionResponse values are equivalent by recursively
 // comparing the message's fields.
 // For more information see the documentation for
 // https://pkg.go.dev/google.golang.org/protobuf/proto#Equal
-func (this *GetShardRequest) Equal(that interface{}) bool {
+func (this *RedactWorkflowExecutionResponse) Equal(that interface{}) bool {
 	if that == nil {
 		return this == nil
 	}
 
-	var that1 *GetShardRequest
+	var that1 *RedactWorkflowExecutionResponse
 	switch t := that.(type) {
-	case *GetShardRequest:
+	case *RedactWorkflowExecutionResponse:
 		that1 = t
-	case GetShardRequest:
+	case RedactWorkflowExecutionResponse:
 		that1 = &t
 	default:
 		return false
@@ -412,35 +412,35 @@ func (this *GetShardRequest) Equal(that interface{}) bool {
 	return proto.Equal(this, that1)
 }
 
-// Marshal an object of type GetShardResponse to the protobuf v3 wire format
-func (val *GetShardResponse) Marshal() ([]byte, error) {
+// Marshal an object of type GetWorkflowHistoryBudgetRequest to the protobuf v3 wire format
+func (val *GetWorkflowHistoryBudgetRequest) Marshal() ([]byte, error) {
 	return proto.Marshal(val)
 }
 
-// Unmarshal an object of type GetShardResponse from the protobuf v3 wire format
-func (val *GetShardResponse) Unmarshal(buf []byte) error {
+// Unmarshal an object of type GetWorkflowHistoryBudgetRequest from the protobuf v3 wire format
+func (val *GetWorkflowHistoryBudgetRequest) Unmarshal(buf []byte) error {
 	return proto.Unmarshal(buf, val)
 }
 
 // Size returns the size of the object, in bytes, once serialized
-func (val *GetShardResponse) Size() int {
+func (val *GetWorkflowHistoryBudgetRequest) Size() int {
 	return proto.Size(val)
 }
 
-// Equal returns whether two GetShardResponse values are equivalent by recursively
+// Equal returns whether two GetWorkflowHistoryBudgetRequest values are equivalent by recursively
 // comparing the message's fields.
 // For more information see the documentation for
 // https://pkg.go.dev/google.golang.org/protobuf/proto#Equal
-func (this *GetShardResponse) Equal(that interface{}) bool {
+func (this *GetWorkflowHistoryBudgetRequest) Equal(that interface{}) bool {
 	if that == nil {
 		return this == nil
 	}
 
-	var that1 *GetShardResponse
+	var that1 *GetWorkflowHistoryBudgetRequest
 	switch t := that.(type) {
-	case *GetShardResponse:
+	case *GetWorkflowHistoryBudgetRequest:
 		that1 = t
-	case GetShardResponse:
+	case GetWorkflowHistoryBudgetRequest:
 		that1 = &t
 	default:
 		return false
@@ -449,35 +449,35 @@ func (this *GetShardResponse) Equal(that interface{}) bool {
 	return proto.Equal(this, that1)
 }
 
-// Marshal an object of type ListHistoryTasksRequest to the protobuf v3 wire format
-func (val *ListHistoryTasksRequest) Marshal() ([]byte, error) {
+// Marshal an object of type GetWorkflowHistoryBudgetResponse to the protobuf v3 wire format
+func (val *GetWorkflowHistoryBudgetResponse) Marshal() ([]byte, error) {
 	return proto.Marshal(val)
 }
 
-// Unmarshal an object of type ListHistoryTasksRequest from the protobuf v3 wire format
-func (val *ListHistoryTasksRequest) Unmarshal(buf []byte) error {
+// Unmarshal an object of type GetWorkflowHistoryBudgetResponse from the protobuf v3 wire format
+func (val *GetWorkflowHistoryBudgetResponse) Unmarshal(buf []byte) error {
 	return proto.Unmarshal(buf, val)
 }
 
 // Size returns the size of the object, in bytes, once serialized
-func (val *ListHistoryTasksRequest) Size() int {
+func (val *GetWorkflowHistoryBudgetResponse) Size() int {
 	return proto.Size(val)
 }
 
-// Equal returns whether two ListHistoryTasksRequest values are equivalent by recursively
+// Equal returns whether two GetWorkflowHistoryBudgetResponse values are equivalent by recursively
 // comparing the message's fields.
 // For more information see the documentation for
 // https://pkg.go.dev/google.golang.org/protobuf/proto#Equal
-func (this *ListHistoryTasksRequest) Equal(that interface{}) bool {
+func (this *GetWorkflowHistoryBudgetResponse) Equal(that interface{}) bool {
 	if that == nil {
 		return this == nil
 	}
 
-	var that1 *ListHistoryTasksRequest
+	var that1 *GetWorkflowHistoryBudgetResponse
 	switch t := that.(type) {
-	case *ListHistoryTasksRequest:
+	case *GetWorkflowHistoryBudgetResponse:
 		that1 = t
-	case ListHistoryTasksRequest:
+	case GetWorkflowHistoryBudgetResponse:
 		that1 = &t
 	default:
 		return false
@@ -486,35 +486,35 @@ func (this *ListHistoryTasksRequest) Equal(that interface{}) bool {
 	return proto.Equal(this, that1)
 }
 
-// Marshal an object of type ListHistoryTasksResponse to the protobuf v3 wire format
-func (val *ListHistoryTasksResponse) Marshal() ([]byte, error) {
+// Marshal an object of type WorkflowHistoryBudgetUsage to the protobuf v3 wire format
+func (val *WorkflowHistoryBudgetUsage) Marshal() ([]byte, error) {
 	return proto.Marshal(val)
 }
 
-// Unmarshal an object of type ListHistoryTasksResponse from the protobuf v3 wire format
-func (val *ListHistoryTasksResponse) Unmarshal(buf []byte) error {
+// Unmarshal an object of type WorkflowHistoryBudgetUsage from the protobuf v3 wire format
+func (val *WorkflowHistoryBudgetUsage) Unmarshal(buf []byte) error {
 	return proto.Unmarshal(buf, val)
 }
 
 // Size returns the size of the object, in bytes, once serialized
-func (val *ListHistoryTasksResponse) Size() int {
+func (val *WorkflowHistoryBudgetUsage) Size() int {
 	return proto.Size(val)
 }
 
-// Equal returns whether two ListHistoryTasksResponse values are equivalent by recursively
+// Equal returns whether two WorkflowHistoryBudgetUsage values are equivalent by recursively
 // comparing the message's fields.
 // For more information see the documentation for
 // https://pkg.go.dev/google.golang.org/protobuf/proto#Equal
-func (this *ListHistoryTasksResponse) Equal(that interface{}) bool {
+func (this *WorkflowHistoryBudgetUsage) Equal(that interface{}) bool {
 	if that == nil {
 		return this == nil
 	}
 
-	var that1 *ListHistoryTasksResponse
+	var that1 *WorkflowHistoryBudgetUsage
 	switch t := that.(type) {
-	case *ListHistoryTasksResponse:
+	case *WorkflowHistoryBudgetUsage:
 		that1 = t
-	case ListHistoryTasksResponse:
+	case WorkflowHistoryBudgetUsage:
 		that1 = &t
 	default:
 		return false
@@ -523,35 +523,35 @@ func (this *ListHistoryTasksResponse) Equal(that interface{}) bool {
 	return proto.Equal(this, that1)
 }
 
-// Marshal an object of type Task to the protobuf v3 wire format
-func (val *Task) Marshal() ([]byte, error) {
+// Marshal an object of type GetWorkflowExecutionTimelineRequest to the protobuf v3 wire format
+func (val *GetWorkflowExecutionTimelineRequest) Marshal() ([]byte, error) {
 	return proto.Marshal(val)
 }
 
-// Unmarshal an object of type Task from the protobuf v3 wire format
-func (val *Task) Unmarshal(buf []byte) error {
+// Unmarshal an object of type GetWorkflowExecutionTimelineRequest from the protobuf v3 wire format
+func (val *GetWorkflowExecutionTimelineRequest) Unmarshal(buf []byte) error {
 	return proto.Unmarshal(buf, val)
 }
 
 // Size returns the size of the object, in bytes, once serialized
-func (val *Task) Size() int {
+func (val *GetWorkflowExecutionTimelineRequest) Size() int {
 	return proto.Size(val)
 }
 
-// Equal returns whether two Task values are equivalent by recursively
+// Equal returns whether two GetWorkflowExecutionTimelineRequest values are equivalent by recursively
 // comparing the message's fields.
 // For more information see the documentation for
 // https://pkg.go.dev/google.golang.org/protobuf/proto#Equal
-func (this *Task) Equal(that interface{}) bool {
+func (this *GetWorkflowExecutionTimelineRequest) Equal(that interface{}) bool {
 	if that == nil {
 		return this == nil
 	}
 
-	var that1 *Task
+	var that1 *GetWorkflowExecutionTimelineRequest
 	switch t := that.(type) {
-	case *Task:
+	case *GetWorkflowExecutionTimelineRequest:
 		that1 = t
-	case Task:
+	case GetWorkflowExecutionTimelineRequest:
 		that1 = &t
 	default:
 		return false
@@ -560,35 +560,35 @@ func (this *Task) Equal(that interface{}) bool {
 	return proto.Equal(this, that1)
 }
 
-// Marshal an object of type RemoveTaskRequest to the protobuf v3 wire format
-func (val *RemoveTaskRequest) Marshal() ([]byte, error) {
+// Marshal an object of type GetWorkflowExecutionTimelineResponse to the protobuf v3 wire format
+func (val *GetWorkflowExecutionTimelineResponse) Marshal() ([]byte, error) {
 	return proto.Marshal(val)
 }
 
-// Unmarshal an object of type RemoveTaskRequest from the protobuf v3 wire format
-func (val *RemoveTaskRequest) Unmarshal(buf []byte) error {
+// Unmarshal an object of type GetWorkflowExecutionTimelineResponse from the protobuf v3 wire format
+func (val *GetWorkflowExecutionTimelineResponse) Unmarshal(buf []byte) error {
 	return proto.Unmarshal(buf, val)
 }
 
 // Size returns the size of the object, in bytes, once serialized
-func (val *RemoveTaskRequest) Size() int {
+func (val *GetWorkflowExecutionTimelineResponse) Size() int {
 	return proto.Size(val)
 }
 
-// Equal returns whether two RemoveTaskRequest values are equivalent by recursively
+// Equal returns whether two GetWorkflowExecutionTimelineResponse values are equivalent by recursively
 // comparing the message's fields.
 // For more information see the documentation for
 // https://pkg.go.dev/google.golang.org/protobuf/proto#Equal
-func (this *RemoveTaskRequest) Equal(that interface{}) bool {
+func (this *GetWorkflowExecutionTimelineResponse) Equal(that interface{}) bool {
 	if that == nil {
 		return this == nil
 	}
 
-	var that1 *RemoveTaskRequest
+	var that1 *GetWorkflowExecutionTimelineResponse
 	switch t := that.(type) {
-	case *RemoveTaskRequest:
+	case *GetWorkflowExecutionTimelineResponse:
 		that1 = t
-	case RemoveTaskRequest:
+	case GetWorkflowExecutionTimelineResponse:
 		that1 = &t
 	default:
 		return false
@@ -597,35 +597,35 @@ func (this *RemoveTaskRequest) Equal(that interface{}) bool {
 	return proto.Equal(this, that1)
 }
 
-// Marshal an object of type RemoveTaskResponse to the protobuf v3 wire format
-func (val *RemoveTaskResponse) Marshal() ([]byte, error) {
+// Marshal an object of type WorkflowExecutionTimeline to the protobuf v3 wire format
+func (val *WorkflowExecutionTimeline) Marshal() ([]byte, error) {
 	return proto.Marshal(val)
 }
 
-// Unmarshal an object of type RemoveTaskResponse from the protobuf v3 wire format
-func (val *RemoveTaskResponse) Unmarshal(buf []byte) error {
+// Unmarshal an object of type WorkflowExecutionTimeline from the protobuf v3 wire format
+func (val *WorkflowExecutionTimeline) Unmarshal(buf []byte) error {
 	return proto.Unmarshal(buf, val)
 }
 
 // Size returns the size of the object, in bytes, once serialized
-func (val *RemoveTaskResponse) Size() int {
+func (val *WorkflowExecutionTimeline) Size() int {
 	return proto.Size(val)
 }
 
-// Equal returns whether two RemoveTaskResponse values are equivalent by recursively
+// Equal returns whether two WorkflowExecutionTimeline values are equivalent by recursively
 // comparing the message's fields.
 // For more information see the documentation for
 // https://pkg.go.dev/google.golang.org/protobuf/proto#Equal
-func (this *RemoveTaskResponse) Equal(that interface{}) bool {
+func (this *WorkflowExecutionTimeline) Equal(that interface{}) bool {
 	if that == nil {
 		return this == nil
 	}
 
-	var that1 *RemoveTaskResponse
+	var that1 *WorkflowExecutionTimeline
 	switch t := that.(type) {
-	case *RemoveTaskResponse:
+	case *WorkflowExecutionTimeline:
 		that1 = t
-	case RemoveTaskResponse:
+	case WorkflowExecutionTimeline:
 		that1 = &t
 	default:
 		return false
@@ -634,35 +634,35 @@ func (this *RemoveTaskResponse) Equal(that interface{}) bool {
 	return proto.Equal(this, that1)
 }
 
-// Marshal an object of type GetWorkflowExecutionRawHistoryV2Request to the protobuf v3 wire format
-func (val *GetWorkflowExecutionRawHistoryV2Request) Marshal() ([]byte, error) {
+// Marshal an object of type TimelineSpan to the protobuf v3 wire format
+func (val *TimelineSpan) Marshal() ([]byte, error) {
 	return proto.Marshal(val)
 }
 
-// Unmarshal an object of type GetWorkflowExecutionRawHistoryV2Request from the protobuf v3 wire format
-func (val *GetWorkflowExecutionRawHistoryV2Request) Unmarshal(buf []byte) error {
+// Unmarshal an object of type TimelineSpan from the protobuf v3 wire format
+func (val *TimelineSpan) Unmarshal(buf []byte) error {
 	return proto.Unmarshal(buf, val)
 }
 
 // Size returns the size of the object, in bytes, once serialized
-func (val *GetWorkflowExecutionRawHistoryV2Request) Size() int {
+func (val *TimelineSpan) Size() int {
 	return proto.Size(val)
 }
 
-// Equal returns whether two GetWorkflowExecutionRawHistoryV2Request values are equivalent by recursively
+// Equal returns whether two TimelineSpan values are equivalent by recursively
 // comparing the message's fields.
 // For more information see the documentation for
 // https://pkg.go.dev/google.golang.org/protobuf/proto#Equal
-func (this *GetWorkflowExecutionRawHistoryV2Request) Equal(that interface{}) bool {
+func (this *TimelineSpan) Equal(that interface{}) bool {
 	if that == nil {
 		return this == nil
 	}
 
-	var that1 *GetWorkflowExecutionRawHistoryV2Request
+	var that1 *TimelineSpan
 	switch t := that.(type) {
-	case *GetWorkflowExecutionRawHistoryV2Request:
+	case *TimelineSpan:
 		that1 = t
-	case GetWorkflowExecutionRawHistoryV2Request:
+	case TimelineSpan:
 		that1 = &t
 	default:
 		return false
@@ -671,35 +671,35 @@ func (this *GetWorkflowExecutionRawHistoryV2Request) Equal(that interface{}) boo
 	return proto.Equal(this, that1)
 }
 
-// Marshal an object of type GetWorkflowExecutionRawHistoryV2Response to the protobuf v3 wire format
-func (val *GetWorkflowExecutionRawHistoryV2Response) Marshal() ([]byte, error) {
+// Marshal an object of type DescribeMutableStateRequest to the protobuf v3 wire format
+func (val *DescribeMutableStateRequest) Marshal() ([]byte, error) {
 	return proto.Marshal(val)
 }
 
-// Unmarshal an object of type GetWorkflowExecutionRawHistoryV2Response from the protobuf v3 wire format
-func (val *GetWorkflowExecutionRawHistoryV2Response) Unmarshal(buf []byte) error {
+// Unmarshal an object of type DescribeMutableStateRequest from the protobuf v3 wire format
+func (val *DescribeMutableStateRequest) Unmarshal(buf []byte) error {
 	return proto.Unmarshal(buf, val)
 }
 
 // Size returns the size of the object, in bytes, once serialized
-func (val *GetWorkflowExecutionRawHistoryV2Response) Size() int {
+func (val *DescribeMutableStateRequest) Size() int {
 	return proto.Size(val)
 }
 
-// Equal returns whether two GetWorkflowExecutionRawHistoryV2Response values are equivalent by recursively
+// Equal returns whether two DescribeMutableStateRequest values are equivalent by recursively
 // comparing the message's fields.
 // For more information see the documentation for
 // https://pkg.go.dev/google.golang.org/protobuf/proto#Equal
-func (this *GetWorkflowExecutionRawHistoryV2Response) Equal(that interface{}) bool {
+func (this *DescribeMutableStateRequest) Equal(that interface{}) bool {
 	if that == nil {
 		return this == nil
 	}
 
-	var that1 *GetWorkflowExecutionRawHistoryV2Response
+	var that1 *DescribeMutableStateRequest
 	switch t := that.(type) {
-	case *GetWorkflowExecutionRawHistoryV2Response:
+	case *DescribeMutableStateRequest:
 		that1 = t
-	case GetWorkflowExecutionRawHistoryV2Response:
+	case DescribeMutableStateRequest:
 		that1 = &t
 	default:
 		return false
@@ -708,35 +708,35 @@ func (this *GetWorkflowExecutionRawHistoryV2Response) Equal(that interface{}) bo
 	return proto.Equal(this, that1)
 }
 
-// Marshal an object of type GetWorkflowExecutionRawHistoryRequest to the protobuf v3 wire format
-func (val *GetWorkflowExecutionRawHistoryRequest) Marshal() ([]byte, error) {
+// Marshal an object of type DescribeMutableStateResponse to the protobuf v3 wire format
+func (val *DescribeMutableStateResponse) Marshal() ([]byte, error) {
 	return proto.Marshal(val)
 }
 
-// Unmarshal an object of type GetWorkflowExecutionRawHistoryRequest from the protobuf v3 wire format
-func (val *GetWorkflowExecutionRawHistoryRequest) Unmarshal(buf []byte) error {
+// Unmarshal an object of type DescribeMutableStateResponse from the protobuf v3 wire format
+func (val *DescribeMutableStateResponse) Unmarshal(buf []byte) error {
 	return proto.Unmarshal(buf, val)
 }
 
 // Size returns the size of the object, in bytes, once serialized
-func (val *GetWorkflowExecutionRawHistoryRequest) Size() int {
+func (val *DescribeMutableStateResponse) Size() int {
 	return proto.Size(val)
 }
 
-// Equal returns whether two GetWorkflowExecutionRawHistoryRequest values are equivalent by recursively
+// Equal returns whether two DescribeMutableStateResponse values are equivalent by recursively
 // comparing the message's fields.
 // For more information see the documentation for
 // https://pkg.go.dev/google.golang.org/protobuf/proto#Equal
-func (this *GetWorkflowExecutionRawHistoryRequest) Equal(that interface{}) bool {
+func (this *DescribeMutableStateResponse) Equal(that interface{}) bool {
 	if that == nil {
 		return this == nil
 	}
 
-	var that1 *GetWorkflowExecutionRawHistoryRequest
+	var that1 *DescribeMutableStateResponse
 	switch t := that.(type) {
-	case *GetWorkflowExecutionRawHistoryRequest:
+	case *DescribeMutableStateResponse:
 		that1 = t
-	case GetWorkflowExecutionRawHistoryRequest:
+	case DescribeMutableStateResponse:
 		that1 = &t
 	default:
 		return false
@@ -745,35 +745,35 @@ func (this *GetWorkflowExecutionRawHistoryRequest) Equal(that interface{}) bool
 	return proto.Equal(this, that1)
 }
 
-// Marshal an object of type GetWorkflowExecutionRawHistoryResponse to the protobuf v3 wire format
-func (val *GetWorkflowExecutionRawHistoryResponse) Marshal() ([]byte, error) {
+// Marshal an object of type DescribeHistoryHostRequest to the protobuf v3 wire format
+func (val *DescribeHistoryHostRequest) Marshal() ([]byte, error) {
 	return proto.Marshal(val)
 }
 
-// Unmarshal an object of type GetWorkflowExecutionRawHistoryResponse from the protobuf v3 wire format
-func (val *GetWorkflowExecutionRawHistoryResponse) Unmarshal(buf []byte) error {
+// Unmarshal an object of type DescribeHistoryHostRequest from the protobuf v3 wire format
+func (val *DescribeHistoryHostRequest) Unmarshal(buf []byte) error {
 	return proto.Unmarshal(buf, val)
 }
 
 // Size returns the size of the object, in bytes, once serialized
-func (val *GetWorkflowExecutionRawHistoryResponse) Size() int {
+func (val *DescribeHistoryHostRequest) Size() int {
 	return proto.Size(val)
 }
 
-// Equal returns whether two GetWorkflowExecutionRawHistoryResponse values are equivalent by recursively
+// Equal returns whether two DescribeHistoryHostRequest values are equivalent by recursively
 // comparing the message's fields.
 // For more information see the documentation for
 // https://pkg.go.dev/google.golang.org/protobuf/proto#Equal
-func (this *GetWorkflowExecutionRawHistoryResponse) Equal(that interface{}) bool {
+func (this *DescribeHistoryHostRequest) Equal(that interface{}) bool {
 	if that == nil {
 		return this == nil
 	}
 
-	var that1 *GetWorkflowExecutionRawHistoryResponse
+	var that1 *DescribeHistoryHostRequest
 	switch t := that.(type) {
-	case *GetWorkflowExecutionRawHistoryResponse:
+	case *DescribeHistoryHostRequest:
 		that1 = t
-	case GetWorkflowExecutionRawHistoryResponse:
+	case DescribeHistoryHostRequest:
 		that1 = &t
 	default:
 		return false
@@ -782,35 +782,35 @@ func (this *GetWorkflowExecutionRawHistoryResponse) Equal(that interface{}) bool
 	return proto.Equal(this, that1)
 }
 
-// Marshal an object of type GetReplicationMessagesRequest to the protobuf v3 wire format
-func (val *GetReplicationMessagesRequest) Marshal() ([]byte, error) {
+// Marshal an object of type DescribeHistoryHostResponse to the protobuf v3 wire format
+func (val *DescribeHistoryHostResponse) Marshal() ([]byte, error) {
 	return proto.Marshal(val)
 }
 
-// Unmarshal an object of type GetReplicationMessagesRequest from the protobuf v3 wire format
-func (val *GetReplicationMessagesRequest) Unmarshal(buf []byte) error {
+// Unmarshal an object of type DescribeHistoryHostResponse from the protobuf v3 wire format
+func (val *DescribeHistoryHostResponse) Unmarshal(buf []byte) error {
 	return proto.Unmarshal(buf, val)
 }
 
 // Size returns the size of the object, in bytes, once serialized
-func (val *GetReplicationMessagesRequest) Size() int {
+func (val *DescribeHistoryHostResponse) Size() int {
 	return proto.Size(val)
 }
 
-// Equal returns whether two GetReplicationMessagesRequest values are equivalent by recursively
+// Equal returns whether two DescribeHistoryHostResponse values are equivalent by recursively
 // comparing the message's fields.
 // For more information see the documentation for
 // https://pkg.go.dev/google.golang.org/protobuf/proto#Equal
-func (this *GetReplicationMessagesRequest) Equal(that interface{}) bool {
+func (this *DescribeHistoryHostResponse) Equal(that interface{}) bool {
 	if that == nil {
 		return this == nil
 	}
 
-	var that1 *GetReplicationMessagesRequest
+	var that1 *DescribeHistoryHostResponse
 	switch t := that.(type) {
-	case *GetReplicationMessagesRequest:
+	case *DescribeHistoryHostResponse:
 		that1 = t
-	case GetReplicationMessagesRequest:
+	case DescribeHistoryHostResponse:
 		that1 = &t
 	default:
 		return false
@@ -819,35 +819,35 @@ func (this *GetReplicationMessagesRequest) Equal(that interface{}) bool {
 	return proto.Equal(this, that1)
 }
 
-// Marshal an object of type GetReplicationMessagesResponse to the protobuf v3 wire format
-func (val *GetReplicationMessagesResponse) Marshal() ([]byte, error) {
+// Marshal an object of type MoveHistoryShardRequest to the protobuf v3 wire format
+func (val *MoveHistoryShardRequest) Marshal() ([]byte, error) {
 	return proto.Marshal(val)
 }
 
-// Unmarshal an object of type GetReplicationMessagesResponse from the protobuf v3 wire format
-func (val *GetReplicationMessagesResponse) Unmarshal(buf []byte) error {
+// Unmarshal an object of type MoveHistoryShardRequest from the protobuf v3 wire format
+func (val *MoveHistoryShardRequest) Unmarshal(buf []byte) error {
 	return proto.Unmarshal(buf, val)
 }
 
 // Size returns the size of the object, in bytes, once serialized
-func (val *GetReplicationMessagesResponse) Size() int {
+func (val *MoveHistoryShardRequest) Size() int {
 	return proto.Size(val)
 }
 
-// Equal returns whether two GetReplicationMessagesResponse values are equivalent by recursively
+// Equal returns whether two MoveHistoryShardRequest values are equivalent by recursively
 // comparing the message's fields.
 // For more information see the documentation for
 // https://pkg.go.dev/google.golang.org/protobuf/proto#Equal
-func (this *GetReplicationMessagesResponse) Equal(that interface{}) bool {
+func (this *MoveHistoryShardRequest) Equal(that interface{}) bool {
 	if that == nil {
 		return this == nil
 	}
 
-	var that1 *GetReplicationMessagesResponse
+	var that1 *MoveHistoryShardRequest
 	switch t := that.(type) {
-	case *GetReplicationMessagesResponse:
+	case *MoveHistoryShardRequest:
 		that1 = t
-	case GetReplicationMessagesResponse:
+	case MoveHistoryShardRequest:
 		that1 = &t
 	default:
 		return false
@@ -856,35 +856,35 @@ func (this *GetReplicationMessagesResponse) Equal(that interface{}) bool {
 	return proto.Equal(this, that1)
 }
 
-// Marshal an object of type GetNamespaceReplicationMessagesRequest to the protobuf v3 wire format
-func (val *GetNamespaceReplicationMessagesRequest) Marshal() ([]byte, error) {
+// Marshal an object of type MoveHistoryShardResponse to the protobuf v3 wire format
+func (val *MoveHistoryShardResponse) Marshal() ([]byte, error) {
 	return proto.Marshal(val)
 }
 
-// Unmarshal an object of type GetNamespaceReplicationMessagesRequest from the protobuf v3 wire format
-func (val *GetNamespaceReplicationMessagesRequest) Unmarshal(buf []byte) error {
+// Unmarshal an object of type MoveHistoryShardResponse from the protobuf v3 wire format
+func (val *MoveHistoryShardResponse) Unmarshal(buf []byte) error {
 	return proto.Unmarshal(buf, val)
 }
 
 // Size returns the size of the object, in bytes, once serialized
-func (val *GetNamespaceReplicationMessagesRequest) Size() int {
+func (val *MoveHistoryShardResponse) Size() int {
 	return proto.Size(val)
 }
 
-// Equal returns whether two GetNamespaceReplicationMessagesRequest values are equivalent by recursively
+// Equal returns whether two MoveHistoryShardResponse values are equivalent by recursively
 // comparing the message's fields.
 // For more information see the documentation for
 // https://pkg.go.dev/google.golang.org/protobuf/proto#Equal
-func (this *GetNamespaceReplicationMessagesRequest) Equal(that interface{}) bool {
+func (this *MoveHistoryShardResponse) Equal(that interface{}) bool {
 	if that == nil {
 		return this == nil
 	}
 
-	var that1 *GetNamespaceReplicationMessagesRequest
+	var that1 *MoveHistoryShardResponse
 	switch t := that.(type) {
-	case *GetNamespaceReplicationMessagesRequest:
+	case *MoveHistoryShardResponse:
 		that1 = t
-	case GetNamespaceReplicationMessagesRequest:
+	case MoveHistoryShardResponse:
 		that1 = &t
 	default:
 		return false
@@ -893,35 +893,35 @@ func (this *GetNamespaceReplicationMessagesRequest) Equal(that interface{}) bool
 	return proto.Equal(this, that1)
 }
 
-// Marshal an object of type GetNamespaceReplicationMessagesResponse to the protobuf v3 wire format
-func (val *GetNamespaceReplicationMessagesResponse) Marshal() ([]byte, error) {
+// Marshal an object of type CloseShardRequest to the protobuf v3 wire format
+func (val *CloseShardRequest) Marshal() ([]byte, error) {
 	return proto.Marshal(val)
 }
 
-// Unmarshal an object of type GetNamespaceReplicationMessagesResponse from the protobuf v3 wire format
-func (val *GetNamespaceReplicationMessagesResponse) Unmarshal(buf []byte) error {
+// Unmarshal an object of type CloseShardRequest from the protobuf v3 wire format
+func (val *CloseShardRequest) Unmarshal(buf []byte) error {
 	return proto.Unmarshal(buf, val)
 }
 
 // Size returns the size of the object, in bytes, once serialized
-func (val *GetNamespaceReplicationMessagesResponse) Size() int {
+func (val *CloseShardRequest) Size() int {
 	return proto.Size(val)
 }
 
-// Equal returns whether two GetNamespaceReplicationMessagesResponse values are equivalent by recursively
+// Equal returns whether two CloseShardRequest values are equivalent by recursively
 // comparing the message's fields.
 // For more information see the documentation for
 // https://pkg.go.dev/google.golang.org/protobuf/proto#Equal
-func (this *GetNamespaceReplicationMessagesResponse) Equal(that interface{}) bool {
+func (this *CloseShardRequest) Equal(that interface{}) bool {
 	if that == nil {
 		return this == nil
 	}
 
-	var that1 *GetNamespaceReplicationMessagesResponse
+	var that1 *CloseShardRequest
 	switch t := that.(type) {
-	case *GetNamespaceReplicationMessagesResponse:
+	case *CloseShardRequest:
 		that1 = t
-	case GetNamespaceReplicationMessagesResponse:
+	case CloseShardRequest:
 		that1 = &t
 	default:
 		return false
@@ -930,35 +930,35 @@ func (this *GetNamespaceReplicationMessagesResponse) Equal(that interface{}) boo
 	return proto.Equal(this, that1)
 }
 
-// Marshal an object of type GetDLQReplicationMessagesRequest to the protobuf v3 wire format
-func (val *GetDLQReplicationMessagesRequest) Marshal() ([]byte, error) {
+// Marshal an object of type CloseShardResponse to the protobuf v3 wire format
+func (val *CloseShardResponse) Marshal() ([]byte, error) {
 	return proto.Marshal(val)
 }
 
-// Unmarshal an object of type GetDLQReplicationMessagesRequest from the protobuf v3 wire format
-func (val *GetDLQReplicationMessagesRequest) Unmarshal(buf []byte) error {
+// Unmarshal an object of type CloseShardResponse from the protobuf v3 wire format
+func (val *CloseShardResponse) Unmarshal(buf []byte) error {
 	return proto.Unmarshal(buf, val)
 }
 
 // Size returns the size of the object, in bytes, once serialized
-func (val *GetDLQReplicationMessagesRequest) Size() int {
+func (val *CloseShardResponse) Size() int {
 	return proto.Size(val)
 }
 
-// Equal returns whether two GetDLQReplicationMessagesRequest values are equivalent by recursively
+// Equal returns whether two CloseShardResponse values are equivalent by recursively
 // comparing the message's fields.
 // For more information see the documentation for
 // https://pkg.go.dev/google.golang.org/protobuf/proto#Equal
-func (this *GetDLQReplicationMessagesRequest) Equal(that interface{}) bool {
+func (this *CloseShardResponse) Equal(that interface{}) bool {
 	if that == nil {
 		return this == nil
 	}
 
-	var that1 *GetDLQReplicationMessagesRequest
+	var that1 *CloseShardResponse
 	switch t := that.(type) {
-	case *GetDLQReplicationMessagesRequest:
+	case *CloseShardResponse:
 		that1 = t
-	case GetDLQReplicationMessagesRequest:
+	case CloseShardResponse:
 		that1 = &t
 	default:
 		return false
@@ -967,35 +967,35 @@ func (this *GetDLQReplicationMessagesRequest) Equal(that interface{}) bool {
 	return proto.Equal(this, that1)
 }
 
-// Marshal an object of type GetDLQReplicationMessagesResponse to the protobuf v3 wire format
-func (val *GetDLQReplicationMessagesResponse) Marshal() ([]byte, error) {
+// Marshal an object of type GetShardRequest to the protobuf v3 wire format
+func (val *GetShardRequest) Marshal() ([]byte, error) {
 	return proto.Marshal(val)
 }
 
-// Unmarshal an object of type GetDLQReplicationMessagesResponse from the protobuf v3 wire format
-func (val *GetDLQReplicationMessagesResponse) Unmarshal(buf []byte) error {
+// Unmarshal an object of type GetShardRequest from the protobuf v3 wire format
+func (val *GetShardRequest) Unmarshal(buf []byte) error {
 	return proto.Unmarshal(buf, val)
 }
 
 // Size returns the size of the object, in bytes, once serialized
-func (val *GetDLQReplicationMessagesResponse) Size() int {
+func (val *GetShardRequest) Size() int {
 	return proto.Size(val)
 }
 
-// Equal returns whether two GetDLQReplicationMessagesResponse values are equivalent by recursively
+// Equal returns whether two GetShardRequest values are equivalent by recursively
 // comparing the message's fields.
 // For more information see the documentation for
 // https://pkg.go.dev/google.golang.org/protobuf/proto#Equal
-func (this *GetDLQReplicationMessagesResponse) Equal(that interface{}) bool {
+func (this *GetShardRequest) Equal(that interface{}) bool {
 	if that == nil {
 		return this == nil
 	}
 
-	var that1 *GetDLQReplicationMessagesResponse
+	var that1 *GetShardRequest
 	switch t := that.(type) {
-	case *GetDLQReplicationMessagesResponse:
+	case *GetShardRequest:
 		that1 = t
-	case GetDLQReplicationMessagesResponse:
+	case GetShardRequest:
 		that1 = &t
 	default:
 		return false
@@ -1004,35 +1004,35 @@ func (this *GetDLQReplicationMessagesResponse) Equal(that interface{}) bool {
 	return proto.Equal(this, that1)
 }
 
-// Marshal an object of type ReapplyEventsRequest to the protobuf v3 wire format
-func (val *ReapplyEventsRequest) Marshal() ([]byte, error) {
+// Marshal an object of type GetShardResponse to the protobuf v3 wire format
+func (val *GetShardResponse) Marshal() ([]byte, error) {
 	return proto.Marshal(val)
 }
 
-// Unmarshal an object of type ReapplyEventsRequest from the protobuf v3 wire format
-func (val *ReapplyEventsRequest) Unmarshal(buf []byte) error {
+// Unmarshal an object of type GetShardResponse from the protobuf v3 wire format
+func (val *GetShardResponse) Unmarshal(buf []byte) error {
 	return proto.Unmarshal(buf, val)
 }
 
 // Size returns the size of the object, in bytes, once serialized
-func (val *ReapplyEventsRequest) Size() int {
+func (val *GetShardResponse) Size() int {
 	return proto.Size(val)
 }
 
-// Equal returns whether two ReapplyEventsRequest values are equivalent by recursively
+// Equal returns whether two GetShardResponse values are equivalent by recursively
 // comparing the message's fields.
 // For more information see the documentation for
 // https://pkg.go.dev/google.golang.org/protobuf/proto#Equal
-func (this *ReapplyEventsRequest) Equal(that interface{}) bool {
+func (this *GetShardResponse) Equal(that interface{}) bool {
 	if that == nil {
 		return this == nil
 	}
 
-	var that1 *ReapplyEventsRequest
+	var that1 *GetShardResponse
 	switch t := that.(type) {
-	case *ReapplyEventsRequest:
+	case *GetShardResponse:
 		that1 = t
-	case ReapplyEventsRequest:
+	case GetShardResponse:
 		that1 = &t
 	default:
 		return false
@@ -1041,35 +1041,35 @@ func (this *ReapplyEventsRequest) Equal(that interface{}) bool {
 	return proto.Equal(this, that1)
 }
 
-// Marshal an object of type ReapplyEventsResponse to the protobuf v3 wire format
-func (val *ReapplyEventsResponse) Marshal() ([]byte, error) {
+// Marshal an object of type ListHistoryTasksRequest to the protobuf v3 wire format
+func (val *ListHistoryTasksRequest) Marshal() ([]byte, error) {
 	return proto.Marshal(val)
 }
 
-// Unmarshal an object of type ReapplyEventsResponse from the protobuf v3 wire format
-func (val *ReapplyEventsResponse) Unmarshal(buf []byte) error {
+// Unmarshal an object of type ListHistoryTasksRequest from the protobuf v3 wire format
+func (val *ListHistoryTasksRequest) Unmarshal(buf []byte) error {
 	return proto.Unmarshal(buf, val)
 }
 
 // Size returns the size of the object, in bytes, once serialized
-func (val *ReapplyEventsResponse) Size() int {
+func (val *ListHistoryTasksRequest) Size() int {
 	return proto.Size(val)
 }
 
-// Equal returns whether two ReapplyEventsResponse values are equivalent by recursively
+// Equal returns whether two ListHistoryTasksRequest values are equivalent by recursively
 // comparing the message's fields.
 // For more information see the documentation for
 // https://pkg.go.dev/google.golang.org/protobuf/proto#Equal
-func (this *ReapplyEventsResponse) Equal(that interface{}) bool {
+func (this *ListHistoryTasksRequest) Equal(that interface{}) bool {
 	if that == nil {
 		return this == nil
 	}
 
-	var that1 *ReapplyEventsResponse
+	var that1 *ListHistoryTasksRequest
 	switch t := that.(type) {
-	case *ReapplyEventsResponse:
+	case *ListHistoryTasksRequest:
 		that1 = t
-	case ReapplyEventsResponse:
+	case ListHistoryTasksRequest:
 		that1 = &t
 	default:
 		return false
@@ -1078,35 +1078,35 @@ func (this *ReapplyEventsResponse) Equal(that interface{}) bool {
 	return proto.Equal(this, that1)
 }
 
-// Marshal an object of type AddSearchAttributesRequest to the protobuf v3 wire format
-func (val *AddSearchAttributesRequest) Marshal() ([]byte, error) {
+// Marshal an object of type ListHistoryTasksResponse to the protobuf v3 wire format
+func (val *ListHistoryTasksResponse) Marshal() ([]byte, error) {
 	return proto.Marshal(val)
 }
 
-// Unmarshal an object of type AddSearchAttributesRequest from the protobuf v3 wire format
-func (val *AddSearchAttributesRequest) Unmarshal(buf []byte) error {
+// Unmarshal an object of type ListHistoryTasksResponse from the protobuf v3 wire format
+func (val *ListHistoryTasksResponse) Unmarshal(buf []byte) error {
 	return proto.Unmarshal(buf, val)
 }
 
 // Size returns the size of the object, in bytes, once serialized
-func (val *AddSearchAttributesRequest) Size() int {
+func (val *ListHistoryTasksResponse) Size() int {
 	return proto.Size(val)
 }
 
-// Equal returns whether two AddSearchAttributesRequest values are equivalent by recursively
+// Equal returns whether two ListHistoryTasksResponse values are equivalent by recursively
 // comparing the message's fields.
 // For more information see the documentation for
 // https://pkg.go.dev/google.golang.org/protobuf/proto#Equal
-func (this *AddSearchAttributesRequest) Equal(that interface{}) bool {
+func (this *ListHistoryTasksResponse) Equal(that interface{}) bool {
 	if that == nil {
 		return this == nil
 	}
 
-	var that1 *AddSearchAttributesRequest
+	var that1 *ListHistoryTasksResponse
 	switch t := that.(type) {
-	case *AddSearchAttributesRequest:
+	case *ListHistoryTasksResponse:
 		that1 = t
-	case AddSearchAttributesRequest:
+	case ListHistoryTasksResponse:
 		that1 = &t
 	default:
 		return false
@@ -1115,35 +1115,35 @@ func (this *AddSearchAttributesRequest) Equal(that interface{}) bool {
 	return proto.Equal(this, that1)
 }
 
-// Marshal an object of type AddSearchAttributesResponse to the protobuf v3 wire format
-func (val *AddSearchAttributesResponse) Marshal() ([]byte, error) {
+// Marshal an object of type Task to the protobuf v3 wire format
+func (val *Task) Marshal() ([]byte, error) {
 	return proto.Marshal(val)
 }
 
-// Unmarshal an object of type AddSearchAttributesResponse from the protobuf v3 wire format
-func (val *AddSearchAttributesResponse) Unmarshal(buf []byte) error {
+// Unmarshal an object of type Task from the protobuf v3 wire format
+func (val *Task) Unmarshal(buf []byte) error {
 	return proto.Unmarshal(buf, val)
 }
 
 // Size returns the size of the object, in bytes, once serialized
-func (val *AddSearchAttributesResponse) Size() int {
+func (val *Task) Size() int {
 	return proto.Size(val)
 }
 
-// Equal returns whether two AddSearchAttributesResponse values are equivalent by recursively
+// Equal returns whether two Task values are equivalent by recursively
 // comparing the message's fields.
 // For more information see the documentation for
 // https://pkg.go.dev/google.golang.org/protobuf/proto#Equal
-func (this *AddSearchAttributesResponse) Equal(that interface{}) bool {
+func (this *Task) Equal(that interface{}) bool {
 	if that == nil {
 		return this == nil
 	}
 
-	var that1 *AddSearchAttributesResponse
+	var that1 *Task
 	switch t := that.(type) {
-	case *AddSearchAttributesResponse:
+	case *Task:
 		that1 = t
-	case AddSearchAttributesResponse:
+	case Task:
 		that1 = &t
 	default:
 		return false
@@ -1152,35 +1152,35 @@ func (this *AddSearchAttributesResponse) Equal(that interface{}) bool {
 	return proto.Equal(this, that1)
 }
 
-// Marshal an object of type RemoveSearchAttributesRequest to the protobuf v3 wire format
-func (val *RemoveSearchAttributesRequest) Marshal() ([]byte, error) {
+// Marshal an object of type RemoveTaskRequest to the protobuf v3 wire format
+func (val *RemoveTaskRequest) Marshal() ([]byte, error) {
 	return proto.Marshal(val)
 }
 
-// Unmarshal an object of type RemoveSearchAttributesRequest from the protobuf v3 wire format
-func (val *RemoveSearchAttributesRequest) Unmarshal(buf []byte) error {
+// Unmarshal an object of type RemoveTaskRequest from the protobuf v3 wire format
+func (val *RemoveTaskRequest) Unmarshal(buf []byte) error {
 	return proto.Unmarshal(buf, val)
 }
 
 // Size returns the size of the object, in bytes, once serialized
-func (val *RemoveSearchAttributesRequest) Size() int {
+func (val *RemoveTaskRequest) Size() int {
 	return proto.Size(val)
 }
 
-// Equal returns whether two RemoveSearchAttributesRequest values are equivalent by recursively
+// Equal returns whether two RemoveTaskRequest values are equivalent by recursively
 // comparing the message's fields.
 // For more information see the documentation for
 // https://pkg.go.dev/google.golang.org/protobuf/proto#Equal
-func (this *RemoveSearchAttributesRequest) Equal(that interface{}) bool {
+func (this *RemoveTaskRequest) Equal(that interface{}) bool {
 	if that == nil {
 		return this == nil
 	}
 
-	var that1 *RemoveSearchAttributesRequest
+	var that1 *RemoveTaskRequest
 	switch t := that.(type) {
-	case *RemoveSearchAttributesRequest:
+	case *RemoveTaskRequest:
 		that1 = t
-	case RemoveSearchAttributesRequest:
+	case RemoveTaskRequest:
 		that1 = &t
 	default:
 		return false
@@ -1189,35 +1189,35 @@ func (this *RemoveSearchAttributesRequest) Equal(that interface{}) bool {
 	return proto.Equal(this, that1)
 }
 
-// Marshal an object of type RemoveSearchAttributesResponse to the protobuf v3 wire format
-func (val *RemoveSearchAttributesResponse) Marshal() ([]byte, error) {
+// Marshal an object of type RemoveTaskResponse to the protobuf v3 wire format
+func (val *RemoveTaskResponse) Marshal() ([]byte, error) {
 	return proto.Marshal(val)
 }
 
-// Unmarshal an object of type RemoveSearchAttributesResponse from the protobuf v3 wire format
-func (val *RemoveSearchAttributesResponse) Unmarshal(buf []byte) error {
+// Unmarshal an object of type RemoveTaskResponse from the protobuf v3 wire format
+func (val *RemoveTaskResponse) Unmarshal(buf []byte) error {
 	return proto.Unmarshal(buf, val)
 }
 
 // Size returns the size of the object, in bytes, once serialized
-func (val *RemoveSearchAttributesResponse) Size() int {
+func (val *RemoveTaskResponse) Size() int {
 	return proto.Size(val)
 }
 
-// Equal returns whether two RemoveSearchAttributesResponse values are equivalent by recursively
+// Equal returns whether two RemoveTaskResponse values are equivalent by recursively
 // comparing the message's fields.
 // For more information see the documentation for
 // https://pkg.go.dev/google.golang.org/protobuf/proto#Equal
-func (this *RemoveSearchAttributesResponse) Equal(that interface{}) bool {
+func (this *RemoveTaskResponse) Equal(that interface{}) bool {
 	if that == nil {
 		return this == nil
 	}
 
-	var that1 *RemoveSearchAttributesResponse
+	var that1 *RemoveTaskResponse
 	switch t := that.(type) {
-	case *RemoveSearchAttributesResponse:
+	case *RemoveTaskResponse:
 		that1 = t
-	case RemoveSearchAttributesResponse:
+	case RemoveTaskResponse:
 		that1 = &t
 	default:
 		return false
@@ -1226,35 +1226,35 @@ func (this *RemoveSearchAttributesResponse) Equal(that interface{}) bool {
 	return proto.Equal(this, that1)
 }
 
-// Marshal an object of type GetSearchAttributesRequest to the protobuf v3 wire format
-func (val *GetSearchAttributesRequest) Marshal() ([]byte, error) {
+// Marshal an object of type GetWorkflowExecutionRawHistoryV2Request to the protobuf v3 wire format
+func (val *GetWorkflowExecutionRawHistoryV2Request) Marshal() ([]byte, error) {
 	return proto.Marshal(val)
 }
 
-// Unmarshal an object of type GetSearchAttributesRequest from the protobuf v3 wire format
-func (val *GetSearchAttributesRequest) Unmarshal(buf []byte) error {
+// Unmarshal an object of type GetWorkflowExecutionRawHistoryV2Request from the protobuf v3 wire format
+func (val *GetWorkflowExecutionRawHistoryV2Request) Unmarshal(buf []byte) error {
 	return proto.Unmarshal(buf, val)
 }
 
 // Size returns the size of the object, in bytes, once serialized
-func (val *GetSearchAttributesRequest) Size() int {
+func (val *GetWorkflowExecutionRawHistoryV2Request) Size() int {
 	return proto.Size(val)
 }
 
-// Equal returns whether two GetSearchAttributesRequest values are equivalent by recursively
+// Equal returns whether two GetWorkflowExecutionRawHistoryV2Request values are equivalent by recursively
 // comparing the message's fields.
 // For more information see the documentation for
 // https://pkg.go.dev/google.golang.org/protobuf/proto#Equal
-func (this *GetSearchAttributesRequest) Equal(that interface{}) bool {
+func (this *GetWorkflowExecutionRawHistoryV2Request) Equal(that interface{}) bool {
 	if that == nil {
 		return this == nil
 	}
 
-	var that1 *GetSearchAttributesRequest
+	var that1 *GetWorkflowExecutionRawHistoryV2Request
 	switch t := that.(type) {
-	case *GetSearchAttributesRequest:
+	case *GetWorkflowExecutionRawHistoryV2Request:
 		that1 = t
-	case GetSearchAttributesRequest:
+	case GetWorkflowExecutionRawHistoryV2Request:
 		that1 = &t
 	default:
 		return false
@@ -1263,35 +1263,35 @@ func (this *GetSearchAttributesRequest) Equal(that interface{}) bool {
 	return proto.Equal(this, that1)
 }
 
-// Marshal an object of type GetSearchAttributesResponse to the protobuf v3 wire format
-func (val *GetSearchAttributesResponse) Marshal() ([]byte, error) {
+// Marshal an object of type GetWorkflowExecutionRawHistoryV2Response to the protobuf v3 wire format
+func (val *GetWorkflowExecutionRawHistoryV2Response) Marshal() ([]byte, error) {
 	return proto.Marshal(val)
 }
 
-// Unmarshal an object of type GetSearchAttributesResponse from the protobuf v3 wire format
-func (val *GetSearchAttributesResponse) Unmarshal(buf []byte) error {
+// Unmarshal an object of type GetWorkflowExecutionRawHistoryV2Response from the protobuf v3 wire format
+func (val *GetWorkflowExecutionRawHistoryV2Response) Unmarshal(buf []byte) error {
 	return proto.Unmarshal(buf, val)
 }
 
 // Size returns the size of the object, in bytes, once serialized
-func (val *GetSearchAttributesResponse) Size() int {
+func (val *GetWorkflowExecutionRawHistoryV2Response) Size() int {
 	return proto.Size(val)
 }
 
-// Equal returns whether two GetSearchAttributesResponse values are equivalent by recursively
+// Equal returns whether two GetWorkflowExecutionRawHistoryV2Response values are equivalent by recursively
 // comparing the message's fields.
 // For more information see the documentation for
 // https://pkg.go.dev/google.golang.org/protobuf/proto#Equal
-func (this *GetSearchAttributesResponse) Equal(that interface{}) bool {
+func (this *GetWorkflowExecutionRawHistoryV2Response) Equal(that interface{}) bool {
 	if that == nil {
 		return this == nil
 	}
 
-	var that1 *GetSearchAttributesResponse
+	var that1 *GetWorkflowExecutionRawHistoryV2Response
 	switch t := that.(type) {
-	case *GetSearchAttributesResponse:
+	case *GetWorkflowExecutionRawHistoryV2Response:
 		that1 = t
-	case GetSearchAttributesResponse:
+	case GetWorkflowExecutionRawHistoryV2Response:
 		that1 = &t
 	default:
 		return false
@@ -1300,35 +1300,35 @@ func (this *GetSearchAttributesResponse) Equal(that interface{}) bool {
 	return proto.Equal(this, that1)
 }
 
-// Marshal an object of type DescribeClusterRequest to the protobuf v3 wire format
-func (val *DescribeClusterRequest) Marshal() ([]byte, error) {
+// Marshal an object of type GetWorkflowExecutionRawHistoryRequest to the protobuf v3 wire format
+func (val *GetWorkflowExecutionRawHistoryRequest) Marshal() ([]byte, error) {
 	return proto.Marshal(val)
 }
 
-// Unmarshal an object of type DescribeClusterRequest from the protobuf v3 wire format
-func (val *DescribeClusterRequest) Unmarshal(buf []byte) error {
+// Unmarshal an object of type GetWorkflowExecutionRawHistoryRequest from the protobuf v3 wire format
+func (val *GetWorkflowExecutionRawHistoryRequest) Unmarshal(buf []byte) error {
 	return proto.Unmarshal(buf, val)
 }
 
 // Size returns the size of the object, in bytes, once serialized
-func (val *DescribeClusterRequest) Size() int {
+func (val *GetWorkflowExecutionRawHistoryRequest) Size() int {
 	return proto.Size(val)
 }
 
-// Equal returns whether two DescribeClusterRequest values are equivalent by recursively
+// Equal returns whether two GetWorkflowExecutionRawHistoryRequest values are equivalent by recursively
 // comparing the message's fields.
 // For more information see the documentation for
 // https://pkg.go.dev/google.golang.org/protobuf/proto#Equal
-func (this *DescribeClusterRequest) Equal(that interface{}) bool {
+func (this *GetWorkflowExecutionRawHistoryRequest) Equal(that interface{}) bool {
 	if that == nil {
 		return this == nil
 	}
 
-	var that1 *DescribeClusterRequest
+	var that1 *GetWorkflowExecutionRawHistoryRequest
 	switch t := that.(type) {
-	case *DescribeClusterRequest:
+	case *GetWorkflowExecutionRawHistoryRequest:
 		that1 = t
-	case DescribeClusterRequest:
+	case GetWorkflowExecutionRawHistoryRequest:
 		that1 = &t
 	default:
 		return false
@@ -1337,35 +1337,35 @@ func (this *DescribeClusterRequest) Equal(that interface{}) bool {
 	return proto.Equal(this, that1)
 }
 
-// Marshal an object of type DescribeClusterResponse to the protobuf v3 wire format
-func (val *DescribeClusterResponse) Marshal() ([]byte, error) {
+// Marshal an object of type GetWorkflowExecutionRawHistoryResponse to the protobuf v3 wire format
+func (val *GetWorkflowExecutionRawHistoryResponse) Marshal() ([]byte, error) {
 	return proto.Marshal(val)
 }
 
-// Unmarshal an object of type DescribeClusterResponse from the protobuf v3 wire format
-func (val *DescribeClusterResponse) Unmarshal(buf []byte) error {
+// Unmarshal an object of type GetWorkflowExecutionRawHistoryResponse from the protobuf v3 wire format
+func (val *GetWorkflowExecutionRawHistoryResponse) Unmarshal(buf []byte) error {
 	return proto.Unmarshal(buf, val)
 }
 
 // Size returns the size of the object, in bytes, once serialized
-func (val *DescribeClusterResponse) Size() int {
+func (val *GetWorkflowExecutionRawHistoryResponse) Size() int {
 	return proto.Size(val)
 }
 
-// Equal returns whether two DescribeClusterResponse values are equivalent by recursively
+// Equal returns whether two GetWorkflowExecutionRawHistoryResponse values are equivalent by recursively
 // comparing the message's fields.
 // For more information see the documentation for
 // https://pkg.go.dev/google.golang.org/protobuf/proto#Equal
-func (this *DescribeClusterResponse) Equal(that interface{}) bool {
+func (this *GetWorkflowExecutionRawHistoryResponse) Equal(that interface{}) bool {
 	if that == nil {
 		return this == nil
 	}
 
-	var that1 *DescribeClusterResponse
+	var that1 *GetWorkflowExecutionRawHistoryResponse
 	switch t := that.(type) {
-	case *DescribeClusterResponse:
+	case *GetWorkflowExecutionRawHistoryResponse:
 		that1 = t
-	case DescribeClusterResponse:
+	case GetWorkflowExecutionRawHistoryResponse:
 		that1 = &t
 	default:
 		return false
@@ -1374,35 +1374,35 @@ func (this *DescribeClusterResponse) Equal(that interface{}) bool {
 	return proto.Equal(this, that1)
 }
 
-// Marshal an object of type ListClustersRequest to the protobuf v3 wire format
-func (val *ListClustersRequest) Marshal() ([]byte, error) {
+// Marshal an object of type GetReplicationMessagesRequest to the protobuf v3 wire format
+func (val *GetReplicationMessagesRequest) Marshal() ([]byte, error) {
 	return proto.Marshal(val)
 }
 
-// Unmarshal an object of type ListClustersRequest from the protobuf v3 wire format
-func (val *ListClustersRequest) Unmarshal(buf []byte) error {
+// Unmarshal an object of type GetReplicationMessagesRequest from the protobuf v3 wire format
+func (val *GetReplicationMessagesRequest) Unmarshal(buf []byte) error {
 	return proto.Unmarshal(buf, val)
 }
 
 // Size returns the size of the object, in bytes, once serialized
-func (val *ListClustersRequest) Size() int {
+func (val *GetReplicationMessagesRequest) Size() int {
 	return proto.Size(val)
 }
 
-// Equal returns whether two ListClustersRequest values are equivalent by recursively
+// Equal returns whether two GetReplicationMessagesRequest values are equivalent by recursively
 // comparing the message's fields.
 // For more information see the documentation for
 // https://pkg.go.dev/google.golang.org/protobuf/proto#Equal
-func (this *ListClustersRequest) Equal(that interface{}) bool {
+func (this *GetReplicationMessagesRequest) Equal(that interface{}) bool {
 	if that == nil {
 		return this == nil
 	}
 
-	var that1 *ListClustersRequest
+	var that1 *GetReplicationMessagesRequest
 	switch t := that.(type) {
-	case *ListClustersRequest:
+	case *GetReplicationMessagesRequest:
 		that1 = t
-	case ListClustersRequest:
+	case GetReplicationMessagesRequest:
 		that1 = &t
 	default:
 		return false
@@ -1411,35 +1411,35 @@ func (this *ListClustersRequest) Equal(that interface{}) bool {
 	return proto.Equal(this, that1)
 }
 
-// Marshal an object of type ListClustersResponse to the protobuf v3 wire format
-func (val *ListClustersResponse) Marshal() ([]byte, error) {
+// Marshal an object of type GetReplicationMessagesResponse to the protobuf v3 wire format
+func (val *GetReplicationMessagesResponse) Marshal() ([]byte, error) {
 	return proto.Marshal(val)
 }
 
-// Unmarshal an object of type ListClustersResponse from the protobuf v3 wire format
-func (val *ListClustersResponse) Unmarshal(buf []byte) error {
+// Unmarshal an object of type GetReplicationMessagesResponse from the protobuf v3 wire format
+func (val *GetReplicationMessagesResponse) Unmarshal(buf []byte) error {
 	return proto.Unmarshal(buf, val)
 }
 
 // Size returns the size of the object, in bytes, once serialized
-func (val *ListClustersResponse) Size() int {
+func (val *GetReplicationMessagesResponse) Size() int {
 	return proto.Size(val)
 }
 
-// Equal returns whether two ListClustersResponse values are equivalent by recursively
+// Equal returns whether two GetReplicationMessagesResponse values are equivalent by recursively
 // comparing the message's fields.
 // For more information see the documentation for
 // https://pkg.go.dev/google.golang.org/protobuf/proto#Equal
-func (this *ListClustersResponse) Equal(that interface{}) bool {
+func (this *GetReplicationMessagesResponse) Equal(that interface{}) bool {
 	if that == nil {
 		return this == nil
 	}
 
-	var that1 *ListClustersResponse
+	var that1 *GetReplicationMessagesResponse
 	switch t := that.(type) {
-	case *ListClustersResponse:
+	case *GetReplicationMessagesResponse:
 		that1 = t
-	case ListClustersResponse:
+	case GetReplicationMessagesResponse:
 		that1 = &t
 	default:
 		return false
@@ -1448,35 +1448,35 @@ func (this *ListClustersResponse) Equal(that interface{}) bool {
 	return proto.Equal(this, that1)
 }
 
-// Marshal an object of type AddOrUpdateRemoteClusterRequest to the protobuf v3 wire format
-func (val *AddOrUpdateRemoteClusterRequest) Marshal() ([]byte, error) {
+// Marshal an object of type GetNamespaceReplicationMessagesRequest to the protobuf v3 wire format
+func (val *GetNamespaceReplicationMessagesRequest) Marshal() ([]byte, error) {
 	return proto.Marshal(val)
 }
 
-// Unmarshal an object of type AddOrUpdateRemoteClusterRequest from the protobuf v3 wire format
-func (val *AddOrUpdateRemoteClusterRequest) Unmarshal(buf []byte) error {
+// Unmarshal an object of type GetNamespaceReplicationMessagesRequest from the protobuf v3 wire format
+func (val *GetNamespaceReplicationMessagesRequest) Unmarshal(buf []byte) error {
 	return proto.Unmarshal(buf, val)
 }
 
 // Size returns the size of the object, in bytes, once serialized
-func (val *AddOrUpdateRemoteClusterRequest) Size() int {
+func (val *GetNamespaceReplicationMessagesRequest) Size() int {
 	return proto.Size(val)
 }
 
-// Equal returns whether two AddOrUpdateRemoteClusterRequest values are equivalent by recursively
+// Equal returns whether two GetNamespaceReplicationMessagesRequest values are equivalent by recursively
 // comparing the message's fields.
 // For more information see the documentation for
 // https://pkg.go.dev/google.golang.org/protobuf/proto#Equal
-func (this *AddOrUpdateRemoteClusterRequest) Equal(that interface{}) bool {
+func (this *GetNamespaceReplicationMessagesRequest) Equal(that interface{}) bool {
 	if that == nil {
 		return this == nil
 	}
 
-	var that1 *AddOrUpdateRemoteClusterRequest
+	var that1 *GetNamespaceReplicationMessagesRequest
 	switch t := that.(type) {
-	case *AddOrUpdateRemoteClusterRequest:
+	case *GetNamespaceReplicationMessagesRequest:
 		that1 = t
-	case AddOrUpdateRemoteClusterRequest:
+	case GetNamespaceReplicationMessagesRequest:
 		that1 = &t
 	default:
 		return false
@@ -1485,35 +1485,35 @@ func (this *AddOrUpdateRemoteClusterRequest) Equal(that interface{}) bool {
 	return proto.Equal(this, that1)
 }
 
-// Marshal an object of type AddOrUpdateRemoteClusterResponse to the protobuf v3 wire format
-func (val *AddOrUpdateRemoteClusterResponse) Marshal() ([]byte, error) {
+// Marshal an object of type GetNamespaceReplicationMessagesResponse to the protobuf v3 wire format
+func (val *GetNamespaceReplicationMessagesResponse) Marshal() ([]byte, error) {
 	return proto.Marshal(val)
 }
 
-// Unmarshal an object of type AddOrUpdateRemoteClusterResponse from the protobuf v3 wire format
-func (val *AddOrUpdateRemoteClusterResponse) Unmarshal(buf []byte) error {
+// Unmarshal an object of type GetNamespaceReplicationMessagesResponse from the protobuf v3 wire format
+func (val *GetNamespaceReplicationMessagesResponse) Unmarshal(buf []byte) error {
 	return proto.Unmarshal(buf, val)
 }
 
 // Size returns the size of the object, in bytes, once serialized
-func (val *AddOrUpdateRemoteClusterResponse) Size() int {
+func (val *GetNamespaceReplicationMessagesResponse) Size() int {
 	return proto.Size(val)
 }
 
-// Equal returns whether two AddOrUpdateRemoteClusterResponse values are equivalent by recursively
+// Equal returns whether two GetNamespaceReplicationMessagesResponse values are equivalent by recursively
 // comparing the message's fields.
 // For more information see the documentation for
 // https://pkg.go.dev/google.golang.org/protobuf/proto#Equal
-func (this *AddOrUpdateRemoteClusterResponse) Equal(that interface{}) bool {
+func (this *GetNamespaceReplicationMessagesResponse) Equal(that interface{}) bool {
 	if that == nil {
 		return this == nil
 	}
 
-	var that1 *AddOrUpdateRemoteClusterResponse
+	var that1 *GetNamespaceReplicationMessagesResponse
 	switch t := that.(type) {
-	case *AddOrUpdateRemoteClusterResponse:
+	case *GetNamespaceReplicationMessagesResponse:
 		that1 = t
-	case AddOrUpdateRemoteClusterResponse:
+	case GetNamespaceReplicationMessagesResponse:
 		that1 = &t
 	default:
 		return false
@@ -1522,35 +1522,35 @@ func (this *AddOrUpdateRemoteClusterResponse) Equal(that interface{}) bool {
 	return proto.Equal(this, that1)
 }
 
-// Marshal an object of type RemoveRemoteClusterRequest to the protobuf v3 wire format
-func (val *RemoveRemoteClusterRequest) Marshal() ([]byte, error) {
+// Marshal an object of type GetDLQReplicationMessagesRequest to the protobuf v3 wire format
+func (val *GetDLQReplicationMessagesRequest) Marshal() ([]byte, error) {
 	return proto.Marshal(val)
 }
 
-// Unmarshal an object of type RemoveRemoteClusterRequest from the protobuf v3 wire format
-func (val *RemoveRemoteClusterRequest) Unmarshal(buf []byte) error {
+// Unmarshal an object of type GetDLQReplicationMessagesRequest from the protobuf v3 wire format
+func (val *GetDLQReplicationMessagesRequest) Unmarshal(buf []byte) error {
 	return proto.Unmarshal(buf, val)
 }
 
 // Size returns the size of the object, in bytes, once serialized
-func (val *RemoveRemoteClusterRequest) Size() int {
+func (val *GetDLQReplicationMessagesRequest) Size() int {
 	return proto.Size(val)
 }
 
-// Equal returns whether two RemoveRemoteClusterRequest values are equivalent by recursively
+// Equal returns whether two GetDLQReplicationMessagesRequest values are equivalent by recursively
 // comparing the message's fields.
 // For more information see the documentation for
 // https://pkg.go.dev/google.golang.org/protobuf/proto#Equal
-func (this *RemoveRemoteClusterRequest) Equal(that interface{}) bool {
+func (this *GetDLQReplicationMessagesRequest) Equal(that interface{}) bool {
 	if that == nil {
 		return this == nil
 	}
 
-	var that1 *RemoveRemoteClusterRequest
+	var that1 *GetDLQReplicationMessagesRequest
 	switch t := that.(type) {
-	case *RemoveRemoteClusterRequest:
+	case *GetDLQReplicationMessagesRequest:
 		that1 = t
-	case RemoveRemoteClusterRequest:
+	case GetDLQReplicationMessagesRequest:
 		that1 = &t
 	default:
 		return false
@@ -1559,35 +1559,35 @@ func (this *RemoveRemoteClusterRequest) Equal(that interface{}) bool {
 	return proto.Equal(this, that1)
 }
 
-// Marshal an object of type RemoveRemoteClusterResponse to the protobuf v3 wire format
-func (val *RemoveRemoteClusterResponse) Marshal() ([]byte, error) {
+// Marshal an object of type GetDLQReplicationMessagesResponse to the protobuf v3 wire format
+func (val *GetDLQReplicationMessagesResponse) Marshal() ([]byte, error) {
 	return proto.Marshal(val)
 }
 
-// Unmarshal an object of type RemoveRemoteClusterResponse from the protobuf v3 wire format
-func (val *RemoveRemoteClusterResponse) Unmarshal(buf []byte) error {
+// Unmarshal an object of type GetDLQReplicationMessagesResponse from the protobuf v3 wire format
+func (val *GetDLQReplicationMessagesResponse) Unmarshal(buf []byte) error {
 	return proto.Unmarshal(buf, val)
 }
 
 // Size returns the size of the object, in bytes, once serialized
-func (val *RemoveRemoteClusterResponse) Size() int {
+func (val *GetDLQReplicationMessagesResponse) Size() int {
 	return proto.Size(val)
 }
 
-// Equal returns whether two RemoveRemoteClusterResponse values are equivalent by recursively
+// Equal returns whether two GetDLQReplicationMessagesResponse values are equivalent by recursively
 // comparing the message's fields.
 // For more information see the documentation for
 // https://pkg.go.dev/google.golang.org/protobuf/proto#Equal
-func (this *RemoveRemoteClusterResponse) Equal(that interface{}) bool {
+func (this *GetDLQReplicationMessagesResponse) Equal(that interface{}) bool {
 	if that == nil {
 		return this == nil
 	}
 
-	var that1 *RemoveRemoteClusterResponse
+	var that1 *GetDLQReplicationMessagesResponse
 	switch t := that.(type) {
-	case *RemoveRemoteClusterResponse:
+	case *GetDLQReplicationMessagesResponse:
 		that1 = t
-	case RemoveRemoteClusterResponse:
+	case GetDLQReplicationMessagesResponse:
 		that1 = &t
 	default:
 		return false
@@ -1596,35 +1596,35 @@ func (this *RemoveRemoteClusterResponse) Equal(that interface{}) bool {
 	return proto.Equal(this, that1)
 }
 
-// Marshal an object of type ListClusterMembersRequest to the protobuf v3 wire format
-func (val *ListClusterMembersRequest) Marshal() ([]byte, error) {
+// Marshal an object of type ReapplyEventsRequest to the protobuf v3 wire format
+func (val *ReapplyEventsRequest) Marshal() ([]byte, error) {
 	return proto.Marshal(val)
 }
 
-// Unmarshal an object of type ListClusterMembersRequest from the protobuf v3 wire format
-func (val *ListClusterMembersRequest) Unmarshal(buf []byte) error {
+// Unmarshal an object of type ReapplyEventsRequest from the protobuf v3 wire format
+func (val *ReapplyEventsRequest) Unmarshal(buf []byte) error {
 	return proto.Unmarshal(buf, val)
 }
 
 // Size returns the size of the object, in bytes, once serialized
-func (val *ListClusterMembersRequest) Size() int {
+func (val *ReapplyEventsRequest) Size() int {
 	return proto.Size(val)
 }
 
-// Equal returns whether two ListClusterMembersRequest values are equivalent by recursively
+// Equal returns whether two ReapplyEventsRequest values are equivalent by recursively
 // comparing the message's fields.
 // For more information see the documentation for
 // https://pkg.go.dev/google.golang.org/protobuf/proto#Equal
-func (this *ListClusterMembersRequest) Equal(that interface{}) bool {
+func (this *ReapplyEventsRequest) Equal(that interface{}) bool {
 	if that == nil {
 		return this == nil
 	}
 
-	var that1 *ListClusterMembersRequest
+	var that1 *ReapplyEventsRequest
 	switch t := that.(type) {
-	case *ListClusterMembersRequest:
+	case *ReapplyEventsRequest:
 		that1 = t
-	case ListClusterMembersRequest:
+	case ReapplyEventsRequest:
 		that1 = &t
 	default:
 		return false
@@ -1633,35 +1633,35 @@ func (this *ListClusterMembersRequest) Equal(that interface{}) bool {
 	return proto.Equal(this, that1)
 }
 
-// Marshal an object of type ListClusterMembersResponse to the protobuf v3 wire format
-func (val *ListClusterMembersResponse) Marshal() ([]byte, error) {
+// Marshal an object of type ReapplyEventsResponse to the protobuf v3 wire format
+func (val *ReapplyEventsResponse) Marshal() ([]byte, error) {
 	return proto.Marshal(val)
 }
 
-// Unmarshal an object of type ListClusterMembersResponse from the protobuf v3 wire format
-func (val *ListClusterMembersResponse) Unmarshal(buf []byte) error {
+// Unmarshal an object of type ReapplyEventsResponse from the protobuf v3 wire format
+func (val *ReapplyEventsResponse) Unmarshal(buf []byte) error {
 	return proto.Unmarshal(buf, val)
 }
 
 // Size returns the size of the object, in bytes, once serialized
-func (val *ListClusterMembersResponse) Size() int {
+func (val *ReapplyEventsResponse) Size() int {
 	return proto.Size(val)
 }
 
-// Equal returns whether two ListClusterMembersResponse values are equivalent by recursively
+// Equal returns whether two ReapplyEventsResponse values are equivalent by recursively
 // comparing the message's fields.
 // For more information see the documentation for
 // https://pkg.go.dev/google.golang.org/protobuf/proto#Equal
-func (this *ListClusterMembersResponse) Equal(that interface{}) bool {
+func (this *ReapplyEventsResponse) Equal(that interface{}) bool {
 	if that == nil {
 		return this == nil
 	}
 
-	var that1 *ListClusterMembersResponse
+	var that1 *ReapplyEventsResponse
 	switch t := that.(type) {
-	case *ListClusterMembersResponse:
+	case *ReapplyEventsResponse:
 		that1 = t
-	case ListClusterMembersResponse:
+	case ReapplyEventsResponse:
 		that1 = &t
 	default:
 		return false
@@ -1670,35 +1670,35 @@ func (this *ListClusterMembersResponse) Equal(that interface{}) bool {
 	return proto.Equal(this, that1)
 }
 
-// Marshal an object of type GetDLQMessagesRequest to the protobuf v3 wire format
-func (val *GetDLQMessagesRequest) Marshal() ([]byte, error) {
+// Marshal an object of type AddSearchAttributesRequest to the protobuf v3 wire format
+func (val *AddSearchAttributesRequest) Marshal() ([]byte, error) {
 	return proto.Marshal(val)
 }
 
-// Unmarshal an object of type GetDLQMessagesRequest from the protobuf v3 wire format
-func (val *GetDLQMessagesRequest) Unmarshal(buf []byte) error {
+// Unmarshal an object of type AddSearchAttributesRequest from the protobuf v3 wire format
+func (val *AddSearchAttributesRequest) Unmarshal(buf []byte) error {
 	return proto.Unmarshal(buf, val)
 }
 
 // Size returns the size of the object, in bytes, once serialized
-func (val *GetDLQMessagesRequest) Size() int {
+func (val *AddSearchAttributesRequest) Size() int {
 	return proto.Size(val)
 }
 
-// Equal returns whether two GetDLQMessagesRequest values are equivalent by recursively
+// Equal returns whether two AddSearchAttributesRequest values are equivalent by recursively
 // comparing the message's fields.
 // For more information see the documentation for
 // https://pkg.go.dev/google.golang.org/protobuf/proto#Equal
-func (this *GetDLQMessagesRequest) Equal(that interface{}) bool {
+func (this *AddSearchAttributesRequest) Equal(that interface{}) bool {
 	if that == nil {
 		return this == nil
 	}
 
-	var that1 *GetDLQMessagesRequest
+	var that1 *AddSearchAttributesRequest
 	switch t := that.(type) {
-	case *GetDLQMessagesRequest:
+	case *AddSearchAttributesRequest:
 		that1 = t
-	case GetDLQMessagesRequest:
+	case AddSearchAttributesRequest:
 		that1 = &t
 	default:
 		return false
@@ -1707,35 +1707,35 @@ func (this *GetDLQMessagesRequest) Equal(that interface{}) bool {
 	return proto.Equal(this, that1)
 }
 
-// Marshal an object of type GetDLQMessagesResponse to the protobuf v3 wire format
-func (val *GetDLQMessagesResponse) Marshal() ([]byte, error) {
+// Marshal an object of type AddSearchAttributesResponse to the protobuf v3 wire format
+func (val *AddSearchAttributesResponse) Marshal() ([]byte, error) {
 	return proto.Marshal(val)
 }
 
-// Unmarshal an object of type GetDLQMessagesResponse from the protobuf v3 wire format
-func (val *GetDLQMessagesResponse) Unmarshal(buf []byte) error {
+// Unmarshal an object of type AddSearchAttributesResponse from the protobuf v3 wire format
+func (val *AddSearchAttributesResponse) Unmarshal(buf []byte) error {
 	return proto.Unmarshal(buf, val)
 }
 
 // Size returns the size of the object, in bytes, once serialized
-func (val *GetDLQMessagesResponse) Size() int {
+func (val *AddSearchAttributesResponse) Size() int {
 	return proto.Size(val)
 }
 
-// Equal returns whether two GetDLQMessagesResponse values are equivalent by recursively
+// Equal returns whether two AddSearchAttributesResponse values are equivalent by recursively
 // comparing the message's fields.
 // For more information see the documentation for
 // https://pkg.go.dev/google.golang.org/protobuf/proto#Equal
-func (this *GetDLQMessagesResponse) Equal(that interface{}) bool {
+func (this *AddSearchAttributesResponse) Equal(that interface{}) bool {
 	if that == nil {
 		return this == nil
 	}
 
-	var that1 *GetDLQMessagesResponse
+	var that1 *AddSearchAttributesResponse
 	switch t := that.(type) {
-	case *GetDLQMessagesResponse:
+	case *AddSearchAttributesResponse:
 		that1 = t
-	case GetDLQMessagesResponse:
+	case AddSearchAttributesResponse:
 		that1 = &t
 	default:
 		return false
@@ -1744,35 +1744,35 @@ func (this *GetDLQMessagesResponse) Equal(that interface{}) bool {
 	return proto.Equal(this, that1)
 }
 
-// Marshal an object of type PurgeDLQMessagesRequest to the protobuf v3 wire format
-func (val *PurgeDLQMessagesRequest) Marshal() ([]byte, error) {
+// Marshal an object of type RemoveSearchAttributesRequest to the protobuf v3 wire format
+func (val *RemoveSearchAttributesRequest) Marshal() ([]byte, error) {
 	return proto.Marshal(val)
 }
 
-// Unmarshal an object of type PurgeDLQMessagesRequest from the protobuf v3 wire format
-func (val *PurgeDLQMessagesRequest) Unmarshal(buf []byte) error {
+// Unmarshal an object of type RemoveSearchAttributesRequest from the protobuf v3 wire format
+func (val *RemoveSearchAttributesRequest) Unmarshal(buf []byte) error {
 	return proto.Unmarshal(buf, val)
 }
 
 // Size returns the size of the object, in bytes, once serialized
-func (val *PurgeDLQMessagesRequest) Size() int {
+func (val *RemoveSearchAttributesRequest) Size() int {
 	return proto.Size(val)
 }
 
-// Equal returns whether two PurgeDLQMessagesRequest values are equivalent by recursively
+// Equal returns whether two RemoveSearchAttributesRequest values are equivalent by recursively
 // comparing the message's fields.
 // For more information see the documentation for
 // https://pkg.go.dev/google.golang.org/protobuf/proto#Equal
-func (this *PurgeDLQMessagesRequest) Equal(that interface{}) bool {
+func (this *RemoveSearchAttributesRequest) Equal(that interface{}) bool {
 	if that == nil {
 		return this == nil
 	}
 
-	var that1 *PurgeDLQMessagesRequest
+	var that1 *RemoveSearchAttributesRequest
 	switch t := that.(type) {
-	case *PurgeDLQMessagesRequest:
+	case *RemoveSearchAttributesRequest:
 		that1 = t
-	case PurgeDLQMessagesRequest:
+	case RemoveSearchAttributesRequest:
 		that1 = &t
 	default:
 		return false
@@ -1781,13 +1781,1012 @@ func (this *PurgeDLQMessagesRequest) Equal(that interface{}) bool {
 	return proto.Equal(this, that1)
 }
 
-// Marshal an object of type PurgeDLQMessagesResponse to the protobuf v3 wire format
-func (val *PurgeDLQMessagesResponse) Marshal() ([]byte, error) {
+// Marshal an object of type RemoveSearchAttributesResponse to the protobuf v3 wire format
+func (val *RemoveSearchAttributesResponse) Marshal() ([]byte, error) {
 	return proto.Marshal(val)
 }
 
-// Unmarshal an object of type PurgeDLQMessagesResponse from the protobuf v3 wire format
-func (val *PurgeDLQMessagesResponse) Unmarshal(buf []byte) error {
+// Unmarshal an object of type RemoveSearchAttributesResponse from the protobuf v3 wire format
+func (val *RemoveSearchAttributesResponse) Unmarshal(buf []byte) error {
+	return proto.Unmarshal(buf, val)
+}
+
+// Size returns the size of the object, in bytes, once serialized
+func (val *RemoveSearchAttributesResponse) Size() int {
+	return proto.Size(val)
+}
+
+// Equal returns whether two RemoveSearchAttributesResponse values are equivalent by recursively
+// comparing the message's fields.
+// For more information see the documentation for
+// https://pkg.go.dev/google.golang.org/protobuf/proto#Equal
+func (this *RemoveSearchAttributesResponse) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	var that1 *RemoveSearchAttributesResponse
+	switch t := that.(type) {
+	case *RemoveSearchAttributesResponse:
+		that1 = t
+	case RemoveSearchAttributesResponse:
+		that1 = &t
+	default:
+		return false
+	}
+
+	return proto.Equal(this, that1)
+}
+
+// Marshal an object of type GetSearchAttributesRequest to the protobuf v3 wire format
+func (val *GetSearchAttributesRequest) Marshal() ([]byte, error) {
+	return proto.Marshal(val)
+}
+
+// Unmarshal an object of type GetSearchAttributesRequest from the protobuf v3 wire format
+func (val *GetSearchAttributesRequest) Unmarshal(buf []byte) error {
+	return proto.Unmarshal(buf, val)
+}
+
+// Size returns the size of the object, in bytes, once serialized
+func (val *GetSearchAttributesRequest) Size() int {
+	return proto.Size(val)
+}
+
+// Equal returns whether two GetSearchAttributesRequest values are equivalent by recursively
+// comparing the message's fields.
+// For more information see the documentation for
+// https://pkg.go.dev/google.golang.org/protobuf/proto#Equal
+func (this *GetSearchAttributesRequest) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	var that1 *GetSearchAttributesRequest
+	switch t := that.(type) {
+	case *GetSearchAttributesRequest:
+		that1 = t
+	case GetSearchAttributesRequest:
+		that1 = &t
+	default:
+		return false
+	}
+
+	return proto.Equal(this, that1)
+}
+
+// Marshal an object of type GetSearchAttributesResponse to the protobuf v3 wire format
+func (val *GetSearchAttributesResponse) Marshal() ([]byte, error) {
+	return proto.Marshal(val)
+}
+
+// Unmarshal an object of type GetSearchAttributesResponse from the protobuf v3 wire format
+func (val *GetSearchAttributesResponse) Unmarshal(buf []byte) error {
+	return proto.Unmarshal(buf, val)
+}
+
+// Size returns the size of the object, in bytes, once serialized
+func (val *GetSearchAttributesResponse) Size() int {
+	return proto.Size(val)
+}
+
+// Equal returns whether two GetSearchAttributesResponse values are equivalent by recursively
+// comparing the message's fields.
+// For more information see the documentation for
+// https://pkg.go.dev/google.golang.org/protobuf/proto#Equal
+func (this *GetSearchAttributesResponse) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	var that1 *GetSearchAttributesResponse
+	switch t := that.(type) {
+	case *GetSearchAttributesResponse:
+		that1 = t
+	case GetSearchAttributesResponse:
+		that1 = &t
+	default:
+		return false
+	}
+
+	return proto.Equal(this, that1)
+}
+
+// Marshal an object of type AggregateWorkflowExecutionsRequest to the protobuf v3 wire format
+func (val *AggregateWorkflowExecutionsRequest) Marshal() ([]byte, error) {
+	return proto.Marshal(val)
+}
+
+// Unmarshal an object of type AggregateWorkflowExecutionsRequest from the protobuf v3 wire format
+func (val *AggregateWorkflowExecutionsRequest) Unmarshal(buf []byte) error {
+	return proto.Unmarshal(buf, val)
+}
+
+// Size returns the size of the object, in bytes, once serialized
+func (val *AggregateWorkflowExecutionsRequest) Size() int {
+	return proto.Size(val)
+}
+
+// Equal returns whether two AggregateWorkflowExecutionsRequest values are equivalent by recursively
+// comparing the message's fields.
+// For more information see the documentation for
+// https://pkg.go.dev/google.golang.org/protobuf/proto#Equal
+func (this *AggregateWorkflowExecutionsRequest) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	var that1 *AggregateWorkflowExecutionsRequest
+	switch t := that.(type) {
+	case *AggregateWorkflowExecutionsRequest:
+		that1 = t
+	case AggregateWorkflowExecutionsRequest:
+		that1 = &t
+	default:
+		return false
+	}
+
+	return proto.Equal(this, that1)
+}
+
+// Marshal an object of type AggregateWorkflowExecutionsResponse to the protobuf v3 wire format
+func (val *AggregateWorkflowExecutionsResponse) Marshal() ([]byte, error) {
+	return proto.Marshal(val)
+}
+
+// Unmarshal an object of type AggregateWorkflowExecutionsResponse from the protobuf v3 wire format
+func (val *AggregateWorkflowExecutionsResponse) Unmarshal(buf []byte) error {
+	return proto.Unmarshal(buf, val)
+}
+
+// Size returns the size of the object, in bytes, once serialized
+func (val *AggregateWorkflowExecutionsResponse) Size() int {
+	return proto.Size(val)
+}
+
+// Equal returns whether two AggregateWorkflowExecutionsResponse values are equivalent by recursively
+// comparing the message's fields.
+// For more information see the documentation for
+// https://pkg.go.dev/google.golang.org/protobuf/proto#Equal
+func (this *AggregateWorkflowExecutionsResponse) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	var that1 *AggregateWorkflowExecutionsResponse
+	switch t := that.(type) {
+	case *AggregateWorkflowExecutionsResponse:
+		that1 = t
+	case AggregateWorkflowExecutionsResponse:
+		that1 = &t
+	default:
+		return false
+	}
+
+	return proto.Equal(this, that1)
+}
+
+// Marshal an object of type VisibilityAggregation to the protobuf v3 wire format
+func (val *VisibilityAggregation) Marshal() ([]byte, error) {
+	return proto.Marshal(val)
+}
+
+// Unmarshal an object of type VisibilityAggregation from the protobuf v3 wire format
+func (val *VisibilityAggregation) Unmarshal(buf []byte) error {
+	return proto.Unmarshal(buf, val)
+}
+
+// Size returns the size of the object, in bytes, once serialized
+func (val *VisibilityAggregation) Size() int {
+	return proto.Size(val)
+}
+
+// Equal returns whether two VisibilityAggregation values are equivalent by recursively
+// comparing the message's fields.
+// For more information see the documentation for
+// https://pkg.go.dev/google.golang.org/protobuf/proto#Equal
+func (this *VisibilityAggregation) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	var that1 *VisibilityAggregation
+	switch t := that.(type) {
+	case *VisibilityAggregation:
+		that1 = t
+	case VisibilityAggregation:
+		that1 = &t
+	default:
+		return false
+	}
+
+	return proto.Equal(this, that1)
+}
+
+// Marshal an object of type VisibilityAggregationGroup to the protobuf v3 wire format
+func (val *VisibilityAggregationGroup) Marshal() ([]byte, error) {
+	return proto.Marshal(val)
+}
+
+// Unmarshal an object of type VisibilityAggregationGroup from the protobuf v3 wire format
+func (val *VisibilityAggregationGroup) Unmarshal(buf []byte) error {
+	return proto.Unmarshal(buf, val)
+}
+
+// Size returns the size of the object, in bytes, once serialized
+func (val *VisibilityAggregationGroup) Size() int {
+	return proto.Size(val)
+}
+
+// Equal returns whether two VisibilityAggregationGroup values are equivalent by recursively
+// comparing the message's fields.
+// For more information see the documentation for
+// https://pkg.go.dev/google.golang.org/protobuf/proto#Equal
+func (this *VisibilityAggregationGroup) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	var that1 *VisibilityAggregationGroup
+	switch t := that.(type) {
+	case *VisibilityAggregationGroup:
+		that1 = t
+	case VisibilityAggregationGroup:
+		that1 = &t
+	default:
+		return false
+	}
+
+	return proto.Equal(this, that1)
+}
+
+// Marshal an object of type VisibilityAggregationResult to the protobuf v3 wire format
+func (val *VisibilityAggregationResult) Marshal() ([]byte, error) {
+	return proto.Marshal(val)
+}
+
+// Unmarshal an object of type VisibilityAggregationResult from the protobuf v3 wire format
+func (val *VisibilityAggregationResult) Unmarshal(buf []byte) error {
+	return proto.Unmarshal(buf, val)
+}
+
+// Size returns the size of the object, in bytes, once serialized
+func (val *VisibilityAggregationResult) Size() int {
+	return proto.Size(val)
+}
+
+// Equal returns whether two VisibilityAggregationResult values are equivalent by recursively
+// comparing the message's fields.
+// For more information see the documentation for
+// https://pkg.go.dev/google.golang.org/protobuf/proto#Equal
+func (this *VisibilityAggregationResult) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	var that1 *VisibilityAggregationResult
+	switch t := that.(type) {
+	case *VisibilityAggregationResult:
+		that1 = t
+	case VisibilityAggregationResult:
+		that1 = &t
+	default:
+		return false
+	}
+
+	return proto.Equal(this, that1)
+}
+
+// Marshal an object of type UpsertSavedVisibilityQueryRequest to the protobuf v3 wire format
+func (val *UpsertSavedVisibilityQueryRequest) Marshal() ([]byte, error) {
+	return proto.Marshal(val)
+}
+
+// Unmarshal an object of type UpsertSavedVisibilityQueryRequest from the protobuf v3 wire format
+func (val *UpsertSavedVisibilityQueryRequest) Unmarshal(buf []byte) error {
+	return proto.Unmarshal(buf, val)
+}
+
+// Size returns the size of the object, in bytes, once serialized
+func (val *UpsertSavedVisibilityQueryRequest) Size() int {
+	return proto.Size(val)
+}
+
+// Equal returns whether two UpsertSavedVisibilityQueryRequest values are equivalent by recursively
+// comparing the message's fields.
+// For more information see the documentation for
+// https://pkg.go.dev/google.golang.org/protobuf/proto#Equal
+func (this *UpsertSavedVisibilityQueryRequest) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	var that1 *UpsertSavedVisibilityQueryRequest
+	switch t := that.(type) {
+	case *UpsertSavedVisibilityQueryRequest:
+		that1 = t
+	case UpsertSavedVisibilityQueryRequest:
+		that1 = &t
+	default:
+		return false
+	}
+
+	return proto.Equal(this, that1)
+}
+
+// Marshal an object of type UpsertSavedVisibilityQueryResponse to the protobuf v3 wire format
+func (val *UpsertSavedVisibilityQueryResponse) Marshal() ([]byte, error) {
+	return proto.Marshal(val)
+}
+
+// Unmarshal an object of type UpsertSavedVisibilityQueryResponse from the protobuf v3 wire format
+func (val *UpsertSavedVisibilityQueryResponse) Unmarshal(buf []byte) error {
+	return proto.Unmarshal(buf, val)
+}
+
+// Size returns the size of the object, in bytes, once serialized
+func (val *UpsertSavedVisibilityQueryResponse) Size() int {
+	return proto.Size(val)
+}
+
+// Equal returns whether two UpsertSavedVisibilityQueryResponse values are equivalent by recursively
+// comparing the message's fields.
+// For more information see the documentation for
+// https://pkg.go.dev/google.golang.org/protobuf/proto#Equal
+func (this *UpsertSavedVisibilityQueryResponse) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	var that1 *UpsertSavedVisibilityQueryResponse
+	switch t := that.(type) {
+	case *UpsertSavedVisibilityQueryResponse:
+		that1 = t
+	case UpsertSavedVisibilityQueryResponse:
+		that1 = &t
+	default:
+		return false
+	}
+
+	return proto.Equal(this, that1)
+}
+
+// Marshal an object of type DeleteSavedVisibilityQueryRequest to the protobuf v3 wire format
+func (val *DeleteSavedVisibilityQueryRequest) Marshal() ([]byte, error) {
+	return proto.Marshal(val)
+}
+
+// Unmarshal an object of type DeleteSavedVisibilityQueryRequest from the protobuf v3 wire format
+func (val *DeleteSavedVisibilityQueryRequest) Unmarshal(buf []byte) error {
+	return proto.Unmarshal(buf, val)
+}
+
+// Size returns the size of the object, in bytes, once serialized
+func (val *DeleteSavedVisibilityQueryRequest) Size() int {
+	return proto.Size(val)
+}
+
+// Equal returns whether two DeleteSavedVisibilityQueryRequest values are equivalent by recursively
+// comparing the message's fields.
+// For more information see the documentation for
+// https://pkg.go.dev/google.golang.org/protobuf/proto#Equal
+func (this *DeleteSavedVisibilityQueryRequest) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	var that1 *DeleteSavedVisibilityQueryRequest
+	switch t := that.(type) {
+	case *DeleteSavedVisibilityQueryRequest:
+		that1 = t
+	case DeleteSavedVisibilityQueryRequest:
+		that1 = &t
+	default:
+		return false
+	}
+
+	return proto.Equal(this, that1)
+}
+
+// Marshal an object of type DeleteSavedVisibilityQueryResponse to the protobuf v3 wire format
+func (val *DeleteSavedVisibilityQueryResponse) Marshal() ([]byte, error) {
+	return proto.Marshal(val)
+}
+
+// Unmarshal an object of type DeleteSavedVisibilityQueryResponse from the protobuf v3 wire format
+func (val *DeleteSavedVisibilityQueryResponse) Unmarshal(buf []byte) error {
+	return proto.Unmarshal(buf, val)
+}
+
+// Size returns the size of the object, in bytes, once serialized
+func (val *DeleteSavedVisibilityQueryResponse) Size() int {
+	return proto.Size(val)
+}
+
+// Equal returns whether two DeleteSavedVisibilityQueryResponse values are equivalent by recursively
+// comparing the message's fields.
+// For more information see the documentation for
+// https://pkg.go.dev/google.golang.org/protobuf/proto#Equal
+func (this *DeleteSavedVisibilityQueryResponse) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	var that1 *DeleteSavedVisibilityQueryResponse
+	switch t := that.(type) {
+	case *DeleteSavedVisibilityQueryResponse:
+		that1 = t
+	case DeleteSavedVisibilityQueryResponse:
+		that1 = &t
+	default:
+		return false
+	}
+
+	return proto.Equal(this, that1)
+}
+
+// Marshal an object of type ListSavedVisibilityQueriesRequest to the protobuf v3 wire format
+func (val *ListSavedVisibilityQueriesRequest) Marshal() ([]byte, error) {
+	return proto.Marshal(val)
+}
+
+// Unmarshal an object of type ListSavedVisibilityQueriesRequest from the protobuf v3 wire format
+func (val *ListSavedVisibilityQueriesRequest) Unmarshal(buf []byte) error {
+	return proto.Unmarshal(buf, val)
+}
+
+// Size returns the size of the object, in bytes, once serialized
+func (val *ListSavedVisibilityQueriesRequest) Size() int {
+	return proto.Size(val)
+}
+
+// Equal returns whether two ListSavedVisibilityQueriesRequest values are equivalent by recursively
+// comparing the message's fields.
+// For more information see the documentation for
+// https://pkg.go.dev/google.golang.org/protobuf/proto#Equal
+func (this *ListSavedVisibilityQueriesRequest) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	var that1 *ListSavedVisibilityQueriesRequest
+	switch t := that.(type) {
+	case *ListSavedVisibilityQueriesRequest:
+		that1 = t
+	case ListSavedVisibilityQueriesRequest:
+		that1 = &t
+	default:
+		return false
+	}
+
+	return proto.Equal(this, that1)
+}
+
+// Marshal an object of type ListSavedVisibilityQueriesResponse to the protobuf v3 wire format
+func (val *ListSavedVisibilityQueriesResponse) Marshal() ([]byte, error) {
+	return proto.Marshal(val)
+}
+
+// Unmarshal an object of type ListSavedVisibilityQueriesResponse from the protobuf v3 wire format
+func (val *ListSavedVisibilityQueriesResponse) Unmarshal(buf []byte) error {
+	return proto.Unmarshal(buf, val)
+}
+
+// Size returns the size of the object, in bytes, once serialized
+func (val *ListSavedVisibilityQueriesResponse) Size() int {
+	return proto.Size(val)
+}
+
+// Equal returns whether two ListSavedVisibilityQueriesResponse values are equivalent by recursively
+// comparing the message's fields.
+// For more information see the documentation for
+// https://pkg.go.dev/google.golang.org/protobuf/proto#Equal
+func (this *ListSavedVisibilityQueriesResponse) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	var that1 *ListSavedVisibilityQueriesResponse
+	switch t := that.(type) {
+	case *ListSavedVisibilityQueriesResponse:
+		that1 = t
+	case ListSavedVisibilityQueriesResponse:
+		that1 = &t
+	default:
+		return false
+	}
+
+	return proto.Equal(this, that1)
+}
+
+// Marshal an object of type DescribeClusterRequest to the protobuf v3 wire format
+func (val *DescribeClusterRequest) Marshal() ([]byte, error) {
+	return proto.Marshal(val)
+}
+
+// Unmarshal an object of type DescribeClusterRequest from the protobuf v3 wire format
+func (val *DescribeClusterRequest) Unmarshal(buf []byte) error {
+	return proto.Unmarshal(buf, val)
+}
+
+// Size returns the size of the object, in bytes, once serialized
+func (val *DescribeClusterRequest) Size() int {
+	return proto.Size(val)
+}
+
+// Equal returns whether two DescribeClusterRequest values are equivalent by recursively
+// comparing the message's fields.
+// For more information see the documentation for
+// https://pkg.go.dev/google.golang.org/protobuf/proto#Equal
+func (this *DescribeClusterRequest) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	var that1 *DescribeClusterRequest
+	switch t := that.(type) {
+	case *DescribeClusterRequest:
+		that1 = t
+	case DescribeClusterRequest:
+		that1 = &t
+	default:
+		return false
+	}
+
+	return proto.Equal(this, that1)
+}
+
+// Marshal an object of type DescribeClusterResponse to the protobuf v3 wire format
+func (val *DescribeClusterResponse) Marshal() ([]byte, error) {
+	return proto.Marshal(val)
+}
+
+// Unmarshal an object of type DescribeClusterResponse from the protobuf v3 wire format
+func (val *DescribeClusterResponse) Unmarshal(buf []byte) error {
+	return proto.Unmarshal(buf, val)
+}
+
+// Size returns the size of the object, in bytes, once serialized
+func (val *DescribeClusterResponse) Size() int {
+	return proto.Size(val)
+}
+
+// Equal returns whether two DescribeClusterResponse values are equivalent by recursively
+// comparing the message's fields.
+// For more information see the documentation for
+// https://pkg.go.dev/google.golang.org/protobuf/proto#Equal
+func (this *DescribeClusterResponse) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	var that1 *DescribeClusterResponse
+	switch t := that.(type) {
+	case *DescribeClusterResponse:
+		that1 = t
+	case DescribeClusterResponse:
+		that1 = &t
+	default:
+		return false
+	}
+
+	return proto.Equal(this, that1)
+}
+
+// Marshal an object of type ListClustersRequest to the protobuf v3 wire format
+func (val *ListClustersRequest) Marshal() ([]byte, error) {
+	return proto.Marshal(val)
+}
+
+// Unmarshal an object of type ListClustersRequest from the protobuf v3 wire format
+func (val *ListClustersRequest) Unmarshal(buf []byte) error {
+	return proto.Unmarshal(buf, val)
+}
+
+// Size returns the size of the object, in bytes, once serialized
+func (val *ListClustersRequest) Size() int {
+	return proto.Size(val)
+}
+
+// Equal returns whether two ListClustersRequest values are equivalent by recursively
+// comparing the message's fields.
+// For more information see the documentation for
+// https://pkg.go.dev/google.golang.org/protobuf/proto#Equal
+func (this *ListClustersRequest) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	var that1 *ListClustersRequest
+	switch t := that.(type) {
+	case *ListClustersRequest:
+		that1 = t
+	case ListClustersRequest:
+		that1 = &t
+	default:
+		return false
+	}
+
+	return proto.Equal(this, that1)
+}
+
+// Marshal an object of type ListClustersResponse to the protobuf v3 wire format
+func (val *ListClustersResponse) Marshal() ([]byte, error) {
+	return proto.Marshal(val)
+}
+
+// Unmarshal an object of type ListClustersResponse from the protobuf v3 wire format
+func (val *ListClustersResponse) Unmarshal(buf []byte) error {
+	return proto.Unmarshal(buf, val)
+}
+
+// Size returns the size of the object, in bytes, once serialized
+func (val *ListClustersResponse) Size() int {
+	return proto.Size(val)
+}
+
+// Equal returns whether two ListClustersResponse values are equivalent by recursively
+// comparing the message's fields.
+// For more information see the documentation for
+// https://pkg.go.dev/google.golang.org/protobuf/proto#Equal
+func (this *ListClustersResponse) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	var that1 *ListClustersResponse
+	switch t := that.(type) {
+	case *ListClustersResponse:
+		that1 = t
+	case ListClustersResponse:
+		that1 = &t
+	default:
+		return false
+	}
+
+	return proto.Equal(this, that1)
+}
+
+// Marshal an object of type AddOrUpdateRemoteClusterRequest to the protobuf v3 wire format
+func (val *AddOrUpdateRemoteClusterRequest) Marshal() ([]byte, error) {
+	return proto.Marshal(val)
+}
+
+// Unmarshal an object of type AddOrUpdateRemoteClusterRequest from the protobuf v3 wire format
+func (val *AddOrUpdateRemoteClusterRequest) Unmarshal(buf []byte) error {
+	return proto.Unmarshal(buf, val)
+}
+
+// Size returns the size of the object, in bytes, once serialized
+func (val *AddOrUpdateRemoteClusterRequest) Size() int {
+	return proto.Size(val)
+}
+
+// Equal returns whether two AddOrUpdateRemoteClusterRequest values are equivalent by recursively
+// comparing the message's fields.
+// For more information see the documentation for
+// https://pkg.go.dev/google.golang.org/protobuf/proto#Equal
+func (this *AddOrUpdateRemoteClusterRequest) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	var that1 *AddOrUpdateRemoteClusterRequest
+	switch t := that.(type) {
+	case *AddOrUpdateRemoteClusterRequest:
+		that1 = t
+	case AddOrUpdateRemoteClusterRequest:
+		that1 = &t
+	default:
+		return false
+	}
+
+	return proto.Equal(this, that1)
+}
+
+// Marshal an object of type AddOrUpdateRemoteClusterResponse to the protobuf v3 wire format
+func (val *AddOrUpdateRemoteClusterResponse) Marshal() ([]byte, error) {
+	return proto.Marshal(val)
+}
+
+// Unmarshal an object of type AddOrUpdateRemoteClusterResponse from the protobuf v3 wire format
+func (val *AddOrUpdateRemoteClusterResponse) Unmarshal(buf []byte) error {
+	return proto.Unmarshal(buf, val)
+}
+
+// Size returns the size of the object, in bytes, once serialized
+func (val *AddOrUpdateRemoteClusterResponse) Size() int {
+	return proto.Size(val)
+}
+
+// Equal returns whether two AddOrUpdateRemoteClusterResponse values are equivalent by recursively
+// comparing the message's fields.
+// For more information see the documentation for
+// https://pkg.go.dev/google.golang.org/protobuf/proto#Equal
+func (this *AddOrUpdateRemoteClusterResponse) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	var that1 *AddOrUpdateRemoteClusterResponse
+	switch t := that.(type) {
+	case *AddOrUpdateRemoteClusterResponse:
+		that1 = t
+	case AddOrUpdateRemoteClusterResponse:
+		that1 = &t
+	default:
+		return false
+	}
+
+	return proto.Equal(this, that1)
+}
+
+// Marshal an object of type RemoveRemoteClusterRequest to the protobuf v3 wire format
+func (val *RemoveRemoteClusterRequest) Marshal() ([]byte, error) {
+	return proto.Marshal(val)
+}
+
+// Unmarshal an object of type RemoveRemoteClusterRequest from the protobuf v3 wire format
+func (val *RemoveRemoteClusterRequest) Unmarshal(buf []byte) error {
+	return proto.Unmarshal(buf, val)
+}
+
+// Size returns the size of the object, in bytes, once serialized
+func (val *RemoveRemoteClusterRequest) Size() int {
+	return proto.Size(val)
+}
+
+// Equal returns whether two RemoveRemoteClusterRequest values are equivalent by recursively
+// comparing the message's fields.
+// For more information see the documentation for
+// https://pkg.go.dev/google.golang.org/protobuf/proto#Equal
+func (this *RemoveRemoteClusterRequest) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	var that1 *RemoveRemoteClusterRequest
+	switch t := that.(type) {
+	case *RemoveRemoteClusterRequest:
+		that1 = t
+	case RemoveRemoteClusterRequest:
+		that1 = &t
+	default:
+		return false
+	}
+
+	return proto.Equal(this, that1)
+}
+
+// Marshal an object of type RemoveRemoteClusterResponse to the protobuf v3 wire format
+func (val *RemoveRemoteClusterResponse) Marshal() ([]byte, error) {
+	return proto.Marshal(val)
+}
+
+// Unmarshal an object of type RemoveRemoteClusterResponse from the protobuf v3 wire format
+func (val *RemoveRemoteClusterResponse) Unmarshal(buf []byte) error {
+	return proto.Unmarshal(buf, val)
+}
+
+// Size returns the size of the object, in bytes, once serialized
+func (val *RemoveRemoteClusterResponse) Size() int {
+	return proto.Size(val)
+}
+
+// Equal returns whether two RemoveRemoteClusterResponse values are equivalent by recursively
+// comparing the message's fields.
+// For more information see the documentation for
+// https://pkg.go.dev/google.golang.org/protobuf/proto#Equal
+func (this *RemoveRemoteClusterResponse) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	var that1 *RemoveRemoteClusterResponse
+	switch t := that.(type) {
+	case *RemoveRemoteClusterResponse:
+		that1 = t
+	case RemoveRemoteClusterResponse:
+		that1 = &t
+	default:
+		return false
+	}
+
+	return proto.Equal(this, that1)
+}
+
+// Marshal an object of type ListClusterMembersRequest to the protobuf v3 wire format
+func (val *ListClusterMembersRequest) Marshal() ([]byte, error) {
+	return proto.Marshal(val)
+}
+
+// Unmarshal an object of type ListClusterMembersRequest from the protobuf v3 wire format
+func (val *ListClusterMembersRequest) Unmarshal(buf []byte) error {
+	return proto.Unmarshal(buf, val)
+}
+
+// Size returns the size of the object, in bytes, once serialized
+func (val *ListClusterMembersRequest) Size() int {
+	return proto.Size(val)
+}
+
+// Equal returns whether two ListClusterMembersRequest values are equivalent by recursively
+// comparing the message's fields.
+// For more information see the documentation for
+// https://pkg.go.dev/google.golang.org/protobuf/proto#Equal
+func (this *ListClusterMembersRequest) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	var that1 *ListClusterMembersRequest
+	switch t := that.(type) {
+	case *ListClusterMembersRequest:
+		that1 = t
+	case ListClusterMembersRequest:
+		that1 = &t
+	default:
+		return false
+	}
+
+	return proto.Equal(this, that1)
+}
+
+// Marshal an object of type ListClusterMembersResponse to the protobuf v3 wire format
+func (val *ListClusterMembersResponse) Marshal() ([]byte, error) {
+	return proto.Marshal(val)
+}
+
+// Unmarshal an object of type ListClusterMembersResponse from the protobuf v3 wire format
+func (val *ListClusterMembersResponse) Unmarshal(buf []byte) error {
+	return proto.Unmarshal(buf, val)
+}
+
+// Size returns the size of the object, in bytes, once serialized
+func (val *ListClusterMembersResponse) Size() int {
+	return proto.Size(val)
+}
+
+// Equal returns whether two ListClusterMembersResponse values are equivalent by recursively
+// comparing the message's fields.
+// For more information see the documentation for
+// https://pkg.go.dev/google.golang.org/protobuf/proto#Equal
+func (this *ListClusterMembersResponse) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	var that1 *ListClusterMembersResponse
+	switch t := that.(type) {
+	case *ListClusterMembersResponse:
+		that1 = t
+	case ListClusterMembersResponse:
+		that1 = &t
+	default:
+		return false
+	}
+
+	return proto.Equal(this, that1)
+}
+
+// Marshal an object of type GetDLQMessagesRequest to the protobuf v3 wire format
+func (val *GetDLQMessagesRequest) Marshal() ([]byte, error) {
+	return proto.Marshal(val)
+}
+
+// Unmarshal an object of type GetDLQMessagesRequest from the protobuf v3 wire format
+func (val *GetDLQMessagesRequest) Unmarshal(buf []byte) error {
+	return proto.Unmarshal(buf, val)
+}
+
+// Size returns the size of the object, in bytes, once serialized
+func (val *GetDLQMessagesRequest) Size() int {
+	return proto.Size(val)
+}
+
+// Equal returns whether two GetDLQMessagesRequest values are equivalent by recursively
+// comparing the message's fields.
+// For more information see the documentation for
+// https://pkg.go.dev/google.golang.org/protobuf/proto#Equal
+func (this *GetDLQMessagesRequest) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	var that1 *GetDLQMessagesRequest
+	switch t := that.(type) {
+	case *GetDLQMessagesRequest:
+		that1 = t
+	case GetDLQMessagesRequest:
+		that1 = &t
+	default:
+		return false
+	}
+
+	return proto.Equal(this, that1)
+}
+
+// Marshal an object of type GetDLQMessagesResponse to the protobuf v3 wire format
+func (val *GetDLQMessagesResponse) Marshal() ([]byte, error) {
+	return proto.Marshal(val)
+}
+
+// Unmarshal an object of type GetDLQMessagesResponse from the protobuf v3 wire format
+func (val *GetDLQMessagesResponse) Unmarshal(buf []byte) error {
+	return proto.Unmarshal(buf, val)
+}
+
+// Size returns the size of the object, in bytes, once serialized
+func (val *GetDLQMessagesResponse) Size() int {
+	return proto.Size(val)
+}
+
+// Equal returns whether two GetDLQMessagesResponse values are equivalent by recursively
+// comparing the message's fields.
+// For more information see the documentation for
+// https://pkg.go.dev/google.golang.org/protobuf/proto#Equal
+func (this *GetDLQMessagesResponse) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	var that1 *GetDLQMessagesResponse
+	switch t := that.(type) {
+	case *GetDLQMessagesResponse:
+		that1 = t
+	case GetDLQMessagesResponse:
+		that1 = &t
+	default:
+		return false
+	}
+
+	return proto.Equal(this, that1)
+}
+
+// Marshal an object of type PurgeDLQMessagesRequest to the protobuf v3 wire format
+func (val *PurgeDLQMessagesRequest) Marshal() ([]byte, error) {
+	return proto.Marshal(val)
+}
+
+// Unmarshal an object of type PurgeDLQMessagesRequest from the protobuf v3 wire format
+func (val *PurgeDLQMessagesRequest) Unmarshal(buf []byte) error {
+	return proto.Unmarshal(buf, val)
+}
+
+// Size returns the size of the object, in bytes, once serialized
+func (val *PurgeDLQMessagesRequest) Size() int {
+	return proto.Size(val)
+}
+
+// Equal returns whether two PurgeDLQMessagesRequest values are equivalent by recursively
+// comparing the message's fields.
+// For more information see the documentation for
+// https://pkg.go.dev/google.golang.org/protobuf/proto#Equal
+func (this *PurgeDLQMessagesRequest) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	var that1 *PurgeDLQMessagesRequest
+	switch t := that.(type) {
+	case *PurgeDLQMessagesRequest:
+		that1 = t
+	case PurgeDLQMessagesRequest:
+		that1 = &t
+	default:
+		return false
+	}
+
+	return proto.Equal(this, that1)
+}
+
+// Marshal an object of type PurgeDLQMessagesResponse to the protobuf v3 wire format
+func (val *PurgeDLQMessagesResponse) Marshal() ([]byte, error) {
+	return proto.Marshal(val)
+}
+
+// Unmarshal an object of type PurgeDLQMessagesResponse from the protobuf v3 wire format
+func (val *PurgeDLQMessagesResponse) Unmarshal(buf []byte) error {
 	return proto.Unmarshal(buf, val)
 }
 
@@ -1796,20 +2795,353 @@ func (val *PurgeDLQMessagesResponse) Size() int {
 	return proto.Size(val)
 }
 
-// Equal returns whether two PurgeDLQMessagesResponse values are equivalent by recursively
+// Equal returns whether two PurgeDLQMessagesResponse values are equivalent by recursively
+// comparing the message's fields.
+// For more information see the documentation for
+// https://pkg.go.dev/google.golang.org/protobuf/proto#Equal
+func (this *PurgeDLQMessagesResponse) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	var that1 *PurgeDLQMessagesResponse
+	switch t := that.(type) {
+	case *PurgeDLQMessagesResponse:
+		that1 = t
+	case PurgeDLQMessagesResponse:
+		that1 = &t
+	default:
+		return false
+	}
+
+	return proto.Equal(this, that1)
+}
+
+// Marshal an object of type MergeDLQMessagesRequest to the protobuf v3 wire format
+func (val *MergeDLQMessagesRequest) Marshal() ([]byte, error) {
+	return proto.Marshal(val)
+}
+
+// Unmarshal an object of type MergeDLQMessagesRequest from the protobuf v3 wire format
+func (val *MergeDLQMessagesRequest) Unmarshal(buf []byte) error {
+	return proto.Unmarshal(buf, val)
+}
+
+// Size returns the size of the object, in bytes, once serialized
+func (val *MergeDLQMessagesRequest) Size() int {
+	return proto.Size(val)
+}
+
+// Equal returns whether two MergeDLQMessagesRequest values are equivalent by recursively
+// comparing the message's fields.
+// For more information see the documentation for
+// https://pkg.go.dev/google.golang.org/protobuf/proto#Equal
+func (this *MergeDLQMessagesRequest) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	var that1 *MergeDLQMessagesRequest
+	switch t := that.(type) {
+	case *MergeDLQMessagesRequest:
+		that1 = t
+	case MergeDLQMessagesRequest:
+		that1 = &t
+	default:
+		return false
+	}
+
+	return proto.Equal(this, that1)
+}
+
+// Marshal an object of type MergeDLQMessagesResponse to the protobuf v3 wire format
+func (val *MergeDLQMessagesResponse) Marshal() ([]byte, error) {
+	return proto.Marshal(val)
+}
+
+// Unmarshal an object of type MergeDLQMessagesResponse from the protobuf v3 wire format
+func (val *MergeDLQMessagesResponse) Unmarshal(buf []byte) error {
+	return proto.Unmarshal(buf, val)
+}
+
+// Size returns the size of the object, in bytes, once serialized
+func (val *MergeDLQMessagesResponse) Size() int {
+	return proto.Size(val)
+}
+
+// Equal returns whether two MergeDLQMessagesResponse values are equivalent by recursively
+// comparing the message's fields.
+// For more information see the documentation for
+// https://pkg.go.dev/google.golang.org/protobuf/proto#Equal
+func (this *MergeDLQMessagesResponse) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	var that1 *MergeDLQMessagesResponse
+	switch t := that.(type) {
+	case *MergeDLQMessagesResponse:
+		that1 = t
+	case MergeDLQMessagesResponse:
+		that1 = &t
+	default:
+		return false
+	}
+
+	return proto.Equal(this, that1)
+}
+
+// Marshal an object of type RefreshWorkflowTasksRequest to the protobuf v3 wire format
+func (val *RefreshWorkflowTasksRequest) Marshal() ([]byte, error) {
+	return proto.Marshal(val)
+}
+
+// Unmarshal an object of type RefreshWorkflowTasksRequest from the protobuf v3 wire format
+func (val *RefreshWorkflowTasksRequest) Unmarshal(buf []byte) error {
+	return proto.Unmarshal(buf, val)
+}
+
+// Size returns the size of the object, in bytes, once serialized
+func (val *RefreshWorkflowTasksRequest) Size() int {
+	return proto.Size(val)
+}
+
+// Equal returns whether two RefreshWorkflowTasksRequest values are equivalent by recursively
+// comparing the message's fields.
+// For more information see the documentation for
+// https://pkg.go.dev/google.golang.org/protobuf/proto#Equal
+func (this *RefreshWorkflowTasksRequest) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	var that1 *RefreshWorkflowTasksRequest
+	switch t := that.(type) {
+	case *RefreshWorkflowTasksRequest:
+		that1 = t
+	case RefreshWorkflowTasksRequest:
+		that1 = &t
+	default:
+		return false
+	}
+
+	return proto.Equal(this, that1)
+}
+
+// Marshal an object of type RefreshWorkflowTasksResponse to the protobuf v3 wire format
+func (val *RefreshWorkflowTasksResponse) Marshal() ([]byte, error) {
+	return proto.Marshal(val)
+}
+
+// Unmarshal an object of type RefreshWorkflowTasksResponse from the protobuf v3 wire format
+func (val *RefreshWorkflowTasksResponse) Unmarshal(buf []byte) error {
+	return proto.Unmarshal(buf, val)
+}
+
+// Size returns the size of the object, in bytes, once serialized
+func (val *RefreshWorkflowTasksResponse) Size() int {
+	return proto.Size(val)
+}
+
+// Equal returns whether two RefreshWorkflowTasksResponse values are equivalent by recursively
+// comparing the message's fields.
+// For more information see the documentation for
+// https://pkg.go.dev/google.golang.org/protobuf/proto#Equal
+func (this *RefreshWorkflowTasksResponse) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	var that1 *RefreshWorkflowTasksResponse
+	switch t := that.(type) {
+	case *RefreshWorkflowTasksResponse:
+		that1 = t
+	case RefreshWorkflowTasksResponse:
+		that1 = &t
+	default:
+		return false
+	}
+
+	return proto.Equal(this, that1)
+}
+
+// Marshal an object of type ResendReplicationTasksRequest to the protobuf v3 wire format
+func (val *ResendReplicationTasksRequest) Marshal() ([]byte, error) {
+	return proto.Marshal(val)
+}
+
+// Unmarshal an object of type ResendReplicationTasksRequest from the protobuf v3 wire format
+func (val *ResendReplicationTasksRequest) Unmarshal(buf []byte) error {
+	return proto.Unmarshal(buf, val)
+}
+
+// Size returns the size of the object, in bytes, once serialized
+func (val *ResendReplicationTasksRequest) Size() int {
+	return proto.Size(val)
+}
+
+// Equal returns whether two ResendReplicationTasksRequest values are equivalent by recursively
+// comparing the message's fields.
+// For more information see the documentation for
+// https://pkg.go.dev/google.golang.org/protobuf/proto#Equal
+func (this *ResendReplicationTasksRequest) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	var that1 *ResendReplicationTasksRequest
+	switch t := that.(type) {
+	case *ResendReplicationTasksRequest:
+		that1 = t
+	case ResendReplicationTasksRequest:
+		that1 = &t
+	default:
+		return false
+	}
+
+	return proto.Equal(this, that1)
+}
+
+// Marshal an object of type ResendReplicationTasksResponse to the protobuf v3 wire format
+func (val *ResendReplicationTasksResponse) Marshal() ([]byte, error) {
+	return proto.Marshal(val)
+}
+
+// Unmarshal an object of type ResendReplicationTasksResponse from the protobuf v3 wire format
+func (val *ResendReplicationTasksResponse) Unmarshal(buf []byte) error {
+	return proto.Unmarshal(buf, val)
+}
+
+// Size returns the size of the object, in bytes, once serialized
+func (val *ResendReplicationTasksResponse) Size() int {
+	return proto.Size(val)
+}
+
+// Equal returns whether two ResendReplicationTasksResponse values are equivalent by recursively
+// comparing the message's fields.
+// For more information see the documentation for
+// https://pkg.go.dev/google.golang.org/protobuf/proto#Equal
+func (this *ResendReplicationTasksResponse) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	var that1 *ResendReplicationTasksResponse
+	switch t := that.(type) {
+	case *ResendReplicationTasksResponse:
+		that1 = t
+	case ResendReplicationTasksResponse:
+		that1 = &t
+	default:
+		return false
+	}
+
+	return proto.Equal(this, that1)
+}
+
+// Marshal an object of type GetTaskQueueTasksRequest to the protobuf v3 wire format
+func (val *GetTaskQueueTasksRequest) Marshal() ([]byte, error) {
+	return proto.Marshal(val)
+}
+
+// Unmarshal an object of type GetTaskQueueTasksRequest from the protobuf v3 wire format
+func (val *GetTaskQueueTasksRequest) Unmarshal(buf []byte) error {
+	return proto.Unmarshal(buf, val)
+}
+
+// Size returns the size of the object, in bytes, once serialized
+func (val *GetTaskQueueTasksRequest) Size() int {
+	return proto.Size(val)
+}
+
+// Equal returns whether two GetTaskQueueTasksRequest values are equivalent by recursively
+// comparing the message's fields.
+// For more information see the documentation for
+// https://pkg.go.dev/google.golang.org/protobuf/proto#Equal
+func (this *GetTaskQueueTasksRequest) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	var that1 *GetTaskQueueTasksRequest
+	switch t := that.(type) {
+	case *GetTaskQueueTasksRequest:
+		that1 = t
+	case GetTaskQueueTasksRequest:
+		that1 = &t
+	default:
+		return false
+	}
+
+	return proto.Equal(this, that1)
+}
+
+// Marshal an object of type GetTaskQueueTasksResponse to the protobuf v3 wire format
+func (val *GetTaskQueueTasksResponse) Marshal() ([]byte, error) {
+	return proto.Marshal(val)
+}
+
+// Unmarshal an object of type GetTaskQueueTasksResponse from the protobuf v3 wire format
+func (val *GetTaskQueueTasksResponse) Unmarshal(buf []byte) error {
+	return proto.Unmarshal(buf, val)
+}
+
+// Size returns the size of the object, in bytes, once serialized
+func (val *GetTaskQueueTasksResponse) Size() int {
+	return proto.Size(val)
+}
+
+// Equal returns whether two GetTaskQueueTasksResponse values are equivalent by recursively
+// comparing the message's fields.
+// For more information see the documentation for
+// https://pkg.go.dev/google.golang.org/protobuf/proto#Equal
+func (this *GetTaskQueueTasksResponse) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	var that1 *GetTaskQueueTasksResponse
+	switch t := that.(type) {
+	case *GetTaskQueueTasksResponse:
+		that1 = t
+	case GetTaskQueueTasksResponse:
+		that1 = &t
+	default:
+		return false
+	}
+
+	return proto.Equal(this, that1)
+}
+
+// Marshal an object of type DeleteWorkflowExecutionRequest to the protobuf v3 wire format
+func (val *DeleteWorkflowExecutionRequest) Marshal() ([]byte, error) {
+	return proto.Marshal(val)
+}
+
+// Unmarshal an object of type DeleteWorkflowExecutionRequest from the protobuf v3 wire format
+func (val *DeleteWorkflowExecutionRequest) Unmarshal(buf []byte) error {
+	return proto.Unmarshal(buf, val)
+}
+
+// Size returns the size of the object, in bytes, once serialized
+func (val *DeleteWorkflowExecutionRequest) Size() int {
+	return proto.Size(val)
+}
+
+// Equal returns whether two DeleteWorkflowExecutionRequest values are equivalent by recursively
 // comparing the message's fields.
 // For more information see the documentation for
 // https://pkg.go.dev/google.golang.org/protobuf/proto#Equal
-func (this *PurgeDLQMessagesResponse) Equal(that interface{}) bool {
+func (this *DeleteWorkflowExecutionRequest) Equal(that interface{}) bool {
 	if that == nil {
 		return this == nil
 	}
 
-	var that1 *PurgeDLQMessagesResponse
+	var that1 *DeleteWorkflowExecutionRequest
 	switch t := that.(type) {
-	case *PurgeDLQMessagesResponse:
+	case *DeleteWorkflowExecutionRequest:
 		that1 = t
-	case PurgeDLQMessagesResponse:
+	case DeleteWorkflowExecutionRequest:
 		that1 = &t
 	default:
 		return false
@@ -1818,35 +3150,35 @@ func (this *PurgeDLQMessagesResponse) Equal(that interface{}) bool {
 	return proto.Equal(this, that1)
 }
 
-// Marshal an object of type MergeDLQMessagesRequest to the protobuf v3 wire format
-func (val *MergeDLQMessagesRequest) Marshal() ([]byte, error) {
+// Marshal an object of type DeleteWorkflowExecutionResponse to the protobuf v3 wire format
+func (val *DeleteWorkflowExecutionResponse) Marshal() ([]byte, error) {
 	return proto.Marshal(val)
 }
 
-// Unmarshal an object of type MergeDLQMessagesRequest from the protobuf v3 wire format
-func (val *MergeDLQMessagesRequest) Unmarshal(buf []byte) error {
+// Unmarshal an object of type DeleteWorkflowExecutionResponse from the protobuf v3 wire format
+func (val *DeleteWorkflowExecutionResponse) Unmarshal(buf []byte) error {
 	return proto.Unmarshal(buf, val)
 }
 
 // Size returns the size of the object, in bytes, once serialized
-func (val *MergeDLQMessagesRequest) Size() int {
+func (val *DeleteWorkflowExecutionResponse) Size() int {
 	return proto.Size(val)
 }
 
-// Equal returns whether two MergeDLQMessagesRequest values are equivalent by recursively
+// Equal returns whether two DeleteWorkflowExecutionResponse values are equivalent by recursively
 // comparing the message's fields.
 // For more information see the documentation for
 // https://pkg.go.dev/google.golang.org/protobuf/proto#Equal
-func (this *MergeDLQMessagesRequest) Equal(that interface{}) bool {
+func (this *DeleteWorkflowExecutionResponse) Equal(that interface{}) bool {
 	if that == nil {
 		return this == nil
 	}
 
-	var that1 *MergeDLQMessagesRequest
+	var that1 *DeleteWorkflowExecutionResponse
 	switch t := that.(type) {
-	case *MergeDLQMessagesRequest:
+	case *DeleteWorkflowExecutionResponse:
 		that1 = t
-	case MergeDLQMessagesRequest:
+	case DeleteWorkflowExecutionResponse:
 		that1 = &t
 	default:
 		return false
@@ -1855,35 +3187,35 @@ func (this *MergeDLQMessagesRequest) Equal(that interface{}) bool {
 	return proto.Equal(this, that1)
 }
 
-// Marshal an object of type MergeDLQMessagesResponse to the protobuf v3 wire format
-func (val *MergeDLQMessagesResponse) Marshal() ([]byte, error) {
+// Marshal an object of type StreamWorkflowReplicationMessagesRequest to the protobuf v3 wire format
+func (val *StreamWorkflowReplicationMessagesRequest) Marshal() ([]byte, error) {
 	return proto.Marshal(val)
 }
 
-// Unmarshal an object of type MergeDLQMessagesResponse from the protobuf v3 wire format
-func (val *MergeDLQMessagesResponse) Unmarshal(buf []byte) error {
+// Unmarshal an object of type StreamWorkflowReplicationMessagesRequest from the protobuf v3 wire format
+func (val *StreamWorkflowReplicationMessagesRequest) Unmarshal(buf []byte) error {
 	return proto.Unmarshal(buf, val)
 }
 
 // Size returns the size of the object, in bytes, once serialized
-func (val *MergeDLQMessagesResponse) Size() int {
+func (val *StreamWorkflowReplicationMessagesRequest) Size() int {
 	return proto.Size(val)
 }
 
-// Equal returns whether two MergeDLQMessagesResponse values are equivalent by recursively
+// Equal returns whether two StreamWorkflowReplicationMessagesRequest values are equivalent by recursively
 // comparing the message's fields.
 // For more information see the documentation for
 // https://pkg.go.dev/google.golang.org/protobuf/proto#Equal
-func (this *MergeDLQMessagesResponse) Equal(that interface{}) bool {
+func (this *StreamWorkflowReplicationMessagesRequest) Equal(that interface{}) bool {
 	if that == nil {
 		return this == nil
 	}
 
-	var that1 *MergeDLQMessagesResponse
+	var that1 *StreamWorkflowReplicationMessagesRequest
 	switch t := that.(type) {
-	case *MergeDLQMessagesResponse:
+	case *StreamWorkflowReplicationMessagesRequest:
 		that1 = t
-	case MergeDLQMessagesResponse:
+	case StreamWorkflowReplicationMessagesRequest:
 		that1 = &t
 	default:
 		return false
@@ -1892,35 +3224,35 @@ func (this *MergeDLQMessagesResponse) Equal(that interface{}) bool {
 	return proto.Equal(this, that1)
 }
 
-// Marshal an object of type RefreshWorkflowTasksRequest to the protobuf v3 wire format
-func (val *RefreshWorkflowTasksRequest) Marshal() ([]byte, error) {
+// Marshal an object of type StreamWorkflowReplicationMessagesResponse to the protobuf v3 wire format
+func (val *StreamWorkflowReplicationMessagesResponse) Marshal() ([]byte, error) {
 	return proto.Marshal(val)
 }
 
-// Unmarshal an object of type RefreshWorkflowTasksRequest from the protobuf v3 wire format
-func (val *RefreshWorkflowTasksRequest) Unmarshal(buf []byte) error {
+// Unmarshal an object of type StreamWorkflowReplicationMessagesResponse from the protobuf v3 wire format
+func (val *StreamWorkflowReplicationMessagesResponse) Unmarshal(buf []byte) error {
 	return proto.Unmarshal(buf, val)
 }
 
 // Size returns the size of the object, in bytes, once serialized
-func (val *RefreshWorkflowTasksRequest) Size() int {
+func (val *StreamWorkflowReplicationMessagesResponse) Size() int {
 	return proto.Size(val)
 }
 
-// Equal returns whether two RefreshWorkflowTasksRequest values are equivalent by recursively
+// Equal returns whether two StreamWorkflowReplicationMessagesResponse values are equivalent by recursively
 // comparing the message's fields.
 // For more information see the documentation for
 // https://pkg.go.dev/google.golang.org/protobuf/proto#Equal
-func (this *RefreshWorkflowTasksRequest) Equal(that interface{}) bool {
+func (this *StreamWorkflowReplicationMessagesResponse) Equal(that interface{}) bool {
 	if that == nil {
 		return this == nil
 	}
 
-	var that1 *RefreshWorkflowTasksRequest
+	var that1 *StreamWorkflowReplicationMessagesResponse
 	switch t := that.(type) {
-	case *RefreshWorkflowTasksRequest:
+	case *StreamWorkflowReplicationMessagesResponse:
 		that1 = t
-	case RefreshWorkflowTasksRequest:
+	case StreamWorkflowReplicationMessagesResponse:
 		that1 = &t
 	default:
 		return false
@@ -1929,35 +3261,35 @@ func (this *RefreshWorkflowTasksRequest) Equal(that interface{}) bool {
 	return proto.Equal(this, that1)
 }
 
-// Marshal an object of type RefreshWorkflowTasksResponse to the protobuf v3 wire format
-func (val *RefreshWorkflowTasksResponse) Marshal() ([]byte, error) {
+// Marshal an object of type GetNamespaceRequest to the protobuf v3 wire format
+func (val *GetNamespaceRequest) Marshal() ([]byte, error) {
 	return proto.Marshal(val)
 }
 
-// Unmarshal an object of type RefreshWorkflowTasksResponse from the protobuf v3 wire format
-func (val *RefreshWorkflowTasksResponse) Unmarshal(buf []byte) error {
+// Unmarshal an object of type GetNamespaceRequest from the protobuf v3 wire format
+func (val *GetNamespaceRequest) Unmarshal(buf []byte) error {
 	return proto.Unmarshal(buf, val)
 }
 
 // Size returns the size of the object, in bytes, once serialized
-func (val *RefreshWorkflowTasksResponse) Size() int {
+func (val *GetNamespaceRequest) Size() int {
 	return proto.Size(val)
 }
 
-// Equal returns whether two RefreshWorkflowTasksResponse values are equivalent by recursively
+// Equal returns whether two GetNamespaceRequest values are equivalent by recursively
 // comparing the message's fields.
 // For more information see the documentation for
 // https://pkg.go.dev/google.golang.org/protobuf/proto#Equal
-func (this *RefreshWorkflowTasksResponse) Equal(that interface{}) bool {
+func (this *GetNamespaceRequest) Equal(that interface{}) bool {
 	if that == nil {
 		return this == nil
 	}
 
-	var that1 *RefreshWorkflowTasksResponse
+	var that1 *GetNamespaceRequest
 	switch t := that.(type) {
-	case *RefreshWorkflowTasksResponse:
+	case *GetNamespaceRequest:
 		that1 = t
-	case RefreshWorkflowTasksResponse:
+	case GetNamespaceRequest:
 		that1 = &t
 	default:
 		return false
@@ -1966,35 +3298,35 @@ func (this *RefreshWorkflowTasksResponse) Equal(that interface{}) bool {
 	return proto.Equal(this, that1)
 }
 
-// Marshal an object of type ResendReplicationTasksRequest to the protobuf v3 wire format
-func (val *ResendReplicationTasksRequest) Marshal() ([]byte, error) {
+// Marshal an object of type GetNamespaceResponse to the protobuf v3 wire format
+func (val *GetNamespaceResponse) Marshal() ([]byte, error) {
 	return proto.Marshal(val)
 }
 
-// Unmarshal an object of type ResendReplicationTasksRequest from the protobuf v3 wire format
-func (val *ResendReplicationTasksRequest) Unmarshal(buf []byte) error {
+// Unmarshal an object of type GetNamespaceResponse from the protobuf v3 wire format
+func (val *GetNamespaceResponse) Unmarshal(buf []byte) error {
 	return proto.Unmarshal(buf, val)
 }
 
 // Size returns the size of the object, in bytes, once serialized
-func (val *ResendReplicationTasksRequest) Size() int {
+func (val *GetNamespaceResponse) Size() int {
 	return proto.Size(val)
 }
 
-// Equal returns whether two ResendReplicationTasksRequest values are equivalent by recursively
+// Equal returns whether two GetNamespaceResponse values are equivalent by recursively
 // comparing the message's fields.
 // For more information see the documentation for
 // https://pkg.go.dev/google.golang.org/protobuf/proto#Equal
-func (this *ResendReplicationTasksRequest) Equal(that interface{}) bool {
+func (this *GetNamespaceResponse) Equal(that interface{}) bool {
 	if that == nil {
 		return this == nil
 	}
 
-	var that1 *ResendReplicationTasksRequest
+	var that1 *GetNamespaceResponse
 	switch t := that.(type) {
-	case *ResendReplicationTasksRequest:
+	case *GetNamespaceResponse:
 		that1 = t
-	case ResendReplicationTasksRequest:
+	case GetNamespaceResponse:
 		that1 = &t
 	default:
 		return false
@@ -2003,35 +3335,35 @@ func (this *ResendReplicationTasksRequest) Equal(that interface{}) bool {
 	return proto.Equal(this, that1)
 }
 
-// Marshal an object of type ResendReplicationTasksResponse to the protobuf v3 wire format
-func (val *ResendReplicationTasksResponse) Marshal() ([]byte, error) {
+// Marshal an object of type GetDLQTasksRequest to the protobuf v3 wire format
+func (val *GetDLQTasksRequest) Marshal() ([]byte, error) {
 	return proto.Marshal(val)
 }
 
-// Unmarshal an object of type ResendReplicationTasksResponse from the protobuf v3 wire format
-func (val *ResendReplicationTasksResponse) Unmarshal(buf []byte) error {
+// Unmarshal an object of type GetDLQTasksRequest from the protobuf v3 wire format
+func (val *GetDLQTasksRequest) Unmarshal(buf []byte) error {
 	return proto.Unmarshal(buf, val)
 }
 
 // Size returns the size of the object, in bytes, once serialized
-func (val *ResendReplicationTasksResponse) Size() int {
+func (val *GetDLQTasksRequest) Size() int {
 	return proto.Size(val)
 }
 
-// Equal returns whether two ResendReplicationTasksResponse values are equivalent by recursively
+// Equal returns whether two GetDLQTasksRequest values are equivalent by recursively
 // comparing the message's fields.
 // For more information see the documentation for
 // https://pkg.go.dev/google.golang.org/protobuf/proto#Equal
-func (this *ResendReplicationTasksResponse) Equal(that interface{}) bool {
+func (this *GetDLQTasksRequest) Equal(that interface{}) bool {
 	if that == nil {
 		return this == nil
 	}
 
-	var that1 *ResendReplicationTasksResponse
+	var that1 *GetDLQTasksRequest
 	switch t := that.(type) {
-	case *ResendReplicationTasksResponse:
+	case *GetDLQTasksRequest:
 		that1 = t
-	case ResendReplicationTasksResponse:
+	case GetDLQTasksRequest:
 		that1 = &t
 	default:
 		return false
@@ -2040,35 +3372,35 @@ func (this *ResendReplicationTasksResponse) Equal(that interface{}) bool {
 	return proto.Equal(this, that1)
 }
 
-// Marshal an object of type GetTaskQueueTasksRequest to the protobuf v3 wire format
-func (val *GetTaskQueueTasksRequest) Marshal() ([]byte, error) {
+// Marshal an object of type GetDLQTasksResponse to the protobuf v3 wire format
+func (val *GetDLQTasksResponse) Marshal() ([]byte, error) {
 	return proto.Marshal(val)
 }
 
-// Unmarshal an object of type GetTaskQueueTasksRequest from the protobuf v3 wire format
-func (val *GetTaskQueueTasksRequest) Unmarshal(buf []byte) error {
+// Unmarshal an object of type GetDLQTasksResponse from the protobuf v3 wire format
+func (val *GetDLQTasksResponse) Unmarshal(buf []byte) error {
 	return proto.Unmarshal(buf, val)
 }
 
 // Size returns the size of the object, in bytes, once serialized
-func (val *GetTaskQueueTasksRequest) Size() int {
+func (val *GetDLQTasksResponse) Size() int {
 	return proto.Size(val)
 }
 
-// Equal returns whether two GetTaskQueueTasksRequest values are equivalent by recursively
+// Equal returns whether two GetDLQTasksResponse values are equivalent by recursively
 // comparing the message's fields.
 // For more information see the documentation for
 // https://pkg.go.dev/google.golang.org/protobuf/proto#Equal
-func (this *GetTaskQueueTasksRequest) Equal(that interface{}) bool {
+func (this *GetDLQTasksResponse) Equal(that interface{}) bool {
 	if that == nil {
 		return this == nil
 	}
 
-	var that1 *GetTaskQueueTasksRequest
+	var that1 *GetDLQTasksResponse
 	switch t := that.(type) {
-	case *GetTaskQueueTasksRequest:
+	case *GetDLQTasksResponse:
 		that1 = t
-	case GetTaskQueueTasksRequest:
+	case GetDLQTasksResponse:
 		that1 = &t
 	default:
 		return false
@@ -2077,35 +3409,35 @@ func (this *GetTaskQueueTasksRequest) Equal(that interface{}) bool {
 	return proto.Equal(this, that1)
 }
 
-// Marshal an object of type GetTaskQueueTasksResponse to the protobuf v3 wire format
-func (val *GetTaskQueueTasksResponse) Marshal() ([]byte, error) {
+// Marshal an object of type PurgeDLQTasksRequest to the protobuf v3 wire format
+func (val *PurgeDLQTasksRequest) Marshal() ([]byte, error) {
 	return proto.Marshal(val)
 }
 
-// Unmarshal an object of type GetTaskQueueTasksResponse from the protobuf v3 wire format
-func (val *GetTaskQueueTasksResponse) Unmarshal(buf []byte) error {
+// Unmarshal an object of type PurgeDLQTasksRequest from the protobuf v3 wire format
+func (val *PurgeDLQTasksRequest) Unmarshal(buf []byte) error {
 	return proto.Unmarshal(buf, val)
 }
 
 // Size returns the size of the object, in bytes, once serialized
-func (val *GetTaskQueueTasksResponse) Size() int {
+func (val *PurgeDLQTasksRequest) Size() int {
 	return proto.Size(val)
 }
 
-// Equal returns whether two GetTaskQueueTasksResponse values are equivalent by recursively
+// Equal returns whether two PurgeDLQTasksRequest values are equivalent by recursively
 // comparing the message's fields.
 // For more information see the documentation for
 // https://pkg.go.dev/google.golang.org/protobuf/proto#Equal
-func (this *GetTaskQueueTasksResponse) Equal(that interface{}) bool {
+func (this *PurgeDLQTasksRequest) Equal(that interface{}) bool {
 	if that == nil {
 		return this == nil
 	}
 
-	var that1 *GetTaskQueueTasksResponse
+	var that1 *PurgeDLQTasksRequest
 	switch t := that.(type) {
-	case *GetTaskQueueTasksResponse:
+	case *PurgeDLQTasksRequest:
 		that1 = t
-	case GetTaskQueueTasksResponse:
+	case PurgeDLQTasksRequest:
 		that1 = &t
 	default:
 		return false
@@ -2114,35 +3446,35 @@ func (this *GetTaskQueueTasksResponse) Equal(that interface{}) bool {
 	return proto.Equal(this, that1)
 }
 
-// Marshal an object of type DeleteWorkflowExecutionRequest to the protobuf v3 wire format
-func (val *DeleteWorkflowExecutionRequest) Marshal() ([]byte, error) {
+// Marshal an object of type PurgeDLQTasksResponse to the protobuf v3 wire format
+func (val *PurgeDLQTasksResponse) Marshal() ([]byte, error) {
 	return proto.Marshal(val)
 }
 
-// Unmarshal an object of type DeleteWorkflowExecutionRequest from the protobuf v3 wire format
-func (val *DeleteWorkflowExecutionRequest) Unmarshal(buf []byte) error {
+// Unmarshal an object of type PurgeDLQTasksResponse from the protobuf v3 wire format
+func (val *PurgeDLQTasksResponse) Unmarshal(buf []byte) error {
 	return proto.Unmarshal(buf, val)
 }
 
 // Size returns the size of the object, in bytes, once serialized
-func (val *DeleteWorkflowExecutionRequest) Size() int {
+func (val *PurgeDLQTasksResponse) Size() int {
 	return proto.Size(val)
 }
 
-// Equal returns whether two DeleteWorkflowExecutionRequest values are equivalent by recursively
+// Equal returns whether two PurgeDLQTasksResponse values are equivalent by recursively
 // comparing the message's fields.
 // For more information see the documentation for
 // https://pkg.go.dev/google.golang.org/protobuf/proto#Equal
-func (this *DeleteWorkflowExecutionRequest) Equal(that interface{}) bool {
+func (this *PurgeDLQTasksResponse) Equal(that interface{}) bool {
 	if that == nil {
 		return this == nil
 	}
 
-	var that1 *DeleteWorkflowExecutionRequest
+	var that1 *PurgeDLQTasksResponse
 	switch t := that.(type) {
-	case *DeleteWorkflowExecutionRequest:
+	case *PurgeDLQTasksResponse:
 		that1 = t
-	case DeleteWorkflowExecutionRequest:
+	case PurgeDLQTasksResponse:
 		that1 = &t
 	default:
 		return false
@@ -2151,35 +3483,72 @@ func (this *DeleteWorkflowExecutionRequest) Equal(that interface{}) bool {
 	return proto.Equal(this, that1)
 }
 
-// Marshal an object of type DeleteWorkflowExecutionResponse to the protobuf v3 wire format
-func (val *DeleteWorkflowExecutionResponse) Marshal() ([]byte, error) {
+// Marshal an object of type DLQJobToken to the protobuf v3 wire format
+func (val *DLQJobToken) Marshal() ([]byte, error) {
 	return proto.Marshal(val)
 }
 
-// Unmarshal an object of type DeleteWorkflowExecutionResponse from the protobuf v3 wire format
-func (val *DeleteWorkflowExecutionResponse) Unmarshal(buf []byte) error {
+// Unmarshal an object of type DLQJobToken from the protobuf v3 wire format
+func (val *DLQJobToken) Unmarshal(buf []byte) error {
+	return proto.Unmarshal(buf, val)
+}
+
+// Size returns the size of the object, in bytes, once serialized
+func (val *DLQJobToken) Size() int {
+	return proto.Size(val)
+}
+
+// Equal returns whether two DLQJobToken values are equivalent by recursively
+// comparing the message's fields.
+// For more information see the documentation for
+// https://pkg.go.dev/google.golang.org/protobuf/proto#Equal
+func (this *DLQJobToken) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	var that1 *DLQJobToken
+	switch t := that.(type) {
+	case *DLQJobToken:
+		that1 = t
+	case DLQJobToken:
+		that1 = &t
+	default:
+		return false
+	}
+
+	return proto.Equal(this, that1)
+}
+
+// Marshal an object of type MergeDLQTasksRequest to the protobuf v3 wire format
+func (val *MergeDLQTasksRequest) Marshal() ([]byte, error) {
+	return proto.Marshal(val)
+}
+
+// Unmarshal an object of type MergeDLQTasksRequest from the protobuf v3 wire format
+func (val *MergeDLQTasksRequest) Unmarshal(buf []byte) error {
 	return proto.Unmarshal(buf, val)
 }
 
 // Size returns the size of the object, in bytes, once serialized
-func (val *DeleteWorkflowExecutionResponse) Size() int {
+func (val *MergeDLQTasksRequest) Size() int {
 	return proto.Size(val)
 }
 
-// Equal returns whether two DeleteWorkflowExecutionResponse values are equivalent by recursively
+// Equal returns whether two MergeDLQTasksRequest values are equivalent by recursively
 // comparing the message's fields.
 // For more information see the documentation for
 // https://pkg.go.dev/google.golang.org/protobuf/proto#Equal
-func (this *DeleteWorkflowExecutionResponse) Equal(that interface{}) bool {
+func (this *MergeDLQTasksRequest) Equal(that interface{}) bool {
 	if that == nil {
 		return this == nil
 	}
 
-	var that1 *DeleteWorkflowExecutionResponse
+	var that1 *MergeDLQTasksRequest
 	switch t := that.(type) {
-	case *DeleteWorkflowExecutionResponse:
+	case *MergeDLQTasksRequest:
 		that1 = t
-	case DeleteWorkflowExecutionResponse:
+	case MergeDLQTasksRequest:
 		that1 = &t
 	default:
 		return false
@@ -2188,35 +3557,35 @@ func (this *DeleteWorkflowExecutionResponse) Equal(that interface{}) bool {
 	return proto.Equal(this, that1)
 }
 
-// Marshal an object of type StreamWorkflowReplicationMessagesRequest to the protobuf v3 wire format
-func (val *StreamWorkflowReplicationMessagesRequest) Marshal() ([]byte, error) {
+// Marshal an object of type MergeDLQTasksResponse to the protobuf v3 wire format
+func (val *MergeDLQTasksResponse) Marshal() ([]byte, error) {
 	return proto.Marshal(val)
 }
 
-// Unmarshal an object of type StreamWorkflowReplicationMessagesRequest from the protobuf v3 wire format
-func (val *StreamWorkflowReplicationMessagesRequest) Unmarshal(buf []byte) error {
+// Unmarshal an object of type MergeDLQTasksResponse from the protobuf v3 wire format
+func (val *MergeDLQTasksResponse) Unmarshal(buf []byte) error {
 	return proto.Unmarshal(buf, val)
 }
 
 // Size returns the size of the object, in bytes, once serialized
-func (val *StreamWorkflowReplicationMessagesRequest) Size() int {
+func (val *MergeDLQTasksResponse) Size() int {
 	return proto.Size(val)
 }
 
-// Equal returns whether two StreamWorkflowReplicationMessagesRequest values are equivalent by recursively
+// Equal returns whether two MergeDLQTasksResponse values are equivalent by recursively
 // comparing the message's fields.
 // For more information see the documentation for
 // https://pkg.go.dev/google.golang.org/protobuf/proto#Equal
-func (this *StreamWorkflowReplicationMessagesRequest) Equal(that interface{}) bool {
+func (this *MergeDLQTasksResponse) Equal(that interface{}) bool {
 	if that == nil {
 		return this == nil
 	}
 
-	var that1 *StreamWorkflowReplicationMessagesRequest
+	var that1 *MergeDLQTasksResponse
 	switch t := that.(type) {
-	case *StreamWorkflowReplicationMessagesRequest:
+	case *MergeDLQTasksResponse:
 		that1 = t
-	case StreamWorkflowReplicationMessagesRequest:
+	case MergeDLQTasksResponse:
 		that1 = &t
 	default:
 		return false
@@ -2225,35 +3594,35 @@ func (this *StreamWorkflowReplicationMessagesRequest) Equal(that interface{}) bo
 	return proto.Equal(this, that1)
 }
 
-// Marshal an object of type StreamWorkflowReplicationMessagesResponse to the protobuf v3 wire format
-func (val *StreamWorkflowReplicationMessagesResponse) Marshal() ([]byte, error) {
+// Marshal an object of type DescribeDLQJobRequest to the protobuf v3 wire format
+func (val *DescribeDLQJobRequest) Marshal() ([]byte, error) {
 	return proto.Marshal(val)
 }
 
-// Unmarshal an object of type StreamWorkflowReplicationMessagesResponse from the protobuf v3 wire format
-func (val *StreamWorkflowReplicationMessagesResponse) Unmarshal(buf []byte) error {
+// Unmarshal an object of type DescribeDLQJobRequest from the protobuf v3 wire format
+func (val *DescribeDLQJobRequest) Unmarshal(buf []byte) error {
 	return proto.Unmarshal(buf, val)
 }
 
 // Size returns the size of the object, in bytes, once serialized
-func (val *StreamWorkflowReplicationMessagesResponse) Size() int {
+func (val *DescribeDLQJobRequest) Size() int {
 	return proto.Size(val)
 }
 
-// Equal returns whether two StreamWorkflowReplicationMessagesResponse values are equivalent by recursively
+// Equal returns whether two DescribeDLQJobRequest values are equivalent by recursively
 // comparing the message's fields.
 // For more information see the documentation for
 // https://pkg.go.dev/google.golang.org/protobuf/proto#Equal
-func (this *StreamWorkflowReplicationMessagesResponse) Equal(that interface{}) bool {
+func (this *DescribeDLQJobRequest) Equal(that interface{}) bool {
 	if that == nil {
 		return this == nil
 	}
 
-	var that1 *StreamWorkflowReplicationMessagesResponse
+	var that1 *DescribeDLQJobRequest
 	switch t := that.(type) {
-	case *StreamWorkflowReplicationMessagesResponse:
+	case *DescribeDLQJobRequest:
 		that1 = t
-	case StreamWorkflowReplicationMessagesResponse:
+	case DescribeDLQJobRequest:
 		that1 = &t
 	default:
 		return false
@@ -2262,35 +3631,35 @@ func (this *StreamWorkflowReplicationMessagesResponse) Equal(that interface{}) b
 	return proto.Equal(this, that1)
 }
 
-// Marshal an object of type GetNamespaceRequest to the protobuf v3 wire format
-func (val *GetNamespaceRequest) Marshal() ([]byte, error) {
+// Marshal an object of type DescribeDLQJobResponse to the protobuf v3 wire format
+func (val *DescribeDLQJobResponse) Marshal() ([]byte, error) {
 	return proto.Marshal(val)
 }
 
-// Unmarshal an object of type GetNamespaceRequest from the protobuf v3 wire format
-func (val *GetNamespaceRequest) Unmarshal(buf []byte) error {
+// Unmarshal an object of type DescribeDLQJobResponse from the protobuf v3 wire format
+func (val *DescribeDLQJobResponse) Unmarshal(buf []byte) error {
 	return proto.Unmarshal(buf, val)
 }
 
 // Size returns the size of the object, in bytes, once serialized
-func (val *GetNamespaceRequest) Size() int {
+func (val *DescribeDLQJobResponse) Size() int {
 	return proto.Size(val)
 }
 
-// Equal returns whether two GetNamespaceRequest values are equivalent by recursively
+// Equal returns whether two DescribeDLQJobResponse values are equivalent by recursively
 // comparing the message's fields.
 // For more information see the documentation for
 // https://pkg.go.dev/google.golang.org/protobuf/proto#Equal
-func (this *GetNamespaceRequest) Equal(that interface{}) bool {
+func (this *DescribeDLQJobResponse) Equal(that interface{}) bool {
 	if that == nil {
 		return this == nil
 	}
 
-	var that1 *GetNamespaceRequest
+	var that1 *DescribeDLQJobResponse
 	switch t := that.(type) {
-	case *GetNamespaceRequest:
+	case *DescribeDLQJobResponse:
 		that1 = t
-	case GetNamespaceRequest:
+	case DescribeDLQJobResponse:
 		that1 = &t
 	default:
 		return false
@@ -2299,35 +3668,35 @@ func (this *GetNamespaceRequest) Equal(that interface{}) bool {
 	return proto.Equal(this, that1)
 }
 
-// Marshal an object of type GetNamespaceResponse to the protobuf v3 wire format
-func (val *GetNamespaceResponse) Marshal() ([]byte, error) {
+// Marshal an object of type CancelDLQJobRequest to the protobuf v3 wire format
+func (val *CancelDLQJobRequest) Marshal() ([]byte, error) {
 	return proto.Marshal(val)
 }
 
-// Unmarshal an object of type GetNamespaceResponse from the protobuf v3 wire format
-func (val *GetNamespaceResponse) Unmarshal(buf []byte) error {
+// Unmarshal an object of type CancelDLQJobRequest from the protobuf v3 wire format
+func (val *CancelDLQJobRequest) Unmarshal(buf []byte) error {
 	return proto.Unmarshal(buf, val)
 }
 
 // Size returns the size of the object, in bytes, once serialized
-func (val *GetNamespaceResponse) Size() int {
+func (val *CancelDLQJobRequest) Size() int {
 	return proto.Size(val)
 }
 
-// Equal returns whether two GetNamespaceResponse values are equivalent by recursively
+// Equal returns whether two CancelDLQJobRequest values are equivalent by recursively
 // comparing the message's fields.
 // For more information see the documentation for
 // https://pkg.go.dev/google.golang.org/protobuf/proto#Equal
-func (this *GetNamespaceResponse) Equal(that interface{}) bool {
+func (this *CancelDLQJobRequest) Equal(that interface{}) bool {
 	if that == nil {
 		return this == nil
 	}
 
-	var that1 *GetNamespaceResponse
+	var that1 *CancelDLQJobRequest
 	switch t := that.(type) {
-	case *GetNamespaceResponse:
+	case *CancelDLQJobRequest:
 		that1 = t
-	case GetNamespaceResponse:
+	case CancelDLQJobRequest:
 		that1 = &t
 	default:
 		return false
@@ -2336,35 +3705,35 @@ func (this *GetNamespaceResponse) Equal(that interface{}) bool {
 	return proto.Equal(this, that1)
 }
 
-// Marshal an object of type GetDLQTasksRequest to the protobuf v3 wire format
-func (val *GetDLQTasksRequest) Marshal() ([]byte, error) {
+// Marshal an object of type CancelDLQJobResponse to the protobuf v3 wire format
+func (val *CancelDLQJobResponse) Marshal() ([]byte, error) {
 	return proto.Marshal(val)
 }
 
-// Unmarshal an object of type GetDLQTasksRequest from the protobuf v3 wire format
-func (val *GetDLQTasksRequest) Unmarshal(buf []byte) error {
+// Unmarshal an object of type CancelDLQJobResponse from the protobuf v3 wire format
+func (val *CancelDLQJobResponse) Unmarshal(buf []byte) error {
 	return proto.Unmarshal(buf, val)
 }
 
 // Size returns the size of the object, in bytes, once serialized
-func (val *GetDLQTasksRequest) Size() int {
+func (val *CancelDLQJobResponse) Size() int {
 	return proto.Size(val)
 }
 
-// Equal returns whether two GetDLQTasksRequest values are equivalent by recursively
+// Equal returns whether two CancelDLQJobResponse values are equivalent by recursively
 // comparing the message's fields.
 // For more information see the documentation for
 // https://pkg.go.dev/google.golang.org/protobuf/proto#Equal
-func (this *GetDLQTasksRequest) Equal(that interface{}) bool {
+func (this *CancelDLQJobResponse) Equal(that interface{}) bool {
 	if that == nil {
 		return this == nil
 	}
 
-	var that1 *GetDLQTasksRequest
+	var that1 *CancelDLQJobResponse
 	switch t := that.(type) {
-	case *GetDLQTasksRequest:
+	case *CancelDLQJobResponse:
 		that1 = t
-	case GetDLQTasksRequest:
+	case CancelDLQJobResponse:
 		that1 = &t
 	default:
 		return false
@@ -2373,35 +3742,35 @@ func (this *GetDLQTasksRequest) Equal(that interface{}) bool {
 	return proto.Equal(this, that1)
 }
 
-// Marshal an object of type GetDLQTasksResponse to the protobuf v3 wire format
-func (val *GetDLQTasksResponse) Marshal() ([]byte, error) {
+// Marshal an object of type AddTasksRequest to the protobuf v3 wire format
+func (val *AddTasksRequest) Marshal() ([]byte, error) {
 	return proto.Marshal(val)
 }
 
-// Unmarshal an object of type GetDLQTasksResponse from the protobuf v3 wire format
-func (val *GetDLQTasksResponse) Unmarshal(buf []byte) error {
+// Unmarshal an object of type AddTasksRequest from the protobuf v3 wire format
+func (val *AddTasksRequest) Unmarshal(buf []byte) error {
 	return proto.Unmarshal(buf, val)
 }
 
 // Size returns the size of the object, in bytes, once serialized
-func (val *GetDLQTasksResponse) Size() int {
+func (val *AddTasksRequest) Size() int {
 	return proto.Size(val)
 }
 
-// Equal returns whether two GetDLQTasksResponse values are equivalent by recursively
+// Equal returns whether two AddTasksRequest values are equivalent by recursively
 // comparing the message's fields.
 // For more information see the documentation for
 // https://pkg.go.dev/google.golang.org/protobuf/proto#Equal
-func (this *GetDLQTasksResponse) Equal(that interface{}) bool {
+func (this *AddTasksRequest) Equal(that interface{}) bool {
 	if that == nil {
 		return this == nil
 	}
 
-	var that1 *GetDLQTasksResponse
+	var that1 *AddTasksRequest
 	switch t := that.(type) {
-	case *GetDLQTasksResponse:
+	case *AddTasksRequest:
 		that1 = t
-	case GetDLQTasksResponse:
+	case AddTasksRequest:
 		that1 = &t
 	default:
 		return false
@@ -2410,35 +3779,35 @@ func (this *GetDLQTasksResponse) Equal(that interface{}) bool {
 	return proto.Equal(this, that1)
 }
 
-// Marshal an object of type PurgeDLQTasksRequest to the protobuf v3 wire format
-func (val *PurgeDLQTasksRequest) Marshal() ([]byte, error) {
+// Marshal an object of type AddTasksResponse to the protobuf v3 wire format
+func (val *AddTasksResponse) Marshal() ([]byte, error) {
 	return proto.Marshal(val)
 }
 
-// Unmarshal an object of type PurgeDLQTasksRequest from the protobuf v3 wire format
-func (val *PurgeDLQTasksRequest) Unmarshal(buf []byte) error {
+// Unmarshal an object of type AddTasksResponse from the protobuf v3 wire format
+func (val *AddTasksResponse) Unmarshal(buf []byte) error {
 	return proto.Unmarshal(buf, val)
 }
 
 // Size returns the size of the object, in bytes, once serialized
-func (val *PurgeDLQTasksRequest) Size() int {
+func (val *AddTasksResponse) Size() int {
 	return proto.Size(val)
 }
 
-// Equal returns whether two PurgeDLQTasksRequest values are equivalent by recursively
+// Equal returns whether two AddTasksResponse values are equivalent by recursively
 // comparing the message's fields.
 // For more information see the documentation for
 // https://pkg.go.dev/google.golang.org/protobuf/proto#Equal
-func (this *PurgeDLQTasksRequest) Equal(that interface{}) bool {
+func (this *AddTasksResponse) Equal(that interface{}) bool {
 	if that == nil {
 		return this == nil
 	}
 
-	var that1 *PurgeDLQTasksRequest
+	var that1 *AddTasksResponse
 	switch t := that.(type) {
-	case *PurgeDLQTasksRequest:
+	case *AddTasksResponse:
 		that1 = t
-	case PurgeDLQTasksRequest:
+	case AddTasksResponse:
 		that1 = &t
 	default:
 		return false
@@ -2447,35 +3816,35 @@ func (this *PurgeDLQTasksRequest) Equal(that interface{}) bool {
 	return proto.Equal(this, that1)
 }
 
-// Marshal an object of type PurgeDLQTasksResponse to the protobuf v3 wire format
-func (val *PurgeDLQTasksResponse) Marshal() ([]byte, error) {
+// Marshal an object of type ListQueuesRequest to the protobuf v3 wire format
+func (val *ListQueuesRequest) Marshal() ([]byte, error) {
 	return proto.Marshal(val)
 }
 
-// Unmarshal an object of type PurgeDLQTasksResponse from the protobuf v3 wire format
-func (val *PurgeDLQTasksResponse) Unmarshal(buf []byte) error {
+// Unmarshal an object of type ListQueuesRequest from the protobuf v3 wire format
+func (val *ListQueuesRequest) Unmarshal(buf []byte) error {
 	return proto.Unmarshal(buf, val)
 }
 
 // Size returns the size of the object, in bytes, once serialized
-func (val *PurgeDLQTasksResponse) Size() int {
+func (val *ListQueuesRequest) Size() int {
 	return proto.Size(val)
 }
 
-// Equal returns whether two PurgeDLQTasksResponse values are equivalent by recursively
+// Equal returns whether two ListQueuesRequest values are equivalent by recursively
 // comparing the message's fields.
 // For more information see the documentation for
 // https://pkg.go.dev/google.golang.org/protobuf/proto#Equal
-func (this *PurgeDLQTasksResponse) Equal(that interface{}) bool {
+func (this *ListQueuesRequest) Equal(that interface{}) bool {
 	if that == nil {
 		return this == nil
 	}
 
-	var that1 *PurgeDLQTasksResponse
+	var that1 *ListQueuesRequest
 	switch t := that.(type) {
-	case *PurgeDLQTasksResponse:
+	case *ListQueuesRequest:
 		that1 = t
-	case PurgeDLQTasksResponse:
+	case ListQueuesRequest:
 		that1 = &t
 	default:
 		return false
@@ -2484,35 +3853,35 @@ func (this *PurgeDLQTasksResponse) Equal(that interface{}) bool {
 	return proto.Equal(this, that1)
 }
 
-// Marshal an object of type DLQJobToken to the protobuf v3 wire format
-func (val *DLQJobToken) Marshal() ([]byte, error) {
+// Marshal an object of type ListQueuesResponse to the protobuf v3 wire format
+func (val *ListQueuesResponse) Marshal() ([]byte, error) {
 	return proto.Marshal(val)
 }
 
-// Unmarshal an object of type DLQJobToken from the protobuf v3 wire format
-func (val *DLQJobToken) Unmarshal(buf []byte) error {
+// Unmarshal an object of type ListQueuesResponse from the protobuf v3 wire format
+func (val *ListQueuesResponse) Unmarshal(buf []byte) error {
 	return proto.Unmarshal(buf, val)
 }
 
 // Size returns the size of the object, in bytes, once serialized
-func (val *DLQJobToken) Size() int {
+func (val *ListQueuesResponse) Size() int {
 	return proto.Size(val)
 }
 
-// Equal returns whether two DLQJobToken values are equivalent by recursively
+// Equal returns whether two ListQueuesResponse values are equivalent by recursively
 // comparing the message's fields.
 // For more information see the documentation for
 // https://pkg.go.dev/google.golang.org/protobuf/proto#Equal
-func (this *DLQJobToken) Equal(that interface{}) bool {
+func (this *ListQueuesResponse) Equal(that interface{}) bool {
 	if that == nil {
 		return this == nil
 	}
 
-	var that1 *DLQJobToken
+	var that1 *ListQueuesResponse
 	switch t := that.(type) {
-	case *DLQJobToken:
+	case *ListQueuesResponse:
 		that1 = t
-	case DLQJobToken:
+	case ListQueuesResponse:
 		that1 = &t
 	default:
 		return false
@@ -2521,35 +3890,35 @@ func (this *DLQJobToken) Equal(that interface{}) bool {
 	return proto.Equal(this, that1)
 }
 
-// Marshal an object of type MergeDLQTasksRequest to the protobuf v3 wire format
-func (val *MergeDLQTasksRequest) Marshal() ([]byte, error) {
+// Marshal an object of type DeepHealthCheckRequest to the protobuf v3 wire format
+func (val *DeepHealthCheckRequest) Marshal() ([]byte, error) {
 	return proto.Marshal(val)
 }
 
-// Unmarshal an object of type MergeDLQTasksRequest from the protobuf v3 wire format
-func (val *MergeDLQTasksRequest) Unmarshal(buf []byte) error {
+// Unmarshal an object of type DeepHealthCheckRequest from the protobuf v3 wire format
+func (val *DeepHealthCheckRequest) Unmarshal(buf []byte) error {
 	return proto.Unmarshal(buf, val)
 }
 
 // Size returns the size of the object, in bytes, once serialized
-func (val *MergeDLQTasksRequest) Size() int {
+func (val *DeepHealthCheckRequest) Size() int {
 	return proto.Size(val)
 }
 
-// Equal returns whether two MergeDLQTasksRequest values are equivalent by recursively
+// Equal returns whether two DeepHealthCheckRequest values are equivalent by recursively
 // comparing the message's fields.
 // For more information see the documentation for
 // https://pkg.go.dev/google.golang.org/protobuf/proto#Equal
-func (this *MergeDLQTasksRequest) Equal(that interface{}) bool {
+func (this *DeepHealthCheckRequest) Equal(that interface{}) bool {
 	if that == nil {
 		return this == nil
 	}
 
-	var that1 *MergeDLQTasksRequest
+	var that1 *DeepHealthCheckRequest
 	switch t := that.(type) {
-	case *MergeDLQTasksRequest:
+	case *DeepHealthCheckRequest:
 		that1 = t
-	case MergeDLQTasksRequest:
+	case DeepHealthCheckRequest:
 		that1 = &t
 	default:
 		return false
@@ -2558,35 +3927,35 @@ func (this *MergeDLQTasksRequest) Equal(that interface{}) bool {
 	return proto.Equal(this, that1)
 }
 
-// Marshal an object of type MergeDLQTasksResponse to the protobuf v3 wire format
-func (val *MergeDLQTasksResponse) Marshal() ([]byte, error) {
+// Marshal an object of type DeepHealthCheckResponse to the protobuf v3 wire format
+func (val *DeepHealthCheckResponse) Marshal() ([]byte, error) {
 	return proto.Marshal(val)
 }
 
-// Unmarshal an object of type MergeDLQTasksResponse from the protobuf v3 wire format
-func (val *MergeDLQTasksResponse) Unmarshal(buf []byte) error {
+// Unmarshal an object of type DeepHealthCheckResponse from the protobuf v3 wire format
+func (val *DeepHealthCheckResponse) Unmarshal(buf []byte) error {
 	return proto.Unmarshal(buf, val)
 }
 
 // Size returns the size of the object, in bytes, once serialized
-func (val *MergeDLQTasksResponse) Size() int {
+func (val *DeepHealthCheckResponse) Size() int {
 	return proto.Size(val)
 }
 
-// Equal returns whether two MergeDLQTasksResponse values are equivalent by recursively
+// Equal returns whether two DeepHealthCheckResponse values are equivalent by recursively
 // comparing the message's fields.
 // For more information see the documentation for
 // https://pkg.go.dev/google.golang.org/protobuf/proto#Equal
-func (this *MergeDLQTasksResponse) Equal(that interface{}) bool {
+func (this *DeepHealthCheckResponse) Equal(that interface{}) bool {
 	if that == nil {
 		return this == nil
 	}
 
-	var that1 *MergeDLQTasksResponse
+	var that1 *DeepHealthCheckResponse
 	switch t := that.(type) {
-	case *MergeDLQTasksResponse:
+	case *DeepHealthCheckResponse:
 		that1 = t
-	case MergeDLQTasksResponse:
+	case DeepHealthCheckResponse:
 		that1 = &t
 	default:
 		return false
@@ -2595,35 +3964,35 @@ func (this *MergeDLQTasksResponse) Equal(that interface{}) bool {
 	return proto.Equal(this, that1)
 }
 
-// Marshal an object of type DescribeDLQJobRequest to the protobuf v3 wire format
-func (val *DescribeDLQJobRequest) Marshal() ([]byte, error) {
+// Marshal an object of type SyncWorkflowStateRequest to the protobuf v3 wire format
+func (val *SyncWorkflowStateRequest) Marshal() ([]byte, error) {
 	return proto.Marshal(val)
 }
 
-// Unmarshal an object of type DescribeDLQJobRequest from the protobuf v3 wire format
-func (val *DescribeDLQJobRequest) Unmarshal(buf []byte) error {
+// Unmarshal an object of type SyncWorkflowStateRequest from the protobuf v3 wire format
+func (val *SyncWorkflowStateRequest) Unmarshal(buf []byte) error {
 	return proto.Unmarshal(buf, val)
 }
 
 // Size returns the size of the object, in bytes, once serialized
-func (val *DescribeDLQJobRequest) Size() int {
+func (val *SyncWorkflowStateRequest) Size() int {
 	return proto.Size(val)
 }
 
-// Equal returns whether two DescribeDLQJobRequest values are equivalent by recursively
+// Equal returns whether two SyncWorkflowStateRequest values are equivalent by recursively
 // comparing the message's fields.
 // For more information see the documentation for
 // https://pkg.go.dev/google.golang.org/protobuf/proto#Equal
-func (this *DescribeDLQJobRequest) Equal(that interface{}) bool {
+func (this *SyncWorkflowStateRequest) Equal(that interface{}) bool {
 	if that == nil {
 		return this == nil
 	}
 
-	var that1 *DescribeDLQJobRequest
+	var that1 *SyncWorkflowStateRequest
 	switch t := that.(type) {
-	case *DescribeDLQJobRequest:
+	case *SyncWorkflowStateRequest:
 		that1 = t
-	case DescribeDLQJobRequest:
+	case SyncWorkflowStateRequest:
 		that1 = &t
 	default:
 		return false
@@ -2632,35 +4001,35 @@ func (this *DescribeDLQJobRequest) Equal(that interface{}) bool {
 	return proto.Equal(this, that1)
 }
 
-// Marshal an object of type DescribeDLQJobResponse to the protobuf v3 wire format
-func (val *DescribeDLQJobResponse) Marshal() ([]byte, error) {
+// Marshal an object of type SyncWorkflowStateResponse to the protobuf v3 wire format
+func (val *SyncWorkflowStateResponse) Marshal() ([]byte, error) {
 	return proto.Marshal(val)
 }
 
-// Unmarshal an object of type DescribeDLQJobResponse from the protobuf v3 wire format
-func (val *DescribeDLQJobResponse) Unmarshal(buf []byte) error {
+// Unmarshal an object of type SyncWorkflowStateResponse from the protobuf v3 wire format
+func (val *SyncWorkflowStateResponse) Unmarshal(buf []byte) error {
 	return proto.Unmarshal(buf, val)
 }
 
 // Size returns the size of the object, in bytes, once serialized
-func (val *DescribeDLQJobResponse) Size() int {
+func (val *SyncWorkflowStateResponse) Size() int {
 	return proto.Size(val)
 }
 
-// Equal returns whether two DescribeDLQJobResponse values are equivalent by recursively
+// Equal returns whether two SyncWorkflowStateResponse values are equivalent by recursively
 // comparing the message's fields.
 // For more information see the documentation for
 // https://pkg.go.dev/google.golang.org/protobuf/proto#Equal
-func (this *DescribeDLQJobResponse) Equal(that interface{}) bool {
+func (this *SyncWorkflowStateResponse) Equal(that interface{}) bool {
 	if that == nil {
 		return this == nil
 	}
 
-	var that1 *DescribeDLQJobResponse
+	var that1 *SyncWorkflowStateResponse
 	switch t := that.(type) {
-	case *DescribeDLQJobResponse:
+	case *SyncWorkflowStateResponse:
 		that1 = t
-	case DescribeDLQJobResponse:
+	case SyncWorkflowStateResponse:
 		that1 = &t
 	default:
 		return false
@@ -2669,35 +4038,35 @@ func (this *DescribeDLQJobResponse) Equal(that interface{}) bool {
 	return proto.Equal(this, that1)
 }
 
-// Marshal an object of type CancelDLQJobRequest to the protobuf v3 wire format
-func (val *CancelDLQJobRequest) Marshal() ([]byte, error) {
+// Marshal an object of type GenerateLastHistoryReplicationTasksRequest to the protobuf v3 wire format
+func (val *GenerateLastHistoryReplicationTasksRequest) Marshal() ([]byte, error) {
 	return proto.Marshal(val)
 }
 
-// Unmarshal an object of type CancelDLQJobRequest from the protobuf v3 wire format
-func (val *CancelDLQJobRequest) Unmarshal(buf []byte) error {
+// Unmarshal an object of type GenerateLastHistoryReplicationTasksRequest from the protobuf v3 wire format
+func (val *GenerateLastHistoryReplicationTasksRequest) Unmarshal(buf []byte) error {
 	return proto.Unmarshal(buf, val)
 }
 
 // Size returns the size of the object, in bytes, once serialized
-func (val *CancelDLQJobRequest) Size() int {
+func (val *GenerateLastHistoryReplicationTasksRequest) Size() int {
 	return proto.Size(val)
 }
 
-// Equal returns whether two CancelDLQJobRequest values are equivalent by recursively
+// Equal returns whether two GenerateLastHistoryReplicationTasksRequest values are equivalent by recursively
 // comparing the message's fields.
 // For more information see the documentation for
 // https://pkg.go.dev/google.golang.org/protobuf/proto#Equal
-func (this *CancelDLQJobRequest) Equal(that interface{}) bool {
+func (this *GenerateLastHistoryReplicationTasksRequest) Equal(that interface{}) bool {
 	if that == nil {
 		return this == nil
 	}
 
-	var that1 *CancelDLQJobRequest
+	var that1 *GenerateLastHistoryReplicationTasksRequest
 	switch t := that.(type) {
-	case *CancelDLQJobRequest:
+	case *GenerateLastHistoryReplicationTasksRequest:
 		that1 = t
-	case CancelDLQJobRequest:
+	case GenerateLastHistoryReplicationTasksRequest:
 		that1 = &t
 	default:
 		return false
@@ -2706,35 +4075,35 @@ func (this *CancelDLQJobRequest) Equal(that interface{}) bool {
 	return proto.Equal(this, that1)
 }
 
-// Marshal an object of type CancelDLQJobResponse to the protobuf v3 wire format
-func (val *CancelDLQJobResponse) Marshal() ([]byte, error) {
+// Marshal an object of type GenerateLastHistoryReplicationTasksResponse to the protobuf v3 wire format
+func (val *GenerateLastHistoryReplicationTasksResponse) Marshal() ([]byte, error) {
 	return proto.Marshal(val)
 }
 
-// Unmarshal an object of type CancelDLQJobResponse from the protobuf v3 wire format
-func (val *CancelDLQJobResponse) Unmarshal(buf []byte) error {
+// Unmarshal an object of type GenerateLastHistoryReplicationTasksResponse from the protobuf v3 wire format
+func (val *GenerateLastHistoryReplicationTasksResponse) Unmarshal(buf []byte) error {
 	return proto.Unmarshal(buf, val)
 }
 
 // Size returns the size of the object, in bytes, once serialized
-func (val *CancelDLQJobResponse) Size() int {
+func (val *GenerateLastHistoryReplicationTasksResponse) Size() int {
 	return proto.Size(val)
 }
 
-// Equal returns whether two CancelDLQJobResponse values are equivalent by recursively
+// Equal returns whether two GenerateLastHistoryReplicationTasksResponse values are equivalent by recursively
 // comparing the message's fields.
 // For more information see the documentation for
 // https://pkg.go.dev/google.golang.org/protobuf/proto#Equal
-func (this *CancelDLQJobResponse) Equal(that interface{}) bool {
+func (this *GenerateLastHistoryReplicationTasksResponse) Equal(that interface{}) bool {
 	if that == nil {
 		return this == nil
 	}
 
-	var that1 *CancelDLQJobResponse
+	var that1 *GenerateLastHistoryReplicationTasksResponse
 	switch t := that.(type) {
-	case *CancelDLQJobResponse:
+	case *GenerateLastHistoryReplicationTasksResponse:
 		that1 = t
-	case CancelDLQJobResponse:
+	case GenerateLastHistoryReplicationTasksResponse:
 		that1 = &t
 	default:
 		return false
@@ -2743,35 +4112,35 @@ func (this *CancelDLQJobResponse) Equal(that interface{}) bool {
 	return proto.Equal(this, that1)
 }
 
-// Marshal an object of type AddTasksRequest to the protobuf v3 wire format
-func (val *AddTasksRequest) Marshal() ([]byte, error) {
+// Marshal an object of type DescribeTaskQueuePartitionRequest to the protobuf v3 wire format
+func (val *DescribeTaskQueuePartitionRequest) Marshal() ([]byte, error) {
 	return proto.Marshal(val)
 }
 
-// Unmarshal an object of type AddTasksRequest from the protobuf v3 wire format
-func (val *AddTasksRequest) Unmarshal(buf []byte) error {
+// Unmarshal an object of type DescribeTaskQueuePartitionRequest from the protobuf v3 wire format
+func (val *DescribeTaskQueuePartitionRequest) Unmarshal(buf []byte) error {
 	return proto.Unmarshal(buf, val)
 }
 
 // Size returns the size of the object, in bytes, once serialized
-func (val *AddTasksRequest) Size() int {
+func (val *DescribeTaskQueuePartitionRequest) Size() int {
 	return proto.Size(val)
 }
 
-// Equal returns whether two AddTasksRequest values are equivalent by recursively
+// Equal returns whether two DescribeTaskQueuePartitionRequest values are equivalent by recursively
 // comparing the message's fields.
 // For more information see the documentation for
 // https://pkg.go.dev/google.golang.org/protobuf/proto#Equal
-func (this *AddTasksRequest) Equal(that interface{}) bool {
+func (this *DescribeTaskQueuePartitionRequest) Equal(that interface{}) bool {
 	if that == nil {
 		return this == nil
 	}
 
-	var that1 *AddTasksRequest
+	var that1 *DescribeTaskQueuePartitionRequest
 	switch t := that.(type) {
-	case *AddTasksRequest:
+	case *DescribeTaskQueuePartitionRequest:
 		that1 = t
-	case AddTasksRequest:
+	case DescribeTaskQueuePartitionRequest:
 		that1 = &t
 	default:
 		return false
@@ -2780,35 +4149,35 @@ func (this *AddTasksRequest) Equal(that interface{}) bool {
 	return proto.Equal(this, that1)
 }
 
-// Marshal an object of type AddTasksResponse to the protobuf v3 wire format
-func (val *AddTasksResponse) Marshal() ([]byte, error) {
+// Marshal an object of type InternalTaskQueueStatus to the protobuf v3 wire format
+func (val *InternalTaskQueueStatus) Marshal() ([]byte, error) {
 	return proto.Marshal(val)
 }
 
-// Unmarshal an object of type AddTasksResponse from the protobuf v3 wire format
-func (val *AddTasksResponse) Unmarshal(buf []byte) error {
+// Unmarshal an object of type InternalTaskQueueStatus from the protobuf v3 wire format
+func (val *InternalTaskQueueStatus) Unmarshal(buf []byte) error {
 	return proto.Unmarshal(buf, val)
 }
 
 // Size returns the size of the object, in bytes, once serialized
-func (val *AddTasksResponse) Size() int {
+func (val *InternalTaskQueueStatus) Size() int {
 	return proto.Size(val)
 }
 
-// Equal returns whether two AddTasksResponse values are equivalent by recursively
+// Equal returns whether two InternalTaskQueueStatus values are equivalent by recursively
 // comparing the message's fields.
 // For more information see the documentation for
 // https://pkg.go.dev/google.golang.org/protobuf/proto#Equal
-func (this *AddTasksResponse) Equal(that interface{}) bool {
+func (this *InternalTaskQueueStatus) Equal(that interface{}) bool {
 	if that == nil {
 		return this == nil
 	}
 
-	var that1 *AddTasksResponse
+	var that1 *InternalTaskQueueStatus
 	switch t := that.(type) {
-	case *AddTasksResponse:
+	case *InternalTaskQueueStatus:
 		that1 = t
-	case AddTasksResponse:
+	case InternalTaskQueueStatus:
 		that1 = &t
 	default:
 		return false
@@ -2817,35 +4186,35 @@ func (this *AddTasksResponse) Equal(that interface{}) bool {
 	return proto.Equal(this, that1)
 }
 
-// Marshal an object of type ListQueuesRequest to the protobuf v3 wire format
-func (val *ListQueuesRequest) Marshal() ([]byte, error) {
+// Marshal an object of type DescribeTaskQueuePartitionResponse to the protobuf v3 wire format
+func (val *DescribeTaskQueuePartitionResponse) Marshal() ([]byte, error) {
 	return proto.Marshal(val)
 }
 
-// Unmarshal an object of type ListQueuesRequest from the protobuf v3 wire format
-func (val *ListQueuesRequest) Unmarshal(buf []byte) error {
+// Unmarshal an object of type DescribeTaskQueuePartitionResponse from the protobuf v3 wire format
+func (val *DescribeTaskQueuePartitionResponse) Unmarshal(buf []byte) error {
 	return proto.Unmarshal(buf, val)
 }
 
 // Size returns the size of the object, in bytes, once serialized
-func (val *ListQueuesRequest) Size() int {
+func (val *DescribeTaskQueuePartitionResponse) Size() int {
 	return proto.Size(val)
 }
 
-// Equal returns whether two ListQueuesRequest values are equivalent by recursively
+// Equal returns whether two DescribeTaskQueuePartitionResponse values are equivalent by recursively
 // comparing the message's fields.
 // For more information see the documentation for
 // https://pkg.go.dev/google.golang.org/protobuf/proto#Equal
-func (this *ListQueuesRequest) Equal(that interface{}) bool {
+func (this *DescribeTaskQueuePartitionResponse) Equal(that interface{}) bool {
 	if that == nil {
 		return this == nil
 	}
 
-	var that1 *ListQueuesRequest
+	var that1 *DescribeTaskQueuePartitionResponse
 	switch t := that.(type) {
-	case *ListQueuesRequest:
+	case *DescribeTaskQueuePartitionResponse:
 		that1 = t
-	case ListQueuesRequest:
+	case DescribeTaskQueuePartitionResponse:
 		that1 = &t
 	default:
 		return false
@@ -2854,35 +4223,35 @@ func (this *ListQueuesRequest) Equal(that interface{}) bool {
 	return proto.Equal(this, that1)
 }
 
-// Marshal an object of type ListQueuesResponse to the protobuf v3 wire format
-func (val *ListQueuesResponse) Marshal() ([]byte, error) {
+// Marshal an object of type ForceUnloadTaskQueuePartitionRequest to the protobuf v3 wire format
+func (val *ForceUnloadTaskQueuePartitionRequest) Marshal() ([]byte, error) {
 	return proto.Marshal(val)
 }
 
-// Unmarshal an object of type ListQueuesResponse from the protobuf v3 wire format
-func (val *ListQueuesResponse) Unmarshal(buf []byte) error {
+// Unmarshal an object of type ForceUnloadTaskQueuePartitionRequest from the protobuf v3 wire format
+func (val *ForceUnloadTaskQueuePartitionRequest) Unmarshal(buf []byte) error {
 	return proto.Unmarshal(buf, val)
 }
 
 // Size returns the size of the object, in bytes, once serialized
-func (val *ListQueuesResponse) Size() int {
+func (val *ForceUnloadTaskQueuePartitionRequest) Size() int {
 	return proto.Size(val)
 }
 
-// Equal returns whether two ListQueuesResponse values are equivalent by recursively
+// Equal returns whether two ForceUnloadTaskQueuePartitionRequest values are equivalent by recursively
 // comparing the message's fields.
 // For more information see the documentation for
 // https://pkg.go.dev/google.golang.org/protobuf/proto#Equal
-func (this *ListQueuesResponse) Equal(that interface{}) bool {
+func (this *ForceUnloadTaskQueuePartitionRequest) Equal(that interface{}) bool {
 	if that == nil {
 		return this == nil
 	}
 
-	var that1 *ListQueuesResponse
+	var that1 *ForceUnloadTaskQueuePartitionRequest
 	switch t := that.(type) {
-	case *ListQueuesResponse:
+	case *ForceUnloadTaskQueuePartitionRequest:
 		that1 = t
-	case ListQueuesResponse:
+	case ForceUnloadTaskQueuePartitionRequest:
 		that1 = &t
 	default:
 		return false
@@ -2891,35 +4260,35 @@ func (this *ListQueuesResponse) Equal(that interface{}) bool {
 	return proto.Equal(this, that1)
 }
 
-// Marshal an object of type DeepHealthCheckRequest to the protobuf v3 wire format
-func (val *DeepHealthCheckRequest) Marshal() ([]byte, error) {
+// Marshal an object of type ForceUnloadTaskQueuePartitionResponse to the protobuf v3 wire format
+func (val *ForceUnloadTaskQueuePartitionResponse) Marshal() ([]byte, error) {
 	return proto.Marshal(val)
 }
 
-// Unmarshal an object of type DeepHealthCheckRequest from the protobuf v3 wire format
-func (val *DeepHealthCheckRequest) Unmarshal(buf []byte) error {
+// Unmarshal an object of type ForceUnloadTaskQueuePartitionResponse from the protobuf v3 wire format
+func (val *ForceUnloadTaskQueuePartitionResponse) Unmarshal(buf []byte) error {
 	return proto.Unmarshal(buf, val)
 }
 
 // Size returns the size of the object, in bytes, once serialized
-func (val *DeepHealthCheckRequest) Size() int {
+func (val *ForceUnloadTaskQueuePartitionResponse) Size() int {
 	return proto.Size(val)
 }
 
-// Equal returns whether two DeepHealthCheckRequest values are equivalent by recursively
+// Equal returns whether two ForceUnloadTaskQueuePartitionResponse values are equivalent by recursively
 // comparing the message's fields.
 // For more information see the documentation for
 // https://pkg.go.dev/google.golang.org/protobuf/proto#Equal
-func (this *DeepHealthCheckRequest) Equal(that interface{}) bool {
+func (this *ForceUnloadTaskQueuePartitionResponse) Equal(that interface{}) bool {
 	if that == nil {
 		return this == nil
 	}
 
-	var that1 *DeepHealthCheckRequest
+	var that1 *ForceUnloadTaskQueuePartitionResponse
 	switch t := that.(type) {
-	case *DeepHealthCheckRequest:
+	case *ForceUnloadTaskQueuePartitionResponse:
 		that1 = t
-	case DeepHealthCheckRequest:
+	case ForceUnloadTaskQueuePartitionResponse:
 		that1 = &t
 	default:
 		return false
@@ -2928,35 +4297,35 @@ func (this *DeepHealthCheckRequest) Equal(that interface{}) bool {
 	return proto.Equal(this, that1)
 }
 
-// Marshal an object of type DeepHealthCheckResponse to the protobuf v3 wire format
-func (val *DeepHealthCheckResponse) Marshal() ([]byte, error) {
+// Marshal an object of type PauseTaskQueueDispatchRequest to the protobuf v3 wire format
+func (val *PauseTaskQueueDispatchRequest) Marshal() ([]byte, error) {
 	return proto.Marshal(val)
 }
 
-// Unmarshal an object of type DeepHealthCheckResponse from the protobuf v3 wire format
-func (val *DeepHealthCheckResponse) Unmarshal(buf []byte) error {
+// Unmarshal an object of type PauseTaskQueueDispatchRequest from the protobuf v3 wire format
+func (val *PauseTaskQueueDispatchRequest) Unmarshal(buf []byte) error {
 	return proto.Unmarshal(buf, val)
 }
 
 // Size returns the size of the object, in bytes, once serialized
-func (val *DeepHealthCheckResponse) Size() int {
+func (val *PauseTaskQueueDispatchRequest) Size() int {
 	return proto.Size(val)
 }
 
-// Equal returns whether two DeepHealthCheckResponse values are equivalent by recursively
+// Equal returns whether two PauseTaskQueueDispatchRequest values are equivalent by recursively
 // comparing the message's fields.
 // For more information see the documentation for
 // https://pkg.go.dev/google.golang.org/protobuf/proto#Equal
-func (this *DeepHealthCheckResponse) Equal(that interface{}) bool {
+func (this *PauseTaskQueueDispatchRequest) Equal(that interface{}) bool {
 	if that == nil {
 		return this == nil
 	}
 
-	var that1 *DeepHealthCheckResponse
+	var that1 *PauseTaskQueueDispatchRequest
 	switch t := that.(type) {
-	case *DeepHealthCheckResponse:
+	case *PauseTaskQueueDispatchRequest:
 		that1 = t
-	case DeepHealthCheckResponse:
+	case PauseTaskQueueDispatchRequest:
 		that1 = &t
 	default:
 		return false
@@ -2965,35 +4334,35 @@ func (this *DeepHealthCheckResponse) Equal(that interface{}) bool {
 	return proto.Equal(this, that1)
 }
 
-// Marshal an object of type SyncWorkflowStateRequest to the protobuf v3 wire format
-func (val *SyncWorkflowStateRequest) Marshal() ([]byte, error) {
+// Marshal an object of type PauseTaskQueueDispatchResponse to the protobuf v3 wire format
+func (val *PauseTaskQueueDispatchResponse) Marshal() ([]byte, error) {
 	return proto.Marshal(val)
 }
 
-// Unmarshal an object of type SyncWorkflowStateRequest from the protobuf v3 wire format
-func (val *SyncWorkflowStateRequest) Unmarshal(buf []byte) error {
+// Unmarshal an object of type PauseTaskQueueDispatchResponse from the protobuf v3 wire format
+func (val *PauseTaskQueueDispatchResponse) Unmarshal(buf []byte) error {
 	return proto.Unmarshal(buf, val)
 }
 
 // Size returns the size of the object, in bytes, once serialized
-func (val *SyncWorkflowStateRequest) Size() int {
+func (val *PauseTaskQueueDispatchResponse) Size() int {
 	return proto.Size(val)
 }
 
-// Equal returns whether two SyncWorkflowStateRequest values are equivalent by recursively
+// Equal returns whether two PauseTaskQueueDispatchResponse values are equivalent by recursively
 // comparing the message's fields.
 // For more information see the documentation for
 // https://pkg.go.dev/google.golang.org/protobuf/proto#Equal
-func (this *SyncWorkflowStateRequest) Equal(that interface{}) bool {
+func (this *PauseTaskQueueDispatchResponse) Equal(that interface{}) bool {
 	if that == nil {
 		return this == nil
 	}
 
-	var that1 *SyncWorkflowStateRequest
+	var that1 *PauseTaskQueueDispatchResponse
 	switch t := that.(type) {
-	case *SyncWorkflowStateRequest:
+	case *PauseTaskQueueDispatchResponse:
 		that1 = t
-	case SyncWorkflowStateRequest:
+	case PauseTaskQueueDispatchResponse:
 		that1 = &t
 	default:
 		return false
@@ -3002,35 +4371,35 @@ func (this *SyncWorkflowStateRequest) Equal(that interface{}) bool {
 	return proto.Equal(this, that1)
 }
 
-// Marshal an object of type SyncWorkflowStateResponse to the protobuf v3 wire format
-func (val *SyncWorkflowStateResponse) Marshal() ([]byte, error) {
+// Marshal an object of type ResumeTaskQueueDispatchRequest to the protobuf v3 wire format
+func (val *ResumeTaskQueueDispatchRequest) Marshal() ([]byte, error) {
 	return proto.Marshal(val)
 }
 
-// Unmarshal an object of type SyncWorkflowStateResponse from the protobuf v3 wire format
-func (val *SyncWorkflowStateResponse) Unmarshal(buf []byte) error {
+// Unmarshal an object of type ResumeTaskQueueDispatchRequest from the protobuf v3 wire format
+func (val *ResumeTaskQueueDispatchRequest) Unmarshal(buf []byte) error {
 	return proto.Unmarshal(buf, val)
 }
 
 // Size returns the size of the object, in bytes, once serialized
-func (val *SyncWorkflowStateResponse) Size() int {
+func (val *ResumeTaskQueueDispatchRequest) Size() int {
 	return proto.Size(val)
 }
 
-// Equal returns whether two SyncWorkflowStateResponse values are equivalent by recursively
+// Equal returns whether two ResumeTaskQueueDispatchRequest values are equivalent by recursively
 // comparing the message's fields.
 // For more information see the documentation for
 // https://pkg.go.dev/google.golang.org/protobuf/proto#Equal
-func (this *SyncWorkflowStateResponse) Equal(that interface{}) bool {
+func (this *ResumeTaskQueueDispatchRequest) Equal(that interface{}) bool {
 	if that == nil {
 		return this == nil
 	}
 
-	var that1 *SyncWorkflowStateResponse
+	var that1 *ResumeTaskQueueDispatchRequest
 	switch t := that.(type) {
-	case *SyncWorkflowStateResponse:
+	case *ResumeTaskQueueDispatchRequest:
 		that1 = t
-	case SyncWorkflowStateResponse:
+	case ResumeTaskQueueDispatchRequest:
 		that1 = &t
 	default:
 		return false
@@ -3039,35 +4408,35 @@ func (this *SyncWorkflowStateResponse) Equal(that interface{}) bool {
 	return proto.Equal(this, that1)
 }
 
-// Marshal an object of type GenerateLastHistoryReplicationTasksRequest to the protobuf v3 wire format
-func (val *GenerateLastHistoryReplicationTasksRequest) Marshal() ([]byte, error) {
+// Marshal an object of type ResumeTaskQueueDispatchResponse to the protobuf v3 wire format
+func (val *ResumeTaskQueueDispatchResponse) Marshal() ([]byte, error) {
 	return proto.Marshal(val)
 }
 
-// Unmarshal an object of type GenerateLastHistoryReplicationTasksRequest from the protobuf v3 wire format
-func (val *GenerateLastHistoryReplicationTasksRequest) Unmarshal(buf []byte) error {
+// Unmarshal an object of type ResumeTaskQueueDispatchResponse from the protobuf v3 wire format
+func (val *ResumeTaskQueueDispatchResponse) Unmarshal(buf []byte) error {
 	return proto.Unmarshal(buf, val)
 }
 
 // Size returns the size of the object, in bytes, once serialized
-func (val *GenerateLastHistoryReplicationTasksRequest) Size() int {
+func (val *ResumeTaskQueueDispatchResponse) Size() int {
 	return proto.Size(val)
 }
 
-// Equal returns whether two GenerateLastHistoryReplicationTasksRequest values are equivalent by recursively
+// Equal returns whether two ResumeTaskQueueDispatchResponse values are equivalent by recursively
 // comparing the message's fields.
 // For more information see the documentation for
 // https://pkg.go.dev/google.golang.org/protobuf/proto#Equal
-func (this *GenerateLastHistoryReplicationTasksRequest) Equal(that interface{}) bool {
+func (this *ResumeTaskQueueDispatchResponse) Equal(that interface{}) bool {
 	if that == nil {
 		return this == nil
 	}
 
-	var that1 *GenerateLastHistoryReplicationTasksRequest
+	var that1 *ResumeTaskQueueDispatchResponse
 	switch t := that.(type) {
-	case *GenerateLastHistoryReplicationTasksRequest:
+	case *ResumeTaskQueueDispatchResponse:
 		that1 = t
-	case GenerateLastHistoryReplicationTasksRequest:
+	case ResumeTaskQueueDispatchResponse:
 		that1 = &t
 	default:
 		return false
@@ -3076,35 +4445,35 @@ func (this *GenerateLastHistoryReplicationTasksRequest) Equal(that interface{})
 	return proto.Equal(this, that1)
 }
 
-// Marshal an object of type GenerateLastHistoryReplicationTasksResponse to the protobuf v3 wire format
-func (val *GenerateLastHistoryReplicationTasksResponse) Marshal() ([]byte, error) {
+// Marshal an object of type ListTaskQueueBacklogTasksRequest to the protobuf v3 wire format
+func (val *ListTaskQueueBacklogTasksRequest) Marshal() ([]byte, error) {
 	return proto.Marshal(val)
 }
 
-// Unmarshal an object of type GenerateLastHistoryReplicationTasksResponse from the protobuf v3 wire format
-func (val *GenerateLastHistoryReplicationTasksResponse) Unmarshal(buf []byte) error {
+// Unmarshal an object of type ListTaskQueueBacklogTasksRequest from the protobuf v3 wire format
+func (val *ListTaskQueueBacklogTasksRequest) Unmarshal(buf []byte) error {
 	return proto.Unmarshal(buf, val)
 }
 
 // Size returns the size of the object, in bytes, once serialized
-func (val *GenerateLastHistoryReplicationTasksResponse) Size() int {
+func (val *ListTaskQueueBacklogTasksRequest) Size() int {
 	return proto.Size(val)
 }
 
-// Equal returns whether two GenerateLastHistoryReplicationTasksResponse values are equivalent by recursively
+// Equal returns whether two ListTaskQueueBacklogTasksRequest values are equivalent by recursively
 // comparing the message's fields.
 // For more information see the documentation for
 // https://pkg.go.dev/google.golang.org/protobuf/proto#Equal
-func (this *GenerateLastHistoryReplicationTasksResponse) Equal(that interface{}) bool {
+func (this *ListTaskQueueBacklogTasksRequest) Equal(that interface{}) bool {
 	if that == nil {
 		return this == nil
 	}
 
-	var that1 *GenerateLastHistoryReplicationTasksResponse
+	var that1 *ListTaskQueueBacklogTasksRequest
 	switch t := that.(type) {
-	case *GenerateLastHistoryReplicationTasksResponse:
+	case *ListTaskQueueBacklogTasksRequest:
 		that1 = t
-	case GenerateLastHistoryReplicationTasksResponse:
+	case ListTaskQueueBacklogTasksRequest:
 		that1 = &t
 	default:
 		return false
@@ -3113,35 +4482,35 @@ func (this *GenerateLastHistoryReplicationTasksResponse) Equal(that interface{})
 	return proto.Equal(this, that1)
 }
 
-// Marshal an object of type DescribeTaskQueuePartitionRequest to the protobuf v3 wire format
-func (val *DescribeTaskQueuePartitionRequest) Marshal() ([]byte, error) {
+// Marshal an object of type ListTaskQueueBacklogTasksResponse to the protobuf v3 wire format
+func (val *ListTaskQueueBacklogTasksResponse) Marshal() ([]byte, error) {
 	return proto.Marshal(val)
 }
 
-// Unmarshal an object of type DescribeTaskQueuePartitionRequest from the protobuf v3 wire format
-func (val *DescribeTaskQueuePartitionRequest) Unmarshal(buf []byte) error {
+// Unmarshal an object of type ListTaskQueueBacklogTasksResponse from the protobuf v3 wire format
+func (val *ListTaskQueueBacklogTasksResponse) Unmarshal(buf []byte) error {
 	return proto.Unmarshal(buf, val)
 }
 
 // Size returns the size of the object, in bytes, once serialized
-func (val *DescribeTaskQueuePartitionRequest) Size() int {
+func (val *ListTaskQueueBacklogTasksResponse) Size() int {
 	return proto.Size(val)
 }
 
-// Equal returns whether two DescribeTaskQueuePartitionRequest values are equivalent by recursively
+// Equal returns whether two ListTaskQueueBacklogTasksResponse values are equivalent by recursively
 // comparing the message's fields.
 // For more information see the documentation for
 // https://pkg.go.dev/google.golang.org/protobuf/proto#Equal
-func (this *DescribeTaskQueuePartitionRequest) Equal(that interface{}) bool {
+func (this *ListTaskQueueBacklogTasksResponse) Equal(that interface{}) bool {
 	if that == nil {
 		return this == nil
 	}
 
-	var that1 *DescribeTaskQueuePartitionRequest
+	var that1 *ListTaskQueueBacklogTasksResponse
 	switch t := that.(type) {
-	case *DescribeTaskQueuePartitionRequest:
+	case *ListTaskQueueBacklogTasksResponse:
 		that1 = t
-	case DescribeTaskQueuePartitionRequest:
+	case ListTaskQueueBacklogTasksResponse:
 		that1 = &t
 	default:
 		return false
@@ -3150,35 +4519,35 @@ func (this *DescribeTaskQueuePartitionRequest) Equal(that interface{}) bool {
 	return proto.Equal(this, that1)
 }
 
-// Marshal an object of type InternalTaskQueueStatus to the protobuf v3 wire format
-func (val *InternalTaskQueueStatus) Marshal() ([]byte, error) {
+// Marshal an object of type DeleteTaskQueueBacklogTasksRequest to the protobuf v3 wire format
+func (val *DeleteTaskQueueBacklogTasksRequest) Marshal() ([]byte, error) {
 	return proto.Marshal(val)
 }
 
-// Unmarshal an object of type InternalTaskQueueStatus from the protobuf v3 wire format
-func (val *InternalTaskQueueStatus) Unmarshal(buf []byte) error {
+// Unmarshal an object of type DeleteTaskQueueBacklogTasksRequest from the protobuf v3 wire format
+func (val *DeleteTaskQueueBacklogTasksRequest) Unmarshal(buf []byte) error {
 	return proto.Unmarshal(buf, val)
 }
 
 // Size returns the size of the object, in bytes, once serialized
-func (val *InternalTaskQueueStatus) Size() int {
+func (val *DeleteTaskQueueBacklogTasksRequest) Size() int {
 	return proto.Size(val)
 }
 
-// Equal returns whether two InternalTaskQueueStatus values are equivalent by recursively
+// Equal returns whether two DeleteTaskQueueBacklogTasksRequest values are equivalent by recursively
 // comparing the message's fields.
 // For more information see the documentation for
 // https://pkg.go.dev/google.golang.org/protobuf/proto#Equal
-func (this *InternalTaskQueueStatus) Equal(that interface{}) bool {
+func (this *DeleteTaskQueueBacklogTasksRequest) Equal(that interface{}) bool {
 	if that == nil {
 		return this == nil
 	}
 
-	var that1 *InternalTaskQueueStatus
+	var that1 *DeleteTaskQueueBacklogTasksRequest
 	switch t := that.(type) {
-	case *InternalTaskQueueStatus:
+	case *DeleteTaskQueueBacklogTasksRequest:
 		that1 = t
-	case InternalTaskQueueStatus:
+	case DeleteTaskQueueBacklogTasksRequest:
 		that1 = &t
 	default:
 		return false
@@ -3187,35 +4556,35 @@ func (this *InternalTaskQueueStatus) Equal(that interface{}) bool {
 	return proto.Equal(this, that1)
 }
 
-// Marshal an object of type DescribeTaskQueuePartitionResponse to the protobuf v3 wire format
-func (val *DescribeTaskQueuePartitionResponse) Marshal() ([]byte, error) {
+// Marshal an object of type DeleteTaskQueueBacklogTasksResponse to the protobuf v3 wire format
+func (val *DeleteTaskQueueBacklogTasksResponse) Marshal() ([]byte, error) {
 	return proto.Marshal(val)
 }
 
-// Unmarshal an object of type DescribeTaskQueuePartitionResponse from the protobuf v3 wire format
-func (val *DescribeTaskQueuePartitionResponse) Unmarshal(buf []byte) error {
+// Unmarshal an object of type DeleteTaskQueueBacklogTasksResponse from the protobuf v3 wire format
+func (val *DeleteTaskQueueBacklogTasksResponse) Unmarshal(buf []byte) error {
 	return proto.Unmarshal(buf, val)
 }
 
 // Size returns the size of the object, in bytes, once serialized
-func (val *DescribeTaskQueuePartitionResponse) Size() int {
+func (val *DeleteTaskQueueBacklogTasksResponse) Size() int {
 	return proto.Size(val)
 }
 
-// Equal returns whether two DescribeTaskQueuePartitionResponse values are equivalent by recursively
+// Equal returns whether two DeleteTaskQueueBacklogTasksResponse values are equivalent by recursively
 // comparing the message's fields.
 // For more information see the documentation for
 // https://pkg.go.dev/google.golang.org/protobuf/proto#Equal
-func (this *DescribeTaskQueuePartitionResponse) Equal(that interface{}) bool {
+func (this *DeleteTaskQueueBacklogTasksResponse) Equal(that interface{}) bool {
 	if that == nil {
 		return this == nil
 	}
 
-	var that1 *DescribeTaskQueuePartitionResponse
+	var that1 *DeleteTaskQueueBacklogTasksResponse
 	switch t := that.(type) {
-	case *DescribeTaskQueuePartitionResponse:
+	case *DeleteTaskQueueBacklogTasksResponse:
 		that1 = t
-	case DescribeTaskQueuePartitionResponse:
+	case DeleteTaskQueueBacklogTasksResponse:
 		that1 = &t
 	default:
 		return false
@@ -3224,35 +4593,35 @@ func (this *DescribeTaskQueuePartitionResponse) Equal(that interface{}) bool {
 	return proto.Equal(this, that1)
 }
 
-// Marshal an object of type ForceUnloadTaskQueuePartitionRequest to the protobuf v3 wire format
-func (val *ForceUnloadTaskQueuePartitionRequest) Marshal() ([]byte, error) {
+// Marshal an object of type MoveTaskQueueBacklogTasksRequest to the protobuf v3 wire format
+func (val *MoveTaskQueueBacklogTasksRequest) Marshal() ([]byte, error) {
 	return proto.Marshal(val)
 }
 
-// Unmarshal an object of type ForceUnloadTaskQueuePartitionRequest from the protobuf v3 wire format
-func (val *ForceUnloadTaskQueuePartitionRequest) Unmarshal(buf []byte) error {
+// Unmarshal an object of type MoveTaskQueueBacklogTasksRequest from the protobuf v3 wire format
+func (val *MoveTaskQueueBacklogTasksRequest) Unmarshal(buf []byte) error {
 	return proto.Unmarshal(buf, val)
 }
 
 // Size returns the size of the object, in bytes, once serialized
-func (val *ForceUnloadTaskQueuePartitionRequest) Size() int {
+func (val *MoveTaskQueueBacklogTasksRequest) Size() int {
 	return proto.Size(val)
 }
 
-// Equal returns whether two ForceUnloadTaskQueuePartitionRequest values are equivalent by recursively
+// Equal returns whether two MoveTaskQueueBacklogTasksRequest values are equivalent by recursively
 // comparing the message's fields.
 // For more information see the documentation for
 // https://pkg.go.dev/google.golang.org/protobuf/proto#Equal
-func (this *ForceUnloadTaskQueuePartitionRequest) Equal(that interface{}) bool {
+func (this *MoveTaskQueueBacklogTasksRequest) Equal(that interface{}) bool {
 	if that == nil {
 		return this == nil
 	}
 
-	var that1 *ForceUnloadTaskQueuePartitionRequest
+	var that1 *MoveTaskQueueBacklogTasksRequest
 	switch t := that.(type) {
-	case *ForceUnloadTaskQueuePartitionRequest:
+	case *MoveTaskQueueBacklogTasksRequest:
 		that1 = t
-	case ForceUnloadTaskQueuePartitionRequest:
+	case MoveTaskQueueBacklogTasksRequest:
 		that1 = &t
 	default:
 		return false
@@ -3261,35 +4630,35 @@ func (this *ForceUnloadTaskQueuePartitionRequest) Equal(that interface{}) bool {
 	return proto.Equal(this, that1)
 }
 
-// Marshal an object of type ForceUnloadTaskQueuePartitionResponse to the protobuf v3 wire format
-func (val *ForceUnloadTaskQueuePartitionResponse) Marshal() ([]byte, error) {
+// Marshal an object of type MoveTaskQueueBacklogTasksResponse to the protobuf v3 wire format
+func (val *MoveTaskQueueBacklogTasksResponse) Marshal() ([]byte, error) {
 	return proto.Marshal(val)
 }
 
-// Unmarshal an object of type ForceUnloadTaskQueuePartitionResponse from the protobuf v3 wire format
-func (val *ForceUnloadTaskQueuePartitionResponse) Unmarshal(buf []byte) error {
+// Unmarshal an object of type MoveTaskQueueBacklogTasksResponse from the protobuf v3 wire format
+func (val *MoveTaskQueueBacklogTasksResponse) Unmarshal(buf []byte) error {
 	return proto.Unmarshal(buf, val)
 }
 
 // Size returns the size of the object, in bytes, once serialized
-func (val *ForceUnloadTaskQueuePartitionResponse) Size() int {
+func (val *MoveTaskQueueBacklogTasksResponse) Size() int {
 	return proto.Size(val)
 }
 
-// Equal returns whether two ForceUnloadTaskQueuePartitionResponse values are equivalent by recursively
+// Equal returns whether two MoveTaskQueueBacklogTasksResponse values are equivalent by recursively
 // comparing the message's fields.
 // For more information see the documentation for
 // https://pkg.go.dev/google.golang.org/protobuf/proto#Equal
-func (this *ForceUnloadTaskQueuePartitionResponse) Equal(that interface{}) bool {
+func (this *MoveTaskQueueBacklogTasksResponse) Equal(that interface{}) bool {
 	if that == nil {
 		return this == nil
 	}
 
-	var that1 *ForceUnloadTaskQueuePartitionResponse
+	var that1 *MoveTaskQueueBacklogTasksResponse
 	switch t := that.(type) {
-	case *ForceUnloadTaskQueuePartitionResponse:
+	case *MoveTaskQueueBacklogTasksResponse:
 		that1 = t
-	case ForceUnloadTaskQueuePartitionResponse:
+	case MoveTaskQueueBacklogTasksResponse:
 		that1 = &t
 	default:
 		return false
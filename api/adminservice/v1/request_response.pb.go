@@ -12,7 +12,7 @@ import (
 	unsafe "unsafe"
 
 	v1 "go.temporal.io/api/common/v1"
-	v16 "go.temporal.io/api/enums/v1"
+	v12 "go.temporal.io/api/enums/v1"
 	v110 "go.temporal.io/api/namespace/v1"
 	v111 "go.temporal.io/api/replication/v1"
 	v114 "go.temporal.io/api/taskqueue/v1"
@@ -20,11 +20,11 @@ import (
 	v17 "go.temporal.io/api/workflow/v1"
 	v18 "go.temporal.io/server/api/cluster/v1"
 	v112 "go.temporal.io/server/api/common/v1"
-	v14 "go.temporal.io/server/api/enums/v1"
+	v13 "go.temporal.io/server/api/enums/v1"
 	v11 "go.temporal.io/server/api/history/v1"
-	v13 "go.temporal.io/server/api/namespace/v1"
-	v12 "go.temporal.io/server/api/persistence/v1"
-	v15 "go.temporal.io/server/api/replication/v1"
+	v15 "go.temporal.io/server/api/namespace/v1"
+	v14 "go.temporal.io/server/api/persistence/v1"
+	v16 "go.temporal.io/server/api/replication/v1"
 	v113 "go.temporal.io/server/api/taskqueue/v1"
 	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
 	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
@@ -127,31 +127,28 @@ func (*RebuildMutableStateResponse) Descriptor() ([]byte, []int) {
 	return file_temporal_server_api_adminservice_v1_request_response_proto_rawDescGZIP(), []int{1}
 }
 
-type ImportWorkflowExecutionRequest struct {
-	state          protoimpl.MessageState `protogen:"open.v1"`
-	Namespace      string                 `protobuf:"bytes,1,opt,name=namespace,proto3" json:"namespace,omitempty"`
-	Execution      *v1.WorkflowExecution  `protobuf:"bytes,2,opt,name=execution,proto3" json:"execution,omitempty"`
-	HistoryBatches []*v1.DataBlob         `protobuf:"bytes,3,rep,name=history_batches,json=historyBatches,proto3" json:"history_batches,omitempty"`
-	VersionHistory *v11.VersionHistory    `protobuf:"bytes,4,opt,name=version_history,json=versionHistory,proto3" json:"version_history,omitempty"`
-	Token          []byte                 `protobuf:"bytes,5,opt,name=token,proto3" json:"token,omitempty"`
-	unknownFields  protoimpl.UnknownFields
-	sizeCache      protoimpl.SizeCache
+type CheckMutableStateRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Namespace     string                 `protobuf:"bytes,1,opt,name=namespace,proto3" json:"namespace,omitempty"`
+	Execution     *v1.WorkflowExecution  `protobuf:"bytes,2,opt,name=execution,proto3" json:"execution,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ImportWorkflowExecutionRequest) Reset() {
-	*x = ImportWorkflowExecutionRequest{}
+func (x *CheckMutableStateRequest) Reset() {
+	*x = CheckMutableStateRequest{}
 	mi := &file_temporal_server_api_adminservice_v1_request_response_proto_msgTypes[2]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ImportWorkflowExecutionRequest) String() string {
+func (x *CheckMutableStateRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ImportWorkflowExecutionRequest) ProtoMessage() {}
+func (*CheckMutableStateRequest) ProtoMessage() {}
 
-func (x *ImportWorkflowExecutionRequest) ProtoReflect() protoreflect.Message {
+func (x *CheckMutableStateRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_temporal_server_api_adminservice_v1_request_response_proto_msgTypes[2]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -163,67 +160,48 @@ func (x *ImportWorkflowExecutionRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ImportWorkflowExecutionRequest.ProtoReflect.Descriptor instead.
-func (*ImportWorkflowExecutionRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use CheckMutableStateRequest.ProtoReflect.Descriptor instead.
+func (*CheckMutableStateRequest) Descriptor() ([]byte, []int) {
 	return file_temporal_server_api_adminservice_v1_request_response_proto_rawDescGZIP(), []int{2}
 }
 
-func (x *ImportWorkflowExecutionRequest) GetNamespace() string {
+func (x *CheckMutableStateRequest) GetNamespace() string {
 	if x != nil {
 		return x.Namespace
 	}
 	return ""
 }
 
-func (x *ImportWorkflowExecutionRequest) GetExecution() *v1.WorkflowExecution {
+func (x *CheckMutableStateRequest) GetExecution() *v1.WorkflowExecution {
 	if x != nil {
 		return x.Execution
 	}
 	return nil
 }
 
-func (x *ImportWorkflowExecutionRequest) GetHistoryBatches() []*v1.DataBlob {
-	if x != nil {
-		return x.HistoryBatches
-	}
-	return nil
-}
-
-func (x *ImportWorkflowExecutionRequest) GetVersionHistory() *v11.VersionHistory {
-	if x != nil {
-		return x.VersionHistory
-	}
-	return nil
-}
-
-func (x *ImportWorkflowExecutionRequest) GetToken() []byte {
-	if x != nil {
-		return x.Token
-	}
-	return nil
-}
-
-type ImportWorkflowExecutionResponse struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Token         []byte                 `protobuf:"bytes,1,opt,name=token,proto3" json:"token,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+type CheckMutableStateResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Differences between the persisted mutable state and the mutable state rebuilt from history.
+	// Empty if the mutable state is consistent with history.
+	Inconsistencies []*MutableStateInconsistency `protobuf:"bytes,1,rep,name=inconsistencies,proto3" json:"inconsistencies,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
 }
 
-func (x *ImportWorkflowExecutionResponse) Reset() {
-	*x = ImportWorkflowExecutionResponse{}
+func (x *CheckMutableStateResponse) Reset() {
+	*x = CheckMutableStateResponse{}
 	mi := &file_temporal_server_api_adminservice_v1_request_response_proto_msgTypes[3]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ImportWorkflowExecutionResponse) String() string {
+func (x *CheckMutableStateResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ImportWorkflowExecutionResponse) ProtoMessage() {}
+func (*CheckMutableStateResponse) ProtoMessage() {}
 
-func (x *ImportWorkflowExecutionResponse) ProtoReflect() protoreflect.Message {
+func (x *CheckMutableStateResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_temporal_server_api_adminservice_v1_request_response_proto_msgTypes[3]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -235,41 +213,44 @@ func (x *ImportWorkflowExecutionResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ImportWorkflowExecutionResponse.ProtoReflect.Descriptor instead.
-func (*ImportWorkflowExecutionResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use CheckMutableStateResponse.ProtoReflect.Descriptor instead.
+func (*CheckMutableStateResponse) Descriptor() ([]byte, []int) {
 	return file_temporal_server_api_adminservice_v1_request_response_proto_rawDescGZIP(), []int{3}
 }
 
-func (x *ImportWorkflowExecutionResponse) GetToken() []byte {
+func (x *CheckMutableStateResponse) GetInconsistencies() []*MutableStateInconsistency {
 	if x != nil {
-		return x.Token
+		return x.Inconsistencies
 	}
 	return nil
 }
 
-type DescribeMutableStateRequest struct {
-	state           protoimpl.MessageState `protogen:"open.v1"`
-	Namespace       string                 `protobuf:"bytes,1,opt,name=namespace,proto3" json:"namespace,omitempty"`
-	Execution       *v1.WorkflowExecution  `protobuf:"bytes,2,opt,name=execution,proto3" json:"execution,omitempty"`
-	SkipForceReload bool                   `protobuf:"varint,3,opt,name=skip_force_reload,json=skipForceReload,proto3" json:"skip_force_reload,omitempty"`
-	unknownFields   protoimpl.UnknownFields
-	sizeCache       protoimpl.SizeCache
+type MutableStateInconsistency struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Field of the mutable state, e.g. activity_infos[5].
+	Field string `protobuf:"bytes,1,opt,name=field,proto3" json:"field,omitempty"`
+	// Value in the persisted mutable state, empty if missing.
+	DatabaseValue string `protobuf:"bytes,2,opt,name=database_value,json=databaseValue,proto3" json:"database_value,omitempty"`
+	// Value in the mutable state rebuilt from history, empty if missing.
+	HistoryValue  string `protobuf:"bytes,3,opt,name=history_value,json=historyValue,proto3" json:"history_value,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *DescribeMutableStateRequest) Reset() {
-	*x = DescribeMutableStateRequest{}
+func (x *MutableStateInconsistency) Reset() {
+	*x = MutableStateInconsistency{}
 	mi := &file_temporal_server_api_adminservice_v1_request_response_proto_msgTypes[4]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *DescribeMutableStateRequest) String() string {
+func (x *MutableStateInconsistency) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DescribeMutableStateRequest) ProtoMessage() {}
+func (*MutableStateInconsistency) ProtoMessage() {}
 
-func (x *DescribeMutableStateRequest) ProtoReflect() protoreflect.Message {
+func (x *MutableStateInconsistency) ProtoReflect() protoreflect.Message {
 	mi := &file_temporal_server_api_adminservice_v1_request_response_proto_msgTypes[4]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -281,59 +262,57 @@ func (x *DescribeMutableStateRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use DescribeMutableStateRequest.ProtoReflect.Descriptor instead.
-func (*DescribeMutableStateRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use MutableStateInconsistency.ProtoReflect.Descriptor instead.
+func (*MutableStateInconsistency) Descriptor() ([]byte, []int) {
 	return file_temporal_server_api_adminservice_v1_request_response_proto_rawDescGZIP(), []int{4}
 }
 
-func (x *DescribeMutableStateRequest) GetNamespace() string {
+func (x *MutableStateInconsistency) GetField() string {
 	if x != nil {
-		return x.Namespace
+		return x.Field
 	}
 	return ""
 }
 
-func (x *DescribeMutableStateRequest) GetExecution() *v1.WorkflowExecution {
+func (x *MutableStateInconsistency) GetDatabaseValue() string {
 	if x != nil {
-		return x.Execution
+		return x.DatabaseValue
 	}
-	return nil
+	return ""
 }
 
-func (x *DescribeMutableStateRequest) GetSkipForceReload() bool {
+func (x *MutableStateInconsistency) GetHistoryValue() string {
 	if x != nil {
-		return x.SkipForceReload
+		return x.HistoryValue
 	}
-	return false
+	return ""
 }
 
-type DescribeMutableStateResponse struct {
-	state       protoimpl.MessageState `protogen:"open.v1"`
-	ShardId     string                 `protobuf:"bytes,1,opt,name=shard_id,json=shardId,proto3" json:"shard_id,omitempty"`
-	HistoryAddr string                 `protobuf:"bytes,2,opt,name=history_addr,json=historyAddr,proto3" json:"history_addr,omitempty"`
-	// CacheMutableState is only available when mutable state is in cache.
-	CacheMutableState *v12.WorkflowMutableState `protobuf:"bytes,3,opt,name=cache_mutable_state,json=cacheMutableState,proto3" json:"cache_mutable_state,omitempty"`
-	// DatabaseMutableState is always available,
-	// but only loaded from database when mutable state is NOT in cache or skip_force_reload is false.
-	DatabaseMutableState *v12.WorkflowMutableState `protobuf:"bytes,4,opt,name=database_mutable_state,json=databaseMutableState,proto3" json:"database_mutable_state,omitempty"`
-	unknownFields        protoimpl.UnknownFields
-	sizeCache            protoimpl.SizeCache
+type ImportWorkflowExecutionRequest struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	Namespace      string                 `protobuf:"bytes,1,opt,name=namespace,proto3" json:"namespace,omitempty"`
+	Execution      *v1.WorkflowExecution  `protobuf:"bytes,2,opt,name=execution,proto3" json:"execution,omitempty"`
+	HistoryBatches []*v1.DataBlob         `protobuf:"bytes,3,rep,name=history_batches,json=historyBatches,proto3" json:"history_batches,omitempty"`
+	VersionHistory *v11.VersionHistory    `protobuf:"bytes,4,opt,name=version_history,json=versionHistory,proto3" json:"version_history,omitempty"`
+	Token          []byte                 `protobuf:"bytes,5,opt,name=token,proto3" json:"token,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
 }
 
-func (x *DescribeMutableStateResponse) Reset() {
-	*x = DescribeMutableStateResponse{}
+func (x *ImportWorkflowExecutionRequest) Reset() {
+	*x = ImportWorkflowExecutionRequest{}
 	mi := &file_temporal_server_api_adminservice_v1_request_response_proto_msgTypes[5]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *DescribeMutableStateResponse) String() string {
+func (x *ImportWorkflowExecutionRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DescribeMutableStateResponse) ProtoMessage() {}
+func (*ImportWorkflowExecutionRequest) ProtoMessage() {}
 
-func (x *DescribeMutableStateResponse) ProtoReflect() protoreflect.Message {
+func (x *ImportWorkflowExecutionRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_temporal_server_api_adminservice_v1_request_response_proto_msgTypes[5]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -345,65 +324,67 @@ func (x *DescribeMutableStateResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use DescribeMutableStateResponse.ProtoReflect.Descriptor instead.
-func (*DescribeMutableStateResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use ImportWorkflowExecutionRequest.ProtoReflect.Descriptor instead.
+func (*ImportWorkflowExecutionRequest) Descriptor() ([]byte, []int) {
 	return file_temporal_server_api_adminservice_v1_request_response_proto_rawDescGZIP(), []int{5}
 }
 
-func (x *DescribeMutableStateResponse) GetShardId() string {
+func (x *ImportWorkflowExecutionRequest) GetNamespace() string {
 	if x != nil {
-		return x.ShardId
+		return x.Namespace
 	}
 	return ""
 }
 
-func (x *DescribeMutableStateResponse) GetHistoryAddr() string {
+func (x *ImportWorkflowExecutionRequest) GetExecution() *v1.WorkflowExecution {
 	if x != nil {
-		return x.HistoryAddr
+		return x.Execution
 	}
-	return ""
+	return nil
 }
 
-func (x *DescribeMutableStateResponse) GetCacheMutableState() *v12.WorkflowMutableState {
+func (x *ImportWorkflowExecutionRequest) GetHistoryBatches() []*v1.DataBlob {
 	if x != nil {
-		return x.CacheMutableState
+		return x.HistoryBatches
 	}
 	return nil
 }
 
-func (x *DescribeMutableStateResponse) GetDatabaseMutableState() *v12.WorkflowMutableState {
+func (x *ImportWorkflowExecutionRequest) GetVersionHistory() *v11.VersionHistory {
 	if x != nil {
-		return x.DatabaseMutableState
+		return x.VersionHistory
 	}
 	return nil
 }
 
-// At least one of the parameters needs to be provided.
-type DescribeHistoryHostRequest struct {
-	state protoimpl.MessageState `protogen:"open.v1"`
-	//ip:port
-	HostAddress       string                `protobuf:"bytes,1,opt,name=host_address,json=hostAddress,proto3" json:"host_address,omitempty"`
-	ShardId           int32                 `protobuf:"varint,2,opt,name=shard_id,json=shardId,proto3" json:"shard_id,omitempty"`
-	Namespace         string                `protobuf:"bytes,3,opt,name=namespace,proto3" json:"namespace,omitempty"`
-	WorkflowExecution *v1.WorkflowExecution `protobuf:"bytes,4,opt,name=workflow_execution,json=workflowExecution,proto3" json:"workflow_execution,omitempty"`
-	unknownFields     protoimpl.UnknownFields
-	sizeCache         protoimpl.SizeCache
+func (x *ImportWorkflowExecutionRequest) GetToken() []byte {
+	if x != nil {
+		return x.Token
+	}
+	return nil
 }
 
-func (x *DescribeHistoryHostRequest) Reset() {
-	*x = DescribeHistoryHostRequest{}
+type ImportWorkflowExecutionResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Token         []byte                 `protobuf:"bytes,1,opt,name=token,proto3" json:"token,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ImportWorkflowExecutionResponse) Reset() {
+	*x = ImportWorkflowExecutionResponse{}
 	mi := &file_temporal_server_api_adminservice_v1_request_response_proto_msgTypes[6]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *DescribeHistoryHostRequest) String() string {
+func (x *ImportWorkflowExecutionResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DescribeHistoryHostRequest) ProtoMessage() {}
+func (*ImportWorkflowExecutionResponse) ProtoMessage() {}
 
-func (x *DescribeHistoryHostRequest) ProtoReflect() protoreflect.Message {
+func (x *ImportWorkflowExecutionResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_temporal_server_api_adminservice_v1_request_response_proto_msgTypes[6]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -415,63 +396,51 @@ func (x *DescribeHistoryHostRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use DescribeHistoryHostRequest.ProtoReflect.Descriptor instead.
-func (*DescribeHistoryHostRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use ImportWorkflowExecutionResponse.ProtoReflect.Descriptor instead.
+func (*ImportWorkflowExecutionResponse) Descriptor() ([]byte, []int) {
 	return file_temporal_server_api_adminservice_v1_request_response_proto_rawDescGZIP(), []int{6}
 }
 
-func (x *DescribeHistoryHostRequest) GetHostAddress() string {
-	if x != nil {
-		return x.HostAddress
-	}
-	return ""
-}
-
-func (x *DescribeHistoryHostRequest) GetShardId() int32 {
-	if x != nil {
-		return x.ShardId
-	}
-	return 0
-}
-
-func (x *DescribeHistoryHostRequest) GetNamespace() string {
-	if x != nil {
-		return x.Namespace
-	}
-	return ""
-}
-
-func (x *DescribeHistoryHostRequest) GetWorkflowExecution() *v1.WorkflowExecution {
+func (x *ImportWorkflowExecutionResponse) GetToken() []byte {
 	if x != nil {
-		return x.WorkflowExecution
+		return x.Token
 	}
 	return nil
 }
 
-type DescribeHistoryHostResponse struct {
-	state          protoimpl.MessageState  `protogen:"open.v1"`
-	ShardsNumber   int32                   `protobuf:"varint,1,opt,name=shards_number,json=shardsNumber,proto3" json:"shards_number,omitempty"`
-	ShardIds       []int32                 `protobuf:"varint,2,rep,packed,name=shard_ids,json=shardIds,proto3" json:"shard_ids,omitempty"`
-	NamespaceCache *v13.NamespaceCacheInfo `protobuf:"bytes,3,opt,name=namespace_cache,json=namespaceCache,proto3" json:"namespace_cache,omitempty"`
-	Address        string                  `protobuf:"bytes,5,opt,name=address,proto3" json:"address,omitempty"`
-	unknownFields  protoimpl.UnknownFields
-	sizeCache      protoimpl.SizeCache
+type ForkWorkflowExecutionRequest struct {
+	state     protoimpl.MessageState `protogen:"open.v1"`
+	Namespace string                 `protobuf:"bytes,1,opt,name=namespace,proto3" json:"namespace,omitempty"`
+	Execution *v1.WorkflowExecution  `protobuf:"bytes,2,opt,name=execution,proto3" json:"execution,omitempty"`
+	// The id of a WORKFLOW_TASK_COMPLETED, WORKFLOW_TASK_TIMED_OUT, WORKFLOW_TASK_FAILED, or
+	// WORKFLOW_TASK_STARTED event. History is copied up to, but not including, this event.
+	WorkflowTaskFinishEventId int64 `protobuf:"varint,3,opt,name=workflow_task_finish_event_id,json=workflowTaskFinishEventId,proto3" json:"workflow_task_finish_event_id,omitempty"`
+	// Namespace of the new execution. Defaults to the namespace of the forked workflow.
+	NewNamespace  string `protobuf:"bytes,4,opt,name=new_namespace,json=newNamespace,proto3" json:"new_namespace,omitempty"`
+	NewWorkflowId string `protobuf:"bytes,5,opt,name=new_workflow_id,json=newWorkflowId,proto3" json:"new_workflow_id,omitempty"`
+	// Used to de-dupe fork requests.
+	RequestId string `protobuf:"bytes,6,opt,name=request_id,json=requestId,proto3" json:"request_id,omitempty"`
+	Reason    string `protobuf:"bytes,7,opt,name=reason,proto3" json:"reason,omitempty"`
+	// Event types not to be reapplied to the new execution.
+	ResetReapplyExcludeTypes []v12.ResetReapplyExcludeType `protobuf:"varint,8,rep,packed,name=reset_reapply_exclude_types,json=resetReapplyExcludeTypes,proto3,enum=temporal.api.enums.v1.ResetReapplyExcludeType" json:"reset_reapply_exclude_types,omitempty"`
+	unknownFields            protoimpl.UnknownFields
+	sizeCache                protoimpl.SizeCache
 }
 
-func (x *DescribeHistoryHostResponse) Reset() {
-	*x = DescribeHistoryHostResponse{}
+func (x *ForkWorkflowExecutionRequest) Reset() {
+	*x = ForkWorkflowExecutionRequest{}
 	mi := &file_temporal_server_api_adminservice_v1_request_response_proto_msgTypes[7]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *DescribeHistoryHostResponse) String() string {
+func (x *ForkWorkflowExecutionRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DescribeHistoryHostResponse) ProtoMessage() {}
+func (*ForkWorkflowExecutionRequest) ProtoMessage() {}
 
-func (x *DescribeHistoryHostResponse) ProtoReflect() protoreflect.Message {
+func (x *ForkWorkflowExecutionRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_temporal_server_api_adminservice_v1_request_response_proto_msgTypes[7]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -483,60 +452,88 @@ func (x *DescribeHistoryHostResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use DescribeHistoryHostResponse.ProtoReflect.Descriptor instead.
-func (*DescribeHistoryHostResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use ForkWorkflowExecutionRequest.ProtoReflect.Descriptor instead.
+func (*ForkWorkflowExecutionRequest) Descriptor() ([]byte, []int) {
 	return file_temporal_server_api_adminservice_v1_request_response_proto_rawDescGZIP(), []int{7}
 }
 
-func (x *DescribeHistoryHostResponse) GetShardsNumber() int32 {
+func (x *ForkWorkflowExecutionRequest) GetNamespace() string {
 	if x != nil {
-		return x.ShardsNumber
+		return x.Namespace
 	}
-	return 0
+	return ""
 }
 
-func (x *DescribeHistoryHostResponse) GetShardIds() []int32 {
+func (x *ForkWorkflowExecutionRequest) GetExecution() *v1.WorkflowExecution {
 	if x != nil {
-		return x.ShardIds
+		return x.Execution
 	}
 	return nil
 }
 
-func (x *DescribeHistoryHostResponse) GetNamespaceCache() *v13.NamespaceCacheInfo {
+func (x *ForkWorkflowExecutionRequest) GetWorkflowTaskFinishEventId() int64 {
 	if x != nil {
-		return x.NamespaceCache
+		return x.WorkflowTaskFinishEventId
 	}
-	return nil
+	return 0
 }
 
-func (x *DescribeHistoryHostResponse) GetAddress() string {
+func (x *ForkWorkflowExecutionRequest) GetNewNamespace() string {
 	if x != nil {
-		return x.Address
+		return x.NewNamespace
 	}
 	return ""
 }
 
-type CloseShardRequest struct {
+func (x *ForkWorkflowExecutionRequest) GetNewWorkflowId() string {
+	if x != nil {
+		return x.NewWorkflowId
+	}
+	return ""
+}
+
+func (x *ForkWorkflowExecutionRequest) GetRequestId() string {
+	if x != nil {
+		return x.RequestId
+	}
+	return ""
+}
+
+func (x *ForkWorkflowExecutionRequest) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+func (x *ForkWorkflowExecutionRequest) GetResetReapplyExcludeTypes() []v12.ResetReapplyExcludeType {
+	if x != nil {
+		return x.ResetReapplyExcludeTypes
+	}
+	return nil
+}
+
+type ForkWorkflowExecutionResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	ShardId       int32                  `protobuf:"varint,1,opt,name=shard_id,json=shardId,proto3" json:"shard_id,omitempty"`
+	RunId         string                 `protobuf:"bytes,1,opt,name=run_id,json=runId,proto3" json:"run_id,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *CloseShardRequest) Reset() {
-	*x = CloseShardRequest{}
+func (x *ForkWorkflowExecutionResponse) Reset() {
+	*x = ForkWorkflowExecutionResponse{}
 	mi := &file_temporal_server_api_adminservice_v1_request_response_proto_msgTypes[8]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *CloseShardRequest) String() string {
+func (x *ForkWorkflowExecutionResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CloseShardRequest) ProtoMessage() {}
+func (*ForkWorkflowExecutionResponse) ProtoMessage() {}
 
-func (x *CloseShardRequest) ProtoReflect() protoreflect.Message {
+func (x *ForkWorkflowExecutionResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_temporal_server_api_adminservice_v1_request_response_proto_msgTypes[8]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -548,38 +545,47 @@ func (x *CloseShardRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CloseShardRequest.ProtoReflect.Descriptor instead.
-func (*CloseShardRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use ForkWorkflowExecutionResponse.ProtoReflect.Descriptor instead.
+func (*ForkWorkflowExecutionResponse) Descriptor() ([]byte, []int) {
 	return file_temporal_server_api_adminservice_v1_request_response_proto_rawDescGZIP(), []int{8}
 }
 
-func (x *CloseShardRequest) GetShardId() int32 {
+func (x *ForkWorkflowExecutionResponse) GetRunId() string {
 	if x != nil {
-		return x.ShardId
+		return x.RunId
 	}
-	return 0
+	return ""
 }
 
-type CloseShardResponse struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
+type RedactWorkflowExecutionRequest struct {
+	state     protoimpl.MessageState `protogen:"open.v1"`
+	Namespace string                 `protobuf:"bytes,1,opt,name=namespace,proto3" json:"namespace,omitempty"`
+	Execution *v1.WorkflowExecution  `protobuf:"bytes,2,opt,name=execution,proto3" json:"execution,omitempty"`
+	// IDs of the events whose payloads are replaced with a redaction marker.
+	EventIds []int64 `protobuf:"varint,3,rep,packed,name=event_ids,json=eventIds,proto3" json:"event_ids,omitempty"`
+	// Memo fields replaced with a redaction marker in the history, mutable state and visibility
+	// record of the workflow.
+	MemoKeys      []string `protobuf:"bytes,4,rep,name=memo_keys,json=memoKeys,proto3" json:"memo_keys,omitempty"`
+	Reason        string   `protobuf:"bytes,5,opt,name=reason,proto3" json:"reason,omitempty"`
+	Identity      string   `protobuf:"bytes,6,opt,name=identity,proto3" json:"identity,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *CloseShardResponse) Reset() {
-	*x = CloseShardResponse{}
+func (x *RedactWorkflowExecutionRequest) Reset() {
+	*x = RedactWorkflowExecutionRequest{}
 	mi := &file_temporal_server_api_adminservice_v1_request_response_proto_msgTypes[9]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *CloseShardResponse) String() string {
+func (x *RedactWorkflowExecutionRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CloseShardResponse) ProtoMessage() {}
+func (*RedactWorkflowExecutionRequest) ProtoMessage() {}
 
-func (x *CloseShardResponse) ProtoReflect() protoreflect.Message {
+func (x *RedactWorkflowExecutionRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_temporal_server_api_adminservice_v1_request_response_proto_msgTypes[9]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -591,32 +597,73 @@ func (x *CloseShardResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CloseShardResponse.ProtoReflect.Descriptor instead.
-func (*CloseShardResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use RedactWorkflowExecutionRequest.ProtoReflect.Descriptor instead.
+func (*RedactWorkflowExecutionRequest) Descriptor() ([]byte, []int) {
 	return file_temporal_server_api_adminservice_v1_request_response_proto_rawDescGZIP(), []int{9}
 }
 
-type GetShardRequest struct {
+func (x *RedactWorkflowExecutionRequest) GetNamespace() string {
+	if x != nil {
+		return x.Namespace
+	}
+	return ""
+}
+
+func (x *RedactWorkflowExecutionRequest) GetExecution() *v1.WorkflowExecution {
+	if x != nil {
+		return x.Execution
+	}
+	return nil
+}
+
+func (x *RedactWorkflowExecutionRequest) GetEventIds() []int64 {
+	if x != nil {
+		return x.EventIds
+	}
+	return nil
+}
+
+func (x *RedactWorkflowExecutionRequest) GetMemoKeys() []string {
+	if x != nil {
+		return x.MemoKeys
+	}
+	return nil
+}
+
+func (x *RedactWorkflowExecutionRequest) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+func (x *RedactWorkflowExecutionRequest) GetIdentity() string {
+	if x != nil {
+		return x.Identity
+	}
+	return ""
+}
+
+type RedactWorkflowExecutionResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	ShardId       int32                  `protobuf:"varint,1,opt,name=shard_id,json=shardId,proto3" json:"shard_id,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetShardRequest) Reset() {
-	*x = GetShardRequest{}
+func (x *RedactWorkflowExecutionResponse) Reset() {
+	*x = RedactWorkflowExecutionResponse{}
 	mi := &file_temporal_server_api_adminservice_v1_request_response_proto_msgTypes[10]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetShardRequest) String() string {
+func (x *RedactWorkflowExecutionResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetShardRequest) ProtoMessage() {}
+func (*RedactWorkflowExecutionResponse) ProtoMessage() {}
 
-func (x *GetShardRequest) ProtoReflect() protoreflect.Message {
+func (x *RedactWorkflowExecutionResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_temporal_server_api_adminservice_v1_request_response_proto_msgTypes[10]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -628,39 +675,33 @@ func (x *GetShardRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetShardRequest.ProtoReflect.Descriptor instead.
-func (*GetShardRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use RedactWorkflowExecutionResponse.ProtoReflect.Descriptor instead.
+func (*RedactWorkflowExecutionResponse) Descriptor() ([]byte, []int) {
 	return file_temporal_server_api_adminservice_v1_request_response_proto_rawDescGZIP(), []int{10}
 }
 
-func (x *GetShardRequest) GetShardId() int32 {
-	if x != nil {
-		return x.ShardId
-	}
-	return 0
-}
-
-type GetShardResponse struct {
+type GetWorkflowHistoryBudgetRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	ShardInfo     *v12.ShardInfo         `protobuf:"bytes,1,opt,name=shard_info,json=shardInfo,proto3" json:"shard_info,omitempty"`
+	Namespace     string                 `protobuf:"bytes,1,opt,name=namespace,proto3" json:"namespace,omitempty"`
+	Execution     *v1.WorkflowExecution  `protobuf:"bytes,2,opt,name=execution,proto3" json:"execution,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetShardResponse) Reset() {
-	*x = GetShardResponse{}
+func (x *GetWorkflowHistoryBudgetRequest) Reset() {
+	*x = GetWorkflowHistoryBudgetRequest{}
 	mi := &file_temporal_server_api_adminservice_v1_request_response_proto_msgTypes[11]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetShardResponse) String() string {
+func (x *GetWorkflowHistoryBudgetRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetShardResponse) ProtoMessage() {}
+func (*GetWorkflowHistoryBudgetRequest) ProtoMessage() {}
 
-func (x *GetShardResponse) ProtoReflect() protoreflect.Message {
+func (x *GetWorkflowHistoryBudgetRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_temporal_server_api_adminservice_v1_request_response_proto_msgTypes[11]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -672,44 +713,46 @@ func (x *GetShardResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetShardResponse.ProtoReflect.Descriptor instead.
-func (*GetShardResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use GetWorkflowHistoryBudgetRequest.ProtoReflect.Descriptor instead.
+func (*GetWorkflowHistoryBudgetRequest) Descriptor() ([]byte, []int) {
 	return file_temporal_server_api_adminservice_v1_request_response_proto_rawDescGZIP(), []int{11}
 }
 
-func (x *GetShardResponse) GetShardInfo() *v12.ShardInfo {
+func (x *GetWorkflowHistoryBudgetRequest) GetNamespace() string {
 	if x != nil {
-		return x.ShardInfo
+		return x.Namespace
+	}
+	return ""
+}
+
+func (x *GetWorkflowHistoryBudgetRequest) GetExecution() *v1.WorkflowExecution {
+	if x != nil {
+		return x.Execution
 	}
 	return nil
 }
 
-type ListHistoryTasksRequest struct {
-	state   protoimpl.MessageState `protogen:"open.v1"`
-	ShardId int32                  `protobuf:"varint,1,opt,name=shard_id,json=shardId,proto3" json:"shard_id,omitempty"`
-	// The task category. See tasks.TaskCategoryRegistry for more.
-	Category      int32          `protobuf:"varint,2,opt,name=category,proto3" json:"category,omitempty"`
-	TaskRange     *v11.TaskRange `protobuf:"bytes,3,opt,name=task_range,json=taskRange,proto3" json:"task_range,omitempty"`
-	BatchSize     int32          `protobuf:"varint,4,opt,name=batch_size,json=batchSize,proto3" json:"batch_size,omitempty"`
-	NextPageToken []byte         `protobuf:"bytes,5,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"`
+type GetWorkflowHistoryBudgetResponse struct {
+	state         protoimpl.MessageState      `protogen:"open.v1"`
+	Usage         *WorkflowHistoryBudgetUsage `protobuf:"bytes,1,opt,name=usage,proto3" json:"usage,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ListHistoryTasksRequest) Reset() {
-	*x = ListHistoryTasksRequest{}
+func (x *GetWorkflowHistoryBudgetResponse) Reset() {
+	*x = GetWorkflowHistoryBudgetResponse{}
 	mi := &file_temporal_server_api_adminservice_v1_request_response_proto_msgTypes[12]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ListHistoryTasksRequest) String() string {
+func (x *GetWorkflowHistoryBudgetResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ListHistoryTasksRequest) ProtoMessage() {}
+func (*GetWorkflowHistoryBudgetResponse) ProtoMessage() {}
 
-func (x *ListHistoryTasksRequest) ProtoReflect() protoreflect.Message {
+func (x *GetWorkflowHistoryBudgetResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_temporal_server_api_adminservice_v1_request_response_proto_msgTypes[12]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -721,68 +764,54 @@ func (x *ListHistoryTasksRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ListHistoryTasksRequest.ProtoReflect.Descriptor instead.
-func (*ListHistoryTasksRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use GetWorkflowHistoryBudgetResponse.ProtoReflect.Descriptor instead.
+func (*GetWorkflowHistoryBudgetResponse) Descriptor() ([]byte, []int) {
 	return file_temporal_server_api_adminservice_v1_request_response_proto_rawDescGZIP(), []int{12}
 }
 
-func (x *ListHistoryTasksRequest) GetShardId() int32 {
-	if x != nil {
-		return x.ShardId
-	}
-	return 0
-}
-
-func (x *ListHistoryTasksRequest) GetCategory() int32 {
-	if x != nil {
-		return x.Category
-	}
-	return 0
-}
-
-func (x *ListHistoryTasksRequest) GetTaskRange() *v11.TaskRange {
-	if x != nil {
-		return x.TaskRange
-	}
-	return nil
-}
-
-func (x *ListHistoryTasksRequest) GetBatchSize() int32 {
-	if x != nil {
-		return x.BatchSize
-	}
-	return 0
-}
-
-func (x *ListHistoryTasksRequest) GetNextPageToken() []byte {
+func (x *GetWorkflowHistoryBudgetResponse) GetUsage() *WorkflowHistoryBudgetUsage {
 	if x != nil {
-		return x.NextPageToken
+		return x.Usage
 	}
 	return nil
 }
 
-type ListHistoryTasksResponse struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Tasks         []*Task                `protobuf:"bytes,1,rep,name=tasks,proto3" json:"tasks,omitempty"`
-	NextPageToken []byte                 `protobuf:"bytes,2,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"`
+// WorkflowHistoryBudgetUsage is the consumption of a workflow execution against the history budget
+// of its namespace and workflow type.
+type WorkflowHistoryBudgetUsage struct {
+	state        protoimpl.MessageState `protogen:"open.v1"`
+	WorkflowType string                 `protobuf:"bytes,1,opt,name=workflow_type,json=workflowType,proto3" json:"workflow_type,omitempty"`
+	// False if no budget applies to the workflow execution, in which case only the history size and
+	// count are set.
+	HasBudget        bool  `protobuf:"varint,2,opt,name=has_budget,json=hasBudget,proto3" json:"has_budget,omitempty"`
+	HistorySizeBytes int64 `protobuf:"varint,3,opt,name=history_size_bytes,json=historySizeBytes,proto3" json:"history_size_bytes,omitempty"`
+	// Zero if the history size is not budgeted.
+	MaxHistorySizeBytes int64 `protobuf:"varint,4,opt,name=max_history_size_bytes,json=maxHistorySizeBytes,proto3" json:"max_history_size_bytes,omitempty"`
+	HistoryCount        int64 `protobuf:"varint,5,opt,name=history_count,json=historyCount,proto3" json:"history_count,omitempty"`
+	// Zero if the history event count is not budgeted.
+	MaxHistoryCount int64 `protobuf:"varint,6,opt,name=max_history_count,json=maxHistoryCount,proto3" json:"max_history_count,omitempty"`
+	// The larger of the consumed fractions of the size and count budgets.
+	ConsumedRatio float64 `protobuf:"fixed64,7,opt,name=consumed_ratio,json=consumedRatio,proto3" json:"consumed_ratio,omitempty"`
+	// Actions currently taken on the workflow execution.
+	Actions       []v13.HistoryBudgetAction `protobuf:"varint,8,rep,packed,name=actions,proto3,enum=temporal.server.api.enums.v1.HistoryBudgetAction" json:"actions,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ListHistoryTasksResponse) Reset() {
-	*x = ListHistoryTasksResponse{}
+func (x *WorkflowHistoryBudgetUsage) Reset() {
+	*x = WorkflowHistoryBudgetUsage{}
 	mi := &file_temporal_server_api_adminservice_v1_request_response_proto_msgTypes[13]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ListHistoryTasksResponse) String() string {
+func (x *WorkflowHistoryBudgetUsage) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ListHistoryTasksResponse) ProtoMessage() {}
+func (*WorkflowHistoryBudgetUsage) ProtoMessage() {}
 
-func (x *ListHistoryTasksResponse) ProtoReflect() protoreflect.Message {
+func (x *WorkflowHistoryBudgetUsage) ProtoReflect() protoreflect.Message {
 	mi := &file_temporal_server_api_adminservice_v1_request_response_proto_msgTypes[13]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -794,52 +823,89 @@ func (x *ListHistoryTasksResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ListHistoryTasksResponse.ProtoReflect.Descriptor instead.
-func (*ListHistoryTasksResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use WorkflowHistoryBudgetUsage.ProtoReflect.Descriptor instead.
+func (*WorkflowHistoryBudgetUsage) Descriptor() ([]byte, []int) {
 	return file_temporal_server_api_adminservice_v1_request_response_proto_rawDescGZIP(), []int{13}
 }
 
-func (x *ListHistoryTasksResponse) GetTasks() []*Task {
+func (x *WorkflowHistoryBudgetUsage) GetWorkflowType() string {
 	if x != nil {
-		return x.Tasks
+		return x.WorkflowType
 	}
-	return nil
+	return ""
 }
 
-func (x *ListHistoryTasksResponse) GetNextPageToken() []byte {
+func (x *WorkflowHistoryBudgetUsage) GetHasBudget() bool {
 	if x != nil {
-		return x.NextPageToken
+		return x.HasBudget
+	}
+	return false
+}
+
+func (x *WorkflowHistoryBudgetUsage) GetHistorySizeBytes() int64 {
+	if x != nil {
+		return x.HistorySizeBytes
+	}
+	return 0
+}
+
+func (x *WorkflowHistoryBudgetUsage) GetMaxHistorySizeBytes() int64 {
+	if x != nil {
+		return x.MaxHistorySizeBytes
+	}
+	return 0
+}
+
+func (x *WorkflowHistoryBudgetUsage) GetHistoryCount() int64 {
+	if x != nil {
+		return x.HistoryCount
+	}
+	return 0
+}
+
+func (x *WorkflowHistoryBudgetUsage) GetMaxHistoryCount() int64 {
+	if x != nil {
+		return x.MaxHistoryCount
+	}
+	return 0
+}
+
+func (x *WorkflowHistoryBudgetUsage) GetConsumedRatio() float64 {
+	if x != nil {
+		return x.ConsumedRatio
+	}
+	return 0
+}
+
+func (x *WorkflowHistoryBudgetUsage) GetActions() []v13.HistoryBudgetAction {
+	if x != nil {
+		return x.Actions
 	}
 	return nil
 }
 
-type Task struct {
+type GetWorkflowExecutionTimelineRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	NamespaceId   string                 `protobuf:"bytes,1,opt,name=namespace_id,json=namespaceId,proto3" json:"namespace_id,omitempty"`
-	WorkflowId    string                 `protobuf:"bytes,2,opt,name=workflow_id,json=workflowId,proto3" json:"workflow_id,omitempty"`
-	RunId         string                 `protobuf:"bytes,3,opt,name=run_id,json=runId,proto3" json:"run_id,omitempty"`
-	TaskId        int64                  `protobuf:"varint,4,opt,name=task_id,json=taskId,proto3" json:"task_id,omitempty"`
-	TaskType      v14.TaskType           `protobuf:"varint,5,opt,name=task_type,json=taskType,proto3,enum=temporal.server.api.enums.v1.TaskType" json:"task_type,omitempty"`
-	FireTime      *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=fire_time,json=fireTime,proto3" json:"fire_time,omitempty"`
-	Version       int64                  `protobuf:"varint,7,opt,name=version,proto3" json:"version,omitempty"`
+	Namespace     string                 `protobuf:"bytes,1,opt,name=namespace,proto3" json:"namespace,omitempty"`
+	Execution     *v1.WorkflowExecution  `protobuf:"bytes,2,opt,name=execution,proto3" json:"execution,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *Task) Reset() {
-	*x = Task{}
+func (x *GetWorkflowExecutionTimelineRequest) Reset() {
+	*x = GetWorkflowExecutionTimelineRequest{}
 	mi := &file_temporal_server_api_adminservice_v1_request_response_proto_msgTypes[14]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *Task) String() string {
+func (x *GetWorkflowExecutionTimelineRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Task) ProtoMessage() {}
+func (*GetWorkflowExecutionTimelineRequest) ProtoMessage() {}
 
-func (x *Task) ProtoReflect() protoreflect.Message {
+func (x *GetWorkflowExecutionTimelineRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_temporal_server_api_adminservice_v1_request_response_proto_msgTypes[14]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -851,86 +917,101 @@ func (x *Task) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Task.ProtoReflect.Descriptor instead.
-func (*Task) Descriptor() ([]byte, []int) {
+// Deprecated: Use GetWorkflowExecutionTimelineRequest.ProtoReflect.Descriptor instead.
+func (*GetWorkflowExecutionTimelineRequest) Descriptor() ([]byte, []int) {
 	return file_temporal_server_api_adminservice_v1_request_response_proto_rawDescGZIP(), []int{14}
 }
 
-func (x *Task) GetNamespaceId() string {
+func (x *GetWorkflowExecutionTimelineRequest) GetNamespace() string {
 	if x != nil {
-		return x.NamespaceId
+		return x.Namespace
 	}
 	return ""
 }
 
-func (x *Task) GetWorkflowId() string {
+func (x *GetWorkflowExecutionTimelineRequest) GetExecution() *v1.WorkflowExecution {
 	if x != nil {
-		return x.WorkflowId
+		return x.Execution
 	}
-	return ""
+	return nil
 }
 
-func (x *Task) GetRunId() string {
-	if x != nil {
-		return x.RunId
-	}
-	return ""
+type GetWorkflowExecutionTimelineResponse struct {
+	state         protoimpl.MessageState     `protogen:"open.v1"`
+	Timeline      *WorkflowExecutionTimeline `protobuf:"bytes,1,opt,name=timeline,proto3" json:"timeline,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *Task) GetTaskId() int64 {
-	if x != nil {
-		return x.TaskId
-	}
-	return 0
+func (x *GetWorkflowExecutionTimelineResponse) Reset() {
+	*x = GetWorkflowExecutionTimelineResponse{}
+	mi := &file_temporal_server_api_adminservice_v1_request_response_proto_msgTypes[15]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
 }
 
-func (x *Task) GetTaskType() v14.TaskType {
-	if x != nil {
-		return x.TaskType
-	}
-	return v14.TaskType(0)
+func (x *GetWorkflowExecutionTimelineResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
 }
 
-func (x *Task) GetFireTime() *timestamppb.Timestamp {
+func (*GetWorkflowExecutionTimelineResponse) ProtoMessage() {}
+
+func (x *GetWorkflowExecutionTimelineResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_temporal_server_api_adminservice_v1_request_response_proto_msgTypes[15]
 	if x != nil {
-		return x.FireTime
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return nil
+	return mi.MessageOf(x)
 }
 
-func (x *Task) GetVersion() int64 {
+// Deprecated: Use GetWorkflowExecutionTimelineResponse.ProtoReflect.Descriptor instead.
+func (*GetWorkflowExecutionTimelineResponse) Descriptor() ([]byte, []int) {
+	return file_temporal_server_api_adminservice_v1_request_response_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *GetWorkflowExecutionTimelineResponse) GetTimeline() *WorkflowExecutionTimeline {
 	if x != nil {
-		return x.Version
+		return x.Timeline
 	}
-	return 0
+	return nil
 }
 
-type RemoveTaskRequest struct {
-	state   protoimpl.MessageState `protogen:"open.v1"`
-	ShardId int32                  `protobuf:"varint,1,opt,name=shard_id,json=shardId,proto3" json:"shard_id,omitempty"`
-	// The task category. See tasks.TaskCategoryRegistry for more.
-	Category       int32                  `protobuf:"varint,2,opt,name=category,proto3" json:"category,omitempty"`
-	TaskId         int64                  `protobuf:"varint,3,opt,name=task_id,json=taskId,proto3" json:"task_id,omitempty"`
-	VisibilityTime *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=visibility_time,json=visibilityTime,proto3" json:"visibility_time,omitempty"`
-	unknownFields  protoimpl.UnknownFields
-	sizeCache      protoimpl.SizeCache
+// WorkflowExecutionTimeline is the history of a workflow execution broken down into spans, one for
+// every workflow task, activity, timer, child workflow and Nexus operation.
+type WorkflowExecutionTimeline struct {
+	state     protoimpl.MessageState `protogen:"open.v1"`
+	StartTime *timestamppb.Timestamp `protobuf:"bytes,1,opt,name=start_time,json=startTime,proto3" json:"start_time,omitempty"`
+	// Close time of the workflow. Set to the time of the analysis for running workflows.
+	EndTime *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=end_time,json=endTime,proto3" json:"end_time,omitempty"`
+	Running bool                   `protobuf:"varint,3,opt,name=running,proto3" json:"running,omitempty"`
+	// Spans ordered by the ID of their first event.
+	Spans []*TimelineSpan `protobuf:"bytes,4,rep,name=spans,proto3" json:"spans,omitempty"`
+	// Indexes of the spans on the critical path, i.e. the chain of spans the workflow waited on
+	// from its start to its close, in order.
+	CriticalPath  []int32 `protobuf:"varint,5,rep,packed,name=critical_path,json=criticalPath,proto3" json:"critical_path,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *RemoveTaskRequest) Reset() {
-	*x = RemoveTaskRequest{}
-	mi := &file_temporal_server_api_adminservice_v1_request_response_proto_msgTypes[15]
+func (x *WorkflowExecutionTimeline) Reset() {
+	*x = WorkflowExecutionTimeline{}
+	mi := &file_temporal_server_api_adminservice_v1_request_response_proto_msgTypes[16]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *RemoveTaskRequest) String() string {
+func (x *WorkflowExecutionTimeline) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*RemoveTaskRequest) ProtoMessage() {}
+func (*WorkflowExecutionTimeline) ProtoMessage() {}
 
-func (x *RemoveTaskRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_temporal_server_api_adminservice_v1_request_response_proto_msgTypes[15]
+func (x *WorkflowExecutionTimeline) ProtoReflect() protoreflect.Message {
+	mi := &file_temporal_server_api_adminservice_v1_request_response_proto_msgTypes[16]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -941,60 +1022,88 @@ func (x *RemoveTaskRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use RemoveTaskRequest.ProtoReflect.Descriptor instead.
-func (*RemoveTaskRequest) Descriptor() ([]byte, []int) {
-	return file_temporal_server_api_adminservice_v1_request_response_proto_rawDescGZIP(), []int{15}
+// Deprecated: Use WorkflowExecutionTimeline.ProtoReflect.Descriptor instead.
+func (*WorkflowExecutionTimeline) Descriptor() ([]byte, []int) {
+	return file_temporal_server_api_adminservice_v1_request_response_proto_rawDescGZIP(), []int{16}
 }
 
-func (x *RemoveTaskRequest) GetShardId() int32 {
+func (x *WorkflowExecutionTimeline) GetStartTime() *timestamppb.Timestamp {
 	if x != nil {
-		return x.ShardId
+		return x.StartTime
 	}
-	return 0
+	return nil
 }
 
-func (x *RemoveTaskRequest) GetCategory() int32 {
+func (x *WorkflowExecutionTimeline) GetEndTime() *timestamppb.Timestamp {
 	if x != nil {
-		return x.Category
+		return x.EndTime
 	}
-	return 0
+	return nil
 }
 
-func (x *RemoveTaskRequest) GetTaskId() int64 {
+func (x *WorkflowExecutionTimeline) GetRunning() bool {
 	if x != nil {
-		return x.TaskId
+		return x.Running
 	}
-	return 0
+	return false
 }
 
-func (x *RemoveTaskRequest) GetVisibilityTime() *timestamppb.Timestamp {
+func (x *WorkflowExecutionTimeline) GetSpans() []*TimelineSpan {
 	if x != nil {
-		return x.VisibilityTime
+		return x.Spans
 	}
 	return nil
 }
 
-type RemoveTaskResponse struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
+func (x *WorkflowExecutionTimeline) GetCriticalPath() []int32 {
+	if x != nil {
+		return x.CriticalPath
+	}
+	return nil
+}
+
+type TimelineSpan struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Type  v13.TimelineSpanType   `protobuf:"varint,1,opt,name=type,proto3,enum=temporal.server.api.enums.v1.TimelineSpanType" json:"type,omitempty"`
+	// Activity type, timer ID, child workflow type or Nexus service and operation.
+	Name string `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	// Activity ID, child workflow ID or Nexus operation ID, if any.
+	Id string `protobuf:"bytes,3,opt,name=id,proto3" json:"id,omitempty"`
+	// ID of the event that opened the span, e.g. ActivityTaskScheduled or TimerStarted.
+	EventId int64 `protobuf:"varint,4,opt,name=event_id,json=eventId,proto3" json:"event_id,omitempty"`
+	// ID of the event that closed the span. Not set for pending spans.
+	EndEventId int64 `protobuf:"varint,5,opt,name=end_event_id,json=endEventId,proto3" json:"end_event_id,omitempty"`
+	// Type of the event that closed the span, e.g. ActivityTaskFailed or TimerFired.
+	EndEventType v12.EventType `protobuf:"varint,6,opt,name=end_event_type,json=endEventType,proto3,enum=temporal.api.enums.v1.EventType" json:"end_event_type,omitempty"`
+	// ID of the WorkflowTaskCompleted event whose command opened the span.
+	WorkflowTaskCompletedEventId int64                  `protobuf:"varint,7,opt,name=workflow_task_completed_event_id,json=workflowTaskCompletedEventId,proto3" json:"workflow_task_completed_event_id,omitempty"`
+	ScheduleTime                 *timestamppb.Timestamp `protobuf:"bytes,8,opt,name=schedule_time,json=scheduleTime,proto3" json:"schedule_time,omitempty"`
+	// Set for spans that were picked up by a worker or started by a handler.
+	StartTime              *timestamppb.Timestamp `protobuf:"bytes,9,opt,name=start_time,json=startTime,proto3" json:"start_time,omitempty"`
+	EndTime                *timestamppb.Timestamp `protobuf:"bytes,10,opt,name=end_time,json=endTime,proto3" json:"end_time,omitempty"`
+	ScheduleToStartLatency *durationpb.Duration   `protobuf:"bytes,11,opt,name=schedule_to_start_latency,json=scheduleToStartLatency,proto3" json:"schedule_to_start_latency,omitempty"`
+	StartToCloseLatency    *durationpb.Duration   `protobuf:"bytes,12,opt,name=start_to_close_latency,json=startToCloseLatency,proto3" json:"start_to_close_latency,omitempty"`
+	// Attempt of the activity or workflow task recorded in history. Attempts greater than 1 are retries.
+	Attempt       int32 `protobuf:"varint,13,opt,name=attempt,proto3" json:"attempt,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *RemoveTaskResponse) Reset() {
-	*x = RemoveTaskResponse{}
-	mi := &file_temporal_server_api_adminservice_v1_request_response_proto_msgTypes[16]
+func (x *TimelineSpan) Reset() {
+	*x = TimelineSpan{}
+	mi := &file_temporal_server_api_adminservice_v1_request_response_proto_msgTypes[17]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *RemoveTaskResponse) String() string {
+func (x *TimelineSpan) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*RemoveTaskResponse) ProtoMessage() {}
+func (*TimelineSpan) ProtoMessage() {}
 
-func (x *RemoveTaskResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_temporal_server_api_adminservice_v1_request_response_proto_msgTypes[16]
+func (x *TimelineSpan) ProtoReflect() protoreflect.Message {
+	mi := &file_temporal_server_api_adminservice_v1_request_response_proto_msgTypes[17]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1005,138 +1114,125 @@ func (x *RemoveTaskResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use RemoveTaskResponse.ProtoReflect.Descriptor instead.
-func (*RemoveTaskResponse) Descriptor() ([]byte, []int) {
-	return file_temporal_server_api_adminservice_v1_request_response_proto_rawDescGZIP(), []int{16}
-}
-
-// *
-// StartEventId defines the beginning of the event to fetch. The first event is exclusive.
-// EndEventId and EndEventVersion defines the end of the event to fetch. The end event is exclusive.
-type GetWorkflowExecutionRawHistoryV2Request struct {
-	state             protoimpl.MessageState `protogen:"open.v1"`
-	NamespaceId       string                 `protobuf:"bytes,9,opt,name=namespace_id,json=namespaceId,proto3" json:"namespace_id,omitempty"`
-	Execution         *v1.WorkflowExecution  `protobuf:"bytes,2,opt,name=execution,proto3" json:"execution,omitempty"`
-	StartEventId      int64                  `protobuf:"varint,3,opt,name=start_event_id,json=startEventId,proto3" json:"start_event_id,omitempty"`
-	StartEventVersion int64                  `protobuf:"varint,4,opt,name=start_event_version,json=startEventVersion,proto3" json:"start_event_version,omitempty"`
-	EndEventId        int64                  `protobuf:"varint,5,opt,name=end_event_id,json=endEventId,proto3" json:"end_event_id,omitempty"`
-	EndEventVersion   int64                  `protobuf:"varint,6,opt,name=end_event_version,json=endEventVersion,proto3" json:"end_event_version,omitempty"`
-	MaximumPageSize   int32                  `protobuf:"varint,7,opt,name=maximum_page_size,json=maximumPageSize,proto3" json:"maximum_page_size,omitempty"`
-	NextPageToken     []byte                 `protobuf:"bytes,8,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"`
-	unknownFields     protoimpl.UnknownFields
-	sizeCache         protoimpl.SizeCache
+// Deprecated: Use TimelineSpan.ProtoReflect.Descriptor instead.
+func (*TimelineSpan) Descriptor() ([]byte, []int) {
+	return file_temporal_server_api_adminservice_v1_request_response_proto_rawDescGZIP(), []int{17}
 }
 
-func (x *GetWorkflowExecutionRawHistoryV2Request) Reset() {
-	*x = GetWorkflowExecutionRawHistoryV2Request{}
-	mi := &file_temporal_server_api_adminservice_v1_request_response_proto_msgTypes[17]
-	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-	ms.StoreMessageInfo(mi)
+func (x *TimelineSpan) GetType() v13.TimelineSpanType {
+	if x != nil {
+		return x.Type
+	}
+	return v13.TimelineSpanType(0)
 }
 
-func (x *GetWorkflowExecutionRawHistoryV2Request) String() string {
-	return protoimpl.X.MessageStringOf(x)
+func (x *TimelineSpan) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
 }
 
-func (*GetWorkflowExecutionRawHistoryV2Request) ProtoMessage() {}
-
-func (x *GetWorkflowExecutionRawHistoryV2Request) ProtoReflect() protoreflect.Message {
-	mi := &file_temporal_server_api_adminservice_v1_request_response_proto_msgTypes[17]
+func (x *TimelineSpan) GetId() string {
 	if x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+		return x.Id
 	}
-	return mi.MessageOf(x)
+	return ""
 }
 
-// Deprecated: Use GetWorkflowExecutionRawHistoryV2Request.ProtoReflect.Descriptor instead.
-func (*GetWorkflowExecutionRawHistoryV2Request) Descriptor() ([]byte, []int) {
-	return file_temporal_server_api_adminservice_v1_request_response_proto_rawDescGZIP(), []int{17}
+func (x *TimelineSpan) GetEventId() int64 {
+	if x != nil {
+		return x.EventId
+	}
+	return 0
 }
 
-func (x *GetWorkflowExecutionRawHistoryV2Request) GetNamespaceId() string {
+func (x *TimelineSpan) GetEndEventId() int64 {
 	if x != nil {
-		return x.NamespaceId
+		return x.EndEventId
 	}
-	return ""
+	return 0
 }
 
-func (x *GetWorkflowExecutionRawHistoryV2Request) GetExecution() *v1.WorkflowExecution {
+func (x *TimelineSpan) GetEndEventType() v12.EventType {
 	if x != nil {
-		return x.Execution
+		return x.EndEventType
 	}
-	return nil
+	return v12.EventType(0)
 }
 
-func (x *GetWorkflowExecutionRawHistoryV2Request) GetStartEventId() int64 {
+func (x *TimelineSpan) GetWorkflowTaskCompletedEventId() int64 {
 	if x != nil {
-		return x.StartEventId
+		return x.WorkflowTaskCompletedEventId
 	}
 	return 0
 }
 
-func (x *GetWorkflowExecutionRawHistoryV2Request) GetStartEventVersion() int64 {
+func (x *TimelineSpan) GetScheduleTime() *timestamppb.Timestamp {
 	if x != nil {
-		return x.StartEventVersion
+		return x.ScheduleTime
 	}
-	return 0
+	return nil
 }
 
-func (x *GetWorkflowExecutionRawHistoryV2Request) GetEndEventId() int64 {
+func (x *TimelineSpan) GetStartTime() *timestamppb.Timestamp {
 	if x != nil {
-		return x.EndEventId
+		return x.StartTime
 	}
-	return 0
+	return nil
 }
 
-func (x *GetWorkflowExecutionRawHistoryV2Request) GetEndEventVersion() int64 {
+func (x *TimelineSpan) GetEndTime() *timestamppb.Timestamp {
 	if x != nil {
-		return x.EndEventVersion
+		return x.EndTime
 	}
-	return 0
+	return nil
 }
 
-func (x *GetWorkflowExecutionRawHistoryV2Request) GetMaximumPageSize() int32 {
+func (x *TimelineSpan) GetScheduleToStartLatency() *durationpb.Duration {
 	if x != nil {
-		return x.MaximumPageSize
+		return x.ScheduleToStartLatency
 	}
-	return 0
+	return nil
 }
 
-func (x *GetWorkflowExecutionRawHistoryV2Request) GetNextPageToken() []byte {
+func (x *TimelineSpan) GetStartToCloseLatency() *durationpb.Duration {
 	if x != nil {
-		return x.NextPageToken
+		return x.StartToCloseLatency
 	}
 	return nil
 }
 
-type GetWorkflowExecutionRawHistoryV2Response struct {
-	state          protoimpl.MessageState `protogen:"open.v1"`
-	NextPageToken  []byte                 `protobuf:"bytes,1,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"`
-	HistoryBatches []*v1.DataBlob         `protobuf:"bytes,2,rep,name=history_batches,json=historyBatches,proto3" json:"history_batches,omitempty"`
-	VersionHistory *v11.VersionHistory    `protobuf:"bytes,3,opt,name=version_history,json=versionHistory,proto3" json:"version_history,omitempty"`
-	HistoryNodeIds []int64                `protobuf:"varint,4,rep,packed,name=history_node_ids,json=historyNodeIds,proto3" json:"history_node_ids,omitempty"`
-	unknownFields  protoimpl.UnknownFields
-	sizeCache      protoimpl.SizeCache
+func (x *TimelineSpan) GetAttempt() int32 {
+	if x != nil {
+		return x.Attempt
+	}
+	return 0
 }
 
-func (x *GetWorkflowExecutionRawHistoryV2Response) Reset() {
-	*x = GetWorkflowExecutionRawHistoryV2Response{}
+type DescribeMutableStateRequest struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	Namespace       string                 `protobuf:"bytes,1,opt,name=namespace,proto3" json:"namespace,omitempty"`
+	Execution       *v1.WorkflowExecution  `protobuf:"bytes,2,opt,name=execution,proto3" json:"execution,omitempty"`
+	SkipForceReload bool                   `protobuf:"varint,3,opt,name=skip_force_reload,json=skipForceReload,proto3" json:"skip_force_reload,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *DescribeMutableStateRequest) Reset() {
+	*x = DescribeMutableStateRequest{}
 	mi := &file_temporal_server_api_adminservice_v1_request_response_proto_msgTypes[18]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetWorkflowExecutionRawHistoryV2Response) String() string {
+func (x *DescribeMutableStateRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetWorkflowExecutionRawHistoryV2Response) ProtoMessage() {}
+func (*DescribeMutableStateRequest) ProtoMessage() {}
 
-func (x *GetWorkflowExecutionRawHistoryV2Response) ProtoReflect() protoreflect.Message {
+func (x *DescribeMutableStateRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_temporal_server_api_adminservice_v1_request_response_proto_msgTypes[18]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1148,67 +1244,59 @@ func (x *GetWorkflowExecutionRawHistoryV2Response) ProtoReflect() protoreflect.M
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetWorkflowExecutionRawHistoryV2Response.ProtoReflect.Descriptor instead.
-func (*GetWorkflowExecutionRawHistoryV2Response) Descriptor() ([]byte, []int) {
+// Deprecated: Use DescribeMutableStateRequest.ProtoReflect.Descriptor instead.
+func (*DescribeMutableStateRequest) Descriptor() ([]byte, []int) {
 	return file_temporal_server_api_adminservice_v1_request_response_proto_rawDescGZIP(), []int{18}
 }
 
-func (x *GetWorkflowExecutionRawHistoryV2Response) GetNextPageToken() []byte {
-	if x != nil {
-		return x.NextPageToken
-	}
-	return nil
-}
-
-func (x *GetWorkflowExecutionRawHistoryV2Response) GetHistoryBatches() []*v1.DataBlob {
+func (x *DescribeMutableStateRequest) GetNamespace() string {
 	if x != nil {
-		return x.HistoryBatches
+		return x.Namespace
 	}
-	return nil
+	return ""
 }
 
-func (x *GetWorkflowExecutionRawHistoryV2Response) GetVersionHistory() *v11.VersionHistory {
+func (x *DescribeMutableStateRequest) GetExecution() *v1.WorkflowExecution {
 	if x != nil {
-		return x.VersionHistory
+		return x.Execution
 	}
 	return nil
 }
 
-func (x *GetWorkflowExecutionRawHistoryV2Response) GetHistoryNodeIds() []int64 {
+func (x *DescribeMutableStateRequest) GetSkipForceReload() bool {
 	if x != nil {
-		return x.HistoryNodeIds
+		return x.SkipForceReload
 	}
-	return nil
+	return false
 }
 
-type GetWorkflowExecutionRawHistoryRequest struct {
-	state             protoimpl.MessageState `protogen:"open.v1"`
-	NamespaceId       string                 `protobuf:"bytes,1,opt,name=namespace_id,json=namespaceId,proto3" json:"namespace_id,omitempty"`
-	Execution         *v1.WorkflowExecution  `protobuf:"bytes,2,opt,name=execution,proto3" json:"execution,omitempty"`
-	StartEventId      int64                  `protobuf:"varint,3,opt,name=start_event_id,json=startEventId,proto3" json:"start_event_id,omitempty"`
-	StartEventVersion int64                  `protobuf:"varint,4,opt,name=start_event_version,json=startEventVersion,proto3" json:"start_event_version,omitempty"`
-	EndEventId        int64                  `protobuf:"varint,5,opt,name=end_event_id,json=endEventId,proto3" json:"end_event_id,omitempty"`
-	EndEventVersion   int64                  `protobuf:"varint,6,opt,name=end_event_version,json=endEventVersion,proto3" json:"end_event_version,omitempty"`
-	MaximumPageSize   int32                  `protobuf:"varint,7,opt,name=maximum_page_size,json=maximumPageSize,proto3" json:"maximum_page_size,omitempty"`
-	NextPageToken     []byte                 `protobuf:"bytes,8,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"`
-	unknownFields     protoimpl.UnknownFields
-	sizeCache         protoimpl.SizeCache
+type DescribeMutableStateResponse struct {
+	state       protoimpl.MessageState `protogen:"open.v1"`
+	ShardId     string                 `protobuf:"bytes,1,opt,name=shard_id,json=shardId,proto3" json:"shard_id,omitempty"`
+	HistoryAddr string                 `protobuf:"bytes,2,opt,name=history_addr,json=historyAddr,proto3" json:"history_addr,omitempty"`
+	// CacheMutableState is only available when mutable state is in cache.
+	CacheMutableState *v14.WorkflowMutableState `protobuf:"bytes,3,opt,name=cache_mutable_state,json=cacheMutableState,proto3" json:"cache_mutable_state,omitempty"`
+	// DatabaseMutableState is always available,
+	// but only loaded from database when mutable state is NOT in cache or skip_force_reload is false.
+	DatabaseMutableState *v14.WorkflowMutableState `protobuf:"bytes,4,opt,name=database_mutable_state,json=databaseMutableState,proto3" json:"database_mutable_state,omitempty"`
+	unknownFields        protoimpl.UnknownFields
+	sizeCache            protoimpl.SizeCache
 }
 
-func (x *GetWorkflowExecutionRawHistoryRequest) Reset() {
-	*x = GetWorkflowExecutionRawHistoryRequest{}
+func (x *DescribeMutableStateResponse) Reset() {
+	*x = DescribeMutableStateResponse{}
 	mi := &file_temporal_server_api_adminservice_v1_request_response_proto_msgTypes[19]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetWorkflowExecutionRawHistoryRequest) String() string {
+func (x *DescribeMutableStateResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetWorkflowExecutionRawHistoryRequest) ProtoMessage() {}
+func (*DescribeMutableStateResponse) ProtoMessage() {}
 
-func (x *GetWorkflowExecutionRawHistoryRequest) ProtoReflect() protoreflect.Message {
+func (x *DescribeMutableStateResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_temporal_server_api_adminservice_v1_request_response_proto_msgTypes[19]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1220,91 +1308,65 @@ func (x *GetWorkflowExecutionRawHistoryRequest) ProtoReflect() protoreflect.Mess
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetWorkflowExecutionRawHistoryRequest.ProtoReflect.Descriptor instead.
-func (*GetWorkflowExecutionRawHistoryRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use DescribeMutableStateResponse.ProtoReflect.Descriptor instead.
+func (*DescribeMutableStateResponse) Descriptor() ([]byte, []int) {
 	return file_temporal_server_api_adminservice_v1_request_response_proto_rawDescGZIP(), []int{19}
 }
 
-func (x *GetWorkflowExecutionRawHistoryRequest) GetNamespaceId() string {
+func (x *DescribeMutableStateResponse) GetShardId() string {
 	if x != nil {
-		return x.NamespaceId
+		return x.ShardId
 	}
 	return ""
 }
 
-func (x *GetWorkflowExecutionRawHistoryRequest) GetExecution() *v1.WorkflowExecution {
+func (x *DescribeMutableStateResponse) GetHistoryAddr() string {
 	if x != nil {
-		return x.Execution
+		return x.HistoryAddr
 	}
-	return nil
+	return ""
 }
 
-func (x *GetWorkflowExecutionRawHistoryRequest) GetStartEventId() int64 {
+func (x *DescribeMutableStateResponse) GetCacheMutableState() *v14.WorkflowMutableState {
 	if x != nil {
-		return x.StartEventId
+		return x.CacheMutableState
 	}
-	return 0
+	return nil
 }
 
-func (x *GetWorkflowExecutionRawHistoryRequest) GetStartEventVersion() int64 {
+func (x *DescribeMutableStateResponse) GetDatabaseMutableState() *v14.WorkflowMutableState {
 	if x != nil {
-		return x.StartEventVersion
+		return x.DatabaseMutableState
 	}
-	return 0
+	return nil
 }
 
-func (x *GetWorkflowExecutionRawHistoryRequest) GetEndEventId() int64 {
-	if x != nil {
-		return x.EndEventId
-	}
-	return 0
+// At least one of the parameters needs to be provided.
+type DescribeHistoryHostRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	//ip:port
+	HostAddress       string                `protobuf:"bytes,1,opt,name=host_address,json=hostAddress,proto3" json:"host_address,omitempty"`
+	ShardId           int32                 `protobuf:"varint,2,opt,name=shard_id,json=shardId,proto3" json:"shard_id,omitempty"`
+	Namespace         string                `protobuf:"bytes,3,opt,name=namespace,proto3" json:"namespace,omitempty"`
+	WorkflowExecution *v1.WorkflowExecution `protobuf:"bytes,4,opt,name=workflow_execution,json=workflowExecution,proto3" json:"workflow_execution,omitempty"`
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
 }
 
-func (x *GetWorkflowExecutionRawHistoryRequest) GetEndEventVersion() int64 {
-	if x != nil {
-		return x.EndEventVersion
-	}
-	return 0
+func (x *DescribeHistoryHostRequest) Reset() {
+	*x = DescribeHistoryHostRequest{}
+	mi := &file_temporal_server_api_adminservice_v1_request_response_proto_msgTypes[20]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetWorkflowExecutionRawHistoryRequest) GetMaximumPageSize() int32 {
-	if x != nil {
-		return x.MaximumPageSize
-	}
-	return 0
-}
-
-func (x *GetWorkflowExecutionRawHistoryRequest) GetNextPageToken() []byte {
-	if x != nil {
-		return x.NextPageToken
-	}
-	return nil
-}
-
-type GetWorkflowExecutionRawHistoryResponse struct {
-	state          protoimpl.MessageState `protogen:"open.v1"`
-	NextPageToken  []byte                 `protobuf:"bytes,1,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"`
-	HistoryBatches []*v1.DataBlob         `protobuf:"bytes,2,rep,name=history_batches,json=historyBatches,proto3" json:"history_batches,omitempty"`
-	VersionHistory *v11.VersionHistory    `protobuf:"bytes,3,opt,name=version_history,json=versionHistory,proto3" json:"version_history,omitempty"`
-	HistoryNodeIds []int64                `protobuf:"varint,4,rep,packed,name=history_node_ids,json=historyNodeIds,proto3" json:"history_node_ids,omitempty"`
-	unknownFields  protoimpl.UnknownFields
-	sizeCache      protoimpl.SizeCache
-}
-
-func (x *GetWorkflowExecutionRawHistoryResponse) Reset() {
-	*x = GetWorkflowExecutionRawHistoryResponse{}
-	mi := &file_temporal_server_api_adminservice_v1_request_response_proto_msgTypes[20]
-	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-	ms.StoreMessageInfo(mi)
-}
-
-func (x *GetWorkflowExecutionRawHistoryResponse) String() string {
+func (x *DescribeHistoryHostRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetWorkflowExecutionRawHistoryResponse) ProtoMessage() {}
+func (*DescribeHistoryHostRequest) ProtoMessage() {}
 
-func (x *GetWorkflowExecutionRawHistoryResponse) ProtoReflect() protoreflect.Message {
+func (x *DescribeHistoryHostRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_temporal_server_api_adminservice_v1_request_response_proto_msgTypes[20]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1316,61 +1378,64 @@ func (x *GetWorkflowExecutionRawHistoryResponse) ProtoReflect() protoreflect.Mes
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetWorkflowExecutionRawHistoryResponse.ProtoReflect.Descriptor instead.
-func (*GetWorkflowExecutionRawHistoryResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use DescribeHistoryHostRequest.ProtoReflect.Descriptor instead.
+func (*DescribeHistoryHostRequest) Descriptor() ([]byte, []int) {
 	return file_temporal_server_api_adminservice_v1_request_response_proto_rawDescGZIP(), []int{20}
 }
 
-func (x *GetWorkflowExecutionRawHistoryResponse) GetNextPageToken() []byte {
+func (x *DescribeHistoryHostRequest) GetHostAddress() string {
 	if x != nil {
-		return x.NextPageToken
+		return x.HostAddress
 	}
-	return nil
+	return ""
 }
 
-func (x *GetWorkflowExecutionRawHistoryResponse) GetHistoryBatches() []*v1.DataBlob {
+func (x *DescribeHistoryHostRequest) GetShardId() int32 {
 	if x != nil {
-		return x.HistoryBatches
+		return x.ShardId
 	}
-	return nil
+	return 0
 }
 
-func (x *GetWorkflowExecutionRawHistoryResponse) GetVersionHistory() *v11.VersionHistory {
+func (x *DescribeHistoryHostRequest) GetNamespace() string {
 	if x != nil {
-		return x.VersionHistory
+		return x.Namespace
 	}
-	return nil
+	return ""
 }
 
-func (x *GetWorkflowExecutionRawHistoryResponse) GetHistoryNodeIds() []int64 {
+func (x *DescribeHistoryHostRequest) GetWorkflowExecution() *v1.WorkflowExecution {
 	if x != nil {
-		return x.HistoryNodeIds
+		return x.WorkflowExecution
 	}
 	return nil
 }
 
-type GetReplicationMessagesRequest struct {
-	state         protoimpl.MessageState  `protogen:"open.v1"`
-	Tokens        []*v15.ReplicationToken `protobuf:"bytes,1,rep,name=tokens,proto3" json:"tokens,omitempty"`
-	ClusterName   string                  `protobuf:"bytes,2,opt,name=cluster_name,json=clusterName,proto3" json:"cluster_name,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+type DescribeHistoryHostResponse struct {
+	state          protoimpl.MessageState  `protogen:"open.v1"`
+	ShardsNumber   int32                   `protobuf:"varint,1,opt,name=shards_number,json=shardsNumber,proto3" json:"shards_number,omitempty"`
+	ShardIds       []int32                 `protobuf:"varint,2,rep,packed,name=shard_ids,json=shardIds,proto3" json:"shard_ids,omitempty"`
+	NamespaceCache *v15.NamespaceCacheInfo `protobuf:"bytes,3,opt,name=namespace_cache,json=namespaceCache,proto3" json:"namespace_cache,omitempty"`
+	Address        string                  `protobuf:"bytes,5,opt,name=address,proto3" json:"address,omitempty"`
+	ShardLoads     []*v11.ShardLoad        `protobuf:"bytes,6,rep,name=shard_loads,json=shardLoads,proto3" json:"shard_loads,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
 }
 
-func (x *GetReplicationMessagesRequest) Reset() {
-	*x = GetReplicationMessagesRequest{}
+func (x *DescribeHistoryHostResponse) Reset() {
+	*x = DescribeHistoryHostResponse{}
 	mi := &file_temporal_server_api_adminservice_v1_request_response_proto_msgTypes[21]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetReplicationMessagesRequest) String() string {
+func (x *DescribeHistoryHostResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetReplicationMessagesRequest) ProtoMessage() {}
+func (*DescribeHistoryHostResponse) ProtoMessage() {}
 
-func (x *GetReplicationMessagesRequest) ProtoReflect() protoreflect.Message {
+func (x *DescribeHistoryHostResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_temporal_server_api_adminservice_v1_request_response_proto_msgTypes[21]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1382,46 +1447,69 @@ func (x *GetReplicationMessagesRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetReplicationMessagesRequest.ProtoReflect.Descriptor instead.
-func (*GetReplicationMessagesRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use DescribeHistoryHostResponse.ProtoReflect.Descriptor instead.
+func (*DescribeHistoryHostResponse) Descriptor() ([]byte, []int) {
 	return file_temporal_server_api_adminservice_v1_request_response_proto_rawDescGZIP(), []int{21}
 }
 
-func (x *GetReplicationMessagesRequest) GetTokens() []*v15.ReplicationToken {
+func (x *DescribeHistoryHostResponse) GetShardsNumber() int32 {
+	if x != nil {
+		return x.ShardsNumber
+	}
+	return 0
+}
+
+func (x *DescribeHistoryHostResponse) GetShardIds() []int32 {
 	if x != nil {
-		return x.Tokens
+		return x.ShardIds
 	}
 	return nil
 }
 
-func (x *GetReplicationMessagesRequest) GetClusterName() string {
+func (x *DescribeHistoryHostResponse) GetNamespaceCache() *v15.NamespaceCacheInfo {
 	if x != nil {
-		return x.ClusterName
+		return x.NamespaceCache
+	}
+	return nil
+}
+
+func (x *DescribeHistoryHostResponse) GetAddress() string {
+	if x != nil {
+		return x.Address
 	}
 	return ""
 }
 
-type GetReplicationMessagesResponse struct {
-	state         protoimpl.MessageState             `protogen:"open.v1"`
-	ShardMessages map[int32]*v15.ReplicationMessages `protobuf:"bytes,1,rep,name=shard_messages,json=shardMessages,proto3" json:"shard_messages,omitempty" protobuf_key:"varint,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+func (x *DescribeHistoryHostResponse) GetShardLoads() []*v11.ShardLoad {
+	if x != nil {
+		return x.ShardLoads
+	}
+	return nil
 }
 
-func (x *GetReplicationMessagesResponse) Reset() {
-	*x = GetReplicationMessagesResponse{}
+type MoveHistoryShardRequest struct {
+	state   protoimpl.MessageState `protogen:"open.v1"`
+	ShardId int32                  `protobuf:"varint,1,opt,name=shard_id,json=shardId,proto3" json:"shard_id,omitempty"`
+	// ip:port of the history host which should take over the shard.
+	TargetHostAddress string `protobuf:"bytes,2,opt,name=target_host_address,json=targetHostAddress,proto3" json:"target_host_address,omitempty"`
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
+}
+
+func (x *MoveHistoryShardRequest) Reset() {
+	*x = MoveHistoryShardRequest{}
 	mi := &file_temporal_server_api_adminservice_v1_request_response_proto_msgTypes[22]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetReplicationMessagesResponse) String() string {
+func (x *MoveHistoryShardRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetReplicationMessagesResponse) ProtoMessage() {}
+func (*MoveHistoryShardRequest) ProtoMessage() {}
 
-func (x *GetReplicationMessagesResponse) ProtoReflect() protoreflect.Message {
+func (x *MoveHistoryShardRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_temporal_server_api_adminservice_v1_request_response_proto_msgTypes[22]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1433,45 +1521,45 @@ func (x *GetReplicationMessagesResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetReplicationMessagesResponse.ProtoReflect.Descriptor instead.
-func (*GetReplicationMessagesResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use MoveHistoryShardRequest.ProtoReflect.Descriptor instead.
+func (*MoveHistoryShardRequest) Descriptor() ([]byte, []int) {
 	return file_temporal_server_api_adminservice_v1_request_response_proto_rawDescGZIP(), []int{22}
 }
 
-func (x *GetReplicationMessagesResponse) GetShardMessages() map[int32]*v15.ReplicationMessages {
+func (x *MoveHistoryShardRequest) GetShardId() int32 {
 	if x != nil {
-		return x.ShardMessages
+		return x.ShardId
 	}
-	return nil
+	return 0
 }
 
-type GetNamespaceReplicationMessagesRequest struct {
-	state protoimpl.MessageState `protogen:"open.v1"`
-	// lastRetrievedMessageId is where the next fetch should begin with.
-	LastRetrievedMessageId int64 `protobuf:"varint,1,opt,name=last_retrieved_message_id,json=lastRetrievedMessageId,proto3" json:"last_retrieved_message_id,omitempty"`
-	// lastProcessedMessageId is the last messageId that is processed on the passive side.
-	// This can be different than lastRetrievedMessageId if passive side supports prefetching messages.
-	LastProcessedMessageId int64 `protobuf:"varint,2,opt,name=last_processed_message_id,json=lastProcessedMessageId,proto3" json:"last_processed_message_id,omitempty"`
-	// clusterName is the name of the pulling cluster.
-	ClusterName   string `protobuf:"bytes,3,opt,name=cluster_name,json=clusterName,proto3" json:"cluster_name,omitempty"`
+func (x *MoveHistoryShardRequest) GetTargetHostAddress() string {
+	if x != nil {
+		return x.TargetHostAddress
+	}
+	return ""
+}
+
+type MoveHistoryShardResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetNamespaceReplicationMessagesRequest) Reset() {
-	*x = GetNamespaceReplicationMessagesRequest{}
+func (x *MoveHistoryShardResponse) Reset() {
+	*x = MoveHistoryShardResponse{}
 	mi := &file_temporal_server_api_adminservice_v1_request_response_proto_msgTypes[23]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetNamespaceReplicationMessagesRequest) String() string {
+func (x *MoveHistoryShardResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetNamespaceReplicationMessagesRequest) ProtoMessage() {}
+func (*MoveHistoryShardResponse) ProtoMessage() {}
 
-func (x *GetNamespaceReplicationMessagesRequest) ProtoReflect() protoreflect.Message {
+func (x *MoveHistoryShardResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_temporal_server_api_adminservice_v1_request_response_proto_msgTypes[23]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1483,53 +1571,32 @@ func (x *GetNamespaceReplicationMessagesRequest) ProtoReflect() protoreflect.Mes
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetNamespaceReplicationMessagesRequest.ProtoReflect.Descriptor instead.
-func (*GetNamespaceReplicationMessagesRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use MoveHistoryShardResponse.ProtoReflect.Descriptor instead.
+func (*MoveHistoryShardResponse) Descriptor() ([]byte, []int) {
 	return file_temporal_server_api_adminservice_v1_request_response_proto_rawDescGZIP(), []int{23}
 }
 
-func (x *GetNamespaceReplicationMessagesRequest) GetLastRetrievedMessageId() int64 {
-	if x != nil {
-		return x.LastRetrievedMessageId
-	}
-	return 0
-}
-
-func (x *GetNamespaceReplicationMessagesRequest) GetLastProcessedMessageId() int64 {
-	if x != nil {
-		return x.LastProcessedMessageId
-	}
-	return 0
-}
-
-func (x *GetNamespaceReplicationMessagesRequest) GetClusterName() string {
-	if x != nil {
-		return x.ClusterName
-	}
-	return ""
-}
-
-type GetNamespaceReplicationMessagesResponse struct {
-	state         protoimpl.MessageState   `protogen:"open.v1"`
-	Messages      *v15.ReplicationMessages `protobuf:"bytes,1,opt,name=messages,proto3" json:"messages,omitempty"`
+type CloseShardRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ShardId       int32                  `protobuf:"varint,1,opt,name=shard_id,json=shardId,proto3" json:"shard_id,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetNamespaceReplicationMessagesResponse) Reset() {
-	*x = GetNamespaceReplicationMessagesResponse{}
+func (x *CloseShardRequest) Reset() {
+	*x = CloseShardRequest{}
 	mi := &file_temporal_server_api_adminservice_v1_request_response_proto_msgTypes[24]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetNamespaceReplicationMessagesResponse) String() string {
+func (x *CloseShardRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetNamespaceReplicationMessagesResponse) ProtoMessage() {}
+func (*CloseShardRequest) ProtoMessage() {}
 
-func (x *GetNamespaceReplicationMessagesResponse) ProtoReflect() protoreflect.Message {
+func (x *CloseShardRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_temporal_server_api_adminservice_v1_request_response_proto_msgTypes[24]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1541,39 +1608,38 @@ func (x *GetNamespaceReplicationMessagesResponse) ProtoReflect() protoreflect.Me
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetNamespaceReplicationMessagesResponse.ProtoReflect.Descriptor instead.
-func (*GetNamespaceReplicationMessagesResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use CloseShardRequest.ProtoReflect.Descriptor instead.
+func (*CloseShardRequest) Descriptor() ([]byte, []int) {
 	return file_temporal_server_api_adminservice_v1_request_response_proto_rawDescGZIP(), []int{24}
 }
 
-func (x *GetNamespaceReplicationMessagesResponse) GetMessages() *v15.ReplicationMessages {
+func (x *CloseShardRequest) GetShardId() int32 {
 	if x != nil {
-		return x.Messages
+		return x.ShardId
 	}
-	return nil
+	return 0
 }
 
-type GetDLQReplicationMessagesRequest struct {
-	state         protoimpl.MessageState     `protogen:"open.v1"`
-	TaskInfos     []*v15.ReplicationTaskInfo `protobuf:"bytes,1,rep,name=task_infos,json=taskInfos,proto3" json:"task_infos,omitempty"`
+type CloseShardResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetDLQReplicationMessagesRequest) Reset() {
-	*x = GetDLQReplicationMessagesRequest{}
+func (x *CloseShardResponse) Reset() {
+	*x = CloseShardResponse{}
 	mi := &file_temporal_server_api_adminservice_v1_request_response_proto_msgTypes[25]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetDLQReplicationMessagesRequest) String() string {
+func (x *CloseShardResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetDLQReplicationMessagesRequest) ProtoMessage() {}
+func (*CloseShardResponse) ProtoMessage() {}
 
-func (x *GetDLQReplicationMessagesRequest) ProtoReflect() protoreflect.Message {
+func (x *CloseShardResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_temporal_server_api_adminservice_v1_request_response_proto_msgTypes[25]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1585,39 +1651,32 @@ func (x *GetDLQReplicationMessagesRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetDLQReplicationMessagesRequest.ProtoReflect.Descriptor instead.
-func (*GetDLQReplicationMessagesRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use CloseShardResponse.ProtoReflect.Descriptor instead.
+func (*CloseShardResponse) Descriptor() ([]byte, []int) {
 	return file_temporal_server_api_adminservice_v1_request_response_proto_rawDescGZIP(), []int{25}
 }
 
-func (x *GetDLQReplicationMessagesRequest) GetTaskInfos() []*v15.ReplicationTaskInfo {
-	if x != nil {
-		return x.TaskInfos
-	}
-	return nil
-}
-
-type GetDLQReplicationMessagesResponse struct {
-	state            protoimpl.MessageState `protogen:"open.v1"`
-	ReplicationTasks []*v15.ReplicationTask `protobuf:"bytes,1,rep,name=replication_tasks,json=replicationTasks,proto3" json:"replication_tasks,omitempty"`
-	unknownFields    protoimpl.UnknownFields
-	sizeCache        protoimpl.SizeCache
+type GetShardRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ShardId       int32                  `protobuf:"varint,1,opt,name=shard_id,json=shardId,proto3" json:"shard_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetDLQReplicationMessagesResponse) Reset() {
-	*x = GetDLQReplicationMessagesResponse{}
+func (x *GetShardRequest) Reset() {
+	*x = GetShardRequest{}
 	mi := &file_temporal_server_api_adminservice_v1_request_response_proto_msgTypes[26]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetDLQReplicationMessagesResponse) String() string {
+func (x *GetShardRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetDLQReplicationMessagesResponse) ProtoMessage() {}
+func (*GetShardRequest) ProtoMessage() {}
 
-func (x *GetDLQReplicationMessagesResponse) ProtoReflect() protoreflect.Message {
+func (x *GetShardRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_temporal_server_api_adminservice_v1_request_response_proto_msgTypes[26]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1629,42 +1688,39 @@ func (x *GetDLQReplicationMessagesResponse) ProtoReflect() protoreflect.Message
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetDLQReplicationMessagesResponse.ProtoReflect.Descriptor instead.
-func (*GetDLQReplicationMessagesResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use GetShardRequest.ProtoReflect.Descriptor instead.
+func (*GetShardRequest) Descriptor() ([]byte, []int) {
 	return file_temporal_server_api_adminservice_v1_request_response_proto_rawDescGZIP(), []int{26}
 }
 
-func (x *GetDLQReplicationMessagesResponse) GetReplicationTasks() []*v15.ReplicationTask {
+func (x *GetShardRequest) GetShardId() int32 {
 	if x != nil {
-		return x.ReplicationTasks
+		return x.ShardId
 	}
-	return nil
+	return 0
 }
 
-// ReapplyEventsRequest is the request for reapply events API.
-type ReapplyEventsRequest struct {
-	state             protoimpl.MessageState `protogen:"open.v1"`
-	NamespaceId       string                 `protobuf:"bytes,4,opt,name=namespace_id,json=namespaceId,proto3" json:"namespace_id,omitempty"`
-	WorkflowExecution *v1.WorkflowExecution  `protobuf:"bytes,2,opt,name=workflow_execution,json=workflowExecution,proto3" json:"workflow_execution,omitempty"`
-	Events            *v1.DataBlob           `protobuf:"bytes,3,opt,name=events,proto3" json:"events,omitempty"`
-	unknownFields     protoimpl.UnknownFields
-	sizeCache         protoimpl.SizeCache
+type GetShardResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ShardInfo     *v14.ShardInfo         `protobuf:"bytes,1,opt,name=shard_info,json=shardInfo,proto3" json:"shard_info,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ReapplyEventsRequest) Reset() {
-	*x = ReapplyEventsRequest{}
+func (x *GetShardResponse) Reset() {
+	*x = GetShardResponse{}
 	mi := &file_temporal_server_api_adminservice_v1_request_response_proto_msgTypes[27]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ReapplyEventsRequest) String() string {
+func (x *GetShardResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ReapplyEventsRequest) ProtoMessage() {}
+func (*GetShardResponse) ProtoMessage() {}
 
-func (x *ReapplyEventsRequest) ProtoReflect() protoreflect.Message {
+func (x *GetShardResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_temporal_server_api_adminservice_v1_request_response_proto_msgTypes[27]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1676,52 +1732,44 @@ func (x *ReapplyEventsRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ReapplyEventsRequest.ProtoReflect.Descriptor instead.
-func (*ReapplyEventsRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use GetShardResponse.ProtoReflect.Descriptor instead.
+func (*GetShardResponse) Descriptor() ([]byte, []int) {
 	return file_temporal_server_api_adminservice_v1_request_response_proto_rawDescGZIP(), []int{27}
 }
 
-func (x *ReapplyEventsRequest) GetNamespaceId() string {
-	if x != nil {
-		return x.NamespaceId
-	}
-	return ""
-}
-
-func (x *ReapplyEventsRequest) GetWorkflowExecution() *v1.WorkflowExecution {
-	if x != nil {
-		return x.WorkflowExecution
-	}
-	return nil
-}
-
-func (x *ReapplyEventsRequest) GetEvents() *v1.DataBlob {
+func (x *GetShardResponse) GetShardInfo() *v14.ShardInfo {
 	if x != nil {
-		return x.Events
+		return x.ShardInfo
 	}
 	return nil
 }
 
-type ReapplyEventsResponse struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
+type ListHistoryTasksRequest struct {
+	state   protoimpl.MessageState `protogen:"open.v1"`
+	ShardId int32                  `protobuf:"varint,1,opt,name=shard_id,json=shardId,proto3" json:"shard_id,omitempty"`
+	// The task category. See tasks.TaskCategoryRegistry for more.
+	Category      int32          `protobuf:"varint,2,opt,name=category,proto3" json:"category,omitempty"`
+	TaskRange     *v11.TaskRange `protobuf:"bytes,3,opt,name=task_range,json=taskRange,proto3" json:"task_range,omitempty"`
+	BatchSize     int32          `protobuf:"varint,4,opt,name=batch_size,json=batchSize,proto3" json:"batch_size,omitempty"`
+	NextPageToken []byte         `protobuf:"bytes,5,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ReapplyEventsResponse) Reset() {
-	*x = ReapplyEventsResponse{}
+func (x *ListHistoryTasksRequest) Reset() {
+	*x = ListHistoryTasksRequest{}
 	mi := &file_temporal_server_api_adminservice_v1_request_response_proto_msgTypes[28]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ReapplyEventsResponse) String() string {
+func (x *ListHistoryTasksRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ReapplyEventsResponse) ProtoMessage() {}
+func (*ListHistoryTasksRequest) ProtoMessage() {}
 
-func (x *ReapplyEventsResponse) ProtoReflect() protoreflect.Message {
+func (x *ListHistoryTasksRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_temporal_server_api_adminservice_v1_request_response_proto_msgTypes[28]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1733,35 +1781,68 @@ func (x *ReapplyEventsResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ReapplyEventsResponse.ProtoReflect.Descriptor instead.
-func (*ReapplyEventsResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use ListHistoryTasksRequest.ProtoReflect.Descriptor instead.
+func (*ListHistoryTasksRequest) Descriptor() ([]byte, []int) {
 	return file_temporal_server_api_adminservice_v1_request_response_proto_rawDescGZIP(), []int{28}
 }
 
-type AddSearchAttributesRequest struct {
-	state            protoimpl.MessageState          `protogen:"open.v1"`
-	SearchAttributes map[string]v16.IndexedValueType `protobuf:"bytes,1,rep,name=search_attributes,json=searchAttributes,proto3" json:"search_attributes,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"varint,2,opt,name=value,enum=temporal.api.enums.v1.IndexedValueType"`
-	IndexName        string                          `protobuf:"bytes,2,opt,name=index_name,json=indexName,proto3" json:"index_name,omitempty"`
-	SkipSchemaUpdate bool                            `protobuf:"varint,3,opt,name=skip_schema_update,json=skipSchemaUpdate,proto3" json:"skip_schema_update,omitempty"`
-	Namespace        string                          `protobuf:"bytes,4,opt,name=namespace,proto3" json:"namespace,omitempty"`
-	unknownFields    protoimpl.UnknownFields
-	sizeCache        protoimpl.SizeCache
+func (x *ListHistoryTasksRequest) GetShardId() int32 {
+	if x != nil {
+		return x.ShardId
+	}
+	return 0
 }
 
-func (x *AddSearchAttributesRequest) Reset() {
-	*x = AddSearchAttributesRequest{}
+func (x *ListHistoryTasksRequest) GetCategory() int32 {
+	if x != nil {
+		return x.Category
+	}
+	return 0
+}
+
+func (x *ListHistoryTasksRequest) GetTaskRange() *v11.TaskRange {
+	if x != nil {
+		return x.TaskRange
+	}
+	return nil
+}
+
+func (x *ListHistoryTasksRequest) GetBatchSize() int32 {
+	if x != nil {
+		return x.BatchSize
+	}
+	return 0
+}
+
+func (x *ListHistoryTasksRequest) GetNextPageToken() []byte {
+	if x != nil {
+		return x.NextPageToken
+	}
+	return nil
+}
+
+type ListHistoryTasksResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Tasks         []*Task                `protobuf:"bytes,1,rep,name=tasks,proto3" json:"tasks,omitempty"`
+	NextPageToken []byte                 `protobuf:"bytes,2,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListHistoryTasksResponse) Reset() {
+	*x = ListHistoryTasksResponse{}
 	mi := &file_temporal_server_api_adminservice_v1_request_response_proto_msgTypes[29]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *AddSearchAttributesRequest) String() string {
+func (x *ListHistoryTasksResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*AddSearchAttributesRequest) ProtoMessage() {}
+func (*ListHistoryTasksResponse) ProtoMessage() {}
 
-func (x *AddSearchAttributesRequest) ProtoReflect() protoreflect.Message {
+func (x *ListHistoryTasksResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_temporal_server_api_adminservice_v1_request_response_proto_msgTypes[29]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1773,59 +1854,52 @@ func (x *AddSearchAttributesRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use AddSearchAttributesRequest.ProtoReflect.Descriptor instead.
-func (*AddSearchAttributesRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use ListHistoryTasksResponse.ProtoReflect.Descriptor instead.
+func (*ListHistoryTasksResponse) Descriptor() ([]byte, []int) {
 	return file_temporal_server_api_adminservice_v1_request_response_proto_rawDescGZIP(), []int{29}
 }
 
-func (x *AddSearchAttributesRequest) GetSearchAttributes() map[string]v16.IndexedValueType {
+func (x *ListHistoryTasksResponse) GetTasks() []*Task {
 	if x != nil {
-		return x.SearchAttributes
+		return x.Tasks
 	}
 	return nil
 }
 
-func (x *AddSearchAttributesRequest) GetIndexName() string {
-	if x != nil {
-		return x.IndexName
-	}
-	return ""
-}
-
-func (x *AddSearchAttributesRequest) GetSkipSchemaUpdate() bool {
-	if x != nil {
-		return x.SkipSchemaUpdate
-	}
-	return false
-}
-
-func (x *AddSearchAttributesRequest) GetNamespace() string {
+func (x *ListHistoryTasksResponse) GetNextPageToken() []byte {
 	if x != nil {
-		return x.Namespace
+		return x.NextPageToken
 	}
-	return ""
+	return nil
 }
 
-type AddSearchAttributesResponse struct {
+type Task struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
+	NamespaceId   string                 `protobuf:"bytes,1,opt,name=namespace_id,json=namespaceId,proto3" json:"namespace_id,omitempty"`
+	WorkflowId    string                 `protobuf:"bytes,2,opt,name=workflow_id,json=workflowId,proto3" json:"workflow_id,omitempty"`
+	RunId         string                 `protobuf:"bytes,3,opt,name=run_id,json=runId,proto3" json:"run_id,omitempty"`
+	TaskId        int64                  `protobuf:"varint,4,opt,name=task_id,json=taskId,proto3" json:"task_id,omitempty"`
+	TaskType      v13.TaskType           `protobuf:"varint,5,opt,name=task_type,json=taskType,proto3,enum=temporal.server.api.enums.v1.TaskType" json:"task_type,omitempty"`
+	FireTime      *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=fire_time,json=fireTime,proto3" json:"fire_time,omitempty"`
+	Version       int64                  `protobuf:"varint,7,opt,name=version,proto3" json:"version,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *AddSearchAttributesResponse) Reset() {
-	*x = AddSearchAttributesResponse{}
+func (x *Task) Reset() {
+	*x = Task{}
 	mi := &file_temporal_server_api_adminservice_v1_request_response_proto_msgTypes[30]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *AddSearchAttributesResponse) String() string {
+func (x *Task) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*AddSearchAttributesResponse) ProtoMessage() {}
+func (*Task) ProtoMessage() {}
 
-func (x *AddSearchAttributesResponse) ProtoReflect() protoreflect.Message {
+func (x *Task) ProtoReflect() protoreflect.Message {
 	mi := &file_temporal_server_api_adminservice_v1_request_response_proto_msgTypes[30]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1837,92 +1911,86 @@ func (x *AddSearchAttributesResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use AddSearchAttributesResponse.ProtoReflect.Descriptor instead.
-func (*AddSearchAttributesResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use Task.ProtoReflect.Descriptor instead.
+func (*Task) Descriptor() ([]byte, []int) {
 	return file_temporal_server_api_adminservice_v1_request_response_proto_rawDescGZIP(), []int{30}
 }
 
-type RemoveSearchAttributesRequest struct {
-	state            protoimpl.MessageState `protogen:"open.v1"`
-	SearchAttributes []string               `protobuf:"bytes,1,rep,name=search_attributes,json=searchAttributes,proto3" json:"search_attributes,omitempty"`
-	IndexName        string                 `protobuf:"bytes,2,opt,name=index_name,json=indexName,proto3" json:"index_name,omitempty"`
-	Namespace        string                 `protobuf:"bytes,3,opt,name=namespace,proto3" json:"namespace,omitempty"`
-	unknownFields    protoimpl.UnknownFields
-	sizeCache        protoimpl.SizeCache
-}
-
-func (x *RemoveSearchAttributesRequest) Reset() {
-	*x = RemoveSearchAttributesRequest{}
-	mi := &file_temporal_server_api_adminservice_v1_request_response_proto_msgTypes[31]
-	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-	ms.StoreMessageInfo(mi)
+func (x *Task) GetNamespaceId() string {
+	if x != nil {
+		return x.NamespaceId
+	}
+	return ""
 }
 
-func (x *RemoveSearchAttributesRequest) String() string {
-	return protoimpl.X.MessageStringOf(x)
+func (x *Task) GetWorkflowId() string {
+	if x != nil {
+		return x.WorkflowId
+	}
+	return ""
 }
 
-func (*RemoveSearchAttributesRequest) ProtoMessage() {}
-
-func (x *RemoveSearchAttributesRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_temporal_server_api_adminservice_v1_request_response_proto_msgTypes[31]
+func (x *Task) GetRunId() string {
 	if x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+		return x.RunId
 	}
-	return mi.MessageOf(x)
+	return ""
 }
 
-// Deprecated: Use RemoveSearchAttributesRequest.ProtoReflect.Descriptor instead.
-func (*RemoveSearchAttributesRequest) Descriptor() ([]byte, []int) {
-	return file_temporal_server_api_adminservice_v1_request_response_proto_rawDescGZIP(), []int{31}
+func (x *Task) GetTaskId() int64 {
+	if x != nil {
+		return x.TaskId
+	}
+	return 0
 }
 
-func (x *RemoveSearchAttributesRequest) GetSearchAttributes() []string {
+func (x *Task) GetTaskType() v13.TaskType {
 	if x != nil {
-		return x.SearchAttributes
+		return x.TaskType
 	}
-	return nil
+	return v13.TaskType(0)
 }
 
-func (x *RemoveSearchAttributesRequest) GetIndexName() string {
+func (x *Task) GetFireTime() *timestamppb.Timestamp {
 	if x != nil {
-		return x.IndexName
+		return x.FireTime
 	}
-	return ""
+	return nil
 }
 
-func (x *RemoveSearchAttributesRequest) GetNamespace() string {
+func (x *Task) GetVersion() int64 {
 	if x != nil {
-		return x.Namespace
+		return x.Version
 	}
-	return ""
+	return 0
 }
 
-type RemoveSearchAttributesResponse struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+type RemoveTaskRequest struct {
+	state   protoimpl.MessageState `protogen:"open.v1"`
+	ShardId int32                  `protobuf:"varint,1,opt,name=shard_id,json=shardId,proto3" json:"shard_id,omitempty"`
+	// The task category. See tasks.TaskCategoryRegistry for more.
+	Category       int32                  `protobuf:"varint,2,opt,name=category,proto3" json:"category,omitempty"`
+	TaskId         int64                  `protobuf:"varint,3,opt,name=task_id,json=taskId,proto3" json:"task_id,omitempty"`
+	VisibilityTime *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=visibility_time,json=visibilityTime,proto3" json:"visibility_time,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
 }
 
-func (x *RemoveSearchAttributesResponse) Reset() {
-	*x = RemoveSearchAttributesResponse{}
-	mi := &file_temporal_server_api_adminservice_v1_request_response_proto_msgTypes[32]
+func (x *RemoveTaskRequest) Reset() {
+	*x = RemoveTaskRequest{}
+	mi := &file_temporal_server_api_adminservice_v1_request_response_proto_msgTypes[31]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *RemoveSearchAttributesResponse) String() string {
+func (x *RemoveTaskRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*RemoveSearchAttributesResponse) ProtoMessage() {}
+func (*RemoveTaskRequest) ProtoMessage() {}
 
-func (x *RemoveSearchAttributesResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_temporal_server_api_adminservice_v1_request_response_proto_msgTypes[32]
+func (x *RemoveTaskRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_temporal_server_api_adminservice_v1_request_response_proto_msgTypes[31]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1933,34 +2001,60 @@ func (x *RemoveSearchAttributesResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use RemoveSearchAttributesResponse.ProtoReflect.Descriptor instead.
-func (*RemoveSearchAttributesResponse) Descriptor() ([]byte, []int) {
-	return file_temporal_server_api_adminservice_v1_request_response_proto_rawDescGZIP(), []int{32}
+// Deprecated: Use RemoveTaskRequest.ProtoReflect.Descriptor instead.
+func (*RemoveTaskRequest) Descriptor() ([]byte, []int) {
+	return file_temporal_server_api_adminservice_v1_request_response_proto_rawDescGZIP(), []int{31}
 }
 
-type GetSearchAttributesRequest struct {
+func (x *RemoveTaskRequest) GetShardId() int32 {
+	if x != nil {
+		return x.ShardId
+	}
+	return 0
+}
+
+func (x *RemoveTaskRequest) GetCategory() int32 {
+	if x != nil {
+		return x.Category
+	}
+	return 0
+}
+
+func (x *RemoveTaskRequest) GetTaskId() int64 {
+	if x != nil {
+		return x.TaskId
+	}
+	return 0
+}
+
+func (x *RemoveTaskRequest) GetVisibilityTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.VisibilityTime
+	}
+	return nil
+}
+
+type RemoveTaskResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	IndexName     string                 `protobuf:"bytes,1,opt,name=index_name,json=indexName,proto3" json:"index_name,omitempty"`
-	Namespace     string                 `protobuf:"bytes,2,opt,name=namespace,proto3" json:"namespace,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetSearchAttributesRequest) Reset() {
-	*x = GetSearchAttributesRequest{}
-	mi := &file_temporal_server_api_adminservice_v1_request_response_proto_msgTypes[33]
+func (x *RemoveTaskResponse) Reset() {
+	*x = RemoveTaskResponse{}
+	mi := &file_temporal_server_api_adminservice_v1_request_response_proto_msgTypes[32]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetSearchAttributesRequest) String() string {
+func (x *RemoveTaskResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetSearchAttributesRequest) ProtoMessage() {}
+func (*RemoveTaskResponse) ProtoMessage() {}
 
-func (x *GetSearchAttributesRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_temporal_server_api_adminservice_v1_request_response_proto_msgTypes[33]
+func (x *RemoveTaskResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_temporal_server_api_adminservice_v1_request_response_proto_msgTypes[32]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1971,51 +2065,43 @@ func (x *GetSearchAttributesRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetSearchAttributesRequest.ProtoReflect.Descriptor instead.
-func (*GetSearchAttributesRequest) Descriptor() ([]byte, []int) {
-	return file_temporal_server_api_adminservice_v1_request_response_proto_rawDescGZIP(), []int{33}
-}
-
-func (x *GetSearchAttributesRequest) GetIndexName() string {
-	if x != nil {
-		return x.IndexName
-	}
-	return ""
-}
-
-func (x *GetSearchAttributesRequest) GetNamespace() string {
-	if x != nil {
-		return x.Namespace
-	}
-	return ""
+// Deprecated: Use RemoveTaskResponse.ProtoReflect.Descriptor instead.
+func (*RemoveTaskResponse) Descriptor() ([]byte, []int) {
+	return file_temporal_server_api_adminservice_v1_request_response_proto_rawDescGZIP(), []int{32}
 }
 
-type GetSearchAttributesResponse struct {
-	state            protoimpl.MessageState          `protogen:"open.v1"`
-	CustomAttributes map[string]v16.IndexedValueType `protobuf:"bytes,1,rep,name=custom_attributes,json=customAttributes,proto3" json:"custom_attributes,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"varint,2,opt,name=value,enum=temporal.api.enums.v1.IndexedValueType"`
-	SystemAttributes map[string]v16.IndexedValueType `protobuf:"bytes,2,rep,name=system_attributes,json=systemAttributes,proto3" json:"system_attributes,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"varint,2,opt,name=value,enum=temporal.api.enums.v1.IndexedValueType"`
-	Mapping          map[string]string               `protobuf:"bytes,3,rep,name=mapping,proto3" json:"mapping,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
-	// State of the workflow that adds search attributes to the system.
-	AddWorkflowExecutionInfo *v17.WorkflowExecutionInfo `protobuf:"bytes,4,opt,name=add_workflow_execution_info,json=addWorkflowExecutionInfo,proto3" json:"add_workflow_execution_info,omitempty"`
-	unknownFields            protoimpl.UnknownFields
-	sizeCache                protoimpl.SizeCache
+// *
+// StartEventId defines the beginning of the event to fetch. The first event is exclusive.
+// EndEventId and EndEventVersion defines the end of the event to fetch. The end event is exclusive.
+type GetWorkflowExecutionRawHistoryV2Request struct {
+	state             protoimpl.MessageState `protogen:"open.v1"`
+	NamespaceId       string                 `protobuf:"bytes,9,opt,name=namespace_id,json=namespaceId,proto3" json:"namespace_id,omitempty"`
+	Execution         *v1.WorkflowExecution  `protobuf:"bytes,2,opt,name=execution,proto3" json:"execution,omitempty"`
+	StartEventId      int64                  `protobuf:"varint,3,opt,name=start_event_id,json=startEventId,proto3" json:"start_event_id,omitempty"`
+	StartEventVersion int64                  `protobuf:"varint,4,opt,name=start_event_version,json=startEventVersion,proto3" json:"start_event_version,omitempty"`
+	EndEventId        int64                  `protobuf:"varint,5,opt,name=end_event_id,json=endEventId,proto3" json:"end_event_id,omitempty"`
+	EndEventVersion   int64                  `protobuf:"varint,6,opt,name=end_event_version,json=endEventVersion,proto3" json:"end_event_version,omitempty"`
+	MaximumPageSize   int32                  `protobuf:"varint,7,opt,name=maximum_page_size,json=maximumPageSize,proto3" json:"maximum_page_size,omitempty"`
+	NextPageToken     []byte                 `protobuf:"bytes,8,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"`
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
 }
 
-func (x *GetSearchAttributesResponse) Reset() {
-	*x = GetSearchAttributesResponse{}
-	mi := &file_temporal_server_api_adminservice_v1_request_response_proto_msgTypes[34]
+func (x *GetWorkflowExecutionRawHistoryV2Request) Reset() {
+	*x = GetWorkflowExecutionRawHistoryV2Request{}
+	mi := &file_temporal_server_api_adminservice_v1_request_response_proto_msgTypes[33]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetSearchAttributesResponse) String() string {
+func (x *GetWorkflowExecutionRawHistoryV2Request) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetSearchAttributesResponse) ProtoMessage() {}
+func (*GetWorkflowExecutionRawHistoryV2Request) ProtoMessage() {}
 
-func (x *GetSearchAttributesResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_temporal_server_api_adminservice_v1_request_response_proto_msgTypes[34]
+func (x *GetWorkflowExecutionRawHistoryV2Request) ProtoReflect() protoreflect.Message {
+	mi := &file_temporal_server_api_adminservice_v1_request_response_proto_msgTypes[33]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2026,118 +2112,92 @@ func (x *GetSearchAttributesResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetSearchAttributesResponse.ProtoReflect.Descriptor instead.
-func (*GetSearchAttributesResponse) Descriptor() ([]byte, []int) {
-	return file_temporal_server_api_adminservice_v1_request_response_proto_rawDescGZIP(), []int{34}
+// Deprecated: Use GetWorkflowExecutionRawHistoryV2Request.ProtoReflect.Descriptor instead.
+func (*GetWorkflowExecutionRawHistoryV2Request) Descriptor() ([]byte, []int) {
+	return file_temporal_server_api_adminservice_v1_request_response_proto_rawDescGZIP(), []int{33}
 }
 
-func (x *GetSearchAttributesResponse) GetCustomAttributes() map[string]v16.IndexedValueType {
+func (x *GetWorkflowExecutionRawHistoryV2Request) GetNamespaceId() string {
 	if x != nil {
-		return x.CustomAttributes
+		return x.NamespaceId
 	}
-	return nil
+	return ""
 }
 
-func (x *GetSearchAttributesResponse) GetSystemAttributes() map[string]v16.IndexedValueType {
+func (x *GetWorkflowExecutionRawHistoryV2Request) GetExecution() *v1.WorkflowExecution {
 	if x != nil {
-		return x.SystemAttributes
+		return x.Execution
 	}
 	return nil
 }
 
-func (x *GetSearchAttributesResponse) GetMapping() map[string]string {
+func (x *GetWorkflowExecutionRawHistoryV2Request) GetStartEventId() int64 {
 	if x != nil {
-		return x.Mapping
+		return x.StartEventId
 	}
-	return nil
+	return 0
 }
 
-func (x *GetSearchAttributesResponse) GetAddWorkflowExecutionInfo() *v17.WorkflowExecutionInfo {
+func (x *GetWorkflowExecutionRawHistoryV2Request) GetStartEventVersion() int64 {
 	if x != nil {
-		return x.AddWorkflowExecutionInfo
+		return x.StartEventVersion
 	}
-	return nil
-}
-
-type DescribeClusterRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	ClusterName   string                 `protobuf:"bytes,1,opt,name=cluster_name,json=clusterName,proto3" json:"cluster_name,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
-}
-
-func (x *DescribeClusterRequest) Reset() {
-	*x = DescribeClusterRequest{}
-	mi := &file_temporal_server_api_adminservice_v1_request_response_proto_msgTypes[35]
-	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-	ms.StoreMessageInfo(mi)
+	return 0
 }
 
-func (x *DescribeClusterRequest) String() string {
-	return protoimpl.X.MessageStringOf(x)
+func (x *GetWorkflowExecutionRawHistoryV2Request) GetEndEventId() int64 {
+	if x != nil {
+		return x.EndEventId
+	}
+	return 0
 }
 
-func (*DescribeClusterRequest) ProtoMessage() {}
-
-func (x *DescribeClusterRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_temporal_server_api_adminservice_v1_request_response_proto_msgTypes[35]
+func (x *GetWorkflowExecutionRawHistoryV2Request) GetEndEventVersion() int64 {
 	if x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+		return x.EndEventVersion
 	}
-	return mi.MessageOf(x)
+	return 0
 }
 
-// Deprecated: Use DescribeClusterRequest.ProtoReflect.Descriptor instead.
-func (*DescribeClusterRequest) Descriptor() ([]byte, []int) {
-	return file_temporal_server_api_adminservice_v1_request_response_proto_rawDescGZIP(), []int{35}
+func (x *GetWorkflowExecutionRawHistoryV2Request) GetMaximumPageSize() int32 {
+	if x != nil {
+		return x.MaximumPageSize
+	}
+	return 0
 }
 
-func (x *DescribeClusterRequest) GetClusterName() string {
+func (x *GetWorkflowExecutionRawHistoryV2Request) GetNextPageToken() []byte {
 	if x != nil {
-		return x.ClusterName
+		return x.NextPageToken
 	}
-	return ""
+	return nil
 }
 
-type DescribeClusterResponse struct {
-	state                    protoimpl.MessageState `protogen:"open.v1"`
-	SupportedClients         map[string]string      `protobuf:"bytes,1,rep,name=supported_clients,json=supportedClients,proto3" json:"supported_clients,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
-	ServerVersion            string                 `protobuf:"bytes,2,opt,name=server_version,json=serverVersion,proto3" json:"server_version,omitempty"`
-	MembershipInfo           *v18.MembershipInfo    `protobuf:"bytes,3,opt,name=membership_info,json=membershipInfo,proto3" json:"membership_info,omitempty"`
-	ClusterId                string                 `protobuf:"bytes,4,opt,name=cluster_id,json=clusterId,proto3" json:"cluster_id,omitempty"`
-	ClusterName              string                 `protobuf:"bytes,5,opt,name=cluster_name,json=clusterName,proto3" json:"cluster_name,omitempty"`
-	HistoryShardCount        int32                  `protobuf:"varint,6,opt,name=history_shard_count,json=historyShardCount,proto3" json:"history_shard_count,omitempty"`
-	PersistenceStore         string                 `protobuf:"bytes,7,opt,name=persistence_store,json=persistenceStore,proto3" json:"persistence_store,omitempty"`
-	VisibilityStore          string                 `protobuf:"bytes,8,opt,name=visibility_store,json=visibilityStore,proto3" json:"visibility_store,omitempty"`
-	VersionInfo              *v19.VersionInfo       `protobuf:"bytes,9,opt,name=version_info,json=versionInfo,proto3" json:"version_info,omitempty"`
-	FailoverVersionIncrement int64                  `protobuf:"varint,10,opt,name=failover_version_increment,json=failoverVersionIncrement,proto3" json:"failover_version_increment,omitempty"`
-	InitialFailoverVersion   int64                  `protobuf:"varint,11,opt,name=initial_failover_version,json=initialFailoverVersion,proto3" json:"initial_failover_version,omitempty"`
-	IsGlobalNamespaceEnabled bool                   `protobuf:"varint,12,opt,name=is_global_namespace_enabled,json=isGlobalNamespaceEnabled,proto3" json:"is_global_namespace_enabled,omitempty"`
-	Tags                     map[string]string      `protobuf:"bytes,13,rep,name=tags,proto3" json:"tags,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
-	HttpAddress              string                 `protobuf:"bytes,14,opt,name=http_address,json=httpAddress,proto3" json:"http_address,omitempty"`
-	unknownFields            protoimpl.UnknownFields
-	sizeCache                protoimpl.SizeCache
+type GetWorkflowExecutionRawHistoryV2Response struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	NextPageToken  []byte                 `protobuf:"bytes,1,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"`
+	HistoryBatches []*v1.DataBlob         `protobuf:"bytes,2,rep,name=history_batches,json=historyBatches,proto3" json:"history_batches,omitempty"`
+	VersionHistory *v11.VersionHistory    `protobuf:"bytes,3,opt,name=version_history,json=versionHistory,proto3" json:"version_history,omitempty"`
+	HistoryNodeIds []int64                `protobuf:"varint,4,rep,packed,name=history_node_ids,json=historyNodeIds,proto3" json:"history_node_ids,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
 }
 
-func (x *DescribeClusterResponse) Reset() {
-	*x = DescribeClusterResponse{}
-	mi := &file_temporal_server_api_adminservice_v1_request_response_proto_msgTypes[36]
+func (x *GetWorkflowExecutionRawHistoryV2Response) Reset() {
+	*x = GetWorkflowExecutionRawHistoryV2Response{}
+	mi := &file_temporal_server_api_adminservice_v1_request_response_proto_msgTypes[34]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *DescribeClusterResponse) String() string {
+func (x *GetWorkflowExecutionRawHistoryV2Response) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DescribeClusterResponse) ProtoMessage() {}
+func (*GetWorkflowExecutionRawHistoryV2Response) ProtoMessage() {}
 
-func (x *DescribeClusterResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_temporal_server_api_adminservice_v1_request_response_proto_msgTypes[36]
+func (x *GetWorkflowExecutionRawHistoryV2Response) ProtoReflect() protoreflect.Message {
+	mi := &file_temporal_server_api_adminservice_v1_request_response_proto_msgTypes[34]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2148,132 +2208,164 @@ func (x *DescribeClusterResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use DescribeClusterResponse.ProtoReflect.Descriptor instead.
-func (*DescribeClusterResponse) Descriptor() ([]byte, []int) {
-	return file_temporal_server_api_adminservice_v1_request_response_proto_rawDescGZIP(), []int{36}
+// Deprecated: Use GetWorkflowExecutionRawHistoryV2Response.ProtoReflect.Descriptor instead.
+func (*GetWorkflowExecutionRawHistoryV2Response) Descriptor() ([]byte, []int) {
+	return file_temporal_server_api_adminservice_v1_request_response_proto_rawDescGZIP(), []int{34}
 }
 
-func (x *DescribeClusterResponse) GetSupportedClients() map[string]string {
+func (x *GetWorkflowExecutionRawHistoryV2Response) GetNextPageToken() []byte {
 	if x != nil {
-		return x.SupportedClients
+		return x.NextPageToken
 	}
 	return nil
 }
 
-func (x *DescribeClusterResponse) GetServerVersion() string {
+func (x *GetWorkflowExecutionRawHistoryV2Response) GetHistoryBatches() []*v1.DataBlob {
 	if x != nil {
-		return x.ServerVersion
+		return x.HistoryBatches
 	}
-	return ""
+	return nil
 }
 
-func (x *DescribeClusterResponse) GetMembershipInfo() *v18.MembershipInfo {
+func (x *GetWorkflowExecutionRawHistoryV2Response) GetVersionHistory() *v11.VersionHistory {
 	if x != nil {
-		return x.MembershipInfo
+		return x.VersionHistory
 	}
 	return nil
 }
 
-func (x *DescribeClusterResponse) GetClusterId() string {
+func (x *GetWorkflowExecutionRawHistoryV2Response) GetHistoryNodeIds() []int64 {
 	if x != nil {
-		return x.ClusterId
+		return x.HistoryNodeIds
 	}
-	return ""
+	return nil
 }
 
-func (x *DescribeClusterResponse) GetClusterName() string {
-	if x != nil {
-		return x.ClusterName
-	}
-	return ""
-}
+type GetWorkflowExecutionRawHistoryRequest struct {
+	state             protoimpl.MessageState `protogen:"open.v1"`
+	NamespaceId       string                 `protobuf:"bytes,1,opt,name=namespace_id,json=namespaceId,proto3" json:"namespace_id,omitempty"`
+	Execution         *v1.WorkflowExecution  `protobuf:"bytes,2,opt,name=execution,proto3" json:"execution,omitempty"`
+	StartEventId      int64                  `protobuf:"varint,3,opt,name=start_event_id,json=startEventId,proto3" json:"start_event_id,omitempty"`
+	StartEventVersion int64                  `protobuf:"varint,4,opt,name=start_event_version,json=startEventVersion,proto3" json:"start_event_version,omitempty"`
+	EndEventId        int64                  `protobuf:"varint,5,opt,name=end_event_id,json=endEventId,proto3" json:"end_event_id,omitempty"`
+	EndEventVersion   int64                  `protobuf:"varint,6,opt,name=end_event_version,json=endEventVersion,proto3" json:"end_event_version,omitempty"`
+	MaximumPageSize   int32                  `protobuf:"varint,7,opt,name=maximum_page_size,json=maximumPageSize,proto3" json:"maximum_page_size,omitempty"`
+	NextPageToken     []byte                 `protobuf:"bytes,8,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"`
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
+}
 
-func (x *DescribeClusterResponse) GetHistoryShardCount() int32 {
+func (x *GetWorkflowExecutionRawHistoryRequest) Reset() {
+	*x = GetWorkflowExecutionRawHistoryRequest{}
+	mi := &file_temporal_server_api_adminservice_v1_request_response_proto_msgTypes[35]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetWorkflowExecutionRawHistoryRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetWorkflowExecutionRawHistoryRequest) ProtoMessage() {}
+
+func (x *GetWorkflowExecutionRawHistoryRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_temporal_server_api_adminservice_v1_request_response_proto_msgTypes[35]
 	if x != nil {
-		return x.HistoryShardCount
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return 0
+	return mi.MessageOf(x)
 }
 
-func (x *DescribeClusterResponse) GetPersistenceStore() string {
+// Deprecated: Use GetWorkflowExecutionRawHistoryRequest.ProtoReflect.Descriptor instead.
+func (*GetWorkflowExecutionRawHistoryRequest) Descriptor() ([]byte, []int) {
+	return file_temporal_server_api_adminservice_v1_request_response_proto_rawDescGZIP(), []int{35}
+}
+
+func (x *GetWorkflowExecutionRawHistoryRequest) GetNamespaceId() string {
 	if x != nil {
-		return x.PersistenceStore
+		return x.NamespaceId
 	}
 	return ""
 }
 
-func (x *DescribeClusterResponse) GetVisibilityStore() string {
+func (x *GetWorkflowExecutionRawHistoryRequest) GetExecution() *v1.WorkflowExecution {
 	if x != nil {
-		return x.VisibilityStore
+		return x.Execution
 	}
-	return ""
+	return nil
 }
 
-func (x *DescribeClusterResponse) GetVersionInfo() *v19.VersionInfo {
+func (x *GetWorkflowExecutionRawHistoryRequest) GetStartEventId() int64 {
 	if x != nil {
-		return x.VersionInfo
+		return x.StartEventId
 	}
-	return nil
+	return 0
 }
 
-func (x *DescribeClusterResponse) GetFailoverVersionIncrement() int64 {
+func (x *GetWorkflowExecutionRawHistoryRequest) GetStartEventVersion() int64 {
 	if x != nil {
-		return x.FailoverVersionIncrement
+		return x.StartEventVersion
 	}
 	return 0
 }
 
-func (x *DescribeClusterResponse) GetInitialFailoverVersion() int64 {
+func (x *GetWorkflowExecutionRawHistoryRequest) GetEndEventId() int64 {
 	if x != nil {
-		return x.InitialFailoverVersion
+		return x.EndEventId
 	}
 	return 0
 }
 
-func (x *DescribeClusterResponse) GetIsGlobalNamespaceEnabled() bool {
+func (x *GetWorkflowExecutionRawHistoryRequest) GetEndEventVersion() int64 {
 	if x != nil {
-		return x.IsGlobalNamespaceEnabled
+		return x.EndEventVersion
 	}
-	return false
+	return 0
 }
 
-func (x *DescribeClusterResponse) GetTags() map[string]string {
+func (x *GetWorkflowExecutionRawHistoryRequest) GetMaximumPageSize() int32 {
 	if x != nil {
-		return x.Tags
+		return x.MaximumPageSize
 	}
-	return nil
+	return 0
 }
 
-func (x *DescribeClusterResponse) GetHttpAddress() string {
+func (x *GetWorkflowExecutionRawHistoryRequest) GetNextPageToken() []byte {
 	if x != nil {
-		return x.HttpAddress
+		return x.NextPageToken
 	}
-	return ""
+	return nil
 }
 
-type ListClustersRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	PageSize      int32                  `protobuf:"varint,1,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
-	NextPageToken []byte                 `protobuf:"bytes,2,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+type GetWorkflowExecutionRawHistoryResponse struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	NextPageToken  []byte                 `protobuf:"bytes,1,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"`
+	HistoryBatches []*v1.DataBlob         `protobuf:"bytes,2,rep,name=history_batches,json=historyBatches,proto3" json:"history_batches,omitempty"`
+	VersionHistory *v11.VersionHistory    `protobuf:"bytes,3,opt,name=version_history,json=versionHistory,proto3" json:"version_history,omitempty"`
+	HistoryNodeIds []int64                `protobuf:"varint,4,rep,packed,name=history_node_ids,json=historyNodeIds,proto3" json:"history_node_ids,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
 }
 
-func (x *ListClustersRequest) Reset() {
-	*x = ListClustersRequest{}
-	mi := &file_temporal_server_api_adminservice_v1_request_response_proto_msgTypes[37]
+func (x *GetWorkflowExecutionRawHistoryResponse) Reset() {
+	*x = GetWorkflowExecutionRawHistoryResponse{}
+	mi := &file_temporal_server_api_adminservice_v1_request_response_proto_msgTypes[36]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ListClustersRequest) String() string {
+func (x *GetWorkflowExecutionRawHistoryResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ListClustersRequest) ProtoMessage() {}
+func (*GetWorkflowExecutionRawHistoryResponse) ProtoMessage() {}
 
-func (x *ListClustersRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_temporal_server_api_adminservice_v1_request_response_proto_msgTypes[37]
+func (x *GetWorkflowExecutionRawHistoryResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_temporal_server_api_adminservice_v1_request_response_proto_msgTypes[36]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2284,48 +2376,62 @@ func (x *ListClustersRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ListClustersRequest.ProtoReflect.Descriptor instead.
-func (*ListClustersRequest) Descriptor() ([]byte, []int) {
-	return file_temporal_server_api_adminservice_v1_request_response_proto_rawDescGZIP(), []int{37}
+// Deprecated: Use GetWorkflowExecutionRawHistoryResponse.ProtoReflect.Descriptor instead.
+func (*GetWorkflowExecutionRawHistoryResponse) Descriptor() ([]byte, []int) {
+	return file_temporal_server_api_adminservice_v1_request_response_proto_rawDescGZIP(), []int{36}
 }
 
-func (x *ListClustersRequest) GetPageSize() int32 {
+func (x *GetWorkflowExecutionRawHistoryResponse) GetNextPageToken() []byte {
 	if x != nil {
-		return x.PageSize
+		return x.NextPageToken
 	}
-	return 0
+	return nil
 }
 
-func (x *ListClustersRequest) GetNextPageToken() []byte {
+func (x *GetWorkflowExecutionRawHistoryResponse) GetHistoryBatches() []*v1.DataBlob {
 	if x != nil {
-		return x.NextPageToken
+		return x.HistoryBatches
 	}
 	return nil
 }
 
-type ListClustersResponse struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Clusters      []*v12.ClusterMetadata `protobuf:"bytes,1,rep,name=clusters,proto3" json:"clusters,omitempty"`
-	NextPageToken []byte                 `protobuf:"bytes,2,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"`
+func (x *GetWorkflowExecutionRawHistoryResponse) GetVersionHistory() *v11.VersionHistory {
+	if x != nil {
+		return x.VersionHistory
+	}
+	return nil
+}
+
+func (x *GetWorkflowExecutionRawHistoryResponse) GetHistoryNodeIds() []int64 {
+	if x != nil {
+		return x.HistoryNodeIds
+	}
+	return nil
+}
+
+type GetReplicationMessagesRequest struct {
+	state         protoimpl.MessageState  `protogen:"open.v1"`
+	Tokens        []*v16.ReplicationToken `protobuf:"bytes,1,rep,name=tokens,proto3" json:"tokens,omitempty"`
+	ClusterName   string                  `protobuf:"bytes,2,opt,name=cluster_name,json=clusterName,proto3" json:"cluster_name,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ListClustersResponse) Reset() {
-	*x = ListClustersResponse{}
-	mi := &file_temporal_server_api_adminservice_v1_request_response_proto_msgTypes[38]
+func (x *GetReplicationMessagesRequest) Reset() {
+	*x = GetReplicationMessagesRequest{}
+	mi := &file_temporal_server_api_adminservice_v1_request_response_proto_msgTypes[37]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ListClustersResponse) String() string {
+func (x *GetReplicationMessagesRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ListClustersResponse) ProtoMessage() {}
+func (*GetReplicationMessagesRequest) ProtoMessage() {}
 
-func (x *ListClustersResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_temporal_server_api_adminservice_v1_request_response_proto_msgTypes[38]
+func (x *GetReplicationMessagesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_temporal_server_api_adminservice_v1_request_response_proto_msgTypes[37]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2336,50 +2442,47 @@ func (x *ListClustersResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ListClustersResponse.ProtoReflect.Descriptor instead.
-func (*ListClustersResponse) Descriptor() ([]byte, []int) {
-	return file_temporal_server_api_adminservice_v1_request_response_proto_rawDescGZIP(), []int{38}
+// Deprecated: Use GetReplicationMessagesRequest.ProtoReflect.Descriptor instead.
+func (*GetReplicationMessagesRequest) Descriptor() ([]byte, []int) {
+	return file_temporal_server_api_adminservice_v1_request_response_proto_rawDescGZIP(), []int{37}
 }
 
-func (x *ListClustersResponse) GetClusters() []*v12.ClusterMetadata {
+func (x *GetReplicationMessagesRequest) GetTokens() []*v16.ReplicationToken {
 	if x != nil {
-		return x.Clusters
+		return x.Tokens
 	}
 	return nil
 }
 
-func (x *ListClustersResponse) GetNextPageToken() []byte {
+func (x *GetReplicationMessagesRequest) GetClusterName() string {
 	if x != nil {
-		return x.NextPageToken
+		return x.ClusterName
 	}
-	return nil
+	return ""
 }
 
-type AddOrUpdateRemoteClusterRequest struct {
-	state                         protoimpl.MessageState `protogen:"open.v1"`
-	FrontendAddress               string                 `protobuf:"bytes,1,opt,name=frontend_address,json=frontendAddress,proto3" json:"frontend_address,omitempty"`
-	EnableRemoteClusterConnection bool                   `protobuf:"varint,2,opt,name=enable_remote_cluster_connection,json=enableRemoteClusterConnection,proto3" json:"enable_remote_cluster_connection,omitempty"`
-	// Deprecated: Marked as deprecated in temporal/server/api/adminservice/v1/request_response.proto.
-	FrontendHttpAddress string `protobuf:"bytes,3,opt,name=frontend_http_address,json=frontendHttpAddress,proto3" json:"frontend_http_address,omitempty"`
-	unknownFields       protoimpl.UnknownFields
-	sizeCache           protoimpl.SizeCache
+type GetReplicationMessagesResponse struct {
+	state         protoimpl.MessageState             `protogen:"open.v1"`
+	ShardMessages map[int32]*v16.ReplicationMessages `protobuf:"bytes,1,rep,name=shard_messages,json=shardMessages,proto3" json:"shard_messages,omitempty" protobuf_key:"varint,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *AddOrUpdateRemoteClusterRequest) Reset() {
-	*x = AddOrUpdateRemoteClusterRequest{}
-	mi := &file_temporal_server_api_adminservice_v1_request_response_proto_msgTypes[39]
+func (x *GetReplicationMessagesResponse) Reset() {
+	*x = GetReplicationMessagesResponse{}
+	mi := &file_temporal_server_api_adminservice_v1_request_response_proto_msgTypes[38]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *AddOrUpdateRemoteClusterRequest) String() string {
+func (x *GetReplicationMessagesResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*AddOrUpdateRemoteClusterRequest) ProtoMessage() {}
+func (*GetReplicationMessagesResponse) ProtoMessage() {}
 
-func (x *AddOrUpdateRemoteClusterRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_temporal_server_api_adminservice_v1_request_response_proto_msgTypes[39]
+func (x *GetReplicationMessagesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_temporal_server_api_adminservice_v1_request_response_proto_msgTypes[38]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2390,54 +2493,46 @@ func (x *AddOrUpdateRemoteClusterRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use AddOrUpdateRemoteClusterRequest.ProtoReflect.Descriptor instead.
-func (*AddOrUpdateRemoteClusterRequest) Descriptor() ([]byte, []int) {
-	return file_temporal_server_api_adminservice_v1_request_response_proto_rawDescGZIP(), []int{39}
-}
-
-func (x *AddOrUpdateRemoteClusterRequest) GetFrontendAddress() string {
-	if x != nil {
-		return x.FrontendAddress
-	}
-	return ""
-}
-
-func (x *AddOrUpdateRemoteClusterRequest) GetEnableRemoteClusterConnection() bool {
-	if x != nil {
-		return x.EnableRemoteClusterConnection
-	}
-	return false
+// Deprecated: Use GetReplicationMessagesResponse.ProtoReflect.Descriptor instead.
+func (*GetReplicationMessagesResponse) Descriptor() ([]byte, []int) {
+	return file_temporal_server_api_adminservice_v1_request_response_proto_rawDescGZIP(), []int{38}
 }
 
-// Deprecated: Marked as deprecated in temporal/server/api/adminservice/v1/request_response.proto.
-func (x *AddOrUpdateRemoteClusterRequest) GetFrontendHttpAddress() string {
+func (x *GetReplicationMessagesResponse) GetShardMessages() map[int32]*v16.ReplicationMessages {
 	if x != nil {
-		return x.FrontendHttpAddress
+		return x.ShardMessages
 	}
-	return ""
+	return nil
 }
 
-type AddOrUpdateRemoteClusterResponse struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
+type GetNamespaceReplicationMessagesRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// lastRetrievedMessageId is where the next fetch should begin with.
+	LastRetrievedMessageId int64 `protobuf:"varint,1,opt,name=last_retrieved_message_id,json=lastRetrievedMessageId,proto3" json:"last_retrieved_message_id,omitempty"`
+	// lastProcessedMessageId is the last messageId that is processed on the passive side.
+	// This can be different than lastRetrievedMessageId if passive side supports prefetching messages.
+	LastProcessedMessageId int64 `protobuf:"varint,2,opt,name=last_processed_message_id,json=lastProcessedMessageId,proto3" json:"last_processed_message_id,omitempty"`
+	// clusterName is the name of the pulling cluster.
+	ClusterName   string `protobuf:"bytes,3,opt,name=cluster_name,json=clusterName,proto3" json:"cluster_name,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *AddOrUpdateRemoteClusterResponse) Reset() {
-	*x = AddOrUpdateRemoteClusterResponse{}
-	mi := &file_temporal_server_api_adminservice_v1_request_response_proto_msgTypes[40]
+func (x *GetNamespaceReplicationMessagesRequest) Reset() {
+	*x = GetNamespaceReplicationMessagesRequest{}
+	mi := &file_temporal_server_api_adminservice_v1_request_response_proto_msgTypes[39]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *AddOrUpdateRemoteClusterResponse) String() string {
+func (x *GetNamespaceReplicationMessagesRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*AddOrUpdateRemoteClusterResponse) ProtoMessage() {}
+func (*GetNamespaceReplicationMessagesRequest) ProtoMessage() {}
 
-func (x *AddOrUpdateRemoteClusterResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_temporal_server_api_adminservice_v1_request_response_proto_msgTypes[40]
+func (x *GetNamespaceReplicationMessagesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_temporal_server_api_adminservice_v1_request_response_proto_msgTypes[39]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2448,33 +2543,54 @@ func (x *AddOrUpdateRemoteClusterResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use AddOrUpdateRemoteClusterResponse.ProtoReflect.Descriptor instead.
-func (*AddOrUpdateRemoteClusterResponse) Descriptor() ([]byte, []int) {
-	return file_temporal_server_api_adminservice_v1_request_response_proto_rawDescGZIP(), []int{40}
+// Deprecated: Use GetNamespaceReplicationMessagesRequest.ProtoReflect.Descriptor instead.
+func (*GetNamespaceReplicationMessagesRequest) Descriptor() ([]byte, []int) {
+	return file_temporal_server_api_adminservice_v1_request_response_proto_rawDescGZIP(), []int{39}
 }
 
-type RemoveRemoteClusterRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	ClusterName   string                 `protobuf:"bytes,1,opt,name=cluster_name,json=clusterName,proto3" json:"cluster_name,omitempty"`
+func (x *GetNamespaceReplicationMessagesRequest) GetLastRetrievedMessageId() int64 {
+	if x != nil {
+		return x.LastRetrievedMessageId
+	}
+	return 0
+}
+
+func (x *GetNamespaceReplicationMessagesRequest) GetLastProcessedMessageId() int64 {
+	if x != nil {
+		return x.LastProcessedMessageId
+	}
+	return 0
+}
+
+func (x *GetNamespaceReplicationMessagesRequest) GetClusterName() string {
+	if x != nil {
+		return x.ClusterName
+	}
+	return ""
+}
+
+type GetNamespaceReplicationMessagesResponse struct {
+	state         protoimpl.MessageState   `protogen:"open.v1"`
+	Messages      *v16.ReplicationMessages `protobuf:"bytes,1,opt,name=messages,proto3" json:"messages,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *RemoveRemoteClusterRequest) Reset() {
-	*x = RemoveRemoteClusterRequest{}
-	mi := &file_temporal_server_api_adminservice_v1_request_response_proto_msgTypes[41]
+func (x *GetNamespaceReplicationMessagesResponse) Reset() {
+	*x = GetNamespaceReplicationMessagesResponse{}
+	mi := &file_temporal_server_api_adminservice_v1_request_response_proto_msgTypes[40]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *RemoveRemoteClusterRequest) String() string {
+func (x *GetNamespaceReplicationMessagesResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*RemoveRemoteClusterRequest) ProtoMessage() {}
+func (*GetNamespaceReplicationMessagesResponse) ProtoMessage() {}
 
-func (x *RemoveRemoteClusterRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_temporal_server_api_adminservice_v1_request_response_proto_msgTypes[41]
+func (x *GetNamespaceReplicationMessagesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_temporal_server_api_adminservice_v1_request_response_proto_msgTypes[40]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2485,39 +2601,40 @@ func (x *RemoveRemoteClusterRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use RemoveRemoteClusterRequest.ProtoReflect.Descriptor instead.
-func (*RemoveRemoteClusterRequest) Descriptor() ([]byte, []int) {
-	return file_temporal_server_api_adminservice_v1_request_response_proto_rawDescGZIP(), []int{41}
+// Deprecated: Use GetNamespaceReplicationMessagesResponse.ProtoReflect.Descriptor instead.
+func (*GetNamespaceReplicationMessagesResponse) Descriptor() ([]byte, []int) {
+	return file_temporal_server_api_adminservice_v1_request_response_proto_rawDescGZIP(), []int{40}
 }
 
-func (x *RemoveRemoteClusterRequest) GetClusterName() string {
+func (x *GetNamespaceReplicationMessagesResponse) GetMessages() *v16.ReplicationMessages {
 	if x != nil {
-		return x.ClusterName
+		return x.Messages
 	}
-	return ""
+	return nil
 }
 
-type RemoveRemoteClusterResponse struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
+type GetDLQReplicationMessagesRequest struct {
+	state         protoimpl.MessageState     `protogen:"open.v1"`
+	TaskInfos     []*v16.ReplicationTaskInfo `protobuf:"bytes,1,rep,name=task_infos,json=taskInfos,proto3" json:"task_infos,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *RemoveRemoteClusterResponse) Reset() {
-	*x = RemoveRemoteClusterResponse{}
-	mi := &file_temporal_server_api_adminservice_v1_request_response_proto_msgTypes[42]
+func (x *GetDLQReplicationMessagesRequest) Reset() {
+	*x = GetDLQReplicationMessagesRequest{}
+	mi := &file_temporal_server_api_adminservice_v1_request_response_proto_msgTypes[41]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *RemoveRemoteClusterResponse) String() string {
+func (x *GetDLQReplicationMessagesRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*RemoveRemoteClusterResponse) ProtoMessage() {}
+func (*GetDLQReplicationMessagesRequest) ProtoMessage() {}
 
-func (x *RemoveRemoteClusterResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_temporal_server_api_adminservice_v1_request_response_proto_msgTypes[42]
+func (x *GetDLQReplicationMessagesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_temporal_server_api_adminservice_v1_request_response_proto_msgTypes[41]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2528,45 +2645,40 @@ func (x *RemoveRemoteClusterResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use RemoveRemoteClusterResponse.ProtoReflect.Descriptor instead.
-func (*RemoveRemoteClusterResponse) Descriptor() ([]byte, []int) {
-	return file_temporal_server_api_adminservice_v1_request_response_proto_rawDescGZIP(), []int{42}
+// Deprecated: Use GetDLQReplicationMessagesRequest.ProtoReflect.Descriptor instead.
+func (*GetDLQReplicationMessagesRequest) Descriptor() ([]byte, []int) {
+	return file_temporal_server_api_adminservice_v1_request_response_proto_rawDescGZIP(), []int{41}
 }
 
-type ListClusterMembersRequest struct {
-	state protoimpl.MessageState `protogen:"open.v1"`
-	// (-- api-linter: core::0140::prepositions=disabled
-	//
-	//	aip.dev/not-precedent: "within" is used to indicate a time range. --)
-	LastHeartbeatWithin *durationpb.Duration  `protobuf:"bytes,1,opt,name=last_heartbeat_within,json=lastHeartbeatWithin,proto3" json:"last_heartbeat_within,omitempty"`
-	RpcAddress          string                `protobuf:"bytes,2,opt,name=rpc_address,json=rpcAddress,proto3" json:"rpc_address,omitempty"`
-	HostId              string                `protobuf:"bytes,3,opt,name=host_id,json=hostId,proto3" json:"host_id,omitempty"`
-	Role                v14.ClusterMemberRole `protobuf:"varint,4,opt,name=role,proto3,enum=temporal.server.api.enums.v1.ClusterMemberRole" json:"role,omitempty"`
-	// (-- api-linter: core::0140::prepositions=disabled
-	//
-	//	aip.dev/not-precedent: "after" is used to indicate a time range. --)
-	SessionStartedAfterTime *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=session_started_after_time,json=sessionStartedAfterTime,proto3" json:"session_started_after_time,omitempty"`
-	PageSize                int32                  `protobuf:"varint,6,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
-	NextPageToken           []byte                 `protobuf:"bytes,7,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"`
-	unknownFields           protoimpl.UnknownFields
-	sizeCache               protoimpl.SizeCache
+func (x *GetDLQReplicationMessagesRequest) GetTaskInfos() []*v16.ReplicationTaskInfo {
+	if x != nil {
+		return x.TaskInfos
+	}
+	return nil
 }
 
-func (x *ListClusterMembersRequest) Reset() {
-	*x = ListClusterMembersRequest{}
-	mi := &file_temporal_server_api_adminservice_v1_request_response_proto_msgTypes[43]
+type GetDLQReplicationMessagesResponse struct {
+	state            protoimpl.MessageState `protogen:"open.v1"`
+	ReplicationTasks []*v16.ReplicationTask `protobuf:"bytes,1,rep,name=replication_tasks,json=replicationTasks,proto3" json:"replication_tasks,omitempty"`
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *GetDLQReplicationMessagesResponse) Reset() {
+	*x = GetDLQReplicationMessagesResponse{}
+	mi := &file_temporal_server_api_adminservice_v1_request_response_proto_msgTypes[42]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ListClusterMembersRequest) String() string {
+func (x *GetDLQReplicationMessagesResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ListClusterMembersRequest) ProtoMessage() {}
+func (*GetDLQReplicationMessagesResponse) ProtoMessage() {}
 
-func (x *ListClusterMembersRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_temporal_server_api_adminservice_v1_request_response_proto_msgTypes[43]
+func (x *GetDLQReplicationMessagesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_temporal_server_api_adminservice_v1_request_response_proto_msgTypes[42]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2577,82 +2689,99 @@ func (x *ListClusterMembersRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ListClusterMembersRequest.ProtoReflect.Descriptor instead.
-func (*ListClusterMembersRequest) Descriptor() ([]byte, []int) {
-	return file_temporal_server_api_adminservice_v1_request_response_proto_rawDescGZIP(), []int{43}
+// Deprecated: Use GetDLQReplicationMessagesResponse.ProtoReflect.Descriptor instead.
+func (*GetDLQReplicationMessagesResponse) Descriptor() ([]byte, []int) {
+	return file_temporal_server_api_adminservice_v1_request_response_proto_rawDescGZIP(), []int{42}
 }
 
-func (x *ListClusterMembersRequest) GetLastHeartbeatWithin() *durationpb.Duration {
+func (x *GetDLQReplicationMessagesResponse) GetReplicationTasks() []*v16.ReplicationTask {
 	if x != nil {
-		return x.LastHeartbeatWithin
+		return x.ReplicationTasks
 	}
 	return nil
 }
 
-func (x *ListClusterMembersRequest) GetRpcAddress() string {
-	if x != nil {
-		return x.RpcAddress
-	}
-	return ""
+// ReapplyEventsRequest is the request for reapply events API.
+type ReapplyEventsRequest struct {
+	state             protoimpl.MessageState `protogen:"open.v1"`
+	NamespaceId       string                 `protobuf:"bytes,4,opt,name=namespace_id,json=namespaceId,proto3" json:"namespace_id,omitempty"`
+	WorkflowExecution *v1.WorkflowExecution  `protobuf:"bytes,2,opt,name=workflow_execution,json=workflowExecution,proto3" json:"workflow_execution,omitempty"`
+	Events            *v1.DataBlob           `protobuf:"bytes,3,opt,name=events,proto3" json:"events,omitempty"`
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
 }
 
-func (x *ListClusterMembersRequest) GetHostId() string {
-	if x != nil {
-		return x.HostId
-	}
-	return ""
+func (x *ReapplyEventsRequest) Reset() {
+	*x = ReapplyEventsRequest{}
+	mi := &file_temporal_server_api_adminservice_v1_request_response_proto_msgTypes[43]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ReapplyEventsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
 }
 
-func (x *ListClusterMembersRequest) GetRole() v14.ClusterMemberRole {
+func (*ReapplyEventsRequest) ProtoMessage() {}
+
+func (x *ReapplyEventsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_temporal_server_api_adminservice_v1_request_response_proto_msgTypes[43]
 	if x != nil {
-		return x.Role
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return v14.ClusterMemberRole(0)
+	return mi.MessageOf(x)
 }
 
-func (x *ListClusterMembersRequest) GetSessionStartedAfterTime() *timestamppb.Timestamp {
+// Deprecated: Use ReapplyEventsRequest.ProtoReflect.Descriptor instead.
+func (*ReapplyEventsRequest) Descriptor() ([]byte, []int) {
+	return file_temporal_server_api_adminservice_v1_request_response_proto_rawDescGZIP(), []int{43}
+}
+
+func (x *ReapplyEventsRequest) GetNamespaceId() string {
 	if x != nil {
-		return x.SessionStartedAfterTime
+		return x.NamespaceId
 	}
-	return nil
+	return ""
 }
 
-func (x *ListClusterMembersRequest) GetPageSize() int32 {
+func (x *ReapplyEventsRequest) GetWorkflowExecution() *v1.WorkflowExecution {
 	if x != nil {
-		return x.PageSize
+		return x.WorkflowExecution
 	}
-	return 0
+	return nil
 }
 
-func (x *ListClusterMembersRequest) GetNextPageToken() []byte {
+func (x *ReapplyEventsRequest) GetEvents() *v1.DataBlob {
 	if x != nil {
-		return x.NextPageToken
+		return x.Events
 	}
 	return nil
 }
 
-type ListClusterMembersResponse struct {
+type ReapplyEventsResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	ActiveMembers []*v18.ClusterMember   `protobuf:"bytes,1,rep,name=active_members,json=activeMembers,proto3" json:"active_members,omitempty"`
-	NextPageToken []byte                 `protobuf:"bytes,2,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ListClusterMembersResponse) Reset() {
-	*x = ListClusterMembersResponse{}
+func (x *ReapplyEventsResponse) Reset() {
+	*x = ReapplyEventsResponse{}
 	mi := &file_temporal_server_api_adminservice_v1_request_response_proto_msgTypes[44]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ListClusterMembersResponse) String() string {
+func (x *ReapplyEventsResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ListClusterMembersResponse) ProtoMessage() {}
+func (*ReapplyEventsResponse) ProtoMessage() {}
 
-func (x *ListClusterMembersResponse) ProtoReflect() protoreflect.Message {
+func (x *ReapplyEventsResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_temporal_server_api_adminservice_v1_request_response_proto_msgTypes[44]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2664,51 +2793,35 @@ func (x *ListClusterMembersResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ListClusterMembersResponse.ProtoReflect.Descriptor instead.
-func (*ListClusterMembersResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use ReapplyEventsResponse.ProtoReflect.Descriptor instead.
+func (*ReapplyEventsResponse) Descriptor() ([]byte, []int) {
 	return file_temporal_server_api_adminservice_v1_request_response_proto_rawDescGZIP(), []int{44}
 }
 
-func (x *ListClusterMembersResponse) GetActiveMembers() []*v18.ClusterMember {
-	if x != nil {
-		return x.ActiveMembers
-	}
-	return nil
-}
-
-func (x *ListClusterMembersResponse) GetNextPageToken() []byte {
-	if x != nil {
-		return x.NextPageToken
-	}
-	return nil
-}
-
-type GetDLQMessagesRequest struct {
-	state                 protoimpl.MessageState  `protogen:"open.v1"`
-	Type                  v14.DeadLetterQueueType `protobuf:"varint,1,opt,name=type,proto3,enum=temporal.server.api.enums.v1.DeadLetterQueueType" json:"type,omitempty"`
-	ShardId               int32                   `protobuf:"varint,2,opt,name=shard_id,json=shardId,proto3" json:"shard_id,omitempty"`
-	SourceCluster         string                  `protobuf:"bytes,3,opt,name=source_cluster,json=sourceCluster,proto3" json:"source_cluster,omitempty"`
-	InclusiveEndMessageId int64                   `protobuf:"varint,4,opt,name=inclusive_end_message_id,json=inclusiveEndMessageId,proto3" json:"inclusive_end_message_id,omitempty"`
-	MaximumPageSize       int32                   `protobuf:"varint,5,opt,name=maximum_page_size,json=maximumPageSize,proto3" json:"maximum_page_size,omitempty"`
-	NextPageToken         []byte                  `protobuf:"bytes,6,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"`
-	unknownFields         protoimpl.UnknownFields
-	sizeCache             protoimpl.SizeCache
+type AddSearchAttributesRequest struct {
+	state            protoimpl.MessageState          `protogen:"open.v1"`
+	SearchAttributes map[string]v12.IndexedValueType `protobuf:"bytes,1,rep,name=search_attributes,json=searchAttributes,proto3" json:"search_attributes,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"varint,2,opt,name=value,enum=temporal.api.enums.v1.IndexedValueType"`
+	IndexName        string                          `protobuf:"bytes,2,opt,name=index_name,json=indexName,proto3" json:"index_name,omitempty"`
+	SkipSchemaUpdate bool                            `protobuf:"varint,3,opt,name=skip_schema_update,json=skipSchemaUpdate,proto3" json:"skip_schema_update,omitempty"`
+	Namespace        string                          `protobuf:"bytes,4,opt,name=namespace,proto3" json:"namespace,omitempty"`
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
 }
 
-func (x *GetDLQMessagesRequest) Reset() {
-	*x = GetDLQMessagesRequest{}
+func (x *AddSearchAttributesRequest) Reset() {
+	*x = AddSearchAttributesRequest{}
 	mi := &file_temporal_server_api_adminservice_v1_request_response_proto_msgTypes[45]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetDLQMessagesRequest) String() string {
+func (x *AddSearchAttributesRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetDLQMessagesRequest) ProtoMessage() {}
+func (*AddSearchAttributesRequest) ProtoMessage() {}
 
-func (x *GetDLQMessagesRequest) ProtoReflect() protoreflect.Message {
+func (x *AddSearchAttributesRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_temporal_server_api_adminservice_v1_request_response_proto_msgTypes[45]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2720,77 +2833,59 @@ func (x *GetDLQMessagesRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetDLQMessagesRequest.ProtoReflect.Descriptor instead.
-func (*GetDLQMessagesRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use AddSearchAttributesRequest.ProtoReflect.Descriptor instead.
+func (*AddSearchAttributesRequest) Descriptor() ([]byte, []int) {
 	return file_temporal_server_api_adminservice_v1_request_response_proto_rawDescGZIP(), []int{45}
 }
 
-func (x *GetDLQMessagesRequest) GetType() v14.DeadLetterQueueType {
+func (x *AddSearchAttributesRequest) GetSearchAttributes() map[string]v12.IndexedValueType {
 	if x != nil {
-		return x.Type
+		return x.SearchAttributes
 	}
-	return v14.DeadLetterQueueType(0)
+	return nil
 }
 
-func (x *GetDLQMessagesRequest) GetShardId() int32 {
+func (x *AddSearchAttributesRequest) GetIndexName() string {
 	if x != nil {
-		return x.ShardId
-	}
-	return 0
-}
-
-func (x *GetDLQMessagesRequest) GetSourceCluster() string {
-	if x != nil {
-		return x.SourceCluster
+		return x.IndexName
 	}
 	return ""
 }
 
-func (x *GetDLQMessagesRequest) GetInclusiveEndMessageId() int64 {
-	if x != nil {
-		return x.InclusiveEndMessageId
-	}
-	return 0
-}
-
-func (x *GetDLQMessagesRequest) GetMaximumPageSize() int32 {
+func (x *AddSearchAttributesRequest) GetSkipSchemaUpdate() bool {
 	if x != nil {
-		return x.MaximumPageSize
+		return x.SkipSchemaUpdate
 	}
-	return 0
+	return false
 }
 
-func (x *GetDLQMessagesRequest) GetNextPageToken() []byte {
+func (x *AddSearchAttributesRequest) GetNamespace() string {
 	if x != nil {
-		return x.NextPageToken
+		return x.Namespace
 	}
-	return nil
+	return ""
 }
 
-type GetDLQMessagesResponse struct {
-	state                protoimpl.MessageState     `protogen:"open.v1"`
-	Type                 v14.DeadLetterQueueType    `protobuf:"varint,1,opt,name=type,proto3,enum=temporal.server.api.enums.v1.DeadLetterQueueType" json:"type,omitempty"`
-	ReplicationTasks     []*v15.ReplicationTask     `protobuf:"bytes,2,rep,name=replication_tasks,json=replicationTasks,proto3" json:"replication_tasks,omitempty"`
-	NextPageToken        []byte                     `protobuf:"bytes,3,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"`
-	ReplicationTasksInfo []*v15.ReplicationTaskInfo `protobuf:"bytes,4,rep,name=replication_tasks_info,json=replicationTasksInfo,proto3" json:"replication_tasks_info,omitempty"`
-	unknownFields        protoimpl.UnknownFields
-	sizeCache            protoimpl.SizeCache
+type AddSearchAttributesResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetDLQMessagesResponse) Reset() {
-	*x = GetDLQMessagesResponse{}
+func (x *AddSearchAttributesResponse) Reset() {
+	*x = AddSearchAttributesResponse{}
 	mi := &file_temporal_server_api_adminservice_v1_request_response_proto_msgTypes[46]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetDLQMessagesResponse) String() string {
+func (x *AddSearchAttributesResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetDLQMessagesResponse) ProtoMessage() {}
+func (*AddSearchAttributesResponse) ProtoMessage() {}
 
-func (x *GetDLQMessagesResponse) ProtoReflect() protoreflect.Message {
+func (x *AddSearchAttributesResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_temporal_server_api_adminservice_v1_request_response_proto_msgTypes[46]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2802,63 +2897,34 @@ func (x *GetDLQMessagesResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetDLQMessagesResponse.ProtoReflect.Descriptor instead.
-func (*GetDLQMessagesResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use AddSearchAttributesResponse.ProtoReflect.Descriptor instead.
+func (*AddSearchAttributesResponse) Descriptor() ([]byte, []int) {
 	return file_temporal_server_api_adminservice_v1_request_response_proto_rawDescGZIP(), []int{46}
 }
 
-func (x *GetDLQMessagesResponse) GetType() v14.DeadLetterQueueType {
-	if x != nil {
-		return x.Type
-	}
-	return v14.DeadLetterQueueType(0)
-}
-
-func (x *GetDLQMessagesResponse) GetReplicationTasks() []*v15.ReplicationTask {
-	if x != nil {
-		return x.ReplicationTasks
-	}
-	return nil
-}
-
-func (x *GetDLQMessagesResponse) GetNextPageToken() []byte {
-	if x != nil {
-		return x.NextPageToken
-	}
-	return nil
-}
-
-func (x *GetDLQMessagesResponse) GetReplicationTasksInfo() []*v15.ReplicationTaskInfo {
-	if x != nil {
-		return x.ReplicationTasksInfo
-	}
-	return nil
-}
-
-type PurgeDLQMessagesRequest struct {
-	state                 protoimpl.MessageState  `protogen:"open.v1"`
-	Type                  v14.DeadLetterQueueType `protobuf:"varint,1,opt,name=type,proto3,enum=temporal.server.api.enums.v1.DeadLetterQueueType" json:"type,omitempty"`
-	ShardId               int32                   `protobuf:"varint,2,opt,name=shard_id,json=shardId,proto3" json:"shard_id,omitempty"`
-	SourceCluster         string                  `protobuf:"bytes,3,opt,name=source_cluster,json=sourceCluster,proto3" json:"source_cluster,omitempty"`
-	InclusiveEndMessageId int64                   `protobuf:"varint,4,opt,name=inclusive_end_message_id,json=inclusiveEndMessageId,proto3" json:"inclusive_end_message_id,omitempty"`
-	unknownFields         protoimpl.UnknownFields
-	sizeCache             protoimpl.SizeCache
+type RemoveSearchAttributesRequest struct {
+	state            protoimpl.MessageState `protogen:"open.v1"`
+	SearchAttributes []string               `protobuf:"bytes,1,rep,name=search_attributes,json=searchAttributes,proto3" json:"search_attributes,omitempty"`
+	IndexName        string                 `protobuf:"bytes,2,opt,name=index_name,json=indexName,proto3" json:"index_name,omitempty"`
+	Namespace        string                 `protobuf:"bytes,3,opt,name=namespace,proto3" json:"namespace,omitempty"`
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
 }
 
-func (x *PurgeDLQMessagesRequest) Reset() {
-	*x = PurgeDLQMessagesRequest{}
+func (x *RemoveSearchAttributesRequest) Reset() {
+	*x = RemoveSearchAttributesRequest{}
 	mi := &file_temporal_server_api_adminservice_v1_request_response_proto_msgTypes[47]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *PurgeDLQMessagesRequest) String() string {
+func (x *RemoveSearchAttributesRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*PurgeDLQMessagesRequest) ProtoMessage() {}
+func (*RemoveSearchAttributesRequest) ProtoMessage() {}
 
-func (x *PurgeDLQMessagesRequest) ProtoReflect() protoreflect.Message {
+func (x *RemoveSearchAttributesRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_temporal_server_api_adminservice_v1_request_response_proto_msgTypes[47]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2870,59 +2936,52 @@ func (x *PurgeDLQMessagesRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use PurgeDLQMessagesRequest.ProtoReflect.Descriptor instead.
-func (*PurgeDLQMessagesRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use RemoveSearchAttributesRequest.ProtoReflect.Descriptor instead.
+func (*RemoveSearchAttributesRequest) Descriptor() ([]byte, []int) {
 	return file_temporal_server_api_adminservice_v1_request_response_proto_rawDescGZIP(), []int{47}
 }
 
-func (x *PurgeDLQMessagesRequest) GetType() v14.DeadLetterQueueType {
-	if x != nil {
-		return x.Type
-	}
-	return v14.DeadLetterQueueType(0)
-}
-
-func (x *PurgeDLQMessagesRequest) GetShardId() int32 {
+func (x *RemoveSearchAttributesRequest) GetSearchAttributes() []string {
 	if x != nil {
-		return x.ShardId
+		return x.SearchAttributes
 	}
-	return 0
+	return nil
 }
 
-func (x *PurgeDLQMessagesRequest) GetSourceCluster() string {
+func (x *RemoveSearchAttributesRequest) GetIndexName() string {
 	if x != nil {
-		return x.SourceCluster
+		return x.IndexName
 	}
 	return ""
 }
 
-func (x *PurgeDLQMessagesRequest) GetInclusiveEndMessageId() int64 {
+func (x *RemoveSearchAttributesRequest) GetNamespace() string {
 	if x != nil {
-		return x.InclusiveEndMessageId
+		return x.Namespace
 	}
-	return 0
+	return ""
 }
 
-type PurgeDLQMessagesResponse struct {
+type RemoveSearchAttributesResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *PurgeDLQMessagesResponse) Reset() {
-	*x = PurgeDLQMessagesResponse{}
+func (x *RemoveSearchAttributesResponse) Reset() {
+	*x = RemoveSearchAttributesResponse{}
 	mi := &file_temporal_server_api_adminservice_v1_request_response_proto_msgTypes[48]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *PurgeDLQMessagesResponse) String() string {
+func (x *RemoveSearchAttributesResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*PurgeDLQMessagesResponse) ProtoMessage() {}
+func (*RemoveSearchAttributesResponse) ProtoMessage() {}
 
-func (x *PurgeDLQMessagesResponse) ProtoReflect() protoreflect.Message {
+func (x *RemoveSearchAttributesResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_temporal_server_api_adminservice_v1_request_response_proto_msgTypes[48]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2934,37 +2993,33 @@ func (x *PurgeDLQMessagesResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use PurgeDLQMessagesResponse.ProtoReflect.Descriptor instead.
-func (*PurgeDLQMessagesResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use RemoveSearchAttributesResponse.ProtoReflect.Descriptor instead.
+func (*RemoveSearchAttributesResponse) Descriptor() ([]byte, []int) {
 	return file_temporal_server_api_adminservice_v1_request_response_proto_rawDescGZIP(), []int{48}
 }
 
-type MergeDLQMessagesRequest struct {
-	state                 protoimpl.MessageState  `protogen:"open.v1"`
-	Type                  v14.DeadLetterQueueType `protobuf:"varint,1,opt,name=type,proto3,enum=temporal.server.api.enums.v1.DeadLetterQueueType" json:"type,omitempty"`
-	ShardId               int32                   `protobuf:"varint,2,opt,name=shard_id,json=shardId,proto3" json:"shard_id,omitempty"`
-	SourceCluster         string                  `protobuf:"bytes,3,opt,name=source_cluster,json=sourceCluster,proto3" json:"source_cluster,omitempty"`
-	InclusiveEndMessageId int64                   `protobuf:"varint,4,opt,name=inclusive_end_message_id,json=inclusiveEndMessageId,proto3" json:"inclusive_end_message_id,omitempty"`
-	MaximumPageSize       int32                   `protobuf:"varint,5,opt,name=maximum_page_size,json=maximumPageSize,proto3" json:"maximum_page_size,omitempty"`
-	NextPageToken         []byte                  `protobuf:"bytes,6,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"`
-	unknownFields         protoimpl.UnknownFields
-	sizeCache             protoimpl.SizeCache
+type GetSearchAttributesRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	IndexName     string                 `protobuf:"bytes,1,opt,name=index_name,json=indexName,proto3" json:"index_name,omitempty"`
+	Namespace     string                 `protobuf:"bytes,2,opt,name=namespace,proto3" json:"namespace,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *MergeDLQMessagesRequest) Reset() {
-	*x = MergeDLQMessagesRequest{}
+func (x *GetSearchAttributesRequest) Reset() {
+	*x = GetSearchAttributesRequest{}
 	mi := &file_temporal_server_api_adminservice_v1_request_response_proto_msgTypes[49]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *MergeDLQMessagesRequest) String() string {
+func (x *GetSearchAttributesRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*MergeDLQMessagesRequest) ProtoMessage() {}
+func (*GetSearchAttributesRequest) ProtoMessage() {}
 
-func (x *MergeDLQMessagesRequest) ProtoReflect() protoreflect.Message {
+func (x *GetSearchAttributesRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_temporal_server_api_adminservice_v1_request_response_proto_msgTypes[49]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2976,75 +3031,122 @@ func (x *MergeDLQMessagesRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use MergeDLQMessagesRequest.ProtoReflect.Descriptor instead.
-func (*MergeDLQMessagesRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use GetSearchAttributesRequest.ProtoReflect.Descriptor instead.
+func (*GetSearchAttributesRequest) Descriptor() ([]byte, []int) {
 	return file_temporal_server_api_adminservice_v1_request_response_proto_rawDescGZIP(), []int{49}
 }
 
-func (x *MergeDLQMessagesRequest) GetType() v14.DeadLetterQueueType {
+func (x *GetSearchAttributesRequest) GetIndexName() string {
 	if x != nil {
-		return x.Type
+		return x.IndexName
 	}
-	return v14.DeadLetterQueueType(0)
+	return ""
 }
 
-func (x *MergeDLQMessagesRequest) GetShardId() int32 {
+func (x *GetSearchAttributesRequest) GetNamespace() string {
 	if x != nil {
-		return x.ShardId
+		return x.Namespace
 	}
-	return 0
+	return ""
 }
 
-func (x *MergeDLQMessagesRequest) GetSourceCluster() string {
+type GetSearchAttributesResponse struct {
+	state            protoimpl.MessageState          `protogen:"open.v1"`
+	CustomAttributes map[string]v12.IndexedValueType `protobuf:"bytes,1,rep,name=custom_attributes,json=customAttributes,proto3" json:"custom_attributes,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"varint,2,opt,name=value,enum=temporal.api.enums.v1.IndexedValueType"`
+	SystemAttributes map[string]v12.IndexedValueType `protobuf:"bytes,2,rep,name=system_attributes,json=systemAttributes,proto3" json:"system_attributes,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"varint,2,opt,name=value,enum=temporal.api.enums.v1.IndexedValueType"`
+	Mapping          map[string]string               `protobuf:"bytes,3,rep,name=mapping,proto3" json:"mapping,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	// State of the workflow that adds search attributes to the system.
+	AddWorkflowExecutionInfo *v17.WorkflowExecutionInfo `protobuf:"bytes,4,opt,name=add_workflow_execution_info,json=addWorkflowExecutionInfo,proto3" json:"add_workflow_execution_info,omitempty"`
+	unknownFields            protoimpl.UnknownFields
+	sizeCache                protoimpl.SizeCache
+}
+
+func (x *GetSearchAttributesResponse) Reset() {
+	*x = GetSearchAttributesResponse{}
+	mi := &file_temporal_server_api_adminservice_v1_request_response_proto_msgTypes[50]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetSearchAttributesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetSearchAttributesResponse) ProtoMessage() {}
+
+func (x *GetSearchAttributesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_temporal_server_api_adminservice_v1_request_response_proto_msgTypes[50]
 	if x != nil {
-		return x.SourceCluster
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return ""
+	return mi.MessageOf(x)
 }
 
-func (x *MergeDLQMessagesRequest) GetInclusiveEndMessageId() int64 {
+// Deprecated: Use GetSearchAttributesResponse.ProtoReflect.Descriptor instead.
+func (*GetSearchAttributesResponse) Descriptor() ([]byte, []int) {
+	return file_temporal_server_api_adminservice_v1_request_response_proto_rawDescGZIP(), []int{50}
+}
+
+func (x *GetSearchAttributesResponse) GetCustomAttributes() map[string]v12.IndexedValueType {
 	if x != nil {
-		return x.InclusiveEndMessageId
+		return x.CustomAttributes
 	}
-	return 0
+	return nil
 }
 
-func (x *MergeDLQMessagesRequest) GetMaximumPageSize() int32 {
+func (x *GetSearchAttributesResponse) GetSystemAttributes() map[string]v12.IndexedValueType {
 	if x != nil {
-		return x.MaximumPageSize
+		return x.SystemAttributes
 	}
-	return 0
+	return nil
 }
 
-func (x *MergeDLQMessagesRequest) GetNextPageToken() []byte {
+func (x *GetSearchAttributesResponse) GetMapping() map[string]string {
 	if x != nil {
-		return x.NextPageToken
+		return x.Mapping
 	}
 	return nil
 }
 
-type MergeDLQMessagesResponse struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	NextPageToken []byte                 `protobuf:"bytes,1,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"`
+func (x *GetSearchAttributesResponse) GetAddWorkflowExecutionInfo() *v17.WorkflowExecutionInfo {
+	if x != nil {
+		return x.AddWorkflowExecutionInfo
+	}
+	return nil
+}
+
+type AggregateWorkflowExecutionsRequest struct {
+	state     protoimpl.MessageState `protogen:"open.v1"`
+	Namespace string                 `protobuf:"bytes,1,opt,name=namespace,proto3" json:"namespace,omitempty"`
+	// Visibility query selecting the workflow executions to aggregate. ORDER BY and GROUP BY clauses are not supported.
+	Query string `protobuf:"bytes,2,opt,name=query,proto3" json:"query,omitempty"`
+	// Keyword search attributes to group the workflow executions by. All workflow executions are
+	// aggregated as a single group if empty.
+	GroupBy       []string                 `protobuf:"bytes,3,rep,name=group_by,json=groupBy,proto3" json:"group_by,omitempty"`
+	Aggregations  []*VisibilityAggregation `protobuf:"bytes,4,rep,name=aggregations,proto3" json:"aggregations,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *MergeDLQMessagesResponse) Reset() {
-	*x = MergeDLQMessagesResponse{}
-	mi := &file_temporal_server_api_adminservice_v1_request_response_proto_msgTypes[50]
+func (x *AggregateWorkflowExecutionsRequest) Reset() {
+	*x = AggregateWorkflowExecutionsRequest{}
+	mi := &file_temporal_server_api_adminservice_v1_request_response_proto_msgTypes[51]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *MergeDLQMessagesResponse) String() string {
+func (x *AggregateWorkflowExecutionsRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*MergeDLQMessagesResponse) ProtoMessage() {}
+func (*AggregateWorkflowExecutionsRequest) ProtoMessage() {}
 
-func (x *MergeDLQMessagesResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_temporal_server_api_adminservice_v1_request_response_proto_msgTypes[50]
+func (x *AggregateWorkflowExecutionsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_temporal_server_api_adminservice_v1_request_response_proto_msgTypes[51]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3055,90 +3157,61 @@ func (x *MergeDLQMessagesResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use MergeDLQMessagesResponse.ProtoReflect.Descriptor instead.
-func (*MergeDLQMessagesResponse) Descriptor() ([]byte, []int) {
-	return file_temporal_server_api_adminservice_v1_request_response_proto_rawDescGZIP(), []int{50}
+// Deprecated: Use AggregateWorkflowExecutionsRequest.ProtoReflect.Descriptor instead.
+func (*AggregateWorkflowExecutionsRequest) Descriptor() ([]byte, []int) {
+	return file_temporal_server_api_adminservice_v1_request_response_proto_rawDescGZIP(), []int{51}
 }
 
-func (x *MergeDLQMessagesResponse) GetNextPageToken() []byte {
+func (x *AggregateWorkflowExecutionsRequest) GetNamespace() string {
 	if x != nil {
-		return x.NextPageToken
+		return x.Namespace
 	}
-	return nil
+	return ""
 }
 
-type RefreshWorkflowTasksRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	NamespaceId   string                 `protobuf:"bytes,3,opt,name=namespace_id,json=namespaceId,proto3" json:"namespace_id,omitempty"`
-	Execution     *v1.WorkflowExecution  `protobuf:"bytes,2,opt,name=execution,proto3" json:"execution,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
-}
-
-func (x *RefreshWorkflowTasksRequest) Reset() {
-	*x = RefreshWorkflowTasksRequest{}
-	mi := &file_temporal_server_api_adminservice_v1_request_response_proto_msgTypes[51]
-	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-	ms.StoreMessageInfo(mi)
-}
-
-func (x *RefreshWorkflowTasksRequest) String() string {
-	return protoimpl.X.MessageStringOf(x)
-}
-
-func (*RefreshWorkflowTasksRequest) ProtoMessage() {}
-
-func (x *RefreshWorkflowTasksRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_temporal_server_api_adminservice_v1_request_response_proto_msgTypes[51]
+func (x *AggregateWorkflowExecutionsRequest) GetQuery() string {
 	if x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+		return x.Query
 	}
-	return mi.MessageOf(x)
-}
-
-// Deprecated: Use RefreshWorkflowTasksRequest.ProtoReflect.Descriptor instead.
-func (*RefreshWorkflowTasksRequest) Descriptor() ([]byte, []int) {
-	return file_temporal_server_api_adminservice_v1_request_response_proto_rawDescGZIP(), []int{51}
+	return ""
 }
 
-func (x *RefreshWorkflowTasksRequest) GetNamespaceId() string {
+func (x *AggregateWorkflowExecutionsRequest) GetGroupBy() []string {
 	if x != nil {
-		return x.NamespaceId
+		return x.GroupBy
 	}
-	return ""
+	return nil
 }
 
-func (x *RefreshWorkflowTasksRequest) GetExecution() *v1.WorkflowExecution {
+func (x *AggregateWorkflowExecutionsRequest) GetAggregations() []*VisibilityAggregation {
 	if x != nil {
-		return x.Execution
+		return x.Aggregations
 	}
 	return nil
 }
 
-type RefreshWorkflowTasksResponse struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
+type AggregateWorkflowExecutionsResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Groups ordered by descending count.
+	Groups        []*VisibilityAggregationGroup `protobuf:"bytes,1,rep,name=groups,proto3" json:"groups,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *RefreshWorkflowTasksResponse) Reset() {
-	*x = RefreshWorkflowTasksResponse{}
+func (x *AggregateWorkflowExecutionsResponse) Reset() {
+	*x = AggregateWorkflowExecutionsResponse{}
 	mi := &file_temporal_server_api_adminservice_v1_request_response_proto_msgTypes[52]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *RefreshWorkflowTasksResponse) String() string {
+func (x *AggregateWorkflowExecutionsResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*RefreshWorkflowTasksResponse) ProtoMessage() {}
+func (*AggregateWorkflowExecutionsResponse) ProtoMessage() {}
 
-func (x *RefreshWorkflowTasksResponse) ProtoReflect() protoreflect.Message {
+func (x *AggregateWorkflowExecutionsResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_temporal_server_api_adminservice_v1_request_response_proto_msgTypes[52]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -3150,39 +3223,43 @@ func (x *RefreshWorkflowTasksResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use RefreshWorkflowTasksResponse.ProtoReflect.Descriptor instead.
-func (*RefreshWorkflowTasksResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use AggregateWorkflowExecutionsResponse.ProtoReflect.Descriptor instead.
+func (*AggregateWorkflowExecutionsResponse) Descriptor() ([]byte, []int) {
 	return file_temporal_server_api_adminservice_v1_request_response_proto_rawDescGZIP(), []int{52}
 }
 
-type ResendReplicationTasksRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	NamespaceId   string                 `protobuf:"bytes,1,opt,name=namespace_id,json=namespaceId,proto3" json:"namespace_id,omitempty"`
-	WorkflowId    string                 `protobuf:"bytes,2,opt,name=workflow_id,json=workflowId,proto3" json:"workflow_id,omitempty"`
-	RunId         string                 `protobuf:"bytes,3,opt,name=run_id,json=runId,proto3" json:"run_id,omitempty"`
-	RemoteCluster string                 `protobuf:"bytes,4,opt,name=remote_cluster,json=remoteCluster,proto3" json:"remote_cluster,omitempty"`
-	StartEventId  int64                  `protobuf:"varint,5,opt,name=start_event_id,json=startEventId,proto3" json:"start_event_id,omitempty"`
-	StartVersion  int64                  `protobuf:"varint,6,opt,name=start_version,json=startVersion,proto3" json:"start_version,omitempty"`
-	EndEventId    int64                  `protobuf:"varint,7,opt,name=end_event_id,json=endEventId,proto3" json:"end_event_id,omitempty"`
-	EndVersion    int64                  `protobuf:"varint,8,opt,name=end_version,json=endVersion,proto3" json:"end_version,omitempty"`
+func (x *AggregateWorkflowExecutionsResponse) GetGroups() []*VisibilityAggregationGroup {
+	if x != nil {
+		return x.Groups
+	}
+	return nil
+}
+
+type VisibilityAggregation struct {
+	state    protoimpl.MessageState            `protogen:"open.v1"`
+	Function v13.VisibilityAggregationFunction `protobuf:"varint,1,opt,name=function,proto3,enum=temporal.server.api.enums.v1.VisibilityAggregationFunction" json:"function,omitempty"`
+	// Int, Double or Datetime search attribute to aggregate.
+	Field string `protobuf:"bytes,2,opt,name=field,proto3" json:"field,omitempty"`
+	// Percentiles to compute, between 0 and 100. Only used by the PERCENTILES function.
+	Percentiles   []float64 `protobuf:"fixed64,3,rep,packed,name=percentiles,proto3" json:"percentiles,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ResendReplicationTasksRequest) Reset() {
-	*x = ResendReplicationTasksRequest{}
+func (x *VisibilityAggregation) Reset() {
+	*x = VisibilityAggregation{}
 	mi := &file_temporal_server_api_adminservice_v1_request_response_proto_msgTypes[53]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ResendReplicationTasksRequest) String() string {
+func (x *VisibilityAggregation) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ResendReplicationTasksRequest) ProtoMessage() {}
+func (*VisibilityAggregation) ProtoMessage() {}
 
-func (x *ResendReplicationTasksRequest) ProtoReflect() protoreflect.Message {
+func (x *VisibilityAggregation) ProtoReflect() protoreflect.Message {
 	mi := &file_temporal_server_api_adminservice_v1_request_response_proto_msgTypes[53]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -3194,87 +3271,57 @@ func (x *ResendReplicationTasksRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ResendReplicationTasksRequest.ProtoReflect.Descriptor instead.
-func (*ResendReplicationTasksRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use VisibilityAggregation.ProtoReflect.Descriptor instead.
+func (*VisibilityAggregation) Descriptor() ([]byte, []int) {
 	return file_temporal_server_api_adminservice_v1_request_response_proto_rawDescGZIP(), []int{53}
 }
 
-func (x *ResendReplicationTasksRequest) GetNamespaceId() string {
-	if x != nil {
-		return x.NamespaceId
-	}
-	return ""
-}
-
-func (x *ResendReplicationTasksRequest) GetWorkflowId() string {
-	if x != nil {
-		return x.WorkflowId
-	}
-	return ""
-}
-
-func (x *ResendReplicationTasksRequest) GetRunId() string {
+func (x *VisibilityAggregation) GetFunction() v13.VisibilityAggregationFunction {
 	if x != nil {
-		return x.RunId
+		return x.Function
 	}
-	return ""
+	return v13.VisibilityAggregationFunction(0)
 }
 
-func (x *ResendReplicationTasksRequest) GetRemoteCluster() string {
+func (x *VisibilityAggregation) GetField() string {
 	if x != nil {
-		return x.RemoteCluster
+		return x.Field
 	}
 	return ""
 }
 
-func (x *ResendReplicationTasksRequest) GetStartEventId() int64 {
-	if x != nil {
-		return x.StartEventId
-	}
-	return 0
-}
-
-func (x *ResendReplicationTasksRequest) GetStartVersion() int64 {
-	if x != nil {
-		return x.StartVersion
-	}
-	return 0
-}
-
-func (x *ResendReplicationTasksRequest) GetEndEventId() int64 {
-	if x != nil {
-		return x.EndEventId
-	}
-	return 0
-}
-
-func (x *ResendReplicationTasksRequest) GetEndVersion() int64 {
+func (x *VisibilityAggregation) GetPercentiles() []float64 {
 	if x != nil {
-		return x.EndVersion
+		return x.Percentiles
 	}
-	return 0
+	return nil
 }
 
-type ResendReplicationTasksResponse struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
+type VisibilityAggregationGroup struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Values of the group by search attributes, in the order of the request.
+	GroupValues []*v1.Payload `protobuf:"bytes,1,rep,name=group_values,json=groupValues,proto3" json:"group_values,omitempty"`
+	Count       int64         `protobuf:"varint,2,opt,name=count,proto3" json:"count,omitempty"`
+	// Results of the aggregations, in the order of the request.
+	Results       []*VisibilityAggregationResult `protobuf:"bytes,3,rep,name=results,proto3" json:"results,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ResendReplicationTasksResponse) Reset() {
-	*x = ResendReplicationTasksResponse{}
+func (x *VisibilityAggregationGroup) Reset() {
+	*x = VisibilityAggregationGroup{}
 	mi := &file_temporal_server_api_adminservice_v1_request_response_proto_msgTypes[54]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ResendReplicationTasksResponse) String() string {
+func (x *VisibilityAggregationGroup) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ResendReplicationTasksResponse) ProtoMessage() {}
+func (*VisibilityAggregationGroup) ProtoMessage() {}
 
-func (x *ResendReplicationTasksResponse) ProtoReflect() protoreflect.Message {
+func (x *VisibilityAggregationGroup) ProtoReflect() protoreflect.Message {
 	mi := &file_temporal_server_api_adminservice_v1_request_response_proto_msgTypes[54]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -3286,40 +3333,58 @@ func (x *ResendReplicationTasksResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ResendReplicationTasksResponse.ProtoReflect.Descriptor instead.
-func (*ResendReplicationTasksResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use VisibilityAggregationGroup.ProtoReflect.Descriptor instead.
+func (*VisibilityAggregationGroup) Descriptor() ([]byte, []int) {
 	return file_temporal_server_api_adminservice_v1_request_response_proto_rawDescGZIP(), []int{54}
 }
 
-type GetTaskQueueTasksRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Namespace     string                 `protobuf:"bytes,1,opt,name=namespace,proto3" json:"namespace,omitempty"`
-	TaskQueue     string                 `protobuf:"bytes,2,opt,name=task_queue,json=taskQueue,proto3" json:"task_queue,omitempty"`
-	TaskQueueType v16.TaskQueueType      `protobuf:"varint,3,opt,name=task_queue_type,json=taskQueueType,proto3,enum=temporal.api.enums.v1.TaskQueueType" json:"task_queue_type,omitempty"`
-	MinPass       int64                  `protobuf:"varint,9,opt,name=min_pass,json=minPass,proto3" json:"min_pass,omitempty"`
-	MinTaskId     int64                  `protobuf:"varint,4,opt,name=min_task_id,json=minTaskId,proto3" json:"min_task_id,omitempty"`
-	MaxTaskId     int64                  `protobuf:"varint,5,opt,name=max_task_id,json=maxTaskId,proto3" json:"max_task_id,omitempty"`
-	BatchSize     int32                  `protobuf:"varint,6,opt,name=batch_size,json=batchSize,proto3" json:"batch_size,omitempty"`
-	NextPageToken []byte                 `protobuf:"bytes,7,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"`
-	Subqueue      int32                  `protobuf:"varint,8,opt,name=subqueue,proto3" json:"subqueue,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+func (x *VisibilityAggregationGroup) GetGroupValues() []*v1.Payload {
+	if x != nil {
+		return x.GroupValues
+	}
+	return nil
 }
 
-func (x *GetTaskQueueTasksRequest) Reset() {
-	*x = GetTaskQueueTasksRequest{}
+func (x *VisibilityAggregationGroup) GetCount() int64 {
+	if x != nil {
+		return x.Count
+	}
+	return 0
+}
+
+func (x *VisibilityAggregationGroup) GetResults() []*VisibilityAggregationResult {
+	if x != nil {
+		return x.Results
+	}
+	return nil
+}
+
+// Result of an aggregation over a group. Values of Datetime search attributes are Unix epoch milliseconds.
+type VisibilityAggregationResult struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// False if none of the workflow executions in the group has a value for the aggregated search attribute.
+	HasValue bool    `protobuf:"varint,1,opt,name=has_value,json=hasValue,proto3" json:"has_value,omitempty"`
+	Value    float64 `protobuf:"fixed64,2,opt,name=value,proto3" json:"value,omitempty"`
+	// Values of the percentiles, in the order of the request. Only set by the PERCENTILES function.
+	PercentileValues []float64 `protobuf:"fixed64,3,rep,packed,name=percentile_values,json=percentileValues,proto3" json:"percentile_values,omitempty"`
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *VisibilityAggregationResult) Reset() {
+	*x = VisibilityAggregationResult{}
 	mi := &file_temporal_server_api_adminservice_v1_request_response_proto_msgTypes[55]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetTaskQueueTasksRequest) String() string {
+func (x *VisibilityAggregationResult) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetTaskQueueTasksRequest) ProtoMessage() {}
+func (*VisibilityAggregationResult) ProtoMessage() {}
 
-func (x *GetTaskQueueTasksRequest) ProtoReflect() protoreflect.Message {
+func (x *VisibilityAggregationResult) ProtoReflect() protoreflect.Message {
 	mi := &file_temporal_server_api_adminservice_v1_request_response_proto_msgTypes[55]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -3331,97 +3396,131 @@ func (x *GetTaskQueueTasksRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetTaskQueueTasksRequest.ProtoReflect.Descriptor instead.
-func (*GetTaskQueueTasksRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use VisibilityAggregationResult.ProtoReflect.Descriptor instead.
+func (*VisibilityAggregationResult) Descriptor() ([]byte, []int) {
 	return file_temporal_server_api_adminservice_v1_request_response_proto_rawDescGZIP(), []int{55}
 }
 
-func (x *GetTaskQueueTasksRequest) GetNamespace() string {
+func (x *VisibilityAggregationResult) GetHasValue() bool {
 	if x != nil {
-		return x.Namespace
+		return x.HasValue
 	}
-	return ""
+	return false
 }
 
-func (x *GetTaskQueueTasksRequest) GetTaskQueue() string {
+func (x *VisibilityAggregationResult) GetValue() float64 {
 	if x != nil {
-		return x.TaskQueue
+		return x.Value
 	}
-	return ""
+	return 0
 }
 
-func (x *GetTaskQueueTasksRequest) GetTaskQueueType() v16.TaskQueueType {
+func (x *VisibilityAggregationResult) GetPercentileValues() []float64 {
 	if x != nil {
-		return x.TaskQueueType
+		return x.PercentileValues
 	}
-	return v16.TaskQueueType(0)
+	return nil
 }
 
-func (x *GetTaskQueueTasksRequest) GetMinPass() int64 {
+type UpsertSavedVisibilityQueryRequest struct {
+	state     protoimpl.MessageState `protogen:"open.v1"`
+	Namespace string                 `protobuf:"bytes,1,opt,name=namespace,proto3" json:"namespace,omitempty"`
+	Name      string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	// Visibility query in the ListWorkflowExecutions syntax.
+	Query         string `protobuf:"bytes,3,opt,name=query,proto3" json:"query,omitempty"`
+	Description   string `protobuf:"bytes,4,opt,name=description,proto3" json:"description,omitempty"`
+	Identity      string `protobuf:"bytes,5,opt,name=identity,proto3" json:"identity,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpsertSavedVisibilityQueryRequest) Reset() {
+	*x = UpsertSavedVisibilityQueryRequest{}
+	mi := &file_temporal_server_api_adminservice_v1_request_response_proto_msgTypes[56]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpsertSavedVisibilityQueryRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpsertSavedVisibilityQueryRequest) ProtoMessage() {}
+
+func (x *UpsertSavedVisibilityQueryRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_temporal_server_api_adminservice_v1_request_response_proto_msgTypes[56]
 	if x != nil {
-		return x.MinPass
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return 0
+	return mi.MessageOf(x)
 }
 
-func (x *GetTaskQueueTasksRequest) GetMinTaskId() int64 {
+// Deprecated: Use UpsertSavedVisibilityQueryRequest.ProtoReflect.Descriptor instead.
+func (*UpsertSavedVisibilityQueryRequest) Descriptor() ([]byte, []int) {
+	return file_temporal_server_api_adminservice_v1_request_response_proto_rawDescGZIP(), []int{56}
+}
+
+func (x *UpsertSavedVisibilityQueryRequest) GetNamespace() string {
 	if x != nil {
-		return x.MinTaskId
+		return x.Namespace
 	}
-	return 0
+	return ""
 }
 
-func (x *GetTaskQueueTasksRequest) GetMaxTaskId() int64 {
+func (x *UpsertSavedVisibilityQueryRequest) GetName() string {
 	if x != nil {
-		return x.MaxTaskId
+		return x.Name
 	}
-	return 0
+	return ""
 }
 
-func (x *GetTaskQueueTasksRequest) GetBatchSize() int32 {
+func (x *UpsertSavedVisibilityQueryRequest) GetQuery() string {
 	if x != nil {
-		return x.BatchSize
+		return x.Query
 	}
-	return 0
+	return ""
 }
 
-func (x *GetTaskQueueTasksRequest) GetNextPageToken() []byte {
+func (x *UpsertSavedVisibilityQueryRequest) GetDescription() string {
 	if x != nil {
-		return x.NextPageToken
+		return x.Description
 	}
-	return nil
+	return ""
 }
 
-func (x *GetTaskQueueTasksRequest) GetSubqueue() int32 {
+func (x *UpsertSavedVisibilityQueryRequest) GetIdentity() string {
 	if x != nil {
-		return x.Subqueue
+		return x.Identity
 	}
-	return 0
+	return ""
 }
 
-type GetTaskQueueTasksResponse struct {
-	state         protoimpl.MessageState   `protogen:"open.v1"`
-	Tasks         []*v12.AllocatedTaskInfo `protobuf:"bytes,1,rep,name=tasks,proto3" json:"tasks,omitempty"`
-	NextPageToken []byte                   `protobuf:"bytes,2,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"`
+type UpsertSavedVisibilityQueryResponse struct {
+	state         protoimpl.MessageState    `protogen:"open.v1"`
+	SavedQuery    *v14.SavedVisibilityQuery `protobuf:"bytes,1,opt,name=saved_query,json=savedQuery,proto3" json:"saved_query,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetTaskQueueTasksResponse) Reset() {
-	*x = GetTaskQueueTasksResponse{}
-	mi := &file_temporal_server_api_adminservice_v1_request_response_proto_msgTypes[56]
+func (x *UpsertSavedVisibilityQueryResponse) Reset() {
+	*x = UpsertSavedVisibilityQueryResponse{}
+	mi := &file_temporal_server_api_adminservice_v1_request_response_proto_msgTypes[57]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetTaskQueueTasksResponse) String() string {
+func (x *UpsertSavedVisibilityQueryResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetTaskQueueTasksResponse) ProtoMessage() {}
+func (*UpsertSavedVisibilityQueryResponse) ProtoMessage() {}
 
-func (x *GetTaskQueueTasksResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_temporal_server_api_adminservice_v1_request_response_proto_msgTypes[56]
+func (x *UpsertSavedVisibilityQueryResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_temporal_server_api_adminservice_v1_request_response_proto_msgTypes[57]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3432,48 +3531,41 @@ func (x *GetTaskQueueTasksResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetTaskQueueTasksResponse.ProtoReflect.Descriptor instead.
-func (*GetTaskQueueTasksResponse) Descriptor() ([]byte, []int) {
-	return file_temporal_server_api_adminservice_v1_request_response_proto_rawDescGZIP(), []int{56}
-}
-
-func (x *GetTaskQueueTasksResponse) GetTasks() []*v12.AllocatedTaskInfo {
-	if x != nil {
-		return x.Tasks
-	}
-	return nil
+// Deprecated: Use UpsertSavedVisibilityQueryResponse.ProtoReflect.Descriptor instead.
+func (*UpsertSavedVisibilityQueryResponse) Descriptor() ([]byte, []int) {
+	return file_temporal_server_api_adminservice_v1_request_response_proto_rawDescGZIP(), []int{57}
 }
 
-func (x *GetTaskQueueTasksResponse) GetNextPageToken() []byte {
+func (x *UpsertSavedVisibilityQueryResponse) GetSavedQuery() *v14.SavedVisibilityQuery {
 	if x != nil {
-		return x.NextPageToken
+		return x.SavedQuery
 	}
 	return nil
 }
 
-type DeleteWorkflowExecutionRequest struct {
+type DeleteSavedVisibilityQueryRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Namespace     string                 `protobuf:"bytes,1,opt,name=namespace,proto3" json:"namespace,omitempty"`
-	Execution     *v1.WorkflowExecution  `protobuf:"bytes,2,opt,name=execution,proto3" json:"execution,omitempty"`
+	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *DeleteWorkflowExecutionRequest) Reset() {
-	*x = DeleteWorkflowExecutionRequest{}
-	mi := &file_temporal_server_api_adminservice_v1_request_response_proto_msgTypes[57]
+func (x *DeleteSavedVisibilityQueryRequest) Reset() {
+	*x = DeleteSavedVisibilityQueryRequest{}
+	mi := &file_temporal_server_api_adminservice_v1_request_response_proto_msgTypes[58]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *DeleteWorkflowExecutionRequest) String() string {
+func (x *DeleteSavedVisibilityQueryRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DeleteWorkflowExecutionRequest) ProtoMessage() {}
+func (*DeleteSavedVisibilityQueryRequest) ProtoMessage() {}
 
-func (x *DeleteWorkflowExecutionRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_temporal_server_api_adminservice_v1_request_response_proto_msgTypes[57]
+func (x *DeleteSavedVisibilityQueryRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_temporal_server_api_adminservice_v1_request_response_proto_msgTypes[58]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3484,47 +3576,46 @@ func (x *DeleteWorkflowExecutionRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use DeleteWorkflowExecutionRequest.ProtoReflect.Descriptor instead.
-func (*DeleteWorkflowExecutionRequest) Descriptor() ([]byte, []int) {
-	return file_temporal_server_api_adminservice_v1_request_response_proto_rawDescGZIP(), []int{57}
+// Deprecated: Use DeleteSavedVisibilityQueryRequest.ProtoReflect.Descriptor instead.
+func (*DeleteSavedVisibilityQueryRequest) Descriptor() ([]byte, []int) {
+	return file_temporal_server_api_adminservice_v1_request_response_proto_rawDescGZIP(), []int{58}
 }
 
-func (x *DeleteWorkflowExecutionRequest) GetNamespace() string {
+func (x *DeleteSavedVisibilityQueryRequest) GetNamespace() string {
 	if x != nil {
 		return x.Namespace
 	}
 	return ""
 }
 
-func (x *DeleteWorkflowExecutionRequest) GetExecution() *v1.WorkflowExecution {
+func (x *DeleteSavedVisibilityQueryRequest) GetName() string {
 	if x != nil {
-		return x.Execution
+		return x.Name
 	}
-	return nil
+	return ""
 }
 
-type DeleteWorkflowExecutionResponse struct {
+type DeleteSavedVisibilityQueryResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Warnings      []string               `protobuf:"bytes,1,rep,name=warnings,proto3" json:"warnings,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *DeleteWorkflowExecutionResponse) Reset() {
-	*x = DeleteWorkflowExecutionResponse{}
-	mi := &file_temporal_server_api_adminservice_v1_request_response_proto_msgTypes[58]
+func (x *DeleteSavedVisibilityQueryResponse) Reset() {
+	*x = DeleteSavedVisibilityQueryResponse{}
+	mi := &file_temporal_server_api_adminservice_v1_request_response_proto_msgTypes[59]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *DeleteWorkflowExecutionResponse) String() string {
+func (x *DeleteSavedVisibilityQueryResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DeleteWorkflowExecutionResponse) ProtoMessage() {}
+func (*DeleteSavedVisibilityQueryResponse) ProtoMessage() {}
 
-func (x *DeleteWorkflowExecutionResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_temporal_server_api_adminservice_v1_request_response_proto_msgTypes[58]
+func (x *DeleteSavedVisibilityQueryResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_temporal_server_api_adminservice_v1_request_response_proto_msgTypes[59]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3535,43 +3626,33 @@ func (x *DeleteWorkflowExecutionResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use DeleteWorkflowExecutionResponse.ProtoReflect.Descriptor instead.
-func (*DeleteWorkflowExecutionResponse) Descriptor() ([]byte, []int) {
-	return file_temporal_server_api_adminservice_v1_request_response_proto_rawDescGZIP(), []int{58}
-}
-
-func (x *DeleteWorkflowExecutionResponse) GetWarnings() []string {
-	if x != nil {
-		return x.Warnings
-	}
-	return nil
+// Deprecated: Use DeleteSavedVisibilityQueryResponse.ProtoReflect.Descriptor instead.
+func (*DeleteSavedVisibilityQueryResponse) Descriptor() ([]byte, []int) {
+	return file_temporal_server_api_adminservice_v1_request_response_proto_rawDescGZIP(), []int{59}
 }
 
-type StreamWorkflowReplicationMessagesRequest struct {
-	state protoimpl.MessageState `protogen:"open.v1"`
-	// Types that are valid to be assigned to Attributes:
-	//
-	//	*StreamWorkflowReplicationMessagesRequest_SyncReplicationState
-	Attributes    isStreamWorkflowReplicationMessagesRequest_Attributes `protobuf_oneof:"attributes"`
+type ListSavedVisibilityQueriesRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Namespace     string                 `protobuf:"bytes,1,opt,name=namespace,proto3" json:"namespace,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *StreamWorkflowReplicationMessagesRequest) Reset() {
-	*x = StreamWorkflowReplicationMessagesRequest{}
-	mi := &file_temporal_server_api_adminservice_v1_request_response_proto_msgTypes[59]
+func (x *ListSavedVisibilityQueriesRequest) Reset() {
+	*x = ListSavedVisibilityQueriesRequest{}
+	mi := &file_temporal_server_api_adminservice_v1_request_response_proto_msgTypes[60]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *StreamWorkflowReplicationMessagesRequest) String() string {
+func (x *ListSavedVisibilityQueriesRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*StreamWorkflowReplicationMessagesRequest) ProtoMessage() {}
+func (*ListSavedVisibilityQueriesRequest) ProtoMessage() {}
 
-func (x *StreamWorkflowReplicationMessagesRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_temporal_server_api_adminservice_v1_request_response_proto_msgTypes[59]
+func (x *ListSavedVisibilityQueriesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_temporal_server_api_adminservice_v1_request_response_proto_msgTypes[60]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3582,63 +3663,41 @@ func (x *StreamWorkflowReplicationMessagesRequest) ProtoReflect() protoreflect.M
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use StreamWorkflowReplicationMessagesRequest.ProtoReflect.Descriptor instead.
-func (*StreamWorkflowReplicationMessagesRequest) Descriptor() ([]byte, []int) {
-	return file_temporal_server_api_adminservice_v1_request_response_proto_rawDescGZIP(), []int{59}
-}
-
-func (x *StreamWorkflowReplicationMessagesRequest) GetAttributes() isStreamWorkflowReplicationMessagesRequest_Attributes {
-	if x != nil {
-		return x.Attributes
-	}
-	return nil
+// Deprecated: Use ListSavedVisibilityQueriesRequest.ProtoReflect.Descriptor instead.
+func (*ListSavedVisibilityQueriesRequest) Descriptor() ([]byte, []int) {
+	return file_temporal_server_api_adminservice_v1_request_response_proto_rawDescGZIP(), []int{60}
 }
 
-func (x *StreamWorkflowReplicationMessagesRequest) GetSyncReplicationState() *v15.SyncReplicationState {
+func (x *ListSavedVisibilityQueriesRequest) GetNamespace() string {
 	if x != nil {
-		if x, ok := x.Attributes.(*StreamWorkflowReplicationMessagesRequest_SyncReplicationState); ok {
-			return x.SyncReplicationState
-		}
+		return x.Namespace
 	}
-	return nil
-}
-
-type isStreamWorkflowReplicationMessagesRequest_Attributes interface {
-	isStreamWorkflowReplicationMessagesRequest_Attributes()
-}
-
-type StreamWorkflowReplicationMessagesRequest_SyncReplicationState struct {
-	SyncReplicationState *v15.SyncReplicationState `protobuf:"bytes,1,opt,name=sync_replication_state,json=syncReplicationState,proto3,oneof"`
-}
-
-func (*StreamWorkflowReplicationMessagesRequest_SyncReplicationState) isStreamWorkflowReplicationMessagesRequest_Attributes() {
+	return ""
 }
 
-type StreamWorkflowReplicationMessagesResponse struct {
+type ListSavedVisibilityQueriesResponse struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
-	// Types that are valid to be assigned to Attributes:
-	//
-	//	*StreamWorkflowReplicationMessagesResponse_Messages
-	Attributes    isStreamWorkflowReplicationMessagesResponse_Attributes `protobuf_oneof:"attributes"`
+	// Saved queries ordered by name.
+	SavedQueries  []*v14.SavedVisibilityQuery `protobuf:"bytes,1,rep,name=saved_queries,json=savedQueries,proto3" json:"saved_queries,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *StreamWorkflowReplicationMessagesResponse) Reset() {
-	*x = StreamWorkflowReplicationMessagesResponse{}
-	mi := &file_temporal_server_api_adminservice_v1_request_response_proto_msgTypes[60]
+func (x *ListSavedVisibilityQueriesResponse) Reset() {
+	*x = ListSavedVisibilityQueriesResponse{}
+	mi := &file_temporal_server_api_adminservice_v1_request_response_proto_msgTypes[61]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *StreamWorkflowReplicationMessagesResponse) String() string {
+func (x *ListSavedVisibilityQueriesResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*StreamWorkflowReplicationMessagesResponse) ProtoMessage() {}
+func (*ListSavedVisibilityQueriesResponse) ProtoMessage() {}
 
-func (x *StreamWorkflowReplicationMessagesResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_temporal_server_api_adminservice_v1_request_response_proto_msgTypes[60]
+func (x *ListSavedVisibilityQueriesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_temporal_server_api_adminservice_v1_request_response_proto_msgTypes[61]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3649,64 +3708,40 @@ func (x *StreamWorkflowReplicationMessagesResponse) ProtoReflect() protoreflect.
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use StreamWorkflowReplicationMessagesResponse.ProtoReflect.Descriptor instead.
-func (*StreamWorkflowReplicationMessagesResponse) Descriptor() ([]byte, []int) {
-	return file_temporal_server_api_adminservice_v1_request_response_proto_rawDescGZIP(), []int{60}
-}
-
-func (x *StreamWorkflowReplicationMessagesResponse) GetAttributes() isStreamWorkflowReplicationMessagesResponse_Attributes {
-	if x != nil {
-		return x.Attributes
-	}
-	return nil
+// Deprecated: Use ListSavedVisibilityQueriesResponse.ProtoReflect.Descriptor instead.
+func (*ListSavedVisibilityQueriesResponse) Descriptor() ([]byte, []int) {
+	return file_temporal_server_api_adminservice_v1_request_response_proto_rawDescGZIP(), []int{61}
 }
 
-func (x *StreamWorkflowReplicationMessagesResponse) GetMessages() *v15.WorkflowReplicationMessages {
+func (x *ListSavedVisibilityQueriesResponse) GetSavedQueries() []*v14.SavedVisibilityQuery {
 	if x != nil {
-		if x, ok := x.Attributes.(*StreamWorkflowReplicationMessagesResponse_Messages); ok {
-			return x.Messages
-		}
+		return x.SavedQueries
 	}
 	return nil
 }
 
-type isStreamWorkflowReplicationMessagesResponse_Attributes interface {
-	isStreamWorkflowReplicationMessagesResponse_Attributes()
-}
-
-type StreamWorkflowReplicationMessagesResponse_Messages struct {
-	Messages *v15.WorkflowReplicationMessages `protobuf:"bytes,1,opt,name=messages,proto3,oneof"`
-}
-
-func (*StreamWorkflowReplicationMessagesResponse_Messages) isStreamWorkflowReplicationMessagesResponse_Attributes() {
-}
-
-type GetNamespaceRequest struct {
-	state protoimpl.MessageState `protogen:"open.v1"`
-	// Types that are valid to be assigned to Attributes:
-	//
-	//	*GetNamespaceRequest_Namespace
-	//	*GetNamespaceRequest_Id
-	Attributes    isGetNamespaceRequest_Attributes `protobuf_oneof:"attributes"`
+type DescribeClusterRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ClusterName   string                 `protobuf:"bytes,1,opt,name=cluster_name,json=clusterName,proto3" json:"cluster_name,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetNamespaceRequest) Reset() {
-	*x = GetNamespaceRequest{}
-	mi := &file_temporal_server_api_adminservice_v1_request_response_proto_msgTypes[61]
+func (x *DescribeClusterRequest) Reset() {
+	*x = DescribeClusterRequest{}
+	mi := &file_temporal_server_api_adminservice_v1_request_response_proto_msgTypes[62]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetNamespaceRequest) String() string {
+func (x *DescribeClusterRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetNamespaceRequest) ProtoMessage() {}
+func (*DescribeClusterRequest) ProtoMessage() {}
 
-func (x *GetNamespaceRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_temporal_server_api_adminservice_v1_request_response_proto_msgTypes[61]
+func (x *DescribeClusterRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_temporal_server_api_adminservice_v1_request_response_proto_msgTypes[62]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3717,80 +3752,53 @@ func (x *GetNamespaceRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetNamespaceRequest.ProtoReflect.Descriptor instead.
-func (*GetNamespaceRequest) Descriptor() ([]byte, []int) {
-	return file_temporal_server_api_adminservice_v1_request_response_proto_rawDescGZIP(), []int{61}
-}
-
-func (x *GetNamespaceRequest) GetAttributes() isGetNamespaceRequest_Attributes {
-	if x != nil {
-		return x.Attributes
-	}
-	return nil
-}
-
-func (x *GetNamespaceRequest) GetNamespace() string {
-	if x != nil {
-		if x, ok := x.Attributes.(*GetNamespaceRequest_Namespace); ok {
-			return x.Namespace
-		}
-	}
-	return ""
+// Deprecated: Use DescribeClusterRequest.ProtoReflect.Descriptor instead.
+func (*DescribeClusterRequest) Descriptor() ([]byte, []int) {
+	return file_temporal_server_api_adminservice_v1_request_response_proto_rawDescGZIP(), []int{62}
 }
 
-func (x *GetNamespaceRequest) GetId() string {
+func (x *DescribeClusterRequest) GetClusterName() string {
 	if x != nil {
-		if x, ok := x.Attributes.(*GetNamespaceRequest_Id); ok {
-			return x.Id
-		}
+		return x.ClusterName
 	}
 	return ""
 }
 
-type isGetNamespaceRequest_Attributes interface {
-	isGetNamespaceRequest_Attributes()
-}
-
-type GetNamespaceRequest_Namespace struct {
-	Namespace string `protobuf:"bytes,1,opt,name=namespace,proto3,oneof"`
-}
-
-type GetNamespaceRequest_Id struct {
-	Id string `protobuf:"bytes,2,opt,name=id,proto3,oneof"`
-}
-
-func (*GetNamespaceRequest_Namespace) isGetNamespaceRequest_Attributes() {}
-
-func (*GetNamespaceRequest_Id) isGetNamespaceRequest_Attributes() {}
-
-type GetNamespaceResponse struct {
-	state             protoimpl.MessageState           `protogen:"open.v1"`
-	Info              *v110.NamespaceInfo              `protobuf:"bytes,3,opt,name=info,proto3" json:"info,omitempty"`
-	Config            *v110.NamespaceConfig            `protobuf:"bytes,4,opt,name=config,proto3" json:"config,omitempty"`
-	ReplicationConfig *v111.NamespaceReplicationConfig `protobuf:"bytes,5,opt,name=replication_config,json=replicationConfig,proto3" json:"replication_config,omitempty"`
-	ConfigVersion     int64                            `protobuf:"varint,6,opt,name=config_version,json=configVersion,proto3" json:"config_version,omitempty"`
-	FailoverVersion   int64                            `protobuf:"varint,7,opt,name=failover_version,json=failoverVersion,proto3" json:"failover_version,omitempty"`
-	FailoverHistory   []*v111.FailoverStatus           `protobuf:"bytes,8,rep,name=failover_history,json=failoverHistory,proto3" json:"failover_history,omitempty"`
-	IsGlobalNamespace bool                             `protobuf:"varint,9,opt,name=is_global_namespace,json=isGlobalNamespace,proto3" json:"is_global_namespace,omitempty"`
-	unknownFields     protoimpl.UnknownFields
-	sizeCache         protoimpl.SizeCache
+type DescribeClusterResponse struct {
+	state                    protoimpl.MessageState `protogen:"open.v1"`
+	SupportedClients         map[string]string      `protobuf:"bytes,1,rep,name=supported_clients,json=supportedClients,proto3" json:"supported_clients,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	ServerVersion            string                 `protobuf:"bytes,2,opt,name=server_version,json=serverVersion,proto3" json:"server_version,omitempty"`
+	MembershipInfo           *v18.MembershipInfo    `protobuf:"bytes,3,opt,name=membership_info,json=membershipInfo,proto3" json:"membership_info,omitempty"`
+	ClusterId                string                 `protobuf:"bytes,4,opt,name=cluster_id,json=clusterId,proto3" json:"cluster_id,omitempty"`
+	ClusterName              string                 `protobuf:"bytes,5,opt,name=cluster_name,json=clusterName,proto3" json:"cluster_name,omitempty"`
+	HistoryShardCount        int32                  `protobuf:"varint,6,opt,name=history_shard_count,json=historyShardCount,proto3" json:"history_shard_count,omitempty"`
+	PersistenceStore         string                 `protobuf:"bytes,7,opt,name=persistence_store,json=persistenceStore,proto3" json:"persistence_store,omitempty"`
+	VisibilityStore          string                 `protobuf:"bytes,8,opt,name=visibility_store,json=visibilityStore,proto3" json:"visibility_store,omitempty"`
+	VersionInfo              *v19.VersionInfo       `protobuf:"bytes,9,opt,name=version_info,json=versionInfo,proto3" json:"version_info,omitempty"`
+	FailoverVersionIncrement int64                  `protobuf:"varint,10,opt,name=failover_version_increment,json=failoverVersionIncrement,proto3" json:"failover_version_increment,omitempty"`
+	InitialFailoverVersion   int64                  `protobuf:"varint,11,opt,name=initial_failover_version,json=initialFailoverVersion,proto3" json:"initial_failover_version,omitempty"`
+	IsGlobalNamespaceEnabled bool                   `protobuf:"varint,12,opt,name=is_global_namespace_enabled,json=isGlobalNamespaceEnabled,proto3" json:"is_global_namespace_enabled,omitempty"`
+	Tags                     map[string]string      `protobuf:"bytes,13,rep,name=tags,proto3" json:"tags,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	HttpAddress              string                 `protobuf:"bytes,14,opt,name=http_address,json=httpAddress,proto3" json:"http_address,omitempty"`
+	unknownFields            protoimpl.UnknownFields
+	sizeCache                protoimpl.SizeCache
 }
 
-func (x *GetNamespaceResponse) Reset() {
-	*x = GetNamespaceResponse{}
-	mi := &file_temporal_server_api_adminservice_v1_request_response_proto_msgTypes[62]
+func (x *DescribeClusterResponse) Reset() {
+	*x = DescribeClusterResponse{}
+	mi := &file_temporal_server_api_adminservice_v1_request_response_proto_msgTypes[63]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetNamespaceResponse) String() string {
+func (x *DescribeClusterResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetNamespaceResponse) ProtoMessage() {}
+func (*DescribeClusterResponse) ProtoMessage() {}
 
-func (x *GetNamespaceResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_temporal_server_api_adminservice_v1_request_response_proto_msgTypes[62]
+func (x *DescribeClusterResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_temporal_server_api_adminservice_v1_request_response_proto_msgTypes[63]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3801,85 +3809,2414 @@ func (x *GetNamespaceResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetNamespaceResponse.ProtoReflect.Descriptor instead.
-func (*GetNamespaceResponse) Descriptor() ([]byte, []int) {
-	return file_temporal_server_api_adminservice_v1_request_response_proto_rawDescGZIP(), []int{62}
+// Deprecated: Use DescribeClusterResponse.ProtoReflect.Descriptor instead.
+func (*DescribeClusterResponse) Descriptor() ([]byte, []int) {
+	return file_temporal_server_api_adminservice_v1_request_response_proto_rawDescGZIP(), []int{63}
 }
 
-func (x *GetNamespaceResponse) GetInfo() *v110.NamespaceInfo {
+func (x *DescribeClusterResponse) GetSupportedClients() map[string]string {
 	if x != nil {
-		return x.Info
+		return x.SupportedClients
 	}
 	return nil
 }
 
-func (x *GetNamespaceResponse) GetConfig() *v110.NamespaceConfig {
+func (x *DescribeClusterResponse) GetServerVersion() string {
 	if x != nil {
-		return x.Config
+		return x.ServerVersion
 	}
-	return nil
+	return ""
 }
 
-func (x *GetNamespaceResponse) GetReplicationConfig() *v111.NamespaceReplicationConfig {
+func (x *DescribeClusterResponse) GetMembershipInfo() *v18.MembershipInfo {
 	if x != nil {
-		return x.ReplicationConfig
+		return x.MembershipInfo
 	}
 	return nil
 }
 
-func (x *GetNamespaceResponse) GetConfigVersion() int64 {
+func (x *DescribeClusterResponse) GetClusterId() string {
 	if x != nil {
-		return x.ConfigVersion
+		return x.ClusterId
 	}
-	return 0
+	return ""
 }
 
-func (x *GetNamespaceResponse) GetFailoverVersion() int64 {
+func (x *DescribeClusterResponse) GetClusterName() string {
 	if x != nil {
-		return x.FailoverVersion
+		return x.ClusterName
 	}
-	return 0
+	return ""
+}
+
+func (x *DescribeClusterResponse) GetHistoryShardCount() int32 {
+	if x != nil {
+		return x.HistoryShardCount
+	}
+	return 0
+}
+
+func (x *DescribeClusterResponse) GetPersistenceStore() string {
+	if x != nil {
+		return x.PersistenceStore
+	}
+	return ""
+}
+
+func (x *DescribeClusterResponse) GetVisibilityStore() string {
+	if x != nil {
+		return x.VisibilityStore
+	}
+	return ""
+}
+
+func (x *DescribeClusterResponse) GetVersionInfo() *v19.VersionInfo {
+	if x != nil {
+		return x.VersionInfo
+	}
+	return nil
+}
+
+func (x *DescribeClusterResponse) GetFailoverVersionIncrement() int64 {
+	if x != nil {
+		return x.FailoverVersionIncrement
+	}
+	return 0
+}
+
+func (x *DescribeClusterResponse) GetInitialFailoverVersion() int64 {
+	if x != nil {
+		return x.InitialFailoverVersion
+	}
+	return 0
+}
+
+func (x *DescribeClusterResponse) GetIsGlobalNamespaceEnabled() bool {
+	if x != nil {
+		return x.IsGlobalNamespaceEnabled
+	}
+	return false
+}
+
+func (x *DescribeClusterResponse) GetTags() map[string]string {
+	if x != nil {
+		return x.Tags
+	}
+	return nil
+}
+
+func (x *DescribeClusterResponse) GetHttpAddress() string {
+	if x != nil {
+		return x.HttpAddress
+	}
+	return ""
+}
+
+type ListClustersRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	PageSize      int32                  `protobuf:"varint,1,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	NextPageToken []byte                 `protobuf:"bytes,2,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListClustersRequest) Reset() {
+	*x = ListClustersRequest{}
+	mi := &file_temporal_server_api_adminservice_v1_request_response_proto_msgTypes[64]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListClustersRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListClustersRequest) ProtoMessage() {}
+
+func (x *ListClustersRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_temporal_server_api_adminservice_v1_request_response_proto_msgTypes[64]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListClustersRequest.ProtoReflect.Descriptor instead.
+func (*ListClustersRequest) Descriptor() ([]byte, []int) {
+	return file_temporal_server_api_adminservice_v1_request_response_proto_rawDescGZIP(), []int{64}
+}
+
+func (x *ListClustersRequest) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+func (x *ListClustersRequest) GetNextPageToken() []byte {
+	if x != nil {
+		return x.NextPageToken
+	}
+	return nil
+}
+
+type ListClustersResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Clusters      []*v14.ClusterMetadata `protobuf:"bytes,1,rep,name=clusters,proto3" json:"clusters,omitempty"`
+	NextPageToken []byte                 `protobuf:"bytes,2,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListClustersResponse) Reset() {
+	*x = ListClustersResponse{}
+	mi := &file_temporal_server_api_adminservice_v1_request_response_proto_msgTypes[65]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListClustersResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListClustersResponse) ProtoMessage() {}
+
+func (x *ListClustersResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_temporal_server_api_adminservice_v1_request_response_proto_msgTypes[65]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListClustersResponse.ProtoReflect.Descriptor instead.
+func (*ListClustersResponse) Descriptor() ([]byte, []int) {
+	return file_temporal_server_api_adminservice_v1_request_response_proto_rawDescGZIP(), []int{65}
+}
+
+func (x *ListClustersResponse) GetClusters() []*v14.ClusterMetadata {
+	if x != nil {
+		return x.Clusters
+	}
+	return nil
+}
+
+func (x *ListClustersResponse) GetNextPageToken() []byte {
+	if x != nil {
+		return x.NextPageToken
+	}
+	return nil
+}
+
+type AddOrUpdateRemoteClusterRequest struct {
+	state                         protoimpl.MessageState `protogen:"open.v1"`
+	FrontendAddress               string                 `protobuf:"bytes,1,opt,name=frontend_address,json=frontendAddress,proto3" json:"frontend_address,omitempty"`
+	EnableRemoteClusterConnection bool                   `protobuf:"varint,2,opt,name=enable_remote_cluster_connection,json=enableRemoteClusterConnection,proto3" json:"enable_remote_cluster_connection,omitempty"`
+	// Deprecated: Marked as deprecated in temporal/server/api/adminservice/v1/request_response.proto.
+	FrontendHttpAddress string `protobuf:"bytes,3,opt,name=frontend_http_address,json=frontendHttpAddress,proto3" json:"frontend_http_address,omitempty"`
+	unknownFields       protoimpl.UnknownFields
+	sizeCache           protoimpl.SizeCache
+}
+
+func (x *AddOrUpdateRemoteClusterRequest) Reset() {
+	*x = AddOrUpdateRemoteClusterRequest{}
+	mi := &file_temporal_server_api_adminservice_v1_request_response_proto_msgTypes[66]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AddOrUpdateRemoteClusterRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AddOrUpdateRemoteClusterRequest) ProtoMessage() {}
+
+func (x *AddOrUpdateRemoteClusterRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_temporal_server_api_adminservice_v1_request_response_proto_msgTypes[66]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AddOrUpdateRemoteClusterRequest.ProtoReflect.Descriptor instead.
+func (*AddOrUpdateRemoteClusterRequest) Descriptor() ([]byte, []int) {
+	return file_temporal_server_api_adminservice_v1_request_response_proto_rawDescGZIP(), []int{66}
+}
+
+func (x *AddOrUpdateRemoteClusterRequest) GetFrontendAddress() string {
+	if x != nil {
+		return x.FrontendAddress
+	}
+	return ""
+}
+
+func (x *AddOrUpdateRemoteClusterRequest) GetEnableRemoteClusterConnection() bool {
+	if x != nil {
+		return x.EnableRemoteClusterConnection
+	}
+	return false
+}
+
+// Deprecated: Marked as deprecated in temporal/server/api/adminservice/v1/request_response.proto.
+func (x *AddOrUpdateRemoteClusterRequest) GetFrontendHttpAddress() string {
+	if x != nil {
+		return x.FrontendHttpAddress
+	}
+	return ""
+}
+
+type AddOrUpdateRemoteClusterResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AddOrUpdateRemoteClusterResponse) Reset() {
+	*x = AddOrUpdateRemoteClusterResponse{}
+	mi := &file_temporal_server_api_adminservice_v1_request_response_proto_msgTypes[67]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AddOrUpdateRemoteClusterResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AddOrUpdateRemoteClusterResponse) ProtoMessage() {}
+
+func (x *AddOrUpdateRemoteClusterResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_temporal_server_api_adminservice_v1_request_response_proto_msgTypes[67]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AddOrUpdateRemoteClusterResponse.ProtoReflect.Descriptor instead.
+func (*AddOrUpdateRemoteClusterResponse) Descriptor() ([]byte, []int) {
+	return file_temporal_server_api_adminservice_v1_request_response_proto_rawDescGZIP(), []int{67}
+}
+
+type RemoveRemoteClusterRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ClusterName   string                 `protobuf:"bytes,1,opt,name=cluster_name,json=clusterName,proto3" json:"cluster_name,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RemoveRemoteClusterRequest) Reset() {
+	*x = RemoveRemoteClusterRequest{}
+	mi := &file_temporal_server_api_adminservice_v1_request_response_proto_msgTypes[68]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RemoveRemoteClusterRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RemoveRemoteClusterRequest) ProtoMessage() {}
+
+func (x *RemoveRemoteClusterRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_temporal_server_api_adminservice_v1_request_response_proto_msgTypes[68]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RemoveRemoteClusterRequest.ProtoReflect.Descriptor instead.
+func (*RemoveRemoteClusterRequest) Descriptor() ([]byte, []int) {
+	return file_temporal_server_api_adminservice_v1_request_response_proto_rawDescGZIP(), []int{68}
+}
+
+func (x *RemoveRemoteClusterRequest) GetClusterName() string {
+	if x != nil {
+		return x.ClusterName
+	}
+	return ""
+}
+
+type RemoveRemoteClusterResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RemoveRemoteClusterResponse) Reset() {
+	*x = RemoveRemoteClusterResponse{}
+	mi := &file_temporal_server_api_adminservice_v1_request_response_proto_msgTypes[69]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RemoveRemoteClusterResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RemoveRemoteClusterResponse) ProtoMessage() {}
+
+func (x *RemoveRemoteClusterResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_temporal_server_api_adminservice_v1_request_response_proto_msgTypes[69]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RemoveRemoteClusterResponse.ProtoReflect.Descriptor instead.
+func (*RemoveRemoteClusterResponse) Descriptor() ([]byte, []int) {
+	return file_temporal_server_api_adminservice_v1_request_response_proto_rawDescGZIP(), []int{69}
+}
+
+type ListClusterMembersRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// (-- api-linter: core::0140::prepositions=disabled
+	//
+	//	aip.dev/not-precedent: "within" is used to indicate a time range. --)
+	LastHeartbeatWithin *durationpb.Duration  `protobuf:"bytes,1,opt,name=last_heartbeat_within,json=lastHeartbeatWithin,proto3" json:"last_heartbeat_within,omitempty"`
+	RpcAddress          string                `protobuf:"bytes,2,opt,name=rpc_address,json=rpcAddress,proto3" json:"rpc_address,omitempty"`
+	HostId              string                `protobuf:"bytes,3,opt,name=host_id,json=hostId,proto3" json:"host_id,omitempty"`
+	Role                v13.ClusterMemberRole `protobuf:"varint,4,opt,name=role,proto3,enum=temporal.server.api.enums.v1.ClusterMemberRole" json:"role,omitempty"`
+	// (-- api-linter: core::0140::prepositions=disabled
+	//
+	//	aip.dev/not-precedent: "after" is used to indicate a time range. --)
+	SessionStartedAfterTime *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=session_started_after_time,json=sessionStartedAfterTime,proto3" json:"session_started_after_time,omitempty"`
+	PageSize                int32                  `protobuf:"varint,6,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	NextPageToken           []byte                 `protobuf:"bytes,7,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"`
+	unknownFields           protoimpl.UnknownFields
+	sizeCache               protoimpl.SizeCache
+}
+
+func (x *ListClusterMembersRequest) Reset() {
+	*x = ListClusterMembersRequest{}
+	mi := &file_temporal_server_api_adminservice_v1_request_response_proto_msgTypes[70]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListClusterMembersRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListClusterMembersRequest) ProtoMessage() {}
+
+func (x *ListClusterMembersRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_temporal_server_api_adminservice_v1_request_response_proto_msgTypes[70]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListClusterMembersRequest.ProtoReflect.Descriptor instead.
+func (*ListClusterMembersRequest) Descriptor() ([]byte, []int) {
+	return file_temporal_server_api_adminservice_v1_request_response_proto_rawDescGZIP(), []int{70}
+}
+
+func (x *ListClusterMembersRequest) GetLastHeartbeatWithin() *durationpb.Duration {
+	if x != nil {
+		return x.LastHeartbeatWithin
+	}
+	return nil
+}
+
+func (x *ListClusterMembersRequest) GetRpcAddress() string {
+	if x != nil {
+		return x.RpcAddress
+	}
+	return ""
+}
+
+func (x *ListClusterMembersRequest) GetHostId() string {
+	if x != nil {
+		return x.HostId
+	}
+	return ""
+}
+
+func (x *ListClusterMembersRequest) GetRole() v13.ClusterMemberRole {
+	if x != nil {
+		return x.Role
+	}
+	return v13.ClusterMemberRole(0)
+}
+
+func (x *ListClusterMembersRequest) GetSessionStartedAfterTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.SessionStartedAfterTime
+	}
+	return nil
+}
+
+func (x *ListClusterMembersRequest) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+func (x *ListClusterMembersRequest) GetNextPageToken() []byte {
+	if x != nil {
+		return x.NextPageToken
+	}
+	return nil
+}
+
+type ListClusterMembersResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ActiveMembers []*v18.ClusterMember   `protobuf:"bytes,1,rep,name=active_members,json=activeMembers,proto3" json:"active_members,omitempty"`
+	NextPageToken []byte                 `protobuf:"bytes,2,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListClusterMembersResponse) Reset() {
+	*x = ListClusterMembersResponse{}
+	mi := &file_temporal_server_api_adminservice_v1_request_response_proto_msgTypes[71]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListClusterMembersResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListClusterMembersResponse) ProtoMessage() {}
+
+func (x *ListClusterMembersResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_temporal_server_api_adminservice_v1_request_response_proto_msgTypes[71]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListClusterMembersResponse.ProtoReflect.Descriptor instead.
+func (*ListClusterMembersResponse) Descriptor() ([]byte, []int) {
+	return file_temporal_server_api_adminservice_v1_request_response_proto_rawDescGZIP(), []int{71}
+}
+
+func (x *ListClusterMembersResponse) GetActiveMembers() []*v18.ClusterMember {
+	if x != nil {
+		return x.ActiveMembers
+	}
+	return nil
+}
+
+func (x *ListClusterMembersResponse) GetNextPageToken() []byte {
+	if x != nil {
+		return x.NextPageToken
+	}
+	return nil
+}
+
+type GetDLQMessagesRequest struct {
+	state                 protoimpl.MessageState  `protogen:"open.v1"`
+	Type                  v13.DeadLetterQueueType `protobuf:"varint,1,opt,name=type,proto3,enum=temporal.server.api.enums.v1.DeadLetterQueueType" json:"type,omitempty"`
+	ShardId               int32                   `protobuf:"varint,2,opt,name=shard_id,json=shardId,proto3" json:"shard_id,omitempty"`
+	SourceCluster         string                  `protobuf:"bytes,3,opt,name=source_cluster,json=sourceCluster,proto3" json:"source_cluster,omitempty"`
+	InclusiveEndMessageId int64                   `protobuf:"varint,4,opt,name=inclusive_end_message_id,json=inclusiveEndMessageId,proto3" json:"inclusive_end_message_id,omitempty"`
+	MaximumPageSize       int32                   `protobuf:"varint,5,opt,name=maximum_page_size,json=maximumPageSize,proto3" json:"maximum_page_size,omitempty"`
+	NextPageToken         []byte                  `protobuf:"bytes,6,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"`
+	unknownFields         protoimpl.UnknownFields
+	sizeCache             protoimpl.SizeCache
+}
+
+func (x *GetDLQMessagesRequest) Reset() {
+	*x = GetDLQMessagesRequest{}
+	mi := &file_temporal_server_api_adminservice_v1_request_response_proto_msgTypes[72]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetDLQMessagesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetDLQMessagesRequest) ProtoMessage() {}
+
+func (x *GetDLQMessagesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_temporal_server_api_adminservice_v1_request_response_proto_msgTypes[72]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetDLQMessagesRequest.ProtoReflect.Descriptor instead.
+func (*GetDLQMessagesRequest) Descriptor() ([]byte, []int) {
+	return file_temporal_server_api_adminservice_v1_request_response_proto_rawDescGZIP(), []int{72}
+}
+
+func (x *GetDLQMessagesRequest) GetType() v13.DeadLetterQueueType {
+	if x != nil {
+		return x.Type
+	}
+	return v13.DeadLetterQueueType(0)
+}
+
+func (x *GetDLQMessagesRequest) GetShardId() int32 {
+	if x != nil {
+		return x.ShardId
+	}
+	return 0
+}
+
+func (x *GetDLQMessagesRequest) GetSourceCluster() string {
+	if x != nil {
+		return x.SourceCluster
+	}
+	return ""
+}
+
+func (x *GetDLQMessagesRequest) GetInclusiveEndMessageId() int64 {
+	if x != nil {
+		return x.InclusiveEndMessageId
+	}
+	return 0
+}
+
+func (x *GetDLQMessagesRequest) GetMaximumPageSize() int32 {
+	if x != nil {
+		return x.MaximumPageSize
+	}
+	return 0
+}
+
+func (x *GetDLQMessagesRequest) GetNextPageToken() []byte {
+	if x != nil {
+		return x.NextPageToken
+	}
+	return nil
+}
+
+type GetDLQMessagesResponse struct {
+	state                protoimpl.MessageState     `protogen:"open.v1"`
+	Type                 v13.DeadLetterQueueType    `protobuf:"varint,1,opt,name=type,proto3,enum=temporal.server.api.enums.v1.DeadLetterQueueType" json:"type,omitempty"`
+	ReplicationTasks     []*v16.ReplicationTask     `protobuf:"bytes,2,rep,name=replication_tasks,json=replicationTasks,proto3" json:"replication_tasks,omitempty"`
+	NextPageToken        []byte                     `protobuf:"bytes,3,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"`
+	ReplicationTasksInfo []*v16.ReplicationTaskInfo `protobuf:"bytes,4,rep,name=replication_tasks_info,json=replicationTasksInfo,proto3" json:"replication_tasks_info,omitempty"`
+	unknownFields        protoimpl.UnknownFields
+	sizeCache            protoimpl.SizeCache
+}
+
+func (x *GetDLQMessagesResponse) Reset() {
+	*x = GetDLQMessagesResponse{}
+	mi := &file_temporal_server_api_adminservice_v1_request_response_proto_msgTypes[73]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetDLQMessagesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetDLQMessagesResponse) ProtoMessage() {}
+
+func (x *GetDLQMessagesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_temporal_server_api_adminservice_v1_request_response_proto_msgTypes[73]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetDLQMessagesResponse.ProtoReflect.Descriptor instead.
+func (*GetDLQMessagesResponse) Descriptor() ([]byte, []int) {
+	return file_temporal_server_api_adminservice_v1_request_response_proto_rawDescGZIP(), []int{73}
+}
+
+func (x *GetDLQMessagesResponse) GetType() v13.DeadLetterQueueType {
+	if x != nil {
+		return x.Type
+	}
+	return v13.DeadLetterQueueType(0)
+}
+
+func (x *GetDLQMessagesResponse) GetReplicationTasks() []*v16.ReplicationTask {
+	if x != nil {
+		return x.ReplicationTasks
+	}
+	return nil
+}
+
+func (x *GetDLQMessagesResponse) GetNextPageToken() []byte {
+	if x != nil {
+		return x.NextPageToken
+	}
+	return nil
+}
+
+func (x *GetDLQMessagesResponse) GetReplicationTasksInfo() []*v16.ReplicationTaskInfo {
+	if x != nil {
+		return x.ReplicationTasksInfo
+	}
+	return nil
+}
+
+type PurgeDLQMessagesRequest struct {
+	state                 protoimpl.MessageState  `protogen:"open.v1"`
+	Type                  v13.DeadLetterQueueType `protobuf:"varint,1,opt,name=type,proto3,enum=temporal.server.api.enums.v1.DeadLetterQueueType" json:"type,omitempty"`
+	ShardId               int32                   `protobuf:"varint,2,opt,name=shard_id,json=shardId,proto3" json:"shard_id,omitempty"`
+	SourceCluster         string                  `protobuf:"bytes,3,opt,name=source_cluster,json=sourceCluster,proto3" json:"source_cluster,omitempty"`
+	InclusiveEndMessageId int64                   `protobuf:"varint,4,opt,name=inclusive_end_message_id,json=inclusiveEndMessageId,proto3" json:"inclusive_end_message_id,omitempty"`
+	unknownFields         protoimpl.UnknownFields
+	sizeCache             protoimpl.SizeCache
+}
+
+func (x *PurgeDLQMessagesRequest) Reset() {
+	*x = PurgeDLQMessagesRequest{}
+	mi := &file_temporal_server_api_adminservice_v1_request_response_proto_msgTypes[74]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PurgeDLQMessagesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PurgeDLQMessagesRequest) ProtoMessage() {}
+
+func (x *PurgeDLQMessagesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_temporal_server_api_adminservice_v1_request_response_proto_msgTypes[74]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PurgeDLQMessagesRequest.ProtoReflect.Descriptor instead.
+func (*PurgeDLQMessagesRequest) Descriptor() ([]byte, []int) {
+	return file_temporal_server_api_adminservice_v1_request_response_proto_rawDescGZIP(), []int{74}
+}
+
+func (x *PurgeDLQMessagesRequest) GetType() v13.DeadLetterQueueType {
+	if x != nil {
+		return x.Type
+	}
+	return v13.DeadLetterQueueType(0)
+}
+
+func (x *PurgeDLQMessagesRequest) GetShardId() int32 {
+	if x != nil {
+		return x.ShardId
+	}
+	return 0
+}
+
+func (x *PurgeDLQMessagesRequest) GetSourceCluster() string {
+	if x != nil {
+		return x.SourceCluster
+	}
+	return ""
+}
+
+func (x *PurgeDLQMessagesRequest) GetInclusiveEndMessageId() int64 {
+	if x != nil {
+		return x.InclusiveEndMessageId
+	}
+	return 0
+}
+
+type PurgeDLQMessagesResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PurgeDLQMessagesResponse) Reset() {
+	*x = PurgeDLQMessagesResponse{}
+	mi := &file_temporal_server_api_adminservice_v1_request_response_proto_msgTypes[75]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PurgeDLQMessagesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PurgeDLQMessagesResponse) ProtoMessage() {}
+
+func (x *PurgeDLQMessagesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_temporal_server_api_adminservice_v1_request_response_proto_msgTypes[75]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PurgeDLQMessagesResponse.ProtoReflect.Descriptor instead.
+func (*PurgeDLQMessagesResponse) Descriptor() ([]byte, []int) {
+	return file_temporal_server_api_adminservice_v1_request_response_proto_rawDescGZIP(), []int{75}
+}
+
+type MergeDLQMessagesRequest struct {
+	state                 protoimpl.MessageState  `protogen:"open.v1"`
+	Type                  v13.DeadLetterQueueType `protobuf:"varint,1,opt,name=type,proto3,enum=temporal.server.api.enums.v1.DeadLetterQueueType" json:"type,omitempty"`
+	ShardId               int32                   `protobuf:"varint,2,opt,name=shard_id,json=shardId,proto3" json:"shard_id,omitempty"`
+	SourceCluster         string                  `protobuf:"bytes,3,opt,name=source_cluster,json=sourceCluster,proto3" json:"source_cluster,omitempty"`
+	InclusiveEndMessageId int64                   `protobuf:"varint,4,opt,name=inclusive_end_message_id,json=inclusiveEndMessageId,proto3" json:"inclusive_end_message_id,omitempty"`
+	MaximumPageSize       int32                   `protobuf:"varint,5,opt,name=maximum_page_size,json=maximumPageSize,proto3" json:"maximum_page_size,omitempty"`
+	NextPageToken         []byte                  `protobuf:"bytes,6,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"`
+	unknownFields         protoimpl.UnknownFields
+	sizeCache             protoimpl.SizeCache
+}
+
+func (x *MergeDLQMessagesRequest) Reset() {
+	*x = MergeDLQMessagesRequest{}
+	mi := &file_temporal_server_api_adminservice_v1_request_response_proto_msgTypes[76]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MergeDLQMessagesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MergeDLQMessagesRequest) ProtoMessage() {}
+
+func (x *MergeDLQMessagesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_temporal_server_api_adminservice_v1_request_response_proto_msgTypes[76]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MergeDLQMessagesRequest.ProtoReflect.Descriptor instead.
+func (*MergeDLQMessagesRequest) Descriptor() ([]byte, []int) {
+	return file_temporal_server_api_adminservice_v1_request_response_proto_rawDescGZIP(), []int{76}
+}
+
+func (x *MergeDLQMessagesRequest) GetType() v13.DeadLetterQueueType {
+	if x != nil {
+		return x.Type
+	}
+	return v13.DeadLetterQueueType(0)
+}
+
+func (x *MergeDLQMessagesRequest) GetShardId() int32 {
+	if x != nil {
+		return x.ShardId
+	}
+	return 0
+}
+
+func (x *MergeDLQMessagesRequest) GetSourceCluster() string {
+	if x != nil {
+		return x.SourceCluster
+	}
+	return ""
+}
+
+func (x *MergeDLQMessagesRequest) GetInclusiveEndMessageId() int64 {
+	if x != nil {
+		return x.InclusiveEndMessageId
+	}
+	return 0
+}
+
+func (x *MergeDLQMessagesRequest) GetMaximumPageSize() int32 {
+	if x != nil {
+		return x.MaximumPageSize
+	}
+	return 0
+}
+
+func (x *MergeDLQMessagesRequest) GetNextPageToken() []byte {
+	if x != nil {
+		return x.NextPageToken
+	}
+	return nil
+}
+
+type MergeDLQMessagesResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	NextPageToken []byte                 `protobuf:"bytes,1,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *MergeDLQMessagesResponse) Reset() {
+	*x = MergeDLQMessagesResponse{}
+	mi := &file_temporal_server_api_adminservice_v1_request_response_proto_msgTypes[77]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MergeDLQMessagesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MergeDLQMessagesResponse) ProtoMessage() {}
+
+func (x *MergeDLQMessagesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_temporal_server_api_adminservice_v1_request_response_proto_msgTypes[77]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MergeDLQMessagesResponse.ProtoReflect.Descriptor instead.
+func (*MergeDLQMessagesResponse) Descriptor() ([]byte, []int) {
+	return file_temporal_server_api_adminservice_v1_request_response_proto_rawDescGZIP(), []int{77}
+}
+
+func (x *MergeDLQMessagesResponse) GetNextPageToken() []byte {
+	if x != nil {
+		return x.NextPageToken
+	}
+	return nil
+}
+
+type RefreshWorkflowTasksRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	NamespaceId   string                 `protobuf:"bytes,3,opt,name=namespace_id,json=namespaceId,proto3" json:"namespace_id,omitempty"`
+	Execution     *v1.WorkflowExecution  `protobuf:"bytes,2,opt,name=execution,proto3" json:"execution,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RefreshWorkflowTasksRequest) Reset() {
+	*x = RefreshWorkflowTasksRequest{}
+	mi := &file_temporal_server_api_adminservice_v1_request_response_proto_msgTypes[78]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RefreshWorkflowTasksRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RefreshWorkflowTasksRequest) ProtoMessage() {}
+
+func (x *RefreshWorkflowTasksRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_temporal_server_api_adminservice_v1_request_response_proto_msgTypes[78]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RefreshWorkflowTasksRequest.ProtoReflect.Descriptor instead.
+func (*RefreshWorkflowTasksRequest) Descriptor() ([]byte, []int) {
+	return file_temporal_server_api_adminservice_v1_request_response_proto_rawDescGZIP(), []int{78}
+}
+
+func (x *RefreshWorkflowTasksRequest) GetNamespaceId() string {
+	if x != nil {
+		return x.NamespaceId
+	}
+	return ""
+}
+
+func (x *RefreshWorkflowTasksRequest) GetExecution() *v1.WorkflowExecution {
+	if x != nil {
+		return x.Execution
+	}
+	return nil
+}
+
+type RefreshWorkflowTasksResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RefreshWorkflowTasksResponse) Reset() {
+	*x = RefreshWorkflowTasksResponse{}
+	mi := &file_temporal_server_api_adminservice_v1_request_response_proto_msgTypes[79]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RefreshWorkflowTasksResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RefreshWorkflowTasksResponse) ProtoMessage() {}
+
+func (x *RefreshWorkflowTasksResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_temporal_server_api_adminservice_v1_request_response_proto_msgTypes[79]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RefreshWorkflowTasksResponse.ProtoReflect.Descriptor instead.
+func (*RefreshWorkflowTasksResponse) Descriptor() ([]byte, []int) {
+	return file_temporal_server_api_adminservice_v1_request_response_proto_rawDescGZIP(), []int{79}
+}
+
+type ResendReplicationTasksRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	NamespaceId   string                 `protobuf:"bytes,1,opt,name=namespace_id,json=namespaceId,proto3" json:"namespace_id,omitempty"`
+	WorkflowId    string                 `protobuf:"bytes,2,opt,name=workflow_id,json=workflowId,proto3" json:"workflow_id,omitempty"`
+	RunId         string                 `protobuf:"bytes,3,opt,name=run_id,json=runId,proto3" json:"run_id,omitempty"`
+	RemoteCluster string                 `protobuf:"bytes,4,opt,name=remote_cluster,json=remoteCluster,proto3" json:"remote_cluster,omitempty"`
+	StartEventId  int64                  `protobuf:"varint,5,opt,name=start_event_id,json=startEventId,proto3" json:"start_event_id,omitempty"`
+	StartVersion  int64                  `protobuf:"varint,6,opt,name=start_version,json=startVersion,proto3" json:"start_version,omitempty"`
+	EndEventId    int64                  `protobuf:"varint,7,opt,name=end_event_id,json=endEventId,proto3" json:"end_event_id,omitempty"`
+	EndVersion    int64                  `protobuf:"varint,8,opt,name=end_version,json=endVersion,proto3" json:"end_version,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ResendReplicationTasksRequest) Reset() {
+	*x = ResendReplicationTasksRequest{}
+	mi := &file_temporal_server_api_adminservice_v1_request_response_proto_msgTypes[80]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ResendReplicationTasksRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ResendReplicationTasksRequest) ProtoMessage() {}
+
+func (x *ResendReplicationTasksRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_temporal_server_api_adminservice_v1_request_response_proto_msgTypes[80]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ResendReplicationTasksRequest.ProtoReflect.Descriptor instead.
+func (*ResendReplicationTasksRequest) Descriptor() ([]byte, []int) {
+	return file_temporal_server_api_adminservice_v1_request_response_proto_rawDescGZIP(), []int{80}
+}
+
+func (x *ResendReplicationTasksRequest) GetNamespaceId() string {
+	if x != nil {
+		return x.NamespaceId
+	}
+	return ""
+}
+
+func (x *ResendReplicationTasksRequest) GetWorkflowId() string {
+	if x != nil {
+		return x.WorkflowId
+	}
+	return ""
+}
+
+func (x *ResendReplicationTasksRequest) GetRunId() string {
+	if x != nil {
+		return x.RunId
+	}
+	return ""
+}
+
+func (x *ResendReplicationTasksRequest) GetRemoteCluster() string {
+	if x != nil {
+		return x.RemoteCluster
+	}
+	return ""
+}
+
+func (x *ResendReplicationTasksRequest) GetStartEventId() int64 {
+	if x != nil {
+		return x.StartEventId
+	}
+	return 0
+}
+
+func (x *ResendReplicationTasksRequest) GetStartVersion() int64 {
+	if x != nil {
+		return x.StartVersion
+	}
+	return 0
+}
+
+func (x *ResendReplicationTasksRequest) GetEndEventId() int64 {
+	if x != nil {
+		return x.EndEventId
+	}
+	return 0
+}
+
+func (x *ResendReplicationTasksRequest) GetEndVersion() int64 {
+	if x != nil {
+		return x.EndVersion
+	}
+	return 0
+}
+
+type ResendReplicationTasksResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ResendReplicationTasksResponse) Reset() {
+	*x = ResendReplicationTasksResponse{}
+	mi := &file_temporal_server_api_adminservice_v1_request_response_proto_msgTypes[81]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ResendReplicationTasksResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ResendReplicationTasksResponse) ProtoMessage() {}
+
+func (x *ResendReplicationTasksResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_temporal_server_api_adminservice_v1_request_response_proto_msgTypes[81]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ResendReplicationTasksResponse.ProtoReflect.Descriptor instead.
+func (*ResendReplicationTasksResponse) Descriptor() ([]byte, []int) {
+	return file_temporal_server_api_adminservice_v1_request_response_proto_rawDescGZIP(), []int{81}
+}
+
+type GetTaskQueueTasksRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Namespace     string                 `protobuf:"bytes,1,opt,name=namespace,proto3" json:"namespace,omitempty"`
+	TaskQueue     string                 `protobuf:"bytes,2,opt,name=task_queue,json=taskQueue,proto3" json:"task_queue,omitempty"`
+	TaskQueueType v12.TaskQueueType      `protobuf:"varint,3,opt,name=task_queue_type,json=taskQueueType,proto3,enum=temporal.api.enums.v1.TaskQueueType" json:"task_queue_type,omitempty"`
+	MinPass       int64                  `protobuf:"varint,9,opt,name=min_pass,json=minPass,proto3" json:"min_pass,omitempty"`
+	MinTaskId     int64                  `protobuf:"varint,4,opt,name=min_task_id,json=minTaskId,proto3" json:"min_task_id,omitempty"`
+	MaxTaskId     int64                  `protobuf:"varint,5,opt,name=max_task_id,json=maxTaskId,proto3" json:"max_task_id,omitempty"`
+	BatchSize     int32                  `protobuf:"varint,6,opt,name=batch_size,json=batchSize,proto3" json:"batch_size,omitempty"`
+	NextPageToken []byte                 `protobuf:"bytes,7,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"`
+	Subqueue      int32                  `protobuf:"varint,8,opt,name=subqueue,proto3" json:"subqueue,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetTaskQueueTasksRequest) Reset() {
+	*x = GetTaskQueueTasksRequest{}
+	mi := &file_temporal_server_api_adminservice_v1_request_response_proto_msgTypes[82]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetTaskQueueTasksRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetTaskQueueTasksRequest) ProtoMessage() {}
+
+func (x *GetTaskQueueTasksRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_temporal_server_api_adminservice_v1_request_response_proto_msgTypes[82]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetTaskQueueTasksRequest.ProtoReflect.Descriptor instead.
+func (*GetTaskQueueTasksRequest) Descriptor() ([]byte, []int) {
+	return file_temporal_server_api_adminservice_v1_request_response_proto_rawDescGZIP(), []int{82}
+}
+
+func (x *GetTaskQueueTasksRequest) GetNamespace() string {
+	if x != nil {
+		return x.Namespace
+	}
+	return ""
+}
+
+func (x *GetTaskQueueTasksRequest) GetTaskQueue() string {
+	if x != nil {
+		return x.TaskQueue
+	}
+	return ""
+}
+
+func (x *GetTaskQueueTasksRequest) GetTaskQueueType() v12.TaskQueueType {
+	if x != nil {
+		return x.TaskQueueType
+	}
+	return v12.TaskQueueType(0)
+}
+
+func (x *GetTaskQueueTasksRequest) GetMinPass() int64 {
+	if x != nil {
+		return x.MinPass
+	}
+	return 0
+}
+
+func (x *GetTaskQueueTasksRequest) GetMinTaskId() int64 {
+	if x != nil {
+		return x.MinTaskId
+	}
+	return 0
+}
+
+func (x *GetTaskQueueTasksRequest) GetMaxTaskId() int64 {
+	if x != nil {
+		return x.MaxTaskId
+	}
+	return 0
+}
+
+func (x *GetTaskQueueTasksRequest) GetBatchSize() int32 {
+	if x != nil {
+		return x.BatchSize
+	}
+	return 0
+}
+
+func (x *GetTaskQueueTasksRequest) GetNextPageToken() []byte {
+	if x != nil {
+		return x.NextPageToken
+	}
+	return nil
+}
+
+func (x *GetTaskQueueTasksRequest) GetSubqueue() int32 {
+	if x != nil {
+		return x.Subqueue
+	}
+	return 0
+}
+
+type GetTaskQueueTasksResponse struct {
+	state         protoimpl.MessageState   `protogen:"open.v1"`
+	Tasks         []*v14.AllocatedTaskInfo `protobuf:"bytes,1,rep,name=tasks,proto3" json:"tasks,omitempty"`
+	NextPageToken []byte                   `protobuf:"bytes,2,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetTaskQueueTasksResponse) Reset() {
+	*x = GetTaskQueueTasksResponse{}
+	mi := &file_temporal_server_api_adminservice_v1_request_response_proto_msgTypes[83]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetTaskQueueTasksResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetTaskQueueTasksResponse) ProtoMessage() {}
+
+func (x *GetTaskQueueTasksResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_temporal_server_api_adminservice_v1_request_response_proto_msgTypes[83]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetTaskQueueTasksResponse.ProtoReflect.Descriptor instead.
+func (*GetTaskQueueTasksResponse) Descriptor() ([]byte, []int) {
+	return file_temporal_server_api_adminservice_v1_request_response_proto_rawDescGZIP(), []int{83}
+}
+
+func (x *GetTaskQueueTasksResponse) GetTasks() []*v14.AllocatedTaskInfo {
+	if x != nil {
+		return x.Tasks
+	}
+	return nil
+}
+
+func (x *GetTaskQueueTasksResponse) GetNextPageToken() []byte {
+	if x != nil {
+		return x.NextPageToken
+	}
+	return nil
+}
+
+type DeleteWorkflowExecutionRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Namespace     string                 `protobuf:"bytes,1,opt,name=namespace,proto3" json:"namespace,omitempty"`
+	Execution     *v1.WorkflowExecution  `protobuf:"bytes,2,opt,name=execution,proto3" json:"execution,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteWorkflowExecutionRequest) Reset() {
+	*x = DeleteWorkflowExecutionRequest{}
+	mi := &file_temporal_server_api_adminservice_v1_request_response_proto_msgTypes[84]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteWorkflowExecutionRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteWorkflowExecutionRequest) ProtoMessage() {}
+
+func (x *DeleteWorkflowExecutionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_temporal_server_api_adminservice_v1_request_response_proto_msgTypes[84]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteWorkflowExecutionRequest.ProtoReflect.Descriptor instead.
+func (*DeleteWorkflowExecutionRequest) Descriptor() ([]byte, []int) {
+	return file_temporal_server_api_adminservice_v1_request_response_proto_rawDescGZIP(), []int{84}
+}
+
+func (x *DeleteWorkflowExecutionRequest) GetNamespace() string {
+	if x != nil {
+		return x.Namespace
+	}
+	return ""
+}
+
+func (x *DeleteWorkflowExecutionRequest) GetExecution() *v1.WorkflowExecution {
+	if x != nil {
+		return x.Execution
+	}
+	return nil
+}
+
+type DeleteWorkflowExecutionResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Warnings      []string               `protobuf:"bytes,1,rep,name=warnings,proto3" json:"warnings,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteWorkflowExecutionResponse) Reset() {
+	*x = DeleteWorkflowExecutionResponse{}
+	mi := &file_temporal_server_api_adminservice_v1_request_response_proto_msgTypes[85]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteWorkflowExecutionResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteWorkflowExecutionResponse) ProtoMessage() {}
+
+func (x *DeleteWorkflowExecutionResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_temporal_server_api_adminservice_v1_request_response_proto_msgTypes[85]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteWorkflowExecutionResponse.ProtoReflect.Descriptor instead.
+func (*DeleteWorkflowExecutionResponse) Descriptor() ([]byte, []int) {
+	return file_temporal_server_api_adminservice_v1_request_response_proto_rawDescGZIP(), []int{85}
+}
+
+func (x *DeleteWorkflowExecutionResponse) GetWarnings() []string {
+	if x != nil {
+		return x.Warnings
+	}
+	return nil
+}
+
+type StreamWorkflowReplicationMessagesRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Types that are valid to be assigned to Attributes:
+	//
+	//	*StreamWorkflowReplicationMessagesRequest_SyncReplicationState
+	Attributes    isStreamWorkflowReplicationMessagesRequest_Attributes `protobuf_oneof:"attributes"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *StreamWorkflowReplicationMessagesRequest) Reset() {
+	*x = StreamWorkflowReplicationMessagesRequest{}
+	mi := &file_temporal_server_api_adminservice_v1_request_response_proto_msgTypes[86]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *StreamWorkflowReplicationMessagesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StreamWorkflowReplicationMessagesRequest) ProtoMessage() {}
+
+func (x *StreamWorkflowReplicationMessagesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_temporal_server_api_adminservice_v1_request_response_proto_msgTypes[86]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StreamWorkflowReplicationMessagesRequest.ProtoReflect.Descriptor instead.
+func (*StreamWorkflowReplicationMessagesRequest) Descriptor() ([]byte, []int) {
+	return file_temporal_server_api_adminservice_v1_request_response_proto_rawDescGZIP(), []int{86}
+}
+
+func (x *StreamWorkflowReplicationMessagesRequest) GetAttributes() isStreamWorkflowReplicationMessagesRequest_Attributes {
+	if x != nil {
+		return x.Attributes
+	}
+	return nil
+}
+
+func (x *StreamWorkflowReplicationMessagesRequest) GetSyncReplicationState() *v16.SyncReplicationState {
+	if x != nil {
+		if x, ok := x.Attributes.(*StreamWorkflowReplicationMessagesRequest_SyncReplicationState); ok {
+			return x.SyncReplicationState
+		}
+	}
+	return nil
+}
+
+type isStreamWorkflowReplicationMessagesRequest_Attributes interface {
+	isStreamWorkflowReplicationMessagesRequest_Attributes()
+}
+
+type StreamWorkflowReplicationMessagesRequest_SyncReplicationState struct {
+	SyncReplicationState *v16.SyncReplicationState `protobuf:"bytes,1,opt,name=sync_replication_state,json=syncReplicationState,proto3,oneof"`
+}
+
+func (*StreamWorkflowReplicationMessagesRequest_SyncReplicationState) isStreamWorkflowReplicationMessagesRequest_Attributes() {
+}
+
+type StreamWorkflowReplicationMessagesResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Types that are valid to be assigned to Attributes:
+	//
+	//	*StreamWorkflowReplicationMessagesResponse_Messages
+	Attributes    isStreamWorkflowReplicationMessagesResponse_Attributes `protobuf_oneof:"attributes"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *StreamWorkflowReplicationMessagesResponse) Reset() {
+	*x = StreamWorkflowReplicationMessagesResponse{}
+	mi := &file_temporal_server_api_adminservice_v1_request_response_proto_msgTypes[87]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *StreamWorkflowReplicationMessagesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StreamWorkflowReplicationMessagesResponse) ProtoMessage() {}
+
+func (x *StreamWorkflowReplicationMessagesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_temporal_server_api_adminservice_v1_request_response_proto_msgTypes[87]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StreamWorkflowReplicationMessagesResponse.ProtoReflect.Descriptor instead.
+func (*StreamWorkflowReplicationMessagesResponse) Descriptor() ([]byte, []int) {
+	return file_temporal_server_api_adminservice_v1_request_response_proto_rawDescGZIP(), []int{87}
+}
+
+func (x *StreamWorkflowReplicationMessagesResponse) GetAttributes() isStreamWorkflowReplicationMessagesResponse_Attributes {
+	if x != nil {
+		return x.Attributes
+	}
+	return nil
+}
+
+func (x *StreamWorkflowReplicationMessagesResponse) GetMessages() *v16.WorkflowReplicationMessages {
+	if x != nil {
+		if x, ok := x.Attributes.(*StreamWorkflowReplicationMessagesResponse_Messages); ok {
+			return x.Messages
+		}
+	}
+	return nil
+}
+
+type isStreamWorkflowReplicationMessagesResponse_Attributes interface {
+	isStreamWorkflowReplicationMessagesResponse_Attributes()
+}
+
+type StreamWorkflowReplicationMessagesResponse_Messages struct {
+	Messages *v16.WorkflowReplicationMessages `protobuf:"bytes,1,opt,name=messages,proto3,oneof"`
+}
+
+func (*StreamWorkflowReplicationMessagesResponse_Messages) isStreamWorkflowReplicationMessagesResponse_Attributes() {
+}
+
+type GetNamespaceRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Types that are valid to be assigned to Attributes:
+	//
+	//	*GetNamespaceRequest_Namespace
+	//	*GetNamespaceRequest_Id
+	Attributes    isGetNamespaceRequest_Attributes `protobuf_oneof:"attributes"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetNamespaceRequest) Reset() {
+	*x = GetNamespaceRequest{}
+	mi := &file_temporal_server_api_adminservice_v1_request_response_proto_msgTypes[88]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetNamespaceRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetNamespaceRequest) ProtoMessage() {}
+
+func (x *GetNamespaceRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_temporal_server_api_adminservice_v1_request_response_proto_msgTypes[88]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetNamespaceRequest.ProtoReflect.Descriptor instead.
+func (*GetNamespaceRequest) Descriptor() ([]byte, []int) {
+	return file_temporal_server_api_adminservice_v1_request_response_proto_rawDescGZIP(), []int{88}
+}
+
+func (x *GetNamespaceRequest) GetAttributes() isGetNamespaceRequest_Attributes {
+	if x != nil {
+		return x.Attributes
+	}
+	return nil
+}
+
+func (x *GetNamespaceRequest) GetNamespace() string {
+	if x != nil {
+		if x, ok := x.Attributes.(*GetNamespaceRequest_Namespace); ok {
+			return x.Namespace
+		}
+	}
+	return ""
+}
+
+func (x *GetNamespaceRequest) GetId() string {
+	if x != nil {
+		if x, ok := x.Attributes.(*GetNamespaceRequest_Id); ok {
+			return x.Id
+		}
+	}
+	return ""
+}
+
+type isGetNamespaceRequest_Attributes interface {
+	isGetNamespaceRequest_Attributes()
+}
+
+type GetNamespaceRequest_Namespace struct {
+	Namespace string `protobuf:"bytes,1,opt,name=namespace,proto3,oneof"`
+}
+
+type GetNamespaceRequest_Id struct {
+	Id string `protobuf:"bytes,2,opt,name=id,proto3,oneof"`
+}
+
+func (*GetNamespaceRequest_Namespace) isGetNamespaceRequest_Attributes() {}
+
+func (*GetNamespaceRequest_Id) isGetNamespaceRequest_Attributes() {}
+
+type GetNamespaceResponse struct {
+	state             protoimpl.MessageState           `protogen:"open.v1"`
+	Info              *v110.NamespaceInfo              `protobuf:"bytes,3,opt,name=info,proto3" json:"info,omitempty"`
+	Config            *v110.NamespaceConfig            `protobuf:"bytes,4,opt,name=config,proto3" json:"config,omitempty"`
+	ReplicationConfig *v111.NamespaceReplicationConfig `protobuf:"bytes,5,opt,name=replication_config,json=replicationConfig,proto3" json:"replication_config,omitempty"`
+	ConfigVersion     int64                            `protobuf:"varint,6,opt,name=config_version,json=configVersion,proto3" json:"config_version,omitempty"`
+	FailoverVersion   int64                            `protobuf:"varint,7,opt,name=failover_version,json=failoverVersion,proto3" json:"failover_version,omitempty"`
+	FailoverHistory   []*v111.FailoverStatus           `protobuf:"bytes,8,rep,name=failover_history,json=failoverHistory,proto3" json:"failover_history,omitempty"`
+	IsGlobalNamespace bool                             `protobuf:"varint,9,opt,name=is_global_namespace,json=isGlobalNamespace,proto3" json:"is_global_namespace,omitempty"`
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
+}
+
+func (x *GetNamespaceResponse) Reset() {
+	*x = GetNamespaceResponse{}
+	mi := &file_temporal_server_api_adminservice_v1_request_response_proto_msgTypes[89]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetNamespaceResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetNamespaceResponse) ProtoMessage() {}
+
+func (x *GetNamespaceResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_temporal_server_api_adminservice_v1_request_response_proto_msgTypes[89]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetNamespaceResponse.ProtoReflect.Descriptor instead.
+func (*GetNamespaceResponse) Descriptor() ([]byte, []int) {
+	return file_temporal_server_api_adminservice_v1_request_response_proto_rawDescGZIP(), []int{89}
+}
+
+func (x *GetNamespaceResponse) GetInfo() *v110.NamespaceInfo {
+	if x != nil {
+		return x.Info
+	}
+	return nil
+}
+
+func (x *GetNamespaceResponse) GetConfig() *v110.NamespaceConfig {
+	if x != nil {
+		return x.Config
+	}
+	return nil
+}
+
+func (x *GetNamespaceResponse) GetReplicationConfig() *v111.NamespaceReplicationConfig {
+	if x != nil {
+		return x.ReplicationConfig
+	}
+	return nil
+}
+
+func (x *GetNamespaceResponse) GetConfigVersion() int64 {
+	if x != nil {
+		return x.ConfigVersion
+	}
+	return 0
+}
+
+func (x *GetNamespaceResponse) GetFailoverVersion() int64 {
+	if x != nil {
+		return x.FailoverVersion
+	}
+	return 0
 }
 
 func (x *GetNamespaceResponse) GetFailoverHistory() []*v111.FailoverStatus {
 	if x != nil {
-		return x.FailoverHistory
+		return x.FailoverHistory
+	}
+	return nil
+}
+
+func (x *GetNamespaceResponse) GetIsGlobalNamespace() bool {
+	if x != nil {
+		return x.IsGlobalNamespace
+	}
+	return false
+}
+
+type GetDLQTasksRequest struct {
+	state  protoimpl.MessageState `protogen:"open.v1"`
+	DlqKey *v112.HistoryDLQKey    `protobuf:"bytes,1,opt,name=dlq_key,json=dlqKey,proto3" json:"dlq_key,omitempty"`
+	// page_size must be positive. Up to this many tasks will be returned.
+	PageSize      int32  `protobuf:"varint,2,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	NextPageToken []byte `protobuf:"bytes,3,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetDLQTasksRequest) Reset() {
+	*x = GetDLQTasksRequest{}
+	mi := &file_temporal_server_api_adminservice_v1_request_response_proto_msgTypes[90]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetDLQTasksRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetDLQTasksRequest) ProtoMessage() {}
+
+func (x *GetDLQTasksRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_temporal_server_api_adminservice_v1_request_response_proto_msgTypes[90]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetDLQTasksRequest.ProtoReflect.Descriptor instead.
+func (*GetDLQTasksRequest) Descriptor() ([]byte, []int) {
+	return file_temporal_server_api_adminservice_v1_request_response_proto_rawDescGZIP(), []int{90}
+}
+
+func (x *GetDLQTasksRequest) GetDlqKey() *v112.HistoryDLQKey {
+	if x != nil {
+		return x.DlqKey
+	}
+	return nil
+}
+
+func (x *GetDLQTasksRequest) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+func (x *GetDLQTasksRequest) GetNextPageToken() []byte {
+	if x != nil {
+		return x.NextPageToken
+	}
+	return nil
+}
+
+type GetDLQTasksResponse struct {
+	state    protoimpl.MessageState `protogen:"open.v1"`
+	DlqTasks []*v112.HistoryDLQTask `protobuf:"bytes,1,rep,name=dlq_tasks,json=dlqTasks,proto3" json:"dlq_tasks,omitempty"`
+	// next_page_token is empty if there are no more results. However, the converse is not true. If there are no more
+	// results, this field may still be non-empty. This is to avoid having to do a count query to determine whether
+	// there are more results.
+	NextPageToken []byte `protobuf:"bytes,2,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetDLQTasksResponse) Reset() {
+	*x = GetDLQTasksResponse{}
+	mi := &file_temporal_server_api_adminservice_v1_request_response_proto_msgTypes[91]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetDLQTasksResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetDLQTasksResponse) ProtoMessage() {}
+
+func (x *GetDLQTasksResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_temporal_server_api_adminservice_v1_request_response_proto_msgTypes[91]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetDLQTasksResponse.ProtoReflect.Descriptor instead.
+func (*GetDLQTasksResponse) Descriptor() ([]byte, []int) {
+	return file_temporal_server_api_adminservice_v1_request_response_proto_rawDescGZIP(), []int{91}
+}
+
+func (x *GetDLQTasksResponse) GetDlqTasks() []*v112.HistoryDLQTask {
+	if x != nil {
+		return x.DlqTasks
+	}
+	return nil
+}
+
+func (x *GetDLQTasksResponse) GetNextPageToken() []byte {
+	if x != nil {
+		return x.NextPageToken
+	}
+	return nil
+}
+
+type PurgeDLQTasksRequest struct {
+	state                    protoimpl.MessageState       `protogen:"open.v1"`
+	DlqKey                   *v112.HistoryDLQKey          `protobuf:"bytes,1,opt,name=dlq_key,json=dlqKey,proto3" json:"dlq_key,omitempty"`
+	InclusiveMaxTaskMetadata *v112.HistoryDLQTaskMetadata `protobuf:"bytes,2,opt,name=inclusive_max_task_metadata,json=inclusiveMaxTaskMetadata,proto3" json:"inclusive_max_task_metadata,omitempty"`
+	unknownFields            protoimpl.UnknownFields
+	sizeCache                protoimpl.SizeCache
+}
+
+func (x *PurgeDLQTasksRequest) Reset() {
+	*x = PurgeDLQTasksRequest{}
+	mi := &file_temporal_server_api_adminservice_v1_request_response_proto_msgTypes[92]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PurgeDLQTasksRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PurgeDLQTasksRequest) ProtoMessage() {}
+
+func (x *PurgeDLQTasksRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_temporal_server_api_adminservice_v1_request_response_proto_msgTypes[92]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PurgeDLQTasksRequest.ProtoReflect.Descriptor instead.
+func (*PurgeDLQTasksRequest) Descriptor() ([]byte, []int) {
+	return file_temporal_server_api_adminservice_v1_request_response_proto_rawDescGZIP(), []int{92}
+}
+
+func (x *PurgeDLQTasksRequest) GetDlqKey() *v112.HistoryDLQKey {
+	if x != nil {
+		return x.DlqKey
+	}
+	return nil
+}
+
+func (x *PurgeDLQTasksRequest) GetInclusiveMaxTaskMetadata() *v112.HistoryDLQTaskMetadata {
+	if x != nil {
+		return x.InclusiveMaxTaskMetadata
+	}
+	return nil
+}
+
+type PurgeDLQTasksResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// job_token is a token that can be used to query the status of the purge operation.
+	JobToken      []byte `protobuf:"bytes,1,opt,name=job_token,json=jobToken,proto3" json:"job_token,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PurgeDLQTasksResponse) Reset() {
+	*x = PurgeDLQTasksResponse{}
+	mi := &file_temporal_server_api_adminservice_v1_request_response_proto_msgTypes[93]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PurgeDLQTasksResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PurgeDLQTasksResponse) ProtoMessage() {}
+
+func (x *PurgeDLQTasksResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_temporal_server_api_adminservice_v1_request_response_proto_msgTypes[93]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PurgeDLQTasksResponse.ProtoReflect.Descriptor instead.
+func (*PurgeDLQTasksResponse) Descriptor() ([]byte, []int) {
+	return file_temporal_server_api_adminservice_v1_request_response_proto_rawDescGZIP(), []int{93}
+}
+
+func (x *PurgeDLQTasksResponse) GetJobToken() []byte {
+	if x != nil {
+		return x.JobToken
+	}
+	return nil
+}
+
+// DLQJobToken identifies a DLQ job. This proto is for internal use only and clients should not use it.
+type DLQJobToken struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	WorkflowId    string                 `protobuf:"bytes,1,opt,name=workflow_id,json=workflowId,proto3" json:"workflow_id,omitempty"`
+	RunId         string                 `protobuf:"bytes,2,opt,name=run_id,json=runId,proto3" json:"run_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DLQJobToken) Reset() {
+	*x = DLQJobToken{}
+	mi := &file_temporal_server_api_adminservice_v1_request_response_proto_msgTypes[94]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DLQJobToken) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DLQJobToken) ProtoMessage() {}
+
+func (x *DLQJobToken) ProtoReflect() protoreflect.Message {
+	mi := &file_temporal_server_api_adminservice_v1_request_response_proto_msgTypes[94]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DLQJobToken.ProtoReflect.Descriptor instead.
+func (*DLQJobToken) Descriptor() ([]byte, []int) {
+	return file_temporal_server_api_adminservice_v1_request_response_proto_rawDescGZIP(), []int{94}
+}
+
+func (x *DLQJobToken) GetWorkflowId() string {
+	if x != nil {
+		return x.WorkflowId
+	}
+	return ""
+}
+
+func (x *DLQJobToken) GetRunId() string {
+	if x != nil {
+		return x.RunId
+	}
+	return ""
+}
+
+type MergeDLQTasksRequest struct {
+	state                    protoimpl.MessageState       `protogen:"open.v1"`
+	DlqKey                   *v112.HistoryDLQKey          `protobuf:"bytes,1,opt,name=dlq_key,json=dlqKey,proto3" json:"dlq_key,omitempty"`
+	InclusiveMaxTaskMetadata *v112.HistoryDLQTaskMetadata `protobuf:"bytes,2,opt,name=inclusive_max_task_metadata,json=inclusiveMaxTaskMetadata,proto3" json:"inclusive_max_task_metadata,omitempty"`
+	// batch_size controls how many tasks to merge at a time. The default can be found in the dlq package of the server.
+	// - If this is negative, an error will be returned.
+	// - If this is 0, the default will be used.
+	// - If this is greater than the maximum allowed batch size, an error will be returned.
+	// - Otherwise, the specified batch size will be used.
+	BatchSize     int32 `protobuf:"varint,3,opt,name=batch_size,json=batchSize,proto3" json:"batch_size,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *MergeDLQTasksRequest) Reset() {
+	*x = MergeDLQTasksRequest{}
+	mi := &file_temporal_server_api_adminservice_v1_request_response_proto_msgTypes[95]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MergeDLQTasksRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MergeDLQTasksRequest) ProtoMessage() {}
+
+func (x *MergeDLQTasksRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_temporal_server_api_adminservice_v1_request_response_proto_msgTypes[95]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MergeDLQTasksRequest.ProtoReflect.Descriptor instead.
+func (*MergeDLQTasksRequest) Descriptor() ([]byte, []int) {
+	return file_temporal_server_api_adminservice_v1_request_response_proto_rawDescGZIP(), []int{95}
+}
+
+func (x *MergeDLQTasksRequest) GetDlqKey() *v112.HistoryDLQKey {
+	if x != nil {
+		return x.DlqKey
+	}
+	return nil
+}
+
+func (x *MergeDLQTasksRequest) GetInclusiveMaxTaskMetadata() *v112.HistoryDLQTaskMetadata {
+	if x != nil {
+		return x.InclusiveMaxTaskMetadata
+	}
+	return nil
+}
+
+func (x *MergeDLQTasksRequest) GetBatchSize() int32 {
+	if x != nil {
+		return x.BatchSize
+	}
+	return 0
+}
+
+type MergeDLQTasksResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	JobToken      []byte                 `protobuf:"bytes,1,opt,name=job_token,json=jobToken,proto3" json:"job_token,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *MergeDLQTasksResponse) Reset() {
+	*x = MergeDLQTasksResponse{}
+	mi := &file_temporal_server_api_adminservice_v1_request_response_proto_msgTypes[96]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MergeDLQTasksResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MergeDLQTasksResponse) ProtoMessage() {}
+
+func (x *MergeDLQTasksResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_temporal_server_api_adminservice_v1_request_response_proto_msgTypes[96]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MergeDLQTasksResponse.ProtoReflect.Descriptor instead.
+func (*MergeDLQTasksResponse) Descriptor() ([]byte, []int) {
+	return file_temporal_server_api_adminservice_v1_request_response_proto_rawDescGZIP(), []int{96}
+}
+
+func (x *MergeDLQTasksResponse) GetJobToken() []byte {
+	if x != nil {
+		return x.JobToken
+	}
+	return nil
+}
+
+type DescribeDLQJobRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Job token of MergeDLQTasks or PurgeDLQTasks job.
+	JobToken      []byte `protobuf:"bytes,1,opt,name=job_token,json=jobToken,proto3" json:"job_token,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DescribeDLQJobRequest) Reset() {
+	*x = DescribeDLQJobRequest{}
+	mi := &file_temporal_server_api_adminservice_v1_request_response_proto_msgTypes[97]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DescribeDLQJobRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DescribeDLQJobRequest) ProtoMessage() {}
+
+func (x *DescribeDLQJobRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_temporal_server_api_adminservice_v1_request_response_proto_msgTypes[97]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DescribeDLQJobRequest.ProtoReflect.Descriptor instead.
+func (*DescribeDLQJobRequest) Descriptor() ([]byte, []int) {
+	return file_temporal_server_api_adminservice_v1_request_response_proto_rawDescGZIP(), []int{97}
+}
+
+func (x *DescribeDLQJobRequest) GetJobToken() []byte {
+	if x != nil {
+		return x.JobToken
 	}
 	return nil
 }
 
-func (x *GetNamespaceResponse) GetIsGlobalNamespace() bool {
+type DescribeDLQJobResponse struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	DlqKey         *v112.HistoryDLQKey    `protobuf:"bytes,1,opt,name=dlq_key,json=dlqKey,proto3" json:"dlq_key,omitempty"`
+	OperationType  v13.DLQOperationType   `protobuf:"varint,2,opt,name=operation_type,json=operationType,proto3,enum=temporal.server.api.enums.v1.DLQOperationType" json:"operation_type,omitempty"`
+	OperationState v13.DLQOperationState  `protobuf:"varint,3,opt,name=operation_state,json=operationState,proto3,enum=temporal.server.api.enums.v1.DLQOperationState" json:"operation_state,omitempty"`
+	StartTime      *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=start_time,json=startTime,proto3" json:"start_time,omitempty"`
+	EndTime        *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=end_time,json=endTime,proto3" json:"end_time,omitempty"`
+	// max_message_id is the ID of the last message(inclusive) to be processed as part of this job.
+	MaxMessageId int64 `protobuf:"varint,6,opt,name=max_message_id,json=maxMessageId,proto3" json:"max_message_id,omitempty"`
+	// last_processed_message_id is the ID of the last message that has been processed.
+	// For PurgeDLQTasks job, it the ID of the last message that was purged.
+	// For MergeDLQTasks job, it is the ID of the last message that was re-enqueued and removed from the DLQ.
+	LastProcessedMessageId int64 `protobuf:"varint,7,opt,name=last_processed_message_id,json=lastProcessedMessageId,proto3" json:"last_processed_message_id,omitempty"`
+	// messages_processed is the total number of messages that are re-enqueued and deleted from the DLQ so far by the DLQ job.
+	MessagesProcessed int64 `protobuf:"varint,8,opt,name=messages_processed,json=messagesProcessed,proto3" json:"messages_processed,omitempty"`
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
+}
+
+func (x *DescribeDLQJobResponse) Reset() {
+	*x = DescribeDLQJobResponse{}
+	mi := &file_temporal_server_api_adminservice_v1_request_response_proto_msgTypes[98]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DescribeDLQJobResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DescribeDLQJobResponse) ProtoMessage() {}
+
+func (x *DescribeDLQJobResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_temporal_server_api_adminservice_v1_request_response_proto_msgTypes[98]
 	if x != nil {
-		return x.IsGlobalNamespace
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DescribeDLQJobResponse.ProtoReflect.Descriptor instead.
+func (*DescribeDLQJobResponse) Descriptor() ([]byte, []int) {
+	return file_temporal_server_api_adminservice_v1_request_response_proto_rawDescGZIP(), []int{98}
+}
+
+func (x *DescribeDLQJobResponse) GetDlqKey() *v112.HistoryDLQKey {
+	if x != nil {
+		return x.DlqKey
+	}
+	return nil
+}
+
+func (x *DescribeDLQJobResponse) GetOperationType() v13.DLQOperationType {
+	if x != nil {
+		return x.OperationType
+	}
+	return v13.DLQOperationType(0)
+}
+
+func (x *DescribeDLQJobResponse) GetOperationState() v13.DLQOperationState {
+	if x != nil {
+		return x.OperationState
+	}
+	return v13.DLQOperationState(0)
+}
+
+func (x *DescribeDLQJobResponse) GetStartTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.StartTime
+	}
+	return nil
+}
+
+func (x *DescribeDLQJobResponse) GetEndTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.EndTime
+	}
+	return nil
+}
+
+func (x *DescribeDLQJobResponse) GetMaxMessageId() int64 {
+	if x != nil {
+		return x.MaxMessageId
+	}
+	return 0
+}
+
+func (x *DescribeDLQJobResponse) GetLastProcessedMessageId() int64 {
+	if x != nil {
+		return x.LastProcessedMessageId
+	}
+	return 0
+}
+
+func (x *DescribeDLQJobResponse) GetMessagesProcessed() int64 {
+	if x != nil {
+		return x.MessagesProcessed
+	}
+	return 0
+}
+
+type CancelDLQJobRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Job token of MergeDLQTasks or PurgeDLQTasks job to cancel.
+	JobToken []byte `protobuf:"bytes,1,opt,name=job_token,json=jobToken,proto3" json:"job_token,omitempty"`
+	// The reason for cancellation.
+	Reason        string `protobuf:"bytes,2,opt,name=reason,proto3" json:"reason,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CancelDLQJobRequest) Reset() {
+	*x = CancelDLQJobRequest{}
+	mi := &file_temporal_server_api_adminservice_v1_request_response_proto_msgTypes[99]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CancelDLQJobRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CancelDLQJobRequest) ProtoMessage() {}
+
+func (x *CancelDLQJobRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_temporal_server_api_adminservice_v1_request_response_proto_msgTypes[99]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CancelDLQJobRequest.ProtoReflect.Descriptor instead.
+func (*CancelDLQJobRequest) Descriptor() ([]byte, []int) {
+	return file_temporal_server_api_adminservice_v1_request_response_proto_rawDescGZIP(), []int{99}
+}
+
+func (x *CancelDLQJobRequest) GetJobToken() []byte {
+	if x != nil {
+		return x.JobToken
+	}
+	return nil
+}
+
+func (x *CancelDLQJobRequest) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+type CancelDLQJobResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// This is true if the workflow was successfully terminated by this request and false if
+	// the workflow was already completed or terminated.
+	Canceled      bool `protobuf:"varint,1,opt,name=canceled,proto3" json:"canceled,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CancelDLQJobResponse) Reset() {
+	*x = CancelDLQJobResponse{}
+	mi := &file_temporal_server_api_adminservice_v1_request_response_proto_msgTypes[100]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CancelDLQJobResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CancelDLQJobResponse) ProtoMessage() {}
+
+func (x *CancelDLQJobResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_temporal_server_api_adminservice_v1_request_response_proto_msgTypes[100]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CancelDLQJobResponse.ProtoReflect.Descriptor instead.
+func (*CancelDLQJobResponse) Descriptor() ([]byte, []int) {
+	return file_temporal_server_api_adminservice_v1_request_response_proto_rawDescGZIP(), []int{100}
+}
+
+func (x *CancelDLQJobResponse) GetCanceled() bool {
+	if x != nil {
+		return x.Canceled
 	}
 	return false
 }
 
-type GetDLQTasksRequest struct {
-	state  protoimpl.MessageState `protogen:"open.v1"`
-	DlqKey *v112.HistoryDLQKey    `protobuf:"bytes,1,opt,name=dlq_key,json=dlqKey,proto3" json:"dlq_key,omitempty"`
-	// page_size must be positive. Up to this many tasks will be returned.
-	PageSize      int32  `protobuf:"varint,2,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
-	NextPageToken []byte `protobuf:"bytes,3,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"`
+// This is a direct copy of the same proto in the history service. We can't import it, though because of a circular
+// dependency. In addition, we can't extract a common request proto because the shard_id needs to be present in the top
+// proto layer, so we duplicate it. It shouldn't be a big deal because this proto is not very big.
+type AddTasksRequest struct {
+	state         protoimpl.MessageState  `protogen:"open.v1"`
+	ShardId       int32                   `protobuf:"varint,1,opt,name=shard_id,json=shardId,proto3" json:"shard_id,omitempty"`
+	Tasks         []*AddTasksRequest_Task `protobuf:"bytes,2,rep,name=tasks,proto3" json:"tasks,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AddTasksRequest) Reset() {
+	*x = AddTasksRequest{}
+	mi := &file_temporal_server_api_adminservice_v1_request_response_proto_msgTypes[101]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AddTasksRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AddTasksRequest) ProtoMessage() {}
+
+func (x *AddTasksRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_temporal_server_api_adminservice_v1_request_response_proto_msgTypes[101]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AddTasksRequest.ProtoReflect.Descriptor instead.
+func (*AddTasksRequest) Descriptor() ([]byte, []int) {
+	return file_temporal_server_api_adminservice_v1_request_response_proto_rawDescGZIP(), []int{101}
+}
+
+func (x *AddTasksRequest) GetShardId() int32 {
+	if x != nil {
+		return x.ShardId
+	}
+	return 0
+}
+
+func (x *AddTasksRequest) GetTasks() []*AddTasksRequest_Task {
+	if x != nil {
+		return x.Tasks
+	}
+	return nil
+}
+
+type AddTasksResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetDLQTasksRequest) Reset() {
-	*x = GetDLQTasksRequest{}
-	mi := &file_temporal_server_api_adminservice_v1_request_response_proto_msgTypes[63]
+func (x *AddTasksResponse) Reset() {
+	*x = AddTasksResponse{}
+	mi := &file_temporal_server_api_adminservice_v1_request_response_proto_msgTypes[102]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetDLQTasksRequest) String() string {
+func (x *AddTasksResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetDLQTasksRequest) ProtoMessage() {}
+func (*AddTasksResponse) ProtoMessage() {}
 
-func (x *GetDLQTasksRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_temporal_server_api_adminservice_v1_request_response_proto_msgTypes[63]
+func (x *AddTasksResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_temporal_server_api_adminservice_v1_request_response_proto_msgTypes[102]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3890,58 +6227,35 @@ func (x *GetDLQTasksRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetDLQTasksRequest.ProtoReflect.Descriptor instead.
-func (*GetDLQTasksRequest) Descriptor() ([]byte, []int) {
-	return file_temporal_server_api_adminservice_v1_request_response_proto_rawDescGZIP(), []int{63}
-}
-
-func (x *GetDLQTasksRequest) GetDlqKey() *v112.HistoryDLQKey {
-	if x != nil {
-		return x.DlqKey
-	}
-	return nil
-}
-
-func (x *GetDLQTasksRequest) GetPageSize() int32 {
-	if x != nil {
-		return x.PageSize
-	}
-	return 0
-}
-
-func (x *GetDLQTasksRequest) GetNextPageToken() []byte {
-	if x != nil {
-		return x.NextPageToken
-	}
-	return nil
+// Deprecated: Use AddTasksResponse.ProtoReflect.Descriptor instead.
+func (*AddTasksResponse) Descriptor() ([]byte, []int) {
+	return file_temporal_server_api_adminservice_v1_request_response_proto_rawDescGZIP(), []int{102}
 }
 
-type GetDLQTasksResponse struct {
-	state    protoimpl.MessageState `protogen:"open.v1"`
-	DlqTasks []*v112.HistoryDLQTask `protobuf:"bytes,1,rep,name=dlq_tasks,json=dlqTasks,proto3" json:"dlq_tasks,omitempty"`
-	// next_page_token is empty if there are no more results. However, the converse is not true. If there are no more
-	// results, this field may still be non-empty. This is to avoid having to do a count query to determine whether
-	// there are more results.
-	NextPageToken []byte `protobuf:"bytes,2,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"`
+type ListQueuesRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	QueueType     int32                  `protobuf:"varint,1,opt,name=queue_type,json=queueType,proto3" json:"queue_type,omitempty"`
+	PageSize      int32                  `protobuf:"varint,2,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	NextPageToken []byte                 `protobuf:"bytes,3,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetDLQTasksResponse) Reset() {
-	*x = GetDLQTasksResponse{}
-	mi := &file_temporal_server_api_adminservice_v1_request_response_proto_msgTypes[64]
+func (x *ListQueuesRequest) Reset() {
+	*x = ListQueuesRequest{}
+	mi := &file_temporal_server_api_adminservice_v1_request_response_proto_msgTypes[103]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetDLQTasksResponse) String() string {
+func (x *ListQueuesRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetDLQTasksResponse) ProtoMessage() {}
+func (*ListQueuesRequest) ProtoMessage() {}
 
-func (x *GetDLQTasksResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_temporal_server_api_adminservice_v1_request_response_proto_msgTypes[64]
+func (x *ListQueuesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_temporal_server_api_adminservice_v1_request_response_proto_msgTypes[103]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3952,48 +6266,55 @@ func (x *GetDLQTasksResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetDLQTasksResponse.ProtoReflect.Descriptor instead.
-func (*GetDLQTasksResponse) Descriptor() ([]byte, []int) {
-	return file_temporal_server_api_adminservice_v1_request_response_proto_rawDescGZIP(), []int{64}
+// Deprecated: Use ListQueuesRequest.ProtoReflect.Descriptor instead.
+func (*ListQueuesRequest) Descriptor() ([]byte, []int) {
+	return file_temporal_server_api_adminservice_v1_request_response_proto_rawDescGZIP(), []int{103}
 }
 
-func (x *GetDLQTasksResponse) GetDlqTasks() []*v112.HistoryDLQTask {
+func (x *ListQueuesRequest) GetQueueType() int32 {
 	if x != nil {
-		return x.DlqTasks
+		return x.QueueType
 	}
-	return nil
+	return 0
 }
 
-func (x *GetDLQTasksResponse) GetNextPageToken() []byte {
+func (x *ListQueuesRequest) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+func (x *ListQueuesRequest) GetNextPageToken() []byte {
 	if x != nil {
 		return x.NextPageToken
 	}
 	return nil
 }
 
-type PurgeDLQTasksRequest struct {
-	state                    protoimpl.MessageState       `protogen:"open.v1"`
-	DlqKey                   *v112.HistoryDLQKey          `protobuf:"bytes,1,opt,name=dlq_key,json=dlqKey,proto3" json:"dlq_key,omitempty"`
-	InclusiveMaxTaskMetadata *v112.HistoryDLQTaskMetadata `protobuf:"bytes,2,opt,name=inclusive_max_task_metadata,json=inclusiveMaxTaskMetadata,proto3" json:"inclusive_max_task_metadata,omitempty"`
-	unknownFields            protoimpl.UnknownFields
-	sizeCache                protoimpl.SizeCache
+type ListQueuesResponse struct {
+	state         protoimpl.MessageState          `protogen:"open.v1"`
+	Queues        []*ListQueuesResponse_QueueInfo `protobuf:"bytes,1,rep,name=queues,proto3" json:"queues,omitempty"`
+	NextPageToken []byte                          `protobuf:"bytes,2,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *PurgeDLQTasksRequest) Reset() {
-	*x = PurgeDLQTasksRequest{}
-	mi := &file_temporal_server_api_adminservice_v1_request_response_proto_msgTypes[65]
+func (x *ListQueuesResponse) Reset() {
+	*x = ListQueuesResponse{}
+	mi := &file_temporal_server_api_adminservice_v1_request_response_proto_msgTypes[104]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *PurgeDLQTasksRequest) String() string {
+func (x *ListQueuesResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*PurgeDLQTasksRequest) ProtoMessage() {}
+func (*ListQueuesResponse) ProtoMessage() {}
 
-func (x *PurgeDLQTasksRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_temporal_server_api_adminservice_v1_request_response_proto_msgTypes[65]
+func (x *ListQueuesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_temporal_server_api_adminservice_v1_request_response_proto_msgTypes[104]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4004,48 +6325,46 @@ func (x *PurgeDLQTasksRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use PurgeDLQTasksRequest.ProtoReflect.Descriptor instead.
-func (*PurgeDLQTasksRequest) Descriptor() ([]byte, []int) {
-	return file_temporal_server_api_adminservice_v1_request_response_proto_rawDescGZIP(), []int{65}
+// Deprecated: Use ListQueuesResponse.ProtoReflect.Descriptor instead.
+func (*ListQueuesResponse) Descriptor() ([]byte, []int) {
+	return file_temporal_server_api_adminservice_v1_request_response_proto_rawDescGZIP(), []int{104}
 }
 
-func (x *PurgeDLQTasksRequest) GetDlqKey() *v112.HistoryDLQKey {
+func (x *ListQueuesResponse) GetQueues() []*ListQueuesResponse_QueueInfo {
 	if x != nil {
-		return x.DlqKey
+		return x.Queues
 	}
 	return nil
 }
 
-func (x *PurgeDLQTasksRequest) GetInclusiveMaxTaskMetadata() *v112.HistoryDLQTaskMetadata {
+func (x *ListQueuesResponse) GetNextPageToken() []byte {
 	if x != nil {
-		return x.InclusiveMaxTaskMetadata
+		return x.NextPageToken
 	}
 	return nil
 }
 
-type PurgeDLQTasksResponse struct {
-	state protoimpl.MessageState `protogen:"open.v1"`
-	// job_token is a token that can be used to query the status of the purge operation.
-	JobToken      []byte `protobuf:"bytes,1,opt,name=job_token,json=jobToken,proto3" json:"job_token,omitempty"`
+type DeepHealthCheckRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *PurgeDLQTasksResponse) Reset() {
-	*x = PurgeDLQTasksResponse{}
-	mi := &file_temporal_server_api_adminservice_v1_request_response_proto_msgTypes[66]
+func (x *DeepHealthCheckRequest) Reset() {
+	*x = DeepHealthCheckRequest{}
+	mi := &file_temporal_server_api_adminservice_v1_request_response_proto_msgTypes[105]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *PurgeDLQTasksResponse) String() string {
+func (x *DeepHealthCheckRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*PurgeDLQTasksResponse) ProtoMessage() {}
+func (*DeepHealthCheckRequest) ProtoMessage() {}
 
-func (x *PurgeDLQTasksResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_temporal_server_api_adminservice_v1_request_response_proto_msgTypes[66]
+func (x *DeepHealthCheckRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_temporal_server_api_adminservice_v1_request_response_proto_msgTypes[105]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4056,42 +6375,33 @@ func (x *PurgeDLQTasksResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use PurgeDLQTasksResponse.ProtoReflect.Descriptor instead.
-func (*PurgeDLQTasksResponse) Descriptor() ([]byte, []int) {
-	return file_temporal_server_api_adminservice_v1_request_response_proto_rawDescGZIP(), []int{66}
-}
-
-func (x *PurgeDLQTasksResponse) GetJobToken() []byte {
-	if x != nil {
-		return x.JobToken
-	}
-	return nil
+// Deprecated: Use DeepHealthCheckRequest.ProtoReflect.Descriptor instead.
+func (*DeepHealthCheckRequest) Descriptor() ([]byte, []int) {
+	return file_temporal_server_api_adminservice_v1_request_response_proto_rawDescGZIP(), []int{105}
 }
 
-// DLQJobToken identifies a DLQ job. This proto is for internal use only and clients should not use it.
-type DLQJobToken struct {
+type DeepHealthCheckResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	WorkflowId    string                 `protobuf:"bytes,1,opt,name=workflow_id,json=workflowId,proto3" json:"workflow_id,omitempty"`
-	RunId         string                 `protobuf:"bytes,2,opt,name=run_id,json=runId,proto3" json:"run_id,omitempty"`
+	State         v13.HealthState        `protobuf:"varint,1,opt,name=state,proto3,enum=temporal.server.api.enums.v1.HealthState" json:"state,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *DLQJobToken) Reset() {
-	*x = DLQJobToken{}
-	mi := &file_temporal_server_api_adminservice_v1_request_response_proto_msgTypes[67]
+func (x *DeepHealthCheckResponse) Reset() {
+	*x = DeepHealthCheckResponse{}
+	mi := &file_temporal_server_api_adminservice_v1_request_response_proto_msgTypes[106]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *DLQJobToken) String() string {
+func (x *DeepHealthCheckResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DLQJobToken) ProtoMessage() {}
+func (*DeepHealthCheckResponse) ProtoMessage() {}
 
-func (x *DLQJobToken) ProtoReflect() protoreflect.Message {
-	mi := &file_temporal_server_api_adminservice_v1_request_response_proto_msgTypes[67]
+func (x *DeepHealthCheckResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_temporal_server_api_adminservice_v1_request_response_proto_msgTypes[106]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4102,54 +6412,44 @@ func (x *DLQJobToken) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use DLQJobToken.ProtoReflect.Descriptor instead.
-func (*DLQJobToken) Descriptor() ([]byte, []int) {
-	return file_temporal_server_api_adminservice_v1_request_response_proto_rawDescGZIP(), []int{67}
-}
-
-func (x *DLQJobToken) GetWorkflowId() string {
-	if x != nil {
-		return x.WorkflowId
-	}
-	return ""
+// Deprecated: Use DeepHealthCheckResponse.ProtoReflect.Descriptor instead.
+func (*DeepHealthCheckResponse) Descriptor() ([]byte, []int) {
+	return file_temporal_server_api_adminservice_v1_request_response_proto_rawDescGZIP(), []int{106}
 }
 
-func (x *DLQJobToken) GetRunId() string {
+func (x *DeepHealthCheckResponse) GetState() v13.HealthState {
 	if x != nil {
-		return x.RunId
+		return x.State
 	}
-	return ""
+	return v13.HealthState(0)
 }
 
-type MergeDLQTasksRequest struct {
-	state                    protoimpl.MessageState       `protogen:"open.v1"`
-	DlqKey                   *v112.HistoryDLQKey          `protobuf:"bytes,1,opt,name=dlq_key,json=dlqKey,proto3" json:"dlq_key,omitempty"`
-	InclusiveMaxTaskMetadata *v112.HistoryDLQTaskMetadata `protobuf:"bytes,2,opt,name=inclusive_max_task_metadata,json=inclusiveMaxTaskMetadata,proto3" json:"inclusive_max_task_metadata,omitempty"`
-	// batch_size controls how many tasks to merge at a time. The default can be found in the dlq package of the server.
-	// - If this is negative, an error will be returned.
-	// - If this is 0, the default will be used.
-	// - If this is greater than the maximum allowed batch size, an error will be returned.
-	// - Otherwise, the specified batch size will be used.
-	BatchSize     int32 `protobuf:"varint,3,opt,name=batch_size,json=batchSize,proto3" json:"batch_size,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+type SyncWorkflowStateRequest struct {
+	state               protoimpl.MessageState   `protogen:"open.v1"`
+	NamespaceId         string                   `protobuf:"bytes,1,opt,name=namespace_id,json=namespaceId,proto3" json:"namespace_id,omitempty"`
+	Execution           *v1.WorkflowExecution    `protobuf:"bytes,2,opt,name=execution,proto3" json:"execution,omitempty"`
+	VersionedTransition *v14.VersionedTransition `protobuf:"bytes,3,opt,name=versioned_transition,json=versionedTransition,proto3" json:"versioned_transition,omitempty"`
+	VersionHistories    *v11.VersionHistories    `protobuf:"bytes,4,opt,name=version_histories,json=versionHistories,proto3" json:"version_histories,omitempty"`
+	TargetClusterId     int32                    `protobuf:"varint,5,opt,name=target_cluster_id,json=targetClusterId,proto3" json:"target_cluster_id,omitempty"`
+	unknownFields       protoimpl.UnknownFields
+	sizeCache           protoimpl.SizeCache
 }
 
-func (x *MergeDLQTasksRequest) Reset() {
-	*x = MergeDLQTasksRequest{}
-	mi := &file_temporal_server_api_adminservice_v1_request_response_proto_msgTypes[68]
+func (x *SyncWorkflowStateRequest) Reset() {
+	*x = SyncWorkflowStateRequest{}
+	mi := &file_temporal_server_api_adminservice_v1_request_response_proto_msgTypes[107]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *MergeDLQTasksRequest) String() string {
+func (x *SyncWorkflowStateRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*MergeDLQTasksRequest) ProtoMessage() {}
+func (*SyncWorkflowStateRequest) ProtoMessage() {}
 
-func (x *MergeDLQTasksRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_temporal_server_api_adminservice_v1_request_response_proto_msgTypes[68]
+func (x *SyncWorkflowStateRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_temporal_server_api_adminservice_v1_request_response_proto_msgTypes[107]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4160,99 +6460,68 @@ func (x *MergeDLQTasksRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use MergeDLQTasksRequest.ProtoReflect.Descriptor instead.
-func (*MergeDLQTasksRequest) Descriptor() ([]byte, []int) {
-	return file_temporal_server_api_adminservice_v1_request_response_proto_rawDescGZIP(), []int{68}
-}
-
-func (x *MergeDLQTasksRequest) GetDlqKey() *v112.HistoryDLQKey {
-	if x != nil {
-		return x.DlqKey
-	}
-	return nil
-}
-
-func (x *MergeDLQTasksRequest) GetInclusiveMaxTaskMetadata() *v112.HistoryDLQTaskMetadata {
-	if x != nil {
-		return x.InclusiveMaxTaskMetadata
-	}
-	return nil
+// Deprecated: Use SyncWorkflowStateRequest.ProtoReflect.Descriptor instead.
+func (*SyncWorkflowStateRequest) Descriptor() ([]byte, []int) {
+	return file_temporal_server_api_adminservice_v1_request_response_proto_rawDescGZIP(), []int{107}
 }
 
-func (x *MergeDLQTasksRequest) GetBatchSize() int32 {
+func (x *SyncWorkflowStateRequest) GetNamespaceId() string {
 	if x != nil {
-		return x.BatchSize
+		return x.NamespaceId
 	}
-	return 0
-}
-
-type MergeDLQTasksResponse struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	JobToken      []byte                 `protobuf:"bytes,1,opt,name=job_token,json=jobToken,proto3" json:"job_token,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
-}
-
-func (x *MergeDLQTasksResponse) Reset() {
-	*x = MergeDLQTasksResponse{}
-	mi := &file_temporal_server_api_adminservice_v1_request_response_proto_msgTypes[69]
-	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-	ms.StoreMessageInfo(mi)
+	return ""
 }
 
-func (x *MergeDLQTasksResponse) String() string {
-	return protoimpl.X.MessageStringOf(x)
+func (x *SyncWorkflowStateRequest) GetExecution() *v1.WorkflowExecution {
+	if x != nil {
+		return x.Execution
+	}
+	return nil
 }
 
-func (*MergeDLQTasksResponse) ProtoMessage() {}
-
-func (x *MergeDLQTasksResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_temporal_server_api_adminservice_v1_request_response_proto_msgTypes[69]
+func (x *SyncWorkflowStateRequest) GetVersionedTransition() *v14.VersionedTransition {
 	if x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+		return x.VersionedTransition
 	}
-	return mi.MessageOf(x)
+	return nil
 }
 
-// Deprecated: Use MergeDLQTasksResponse.ProtoReflect.Descriptor instead.
-func (*MergeDLQTasksResponse) Descriptor() ([]byte, []int) {
-	return file_temporal_server_api_adminservice_v1_request_response_proto_rawDescGZIP(), []int{69}
+func (x *SyncWorkflowStateRequest) GetVersionHistories() *v11.VersionHistories {
+	if x != nil {
+		return x.VersionHistories
+	}
+	return nil
 }
 
-func (x *MergeDLQTasksResponse) GetJobToken() []byte {
+func (x *SyncWorkflowStateRequest) GetTargetClusterId() int32 {
 	if x != nil {
-		return x.JobToken
+		return x.TargetClusterId
 	}
-	return nil
+	return 0
 }
 
-type DescribeDLQJobRequest struct {
-	state protoimpl.MessageState `protogen:"open.v1"`
-	// Job token of MergeDLQTasks or PurgeDLQTasks job.
-	JobToken      []byte `protobuf:"bytes,1,opt,name=job_token,json=jobToken,proto3" json:"job_token,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+type SyncWorkflowStateResponse struct {
+	state                       protoimpl.MessageState           `protogen:"open.v1"`
+	VersionedTransitionArtifact *v16.VersionedTransitionArtifact `protobuf:"bytes,5,opt,name=versioned_transition_artifact,json=versionedTransitionArtifact,proto3" json:"versioned_transition_artifact,omitempty"`
+	unknownFields               protoimpl.UnknownFields
+	sizeCache                   protoimpl.SizeCache
 }
 
-func (x *DescribeDLQJobRequest) Reset() {
-	*x = DescribeDLQJobRequest{}
-	mi := &file_temporal_server_api_adminservice_v1_request_response_proto_msgTypes[70]
+func (x *SyncWorkflowStateResponse) Reset() {
+	*x = SyncWorkflowStateResponse{}
+	mi := &file_temporal_server_api_adminservice_v1_request_response_proto_msgTypes[108]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *DescribeDLQJobRequest) String() string {
+func (x *SyncWorkflowStateResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DescribeDLQJobRequest) ProtoMessage() {}
+func (*SyncWorkflowStateResponse) ProtoMessage() {}
 
-func (x *DescribeDLQJobRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_temporal_server_api_adminservice_v1_request_response_proto_msgTypes[70]
+func (x *SyncWorkflowStateResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_temporal_server_api_adminservice_v1_request_response_proto_msgTypes[108]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4263,52 +6532,42 @@ func (x *DescribeDLQJobRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use DescribeDLQJobRequest.ProtoReflect.Descriptor instead.
-func (*DescribeDLQJobRequest) Descriptor() ([]byte, []int) {
-	return file_temporal_server_api_adminservice_v1_request_response_proto_rawDescGZIP(), []int{70}
+// Deprecated: Use SyncWorkflowStateResponse.ProtoReflect.Descriptor instead.
+func (*SyncWorkflowStateResponse) Descriptor() ([]byte, []int) {
+	return file_temporal_server_api_adminservice_v1_request_response_proto_rawDescGZIP(), []int{108}
 }
 
-func (x *DescribeDLQJobRequest) GetJobToken() []byte {
+func (x *SyncWorkflowStateResponse) GetVersionedTransitionArtifact() *v16.VersionedTransitionArtifact {
 	if x != nil {
-		return x.JobToken
+		return x.VersionedTransitionArtifact
 	}
 	return nil
 }
 
-type DescribeDLQJobResponse struct {
+type GenerateLastHistoryReplicationTasksRequest struct {
 	state          protoimpl.MessageState `protogen:"open.v1"`
-	DlqKey         *v112.HistoryDLQKey    `protobuf:"bytes,1,opt,name=dlq_key,json=dlqKey,proto3" json:"dlq_key,omitempty"`
-	OperationType  v14.DLQOperationType   `protobuf:"varint,2,opt,name=operation_type,json=operationType,proto3,enum=temporal.server.api.enums.v1.DLQOperationType" json:"operation_type,omitempty"`
-	OperationState v14.DLQOperationState  `protobuf:"varint,3,opt,name=operation_state,json=operationState,proto3,enum=temporal.server.api.enums.v1.DLQOperationState" json:"operation_state,omitempty"`
-	StartTime      *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=start_time,json=startTime,proto3" json:"start_time,omitempty"`
-	EndTime        *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=end_time,json=endTime,proto3" json:"end_time,omitempty"`
-	// max_message_id is the ID of the last message(inclusive) to be processed as part of this job.
-	MaxMessageId int64 `protobuf:"varint,6,opt,name=max_message_id,json=maxMessageId,proto3" json:"max_message_id,omitempty"`
-	// last_processed_message_id is the ID of the last message that has been processed.
-	// For PurgeDLQTasks job, it the ID of the last message that was purged.
-	// For MergeDLQTasks job, it is the ID of the last message that was re-enqueued and removed from the DLQ.
-	LastProcessedMessageId int64 `protobuf:"varint,7,opt,name=last_processed_message_id,json=lastProcessedMessageId,proto3" json:"last_processed_message_id,omitempty"`
-	// messages_processed is the total number of messages that are re-enqueued and deleted from the DLQ so far by the DLQ job.
-	MessagesProcessed int64 `protobuf:"varint,8,opt,name=messages_processed,json=messagesProcessed,proto3" json:"messages_processed,omitempty"`
-	unknownFields     protoimpl.UnknownFields
-	sizeCache         protoimpl.SizeCache
+	Namespace      string                 `protobuf:"bytes,1,opt,name=namespace,proto3" json:"namespace,omitempty"`
+	Execution      *v1.WorkflowExecution  `protobuf:"bytes,2,opt,name=execution,proto3" json:"execution,omitempty"`
+	TargetClusters []string               `protobuf:"bytes,3,rep,name=target_clusters,json=targetClusters,proto3" json:"target_clusters,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
 }
 
-func (x *DescribeDLQJobResponse) Reset() {
-	*x = DescribeDLQJobResponse{}
-	mi := &file_temporal_server_api_adminservice_v1_request_response_proto_msgTypes[71]
+func (x *GenerateLastHistoryReplicationTasksRequest) Reset() {
+	*x = GenerateLastHistoryReplicationTasksRequest{}
+	mi := &file_temporal_server_api_adminservice_v1_request_response_proto_msgTypes[109]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *DescribeDLQJobResponse) String() string {
+func (x *GenerateLastHistoryReplicationTasksRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DescribeDLQJobResponse) ProtoMessage() {}
+func (*GenerateLastHistoryReplicationTasksRequest) ProtoMessage() {}
 
-func (x *DescribeDLQJobResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_temporal_server_api_adminservice_v1_request_response_proto_msgTypes[71]
+func (x *GenerateLastHistoryReplicationTasksRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_temporal_server_api_adminservice_v1_request_response_proto_msgTypes[109]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4319,92 +6578,55 @@ func (x *DescribeDLQJobResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use DescribeDLQJobResponse.ProtoReflect.Descriptor instead.
-func (*DescribeDLQJobResponse) Descriptor() ([]byte, []int) {
-	return file_temporal_server_api_adminservice_v1_request_response_proto_rawDescGZIP(), []int{71}
-}
-
-func (x *DescribeDLQJobResponse) GetDlqKey() *v112.HistoryDLQKey {
-	if x != nil {
-		return x.DlqKey
-	}
-	return nil
-}
-
-func (x *DescribeDLQJobResponse) GetOperationType() v14.DLQOperationType {
-	if x != nil {
-		return x.OperationType
-	}
-	return v14.DLQOperationType(0)
+// Deprecated: Use GenerateLastHistoryReplicationTasksRequest.ProtoReflect.Descriptor instead.
+func (*GenerateLastHistoryReplicationTasksRequest) Descriptor() ([]byte, []int) {
+	return file_temporal_server_api_adminservice_v1_request_response_proto_rawDescGZIP(), []int{109}
 }
 
-func (x *DescribeDLQJobResponse) GetOperationState() v14.DLQOperationState {
+func (x *GenerateLastHistoryReplicationTasksRequest) GetNamespace() string {
 	if x != nil {
-		return x.OperationState
+		return x.Namespace
 	}
-	return v14.DLQOperationState(0)
+	return ""
 }
 
-func (x *DescribeDLQJobResponse) GetStartTime() *timestamppb.Timestamp {
+func (x *GenerateLastHistoryReplicationTasksRequest) GetExecution() *v1.WorkflowExecution {
 	if x != nil {
-		return x.StartTime
+		return x.Execution
 	}
 	return nil
 }
 
-func (x *DescribeDLQJobResponse) GetEndTime() *timestamppb.Timestamp {
+func (x *GenerateLastHistoryReplicationTasksRequest) GetTargetClusters() []string {
 	if x != nil {
-		return x.EndTime
+		return x.TargetClusters
 	}
 	return nil
 }
 
-func (x *DescribeDLQJobResponse) GetMaxMessageId() int64 {
-	if x != nil {
-		return x.MaxMessageId
-	}
-	return 0
-}
-
-func (x *DescribeDLQJobResponse) GetLastProcessedMessageId() int64 {
-	if x != nil {
-		return x.LastProcessedMessageId
-	}
-	return 0
-}
-
-func (x *DescribeDLQJobResponse) GetMessagesProcessed() int64 {
-	if x != nil {
-		return x.MessagesProcessed
-	}
-	return 0
-}
-
-type CancelDLQJobRequest struct {
-	state protoimpl.MessageState `protogen:"open.v1"`
-	// Job token of MergeDLQTasks or PurgeDLQTasks job to cancel.
-	JobToken []byte `protobuf:"bytes,1,opt,name=job_token,json=jobToken,proto3" json:"job_token,omitempty"`
-	// The reason for cancellation.
-	Reason        string `protobuf:"bytes,2,opt,name=reason,proto3" json:"reason,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+type GenerateLastHistoryReplicationTasksResponse struct {
+	state                protoimpl.MessageState `protogen:"open.v1"`
+	StateTransitionCount int64                  `protobuf:"varint,1,opt,name=state_transition_count,json=stateTransitionCount,proto3" json:"state_transition_count,omitempty"`
+	HistoryLength        int64                  `protobuf:"varint,2,opt,name=history_length,json=historyLength,proto3" json:"history_length,omitempty"`
+	unknownFields        protoimpl.UnknownFields
+	sizeCache            protoimpl.SizeCache
 }
 
-func (x *CancelDLQJobRequest) Reset() {
-	*x = CancelDLQJobRequest{}
-	mi := &file_temporal_server_api_adminservice_v1_request_response_proto_msgTypes[72]
+func (x *GenerateLastHistoryReplicationTasksResponse) Reset() {
+	*x = GenerateLastHistoryReplicationTasksResponse{}
+	mi := &file_temporal_server_api_adminservice_v1_request_response_proto_msgTypes[110]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *CancelDLQJobRequest) String() string {
+func (x *GenerateLastHistoryReplicationTasksResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CancelDLQJobRequest) ProtoMessage() {}
+func (*GenerateLastHistoryReplicationTasksResponse) ProtoMessage() {}
 
-func (x *CancelDLQJobRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_temporal_server_api_adminservice_v1_request_response_proto_msgTypes[72]
+func (x *GenerateLastHistoryReplicationTasksResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_temporal_server_api_adminservice_v1_request_response_proto_msgTypes[110]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4415,49 +6637,50 @@ func (x *CancelDLQJobRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CancelDLQJobRequest.ProtoReflect.Descriptor instead.
-func (*CancelDLQJobRequest) Descriptor() ([]byte, []int) {
-	return file_temporal_server_api_adminservice_v1_request_response_proto_rawDescGZIP(), []int{72}
+// Deprecated: Use GenerateLastHistoryReplicationTasksResponse.ProtoReflect.Descriptor instead.
+func (*GenerateLastHistoryReplicationTasksResponse) Descriptor() ([]byte, []int) {
+	return file_temporal_server_api_adminservice_v1_request_response_proto_rawDescGZIP(), []int{110}
 }
 
-func (x *CancelDLQJobRequest) GetJobToken() []byte {
+func (x *GenerateLastHistoryReplicationTasksResponse) GetStateTransitionCount() int64 {
 	if x != nil {
-		return x.JobToken
+		return x.StateTransitionCount
 	}
-	return nil
+	return 0
 }
 
-func (x *CancelDLQJobRequest) GetReason() string {
+func (x *GenerateLastHistoryReplicationTasksResponse) GetHistoryLength() int64 {
 	if x != nil {
-		return x.Reason
+		return x.HistoryLength
 	}
-	return ""
+	return 0
 }
 
-type CancelDLQJobResponse struct {
-	state protoimpl.MessageState `protogen:"open.v1"`
-	// This is true if the workflow was successfully terminated by this request and false if
-	// the workflow was already completed or terminated.
-	Canceled      bool `protobuf:"varint,1,opt,name=canceled,proto3" json:"canceled,omitempty"`
+type DescribeTaskQueuePartitionRequest struct {
+	state              protoimpl.MessageState   `protogen:"open.v1"`
+	Namespace          string                   `protobuf:"bytes,1,opt,name=namespace,proto3" json:"namespace,omitempty"`
+	TaskQueuePartition *v113.TaskQueuePartition `protobuf:"bytes,2,opt,name=task_queue_partition,json=taskQueuePartition,proto3" json:"task_queue_partition,omitempty"`
+	// Absent means unversioned queue. Ignored for sticky partitions.
+	BuildIds      *v114.TaskQueueVersionSelection `protobuf:"bytes,3,opt,name=build_ids,json=buildIds,proto3" json:"build_ids,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *CancelDLQJobResponse) Reset() {
-	*x = CancelDLQJobResponse{}
-	mi := &file_temporal_server_api_adminservice_v1_request_response_proto_msgTypes[73]
+func (x *DescribeTaskQueuePartitionRequest) Reset() {
+	*x = DescribeTaskQueuePartitionRequest{}
+	mi := &file_temporal_server_api_adminservice_v1_request_response_proto_msgTypes[111]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *CancelDLQJobResponse) String() string {
+func (x *DescribeTaskQueuePartitionRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CancelDLQJobResponse) ProtoMessage() {}
+func (*DescribeTaskQueuePartitionRequest) ProtoMessage() {}
 
-func (x *CancelDLQJobResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_temporal_server_api_adminservice_v1_request_response_proto_msgTypes[73]
+func (x *DescribeTaskQueuePartitionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_temporal_server_api_adminservice_v1_request_response_proto_msgTypes[111]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4468,44 +6691,57 @@ func (x *CancelDLQJobResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CancelDLQJobResponse.ProtoReflect.Descriptor instead.
-func (*CancelDLQJobResponse) Descriptor() ([]byte, []int) {
-	return file_temporal_server_api_adminservice_v1_request_response_proto_rawDescGZIP(), []int{73}
+// Deprecated: Use DescribeTaskQueuePartitionRequest.ProtoReflect.Descriptor instead.
+func (*DescribeTaskQueuePartitionRequest) Descriptor() ([]byte, []int) {
+	return file_temporal_server_api_adminservice_v1_request_response_proto_rawDescGZIP(), []int{111}
 }
 
-func (x *CancelDLQJobResponse) GetCanceled() bool {
+func (x *DescribeTaskQueuePartitionRequest) GetNamespace() string {
 	if x != nil {
-		return x.Canceled
+		return x.Namespace
 	}
-	return false
+	return ""
 }
 
-// This is a direct copy of the same proto in the history service. We can't import it, though because of a circular
-// dependency. In addition, we can't extract a common request proto because the shard_id needs to be present in the top
-// proto layer, so we duplicate it. It shouldn't be a big deal because this proto is not very big.
-type AddTasksRequest struct {
-	state         protoimpl.MessageState  `protogen:"open.v1"`
-	ShardId       int32                   `protobuf:"varint,1,opt,name=shard_id,json=shardId,proto3" json:"shard_id,omitempty"`
-	Tasks         []*AddTasksRequest_Task `protobuf:"bytes,2,rep,name=tasks,proto3" json:"tasks,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+func (x *DescribeTaskQueuePartitionRequest) GetTaskQueuePartition() *v113.TaskQueuePartition {
+	if x != nil {
+		return x.TaskQueuePartition
+	}
+	return nil
 }
 
-func (x *AddTasksRequest) Reset() {
-	*x = AddTasksRequest{}
-	mi := &file_temporal_server_api_adminservice_v1_request_response_proto_msgTypes[74]
+func (x *DescribeTaskQueuePartitionRequest) GetBuildIds() *v114.TaskQueueVersionSelection {
+	if x != nil {
+		return x.BuildIds
+	}
+	return nil
+}
+
+type InternalTaskQueueStatus struct {
+	state            protoimpl.MessageState `protogen:"open.v1"`
+	ReadLevel        int64                  `protobuf:"varint,1,opt,name=read_level,json=readLevel,proto3" json:"read_level,omitempty"`
+	AckLevel         int64                  `protobuf:"varint,2,opt,name=ack_level,json=ackLevel,proto3" json:"ack_level,omitempty"`
+	TaskIdBlock      *v114.TaskIdBlock      `protobuf:"bytes,3,opt,name=task_id_block,json=taskIdBlock,proto3" json:"task_id_block,omitempty"`
+	ReadBufferLength int64                  `protobuf:"varint,4,opt,name=read_buffer_length,json=readBufferLength,proto3" json:"read_buffer_length,omitempty"`
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *InternalTaskQueueStatus) Reset() {
+	*x = InternalTaskQueueStatus{}
+	mi := &file_temporal_server_api_adminservice_v1_request_response_proto_msgTypes[112]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *AddTasksRequest) String() string {
+func (x *InternalTaskQueueStatus) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*AddTasksRequest) ProtoMessage() {}
+func (*InternalTaskQueueStatus) ProtoMessage() {}
 
-func (x *AddTasksRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_temporal_server_api_adminservice_v1_request_response_proto_msgTypes[74]
+func (x *InternalTaskQueueStatus) ProtoReflect() protoreflect.Message {
+	mi := &file_temporal_server_api_adminservice_v1_request_response_proto_msgTypes[112]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4516,46 +6752,64 @@ func (x *AddTasksRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use AddTasksRequest.ProtoReflect.Descriptor instead.
-func (*AddTasksRequest) Descriptor() ([]byte, []int) {
-	return file_temporal_server_api_adminservice_v1_request_response_proto_rawDescGZIP(), []int{74}
+// Deprecated: Use InternalTaskQueueStatus.ProtoReflect.Descriptor instead.
+func (*InternalTaskQueueStatus) Descriptor() ([]byte, []int) {
+	return file_temporal_server_api_adminservice_v1_request_response_proto_rawDescGZIP(), []int{112}
 }
 
-func (x *AddTasksRequest) GetShardId() int32 {
+func (x *InternalTaskQueueStatus) GetReadLevel() int64 {
 	if x != nil {
-		return x.ShardId
+		return x.ReadLevel
 	}
 	return 0
 }
 
-func (x *AddTasksRequest) GetTasks() []*AddTasksRequest_Task {
+func (x *InternalTaskQueueStatus) GetAckLevel() int64 {
 	if x != nil {
-		return x.Tasks
+		return x.AckLevel
+	}
+	return 0
+}
+
+func (x *InternalTaskQueueStatus) GetTaskIdBlock() *v114.TaskIdBlock {
+	if x != nil {
+		return x.TaskIdBlock
 	}
 	return nil
 }
 
-type AddTasksResponse struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
+func (x *InternalTaskQueueStatus) GetReadBufferLength() int64 {
+	if x != nil {
+		return x.ReadBufferLength
+	}
+	return 0
+}
+
+type DescribeTaskQueuePartitionResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// contains k-v pairs of the type: buildID -> TaskQueueVersionInfoInternal
+	VersionsInfoInternal map[string]*v113.TaskQueueVersionInfoInternal `protobuf:"bytes,1,rep,name=versions_info_internal,json=versionsInfoInternal,proto3" json:"versions_info_internal,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	// Present if dispatch of some or all tasks of the partition's task queue type is paused.
+	DispatchPause *v14.TaskQueueDispatchPause `protobuf:"bytes,2,opt,name=dispatch_pause,json=dispatchPause,proto3" json:"dispatch_pause,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *AddTasksResponse) Reset() {
-	*x = AddTasksResponse{}
-	mi := &file_temporal_server_api_adminservice_v1_request_response_proto_msgTypes[75]
+func (x *DescribeTaskQueuePartitionResponse) Reset() {
+	*x = DescribeTaskQueuePartitionResponse{}
+	mi := &file_temporal_server_api_adminservice_v1_request_response_proto_msgTypes[113]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *AddTasksResponse) String() string {
+func (x *DescribeTaskQueuePartitionResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*AddTasksResponse) ProtoMessage() {}
+func (*DescribeTaskQueuePartitionResponse) ProtoMessage() {}
 
-func (x *AddTasksResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_temporal_server_api_adminservice_v1_request_response_proto_msgTypes[75]
+func (x *DescribeTaskQueuePartitionResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_temporal_server_api_adminservice_v1_request_response_proto_msgTypes[113]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4566,35 +6820,48 @@ func (x *AddTasksResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use AddTasksResponse.ProtoReflect.Descriptor instead.
-func (*AddTasksResponse) Descriptor() ([]byte, []int) {
-	return file_temporal_server_api_adminservice_v1_request_response_proto_rawDescGZIP(), []int{75}
+// Deprecated: Use DescribeTaskQueuePartitionResponse.ProtoReflect.Descriptor instead.
+func (*DescribeTaskQueuePartitionResponse) Descriptor() ([]byte, []int) {
+	return file_temporal_server_api_adminservice_v1_request_response_proto_rawDescGZIP(), []int{113}
 }
 
-type ListQueuesRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	QueueType     int32                  `protobuf:"varint,1,opt,name=queue_type,json=queueType,proto3" json:"queue_type,omitempty"`
-	PageSize      int32                  `protobuf:"varint,2,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
-	NextPageToken []byte                 `protobuf:"bytes,3,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+func (x *DescribeTaskQueuePartitionResponse) GetVersionsInfoInternal() map[string]*v113.TaskQueueVersionInfoInternal {
+	if x != nil {
+		return x.VersionsInfoInternal
+	}
+	return nil
 }
 
-func (x *ListQueuesRequest) Reset() {
-	*x = ListQueuesRequest{}
-	mi := &file_temporal_server_api_adminservice_v1_request_response_proto_msgTypes[76]
+func (x *DescribeTaskQueuePartitionResponse) GetDispatchPause() *v14.TaskQueueDispatchPause {
+	if x != nil {
+		return x.DispatchPause
+	}
+	return nil
+}
+
+type ForceUnloadTaskQueuePartitionRequest struct {
+	state              protoimpl.MessageState   `protogen:"open.v1"`
+	Namespace          string                   `protobuf:"bytes,1,opt,name=namespace,proto3" json:"namespace,omitempty"`
+	TaskQueuePartition *v113.TaskQueuePartition `protobuf:"bytes,2,opt,name=task_queue_partition,json=taskQueuePartition,proto3" json:"task_queue_partition,omitempty"`
+	unknownFields      protoimpl.UnknownFields
+	sizeCache          protoimpl.SizeCache
+}
+
+func (x *ForceUnloadTaskQueuePartitionRequest) Reset() {
+	*x = ForceUnloadTaskQueuePartitionRequest{}
+	mi := &file_temporal_server_api_adminservice_v1_request_response_proto_msgTypes[114]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ListQueuesRequest) String() string {
+func (x *ForceUnloadTaskQueuePartitionRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ListQueuesRequest) ProtoMessage() {}
+func (*ForceUnloadTaskQueuePartitionRequest) ProtoMessage() {}
 
-func (x *ListQueuesRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_temporal_server_api_adminservice_v1_request_response_proto_msgTypes[76]
+func (x *ForceUnloadTaskQueuePartitionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_temporal_server_api_adminservice_v1_request_response_proto_msgTypes[114]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4605,55 +6872,47 @@ func (x *ListQueuesRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ListQueuesRequest.ProtoReflect.Descriptor instead.
-func (*ListQueuesRequest) Descriptor() ([]byte, []int) {
-	return file_temporal_server_api_adminservice_v1_request_response_proto_rawDescGZIP(), []int{76}
-}
-
-func (x *ListQueuesRequest) GetQueueType() int32 {
-	if x != nil {
-		return x.QueueType
-	}
-	return 0
+// Deprecated: Use ForceUnloadTaskQueuePartitionRequest.ProtoReflect.Descriptor instead.
+func (*ForceUnloadTaskQueuePartitionRequest) Descriptor() ([]byte, []int) {
+	return file_temporal_server_api_adminservice_v1_request_response_proto_rawDescGZIP(), []int{114}
 }
 
-func (x *ListQueuesRequest) GetPageSize() int32 {
+func (x *ForceUnloadTaskQueuePartitionRequest) GetNamespace() string {
 	if x != nil {
-		return x.PageSize
+		return x.Namespace
 	}
-	return 0
+	return ""
 }
 
-func (x *ListQueuesRequest) GetNextPageToken() []byte {
+func (x *ForceUnloadTaskQueuePartitionRequest) GetTaskQueuePartition() *v113.TaskQueuePartition {
 	if x != nil {
-		return x.NextPageToken
+		return x.TaskQueuePartition
 	}
 	return nil
 }
 
-type ListQueuesResponse struct {
-	state         protoimpl.MessageState          `protogen:"open.v1"`
-	Queues        []*ListQueuesResponse_QueueInfo `protobuf:"bytes,1,rep,name=queues,proto3" json:"queues,omitempty"`
-	NextPageToken []byte                          `protobuf:"bytes,2,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"`
+type ForceUnloadTaskQueuePartitionResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	WasLoaded     bool                   `protobuf:"varint,1,opt,name=was_loaded,json=wasLoaded,proto3" json:"was_loaded,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ListQueuesResponse) Reset() {
-	*x = ListQueuesResponse{}
-	mi := &file_temporal_server_api_adminservice_v1_request_response_proto_msgTypes[77]
+func (x *ForceUnloadTaskQueuePartitionResponse) Reset() {
+	*x = ForceUnloadTaskQueuePartitionResponse{}
+	mi := &file_temporal_server_api_adminservice_v1_request_response_proto_msgTypes[115]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ListQueuesResponse) String() string {
+func (x *ForceUnloadTaskQueuePartitionResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ListQueuesResponse) ProtoMessage() {}
+func (*ForceUnloadTaskQueuePartitionResponse) ProtoMessage() {}
 
-func (x *ListQueuesResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_temporal_server_api_adminservice_v1_request_response_proto_msgTypes[77]
+func (x *ForceUnloadTaskQueuePartitionResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_temporal_server_api_adminservice_v1_request_response_proto_msgTypes[115]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4664,46 +6923,47 @@ func (x *ListQueuesResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ListQueuesResponse.ProtoReflect.Descriptor instead.
-func (*ListQueuesResponse) Descriptor() ([]byte, []int) {
-	return file_temporal_server_api_adminservice_v1_request_response_proto_rawDescGZIP(), []int{77}
-}
-
-func (x *ListQueuesResponse) GetQueues() []*ListQueuesResponse_QueueInfo {
-	if x != nil {
-		return x.Queues
-	}
-	return nil
+// Deprecated: Use ForceUnloadTaskQueuePartitionResponse.ProtoReflect.Descriptor instead.
+func (*ForceUnloadTaskQueuePartitionResponse) Descriptor() ([]byte, []int) {
+	return file_temporal_server_api_adminservice_v1_request_response_proto_rawDescGZIP(), []int{115}
 }
 
-func (x *ListQueuesResponse) GetNextPageToken() []byte {
+func (x *ForceUnloadTaskQueuePartitionResponse) GetWasLoaded() bool {
 	if x != nil {
-		return x.NextPageToken
+		return x.WasLoaded
 	}
-	return nil
+	return false
 }
 
-type DeepHealthCheckRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
+type PauseTaskQueueDispatchRequest struct {
+	state     protoimpl.MessageState `protogen:"open.v1"`
+	Namespace string                 `protobuf:"bytes,1,opt,name=namespace,proto3" json:"namespace,omitempty"`
+	TaskQueue string                 `protobuf:"bytes,2,opt,name=task_queue,json=taskQueue,proto3" json:"task_queue,omitempty"`
+	// Task queue types to pause, either workflow or activity. Both are paused if empty.
+	TaskQueueTypes []v12.TaskQueueType `protobuf:"varint,3,rep,packed,name=task_queue_types,json=taskQueueTypes,proto3,enum=temporal.api.enums.v1.TaskQueueType" json:"task_queue_types,omitempty"`
+	// Priority keys to pause. All priority levels are paused if empty.
+	PriorityKeys  []int32 `protobuf:"varint,4,rep,packed,name=priority_keys,json=priorityKeys,proto3" json:"priority_keys,omitempty"`
+	Reason        string  `protobuf:"bytes,5,opt,name=reason,proto3" json:"reason,omitempty"`
+	Identity      string  `protobuf:"bytes,6,opt,name=identity,proto3" json:"identity,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *DeepHealthCheckRequest) Reset() {
-	*x = DeepHealthCheckRequest{}
-	mi := &file_temporal_server_api_adminservice_v1_request_response_proto_msgTypes[78]
+func (x *PauseTaskQueueDispatchRequest) Reset() {
+	*x = PauseTaskQueueDispatchRequest{}
+	mi := &file_temporal_server_api_adminservice_v1_request_response_proto_msgTypes[116]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *DeepHealthCheckRequest) String() string {
+func (x *PauseTaskQueueDispatchRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DeepHealthCheckRequest) ProtoMessage() {}
+func (*PauseTaskQueueDispatchRequest) ProtoMessage() {}
 
-func (x *DeepHealthCheckRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_temporal_server_api_adminservice_v1_request_response_proto_msgTypes[78]
+func (x *PauseTaskQueueDispatchRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_temporal_server_api_adminservice_v1_request_response_proto_msgTypes[116]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4714,33 +6974,76 @@ func (x *DeepHealthCheckRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use DeepHealthCheckRequest.ProtoReflect.Descriptor instead.
-func (*DeepHealthCheckRequest) Descriptor() ([]byte, []int) {
-	return file_temporal_server_api_adminservice_v1_request_response_proto_rawDescGZIP(), []int{78}
+// Deprecated: Use PauseTaskQueueDispatchRequest.ProtoReflect.Descriptor instead.
+func (*PauseTaskQueueDispatchRequest) Descriptor() ([]byte, []int) {
+	return file_temporal_server_api_adminservice_v1_request_response_proto_rawDescGZIP(), []int{116}
 }
 
-type DeepHealthCheckResponse struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	State         v14.HealthState        `protobuf:"varint,1,opt,name=state,proto3,enum=temporal.server.api.enums.v1.HealthState" json:"state,omitempty"`
+func (x *PauseTaskQueueDispatchRequest) GetNamespace() string {
+	if x != nil {
+		return x.Namespace
+	}
+	return ""
+}
+
+func (x *PauseTaskQueueDispatchRequest) GetTaskQueue() string {
+	if x != nil {
+		return x.TaskQueue
+	}
+	return ""
+}
+
+func (x *PauseTaskQueueDispatchRequest) GetTaskQueueTypes() []v12.TaskQueueType {
+	if x != nil {
+		return x.TaskQueueTypes
+	}
+	return nil
+}
+
+func (x *PauseTaskQueueDispatchRequest) GetPriorityKeys() []int32 {
+	if x != nil {
+		return x.PriorityKeys
+	}
+	return nil
+}
+
+func (x *PauseTaskQueueDispatchRequest) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+func (x *PauseTaskQueueDispatchRequest) GetIdentity() string {
+	if x != nil {
+		return x.Identity
+	}
+	return ""
+}
+
+type PauseTaskQueueDispatchResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Dispatch pause state after the update, keyed by task queue type. Types without paused dispatch are absent.
+	DispatchPause map[int32]*v14.TaskQueueDispatchPause `protobuf:"bytes,1,rep,name=dispatch_pause,json=dispatchPause,proto3" json:"dispatch_pause,omitempty" protobuf_key:"varint,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *DeepHealthCheckResponse) Reset() {
-	*x = DeepHealthCheckResponse{}
-	mi := &file_temporal_server_api_adminservice_v1_request_response_proto_msgTypes[79]
+func (x *PauseTaskQueueDispatchResponse) Reset() {
+	*x = PauseTaskQueueDispatchResponse{}
+	mi := &file_temporal_server_api_adminservice_v1_request_response_proto_msgTypes[117]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *DeepHealthCheckResponse) String() string {
+func (x *PauseTaskQueueDispatchResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DeepHealthCheckResponse) ProtoMessage() {}
+func (*PauseTaskQueueDispatchResponse) ProtoMessage() {}
 
-func (x *DeepHealthCheckResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_temporal_server_api_adminservice_v1_request_response_proto_msgTypes[79]
+func (x *PauseTaskQueueDispatchResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_temporal_server_api_adminservice_v1_request_response_proto_msgTypes[117]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4751,44 +7054,46 @@ func (x *DeepHealthCheckResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use DeepHealthCheckResponse.ProtoReflect.Descriptor instead.
-func (*DeepHealthCheckResponse) Descriptor() ([]byte, []int) {
-	return file_temporal_server_api_adminservice_v1_request_response_proto_rawDescGZIP(), []int{79}
+// Deprecated: Use PauseTaskQueueDispatchResponse.ProtoReflect.Descriptor instead.
+func (*PauseTaskQueueDispatchResponse) Descriptor() ([]byte, []int) {
+	return file_temporal_server_api_adminservice_v1_request_response_proto_rawDescGZIP(), []int{117}
 }
 
-func (x *DeepHealthCheckResponse) GetState() v14.HealthState {
+func (x *PauseTaskQueueDispatchResponse) GetDispatchPause() map[int32]*v14.TaskQueueDispatchPause {
 	if x != nil {
-		return x.State
+		return x.DispatchPause
 	}
-	return v14.HealthState(0)
+	return nil
 }
 
-type SyncWorkflowStateRequest struct {
-	state               protoimpl.MessageState   `protogen:"open.v1"`
-	NamespaceId         string                   `protobuf:"bytes,1,opt,name=namespace_id,json=namespaceId,proto3" json:"namespace_id,omitempty"`
-	Execution           *v1.WorkflowExecution    `protobuf:"bytes,2,opt,name=execution,proto3" json:"execution,omitempty"`
-	VersionedTransition *v12.VersionedTransition `protobuf:"bytes,3,opt,name=versioned_transition,json=versionedTransition,proto3" json:"versioned_transition,omitempty"`
-	VersionHistories    *v11.VersionHistories    `protobuf:"bytes,4,opt,name=version_histories,json=versionHistories,proto3" json:"version_histories,omitempty"`
-	TargetClusterId     int32                    `protobuf:"varint,5,opt,name=target_cluster_id,json=targetClusterId,proto3" json:"target_cluster_id,omitempty"`
-	unknownFields       protoimpl.UnknownFields
-	sizeCache           protoimpl.SizeCache
+type ResumeTaskQueueDispatchRequest struct {
+	state     protoimpl.MessageState `protogen:"open.v1"`
+	Namespace string                 `protobuf:"bytes,1,opt,name=namespace,proto3" json:"namespace,omitempty"`
+	TaskQueue string                 `protobuf:"bytes,2,opt,name=task_queue,json=taskQueue,proto3" json:"task_queue,omitempty"`
+	// Task queue types to resume, either workflow or activity. Both are resumed if empty.
+	TaskQueueTypes []v12.TaskQueueType `protobuf:"varint,3,rep,packed,name=task_queue_types,json=taskQueueTypes,proto3,enum=temporal.api.enums.v1.TaskQueueType" json:"task_queue_types,omitempty"`
+	// Priority keys to resume. All priority levels are resumed if empty.
+	PriorityKeys  []int32 `protobuf:"varint,4,rep,packed,name=priority_keys,json=priorityKeys,proto3" json:"priority_keys,omitempty"`
+	Identity      string  `protobuf:"bytes,5,opt,name=identity,proto3" json:"identity,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *SyncWorkflowStateRequest) Reset() {
-	*x = SyncWorkflowStateRequest{}
-	mi := &file_temporal_server_api_adminservice_v1_request_response_proto_msgTypes[80]
+func (x *ResumeTaskQueueDispatchRequest) Reset() {
+	*x = ResumeTaskQueueDispatchRequest{}
+	mi := &file_temporal_server_api_adminservice_v1_request_response_proto_msgTypes[118]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *SyncWorkflowStateRequest) String() string {
+func (x *ResumeTaskQueueDispatchRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*SyncWorkflowStateRequest) ProtoMessage() {}
+func (*ResumeTaskQueueDispatchRequest) ProtoMessage() {}
 
-func (x *SyncWorkflowStateRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_temporal_server_api_adminservice_v1_request_response_proto_msgTypes[80]
+func (x *ResumeTaskQueueDispatchRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_temporal_server_api_adminservice_v1_request_response_proto_msgTypes[118]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4799,68 +7104,69 @@ func (x *SyncWorkflowStateRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use SyncWorkflowStateRequest.ProtoReflect.Descriptor instead.
-func (*SyncWorkflowStateRequest) Descriptor() ([]byte, []int) {
-	return file_temporal_server_api_adminservice_v1_request_response_proto_rawDescGZIP(), []int{80}
+// Deprecated: Use ResumeTaskQueueDispatchRequest.ProtoReflect.Descriptor instead.
+func (*ResumeTaskQueueDispatchRequest) Descriptor() ([]byte, []int) {
+	return file_temporal_server_api_adminservice_v1_request_response_proto_rawDescGZIP(), []int{118}
 }
 
-func (x *SyncWorkflowStateRequest) GetNamespaceId() string {
+func (x *ResumeTaskQueueDispatchRequest) GetNamespace() string {
 	if x != nil {
-		return x.NamespaceId
+		return x.Namespace
 	}
 	return ""
 }
 
-func (x *SyncWorkflowStateRequest) GetExecution() *v1.WorkflowExecution {
+func (x *ResumeTaskQueueDispatchRequest) GetTaskQueue() string {
 	if x != nil {
-		return x.Execution
+		return x.TaskQueue
 	}
-	return nil
+	return ""
 }
 
-func (x *SyncWorkflowStateRequest) GetVersionedTransition() *v12.VersionedTransition {
+func (x *ResumeTaskQueueDispatchRequest) GetTaskQueueTypes() []v12.TaskQueueType {
 	if x != nil {
-		return x.VersionedTransition
+		return x.TaskQueueTypes
 	}
 	return nil
 }
 
-func (x *SyncWorkflowStateRequest) GetVersionHistories() *v11.VersionHistories {
+func (x *ResumeTaskQueueDispatchRequest) GetPriorityKeys() []int32 {
 	if x != nil {
-		return x.VersionHistories
+		return x.PriorityKeys
 	}
 	return nil
 }
 
-func (x *SyncWorkflowStateRequest) GetTargetClusterId() int32 {
+func (x *ResumeTaskQueueDispatchRequest) GetIdentity() string {
 	if x != nil {
-		return x.TargetClusterId
+		return x.Identity
 	}
-	return 0
+	return ""
 }
 
-type SyncWorkflowStateResponse struct {
-	state                       protoimpl.MessageState           `protogen:"open.v1"`
-	VersionedTransitionArtifact *v15.VersionedTransitionArtifact `protobuf:"bytes,5,opt,name=versioned_transition_artifact,json=versionedTransitionArtifact,proto3" json:"versioned_transition_artifact,omitempty"`
-	unknownFields               protoimpl.UnknownFields
-	sizeCache                   protoimpl.SizeCache
+type ResumeTaskQueueDispatchResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Dispatch pause state after the update, keyed by task queue type. Types without paused dispatch are absent.
+	DispatchPause map[int32]*v14.TaskQueueDispatchPause `protobuf:"bytes,1,rep,name=dispatch_pause,json=dispatchPause,proto3" json:"dispatch_pause,omitempty" protobuf_key:"varint,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *SyncWorkflowStateResponse) Reset() {
-	*x = SyncWorkflowStateResponse{}
-	mi := &file_temporal_server_api_adminservice_v1_request_response_proto_msgTypes[81]
+func (x *ResumeTaskQueueDispatchResponse) Reset() {
+	*x = ResumeTaskQueueDispatchResponse{}
+	mi := &file_temporal_server_api_adminservice_v1_request_response_proto_msgTypes[119]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *SyncWorkflowStateResponse) String() string {
+func (x *ResumeTaskQueueDispatchResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*SyncWorkflowStateResponse) ProtoMessage() {}
+func (*ResumeTaskQueueDispatchResponse) ProtoMessage() {}
 
-func (x *SyncWorkflowStateResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_temporal_server_api_adminservice_v1_request_response_proto_msgTypes[81]
+func (x *ResumeTaskQueueDispatchResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_temporal_server_api_adminservice_v1_request_response_proto_msgTypes[119]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4871,42 +7177,43 @@ func (x *SyncWorkflowStateResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use SyncWorkflowStateResponse.ProtoReflect.Descriptor instead.
-func (*SyncWorkflowStateResponse) Descriptor() ([]byte, []int) {
-	return file_temporal_server_api_adminservice_v1_request_response_proto_rawDescGZIP(), []int{81}
+// Deprecated: Use ResumeTaskQueueDispatchResponse.ProtoReflect.Descriptor instead.
+func (*ResumeTaskQueueDispatchResponse) Descriptor() ([]byte, []int) {
+	return file_temporal_server_api_adminservice_v1_request_response_proto_rawDescGZIP(), []int{119}
 }
 
-func (x *SyncWorkflowStateResponse) GetVersionedTransitionArtifact() *v15.VersionedTransitionArtifact {
+func (x *ResumeTaskQueueDispatchResponse) GetDispatchPause() map[int32]*v14.TaskQueueDispatchPause {
 	if x != nil {
-		return x.VersionedTransitionArtifact
+		return x.DispatchPause
 	}
 	return nil
 }
 
-type GenerateLastHistoryReplicationTasksRequest struct {
-	state          protoimpl.MessageState `protogen:"open.v1"`
-	Namespace      string                 `protobuf:"bytes,1,opt,name=namespace,proto3" json:"namespace,omitempty"`
-	Execution      *v1.WorkflowExecution  `protobuf:"bytes,2,opt,name=execution,proto3" json:"execution,omitempty"`
-	TargetClusters []string               `protobuf:"bytes,3,rep,name=target_clusters,json=targetClusters,proto3" json:"target_clusters,omitempty"`
-	unknownFields  protoimpl.UnknownFields
-	sizeCache      protoimpl.SizeCache
+type ListTaskQueueBacklogTasksRequest struct {
+	state              protoimpl.MessageState   `protogen:"open.v1"`
+	Namespace          string                   `protobuf:"bytes,1,opt,name=namespace,proto3" json:"namespace,omitempty"`
+	TaskQueuePartition *v113.TaskQueuePartition `protobuf:"bytes,2,opt,name=task_queue_partition,json=taskQueuePartition,proto3" json:"task_queue_partition,omitempty"`
+	PageSize           int32                    `protobuf:"varint,3,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	NextPageToken      []byte                   `protobuf:"bytes,4,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"`
+	unknownFields      protoimpl.UnknownFields
+	sizeCache          protoimpl.SizeCache
 }
 
-func (x *GenerateLastHistoryReplicationTasksRequest) Reset() {
-	*x = GenerateLastHistoryReplicationTasksRequest{}
-	mi := &file_temporal_server_api_adminservice_v1_request_response_proto_msgTypes[82]
+func (x *ListTaskQueueBacklogTasksRequest) Reset() {
+	*x = ListTaskQueueBacklogTasksRequest{}
+	mi := &file_temporal_server_api_adminservice_v1_request_response_proto_msgTypes[120]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GenerateLastHistoryReplicationTasksRequest) String() string {
+func (x *ListTaskQueueBacklogTasksRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GenerateLastHistoryReplicationTasksRequest) ProtoMessage() {}
+func (*ListTaskQueueBacklogTasksRequest) ProtoMessage() {}
 
-func (x *GenerateLastHistoryReplicationTasksRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_temporal_server_api_adminservice_v1_request_response_proto_msgTypes[82]
+func (x *ListTaskQueueBacklogTasksRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_temporal_server_api_adminservice_v1_request_response_proto_msgTypes[120]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4917,55 +7224,62 @@ func (x *GenerateLastHistoryReplicationTasksRequest) ProtoReflect() protoreflect
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GenerateLastHistoryReplicationTasksRequest.ProtoReflect.Descriptor instead.
-func (*GenerateLastHistoryReplicationTasksRequest) Descriptor() ([]byte, []int) {
-	return file_temporal_server_api_adminservice_v1_request_response_proto_rawDescGZIP(), []int{82}
+// Deprecated: Use ListTaskQueueBacklogTasksRequest.ProtoReflect.Descriptor instead.
+func (*ListTaskQueueBacklogTasksRequest) Descriptor() ([]byte, []int) {
+	return file_temporal_server_api_adminservice_v1_request_response_proto_rawDescGZIP(), []int{120}
 }
 
-func (x *GenerateLastHistoryReplicationTasksRequest) GetNamespace() string {
+func (x *ListTaskQueueBacklogTasksRequest) GetNamespace() string {
 	if x != nil {
 		return x.Namespace
 	}
 	return ""
 }
 
-func (x *GenerateLastHistoryReplicationTasksRequest) GetExecution() *v1.WorkflowExecution {
+func (x *ListTaskQueueBacklogTasksRequest) GetTaskQueuePartition() *v113.TaskQueuePartition {
 	if x != nil {
-		return x.Execution
+		return x.TaskQueuePartition
 	}
 	return nil
 }
 
-func (x *GenerateLastHistoryReplicationTasksRequest) GetTargetClusters() []string {
+func (x *ListTaskQueueBacklogTasksRequest) GetPageSize() int32 {
 	if x != nil {
-		return x.TargetClusters
+		return x.PageSize
+	}
+	return 0
+}
+
+func (x *ListTaskQueueBacklogTasksRequest) GetNextPageToken() []byte {
+	if x != nil {
+		return x.NextPageToken
 	}
 	return nil
 }
 
-type GenerateLastHistoryReplicationTasksResponse struct {
-	state                protoimpl.MessageState `protogen:"open.v1"`
-	StateTransitionCount int64                  `protobuf:"varint,1,opt,name=state_transition_count,json=stateTransitionCount,proto3" json:"state_transition_count,omitempty"`
-	HistoryLength        int64                  `protobuf:"varint,2,opt,name=history_length,json=historyLength,proto3" json:"history_length,omitempty"`
-	unknownFields        protoimpl.UnknownFields
-	sizeCache            protoimpl.SizeCache
+type ListTaskQueueBacklogTasksResponse struct {
+	state         protoimpl.MessageState  `protogen:"open.v1"`
+	Tasks         []*v113.BacklogTaskInfo `protobuf:"bytes,1,rep,name=tasks,proto3" json:"tasks,omitempty"`
+	NextPageToken []byte                  `protobuf:"bytes,2,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GenerateLastHistoryReplicationTasksResponse) Reset() {
-	*x = GenerateLastHistoryReplicationTasksResponse{}
-	mi := &file_temporal_server_api_adminservice_v1_request_response_proto_msgTypes[83]
+func (x *ListTaskQueueBacklogTasksResponse) Reset() {
+	*x = ListTaskQueueBacklogTasksResponse{}
+	mi := &file_temporal_server_api_adminservice_v1_request_response_proto_msgTypes[121]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GenerateLastHistoryReplicationTasksResponse) String() string {
+func (x *ListTaskQueueBacklogTasksResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GenerateLastHistoryReplicationTasksResponse) ProtoMessage() {}
+func (*ListTaskQueueBacklogTasksResponse) ProtoMessage() {}
 
-func (x *GenerateLastHistoryReplicationTasksResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_temporal_server_api_adminservice_v1_request_response_proto_msgTypes[83]
+func (x *ListTaskQueueBacklogTasksResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_temporal_server_api_adminservice_v1_request_response_proto_msgTypes[121]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4976,50 +7290,50 @@ func (x *GenerateLastHistoryReplicationTasksResponse) ProtoReflect() protoreflec
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GenerateLastHistoryReplicationTasksResponse.ProtoReflect.Descriptor instead.
-func (*GenerateLastHistoryReplicationTasksResponse) Descriptor() ([]byte, []int) {
-	return file_temporal_server_api_adminservice_v1_request_response_proto_rawDescGZIP(), []int{83}
+// Deprecated: Use ListTaskQueueBacklogTasksResponse.ProtoReflect.Descriptor instead.
+func (*ListTaskQueueBacklogTasksResponse) Descriptor() ([]byte, []int) {
+	return file_temporal_server_api_adminservice_v1_request_response_proto_rawDescGZIP(), []int{121}
 }
 
-func (x *GenerateLastHistoryReplicationTasksResponse) GetStateTransitionCount() int64 {
+func (x *ListTaskQueueBacklogTasksResponse) GetTasks() []*v113.BacklogTaskInfo {
 	if x != nil {
-		return x.StateTransitionCount
+		return x.Tasks
 	}
-	return 0
+	return nil
 }
 
-func (x *GenerateLastHistoryReplicationTasksResponse) GetHistoryLength() int64 {
+func (x *ListTaskQueueBacklogTasksResponse) GetNextPageToken() []byte {
 	if x != nil {
-		return x.HistoryLength
+		return x.NextPageToken
 	}
-	return 0
+	return nil
 }
 
-type DescribeTaskQueuePartitionRequest struct {
+type DeleteTaskQueueBacklogTasksRequest struct {
 	state              protoimpl.MessageState   `protogen:"open.v1"`
 	Namespace          string                   `protobuf:"bytes,1,opt,name=namespace,proto3" json:"namespace,omitempty"`
 	TaskQueuePartition *v113.TaskQueuePartition `protobuf:"bytes,2,opt,name=task_queue_partition,json=taskQueuePartition,proto3" json:"task_queue_partition,omitempty"`
-	// Absent means unversioned queue. Ignored for sticky partitions.
-	BuildIds      *v114.TaskQueueVersionSelection `protobuf:"bytes,3,opt,name=build_ids,json=buildIds,proto3" json:"build_ids,omitempty"`
+	// Keys of the tasks to delete, as returned by ListTaskQueueBacklogTasks.
+	Tasks         []*v113.BacklogTaskKey `protobuf:"bytes,3,rep,name=tasks,proto3" json:"tasks,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *DescribeTaskQueuePartitionRequest) Reset() {
-	*x = DescribeTaskQueuePartitionRequest{}
-	mi := &file_temporal_server_api_adminservice_v1_request_response_proto_msgTypes[84]
+func (x *DeleteTaskQueueBacklogTasksRequest) Reset() {
+	*x = DeleteTaskQueueBacklogTasksRequest{}
+	mi := &file_temporal_server_api_adminservice_v1_request_response_proto_msgTypes[122]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *DescribeTaskQueuePartitionRequest) String() string {
+func (x *DeleteTaskQueueBacklogTasksRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DescribeTaskQueuePartitionRequest) ProtoMessage() {}
+func (*DeleteTaskQueueBacklogTasksRequest) ProtoMessage() {}
 
-func (x *DescribeTaskQueuePartitionRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_temporal_server_api_adminservice_v1_request_response_proto_msgTypes[84]
+func (x *DeleteTaskQueueBacklogTasksRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_temporal_server_api_adminservice_v1_request_response_proto_msgTypes[122]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5030,57 +7344,53 @@ func (x *DescribeTaskQueuePartitionRequest) ProtoReflect() protoreflect.Message
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use DescribeTaskQueuePartitionRequest.ProtoReflect.Descriptor instead.
-func (*DescribeTaskQueuePartitionRequest) Descriptor() ([]byte, []int) {
-	return file_temporal_server_api_adminservice_v1_request_response_proto_rawDescGZIP(), []int{84}
+// Deprecated: Use DeleteTaskQueueBacklogTasksRequest.ProtoReflect.Descriptor instead.
+func (*DeleteTaskQueueBacklogTasksRequest) Descriptor() ([]byte, []int) {
+	return file_temporal_server_api_adminservice_v1_request_response_proto_rawDescGZIP(), []int{122}
 }
 
-func (x *DescribeTaskQueuePartitionRequest) GetNamespace() string {
+func (x *DeleteTaskQueueBacklogTasksRequest) GetNamespace() string {
 	if x != nil {
 		return x.Namespace
 	}
 	return ""
 }
 
-func (x *DescribeTaskQueuePartitionRequest) GetTaskQueuePartition() *v113.TaskQueuePartition {
+func (x *DeleteTaskQueueBacklogTasksRequest) GetTaskQueuePartition() *v113.TaskQueuePartition {
 	if x != nil {
 		return x.TaskQueuePartition
 	}
 	return nil
 }
 
-func (x *DescribeTaskQueuePartitionRequest) GetBuildIds() *v114.TaskQueueVersionSelection {
+func (x *DeleteTaskQueueBacklogTasksRequest) GetTasks() []*v113.BacklogTaskKey {
 	if x != nil {
-		return x.BuildIds
+		return x.Tasks
 	}
 	return nil
 }
 
-type InternalTaskQueueStatus struct {
-	state            protoimpl.MessageState `protogen:"open.v1"`
-	ReadLevel        int64                  `protobuf:"varint,1,opt,name=read_level,json=readLevel,proto3" json:"read_level,omitempty"`
-	AckLevel         int64                  `protobuf:"varint,2,opt,name=ack_level,json=ackLevel,proto3" json:"ack_level,omitempty"`
-	TaskIdBlock      *v114.TaskIdBlock      `protobuf:"bytes,3,opt,name=task_id_block,json=taskIdBlock,proto3" json:"task_id_block,omitempty"`
-	ReadBufferLength int64                  `protobuf:"varint,4,opt,name=read_buffer_length,json=readBufferLength,proto3" json:"read_buffer_length,omitempty"`
-	unknownFields    protoimpl.UnknownFields
-	sizeCache        protoimpl.SizeCache
+type DeleteTaskQueueBacklogTasksResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *InternalTaskQueueStatus) Reset() {
-	*x = InternalTaskQueueStatus{}
-	mi := &file_temporal_server_api_adminservice_v1_request_response_proto_msgTypes[85]
+func (x *DeleteTaskQueueBacklogTasksResponse) Reset() {
+	*x = DeleteTaskQueueBacklogTasksResponse{}
+	mi := &file_temporal_server_api_adminservice_v1_request_response_proto_msgTypes[123]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *InternalTaskQueueStatus) String() string {
+func (x *DeleteTaskQueueBacklogTasksResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*InternalTaskQueueStatus) ProtoMessage() {}
+func (*DeleteTaskQueueBacklogTasksResponse) ProtoMessage() {}
 
-func (x *InternalTaskQueueStatus) ProtoReflect() protoreflect.Message {
-	mi := &file_temporal_server_api_adminservice_v1_request_response_proto_msgTypes[85]
+func (x *DeleteTaskQueueBacklogTasksResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_temporal_server_api_adminservice_v1_request_response_proto_msgTypes[123]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5091,62 +7401,38 @@ func (x *InternalTaskQueueStatus) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use InternalTaskQueueStatus.ProtoReflect.Descriptor instead.
-func (*InternalTaskQueueStatus) Descriptor() ([]byte, []int) {
-	return file_temporal_server_api_adminservice_v1_request_response_proto_rawDescGZIP(), []int{85}
-}
-
-func (x *InternalTaskQueueStatus) GetReadLevel() int64 {
-	if x != nil {
-		return x.ReadLevel
-	}
-	return 0
-}
-
-func (x *InternalTaskQueueStatus) GetAckLevel() int64 {
-	if x != nil {
-		return x.AckLevel
-	}
-	return 0
-}
-
-func (x *InternalTaskQueueStatus) GetTaskIdBlock() *v114.TaskIdBlock {
-	if x != nil {
-		return x.TaskIdBlock
-	}
-	return nil
-}
-
-func (x *InternalTaskQueueStatus) GetReadBufferLength() int64 {
-	if x != nil {
-		return x.ReadBufferLength
-	}
-	return 0
+// Deprecated: Use DeleteTaskQueueBacklogTasksResponse.ProtoReflect.Descriptor instead.
+func (*DeleteTaskQueueBacklogTasksResponse) Descriptor() ([]byte, []int) {
+	return file_temporal_server_api_adminservice_v1_request_response_proto_rawDescGZIP(), []int{123}
 }
 
-type DescribeTaskQueuePartitionResponse struct {
-	state protoimpl.MessageState `protogen:"open.v1"`
-	// contains k-v pairs of the type: buildID -> TaskQueueVersionInfoInternal
-	VersionsInfoInternal map[string]*v113.TaskQueueVersionInfoInternal `protobuf:"bytes,1,rep,name=versions_info_internal,json=versionsInfoInternal,proto3" json:"versions_info_internal,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+type MoveTaskQueueBacklogTasksRequest struct {
+	state              protoimpl.MessageState   `protogen:"open.v1"`
+	Namespace          string                   `protobuf:"bytes,1,opt,name=namespace,proto3" json:"namespace,omitempty"`
+	TaskQueuePartition *v113.TaskQueuePartition `protobuf:"bytes,2,opt,name=task_queue_partition,json=taskQueuePartition,proto3" json:"task_queue_partition,omitempty"`
+	// Name of the task queue to move the tasks to. Tasks keep their type.
+	DestinationTaskQueue string `protobuf:"bytes,3,opt,name=destination_task_queue,json=destinationTaskQueue,proto3" json:"destination_task_queue,omitempty"`
+	PageSize             int32  `protobuf:"varint,4,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	NextPageToken        []byte `protobuf:"bytes,5,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"`
 	unknownFields        protoimpl.UnknownFields
 	sizeCache            protoimpl.SizeCache
 }
 
-func (x *DescribeTaskQueuePartitionResponse) Reset() {
-	*x = DescribeTaskQueuePartitionResponse{}
-	mi := &file_temporal_server_api_adminservice_v1_request_response_proto_msgTypes[86]
+func (x *MoveTaskQueueBacklogTasksRequest) Reset() {
+	*x = MoveTaskQueueBacklogTasksRequest{}
+	mi := &file_temporal_server_api_adminservice_v1_request_response_proto_msgTypes[124]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *DescribeTaskQueuePartitionResponse) String() string {
+func (x *MoveTaskQueueBacklogTasksRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DescribeTaskQueuePartitionResponse) ProtoMessage() {}
+func (*MoveTaskQueueBacklogTasksRequest) ProtoMessage() {}
 
-func (x *DescribeTaskQueuePartitionResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_temporal_server_api_adminservice_v1_request_response_proto_msgTypes[86]
+func (x *MoveTaskQueueBacklogTasksRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_temporal_server_api_adminservice_v1_request_response_proto_msgTypes[124]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5157,92 +7443,70 @@ func (x *DescribeTaskQueuePartitionResponse) ProtoReflect() protoreflect.Message
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use DescribeTaskQueuePartitionResponse.ProtoReflect.Descriptor instead.
-func (*DescribeTaskQueuePartitionResponse) Descriptor() ([]byte, []int) {
-	return file_temporal_server_api_adminservice_v1_request_response_proto_rawDescGZIP(), []int{86}
+// Deprecated: Use MoveTaskQueueBacklogTasksRequest.ProtoReflect.Descriptor instead.
+func (*MoveTaskQueueBacklogTasksRequest) Descriptor() ([]byte, []int) {
+	return file_temporal_server_api_adminservice_v1_request_response_proto_rawDescGZIP(), []int{124}
 }
 
-func (x *DescribeTaskQueuePartitionResponse) GetVersionsInfoInternal() map[string]*v113.TaskQueueVersionInfoInternal {
+func (x *MoveTaskQueueBacklogTasksRequest) GetNamespace() string {
 	if x != nil {
-		return x.VersionsInfoInternal
+		return x.Namespace
 	}
-	return nil
-}
-
-type ForceUnloadTaskQueuePartitionRequest struct {
-	state              protoimpl.MessageState   `protogen:"open.v1"`
-	Namespace          string                   `protobuf:"bytes,1,opt,name=namespace,proto3" json:"namespace,omitempty"`
-	TaskQueuePartition *v113.TaskQueuePartition `protobuf:"bytes,2,opt,name=task_queue_partition,json=taskQueuePartition,proto3" json:"task_queue_partition,omitempty"`
-	unknownFields      protoimpl.UnknownFields
-	sizeCache          protoimpl.SizeCache
-}
-
-func (x *ForceUnloadTaskQueuePartitionRequest) Reset() {
-	*x = ForceUnloadTaskQueuePartitionRequest{}
-	mi := &file_temporal_server_api_adminservice_v1_request_response_proto_msgTypes[87]
-	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-	ms.StoreMessageInfo(mi)
-}
-
-func (x *ForceUnloadTaskQueuePartitionRequest) String() string {
-	return protoimpl.X.MessageStringOf(x)
+	return ""
 }
 
-func (*ForceUnloadTaskQueuePartitionRequest) ProtoMessage() {}
-
-func (x *ForceUnloadTaskQueuePartitionRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_temporal_server_api_adminservice_v1_request_response_proto_msgTypes[87]
+func (x *MoveTaskQueueBacklogTasksRequest) GetTaskQueuePartition() *v113.TaskQueuePartition {
 	if x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+		return x.TaskQueuePartition
 	}
-	return mi.MessageOf(x)
+	return nil
 }
 
-// Deprecated: Use ForceUnloadTaskQueuePartitionRequest.ProtoReflect.Descriptor instead.
-func (*ForceUnloadTaskQueuePartitionRequest) Descriptor() ([]byte, []int) {
-	return file_temporal_server_api_adminservice_v1_request_response_proto_rawDescGZIP(), []int{87}
+func (x *MoveTaskQueueBacklogTasksRequest) GetDestinationTaskQueue() string {
+	if x != nil {
+		return x.DestinationTaskQueue
+	}
+	return ""
 }
 
-func (x *ForceUnloadTaskQueuePartitionRequest) GetNamespace() string {
+func (x *MoveTaskQueueBacklogTasksRequest) GetPageSize() int32 {
 	if x != nil {
-		return x.Namespace
+		return x.PageSize
 	}
-	return ""
+	return 0
 }
 
-func (x *ForceUnloadTaskQueuePartitionRequest) GetTaskQueuePartition() *v113.TaskQueuePartition {
+func (x *MoveTaskQueueBacklogTasksRequest) GetNextPageToken() []byte {
 	if x != nil {
-		return x.TaskQueuePartition
+		return x.NextPageToken
 	}
 	return nil
 }
 
-type ForceUnloadTaskQueuePartitionResponse struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	WasLoaded     bool                   `protobuf:"varint,1,opt,name=was_loaded,json=wasLoaded,proto3" json:"was_loaded,omitempty"`
+type MoveTaskQueueBacklogTasksResponse struct {
+	state      protoimpl.MessageState  `protogen:"open.v1"`
+	MovedTasks []*v113.BacklogTaskInfo `protobuf:"bytes,1,rep,name=moved_tasks,json=movedTasks,proto3" json:"moved_tasks,omitempty"`
+	// Empty when the whole backlog was moved.
+	NextPageToken []byte `protobuf:"bytes,2,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ForceUnloadTaskQueuePartitionResponse) Reset() {
-	*x = ForceUnloadTaskQueuePartitionResponse{}
-	mi := &file_temporal_server_api_adminservice_v1_request_response_proto_msgTypes[88]
+func (x *MoveTaskQueueBacklogTasksResponse) Reset() {
+	*x = MoveTaskQueueBacklogTasksResponse{}
+	mi := &file_temporal_server_api_adminservice_v1_request_response_proto_msgTypes[125]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ForceUnloadTaskQueuePartitionResponse) String() string {
+func (x *MoveTaskQueueBacklogTasksResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ForceUnloadTaskQueuePartitionResponse) ProtoMessage() {}
+func (*MoveTaskQueueBacklogTasksResponse) ProtoMessage() {}
 
-func (x *ForceUnloadTaskQueuePartitionResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_temporal_server_api_adminservice_v1_request_response_proto_msgTypes[88]
+func (x *MoveTaskQueueBacklogTasksResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_temporal_server_api_adminservice_v1_request_response_proto_msgTypes[125]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5253,16 +7517,23 @@ func (x *ForceUnloadTaskQueuePartitionResponse) ProtoReflect() protoreflect.Mess
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ForceUnloadTaskQueuePartitionResponse.ProtoReflect.Descriptor instead.
-func (*ForceUnloadTaskQueuePartitionResponse) Descriptor() ([]byte, []int) {
-	return file_temporal_server_api_adminservice_v1_request_response_proto_rawDescGZIP(), []int{88}
+// Deprecated: Use MoveTaskQueueBacklogTasksResponse.ProtoReflect.Descriptor instead.
+func (*MoveTaskQueueBacklogTasksResponse) Descriptor() ([]byte, []int) {
+	return file_temporal_server_api_adminservice_v1_request_response_proto_rawDescGZIP(), []int{125}
 }
 
-func (x *ForceUnloadTaskQueuePartitionResponse) GetWasLoaded() bool {
+func (x *MoveTaskQueueBacklogTasksResponse) GetMovedTasks() []*v113.BacklogTaskInfo {
 	if x != nil {
-		return x.WasLoaded
+		return x.MovedTasks
 	}
-	return false
+	return nil
+}
+
+func (x *MoveTaskQueueBacklogTasksResponse) GetNextPageToken() []byte {
+	if x != nil {
+		return x.NextPageToken
+	}
+	return nil
 }
 
 type AddTasksRequest_Task struct {
@@ -5275,7 +7546,7 @@ type AddTasksRequest_Task struct {
 
 func (x *AddTasksRequest_Task) Reset() {
 	*x = AddTasksRequest_Task{}
-	mi := &file_temporal_server_api_adminservice_v1_request_response_proto_msgTypes[96]
+	mi := &file_temporal_server_api_adminservice_v1_request_response_proto_msgTypes[133]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -5287,7 +7558,7 @@ func (x *AddTasksRequest_Task) String() string {
 func (*AddTasksRequest_Task) ProtoMessage() {}
 
 func (x *AddTasksRequest_Task) ProtoReflect() protoreflect.Message {
-	mi := &file_temporal_server_api_adminservice_v1_request_response_proto_msgTypes[96]
+	mi := &file_temporal_server_api_adminservice_v1_request_response_proto_msgTypes[133]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5300,7 +7571,7 @@ func (x *AddTasksRequest_Task) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use AddTasksRequest_Task.ProtoReflect.Descriptor instead.
 func (*AddTasksRequest_Task) Descriptor() ([]byte, []int) {
-	return file_temporal_server_api_adminservice_v1_request_response_proto_rawDescGZIP(), []int{74, 0}
+	return file_temporal_server_api_adminservice_v1_request_response_proto_rawDescGZIP(), []int{101, 0}
 }
 
 func (x *AddTasksRequest_Task) GetCategoryId() int32 {
@@ -5327,7 +7598,7 @@ type ListQueuesResponse_QueueInfo struct {
 
 func (x *ListQueuesResponse_QueueInfo) Reset() {
 	*x = ListQueuesResponse_QueueInfo{}
-	mi := &file_temporal_server_api_adminservice_v1_request_response_proto_msgTypes[97]
+	mi := &file_temporal_server_api_adminservice_v1_request_response_proto_msgTypes[134]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -5339,7 +7610,7 @@ func (x *ListQueuesResponse_QueueInfo) String() string {
 func (*ListQueuesResponse_QueueInfo) ProtoMessage() {}
 
 func (x *ListQueuesResponse_QueueInfo) ProtoReflect() protoreflect.Message {
-	mi := &file_temporal_server_api_adminservice_v1_request_response_proto_msgTypes[97]
+	mi := &file_temporal_server_api_adminservice_v1_request_response_proto_msgTypes[134]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5352,7 +7623,7 @@ func (x *ListQueuesResponse_QueueInfo) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ListQueuesResponse_QueueInfo.ProtoReflect.Descriptor instead.
 func (*ListQueuesResponse_QueueInfo) Descriptor() ([]byte, []int) {
-	return file_temporal_server_api_adminservice_v1_request_response_proto_rawDescGZIP(), []int{77, 0}
+	return file_temporal_server_api_adminservice_v1_request_response_proto_rawDescGZIP(), []int{104, 0}
 }
 
 func (x *ListQueuesResponse_QueueInfo) GetQueueName() string {
@@ -5373,11 +7644,20 @@ var File_temporal_server_api_adminservice_v1_request_response_proto protoreflect
 
 const file_temporal_server_api_adminservice_v1_request_response_proto_rawDesc = "" +
 	"\n" +
-	":temporal/server/api/adminservice/v1/request_response.proto\x12#temporal.server.api.adminservice.v1\x1a\x1fgoogle/protobuf/timestamp.proto\x1a\x1egoogle/protobuf/duration.proto\x1a\"temporal/api/enums/v1/common.proto\x1a&temporal/api/enums/v1/task_queue.proto\x1a$temporal/api/common/v1/message.proto\x1a%temporal/api/version/v1/message.proto\x1a&temporal/api/workflow/v1/message.proto\x1a'temporal/api/namespace/v1/message.proto\x1a)temporal/api/replication/v1/message.proto\x1a'temporal/api/taskqueue/v1/message.proto\x1a,temporal/server/api/cluster/v1/message.proto\x1a'temporal/server/api/common/v1/dlq.proto\x1a)temporal/server/api/enums/v1/common.proto\x1a*temporal/server/api/enums/v1/cluster.proto\x1a'temporal/server/api/enums/v1/task.proto\x1a&temporal/server/api/enums/v1/dlq.proto\x1a,temporal/server/api/history/v1/message.proto\x1a.temporal/server/api/namespace/v1/message.proto\x1a0temporal/server/api/replication/v1/message.proto\x1a9temporal/server/api/persistence/v1/cluster_metadata.proto\x1a3temporal/server/api/persistence/v1/executions.proto\x1a?temporal/server/api/persistence/v1/workflow_mutable_state.proto\x1a.temporal/server/api/persistence/v1/tasks.proto\x1a,temporal/server/api/persistence/v1/hsm.proto\x1a.temporal/server/api/taskqueue/v1/message.proto\"\x83\x01\n" +
+	":temporal/server/api/adminservice/v1/request_response.proto\x12#temporal.server.api.adminservice.v1\x1a\x1fgoogle/protobuf/timestamp.proto\x1a\x1egoogle/protobuf/duration.proto\x1a\"temporal/api/enums/v1/common.proto\x1a&temporal/api/enums/v1/event_type.proto\x1a!temporal/api/enums/v1/reset.proto\x1a&temporal/api/enums/v1/task_queue.proto\x1a$temporal/api/common/v1/message.proto\x1a%temporal/api/version/v1/message.proto\x1a&temporal/api/workflow/v1/message.proto\x1a'temporal/api/namespace/v1/message.proto\x1a)temporal/api/replication/v1/message.proto\x1a'temporal/api/taskqueue/v1/message.proto\x1a,temporal/server/api/cluster/v1/message.proto\x1a'temporal/server/api/common/v1/dlq.proto\x1a)temporal/server/api/enums/v1/common.proto\x1a*temporal/server/api/enums/v1/cluster.proto\x1a'temporal/server/api/enums/v1/task.proto\x1a&temporal/server/api/enums/v1/dlq.proto\x1a+temporal/server/api/enums/v1/workflow.proto\x1a-temporal/server/api/enums/v1/visibility.proto\x1a,temporal/server/api/history/v1/message.proto\x1a.temporal/server/api/namespace/v1/message.proto\x1a0temporal/server/api/replication/v1/message.proto\x1a9temporal/server/api/persistence/v1/cluster_metadata.proto\x1a3temporal/server/api/persistence/v1/executions.proto\x1a?temporal/server/api/persistence/v1/workflow_mutable_state.proto\x1a.temporal/server/api/persistence/v1/tasks.proto\x1a,temporal/server/api/persistence/v1/hsm.proto\x1a3temporal/server/api/persistence/v1/namespaces.proto\x1a4temporal/server/api/persistence/v1/task_queues.proto\x1a.temporal/server/api/taskqueue/v1/message.proto\"\x83\x01\n" +
 	"\x1aRebuildMutableStateRequest\x12\x1c\n" +
 	"\tnamespace\x18\x01 \x01(\tR\tnamespace\x12G\n" +
 	"\texecution\x18\x02 \x01(\v2).temporal.api.common.v1.WorkflowExecutionR\texecution\"\x1d\n" +
-	"\x1bRebuildMutableStateResponse\"\xc1\x02\n" +
+	"\x1bRebuildMutableStateResponse\"\x81\x01\n" +
+	"\x18CheckMutableStateRequest\x12\x1c\n" +
+	"\tnamespace\x18\x01 \x01(\tR\tnamespace\x12G\n" +
+	"\texecution\x18\x02 \x01(\v2).temporal.api.common.v1.WorkflowExecutionR\texecution\"\x85\x01\n" +
+	"\x19CheckMutableStateResponse\x12h\n" +
+	"\x0finconsistencies\x18\x01 \x03(\v2>.temporal.server.api.adminservice.v1.MutableStateInconsistencyR\x0finconsistencies\"}\n" +
+	"\x19MutableStateInconsistency\x12\x14\n" +
+	"\x05field\x18\x01 \x01(\tR\x05field\x12%\n" +
+	"\x0edatabase_value\x18\x02 \x01(\tR\rdatabaseValue\x12#\n" +
+	"\rhistory_value\x18\x03 \x01(\tR\fhistoryValue\"\xc1\x02\n" +
 	"\x1eImportWorkflowExecutionRequest\x12\x1c\n" +
 	"\tnamespace\x18\x01 \x01(\tR\tnamespace\x12G\n" +
 	"\texecution\x18\x02 \x01(\v2).temporal.api.common.v1.WorkflowExecutionR\texecution\x12I\n" +
@@ -5385,7 +7665,71 @@ const file_temporal_server_api_adminservice_v1_request_response_proto_rawDesc =
 	"\x0fversion_history\x18\x04 \x01(\v2..temporal.server.api.history.v1.VersionHistoryR\x0eversionHistory\x12\x14\n" +
 	"\x05token\x18\x05 \x01(\fR\x05token\"7\n" +
 	"\x1fImportWorkflowExecutionResponse\x12\x14\n" +
-	"\x05token\x18\x01 \x01(\fR\x05token\"\xb0\x01\n" +
+	"\x05token\x18\x01 \x01(\fR\x05token\"\xba\x03\n" +
+	"\x1cForkWorkflowExecutionRequest\x12\x1c\n" +
+	"\tnamespace\x18\x01 \x01(\tR\tnamespace\x12G\n" +
+	"\texecution\x18\x02 \x01(\v2).temporal.api.common.v1.WorkflowExecutionR\texecution\x12@\n" +
+	"\x1dworkflow_task_finish_event_id\x18\x03 \x01(\x03R\x19workflowTaskFinishEventId\x12#\n" +
+	"\rnew_namespace\x18\x04 \x01(\tR\fnewNamespace\x12&\n" +
+	"\x0fnew_workflow_id\x18\x05 \x01(\tR\rnewWorkflowId\x12\x1d\n" +
+	"\n" +
+	"request_id\x18\x06 \x01(\tR\trequestId\x12\x16\n" +
+	"\x06reason\x18\a \x01(\tR\x06reason\x12m\n" +
+	"\x1breset_reapply_exclude_types\x18\b \x03(\x0e2..temporal.api.enums.v1.ResetReapplyExcludeTypeR\x18resetReapplyExcludeTypes\"6\n" +
+	"\x1dForkWorkflowExecutionResponse\x12\x15\n" +
+	"\x06run_id\x18\x01 \x01(\tR\x05runId\"\xf5\x01\n" +
+	"\x1eRedactWorkflowExecutionRequest\x12\x1c\n" +
+	"\tnamespace\x18\x01 \x01(\tR\tnamespace\x12G\n" +
+	"\texecution\x18\x02 \x01(\v2).temporal.api.common.v1.WorkflowExecutionR\texecution\x12\x1b\n" +
+	"\tevent_ids\x18\x03 \x03(\x03R\beventIds\x12\x1b\n" +
+	"\tmemo_keys\x18\x04 \x03(\tR\bmemoKeys\x12\x16\n" +
+	"\x06reason\x18\x05 \x01(\tR\x06reason\x12\x1a\n" +
+	"\bidentity\x18\x06 \x01(\tR\bidentity\"!\n" +
+	"\x1fRedactWorkflowExecutionResponse\"\x88\x01\n" +
+	"\x1fGetWorkflowHistoryBudgetRequest\x12\x1c\n" +
+	"\tnamespace\x18\x01 \x01(\tR\tnamespace\x12G\n" +
+	"\texecution\x18\x02 \x01(\v2).temporal.api.common.v1.WorkflowExecutionR\texecution\"y\n" +
+	" GetWorkflowHistoryBudgetResponse\x12U\n" +
+	"\x05usage\x18\x01 \x01(\v2?.temporal.server.api.adminservice.v1.WorkflowHistoryBudgetUsageR\x05usage\"\x88\x03\n" +
+	"\x1aWorkflowHistoryBudgetUsage\x12#\n" +
+	"\rworkflow_type\x18\x01 \x01(\tR\fworkflowType\x12\x1d\n" +
+	"\n" +
+	"has_budget\x18\x02 \x01(\bR\thasBudget\x12,\n" +
+	"\x12history_size_bytes\x18\x03 \x01(\x03R\x10historySizeBytes\x123\n" +
+	"\x16max_history_size_bytes\x18\x04 \x01(\x03R\x13maxHistorySizeBytes\x12#\n" +
+	"\rhistory_count\x18\x05 \x01(\x03R\fhistoryCount\x12*\n" +
+	"\x11max_history_count\x18\x06 \x01(\x03R\x0fmaxHistoryCount\x12%\n" +
+	"\x0econsumed_ratio\x18\a \x01(\x01R\rconsumedRatio\x12K\n" +
+	"\aactions\x18\b \x03(\x0e21.temporal.server.api.enums.v1.HistoryBudgetActionR\aactions\"\x8c\x01\n" +
+	"#GetWorkflowExecutionTimelineRequest\x12\x1c\n" +
+	"\tnamespace\x18\x01 \x01(\tR\tnamespace\x12G\n" +
+	"\texecution\x18\x02 \x01(\v2).temporal.api.common.v1.WorkflowExecutionR\texecution\"\x82\x01\n" +
+	"$GetWorkflowExecutionTimelineResponse\x12Z\n" +
+	"\btimeline\x18\x01 \x01(\v2>.temporal.server.api.adminservice.v1.WorkflowExecutionTimelineR\btimeline\"\x95\x02\n" +
+	"\x19WorkflowExecutionTimeline\x129\n" +
+	"\n" +
+	"start_time\x18\x01 \x01(\v2\x1a.google.protobuf.TimestampR\tstartTime\x125\n" +
+	"\bend_time\x18\x02 \x01(\v2\x1a.google.protobuf.TimestampR\aendTime\x12\x18\n" +
+	"\arunning\x18\x03 \x01(\bR\arunning\x12G\n" +
+	"\x05spans\x18\x04 \x03(\v21.temporal.server.api.adminservice.v1.TimelineSpanR\x05spans\x12#\n" +
+	"\rcritical_path\x18\x05 \x03(\x05R\fcriticalPath\"\xb6\x05\n" +
+	"\fTimelineSpan\x12B\n" +
+	"\x04type\x18\x01 \x01(\x0e2..temporal.server.api.enums.v1.TimelineSpanTypeR\x04type\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x12\x0e\n" +
+	"\x02id\x18\x03 \x01(\tR\x02id\x12\x19\n" +
+	"\bevent_id\x18\x04 \x01(\x03R\aeventId\x12 \n" +
+	"\fend_event_id\x18\x05 \x01(\x03R\n" +
+	"endEventId\x12F\n" +
+	"\x0eend_event_type\x18\x06 \x01(\x0e2 .temporal.api.enums.v1.EventTypeR\fendEventType\x12F\n" +
+	" workflow_task_completed_event_id\x18\a \x01(\x03R\x1cworkflowTaskCompletedEventId\x12?\n" +
+	"\rschedule_time\x18\b \x01(\v2\x1a.google.protobuf.TimestampR\fscheduleTime\x129\n" +
+	"\n" +
+	"start_time\x18\t \x01(\v2\x1a.google.protobuf.TimestampR\tstartTime\x125\n" +
+	"\bend_time\x18\n" +
+	" \x01(\v2\x1a.google.protobuf.TimestampR\aendTime\x12T\n" +
+	"\x19schedule_to_start_latency\x18\v \x01(\v2\x19.google.protobuf.DurationR\x16scheduleToStartLatency\x12N\n" +
+	"\x16start_to_close_latency\x18\f \x01(\v2\x19.google.protobuf.DurationR\x13startToCloseLatency\x12\x18\n" +
+	"\aattempt\x18\r \x01(\x05R\aattempt\"\xb0\x01\n" +
 	"\x1bDescribeMutableStateRequest\x12\x1c\n" +
 	"\tnamespace\x18\x01 \x01(\tR\tnamespace\x12G\n" +
 	"\texecution\x18\x02 \x01(\v2).temporal.api.common.v1.WorkflowExecutionR\texecution\x12*\n" +
@@ -5399,12 +7743,18 @@ const file_temporal_server_api_adminservice_v1_request_response_proto_rawDesc =
 	"\fhost_address\x18\x01 \x01(\tR\vhostAddress\x12\x19\n" +
 	"\bshard_id\x18\x02 \x01(\x05R\ashardId\x12\x1c\n" +
 	"\tnamespace\x18\x03 \x01(\tR\tnamespace\x12X\n" +
-	"\x12workflow_execution\x18\x04 \x01(\v2).temporal.api.common.v1.WorkflowExecutionR\x11workflowExecution\"\xde\x01\n" +
+	"\x12workflow_execution\x18\x04 \x01(\v2).temporal.api.common.v1.WorkflowExecutionR\x11workflowExecution\"\xaa\x02\n" +
 	"\x1bDescribeHistoryHostResponse\x12#\n" +
 	"\rshards_number\x18\x01 \x01(\x05R\fshardsNumber\x12\x1b\n" +
 	"\tshard_ids\x18\x02 \x03(\x05R\bshardIds\x12]\n" +
 	"\x0fnamespace_cache\x18\x03 \x01(\v24.temporal.server.api.namespace.v1.NamespaceCacheInfoR\x0enamespaceCache\x12\x18\n" +
-	"\aaddress\x18\x05 \x01(\tR\aaddressJ\x04\b\x04\x10\x05\".\n" +
+	"\aaddress\x18\x05 \x01(\tR\aaddress\x12J\n" +
+	"\vshard_loads\x18\x06 \x03(\v2).temporal.server.api.history.v1.ShardLoadR\n" +
+	"shardLoadsJ\x04\b\x04\x10\x05\"d\n" +
+	"\x17MoveHistoryShardRequest\x12\x19\n" +
+	"\bshard_id\x18\x01 \x01(\x05R\ashardId\x12.\n" +
+	"\x13target_host_address\x18\x02 \x01(\tR\x11targetHostAddress\"\x1a\n" +
+	"\x18MoveHistoryShardResponse\".\n" +
 	"\x11CloseShardRequest\x12\x19\n" +
 	"\bshard_id\x18\x01 \x01(\x05R\ashardId\"\x14\n" +
 	"\x12CloseShardResponse\",\n" +
@@ -5526,7 +7876,43 @@ const file_temporal_server_api_adminservice_v1_request_response_proto_rawDesc =
 	"\x05value\x18\x02 \x01(\x0e2'.temporal.api.enums.v1.IndexedValueTypeR\x05value:\x028\x01\x1a:\n" +
 	"\fMappingEntry\x12\x10\n" +
 	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
-	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01\";\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01\"\xd3\x01\n" +
+	"\"AggregateWorkflowExecutionsRequest\x12\x1c\n" +
+	"\tnamespace\x18\x01 \x01(\tR\tnamespace\x12\x14\n" +
+	"\x05query\x18\x02 \x01(\tR\x05query\x12\x19\n" +
+	"\bgroup_by\x18\x03 \x03(\tR\agroupBy\x12^\n" +
+	"\faggregations\x18\x04 \x03(\v2:.temporal.server.api.adminservice.v1.VisibilityAggregationR\faggregations\"~\n" +
+	"#AggregateWorkflowExecutionsResponse\x12W\n" +
+	"\x06groups\x18\x01 \x03(\v2?.temporal.server.api.adminservice.v1.VisibilityAggregationGroupR\x06groups\"\xa8\x01\n" +
+	"\x15VisibilityAggregation\x12W\n" +
+	"\bfunction\x18\x01 \x01(\x0e2;.temporal.server.api.enums.v1.VisibilityAggregationFunctionR\bfunction\x12\x14\n" +
+	"\x05field\x18\x02 \x01(\tR\x05field\x12 \n" +
+	"\vpercentiles\x18\x03 \x03(\x01R\vpercentiles\"\xd2\x01\n" +
+	"\x1aVisibilityAggregationGroup\x12B\n" +
+	"\fgroup_values\x18\x01 \x03(\v2\x1f.temporal.api.common.v1.PayloadR\vgroupValues\x12\x14\n" +
+	"\x05count\x18\x02 \x01(\x03R\x05count\x12Z\n" +
+	"\aresults\x18\x03 \x03(\v2@.temporal.server.api.adminservice.v1.VisibilityAggregationResultR\aresults\"}\n" +
+	"\x1bVisibilityAggregationResult\x12\x1b\n" +
+	"\thas_value\x18\x01 \x01(\bR\bhasValue\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\x01R\x05value\x12+\n" +
+	"\x11percentile_values\x18\x03 \x03(\x01R\x10percentileValues\"\xa9\x01\n" +
+	"!UpsertSavedVisibilityQueryRequest\x12\x1c\n" +
+	"\tnamespace\x18\x01 \x01(\tR\tnamespace\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x12\x14\n" +
+	"\x05query\x18\x03 \x01(\tR\x05query\x12 \n" +
+	"\vdescription\x18\x04 \x01(\tR\vdescription\x12\x1a\n" +
+	"\bidentity\x18\x05 \x01(\tR\bidentity\"\x7f\n" +
+	"\"UpsertSavedVisibilityQueryResponse\x12Y\n" +
+	"\vsaved_query\x18\x01 \x01(\v28.temporal.server.api.persistence.v1.SavedVisibilityQueryR\n" +
+	"savedQuery\"U\n" +
+	"!DeleteSavedVisibilityQueryRequest\x12\x1c\n" +
+	"\tnamespace\x18\x01 \x01(\tR\tnamespace\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\"$\n" +
+	"\"DeleteSavedVisibilityQueryResponse\"A\n" +
+	"!ListSavedVisibilityQueriesRequest\x12\x1c\n" +
+	"\tnamespace\x18\x01 \x01(\tR\tnamespace\"\x83\x01\n" +
+	"\"ListSavedVisibilityQueriesResponse\x12]\n" +
+	"\rsaved_queries\x18\x01 \x03(\v28.temporal.server.api.persistence.v1.SavedVisibilityQueryR\fsavedQueries\";\n" +
 	"\x16DescribeClusterRequest\x12!\n" +
 	"\fcluster_name\x18\x01 \x01(\tR\vclusterName\"\xe1\a\n" +
 	"\x17DescribeClusterResponse\x12\x7f\n" +
@@ -5750,9 +8136,10 @@ const file_temporal_server_api_adminservice_v1_request_response_proto_rawDesc =
 	"read_level\x18\x01 \x01(\x03R\treadLevel\x12\x1b\n" +
 	"\tack_level\x18\x02 \x01(\x03R\backLevel\x12J\n" +
 	"\rtask_id_block\x18\x03 \x01(\v2&.temporal.api.taskqueue.v1.TaskIdBlockR\vtaskIdBlock\x12,\n" +
-	"\x12read_buffer_length\x18\x04 \x01(\x03R\x10readBufferLength\"\xc8\x02\n" +
+	"\x12read_buffer_length\x18\x04 \x01(\x03R\x10readBufferLength\"\xab\x03\n" +
 	"\"DescribeTaskQueuePartitionResponse\x12\x97\x01\n" +
-	"\x16versions_info_internal\x18\x01 \x03(\v2a.temporal.server.api.adminservice.v1.DescribeTaskQueuePartitionResponse.VersionsInfoInternalEntryR\x14versionsInfoInternal\x1a\x87\x01\n" +
+	"\x16versions_info_internal\x18\x01 \x03(\v2a.temporal.server.api.adminservice.v1.DescribeTaskQueuePartitionResponse.VersionsInfoInternalEntryR\x14versionsInfoInternal\x12a\n" +
+	"\x0edispatch_pause\x18\x02 \x01(\v2:.temporal.server.api.persistence.v1.TaskQueueDispatchPauseR\rdispatchPause\x1a\x87\x01\n" +
 	"\x19VersionsInfoInternalEntry\x12\x10\n" +
 	"\x03key\x18\x01 \x01(\tR\x03key\x12T\n" +
 	"\x05value\x18\x02 \x01(\v2>.temporal.server.api.taskqueue.v1.TaskQueueVersionInfoInternalR\x05value:\x028\x01\"\xac\x01\n" +
@@ -5761,7 +8148,55 @@ const file_temporal_server_api_adminservice_v1_request_response_proto_rawDesc =
 	"\x14task_queue_partition\x18\x02 \x01(\v24.temporal.server.api.taskqueue.v1.TaskQueuePartitionR\x12taskQueuePartition\"F\n" +
 	"%ForceUnloadTaskQueuePartitionResponse\x12\x1d\n" +
 	"\n" +
-	"was_loaded\x18\x01 \x01(\bR\twasLoadedB8Z6go.temporal.io/server/api/adminservice/v1;adminserviceb\x06proto3"
+	"was_loaded\x18\x01 \x01(\bR\twasLoaded\"\x85\x02\n" +
+	"\x1dPauseTaskQueueDispatchRequest\x12\x1c\n" +
+	"\tnamespace\x18\x01 \x01(\tR\tnamespace\x12\x1d\n" +
+	"\n" +
+	"task_queue\x18\x02 \x01(\tR\ttaskQueue\x12N\n" +
+	"\x10task_queue_types\x18\x03 \x03(\x0e2$.temporal.api.enums.v1.TaskQueueTypeR\x0etaskQueueTypes\x12#\n" +
+	"\rpriority_keys\x18\x04 \x03(\x05R\fpriorityKeys\x12\x16\n" +
+	"\x06reason\x18\x05 \x01(\tR\x06reason\x12\x1a\n" +
+	"\bidentity\x18\x06 \x01(\tR\bidentity\"\x9d\x02\n" +
+	"\x1ePauseTaskQueueDispatchResponse\x12}\n" +
+	"\x0edispatch_pause\x18\x01 \x03(\v2V.temporal.server.api.adminservice.v1.PauseTaskQueueDispatchResponse.DispatchPauseEntryR\rdispatchPause\x1a|\n" +
+	"\x12DispatchPauseEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\x05R\x03key\x12P\n" +
+	"\x05value\x18\x02 \x01(\v2:.temporal.server.api.persistence.v1.TaskQueueDispatchPauseR\x05value:\x028\x01\"\xee\x01\n" +
+	"\x1eResumeTaskQueueDispatchRequest\x12\x1c\n" +
+	"\tnamespace\x18\x01 \x01(\tR\tnamespace\x12\x1d\n" +
+	"\n" +
+	"task_queue\x18\x02 \x01(\tR\ttaskQueue\x12N\n" +
+	"\x10task_queue_types\x18\x03 \x03(\x0e2$.temporal.api.enums.v1.TaskQueueTypeR\x0etaskQueueTypes\x12#\n" +
+	"\rpriority_keys\x18\x04 \x03(\x05R\fpriorityKeys\x12\x1a\n" +
+	"\bidentity\x18\x05 \x01(\tR\bidentity\"\x9f\x02\n" +
+	"\x1fResumeTaskQueueDispatchResponse\x12~\n" +
+	"\x0edispatch_pause\x18\x01 \x03(\v2W.temporal.server.api.adminservice.v1.ResumeTaskQueueDispatchResponse.DispatchPauseEntryR\rdispatchPause\x1a|\n" +
+	"\x12DispatchPauseEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\x05R\x03key\x12P\n" +
+	"\x05value\x18\x02 \x01(\v2:.temporal.server.api.persistence.v1.TaskQueueDispatchPauseR\x05value:\x028\x01\"\xed\x01\n" +
+	" ListTaskQueueBacklogTasksRequest\x12\x1c\n" +
+	"\tnamespace\x18\x01 \x01(\tR\tnamespace\x12f\n" +
+	"\x14task_queue_partition\x18\x02 \x01(\v24.temporal.server.api.taskqueue.v1.TaskQueuePartitionR\x12taskQueuePartition\x12\x1b\n" +
+	"\tpage_size\x18\x03 \x01(\x05R\bpageSize\x12&\n" +
+	"\x0fnext_page_token\x18\x04 \x01(\fR\rnextPageToken\"\x94\x01\n" +
+	"!ListTaskQueueBacklogTasksResponse\x12G\n" +
+	"\x05tasks\x18\x01 \x03(\v21.temporal.server.api.taskqueue.v1.BacklogTaskInfoR\x05tasks\x12&\n" +
+	"\x0fnext_page_token\x18\x02 \x01(\fR\rnextPageToken\"\xf2\x01\n" +
+	"\"DeleteTaskQueueBacklogTasksRequest\x12\x1c\n" +
+	"\tnamespace\x18\x01 \x01(\tR\tnamespace\x12f\n" +
+	"\x14task_queue_partition\x18\x02 \x01(\v24.temporal.server.api.taskqueue.v1.TaskQueuePartitionR\x12taskQueuePartition\x12F\n" +
+	"\x05tasks\x18\x03 \x03(\v20.temporal.server.api.taskqueue.v1.BacklogTaskKeyR\x05tasks\"%\n" +
+	"#DeleteTaskQueueBacklogTasksResponse\"\xa3\x02\n" +
+	" MoveTaskQueueBacklogTasksRequest\x12\x1c\n" +
+	"\tnamespace\x18\x01 \x01(\tR\tnamespace\x12f\n" +
+	"\x14task_queue_partition\x18\x02 \x01(\v24.temporal.server.api.taskqueue.v1.TaskQueuePartitionR\x12taskQueuePartition\x124\n" +
+	"\x16destination_task_queue\x18\x03 \x01(\tR\x14destinationTaskQueue\x12\x1b\n" +
+	"\tpage_size\x18\x04 \x01(\x05R\bpageSize\x12&\n" +
+	"\x0fnext_page_token\x18\x05 \x01(\fR\rnextPageToken\"\x9f\x01\n" +
+	"!MoveTaskQueueBacklogTasksResponse\x12R\n" +
+	"\vmoved_tasks\x18\x01 \x03(\v21.temporal.server.api.taskqueue.v1.BacklogTaskInfoR\n" +
+	"movedTasks\x12&\n" +
+	"\x0fnext_page_token\x18\x02 \x01(\fR\rnextPageTokenB8Z6go.temporal.io/server/api/adminservice/v1;adminserviceb\x06proto3"
 
 var (
 	file_temporal_server_api_adminservice_v1_request_response_proto_rawDescOnce sync.Once
@@ -5775,245 +8210,336 @@ func file_temporal_server_api_adminservice_v1_request_response_proto_rawDescGZIP
 	return file_temporal_server_api_adminservice_v1_request_response_proto_rawDescData
 }
 
-var file_temporal_server_api_adminservice_v1_request_response_proto_msgTypes = make([]protoimpl.MessageInfo, 99)
+var file_temporal_server_api_adminservice_v1_request_response_proto_msgTypes = make([]protoimpl.MessageInfo, 138)
 var file_temporal_server_api_adminservice_v1_request_response_proto_goTypes = []any{
 	(*RebuildMutableStateRequest)(nil),                  // 0: temporal.server.api.adminservice.v1.RebuildMutableStateRequest
 	(*RebuildMutableStateResponse)(nil),                 // 1: temporal.server.api.adminservice.v1.RebuildMutableStateResponse
-	(*ImportWorkflowExecutionRequest)(nil),              // 2: temporal.server.api.adminservice.v1.ImportWorkflowExecutionRequest
-	(*ImportWorkflowExecutionResponse)(nil),             // 3: temporal.server.api.adminservice.v1.ImportWorkflowExecutionResponse
-	(*DescribeMutableStateRequest)(nil),                 // 4: temporal.server.api.adminservice.v1.DescribeMutableStateRequest
-	(*DescribeMutableStateResponse)(nil),                // 5: temporal.server.api.adminservice.v1.DescribeMutableStateResponse
-	(*DescribeHistoryHostRequest)(nil),                  // 6: temporal.server.api.adminservice.v1.DescribeHistoryHostRequest
-	(*DescribeHistoryHostResponse)(nil),                 // 7: temporal.server.api.adminservice.v1.DescribeHistoryHostResponse
-	(*CloseShardRequest)(nil),                           // 8: temporal.server.api.adminservice.v1.CloseShardRequest
-	(*CloseShardResponse)(nil),                          // 9: temporal.server.api.adminservice.v1.CloseShardResponse
-	(*GetShardRequest)(nil),                             // 10: temporal.server.api.adminservice.v1.GetShardRequest
-	(*GetShardResponse)(nil),                            // 11: temporal.server.api.adminservice.v1.GetShardResponse
-	(*ListHistoryTasksRequest)(nil),                     // 12: temporal.server.api.adminservice.v1.ListHistoryTasksRequest
-	(*ListHistoryTasksResponse)(nil),                    // 13: temporal.server.api.adminservice.v1.ListHistoryTasksResponse
-	(*Task)(nil),                                        // 14: temporal.server.api.adminservice.v1.Task
-	(*RemoveTaskRequest)(nil),                           // 15: temporal.server.api.adminservice.v1.RemoveTaskRequest
-	(*RemoveTaskResponse)(nil),                          // 16: temporal.server.api.adminservice.v1.RemoveTaskResponse
-	(*GetWorkflowExecutionRawHistoryV2Request)(nil),     // 17: temporal.server.api.adminservice.v1.GetWorkflowExecutionRawHistoryV2Request
-	(*GetWorkflowExecutionRawHistoryV2Response)(nil),    // 18: temporal.server.api.adminservice.v1.GetWorkflowExecutionRawHistoryV2Response
-	(*GetWorkflowExecutionRawHistoryRequest)(nil),       // 19: temporal.server.api.adminservice.v1.GetWorkflowExecutionRawHistoryRequest
-	(*GetWorkflowExecutionRawHistoryResponse)(nil),      // 20: temporal.server.api.adminservice.v1.GetWorkflowExecutionRawHistoryResponse
-	(*GetReplicationMessagesRequest)(nil),               // 21: temporal.server.api.adminservice.v1.GetReplicationMessagesRequest
-	(*GetReplicationMessagesResponse)(nil),              // 22: temporal.server.api.adminservice.v1.GetReplicationMessagesResponse
-	(*GetNamespaceReplicationMessagesRequest)(nil),      // 23: temporal.server.api.adminservice.v1.GetNamespaceReplicationMessagesRequest
-	(*GetNamespaceReplicationMessagesResponse)(nil),     // 24: temporal.server.api.adminservice.v1.GetNamespaceReplicationMessagesResponse
-	(*GetDLQReplicationMessagesRequest)(nil),            // 25: temporal.server.api.adminservice.v1.GetDLQReplicationMessagesRequest
-	(*GetDLQReplicationMessagesResponse)(nil),           // 26: temporal.server.api.adminservice.v1.GetDLQReplicationMessagesResponse
-	(*ReapplyEventsRequest)(nil),                        // 27: temporal.server.api.adminservice.v1.ReapplyEventsRequest
-	(*ReapplyEventsResponse)(nil),                       // 28: temporal.server.api.adminservice.v1.ReapplyEventsResponse
-	(*AddSearchAttributesRequest)(nil),                  // 29: temporal.server.api.adminservice.v1.AddSearchAttributesRequest
-	(*AddSearchAttributesResponse)(nil),                 // 30: temporal.server.api.adminservice.v1.AddSearchAttributesResponse
-	(*RemoveSearchAttributesRequest)(nil),               // 31: temporal.server.api.adminservice.v1.RemoveSearchAttributesRequest
-	(*RemoveSearchAttributesResponse)(nil),              // 32: temporal.server.api.adminservice.v1.RemoveSearchAttributesResponse
-	(*GetSearchAttributesRequest)(nil),                  // 33: temporal.server.api.adminservice.v1.GetSearchAttributesRequest
-	(*GetSearchAttributesResponse)(nil),                 // 34: temporal.server.api.adminservice.v1.GetSearchAttributesResponse
-	(*DescribeClusterRequest)(nil),                      // 35: temporal.server.api.adminservice.v1.DescribeClusterRequest
-	(*DescribeClusterResponse)(nil),                     // 36: temporal.server.api.adminservice.v1.DescribeClusterResponse
-	(*ListClustersRequest)(nil),                         // 37: temporal.server.api.adminservice.v1.ListClustersRequest
-	(*ListClustersResponse)(nil),                        // 38: temporal.server.api.adminservice.v1.ListClustersResponse
-	(*AddOrUpdateRemoteClusterRequest)(nil),             // 39: temporal.server.api.adminservice.v1.AddOrUpdateRemoteClusterRequest
-	(*AddOrUpdateRemoteClusterResponse)(nil),            // 40: temporal.server.api.adminservice.v1.AddOrUpdateRemoteClusterResponse
-	(*RemoveRemoteClusterRequest)(nil),                  // 41: temporal.server.api.adminservice.v1.RemoveRemoteClusterRequest
-	(*RemoveRemoteClusterResponse)(nil),                 // 42: temporal.server.api.adminservice.v1.RemoveRemoteClusterResponse
-	(*ListClusterMembersRequest)(nil),                   // 43: temporal.server.api.adminservice.v1.ListClusterMembersRequest
-	(*ListClusterMembersResponse)(nil),                  // 44: temporal.server.api.adminservice.v1.ListClusterMembersResponse
-	(*GetDLQMessagesRequest)(nil),                       // 45: temporal.server.api.adminservice.v1.GetDLQMessagesRequest
-	(*GetDLQMessagesResponse)(nil),                      // 46: temporal.server.api.adminservice.v1.GetDLQMessagesResponse
-	(*PurgeDLQMessagesRequest)(nil),                     // 47: temporal.server.api.adminservice.v1.PurgeDLQMessagesRequest
-	(*PurgeDLQMessagesResponse)(nil),                    // 48: temporal.server.api.adminservice.v1.PurgeDLQMessagesResponse
-	(*MergeDLQMessagesRequest)(nil),                     // 49: temporal.server.api.adminservice.v1.MergeDLQMessagesRequest
-	(*MergeDLQMessagesResponse)(nil),                    // 50: temporal.server.api.adminservice.v1.MergeDLQMessagesResponse
-	(*RefreshWorkflowTasksRequest)(nil),                 // 51: temporal.server.api.adminservice.v1.RefreshWorkflowTasksRequest
-	(*RefreshWorkflowTasksResponse)(nil),                // 52: temporal.server.api.adminservice.v1.RefreshWorkflowTasksResponse
-	(*ResendReplicationTasksRequest)(nil),               // 53: temporal.server.api.adminservice.v1.ResendReplicationTasksRequest
-	(*ResendReplicationTasksResponse)(nil),              // 54: temporal.server.api.adminservice.v1.ResendReplicationTasksResponse
-	(*GetTaskQueueTasksRequest)(nil),                    // 55: temporal.server.api.adminservice.v1.GetTaskQueueTasksRequest
-	(*GetTaskQueueTasksResponse)(nil),                   // 56: temporal.server.api.adminservice.v1.GetTaskQueueTasksResponse
-	(*DeleteWorkflowExecutionRequest)(nil),              // 57: temporal.server.api.adminservice.v1.DeleteWorkflowExecutionRequest
-	(*DeleteWorkflowExecutionResponse)(nil),             // 58: temporal.server.api.adminservice.v1.DeleteWorkflowExecutionResponse
-	(*StreamWorkflowReplicationMessagesRequest)(nil),    // 59: temporal.server.api.adminservice.v1.StreamWorkflowReplicationMessagesRequest
-	(*StreamWorkflowReplicationMessagesResponse)(nil),   // 60: temporal.server.api.adminservice.v1.StreamWorkflowReplicationMessagesResponse
-	(*GetNamespaceRequest)(nil),                         // 61: temporal.server.api.adminservice.v1.GetNamespaceRequest
-	(*GetNamespaceResponse)(nil),                        // 62: temporal.server.api.adminservice.v1.GetNamespaceResponse
-	(*GetDLQTasksRequest)(nil),                          // 63: temporal.server.api.adminservice.v1.GetDLQTasksRequest
-	(*GetDLQTasksResponse)(nil),                         // 64: temporal.server.api.adminservice.v1.GetDLQTasksResponse
-	(*PurgeDLQTasksRequest)(nil),                        // 65: temporal.server.api.adminservice.v1.PurgeDLQTasksRequest
-	(*PurgeDLQTasksResponse)(nil),                       // 66: temporal.server.api.adminservice.v1.PurgeDLQTasksResponse
-	(*DLQJobToken)(nil),                                 // 67: temporal.server.api.adminservice.v1.DLQJobToken
-	(*MergeDLQTasksRequest)(nil),                        // 68: temporal.server.api.adminservice.v1.MergeDLQTasksRequest
-	(*MergeDLQTasksResponse)(nil),                       // 69: temporal.server.api.adminservice.v1.MergeDLQTasksResponse
-	(*DescribeDLQJobRequest)(nil),                       // 70: temporal.server.api.adminservice.v1.DescribeDLQJobRequest
-	(*DescribeDLQJobResponse)(nil),                      // 71: temporal.server.api.adminservice.v1.DescribeDLQJobResponse
-	(*CancelDLQJobRequest)(nil),                         // 72: temporal.server.api.adminservice.v1.CancelDLQJobRequest
-	(*CancelDLQJobResponse)(nil),                        // 73: temporal.server.api.adminservice.v1.CancelDLQJobResponse
-	(*AddTasksRequest)(nil),                             // 74: temporal.server.api.adminservice.v1.AddTasksRequest
-	(*AddTasksResponse)(nil),                            // 75: temporal.server.api.adminservice.v1.AddTasksResponse
-	(*ListQueuesRequest)(nil),                           // 76: temporal.server.api.adminservice.v1.ListQueuesRequest
-	(*ListQueuesResponse)(nil),                          // 77: temporal.server.api.adminservice.v1.ListQueuesResponse
-	(*DeepHealthCheckRequest)(nil),                      // 78: temporal.server.api.adminservice.v1.DeepHealthCheckRequest
-	(*DeepHealthCheckResponse)(nil),                     // 79: temporal.server.api.adminservice.v1.DeepHealthCheckResponse
-	(*SyncWorkflowStateRequest)(nil),                    // 80: temporal.server.api.adminservice.v1.SyncWorkflowStateRequest
-	(*SyncWorkflowStateResponse)(nil),                   // 81: temporal.server.api.adminservice.v1.SyncWorkflowStateResponse
-	(*GenerateLastHistoryReplicationTasksRequest)(nil),  // 82: temporal.server.api.adminservice.v1.GenerateLastHistoryReplicationTasksRequest
-	(*GenerateLastHistoryReplicationTasksResponse)(nil), // 83: temporal.server.api.adminservice.v1.GenerateLastHistoryReplicationTasksResponse
-	(*DescribeTaskQueuePartitionRequest)(nil),           // 84: temporal.server.api.adminservice.v1.DescribeTaskQueuePartitionRequest
-	(*InternalTaskQueueStatus)(nil),                     // 85: temporal.server.api.adminservice.v1.InternalTaskQueueStatus
-	(*DescribeTaskQueuePartitionResponse)(nil),          // 86: temporal.server.api.adminservice.v1.DescribeTaskQueuePartitionResponse
-	(*ForceUnloadTaskQueuePartitionRequest)(nil),        // 87: temporal.server.api.adminservice.v1.ForceUnloadTaskQueuePartitionRequest
-	(*ForceUnloadTaskQueuePartitionResponse)(nil),       // 88: temporal.server.api.adminservice.v1.ForceUnloadTaskQueuePartitionResponse
-	nil,                                       // 89: temporal.server.api.adminservice.v1.GetReplicationMessagesResponse.ShardMessagesEntry
-	nil,                                       // 90: temporal.server.api.adminservice.v1.AddSearchAttributesRequest.SearchAttributesEntry
-	nil,                                       // 91: temporal.server.api.adminservice.v1.GetSearchAttributesResponse.CustomAttributesEntry
-	nil,                                       // 92: temporal.server.api.adminservice.v1.GetSearchAttributesResponse.SystemAttributesEntry
-	nil,                                       // 93: temporal.server.api.adminservice.v1.GetSearchAttributesResponse.MappingEntry
-	nil,                                       // 94: temporal.server.api.adminservice.v1.DescribeClusterResponse.SupportedClientsEntry
-	nil,                                       // 95: temporal.server.api.adminservice.v1.DescribeClusterResponse.TagsEntry
-	(*AddTasksRequest_Task)(nil),              // 96: temporal.server.api.adminservice.v1.AddTasksRequest.Task
-	(*ListQueuesResponse_QueueInfo)(nil),      // 97: temporal.server.api.adminservice.v1.ListQueuesResponse.QueueInfo
-	nil,                                       // 98: temporal.server.api.adminservice.v1.DescribeTaskQueuePartitionResponse.VersionsInfoInternalEntry
-	(*v1.WorkflowExecution)(nil),              // 99: temporal.api.common.v1.WorkflowExecution
-	(*v1.DataBlob)(nil),                       // 100: temporal.api.common.v1.DataBlob
-	(*v11.VersionHistory)(nil),                // 101: temporal.server.api.history.v1.VersionHistory
-	(*v12.WorkflowMutableState)(nil),          // 102: temporal.server.api.persistence.v1.WorkflowMutableState
-	(*v13.NamespaceCacheInfo)(nil),            // 103: temporal.server.api.namespace.v1.NamespaceCacheInfo
-	(*v12.ShardInfo)(nil),                     // 104: temporal.server.api.persistence.v1.ShardInfo
-	(*v11.TaskRange)(nil),                     // 105: temporal.server.api.history.v1.TaskRange
-	(v14.TaskType)(0),                         // 106: temporal.server.api.enums.v1.TaskType
-	(*timestamppb.Timestamp)(nil),             // 107: google.protobuf.Timestamp
-	(*v15.ReplicationToken)(nil),              // 108: temporal.server.api.replication.v1.ReplicationToken
-	(*v15.ReplicationMessages)(nil),           // 109: temporal.server.api.replication.v1.ReplicationMessages
-	(*v15.ReplicationTaskInfo)(nil),           // 110: temporal.server.api.replication.v1.ReplicationTaskInfo
-	(*v15.ReplicationTask)(nil),               // 111: temporal.server.api.replication.v1.ReplicationTask
-	(*v17.WorkflowExecutionInfo)(nil),         // 112: temporal.api.workflow.v1.WorkflowExecutionInfo
-	(*v18.MembershipInfo)(nil),                // 113: temporal.server.api.cluster.v1.MembershipInfo
-	(*v19.VersionInfo)(nil),                   // 114: temporal.api.version.v1.VersionInfo
-	(*v12.ClusterMetadata)(nil),               // 115: temporal.server.api.persistence.v1.ClusterMetadata
-	(*durationpb.Duration)(nil),               // 116: google.protobuf.Duration
-	(v14.ClusterMemberRole)(0),                // 117: temporal.server.api.enums.v1.ClusterMemberRole
-	(*v18.ClusterMember)(nil),                 // 118: temporal.server.api.cluster.v1.ClusterMember
-	(v14.DeadLetterQueueType)(0),              // 119: temporal.server.api.enums.v1.DeadLetterQueueType
-	(v16.TaskQueueType)(0),                    // 120: temporal.api.enums.v1.TaskQueueType
-	(*v12.AllocatedTaskInfo)(nil),             // 121: temporal.server.api.persistence.v1.AllocatedTaskInfo
-	(*v15.SyncReplicationState)(nil),          // 122: temporal.server.api.replication.v1.SyncReplicationState
-	(*v15.WorkflowReplicationMessages)(nil),   // 123: temporal.server.api.replication.v1.WorkflowReplicationMessages
-	(*v110.NamespaceInfo)(nil),                // 124: temporal.api.namespace.v1.NamespaceInfo
-	(*v110.NamespaceConfig)(nil),              // 125: temporal.api.namespace.v1.NamespaceConfig
-	(*v111.NamespaceReplicationConfig)(nil),   // 126: temporal.api.replication.v1.NamespaceReplicationConfig
-	(*v111.FailoverStatus)(nil),               // 127: temporal.api.replication.v1.FailoverStatus
-	(*v112.HistoryDLQKey)(nil),                // 128: temporal.server.api.common.v1.HistoryDLQKey
-	(*v112.HistoryDLQTask)(nil),               // 129: temporal.server.api.common.v1.HistoryDLQTask
-	(*v112.HistoryDLQTaskMetadata)(nil),       // 130: temporal.server.api.common.v1.HistoryDLQTaskMetadata
-	(v14.DLQOperationType)(0),                 // 131: temporal.server.api.enums.v1.DLQOperationType
-	(v14.DLQOperationState)(0),                // 132: temporal.server.api.enums.v1.DLQOperationState
-	(v14.HealthState)(0),                      // 133: temporal.server.api.enums.v1.HealthState
-	(*v12.VersionedTransition)(nil),           // 134: temporal.server.api.persistence.v1.VersionedTransition
-	(*v11.VersionHistories)(nil),              // 135: temporal.server.api.history.v1.VersionHistories
-	(*v15.VersionedTransitionArtifact)(nil),   // 136: temporal.server.api.replication.v1.VersionedTransitionArtifact
-	(*v113.TaskQueuePartition)(nil),           // 137: temporal.server.api.taskqueue.v1.TaskQueuePartition
-	(*v114.TaskQueueVersionSelection)(nil),    // 138: temporal.api.taskqueue.v1.TaskQueueVersionSelection
-	(*v114.TaskIdBlock)(nil),                  // 139: temporal.api.taskqueue.v1.TaskIdBlock
-	(v16.IndexedValueType)(0),                 // 140: temporal.api.enums.v1.IndexedValueType
-	(*v113.TaskQueueVersionInfoInternal)(nil), // 141: temporal.server.api.taskqueue.v1.TaskQueueVersionInfoInternal
+	(*CheckMutableStateRequest)(nil),                    // 2: temporal.server.api.adminservice.v1.CheckMutableStateRequest
+	(*CheckMutableStateResponse)(nil),                   // 3: temporal.server.api.adminservice.v1.CheckMutableStateResponse
+	(*MutableStateInconsistency)(nil),                   // 4: temporal.server.api.adminservice.v1.MutableStateInconsistency
+	(*ImportWorkflowExecutionRequest)(nil),              // 5: temporal.server.api.adminservice.v1.ImportWorkflowExecutionRequest
+	(*ImportWorkflowExecutionResponse)(nil),             // 6: temporal.server.api.adminservice.v1.ImportWorkflowExecutionResponse
+	(*ForkWorkflowExecutionRequest)(nil),                // 7: temporal.server.api.adminservice.v1.ForkWorkflowExecutionRequest
+	(*ForkWorkflowExecutionResponse)(nil),               // 8: temporal.server.api.adminservice.v1.ForkWorkflowExecutionResponse
+	(*RedactWorkflowExecutionRequest)(nil),              // 9: temporal.server.api.adminservice.v1.RedactWorkflowExecutionRequest
+	(*RedactWorkflowExecutionResponse)(nil),             // 10: temporal.server.api.adminservice.v1.RedactWorkflowExecutionResponse
+	(*GetWorkflowHistoryBudgetRequest)(nil),             // 11: temporal.server.api.adminservice.v1.GetWorkflowHistoryBudgetRequest
+	(*GetWorkflowHistoryBudgetResponse)(nil),            // 12: temporal.server.api.adminservice.v1.GetWorkflowHistoryBudgetResponse
+	(*WorkflowHistoryBudgetUsage)(nil),                  // 13: temporal.server.api.adminservice.v1.WorkflowHistoryBudgetUsage
+	(*GetWorkflowExecutionTimelineRequest)(nil),         // 14: temporal.server.api.adminservice.v1.GetWorkflowExecutionTimelineRequest
+	(*GetWorkflowExecutionTimelineResponse)(nil),        // 15: temporal.server.api.adminservice.v1.GetWorkflowExecutionTimelineResponse
+	(*WorkflowExecutionTimeline)(nil),                   // 16: temporal.server.api.adminservice.v1.WorkflowExecutionTimeline
+	(*TimelineSpan)(nil),                                // 17: temporal.server.api.adminservice.v1.TimelineSpan
+	(*DescribeMutableStateRequest)(nil),                 // 18: temporal.server.api.adminservice.v1.DescribeMutableStateRequest
+	(*DescribeMutableStateResponse)(nil),                // 19: temporal.server.api.adminservice.v1.DescribeMutableStateResponse
+	(*DescribeHistoryHostRequest)(nil),                  // 20: temporal.server.api.adminservice.v1.DescribeHistoryHostRequest
+	(*DescribeHistoryHostResponse)(nil),                 // 21: temporal.server.api.adminservice.v1.DescribeHistoryHostResponse
+	(*MoveHistoryShardRequest)(nil),                     // 22: temporal.server.api.adminservice.v1.MoveHistoryShardRequest
+	(*MoveHistoryShardResponse)(nil),                    // 23: temporal.server.api.adminservice.v1.MoveHistoryShardResponse
+	(*CloseShardRequest)(nil),                           // 24: temporal.server.api.adminservice.v1.CloseShardRequest
+	(*CloseShardResponse)(nil),                          // 25: temporal.server.api.adminservice.v1.CloseShardResponse
+	(*GetShardRequest)(nil),                             // 26: temporal.server.api.adminservice.v1.GetShardRequest
+	(*GetShardResponse)(nil),                            // 27: temporal.server.api.adminservice.v1.GetShardResponse
+	(*ListHistoryTasksRequest)(nil),                     // 28: temporal.server.api.adminservice.v1.ListHistoryTasksRequest
+	(*ListHistoryTasksResponse)(nil),                    // 29: temporal.server.api.adminservice.v1.ListHistoryTasksResponse
+	(*Task)(nil),                                        // 30: temporal.server.api.adminservice.v1.Task
+	(*RemoveTaskRequest)(nil),                           // 31: temporal.server.api.adminservice.v1.RemoveTaskRequest
+	(*RemoveTaskResponse)(nil),                          // 32: temporal.server.api.adminservice.v1.RemoveTaskResponse
+	(*GetWorkflowExecutionRawHistoryV2Request)(nil),     // 33: temporal.server.api.adminservice.v1.GetWorkflowExecutionRawHistoryV2Request
+	(*GetWorkflowExecutionRawHistoryV2Response)(nil),    // 34: temporal.server.api.adminservice.v1.GetWorkflowExecutionRawHistoryV2Response
+	(*GetWorkflowExecutionRawHistoryRequest)(nil),       // 35: temporal.server.api.adminservice.v1.GetWorkflowExecutionRawHistoryRequest
+	(*GetWorkflowExecutionRawHistoryResponse)(nil),      // 36: temporal.server.api.adminservice.v1.GetWorkflowExecutionRawHistoryResponse
+	(*GetReplicationMessagesRequest)(nil),               // 37: temporal.server.api.adminservice.v1.GetReplicationMessagesRequest
+	(*GetReplicationMessagesResponse)(nil),              // 38: temporal.server.api.adminservice.v1.GetReplicationMessagesResponse
+	(*GetNamespaceReplicationMessagesRequest)(nil),      // 39: temporal.server.api.adminservice.v1.GetNamespaceReplicationMessagesRequest
+	(*GetNamespaceReplicationMessagesResponse)(nil),     // 40: temporal.server.api.adminservice.v1.GetNamespaceReplicationMessagesResponse
+	(*GetDLQReplicationMessagesRequest)(nil),            // 41: temporal.server.api.adminservice.v1.GetDLQReplicationMessagesRequest
+	(*GetDLQReplicationMessagesResponse)(nil),           // 42: temporal.server.api.adminservice.v1.GetDLQReplicationMessagesResponse
+	(*ReapplyEventsRequest)(nil),                        // 43: temporal.server.api.adminservice.v1.ReapplyEventsRequest
+	(*ReapplyEventsResponse)(nil),                       // 44: temporal.server.api.adminservice.v1.ReapplyEventsResponse
+	(*AddSearchAttributesRequest)(nil),                  // 45: temporal.server.api.adminservice.v1.AddSearchAttributesRequest
+	(*AddSearchAttributesResponse)(nil),                 // 46: temporal.server.api.adminservice.v1.AddSearchAttributesResponse
+	(*RemoveSearchAttributesRequest)(nil),               // 47: temporal.server.api.adminservice.v1.RemoveSearchAttributesRequest
+	(*RemoveSearchAttributesResponse)(nil),              // 48: temporal.server.api.adminservice.v1.RemoveSearchAttributesResponse
+	(*GetSearchAttributesRequest)(nil),                  // 49: temporal.server.api.adminservice.v1.GetSearchAttributesRequest
+	(*GetSearchAttributesResponse)(nil),                 // 50: temporal.server.api.adminservice.v1.GetSearchAttributesResponse
+	(*AggregateWorkflowExecutionsRequest)(nil),          // 51: temporal.server.api.adminservice.v1.AggregateWorkflowExecutionsRequest
+	(*AggregateWorkflowExecutionsResponse)(nil),         // 52: temporal.server.api.adminservice.v1.AggregateWorkflowExecutionsResponse
+	(*VisibilityAggregation)(nil),                       // 53: temporal.server.api.adminservice.v1.VisibilityAggregation
+	(*VisibilityAggregationGroup)(nil),                  // 54: temporal.server.api.adminservice.v1.VisibilityAggregationGroup
+	(*VisibilityAggregationResult)(nil),                 // 55: temporal.server.api.adminservice.v1.VisibilityAggregationResult
+	(*UpsertSavedVisibilityQueryRequest)(nil),           // 56: temporal.server.api.adminservice.v1.UpsertSavedVisibilityQueryRequest
+	(*UpsertSavedVisibilityQueryResponse)(nil),          // 57: temporal.server.api.adminservice.v1.UpsertSavedVisibilityQueryResponse
+	(*DeleteSavedVisibilityQueryRequest)(nil),           // 58: temporal.server.api.adminservice.v1.DeleteSavedVisibilityQueryRequest
+	(*DeleteSavedVisibilityQueryResponse)(nil),          // 59: temporal.server.api.adminservice.v1.DeleteSavedVisibilityQueryResponse
+	(*ListSavedVisibilityQueriesRequest)(nil),           // 60: temporal.server.api.adminservice.v1.ListSavedVisibilityQueriesRequest
+	(*ListSavedVisibilityQueriesResponse)(nil),          // 61: temporal.server.api.adminservice.v1.ListSavedVisibilityQueriesResponse
+	(*DescribeClusterRequest)(nil),                      // 62: temporal.server.api.adminservice.v1.DescribeClusterRequest
+	(*DescribeClusterResponse)(nil),                     // 63: temporal.server.api.adminservice.v1.DescribeClusterResponse
+	(*ListClustersRequest)(nil),                         // 64: temporal.server.api.adminservice.v1.ListClustersRequest
+	(*ListClustersResponse)(nil),                        // 65: temporal.server.api.adminservice.v1.ListClustersResponse
+	(*AddOrUpdateRemoteClusterRequest)(nil),             // 66: temporal.server.api.adminservice.v1.AddOrUpdateRemoteClusterRequest
+	(*AddOrUpdateRemoteClusterResponse)(nil),            // 67: temporal.server.api.adminservice.v1.AddOrUpdateRemoteClusterResponse
+	(*RemoveRemoteClusterRequest)(nil),                  // 68: temporal.server.api.adminservice.v1.RemoveRemoteClusterRequest
+	(*RemoveRemoteClusterResponse)(nil),                 // 69: temporal.server.api.adminservice.v1.RemoveRemoteClusterResponse
+	(*ListClusterMembersRequest)(nil),                   // 70: temporal.server.api.adminservice.v1.ListClusterMembersRequest
+	(*ListClusterMembersResponse)(nil),                  // 71: temporal.server.api.adminservice.v1.ListClusterMembersResponse
+	(*GetDLQMessagesRequest)(nil),                       // 72: temporal.server.api.adminservice.v1.GetDLQMessagesRequest
+	(*GetDLQMessagesResponse)(nil),                      // 73: temporal.server.api.adminservice.v1.GetDLQMessagesResponse
+	(*PurgeDLQMessagesRequest)(nil),                     // 74: temporal.server.api.adminservice.v1.PurgeDLQMessagesRequest
+	(*PurgeDLQMessagesResponse)(nil),                    // 75: temporal.server.api.adminservice.v1.PurgeDLQMessagesResponse
+	(*MergeDLQMessagesRequest)(nil),                     // 76: temporal.server.api.adminservice.v1.MergeDLQMessagesRequest
+	(*MergeDLQMessagesResponse)(nil),                    // 77: temporal.server.api.adminservice.v1.MergeDLQMessagesResponse
+	(*RefreshWorkflowTasksRequest)(nil),                 // 78: temporal.server.api.adminservice.v1.RefreshWorkflowTasksRequest
+	(*RefreshWorkflowTasksResponse)(nil),                // 79: temporal.server.api.adminservice.v1.RefreshWorkflowTasksResponse
+	(*ResendReplicationTasksRequest)(nil),               // 80: temporal.server.api.adminservice.v1.ResendReplicationTasksRequest
+	(*ResendReplicationTasksResponse)(nil),              // 81: temporal.server.api.adminservice.v1.ResendReplicationTasksResponse
+	(*GetTaskQueueTasksRequest)(nil),                    // 82: temporal.server.api.adminservice.v1.GetTaskQueueTasksRequest
+	(*GetTaskQueueTasksResponse)(nil),                   // 83: temporal.server.api.adminservice.v1.GetTaskQueueTasksResponse
+	(*DeleteWorkflowExecutionRequest)(nil),              // 84: temporal.server.api.adminservice.v1.DeleteWorkflowExecutionRequest
+	(*DeleteWorkflowExecutionResponse)(nil),             // 85: temporal.server.api.adminservice.v1.DeleteWorkflowExecutionResponse
+	(*StreamWorkflowReplicationMessagesRequest)(nil),    // 86: temporal.server.api.adminservice.v1.StreamWorkflowReplicationMessagesRequest
+	(*StreamWorkflowReplicationMessagesResponse)(nil),   // 87: temporal.server.api.adminservice.v1.StreamWorkflowReplicationMessagesResponse
+	(*GetNamespaceRequest)(nil),                         // 88: temporal.server.api.adminservice.v1.GetNamespaceRequest
+	(*GetNamespaceResponse)(nil),                        // 89: temporal.server.api.adminservice.v1.GetNamespaceResponse
+	(*GetDLQTasksRequest)(nil),                          // 90: temporal.server.api.adminservice.v1.GetDLQTasksRequest
+	(*GetDLQTasksResponse)(nil),                         // 91: temporal.server.api.adminservice.v1.GetDLQTasksResponse
+	(*PurgeDLQTasksRequest)(nil),                        // 92: temporal.server.api.adminservice.v1.PurgeDLQTasksRequest
+	(*PurgeDLQTasksResponse)(nil),                       // 93: temporal.server.api.adminservice.v1.PurgeDLQTasksResponse
+	(*DLQJobToken)(nil),                                 // 94: temporal.server.api.adminservice.v1.DLQJobToken
+	(*MergeDLQTasksRequest)(nil),                        // 95: temporal.server.api.adminservice.v1.MergeDLQTasksRequest
+	(*MergeDLQTasksResponse)(nil),                       // 96: temporal.server.api.adminservice.v1.MergeDLQTasksResponse
+	(*DescribeDLQJobRequest)(nil),                       // 97: temporal.server.api.adminservice.v1.DescribeDLQJobRequest
+	(*DescribeDLQJobResponse)(nil),                      // 98: temporal.server.api.adminservice.v1.DescribeDLQJobResponse
+	(*CancelDLQJobRequest)(nil),                         // 99: temporal.server.api.adminservice.v1.CancelDLQJobRequest
+	(*CancelDLQJobResponse)(nil),                        // 100: temporal.server.api.adminservice.v1.CancelDLQJobResponse
+	(*AddTasksRequest)(nil),                             // 101: temporal.server.api.adminservice.v1.AddTasksRequest
+	(*AddTasksResponse)(nil),                            // 102: temporal.server.api.adminservice.v1.AddTasksResponse
+	(*ListQueuesRequest)(nil),                           // 103: temporal.server.api.adminservice.v1.ListQueuesRequest
+	(*ListQueuesResponse)(nil),                          // 104: temporal.server.api.adminservice.v1.ListQueuesResponse
+	(*DeepHealthCheckRequest)(nil),                      // 105: temporal.server.api.adminservice.v1.DeepHealthCheckRequest
+	(*DeepHealthCheckResponse)(nil),                     // 106: temporal.server.api.adminservice.v1.DeepHealthCheckResponse
+	(*SyncWorkflowStateRequest)(nil),                    // 107: temporal.server.api.adminservice.v1.SyncWorkflowStateRequest
+	(*SyncWorkflowStateResponse)(nil),                   // 108: temporal.server.api.adminservice.v1.SyncWorkflowStateResponse
+	(*GenerateLastHistoryReplicationTasksRequest)(nil),  // 109: temporal.server.api.adminservice.v1.GenerateLastHistoryReplicationTasksRequest
+	(*GenerateLastHistoryReplicationTasksResponse)(nil), // 110: temporal.server.api.adminservice.v1.GenerateLastHistoryReplicationTasksResponse
+	(*DescribeTaskQueuePartitionRequest)(nil),           // 111: temporal.server.api.adminservice.v1.DescribeTaskQueuePartitionRequest
+	(*InternalTaskQueueStatus)(nil),                     // 112: temporal.server.api.adminservice.v1.InternalTaskQueueStatus
+	(*DescribeTaskQueuePartitionResponse)(nil),          // 113: temporal.server.api.adminservice.v1.DescribeTaskQueuePartitionResponse
+	(*ForceUnloadTaskQueuePartitionRequest)(nil),        // 114: temporal.server.api.adminservice.v1.ForceUnloadTaskQueuePartitionRequest
+	(*ForceUnloadTaskQueuePartitionResponse)(nil),       // 115: temporal.server.api.adminservice.v1.ForceUnloadTaskQueuePartitionResponse
+	(*PauseTaskQueueDispatchRequest)(nil),               // 116: temporal.server.api.adminservice.v1.PauseTaskQueueDispatchRequest
+	(*PauseTaskQueueDispatchResponse)(nil),              // 117: temporal.server.api.adminservice.v1.PauseTaskQueueDispatchResponse
+	(*ResumeTaskQueueDispatchRequest)(nil),              // 118: temporal.server.api.adminservice.v1.ResumeTaskQueueDispatchRequest
+	(*ResumeTaskQueueDispatchResponse)(nil),             // 119: temporal.server.api.adminservice.v1.ResumeTaskQueueDispatchResponse
+	(*ListTaskQueueBacklogTasksRequest)(nil),            // 120: temporal.server.api.adminservice.v1.ListTaskQueueBacklogTasksRequest
+	(*ListTaskQueueBacklogTasksResponse)(nil),           // 121: temporal.server.api.adminservice.v1.ListTaskQueueBacklogTasksResponse
+	(*DeleteTaskQueueBacklogTasksRequest)(nil),          // 122: temporal.server.api.adminservice.v1.DeleteTaskQueueBacklogTasksRequest
+	(*DeleteTaskQueueBacklogTasksResponse)(nil),         // 123: temporal.server.api.adminservice.v1.DeleteTaskQueueBacklogTasksResponse
+	(*MoveTaskQueueBacklogTasksRequest)(nil),            // 124: temporal.server.api.adminservice.v1.MoveTaskQueueBacklogTasksRequest
+	(*MoveTaskQueueBacklogTasksResponse)(nil),           // 125: temporal.server.api.adminservice.v1.MoveTaskQueueBacklogTasksResponse
+	nil,                                       // 126: temporal.server.api.adminservice.v1.GetReplicationMessagesResponse.ShardMessagesEntry
+	nil,                                       // 127: temporal.server.api.adminservice.v1.AddSearchAttributesRequest.SearchAttributesEntry
+	nil,                                       // 128: temporal.server.api.adminservice.v1.GetSearchAttributesResponse.CustomAttributesEntry
+	nil,                                       // 129: temporal.server.api.adminservice.v1.GetSearchAttributesResponse.SystemAttributesEntry
+	nil,                                       // 130: temporal.server.api.adminservice.v1.GetSearchAttributesResponse.MappingEntry
+	nil,                                       // 131: temporal.server.api.adminservice.v1.DescribeClusterResponse.SupportedClientsEntry
+	nil,                                       // 132: temporal.server.api.adminservice.v1.DescribeClusterResponse.TagsEntry
+	(*AddTasksRequest_Task)(nil),              // 133: temporal.server.api.adminservice.v1.AddTasksRequest.Task
+	(*ListQueuesResponse_QueueInfo)(nil),      // 134: temporal.server.api.adminservice.v1.ListQueuesResponse.QueueInfo
+	nil,                                       // 135: temporal.server.api.adminservice.v1.DescribeTaskQueuePartitionResponse.VersionsInfoInternalEntry
+	nil,                                       // 136: temporal.server.api.adminservice.v1.PauseTaskQueueDispatchResponse.DispatchPauseEntry
+	nil,                                       // 137: temporal.server.api.adminservice.v1.ResumeTaskQueueDispatchResponse.DispatchPauseEntry
+	(*v1.WorkflowExecution)(nil),              // 138: temporal.api.common.v1.WorkflowExecution
+	(*v1.DataBlob)(nil),                       // 139: temporal.api.common.v1.DataBlob
+	(*v11.VersionHistory)(nil),                // 140: temporal.server.api.history.v1.VersionHistory
+	(v12.ResetReapplyExcludeType)(0),          // 141: temporal.api.enums.v1.ResetReapplyExcludeType
+	(v13.HistoryBudgetAction)(0),              // 142: temporal.server.api.enums.v1.HistoryBudgetAction
+	(*timestamppb.Timestamp)(nil),             // 143: google.protobuf.Timestamp
+	(v13.TimelineSpanType)(0),                 // 144: temporal.server.api.enums.v1.TimelineSpanType
+	(v12.EventType)(0),                        // 145: temporal.api.enums.v1.EventType
+	(*durationpb.Duration)(nil),               // 146: google.protobuf.Duration
+	(*v14.WorkflowMutableState)(nil),          // 147: temporal.server.api.persistence.v1.WorkflowMutableState
+	(*v15.NamespaceCacheInfo)(nil),            // 148: temporal.server.api.namespace.v1.NamespaceCacheInfo
+	(*v11.ShardLoad)(nil),                     // 149: temporal.server.api.history.v1.ShardLoad
+	(*v14.ShardInfo)(nil),                     // 150: temporal.server.api.persistence.v1.ShardInfo
+	(*v11.TaskRange)(nil),                     // 151: temporal.server.api.history.v1.TaskRange
+	(v13.TaskType)(0),                         // 152: temporal.server.api.enums.v1.TaskType
+	(*v16.ReplicationToken)(nil),              // 153: temporal.server.api.replication.v1.ReplicationToken
+	(*v16.ReplicationMessages)(nil),           // 154: temporal.server.api.replication.v1.ReplicationMessages
+	(*v16.ReplicationTaskInfo)(nil),           // 155: temporal.server.api.replication.v1.ReplicationTaskInfo
+	(*v16.ReplicationTask)(nil),               // 156: temporal.server.api.replication.v1.ReplicationTask
+	(*v17.WorkflowExecutionInfo)(nil),         // 157: temporal.api.workflow.v1.WorkflowExecutionInfo
+	(v13.VisibilityAggregationFunction)(0),    // 158: temporal.server.api.enums.v1.VisibilityAggregationFunction
+	(*v1.Payload)(nil),                        // 159: temporal.api.common.v1.Payload
+	(*v14.SavedVisibilityQuery)(nil),          // 160: temporal.server.api.persistence.v1.SavedVisibilityQuery
+	(*v18.MembershipInfo)(nil),                // 161: temporal.server.api.cluster.v1.MembershipInfo
+	(*v19.VersionInfo)(nil),                   // 162: temporal.api.version.v1.VersionInfo
+	(*v14.ClusterMetadata)(nil),               // 163: temporal.server.api.persistence.v1.ClusterMetadata
+	(v13.ClusterMemberRole)(0),                // 164: temporal.server.api.enums.v1.ClusterMemberRole
+	(*v18.ClusterMember)(nil),                 // 165: temporal.server.api.cluster.v1.ClusterMember
+	(v13.DeadLetterQueueType)(0),              // 166: temporal.server.api.enums.v1.DeadLetterQueueType
+	(v12.TaskQueueType)(0),                    // 167: temporal.api.enums.v1.TaskQueueType
+	(*v14.AllocatedTaskInfo)(nil),             // 168: temporal.server.api.persistence.v1.AllocatedTaskInfo
+	(*v16.SyncReplicationState)(nil),          // 169: temporal.server.api.replication.v1.SyncReplicationState
+	(*v16.WorkflowReplicationMessages)(nil),   // 170: temporal.server.api.replication.v1.WorkflowReplicationMessages
+	(*v110.NamespaceInfo)(nil),                // 171: temporal.api.namespace.v1.NamespaceInfo
+	(*v110.NamespaceConfig)(nil),              // 172: temporal.api.namespace.v1.NamespaceConfig
+	(*v111.NamespaceReplicationConfig)(nil),   // 173: temporal.api.replication.v1.NamespaceReplicationConfig
+	(*v111.FailoverStatus)(nil),               // 174: temporal.api.replication.v1.FailoverStatus
+	(*v112.HistoryDLQKey)(nil),                // 175: temporal.server.api.common.v1.HistoryDLQKey
+	(*v112.HistoryDLQTask)(nil),               // 176: temporal.server.api.common.v1.HistoryDLQTask
+	(*v112.HistoryDLQTaskMetadata)(nil),       // 177: temporal.server.api.common.v1.HistoryDLQTaskMetadata
+	(v13.DLQOperationType)(0),                 // 178: temporal.server.api.enums.v1.DLQOperationType
+	(v13.DLQOperationState)(0),                // 179: temporal.server.api.enums.v1.DLQOperationState
+	(v13.HealthState)(0),                      // 180: temporal.server.api.enums.v1.HealthState
+	(*v14.VersionedTransition)(nil),           // 181: temporal.server.api.persistence.v1.VersionedTransition
+	(*v11.VersionHistories)(nil),              // 182: temporal.server.api.history.v1.VersionHistories
+	(*v16.VersionedTransitionArtifact)(nil),   // 183: temporal.server.api.replication.v1.VersionedTransitionArtifact
+	(*v113.TaskQueuePartition)(nil),           // 184: temporal.server.api.taskqueue.v1.TaskQueuePartition
+	(*v114.TaskQueueVersionSelection)(nil),    // 185: temporal.api.taskqueue.v1.TaskQueueVersionSelection
+	(*v114.TaskIdBlock)(nil),                  // 186: temporal.api.taskqueue.v1.TaskIdBlock
+	(*v14.TaskQueueDispatchPause)(nil),        // 187: temporal.server.api.persistence.v1.TaskQueueDispatchPause
+	(*v113.BacklogTaskInfo)(nil),              // 188: temporal.server.api.taskqueue.v1.BacklogTaskInfo
+	(*v113.BacklogTaskKey)(nil),               // 189: temporal.server.api.taskqueue.v1.BacklogTaskKey
+	(v12.IndexedValueType)(0),                 // 190: temporal.api.enums.v1.IndexedValueType
+	(*v113.TaskQueueVersionInfoInternal)(nil), // 191: temporal.server.api.taskqueue.v1.TaskQueueVersionInfoInternal
 }
 var file_temporal_server_api_adminservice_v1_request_response_proto_depIdxs = []int32{
-	99,  // 0: temporal.server.api.adminservice.v1.RebuildMutableStateRequest.execution:type_name -> temporal.api.common.v1.WorkflowExecution
-	99,  // 1: temporal.server.api.adminservice.v1.ImportWorkflowExecutionRequest.execution:type_name -> temporal.api.common.v1.WorkflowExecution
-	100, // 2: temporal.server.api.adminservice.v1.ImportWorkflowExecutionRequest.history_batches:type_name -> temporal.api.common.v1.DataBlob
-	101, // 3: temporal.server.api.adminservice.v1.ImportWorkflowExecutionRequest.version_history:type_name -> temporal.server.api.history.v1.VersionHistory
-	99,  // 4: temporal.server.api.adminservice.v1.DescribeMutableStateRequest.execution:type_name -> temporal.api.common.v1.WorkflowExecution
-	102, // 5: temporal.server.api.adminservice.v1.DescribeMutableStateResponse.cache_mutable_state:type_name -> temporal.server.api.persistence.v1.WorkflowMutableState
-	102, // 6: temporal.server.api.adminservice.v1.DescribeMutableStateResponse.database_mutable_state:type_name -> temporal.server.api.persistence.v1.WorkflowMutableState
-	99,  // 7: temporal.server.api.adminservice.v1.DescribeHistoryHostRequest.workflow_execution:type_name -> temporal.api.common.v1.WorkflowExecution
-	103, // 8: temporal.server.api.adminservice.v1.DescribeHistoryHostResponse.namespace_cache:type_name -> temporal.server.api.namespace.v1.NamespaceCacheInfo
-	104, // 9: temporal.server.api.adminservice.v1.GetShardResponse.shard_info:type_name -> temporal.server.api.persistence.v1.ShardInfo
-	105, // 10: temporal.server.api.adminservice.v1.ListHistoryTasksRequest.task_range:type_name -> temporal.server.api.history.v1.TaskRange
-	14,  // 11: temporal.server.api.adminservice.v1.ListHistoryTasksResponse.tasks:type_name -> temporal.server.api.adminservice.v1.Task
-	106, // 12: temporal.server.api.adminservice.v1.Task.task_type:type_name -> temporal.server.api.enums.v1.TaskType
-	107, // 13: temporal.server.api.adminservice.v1.Task.fire_time:type_name -> google.protobuf.Timestamp
-	107, // 14: temporal.server.api.adminservice.v1.RemoveTaskRequest.visibility_time:type_name -> google.protobuf.Timestamp
-	99,  // 15: temporal.server.api.adminservice.v1.GetWorkflowExecutionRawHistoryV2Request.execution:type_name -> temporal.api.common.v1.WorkflowExecution
-	100, // 16: temporal.server.api.adminservice.v1.GetWorkflowExecutionRawHistoryV2Response.history_batches:type_name -> temporal.api.common.v1.DataBlob
-	101, // 17: temporal.server.api.adminservice.v1.GetWorkflowExecutionRawHistoryV2Response.version_history:type_name -> temporal.server.api.history.v1.VersionHistory
-	99,  // 18: temporal.server.api.adminservice.v1.GetWorkflowExecutionRawHistoryRequest.execution:type_name -> temporal.api.common.v1.WorkflowExecution
-	100, // 19: temporal.server.api.adminservice.v1.GetWorkflowExecutionRawHistoryResponse.history_batches:type_name -> temporal.api.common.v1.DataBlob
-	101, // 20: temporal.server.api.adminservice.v1.GetWorkflowExecutionRawHistoryResponse.version_history:type_name -> temporal.server.api.history.v1.VersionHistory
-	108, // 21: temporal.server.api.adminservice.v1.GetReplicationMessagesRequest.tokens:type_name -> temporal.server.api.replication.v1.ReplicationToken
-	89,  // 22: temporal.server.api.adminservice.v1.GetReplicationMessagesResponse.shard_messages:type_name -> temporal.server.api.adminservice.v1.GetReplicationMessagesResponse.ShardMessagesEntry
-	109, // 23: temporal.server.api.adminservice.v1.GetNamespaceReplicationMessagesResponse.messages:type_name -> temporal.server.api.replication.v1.ReplicationMessages
-	110, // 24: temporal.server.api.adminservice.v1.GetDLQReplicationMessagesRequest.task_infos:type_name -> temporal.server.api.replication.v1.ReplicationTaskInfo
-	111, // 25: temporal.server.api.adminservice.v1.GetDLQReplicationMessagesResponse.replication_tasks:type_name -> temporal.server.api.replication.v1.ReplicationTask
-	99,  // 26: temporal.server.api.adminservice.v1.ReapplyEventsRequest.workflow_execution:type_name -> temporal.api.common.v1.WorkflowExecution
-	100, // 27: temporal.server.api.adminservice.v1.ReapplyEventsRequest.events:type_name -> temporal.api.common.v1.DataBlob
-	90,  // 28: temporal.server.api.adminservice.v1.AddSearchAttributesRequest.search_attributes:type_name -> temporal.server.api.adminservice.v1.AddSearchAttributesRequest.SearchAttributesEntry
-	91,  // 29: temporal.server.api.adminservice.v1.GetSearchAttributesResponse.custom_attributes:type_name -> temporal.server.api.adminservice.v1.GetSearchAttributesResponse.CustomAttributesEntry
-	92,  // 30: temporal.server.api.adminservice.v1.GetSearchAttributesResponse.system_attributes:type_name -> temporal.server.api.adminservice.v1.GetSearchAttributesResponse.SystemAttributesEntry
-	93,  // 31: temporal.server.api.adminservice.v1.GetSearchAttributesResponse.mapping:type_name -> temporal.server.api.adminservice.v1.GetSearchAttributesResponse.MappingEntry
-	112, // 32: temporal.server.api.adminservice.v1.GetSearchAttributesResponse.add_workflow_execution_info:type_name -> temporal.api.workflow.v1.WorkflowExecutionInfo
-	94,  // 33: temporal.server.api.adminservice.v1.DescribeClusterResponse.supported_clients:type_name -> temporal.server.api.adminservice.v1.DescribeClusterResponse.SupportedClientsEntry
-	113, // 34: temporal.server.api.adminservice.v1.DescribeClusterResponse.membership_info:type_name -> temporal.server.api.cluster.v1.MembershipInfo
-	114, // 35: temporal.server.api.adminservice.v1.DescribeClusterResponse.version_info:type_name -> temporal.api.version.v1.VersionInfo
-	95,  // 36: temporal.server.api.adminservice.v1.DescribeClusterResponse.tags:type_name -> temporal.server.api.adminservice.v1.DescribeClusterResponse.TagsEntry
-	115, // 37: temporal.server.api.adminservice.v1.ListClustersResponse.clusters:type_name -> temporal.server.api.persistence.v1.ClusterMetadata
-	116, // 38: temporal.server.api.adminservice.v1.ListClusterMembersRequest.last_heartbeat_within:type_name -> google.protobuf.Duration
-	117, // 39: temporal.server.api.adminservice.v1.ListClusterMembersRequest.role:type_name -> temporal.server.api.enums.v1.ClusterMemberRole
-	107, // 40: temporal.server.api.adminservice.v1.ListClusterMembersRequest.session_started_after_time:type_name -> google.protobuf.Timestamp
-	118, // 41: temporal.server.api.adminservice.v1.ListClusterMembersResponse.active_members:type_name -> temporal.server.api.cluster.v1.ClusterMember
-	119, // 42: temporal.server.api.adminservice.v1.GetDLQMessagesRequest.type:type_name -> temporal.server.api.enums.v1.DeadLetterQueueType
-	119, // 43: temporal.server.api.adminservice.v1.GetDLQMessagesResponse.type:type_name -> temporal.server.api.enums.v1.DeadLetterQueueType
-	111, // 44: temporal.server.api.adminservice.v1.GetDLQMessagesResponse.replication_tasks:type_name -> temporal.server.api.replication.v1.ReplicationTask
-	110, // 45: temporal.server.api.adminservice.v1.GetDLQMessagesResponse.replication_tasks_info:type_name -> temporal.server.api.replication.v1.ReplicationTaskInfo
-	119, // 46: temporal.server.api.adminservice.v1.PurgeDLQMessagesRequest.type:type_name -> temporal.server.api.enums.v1.DeadLetterQueueType
-	119, // 47: temporal.server.api.adminservice.v1.MergeDLQMessagesRequest.type:type_name -> temporal.server.api.enums.v1.DeadLetterQueueType
-	99,  // 48: temporal.server.api.adminservice.v1.RefreshWorkflowTasksRequest.execution:type_name -> temporal.api.common.v1.WorkflowExecution
-	120, // 49: temporal.server.api.adminservice.v1.GetTaskQueueTasksRequest.task_queue_type:type_name -> temporal.api.enums.v1.TaskQueueType
-	121, // 50: temporal.server.api.adminservice.v1.GetTaskQueueTasksResponse.tasks:type_name -> temporal.server.api.persistence.v1.AllocatedTaskInfo
-	99,  // 51: temporal.server.api.adminservice.v1.DeleteWorkflowExecutionRequest.execution:type_name -> temporal.api.common.v1.WorkflowExecution
-	122, // 52: temporal.server.api.adminservice.v1.StreamWorkflowReplicationMessagesRequest.sync_replication_state:type_name -> temporal.server.api.replication.v1.SyncReplicationState
-	123, // 53: temporal.server.api.adminservice.v1.StreamWorkflowReplicationMessagesResponse.messages:type_name -> temporal.server.api.replication.v1.WorkflowReplicationMessages
-	124, // 54: temporal.server.api.adminservice.v1.GetNamespaceResponse.info:type_name -> temporal.api.namespace.v1.NamespaceInfo
-	125, // 55: temporal.server.api.adminservice.v1.GetNamespaceResponse.config:type_name -> temporal.api.namespace.v1.NamespaceConfig
-	126, // 56: temporal.server.api.adminservice.v1.GetNamespaceResponse.replication_config:type_name -> temporal.api.replication.v1.NamespaceReplicationConfig
-	127, // 57: temporal.server.api.adminservice.v1.GetNamespaceResponse.failover_history:type_name -> temporal.api.replication.v1.FailoverStatus
-	128, // 58: temporal.server.api.adminservice.v1.GetDLQTasksRequest.dlq_key:type_name -> temporal.server.api.common.v1.HistoryDLQKey
-	129, // 59: temporal.server.api.adminservice.v1.GetDLQTasksResponse.dlq_tasks:type_name -> temporal.server.api.common.v1.HistoryDLQTask
-	128, // 60: temporal.server.api.adminservice.v1.PurgeDLQTasksRequest.dlq_key:type_name -> temporal.server.api.common.v1.HistoryDLQKey
-	130, // 61: temporal.server.api.adminservice.v1.PurgeDLQTasksRequest.inclusive_max_task_metadata:type_name -> temporal.server.api.common.v1.HistoryDLQTaskMetadata
-	128, // 62: temporal.server.api.adminservice.v1.MergeDLQTasksRequest.dlq_key:type_name -> temporal.server.api.common.v1.HistoryDLQKey
-	130, // 63: temporal.server.api.adminservice.v1.MergeDLQTasksRequest.inclusive_max_task_metadata:type_name -> temporal.server.api.common.v1.HistoryDLQTaskMetadata
-	128, // 64: temporal.server.api.adminservice.v1.DescribeDLQJobResponse.dlq_key:type_name -> temporal.server.api.common.v1.HistoryDLQKey
-	131, // 65: temporal.server.api.adminservice.v1.DescribeDLQJobResponse.operation_type:type_name -> temporal.server.api.enums.v1.DLQOperationType
-	132, // 66: temporal.server.api.adminservice.v1.DescribeDLQJobResponse.operation_state:type_name -> temporal.server.api.enums.v1.DLQOperationState
-	107, // 67: temporal.server.api.adminservice.v1.DescribeDLQJobResponse.start_time:type_name -> google.protobuf.Timestamp
-	107, // 68: temporal.server.api.adminservice.v1.DescribeDLQJobResponse.end_time:type_name -> google.protobuf.Timestamp
-	96,  // 69: temporal.server.api.adminservice.v1.AddTasksRequest.tasks:type_name -> temporal.server.api.adminservice.v1.AddTasksRequest.Task
-	97,  // 70: temporal.server.api.adminservice.v1.ListQueuesResponse.queues:type_name -> temporal.server.api.adminservice.v1.ListQueuesResponse.QueueInfo
-	133, // 71: temporal.server.api.adminservice.v1.DeepHealthCheckResponse.state:type_name -> temporal.server.api.enums.v1.HealthState
-	99,  // 72: temporal.server.api.adminservice.v1.SyncWorkflowStateRequest.execution:type_name -> temporal.api.common.v1.WorkflowExecution
-	134, // 73: temporal.server.api.adminservice.v1.SyncWorkflowStateRequest.versioned_transition:type_name -> temporal.server.api.persistence.v1.VersionedTransition
-	135, // 74: temporal.server.api.adminservice.v1.SyncWorkflowStateRequest.version_histories:type_name -> temporal.server.api.history.v1.VersionHistories
-	136, // 75: temporal.server.api.adminservice.v1.SyncWorkflowStateResponse.versioned_transition_artifact:type_name -> temporal.server.api.replication.v1.VersionedTransitionArtifact
-	99,  // 76: temporal.server.api.adminservice.v1.GenerateLastHistoryReplicationTasksRequest.execution:type_name -> temporal.api.common.v1.WorkflowExecution
-	137, // 77: temporal.server.api.adminservice.v1.DescribeTaskQueuePartitionRequest.task_queue_partition:type_name -> temporal.server.api.taskqueue.v1.TaskQueuePartition
-	138, // 78: temporal.server.api.adminservice.v1.DescribeTaskQueuePartitionRequest.build_ids:type_name -> temporal.api.taskqueue.v1.TaskQueueVersionSelection
-	139, // 79: temporal.server.api.adminservice.v1.InternalTaskQueueStatus.task_id_block:type_name -> temporal.api.taskqueue.v1.TaskIdBlock
-	98,  // 80: temporal.server.api.adminservice.v1.DescribeTaskQueuePartitionResponse.versions_info_internal:type_name -> temporal.server.api.adminservice.v1.DescribeTaskQueuePartitionResponse.VersionsInfoInternalEntry
-	137, // 81: temporal.server.api.adminservice.v1.ForceUnloadTaskQueuePartitionRequest.task_queue_partition:type_name -> temporal.server.api.taskqueue.v1.TaskQueuePartition
-	109, // 82: temporal.server.api.adminservice.v1.GetReplicationMessagesResponse.ShardMessagesEntry.value:type_name -> temporal.server.api.replication.v1.ReplicationMessages
-	140, // 83: temporal.server.api.adminservice.v1.AddSearchAttributesRequest.SearchAttributesEntry.value:type_name -> temporal.api.enums.v1.IndexedValueType
-	140, // 84: temporal.server.api.adminservice.v1.GetSearchAttributesResponse.CustomAttributesEntry.value:type_name -> temporal.api.enums.v1.IndexedValueType
-	140, // 85: temporal.server.api.adminservice.v1.GetSearchAttributesResponse.SystemAttributesEntry.value:type_name -> temporal.api.enums.v1.IndexedValueType
-	100, // 86: temporal.server.api.adminservice.v1.AddTasksRequest.Task.blob:type_name -> temporal.api.common.v1.DataBlob
-	141, // 87: temporal.server.api.adminservice.v1.DescribeTaskQueuePartitionResponse.VersionsInfoInternalEntry.value:type_name -> temporal.server.api.taskqueue.v1.TaskQueueVersionInfoInternal
-	88,  // [88:88] is the sub-list for method output_type
-	88,  // [88:88] is the sub-list for method input_type
-	88,  // [88:88] is the sub-list for extension type_name
-	88,  // [88:88] is the sub-list for extension extendee
-	0,   // [0:88] is the sub-list for field type_name
+	138, // 0: temporal.server.api.adminservice.v1.RebuildMutableStateRequest.execution:type_name -> temporal.api.common.v1.WorkflowExecution
+	138, // 1: temporal.server.api.adminservice.v1.CheckMutableStateRequest.execution:type_name -> temporal.api.common.v1.WorkflowExecution
+	4,   // 2: temporal.server.api.adminservice.v1.CheckMutableStateResponse.inconsistencies:type_name -> temporal.server.api.adminservice.v1.MutableStateInconsistency
+	138, // 3: temporal.server.api.adminservice.v1.ImportWorkflowExecutionRequest.execution:type_name -> temporal.api.common.v1.WorkflowExecution
+	139, // 4: temporal.server.api.adminservice.v1.ImportWorkflowExecutionRequest.history_batches:type_name -> temporal.api.common.v1.DataBlob
+	140, // 5: temporal.server.api.adminservice.v1.ImportWorkflowExecutionRequest.version_history:type_name -> temporal.server.api.history.v1.VersionHistory
+	138, // 6: temporal.server.api.adminservice.v1.ForkWorkflowExecutionRequest.execution:type_name -> temporal.api.common.v1.WorkflowExecution
+	141, // 7: temporal.server.api.adminservice.v1.ForkWorkflowExecutionRequest.reset_reapply_exclude_types:type_name -> temporal.api.enums.v1.ResetReapplyExcludeType
+	138, // 8: temporal.server.api.adminservice.v1.RedactWorkflowExecutionRequest.execution:type_name -> temporal.api.common.v1.WorkflowExecution
+	138, // 9: temporal.server.api.adminservice.v1.GetWorkflowHistoryBudgetRequest.execution:type_name -> temporal.api.common.v1.WorkflowExecution
+	13,  // 10: temporal.server.api.adminservice.v1.GetWorkflowHistoryBudgetResponse.usage:type_name -> temporal.server.api.adminservice.v1.WorkflowHistoryBudgetUsage
+	142, // 11: temporal.server.api.adminservice.v1.WorkflowHistoryBudgetUsage.actions:type_name -> temporal.server.api.enums.v1.HistoryBudgetAction
+	138, // 12: temporal.server.api.adminservice.v1.GetWorkflowExecutionTimelineRequest.execution:type_name -> temporal.api.common.v1.WorkflowExecution
+	16,  // 13: temporal.server.api.adminservice.v1.GetWorkflowExecutionTimelineResponse.timeline:type_name -> temporal.server.api.adminservice.v1.WorkflowExecutionTimeline
+	143, // 14: temporal.server.api.adminservice.v1.WorkflowExecutionTimeline.start_time:type_name -> google.protobuf.Timestamp
+	143, // 15: temporal.server.api.adminservice.v1.WorkflowExecutionTimeline.end_time:type_name -> google.protobuf.Timestamp
+	17,  // 16: temporal.server.api.adminservice.v1.WorkflowExecutionTimeline.spans:type_name -> temporal.server.api.adminservice.v1.TimelineSpan
+	144, // 17: temporal.server.api.adminservice.v1.TimelineSpan.type:type_name -> temporal.server.api.enums.v1.TimelineSpanType
+	145, // 18: temporal.server.api.adminservice.v1.TimelineSpan.end_event_type:type_name -> temporal.api.enums.v1.EventType
+	143, // 19: temporal.server.api.adminservice.v1.TimelineSpan.schedule_time:type_name -> google.protobuf.Timestamp
+	143, // 20: temporal.server.api.adminservice.v1.TimelineSpan.start_time:type_name -> google.protobuf.Timestamp
+	143, // 21: temporal.server.api.adminservice.v1.TimelineSpan.end_time:type_name -> google.protobuf.Timestamp
+	146, // 22: temporal.server.api.adminservice.v1.TimelineSpan.schedule_to_start_latency:type_name -> google.protobuf.Duration
+	146, // 23: temporal.server.api.adminservice.v1.TimelineSpan.start_to_close_latency:type_name -> google.protobuf.Duration
+	138, // 24: temporal.server.api.adminservice.v1.DescribeMutableStateRequest.execution:type_name -> temporal.api.common.v1.WorkflowExecution
+	147, // 25: temporal.server.api.adminservice.v1.DescribeMutableStateResponse.cache_mutable_state:type_name -> temporal.server.api.persistence.v1.WorkflowMutableState
+	147, // 26: temporal.server.api.adminservice.v1.DescribeMutableStateResponse.database_mutable_state:type_name -> temporal.server.api.persistence.v1.WorkflowMutableState
+	138, // 27: temporal.server.api.adminservice.v1.DescribeHistoryHostRequest.workflow_execution:type_name -> temporal.api.common.v1.WorkflowExecution
+	148, // 28: temporal.server.api.adminservice.v1.DescribeHistoryHostResponse.namespace_cache:type_name -> temporal.server.api.namespace.v1.NamespaceCacheInfo
+	149, // 29: temporal.server.api.adminservice.v1.DescribeHistoryHostResponse.shard_loads:type_name -> temporal.server.api.history.v1.ShardLoad
+	150, // 30: temporal.server.api.adminservice.v1.GetShardResponse.shard_info:type_name -> temporal.server.api.persistence.v1.ShardInfo
+	151, // 31: temporal.server.api.adminservice.v1.ListHistoryTasksRequest.task_range:type_name -> temporal.server.api.history.v1.TaskRange
+	30,  // 32: temporal.server.api.adminservice.v1.ListHistoryTasksResponse.tasks:type_name -> temporal.server.api.adminservice.v1.Task
+	152, // 33: temporal.server.api.adminservice.v1.Task.task_type:type_name -> temporal.server.api.enums.v1.TaskType
+	143, // 34: temporal.server.api.adminservice.v1.Task.fire_time:type_name -> google.protobuf.Timestamp
+	143, // 35: temporal.server.api.adminservice.v1.RemoveTaskRequest.visibility_time:type_name -> google.protobuf.Timestamp
+	138, // 36: temporal.server.api.adminservice.v1.GetWorkflowExecutionRawHistoryV2Request.execution:type_name -> temporal.api.common.v1.WorkflowExecution
+	139, // 37: temporal.server.api.adminservice.v1.GetWorkflowExecutionRawHistoryV2Response.history_batches:type_name -> temporal.api.common.v1.DataBlob
+	140, // 38: temporal.server.api.adminservice.v1.GetWorkflowExecutionRawHistoryV2Response.version_history:type_name -> temporal.server.api.history.v1.VersionHistory
+	138, // 39: temporal.server.api.adminservice.v1.GetWorkflowExecutionRawHistoryRequest.execution:type_name -> temporal.api.common.v1.WorkflowExecution
+	139, // 40: temporal.server.api.adminservice.v1.GetWorkflowExecutionRawHistoryResponse.history_batches:type_name -> temporal.api.common.v1.DataBlob
+	140, // 41: temporal.server.api.adminservice.v1.GetWorkflowExecutionRawHistoryResponse.version_history:type_name -> temporal.server.api.history.v1.VersionHistory
+	153, // 42: temporal.server.api.adminservice.v1.GetReplicationMessagesRequest.tokens:type_name -> temporal.server.api.replication.v1.ReplicationToken
+	126, // 43: temporal.server.api.adminservice.v1.GetReplicationMessagesResponse.shard_messages:type_name -> temporal.server.api.adminservice.v1.GetReplicationMessagesResponse.ShardMessagesEntry
+	154, // 44: temporal.server.api.adminservice.v1.GetNamespaceReplicationMessagesResponse.messages:type_name -> temporal.server.api.replication.v1.ReplicationMessages
+	155, // 45: temporal.server.api.adminservice.v1.GetDLQReplicationMessagesRequest.task_infos:type_name -> temporal.server.api.replication.v1.ReplicationTaskInfo
+	156, // 46: temporal.server.api.adminservice.v1.GetDLQReplicationMessagesResponse.replication_tasks:type_name -> temporal.server.api.replication.v1.ReplicationTask
+	138, // 47: temporal.server.api.adminservice.v1.ReapplyEventsRequest.workflow_execution:type_name -> temporal.api.common.v1.WorkflowExecution
+	139, // 48: temporal.server.api.adminservice.v1.ReapplyEventsRequest.events:type_name -> temporal.api.common.v1.DataBlob
+	127, // 49: temporal.server.api.adminservice.v1.AddSearchAttributesRequest.search_attributes:type_name -> temporal.server.api.adminservice.v1.AddSearchAttributesRequest.SearchAttributesEntry
+	128, // 50: temporal.server.api.adminservice.v1.GetSearchAttributesResponse.custom_attributes:type_name -> temporal.server.api.adminservice.v1.GetSearchAttributesResponse.CustomAttributesEntry
+	129, // 51: temporal.server.api.adminservice.v1.GetSearchAttributesResponse.system_attributes:type_name -> temporal.server.api.adminservice.v1.GetSearchAttributesResponse.SystemAttributesEntry
+	130, // 52: temporal.server.api.adminservice.v1.GetSearchAttributesResponse.mapping:type_name -> temporal.server.api.adminservice.v1.GetSearchAttributesResponse.MappingEntry
+	157, // 53: temporal.server.api.adminservice.v1.GetSearchAttributesResponse.add_workflow_execution_info:type_name -> temporal.api.workflow.v1.WorkflowExecutionInfo
+	53,  // 54: temporal.server.api.adminservice.v1.AggregateWorkflowExecutionsRequest.aggregations:type_name -> temporal.server.api.adminservice.v1.VisibilityAggregation
+	54,  // 55: temporal.server.api.adminservice.v1.AggregateWorkflowExecutionsResponse.groups:type_name -> temporal.server.api.adminservice.v1.VisibilityAggregationGroup
+	158, // 56: temporal.server.api.adminservice.v1.VisibilityAggregation.function:type_name -> temporal.server.api.enums.v1.VisibilityAggregationFunction
+	159, // 57: temporal.server.api.adminservice.v1.VisibilityAggregationGroup.group_values:type_name -> temporal.api.common.v1.Payload
+	55,  // 58: temporal.server.api.adminservice.v1.VisibilityAggregationGroup.results:type_name -> temporal.server.api.adminservice.v1.VisibilityAggregationResult
+	160, // 59: temporal.server.api.adminservice.v1.UpsertSavedVisibilityQueryResponse.saved_query:type_name -> temporal.server.api.persistence.v1.SavedVisibilityQuery
+	160, // 60: temporal.server.api.adminservice.v1.ListSavedVisibilityQueriesResponse.saved_queries:type_name -> temporal.server.api.persistence.v1.SavedVisibilityQuery
+	131, // 61: temporal.server.api.adminservice.v1.DescribeClusterResponse.supported_clients:type_name -> temporal.server.api.adminservice.v1.DescribeClusterResponse.SupportedClientsEntry
+	161, // 62: temporal.server.api.adminservice.v1.DescribeClusterResponse.membership_info:type_name -> temporal.server.api.cluster.v1.MembershipInfo
+	162, // 63: temporal.server.api.adminservice.v1.DescribeClusterResponse.version_info:type_name -> temporal.api.version.v1.VersionInfo
+	132, // 64: temporal.server.api.adminservice.v1.DescribeClusterResponse.tags:type_name -> temporal.server.api.adminservice.v1.DescribeClusterResponse.TagsEntry
+	163, // 65: temporal.server.api.adminservice.v1.ListClustersResponse.clusters:type_name -> temporal.server.api.persistence.v1.ClusterMetadata
+	146, // 66: temporal.server.api.adminservice.v1.ListClusterMembersRequest.last_heartbeat_within:type_name -> google.protobuf.Duration
+	164, // 67: temporal.server.api.adminservice.v1.ListClusterMembersRequest.role:type_name -> temporal.server.api.enums.v1.ClusterMemberRole
+	143, // 68: temporal.server.api.adminservice.v1.ListClusterMembersRequest.session_started_after_time:type_name -> google.protobuf.Timestamp
+	165, // 69: temporal.server.api.adminservice.v1.ListClusterMembersResponse.active_members:type_name -> temporal.server.api.cluster.v1.ClusterMember
+	166, // 70: temporal.server.api.adminservice.v1.GetDLQMessagesRequest.type:type_name -> temporal.server.api.enums.v1.DeadLetterQueueType
+	166, // 71: temporal.server.api.adminservice.v1.GetDLQMessagesResponse.type:type_name -> temporal.server.api.enums.v1.DeadLetterQueueType
+	156, // 72: temporal.server.api.adminservice.v1.GetDLQMessagesResponse.replication_tasks:type_name -> temporal.server.api.replication.v1.ReplicationTask
+	155, // 73: temporal.server.api.adminservice.v1.GetDLQMessagesResponse.replication_tasks_info:type_name -> temporal.server.api.replication.v1.ReplicationTaskInfo
+	166, // 74: temporal.server.api.adminservice.v1.PurgeDLQMessagesRequest.type:type_name -> temporal.server.api.enums.v1.DeadLetterQueueType
+	166, // 75: temporal.server.api.adminservice.v1.MergeDLQMessagesRequest.type:type_name -> temporal.server.api.enums.v1.DeadLetterQueueType
+	138, // 76: temporal.server.api.adminservice.v1.RefreshWorkflowTasksRequest.execution:type_name -> temporal.api.common.v1.WorkflowExecution
+	167, // 77: temporal.server.api.adminservice.v1.GetTaskQueueTasksRequest.task_queue_type:type_name -> temporal.api.enums.v1.TaskQueueType
+	168, // 78: temporal.server.api.adminservice.v1.GetTaskQueueTasksResponse.tasks:type_name -> temporal.server.api.persistence.v1.AllocatedTaskInfo
+	138, // 79: temporal.server.api.adminservice.v1.DeleteWorkflowExecutionRequest.execution:type_name -> temporal.api.common.v1.WorkflowExecution
+	169, // 80: temporal.server.api.adminservice.v1.StreamWorkflowReplicationMessagesRequest.sync_replication_state:type_name -> temporal.server.api.replication.v1.SyncReplicationState
+	170, // 81: temporal.server.api.adminservice.v1.StreamWorkflowReplicationMessagesResponse.messages:type_name -> temporal.server.api.replication.v1.WorkflowReplicationMessages
+	171, // 82: temporal.server.api.adminservice.v1.GetNamespaceResponse.info:type_name -> temporal.api.namespace.v1.NamespaceInfo
+	172, // 83: temporal.server.api.adminservice.v1.GetNamespaceResponse.config:type_name -> temporal.api.namespace.v1.NamespaceConfig
+	173, // 84: temporal.server.api.adminservice.v1.GetNamespaceResponse.replication_config:type_name -> temporal.api.replication.v1.NamespaceReplicationConfig
+	174, // 85: temporal.server.api.adminservice.v1.GetNamespaceResponse.failover_history:type_name -> temporal.api.replication.v1.FailoverStatus
+	175, // 86: temporal.server.api.adminservice.v1.GetDLQTasksRequest.dlq_key:type_name -> temporal.server.api.common.v1.HistoryDLQKey
+	176, // 87: temporal.server.api.adminservice.v1.GetDLQTasksResponse.dlq_tasks:type_name -> temporal.server.api.common.v1.HistoryDLQTask
+	175, // 88: temporal.server.api.adminservice.v1.PurgeDLQTasksRequest.dlq_key:type_name -> temporal.server.api.common.v1.HistoryDLQKey
+	177, // 89: temporal.server.api.adminservice.v1.PurgeDLQTasksRequest.inclusive_max_task_metadata:type_name -> temporal.server.api.common.v1.HistoryDLQTaskMetadata
+	175, // 90: temporal.server.api.adminservice.v1.MergeDLQTasksRequest.dlq_key:type_name -> temporal.server.api.common.v1.HistoryDLQKey
+	177, // 91: temporal.server.api.adminservice.v1.MergeDLQTasksRequest.inclusive_max_task_metadata:type_name -> temporal.server.api.common.v1.HistoryDLQTaskMetadata
+	175, // 92: temporal.server.api.adminservice.v1.DescribeDLQJobResponse.dlq_key:type_name -> temporal.server.api.common.v1.HistoryDLQKey
+	178, // 93: temporal.server.api.adminservice.v1.DescribeDLQJobResponse.operation_type:type_name -> temporal.server.api.enums.v1.DLQOperationType
+	179, // 94: temporal.server.api.adminservice.v1.DescribeDLQJobResponse.operation_state:type_name -> temporal.server.api.enums.v1.DLQOperationState
+	143, // 95: temporal.server.api.adminservice.v1.DescribeDLQJobResponse.start_time:type_name -> google.protobuf.Timestamp
+	143, // 96: temporal.server.api.adminservice.v1.DescribeDLQJobResponse.end_time:type_name -> google.protobuf.Timestamp
+	133, // 97: temporal.server.api.adminservice.v1.AddTasksRequest.tasks:type_name -> temporal.server.api.adminservice.v1.AddTasksRequest.Task
+	134, // 98: temporal.server.api.adminservice.v1.ListQueuesResponse.queues:type_name -> temporal.server.api.adminservice.v1.ListQueuesResponse.QueueInfo
+	180, // 99: temporal.server.api.adminservice.v1.DeepHealthCheckResponse.state:type_name -> temporal.server.api.enums.v1.HealthState
+	138, // 100: temporal.server.api.adminservice.v1.SyncWorkflowStateRequest.execution:type_name -> temporal.api.common.v1.WorkflowExecution
+	181, // 101: temporal.server.api.adminservice.v1.SyncWorkflowStateRequest.versioned_transition:type_name -> temporal.server.api.persistence.v1.VersionedTransition
+	182, // 102: temporal.server.api.adminservice.v1.SyncWorkflowStateRequest.version_histories:type_name -> temporal.server.api.history.v1.VersionHistories
+	183, // 103: temporal.server.api.adminservice.v1.SyncWorkflowStateResponse.versioned_transition_artifact:type_name -> temporal.server.api.replication.v1.VersionedTransitionArtifact
+	138, // 104: temporal.server.api.adminservice.v1.GenerateLastHistoryReplicationTasksRequest.execution:type_name -> temporal.api.common.v1.WorkflowExecution
+	184, // 105: temporal.server.api.adminservice.v1.DescribeTaskQueuePartitionRequest.task_queue_partition:type_name -> temporal.server.api.taskqueue.v1.TaskQueuePartition
+	185, // 106: temporal.server.api.adminservice.v1.DescribeTaskQueuePartitionRequest.build_ids:type_name -> temporal.api.taskqueue.v1.TaskQueueVersionSelection
+	186, // 107: temporal.server.api.adminservice.v1.InternalTaskQueueStatus.task_id_block:type_name -> temporal.api.taskqueue.v1.TaskIdBlock
+	135, // 108: temporal.server.api.adminservice.v1.DescribeTaskQueuePartitionResponse.versions_info_internal:type_name -> temporal.server.api.adminservice.v1.DescribeTaskQueuePartitionResponse.VersionsInfoInternalEntry
+	187, // 109: temporal.server.api.adminservice.v1.DescribeTaskQueuePartitionResponse.dispatch_pause:type_name -> temporal.server.api.persistence.v1.TaskQueueDispatchPause
+	184, // 110: temporal.server.api.adminservice.v1.ForceUnloadTaskQueuePartitionRequest.task_queue_partition:type_name -> temporal.server.api.taskqueue.v1.TaskQueuePartition
+	167, // 111: temporal.server.api.adminservice.v1.PauseTaskQueueDispatchRequest.task_queue_types:type_name -> temporal.api.enums.v1.TaskQueueType
+	136, // 112: temporal.server.api.adminservice.v1.PauseTaskQueueDispatchResponse.dispatch_pause:type_name -> temporal.server.api.adminservice.v1.PauseTaskQueueDispatchResponse.DispatchPauseEntry
+	167, // 113: temporal.server.api.adminservice.v1.ResumeTaskQueueDispatchRequest.task_queue_types:type_name -> temporal.api.enums.v1.TaskQueueType
+	137, // 114: temporal.server.api.adminservice.v1.ResumeTaskQueueDispatchResponse.dispatch_pause:type_name -> temporal.server.api.adminservice.v1.ResumeTaskQueueDispatchResponse.DispatchPauseEntry
+	184, // 115: temporal.server.api.adminservice.v1.ListTaskQueueBacklogTasksRequest.task_queue_partition:type_name -> temporal.server.api.taskqueue.v1.TaskQueuePartition
+	188, // 116: temporal.server.api.adminservice.v1.ListTaskQueueBacklogTasksResponse.tasks:type_name -> temporal.server.api.taskqueue.v1.BacklogTaskInfo
+	184, // 117: temporal.server.api.adminservice.v1.DeleteTaskQueueBacklogTasksRequest.task_queue_partition:type_name -> temporal.server.api.taskqueue.v1.TaskQueuePartition
+	189, // 118: temporal.server.api.adminservice.v1.DeleteTaskQueueBacklogTasksRequest.tasks:type_name -> temporal.server.api.taskqueue.v1.BacklogTaskKey
+	184, // 119: temporal.server.api.adminservice.v1.MoveTaskQueueBacklogTasksRequest.task_queue_partition:type_name -> temporal.server.api.taskqueue.v1.TaskQueuePartition
+	188, // 120: temporal.server.api.adminservice.v1.MoveTaskQueueBacklogTasksResponse.moved_tasks:type_name -> temporal.server.api.taskqueue.v1.BacklogTaskInfo
+	154, // 121: temporal.server.api.adminservice.v1.GetReplicationMessagesResponse.ShardMessagesEntry.value:type_name -> temporal.server.api.replication.v1.ReplicationMessages
+	190, // 122: temporal.server.api.adminservice.v1.AddSearchAttributesRequest.SearchAttributesEntry.value:type_name -> temporal.api.enums.v1.IndexedValueType
+	190, // 123: temporal.server.api.adminservice.v1.GetSearchAttributesResponse.CustomAttributesEntry.value:type_name -> temporal.api.enums.v1.IndexedValueType
+	190, // 124: temporal.server.api.adminservice.v1.GetSearchAttributesResponse.SystemAttributesEntry.value:type_name -> temporal.api.enums.v1.IndexedValueType
+	139, // 125: temporal.server.api.adminservice.v1.AddTasksRequest.Task.blob:type_name -> temporal.api.common.v1.DataBlob
+	191, // 126: temporal.server.api.adminservice.v1.DescribeTaskQueuePartitionResponse.VersionsInfoInternalEntry.value:type_name -> temporal.server.api.taskqueue.v1.TaskQueueVersionInfoInternal
+	187, // 127: temporal.server.api.adminservice.v1.PauseTaskQueueDispatchResponse.DispatchPauseEntry.value:type_name -> temporal.server.api.persistence.v1.TaskQueueDispatchPause
+	187, // 128: temporal.server.api.adminservice.v1.ResumeTaskQueueDispatchResponse.DispatchPauseEntry.value:type_name -> temporal.server.api.persistence.v1.TaskQueueDispatchPause
+	129, // [129:129] is the sub-list for method output_type
+	129, // [129:129] is the sub-list for method input_type
+	129, // [129:129] is the sub-list for extension type_name
+	129, // [129:129] is the sub-list for extension extendee
+	0,   // [0:129] is the sub-list for field type_name
 }
 
 func init() { file_temporal_server_api_adminservice_v1_request_response_proto_init() }
@@ -6021,13 +8547,13 @@ func file_temporal_server_api_adminservice_v1_request_response_proto_init() {
 	if File_temporal_server_api_adminservice_v1_request_response_proto != nil {
 		return
 	}
-	file_temporal_server_api_adminservice_v1_request_response_proto_msgTypes[59].OneofWrappers = []any{
+	file_temporal_server_api_adminservice_v1_request_response_proto_msgTypes[86].OneofWrappers = []any{
 		(*StreamWorkflowReplicationMessagesRequest_SyncReplicationState)(nil),
 	}
-	file_temporal_server_api_adminservice_v1_request_response_proto_msgTypes[60].OneofWrappers = []any{
+	file_temporal_server_api_adminservice_v1_request_response_proto_msgTypes[87].OneofWrappers = []any{
 		(*StreamWorkflowReplicationMessagesResponse_Messages)(nil),
 	}
-	file_temporal_server_api_adminservice_v1_request_response_proto_msgTypes[61].OneofWrappers = []any{
+	file_temporal_server_api_adminservice_v1_request_response_proto_msgTypes[88].OneofWrappers = []any{
 		(*GetNamespaceRequest_Namespace)(nil),
 		(*GetNamespaceRequest_Id)(nil),
 	}
@@ -6037,7 +8563,7 @@ func file_temporal_server_api_adminservice_v1_request_response_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_temporal_server_api_adminservice_v1_request_response_proto_rawDesc), len(file_temporal_server_api_adminservice_v1_request_response_proto_rawDesc)),
 			NumEnums:      0,
-			NumMessages:   99,
+			NumMessages:   138,
 			NumExtensions: 0,
 			NumServices:   0,
 		},
@@ -25,15 +25,21 @@ var File_temporal_server_api_adminservice_v1_service_proto protoreflect.FileDesc
 
 const file_temporal_server_api_adminservice_v1_service_proto_rawDesc = "" +
 	"\n" +
-	"1temporal/server/api/adminservice/v1/service.proto\x12#temporal.server.api.adminservice.v1\x1a:temporal/server/api/adminservice/v1/request_response.proto2\xbf4\n" +
+	"1temporal/server/api/adminservice/v1/service.proto\x12#temporal.server.api.adminservice.v1\x1a:temporal/server/api/adminservice/v1/request_response.proto2\xc7H\n" +
 	"\fAdminService\x12\x9a\x01\n" +
-	"\x13RebuildMutableState\x12?.temporal.server.api.adminservice.v1.RebuildMutableStateRequest\x1a@.temporal.server.api.adminservice.v1.RebuildMutableStateResponse\"\x00\x12\xa6\x01\n" +
-	"\x17ImportWorkflowExecution\x12C.temporal.server.api.adminservice.v1.ImportWorkflowExecutionRequest\x1aD.temporal.server.api.adminservice.v1.ImportWorkflowExecutionResponse\"\x00\x12\x9d\x01\n" +
+	"\x13RebuildMutableState\x12?.temporal.server.api.adminservice.v1.RebuildMutableStateRequest\x1a@.temporal.server.api.adminservice.v1.RebuildMutableStateResponse\"\x00\x12\x94\x01\n" +
+	"\x11CheckMutableState\x12=.temporal.server.api.adminservice.v1.CheckMutableStateRequest\x1a>.temporal.server.api.adminservice.v1.CheckMutableStateResponse\"\x00\x12\xa6\x01\n" +
+	"\x17ImportWorkflowExecution\x12C.temporal.server.api.adminservice.v1.ImportWorkflowExecutionRequest\x1aD.temporal.server.api.adminservice.v1.ImportWorkflowExecutionResponse\"\x00\x12\xa0\x01\n" +
+	"\x15ForkWorkflowExecution\x12A.temporal.server.api.adminservice.v1.ForkWorkflowExecutionRequest\x1aB.temporal.server.api.adminservice.v1.ForkWorkflowExecutionResponse\"\x00\x12\xa6\x01\n" +
+	"\x17RedactWorkflowExecution\x12C.temporal.server.api.adminservice.v1.RedactWorkflowExecutionRequest\x1aD.temporal.server.api.adminservice.v1.RedactWorkflowExecutionResponse\"\x00\x12\xb5\x01\n" +
+	"\x1cGetWorkflowExecutionTimeline\x12H.temporal.server.api.adminservice.v1.GetWorkflowExecutionTimelineRequest\x1aI.temporal.server.api.adminservice.v1.GetWorkflowExecutionTimelineResponse\"\x00\x12\xa9\x01\n" +
+	"\x18GetWorkflowHistoryBudget\x12D.temporal.server.api.adminservice.v1.GetWorkflowHistoryBudgetRequest\x1aE.temporal.server.api.adminservice.v1.GetWorkflowHistoryBudgetResponse\"\x00\x12\x9d\x01\n" +
 	"\x14DescribeMutableState\x12@.temporal.server.api.adminservice.v1.DescribeMutableStateRequest\x1aA.temporal.server.api.adminservice.v1.DescribeMutableStateResponse\"\x00\x12\x9a\x01\n" +
 	"\x13DescribeHistoryHost\x12?.temporal.server.api.adminservice.v1.DescribeHistoryHostRequest\x1a@.temporal.server.api.adminservice.v1.DescribeHistoryHostResponse\"\x00\x12y\n" +
 	"\bGetShard\x124.temporal.server.api.adminservice.v1.GetShardRequest\x1a5.temporal.server.api.adminservice.v1.GetShardResponse\"\x00\x12\x7f\n" +
 	"\n" +
 	"CloseShard\x126.temporal.server.api.adminservice.v1.CloseShardRequest\x1a7.temporal.server.api.adminservice.v1.CloseShardResponse\"\x00\x12\x91\x01\n" +
+	"\x10MoveHistoryShard\x12<.temporal.server.api.adminservice.v1.MoveHistoryShardRequest\x1a=.temporal.server.api.adminservice.v1.MoveHistoryShardResponse\"\x00\x12\x91\x01\n" +
 	"\x10ListHistoryTasks\x12<.temporal.server.api.adminservice.v1.ListHistoryTasksRequest\x1a=.temporal.server.api.adminservice.v1.ListHistoryTasksResponse\"\x00\x12\x7f\n" +
 	"\n" +
 	"RemoveTask\x126.temporal.server.api.adminservice.v1.RemoveTaskRequest\x1a7.temporal.server.api.adminservice.v1.RemoveTaskResponse\"\x00\x12\xc1\x01\n" +
@@ -45,7 +51,11 @@ const file_temporal_server_api_adminservice_v1_service_proto_rawDesc = "" +
 	"\rReapplyEvents\x129.temporal.server.api.adminservice.v1.ReapplyEventsRequest\x1a:.temporal.server.api.adminservice.v1.ReapplyEventsResponse\"\x00\x12\x9a\x01\n" +
 	"\x13AddSearchAttributes\x12?.temporal.server.api.adminservice.v1.AddSearchAttributesRequest\x1a@.temporal.server.api.adminservice.v1.AddSearchAttributesResponse\"\x00\x12\xa3\x01\n" +
 	"\x16RemoveSearchAttributes\x12B.temporal.server.api.adminservice.v1.RemoveSearchAttributesRequest\x1aC.temporal.server.api.adminservice.v1.RemoveSearchAttributesResponse\"\x00\x12\x9a\x01\n" +
-	"\x13GetSearchAttributes\x12?.temporal.server.api.adminservice.v1.GetSearchAttributesRequest\x1a@.temporal.server.api.adminservice.v1.GetSearchAttributesResponse\"\x00\x12\x8e\x01\n" +
+	"\x13GetSearchAttributes\x12?.temporal.server.api.adminservice.v1.GetSearchAttributesRequest\x1a@.temporal.server.api.adminservice.v1.GetSearchAttributesResponse\"\x00\x12\xb2\x01\n" +
+	"\x1bAggregateWorkflowExecutions\x12G.temporal.server.api.adminservice.v1.AggregateWorkflowExecutionsRequest\x1aH.temporal.server.api.adminservice.v1.AggregateWorkflowExecutionsResponse\"\x00\x12\xaf\x01\n" +
+	"\x1aUpsertSavedVisibilityQuery\x12F.temporal.server.api.adminservice.v1.UpsertSavedVisibilityQueryRequest\x1aG.temporal.server.api.adminservice.v1.UpsertSavedVisibilityQueryResponse\"\x00\x12\xaf\x01\n" +
+	"\x1aDeleteSavedVisibilityQuery\x12F.temporal.server.api.adminservice.v1.DeleteSavedVisibilityQueryRequest\x1aG.temporal.server.api.adminservice.v1.DeleteSavedVisibilityQueryResponse\"\x00\x12\xaf\x01\n" +
+	"\x1aListSavedVisibilityQueries\x12F.temporal.server.api.adminservice.v1.ListSavedVisibilityQueriesRequest\x1aG.temporal.server.api.adminservice.v1.ListSavedVisibilityQueriesResponse\"\x00\x12\x8e\x01\n" +
 	"\x0fDescribeCluster\x12;.temporal.server.api.adminservice.v1.DescribeClusterRequest\x1a<.temporal.server.api.adminservice.v1.DescribeClusterResponse\"\x00\x12\x85\x01\n" +
 	"\fListClusters\x128.temporal.server.api.adminservice.v1.ListClustersRequest\x1a9.temporal.server.api.adminservice.v1.ListClustersResponse\"\x00\x12\x97\x01\n" +
 	"\x12ListClusterMembers\x12>.temporal.server.api.adminservice.v1.ListClusterMembersRequest\x1a?.temporal.server.api.adminservice.v1.ListClusterMembersResponse\"\x00\x12\xa9\x01\n" +
@@ -72,188 +82,253 @@ const file_temporal_server_api_adminservice_v1_service_proto_rawDesc = "" +
 	"\x11SyncWorkflowState\x12=.temporal.server.api.adminservice.v1.SyncWorkflowStateRequest\x1a>.temporal.server.api.adminservice.v1.SyncWorkflowStateResponse\"\x00\x12\xca\x01\n" +
 	"#GenerateLastHistoryReplicationTasks\x12O.temporal.server.api.adminservice.v1.GenerateLastHistoryReplicationTasksRequest\x1aP.temporal.server.api.adminservice.v1.GenerateLastHistoryReplicationTasksResponse\"\x00\x12\xaf\x01\n" +
 	"\x1aDescribeTaskQueuePartition\x12F.temporal.server.api.adminservice.v1.DescribeTaskQueuePartitionRequest\x1aG.temporal.server.api.adminservice.v1.DescribeTaskQueuePartitionResponse\"\x00\x12\xb8\x01\n" +
-	"\x1dForceUnloadTaskQueuePartition\x12I.temporal.server.api.adminservice.v1.ForceUnloadTaskQueuePartitionRequest\x1aJ.temporal.server.api.adminservice.v1.ForceUnloadTaskQueuePartitionResponse\"\x00B8Z6go.temporal.io/server/api/adminservice/v1;adminserviceb\x06proto3"
+	"\x1dForceUnloadTaskQueuePartition\x12I.temporal.server.api.adminservice.v1.ForceUnloadTaskQueuePartitionRequest\x1aJ.temporal.server.api.adminservice.v1.ForceUnloadTaskQueuePartitionResponse\"\x00\x12\xa3\x01\n" +
+	"\x16PauseTaskQueueDispatch\x12B.temporal.server.api.adminservice.v1.PauseTaskQueueDispatchRequest\x1aC.temporal.server.api.adminservice.v1.PauseTaskQueueDispatchResponse\"\x00\x12\xa6\x01\n" +
+	"\x17ResumeTaskQueueDispatch\x12C.temporal.server.api.adminservice.v1.ResumeTaskQueueDispatchRequest\x1aD.temporal.server.api.adminservice.v1.ResumeTaskQueueDispatchResponse\"\x00\x12\xac\x01\n" +
+	"\x19ListTaskQueueBacklogTasks\x12E.temporal.server.api.adminservice.v1.ListTaskQueueBacklogTasksRequest\x1aF.temporal.server.api.adminservice.v1.ListTaskQueueBacklogTasksResponse\"\x00\x12\xb2\x01\n" +
+	"\x1bDeleteTaskQueueBacklogTasks\x12G.temporal.server.api.adminservice.v1.DeleteTaskQueueBacklogTasksRequest\x1aH.temporal.server.api.adminservice.v1.DeleteTaskQueueBacklogTasksResponse\"\x00\x12\xac\x01\n" +
+	"\x19MoveTaskQueueBacklogTasks\x12E.temporal.server.api.adminservice.v1.MoveTaskQueueBacklogTasksRequest\x1aF.temporal.server.api.adminservice.v1.MoveTaskQueueBacklogTasksResponse\"\x00B8Z6go.temporal.io/server/api/adminservice/v1;adminserviceb\x06proto3"
 
 var file_temporal_server_api_adminservice_v1_service_proto_goTypes = []any{
 	(*RebuildMutableStateRequest)(nil),                  // 0: temporal.server.api.adminservice.v1.RebuildMutableStateRequest
-	(*ImportWorkflowExecutionRequest)(nil),              // 1: temporal.server.api.adminservice.v1.ImportWorkflowExecutionRequest
-	(*DescribeMutableStateRequest)(nil),                 // 2: temporal.server.api.adminservice.v1.DescribeMutableStateRequest
-	(*DescribeHistoryHostRequest)(nil),                  // 3: temporal.server.api.adminservice.v1.DescribeHistoryHostRequest
-	(*GetShardRequest)(nil),                             // 4: temporal.server.api.adminservice.v1.GetShardRequest
-	(*CloseShardRequest)(nil),                           // 5: temporal.server.api.adminservice.v1.CloseShardRequest
-	(*ListHistoryTasksRequest)(nil),                     // 6: temporal.server.api.adminservice.v1.ListHistoryTasksRequest
-	(*RemoveTaskRequest)(nil),                           // 7: temporal.server.api.adminservice.v1.RemoveTaskRequest
-	(*GetWorkflowExecutionRawHistoryV2Request)(nil),     // 8: temporal.server.api.adminservice.v1.GetWorkflowExecutionRawHistoryV2Request
-	(*GetWorkflowExecutionRawHistoryRequest)(nil),       // 9: temporal.server.api.adminservice.v1.GetWorkflowExecutionRawHistoryRequest
-	(*GetReplicationMessagesRequest)(nil),               // 10: temporal.server.api.adminservice.v1.GetReplicationMessagesRequest
-	(*GetNamespaceReplicationMessagesRequest)(nil),      // 11: temporal.server.api.adminservice.v1.GetNamespaceReplicationMessagesRequest
-	(*GetDLQReplicationMessagesRequest)(nil),            // 12: temporal.server.api.adminservice.v1.GetDLQReplicationMessagesRequest
-	(*ReapplyEventsRequest)(nil),                        // 13: temporal.server.api.adminservice.v1.ReapplyEventsRequest
-	(*AddSearchAttributesRequest)(nil),                  // 14: temporal.server.api.adminservice.v1.AddSearchAttributesRequest
-	(*RemoveSearchAttributesRequest)(nil),               // 15: temporal.server.api.adminservice.v1.RemoveSearchAttributesRequest
-	(*GetSearchAttributesRequest)(nil),                  // 16: temporal.server.api.adminservice.v1.GetSearchAttributesRequest
-	(*DescribeClusterRequest)(nil),                      // 17: temporal.server.api.adminservice.v1.DescribeClusterRequest
-	(*ListClustersRequest)(nil),                         // 18: temporal.server.api.adminservice.v1.ListClustersRequest
-	(*ListClusterMembersRequest)(nil),                   // 19: temporal.server.api.adminservice.v1.ListClusterMembersRequest
-	(*AddOrUpdateRemoteClusterRequest)(nil),             // 20: temporal.server.api.adminservice.v1.AddOrUpdateRemoteClusterRequest
-	(*RemoveRemoteClusterRequest)(nil),                  // 21: temporal.server.api.adminservice.v1.RemoveRemoteClusterRequest
-	(*GetDLQMessagesRequest)(nil),                       // 22: temporal.server.api.adminservice.v1.GetDLQMessagesRequest
-	(*PurgeDLQMessagesRequest)(nil),                     // 23: temporal.server.api.adminservice.v1.PurgeDLQMessagesRequest
-	(*MergeDLQMessagesRequest)(nil),                     // 24: temporal.server.api.adminservice.v1.MergeDLQMessagesRequest
-	(*RefreshWorkflowTasksRequest)(nil),                 // 25: temporal.server.api.adminservice.v1.RefreshWorkflowTasksRequest
-	(*ResendReplicationTasksRequest)(nil),               // 26: temporal.server.api.adminservice.v1.ResendReplicationTasksRequest
-	(*GetTaskQueueTasksRequest)(nil),                    // 27: temporal.server.api.adminservice.v1.GetTaskQueueTasksRequest
-	(*DeleteWorkflowExecutionRequest)(nil),              // 28: temporal.server.api.adminservice.v1.DeleteWorkflowExecutionRequest
-	(*StreamWorkflowReplicationMessagesRequest)(nil),    // 29: temporal.server.api.adminservice.v1.StreamWorkflowReplicationMessagesRequest
-	(*GetNamespaceRequest)(nil),                         // 30: temporal.server.api.adminservice.v1.GetNamespaceRequest
-	(*GetDLQTasksRequest)(nil),                          // 31: temporal.server.api.adminservice.v1.GetDLQTasksRequest
-	(*PurgeDLQTasksRequest)(nil),                        // 32: temporal.server.api.adminservice.v1.PurgeDLQTasksRequest
-	(*MergeDLQTasksRequest)(nil),                        // 33: temporal.server.api.adminservice.v1.MergeDLQTasksRequest
-	(*DescribeDLQJobRequest)(nil),                       // 34: temporal.server.api.adminservice.v1.DescribeDLQJobRequest
-	(*CancelDLQJobRequest)(nil),                         // 35: temporal.server.api.adminservice.v1.CancelDLQJobRequest
-	(*AddTasksRequest)(nil),                             // 36: temporal.server.api.adminservice.v1.AddTasksRequest
-	(*ListQueuesRequest)(nil),                           // 37: temporal.server.api.adminservice.v1.ListQueuesRequest
-	(*DeepHealthCheckRequest)(nil),                      // 38: temporal.server.api.adminservice.v1.DeepHealthCheckRequest
-	(*SyncWorkflowStateRequest)(nil),                    // 39: temporal.server.api.adminservice.v1.SyncWorkflowStateRequest
-	(*GenerateLastHistoryReplicationTasksRequest)(nil),  // 40: temporal.server.api.adminservice.v1.GenerateLastHistoryReplicationTasksRequest
-	(*DescribeTaskQueuePartitionRequest)(nil),           // 41: temporal.server.api.adminservice.v1.DescribeTaskQueuePartitionRequest
-	(*ForceUnloadTaskQueuePartitionRequest)(nil),        // 42: temporal.server.api.adminservice.v1.ForceUnloadTaskQueuePartitionRequest
-	(*RebuildMutableStateResponse)(nil),                 // 43: temporal.server.api.adminservice.v1.RebuildMutableStateResponse
-	(*ImportWorkflowExecutionResponse)(nil),             // 44: temporal.server.api.adminservice.v1.ImportWorkflowExecutionResponse
-	(*DescribeMutableStateResponse)(nil),                // 45: temporal.server.api.adminservice.v1.DescribeMutableStateResponse
-	(*DescribeHistoryHostResponse)(nil),                 // 46: temporal.server.api.adminservice.v1.DescribeHistoryHostResponse
-	(*GetShardResponse)(nil),                            // 47: temporal.server.api.adminservice.v1.GetShardResponse
-	(*CloseShardResponse)(nil),                          // 48: temporal.server.api.adminservice.v1.CloseShardResponse
-	(*ListHistoryTasksResponse)(nil),                    // 49: temporal.server.api.adminservice.v1.ListHistoryTasksResponse
-	(*RemoveTaskResponse)(nil),                          // 50: temporal.server.api.adminservice.v1.RemoveTaskResponse
-	(*GetWorkflowExecutionRawHistoryV2Response)(nil),    // 51: temporal.server.api.adminservice.v1.GetWorkflowExecutionRawHistoryV2Response
-	(*GetWorkflowExecutionRawHistoryResponse)(nil),      // 52: temporal.server.api.adminservice.v1.GetWorkflowExecutionRawHistoryResponse
-	(*GetReplicationMessagesResponse)(nil),              // 53: temporal.server.api.adminservice.v1.GetReplicationMessagesResponse
-	(*GetNamespaceReplicationMessagesResponse)(nil),     // 54: temporal.server.api.adminservice.v1.GetNamespaceReplicationMessagesResponse
-	(*GetDLQReplicationMessagesResponse)(nil),           // 55: temporal.server.api.adminservice.v1.GetDLQReplicationMessagesResponse
-	(*ReapplyEventsResponse)(nil),                       // 56: temporal.server.api.adminservice.v1.ReapplyEventsResponse
-	(*AddSearchAttributesResponse)(nil),                 // 57: temporal.server.api.adminservice.v1.AddSearchAttributesResponse
-	(*RemoveSearchAttributesResponse)(nil),              // 58: temporal.server.api.adminservice.v1.RemoveSearchAttributesResponse
-	(*GetSearchAttributesResponse)(nil),                 // 59: temporal.server.api.adminservice.v1.GetSearchAttributesResponse
-	(*DescribeClusterResponse)(nil),                     // 60: temporal.server.api.adminservice.v1.DescribeClusterResponse
-	(*ListClustersResponse)(nil),                        // 61: temporal.server.api.adminservice.v1.ListClustersResponse
-	(*ListClusterMembersResponse)(nil),                  // 62: temporal.server.api.adminservice.v1.ListClusterMembersResponse
-	(*AddOrUpdateRemoteClusterResponse)(nil),            // 63: temporal.server.api.adminservice.v1.AddOrUpdateRemoteClusterResponse
-	(*RemoveRemoteClusterResponse)(nil),                 // 64: temporal.server.api.adminservice.v1.RemoveRemoteClusterResponse
-	(*GetDLQMessagesResponse)(nil),                      // 65: temporal.server.api.adminservice.v1.GetDLQMessagesResponse
-	(*PurgeDLQMessagesResponse)(nil),                    // 66: temporal.server.api.adminservice.v1.PurgeDLQMessagesResponse
-	(*MergeDLQMessagesResponse)(nil),                    // 67: temporal.server.api.adminservice.v1.MergeDLQMessagesResponse
-	(*RefreshWorkflowTasksResponse)(nil),                // 68: temporal.server.api.adminservice.v1.RefreshWorkflowTasksResponse
-	(*ResendReplicationTasksResponse)(nil),              // 69: temporal.server.api.adminservice.v1.ResendReplicationTasksResponse
-	(*GetTaskQueueTasksResponse)(nil),                   // 70: temporal.server.api.adminservice.v1.GetTaskQueueTasksResponse
-	(*DeleteWorkflowExecutionResponse)(nil),             // 71: temporal.server.api.adminservice.v1.DeleteWorkflowExecutionResponse
-	(*StreamWorkflowReplicationMessagesResponse)(nil),   // 72: temporal.server.api.adminservice.v1.StreamWorkflowReplicationMessagesResponse
-	(*GetNamespaceResponse)(nil),                        // 73: temporal.server.api.adminservice.v1.GetNamespaceResponse
-	(*GetDLQTasksResponse)(nil),                         // 74: temporal.server.api.adminservice.v1.GetDLQTasksResponse
-	(*PurgeDLQTasksResponse)(nil),                       // 75: temporal.server.api.adminservice.v1.PurgeDLQTasksResponse
-	(*MergeDLQTasksResponse)(nil),                       // 76: temporal.server.api.adminservice.v1.MergeDLQTasksResponse
-	(*DescribeDLQJobResponse)(nil),                      // 77: temporal.server.api.adminservice.v1.DescribeDLQJobResponse
-	(*CancelDLQJobResponse)(nil),                        // 78: temporal.server.api.adminservice.v1.CancelDLQJobResponse
-	(*AddTasksResponse)(nil),                            // 79: temporal.server.api.adminservice.v1.AddTasksResponse
-	(*ListQueuesResponse)(nil),                          // 80: temporal.server.api.adminservice.v1.ListQueuesResponse
-	(*DeepHealthCheckResponse)(nil),                     // 81: temporal.server.api.adminservice.v1.DeepHealthCheckResponse
-	(*SyncWorkflowStateResponse)(nil),                   // 82: temporal.server.api.adminservice.v1.SyncWorkflowStateResponse
-	(*GenerateLastHistoryReplicationTasksResponse)(nil), // 83: temporal.server.api.adminservice.v1.GenerateLastHistoryReplicationTasksResponse
-	(*DescribeTaskQueuePartitionResponse)(nil),          // 84: temporal.server.api.adminservice.v1.DescribeTaskQueuePartitionResponse
-	(*ForceUnloadTaskQueuePartitionResponse)(nil),       // 85: temporal.server.api.adminservice.v1.ForceUnloadTaskQueuePartitionResponse
+	(*CheckMutableStateRequest)(nil),                    // 1: temporal.server.api.adminservice.v1.CheckMutableStateRequest
+	(*ImportWorkflowExecutionRequest)(nil),              // 2: temporal.server.api.adminservice.v1.ImportWorkflowExecutionRequest
+	(*ForkWorkflowExecutionRequest)(nil),                // 3: temporal.server.api.adminservice.v1.ForkWorkflowExecutionRequest
+	(*RedactWorkflowExecutionRequest)(nil),              // 4: temporal.server.api.adminservice.v1.RedactWorkflowExecutionRequest
+	(*GetWorkflowExecutionTimelineRequest)(nil),         // 5: temporal.server.api.adminservice.v1.GetWorkflowExecutionTimelineRequest
+	(*GetWorkflowHistoryBudgetRequest)(nil),             // 6: temporal.server.api.adminservice.v1.GetWorkflowHistoryBudgetRequest
+	(*DescribeMutableStateRequest)(nil),                 // 7: temporal.server.api.adminservice.v1.DescribeMutableStateRequest
+	(*DescribeHistoryHostRequest)(nil),                  // 8: temporal.server.api.adminservice.v1.DescribeHistoryHostRequest
+	(*GetShardRequest)(nil),                             // 9: temporal.server.api.adminservice.v1.GetShardRequest
+	(*CloseShardRequest)(nil),                           // 10: temporal.server.api.adminservice.v1.CloseShardRequest
+	(*MoveHistoryShardRequest)(nil),                     // 11: temporal.server.api.adminservice.v1.MoveHistoryShardRequest
+	(*ListHistoryTasksRequest)(nil),                     // 12: temporal.server.api.adminservice.v1.ListHistoryTasksRequest
+	(*RemoveTaskRequest)(nil),                           // 13: temporal.server.api.adminservice.v1.RemoveTaskRequest
+	(*GetWorkflowExecutionRawHistoryV2Request)(nil),     // 14: temporal.server.api.adminservice.v1.GetWorkflowExecutionRawHistoryV2Request
+	(*GetWorkflowExecutionRawHistoryRequest)(nil),       // 15: temporal.server.api.adminservice.v1.GetWorkflowExecutionRawHistoryRequest
+	(*GetReplicationMessagesRequest)(nil),               // 16: temporal.server.api.adminservice.v1.GetReplicationMessagesRequest
+	(*GetNamespaceReplicationMessagesRequest)(nil),      // 17: temporal.server.api.adminservice.v1.GetNamespaceReplicationMessagesRequest
+	(*GetDLQReplicationMessagesRequest)(nil),            // 18: temporal.server.api.adminservice.v1.GetDLQReplicationMessagesRequest
+	(*ReapplyEventsRequest)(nil),                        // 19: temporal.server.api.adminservice.v1.ReapplyEventsRequest
+	(*AddSearchAttributesRequest)(nil),                  // 20: temporal.server.api.adminservice.v1.AddSearchAttributesRequest
+	(*RemoveSearchAttributesRequest)(nil),               // 21: temporal.server.api.adminservice.v1.RemoveSearchAttributesRequest
+	(*GetSearchAttributesRequest)(nil),                  // 22: temporal.server.api.adminservice.v1.GetSearchAttributesRequest
+	(*AggregateWorkflowExecutionsRequest)(nil),          // 23: temporal.server.api.adminservice.v1.AggregateWorkflowExecutionsRequest
+	(*UpsertSavedVisibilityQueryRequest)(nil),           // 24: temporal.server.api.adminservice.v1.UpsertSavedVisibilityQueryRequest
+	(*DeleteSavedVisibilityQueryRequest)(nil),           // 25: temporal.server.api.adminservice.v1.DeleteSavedVisibilityQueryRequest
+	(*ListSavedVisibilityQueriesRequest)(nil),           // 26: temporal.server.api.adminservice.v1.ListSavedVisibilityQueriesRequest
+	(*DescribeClusterRequest)(nil),                      // 27: temporal.server.api.adminservice.v1.DescribeClusterRequest
+	(*ListClustersRequest)(nil),                         // 28: temporal.server.api.adminservice.v1.ListClustersRequest
+	(*ListClusterMembersRequest)(nil),                   // 29: temporal.server.api.adminservice.v1.ListClusterMembersRequest
+	(*AddOrUpdateRemoteClusterRequest)(nil),             // 30: temporal.server.api.adminservice.v1.AddOrUpdateRemoteClusterRequest
+	(*RemoveRemoteClusterRequest)(nil),                  // 31: temporal.server.api.adminservice.v1.RemoveRemoteClusterRequest
+	(*GetDLQMessagesRequest)(nil),                       // 32: temporal.server.api.adminservice.v1.GetDLQMessagesRequest
+	(*PurgeDLQMessagesRequest)(nil),                     // 33: temporal.server.api.adminservice.v1.PurgeDLQMessagesRequest
+	(*MergeDLQMessagesRequest)(nil),                     // 34: temporal.server.api.adminservice.v1.MergeDLQMessagesRequest
+	(*RefreshWorkflowTasksRequest)(nil),                 // 35: temporal.server.api.adminservice.v1.RefreshWorkflowTasksRequest
+	(*ResendReplicationTasksRequest)(nil),               // 36: temporal.server.api.adminservice.v1.ResendReplicationTasksRequest
+	(*GetTaskQueueTasksRequest)(nil),                    // 37: temporal.server.api.adminservice.v1.GetTaskQueueTasksRequest
+	(*DeleteWorkflowExecutionRequest)(nil),              // 38: temporal.server.api.adminservice.v1.DeleteWorkflowExecutionRequest
+	(*StreamWorkflowReplicationMessagesRequest)(nil),    // 39: temporal.server.api.adminservice.v1.StreamWorkflowReplicationMessagesRequest
+	(*GetNamespaceRequest)(nil),                         // 40: temporal.server.api.adminservice.v1.GetNamespaceRequest
+	(*GetDLQTasksRequest)(nil),                          // 41: temporal.server.api.adminservice.v1.GetDLQTasksRequest
+	(*PurgeDLQTasksRequest)(nil),                        // 42: temporal.server.api.adminservice.v1.PurgeDLQTasksRequest
+	(*MergeDLQTasksRequest)(nil),                        // 43: temporal.server.api.adminservice.v1.MergeDLQTasksRequest
+	(*DescribeDLQJobRequest)(nil),                       // 44: temporal.server.api.adminservice.v1.DescribeDLQJobRequest
+	(*CancelDLQJobRequest)(nil),                         // 45: temporal.server.api.adminservice.v1.CancelDLQJobRequest
+	(*AddTasksRequest)(nil),                             // 46: temporal.server.api.adminservice.v1.AddTasksRequest
+	(*ListQueuesRequest)(nil),                           // 47: temporal.server.api.adminservice.v1.ListQueuesRequest
+	(*DeepHealthCheckRequest)(nil),                      // 48: temporal.server.api.adminservice.v1.DeepHealthCheckRequest
+	(*SyncWorkflowStateRequest)(nil),                    // 49: temporal.server.api.adminservice.v1.SyncWorkflowStateRequest
+	(*GenerateLastHistoryReplicationTasksRequest)(nil),  // 50: temporal.server.api.adminservice.v1.GenerateLastHistoryReplicationTasksRequest
+	(*DescribeTaskQueuePartitionRequest)(nil),           // 51: temporal.server.api.adminservice.v1.DescribeTaskQueuePartitionRequest
+	(*ForceUnloadTaskQueuePartitionRequest)(nil),        // 52: temporal.server.api.adminservice.v1.ForceUnloadTaskQueuePartitionRequest
+	(*PauseTaskQueueDispatchRequest)(nil),               // 53: temporal.server.api.adminservice.v1.PauseTaskQueueDispatchRequest
+	(*ResumeTaskQueueDispatchRequest)(nil),              // 54: temporal.server.api.adminservice.v1.ResumeTaskQueueDispatchRequest
+	(*ListTaskQueueBacklogTasksRequest)(nil),            // 55: temporal.server.api.adminservice.v1.ListTaskQueueBacklogTasksRequest
+	(*DeleteTaskQueueBacklogTasksRequest)(nil),          // 56: temporal.server.api.adminservice.v1.DeleteTaskQueueBacklogTasksRequest
+	(*MoveTaskQueueBacklogTasksRequest)(nil),            // 57: temporal.server.api.adminservice.v1.MoveTaskQueueBacklogTasksRequest
+	(*RebuildMutableStateResponse)(nil),                 // 58: temporal.server.api.adminservice.v1.RebuildMutableStateResponse
+	(*CheckMutableStateResponse)(nil),                   // 59: temporal.server.api.adminservice.v1.CheckMutableStateResponse
+	(*ImportWorkflowExecutionResponse)(nil),             // 60: temporal.server.api.adminservice.v1.ImportWorkflowExecutionResponse
+	(*ForkWorkflowExecutionResponse)(nil),               // 61: temporal.server.api.adminservice.v1.ForkWorkflowExecutionResponse
+	(*RedactWorkflowExecutionResponse)(nil),             // 62: temporal.server.api.adminservice.v1.RedactWorkflowExecutionResponse
+	(*GetWorkflowExecutionTimelineResponse)(nil),        // 63: temporal.server.api.adminservice.v1.GetWorkflowExecutionTimelineResponse
+	(*GetWorkflowHistoryBudgetResponse)(nil),            // 64: temporal.server.api.adminservice.v1.GetWorkflowHistoryBudgetResponse
+	(*DescribeMutableStateResponse)(nil),                // 65: temporal.server.api.adminservice.v1.DescribeMutableStateResponse
+	(*DescribeHistoryHostResponse)(nil),                 // 66: temporal.server.api.adminservice.v1.DescribeHistoryHostResponse
+	(*GetShardResponse)(nil),                            // 67: temporal.server.api.adminservice.v1.GetShardResponse
+	(*CloseShardResponse)(nil),                          // 68: temporal.server.api.adminservice.v1.CloseShardResponse
+	(*MoveHistoryShardResponse)(nil),                    // 69: temporal.server.api.adminservice.v1.MoveHistoryShardResponse
+	(*ListHistoryTasksResponse)(nil),                    // 70: temporal.server.api.adminservice.v1.ListHistoryTasksResponse
+	(*RemoveTaskResponse)(nil),                          // 71: temporal.server.api.adminservice.v1.RemoveTaskResponse
+	(*GetWorkflowExecutionRawHistoryV2Response)(nil),    // 72: temporal.server.api.adminservice.v1.GetWorkflowExecutionRawHistoryV2Response
+	(*GetWorkflowExecutionRawHistoryResponse)(nil),      // 73: temporal.server.api.adminservice.v1.GetWorkflowExecutionRawHistoryResponse
+	(*GetReplicationMessagesResponse)(nil),              // 74: temporal.server.api.adminservice.v1.GetReplicationMessagesResponse
+	(*GetNamespaceReplicationMessagesResponse)(nil),     // 75: temporal.server.api.adminservice.v1.GetNamespaceReplicationMessagesResponse
+	(*GetDLQReplicationMessagesResponse)(nil),           // 76: temporal.server.api.adminservice.v1.GetDLQReplicationMessagesResponse
+	(*ReapplyEventsResponse)(nil),                       // 77: temporal.server.api.adminservice.v1.ReapplyEventsResponse
+	(*AddSearchAttributesResponse)(nil),                 // 78: temporal.server.api.adminservice.v1.AddSearchAttributesResponse
+	(*RemoveSearchAttributesResponse)(nil),              // 79: temporal.server.api.adminservice.v1.RemoveSearchAttributesResponse
+	(*GetSearchAttributesResponse)(nil),                 // 80: temporal.server.api.adminservice.v1.GetSearchAttributesResponse
+	(*AggregateWorkflowExecutionsResponse)(nil),         // 81: temporal.server.api.adminservice.v1.AggregateWorkflowExecutionsResponse
+	(*UpsertSavedVisibilityQueryResponse)(nil),          // 82: temporal.server.api.adminservice.v1.UpsertSavedVisibilityQueryResponse
+	(*DeleteSavedVisibilityQueryResponse)(nil),          // 83: temporal.server.api.adminservice.v1.DeleteSavedVisibilityQueryResponse
+	(*ListSavedVisibilityQueriesResponse)(nil),          // 84: temporal.server.api.adminservice.v1.ListSavedVisibilityQueriesResponse
+	(*DescribeClusterResponse)(nil),                     // 85: temporal.server.api.adminservice.v1.DescribeClusterResponse
+	(*ListClustersResponse)(nil),                        // 86: temporal.server.api.adminservice.v1.ListClustersResponse
+	(*ListClusterMembersResponse)(nil),                  // 87: temporal.server.api.adminservice.v1.ListClusterMembersResponse
+	(*AddOrUpdateRemoteClusterResponse)(nil),            // 88: temporal.server.api.adminservice.v1.AddOrUpdateRemoteClusterResponse
+	(*RemoveRemoteClusterResponse)(nil),                 // 89: temporal.server.api.adminservice.v1.RemoveRemoteClusterResponse
+	(*GetDLQMessagesResponse)(nil),                      // 90: temporal.server.api.adminservice.v1.GetDLQMessagesResponse
+	(*PurgeDLQMessagesResponse)(nil),                    // 91: temporal.server.api.adminservice.v1.PurgeDLQMessagesResponse
+	(*MergeDLQMessagesResponse)(nil),                    // 92: temporal.server.api.adminservice.v1.MergeDLQMessagesResponse
+	(*RefreshWorkflowTasksResponse)(nil),                // 93: temporal.server.api.adminservice.v1.RefreshWorkflowTasksResponse
+	(*ResendReplicationTasksResponse)(nil),              // 94: temporal.server.api.adminservice.v1.ResendReplicationTasksResponse
+	(*GetTaskQueueTasksResponse)(nil),                   // 95: temporal.server.api.adminservice.v1.GetTaskQueueTasksResponse
+	(*DeleteWorkflowExecutionResponse)(nil),             // 96: temporal.server.api.adminservice.v1.DeleteWorkflowExecutionResponse
+	(*StreamWorkflowReplicationMessagesResponse)(nil),   // 97: temporal.server.api.adminservice.v1.StreamWorkflowReplicationMessagesResponse
+	(*GetNamespaceResponse)(nil),                        // 98: temporal.server.api.adminservice.v1.GetNamespaceResponse
+	(*GetDLQTasksResponse)(nil),                         // 99: temporal.server.api.adminservice.v1.GetDLQTasksResponse
+	(*PurgeDLQTasksResponse)(nil),                       // 100: temporal.server.api.adminservice.v1.PurgeDLQTasksResponse
+	(*MergeDLQTasksResponse)(nil),                       // 101: temporal.server.api.adminservice.v1.MergeDLQTasksResponse
+	(*DescribeDLQJobResponse)(nil),                      // 102: temporal.server.api.adminservice.v1.DescribeDLQJobResponse
+	(*CancelDLQJobResponse)(nil),                        // 103: temporal.server.api.adminservice.v1.CancelDLQJobResponse
+	(*AddTasksResponse)(nil),                            // 104: temporal.server.api.adminservice.v1.AddTasksResponse
+	(*ListQueuesResponse)(nil),                          // 105: temporal.server.api.adminservice.v1.ListQueuesResponse
+	(*DeepHealthCheckResponse)(nil),                     // 106: temporal.server.api.adminservice.v1.DeepHealthCheckResponse
+	(*SyncWorkflowStateResponse)(nil),                   // 107: temporal.server.api.adminservice.v1.SyncWorkflowStateResponse
+	(*GenerateLastHistoryReplicationTasksResponse)(nil), // 108: temporal.server.api.adminservice.v1.GenerateLastHistoryReplicationTasksResponse
+	(*DescribeTaskQueuePartitionResponse)(nil),          // 109: temporal.server.api.adminservice.v1.DescribeTaskQueuePartitionResponse
+	(*ForceUnloadTaskQueuePartitionResponse)(nil),       // 110: temporal.server.api.adminservice.v1.ForceUnloadTaskQueuePartitionResponse
+	(*PauseTaskQueueDispatchResponse)(nil),              // 111: temporal.server.api.adminservice.v1.PauseTaskQueueDispatchResponse
+	(*ResumeTaskQueueDispatchResponse)(nil),             // 112: temporal.server.api.adminservice.v1.ResumeTaskQueueDispatchResponse
+	(*ListTaskQueueBacklogTasksResponse)(nil),           // 113: temporal.server.api.adminservice.v1.ListTaskQueueBacklogTasksResponse
+	(*DeleteTaskQueueBacklogTasksResponse)(nil),         // 114: temporal.server.api.adminservice.v1.DeleteTaskQueueBacklogTasksResponse
+	(*MoveTaskQueueBacklogTasksResponse)(nil),           // 115: temporal.server.api.adminservice.v1.MoveTaskQueueBacklogTasksResponse
 }
 var file_temporal_server_api_adminservice_v1_service_proto_depIdxs = []int32{
-	0,  // 0: temporal.server.api.adminservice.v1.AdminService.RebuildMutableState:input_type -> temporal.server.api.adminservice.v1.RebuildMutableStateRequest
-	1,  // 1: temporal.server.api.adminservice.v1.AdminService.ImportWorkflowExecution:input_type -> temporal.server.api.adminservice.v1.ImportWorkflowExecutionRequest
-	2,  // 2: temporal.server.api.adminservice.v1.AdminService.DescribeMutableState:input_type -> temporal.server.api.adminservice.v1.DescribeMutableStateRequest
-	3,  // 3: temporal.server.api.adminservice.v1.AdminService.DescribeHistoryHost:input_type -> temporal.server.api.adminservice.v1.DescribeHistoryHostRequest
-	4,  // 4: temporal.server.api.adminservice.v1.AdminService.GetShard:input_type -> temporal.server.api.adminservice.v1.GetShardRequest
-	5,  // 5: temporal.server.api.adminservice.v1.AdminService.CloseShard:input_type -> temporal.server.api.adminservice.v1.CloseShardRequest
-	6,  // 6: temporal.server.api.adminservice.v1.AdminService.ListHistoryTasks:input_type -> temporal.server.api.adminservice.v1.ListHistoryTasksRequest
-	7,  // 7: temporal.server.api.adminservice.v1.AdminService.RemoveTask:input_type -> temporal.server.api.adminservice.v1.RemoveTaskRequest
-	8,  // 8: temporal.server.api.adminservice.v1.AdminService.GetWorkflowExecutionRawHistoryV2:input_type -> temporal.server.api.adminservice.v1.GetWorkflowExecutionRawHistoryV2Request
-	9,  // 9: temporal.server.api.adminservice.v1.AdminService.GetWorkflowExecutionRawHistory:input_type -> temporal.server.api.adminservice.v1.GetWorkflowExecutionRawHistoryRequest
-	10, // 10: temporal.server.api.adminservice.v1.AdminService.GetReplicationMessages:input_type -> temporal.server.api.adminservice.v1.GetReplicationMessagesRequest
-	11, // 11: temporal.server.api.adminservice.v1.AdminService.GetNamespaceReplicationMessages:input_type -> temporal.server.api.adminservice.v1.GetNamespaceReplicationMessagesRequest
-	12, // 12: temporal.server.api.adminservice.v1.AdminService.GetDLQReplicationMessages:input_type -> temporal.server.api.adminservice.v1.GetDLQReplicationMessagesRequest
-	13, // 13: temporal.server.api.adminservice.v1.AdminService.ReapplyEvents:input_type -> temporal.server.api.adminservice.v1.ReapplyEventsRequest
-	14, // 14: temporal.server.api.adminservice.v1.AdminService.AddSearchAttributes:input_type -> temporal.server.api.adminservice.v1.AddSearchAttributesRequest
-	15, // 15: temporal.server.api.adminservice.v1.AdminService.RemoveSearchAttributes:input_type -> temporal.server.api.adminservice.v1.RemoveSearchAttributesRequest
-	16, // 16: temporal.server.api.adminservice.v1.AdminService.GetSearchAttributes:input_type -> temporal.server.api.adminservice.v1.GetSearchAttributesRequest
-	17, // 17: temporal.server.api.adminservice.v1.AdminService.DescribeCluster:input_type -> temporal.server.api.adminservice.v1.DescribeClusterRequest
-	18, // 18: temporal.server.api.adminservice.v1.AdminService.ListClusters:input_type -> temporal.server.api.adminservice.v1.ListClustersRequest
-	19, // 19: temporal.server.api.adminservice.v1.AdminService.ListClusterMembers:input_type -> temporal.server.api.adminservice.v1.ListClusterMembersRequest
-	20, // 20: temporal.server.api.adminservice.v1.AdminService.AddOrUpdateRemoteCluster:input_type -> temporal.server.api.adminservice.v1.AddOrUpdateRemoteClusterRequest
-	21, // 21: temporal.server.api.adminservice.v1.AdminService.RemoveRemoteCluster:input_type -> temporal.server.api.adminservice.v1.RemoveRemoteClusterRequest
-	22, // 22: temporal.server.api.adminservice.v1.AdminService.GetDLQMessages:input_type -> temporal.server.api.adminservice.v1.GetDLQMessagesRequest
-	23, // 23: temporal.server.api.adminservice.v1.AdminService.PurgeDLQMessages:input_type -> temporal.server.api.adminservice.v1.PurgeDLQMessagesRequest
-	24, // 24: temporal.server.api.adminservice.v1.AdminService.MergeDLQMessages:input_type -> temporal.server.api.adminservice.v1.MergeDLQMessagesRequest
-	25, // 25: temporal.server.api.adminservice.v1.AdminService.RefreshWorkflowTasks:input_type -> temporal.server.api.adminservice.v1.RefreshWorkflowTasksRequest
-	26, // 26: temporal.server.api.adminservice.v1.AdminService.ResendReplicationTasks:input_type -> temporal.server.api.adminservice.v1.ResendReplicationTasksRequest
-	27, // 27: temporal.server.api.adminservice.v1.AdminService.GetTaskQueueTasks:input_type -> temporal.server.api.adminservice.v1.GetTaskQueueTasksRequest
-	28, // 28: temporal.server.api.adminservice.v1.AdminService.DeleteWorkflowExecution:input_type -> temporal.server.api.adminservice.v1.DeleteWorkflowExecutionRequest
-	29, // 29: temporal.server.api.adminservice.v1.AdminService.StreamWorkflowReplicationMessages:input_type -> temporal.server.api.adminservice.v1.StreamWorkflowReplicationMessagesRequest
-	30, // 30: temporal.server.api.adminservice.v1.AdminService.GetNamespace:input_type -> temporal.server.api.adminservice.v1.GetNamespaceRequest
-	31, // 31: temporal.server.api.adminservice.v1.AdminService.GetDLQTasks:input_type -> temporal.server.api.adminservice.v1.GetDLQTasksRequest
-	32, // 32: temporal.server.api.adminservice.v1.AdminService.PurgeDLQTasks:input_type -> temporal.server.api.adminservice.v1.PurgeDLQTasksRequest
-	33, // 33: temporal.server.api.adminservice.v1.AdminService.MergeDLQTasks:input_type -> temporal.server.api.adminservice.v1.MergeDLQTasksRequest
-	34, // 34: temporal.server.api.adminservice.v1.AdminService.DescribeDLQJob:input_type -> temporal.server.api.adminservice.v1.DescribeDLQJobRequest
-	35, // 35: temporal.server.api.adminservice.v1.AdminService.CancelDLQJob:input_type -> temporal.server.api.adminservice.v1.CancelDLQJobRequest
-	36, // 36: temporal.server.api.adminservice.v1.AdminService.AddTasks:input_type -> temporal.server.api.adminservice.v1.AddTasksRequest
-	37, // 37: temporal.server.api.adminservice.v1.AdminService.ListQueues:input_type -> temporal.server.api.adminservice.v1.ListQueuesRequest
-	38, // 38: temporal.server.api.adminservice.v1.AdminService.DeepHealthCheck:input_type -> temporal.server.api.adminservice.v1.DeepHealthCheckRequest
-	39, // 39: temporal.server.api.adminservice.v1.AdminService.SyncWorkflowState:input_type -> temporal.server.api.adminservice.v1.SyncWorkflowStateRequest
-	40, // 40: temporal.server.api.adminservice.v1.AdminService.GenerateLastHistoryReplicationTasks:input_type -> temporal.server.api.adminservice.v1.GenerateLastHistoryReplicationTasksRequest
-	41, // 41: temporal.server.api.adminservice.v1.AdminService.DescribeTaskQueuePartition:input_type -> temporal.server.api.adminservice.v1.DescribeTaskQueuePartitionRequest
-	42, // 42: temporal.server.api.adminservice.v1.AdminService.ForceUnloadTaskQueuePartition:input_type -> temporal.server.api.adminservice.v1.ForceUnloadTaskQueuePartitionRequest
-	43, // 43: temporal.server.api.adminservice.v1.AdminService.RebuildMutableState:output_type -> temporal.server.api.adminservice.v1.RebuildMutableStateResponse
-	44, // 44: temporal.server.api.adminservice.v1.AdminService.ImportWorkflowExecution:output_type -> temporal.server.api.adminservice.v1.ImportWorkflowExecutionResponse
-	45, // 45: temporal.server.api.adminservice.v1.AdminService.DescribeMutableState:output_type -> temporal.server.api.adminservice.v1.DescribeMutableStateResponse
-	46, // 46: temporal.server.api.adminservice.v1.AdminService.DescribeHistoryHost:output_type -> temporal.server.api.adminservice.v1.DescribeHistoryHostResponse
-	47, // 47: temporal.server.api.adminservice.v1.AdminService.GetShard:output_type -> temporal.server.api.adminservice.v1.GetShardResponse
-	48, // 48: temporal.server.api.adminservice.v1.AdminService.CloseShard:output_type -> temporal.server.api.adminservice.v1.CloseShardResponse
-	49, // 49: temporal.server.api.adminservice.v1.AdminService.ListHistoryTasks:output_type -> temporal.server.api.adminservice.v1.ListHistoryTasksResponse
-	50, // 50: temporal.server.api.adminservice.v1.AdminService.RemoveTask:output_type -> temporal.server.api.adminservice.v1.RemoveTaskResponse
-	51, // 51: temporal.server.api.adminservice.v1.AdminService.GetWorkflowExecutionRawHistoryV2:output_type -> temporal.server.api.adminservice.v1.GetWorkflowExecutionRawHistoryV2Response
-	52, // 52: temporal.server.api.adminservice.v1.AdminService.GetWorkflowExecutionRawHistory:output_type -> temporal.server.api.adminservice.v1.GetWorkflowExecutionRawHistoryResponse
-	53, // 53: temporal.server.api.adminservice.v1.AdminService.GetReplicationMessages:output_type -> temporal.server.api.adminservice.v1.GetReplicationMessagesResponse
-	54, // 54: temporal.server.api.adminservice.v1.AdminService.GetNamespaceReplicationMessages:output_type -> temporal.server.api.adminservice.v1.GetNamespaceReplicationMessagesResponse
-	55, // 55: temporal.server.api.adminservice.v1.AdminService.GetDLQReplicationMessages:output_type -> temporal.server.api.adminservice.v1.GetDLQReplicationMessagesResponse
-	56, // 56: temporal.server.api.adminservice.v1.AdminService.ReapplyEvents:output_type -> temporal.server.api.adminservice.v1.ReapplyEventsResponse
-	57, // 57: temporal.server.api.adminservice.v1.AdminService.AddSearchAttributes:output_type -> temporal.server.api.adminservice.v1.AddSearchAttributesResponse
-	58, // 58: temporal.server.api.adminservice.v1.AdminService.RemoveSearchAttributes:output_type -> temporal.server.api.adminservice.v1.RemoveSearchAttributesResponse
-	59, // 59: temporal.server.api.adminservice.v1.AdminService.GetSearchAttributes:output_type -> temporal.server.api.adminservice.v1.GetSearchAttributesResponse
-	60, // 60: temporal.server.api.adminservice.v1.AdminService.DescribeCluster:output_type -> temporal.server.api.adminservice.v1.DescribeClusterResponse
-	61, // 61: temporal.server.api.adminservice.v1.AdminService.ListClusters:output_type -> temporal.server.api.adminservice.v1.ListClustersResponse
-	62, // 62: temporal.server.api.adminservice.v1.AdminService.ListClusterMembers:output_type -> temporal.server.api.adminservice.v1.ListClusterMembersResponse
-	63, // 63: temporal.server.api.adminservice.v1.AdminService.AddOrUpdateRemoteCluster:output_type -> temporal.server.api.adminservice.v1.AddOrUpdateRemoteClusterResponse
-	64, // 64: temporal.server.api.adminservice.v1.AdminService.RemoveRemoteCluster:output_type -> temporal.server.api.adminservice.v1.RemoveRemoteClusterResponse
-	65, // 65: temporal.server.api.adminservice.v1.AdminService.GetDLQMessages:output_type -> temporal.server.api.adminservice.v1.GetDLQMessagesResponse
-	66, // 66: temporal.server.api.adminservice.v1.AdminService.PurgeDLQMessages:output_type -> temporal.server.api.adminservice.v1.PurgeDLQMessagesResponse
-	67, // 67: temporal.server.api.adminservice.v1.AdminService.MergeDLQMessages:output_type -> temporal.server.api.adminservice.v1.MergeDLQMessagesResponse
-	68, // 68: temporal.server.api.adminservice.v1.AdminService.RefreshWorkflowTasks:output_type -> temporal.server.api.adminservice.v1.RefreshWorkflowTasksResponse
-	69, // 69: temporal.server.api.adminservice.v1.AdminService.ResendReplicationTasks:output_type -> temporal.server.api.adminservice.v1.ResendReplicationTasksResponse
-	70, // 70: temporal.server.api.adminservice.v1.AdminService.GetTaskQueueTasks:output_type -> temporal.server.api.adminservice.v1.GetTaskQueueTasksResponse
-	71, // 71: temporal.server.api.adminservice.v1.AdminService.DeleteWorkflowExecution:output_type -> temporal.server.api.adminservice.v1.DeleteWorkflowExecutionResponse
-	72, // 72: temporal.server.api.adminservice.v1.AdminService.StreamWorkflowReplicationMessages:output_type -> temporal.server.api.adminservice.v1.StreamWorkflowReplicationMessagesResponse
-	73, // 73: temporal.server.api.adminservice.v1.AdminService.GetNamespace:output_type -> temporal.server.api.adminservice.v1.GetNamespaceResponse
-	74, // 74: temporal.server.api.adminservice.v1.AdminService.GetDLQTasks:output_type -> temporal.server.api.adminservice.v1.GetDLQTasksResponse
-	75, // 75: temporal.server.api.adminservice.v1.AdminService.PurgeDLQTasks:output_type -> temporal.server.api.adminservice.v1.PurgeDLQTasksResponse
-	76, // 76: temporal.server.api.adminservice.v1.AdminService.MergeDLQTasks:output_type -> temporal.server.api.adminservice.v1.MergeDLQTasksResponse
-	77, // 77: temporal.server.api.adminservice.v1.AdminService.DescribeDLQJob:output_type -> temporal.server.api.adminservice.v1.DescribeDLQJobResponse
-	78, // 78: temporal.server.api.adminservice.v1.AdminService.CancelDLQJob:output_type -> temporal.server.api.adminservice.v1.CancelDLQJobResponse
-	79, // 79: temporal.server.api.adminservice.v1.AdminService.AddTasks:output_type -> temporal.server.api.adminservice.v1.AddTasksResponse
-	80, // 80: temporal.server.api.adminservice.v1.AdminService.ListQueues:output_type -> temporal.server.api.adminservice.v1.ListQueuesResponse
-	81, // 81: temporal.server.api.adminservice.v1.AdminService.DeepHealthCheck:output_type -> temporal.server.api.adminservice.v1.DeepHealthCheckResponse
-	82, // 82: temporal.server.api.adminservice.v1.AdminService.SyncWorkflowState:output_type -> temporal.server.api.adminservice.v1.SyncWorkflowStateResponse
-	83, // 83: temporal.server.api.adminservice.v1.AdminService.GenerateLastHistoryReplicationTasks:output_type -> temporal.server.api.adminservice.v1.GenerateLastHistoryReplicationTasksResponse
-	84, // 84: temporal.server.api.adminservice.v1.AdminService.DescribeTaskQueuePartition:output_type -> temporal.server.api.adminservice.v1.DescribeTaskQueuePartitionResponse
-	85, // 85: temporal.server.api.adminservice.v1.AdminService.ForceUnloadTaskQueuePartition:output_type -> temporal.server.api.adminservice.v1.ForceUnloadTaskQueuePartitionResponse
-	43, // [43:86] is the sub-list for method output_type
-	0,  // [0:43] is the sub-list for method input_type
-	0,  // [0:0] is the sub-list for extension type_name
-	0,  // [0:0] is the sub-list for extension extendee
-	0,  // [0:0] is the sub-list for field type_name
+	0,   // 0: temporal.server.api.adminservice.v1.AdminService.RebuildMutableState:input_type -> temporal.server.api.adminservice.v1.RebuildMutableStateRequest
+	1,   // 1: temporal.server.api.adminservice.v1.AdminService.CheckMutableState:input_type -> temporal.server.api.adminservice.v1.CheckMutableStateRequest
+	2,   // 2: temporal.server.api.adminservice.v1.AdminService.ImportWorkflowExecution:input_type -> temporal.server.api.adminservice.v1.ImportWorkflowExecutionRequest
+	3,   // 3: temporal.server.api.adminservice.v1.AdminService.ForkWorkflowExecution:input_type -> temporal.server.api.adminservice.v1.ForkWorkflowExecutionRequest
+	4,   // 4: temporal.server.api.adminservice.v1.AdminService.RedactWorkflowExecution:input_type -> temporal.server.api.adminservice.v1.RedactWorkflowExecutionRequest
+	5,   // 5: temporal.server.api.adminservice.v1.AdminService.GetWorkflowExecutionTimeline:input_type -> temporal.server.api.adminservice.v1.GetWorkflowExecutionTimelineRequest
+	6,   // 6: temporal.server.api.adminservice.v1.AdminService.GetWorkflowHistoryBudget:input_type -> temporal.server.api.adminservice.v1.GetWorkflowHistoryBudgetRequest
+	7,   // 7: temporal.server.api.adminservice.v1.AdminService.DescribeMutableState:input_type -> temporal.server.api.adminservice.v1.DescribeMutableStateRequest
+	8,   // 8: temporal.server.api.adminservice.v1.AdminService.DescribeHistoryHost:input_type -> temporal.server.api.adminservice.v1.DescribeHistoryHostRequest
+	9,   // 9: temporal.server.api.adminservice.v1.AdminService.GetShard:input_type -> temporal.server.api.adminservice.v1.GetShardRequest
+	10,  // 10: temporal.server.api.adminservice.v1.AdminService.CloseShard:input_type -> temporal.server.api.adminservice.v1.CloseShardRequest
+	11,  // 11: temporal.server.api.adminservice.v1.AdminService.MoveHistoryShard:input_type -> temporal.server.api.adminservice.v1.MoveHistoryShardRequest
+	12,  // 12: temporal.server.api.adminservice.v1.AdminService.ListHistoryTasks:input_type -> temporal.server.api.adminservice.v1.ListHistoryTasksRequest
+	13,  // 13: temporal.server.api.adminservice.v1.AdminService.RemoveTask:input_type -> temporal.server.api.adminservice.v1.RemoveTaskRequest
+	14,  // 14: temporal.server.api.adminservice.v1.AdminService.GetWorkflowExecutionRawHistoryV2:input_type -> temporal.server.api.adminservice.v1.GetWorkflowExecutionRawHistoryV2Request
+	15,  // 15: temporal.server.api.adminservice.v1.AdminService.GetWorkflowExecutionRawHistory:input_type -> temporal.server.api.adminservice.v1.GetWorkflowExecutionRawHistoryRequest
+	16,  // 16: temporal.server.api.adminservice.v1.AdminService.GetReplicationMessages:input_type -> temporal.server.api.adminservice.v1.GetReplicationMessagesRequest
+	17,  // 17: temporal.server.api.adminservice.v1.AdminService.GetNamespaceReplicationMessages:input_type -> temporal.server.api.adminservice.v1.GetNamespaceReplicationMessagesRequest
+	18,  // 18: temporal.server.api.adminservice.v1.AdminService.GetDLQReplicationMessages:input_type -> temporal.server.api.adminservice.v1.GetDLQReplicationMessagesRequest
+	19,  // 19: temporal.server.api.adminservice.v1.AdminService.ReapplyEvents:input_type -> temporal.server.api.adminservice.v1.ReapplyEventsRequest
+	20,  // 20: temporal.server.api.adminservice.v1.AdminService.AddSearchAttributes:input_type -> temporal.server.api.adminservice.v1.AddSearchAttributesRequest
+	21,  // 21: temporal.server.api.adminservice.v1.AdminService.RemoveSearchAttributes:input_type -> temporal.server.api.adminservice.v1.RemoveSearchAttributesRequest
+	22,  // 22: temporal.server.api.adminservice.v1.AdminService.GetSearchAttributes:input_type -> temporal.server.api.adminservice.v1.GetSearchAttributesRequest
+	23,  // 23: temporal.server.api.adminservice.v1.AdminService.AggregateWorkflowExecutions:input_type -> temporal.server.api.adminservice.v1.AggregateWorkflowExecutionsRequest
+	24,  // 24: temporal.server.api.adminservice.v1.AdminService.UpsertSavedVisibilityQuery:input_type -> temporal.server.api.adminservice.v1.UpsertSavedVisibilityQueryRequest
+	25,  // 25: temporal.server.api.adminservice.v1.AdminService.DeleteSavedVisibilityQuery:input_type -> temporal.server.api.adminservice.v1.DeleteSavedVisibilityQueryRequest
+	26,  // 26: temporal.server.api.adminservice.v1.AdminService.ListSavedVisibilityQueries:input_type -> temporal.server.api.adminservice.v1.ListSavedVisibilityQueriesRequest
+	27,  // 27: temporal.server.api.adminservice.v1.AdminService.DescribeCluster:input_type -> temporal.server.api.adminservice.v1.DescribeClusterRequest
+	28,  // 28: temporal.server.api.adminservice.v1.AdminService.ListClusters:input_type -> temporal.server.api.adminservice.v1.ListClustersRequest
+	29,  // 29: temporal.server.api.adminservice.v1.AdminService.ListClusterMembers:input_type -> temporal.server.api.adminservice.v1.ListClusterMembersRequest
+	30,  // 30: temporal.server.api.adminservice.v1.AdminService.AddOrUpdateRemoteCluster:input_type -> temporal.server.api.adminservice.v1.AddOrUpdateRemoteClusterRequest
+	31,  // 31: temporal.server.api.adminservice.v1.AdminService.RemoveRemoteCluster:input_type -> temporal.server.api.adminservice.v1.RemoveRemoteClusterRequest
+	32,  // 32: temporal.server.api.adminservice.v1.AdminService.GetDLQMessages:input_type -> temporal.server.api.adminservice.v1.GetDLQMessagesRequest
+	33,  // 33: temporal.server.api.adminservice.v1.AdminService.PurgeDLQMessages:input_type -> temporal.server.api.adminservice.v1.PurgeDLQMessagesRequest
+	34,  // 34: temporal.server.api.adminservice.v1.AdminService.MergeDLQMessages:input_type -> temporal.server.api.adminservice.v1.MergeDLQMessagesRequest
+	35,  // 35: temporal.server.api.adminservice.v1.AdminService.RefreshWorkflowTasks:input_type -> temporal.server.api.adminservice.v1.RefreshWorkflowTasksRequest
+	36,  // 36: temporal.server.api.adminservice.v1.AdminService.ResendReplicationTasks:input_type -> temporal.server.api.adminservice.v1.ResendReplicationTasksRequest
+	37,  // 37: temporal.server.api.adminservice.v1.AdminService.GetTaskQueueTasks:input_type -> temporal.server.api.adminservice.v1.GetTaskQueueTasksRequest
+	38,  // 38: temporal.server.api.adminservice.v1.AdminService.DeleteWorkflowExecution:input_type -> temporal.server.api.adminservice.v1.DeleteWorkflowExecutionRequest
+	39,  // 39: temporal.server.api.adminservice.v1.AdminService.StreamWorkflowReplicationMessages:input_type -> temporal.server.api.adminservice.v1.StreamWorkflowReplicationMessagesRequest
+	40,  // 40: temporal.server.api.adminservice.v1.AdminService.GetNamespace:input_type -> temporal.server.api.adminservice.v1.GetNamespaceRequest
+	41,  // 41: temporal.server.api.adminservice.v1.AdminService.GetDLQTasks:input_type -> temporal.server.api.adminservice.v1.GetDLQTasksRequest
+	42,  // 42: temporal.server.api.adminservice.v1.AdminService.PurgeDLQTasks:input_type -> temporal.server.api.adminservice.v1.PurgeDLQTasksRequest
+	43,  // 43: temporal.server.api.adminservice.v1.AdminService.MergeDLQTasks:input_type -> temporal.server.api.adminservice.v1.MergeDLQTasksRequest
+	44,  // 44: temporal.server.api.adminservice.v1.AdminService.DescribeDLQJob:input_type -> temporal.server.api.adminservice.v1.DescribeDLQJobRequest
+	45,  // 45: temporal.server.api.adminservice.v1.AdminService.CancelDLQJob:input_type -> temporal.server.api.adminservice.v1.CancelDLQJobRequest
+	46,  // 46: temporal.server.api.adminservice.v1.AdminService.AddTasks:input_type -> temporal.server.api.adminservice.v1.AddTasksRequest
+	47,  // 47: temporal.server.api.adminservice.v1.AdminService.ListQueues:input_type -> temporal.server.api.adminservice.v1.ListQueuesRequest
+	48,  // 48: temporal.server.api.adminservice.v1.AdminService.DeepHealthCheck:input_type -> temporal.server.api.adminservice.v1.DeepHealthCheckRequest
+	49,  // 49: temporal.server.api.adminservice.v1.AdminService.SyncWorkflowState:input_type -> temporal.server.api.adminservice.v1.SyncWorkflowStateRequest
+	50,  // 50: temporal.server.api.adminservice.v1.AdminService.GenerateLastHistoryReplicationTasks:input_type -> temporal.server.api.adminservice.v1.GenerateLastHistoryReplicationTasksRequest
+	51,  // 51: temporal.server.api.adminservice.v1.AdminService.DescribeTaskQueuePartition:input_type -> temporal.server.api.adminservice.v1.DescribeTaskQueuePartitionRequest
+	52,  // 52: temporal.server.api.adminservice.v1.AdminService.ForceUnloadTaskQueuePartition:input_type -> temporal.server.api.adminservice.v1.ForceUnloadTaskQueuePartitionRequest
+	53,  // 53: temporal.server.api.adminservice.v1.AdminService.PauseTaskQueueDispatch:input_type -> temporal.server.api.adminservice.v1.PauseTaskQueueDispatchRequest
+	54,  // 54: temporal.server.api.adminservice.v1.AdminService.ResumeTaskQueueDispatch:input_type -> temporal.server.api.adminservice.v1.ResumeTaskQueueDispatchRequest
+	55,  // 55: temporal.server.api.adminservice.v1.AdminService.ListTaskQueueBacklogTasks:input_type -> temporal.server.api.adminservice.v1.ListTaskQueueBacklogTasksRequest
+	56,  // 56: temporal.server.api.adminservice.v1.AdminService.DeleteTaskQueueBacklogTasks:input_type -> temporal.server.api.adminservice.v1.DeleteTaskQueueBacklogTasksRequest
+	57,  // 57: temporal.server.api.adminservice.v1.AdminService.MoveTaskQueueBacklogTasks:input_type -> temporal.server.api.adminservice.v1.MoveTaskQueueBacklogTasksRequest
+	58,  // 58: temporal.server.api.adminservice.v1.AdminService.RebuildMutableState:output_type -> temporal.server.api.adminservice.v1.RebuildMutableStateResponse
+	59,  // 59: temporal.server.api.adminservice.v1.AdminService.CheckMutableState:output_type -> temporal.server.api.adminservice.v1.CheckMutableStateResponse
+	60,  // 60: temporal.server.api.adminservice.v1.AdminService.ImportWorkflowExecution:output_type -> temporal.server.api.adminservice.v1.ImportWorkflowExecutionResponse
+	61,  // 61: temporal.server.api.adminservice.v1.AdminService.ForkWorkflowExecution:output_type -> temporal.server.api.adminservice.v1.ForkWorkflowExecutionResponse
+	62,  // 62: temporal.server.api.adminservice.v1.AdminService.RedactWorkflowExecution:output_type -> temporal.server.api.adminservice.v1.RedactWorkflowExecutionResponse
+	63,  // 63: temporal.server.api.adminservice.v1.AdminService.GetWorkflowExecutionTimeline:output_type -> temporal.server.api.adminservice.v1.GetWorkflowExecutionTimelineResponse
+	64,  // 64: temporal.server.api.adminservice.v1.AdminService.GetWorkflowHistoryBudget:output_type -> temporal.server.api.adminservice.v1.GetWorkflowHistoryBudgetResponse
+	65,  // 65: temporal.server.api.adminservice.v1.AdminService.DescribeMutableState:output_type -> temporal.server.api.adminservice.v1.DescribeMutableStateResponse
+	66,  // 66: temporal.server.api.adminservice.v1.AdminService.DescribeHistoryHost:output_type -> temporal.server.api.adminservice.v1.DescribeHistoryHostResponse
+	67,  // 67: temporal.server.api.adminservice.v1.AdminService.GetShard:output_type -> temporal.server.api.adminservice.v1.GetShardResponse
+	68,  // 68: temporal.server.api.adminservice.v1.AdminService.CloseShard:output_type -> temporal.server.api.adminservice.v1.CloseShardResponse
+	69,  // 69: temporal.server.api.adminservice.v1.AdminService.MoveHistoryShard:output_type -> temporal.server.api.adminservice.v1.MoveHistoryShardResponse
+	70,  // 70: temporal.server.api.adminservice.v1.AdminService.ListHistoryTasks:output_type -> temporal.server.api.adminservice.v1.ListHistoryTasksResponse
+	71,  // 71: temporal.server.api.adminservice.v1.AdminService.RemoveTask:output_type -> temporal.server.api.adminservice.v1.RemoveTaskResponse
+	72,  // 72: temporal.server.api.adminservice.v1.AdminService.GetWorkflowExecutionRawHistoryV2:output_type -> temporal.server.api.adminservice.v1.GetWorkflowExecutionRawHistoryV2Response
+	73,  // 73: temporal.server.api.adminservice.v1.AdminService.GetWorkflowExecutionRawHistory:output_type -> temporal.server.api.adminservice.v1.GetWorkflowExecutionRawHistoryResponse
+	74,  // 74: temporal.server.api.adminservice.v1.AdminService.GetReplicationMessages:output_type -> temporal.server.api.adminservice.v1.GetReplicationMessagesResponse
+	75,  // 75: temporal.server.api.adminservice.v1.AdminService.GetNamespaceReplicationMessages:output_type -> temporal.server.api.adminservice.v1.GetNamespaceReplicationMessagesResponse
+	76,  // 76: temporal.server.api.adminservice.v1.AdminService.GetDLQReplicationMessages:output_type -> temporal.server.api.adminservice.v1.GetDLQReplicationMessagesResponse
+	77,  // 77: temporal.server.api.adminservice.v1.AdminService.ReapplyEvents:output_type -> temporal.server.api.adminservice.v1.ReapplyEventsResponse
+	78,  // 78: temporal.server.api.adminservice.v1.AdminService.AddSearchAttributes:output_type -> temporal.server.api.adminservice.v1.AddSearchAttributesResponse
+	79,  // 79: temporal.server.api.adminservice.v1.AdminService.RemoveSearchAttributes:output_type -> temporal.server.api.adminservice.v1.RemoveSearchAttributesResponse
+	80,  // 80: temporal.server.api.adminservice.v1.AdminService.GetSearchAttributes:output_type -> temporal.server.api.adminservice.v1.GetSearchAttributesResponse
+	81,  // 81: temporal.server.api.adminservice.v1.AdminService.AggregateWorkflowExecutions:output_type -> temporal.server.api.adminservice.v1.AggregateWorkflowExecutionsResponse
+	82,  // 82: temporal.server.api.adminservice.v1.AdminService.UpsertSavedVisibilityQuery:output_type -> temporal.server.api.adminservice.v1.UpsertSavedVisibilityQueryResponse
+	83,  // 83: temporal.server.api.adminservice.v1.AdminService.DeleteSavedVisibilityQuery:output_type -> temporal.server.api.adminservice.v1.DeleteSavedVisibilityQueryResponse
+	84,  // 84: temporal.server.api.adminservice.v1.AdminService.ListSavedVisibilityQueries:output_type -> temporal.server.api.adminservice.v1.ListSavedVisibilityQueriesResponse
+	85,  // 85: temporal.server.api.adminservice.v1.AdminService.DescribeCluster:output_type -> temporal.server.api.adminservice.v1.DescribeClusterResponse
+	86,  // 86: temporal.server.api.adminservice.v1.AdminService.ListClusters:output_type -> temporal.server.api.adminservice.v1.ListClustersResponse
+	87,  // 87: temporal.server.api.adminservice.v1.AdminService.ListClusterMembers:output_type -> temporal.server.api.adminservice.v1.ListClusterMembersResponse
+	88,  // 88: temporal.server.api.adminservice.v1.AdminService.AddOrUpdateRemoteCluster:output_type -> temporal.server.api.adminservice.v1.AddOrUpdateRemoteClusterResponse
+	89,  // 89: temporal.server.api.adminservice.v1.AdminService.RemoveRemoteCluster:output_type -> temporal.server.api.adminservice.v1.RemoveRemoteClusterResponse
+	90,  // 90: temporal.server.api.adminservice.v1.AdminService.GetDLQMessages:output_type -> temporal.server.api.adminservice.v1.GetDLQMessagesResponse
+	91,  // 91: temporal.server.api.adminservice.v1.AdminService.PurgeDLQMessages:output_type -> temporal.server.api.adminservice.v1.PurgeDLQMessagesResponse
+	92,  // 92: temporal.server.api.adminservice.v1.AdminService.MergeDLQMessages:output_type -> temporal.server.api.adminservice.v1.MergeDLQMessagesResponse
+	93,  // 93: temporal.server.api.adminservice.v1.AdminService.RefreshWorkflowTasks:output_type -> temporal.server.api.adminservice.v1.RefreshWorkflowTasksResponse
+	94,  // 94: temporal.server.api.adminservice.v1.AdminService.ResendReplicationTasks:output_type -> temporal.server.api.adminservice.v1.ResendReplicationTasksResponse
+	95,  // 95: temporal.server.api.adminservice.v1.AdminService.GetTaskQueueTasks:output_type -> temporal.server.api.adminservice.v1.GetTaskQueueTasksResponse
+	96,  // 96: temporal.server.api.adminservice.v1.AdminService.DeleteWorkflowExecution:output_type -> temporal.server.api.adminservice.v1.DeleteWorkflowExecutionResponse
+	97,  // 97: temporal.server.api.adminservice.v1.AdminService.StreamWorkflowReplicationMessages:output_type -> temporal.server.api.adminservice.v1.StreamWorkflowReplicationMessagesResponse
+	98,  // 98: temporal.server.api.adminservice.v1.AdminService.GetNamespace:output_type -> temporal.server.api.adminservice.v1.GetNamespaceResponse
+	99,  // 99: temporal.server.api.adminservice.v1.AdminService.GetDLQTasks:output_type -> temporal.server.api.adminservice.v1.GetDLQTasksResponse
+	100, // 100: temporal.server.api.adminservice.v1.AdminService.PurgeDLQTasks:output_type -> temporal.server.api.adminservice.v1.PurgeDLQTasksResponse
+	101, // 101: temporal.server.api.adminservice.v1.AdminService.MergeDLQTasks:output_type -> temporal.server.api.adminservice.v1.MergeDLQTasksResponse
+	102, // 102: temporal.server.api.adminservice.v1.AdminService.DescribeDLQJob:output_type -> temporal.server.api.adminservice.v1.DescribeDLQJobResponse
+	103, // 103: temporal.server.api.adminservice.v1.AdminService.CancelDLQJob:output_type -> temporal.server.api.adminservice.v1.CancelDLQJobResponse
+	104, // 104: temporal.server.api.adminservice.v1.AdminService.AddTasks:output_type -> temporal.server.api.adminservice.v1.AddTasksResponse
+	105, // 105: temporal.server.api.adminservice.v1.AdminService.ListQueues:output_type -> temporal.server.api.adminservice.v1.ListQueuesResponse
+	106, // 106: temporal.server.api.adminservice.v1.AdminService.DeepHealthCheck:output_type -> temporal.server.api.adminservice.v1.DeepHealthCheckResponse
+	107, // 107: temporal.server.api.adminservice.v1.AdminService.SyncWorkflowState:output_type -> temporal.server.api.adminservice.v1.SyncWorkflowStateResponse
+	108, // 108: temporal.server.api.adminservice.v1.AdminService.GenerateLastHistoryReplicationTasks:output_type -> temporal.server.api.adminservice.v1.GenerateLastHistoryReplicationTasksResponse
+	109, // 109: temporal.server.api.adminservice.v1.AdminService.DescribeTaskQueuePartition:output_type -> temporal.server.api.adminservice.v1.DescribeTaskQueuePartitionResponse
+	110, // 110: temporal.server.api.adminservice.v1.AdminService.ForceUnloadTaskQueuePartition:output_type -> temporal.server.api.adminservice.v1.ForceUnloadTaskQueuePartitionResponse
+	111, // 111: temporal.server.api.adminservice.v1.AdminService.PauseTaskQueueDispatch:output_type -> temporal.server.api.adminservice.v1.PauseTaskQueueDispatchResponse
+	112, // 112: temporal.server.api.adminservice.v1.AdminService.ResumeTaskQueueDispatch:output_type -> temporal.server.api.adminservice.v1.ResumeTaskQueueDispatchResponse
+	113, // 113: temporal.server.api.adminservice.v1.AdminService.ListTaskQueueBacklogTasks:output_type -> temporal.server.api.adminservice.v1.ListTaskQueueBacklogTasksResponse
+	114, // 114: temporal.server.api.adminservice.v1.AdminService.DeleteTaskQueueBacklogTasks:output_type -> temporal.server.api.adminservice.v1.DeleteTaskQueueBacklogTasksResponse
+	115, // 115: temporal.server.api.adminservice.v1.AdminService.MoveTaskQueueBacklogTasks:output_type -> temporal.server.api.adminservice.v1.MoveTaskQueueBacklogTasksResponse
+	58,  // [58:116] is the sub-list for method output_type
+	0,   // [0:58] is the sub-list for method input_type
+	0,   // [0:0] is the sub-list for extension type_name
+	0,   // [0:0] is the sub-list for extension extendee
+	0,   // [0:0] is the sub-list for field type_name
 }
 
 func init() { file_temporal_server_api_adminservice_v1_service_proto_init() }
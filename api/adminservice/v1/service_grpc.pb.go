@@ -21,11 +21,17 @@ const _ = grpc.SupportPackageIsVersion7
 
 const (
 	AdminService_RebuildMutableState_FullMethodName                 = "/temporal.server.api.adminservice.v1.AdminService/RebuildMutableState"
+	AdminService_CheckMutableState_FullMethodName                   = "/temporal.server.api.adminservice.v1.AdminService/CheckMutableState"
 	AdminService_ImportWorkflowExecution_FullMethodName             = "/temporal.server.api.adminservice.v1.AdminService/ImportWorkflowExecution"
+	AdminService_ForkWorkflowExecution_FullMethodName               = "/temporal.server.api.adminservice.v1.AdminService/ForkWorkflowExecution"
+	AdminService_RedactWorkflowExecution_FullMethodName             = "/temporal.server.api.adminservice.v1.AdminService/RedactWorkflowExecution"
+	AdminService_GetWorkflowExecutionTimeline_FullMethodName        = "/temporal.server.api.adminservice.v1.AdminService/GetWorkflowExecutionTimeline"
+	AdminService_GetWorkflowHistoryBudget_FullMethodName            = "/temporal.server.api.adminservice.v1.AdminService/GetWorkflowHistoryBudget"
 	AdminService_DescribeMutableState_FullMethodName                = "/temporal.server.api.adminservice.v1.AdminService/DescribeMutableState"
 	AdminService_DescribeHistoryHost_FullMethodName                 = "/temporal.server.api.adminservice.v1.AdminService/DescribeHistoryHost"
 	AdminService_GetShard_FullMethodName                            = "/temporal.server.api.adminservice.v1.AdminService/GetShard"
 	AdminService_CloseShard_FullMethodName                          = "/temporal.server.api.adminservice.v1.AdminService/CloseShard"
+	AdminService_MoveHistoryShard_FullMethodName                    = "/temporal.server.api.adminservice.v1.AdminService/MoveHistoryShard"
 	AdminService_ListHistoryTasks_FullMethodName                    = "/temporal.server.api.adminservice.v1.AdminService/ListHistoryTasks"
 	AdminService_RemoveTask_FullMethodName                          = "/temporal.server.api.adminservice.v1.AdminService/RemoveTask"
 	AdminService_GetWorkflowExecutionRawHistoryV2_FullMethodName    = "/temporal.server.api.adminservice.v1.AdminService/GetWorkflowExecutionRawHistoryV2"
@@ -37,6 +43,10 @@ const (
 	AdminService_AddSearchAttributes_FullMethodName                 = "/temporal.server.api.adminservice.v1.AdminService/AddSearchAttributes"
 	AdminService_RemoveSearchAttributes_FullMethodName              = "/temporal.server.api.adminservice.v1.AdminService/RemoveSearchAttributes"
 	AdminService_GetSearchAttributes_FullMethodName                 = "/temporal.server.api.adminservice.v1.AdminService/GetSearchAttributes"
+	AdminService_AggregateWorkflowExecutions_FullMethodName         = "/temporal.server.api.adminservice.v1.AdminService/AggregateWorkflowExecutions"
+	AdminService_UpsertSavedVisibilityQuery_FullMethodName          = "/temporal.server.api.adminservice.v1.AdminService/UpsertSavedVisibilityQuery"
+	AdminService_DeleteSavedVisibilityQuery_FullMethodName          = "/temporal.server.api.adminservice.v1.AdminService/DeleteSavedVisibilityQuery"
+	AdminService_ListSavedVisibilityQueries_FullMethodName          = "/temporal.server.api.adminservice.v1.AdminService/ListSavedVisibilityQueries"
 	AdminService_DescribeCluster_FullMethodName                     = "/temporal.server.api.adminservice.v1.AdminService/DescribeCluster"
 	AdminService_ListClusters_FullMethodName                        = "/temporal.server.api.adminservice.v1.AdminService/ListClusters"
 	AdminService_ListClusterMembers_FullMethodName                  = "/temporal.server.api.adminservice.v1.AdminService/ListClusterMembers"
@@ -63,6 +73,11 @@ const (
 	AdminService_GenerateLastHistoryReplicationTasks_FullMethodName = "/temporal.server.api.adminservice.v1.AdminService/GenerateLastHistoryReplicationTasks"
 	AdminService_DescribeTaskQueuePartition_FullMethodName          = "/temporal.server.api.adminservice.v1.AdminService/DescribeTaskQueuePartition"
 	AdminService_ForceUnloadTaskQueuePartition_FullMethodName       = "/temporal.server.api.adminservice.v1.AdminService/ForceUnloadTaskQueuePartition"
+	AdminService_PauseTaskQueueDispatch_FullMethodName              = "/temporal.server.api.adminservice.v1.AdminService/PauseTaskQueueDispatch"
+	AdminService_ResumeTaskQueueDispatch_FullMethodName             = "/temporal.server.api.adminservice.v1.AdminService/ResumeTaskQueueDispatch"
+	AdminService_ListTaskQueueBacklogTasks_FullMethodName           = "/temporal.server.api.adminservice.v1.AdminService/ListTaskQueueBacklogTasks"
+	AdminService_DeleteTaskQueueBacklogTasks_FullMethodName         = "/temporal.server.api.adminservice.v1.AdminService/DeleteTaskQueueBacklogTasks"
+	AdminService_MoveTaskQueueBacklogTasks_FullMethodName           = "/temporal.server.api.adminservice.v1.AdminService/MoveTaskQueueBacklogTasks"
 )
 
 // AdminServiceClient is the client API for AdminService service.
@@ -72,15 +87,36 @@ type AdminServiceClient interface {
 	// RebuildMutableState attempts to rebuild mutable state according to persisted history events.
 	// NOTE: this is experimental API
 	RebuildMutableState(ctx context.Context, in *RebuildMutableStateRequest, opts ...grpc.CallOption) (*RebuildMutableStateResponse, error)
+	// CheckMutableState validates the mutable state of a workflow execution against a mutable state
+	// rebuilt from its history, without modifying either.
+	CheckMutableState(ctx context.Context, in *CheckMutableStateRequest, opts ...grpc.CallOption) (*CheckMutableStateResponse, error)
 	// ImportWorkflowExecution attempts to import workflow according to persisted history events.
 	// NOTE: this is experimental API
 	ImportWorkflowExecution(ctx context.Context, in *ImportWorkflowExecutionRequest, opts ...grpc.CallOption) (*ImportWorkflowExecutionResponse, error)
+	// ForkWorkflowExecution copies the history of a workflow up to the given workflow task into a new
+	// workflow execution. The forked workflow is left untouched.
+	// NOTE: this is experimental API
+	ForkWorkflowExecution(ctx context.Context, in *ForkWorkflowExecutionRequest, opts ...grpc.CallOption) (*ForkWorkflowExecutionResponse, error)
+	// RedactWorkflowExecution erases the selected payloads from the history of a closed workflow
+	// execution, e.g. to honor a data deletion request, while keeping the execution and its audit trail.
+	// NOTE: this is experimental API
+	RedactWorkflowExecution(ctx context.Context, in *RedactWorkflowExecutionRequest, opts ...grpc.CallOption) (*RedactWorkflowExecutionResponse, error)
+	// GetWorkflowExecutionTimeline analyzes the history of a workflow execution and returns its
+	// workflow tasks, activities, timers, child workflows and Nexus operations as timed spans along
+	// with the critical path of the execution.
+	GetWorkflowExecutionTimeline(ctx context.Context, in *GetWorkflowExecutionTimelineRequest, opts ...grpc.CallOption) (*GetWorkflowExecutionTimelineResponse, error)
+	// GetWorkflowHistoryBudget returns the consumption of a workflow execution against its history
+	// budget, along with the actions taken on it.
+	GetWorkflowHistoryBudget(ctx context.Context, in *GetWorkflowHistoryBudgetRequest, opts ...grpc.CallOption) (*GetWorkflowHistoryBudgetResponse, error)
 	// DescribeWorkflowExecution returns information about the internal states of workflow execution.
 	DescribeMutableState(ctx context.Context, in *DescribeMutableStateRequest, opts ...grpc.CallOption) (*DescribeMutableStateResponse, error)
 	// DescribeHistoryHost returns information about the internal states of a history host
 	DescribeHistoryHost(ctx context.Context, in *DescribeHistoryHostRequest, opts ...grpc.CallOption) (*DescribeHistoryHostResponse, error)
 	GetShard(ctx context.Context, in *GetShardRequest, opts ...grpc.CallOption) (*GetShardResponse, error)
 	CloseShard(ctx context.Context, in *CloseShardRequest, opts ...grpc.CallOption) (*CloseShardResponse, error)
+	// MoveHistoryShard moves a history shard to the given history host. The shard is handed off
+	// gracefully: the current owner keeps serving it until the target host has acquired it.
+	MoveHistoryShard(ctx context.Context, in *MoveHistoryShardRequest, opts ...grpc.CallOption) (*MoveHistoryShardResponse, error)
 	ListHistoryTasks(ctx context.Context, in *ListHistoryTasksRequest, opts ...grpc.CallOption) (*ListHistoryTasksResponse, error)
 	RemoveTask(ctx context.Context, in *RemoveTaskRequest, opts ...grpc.CallOption) (*RemoveTaskResponse, error)
 	// Returns the raw history of specified workflow execution.  It fails with 'NotFound' if specified workflow
@@ -108,6 +144,16 @@ type AdminServiceClient interface {
 	// GetSearchAttributes returns comprehensive information about search attributes.
 	// Deprecated. Use operatorservice instead.
 	GetSearchAttributes(ctx context.Context, in *GetSearchAttributesRequest, opts ...grpc.CallOption) (*GetSearchAttributesResponse, error)
+	// AggregateWorkflowExecutions groups the workflow executions matching a visibility query by keyword
+	// search attributes, and computes aggregations over numeric and datetime search attributes per group.
+	AggregateWorkflowExecutions(ctx context.Context, in *AggregateWorkflowExecutionsRequest, opts ...grpc.CallOption) (*AggregateWorkflowExecutionsResponse, error)
+	// UpsertSavedVisibilityQuery creates or replaces a named visibility query of a namespace. Saved queries
+	// are periodically counted by the saved query evaluator, which emits a gauge per query.
+	UpsertSavedVisibilityQuery(ctx context.Context, in *UpsertSavedVisibilityQueryRequest, opts ...grpc.CallOption) (*UpsertSavedVisibilityQueryResponse, error)
+	// DeleteSavedVisibilityQuery deletes a named visibility query of a namespace.
+	DeleteSavedVisibilityQuery(ctx context.Context, in *DeleteSavedVisibilityQueryRequest, opts ...grpc.CallOption) (*DeleteSavedVisibilityQueryResponse, error)
+	// ListSavedVisibilityQueries returns the named visibility queries of a namespace.
+	ListSavedVisibilityQueries(ctx context.Context, in *ListSavedVisibilityQueriesRequest, opts ...grpc.CallOption) (*ListSavedVisibilityQueriesResponse, error)
 	// DescribeCluster returns information about Temporal cluster.
 	DescribeCluster(ctx context.Context, in *DescribeClusterRequest, opts ...grpc.CallOption) (*DescribeClusterResponse, error)
 	// ListClusters returns information about Temporal clusters.
@@ -153,6 +199,20 @@ type AdminServiceClient interface {
 	GenerateLastHistoryReplicationTasks(ctx context.Context, in *GenerateLastHistoryReplicationTasksRequest, opts ...grpc.CallOption) (*GenerateLastHistoryReplicationTasksResponse, error)
 	DescribeTaskQueuePartition(ctx context.Context, in *DescribeTaskQueuePartitionRequest, opts ...grpc.CallOption) (*DescribeTaskQueuePartitionResponse, error)
 	ForceUnloadTaskQueuePartition(ctx context.Context, in *ForceUnloadTaskQueuePartitionRequest, opts ...grpc.CallOption) (*ForceUnloadTaskQueuePartitionResponse, error)
+	// PauseTaskQueueDispatch pauses dispatch of tasks of a task queue, optionally only for some task queue types or
+	// priority levels. Tasks are still accepted into the backlog while dispatch is paused.
+	PauseTaskQueueDispatch(ctx context.Context, in *PauseTaskQueueDispatchRequest, opts ...grpc.CallOption) (*PauseTaskQueueDispatchResponse, error)
+	// ResumeTaskQueueDispatch resumes dispatch of tasks paused by PauseTaskQueueDispatch.
+	ResumeTaskQueueDispatch(ctx context.Context, in *ResumeTaskQueueDispatchRequest, opts ...grpc.CallOption) (*ResumeTaskQueueDispatchResponse, error)
+	// ListTaskQueueBacklogTasks lists the backlog tasks of a task queue partition, with their workflow, priority,
+	// fairness key and age. Only the unversioned queue of the partition is listed.
+	ListTaskQueueBacklogTasks(ctx context.Context, in *ListTaskQueueBacklogTasksRequest, opts ...grpc.CallOption) (*ListTaskQueueBacklogTasksResponse, error)
+	// DeleteTaskQueueBacklogTasks deletes tasks from the backlog of a task queue partition. The tasks are never
+	// dispatched; their workflows have to be recovered by other means, e.g. by a reset.
+	DeleteTaskQueueBacklogTasks(ctx context.Context, in *DeleteTaskQueueBacklogTasksRequest, opts ...grpc.CallOption) (*DeleteTaskQueueBacklogTasksResponse, error)
+	// MoveTaskQueueBacklogTasks moves a page of backlog tasks of a task queue partition to another task queue.
+	// Call it again with the returned page token until the token is empty to move the whole backlog.
+	MoveTaskQueueBacklogTasks(ctx context.Context, in *MoveTaskQueueBacklogTasksRequest, opts ...grpc.CallOption) (*MoveTaskQueueBacklogTasksResponse, error)
 }
 
 type adminServiceClient struct {
@@ -172,6 +232,15 @@ func (c *adminServiceClient) RebuildMutableState(ctx context.Context, in *Rebuil
 	return out, nil
 }
 
+func (c *adminServiceClient) CheckMutableState(ctx context.Context, in *CheckMutableStateRequest, opts ...grpc.CallOption) (*CheckMutableStateResponse, error) {
+	out := new(CheckMutableStateResponse)
+	err := c.cc.Invoke(ctx, AdminService_CheckMutableState_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *adminServiceClient) ImportWorkflowExecution(ctx context.Context, in *ImportWorkflowExecutionRequest, opts ...grpc.CallOption) (*ImportWorkflowExecutionResponse, error) {
 	out := new(ImportWorkflowExecutionResponse)
 	err := c.cc.Invoke(ctx, AdminService_ImportWorkflowExecution_FullMethodName, in, out, opts...)
@@ -181,6 +250,42 @@ func (c *adminServiceClient) ImportWorkflowExecution(ctx context.Context, in *Im
 	return out, nil
 }
 
+func (c *adminServiceClient) ForkWorkflowExecution(ctx context.Context, in *ForkWorkflowExecutionRequest, opts ...grpc.CallOption) (*ForkWorkflowExecutionResponse, error) {
+	out := new(ForkWorkflowExecutionResponse)
+	err := c.cc.Invoke(ctx, AdminService_ForkWorkflowExecution_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminServiceClient) RedactWorkflowExecution(ctx context.Context, in *RedactWorkflowExecutionRequest, opts ...grpc.CallOption) (*RedactWorkflowExecutionResponse, error) {
+	out := new(RedactWorkflowExecutionResponse)
+	err := c.cc.Invoke(ctx, AdminService_RedactWorkflowExecution_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminServiceClient) GetWorkflowExecutionTimeline(ctx context.Context, in *GetWorkflowExecutionTimelineRequest, opts ...grpc.CallOption) (*GetWorkflowExecutionTimelineResponse, error) {
+	out := new(GetWorkflowExecutionTimelineResponse)
+	err := c.cc.Invoke(ctx, AdminService_GetWorkflowExecutionTimeline_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminServiceClient) GetWorkflowHistoryBudget(ctx context.Context, in *GetWorkflowHistoryBudgetRequest, opts ...grpc.CallOption) (*GetWorkflowHistoryBudgetResponse, error) {
+	out := new(GetWorkflowHistoryBudgetResponse)
+	err := c.cc.Invoke(ctx, AdminService_GetWorkflowHistoryBudget_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *adminServiceClient) DescribeMutableState(ctx context.Context, in *DescribeMutableStateRequest, opts ...grpc.CallOption) (*DescribeMutableStateResponse, error) {
 	out := new(DescribeMutableStateResponse)
 	err := c.cc.Invoke(ctx, AdminService_DescribeMutableState_FullMethodName, in, out, opts...)
@@ -217,6 +322,15 @@ func (c *adminServiceClient) CloseShard(ctx context.Context, in *CloseShardReque
 	return out, nil
 }
 
+func (c *adminServiceClient) MoveHistoryShard(ctx context.Context, in *MoveHistoryShardRequest, opts ...grpc.CallOption) (*MoveHistoryShardResponse, error) {
+	out := new(MoveHistoryShardResponse)
+	err := c.cc.Invoke(ctx, AdminService_MoveHistoryShard_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *adminServiceClient) ListHistoryTasks(ctx context.Context, in *ListHistoryTasksRequest, opts ...grpc.CallOption) (*ListHistoryTasksResponse, error) {
 	out := new(ListHistoryTasksResponse)
 	err := c.cc.Invoke(ctx, AdminService_ListHistoryTasks_FullMethodName, in, out, opts...)
@@ -316,6 +430,42 @@ func (c *adminServiceClient) GetSearchAttributes(ctx context.Context, in *GetSea
 	return out, nil
 }
 
+func (c *adminServiceClient) AggregateWorkflowExecutions(ctx context.Context, in *AggregateWorkflowExecutionsRequest, opts ...grpc.CallOption) (*AggregateWorkflowExecutionsResponse, error) {
+	out := new(AggregateWorkflowExecutionsResponse)
+	err := c.cc.Invoke(ctx, AdminService_AggregateWorkflowExecutions_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminServiceClient) UpsertSavedVisibilityQuery(ctx context.Context, in *UpsertSavedVisibilityQueryRequest, opts ...grpc.CallOption) (*UpsertSavedVisibilityQueryResponse, error) {
+	out := new(UpsertSavedVisibilityQueryResponse)
+	err := c.cc.Invoke(ctx, AdminService_UpsertSavedVisibilityQuery_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminServiceClient) DeleteSavedVisibilityQuery(ctx context.Context, in *DeleteSavedVisibilityQueryRequest, opts ...grpc.CallOption) (*DeleteSavedVisibilityQueryResponse, error) {
+	out := new(DeleteSavedVisibilityQueryResponse)
+	err := c.cc.Invoke(ctx, AdminService_DeleteSavedVisibilityQuery_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminServiceClient) ListSavedVisibilityQueries(ctx context.Context, in *ListSavedVisibilityQueriesRequest, opts ...grpc.CallOption) (*ListSavedVisibilityQueriesResponse, error) {
+	out := new(ListSavedVisibilityQueriesResponse)
+	err := c.cc.Invoke(ctx, AdminService_ListSavedVisibilityQueries_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *adminServiceClient) DescribeCluster(ctx context.Context, in *DescribeClusterRequest, opts ...grpc.CallOption) (*DescribeClusterResponse, error) {
 	out := new(DescribeClusterResponse)
 	err := c.cc.Invoke(ctx, AdminService_DescribeCluster_FullMethodName, in, out, opts...)
@@ -572,6 +722,51 @@ func (c *adminServiceClient) ForceUnloadTaskQueuePartition(ctx context.Context,
 	return out, nil
 }
 
+func (c *adminServiceClient) PauseTaskQueueDispatch(ctx context.Context, in *PauseTaskQueueDispatchRequest, opts ...grpc.CallOption) (*PauseTaskQueueDispatchResponse, error) {
+	out := new(PauseTaskQueueDispatchResponse)
+	err := c.cc.Invoke(ctx, AdminService_PauseTaskQueueDispatch_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminServiceClient) ResumeTaskQueueDispatch(ctx context.Context, in *ResumeTaskQueueDispatchRequest, opts ...grpc.CallOption) (*ResumeTaskQueueDispatchResponse, error) {
+	out := new(ResumeTaskQueueDispatchResponse)
+	err := c.cc.Invoke(ctx, AdminService_ResumeTaskQueueDispatch_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminServiceClient) ListTaskQueueBacklogTasks(ctx context.Context, in *ListTaskQueueBacklogTasksRequest, opts ...grpc.CallOption) (*ListTaskQueueBacklogTasksResponse, error) {
+	out := new(ListTaskQueueBacklogTasksResponse)
+	err := c.cc.Invoke(ctx, AdminService_ListTaskQueueBacklogTasks_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminServiceClient) DeleteTaskQueueBacklogTasks(ctx context.Context, in *DeleteTaskQueueBacklogTasksRequest, opts ...grpc.CallOption) (*DeleteTaskQueueBacklogTasksResponse, error) {
+	out := new(DeleteTaskQueueBacklogTasksResponse)
+	err := c.cc.Invoke(ctx, AdminService_DeleteTaskQueueBacklogTasks_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminServiceClient) MoveTaskQueueBacklogTasks(ctx context.Context, in *MoveTaskQueueBacklogTasksRequest, opts ...grpc.CallOption) (*MoveTaskQueueBacklogTasksResponse, error) {
+	out := new(MoveTaskQueueBacklogTasksResponse)
+	err := c.cc.Invoke(ctx, AdminService_MoveTaskQueueBacklogTasks_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // AdminServiceServer is the server API for AdminService service.
 // All implementations must embed UnimplementedAdminServiceServer
 // for forward compatibility
@@ -579,15 +774,36 @@ type AdminServiceServer interface {
 	// RebuildMutableState attempts to rebuild mutable state according to persisted history events.
 	// NOTE: this is experimental API
 	RebuildMutableState(context.Context, *RebuildMutableStateRequest) (*RebuildMutableStateResponse, error)
+	// CheckMutableState validates the mutable state of a workflow execution against a mutable state
+	// rebuilt from its history, without modifying either.
+	CheckMutableState(context.Context, *CheckMutableStateRequest) (*CheckMutableStateResponse, error)
 	// ImportWorkflowExecution attempts to import workflow according to persisted history events.
 	// NOTE: this is experimental API
 	ImportWorkflowExecution(context.Context, *ImportWorkflowExecutionRequest) (*ImportWorkflowExecutionResponse, error)
+	// ForkWorkflowExecution copies the history of a workflow up to the given workflow task into a new
+	// workflow execution. The forked workflow is left untouched.
+	// NOTE: this is experimental API
+	ForkWorkflowExecution(context.Context, *ForkWorkflowExecutionRequest) (*ForkWorkflowExecutionResponse, error)
+	// RedactWorkflowExecution erases the selected payloads from the history of a closed workflow
+	// execution, e.g. to honor a data deletion request, while keeping the execution and its audit trail.
+	// NOTE: this is experimental API
+	RedactWorkflowExecution(context.Context, *RedactWorkflowExecutionRequest) (*RedactWorkflowExecutionResponse, error)
+	// GetWorkflowExecutionTimeline analyzes the history of a workflow execution and returns its
+	// workflow tasks, activities, timers, child workflows and Nexus operations as timed spans along
+	// with the critical path of the execution.
+	GetWorkflowExecutionTimeline(context.Context, *GetWorkflowExecutionTimelineRequest) (*GetWorkflowExecutionTimelineResponse, error)
+	// GetWorkflowHistoryBudget returns the consumption of a workflow execution against its history
+	// budget, along with the actions taken on it.
+	GetWorkflowHistoryBudget(context.Context, *GetWorkflowHistoryBudgetRequest) (*GetWorkflowHistoryBudgetResponse, error)
 	// DescribeWorkflowExecution returns information about the internal states of workflow execution.
 	DescribeMutableState(context.Context, *DescribeMutableStateRequest) (*DescribeMutableStateResponse, error)
 	// DescribeHistoryHost returns information about the internal states of a history host
 	DescribeHistoryHost(context.Context, *DescribeHistoryHostRequest) (*DescribeHistoryHostResponse, error)
 	GetShard(context.Context, *GetShardRequest) (*GetShardResponse, error)
 	CloseShard(context.Context, *CloseShardRequest) (*CloseShardResponse, error)
+	// MoveHistoryShard moves a history shard to the given history host. The shard is handed off
+	// gracefully: the current owner keeps serving it until the target host has acquired it.
+	MoveHistoryShard(context.Context, *MoveHistoryShardRequest) (*MoveHistoryShardResponse, error)
 	ListHistoryTasks(context.Context, *ListHistoryTasksRequest) (*ListHistoryTasksResponse, error)
 	RemoveTask(context.Context, *RemoveTaskRequest) (*RemoveTaskResponse, error)
 	// Returns the raw history of specified workflow execution.  It fails with 'NotFound' if specified workflow
@@ -615,6 +831,16 @@ type AdminServiceServer interface {
 	// GetSearchAttributes returns comprehensive information about search attributes.
 	// Deprecated. Use operatorservice instead.
 	GetSearchAttributes(context.Context, *GetSearchAttributesRequest) (*GetSearchAttributesResponse, error)
+	// AggregateWorkflowExecutions groups the workflow executions matching a visibility query by keyword
+	// search attributes, and computes aggregations over numeric and datetime search attributes per group.
+	AggregateWorkflowExecutions(context.Context, *AggregateWorkflowExecutionsRequest) (*AggregateWorkflowExecutionsResponse, error)
+	// UpsertSavedVisibilityQuery creates or replaces a named visibility query of a namespace. Saved queries
+	// are periodically counted by the saved query evaluator, which emits a gauge per query.
+	UpsertSavedVisibilityQuery(context.Context, *UpsertSavedVisibilityQueryRequest) (*UpsertSavedVisibilityQueryResponse, error)
+	// DeleteSavedVisibilityQuery deletes a named visibility query of a namespace.
+	DeleteSavedVisibilityQuery(context.Context, *DeleteSavedVisibilityQueryRequest) (*DeleteSavedVisibilityQueryResponse, error)
+	// ListSavedVisibilityQueries returns the named visibility queries of a namespace.
+	ListSavedVisibilityQueries(context.Context, *ListSavedVisibilityQueriesRequest) (*ListSavedVisibilityQueriesResponse, error)
 	// DescribeCluster returns information about Temporal cluster.
 	DescribeCluster(context.Context, *DescribeClusterRequest) (*DescribeClusterResponse, error)
 	// ListClusters returns information about Temporal clusters.
@@ -660,6 +886,20 @@ type AdminServiceServer interface {
 	GenerateLastHistoryReplicationTasks(context.Context, *GenerateLastHistoryReplicationTasksRequest) (*GenerateLastHistoryReplicationTasksResponse, error)
 	DescribeTaskQueuePartition(context.Context, *DescribeTaskQueuePartitionRequest) (*DescribeTaskQueuePartitionResponse, error)
 	ForceUnloadTaskQueuePartition(context.Context, *ForceUnloadTaskQueuePartitionRequest) (*ForceUnloadTaskQueuePartitionResponse, error)
+	// PauseTaskQueueDispatch pauses dispatch of tasks of a task queue, optionally only for some task queue types or
+	// priority levels. Tasks are still accepted into the backlog while dispatch is paused.
+	PauseTaskQueueDispatch(context.Context, *PauseTaskQueueDispatchRequest) (*PauseTaskQueueDispatchResponse, error)
+	// ResumeTaskQueueDispatch resumes dispatch of tasks paused by PauseTaskQueueDispatch.
+	ResumeTaskQueueDispatch(context.Context, *ResumeTaskQueueDispatchRequest) (*ResumeTaskQueueDispatchResponse, error)
+	// ListTaskQueueBacklogTasks lists the backlog tasks of a task queue partition, with their workflow, priority,
+	// fairness key and age. Only the unversioned queue of the partition is listed.
+	ListTaskQueueBacklogTasks(context.Context, *ListTaskQueueBacklogTasksRequest) (*ListTaskQueueBacklogTasksResponse, error)
+	// DeleteTaskQueueBacklogTasks deletes tasks from the backlog of a task queue partition. The tasks are never
+	// dispatched; their workflows have to be recovered by other means, e.g. by a reset.
+	DeleteTaskQueueBacklogTasks(context.Context, *DeleteTaskQueueBacklogTasksRequest) (*DeleteTaskQueueBacklogTasksResponse, error)
+	// MoveTaskQueueBacklogTasks moves a page of backlog tasks of a task queue partition to another task queue.
+	// Call it again with the returned page token until the token is empty to move the whole backlog.
+	MoveTaskQueueBacklogTasks(context.Context, *MoveTaskQueueBacklogTasksRequest) (*MoveTaskQueueBacklogTasksResponse, error)
 	mustEmbedUnimplementedAdminServiceServer()
 }
 
@@ -670,9 +910,24 @@ type UnimplementedAdminServiceServer struct {
 func (UnimplementedAdminServiceServer) RebuildMutableState(context.Context, *RebuildMutableStateRequest) (*RebuildMutableStateResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method RebuildMutableState not implemented")
 }
+func (UnimplementedAdminServiceServer) CheckMutableState(context.Context, *CheckMutableStateRequest) (*CheckMutableStateResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CheckMutableState not implemented")
+}
 func (UnimplementedAdminServiceServer) ImportWorkflowExecution(context.Context, *ImportWorkflowExecutionRequest) (*ImportWorkflowExecutionResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ImportWorkflowExecution not implemented")
 }
+func (UnimplementedAdminServiceServer) ForkWorkflowExecution(context.Context, *ForkWorkflowExecutionRequest) (*ForkWorkflowExecutionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ForkWorkflowExecution not implemented")
+}
+func (UnimplementedAdminServiceServer) RedactWorkflowExecution(context.Context, *RedactWorkflowExecutionRequest) (*RedactWorkflowExecutionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RedactWorkflowExecution not implemented")
+}
+func (UnimplementedAdminServiceServer) GetWorkflowExecutionTimeline(context.Context, *GetWorkflowExecutionTimelineRequest) (*GetWorkflowExecutionTimelineResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetWorkflowExecutionTimeline not implemented")
+}
+func (UnimplementedAdminServiceServer) GetWorkflowHistoryBudget(context.Context, *GetWorkflowHistoryBudgetRequest) (*GetWorkflowHistoryBudgetResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetWorkflowHistoryBudget not implemented")
+}
 func (UnimplementedAdminServiceServer) DescribeMutableState(context.Context, *DescribeMutableStateRequest) (*DescribeMutableStateResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method DescribeMutableState not implemented")
 }
@@ -685,6 +940,9 @@ func (UnimplementedAdminServiceServer) GetShard(context.Context, *GetShardReques
 func (UnimplementedAdminServiceServer) CloseShard(context.Context, *CloseShardRequest) (*CloseShardResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method CloseShard not implemented")
 }
+func (UnimplementedAdminServiceServer) MoveHistoryShard(context.Context, *MoveHistoryShardRequest) (*MoveHistoryShardResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method MoveHistoryShard not implemented")
+}
 func (UnimplementedAdminServiceServer) ListHistoryTasks(context.Context, *ListHistoryTasksRequest) (*ListHistoryTasksResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ListHistoryTasks not implemented")
 }
@@ -718,6 +976,18 @@ func (UnimplementedAdminServiceServer) RemoveSearchAttributes(context.Context, *
 func (UnimplementedAdminServiceServer) GetSearchAttributes(context.Context, *GetSearchAttributesRequest) (*GetSearchAttributesResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetSearchAttributes not implemented")
 }
+func (UnimplementedAdminServiceServer) AggregateWorkflowExecutions(context.Context, *AggregateWorkflowExecutionsRequest) (*AggregateWorkflowExecutionsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AggregateWorkflowExecutions not implemented")
+}
+func (UnimplementedAdminServiceServer) UpsertSavedVisibilityQuery(context.Context, *UpsertSavedVisibilityQueryRequest) (*UpsertSavedVisibilityQueryResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpsertSavedVisibilityQuery not implemented")
+}
+func (UnimplementedAdminServiceServer) DeleteSavedVisibilityQuery(context.Context, *DeleteSavedVisibilityQueryRequest) (*DeleteSavedVisibilityQueryResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteSavedVisibilityQuery not implemented")
+}
+func (UnimplementedAdminServiceServer) ListSavedVisibilityQueries(context.Context, *ListSavedVisibilityQueriesRequest) (*ListSavedVisibilityQueriesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListSavedVisibilityQueries not implemented")
+}
 func (UnimplementedAdminServiceServer) DescribeCluster(context.Context, *DescribeClusterRequest) (*DescribeClusterResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method DescribeCluster not implemented")
 }
@@ -796,6 +1066,21 @@ func (UnimplementedAdminServiceServer) DescribeTaskQueuePartition(context.Contex
 func (UnimplementedAdminServiceServer) ForceUnloadTaskQueuePartition(context.Context, *ForceUnloadTaskQueuePartitionRequest) (*ForceUnloadTaskQueuePartitionResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ForceUnloadTaskQueuePartition not implemented")
 }
+func (UnimplementedAdminServiceServer) PauseTaskQueueDispatch(context.Context, *PauseTaskQueueDispatchRequest) (*PauseTaskQueueDispatchResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PauseTaskQueueDispatch not implemented")
+}
+func (UnimplementedAdminServiceServer) ResumeTaskQueueDispatch(context.Context, *ResumeTaskQueueDispatchRequest) (*ResumeTaskQueueDispatchResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ResumeTaskQueueDispatch not implemented")
+}
+func (UnimplementedAdminServiceServer) ListTaskQueueBacklogTasks(context.Context, *ListTaskQueueBacklogTasksRequest) (*ListTaskQueueBacklogTasksResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListTaskQueueBacklogTasks not implemented")
+}
+func (UnimplementedAdminServiceServer) DeleteTaskQueueBacklogTasks(context.Context, *DeleteTaskQueueBacklogTasksRequest) (*DeleteTaskQueueBacklogTasksResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteTaskQueueBacklogTasks not implemented")
+}
+func (UnimplementedAdminServiceServer) MoveTaskQueueBacklogTasks(context.Context, *MoveTaskQueueBacklogTasksRequest) (*MoveTaskQueueBacklogTasksResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method MoveTaskQueueBacklogTasks not implemented")
+}
 func (UnimplementedAdminServiceServer) mustEmbedUnimplementedAdminServiceServer() {}
 
 // UnsafeAdminServiceServer may be embedded to opt out of forward compatibility for this service.
@@ -827,6 +1112,24 @@ func _AdminService_RebuildMutableState_Handler(srv interface{}, ctx context.Cont
 	return interceptor(ctx, in, info, handler)
 }
 
+func _AdminService_CheckMutableState_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CheckMutableStateRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServiceServer).CheckMutableState(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AdminService_CheckMutableState_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServiceServer).CheckMutableState(ctx, req.(*CheckMutableStateRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _AdminService_ImportWorkflowExecution_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(ImportWorkflowExecutionRequest)
 	if err := dec(in); err != nil {
@@ -845,6 +1148,78 @@ func _AdminService_ImportWorkflowExecution_Handler(srv interface{}, ctx context.
 	return interceptor(ctx, in, info, handler)
 }
 
+func _AdminService_ForkWorkflowExecution_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ForkWorkflowExecutionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServiceServer).ForkWorkflowExecution(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AdminService_ForkWorkflowExecution_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServiceServer).ForkWorkflowExecution(ctx, req.(*ForkWorkflowExecutionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AdminService_RedactWorkflowExecution_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RedactWorkflowExecutionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServiceServer).RedactWorkflowExecution(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AdminService_RedactWorkflowExecution_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServiceServer).RedactWorkflowExecution(ctx, req.(*RedactWorkflowExecutionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AdminService_GetWorkflowExecutionTimeline_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetWorkflowExecutionTimelineRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServiceServer).GetWorkflowExecutionTimeline(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AdminService_GetWorkflowExecutionTimeline_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServiceServer).GetWorkflowExecutionTimeline(ctx, req.(*GetWorkflowExecutionTimelineRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AdminService_GetWorkflowHistoryBudget_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetWorkflowHistoryBudgetRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServiceServer).GetWorkflowHistoryBudget(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AdminService_GetWorkflowHistoryBudget_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServiceServer).GetWorkflowHistoryBudget(ctx, req.(*GetWorkflowHistoryBudgetRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _AdminService_DescribeMutableState_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(DescribeMutableStateRequest)
 	if err := dec(in); err != nil {
@@ -917,6 +1292,24 @@ func _AdminService_CloseShard_Handler(srv interface{}, ctx context.Context, dec
 	return interceptor(ctx, in, info, handler)
 }
 
+func _AdminService_MoveHistoryShard_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MoveHistoryShardRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServiceServer).MoveHistoryShard(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AdminService_MoveHistoryShard_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServiceServer).MoveHistoryShard(ctx, req.(*MoveHistoryShardRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _AdminService_ListHistoryTasks_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(ListHistoryTasksRequest)
 	if err := dec(in); err != nil {
@@ -1115,6 +1508,78 @@ func _AdminService_GetSearchAttributes_Handler(srv interface{}, ctx context.Cont
 	return interceptor(ctx, in, info, handler)
 }
 
+func _AdminService_AggregateWorkflowExecutions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AggregateWorkflowExecutionsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServiceServer).AggregateWorkflowExecutions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AdminService_AggregateWorkflowExecutions_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServiceServer).AggregateWorkflowExecutions(ctx, req.(*AggregateWorkflowExecutionsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AdminService_UpsertSavedVisibilityQuery_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpsertSavedVisibilityQueryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServiceServer).UpsertSavedVisibilityQuery(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AdminService_UpsertSavedVisibilityQuery_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServiceServer).UpsertSavedVisibilityQuery(ctx, req.(*UpsertSavedVisibilityQueryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AdminService_DeleteSavedVisibilityQuery_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteSavedVisibilityQueryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServiceServer).DeleteSavedVisibilityQuery(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AdminService_DeleteSavedVisibilityQuery_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServiceServer).DeleteSavedVisibilityQuery(ctx, req.(*DeleteSavedVisibilityQueryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AdminService_ListSavedVisibilityQueries_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListSavedVisibilityQueriesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServiceServer).ListSavedVisibilityQueries(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AdminService_ListSavedVisibilityQueries_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServiceServer).ListSavedVisibilityQueries(ctx, req.(*ListSavedVisibilityQueriesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _AdminService_DescribeCluster_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(DescribeClusterRequest)
 	if err := dec(in); err != nil {
@@ -1591,6 +2056,96 @@ func _AdminService_ForceUnloadTaskQueuePartition_Handler(srv interface{}, ctx co
 	return interceptor(ctx, in, info, handler)
 }
 
+func _AdminService_PauseTaskQueueDispatch_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PauseTaskQueueDispatchRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServiceServer).PauseTaskQueueDispatch(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AdminService_PauseTaskQueueDispatch_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServiceServer).PauseTaskQueueDispatch(ctx, req.(*PauseTaskQueueDispatchRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AdminService_ResumeTaskQueueDispatch_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ResumeTaskQueueDispatchRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServiceServer).ResumeTaskQueueDispatch(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AdminService_ResumeTaskQueueDispatch_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServiceServer).ResumeTaskQueueDispatch(ctx, req.(*ResumeTaskQueueDispatchRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AdminService_ListTaskQueueBacklogTasks_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListTaskQueueBacklogTasksRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServiceServer).ListTaskQueueBacklogTasks(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AdminService_ListTaskQueueBacklogTasks_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServiceServer).ListTaskQueueBacklogTasks(ctx, req.(*ListTaskQueueBacklogTasksRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AdminService_DeleteTaskQueueBacklogTasks_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteTaskQueueBacklogTasksRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServiceServer).DeleteTaskQueueBacklogTasks(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AdminService_DeleteTaskQueueBacklogTasks_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServiceServer).DeleteTaskQueueBacklogTasks(ctx, req.(*DeleteTaskQueueBacklogTasksRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AdminService_MoveTaskQueueBacklogTasks_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MoveTaskQueueBacklogTasksRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServiceServer).MoveTaskQueueBacklogTasks(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AdminService_MoveTaskQueueBacklogTasks_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServiceServer).MoveTaskQueueBacklogTasks(ctx, req.(*MoveTaskQueueBacklogTasksRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // AdminService_ServiceDesc is the grpc.ServiceDesc for AdminService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -1602,10 +2157,30 @@ var AdminService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "RebuildMutableState",
 			Handler:    _AdminService_RebuildMutableState_Handler,
 		},
+		{
+			MethodName: "CheckMutableState",
+			Handler:    _AdminService_CheckMutableState_Handler,
+		},
 		{
 			MethodName: "ImportWorkflowExecution",
 			Handler:    _AdminService_ImportWorkflowExecution_Handler,
 		},
+		{
+			MethodName: "ForkWorkflowExecution",
+			Handler:    _AdminService_ForkWorkflowExecution_Handler,
+		},
+		{
+			MethodName: "RedactWorkflowExecution",
+			Handler:    _AdminService_RedactWorkflowExecution_Handler,
+		},
+		{
+			MethodName: "GetWorkflowExecutionTimeline",
+			Handler:    _AdminService_GetWorkflowExecutionTimeline_Handler,
+		},
+		{
+			MethodName: "GetWorkflowHistoryBudget",
+			Handler:    _AdminService_GetWorkflowHistoryBudget_Handler,
+		},
 		{
 			MethodName: "DescribeMutableState",
 			Handler:    _AdminService_DescribeMutableState_Handler,
@@ -1622,6 +2197,10 @@ var AdminService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "CloseShard",
 			Handler:    _AdminService_CloseShard_Handler,
 		},
+		{
+			MethodName: "MoveHistoryShard",
+			Handler:    _AdminService_MoveHistoryShard_Handler,
+		},
 		{
 			MethodName: "ListHistoryTasks",
 			Handler:    _AdminService_ListHistoryTasks_Handler,
@@ -1666,6 +2245,22 @@ var AdminService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "GetSearchAttributes",
 			Handler:    _AdminService_GetSearchAttributes_Handler,
 		},
+		{
+			MethodName: "AggregateWorkflowExecutions",
+			Handler:    _AdminService_AggregateWorkflowExecutions_Handler,
+		},
+		{
+			MethodName: "UpsertSavedVisibilityQuery",
+			Handler:    _AdminService_UpsertSavedVisibilityQuery_Handler,
+		},
+		{
+			MethodName: "DeleteSavedVisibilityQuery",
+			Handler:    _AdminService_DeleteSavedVisibilityQuery_Handler,
+		},
+		{
+			MethodName: "ListSavedVisibilityQueries",
+			Handler:    _AdminService_ListSavedVisibilityQueries_Handler,
+		},
 		{
 			MethodName: "DescribeCluster",
 			Handler:    _AdminService_DescribeCluster_Handler,
@@ -1766,6 +2361,26 @@ var AdminService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "ForceUnloadTaskQueuePartition",
 			Handler:    _AdminService_ForceUnloadTaskQueuePartition_Handler,
 		},
+		{
+			MethodName: "PauseTaskQueueDispatch",
+			Handler:    _AdminService_PauseTaskQueueDispatch_Handler,
+		},
+		{
+			MethodName: "ResumeTaskQueueDispatch",
+			Handler:    _AdminService_ResumeTaskQueueDispatch_Handler,
+		},
+		{
+			MethodName: "ListTaskQueueBacklogTasks",
+			Handler:    _AdminService_ListTaskQueueBacklogTasks_Handler,
+		},
+		{
+			MethodName: "DeleteTaskQueueBacklogTasks",
+			Handler:    _AdminService_DeleteTaskQueueBacklogTasks_Handler,
+		},
+		{
+			MethodName: "MoveTaskQueueBacklogTasks",
+			Handler:    _AdminService_MoveTaskQueueBacklogTasks_Handler,
+		},
 	},
 	Streams: []grpc.StreamDesc{
 		{
@@ -103,6 +103,26 @@ func (mr *MockAdminServiceClientMockRecorder) AddTasks(ctx, in any, opts ...any)
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddTasks", reflect.TypeOf((*MockAdminServiceClient)(nil).AddTasks), varargs...)
 }
 
+// AggregateWorkflowExecutions mocks base method.
+func (m *MockAdminServiceClient) AggregateWorkflowExecutions(ctx context.Context, in *adminservice.AggregateWorkflowExecutionsRequest, opts ...grpc.CallOption) (*adminservice.AggregateWorkflowExecutionsResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "AggregateWorkflowExecutions", varargs...)
+	ret0, _ := ret[0].(*adminservice.AggregateWorkflowExecutionsResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// AggregateWorkflowExecutions indicates an expected call of AggregateWorkflowExecutions.
+func (mr *MockAdminServiceClientMockRecorder) AggregateWorkflowExecutions(ctx, in any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AggregateWorkflowExecutions", reflect.TypeOf((*MockAdminServiceClient)(nil).AggregateWorkflowExecutions), varargs...)
+}
+
 // CancelDLQJob mocks base method.
 func (m *MockAdminServiceClient) CancelDLQJob(ctx context.Context, in *adminservice.CancelDLQJobRequest, opts ...grpc.CallOption) (*adminservice.CancelDLQJobResponse, error) {
 	m.ctrl.T.Helper()
@@ -123,6 +143,26 @@ func (mr *MockAdminServiceClientMockRecorder) CancelDLQJob(ctx, in any, opts ...
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CancelDLQJob", reflect.TypeOf((*MockAdminServiceClient)(nil).CancelDLQJob), varargs...)
 }
 
+// CheckMutableState mocks base method.
+func (m *MockAdminServiceClient) CheckMutableState(ctx context.Context, in *adminservice.CheckMutableStateRequest, opts ...grpc.CallOption) (*adminservice.CheckMutableStateResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "CheckMutableState", varargs...)
+	ret0, _ := ret[0].(*adminservice.CheckMutableStateResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CheckMutableState indicates an expected call of CheckMutableState.
+func (mr *MockAdminServiceClientMockRecorder) CheckMutableState(ctx, in any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CheckMutableState", reflect.TypeOf((*MockAdminServiceClient)(nil).CheckMutableState), varargs...)
+}
+
 // CloseShard mocks base method.
 func (m *MockAdminServiceClient) CloseShard(ctx context.Context, in *adminservice.CloseShardRequest, opts ...grpc.CallOption) (*adminservice.CloseShardResponse, error) {
 	m.ctrl.T.Helper()
@@ -163,6 +203,46 @@ func (mr *MockAdminServiceClientMockRecorder) DeepHealthCheck(ctx, in any, opts
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeepHealthCheck", reflect.TypeOf((*MockAdminServiceClient)(nil).DeepHealthCheck), varargs...)
 }
 
+// DeleteSavedVisibilityQuery mocks base method.
+func (m *MockAdminServiceClient) DeleteSavedVisibilityQuery(ctx context.Context, in *adminservice.DeleteSavedVisibilityQueryRequest, opts ...grpc.CallOption) (*adminservice.DeleteSavedVisibilityQueryResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "DeleteSavedVisibilityQuery", varargs...)
+	ret0, _ := ret[0].(*adminservice.DeleteSavedVisibilityQueryResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeleteSavedVisibilityQuery indicates an expected call of DeleteSavedVisibilityQuery.
+func (mr *MockAdminServiceClientMockRecorder) DeleteSavedVisibilityQuery(ctx, in any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteSavedVisibilityQuery", reflect.TypeOf((*MockAdminServiceClient)(nil).DeleteSavedVisibilityQuery), varargs...)
+}
+
+// DeleteTaskQueueBacklogTasks mocks base method.
+func (m *MockAdminServiceClient) DeleteTaskQueueBacklogTasks(ctx context.Context, in *adminservice.DeleteTaskQueueBacklogTasksRequest, opts ...grpc.CallOption) (*adminservice.DeleteTaskQueueBacklogTasksResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "DeleteTaskQueueBacklogTasks", varargs...)
+	ret0, _ := ret[0].(*adminservice.DeleteTaskQueueBacklogTasksResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeleteTaskQueueBacklogTasks indicates an expected call of DeleteTaskQueueBacklogTasks.
+func (mr *MockAdminServiceClientMockRecorder) DeleteTaskQueueBacklogTasks(ctx, in any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteTaskQueueBacklogTasks", reflect.TypeOf((*MockAdminServiceClient)(nil).DeleteTaskQueueBacklogTasks), varargs...)
+}
+
 // DeleteWorkflowExecution mocks base method.
 func (m *MockAdminServiceClient) DeleteWorkflowExecution(ctx context.Context, in *adminservice.DeleteWorkflowExecutionRequest, opts ...grpc.CallOption) (*adminservice.DeleteWorkflowExecutionResponse, error) {
 	m.ctrl.T.Helper()
@@ -303,6 +383,26 @@ func (mr *MockAdminServiceClientMockRecorder) ForceUnloadTaskQueuePartition(ctx,
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ForceUnloadTaskQueuePartition", reflect.TypeOf((*MockAdminServiceClient)(nil).ForceUnloadTaskQueuePartition), varargs...)
 }
 
+// ForkWorkflowExecution mocks base method.
+func (m *MockAdminServiceClient) ForkWorkflowExecution(ctx context.Context, in *adminservice.ForkWorkflowExecutionRequest, opts ...grpc.CallOption) (*adminservice.ForkWorkflowExecutionResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ForkWorkflowExecution", varargs...)
+	ret0, _ := ret[0].(*adminservice.ForkWorkflowExecutionResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ForkWorkflowExecution indicates an expected call of ForkWorkflowExecution.
+func (mr *MockAdminServiceClientMockRecorder) ForkWorkflowExecution(ctx, in any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ForkWorkflowExecution", reflect.TypeOf((*MockAdminServiceClient)(nil).ForkWorkflowExecution), varargs...)
+}
+
 // GenerateLastHistoryReplicationTasks mocks base method.
 func (m *MockAdminServiceClient) GenerateLastHistoryReplicationTasks(ctx context.Context, in *adminservice.GenerateLastHistoryReplicationTasksRequest, opts ...grpc.CallOption) (*adminservice.GenerateLastHistoryReplicationTasksResponse, error) {
 	m.ctrl.T.Helper()
@@ -543,6 +643,46 @@ func (mr *MockAdminServiceClientMockRecorder) GetWorkflowExecutionRawHistoryV2(c
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetWorkflowExecutionRawHistoryV2", reflect.TypeOf((*MockAdminServiceClient)(nil).GetWorkflowExecutionRawHistoryV2), varargs...)
 }
 
+// GetWorkflowExecutionTimeline mocks base method.
+func (m *MockAdminServiceClient) GetWorkflowExecutionTimeline(ctx context.Context, in *adminservice.GetWorkflowExecutionTimelineRequest, opts ...grpc.CallOption) (*adminservice.GetWorkflowExecutionTimelineResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "GetWorkflowExecutionTimeline", varargs...)
+	ret0, _ := ret[0].(*adminservice.GetWorkflowExecutionTimelineResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetWorkflowExecutionTimeline indicates an expected call of GetWorkflowExecutionTimeline.
+func (mr *MockAdminServiceClientMockRecorder) GetWorkflowExecutionTimeline(ctx, in any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetWorkflowExecutionTimeline", reflect.TypeOf((*MockAdminServiceClient)(nil).GetWorkflowExecutionTimeline), varargs...)
+}
+
+// GetWorkflowHistoryBudget mocks base method.
+func (m *MockAdminServiceClient) GetWorkflowHistoryBudget(ctx context.Context, in *adminservice.GetWorkflowHistoryBudgetRequest, opts ...grpc.CallOption) (*adminservice.GetWorkflowHistoryBudgetResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "GetWorkflowHistoryBudget", varargs...)
+	ret0, _ := ret[0].(*adminservice.GetWorkflowHistoryBudgetResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetWorkflowHistoryBudget indicates an expected call of GetWorkflowHistoryBudget.
+func (mr *MockAdminServiceClientMockRecorder) GetWorkflowHistoryBudget(ctx, in any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetWorkflowHistoryBudget", reflect.TypeOf((*MockAdminServiceClient)(nil).GetWorkflowHistoryBudget), varargs...)
+}
+
 // ImportWorkflowExecution mocks base method.
 func (m *MockAdminServiceClient) ImportWorkflowExecution(ctx context.Context, in *adminservice.ImportWorkflowExecutionRequest, opts ...grpc.CallOption) (*adminservice.ImportWorkflowExecutionResponse, error) {
 	m.ctrl.T.Helper()
@@ -643,6 +783,46 @@ func (mr *MockAdminServiceClientMockRecorder) ListQueues(ctx, in any, opts ...an
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListQueues", reflect.TypeOf((*MockAdminServiceClient)(nil).ListQueues), varargs...)
 }
 
+// ListSavedVisibilityQueries mocks base method.
+func (m *MockAdminServiceClient) ListSavedVisibilityQueries(ctx context.Context, in *adminservice.ListSavedVisibilityQueriesRequest, opts ...grpc.CallOption) (*adminservice.ListSavedVisibilityQueriesResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ListSavedVisibilityQueries", varargs...)
+	ret0, _ := ret[0].(*adminservice.ListSavedVisibilityQueriesResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListSavedVisibilityQueries indicates an expected call of ListSavedVisibilityQueries.
+func (mr *MockAdminServiceClientMockRecorder) ListSavedVisibilityQueries(ctx, in any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListSavedVisibilityQueries", reflect.TypeOf((*MockAdminServiceClient)(nil).ListSavedVisibilityQueries), varargs...)
+}
+
+// ListTaskQueueBacklogTasks mocks base method.
+func (m *MockAdminServiceClient) ListTaskQueueBacklogTasks(ctx context.Context, in *adminservice.ListTaskQueueBacklogTasksRequest, opts ...grpc.CallOption) (*adminservice.ListTaskQueueBacklogTasksResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ListTaskQueueBacklogTasks", varargs...)
+	ret0, _ := ret[0].(*adminservice.ListTaskQueueBacklogTasksResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListTaskQueueBacklogTasks indicates an expected call of ListTaskQueueBacklogTasks.
+func (mr *MockAdminServiceClientMockRecorder) ListTaskQueueBacklogTasks(ctx, in any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListTaskQueueBacklogTasks", reflect.TypeOf((*MockAdminServiceClient)(nil).ListTaskQueueBacklogTasks), varargs...)
+}
+
 // MergeDLQMessages mocks base method.
 func (m *MockAdminServiceClient) MergeDLQMessages(ctx context.Context, in *adminservice.MergeDLQMessagesRequest, opts ...grpc.CallOption) (*adminservice.MergeDLQMessagesResponse, error) {
 	m.ctrl.T.Helper()
@@ -683,6 +863,66 @@ func (mr *MockAdminServiceClientMockRecorder) MergeDLQTasks(ctx, in any, opts ..
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MergeDLQTasks", reflect.TypeOf((*MockAdminServiceClient)(nil).MergeDLQTasks), varargs...)
 }
 
+// MoveHistoryShard mocks base method.
+func (m *MockAdminServiceClient) MoveHistoryShard(ctx context.Context, in *adminservice.MoveHistoryShardRequest, opts ...grpc.CallOption) (*adminservice.MoveHistoryShardResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "MoveHistoryShard", varargs...)
+	ret0, _ := ret[0].(*adminservice.MoveHistoryShardResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// MoveHistoryShard indicates an expected call of MoveHistoryShard.
+func (mr *MockAdminServiceClientMockRecorder) MoveHistoryShard(ctx, in any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MoveHistoryShard", reflect.TypeOf((*MockAdminServiceClient)(nil).MoveHistoryShard), varargs...)
+}
+
+// MoveTaskQueueBacklogTasks mocks base method.
+func (m *MockAdminServiceClient) MoveTaskQueueBacklogTasks(ctx context.Context, in *adminservice.MoveTaskQueueBacklogTasksRequest, opts ...grpc.CallOption) (*adminservice.MoveTaskQueueBacklogTasksResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "MoveTaskQueueBacklogTasks", varargs...)
+	ret0, _ := ret[0].(*adminservice.MoveTaskQueueBacklogTasksResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// MoveTaskQueueBacklogTasks indicates an expected call of MoveTaskQueueBacklogTasks.
+func (mr *MockAdminServiceClientMockRecorder) MoveTaskQueueBacklogTasks(ctx, in any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MoveTaskQueueBacklogTasks", reflect.TypeOf((*MockAdminServiceClient)(nil).MoveTaskQueueBacklogTasks), varargs...)
+}
+
+// PauseTaskQueueDispatch mocks base method.
+func (m *MockAdminServiceClient) PauseTaskQueueDispatch(ctx context.Context, in *adminservice.PauseTaskQueueDispatchRequest, opts ...grpc.CallOption) (*adminservice.PauseTaskQueueDispatchResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "PauseTaskQueueDispatch", varargs...)
+	ret0, _ := ret[0].(*adminservice.PauseTaskQueueDispatchResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// PauseTaskQueueDispatch indicates an expected call of PauseTaskQueueDispatch.
+func (mr *MockAdminServiceClientMockRecorder) PauseTaskQueueDispatch(ctx, in any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PauseTaskQueueDispatch", reflect.TypeOf((*MockAdminServiceClient)(nil).PauseTaskQueueDispatch), varargs...)
+}
+
 // PurgeDLQMessages mocks base method.
 func (m *MockAdminServiceClient) PurgeDLQMessages(ctx context.Context, in *adminservice.PurgeDLQMessagesRequest, opts ...grpc.CallOption) (*adminservice.PurgeDLQMessagesResponse, error) {
 	m.ctrl.T.Helper()
@@ -763,6 +1003,26 @@ func (mr *MockAdminServiceClientMockRecorder) RebuildMutableState(ctx, in any, o
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RebuildMutableState", reflect.TypeOf((*MockAdminServiceClient)(nil).RebuildMutableState), varargs...)
 }
 
+// RedactWorkflowExecution mocks base method.
+func (m *MockAdminServiceClient) RedactWorkflowExecution(ctx context.Context, in *adminservice.RedactWorkflowExecutionRequest, opts ...grpc.CallOption) (*adminservice.RedactWorkflowExecutionResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "RedactWorkflowExecution", varargs...)
+	ret0, _ := ret[0].(*adminservice.RedactWorkflowExecutionResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RedactWorkflowExecution indicates an expected call of RedactWorkflowExecution.
+func (mr *MockAdminServiceClientMockRecorder) RedactWorkflowExecution(ctx, in any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RedactWorkflowExecution", reflect.TypeOf((*MockAdminServiceClient)(nil).RedactWorkflowExecution), varargs...)
+}
+
 // RefreshWorkflowTasks mocks base method.
 func (m *MockAdminServiceClient) RefreshWorkflowTasks(ctx context.Context, in *adminservice.RefreshWorkflowTasksRequest, opts ...grpc.CallOption) (*adminservice.RefreshWorkflowTasksResponse, error) {
 	m.ctrl.T.Helper()
@@ -863,6 +1123,26 @@ func (mr *MockAdminServiceClientMockRecorder) ResendReplicationTasks(ctx, in any
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ResendReplicationTasks", reflect.TypeOf((*MockAdminServiceClient)(nil).ResendReplicationTasks), varargs...)
 }
 
+// ResumeTaskQueueDispatch mocks base method.
+func (m *MockAdminServiceClient) ResumeTaskQueueDispatch(ctx context.Context, in *adminservice.ResumeTaskQueueDispatchRequest, opts ...grpc.CallOption) (*adminservice.ResumeTaskQueueDispatchResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ResumeTaskQueueDispatch", varargs...)
+	ret0, _ := ret[0].(*adminservice.ResumeTaskQueueDispatchResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ResumeTaskQueueDispatch indicates an expected call of ResumeTaskQueueDispatch.
+func (mr *MockAdminServiceClientMockRecorder) ResumeTaskQueueDispatch(ctx, in any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ResumeTaskQueueDispatch", reflect.TypeOf((*MockAdminServiceClient)(nil).ResumeTaskQueueDispatch), varargs...)
+}
+
 // StreamWorkflowReplicationMessages mocks base method.
 func (m *MockAdminServiceClient) StreamWorkflowReplicationMessages(ctx context.Context, opts ...grpc.CallOption) (adminservice.AdminService_StreamWorkflowReplicationMessagesClient, error) {
 	m.ctrl.T.Helper()
@@ -903,6 +1183,26 @@ func (mr *MockAdminServiceClientMockRecorder) SyncWorkflowState(ctx, in any, opt
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SyncWorkflowState", reflect.TypeOf((*MockAdminServiceClient)(nil).SyncWorkflowState), varargs...)
 }
 
+// UpsertSavedVisibilityQuery mocks base method.
+func (m *MockAdminServiceClient) UpsertSavedVisibilityQuery(ctx context.Context, in *adminservice.UpsertSavedVisibilityQueryRequest, opts ...grpc.CallOption) (*adminservice.UpsertSavedVisibilityQueryResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "UpsertSavedVisibilityQuery", varargs...)
+	ret0, _ := ret[0].(*adminservice.UpsertSavedVisibilityQueryResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpsertSavedVisibilityQuery indicates an expected call of UpsertSavedVisibilityQuery.
+func (mr *MockAdminServiceClientMockRecorder) UpsertSavedVisibilityQuery(ctx, in any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpsertSavedVisibilityQuery", reflect.TypeOf((*MockAdminServiceClient)(nil).UpsertSavedVisibilityQuery), varargs...)
+}
+
 // MockAdminService_StreamWorkflowReplicationMessagesClient is a mock of AdminService_StreamWorkflowReplicationMessagesClient interface.
 type MockAdminService_StreamWorkflowReplicationMessagesClient struct {
 	ctrl     *gomock.Controller
@@ -1110,6 +1410,21 @@ func (mr *MockAdminServiceServerMockRecorder) AddTasks(arg0, arg1 any) *gomock.C
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddTasks", reflect.TypeOf((*MockAdminServiceServer)(nil).AddTasks), arg0, arg1)
 }
 
+// AggregateWorkflowExecutions mocks base method.
+func (m *MockAdminServiceServer) AggregateWorkflowExecutions(arg0 context.Context, arg1 *adminservice.AggregateWorkflowExecutionsRequest) (*adminservice.AggregateWorkflowExecutionsResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AggregateWorkflowExecutions", arg0, arg1)
+	ret0, _ := ret[0].(*adminservice.AggregateWorkflowExecutionsResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// AggregateWorkflowExecutions indicates an expected call of AggregateWorkflowExecutions.
+func (mr *MockAdminServiceServerMockRecorder) AggregateWorkflowExecutions(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AggregateWorkflowExecutions", reflect.TypeOf((*MockAdminServiceServer)(nil).AggregateWorkflowExecutions), arg0, arg1)
+}
+
 // CancelDLQJob mocks base method.
 func (m *MockAdminServiceServer) CancelDLQJob(arg0 context.Context, arg1 *adminservice.CancelDLQJobRequest) (*adminservice.CancelDLQJobResponse, error) {
 	m.ctrl.T.Helper()
@@ -1125,6 +1440,21 @@ func (mr *MockAdminServiceServerMockRecorder) CancelDLQJob(arg0, arg1 any) *gomo
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CancelDLQJob", reflect.TypeOf((*MockAdminServiceServer)(nil).CancelDLQJob), arg0, arg1)
 }
 
+// CheckMutableState mocks base method.
+func (m *MockAdminServiceServer) CheckMutableState(arg0 context.Context, arg1 *adminservice.CheckMutableStateRequest) (*adminservice.CheckMutableStateResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CheckMutableState", arg0, arg1)
+	ret0, _ := ret[0].(*adminservice.CheckMutableStateResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CheckMutableState indicates an expected call of CheckMutableState.
+func (mr *MockAdminServiceServerMockRecorder) CheckMutableState(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CheckMutableState", reflect.TypeOf((*MockAdminServiceServer)(nil).CheckMutableState), arg0, arg1)
+}
+
 // CloseShard mocks base method.
 func (m *MockAdminServiceServer) CloseShard(arg0 context.Context, arg1 *adminservice.CloseShardRequest) (*adminservice.CloseShardResponse, error) {
 	m.ctrl.T.Helper()
@@ -1155,6 +1485,36 @@ func (mr *MockAdminServiceServerMockRecorder) DeepHealthCheck(arg0, arg1 any) *g
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeepHealthCheck", reflect.TypeOf((*MockAdminServiceServer)(nil).DeepHealthCheck), arg0, arg1)
 }
 
+// DeleteSavedVisibilityQuery mocks base method.
+func (m *MockAdminServiceServer) DeleteSavedVisibilityQuery(arg0 context.Context, arg1 *adminservice.DeleteSavedVisibilityQueryRequest) (*adminservice.DeleteSavedVisibilityQueryResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteSavedVisibilityQuery", arg0, arg1)
+	ret0, _ := ret[0].(*adminservice.DeleteSavedVisibilityQueryResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeleteSavedVisibilityQuery indicates an expected call of DeleteSavedVisibilityQuery.
+func (mr *MockAdminServiceServerMockRecorder) DeleteSavedVisibilityQuery(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteSavedVisibilityQuery", reflect.TypeOf((*MockAdminServiceServer)(nil).DeleteSavedVisibilityQuery), arg0, arg1)
+}
+
+// DeleteTaskQueueBacklogTasks mocks base method.
+func (m *MockAdminServiceServer) DeleteTaskQueueBacklogTasks(arg0 context.Context, arg1 *adminservice.DeleteTaskQueueBacklogTasksRequest) (*adminservice.DeleteTaskQueueBacklogTasksResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteTaskQueueBacklogTasks", arg0, arg1)
+	ret0, _ := ret[0].(*adminservice.DeleteTaskQueueBacklogTasksResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeleteTaskQueueBacklogTasks indicates an expected call of DeleteTaskQueueBacklogTasks.
+func (mr *MockAdminServiceServerMockRecorder) DeleteTaskQueueBacklogTasks(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteTaskQueueBacklogTasks", reflect.TypeOf((*MockAdminServiceServer)(nil).DeleteTaskQueueBacklogTasks), arg0, arg1)
+}
+
 // DeleteWorkflowExecution mocks base method.
 func (m *MockAdminServiceServer) DeleteWorkflowExecution(arg0 context.Context, arg1 *adminservice.DeleteWorkflowExecutionRequest) (*adminservice.DeleteWorkflowExecutionResponse, error) {
 	m.ctrl.T.Helper()
@@ -1260,6 +1620,21 @@ func (mr *MockAdminServiceServerMockRecorder) ForceUnloadTaskQueuePartition(arg0
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ForceUnloadTaskQueuePartition", reflect.TypeOf((*MockAdminServiceServer)(nil).ForceUnloadTaskQueuePartition), arg0, arg1)
 }
 
+// ForkWorkflowExecution mocks base method.
+func (m *MockAdminServiceServer) ForkWorkflowExecution(arg0 context.Context, arg1 *adminservice.ForkWorkflowExecutionRequest) (*adminservice.ForkWorkflowExecutionResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ForkWorkflowExecution", arg0, arg1)
+	ret0, _ := ret[0].(*adminservice.ForkWorkflowExecutionResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ForkWorkflowExecution indicates an expected call of ForkWorkflowExecution.
+func (mr *MockAdminServiceServerMockRecorder) ForkWorkflowExecution(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ForkWorkflowExecution", reflect.TypeOf((*MockAdminServiceServer)(nil).ForkWorkflowExecution), arg0, arg1)
+}
+
 // GenerateLastHistoryReplicationTasks mocks base method.
 func (m *MockAdminServiceServer) GenerateLastHistoryReplicationTasks(arg0 context.Context, arg1 *adminservice.GenerateLastHistoryReplicationTasksRequest) (*adminservice.GenerateLastHistoryReplicationTasksResponse, error) {
 	m.ctrl.T.Helper()
@@ -1440,6 +1815,36 @@ func (mr *MockAdminServiceServerMockRecorder) GetWorkflowExecutionRawHistoryV2(a
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetWorkflowExecutionRawHistoryV2", reflect.TypeOf((*MockAdminServiceServer)(nil).GetWorkflowExecutionRawHistoryV2), arg0, arg1)
 }
 
+// GetWorkflowExecutionTimeline mocks base method.
+func (m *MockAdminServiceServer) GetWorkflowExecutionTimeline(arg0 context.Context, arg1 *adminservice.GetWorkflowExecutionTimelineRequest) (*adminservice.GetWorkflowExecutionTimelineResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetWorkflowExecutionTimeline", arg0, arg1)
+	ret0, _ := ret[0].(*adminservice.GetWorkflowExecutionTimelineResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetWorkflowExecutionTimeline indicates an expected call of GetWorkflowExecutionTimeline.
+func (mr *MockAdminServiceServerMockRecorder) GetWorkflowExecutionTimeline(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetWorkflowExecutionTimeline", reflect.TypeOf((*MockAdminServiceServer)(nil).GetWorkflowExecutionTimeline), arg0, arg1)
+}
+
+// GetWorkflowHistoryBudget mocks base method.
+func (m *MockAdminServiceServer) GetWorkflowHistoryBudget(arg0 context.Context, arg1 *adminservice.GetWorkflowHistoryBudgetRequest) (*adminservice.GetWorkflowHistoryBudgetResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetWorkflowHistoryBudget", arg0, arg1)
+	ret0, _ := ret[0].(*adminservice.GetWorkflowHistoryBudgetResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetWorkflowHistoryBudget indicates an expected call of GetWorkflowHistoryBudget.
+func (mr *MockAdminServiceServerMockRecorder) GetWorkflowHistoryBudget(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetWorkflowHistoryBudget", reflect.TypeOf((*MockAdminServiceServer)(nil).GetWorkflowHistoryBudget), arg0, arg1)
+}
+
 // ImportWorkflowExecution mocks base method.
 func (m *MockAdminServiceServer) ImportWorkflowExecution(arg0 context.Context, arg1 *adminservice.ImportWorkflowExecutionRequest) (*adminservice.ImportWorkflowExecutionResponse, error) {
 	m.ctrl.T.Helper()
@@ -1515,6 +1920,36 @@ func (mr *MockAdminServiceServerMockRecorder) ListQueues(arg0, arg1 any) *gomock
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListQueues", reflect.TypeOf((*MockAdminServiceServer)(nil).ListQueues), arg0, arg1)
 }
 
+// ListSavedVisibilityQueries mocks base method.
+func (m *MockAdminServiceServer) ListSavedVisibilityQueries(arg0 context.Context, arg1 *adminservice.ListSavedVisibilityQueriesRequest) (*adminservice.ListSavedVisibilityQueriesResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListSavedVisibilityQueries", arg0, arg1)
+	ret0, _ := ret[0].(*adminservice.ListSavedVisibilityQueriesResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListSavedVisibilityQueries indicates an expected call of ListSavedVisibilityQueries.
+func (mr *MockAdminServiceServerMockRecorder) ListSavedVisibilityQueries(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListSavedVisibilityQueries", reflect.TypeOf((*MockAdminServiceServer)(nil).ListSavedVisibilityQueries), arg0, arg1)
+}
+
+// ListTaskQueueBacklogTasks mocks base method.
+func (m *MockAdminServiceServer) ListTaskQueueBacklogTasks(arg0 context.Context, arg1 *adminservice.ListTaskQueueBacklogTasksRequest) (*adminservice.ListTaskQueueBacklogTasksResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListTaskQueueBacklogTasks", arg0, arg1)
+	ret0, _ := ret[0].(*adminservice.ListTaskQueueBacklogTasksResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListTaskQueueBacklogTasks indicates an expected call of ListTaskQueueBacklogTasks.
+func (mr *MockAdminServiceServerMockRecorder) ListTaskQueueBacklogTasks(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListTaskQueueBacklogTasks", reflect.TypeOf((*MockAdminServiceServer)(nil).ListTaskQueueBacklogTasks), arg0, arg1)
+}
+
 // MergeDLQMessages mocks base method.
 func (m *MockAdminServiceServer) MergeDLQMessages(arg0 context.Context, arg1 *adminservice.MergeDLQMessagesRequest) (*adminservice.MergeDLQMessagesResponse, error) {
 	m.ctrl.T.Helper()
@@ -1545,6 +1980,51 @@ func (mr *MockAdminServiceServerMockRecorder) MergeDLQTasks(arg0, arg1 any) *gom
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MergeDLQTasks", reflect.TypeOf((*MockAdminServiceServer)(nil).MergeDLQTasks), arg0, arg1)
 }
 
+// MoveHistoryShard mocks base method.
+func (m *MockAdminServiceServer) MoveHistoryShard(arg0 context.Context, arg1 *adminservice.MoveHistoryShardRequest) (*adminservice.MoveHistoryShardResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "MoveHistoryShard", arg0, arg1)
+	ret0, _ := ret[0].(*adminservice.MoveHistoryShardResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// MoveHistoryShard indicates an expected call of MoveHistoryShard.
+func (mr *MockAdminServiceServerMockRecorder) MoveHistoryShard(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MoveHistoryShard", reflect.TypeOf((*MockAdminServiceServer)(nil).MoveHistoryShard), arg0, arg1)
+}
+
+// MoveTaskQueueBacklogTasks mocks base method.
+func (m *MockAdminServiceServer) MoveTaskQueueBacklogTasks(arg0 context.Context, arg1 *adminservice.MoveTaskQueueBacklogTasksRequest) (*adminservice.MoveTaskQueueBacklogTasksResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "MoveTaskQueueBacklogTasks", arg0, arg1)
+	ret0, _ := ret[0].(*adminservice.MoveTaskQueueBacklogTasksResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// MoveTaskQueueBacklogTasks indicates an expected call of MoveTaskQueueBacklogTasks.
+func (mr *MockAdminServiceServerMockRecorder) MoveTaskQueueBacklogTasks(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MoveTaskQueueBacklogTasks", reflect.TypeOf((*MockAdminServiceServer)(nil).MoveTaskQueueBacklogTasks), arg0, arg1)
+}
+
+// PauseTaskQueueDispatch mocks base method.
+func (m *MockAdminServiceServer) PauseTaskQueueDispatch(arg0 context.Context, arg1 *adminservice.PauseTaskQueueDispatchRequest) (*adminservice.PauseTaskQueueDispatchResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PauseTaskQueueDispatch", arg0, arg1)
+	ret0, _ := ret[0].(*adminservice.PauseTaskQueueDispatchResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// PauseTaskQueueDispatch indicates an expected call of PauseTaskQueueDispatch.
+func (mr *MockAdminServiceServerMockRecorder) PauseTaskQueueDispatch(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PauseTaskQueueDispatch", reflect.TypeOf((*MockAdminServiceServer)(nil).PauseTaskQueueDispatch), arg0, arg1)
+}
+
 // PurgeDLQMessages mocks base method.
 func (m *MockAdminServiceServer) PurgeDLQMessages(arg0 context.Context, arg1 *adminservice.PurgeDLQMessagesRequest) (*adminservice.PurgeDLQMessagesResponse, error) {
 	m.ctrl.T.Helper()
@@ -1605,6 +2085,21 @@ func (mr *MockAdminServiceServerMockRecorder) RebuildMutableState(arg0, arg1 any
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RebuildMutableState", reflect.TypeOf((*MockAdminServiceServer)(nil).RebuildMutableState), arg0, arg1)
 }
 
+// RedactWorkflowExecution mocks base method.
+func (m *MockAdminServiceServer) RedactWorkflowExecution(arg0 context.Context, arg1 *adminservice.RedactWorkflowExecutionRequest) (*adminservice.RedactWorkflowExecutionResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RedactWorkflowExecution", arg0, arg1)
+	ret0, _ := ret[0].(*adminservice.RedactWorkflowExecutionResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RedactWorkflowExecution indicates an expected call of RedactWorkflowExecution.
+func (mr *MockAdminServiceServerMockRecorder) RedactWorkflowExecution(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RedactWorkflowExecution", reflect.TypeOf((*MockAdminServiceServer)(nil).RedactWorkflowExecution), arg0, arg1)
+}
+
 // RefreshWorkflowTasks mocks base method.
 func (m *MockAdminServiceServer) RefreshWorkflowTasks(arg0 context.Context, arg1 *adminservice.RefreshWorkflowTasksRequest) (*adminservice.RefreshWorkflowTasksResponse, error) {
 	m.ctrl.T.Helper()
@@ -1680,6 +2175,21 @@ func (mr *MockAdminServiceServerMockRecorder) ResendReplicationTasks(arg0, arg1
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ResendReplicationTasks", reflect.TypeOf((*MockAdminServiceServer)(nil).ResendReplicationTasks), arg0, arg1)
 }
 
+// ResumeTaskQueueDispatch mocks base method.
+func (m *MockAdminServiceServer) ResumeTaskQueueDispatch(arg0 context.Context, arg1 *adminservice.ResumeTaskQueueDispatchRequest) (*adminservice.ResumeTaskQueueDispatchResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ResumeTaskQueueDispatch", arg0, arg1)
+	ret0, _ := ret[0].(*adminservice.ResumeTaskQueueDispatchResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ResumeTaskQueueDispatch indicates an expected call of ResumeTaskQueueDispatch.
+func (mr *MockAdminServiceServerMockRecorder) ResumeTaskQueueDispatch(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ResumeTaskQueueDispatch", reflect.TypeOf((*MockAdminServiceServer)(nil).ResumeTaskQueueDispatch), arg0, arg1)
+}
+
 // StreamWorkflowReplicationMessages mocks base method.
 func (m *MockAdminServiceServer) StreamWorkflowReplicationMessages(arg0 adminservice.AdminService_StreamWorkflowReplicationMessagesServer) error {
 	m.ctrl.T.Helper()
@@ -1709,6 +2219,21 @@ func (mr *MockAdminServiceServerMockRecorder) SyncWorkflowState(arg0, arg1 any)
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SyncWorkflowState", reflect.TypeOf((*MockAdminServiceServer)(nil).SyncWorkflowState), arg0, arg1)
 }
 
+// UpsertSavedVisibilityQuery mocks base method.
+func (m *MockAdminServiceServer) UpsertSavedVisibilityQuery(arg0 context.Context, arg1 *adminservice.UpsertSavedVisibilityQueryRequest) (*adminservice.UpsertSavedVisibilityQueryResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpsertSavedVisibilityQuery", arg0, arg1)
+	ret0, _ := ret[0].(*adminservice.UpsertSavedVisibilityQueryResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpsertSavedVisibilityQuery indicates an expected call of UpsertSavedVisibilityQuery.
+func (mr *MockAdminServiceServerMockRecorder) UpsertSavedVisibilityQuery(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpsertSavedVisibilityQuery", reflect.TypeOf((*MockAdminServiceServer)(nil).UpsertSavedVisibilityQuery), arg0, arg1)
+}
+
 // mustEmbedUnimplementedAdminServiceServer mocks base method.
 func (m *MockAdminServiceServer) mustEmbedUnimplementedAdminServiceServer() {
 	m.ctrl.T.Helper()
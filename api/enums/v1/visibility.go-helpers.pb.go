@@ -0,0 +1,28 @@
+// Code generated by protoc-gen-go-helpers. DO NOT EDIT.
+package enums
+
+import (
+	"fmt"
+)
+
+var (
+	VisibilityAggregationFunction_shorthandValue = map[string]int32{
+		"Unspecified": 0,
+		"Min":         1,
+		"Max":         2,
+		"Avg":         3,
+		"Sum":         4,
+		"Percentiles": 5,
+	}
+)
+
+// VisibilityAggregationFunctionFromString parses a VisibilityAggregationFunction value from  either the protojson
+// canonical SCREAMING_CASE enum or the traditional temporal PascalCase enum to VisibilityAggregationFunction
+func VisibilityAggregationFunctionFromString(s string) (VisibilityAggregationFunction, error) {
+	if v, ok := VisibilityAggregationFunction_value[s]; ok {
+		return VisibilityAggregationFunction(v), nil
+	} else if v, ok := VisibilityAggregationFunction_shorthandValue[s]; ok {
+		return VisibilityAggregationFunction(v), nil
+	}
+	return VisibilityAggregationFunction(0), fmt.Errorf("%s is not a valid VisibilityAggregationFunction", s)
+}
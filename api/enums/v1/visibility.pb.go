@@ -0,0 +1,160 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// plugins:
+// 	protoc-gen-go
+// 	protoc
+// source: temporal/server/api/enums/v1/visibility.proto
+
+package enums
+
+import (
+	reflect "reflect"
+	"strconv"
+	sync "sync"
+	unsafe "unsafe"
+
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// Function applied to the values of a search attribute by a visibility aggregation.
+type VisibilityAggregationFunction int32
+
+const (
+	VISIBILITY_AGGREGATION_FUNCTION_UNSPECIFIED VisibilityAggregationFunction = 0
+	VISIBILITY_AGGREGATION_FUNCTION_MIN         VisibilityAggregationFunction = 1
+	VISIBILITY_AGGREGATION_FUNCTION_MAX         VisibilityAggregationFunction = 2
+	VISIBILITY_AGGREGATION_FUNCTION_AVG         VisibilityAggregationFunction = 3
+	VISIBILITY_AGGREGATION_FUNCTION_SUM         VisibilityAggregationFunction = 4
+	VISIBILITY_AGGREGATION_FUNCTION_PERCENTILES VisibilityAggregationFunction = 5
+)
+
+// Enum value maps for VisibilityAggregationFunction.
+var (
+	VisibilityAggregationFunction_name = map[int32]string{
+		0: "VISIBILITY_AGGREGATION_FUNCTION_UNSPECIFIED",
+		1: "VISIBILITY_AGGREGATION_FUNCTION_MIN",
+		2: "VISIBILITY_AGGREGATION_FUNCTION_MAX",
+		3: "VISIBILITY_AGGREGATION_FUNCTION_AVG",
+		4: "VISIBILITY_AGGREGATION_FUNCTION_SUM",
+		5: "VISIBILITY_AGGREGATION_FUNCTION_PERCENTILES",
+	}
+	VisibilityAggregationFunction_value = map[string]int32{
+		"VISIBILITY_AGGREGATION_FUNCTION_UNSPECIFIED": 0,
+		"VISIBILITY_AGGREGATION_FUNCTION_MIN":         1,
+		"VISIBILITY_AGGREGATION_FUNCTION_MAX":         2,
+		"VISIBILITY_AGGREGATION_FUNCTION_AVG":         3,
+		"VISIBILITY_AGGREGATION_FUNCTION_SUM":         4,
+		"VISIBILITY_AGGREGATION_FUNCTION_PERCENTILES": 5,
+	}
+)
+
+func (x VisibilityAggregationFunction) Enum() *VisibilityAggregationFunction {
+	p := new(VisibilityAggregationFunction)
+	*p = x
+	return p
+}
+
+func (x VisibilityAggregationFunction) String() string {
+	switch x {
+	case VISIBILITY_AGGREGATION_FUNCTION_UNSPECIFIED:
+		return "Unspecified"
+	case VISIBILITY_AGGREGATION_FUNCTION_MIN:
+		return "Min"
+	case VISIBILITY_AGGREGATION_FUNCTION_MAX:
+		return "Max"
+	case VISIBILITY_AGGREGATION_FUNCTION_AVG:
+		return "Avg"
+	case VISIBILITY_AGGREGATION_FUNCTION_SUM:
+		return "Sum"
+	case VISIBILITY_AGGREGATION_FUNCTION_PERCENTILES:
+		return "Percentiles"
+	default:
+		return strconv.Itoa(int(x))
+	}
+
+}
+
+func (VisibilityAggregationFunction) Descriptor() protoreflect.EnumDescriptor {
+	return file_temporal_server_api_enums_v1_visibility_proto_enumTypes[0].Descriptor()
+}
+
+func (VisibilityAggregationFunction) Type() protoreflect.EnumType {
+	return &file_temporal_server_api_enums_v1_visibility_proto_enumTypes[0]
+}
+
+func (x VisibilityAggregationFunction) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use VisibilityAggregationFunction.Descriptor instead.
+func (VisibilityAggregationFunction) EnumDescriptor() ([]byte, []int) {
+	return file_temporal_server_api_enums_v1_visibility_proto_rawDescGZIP(), []int{0}
+}
+
+var File_temporal_server_api_enums_v1_visibility_proto protoreflect.FileDescriptor
+
+const file_temporal_server_api_enums_v1_visibility_proto_rawDesc = "" +
+	"\n" +
+	"-temporal/server/api/enums/v1/visibility.proto\x12\x1ctemporal.server.api.enums.v1*\xa5\x02\n" +
+	"\x1dVisibilityAggregationFunction\x12/\n" +
+	"+VISIBILITY_AGGREGATION_FUNCTION_UNSPECIFIED\x10\x00\x12'\n" +
+	"#VISIBILITY_AGGREGATION_FUNCTION_MIN\x10\x01\x12'\n" +
+	"#VISIBILITY_AGGREGATION_FUNCTION_MAX\x10\x02\x12'\n" +
+	"#VISIBILITY_AGGREGATION_FUNCTION_AVG\x10\x03\x12'\n" +
+	"#VISIBILITY_AGGREGATION_FUNCTION_SUM\x10\x04\x12/\n" +
+	"+VISIBILITY_AGGREGATION_FUNCTION_PERCENTILES\x10\x05B*Z(go.temporal.io/server/api/enums/v1;enumsb\x06proto3"
+
+var (
+	file_temporal_server_api_enums_v1_visibility_proto_rawDescOnce sync.Once
+	file_temporal_server_api_enums_v1_visibility_proto_rawDescData []byte
+)
+
+func file_temporal_server_api_enums_v1_visibility_proto_rawDescGZIP() []byte {
+	file_temporal_server_api_enums_v1_visibility_proto_rawDescOnce.Do(func() {
+		file_temporal_server_api_enums_v1_visibility_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_temporal_server_api_enums_v1_visibility_proto_rawDesc), len(file_temporal_server_api_enums_v1_visibility_proto_rawDesc)))
+	})
+	return file_temporal_server_api_enums_v1_visibility_proto_rawDescData
+}
+
+var file_temporal_server_api_enums_v1_visibility_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
+var file_temporal_server_api_enums_v1_visibility_proto_goTypes = []any{
+	(VisibilityAggregationFunction)(0), // 0: temporal.server.api.enums.v1.VisibilityAggregationFunction
+}
+var file_temporal_server_api_enums_v1_visibility_proto_depIdxs = []int32{
+	0, // [0:0] is the sub-list for method output_type
+	0, // [0:0] is the sub-list for method input_type
+	0, // [0:0] is the sub-list for extension type_name
+	0, // [0:0] is the sub-list for extension extendee
+	0, // [0:0] is the sub-list for field type_name
+}
+
+func init() { file_temporal_server_api_enums_v1_visibility_proto_init() }
+func file_temporal_server_api_enums_v1_visibility_proto_init() {
+	if File_temporal_server_api_enums_v1_visibility_proto != nil {
+		return
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_temporal_server_api_enums_v1_visibility_proto_rawDesc), len(file_temporal_server_api_enums_v1_visibility_proto_rawDesc)),
+			NumEnums:      1,
+			NumMessages:   0,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_temporal_server_api_enums_v1_visibility_proto_goTypes,
+		DependencyIndexes: file_temporal_server_api_enums_v1_visibility_proto_depIdxs,
+		EnumInfos:         file_temporal_server_api_enums_v1_visibility_proto_enumTypes,
+	}.Build()
+	File_temporal_server_api_enums_v1_visibility_proto = out.File
+	file_temporal_server_api_enums_v1_visibility_proto_goTypes = nil
+	file_temporal_server_api_enums_v1_visibility_proto_depIdxs = nil
+}
@@ -48,6 +48,48 @@ func WorkflowBackoffTypeFromString(s string) (WorkflowBackoffType, error) {
 	return WorkflowBackoffType(0), fmt.Errorf("%s is not a valid WorkflowBackoffType", s)
 }
 
+var (
+	TimelineSpanType_shorthandValue = map[string]int32{
+		"Unspecified":    0,
+		"WorkflowTask":   1,
+		"Activity":       2,
+		"Timer":          3,
+		"ChildWorkflow":  4,
+		"NexusOperation": 5,
+	}
+)
+
+// TimelineSpanTypeFromString parses a TimelineSpanType value from  either the protojson
+// canonical SCREAMING_CASE enum or the traditional temporal PascalCase enum to TimelineSpanType
+func TimelineSpanTypeFromString(s string) (TimelineSpanType, error) {
+	if v, ok := TimelineSpanType_value[s]; ok {
+		return TimelineSpanType(v), nil
+	} else if v, ok := TimelineSpanType_shorthandValue[s]; ok {
+		return TimelineSpanType(v), nil
+	}
+	return TimelineSpanType(0), fmt.Errorf("%s is not a valid TimelineSpanType", s)
+}
+
+var (
+	HistoryBudgetAction_shorthandValue = map[string]int32{
+		"Unspecified":          0,
+		"Warn":                 1,
+		"SuggestContinueAsNew": 2,
+		"BlockSignals":         3,
+	}
+)
+
+// HistoryBudgetActionFromString parses a HistoryBudgetAction value from  either the protojson
+// canonical SCREAMING_CASE enum or the traditional temporal PascalCase enum to HistoryBudgetAction
+func HistoryBudgetActionFromString(s string) (HistoryBudgetAction, error) {
+	if v, ok := HistoryBudgetAction_value[s]; ok {
+		return HistoryBudgetAction(v), nil
+	} else if v, ok := HistoryBudgetAction_shorthandValue[s]; ok {
+		return HistoryBudgetAction(v), nil
+	}
+	return HistoryBudgetAction(0), fmt.Errorf("%s is not a valid HistoryBudgetAction", s)
+}
+
 var (
 	PausedWorkflowEntityType_shorthandValue = map[string]int32{
 		"Unspecified": 0,
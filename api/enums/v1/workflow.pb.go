@@ -166,6 +166,146 @@ func (WorkflowBackoffType) EnumDescriptor() ([]byte, []int) {
 	return file_temporal_server_api_enums_v1_workflow_proto_rawDescGZIP(), []int{1}
 }
 
+// Kind of operation of a span in the timeline of a workflow execution.
+type TimelineSpanType int32
+
+const (
+	TIMELINE_SPAN_TYPE_UNSPECIFIED     TimelineSpanType = 0
+	TIMELINE_SPAN_TYPE_WORKFLOW_TASK   TimelineSpanType = 1
+	TIMELINE_SPAN_TYPE_ACTIVITY        TimelineSpanType = 2
+	TIMELINE_SPAN_TYPE_TIMER           TimelineSpanType = 3
+	TIMELINE_SPAN_TYPE_CHILD_WORKFLOW  TimelineSpanType = 4
+	TIMELINE_SPAN_TYPE_NEXUS_OPERATION TimelineSpanType = 5
+)
+
+// Enum value maps for TimelineSpanType.
+var (
+	TimelineSpanType_name = map[int32]string{
+		0: "TIMELINE_SPAN_TYPE_UNSPECIFIED",
+		1: "TIMELINE_SPAN_TYPE_WORKFLOW_TASK",
+		2: "TIMELINE_SPAN_TYPE_ACTIVITY",
+		3: "TIMELINE_SPAN_TYPE_TIMER",
+		4: "TIMELINE_SPAN_TYPE_CHILD_WORKFLOW",
+		5: "TIMELINE_SPAN_TYPE_NEXUS_OPERATION",
+	}
+	TimelineSpanType_value = map[string]int32{
+		"TIMELINE_SPAN_TYPE_UNSPECIFIED":     0,
+		"TIMELINE_SPAN_TYPE_WORKFLOW_TASK":   1,
+		"TIMELINE_SPAN_TYPE_ACTIVITY":        2,
+		"TIMELINE_SPAN_TYPE_TIMER":           3,
+		"TIMELINE_SPAN_TYPE_CHILD_WORKFLOW":  4,
+		"TIMELINE_SPAN_TYPE_NEXUS_OPERATION": 5,
+	}
+)
+
+func (x TimelineSpanType) Enum() *TimelineSpanType {
+	p := new(TimelineSpanType)
+	*p = x
+	return p
+}
+
+func (x TimelineSpanType) String() string {
+	switch x {
+	case TIMELINE_SPAN_TYPE_UNSPECIFIED:
+		return "Unspecified"
+	case TIMELINE_SPAN_TYPE_WORKFLOW_TASK:
+		return "WorkflowTask"
+	case TIMELINE_SPAN_TYPE_ACTIVITY:
+		return "Activity"
+	case TIMELINE_SPAN_TYPE_TIMER:
+		return "Timer"
+	case TIMELINE_SPAN_TYPE_CHILD_WORKFLOW:
+		return "ChildWorkflow"
+	case TIMELINE_SPAN_TYPE_NEXUS_OPERATION:
+		return "NexusOperation"
+	default:
+		return strconv.Itoa(int(x))
+	}
+
+}
+
+func (TimelineSpanType) Descriptor() protoreflect.EnumDescriptor {
+	return file_temporal_server_api_enums_v1_workflow_proto_enumTypes[2].Descriptor()
+}
+
+func (TimelineSpanType) Type() protoreflect.EnumType {
+	return &file_temporal_server_api_enums_v1_workflow_proto_enumTypes[2]
+}
+
+func (x TimelineSpanType) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use TimelineSpanType.Descriptor instead.
+func (TimelineSpanType) EnumDescriptor() ([]byte, []int) {
+	return file_temporal_server_api_enums_v1_workflow_proto_rawDescGZIP(), []int{2}
+}
+
+// Action taken on a workflow execution as it consumes its history budget.
+type HistoryBudgetAction int32
+
+const (
+	HISTORY_BUDGET_ACTION_UNSPECIFIED             HistoryBudgetAction = 0
+	HISTORY_BUDGET_ACTION_WARN                    HistoryBudgetAction = 1
+	HISTORY_BUDGET_ACTION_SUGGEST_CONTINUE_AS_NEW HistoryBudgetAction = 2
+	HISTORY_BUDGET_ACTION_BLOCK_SIGNALS           HistoryBudgetAction = 3
+)
+
+// Enum value maps for HistoryBudgetAction.
+var (
+	HistoryBudgetAction_name = map[int32]string{
+		0: "HISTORY_BUDGET_ACTION_UNSPECIFIED",
+		1: "HISTORY_BUDGET_ACTION_WARN",
+		2: "HISTORY_BUDGET_ACTION_SUGGEST_CONTINUE_AS_NEW",
+		3: "HISTORY_BUDGET_ACTION_BLOCK_SIGNALS",
+	}
+	HistoryBudgetAction_value = map[string]int32{
+		"HISTORY_BUDGET_ACTION_UNSPECIFIED":             0,
+		"HISTORY_BUDGET_ACTION_WARN":                    1,
+		"HISTORY_BUDGET_ACTION_SUGGEST_CONTINUE_AS_NEW": 2,
+		"HISTORY_BUDGET_ACTION_BLOCK_SIGNALS":           3,
+	}
+)
+
+func (x HistoryBudgetAction) Enum() *HistoryBudgetAction {
+	p := new(HistoryBudgetAction)
+	*p = x
+	return p
+}
+
+func (x HistoryBudgetAction) String() string {
+	switch x {
+	case HISTORY_BUDGET_ACTION_UNSPECIFIED:
+		return "Unspecified"
+	case HISTORY_BUDGET_ACTION_WARN:
+		return "Warn"
+	case HISTORY_BUDGET_ACTION_SUGGEST_CONTINUE_AS_NEW:
+		return "SuggestContinueAsNew"
+	case HISTORY_BUDGET_ACTION_BLOCK_SIGNALS:
+		return "BlockSignals"
+	default:
+		return strconv.Itoa(int(x))
+	}
+
+}
+
+func (HistoryBudgetAction) Descriptor() protoreflect.EnumDescriptor {
+	return file_temporal_server_api_enums_v1_workflow_proto_enumTypes[3].Descriptor()
+}
+
+func (HistoryBudgetAction) Type() protoreflect.EnumType {
+	return &file_temporal_server_api_enums_v1_workflow_proto_enumTypes[3]
+}
+
+func (x HistoryBudgetAction) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use HistoryBudgetAction.Descriptor instead.
+func (HistoryBudgetAction) EnumDescriptor() ([]byte, []int) {
+	return file_temporal_server_api_enums_v1_workflow_proto_rawDescGZIP(), []int{3}
+}
+
 type PausedWorkflowEntityType int32
 
 const (
@@ -209,11 +349,11 @@ func (x PausedWorkflowEntityType) String() string {
 }
 
 func (PausedWorkflowEntityType) Descriptor() protoreflect.EnumDescriptor {
-	return file_temporal_server_api_enums_v1_workflow_proto_enumTypes[2].Descriptor()
+	return file_temporal_server_api_enums_v1_workflow_proto_enumTypes[4].Descriptor()
 }
 
 func (PausedWorkflowEntityType) Type() protoreflect.EnumType {
-	return &file_temporal_server_api_enums_v1_workflow_proto_enumTypes[2]
+	return &file_temporal_server_api_enums_v1_workflow_proto_enumTypes[4]
 }
 
 func (x PausedWorkflowEntityType) Number() protoreflect.EnumNumber {
@@ -222,7 +362,7 @@ func (x PausedWorkflowEntityType) Number() protoreflect.EnumNumber {
 
 // Deprecated: Use PausedWorkflowEntityType.Descriptor instead.
 func (PausedWorkflowEntityType) EnumDescriptor() ([]byte, []int) {
-	return file_temporal_server_api_enums_v1_workflow_proto_rawDescGZIP(), []int{2}
+	return file_temporal_server_api_enums_v1_workflow_proto_rawDescGZIP(), []int{4}
 }
 
 var File_temporal_server_api_enums_v1_workflow_proto protoreflect.FileDescriptor
@@ -242,7 +382,19 @@ const file_temporal_server_api_enums_v1_workflow_proto_rawDesc = "" +
 	"!WORKFLOW_BACKOFF_TYPE_UNSPECIFIED\x10\x00\x12\x1f\n" +
 	"\x1bWORKFLOW_BACKOFF_TYPE_RETRY\x10\x01\x12\x1e\n" +
 	"\x1aWORKFLOW_BACKOFF_TYPE_CRON\x10\x02\x12%\n" +
-	"!WORKFLOW_BACKOFF_TYPE_DELAY_START\x10\x03*\x9b\x01\n" +
+	"!WORKFLOW_BACKOFF_TYPE_DELAY_START\x10\x03*\xea\x01\n" +
+	"\x10TimelineSpanType\x12\"\n" +
+	"\x1eTIMELINE_SPAN_TYPE_UNSPECIFIED\x10\x00\x12$\n" +
+	" TIMELINE_SPAN_TYPE_WORKFLOW_TASK\x10\x01\x12\x1f\n" +
+	"\x1bTIMELINE_SPAN_TYPE_ACTIVITY\x10\x02\x12\x1c\n" +
+	"\x18TIMELINE_SPAN_TYPE_TIMER\x10\x03\x12%\n" +
+	"!TIMELINE_SPAN_TYPE_CHILD_WORKFLOW\x10\x04\x12&\n" +
+	"\"TIMELINE_SPAN_TYPE_NEXUS_OPERATION\x10\x05*\xb8\x01\n" +
+	"\x13HistoryBudgetAction\x12%\n" +
+	"!HISTORY_BUDGET_ACTION_UNSPECIFIED\x10\x00\x12\x1e\n" +
+	"\x1aHISTORY_BUDGET_ACTION_WARN\x10\x01\x121\n" +
+	"-HISTORY_BUDGET_ACTION_SUGGEST_CONTINUE_AS_NEW\x10\x02\x12'\n" +
+	"#HISTORY_BUDGET_ACTION_BLOCK_SIGNALS\x10\x03*\x9b\x01\n" +
 	"\x18PausedWorkflowEntityType\x12+\n" +
 	"'PAUSED_WORKFLOW_ENTITY_TYPE_UNSPECIFIED\x10\x00\x12(\n" +
 	"$PAUSED_WORKFLOW_ENTITY_TYPE_ACTIVITY\x10\x01\x12(\n" +
@@ -260,11 +412,13 @@ func file_temporal_server_api_enums_v1_workflow_proto_rawDescGZIP() []byte {
 	return file_temporal_server_api_enums_v1_workflow_proto_rawDescData
 }
 
-var file_temporal_server_api_enums_v1_workflow_proto_enumTypes = make([]protoimpl.EnumInfo, 3)
+var file_temporal_server_api_enums_v1_workflow_proto_enumTypes = make([]protoimpl.EnumInfo, 5)
 var file_temporal_server_api_enums_v1_workflow_proto_goTypes = []any{
 	(WorkflowExecutionState)(0),   // 0: temporal.server.api.enums.v1.WorkflowExecutionState
 	(WorkflowBackoffType)(0),      // 1: temporal.server.api.enums.v1.WorkflowBackoffType
-	(PausedWorkflowEntityType)(0), // 2: temporal.server.api.enums.v1.PausedWorkflowEntityType
+	(TimelineSpanType)(0),         // 2: temporal.server.api.enums.v1.TimelineSpanType
+	(HistoryBudgetAction)(0),      // 3: temporal.server.api.enums.v1.HistoryBudgetAction
+	(PausedWorkflowEntityType)(0), // 4: temporal.server.api.enums.v1.PausedWorkflowEntityType
 }
 var file_temporal_server_api_enums_v1_workflow_proto_depIdxs = []int32{
 	0, // [0:0] is the sub-list for method output_type
@@ -284,7 +438,7 @@ func file_temporal_server_api_enums_v1_workflow_proto_init() {
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_temporal_server_api_enums_v1_workflow_proto_rawDesc), len(file_temporal_server_api_enums_v1_workflow_proto_rawDesc)),
-			NumEnums:      3,
+			NumEnums:      5,
 			NumMessages:   0,
 			NumExtensions: 0,
 			NumServices:   0,
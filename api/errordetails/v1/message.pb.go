@@ -131,9 +131,12 @@ func (x *CurrentBranchChangedFailure) GetRequestVersionedTransition() *v1.Versio
 }
 
 type ShardOwnershipLostFailure struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	OwnerHost     string                 `protobuf:"bytes,1,opt,name=owner_host,json=ownerHost,proto3" json:"owner_host,omitempty"`
-	CurrentHost   string                 `protobuf:"bytes,2,opt,name=current_host,json=currentHost,proto3" json:"current_host,omitempty"`
+	state       protoimpl.MessageState `protogen:"open.v1"`
+	OwnerHost   string                 `protobuf:"bytes,1,opt,name=owner_host,json=ownerHost,proto3" json:"owner_host,omitempty"`
+	CurrentHost string                 `protobuf:"bytes,2,opt,name=current_host,json=currentHost,proto3" json:"current_host,omitempty"`
+	// Set when the request was routed by another shard than the one that serves the workflow, e.g. while its
+	// namespace is moved between shard pools.
+	ShardId       int32 `protobuf:"varint,3,opt,name=shard_id,json=shardId,proto3" json:"shard_id,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -182,6 +185,13 @@ func (x *ShardOwnershipLostFailure) GetCurrentHost() string {
 	return ""
 }
 
+func (x *ShardOwnershipLostFailure) GetShardId() int32 {
+	if x != nil {
+		return x.ShardId
+	}
+	return 0
+}
+
 type RetryReplicationFailure struct {
 	state             protoimpl.MessageState `protogen:"open.v1"`
 	NamespaceId       string                 `protobuf:"bytes,1,opt,name=namespace_id,json=namespaceId,proto3" json:"namespace_id,omitempty"`
@@ -508,11 +518,12 @@ const file_temporal_server_api_errordetails_v1_message_proto_rawDesc = "" +
 	"\x14current_branch_token\x18\x01 \x01(\fR\x12currentBranchToken\x120\n" +
 	"\x14request_branch_token\x18\x02 \x01(\fR\x12requestBranchToken\x12y\n" +
 	"\x1ccurrent_versioned_transition\x18\x03 \x01(\v27.temporal.server.api.persistence.v1.VersionedTransitionR\x1acurrentVersionedTransition\x12y\n" +
-	"\x1crequest_versioned_transition\x18\x04 \x01(\v27.temporal.server.api.persistence.v1.VersionedTransitionR\x1arequestVersionedTransition\"]\n" +
+	"\x1crequest_versioned_transition\x18\x04 \x01(\v27.temporal.server.api.persistence.v1.VersionedTransitionR\x1arequestVersionedTransition\"x\n" +
 	"\x19ShardOwnershipLostFailure\x12\x1d\n" +
 	"\n" +
 	"owner_host\x18\x01 \x01(\tR\townerHost\x12!\n" +
-	"\fcurrent_host\x18\x02 \x01(\tR\vcurrentHost\"\x98\x02\n" +
+	"\fcurrent_host\x18\x02 \x01(\tR\vcurrentHost\x12\x19\n" +
+	"\bshard_id\x18\x03 \x01(\x05R\ashardId\"\x98\x02\n" +
 	"\x17RetryReplicationFailure\x12!\n" +
 	"\fnamespace_id\x18\x01 \x01(\tR\vnamespaceId\x12\x1f\n" +
 	"\vworkflow_id\x18\x02 \x01(\tR\n" +
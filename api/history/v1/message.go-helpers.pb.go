@@ -300,3 +300,40 @@ func (this *StrippedHistoryEvents) Equal(that interface{}) bool {
 
 	return proto.Equal(this, that1)
 }
+
+// Marshal an object of type ShardLoad to the protobuf v3 wire format
+func (val *ShardLoad) Marshal() ([]byte, error) {
+	return proto.Marshal(val)
+}
+
+// Unmarshal an object of type ShardLoad from the protobuf v3 wire format
+func (val *ShardLoad) Unmarshal(buf []byte) error {
+	return proto.Unmarshal(buf, val)
+}
+
+// Size returns the size of the object, in bytes, once serialized
+func (val *ShardLoad) Size() int {
+	return proto.Size(val)
+}
+
+// Equal returns whether two ShardLoad values are equivalent by recursively
+// comparing the message's fields.
+// For more information see the documentation for
+// https://pkg.go.dev/google.golang.org/protobuf/proto#Equal
+func (this *ShardLoad) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	var that1 *ShardLoad
+	switch t := that.(type) {
+	case *ShardLoad:
+		that1 = t
+	case ShardLoad:
+		that1 = &t
+	default:
+		return false
+	}
+
+	return proto.Equal(this, that1)
+}
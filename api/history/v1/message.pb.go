@@ -429,6 +429,78 @@ func (x *StrippedHistoryEvents) GetEvents() []*StrippedHistoryEvent {
 	return nil
 }
 
+// ShardLoad describes the load a history shard puts on its owning host.
+type ShardLoad struct {
+	state   protoimpl.MessageState `protogen:"open.v1"`
+	ShardId int32                  `protobuf:"varint,1,opt,name=shard_id,json=shardId,proto3" json:"shard_id,omitempty"`
+	// Rate of requests routed to the shard, averaged over the last load report interval.
+	RequestsPerSecond float64 `protobuf:"fixed64,2,opt,name=requests_per_second,json=requestsPerSecond,proto3" json:"requests_per_second,omitempty"`
+	// Number of tasks loaded by the shard's queues which are not yet completed.
+	QueueBacklog int64 `protobuf:"varint,3,opt,name=queue_backlog,json=queueBacklog,proto3" json:"queue_backlog,omitempty"`
+	// Number of the shard's workflows held in the mutable state cache.
+	CachedWorkflows int64 `protobuf:"varint,4,opt,name=cached_workflows,json=cachedWorkflows,proto3" json:"cached_workflows,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *ShardLoad) Reset() {
+	*x = ShardLoad{}
+	mi := &file_temporal_server_api_history_v1_message_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ShardLoad) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ShardLoad) ProtoMessage() {}
+
+func (x *ShardLoad) ProtoReflect() protoreflect.Message {
+	mi := &file_temporal_server_api_history_v1_message_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ShardLoad.ProtoReflect.Descriptor instead.
+func (*ShardLoad) Descriptor() ([]byte, []int) {
+	return file_temporal_server_api_history_v1_message_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *ShardLoad) GetShardId() int32 {
+	if x != nil {
+		return x.ShardId
+	}
+	return 0
+}
+
+func (x *ShardLoad) GetRequestsPerSecond() float64 {
+	if x != nil {
+		return x.RequestsPerSecond
+	}
+	return 0
+}
+
+func (x *ShardLoad) GetQueueBacklog() int64 {
+	if x != nil {
+		return x.QueueBacklog
+	}
+	return 0
+}
+
+func (x *ShardLoad) GetCachedWorkflows() int64 {
+	if x != nil {
+		return x.CachedWorkflows
+	}
+	return 0
+}
+
 var File_temporal_server_api_history_v1_message_proto protoreflect.FileDescriptor
 
 const file_temporal_server_api_history_v1_message_proto_rawDesc = "" +
@@ -455,7 +527,12 @@ const file_temporal_server_api_history_v1_message_proto_rawDesc = "" +
 	"\bevent_id\x18\x01 \x01(\x03R\aeventId\x12\x18\n" +
 	"\aversion\x18\x04 \x01(\x03R\aversion\"e\n" +
 	"\x15StrippedHistoryEvents\x12L\n" +
-	"\x06events\x18\x01 \x03(\v24.temporal.server.api.history.v1.StrippedHistoryEventR\x06eventsB.Z,go.temporal.io/server/api/history/v1;historyb\x06proto3"
+	"\x06events\x18\x01 \x03(\v24.temporal.server.api.history.v1.StrippedHistoryEventR\x06events\"\xa6\x01\n" +
+	"\tShardLoad\x12\x19\n" +
+	"\bshard_id\x18\x01 \x01(\x05R\ashardId\x12.\n" +
+	"\x13requests_per_second\x18\x02 \x01(\x01R\x11requestsPerSecond\x12#\n" +
+	"\rqueue_backlog\x18\x03 \x01(\x03R\fqueueBacklog\x12)\n" +
+	"\x10cached_workflows\x18\x04 \x01(\x03R\x0fcachedWorkflowsB.Z,go.temporal.io/server/api/history/v1;historyb\x06proto3"
 
 var (
 	file_temporal_server_api_history_v1_message_proto_rawDescOnce sync.Once
@@ -469,7 +546,7 @@ func file_temporal_server_api_history_v1_message_proto_rawDescGZIP() []byte {
 	return file_temporal_server_api_history_v1_message_proto_rawDescData
 }
 
-var file_temporal_server_api_history_v1_message_proto_msgTypes = make([]protoimpl.MessageInfo, 8)
+var file_temporal_server_api_history_v1_message_proto_msgTypes = make([]protoimpl.MessageInfo, 9)
 var file_temporal_server_api_history_v1_message_proto_goTypes = []any{
 	(*TransientWorkflowTaskInfo)(nil), // 0: temporal.server.api.history.v1.TransientWorkflowTaskInfo
 	(*VersionHistoryItem)(nil),        // 1: temporal.server.api.history.v1.VersionHistoryItem
@@ -479,22 +556,23 @@ var file_temporal_server_api_history_v1_message_proto_goTypes = []any{
 	(*TaskRange)(nil),                 // 5: temporal.server.api.history.v1.TaskRange
 	(*StrippedHistoryEvent)(nil),      // 6: temporal.server.api.history.v1.StrippedHistoryEvent
 	(*StrippedHistoryEvents)(nil),     // 7: temporal.server.api.history.v1.StrippedHistoryEvents
-	(*v1.HistoryEvent)(nil),           // 8: temporal.api.history.v1.HistoryEvent
-	(*timestamppb.Timestamp)(nil),     // 9: google.protobuf.Timestamp
+	(*ShardLoad)(nil),                 // 8: temporal.server.api.history.v1.ShardLoad
+	(*v1.HistoryEvent)(nil),           // 9: temporal.api.history.v1.HistoryEvent
+	(*timestamppb.Timestamp)(nil),     // 10: google.protobuf.Timestamp
 }
 var file_temporal_server_api_history_v1_message_proto_depIdxs = []int32{
-	8, // 0: temporal.server.api.history.v1.TransientWorkflowTaskInfo.history_suffix:type_name -> temporal.api.history.v1.HistoryEvent
-	1, // 1: temporal.server.api.history.v1.VersionHistory.items:type_name -> temporal.server.api.history.v1.VersionHistoryItem
-	2, // 2: temporal.server.api.history.v1.VersionHistories.histories:type_name -> temporal.server.api.history.v1.VersionHistory
-	9, // 3: temporal.server.api.history.v1.TaskKey.fire_time:type_name -> google.protobuf.Timestamp
-	4, // 4: temporal.server.api.history.v1.TaskRange.inclusive_min_task_key:type_name -> temporal.server.api.history.v1.TaskKey
-	4, // 5: temporal.server.api.history.v1.TaskRange.exclusive_max_task_key:type_name -> temporal.server.api.history.v1.TaskKey
-	6, // 6: temporal.server.api.history.v1.StrippedHistoryEvents.events:type_name -> temporal.server.api.history.v1.StrippedHistoryEvent
-	7, // [7:7] is the sub-list for method output_type
-	7, // [7:7] is the sub-list for method input_type
-	7, // [7:7] is the sub-list for extension type_name
-	7, // [7:7] is the sub-list for extension extendee
-	0, // [0:7] is the sub-list for field type_name
+	9,  // 0: temporal.server.api.history.v1.TransientWorkflowTaskInfo.history_suffix:type_name -> temporal.api.history.v1.HistoryEvent
+	1,  // 1: temporal.server.api.history.v1.VersionHistory.items:type_name -> temporal.server.api.history.v1.VersionHistoryItem
+	2,  // 2: temporal.server.api.history.v1.VersionHistories.histories:type_name -> temporal.server.api.history.v1.VersionHistory
+	10, // 3: temporal.server.api.history.v1.TaskKey.fire_time:type_name -> google.protobuf.Timestamp
+	4,  // 4: temporal.server.api.history.v1.TaskRange.inclusive_min_task_key:type_name -> temporal.server.api.history.v1.TaskKey
+	4,  // 5: temporal.server.api.history.v1.TaskRange.exclusive_max_task_key:type_name -> temporal.server.api.history.v1.TaskKey
+	6,  // 6: temporal.server.api.history.v1.StrippedHistoryEvents.events:type_name -> temporal.server.api.history.v1.StrippedHistoryEvent
+	7,  // [7:7] is the sub-list for method output_type
+	7,  // [7:7] is the sub-list for method input_type
+	7,  // [7:7] is the sub-list for extension type_name
+	7,  // [7:7] is the sub-list for extension extendee
+	0,  // [0:7] is the sub-list for field type_name
 }
 
 func init() { file_temporal_server_api_history_v1_message_proto_init() }
@@ -508,7 +586,7 @@ func file_temporal_server_api_history_v1_message_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_temporal_server_api_history_v1_message_proto_rawDesc), len(file_temporal_server_api_history_v1_message_proto_rawDesc)),
 			NumEnums:      0,
-			NumMessages:   8,
+			NumMessages:   9,
 			NumExtensions: 0,
 			NumServices:   0,
 		},
@@ -2595,6 +2595,80 @@ func (this *DescribeHistoryHostResponse) Equal(that interface{}) bool {
 	return proto.Equal(this, that1)
 }
 
+// Marshal an object of type MoveShardRequest to the protobuf v3 wire format
+func (val *MoveShardRequest) Marshal() ([]byte, error) {
+	return proto.Marshal(val)
+}
+
+// Unmarshal an object of type MoveShardRequest from the protobuf v3 wire format
+func (val *MoveShardRequest) Unmarshal(buf []byte) error {
+	return proto.Unmarshal(buf, val)
+}
+
+// Size returns the size of the object, in bytes, once serialized
+func (val *MoveShardRequest) Size() int {
+	return proto.Size(val)
+}
+
+// Equal returns whether two MoveShardRequest values are equivalent by recursively
+// comparing the message's fields.
+// For more information see the documentation for
+// https://pkg.go.dev/google.golang.org/protobuf/proto#Equal
+func (this *MoveShardRequest) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	var that1 *MoveShardRequest
+	switch t := that.(type) {
+	case *MoveShardRequest:
+		that1 = t
+	case MoveShardRequest:
+		that1 = &t
+	default:
+		return false
+	}
+
+	return proto.Equal(this, that1)
+}
+
+// Marshal an object of type MoveShardResponse to the protobuf v3 wire format
+func (val *MoveShardResponse) Marshal() ([]byte, error) {
+	return proto.Marshal(val)
+}
+
+// Unmarshal an object of type MoveShardResponse from the protobuf v3 wire format
+func (val *MoveShardResponse) Unmarshal(buf []byte) error {
+	return proto.Unmarshal(buf, val)
+}
+
+// Size returns the size of the object, in bytes, once serialized
+func (val *MoveShardResponse) Size() int {
+	return proto.Size(val)
+}
+
+// Equal returns whether two MoveShardResponse values are equivalent by recursively
+// comparing the message's fields.
+// For more information see the documentation for
+// https://pkg.go.dev/google.golang.org/protobuf/proto#Equal
+func (this *MoveShardResponse) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	var that1 *MoveShardResponse
+	switch t := that.(type) {
+	case *MoveShardResponse:
+		that1 = t
+	case MoveShardResponse:
+		that1 = &t
+	default:
+		return false
+	}
+
+	return proto.Equal(this, that1)
+}
+
 // Marshal an object of type CloseShardRequest to the protobuf v3 wire format
 func (val *CloseShardRequest) Marshal() ([]byte, error) {
 	return proto.Marshal(val)
@@ -3742,6 +3816,80 @@ func (this *RebuildMutableStateResponse) Equal(that interface{}) bool {
 	return proto.Equal(this, that1)
 }
 
+// Marshal an object of type CheckMutableStateRequest to the protobuf v3 wire format
+func (val *CheckMutableStateRequest) Marshal() ([]byte, error) {
+	return proto.Marshal(val)
+}
+
+// Unmarshal an object of type CheckMutableStateRequest from the protobuf v3 wire format
+func (val *CheckMutableStateRequest) Unmarshal(buf []byte) error {
+	return proto.Unmarshal(buf, val)
+}
+
+// Size returns the size of the object, in bytes, once serialized
+func (val *CheckMutableStateRequest) Size() int {
+	return proto.Size(val)
+}
+
+// Equal returns whether two CheckMutableStateRequest values are equivalent by recursively
+// comparing the message's fields.
+// For more information see the documentation for
+// https://pkg.go.dev/google.golang.org/protobuf/proto#Equal
+func (this *CheckMutableStateRequest) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	var that1 *CheckMutableStateRequest
+	switch t := that.(type) {
+	case *CheckMutableStateRequest:
+		that1 = t
+	case CheckMutableStateRequest:
+		that1 = &t
+	default:
+		return false
+	}
+
+	return proto.Equal(this, that1)
+}
+
+// Marshal an object of type CheckMutableStateResponse to the protobuf v3 wire format
+func (val *CheckMutableStateResponse) Marshal() ([]byte, error) {
+	return proto.Marshal(val)
+}
+
+// Unmarshal an object of type CheckMutableStateResponse from the protobuf v3 wire format
+func (val *CheckMutableStateResponse) Unmarshal(buf []byte) error {
+	return proto.Unmarshal(buf, val)
+}
+
+// Size returns the size of the object, in bytes, once serialized
+func (val *CheckMutableStateResponse) Size() int {
+	return proto.Size(val)
+}
+
+// Equal returns whether two CheckMutableStateResponse values are equivalent by recursively
+// comparing the message's fields.
+// For more information see the documentation for
+// https://pkg.go.dev/google.golang.org/protobuf/proto#Equal
+func (this *CheckMutableStateResponse) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	var that1 *CheckMutableStateResponse
+	switch t := that.(type) {
+	case *CheckMutableStateResponse:
+		that1 = t
+	case CheckMutableStateResponse:
+		that1 = &t
+	default:
+		return false
+	}
+
+	return proto.Equal(this, that1)
+}
+
 // Marshal an object of type ImportWorkflowExecutionRequest to the protobuf v3 wire format
 func (val *ImportWorkflowExecutionRequest) Marshal() ([]byte, error) {
 	return proto.Marshal(val)
@@ -3816,6 +3964,376 @@ func (this *ImportWorkflowExecutionResponse) Equal(that interface{}) bool {
 	return proto.Equal(this, that1)
 }
 
+// Marshal an object of type ForkWorkflowExecutionRequest to the protobuf v3 wire format
+func (val *ForkWorkflowExecutionRequest) Marshal() ([]byte, error) {
+	return proto.Marshal(val)
+}
+
+// Unmarshal an object of type ForkWorkflowExecutionRequest from the protobuf v3 wire format
+func (val *ForkWorkflowExecutionRequest) Unmarshal(buf []byte) error {
+	return proto.Unmarshal(buf, val)
+}
+
+// Size returns the size of the object, in bytes, once serialized
+func (val *ForkWorkflowExecutionRequest) Size() int {
+	return proto.Size(val)
+}
+
+// Equal returns whether two ForkWorkflowExecutionRequest values are equivalent by recursively
+// comparing the message's fields.
+// For more information see the documentation for
+// https://pkg.go.dev/google.golang.org/protobuf/proto#Equal
+func (this *ForkWorkflowExecutionRequest) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	var that1 *ForkWorkflowExecutionRequest
+	switch t := that.(type) {
+	case *ForkWorkflowExecutionRequest:
+		that1 = t
+	case ForkWorkflowExecutionRequest:
+		that1 = &t
+	default:
+		return false
+	}
+
+	return proto.Equal(this, that1)
+}
+
+// Marshal an object of type ForkWorkflowExecutionResponse to the protobuf v3 wire format
+func (val *ForkWorkflowExecutionResponse) Marshal() ([]byte, error) {
+	return proto.Marshal(val)
+}
+
+// Unmarshal an object of type ForkWorkflowExecutionResponse from the protobuf v3 wire format
+func (val *ForkWorkflowExecutionResponse) Unmarshal(buf []byte) error {
+	return proto.Unmarshal(buf, val)
+}
+
+// Size returns the size of the object, in bytes, once serialized
+func (val *ForkWorkflowExecutionResponse) Size() int {
+	return proto.Size(val)
+}
+
+// Equal returns whether two ForkWorkflowExecutionResponse values are equivalent by recursively
+// comparing the message's fields.
+// For more information see the documentation for
+// https://pkg.go.dev/google.golang.org/protobuf/proto#Equal
+func (this *ForkWorkflowExecutionResponse) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	var that1 *ForkWorkflowExecutionResponse
+	switch t := that.(type) {
+	case *ForkWorkflowExecutionResponse:
+		that1 = t
+	case ForkWorkflowExecutionResponse:
+		that1 = &t
+	default:
+		return false
+	}
+
+	return proto.Equal(this, that1)
+}
+
+// Marshal an object of type RedactWorkflowExecutionRequest to the protobuf v3 wire format
+func (val *RedactWorkflowExecutionRequest) Marshal() ([]byte, error) {
+	return proto.Marshal(val)
+}
+
+// Unmarshal an object of type RedactWorkflowExecutionRequest from the protobuf v3 wire format
+func (val *RedactWorkflowExecutionRequest) Unmarshal(buf []byte) error {
+	return proto.Unmarshal(buf, val)
+}
+
+// Size returns the size of the object, in bytes, once serialized
+func (val *RedactWorkflowExecutionRequest) Size() int {
+	return proto.Size(val)
+}
+
+// Equal returns whether two RedactWorkflowExecutionRequest values are equivalent by recursively
+// comparing the message's fields.
+// For more information see the documentation for
+// https://pkg.go.dev/google.golang.org/protobuf/proto#Equal
+func (this *RedactWorkflowExecutionRequest) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	var that1 *RedactWorkflowExecutionRequest
+	switch t := that.(type) {
+	case *RedactWorkflowExecutionRequest:
+		that1 = t
+	case RedactWorkflowExecutionRequest:
+		that1 = &t
+	default:
+		return false
+	}
+
+	return proto.Equal(this, that1)
+}
+
+// Marshal an object of type RedactWorkflowExecutionResponse to the protobuf v3 wire format
+func (val *RedactWorkflowExecutionResponse) Marshal() ([]byte, error) {
+	return proto.Marshal(val)
+}
+
+// Unmarshal an object of type RedactWorkflowExecutionResponse from the protobuf v3 wire format
+func (val *RedactWorkflowExecutionResponse) Unmarshal(buf []byte) error {
+	return proto.Unmarshal(buf, val)
+}
+
+// Size returns the size of the object, in bytes, once serialized
+func (val *RedactWorkflowExecutionResponse) Size() int {
+	return proto.Size(val)
+}
+
+// Equal returns whether two RedactWorkflowExecutionResponse values are equivalent by recursively
+// comparing the message's fields.
+// For more information see the documentation for
+// https://pkg.go.dev/google.golang.org/protobuf/proto#Equal
+func (this *RedactWorkflowExecutionResponse) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	var that1 *RedactWorkflowExecutionResponse
+	switch t := that.(type) {
+	case *RedactWorkflowExecutionResponse:
+		that1 = t
+	case RedactWorkflowExecutionResponse:
+		that1 = &t
+	default:
+		return false
+	}
+
+	return proto.Equal(this, that1)
+}
+
+// Marshal an object of type ReencryptWorkflowExecutionRequest to the protobuf v3 wire format
+func (val *ReencryptWorkflowExecutionRequest) Marshal() ([]byte, error) {
+	return proto.Marshal(val)
+}
+
+// Unmarshal an object of type ReencryptWorkflowExecutionRequest from the protobuf v3 wire format
+func (val *ReencryptWorkflowExecutionRequest) Unmarshal(buf []byte) error {
+	return proto.Unmarshal(buf, val)
+}
+
+// Size returns the size of the object, in bytes, once serialized
+func (val *ReencryptWorkflowExecutionRequest) Size() int {
+	return proto.Size(val)
+}
+
+// Equal returns whether two ReencryptWorkflowExecutionRequest values are equivalent by recursively
+// comparing the message's fields.
+// For more information see the documentation for
+// https://pkg.go.dev/google.golang.org/protobuf/proto#Equal
+func (this *ReencryptWorkflowExecutionRequest) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	var that1 *ReencryptWorkflowExecutionRequest
+	switch t := that.(type) {
+	case *ReencryptWorkflowExecutionRequest:
+		that1 = t
+	case ReencryptWorkflowExecutionRequest:
+		that1 = &t
+	default:
+		return false
+	}
+
+	return proto.Equal(this, that1)
+}
+
+// Marshal an object of type ReencryptWorkflowExecutionResponse to the protobuf v3 wire format
+func (val *ReencryptWorkflowExecutionResponse) Marshal() ([]byte, error) {
+	return proto.Marshal(val)
+}
+
+// Unmarshal an object of type ReencryptWorkflowExecutionResponse from the protobuf v3 wire format
+func (val *ReencryptWorkflowExecutionResponse) Unmarshal(buf []byte) error {
+	return proto.Unmarshal(buf, val)
+}
+
+// Size returns the size of the object, in bytes, once serialized
+func (val *ReencryptWorkflowExecutionResponse) Size() int {
+	return proto.Size(val)
+}
+
+// Equal returns whether two ReencryptWorkflowExecutionResponse values are equivalent by recursively
+// comparing the message's fields.
+// For more information see the documentation for
+// https://pkg.go.dev/google.golang.org/protobuf/proto#Equal
+func (this *ReencryptWorkflowExecutionResponse) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	var that1 *ReencryptWorkflowExecutionResponse
+	switch t := that.(type) {
+	case *ReencryptWorkflowExecutionResponse:
+		that1 = t
+	case ReencryptWorkflowExecutionResponse:
+		that1 = &t
+	default:
+		return false
+	}
+
+	return proto.Equal(this, that1)
+}
+
+// Marshal an object of type GetWorkflowExecutionTimelineRequest to the protobuf v3 wire format
+func (val *GetWorkflowExecutionTimelineRequest) Marshal() ([]byte, error) {
+	return proto.Marshal(val)
+}
+
+// Unmarshal an object of type GetWorkflowExecutionTimelineRequest from the protobuf v3 wire format
+func (val *GetWorkflowExecutionTimelineRequest) Unmarshal(buf []byte) error {
+	return proto.Unmarshal(buf, val)
+}
+
+// Size returns the size of the object, in bytes, once serialized
+func (val *GetWorkflowExecutionTimelineRequest) Size() int {
+	return proto.Size(val)
+}
+
+// Equal returns whether two GetWorkflowExecutionTimelineRequest values are equivalent by recursively
+// comparing the message's fields.
+// For more information see the documentation for
+// https://pkg.go.dev/google.golang.org/protobuf/proto#Equal
+func (this *GetWorkflowExecutionTimelineRequest) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	var that1 *GetWorkflowExecutionTimelineRequest
+	switch t := that.(type) {
+	case *GetWorkflowExecutionTimelineRequest:
+		that1 = t
+	case GetWorkflowExecutionTimelineRequest:
+		that1 = &t
+	default:
+		return false
+	}
+
+	return proto.Equal(this, that1)
+}
+
+// Marshal an object of type GetWorkflowExecutionTimelineResponse to the protobuf v3 wire format
+func (val *GetWorkflowExecutionTimelineResponse) Marshal() ([]byte, error) {
+	return proto.Marshal(val)
+}
+
+// Unmarshal an object of type GetWorkflowExecutionTimelineResponse from the protobuf v3 wire format
+func (val *GetWorkflowExecutionTimelineResponse) Unmarshal(buf []byte) error {
+	return proto.Unmarshal(buf, val)
+}
+
+// Size returns the size of the object, in bytes, once serialized
+func (val *GetWorkflowExecutionTimelineResponse) Size() int {
+	return proto.Size(val)
+}
+
+// Equal returns whether two GetWorkflowExecutionTimelineResponse values are equivalent by recursively
+// comparing the message's fields.
+// For more information see the documentation for
+// https://pkg.go.dev/google.golang.org/protobuf/proto#Equal
+func (this *GetWorkflowExecutionTimelineResponse) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	var that1 *GetWorkflowExecutionTimelineResponse
+	switch t := that.(type) {
+	case *GetWorkflowExecutionTimelineResponse:
+		that1 = t
+	case GetWorkflowExecutionTimelineResponse:
+		that1 = &t
+	default:
+		return false
+	}
+
+	return proto.Equal(this, that1)
+}
+
+// Marshal an object of type GetWorkflowHistoryBudgetRequest to the protobuf v3 wire format
+func (val *GetWorkflowHistoryBudgetRequest) Marshal() ([]byte, error) {
+	return proto.Marshal(val)
+}
+
+// Unmarshal an object of type GetWorkflowHistoryBudgetRequest from the protobuf v3 wire format
+func (val *GetWorkflowHistoryBudgetRequest) Unmarshal(buf []byte) error {
+	return proto.Unmarshal(buf, val)
+}
+
+// Size returns the size of the object, in bytes, once serialized
+func (val *GetWorkflowHistoryBudgetRequest) Size() int {
+	return proto.Size(val)
+}
+
+// Equal returns whether two GetWorkflowHistoryBudgetRequest values are equivalent by recursively
+// comparing the message's fields.
+// For more information see the documentation for
+// https://pkg.go.dev/google.golang.org/protobuf/proto#Equal
+func (this *GetWorkflowHistoryBudgetRequest) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	var that1 *GetWorkflowHistoryBudgetRequest
+	switch t := that.(type) {
+	case *GetWorkflowHistoryBudgetRequest:
+		that1 = t
+	case GetWorkflowHistoryBudgetRequest:
+		that1 = &t
+	default:
+		return false
+	}
+
+	return proto.Equal(this, that1)
+}
+
+// Marshal an object of type GetWorkflowHistoryBudgetResponse to the protobuf v3 wire format
+func (val *GetWorkflowHistoryBudgetResponse) Marshal() ([]byte, error) {
+	return proto.Marshal(val)
+}
+
+// Unmarshal an object of type GetWorkflowHistoryBudgetResponse from the protobuf v3 wire format
+func (val *GetWorkflowHistoryBudgetResponse) Unmarshal(buf []byte) error {
+	return proto.Unmarshal(buf, val)
+}
+
+// Size returns the size of the object, in bytes, once serialized
+func (val *GetWorkflowHistoryBudgetResponse) Size() int {
+	return proto.Size(val)
+}
+
+// Equal returns whether two GetWorkflowHistoryBudgetResponse values are equivalent by recursively
+// comparing the message's fields.
+// For more information see the documentation for
+// https://pkg.go.dev/google.golang.org/protobuf/proto#Equal
+func (this *GetWorkflowHistoryBudgetResponse) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	var that1 *GetWorkflowHistoryBudgetResponse
+	switch t := that.(type) {
+	case *GetWorkflowHistoryBudgetResponse:
+		that1 = t
+	case GetWorkflowHistoryBudgetResponse:
+		that1 = &t
+	default:
+		return false
+	}
+
+	return proto.Equal(this, that1)
+}
+
 // Marshal an object of type DeleteWorkflowVisibilityRecordRequest to the protobuf v3 wire format
 func (val *DeleteWorkflowVisibilityRecordRequest) Marshal() ([]byte, error) {
 	return proto.Marshal(val)
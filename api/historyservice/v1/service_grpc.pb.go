@@ -54,6 +54,7 @@ const (
 	HistoryService_SyncActivity_FullMethodName                           = "/temporal.server.api.historyservice.v1.HistoryService/SyncActivity"
 	HistoryService_DescribeMutableState_FullMethodName                   = "/temporal.server.api.historyservice.v1.HistoryService/DescribeMutableState"
 	HistoryService_DescribeHistoryHost_FullMethodName                    = "/temporal.server.api.historyservice.v1.HistoryService/DescribeHistoryHost"
+	HistoryService_MoveShard_FullMethodName                              = "/temporal.server.api.historyservice.v1.HistoryService/MoveShard"
 	HistoryService_CloseShard_FullMethodName                             = "/temporal.server.api.historyservice.v1.HistoryService/CloseShard"
 	HistoryService_GetShard_FullMethodName                               = "/temporal.server.api.historyservice.v1.HistoryService/GetShard"
 	HistoryService_RemoveTask_FullMethodName                             = "/temporal.server.api.historyservice.v1.HistoryService/RemoveTask"
@@ -68,7 +69,13 @@ const (
 	HistoryService_GenerateLastHistoryReplicationTasks_FullMethodName    = "/temporal.server.api.historyservice.v1.HistoryService/GenerateLastHistoryReplicationTasks"
 	HistoryService_GetReplicationStatus_FullMethodName                   = "/temporal.server.api.historyservice.v1.HistoryService/GetReplicationStatus"
 	HistoryService_RebuildMutableState_FullMethodName                    = "/temporal.server.api.historyservice.v1.HistoryService/RebuildMutableState"
+	HistoryService_CheckMutableState_FullMethodName                      = "/temporal.server.api.historyservice.v1.HistoryService/CheckMutableState"
 	HistoryService_ImportWorkflowExecution_FullMethodName                = "/temporal.server.api.historyservice.v1.HistoryService/ImportWorkflowExecution"
+	HistoryService_ForkWorkflowExecution_FullMethodName                  = "/temporal.server.api.historyservice.v1.HistoryService/ForkWorkflowExecution"
+	HistoryService_RedactWorkflowExecution_FullMethodName                = "/temporal.server.api.historyservice.v1.HistoryService/RedactWorkflowExecution"
+	HistoryService_ReencryptWorkflowExecution_FullMethodName             = "/temporal.server.api.historyservice.v1.HistoryService/ReencryptWorkflowExecution"
+	HistoryService_GetWorkflowExecutionTimeline_FullMethodName           = "/temporal.server.api.historyservice.v1.HistoryService/GetWorkflowExecutionTimeline"
+	HistoryService_GetWorkflowHistoryBudget_FullMethodName               = "/temporal.server.api.historyservice.v1.HistoryService/GetWorkflowHistoryBudget"
 	HistoryService_DeleteWorkflowVisibilityRecord_FullMethodName         = "/temporal.server.api.historyservice.v1.HistoryService/DeleteWorkflowVisibilityRecord"
 	HistoryService_UpdateWorkflowExecution_FullMethodName                = "/temporal.server.api.historyservice.v1.HistoryService/UpdateWorkflowExecution"
 	HistoryService_PollWorkflowExecutionUpdate_FullMethodName            = "/temporal.server.api.historyservice.v1.HistoryService/PollWorkflowExecutionUpdate"
@@ -237,6 +244,9 @@ type HistoryServiceClient interface {
 	DescribeMutableState(ctx context.Context, in *DescribeMutableStateRequest, opts ...grpc.CallOption) (*DescribeMutableStateResponse, error)
 	// DescribeHistoryHost returns information about the internal states of a history host.
 	DescribeHistoryHost(ctx context.Context, in *DescribeHistoryHostRequest, opts ...grpc.CallOption) (*DescribeHistoryHostResponse, error)
+	// MoveShard asks the target history host to take over the shard. The current owner keeps serving
+	// the shard until the target host has acquired it.
+	MoveShard(ctx context.Context, in *MoveShardRequest, opts ...grpc.CallOption) (*MoveShardResponse, error)
 	// CloseShard close the shard.
 	CloseShard(ctx context.Context, in *CloseShardRequest, opts ...grpc.CallOption) (*CloseShardResponse, error)
 	// GetShard gets the ShardInfo
@@ -269,9 +279,25 @@ type HistoryServiceClient interface {
 	// RebuildMutableState attempts to rebuild mutable state according to persisted history events.
 	// NOTE: this is experimental API
 	RebuildMutableState(ctx context.Context, in *RebuildMutableStateRequest, opts ...grpc.CallOption) (*RebuildMutableStateResponse, error)
+	// CheckMutableState validates the mutable state of a workflow execution against its history.
+	CheckMutableState(ctx context.Context, in *CheckMutableStateRequest, opts ...grpc.CallOption) (*CheckMutableStateResponse, error)
 	// ImportWorkflowExecution attempts to import workflow according to persisted history events.
 	// NOTE: this is experimental API
 	ImportWorkflowExecution(ctx context.Context, in *ImportWorkflowExecutionRequest, opts ...grpc.CallOption) (*ImportWorkflowExecutionResponse, error)
+	// ForkWorkflowExecution copies the history of a workflow up to the given workflow task into a new
+	// workflow execution owned by this shard.
+	// NOTE: this is experimental API
+	ForkWorkflowExecution(ctx context.Context, in *ForkWorkflowExecutionRequest, opts ...grpc.CallOption) (*ForkWorkflowExecutionResponse, error)
+	// RedactWorkflowExecution erases the selected payloads from the history of a closed workflow execution.
+	// NOTE: this is experimental API
+	RedactWorkflowExecution(ctx context.Context, in *RedactWorkflowExecutionRequest, opts ...grpc.CallOption) (*RedactWorkflowExecutionResponse, error)
+	// ReencryptWorkflowExecution rewrites the mutable state of a closed workflow execution so that it is
+	// encrypted with the active key of its namespace.
+	ReencryptWorkflowExecution(ctx context.Context, in *ReencryptWorkflowExecutionRequest, opts ...grpc.CallOption) (*ReencryptWorkflowExecutionResponse, error)
+	// GetWorkflowExecutionTimeline analyzes the history of a workflow execution and returns it as timed spans.
+	GetWorkflowExecutionTimeline(ctx context.Context, in *GetWorkflowExecutionTimelineRequest, opts ...grpc.CallOption) (*GetWorkflowExecutionTimelineResponse, error)
+	// GetWorkflowHistoryBudget returns the consumption of a workflow execution against its history budget.
+	GetWorkflowHistoryBudget(ctx context.Context, in *GetWorkflowHistoryBudgetRequest, opts ...grpc.CallOption) (*GetWorkflowHistoryBudgetResponse, error)
 	// DeleteWorkflowVisibilityRecord force delete a workflow's visibility record.
 	// This is used by admin delete workflow execution API to delete visibility record as frontend
 	// visibility manager doesn't support write operations
@@ -685,6 +711,15 @@ func (c *historyServiceClient) DescribeHistoryHost(ctx context.Context, in *Desc
 	return out, nil
 }
 
+func (c *historyServiceClient) MoveShard(ctx context.Context, in *MoveShardRequest, opts ...grpc.CallOption) (*MoveShardResponse, error) {
+	out := new(MoveShardResponse)
+	err := c.cc.Invoke(ctx, HistoryService_MoveShard_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *historyServiceClient) CloseShard(ctx context.Context, in *CloseShardRequest, opts ...grpc.CallOption) (*CloseShardResponse, error) {
 	out := new(CloseShardResponse)
 	err := c.cc.Invoke(ctx, HistoryService_CloseShard_FullMethodName, in, out, opts...)
@@ -811,6 +846,15 @@ func (c *historyServiceClient) RebuildMutableState(ctx context.Context, in *Rebu
 	return out, nil
 }
 
+func (c *historyServiceClient) CheckMutableState(ctx context.Context, in *CheckMutableStateRequest, opts ...grpc.CallOption) (*CheckMutableStateResponse, error) {
+	out := new(CheckMutableStateResponse)
+	err := c.cc.Invoke(ctx, HistoryService_CheckMutableState_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *historyServiceClient) ImportWorkflowExecution(ctx context.Context, in *ImportWorkflowExecutionRequest, opts ...grpc.CallOption) (*ImportWorkflowExecutionResponse, error) {
 	out := new(ImportWorkflowExecutionResponse)
 	err := c.cc.Invoke(ctx, HistoryService_ImportWorkflowExecution_FullMethodName, in, out, opts...)
@@ -820,6 +864,51 @@ func (c *historyServiceClient) ImportWorkflowExecution(ctx context.Context, in *
 	return out, nil
 }
 
+func (c *historyServiceClient) ForkWorkflowExecution(ctx context.Context, in *ForkWorkflowExecutionRequest, opts ...grpc.CallOption) (*ForkWorkflowExecutionResponse, error) {
+	out := new(ForkWorkflowExecutionResponse)
+	err := c.cc.Invoke(ctx, HistoryService_ForkWorkflowExecution_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *historyServiceClient) RedactWorkflowExecution(ctx context.Context, in *RedactWorkflowExecutionRequest, opts ...grpc.CallOption) (*RedactWorkflowExecutionResponse, error) {
+	out := new(RedactWorkflowExecutionResponse)
+	err := c.cc.Invoke(ctx, HistoryService_RedactWorkflowExecution_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *historyServiceClient) ReencryptWorkflowExecution(ctx context.Context, in *ReencryptWorkflowExecutionRequest, opts ...grpc.CallOption) (*ReencryptWorkflowExecutionResponse, error) {
+	out := new(ReencryptWorkflowExecutionResponse)
+	err := c.cc.Invoke(ctx, HistoryService_ReencryptWorkflowExecution_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *historyServiceClient) GetWorkflowExecutionTimeline(ctx context.Context, in *GetWorkflowExecutionTimelineRequest, opts ...grpc.CallOption) (*GetWorkflowExecutionTimelineResponse, error) {
+	out := new(GetWorkflowExecutionTimelineResponse)
+	err := c.cc.Invoke(ctx, HistoryService_GetWorkflowExecutionTimeline_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *historyServiceClient) GetWorkflowHistoryBudget(ctx context.Context, in *GetWorkflowHistoryBudgetRequest, opts ...grpc.CallOption) (*GetWorkflowHistoryBudgetResponse, error) {
+	out := new(GetWorkflowHistoryBudgetResponse)
+	err := c.cc.Invoke(ctx, HistoryService_GetWorkflowHistoryBudget_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *historyServiceClient) DeleteWorkflowVisibilityRecord(ctx context.Context, in *DeleteWorkflowVisibilityRecordRequest, opts ...grpc.CallOption) (*DeleteWorkflowVisibilityRecordResponse, error) {
 	out := new(DeleteWorkflowVisibilityRecordResponse)
 	err := c.cc.Invoke(ctx, HistoryService_DeleteWorkflowVisibilityRecord_FullMethodName, in, out, opts...)
@@ -1184,6 +1273,9 @@ type HistoryServiceServer interface {
 	DescribeMutableState(context.Context, *DescribeMutableStateRequest) (*DescribeMutableStateResponse, error)
 	// DescribeHistoryHost returns information about the internal states of a history host.
 	DescribeHistoryHost(context.Context, *DescribeHistoryHostRequest) (*DescribeHistoryHostResponse, error)
+	// MoveShard asks the target history host to take over the shard. The current owner keeps serving
+	// the shard until the target host has acquired it.
+	MoveShard(context.Context, *MoveShardRequest) (*MoveShardResponse, error)
 	// CloseShard close the shard.
 	CloseShard(context.Context, *CloseShardRequest) (*CloseShardResponse, error)
 	// GetShard gets the ShardInfo
@@ -1216,9 +1308,25 @@ type HistoryServiceServer interface {
 	// RebuildMutableState attempts to rebuild mutable state according to persisted history events.
 	// NOTE: this is experimental API
 	RebuildMutableState(context.Context, *RebuildMutableStateRequest) (*RebuildMutableStateResponse, error)
+	// CheckMutableState validates the mutable state of a workflow execution against its history.
+	CheckMutableState(context.Context, *CheckMutableStateRequest) (*CheckMutableStateResponse, error)
 	// ImportWorkflowExecution attempts to import workflow according to persisted history events.
 	// NOTE: this is experimental API
 	ImportWorkflowExecution(context.Context, *ImportWorkflowExecutionRequest) (*ImportWorkflowExecutionResponse, error)
+	// ForkWorkflowExecution copies the history of a workflow up to the given workflow task into a new
+	// workflow execution owned by this shard.
+	// NOTE: this is experimental API
+	ForkWorkflowExecution(context.Context, *ForkWorkflowExecutionRequest) (*ForkWorkflowExecutionResponse, error)
+	// RedactWorkflowExecution erases the selected payloads from the history of a closed workflow execution.
+	// NOTE: this is experimental API
+	RedactWorkflowExecution(context.Context, *RedactWorkflowExecutionRequest) (*RedactWorkflowExecutionResponse, error)
+	// ReencryptWorkflowExecution rewrites the mutable state of a closed workflow execution so that it is
+	// encrypted with the active key of its namespace.
+	ReencryptWorkflowExecution(context.Context, *ReencryptWorkflowExecutionRequest) (*ReencryptWorkflowExecutionResponse, error)
+	// GetWorkflowExecutionTimeline analyzes the history of a workflow execution and returns it as timed spans.
+	GetWorkflowExecutionTimeline(context.Context, *GetWorkflowExecutionTimelineRequest) (*GetWorkflowExecutionTimelineResponse, error)
+	// GetWorkflowHistoryBudget returns the consumption of a workflow execution against its history budget.
+	GetWorkflowHistoryBudget(context.Context, *GetWorkflowHistoryBudgetRequest) (*GetWorkflowHistoryBudgetResponse, error)
 	// DeleteWorkflowVisibilityRecord force delete a workflow's visibility record.
 	// This is used by admin delete workflow execution API to delete visibility record as frontend
 	// visibility manager doesn't support write operations
@@ -1425,6 +1533,9 @@ func (UnimplementedHistoryServiceServer) DescribeMutableState(context.Context, *
 func (UnimplementedHistoryServiceServer) DescribeHistoryHost(context.Context, *DescribeHistoryHostRequest) (*DescribeHistoryHostResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method DescribeHistoryHost not implemented")
 }
+func (UnimplementedHistoryServiceServer) MoveShard(context.Context, *MoveShardRequest) (*MoveShardResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method MoveShard not implemented")
+}
 func (UnimplementedHistoryServiceServer) CloseShard(context.Context, *CloseShardRequest) (*CloseShardResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method CloseShard not implemented")
 }
@@ -1467,9 +1578,27 @@ func (UnimplementedHistoryServiceServer) GetReplicationStatus(context.Context, *
 func (UnimplementedHistoryServiceServer) RebuildMutableState(context.Context, *RebuildMutableStateRequest) (*RebuildMutableStateResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method RebuildMutableState not implemented")
 }
+func (UnimplementedHistoryServiceServer) CheckMutableState(context.Context, *CheckMutableStateRequest) (*CheckMutableStateResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CheckMutableState not implemented")
+}
 func (UnimplementedHistoryServiceServer) ImportWorkflowExecution(context.Context, *ImportWorkflowExecutionRequest) (*ImportWorkflowExecutionResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ImportWorkflowExecution not implemented")
 }
+func (UnimplementedHistoryServiceServer) ForkWorkflowExecution(context.Context, *ForkWorkflowExecutionRequest) (*ForkWorkflowExecutionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ForkWorkflowExecution not implemented")
+}
+func (UnimplementedHistoryServiceServer) RedactWorkflowExecution(context.Context, *RedactWorkflowExecutionRequest) (*RedactWorkflowExecutionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RedactWorkflowExecution not implemented")
+}
+func (UnimplementedHistoryServiceServer) ReencryptWorkflowExecution(context.Context, *ReencryptWorkflowExecutionRequest) (*ReencryptWorkflowExecutionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ReencryptWorkflowExecution not implemented")
+}
+func (UnimplementedHistoryServiceServer) GetWorkflowExecutionTimeline(context.Context, *GetWorkflowExecutionTimelineRequest) (*GetWorkflowExecutionTimelineResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetWorkflowExecutionTimeline not implemented")
+}
+func (UnimplementedHistoryServiceServer) GetWorkflowHistoryBudget(context.Context, *GetWorkflowHistoryBudgetRequest) (*GetWorkflowHistoryBudgetResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetWorkflowHistoryBudget not implemented")
+}
 func (UnimplementedHistoryServiceServer) DeleteWorkflowVisibilityRecord(context.Context, *DeleteWorkflowVisibilityRecordRequest) (*DeleteWorkflowVisibilityRecordResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method DeleteWorkflowVisibilityRecord not implemented")
 }
@@ -2161,6 +2290,24 @@ func _HistoryService_DescribeHistoryHost_Handler(srv interface{}, ctx context.Co
 	return interceptor(ctx, in, info, handler)
 }
 
+func _HistoryService_MoveShard_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MoveShardRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(HistoryServiceServer).MoveShard(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: HistoryService_MoveShard_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(HistoryServiceServer).MoveShard(ctx, req.(*MoveShardRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _HistoryService_CloseShard_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(CloseShardRequest)
 	if err := dec(in); err != nil {
@@ -2413,6 +2560,24 @@ func _HistoryService_RebuildMutableState_Handler(srv interface{}, ctx context.Co
 	return interceptor(ctx, in, info, handler)
 }
 
+func _HistoryService_CheckMutableState_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CheckMutableStateRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(HistoryServiceServer).CheckMutableState(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: HistoryService_CheckMutableState_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(HistoryServiceServer).CheckMutableState(ctx, req.(*CheckMutableStateRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _HistoryService_ImportWorkflowExecution_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(ImportWorkflowExecutionRequest)
 	if err := dec(in); err != nil {
@@ -2431,6 +2596,96 @@ func _HistoryService_ImportWorkflowExecution_Handler(srv interface{}, ctx contex
 	return interceptor(ctx, in, info, handler)
 }
 
+func _HistoryService_ForkWorkflowExecution_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ForkWorkflowExecutionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(HistoryServiceServer).ForkWorkflowExecution(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: HistoryService_ForkWorkflowExecution_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(HistoryServiceServer).ForkWorkflowExecution(ctx, req.(*ForkWorkflowExecutionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _HistoryService_RedactWorkflowExecution_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RedactWorkflowExecutionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(HistoryServiceServer).RedactWorkflowExecution(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: HistoryService_RedactWorkflowExecution_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(HistoryServiceServer).RedactWorkflowExecution(ctx, req.(*RedactWorkflowExecutionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _HistoryService_ReencryptWorkflowExecution_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReencryptWorkflowExecutionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(HistoryServiceServer).ReencryptWorkflowExecution(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: HistoryService_ReencryptWorkflowExecution_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(HistoryServiceServer).ReencryptWorkflowExecution(ctx, req.(*ReencryptWorkflowExecutionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _HistoryService_GetWorkflowExecutionTimeline_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetWorkflowExecutionTimelineRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(HistoryServiceServer).GetWorkflowExecutionTimeline(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: HistoryService_GetWorkflowExecutionTimeline_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(HistoryServiceServer).GetWorkflowExecutionTimeline(ctx, req.(*GetWorkflowExecutionTimelineRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _HistoryService_GetWorkflowHistoryBudget_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetWorkflowHistoryBudgetRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(HistoryServiceServer).GetWorkflowHistoryBudget(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: HistoryService_GetWorkflowHistoryBudget_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(HistoryServiceServer).GetWorkflowHistoryBudget(ctx, req.(*GetWorkflowHistoryBudgetRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _HistoryService_DeleteWorkflowVisibilityRecord_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(DeleteWorkflowVisibilityRecordRequest)
 	if err := dec(in); err != nil {
@@ -2978,6 +3233,10 @@ var HistoryService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "DescribeHistoryHost",
 			Handler:    _HistoryService_DescribeHistoryHost_Handler,
 		},
+		{
+			MethodName: "MoveShard",
+			Handler:    _HistoryService_MoveShard_Handler,
+		},
 		{
 			MethodName: "CloseShard",
 			Handler:    _HistoryService_CloseShard_Handler,
@@ -3034,10 +3293,34 @@ var HistoryService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "RebuildMutableState",
 			Handler:    _HistoryService_RebuildMutableState_Handler,
 		},
+		{
+			MethodName: "CheckMutableState",
+			Handler:    _HistoryService_CheckMutableState_Handler,
+		},
 		{
 			MethodName: "ImportWorkflowExecution",
 			Handler:    _HistoryService_ImportWorkflowExecution_Handler,
 		},
+		{
+			MethodName: "ForkWorkflowExecution",
+			Handler:    _HistoryService_ForkWorkflowExecution_Handler,
+		},
+		{
+			MethodName: "RedactWorkflowExecution",
+			Handler:    _HistoryService_RedactWorkflowExecution_Handler,
+		},
+		{
+			MethodName: "ReencryptWorkflowExecution",
+			Handler:    _HistoryService_ReencryptWorkflowExecution_Handler,
+		},
+		{
+			MethodName: "GetWorkflowExecutionTimeline",
+			Handler:    _HistoryService_GetWorkflowExecutionTimeline_Handler,
+		},
+		{
+			MethodName: "GetWorkflowHistoryBudget",
+			Handler:    _HistoryService_GetWorkflowHistoryBudget_Handler,
+		},
 		{
 			MethodName: "DeleteWorkflowVisibilityRecord",
 			Handler:    _HistoryService_DeleteWorkflowVisibilityRecord_Handler,
@@ -63,6 +63,26 @@ func (mr *MockHistoryServiceClientMockRecorder) AddTasks(ctx, in any, opts ...an
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddTasks", reflect.TypeOf((*MockHistoryServiceClient)(nil).AddTasks), varargs...)
 }
 
+// CheckMutableState mocks base method.
+func (m *MockHistoryServiceClient) CheckMutableState(ctx context.Context, in *historyservice.CheckMutableStateRequest, opts ...grpc.CallOption) (*historyservice.CheckMutableStateResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "CheckMutableState", varargs...)
+	ret0, _ := ret[0].(*historyservice.CheckMutableStateResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CheckMutableState indicates an expected call of CheckMutableState.
+func (mr *MockHistoryServiceClientMockRecorder) CheckMutableState(ctx, in any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CheckMutableState", reflect.TypeOf((*MockHistoryServiceClient)(nil).CheckMutableState), varargs...)
+}
+
 // CloseShard mocks base method.
 func (m *MockHistoryServiceClient) CloseShard(ctx context.Context, in *historyservice.CloseShardRequest, opts ...grpc.CallOption) (*historyservice.CloseShardResponse, error) {
 	m.ctrl.T.Helper()
@@ -283,6 +303,26 @@ func (mr *MockHistoryServiceClientMockRecorder) ForceDeleteWorkflowExecution(ctx
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ForceDeleteWorkflowExecution", reflect.TypeOf((*MockHistoryServiceClient)(nil).ForceDeleteWorkflowExecution), varargs...)
 }
 
+// ForkWorkflowExecution mocks base method.
+func (m *MockHistoryServiceClient) ForkWorkflowExecution(ctx context.Context, in *historyservice.ForkWorkflowExecutionRequest, opts ...grpc.CallOption) (*historyservice.ForkWorkflowExecutionResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ForkWorkflowExecution", varargs...)
+	ret0, _ := ret[0].(*historyservice.ForkWorkflowExecutionResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ForkWorkflowExecution indicates an expected call of ForkWorkflowExecution.
+func (mr *MockHistoryServiceClientMockRecorder) ForkWorkflowExecution(ctx, in any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ForkWorkflowExecution", reflect.TypeOf((*MockHistoryServiceClient)(nil).ForkWorkflowExecution), varargs...)
+}
+
 // GenerateLastHistoryReplicationTasks mocks base method.
 func (m *MockHistoryServiceClient) GenerateLastHistoryReplicationTasks(ctx context.Context, in *historyservice.GenerateLastHistoryReplicationTasksRequest, opts ...grpc.CallOption) (*historyservice.GenerateLastHistoryReplicationTasksResponse, error) {
 	m.ctrl.T.Helper()
@@ -523,6 +563,46 @@ func (mr *MockHistoryServiceClientMockRecorder) GetWorkflowExecutionRawHistoryV2
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetWorkflowExecutionRawHistoryV2", reflect.TypeOf((*MockHistoryServiceClient)(nil).GetWorkflowExecutionRawHistoryV2), varargs...)
 }
 
+// GetWorkflowExecutionTimeline mocks base method.
+func (m *MockHistoryServiceClient) GetWorkflowExecutionTimeline(ctx context.Context, in *historyservice.GetWorkflowExecutionTimelineRequest, opts ...grpc.CallOption) (*historyservice.GetWorkflowExecutionTimelineResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "GetWorkflowExecutionTimeline", varargs...)
+	ret0, _ := ret[0].(*historyservice.GetWorkflowExecutionTimelineResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetWorkflowExecutionTimeline indicates an expected call of GetWorkflowExecutionTimeline.
+func (mr *MockHistoryServiceClientMockRecorder) GetWorkflowExecutionTimeline(ctx, in any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetWorkflowExecutionTimeline", reflect.TypeOf((*MockHistoryServiceClient)(nil).GetWorkflowExecutionTimeline), varargs...)
+}
+
+// GetWorkflowHistoryBudget mocks base method.
+func (m *MockHistoryServiceClient) GetWorkflowHistoryBudget(ctx context.Context, in *historyservice.GetWorkflowHistoryBudgetRequest, opts ...grpc.CallOption) (*historyservice.GetWorkflowHistoryBudgetResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "GetWorkflowHistoryBudget", varargs...)
+	ret0, _ := ret[0].(*historyservice.GetWorkflowHistoryBudgetResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetWorkflowHistoryBudget indicates an expected call of GetWorkflowHistoryBudget.
+func (mr *MockHistoryServiceClientMockRecorder) GetWorkflowHistoryBudget(ctx, in any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetWorkflowHistoryBudget", reflect.TypeOf((*MockHistoryServiceClient)(nil).GetWorkflowHistoryBudget), varargs...)
+}
+
 // ImportWorkflowExecution mocks base method.
 func (m *MockHistoryServiceClient) ImportWorkflowExecution(ctx context.Context, in *historyservice.ImportWorkflowExecutionRequest, opts ...grpc.CallOption) (*historyservice.ImportWorkflowExecutionResponse, error) {
 	m.ctrl.T.Helper()
@@ -663,6 +743,26 @@ func (mr *MockHistoryServiceClientMockRecorder) MergeDLQMessages(ctx, in any, op
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MergeDLQMessages", reflect.TypeOf((*MockHistoryServiceClient)(nil).MergeDLQMessages), varargs...)
 }
 
+// MoveShard mocks base method.
+func (m *MockHistoryServiceClient) MoveShard(ctx context.Context, in *historyservice.MoveShardRequest, opts ...grpc.CallOption) (*historyservice.MoveShardResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "MoveShard", varargs...)
+	ret0, _ := ret[0].(*historyservice.MoveShardResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// MoveShard indicates an expected call of MoveShard.
+func (mr *MockHistoryServiceClientMockRecorder) MoveShard(ctx, in any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MoveShard", reflect.TypeOf((*MockHistoryServiceClient)(nil).MoveShard), varargs...)
+}
+
 // PauseActivity mocks base method.
 func (m *MockHistoryServiceClient) PauseActivity(ctx context.Context, in *historyservice.PauseActivityRequest, opts ...grpc.CallOption) (*historyservice.PauseActivityResponse, error) {
 	m.ctrl.T.Helper()
@@ -883,6 +983,46 @@ func (mr *MockHistoryServiceClientMockRecorder) RecordWorkflowTaskStarted(ctx, i
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RecordWorkflowTaskStarted", reflect.TypeOf((*MockHistoryServiceClient)(nil).RecordWorkflowTaskStarted), varargs...)
 }
 
+// RedactWorkflowExecution mocks base method.
+func (m *MockHistoryServiceClient) RedactWorkflowExecution(ctx context.Context, in *historyservice.RedactWorkflowExecutionRequest, opts ...grpc.CallOption) (*historyservice.RedactWorkflowExecutionResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "RedactWorkflowExecution", varargs...)
+	ret0, _ := ret[0].(*historyservice.RedactWorkflowExecutionResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RedactWorkflowExecution indicates an expected call of RedactWorkflowExecution.
+func (mr *MockHistoryServiceClientMockRecorder) RedactWorkflowExecution(ctx, in any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RedactWorkflowExecution", reflect.TypeOf((*MockHistoryServiceClient)(nil).RedactWorkflowExecution), varargs...)
+}
+
+// ReencryptWorkflowExecution mocks base method.
+func (m *MockHistoryServiceClient) ReencryptWorkflowExecution(ctx context.Context, in *historyservice.ReencryptWorkflowExecutionRequest, opts ...grpc.CallOption) (*historyservice.ReencryptWorkflowExecutionResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ReencryptWorkflowExecution", varargs...)
+	ret0, _ := ret[0].(*historyservice.ReencryptWorkflowExecutionResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ReencryptWorkflowExecution indicates an expected call of ReencryptWorkflowExecution.
+func (mr *MockHistoryServiceClientMockRecorder) ReencryptWorkflowExecution(ctx, in any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReencryptWorkflowExecution", reflect.TypeOf((*MockHistoryServiceClient)(nil).ReencryptWorkflowExecution), varargs...)
+}
+
 // RefreshWorkflowTasks mocks base method.
 func (m *MockHistoryServiceClient) RefreshWorkflowTasks(ctx context.Context, in *historyservice.RefreshWorkflowTasksRequest, opts ...grpc.CallOption) (*historyservice.RefreshWorkflowTasksResponse, error) {
 	m.ctrl.T.Helper()
@@ -1640,6 +1780,21 @@ func (mr *MockHistoryServiceServerMockRecorder) AddTasks(arg0, arg1 any) *gomock
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddTasks", reflect.TypeOf((*MockHistoryServiceServer)(nil).AddTasks), arg0, arg1)
 }
 
+// CheckMutableState mocks base method.
+func (m *MockHistoryServiceServer) CheckMutableState(arg0 context.Context, arg1 *historyservice.CheckMutableStateRequest) (*historyservice.CheckMutableStateResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CheckMutableState", arg0, arg1)
+	ret0, _ := ret[0].(*historyservice.CheckMutableStateResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CheckMutableState indicates an expected call of CheckMutableState.
+func (mr *MockHistoryServiceServerMockRecorder) CheckMutableState(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CheckMutableState", reflect.TypeOf((*MockHistoryServiceServer)(nil).CheckMutableState), arg0, arg1)
+}
+
 // CloseShard mocks base method.
 func (m *MockHistoryServiceServer) CloseShard(arg0 context.Context, arg1 *historyservice.CloseShardRequest) (*historyservice.CloseShardResponse, error) {
 	m.ctrl.T.Helper()
@@ -1805,6 +1960,21 @@ func (mr *MockHistoryServiceServerMockRecorder) ForceDeleteWorkflowExecution(arg
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ForceDeleteWorkflowExecution", reflect.TypeOf((*MockHistoryServiceServer)(nil).ForceDeleteWorkflowExecution), arg0, arg1)
 }
 
+// ForkWorkflowExecution mocks base method.
+func (m *MockHistoryServiceServer) ForkWorkflowExecution(arg0 context.Context, arg1 *historyservice.ForkWorkflowExecutionRequest) (*historyservice.ForkWorkflowExecutionResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ForkWorkflowExecution", arg0, arg1)
+	ret0, _ := ret[0].(*historyservice.ForkWorkflowExecutionResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ForkWorkflowExecution indicates an expected call of ForkWorkflowExecution.
+func (mr *MockHistoryServiceServerMockRecorder) ForkWorkflowExecution(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ForkWorkflowExecution", reflect.TypeOf((*MockHistoryServiceServer)(nil).ForkWorkflowExecution), arg0, arg1)
+}
+
 // GenerateLastHistoryReplicationTasks mocks base method.
 func (m *MockHistoryServiceServer) GenerateLastHistoryReplicationTasks(arg0 context.Context, arg1 *historyservice.GenerateLastHistoryReplicationTasksRequest) (*historyservice.GenerateLastHistoryReplicationTasksResponse, error) {
 	m.ctrl.T.Helper()
@@ -1985,6 +2155,36 @@ func (mr *MockHistoryServiceServerMockRecorder) GetWorkflowExecutionRawHistoryV2
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetWorkflowExecutionRawHistoryV2", reflect.TypeOf((*MockHistoryServiceServer)(nil).GetWorkflowExecutionRawHistoryV2), arg0, arg1)
 }
 
+// GetWorkflowExecutionTimeline mocks base method.
+func (m *MockHistoryServiceServer) GetWorkflowExecutionTimeline(arg0 context.Context, arg1 *historyservice.GetWorkflowExecutionTimelineRequest) (*historyservice.GetWorkflowExecutionTimelineResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetWorkflowExecutionTimeline", arg0, arg1)
+	ret0, _ := ret[0].(*historyservice.GetWorkflowExecutionTimelineResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetWorkflowExecutionTimeline indicates an expected call of GetWorkflowExecutionTimeline.
+func (mr *MockHistoryServiceServerMockRecorder) GetWorkflowExecutionTimeline(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetWorkflowExecutionTimeline", reflect.TypeOf((*MockHistoryServiceServer)(nil).GetWorkflowExecutionTimeline), arg0, arg1)
+}
+
+// GetWorkflowHistoryBudget mocks base method.
+func (m *MockHistoryServiceServer) GetWorkflowHistoryBudget(arg0 context.Context, arg1 *historyservice.GetWorkflowHistoryBudgetRequest) (*historyservice.GetWorkflowHistoryBudgetResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetWorkflowHistoryBudget", arg0, arg1)
+	ret0, _ := ret[0].(*historyservice.GetWorkflowHistoryBudgetResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetWorkflowHistoryBudget indicates an expected call of GetWorkflowHistoryBudget.
+func (mr *MockHistoryServiceServerMockRecorder) GetWorkflowHistoryBudget(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetWorkflowHistoryBudget", reflect.TypeOf((*MockHistoryServiceServer)(nil).GetWorkflowHistoryBudget), arg0, arg1)
+}
+
 // ImportWorkflowExecution mocks base method.
 func (m *MockHistoryServiceServer) ImportWorkflowExecution(arg0 context.Context, arg1 *historyservice.ImportWorkflowExecutionRequest) (*historyservice.ImportWorkflowExecutionResponse, error) {
 	m.ctrl.T.Helper()
@@ -2090,6 +2290,21 @@ func (mr *MockHistoryServiceServerMockRecorder) MergeDLQMessages(arg0, arg1 any)
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MergeDLQMessages", reflect.TypeOf((*MockHistoryServiceServer)(nil).MergeDLQMessages), arg0, arg1)
 }
 
+// MoveShard mocks base method.
+func (m *MockHistoryServiceServer) MoveShard(arg0 context.Context, arg1 *historyservice.MoveShardRequest) (*historyservice.MoveShardResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "MoveShard", arg0, arg1)
+	ret0, _ := ret[0].(*historyservice.MoveShardResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// MoveShard indicates an expected call of MoveShard.
+func (mr *MockHistoryServiceServerMockRecorder) MoveShard(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MoveShard", reflect.TypeOf((*MockHistoryServiceServer)(nil).MoveShard), arg0, arg1)
+}
+
 // PauseActivity mocks base method.
 func (m *MockHistoryServiceServer) PauseActivity(arg0 context.Context, arg1 *historyservice.PauseActivityRequest) (*historyservice.PauseActivityResponse, error) {
 	m.ctrl.T.Helper()
@@ -2255,6 +2470,36 @@ func (mr *MockHistoryServiceServerMockRecorder) RecordWorkflowTaskStarted(arg0,
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RecordWorkflowTaskStarted", reflect.TypeOf((*MockHistoryServiceServer)(nil).RecordWorkflowTaskStarted), arg0, arg1)
 }
 
+// RedactWorkflowExecution mocks base method.
+func (m *MockHistoryServiceServer) RedactWorkflowExecution(arg0 context.Context, arg1 *historyservice.RedactWorkflowExecutionRequest) (*historyservice.RedactWorkflowExecutionResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RedactWorkflowExecution", arg0, arg1)
+	ret0, _ := ret[0].(*historyservice.RedactWorkflowExecutionResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RedactWorkflowExecution indicates an expected call of RedactWorkflowExecution.
+func (mr *MockHistoryServiceServerMockRecorder) RedactWorkflowExecution(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RedactWorkflowExecution", reflect.TypeOf((*MockHistoryServiceServer)(nil).RedactWorkflowExecution), arg0, arg1)
+}
+
+// ReencryptWorkflowExecution mocks base method.
+func (m *MockHistoryServiceServer) ReencryptWorkflowExecution(arg0 context.Context, arg1 *historyservice.ReencryptWorkflowExecutionRequest) (*historyservice.ReencryptWorkflowExecutionResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ReencryptWorkflowExecution", arg0, arg1)
+	ret0, _ := ret[0].(*historyservice.ReencryptWorkflowExecutionResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ReencryptWorkflowExecution indicates an expected call of ReencryptWorkflowExecution.
+func (mr *MockHistoryServiceServerMockRecorder) ReencryptWorkflowExecution(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReencryptWorkflowExecution", reflect.TypeOf((*MockHistoryServiceServer)(nil).ReencryptWorkflowExecution), arg0, arg1)
+}
+
 // RefreshWorkflowTasks mocks base method.
 func (m *MockHistoryServiceServer) RefreshWorkflowTasks(arg0 context.Context, arg1 *historyservice.RefreshWorkflowTasksRequest) (*historyservice.RefreshWorkflowTasksResponse, error) {
 	m.ctrl.T.Helper()
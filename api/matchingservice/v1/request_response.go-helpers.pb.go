@@ -2669,6 +2669,302 @@ func (this *UpdateTaskQueueConfigResponse) Equal(that interface{}) bool {
 	return proto.Equal(this, that1)
 }
 
+// Marshal an object of type UpdateTaskQueueDispatchPauseRequest to the protobuf v3 wire format
+func (val *UpdateTaskQueueDispatchPauseRequest) Marshal() ([]byte, error) {
+	return proto.Marshal(val)
+}
+
+// Unmarshal an object of type UpdateTaskQueueDispatchPauseRequest from the protobuf v3 wire format
+func (val *UpdateTaskQueueDispatchPauseRequest) Unmarshal(buf []byte) error {
+	return proto.Unmarshal(buf, val)
+}
+
+// Size returns the size of the object, in bytes, once serialized
+func (val *UpdateTaskQueueDispatchPauseRequest) Size() int {
+	return proto.Size(val)
+}
+
+// Equal returns whether two UpdateTaskQueueDispatchPauseRequest values are equivalent by recursively
+// comparing the message's fields.
+// For more information see the documentation for
+// https://pkg.go.dev/google.golang.org/protobuf/proto#Equal
+func (this *UpdateTaskQueueDispatchPauseRequest) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	var that1 *UpdateTaskQueueDispatchPauseRequest
+	switch t := that.(type) {
+	case *UpdateTaskQueueDispatchPauseRequest:
+		that1 = t
+	case UpdateTaskQueueDispatchPauseRequest:
+		that1 = &t
+	default:
+		return false
+	}
+
+	return proto.Equal(this, that1)
+}
+
+// Marshal an object of type UpdateTaskQueueDispatchPauseResponse to the protobuf v3 wire format
+func (val *UpdateTaskQueueDispatchPauseResponse) Marshal() ([]byte, error) {
+	return proto.Marshal(val)
+}
+
+// Unmarshal an object of type UpdateTaskQueueDispatchPauseResponse from the protobuf v3 wire format
+func (val *UpdateTaskQueueDispatchPauseResponse) Unmarshal(buf []byte) error {
+	return proto.Unmarshal(buf, val)
+}
+
+// Size returns the size of the object, in bytes, once serialized
+func (val *UpdateTaskQueueDispatchPauseResponse) Size() int {
+	return proto.Size(val)
+}
+
+// Equal returns whether two UpdateTaskQueueDispatchPauseResponse values are equivalent by recursively
+// comparing the message's fields.
+// For more information see the documentation for
+// https://pkg.go.dev/google.golang.org/protobuf/proto#Equal
+func (this *UpdateTaskQueueDispatchPauseResponse) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	var that1 *UpdateTaskQueueDispatchPauseResponse
+	switch t := that.(type) {
+	case *UpdateTaskQueueDispatchPauseResponse:
+		that1 = t
+	case UpdateTaskQueueDispatchPauseResponse:
+		that1 = &t
+	default:
+		return false
+	}
+
+	return proto.Equal(this, that1)
+}
+
+// Marshal an object of type ListTaskQueueBacklogTasksRequest to the protobuf v3 wire format
+func (val *ListTaskQueueBacklogTasksRequest) Marshal() ([]byte, error) {
+	return proto.Marshal(val)
+}
+
+// Unmarshal an object of type ListTaskQueueBacklogTasksRequest from the protobuf v3 wire format
+func (val *ListTaskQueueBacklogTasksRequest) Unmarshal(buf []byte) error {
+	return proto.Unmarshal(buf, val)
+}
+
+// Size returns the size of the object, in bytes, once serialized
+func (val *ListTaskQueueBacklogTasksRequest) Size() int {
+	return proto.Size(val)
+}
+
+// Equal returns whether two ListTaskQueueBacklogTasksRequest values are equivalent by recursively
+// comparing the message's fields.
+// For more information see the documentation for
+// https://pkg.go.dev/google.golang.org/protobuf/proto#Equal
+func (this *ListTaskQueueBacklogTasksRequest) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	var that1 *ListTaskQueueBacklogTasksRequest
+	switch t := that.(type) {
+	case *ListTaskQueueBacklogTasksRequest:
+		that1 = t
+	case ListTaskQueueBacklogTasksRequest:
+		that1 = &t
+	default:
+		return false
+	}
+
+	return proto.Equal(this, that1)
+}
+
+// Marshal an object of type ListTaskQueueBacklogTasksResponse to the protobuf v3 wire format
+func (val *ListTaskQueueBacklogTasksResponse) Marshal() ([]byte, error) {
+	return proto.Marshal(val)
+}
+
+// Unmarshal an object of type ListTaskQueueBacklogTasksResponse from the protobuf v3 wire format
+func (val *ListTaskQueueBacklogTasksResponse) Unmarshal(buf []byte) error {
+	return proto.Unmarshal(buf, val)
+}
+
+// Size returns the size of the object, in bytes, once serialized
+func (val *ListTaskQueueBacklogTasksResponse) Size() int {
+	return proto.Size(val)
+}
+
+// Equal returns whether two ListTaskQueueBacklogTasksResponse values are equivalent by recursively
+// comparing the message's fields.
+// For more information see the documentation for
+// https://pkg.go.dev/google.golang.org/protobuf/proto#Equal
+func (this *ListTaskQueueBacklogTasksResponse) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	var that1 *ListTaskQueueBacklogTasksResponse
+	switch t := that.(type) {
+	case *ListTaskQueueBacklogTasksResponse:
+		that1 = t
+	case ListTaskQueueBacklogTasksResponse:
+		that1 = &t
+	default:
+		return false
+	}
+
+	return proto.Equal(this, that1)
+}
+
+// Marshal an object of type DeleteTaskQueueBacklogTasksRequest to the protobuf v3 wire format
+func (val *DeleteTaskQueueBacklogTasksRequest) Marshal() ([]byte, error) {
+	return proto.Marshal(val)
+}
+
+// Unmarshal an object of type DeleteTaskQueueBacklogTasksRequest from the protobuf v3 wire format
+func (val *DeleteTaskQueueBacklogTasksRequest) Unmarshal(buf []byte) error {
+	return proto.Unmarshal(buf, val)
+}
+
+// Size returns the size of the object, in bytes, once serialized
+func (val *DeleteTaskQueueBacklogTasksRequest) Size() int {
+	return proto.Size(val)
+}
+
+// Equal returns whether two DeleteTaskQueueBacklogTasksRequest values are equivalent by recursively
+// comparing the message's fields.
+// For more information see the documentation for
+// https://pkg.go.dev/google.golang.org/protobuf/proto#Equal
+func (this *DeleteTaskQueueBacklogTasksRequest) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	var that1 *DeleteTaskQueueBacklogTasksRequest
+	switch t := that.(type) {
+	case *DeleteTaskQueueBacklogTasksRequest:
+		that1 = t
+	case DeleteTaskQueueBacklogTasksRequest:
+		that1 = &t
+	default:
+		return false
+	}
+
+	return proto.Equal(this, that1)
+}
+
+// Marshal an object of type DeleteTaskQueueBacklogTasksResponse to the protobuf v3 wire format
+func (val *DeleteTaskQueueBacklogTasksResponse) Marshal() ([]byte, error) {
+	return proto.Marshal(val)
+}
+
+// Unmarshal an object of type DeleteTaskQueueBacklogTasksResponse from the protobuf v3 wire format
+func (val *DeleteTaskQueueBacklogTasksResponse) Unmarshal(buf []byte) error {
+	return proto.Unmarshal(buf, val)
+}
+
+// Size returns the size of the object, in bytes, once serialized
+func (val *DeleteTaskQueueBacklogTasksResponse) Size() int {
+	return proto.Size(val)
+}
+
+// Equal returns whether two DeleteTaskQueueBacklogTasksResponse values are equivalent by recursively
+// comparing the message's fields.
+// For more information see the documentation for
+// https://pkg.go.dev/google.golang.org/protobuf/proto#Equal
+func (this *DeleteTaskQueueBacklogTasksResponse) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	var that1 *DeleteTaskQueueBacklogTasksResponse
+	switch t := that.(type) {
+	case *DeleteTaskQueueBacklogTasksResponse:
+		that1 = t
+	case DeleteTaskQueueBacklogTasksResponse:
+		that1 = &t
+	default:
+		return false
+	}
+
+	return proto.Equal(this, that1)
+}
+
+// Marshal an object of type MoveTaskQueueBacklogTasksRequest to the protobuf v3 wire format
+func (val *MoveTaskQueueBacklogTasksRequest) Marshal() ([]byte, error) {
+	return proto.Marshal(val)
+}
+
+// Unmarshal an object of type MoveTaskQueueBacklogTasksRequest from the protobuf v3 wire format
+func (val *MoveTaskQueueBacklogTasksRequest) Unmarshal(buf []byte) error {
+	return proto.Unmarshal(buf, val)
+}
+
+// Size returns the size of the object, in bytes, once serialized
+func (val *MoveTaskQueueBacklogTasksRequest) Size() int {
+	return proto.Size(val)
+}
+
+// Equal returns whether two MoveTaskQueueBacklogTasksRequest values are equivalent by recursively
+// comparing the message's fields.
+// For more information see the documentation for
+// https://pkg.go.dev/google.golang.org/protobuf/proto#Equal
+func (this *MoveTaskQueueBacklogTasksRequest) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	var that1 *MoveTaskQueueBacklogTasksRequest
+	switch t := that.(type) {
+	case *MoveTaskQueueBacklogTasksRequest:
+		that1 = t
+	case MoveTaskQueueBacklogTasksRequest:
+		that1 = &t
+	default:
+		return false
+	}
+
+	return proto.Equal(this, that1)
+}
+
+// Marshal an object of type MoveTaskQueueBacklogTasksResponse to the protobuf v3 wire format
+func (val *MoveTaskQueueBacklogTasksResponse) Marshal() ([]byte, error) {
+	return proto.Marshal(val)
+}
+
+// Unmarshal an object of type MoveTaskQueueBacklogTasksResponse from the protobuf v3 wire format
+func (val *MoveTaskQueueBacklogTasksResponse) Unmarshal(buf []byte) error {
+	return proto.Unmarshal(buf, val)
+}
+
+// Size returns the size of the object, in bytes, once serialized
+func (val *MoveTaskQueueBacklogTasksResponse) Size() int {
+	return proto.Size(val)
+}
+
+// Equal returns whether two MoveTaskQueueBacklogTasksResponse values are equivalent by recursively
+// comparing the message's fields.
+// For more information see the documentation for
+// https://pkg.go.dev/google.golang.org/protobuf/proto#Equal
+func (this *MoveTaskQueueBacklogTasksResponse) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	var that1 *MoveTaskQueueBacklogTasksResponse
+	switch t := that.(type) {
+	case *MoveTaskQueueBacklogTasksResponse:
+		that1 = t
+	case MoveTaskQueueBacklogTasksResponse:
+		that1 = &t
+	default:
+		return false
+	}
+
+	return proto.Equal(this, that1)
+}
+
 // Marshal an object of type DescribeWorkerRequest to the protobuf v3 wire format
 func (val *DescribeWorkerRequest) Marshal() ([]byte, error) {
 	return proto.Marshal(val)
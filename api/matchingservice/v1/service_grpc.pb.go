@@ -56,6 +56,10 @@ const (
 	MatchingService_RecordWorkerHeartbeat_FullMethodName                  = "/temporal.server.api.matchingservice.v1.MatchingService/RecordWorkerHeartbeat"
 	MatchingService_ListWorkers_FullMethodName                            = "/temporal.server.api.matchingservice.v1.MatchingService/ListWorkers"
 	MatchingService_UpdateTaskQueueConfig_FullMethodName                  = "/temporal.server.api.matchingservice.v1.MatchingService/UpdateTaskQueueConfig"
+	MatchingService_UpdateTaskQueueDispatchPause_FullMethodName           = "/temporal.server.api.matchingservice.v1.MatchingService/UpdateTaskQueueDispatchPause"
+	MatchingService_ListTaskQueueBacklogTasks_FullMethodName              = "/temporal.server.api.matchingservice.v1.MatchingService/ListTaskQueueBacklogTasks"
+	MatchingService_DeleteTaskQueueBacklogTasks_FullMethodName            = "/temporal.server.api.matchingservice.v1.MatchingService/DeleteTaskQueueBacklogTasks"
+	MatchingService_MoveTaskQueueBacklogTasks_FullMethodName              = "/temporal.server.api.matchingservice.v1.MatchingService/MoveTaskQueueBacklogTasks"
 	MatchingService_DescribeWorker_FullMethodName                         = "/temporal.server.api.matchingservice.v1.MatchingService/DescribeWorker"
 )
 
@@ -218,6 +222,16 @@ type MatchingServiceClient interface {
 	//
 	//	aip.dev/not-precedent: UpdateTaskQueueConfig RPC doesn't follow Google API format. --)
 	UpdateTaskQueueConfig(ctx context.Context, in *UpdateTaskQueueConfigRequest, opts ...grpc.CallOption) (*UpdateTaskQueueConfigResponse, error)
+	// Pause or resume dispatch of tasks of a task queue family. The pause state is stored in the task queue user data
+	// so that all partitions honor it.
+	UpdateTaskQueueDispatchPause(ctx context.Context, in *UpdateTaskQueueDispatchPauseRequest, opts ...grpc.CallOption) (*UpdateTaskQueueDispatchPauseResponse, error)
+	// List the tasks in the backlog of the unversioned queue of a task queue partition.
+	ListTaskQueueBacklogTasks(ctx context.Context, in *ListTaskQueueBacklogTasksRequest, opts ...grpc.CallOption) (*ListTaskQueueBacklogTasksResponse, error)
+	// Delete tasks from the backlog of the unversioned queue of a task queue partition.
+	DeleteTaskQueueBacklogTasks(ctx context.Context, in *DeleteTaskQueueBacklogTasksRequest, opts ...grpc.CallOption) (*DeleteTaskQueueBacklogTasksResponse, error)
+	// Move a page of tasks from the backlog of the unversioned queue of a task queue partition to another task
+	// queue. Moved tasks are added to the destination task queue and then deleted from the source backlog.
+	MoveTaskQueueBacklogTasks(ctx context.Context, in *MoveTaskQueueBacklogTasksRequest, opts ...grpc.CallOption) (*MoveTaskQueueBacklogTasksResponse, error)
 	// DescribeWorker retrieves a worker information in the specified namespace that match the provided instance key.
 	// Returns an error if the namespace or worker doesn't exist.
 	DescribeWorker(ctx context.Context, in *DescribeWorkerRequest, opts ...grpc.CallOption) (*DescribeWorkerResponse, error)
@@ -555,6 +569,42 @@ func (c *matchingServiceClient) UpdateTaskQueueConfig(ctx context.Context, in *U
 	return out, nil
 }
 
+func (c *matchingServiceClient) UpdateTaskQueueDispatchPause(ctx context.Context, in *UpdateTaskQueueDispatchPauseRequest, opts ...grpc.CallOption) (*UpdateTaskQueueDispatchPauseResponse, error) {
+	out := new(UpdateTaskQueueDispatchPauseResponse)
+	err := c.cc.Invoke(ctx, MatchingService_UpdateTaskQueueDispatchPause_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *matchingServiceClient) ListTaskQueueBacklogTasks(ctx context.Context, in *ListTaskQueueBacklogTasksRequest, opts ...grpc.CallOption) (*ListTaskQueueBacklogTasksResponse, error) {
+	out := new(ListTaskQueueBacklogTasksResponse)
+	err := c.cc.Invoke(ctx, MatchingService_ListTaskQueueBacklogTasks_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *matchingServiceClient) DeleteTaskQueueBacklogTasks(ctx context.Context, in *DeleteTaskQueueBacklogTasksRequest, opts ...grpc.CallOption) (*DeleteTaskQueueBacklogTasksResponse, error) {
+	out := new(DeleteTaskQueueBacklogTasksResponse)
+	err := c.cc.Invoke(ctx, MatchingService_DeleteTaskQueueBacklogTasks_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *matchingServiceClient) MoveTaskQueueBacklogTasks(ctx context.Context, in *MoveTaskQueueBacklogTasksRequest, opts ...grpc.CallOption) (*MoveTaskQueueBacklogTasksResponse, error) {
+	out := new(MoveTaskQueueBacklogTasksResponse)
+	err := c.cc.Invoke(ctx, MatchingService_MoveTaskQueueBacklogTasks_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *matchingServiceClient) DescribeWorker(ctx context.Context, in *DescribeWorkerRequest, opts ...grpc.CallOption) (*DescribeWorkerResponse, error) {
 	out := new(DescribeWorkerResponse)
 	err := c.cc.Invoke(ctx, MatchingService_DescribeWorker_FullMethodName, in, out, opts...)
@@ -723,6 +773,16 @@ type MatchingServiceServer interface {
 	//
 	//	aip.dev/not-precedent: UpdateTaskQueueConfig RPC doesn't follow Google API format. --)
 	UpdateTaskQueueConfig(context.Context, *UpdateTaskQueueConfigRequest) (*UpdateTaskQueueConfigResponse, error)
+	// Pause or resume dispatch of tasks of a task queue family. The pause state is stored in the task queue user data
+	// so that all partitions honor it.
+	UpdateTaskQueueDispatchPause(context.Context, *UpdateTaskQueueDispatchPauseRequest) (*UpdateTaskQueueDispatchPauseResponse, error)
+	// List the tasks in the backlog of the unversioned queue of a task queue partition.
+	ListTaskQueueBacklogTasks(context.Context, *ListTaskQueueBacklogTasksRequest) (*ListTaskQueueBacklogTasksResponse, error)
+	// Delete tasks from the backlog of the unversioned queue of a task queue partition.
+	DeleteTaskQueueBacklogTasks(context.Context, *DeleteTaskQueueBacklogTasksRequest) (*DeleteTaskQueueBacklogTasksResponse, error)
+	// Move a page of tasks from the backlog of the unversioned queue of a task queue partition to another task
+	// queue. Moved tasks are added to the destination task queue and then deleted from the source backlog.
+	MoveTaskQueueBacklogTasks(context.Context, *MoveTaskQueueBacklogTasksRequest) (*MoveTaskQueueBacklogTasksResponse, error)
 	// DescribeWorker retrieves a worker information in the specified namespace that match the provided instance key.
 	// Returns an error if the namespace or worker doesn't exist.
 	DescribeWorker(context.Context, *DescribeWorkerRequest) (*DescribeWorkerResponse, error)
@@ -841,6 +901,18 @@ func (UnimplementedMatchingServiceServer) ListWorkers(context.Context, *ListWork
 func (UnimplementedMatchingServiceServer) UpdateTaskQueueConfig(context.Context, *UpdateTaskQueueConfigRequest) (*UpdateTaskQueueConfigResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method UpdateTaskQueueConfig not implemented")
 }
+func (UnimplementedMatchingServiceServer) UpdateTaskQueueDispatchPause(context.Context, *UpdateTaskQueueDispatchPauseRequest) (*UpdateTaskQueueDispatchPauseResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateTaskQueueDispatchPause not implemented")
+}
+func (UnimplementedMatchingServiceServer) ListTaskQueueBacklogTasks(context.Context, *ListTaskQueueBacklogTasksRequest) (*ListTaskQueueBacklogTasksResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListTaskQueueBacklogTasks not implemented")
+}
+func (UnimplementedMatchingServiceServer) DeleteTaskQueueBacklogTasks(context.Context, *DeleteTaskQueueBacklogTasksRequest) (*DeleteTaskQueueBacklogTasksResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteTaskQueueBacklogTasks not implemented")
+}
+func (UnimplementedMatchingServiceServer) MoveTaskQueueBacklogTasks(context.Context, *MoveTaskQueueBacklogTasksRequest) (*MoveTaskQueueBacklogTasksResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method MoveTaskQueueBacklogTasks not implemented")
+}
 func (UnimplementedMatchingServiceServer) DescribeWorker(context.Context, *DescribeWorkerRequest) (*DescribeWorkerResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method DescribeWorker not implemented")
 }
@@ -1505,6 +1577,78 @@ func _MatchingService_UpdateTaskQueueConfig_Handler(srv interface{}, ctx context
 	return interceptor(ctx, in, info, handler)
 }
 
+func _MatchingService_UpdateTaskQueueDispatchPause_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateTaskQueueDispatchPauseRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MatchingServiceServer).UpdateTaskQueueDispatchPause(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MatchingService_UpdateTaskQueueDispatchPause_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MatchingServiceServer).UpdateTaskQueueDispatchPause(ctx, req.(*UpdateTaskQueueDispatchPauseRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MatchingService_ListTaskQueueBacklogTasks_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListTaskQueueBacklogTasksRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MatchingServiceServer).ListTaskQueueBacklogTasks(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MatchingService_ListTaskQueueBacklogTasks_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MatchingServiceServer).ListTaskQueueBacklogTasks(ctx, req.(*ListTaskQueueBacklogTasksRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MatchingService_DeleteTaskQueueBacklogTasks_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteTaskQueueBacklogTasksRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MatchingServiceServer).DeleteTaskQueueBacklogTasks(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MatchingService_DeleteTaskQueueBacklogTasks_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MatchingServiceServer).DeleteTaskQueueBacklogTasks(ctx, req.(*DeleteTaskQueueBacklogTasksRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MatchingService_MoveTaskQueueBacklogTasks_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MoveTaskQueueBacklogTasksRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MatchingServiceServer).MoveTaskQueueBacklogTasks(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MatchingService_MoveTaskQueueBacklogTasks_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MatchingServiceServer).MoveTaskQueueBacklogTasks(ctx, req.(*MoveTaskQueueBacklogTasksRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _MatchingService_DescribeWorker_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(DescribeWorkerRequest)
 	if err := dec(in); err != nil {
@@ -1674,6 +1818,22 @@ var MatchingService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "UpdateTaskQueueConfig",
 			Handler:    _MatchingService_UpdateTaskQueueConfig_Handler,
 		},
+		{
+			MethodName: "UpdateTaskQueueDispatchPause",
+			Handler:    _MatchingService_UpdateTaskQueueDispatchPause_Handler,
+		},
+		{
+			MethodName: "ListTaskQueueBacklogTasks",
+			Handler:    _MatchingService_ListTaskQueueBacklogTasks_Handler,
+		},
+		{
+			MethodName: "DeleteTaskQueueBacklogTasks",
+			Handler:    _MatchingService_DeleteTaskQueueBacklogTasks_Handler,
+		},
+		{
+			MethodName: "MoveTaskQueueBacklogTasks",
+			Handler:    _MatchingService_MoveTaskQueueBacklogTasks_Handler,
+		},
 		{
 			MethodName: "DescribeWorker",
 			Handler:    _MatchingService_DescribeWorker_Handler,
@@ -182,6 +182,26 @@ func (mr *MockMatchingServiceClientMockRecorder) DeleteNexusEndpoint(ctx, in any
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteNexusEndpoint", reflect.TypeOf((*MockMatchingServiceClient)(nil).DeleteNexusEndpoint), varargs...)
 }
 
+// DeleteTaskQueueBacklogTasks mocks base method.
+func (m *MockMatchingServiceClient) DeleteTaskQueueBacklogTasks(ctx context.Context, in *matchingservice.DeleteTaskQueueBacklogTasksRequest, opts ...grpc.CallOption) (*matchingservice.DeleteTaskQueueBacklogTasksResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "DeleteTaskQueueBacklogTasks", varargs...)
+	ret0, _ := ret[0].(*matchingservice.DeleteTaskQueueBacklogTasksResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeleteTaskQueueBacklogTasks indicates an expected call of DeleteTaskQueueBacklogTasks.
+func (mr *MockMatchingServiceClientMockRecorder) DeleteTaskQueueBacklogTasks(ctx, in any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteTaskQueueBacklogTasks", reflect.TypeOf((*MockMatchingServiceClient)(nil).DeleteTaskQueueBacklogTasks), varargs...)
+}
+
 // DescribeTaskQueue mocks base method.
 func (m *MockMatchingServiceClient) DescribeTaskQueue(ctx context.Context, in *matchingservice.DescribeTaskQueueRequest, opts ...grpc.CallOption) (*matchingservice.DescribeTaskQueueResponse, error) {
 	m.ctrl.T.Helper()
@@ -442,6 +462,26 @@ func (mr *MockMatchingServiceClientMockRecorder) ListNexusEndpoints(ctx, in any,
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListNexusEndpoints", reflect.TypeOf((*MockMatchingServiceClient)(nil).ListNexusEndpoints), varargs...)
 }
 
+// ListTaskQueueBacklogTasks mocks base method.
+func (m *MockMatchingServiceClient) ListTaskQueueBacklogTasks(ctx context.Context, in *matchingservice.ListTaskQueueBacklogTasksRequest, opts ...grpc.CallOption) (*matchingservice.ListTaskQueueBacklogTasksResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ListTaskQueueBacklogTasks", varargs...)
+	ret0, _ := ret[0].(*matchingservice.ListTaskQueueBacklogTasksResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListTaskQueueBacklogTasks indicates an expected call of ListTaskQueueBacklogTasks.
+func (mr *MockMatchingServiceClientMockRecorder) ListTaskQueueBacklogTasks(ctx, in any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListTaskQueueBacklogTasks", reflect.TypeOf((*MockMatchingServiceClient)(nil).ListTaskQueueBacklogTasks), varargs...)
+}
+
 // ListTaskQueuePartitions mocks base method.
 func (m *MockMatchingServiceClient) ListTaskQueuePartitions(ctx context.Context, in *matchingservice.ListTaskQueuePartitionsRequest, opts ...grpc.CallOption) (*matchingservice.ListTaskQueuePartitionsResponse, error) {
 	m.ctrl.T.Helper()
@@ -482,6 +522,26 @@ func (mr *MockMatchingServiceClientMockRecorder) ListWorkers(ctx, in any, opts .
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListWorkers", reflect.TypeOf((*MockMatchingServiceClient)(nil).ListWorkers), varargs...)
 }
 
+// MoveTaskQueueBacklogTasks mocks base method.
+func (m *MockMatchingServiceClient) MoveTaskQueueBacklogTasks(ctx context.Context, in *matchingservice.MoveTaskQueueBacklogTasksRequest, opts ...grpc.CallOption) (*matchingservice.MoveTaskQueueBacklogTasksResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "MoveTaskQueueBacklogTasks", varargs...)
+	ret0, _ := ret[0].(*matchingservice.MoveTaskQueueBacklogTasksResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// MoveTaskQueueBacklogTasks indicates an expected call of MoveTaskQueueBacklogTasks.
+func (mr *MockMatchingServiceClientMockRecorder) MoveTaskQueueBacklogTasks(ctx, in any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MoveTaskQueueBacklogTasks", reflect.TypeOf((*MockMatchingServiceClient)(nil).MoveTaskQueueBacklogTasks), varargs...)
+}
+
 // PollActivityTaskQueue mocks base method.
 func (m *MockMatchingServiceClient) PollActivityTaskQueue(ctx context.Context, in *matchingservice.PollActivityTaskQueueRequest, opts ...grpc.CallOption) (*matchingservice.PollActivityTaskQueueResponse, error) {
 	m.ctrl.T.Helper()
@@ -722,6 +782,26 @@ func (mr *MockMatchingServiceClientMockRecorder) UpdateTaskQueueConfig(ctx, in a
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateTaskQueueConfig", reflect.TypeOf((*MockMatchingServiceClient)(nil).UpdateTaskQueueConfig), varargs...)
 }
 
+// UpdateTaskQueueDispatchPause mocks base method.
+func (m *MockMatchingServiceClient) UpdateTaskQueueDispatchPause(ctx context.Context, in *matchingservice.UpdateTaskQueueDispatchPauseRequest, opts ...grpc.CallOption) (*matchingservice.UpdateTaskQueueDispatchPauseResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "UpdateTaskQueueDispatchPause", varargs...)
+	ret0, _ := ret[0].(*matchingservice.UpdateTaskQueueDispatchPauseResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateTaskQueueDispatchPause indicates an expected call of UpdateTaskQueueDispatchPause.
+func (mr *MockMatchingServiceClientMockRecorder) UpdateTaskQueueDispatchPause(ctx, in any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateTaskQueueDispatchPause", reflect.TypeOf((*MockMatchingServiceClient)(nil).UpdateTaskQueueDispatchPause), varargs...)
+}
+
 // UpdateTaskQueueUserData mocks base method.
 func (m *MockMatchingServiceClient) UpdateTaskQueueUserData(ctx context.Context, in *matchingservice.UpdateTaskQueueUserDataRequest, opts ...grpc.CallOption) (*matchingservice.UpdateTaskQueueUserDataResponse, error) {
 	m.ctrl.T.Helper()
@@ -911,6 +991,21 @@ func (mr *MockMatchingServiceServerMockRecorder) DeleteNexusEndpoint(arg0, arg1
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteNexusEndpoint", reflect.TypeOf((*MockMatchingServiceServer)(nil).DeleteNexusEndpoint), arg0, arg1)
 }
 
+// DeleteTaskQueueBacklogTasks mocks base method.
+func (m *MockMatchingServiceServer) DeleteTaskQueueBacklogTasks(arg0 context.Context, arg1 *matchingservice.DeleteTaskQueueBacklogTasksRequest) (*matchingservice.DeleteTaskQueueBacklogTasksResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteTaskQueueBacklogTasks", arg0, arg1)
+	ret0, _ := ret[0].(*matchingservice.DeleteTaskQueueBacklogTasksResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeleteTaskQueueBacklogTasks indicates an expected call of DeleteTaskQueueBacklogTasks.
+func (mr *MockMatchingServiceServerMockRecorder) DeleteTaskQueueBacklogTasks(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteTaskQueueBacklogTasks", reflect.TypeOf((*MockMatchingServiceServer)(nil).DeleteTaskQueueBacklogTasks), arg0, arg1)
+}
+
 // DescribeTaskQueue mocks base method.
 func (m *MockMatchingServiceServer) DescribeTaskQueue(arg0 context.Context, arg1 *matchingservice.DescribeTaskQueueRequest) (*matchingservice.DescribeTaskQueueResponse, error) {
 	m.ctrl.T.Helper()
@@ -1106,6 +1201,21 @@ func (mr *MockMatchingServiceServerMockRecorder) ListNexusEndpoints(arg0, arg1 a
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListNexusEndpoints", reflect.TypeOf((*MockMatchingServiceServer)(nil).ListNexusEndpoints), arg0, arg1)
 }
 
+// ListTaskQueueBacklogTasks mocks base method.
+func (m *MockMatchingServiceServer) ListTaskQueueBacklogTasks(arg0 context.Context, arg1 *matchingservice.ListTaskQueueBacklogTasksRequest) (*matchingservice.ListTaskQueueBacklogTasksResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListTaskQueueBacklogTasks", arg0, arg1)
+	ret0, _ := ret[0].(*matchingservice.ListTaskQueueBacklogTasksResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListTaskQueueBacklogTasks indicates an expected call of ListTaskQueueBacklogTasks.
+func (mr *MockMatchingServiceServerMockRecorder) ListTaskQueueBacklogTasks(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListTaskQueueBacklogTasks", reflect.TypeOf((*MockMatchingServiceServer)(nil).ListTaskQueueBacklogTasks), arg0, arg1)
+}
+
 // ListTaskQueuePartitions mocks base method.
 func (m *MockMatchingServiceServer) ListTaskQueuePartitions(arg0 context.Context, arg1 *matchingservice.ListTaskQueuePartitionsRequest) (*matchingservice.ListTaskQueuePartitionsResponse, error) {
 	m.ctrl.T.Helper()
@@ -1136,6 +1246,21 @@ func (mr *MockMatchingServiceServerMockRecorder) ListWorkers(arg0, arg1 any) *go
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListWorkers", reflect.TypeOf((*MockMatchingServiceServer)(nil).ListWorkers), arg0, arg1)
 }
 
+// MoveTaskQueueBacklogTasks mocks base method.
+func (m *MockMatchingServiceServer) MoveTaskQueueBacklogTasks(arg0 context.Context, arg1 *matchingservice.MoveTaskQueueBacklogTasksRequest) (*matchingservice.MoveTaskQueueBacklogTasksResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "MoveTaskQueueBacklogTasks", arg0, arg1)
+	ret0, _ := ret[0].(*matchingservice.MoveTaskQueueBacklogTasksResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// MoveTaskQueueBacklogTasks indicates an expected call of MoveTaskQueueBacklogTasks.
+func (mr *MockMatchingServiceServerMockRecorder) MoveTaskQueueBacklogTasks(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MoveTaskQueueBacklogTasks", reflect.TypeOf((*MockMatchingServiceServer)(nil).MoveTaskQueueBacklogTasks), arg0, arg1)
+}
+
 // PollActivityTaskQueue mocks base method.
 func (m *MockMatchingServiceServer) PollActivityTaskQueue(arg0 context.Context, arg1 *matchingservice.PollActivityTaskQueueRequest) (*matchingservice.PollActivityTaskQueueResponse, error) {
 	m.ctrl.T.Helper()
@@ -1316,6 +1441,21 @@ func (mr *MockMatchingServiceServerMockRecorder) UpdateTaskQueueConfig(arg0, arg
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateTaskQueueConfig", reflect.TypeOf((*MockMatchingServiceServer)(nil).UpdateTaskQueueConfig), arg0, arg1)
 }
 
+// UpdateTaskQueueDispatchPause mocks base method.
+func (m *MockMatchingServiceServer) UpdateTaskQueueDispatchPause(arg0 context.Context, arg1 *matchingservice.UpdateTaskQueueDispatchPauseRequest) (*matchingservice.UpdateTaskQueueDispatchPauseResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateTaskQueueDispatchPause", arg0, arg1)
+	ret0, _ := ret[0].(*matchingservice.UpdateTaskQueueDispatchPauseResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateTaskQueueDispatchPause indicates an expected call of UpdateTaskQueueDispatchPause.
+func (mr *MockMatchingServiceServerMockRecorder) UpdateTaskQueueDispatchPause(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateTaskQueueDispatchPause", reflect.TypeOf((*MockMatchingServiceServer)(nil).UpdateTaskQueueDispatchPause), arg0, arg1)
+}
+
 // UpdateTaskQueueUserData mocks base method.
 func (m *MockMatchingServiceServer) UpdateTaskQueueUserData(arg0 context.Context, arg1 *matchingservice.UpdateTaskQueueUserDataRequest) (*matchingservice.UpdateTaskQueueUserDataResponse, error) {
 	m.ctrl.T.Helper()
@@ -337,3 +337,40 @@ func (this *Queue) Equal(that interface{}) bool {
 
 	return proto.Equal(this, that1)
 }
+
+// Marshal an object of type WorkerHeartbeatBatch to the protobuf v3 wire format
+func (val *WorkerHeartbeatBatch) Marshal() ([]byte, error) {
+	return proto.Marshal(val)
+}
+
+// Unmarshal an object of type WorkerHeartbeatBatch from the protobuf v3 wire format
+func (val *WorkerHeartbeatBatch) Unmarshal(buf []byte) error {
+	return proto.Unmarshal(buf, val)
+}
+
+// Size returns the size of the object, in bytes, once serialized
+func (val *WorkerHeartbeatBatch) Size() int {
+	return proto.Size(val)
+}
+
+// Equal returns whether two WorkerHeartbeatBatch values are equivalent by recursively
+// comparing the message's fields.
+// For more information see the documentation for
+// https://pkg.go.dev/google.golang.org/protobuf/proto#Equal
+func (this *WorkerHeartbeatBatch) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	var that1 *WorkerHeartbeatBatch
+	switch t := that.(type) {
+	case *WorkerHeartbeatBatch:
+		that1 = t
+	case WorkerHeartbeatBatch:
+		that1 = &t
+	default:
+		return false
+	}
+
+	return proto.Equal(this, that1)
+}
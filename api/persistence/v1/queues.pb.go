@@ -12,8 +12,10 @@ import (
 	unsafe "unsafe"
 
 	v1 "go.temporal.io/api/common/v1"
+	v11 "go.temporal.io/api/worker/v1"
 	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
 	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
 )
 
 const (
@@ -472,11 +474,75 @@ func (x *Queue) GetPartitions() map[int32]*QueuePartition {
 	return nil
 }
 
+// WorkerHeartbeatBatch is a message of a worker heartbeat queue. Each matching host periodically appends the worker
+// heartbeats it received since its previous append to the queue of the namespace.
+type WorkerHeartbeatBatch struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Identity of the matching host which received the heartbeats.
+	HostIdentity string `protobuf:"bytes,1,opt,name=host_identity,json=hostIdentity,proto3" json:"host_identity,omitempty"`
+	// Time at which the batch was appended. Heartbeats of a batch are considered last seen at this time.
+	RecordTime    *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=record_time,json=recordTime,proto3" json:"record_time,omitempty"`
+	Heartbeats    []*v11.WorkerHeartbeat `protobuf:"bytes,3,rep,name=heartbeats,proto3" json:"heartbeats,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *WorkerHeartbeatBatch) Reset() {
+	*x = WorkerHeartbeatBatch{}
+	mi := &file_temporal_server_api_persistence_v1_queues_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *WorkerHeartbeatBatch) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WorkerHeartbeatBatch) ProtoMessage() {}
+
+func (x *WorkerHeartbeatBatch) ProtoReflect() protoreflect.Message {
+	mi := &file_temporal_server_api_persistence_v1_queues_proto_msgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WorkerHeartbeatBatch.ProtoReflect.Descriptor instead.
+func (*WorkerHeartbeatBatch) Descriptor() ([]byte, []int) {
+	return file_temporal_server_api_persistence_v1_queues_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *WorkerHeartbeatBatch) GetHostIdentity() string {
+	if x != nil {
+		return x.HostIdentity
+	}
+	return ""
+}
+
+func (x *WorkerHeartbeatBatch) GetRecordTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.RecordTime
+	}
+	return nil
+}
+
+func (x *WorkerHeartbeatBatch) GetHeartbeats() []*v11.WorkerHeartbeat {
+	if x != nil {
+		return x.Heartbeats
+	}
+	return nil
+}
+
 var File_temporal_server_api_persistence_v1_queues_proto protoreflect.FileDescriptor
 
 const file_temporal_server_api_persistence_v1_queues_proto_rawDesc = "" +
 	"\n" +
-	"/temporal/server/api/persistence/v1/queues.proto\x12\"temporal.server.api.persistence.v1\x1a$temporal/api/common/v1/message.proto\x1a3temporal/server/api/persistence/v1/predicates.proto\x1a.temporal/server/api/persistence/v1/tasks.proto\"\xde\x02\n" +
+	"/temporal/server/api/persistence/v1/queues.proto\x12\"temporal.server.api.persistence.v1\x1a\x1fgoogle/protobuf/timestamp.proto\x1a$temporal/api/common/v1/message.proto\x1a$temporal/api/worker/v1/message.proto\x1a3temporal/server/api/persistence/v1/predicates.proto\x1a.temporal/server/api/persistence/v1/tasks.proto\"\xde\x02\n" +
 	"\n" +
 	"QueueState\x12e\n" +
 	"\rreader_states\x18\x01 \x03(\v2@.temporal.server.api.persistence.v1.QueueState.ReaderStatesEntryR\freaderStates\x12r\n" +
@@ -507,7 +573,14 @@ const file_temporal_server_api_persistence_v1_queues_proto_rawDesc = "" +
 	"partitions\x1aq\n" +
 	"\x0fPartitionsEntry\x12\x10\n" +
 	"\x03key\x18\x01 \x01(\x05R\x03key\x12H\n" +
-	"\x05value\x18\x02 \x01(\v22.temporal.server.api.persistence.v1.QueuePartitionR\x05value:\x028\x01B6Z4go.temporal.io/server/api/persistence/v1;persistenceb\x06proto3"
+	"\x05value\x18\x02 \x01(\v22.temporal.server.api.persistence.v1.QueuePartitionR\x05value:\x028\x01\"\xc1\x01\n" +
+	"\x14WorkerHeartbeatBatch\x12#\n" +
+	"\rhost_identity\x18\x01 \x01(\tR\fhostIdentity\x12;\n" +
+	"\vrecord_time\x18\x02 \x01(\v2\x1a.google.protobuf.TimestampR\n" +
+	"recordTime\x12G\n" +
+	"\n" +
+	"heartbeats\x18\x03 \x03(\v2'.temporal.api.worker.v1.WorkerHeartbeatR\n" +
+	"heartbeatsB6Z4go.temporal.io/server/api/persistence/v1;persistenceb\x06proto3"
 
 var (
 	file_temporal_server_api_persistence_v1_queues_proto_rawDescOnce sync.Once
@@ -521,7 +594,7 @@ func file_temporal_server_api_persistence_v1_queues_proto_rawDescGZIP() []byte {
 	return file_temporal_server_api_persistence_v1_queues_proto_rawDescData
 }
 
-var file_temporal_server_api_persistence_v1_queues_proto_msgTypes = make([]protoimpl.MessageInfo, 11)
+var file_temporal_server_api_persistence_v1_queues_proto_msgTypes = make([]protoimpl.MessageInfo, 12)
 var file_temporal_server_api_persistence_v1_queues_proto_goTypes = []any{
 	(*QueueState)(nil),                     // 0: temporal.server.api.persistence.v1.QueueState
 	(*QueueReaderState)(nil),               // 1: temporal.server.api.persistence.v1.QueueReaderState
@@ -532,29 +605,34 @@ var file_temporal_server_api_persistence_v1_queues_proto_goTypes = []any{
 	(*HistoryTask)(nil),                    // 6: temporal.server.api.persistence.v1.HistoryTask
 	(*QueuePartition)(nil),                 // 7: temporal.server.api.persistence.v1.QueuePartition
 	(*Queue)(nil),                          // 8: temporal.server.api.persistence.v1.Queue
-	nil,                                    // 9: temporal.server.api.persistence.v1.QueueState.ReaderStatesEntry
-	nil,                                    // 10: temporal.server.api.persistence.v1.Queue.PartitionsEntry
-	(*TaskKey)(nil),                        // 11: temporal.server.api.persistence.v1.TaskKey
-	(*Predicate)(nil),                      // 12: temporal.server.api.persistence.v1.Predicate
-	(*v1.DataBlob)(nil),                    // 13: temporal.api.common.v1.DataBlob
+	(*WorkerHeartbeatBatch)(nil),           // 9: temporal.server.api.persistence.v1.WorkerHeartbeatBatch
+	nil,                                    // 10: temporal.server.api.persistence.v1.QueueState.ReaderStatesEntry
+	nil,                                    // 11: temporal.server.api.persistence.v1.Queue.PartitionsEntry
+	(*TaskKey)(nil),                        // 12: temporal.server.api.persistence.v1.TaskKey
+	(*Predicate)(nil),                      // 13: temporal.server.api.persistence.v1.Predicate
+	(*v1.DataBlob)(nil),                    // 14: temporal.api.common.v1.DataBlob
+	(*timestamppb.Timestamp)(nil),          // 15: google.protobuf.Timestamp
+	(*v11.WorkerHeartbeat)(nil),            // 16: temporal.api.worker.v1.WorkerHeartbeat
 }
 var file_temporal_server_api_persistence_v1_queues_proto_depIdxs = []int32{
-	9,  // 0: temporal.server.api.persistence.v1.QueueState.reader_states:type_name -> temporal.server.api.persistence.v1.QueueState.ReaderStatesEntry
-	11, // 1: temporal.server.api.persistence.v1.QueueState.exclusive_reader_high_watermark:type_name -> temporal.server.api.persistence.v1.TaskKey
+	10, // 0: temporal.server.api.persistence.v1.QueueState.reader_states:type_name -> temporal.server.api.persistence.v1.QueueState.ReaderStatesEntry
+	12, // 1: temporal.server.api.persistence.v1.QueueState.exclusive_reader_high_watermark:type_name -> temporal.server.api.persistence.v1.TaskKey
 	2,  // 2: temporal.server.api.persistence.v1.QueueReaderState.scopes:type_name -> temporal.server.api.persistence.v1.QueueSliceScope
 	3,  // 3: temporal.server.api.persistence.v1.QueueSliceScope.range:type_name -> temporal.server.api.persistence.v1.QueueSliceRange
-	12, // 4: temporal.server.api.persistence.v1.QueueSliceScope.predicate:type_name -> temporal.server.api.persistence.v1.Predicate
-	11, // 5: temporal.server.api.persistence.v1.QueueSliceRange.inclusive_min:type_name -> temporal.server.api.persistence.v1.TaskKey
-	11, // 6: temporal.server.api.persistence.v1.QueueSliceRange.exclusive_max:type_name -> temporal.server.api.persistence.v1.TaskKey
-	13, // 7: temporal.server.api.persistence.v1.HistoryTask.blob:type_name -> temporal.api.common.v1.DataBlob
-	10, // 8: temporal.server.api.persistence.v1.Queue.partitions:type_name -> temporal.server.api.persistence.v1.Queue.PartitionsEntry
-	1,  // 9: temporal.server.api.persistence.v1.QueueState.ReaderStatesEntry.value:type_name -> temporal.server.api.persistence.v1.QueueReaderState
-	7,  // 10: temporal.server.api.persistence.v1.Queue.PartitionsEntry.value:type_name -> temporal.server.api.persistence.v1.QueuePartition
-	11, // [11:11] is the sub-list for method output_type
-	11, // [11:11] is the sub-list for method input_type
-	11, // [11:11] is the sub-list for extension type_name
-	11, // [11:11] is the sub-list for extension extendee
-	0,  // [0:11] is the sub-list for field type_name
+	13, // 4: temporal.server.api.persistence.v1.QueueSliceScope.predicate:type_name -> temporal.server.api.persistence.v1.Predicate
+	12, // 5: temporal.server.api.persistence.v1.QueueSliceRange.inclusive_min:type_name -> temporal.server.api.persistence.v1.TaskKey
+	12, // 6: temporal.server.api.persistence.v1.QueueSliceRange.exclusive_max:type_name -> temporal.server.api.persistence.v1.TaskKey
+	14, // 7: temporal.server.api.persistence.v1.HistoryTask.blob:type_name -> temporal.api.common.v1.DataBlob
+	11, // 8: temporal.server.api.persistence.v1.Queue.partitions:type_name -> temporal.server.api.persistence.v1.Queue.PartitionsEntry
+	15, // 9: temporal.server.api.persistence.v1.WorkerHeartbeatBatch.record_time:type_name -> google.protobuf.Timestamp
+	16, // 10: temporal.server.api.persistence.v1.WorkerHeartbeatBatch.heartbeats:type_name -> temporal.api.worker.v1.WorkerHeartbeat
+	1,  // 11: temporal.server.api.persistence.v1.QueueState.ReaderStatesEntry.value:type_name -> temporal.server.api.persistence.v1.QueueReaderState
+	7,  // 12: temporal.server.api.persistence.v1.Queue.PartitionsEntry.value:type_name -> temporal.server.api.persistence.v1.QueuePartition
+	13, // [13:13] is the sub-list for method output_type
+	13, // [13:13] is the sub-list for method input_type
+	13, // [13:13] is the sub-list for extension type_name
+	13, // [13:13] is the sub-list for extension extendee
+	0,  // [0:13] is the sub-list for field type_name
 }
 
 func init() { file_temporal_server_api_persistence_v1_queues_proto_init() }
@@ -570,7 +648,7 @@ func file_temporal_server_api_persistence_v1_queues_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_temporal_server_api_persistence_v1_queues_proto_rawDesc), len(file_temporal_server_api_persistence_v1_queues_proto_rawDesc)),
 			NumEnums:      0,
-			NumMessages:   11,
+			NumMessages:   12,
 			NumExtensions: 0,
 			NumServices:   0,
 		},
@@ -18,6 +18,7 @@ import (
 	v12 "go.temporal.io/server/api/deployment/v1"
 	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
 	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
 )
 
 const (
@@ -526,8 +527,13 @@ type TaskQueueTypeUserData struct {
 	state          protoimpl.MessageState `protogen:"open.v1"`
 	DeploymentData *DeploymentData        `protobuf:"bytes,1,opt,name=deployment_data,json=deploymentData,proto3" json:"deployment_data,omitempty"`
 	Config         *v11.TaskQueueConfig   `protobuf:"bytes,2,opt,name=config,proto3" json:"config,omitempty"`
-	unknownFields  protoimpl.UnknownFields
-	sizeCache      protoimpl.SizeCache
+	// Present if dispatch of some or all tasks of this type is paused.
+	DispatchPause *TaskQueueDispatchPause `protobuf:"bytes,3,opt,name=dispatch_pause,json=dispatchPause,proto3" json:"dispatch_pause,omitempty"`
+	// Present if the partition counts of this type are chosen by partition auto-scaling. This is local to the cluster
+	// and is not replicated.
+	PartitionScaling *TaskQueuePartitionScaling `protobuf:"bytes,4,opt,name=partition_scaling,json=partitionScaling,proto3" json:"partition_scaling,omitempty"`
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
 }
 
 func (x *TaskQueueTypeUserData) Reset() {
@@ -574,6 +580,163 @@ func (x *TaskQueueTypeUserData) GetConfig() *v11.TaskQueueConfig {
 	return nil
 }
 
+func (x *TaskQueueTypeUserData) GetDispatchPause() *TaskQueueDispatchPause {
+	if x != nil {
+		return x.DispatchPause
+	}
+	return nil
+}
+
+func (x *TaskQueueTypeUserData) GetPartitionScaling() *TaskQueuePartitionScaling {
+	if x != nil {
+		return x.PartitionScaling
+	}
+	return nil
+}
+
+// Operator controlled pause of task dispatch. Tasks of a paused task queue type or priority level are still accepted
+// into the backlog, but are not matched with pollers until dispatch is resumed.
+type TaskQueueDispatchPause struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Dispatch of all priority levels is paused.
+	AllPriorities bool `protobuf:"varint,1,opt,name=all_priorities,json=allPriorities,proto3" json:"all_priorities,omitempty"`
+	// Priority keys whose dispatch is paused. Only used when all_priorities is not set.
+	PriorityKeys  []int32                `protobuf:"varint,2,rep,packed,name=priority_keys,json=priorityKeys,proto3" json:"priority_keys,omitempty"`
+	Reason        string                 `protobuf:"bytes,3,opt,name=reason,proto3" json:"reason,omitempty"`
+	Identity      string                 `protobuf:"bytes,4,opt,name=identity,proto3" json:"identity,omitempty"`
+	UpdateTime    *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=update_time,json=updateTime,proto3" json:"update_time,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *TaskQueueDispatchPause) Reset() {
+	*x = TaskQueueDispatchPause{}
+	mi := &file_temporal_server_api_persistence_v1_task_queues_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TaskQueueDispatchPause) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TaskQueueDispatchPause) ProtoMessage() {}
+
+func (x *TaskQueueDispatchPause) ProtoReflect() protoreflect.Message {
+	mi := &file_temporal_server_api_persistence_v1_task_queues_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TaskQueueDispatchPause.ProtoReflect.Descriptor instead.
+func (*TaskQueueDispatchPause) Descriptor() ([]byte, []int) {
+	return file_temporal_server_api_persistence_v1_task_queues_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *TaskQueueDispatchPause) GetAllPriorities() bool {
+	if x != nil {
+		return x.AllPriorities
+	}
+	return false
+}
+
+func (x *TaskQueueDispatchPause) GetPriorityKeys() []int32 {
+	if x != nil {
+		return x.PriorityKeys
+	}
+	return nil
+}
+
+func (x *TaskQueueDispatchPause) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+func (x *TaskQueueDispatchPause) GetIdentity() string {
+	if x != nil {
+		return x.Identity
+	}
+	return ""
+}
+
+func (x *TaskQueueDispatchPause) GetUpdateTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.UpdateTime
+	}
+	return nil
+}
+
+// Partition counts chosen by partition auto-scaling. Partitions are added by raising read_partitions before
+// write_partitions, and removed by lowering write_partitions before read_partitions once the removed partitions have
+// no backlog, so that every partition that may have tasks is polled.
+type TaskQueuePartitionScaling struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	ReadPartitions  int32                  `protobuf:"varint,1,opt,name=read_partitions,json=readPartitions,proto3" json:"read_partitions,omitempty"`
+	WritePartitions int32                  `protobuf:"varint,2,opt,name=write_partitions,json=writePartitions,proto3" json:"write_partitions,omitempty"`
+	UpdateTime      *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=update_time,json=updateTime,proto3" json:"update_time,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *TaskQueuePartitionScaling) Reset() {
+	*x = TaskQueuePartitionScaling{}
+	mi := &file_temporal_server_api_persistence_v1_task_queues_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TaskQueuePartitionScaling) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TaskQueuePartitionScaling) ProtoMessage() {}
+
+func (x *TaskQueuePartitionScaling) ProtoReflect() protoreflect.Message {
+	mi := &file_temporal_server_api_persistence_v1_task_queues_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TaskQueuePartitionScaling.ProtoReflect.Descriptor instead.
+func (*TaskQueuePartitionScaling) Descriptor() ([]byte, []int) {
+	return file_temporal_server_api_persistence_v1_task_queues_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *TaskQueuePartitionScaling) GetReadPartitions() int32 {
+	if x != nil {
+		return x.ReadPartitions
+	}
+	return 0
+}
+
+func (x *TaskQueuePartitionScaling) GetWritePartitions() int32 {
+	if x != nil {
+		return x.WritePartitions
+	}
+	return 0
+}
+
+func (x *TaskQueuePartitionScaling) GetUpdateTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.UpdateTime
+	}
+	return nil
+}
+
 // Container for all persistent user provided data for a task queue family.
 // "Task queue" as a named concept here is a task queue family, i.e. the set of task queues
 // that share a name, at most one of each type (workflow, activity, etc.).
@@ -595,7 +758,7 @@ type TaskQueueUserData struct {
 
 func (x *TaskQueueUserData) Reset() {
 	*x = TaskQueueUserData{}
-	mi := &file_temporal_server_api_persistence_v1_task_queues_proto_msgTypes[7]
+	mi := &file_temporal_server_api_persistence_v1_task_queues_proto_msgTypes[9]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -607,7 +770,7 @@ func (x *TaskQueueUserData) String() string {
 func (*TaskQueueUserData) ProtoMessage() {}
 
 func (x *TaskQueueUserData) ProtoReflect() protoreflect.Message {
-	mi := &file_temporal_server_api_persistence_v1_task_queues_proto_msgTypes[7]
+	mi := &file_temporal_server_api_persistence_v1_task_queues_proto_msgTypes[9]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -620,7 +783,7 @@ func (x *TaskQueueUserData) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use TaskQueueUserData.ProtoReflect.Descriptor instead.
 func (*TaskQueueUserData) Descriptor() ([]byte, []int) {
-	return file_temporal_server_api_persistence_v1_task_queues_proto_rawDescGZIP(), []int{7}
+	return file_temporal_server_api_persistence_v1_task_queues_proto_rawDescGZIP(), []int{9}
 }
 
 func (x *TaskQueueUserData) GetClock() *v1.HybridLogicalClock {
@@ -655,7 +818,7 @@ type VersionedTaskQueueUserData struct {
 
 func (x *VersionedTaskQueueUserData) Reset() {
 	*x = VersionedTaskQueueUserData{}
-	mi := &file_temporal_server_api_persistence_v1_task_queues_proto_msgTypes[8]
+	mi := &file_temporal_server_api_persistence_v1_task_queues_proto_msgTypes[10]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -667,7 +830,7 @@ func (x *VersionedTaskQueueUserData) String() string {
 func (*VersionedTaskQueueUserData) ProtoMessage() {}
 
 func (x *VersionedTaskQueueUserData) ProtoReflect() protoreflect.Message {
-	mi := &file_temporal_server_api_persistence_v1_task_queues_proto_msgTypes[8]
+	mi := &file_temporal_server_api_persistence_v1_task_queues_proto_msgTypes[10]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -680,7 +843,7 @@ func (x *VersionedTaskQueueUserData) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use VersionedTaskQueueUserData.ProtoReflect.Descriptor instead.
 func (*VersionedTaskQueueUserData) Descriptor() ([]byte, []int) {
-	return file_temporal_server_api_persistence_v1_task_queues_proto_rawDescGZIP(), []int{8}
+	return file_temporal_server_api_persistence_v1_task_queues_proto_rawDescGZIP(), []int{10}
 }
 
 func (x *VersionedTaskQueueUserData) GetData() *TaskQueueUserData {
@@ -708,7 +871,7 @@ type DeploymentData_DeploymentDataItem struct {
 
 func (x *DeploymentData_DeploymentDataItem) Reset() {
 	*x = DeploymentData_DeploymentDataItem{}
-	mi := &file_temporal_server_api_persistence_v1_task_queues_proto_msgTypes[9]
+	mi := &file_temporal_server_api_persistence_v1_task_queues_proto_msgTypes[11]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -720,7 +883,7 @@ func (x *DeploymentData_DeploymentDataItem) String() string {
 func (*DeploymentData_DeploymentDataItem) ProtoMessage() {}
 
 func (x *DeploymentData_DeploymentDataItem) ProtoReflect() protoreflect.Message {
-	mi := &file_temporal_server_api_persistence_v1_task_queues_proto_msgTypes[9]
+	mi := &file_temporal_server_api_persistence_v1_task_queues_proto_msgTypes[11]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -754,7 +917,7 @@ var File_temporal_server_api_persistence_v1_task_queues_proto protoreflect.FileD
 
 const file_temporal_server_api_persistence_v1_task_queues_proto_rawDesc = "" +
 	"\n" +
-	"4temporal/server/api/persistence/v1/task_queues.proto\x12\"temporal.server.api.persistence.v1\x1a(temporal/api/deployment/v1/message.proto\x1a'temporal/api/taskqueue/v1/message.proto\x1a*temporal/server/api/clock/v1/message.proto\x1a/temporal/server/api/deployment/v1/message.proto\"\xfb\x02\n" +
+	"4temporal/server/api/persistence/v1/task_queues.proto\x12\"temporal.server.api.persistence.v1\x1a\x1fgoogle/protobuf/timestamp.proto\x1a(temporal/api/deployment/v1/message.proto\x1a'temporal/api/taskqueue/v1/message.proto\x1a*temporal/server/api/clock/v1/message.proto\x1a/temporal/server/api/deployment/v1/message.proto\"\xfb\x02\n" +
 	"\aBuildId\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\tR\x02id\x12G\n" +
 	"\x05state\x18\x02 \x01(\x0e21.temporal.server.api.persistence.v1.BuildId.StateR\x05state\x12f\n" +
@@ -788,10 +951,24 @@ const file_temporal_server_api_persistence_v1_task_queues_proto_rawDesc = "" +
 	"\n" +
 	"deployment\x18\x01 \x01(\v2&.temporal.api.deployment.v1.DeploymentR\n" +
 	"deployment\x12D\n" +
-	"\x04data\x18\x02 \x01(\v20.temporal.server.api.deployment.v1.TaskQueueDataR\x04data\"\xb8\x01\n" +
+	"\x04data\x18\x02 \x01(\v20.temporal.server.api.deployment.v1.TaskQueueDataR\x04data\"\x87\x03\n" +
 	"\x15TaskQueueTypeUserData\x12[\n" +
 	"\x0fdeployment_data\x18\x01 \x01(\v22.temporal.server.api.persistence.v1.DeploymentDataR\x0edeploymentData\x12B\n" +
-	"\x06config\x18\x02 \x01(\v2*.temporal.api.taskqueue.v1.TaskQueueConfigR\x06config\"\x8e\x03\n" +
+	"\x06config\x18\x02 \x01(\v2*.temporal.api.taskqueue.v1.TaskQueueConfigR\x06config\x12a\n" +
+	"\x0edispatch_pause\x18\x03 \x01(\v2:.temporal.server.api.persistence.v1.TaskQueueDispatchPauseR\rdispatchPause\x12j\n" +
+	"\x11partition_scaling\x18\x04 \x01(\v2=.temporal.server.api.persistence.v1.TaskQueuePartitionScalingR\x10partitionScaling\"\xd5\x01\n" +
+	"\x16TaskQueueDispatchPause\x12%\n" +
+	"\x0eall_priorities\x18\x01 \x01(\bR\rallPriorities\x12#\n" +
+	"\rpriority_keys\x18\x02 \x03(\x05R\fpriorityKeys\x12\x16\n" +
+	"\x06reason\x18\x03 \x01(\tR\x06reason\x12\x1a\n" +
+	"\bidentity\x18\x04 \x01(\tR\bidentity\x12;\n" +
+	"\vupdate_time\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampR\n" +
+	"updateTime\"\xac\x01\n" +
+	"\x19TaskQueuePartitionScaling\x12'\n" +
+	"\x0fread_partitions\x18\x01 \x01(\x05R\x0ereadPartitions\x12)\n" +
+	"\x10write_partitions\x18\x02 \x01(\x05R\x0fwritePartitions\x12;\n" +
+	"\vupdate_time\x18\x03 \x01(\v2\x1a.google.protobuf.TimestampR\n" +
+	"updateTime\"\x8e\x03\n" +
 	"\x11TaskQueueUserData\x12F\n" +
 	"\x05clock\x18\x01 \x01(\v20.temporal.server.api.clock.v1.HybridLogicalClockR\x05clock\x12[\n" +
 	"\x0fversioning_data\x18\x02 \x01(\v22.temporal.server.api.persistence.v1.VersioningDataR\x0eversioningData\x12]\n" +
@@ -816,7 +993,7 @@ func file_temporal_server_api_persistence_v1_task_queues_proto_rawDescGZIP() []b
 }
 
 var file_temporal_server_api_persistence_v1_task_queues_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
-var file_temporal_server_api_persistence_v1_task_queues_proto_msgTypes = make([]protoimpl.MessageInfo, 11)
+var file_temporal_server_api_persistence_v1_task_queues_proto_msgTypes = make([]protoimpl.MessageInfo, 13)
 var file_temporal_server_api_persistence_v1_task_queues_proto_goTypes = []any{
 	(BuildId_State)(0),                        // 0: temporal.server.api.persistence.v1.BuildId.State
 	(*BuildId)(nil),                           // 1: temporal.server.api.persistence.v1.BuildId
@@ -826,50 +1003,57 @@ var file_temporal_server_api_persistence_v1_task_queues_proto_goTypes = []any{
 	(*VersioningData)(nil),                    // 5: temporal.server.api.persistence.v1.VersioningData
 	(*DeploymentData)(nil),                    // 6: temporal.server.api.persistence.v1.DeploymentData
 	(*TaskQueueTypeUserData)(nil),             // 7: temporal.server.api.persistence.v1.TaskQueueTypeUserData
-	(*TaskQueueUserData)(nil),                 // 8: temporal.server.api.persistence.v1.TaskQueueUserData
-	(*VersionedTaskQueueUserData)(nil),        // 9: temporal.server.api.persistence.v1.VersionedTaskQueueUserData
-	(*DeploymentData_DeploymentDataItem)(nil), // 10: temporal.server.api.persistence.v1.DeploymentData.DeploymentDataItem
-	nil,                               // 11: temporal.server.api.persistence.v1.TaskQueueUserData.PerTypeEntry
-	(*v1.HybridLogicalClock)(nil),     // 12: temporal.server.api.clock.v1.HybridLogicalClock
-	(*v11.BuildIdAssignmentRule)(nil), // 13: temporal.api.taskqueue.v1.BuildIdAssignmentRule
-	(*v11.CompatibleBuildIdRedirectRule)(nil), // 14: temporal.api.taskqueue.v1.CompatibleBuildIdRedirectRule
-	(*v12.DeploymentVersionData)(nil),         // 15: temporal.server.api.deployment.v1.DeploymentVersionData
-	(*v11.TaskQueueConfig)(nil),               // 16: temporal.api.taskqueue.v1.TaskQueueConfig
-	(*v13.Deployment)(nil),                    // 17: temporal.api.deployment.v1.Deployment
-	(*v12.TaskQueueData)(nil),                 // 18: temporal.server.api.deployment.v1.TaskQueueData
+	(*TaskQueueDispatchPause)(nil),            // 8: temporal.server.api.persistence.v1.TaskQueueDispatchPause
+	(*TaskQueuePartitionScaling)(nil),         // 9: temporal.server.api.persistence.v1.TaskQueuePartitionScaling
+	(*TaskQueueUserData)(nil),                 // 10: temporal.server.api.persistence.v1.TaskQueueUserData
+	(*VersionedTaskQueueUserData)(nil),        // 11: temporal.server.api.persistence.v1.VersionedTaskQueueUserData
+	(*DeploymentData_DeploymentDataItem)(nil), // 12: temporal.server.api.persistence.v1.DeploymentData.DeploymentDataItem
+	nil,                               // 13: temporal.server.api.persistence.v1.TaskQueueUserData.PerTypeEntry
+	(*v1.HybridLogicalClock)(nil),     // 14: temporal.server.api.clock.v1.HybridLogicalClock
+	(*v11.BuildIdAssignmentRule)(nil), // 15: temporal.api.taskqueue.v1.BuildIdAssignmentRule
+	(*v11.CompatibleBuildIdRedirectRule)(nil), // 16: temporal.api.taskqueue.v1.CompatibleBuildIdRedirectRule
+	(*v12.DeploymentVersionData)(nil),         // 17: temporal.server.api.deployment.v1.DeploymentVersionData
+	(*v11.TaskQueueConfig)(nil),               // 18: temporal.api.taskqueue.v1.TaskQueueConfig
+	(*timestamppb.Timestamp)(nil),             // 19: google.protobuf.Timestamp
+	(*v13.Deployment)(nil),                    // 20: temporal.api.deployment.v1.Deployment
+	(*v12.TaskQueueData)(nil),                 // 21: temporal.server.api.deployment.v1.TaskQueueData
 }
 var file_temporal_server_api_persistence_v1_task_queues_proto_depIdxs = []int32{
 	0,  // 0: temporal.server.api.persistence.v1.BuildId.state:type_name -> temporal.server.api.persistence.v1.BuildId.State
-	12, // 1: temporal.server.api.persistence.v1.BuildId.state_update_timestamp:type_name -> temporal.server.api.clock.v1.HybridLogicalClock
-	12, // 2: temporal.server.api.persistence.v1.BuildId.became_default_timestamp:type_name -> temporal.server.api.clock.v1.HybridLogicalClock
+	14, // 1: temporal.server.api.persistence.v1.BuildId.state_update_timestamp:type_name -> temporal.server.api.clock.v1.HybridLogicalClock
+	14, // 2: temporal.server.api.persistence.v1.BuildId.became_default_timestamp:type_name -> temporal.server.api.clock.v1.HybridLogicalClock
 	1,  // 3: temporal.server.api.persistence.v1.CompatibleVersionSet.build_ids:type_name -> temporal.server.api.persistence.v1.BuildId
-	12, // 4: temporal.server.api.persistence.v1.CompatibleVersionSet.became_default_timestamp:type_name -> temporal.server.api.clock.v1.HybridLogicalClock
-	13, // 5: temporal.server.api.persistence.v1.AssignmentRule.rule:type_name -> temporal.api.taskqueue.v1.BuildIdAssignmentRule
-	12, // 6: temporal.server.api.persistence.v1.AssignmentRule.create_timestamp:type_name -> temporal.server.api.clock.v1.HybridLogicalClock
-	12, // 7: temporal.server.api.persistence.v1.AssignmentRule.delete_timestamp:type_name -> temporal.server.api.clock.v1.HybridLogicalClock
-	14, // 8: temporal.server.api.persistence.v1.RedirectRule.rule:type_name -> temporal.api.taskqueue.v1.CompatibleBuildIdRedirectRule
-	12, // 9: temporal.server.api.persistence.v1.RedirectRule.create_timestamp:type_name -> temporal.server.api.clock.v1.HybridLogicalClock
-	12, // 10: temporal.server.api.persistence.v1.RedirectRule.delete_timestamp:type_name -> temporal.server.api.clock.v1.HybridLogicalClock
+	14, // 4: temporal.server.api.persistence.v1.CompatibleVersionSet.became_default_timestamp:type_name -> temporal.server.api.clock.v1.HybridLogicalClock
+	15, // 5: temporal.server.api.persistence.v1.AssignmentRule.rule:type_name -> temporal.api.taskqueue.v1.BuildIdAssignmentRule
+	14, // 6: temporal.server.api.persistence.v1.AssignmentRule.create_timestamp:type_name -> temporal.server.api.clock.v1.HybridLogicalClock
+	14, // 7: temporal.server.api.persistence.v1.AssignmentRule.delete_timestamp:type_name -> temporal.server.api.clock.v1.HybridLogicalClock
+	16, // 8: temporal.server.api.persistence.v1.RedirectRule.rule:type_name -> temporal.api.taskqueue.v1.CompatibleBuildIdRedirectRule
+	14, // 9: temporal.server.api.persistence.v1.RedirectRule.create_timestamp:type_name -> temporal.server.api.clock.v1.HybridLogicalClock
+	14, // 10: temporal.server.api.persistence.v1.RedirectRule.delete_timestamp:type_name -> temporal.server.api.clock.v1.HybridLogicalClock
 	2,  // 11: temporal.server.api.persistence.v1.VersioningData.version_sets:type_name -> temporal.server.api.persistence.v1.CompatibleVersionSet
 	3,  // 12: temporal.server.api.persistence.v1.VersioningData.assignment_rules:type_name -> temporal.server.api.persistence.v1.AssignmentRule
 	4,  // 13: temporal.server.api.persistence.v1.VersioningData.redirect_rules:type_name -> temporal.server.api.persistence.v1.RedirectRule
-	10, // 14: temporal.server.api.persistence.v1.DeploymentData.deployments:type_name -> temporal.server.api.persistence.v1.DeploymentData.DeploymentDataItem
-	15, // 15: temporal.server.api.persistence.v1.DeploymentData.versions:type_name -> temporal.server.api.deployment.v1.DeploymentVersionData
-	15, // 16: temporal.server.api.persistence.v1.DeploymentData.unversioned_ramp_data:type_name -> temporal.server.api.deployment.v1.DeploymentVersionData
+	12, // 14: temporal.server.api.persistence.v1.DeploymentData.deployments:type_name -> temporal.server.api.persistence.v1.DeploymentData.DeploymentDataItem
+	17, // 15: temporal.server.api.persistence.v1.DeploymentData.versions:type_name -> temporal.server.api.deployment.v1.DeploymentVersionData
+	17, // 16: temporal.server.api.persistence.v1.DeploymentData.unversioned_ramp_data:type_name -> temporal.server.api.deployment.v1.DeploymentVersionData
 	6,  // 17: temporal.server.api.persistence.v1.TaskQueueTypeUserData.deployment_data:type_name -> temporal.server.api.persistence.v1.DeploymentData
-	16, // 18: temporal.server.api.persistence.v1.TaskQueueTypeUserData.config:type_name -> temporal.api.taskqueue.v1.TaskQueueConfig
-	12, // 19: temporal.server.api.persistence.v1.TaskQueueUserData.clock:type_name -> temporal.server.api.clock.v1.HybridLogicalClock
-	5,  // 20: temporal.server.api.persistence.v1.TaskQueueUserData.versioning_data:type_name -> temporal.server.api.persistence.v1.VersioningData
-	11, // 21: temporal.server.api.persistence.v1.TaskQueueUserData.per_type:type_name -> temporal.server.api.persistence.v1.TaskQueueUserData.PerTypeEntry
-	8,  // 22: temporal.server.api.persistence.v1.VersionedTaskQueueUserData.data:type_name -> temporal.server.api.persistence.v1.TaskQueueUserData
-	17, // 23: temporal.server.api.persistence.v1.DeploymentData.DeploymentDataItem.deployment:type_name -> temporal.api.deployment.v1.Deployment
-	18, // 24: temporal.server.api.persistence.v1.DeploymentData.DeploymentDataItem.data:type_name -> temporal.server.api.deployment.v1.TaskQueueData
-	7,  // 25: temporal.server.api.persistence.v1.TaskQueueUserData.PerTypeEntry.value:type_name -> temporal.server.api.persistence.v1.TaskQueueTypeUserData
-	26, // [26:26] is the sub-list for method output_type
-	26, // [26:26] is the sub-list for method input_type
-	26, // [26:26] is the sub-list for extension type_name
-	26, // [26:26] is the sub-list for extension extendee
-	0,  // [0:26] is the sub-list for field type_name
+	18, // 18: temporal.server.api.persistence.v1.TaskQueueTypeUserData.config:type_name -> temporal.api.taskqueue.v1.TaskQueueConfig
+	8,  // 19: temporal.server.api.persistence.v1.TaskQueueTypeUserData.dispatch_pause:type_name -> temporal.server.api.persistence.v1.TaskQueueDispatchPause
+	9,  // 20: temporal.server.api.persistence.v1.TaskQueueTypeUserData.partition_scaling:type_name -> temporal.server.api.persistence.v1.TaskQueuePartitionScaling
+	19, // 21: temporal.server.api.persistence.v1.TaskQueueDispatchPause.update_time:type_name -> google.protobuf.Timestamp
+	19, // 22: temporal.server.api.persistence.v1.TaskQueuePartitionScaling.update_time:type_name -> google.protobuf.Timestamp
+	14, // 23: temporal.server.api.persistence.v1.TaskQueueUserData.clock:type_name -> temporal.server.api.clock.v1.HybridLogicalClock
+	5,  // 24: temporal.server.api.persistence.v1.TaskQueueUserData.versioning_data:type_name -> temporal.server.api.persistence.v1.VersioningData
+	13, // 25: temporal.server.api.persistence.v1.TaskQueueUserData.per_type:type_name -> temporal.server.api.persistence.v1.TaskQueueUserData.PerTypeEntry
+	10, // 26: temporal.server.api.persistence.v1.VersionedTaskQueueUserData.data:type_name -> temporal.server.api.persistence.v1.TaskQueueUserData
+	20, // 27: temporal.server.api.persistence.v1.DeploymentData.DeploymentDataItem.deployment:type_name -> temporal.api.deployment.v1.Deployment
+	21, // 28: temporal.server.api.persistence.v1.DeploymentData.DeploymentDataItem.data:type_name -> temporal.server.api.deployment.v1.TaskQueueData
+	7,  // 29: temporal.server.api.persistence.v1.TaskQueueUserData.PerTypeEntry.value:type_name -> temporal.server.api.persistence.v1.TaskQueueTypeUserData
+	30, // [30:30] is the sub-list for method output_type
+	30, // [30:30] is the sub-list for method input_type
+	30, // [30:30] is the sub-list for extension type_name
+	30, // [30:30] is the sub-list for extension extendee
+	0,  // [0:30] is the sub-list for field type_name
 }
 
 func init() { file_temporal_server_api_persistence_v1_task_queues_proto_init() }
@@ -883,7 +1067,7 @@ func file_temporal_server_api_persistence_v1_task_queues_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_temporal_server_api_persistence_v1_task_queues_proto_rawDesc), len(file_temporal_server_api_persistence_v1_task_queues_proto_rawDesc)),
 			NumEnums:      1,
-			NumMessages:   11,
+			NumMessages:   13,
 			NumExtensions: 0,
 			NumServices:   0,
 		},
@@ -79,6 +79,117 @@ func (this *FairLevel) Equal(that interface{}) bool {
 	return proto.Equal(this, that1)
 }
 
+// Marshal an object of type BacklogTaskKey to the protobuf v3 wire format
+func (val *BacklogTaskKey) Marshal() ([]byte, error) {
+	return proto.Marshal(val)
+}
+
+// Unmarshal an object of type BacklogTaskKey from the protobuf v3 wire format
+func (val *BacklogTaskKey) Unmarshal(buf []byte) error {
+	return proto.Unmarshal(buf, val)
+}
+
+// Size returns the size of the object, in bytes, once serialized
+func (val *BacklogTaskKey) Size() int {
+	return proto.Size(val)
+}
+
+// Equal returns whether two BacklogTaskKey values are equivalent by recursively
+// comparing the message's fields.
+// For more information see the documentation for
+// https://pkg.go.dev/google.golang.org/protobuf/proto#Equal
+func (this *BacklogTaskKey) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	var that1 *BacklogTaskKey
+	switch t := that.(type) {
+	case *BacklogTaskKey:
+		that1 = t
+	case BacklogTaskKey:
+		that1 = &t
+	default:
+		return false
+	}
+
+	return proto.Equal(this, that1)
+}
+
+// Marshal an object of type BacklogTaskInfo to the protobuf v3 wire format
+func (val *BacklogTaskInfo) Marshal() ([]byte, error) {
+	return proto.Marshal(val)
+}
+
+// Unmarshal an object of type BacklogTaskInfo from the protobuf v3 wire format
+func (val *BacklogTaskInfo) Unmarshal(buf []byte) error {
+	return proto.Unmarshal(buf, val)
+}
+
+// Size returns the size of the object, in bytes, once serialized
+func (val *BacklogTaskInfo) Size() int {
+	return proto.Size(val)
+}
+
+// Equal returns whether two BacklogTaskInfo values are equivalent by recursively
+// comparing the message's fields.
+// For more information see the documentation for
+// https://pkg.go.dev/google.golang.org/protobuf/proto#Equal
+func (this *BacklogTaskInfo) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	var that1 *BacklogTaskInfo
+	switch t := that.(type) {
+	case *BacklogTaskInfo:
+		that1 = t
+	case BacklogTaskInfo:
+		that1 = &t
+	default:
+		return false
+	}
+
+	return proto.Equal(this, that1)
+}
+
+// Marshal an object of type BacklogTasksPageToken to the protobuf v3 wire format
+func (val *BacklogTasksPageToken) Marshal() ([]byte, error) {
+	return proto.Marshal(val)
+}
+
+// Unmarshal an object of type BacklogTasksPageToken from the protobuf v3 wire format
+func (val *BacklogTasksPageToken) Unmarshal(buf []byte) error {
+	return proto.Unmarshal(buf, val)
+}
+
+// Size returns the size of the object, in bytes, once serialized
+func (val *BacklogTasksPageToken) Size() int {
+	return proto.Size(val)
+}
+
+// Equal returns whether two BacklogTasksPageToken values are equivalent by recursively
+// comparing the message's fields.
+// For more information see the documentation for
+// https://pkg.go.dev/google.golang.org/protobuf/proto#Equal
+func (this *BacklogTasksPageToken) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	var that1 *BacklogTasksPageToken
+	switch t := that.(type) {
+	case *BacklogTasksPageToken:
+		that1 = t
+	case BacklogTasksPageToken:
+		that1 = &t
+	default:
+		return false
+	}
+
+	return proto.Equal(this, that1)
+}
+
 // Marshal an object of type InternalTaskQueueStatus to the protobuf v3 wire format
 func (val *InternalTaskQueueStatus) Marshal() ([]byte, error) {
 	return proto.Marshal(val)
@@ -227,6 +338,43 @@ func (this *TaskQueuePartition) Equal(that interface{}) bool {
 	return proto.Equal(this, that1)
 }
 
+// Marshal an object of type TaskQueuePartitionCounts to the protobuf v3 wire format
+func (val *TaskQueuePartitionCounts) Marshal() ([]byte, error) {
+	return proto.Marshal(val)
+}
+
+// Unmarshal an object of type TaskQueuePartitionCounts from the protobuf v3 wire format
+func (val *TaskQueuePartitionCounts) Unmarshal(buf []byte) error {
+	return proto.Unmarshal(buf, val)
+}
+
+// Size returns the size of the object, in bytes, once serialized
+func (val *TaskQueuePartitionCounts) Size() int {
+	return proto.Size(val)
+}
+
+// Equal returns whether two TaskQueuePartitionCounts values are equivalent by recursively
+// comparing the message's fields.
+// For more information see the documentation for
+// https://pkg.go.dev/google.golang.org/protobuf/proto#Equal
+func (this *TaskQueuePartitionCounts) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	var that1 *TaskQueuePartitionCounts
+	switch t := that.(type) {
+	case *TaskQueuePartitionCounts:
+		that1 = t
+	case TaskQueuePartitionCounts:
+		that1 = &t
+	default:
+		return false
+	}
+
+	return proto.Equal(this, that1)
+}
+
 // Marshal an object of type BuildIdRedirectInfo to the protobuf v3 wire format
 func (val *BuildIdRedirectInfo) Marshal() ([]byte, error) {
 	return proto.Marshal(val)
@@ -18,7 +18,9 @@ import (
 	v14 "go.temporal.io/server/api/enums/v1"
 	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
 	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	durationpb "google.golang.org/protobuf/types/known/durationpb"
 	emptypb "google.golang.org/protobuf/types/known/emptypb"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
 )
 
 const (
@@ -202,6 +204,240 @@ func (x *FairLevel) GetTaskId() int64 {
 	return 0
 }
 
+// Identifies a task in the backlog of a physical task queue. task_pass is only set for fairness backlogs.
+type BacklogTaskKey struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Subqueue      int32                  `protobuf:"varint,1,opt,name=subqueue,proto3" json:"subqueue,omitempty"`
+	TaskPass      int64                  `protobuf:"varint,2,opt,name=task_pass,json=taskPass,proto3" json:"task_pass,omitempty"`
+	TaskId        int64                  `protobuf:"varint,3,opt,name=task_id,json=taskId,proto3" json:"task_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *BacklogTaskKey) Reset() {
+	*x = BacklogTaskKey{}
+	mi := &file_temporal_server_api_taskqueue_v1_message_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BacklogTaskKey) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BacklogTaskKey) ProtoMessage() {}
+
+func (x *BacklogTaskKey) ProtoReflect() protoreflect.Message {
+	mi := &file_temporal_server_api_taskqueue_v1_message_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BacklogTaskKey.ProtoReflect.Descriptor instead.
+func (*BacklogTaskKey) Descriptor() ([]byte, []int) {
+	return file_temporal_server_api_taskqueue_v1_message_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *BacklogTaskKey) GetSubqueue() int32 {
+	if x != nil {
+		return x.Subqueue
+	}
+	return 0
+}
+
+func (x *BacklogTaskKey) GetTaskPass() int64 {
+	if x != nil {
+		return x.TaskPass
+	}
+	return 0
+}
+
+func (x *BacklogTaskKey) GetTaskId() int64 {
+	if x != nil {
+		return x.TaskId
+	}
+	return 0
+}
+
+// Summary of a task in the backlog of a physical task queue, for inspection by operators.
+type BacklogTaskInfo struct {
+	state            protoimpl.MessageState `protogen:"open.v1"`
+	Key              *BacklogTaskKey        `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
+	TaskQueueType    v1.TaskQueueType       `protobuf:"varint,2,opt,name=task_queue_type,json=taskQueueType,proto3,enum=temporal.api.enums.v1.TaskQueueType" json:"task_queue_type,omitempty"`
+	WorkflowId       string                 `protobuf:"bytes,3,opt,name=workflow_id,json=workflowId,proto3" json:"workflow_id,omitempty"`
+	RunId            string                 `protobuf:"bytes,4,opt,name=run_id,json=runId,proto3" json:"run_id,omitempty"`
+	ScheduledEventId int64                  `protobuf:"varint,5,opt,name=scheduled_event_id,json=scheduledEventId,proto3" json:"scheduled_event_id,omitempty"`
+	PriorityKey      int32                  `protobuf:"varint,6,opt,name=priority_key,json=priorityKey,proto3" json:"priority_key,omitempty"`
+	FairnessKey      string                 `protobuf:"bytes,7,opt,name=fairness_key,json=fairnessKey,proto3" json:"fairness_key,omitempty"`
+	CreateTime       *timestamppb.Timestamp `protobuf:"bytes,8,opt,name=create_time,json=createTime,proto3" json:"create_time,omitempty"`
+	ExpiryTime       *timestamppb.Timestamp `protobuf:"bytes,9,opt,name=expiry_time,json=expiryTime,proto3" json:"expiry_time,omitempty"`
+	// Time since the task was created.
+	Age           *durationpb.Duration `protobuf:"bytes,10,opt,name=age,proto3" json:"age,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *BacklogTaskInfo) Reset() {
+	*x = BacklogTaskInfo{}
+	mi := &file_temporal_server_api_taskqueue_v1_message_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BacklogTaskInfo) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BacklogTaskInfo) ProtoMessage() {}
+
+func (x *BacklogTaskInfo) ProtoReflect() protoreflect.Message {
+	mi := &file_temporal_server_api_taskqueue_v1_message_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BacklogTaskInfo.ProtoReflect.Descriptor instead.
+func (*BacklogTaskInfo) Descriptor() ([]byte, []int) {
+	return file_temporal_server_api_taskqueue_v1_message_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *BacklogTaskInfo) GetKey() *BacklogTaskKey {
+	if x != nil {
+		return x.Key
+	}
+	return nil
+}
+
+func (x *BacklogTaskInfo) GetTaskQueueType() v1.TaskQueueType {
+	if x != nil {
+		return x.TaskQueueType
+	}
+	return v1.TaskQueueType(0)
+}
+
+func (x *BacklogTaskInfo) GetWorkflowId() string {
+	if x != nil {
+		return x.WorkflowId
+	}
+	return ""
+}
+
+func (x *BacklogTaskInfo) GetRunId() string {
+	if x != nil {
+		return x.RunId
+	}
+	return ""
+}
+
+func (x *BacklogTaskInfo) GetScheduledEventId() int64 {
+	if x != nil {
+		return x.ScheduledEventId
+	}
+	return 0
+}
+
+func (x *BacklogTaskInfo) GetPriorityKey() int32 {
+	if x != nil {
+		return x.PriorityKey
+	}
+	return 0
+}
+
+func (x *BacklogTaskInfo) GetFairnessKey() string {
+	if x != nil {
+		return x.FairnessKey
+	}
+	return ""
+}
+
+func (x *BacklogTaskInfo) GetCreateTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreateTime
+	}
+	return nil
+}
+
+func (x *BacklogTaskInfo) GetExpiryTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ExpiryTime
+	}
+	return nil
+}
+
+func (x *BacklogTaskInfo) GetAge() *durationpb.Duration {
+	if x != nil {
+		return x.Age
+	}
+	return nil
+}
+
+// Page token used when listing or moving backlog tasks. Tasks are returned in subqueue order, and within a
+// subqueue in task level order.
+type BacklogTasksPageToken struct {
+	state    protoimpl.MessageState `protogen:"open.v1"`
+	Subqueue int32                  `protobuf:"varint,1,opt,name=subqueue,proto3" json:"subqueue,omitempty"`
+	// Level of the last task returned from the subqueue.
+	Level         *FairLevel `protobuf:"bytes,2,opt,name=level,proto3" json:"level,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *BacklogTasksPageToken) Reset() {
+	*x = BacklogTasksPageToken{}
+	mi := &file_temporal_server_api_taskqueue_v1_message_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BacklogTasksPageToken) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BacklogTasksPageToken) ProtoMessage() {}
+
+func (x *BacklogTasksPageToken) ProtoReflect() protoreflect.Message {
+	mi := &file_temporal_server_api_taskqueue_v1_message_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BacklogTasksPageToken.ProtoReflect.Descriptor instead.
+func (*BacklogTasksPageToken) Descriptor() ([]byte, []int) {
+	return file_temporal_server_api_taskqueue_v1_message_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *BacklogTasksPageToken) GetSubqueue() int32 {
+	if x != nil {
+		return x.Subqueue
+	}
+	return 0
+}
+
+func (x *BacklogTasksPageToken) GetLevel() *FairLevel {
+	if x != nil {
+		return x.Level
+	}
+	return nil
+}
+
 type InternalTaskQueueStatus struct {
 	state                   protoimpl.MessageState `protogen:"open.v1"`
 	ReadLevel               int64                  `protobuf:"varint,1,opt,name=read_level,json=readLevel,proto3" json:"read_level,omitempty"`
@@ -219,7 +455,7 @@ type InternalTaskQueueStatus struct {
 
 func (x *InternalTaskQueueStatus) Reset() {
 	*x = InternalTaskQueueStatus{}
-	mi := &file_temporal_server_api_taskqueue_v1_message_proto_msgTypes[2]
+	mi := &file_temporal_server_api_taskqueue_v1_message_proto_msgTypes[5]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -231,7 +467,7 @@ func (x *InternalTaskQueueStatus) String() string {
 func (*InternalTaskQueueStatus) ProtoMessage() {}
 
 func (x *InternalTaskQueueStatus) ProtoReflect() protoreflect.Message {
-	mi := &file_temporal_server_api_taskqueue_v1_message_proto_msgTypes[2]
+	mi := &file_temporal_server_api_taskqueue_v1_message_proto_msgTypes[5]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -244,7 +480,7 @@ func (x *InternalTaskQueueStatus) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use InternalTaskQueueStatus.ProtoReflect.Descriptor instead.
 func (*InternalTaskQueueStatus) Descriptor() ([]byte, []int) {
-	return file_temporal_server_api_taskqueue_v1_message_proto_rawDescGZIP(), []int{2}
+	return file_temporal_server_api_taskqueue_v1_message_proto_rawDescGZIP(), []int{5}
 }
 
 func (x *InternalTaskQueueStatus) GetReadLevel() int64 {
@@ -319,7 +555,7 @@ type TaskQueueVersionInfoInternal struct {
 
 func (x *TaskQueueVersionInfoInternal) Reset() {
 	*x = TaskQueueVersionInfoInternal{}
-	mi := &file_temporal_server_api_taskqueue_v1_message_proto_msgTypes[3]
+	mi := &file_temporal_server_api_taskqueue_v1_message_proto_msgTypes[6]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -331,7 +567,7 @@ func (x *TaskQueueVersionInfoInternal) String() string {
 func (*TaskQueueVersionInfoInternal) ProtoMessage() {}
 
 func (x *TaskQueueVersionInfoInternal) ProtoReflect() protoreflect.Message {
-	mi := &file_temporal_server_api_taskqueue_v1_message_proto_msgTypes[3]
+	mi := &file_temporal_server_api_taskqueue_v1_message_proto_msgTypes[6]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -344,7 +580,7 @@ func (x *TaskQueueVersionInfoInternal) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use TaskQueueVersionInfoInternal.ProtoReflect.Descriptor instead.
 func (*TaskQueueVersionInfoInternal) Descriptor() ([]byte, []int) {
-	return file_temporal_server_api_taskqueue_v1_message_proto_rawDescGZIP(), []int{3}
+	return file_temporal_server_api_taskqueue_v1_message_proto_rawDescGZIP(), []int{6}
 }
 
 func (x *TaskQueueVersionInfoInternal) GetPhysicalTaskQueueInfo() *PhysicalTaskQueueInfo {
@@ -370,7 +606,7 @@ type PhysicalTaskQueueInfo struct {
 
 func (x *PhysicalTaskQueueInfo) Reset() {
 	*x = PhysicalTaskQueueInfo{}
-	mi := &file_temporal_server_api_taskqueue_v1_message_proto_msgTypes[4]
+	mi := &file_temporal_server_api_taskqueue_v1_message_proto_msgTypes[7]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -382,7 +618,7 @@ func (x *PhysicalTaskQueueInfo) String() string {
 func (*PhysicalTaskQueueInfo) ProtoMessage() {}
 
 func (x *PhysicalTaskQueueInfo) ProtoReflect() protoreflect.Message {
-	mi := &file_temporal_server_api_taskqueue_v1_message_proto_msgTypes[4]
+	mi := &file_temporal_server_api_taskqueue_v1_message_proto_msgTypes[7]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -395,7 +631,7 @@ func (x *PhysicalTaskQueueInfo) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use PhysicalTaskQueueInfo.ProtoReflect.Descriptor instead.
 func (*PhysicalTaskQueueInfo) Descriptor() ([]byte, []int) {
-	return file_temporal_server_api_taskqueue_v1_message_proto_rawDescGZIP(), []int{4}
+	return file_temporal_server_api_taskqueue_v1_message_proto_rawDescGZIP(), []int{7}
 }
 
 func (x *PhysicalTaskQueueInfo) GetPollers() []*v13.PollerInfo {
@@ -445,7 +681,7 @@ type TaskQueuePartition struct {
 
 func (x *TaskQueuePartition) Reset() {
 	*x = TaskQueuePartition{}
-	mi := &file_temporal_server_api_taskqueue_v1_message_proto_msgTypes[5]
+	mi := &file_temporal_server_api_taskqueue_v1_message_proto_msgTypes[8]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -457,7 +693,7 @@ func (x *TaskQueuePartition) String() string {
 func (*TaskQueuePartition) ProtoMessage() {}
 
 func (x *TaskQueuePartition) ProtoReflect() protoreflect.Message {
-	mi := &file_temporal_server_api_taskqueue_v1_message_proto_msgTypes[5]
+	mi := &file_temporal_server_api_taskqueue_v1_message_proto_msgTypes[8]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -470,7 +706,7 @@ func (x *TaskQueuePartition) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use TaskQueuePartition.ProtoReflect.Descriptor instead.
 func (*TaskQueuePartition) Descriptor() ([]byte, []int) {
-	return file_temporal_server_api_taskqueue_v1_message_proto_rawDescGZIP(), []int{5}
+	return file_temporal_server_api_taskqueue_v1_message_proto_rawDescGZIP(), []int{8}
 }
 
 func (x *TaskQueuePartition) GetTaskQueue() string {
@@ -528,6 +764,60 @@ func (*TaskQueuePartition_NormalPartitionId) isTaskQueuePartition_PartitionId()
 
 func (*TaskQueuePartition_StickyName) isTaskQueuePartition_PartitionId() {}
 
+// Partition counts of a task queue that are chosen by partition auto-scaling. Matching returns them to its clients so
+// that they only load balance over the partitions in use.
+type TaskQueuePartitionCounts struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	ReadPartitions  int32                  `protobuf:"varint,1,opt,name=read_partitions,json=readPartitions,proto3" json:"read_partitions,omitempty"`
+	WritePartitions int32                  `protobuf:"varint,2,opt,name=write_partitions,json=writePartitions,proto3" json:"write_partitions,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *TaskQueuePartitionCounts) Reset() {
+	*x = TaskQueuePartitionCounts{}
+	mi := &file_temporal_server_api_taskqueue_v1_message_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TaskQueuePartitionCounts) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TaskQueuePartitionCounts) ProtoMessage() {}
+
+func (x *TaskQueuePartitionCounts) ProtoReflect() protoreflect.Message {
+	mi := &file_temporal_server_api_taskqueue_v1_message_proto_msgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TaskQueuePartitionCounts.ProtoReflect.Descriptor instead.
+func (*TaskQueuePartitionCounts) Descriptor() ([]byte, []int) {
+	return file_temporal_server_api_taskqueue_v1_message_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *TaskQueuePartitionCounts) GetReadPartitions() int32 {
+	if x != nil {
+		return x.ReadPartitions
+	}
+	return 0
+}
+
+func (x *TaskQueuePartitionCounts) GetWritePartitions() int32 {
+	if x != nil {
+		return x.WritePartitions
+	}
+	return 0
+}
+
 // Information about redirect intention sent by Matching to History in Record*TaskStarted calls.
 // Deprecated.
 type BuildIdRedirectInfo struct {
@@ -542,7 +832,7 @@ type BuildIdRedirectInfo struct {
 
 func (x *BuildIdRedirectInfo) Reset() {
 	*x = BuildIdRedirectInfo{}
-	mi := &file_temporal_server_api_taskqueue_v1_message_proto_msgTypes[6]
+	mi := &file_temporal_server_api_taskqueue_v1_message_proto_msgTypes[10]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -554,7 +844,7 @@ func (x *BuildIdRedirectInfo) String() string {
 func (*BuildIdRedirectInfo) ProtoMessage() {}
 
 func (x *BuildIdRedirectInfo) ProtoReflect() protoreflect.Message {
-	mi := &file_temporal_server_api_taskqueue_v1_message_proto_msgTypes[6]
+	mi := &file_temporal_server_api_taskqueue_v1_message_proto_msgTypes[10]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -567,7 +857,7 @@ func (x *BuildIdRedirectInfo) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use BuildIdRedirectInfo.ProtoReflect.Descriptor instead.
 func (*BuildIdRedirectInfo) Descriptor() ([]byte, []int) {
-	return file_temporal_server_api_taskqueue_v1_message_proto_rawDescGZIP(), []int{6}
+	return file_temporal_server_api_taskqueue_v1_message_proto_rawDescGZIP(), []int{10}
 }
 
 func (x *BuildIdRedirectInfo) GetAssignedBuildId() string {
@@ -596,13 +886,17 @@ type TaskForwardInfo struct {
 	// Only used for old versioning. [cleanup-old-wv]
 	// Deprecated. [cleanup-old-wv]
 	DispatchVersionSet string `protobuf:"bytes,5,opt,name=dispatch_version_set,json=dispatchVersionSet,proto3" json:"dispatch_version_set,omitempty"`
+	// Set if the task was redirected by a partition that partition auto-scaling removed from the write partitions,
+	// instead of being forwarded for sync match. A redirected task is added as if it came from the source partition,
+	// but the matching client does not load balance it again.
+	ScaledDownRedirect bool `protobuf:"varint,6,opt,name=scaled_down_redirect,json=scaledDownRedirect,proto3" json:"scaled_down_redirect,omitempty"`
 	unknownFields      protoimpl.UnknownFields
 	sizeCache          protoimpl.SizeCache
 }
 
 func (x *TaskForwardInfo) Reset() {
 	*x = TaskForwardInfo{}
-	mi := &file_temporal_server_api_taskqueue_v1_message_proto_msgTypes[7]
+	mi := &file_temporal_server_api_taskqueue_v1_message_proto_msgTypes[11]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -614,7 +908,7 @@ func (x *TaskForwardInfo) String() string {
 func (*TaskForwardInfo) ProtoMessage() {}
 
 func (x *TaskForwardInfo) ProtoReflect() protoreflect.Message {
-	mi := &file_temporal_server_api_taskqueue_v1_message_proto_msgTypes[7]
+	mi := &file_temporal_server_api_taskqueue_v1_message_proto_msgTypes[11]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -627,7 +921,7 @@ func (x *TaskForwardInfo) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use TaskForwardInfo.ProtoReflect.Descriptor instead.
 func (*TaskForwardInfo) Descriptor() ([]byte, []int) {
-	return file_temporal_server_api_taskqueue_v1_message_proto_rawDescGZIP(), []int{7}
+	return file_temporal_server_api_taskqueue_v1_message_proto_rawDescGZIP(), []int{11}
 }
 
 func (x *TaskForwardInfo) GetSourcePartition() string {
@@ -665,11 +959,18 @@ func (x *TaskForwardInfo) GetDispatchVersionSet() string {
 	return ""
 }
 
+func (x *TaskForwardInfo) GetScaledDownRedirect() bool {
+	if x != nil {
+		return x.ScaledDownRedirect
+	}
+	return false
+}
+
 var File_temporal_server_api_taskqueue_v1_message_proto protoreflect.FileDescriptor
 
 const file_temporal_server_api_taskqueue_v1_message_proto_rawDesc = "" +
 	"\n" +
-	".temporal/server/api/taskqueue/v1/message.proto\x12 temporal.server.api.taskqueue.v1\x1a\x1bgoogle/protobuf/empty.proto\x1a(temporal/api/deployment/v1/message.proto\x1a&temporal/api/enums/v1/task_queue.proto\x1a$temporal/api/enums/v1/workflow.proto\x1a'temporal/api/taskqueue/v1/message.proto\x1a'temporal/server/api/enums/v1/task.proto\x1a/temporal/server/api/deployment/v1/message.proto\"\x96\x03\n" +
+	".temporal/server/api/taskqueue/v1/message.proto\x12 temporal.server.api.taskqueue.v1\x1a\x1egoogle/protobuf/duration.proto\x1a\x1bgoogle/protobuf/empty.proto\x1a\x1fgoogle/protobuf/timestamp.proto\x1a(temporal/api/deployment/v1/message.proto\x1a&temporal/api/enums/v1/task_queue.proto\x1a$temporal/api/enums/v1/workflow.proto\x1a'temporal/api/taskqueue/v1/message.proto\x1a'temporal/server/api/enums/v1/task.proto\x1a/temporal/server/api/deployment/v1/message.proto\"\x96\x03\n" +
 	"\x14TaskVersionDirective\x12J\n" +
 	"\x14use_assignment_rules\x18\x01 \x01(\v2\x16.google.protobuf.EmptyH\x00R\x12useAssignmentRules\x12,\n" +
 	"\x11assigned_build_id\x18\x02 \x01(\tH\x00R\x0fassignedBuildId\x12E\n" +
@@ -682,7 +983,29 @@ const file_temporal_server_api_taskqueue_v1_message_proto_rawDesc = "" +
 	"\bbuild_id\"A\n" +
 	"\tFairLevel\x12\x1b\n" +
 	"\ttask_pass\x18\x01 \x01(\x03R\btaskPass\x12\x17\n" +
-	"\atask_id\x18\x02 \x01(\x03R\x06taskId\"\xaa\x04\n" +
+	"\atask_id\x18\x02 \x01(\x03R\x06taskId\"b\n" +
+	"\x0eBacklogTaskKey\x12\x1a\n" +
+	"\bsubqueue\x18\x01 \x01(\x05R\bsubqueue\x12\x1b\n" +
+	"\ttask_pass\x18\x02 \x01(\x03R\btaskPass\x12\x17\n" +
+	"\atask_id\x18\x03 \x01(\x03R\x06taskId\"\xf6\x03\n" +
+	"\x0fBacklogTaskInfo\x12B\n" +
+	"\x03key\x18\x01 \x01(\v20.temporal.server.api.taskqueue.v1.BacklogTaskKeyR\x03key\x12L\n" +
+	"\x0ftask_queue_type\x18\x02 \x01(\x0e2$.temporal.api.enums.v1.TaskQueueTypeR\rtaskQueueType\x12\x1f\n" +
+	"\vworkflow_id\x18\x03 \x01(\tR\n" +
+	"workflowId\x12\x15\n" +
+	"\x06run_id\x18\x04 \x01(\tR\x05runId\x12,\n" +
+	"\x12scheduled_event_id\x18\x05 \x01(\x03R\x10scheduledEventId\x12!\n" +
+	"\fpriority_key\x18\x06 \x01(\x05R\vpriorityKey\x12!\n" +
+	"\ffairness_key\x18\a \x01(\tR\vfairnessKey\x12;\n" +
+	"\vcreate_time\x18\b \x01(\v2\x1a.google.protobuf.TimestampR\n" +
+	"createTime\x12;\n" +
+	"\vexpiry_time\x18\t \x01(\v2\x1a.google.protobuf.TimestampR\n" +
+	"expiryTime\x12+\n" +
+	"\x03age\x18\n" +
+	" \x01(\v2\x19.google.protobuf.DurationR\x03age\"v\n" +
+	"\x15BacklogTasksPageToken\x12\x1a\n" +
+	"\bsubqueue\x18\x01 \x01(\x05R\bsubqueue\x12A\n" +
+	"\x05level\x18\x02 \x01(\v2+.temporal.server.api.taskqueue.v1.FairLevelR\x05level\"\xaa\x04\n" +
 	"\x17InternalTaskQueueStatus\x12\x1d\n" +
 	"\n" +
 	"read_level\x18\x01 \x01(\x03R\treadLevel\x12S\n" +
@@ -711,16 +1034,20 @@ const file_temporal_server_api_taskqueue_v1_message_proto_rawDesc = "" +
 	"\x13normal_partition_id\x18\x03 \x01(\x05H\x00R\x11normalPartitionId\x12!\n" +
 	"\vsticky_name\x18\x04 \x01(\tH\x00R\n" +
 	"stickyNameB\x0e\n" +
-	"\fpartition_id\"A\n" +
+	"\fpartition_id\"n\n" +
+	"\x18TaskQueuePartitionCounts\x12'\n" +
+	"\x0fread_partitions\x18\x01 \x01(\x05R\x0ereadPartitions\x12)\n" +
+	"\x10write_partitions\x18\x02 \x01(\x05R\x0fwritePartitions\"A\n" +
 	"\x13BuildIdRedirectInfo\x12*\n" +
-	"\x11assigned_build_id\x18\x01 \x01(\tR\x0fassignedBuildId\"\xc1\x02\n" +
+	"\x11assigned_build_id\x18\x01 \x01(\tR\x0fassignedBuildId\"\xf3\x02\n" +
 	"\x0fTaskForwardInfo\x12)\n" +
 	"\x10source_partition\x18\x01 \x01(\tR\x0fsourcePartition\x12I\n" +
 	"\vtask_source\x18\x02 \x01(\x0e2(.temporal.server.api.enums.v1.TaskSourceR\n" +
 	"taskSource\x12Z\n" +
 	"\rredirect_info\x18\x03 \x01(\v25.temporal.server.api.taskqueue.v1.BuildIdRedirectInfoR\fredirectInfo\x12*\n" +
 	"\x11dispatch_build_id\x18\x04 \x01(\tR\x0fdispatchBuildId\x120\n" +
-	"\x14dispatch_version_set\x18\x05 \x01(\tR\x12dispatchVersionSetB2Z0go.temporal.io/server/api/taskqueue/v1;taskqueueb\x06proto3"
+	"\x14dispatch_version_set\x18\x05 \x01(\tR\x12dispatchVersionSet\x120\n" +
+	"\x14scaled_down_redirect\x18\x06 \x01(\bR\x12scaledDownRedirectB2Z0go.temporal.io/server/api/taskqueue/v1;taskqueueb\x06proto3"
 
 var (
 	file_temporal_server_api_taskqueue_v1_message_proto_rawDescOnce sync.Once
@@ -734,50 +1061,62 @@ func file_temporal_server_api_taskqueue_v1_message_proto_rawDescGZIP() []byte {
 	return file_temporal_server_api_taskqueue_v1_message_proto_rawDescData
 }
 
-var file_temporal_server_api_taskqueue_v1_message_proto_msgTypes = make([]protoimpl.MessageInfo, 9)
+var file_temporal_server_api_taskqueue_v1_message_proto_msgTypes = make([]protoimpl.MessageInfo, 13)
 var file_temporal_server_api_taskqueue_v1_message_proto_goTypes = []any{
 	(*TaskVersionDirective)(nil),         // 0: temporal.server.api.taskqueue.v1.TaskVersionDirective
 	(*FairLevel)(nil),                    // 1: temporal.server.api.taskqueue.v1.FairLevel
-	(*InternalTaskQueueStatus)(nil),      // 2: temporal.server.api.taskqueue.v1.InternalTaskQueueStatus
-	(*TaskQueueVersionInfoInternal)(nil), // 3: temporal.server.api.taskqueue.v1.TaskQueueVersionInfoInternal
-	(*PhysicalTaskQueueInfo)(nil),        // 4: temporal.server.api.taskqueue.v1.PhysicalTaskQueueInfo
-	(*TaskQueuePartition)(nil),           // 5: temporal.server.api.taskqueue.v1.TaskQueuePartition
-	(*BuildIdRedirectInfo)(nil),          // 6: temporal.server.api.taskqueue.v1.BuildIdRedirectInfo
-	(*TaskForwardInfo)(nil),              // 7: temporal.server.api.taskqueue.v1.TaskForwardInfo
-	nil,                                  // 8: temporal.server.api.taskqueue.v1.PhysicalTaskQueueInfo.TaskQueueStatsByPriorityKeyEntry
-	(*emptypb.Empty)(nil),                // 9: google.protobuf.Empty
-	(v1.VersioningBehavior)(0),           // 10: temporal.api.enums.v1.VersioningBehavior
-	(*v11.Deployment)(nil),               // 11: temporal.api.deployment.v1.Deployment
-	(*v12.WorkerDeploymentVersion)(nil),  // 12: temporal.server.api.deployment.v1.WorkerDeploymentVersion
-	(*v13.TaskIdBlock)(nil),              // 13: temporal.api.taskqueue.v1.TaskIdBlock
-	(*v13.PollerInfo)(nil),               // 14: temporal.api.taskqueue.v1.PollerInfo
-	(*v13.TaskQueueStats)(nil),           // 15: temporal.api.taskqueue.v1.TaskQueueStats
-	(v1.TaskQueueType)(0),                // 16: temporal.api.enums.v1.TaskQueueType
-	(v14.TaskSource)(0),                  // 17: temporal.server.api.enums.v1.TaskSource
+	(*BacklogTaskKey)(nil),               // 2: temporal.server.api.taskqueue.v1.BacklogTaskKey
+	(*BacklogTaskInfo)(nil),              // 3: temporal.server.api.taskqueue.v1.BacklogTaskInfo
+	(*BacklogTasksPageToken)(nil),        // 4: temporal.server.api.taskqueue.v1.BacklogTasksPageToken
+	(*InternalTaskQueueStatus)(nil),      // 5: temporal.server.api.taskqueue.v1.InternalTaskQueueStatus
+	(*TaskQueueVersionInfoInternal)(nil), // 6: temporal.server.api.taskqueue.v1.TaskQueueVersionInfoInternal
+	(*PhysicalTaskQueueInfo)(nil),        // 7: temporal.server.api.taskqueue.v1.PhysicalTaskQueueInfo
+	(*TaskQueuePartition)(nil),           // 8: temporal.server.api.taskqueue.v1.TaskQueuePartition
+	(*TaskQueuePartitionCounts)(nil),     // 9: temporal.server.api.taskqueue.v1.TaskQueuePartitionCounts
+	(*BuildIdRedirectInfo)(nil),          // 10: temporal.server.api.taskqueue.v1.BuildIdRedirectInfo
+	(*TaskForwardInfo)(nil),              // 11: temporal.server.api.taskqueue.v1.TaskForwardInfo
+	nil,                                  // 12: temporal.server.api.taskqueue.v1.PhysicalTaskQueueInfo.TaskQueueStatsByPriorityKeyEntry
+	(*emptypb.Empty)(nil),                // 13: google.protobuf.Empty
+	(v1.VersioningBehavior)(0),           // 14: temporal.api.enums.v1.VersioningBehavior
+	(*v11.Deployment)(nil),               // 15: temporal.api.deployment.v1.Deployment
+	(*v12.WorkerDeploymentVersion)(nil),  // 16: temporal.server.api.deployment.v1.WorkerDeploymentVersion
+	(v1.TaskQueueType)(0),                // 17: temporal.api.enums.v1.TaskQueueType
+	(*timestamppb.Timestamp)(nil),        // 18: google.protobuf.Timestamp
+	(*durationpb.Duration)(nil),          // 19: google.protobuf.Duration
+	(*v13.TaskIdBlock)(nil),              // 20: temporal.api.taskqueue.v1.TaskIdBlock
+	(*v13.PollerInfo)(nil),               // 21: temporal.api.taskqueue.v1.PollerInfo
+	(*v13.TaskQueueStats)(nil),           // 22: temporal.api.taskqueue.v1.TaskQueueStats
+	(v14.TaskSource)(0),                  // 23: temporal.server.api.enums.v1.TaskSource
 }
 var file_temporal_server_api_taskqueue_v1_message_proto_depIdxs = []int32{
-	9,  // 0: temporal.server.api.taskqueue.v1.TaskVersionDirective.use_assignment_rules:type_name -> google.protobuf.Empty
-	10, // 1: temporal.server.api.taskqueue.v1.TaskVersionDirective.behavior:type_name -> temporal.api.enums.v1.VersioningBehavior
-	11, // 2: temporal.server.api.taskqueue.v1.TaskVersionDirective.deployment:type_name -> temporal.api.deployment.v1.Deployment
-	12, // 3: temporal.server.api.taskqueue.v1.TaskVersionDirective.deployment_version:type_name -> temporal.server.api.deployment.v1.WorkerDeploymentVersion
-	1,  // 4: temporal.server.api.taskqueue.v1.InternalTaskQueueStatus.fair_read_level:type_name -> temporal.server.api.taskqueue.v1.FairLevel
-	1,  // 5: temporal.server.api.taskqueue.v1.InternalTaskQueueStatus.fair_ack_level:type_name -> temporal.server.api.taskqueue.v1.FairLevel
-	13, // 6: temporal.server.api.taskqueue.v1.InternalTaskQueueStatus.task_id_block:type_name -> temporal.api.taskqueue.v1.TaskIdBlock
-	1,  // 7: temporal.server.api.taskqueue.v1.InternalTaskQueueStatus.fair_max_read_level:type_name -> temporal.server.api.taskqueue.v1.FairLevel
-	4,  // 8: temporal.server.api.taskqueue.v1.TaskQueueVersionInfoInternal.physical_task_queue_info:type_name -> temporal.server.api.taskqueue.v1.PhysicalTaskQueueInfo
-	14, // 9: temporal.server.api.taskqueue.v1.PhysicalTaskQueueInfo.pollers:type_name -> temporal.api.taskqueue.v1.PollerInfo
-	2,  // 10: temporal.server.api.taskqueue.v1.PhysicalTaskQueueInfo.internal_task_queue_status:type_name -> temporal.server.api.taskqueue.v1.InternalTaskQueueStatus
-	15, // 11: temporal.server.api.taskqueue.v1.PhysicalTaskQueueInfo.task_queue_stats:type_name -> temporal.api.taskqueue.v1.TaskQueueStats
-	8,  // 12: temporal.server.api.taskqueue.v1.PhysicalTaskQueueInfo.task_queue_stats_by_priority_key:type_name -> temporal.server.api.taskqueue.v1.PhysicalTaskQueueInfo.TaskQueueStatsByPriorityKeyEntry
-	16, // 13: temporal.server.api.taskqueue.v1.TaskQueuePartition.task_queue_type:type_name -> temporal.api.enums.v1.TaskQueueType
-	17, // 14: temporal.server.api.taskqueue.v1.TaskForwardInfo.task_source:type_name -> temporal.server.api.enums.v1.TaskSource
-	6,  // 15: temporal.server.api.taskqueue.v1.TaskForwardInfo.redirect_info:type_name -> temporal.server.api.taskqueue.v1.BuildIdRedirectInfo
-	15, // 16: temporal.server.api.taskqueue.v1.PhysicalTaskQueueInfo.TaskQueueStatsByPriorityKeyEntry.value:type_name -> temporal.api.taskqueue.v1.TaskQueueStats
-	17, // [17:17] is the sub-list for method output_type
-	17, // [17:17] is the sub-list for method input_type
-	17, // [17:17] is the sub-list for extension type_name
-	17, // [17:17] is the sub-list for extension extendee
-	0,  // [0:17] is the sub-list for field type_name
+	13, // 0: temporal.server.api.taskqueue.v1.TaskVersionDirective.use_assignment_rules:type_name -> google.protobuf.Empty
+	14, // 1: temporal.server.api.taskqueue.v1.TaskVersionDirective.behavior:type_name -> temporal.api.enums.v1.VersioningBehavior
+	15, // 2: temporal.server.api.taskqueue.v1.TaskVersionDirective.deployment:type_name -> temporal.api.deployment.v1.Deployment
+	16, // 3: temporal.server.api.taskqueue.v1.TaskVersionDirective.deployment_version:type_name -> temporal.server.api.deployment.v1.WorkerDeploymentVersion
+	2,  // 4: temporal.server.api.taskqueue.v1.BacklogTaskInfo.key:type_name -> temporal.server.api.taskqueue.v1.BacklogTaskKey
+	17, // 5: temporal.server.api.taskqueue.v1.BacklogTaskInfo.task_queue_type:type_name -> temporal.api.enums.v1.TaskQueueType
+	18, // 6: temporal.server.api.taskqueue.v1.BacklogTaskInfo.create_time:type_name -> google.protobuf.Timestamp
+	18, // 7: temporal.server.api.taskqueue.v1.BacklogTaskInfo.expiry_time:type_name -> google.protobuf.Timestamp
+	19, // 8: temporal.server.api.taskqueue.v1.BacklogTaskInfo.age:type_name -> google.protobuf.Duration
+	1,  // 9: temporal.server.api.taskqueue.v1.BacklogTasksPageToken.level:type_name -> temporal.server.api.taskqueue.v1.FairLevel
+	1,  // 10: temporal.server.api.taskqueue.v1.InternalTaskQueueStatus.fair_read_level:type_name -> temporal.server.api.taskqueue.v1.FairLevel
+	1,  // 11: temporal.server.api.taskqueue.v1.InternalTaskQueueStatus.fair_ack_level:type_name -> temporal.server.api.taskqueue.v1.FairLevel
+	20, // 12: temporal.server.api.taskqueue.v1.InternalTaskQueueStatus.task_id_block:type_name -> temporal.api.taskqueue.v1.TaskIdBlock
+	1,  // 13: temporal.server.api.taskqueue.v1.InternalTaskQueueStatus.fair_max_read_level:type_name -> temporal.server.api.taskqueue.v1.FairLevel
+	7,  // 14: temporal.server.api.taskqueue.v1.TaskQueueVersionInfoInternal.physical_task_queue_info:type_name -> temporal.server.api.taskqueue.v1.PhysicalTaskQueueInfo
+	21, // 15: temporal.server.api.taskqueue.v1.PhysicalTaskQueueInfo.pollers:type_name -> temporal.api.taskqueue.v1.PollerInfo
+	5,  // 16: temporal.server.api.taskqueue.v1.PhysicalTaskQueueInfo.internal_task_queue_status:type_name -> temporal.server.api.taskqueue.v1.InternalTaskQueueStatus
+	22, // 17: temporal.server.api.taskqueue.v1.PhysicalTaskQueueInfo.task_queue_stats:type_name -> temporal.api.taskqueue.v1.TaskQueueStats
+	12, // 18: temporal.server.api.taskqueue.v1.PhysicalTaskQueueInfo.task_queue_stats_by_priority_key:type_name -> temporal.server.api.taskqueue.v1.PhysicalTaskQueueInfo.TaskQueueStatsByPriorityKeyEntry
+	17, // 19: temporal.server.api.taskqueue.v1.TaskQueuePartition.task_queue_type:type_name -> temporal.api.enums.v1.TaskQueueType
+	23, // 20: temporal.server.api.taskqueue.v1.TaskForwardInfo.task_source:type_name -> temporal.server.api.enums.v1.TaskSource
+	10, // 21: temporal.server.api.taskqueue.v1.TaskForwardInfo.redirect_info:type_name -> temporal.server.api.taskqueue.v1.BuildIdRedirectInfo
+	22, // 22: temporal.server.api.taskqueue.v1.PhysicalTaskQueueInfo.TaskQueueStatsByPriorityKeyEntry.value:type_name -> temporal.api.taskqueue.v1.TaskQueueStats
+	23, // [23:23] is the sub-list for method output_type
+	23, // [23:23] is the sub-list for method input_type
+	23, // [23:23] is the sub-list for extension type_name
+	23, // [23:23] is the sub-list for extension extendee
+	0,  // [0:23] is the sub-list for field type_name
 }
 
 func init() { file_temporal_server_api_taskqueue_v1_message_proto_init() }
@@ -789,7 +1128,7 @@ func file_temporal_server_api_taskqueue_v1_message_proto_init() {
 		(*TaskVersionDirective_UseAssignmentRules)(nil),
 		(*TaskVersionDirective_AssignedBuildId)(nil),
 	}
-	file_temporal_server_api_taskqueue_v1_message_proto_msgTypes[5].OneofWrappers = []any{
+	file_temporal_server_api_taskqueue_v1_message_proto_msgTypes[8].OneofWrappers = []any{
 		(*TaskQueuePartition_NormalPartitionId)(nil),
 		(*TaskQueuePartition_StickyName)(nil),
 	}
@@ -799,7 +1138,7 @@ func file_temporal_server_api_taskqueue_v1_message_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_temporal_server_api_taskqueue_v1_message_proto_rawDesc), len(file_temporal_server_api_taskqueue_v1_message_proto_rawDesc)),
 			NumEnums:      0,
-			NumMessages:   9,
+			NumMessages:   13,
 			NumExtensions: 0,
 			NumServices:   0,
 		},
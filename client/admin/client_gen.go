@@ -39,6 +39,16 @@ func (c *clientImpl) AddTasks(
 	return c.client.AddTasks(ctx, request, opts...)
 }
 
+func (c *clientImpl) AggregateWorkflowExecutions(
+	ctx context.Context,
+	request *adminservice.AggregateWorkflowExecutionsRequest,
+	opts ...grpc.CallOption,
+) (*adminservice.AggregateWorkflowExecutionsResponse, error) {
+	ctx, cancel := c.createContext(ctx)
+	defer cancel()
+	return c.client.AggregateWorkflowExecutions(ctx, request, opts...)
+}
+
 func (c *clientImpl) CancelDLQJob(
 	ctx context.Context,
 	request *adminservice.CancelDLQJobRequest,
@@ -49,6 +59,16 @@ func (c *clientImpl) CancelDLQJob(
 	return c.client.CancelDLQJob(ctx, request, opts...)
 }
 
+func (c *clientImpl) CheckMutableState(
+	ctx context.Context,
+	request *adminservice.CheckMutableStateRequest,
+	opts ...grpc.CallOption,
+) (*adminservice.CheckMutableStateResponse, error) {
+	ctx, cancel := c.createContext(ctx)
+	defer cancel()
+	return c.client.CheckMutableState(ctx, request, opts...)
+}
+
 func (c *clientImpl) CloseShard(
 	ctx context.Context,
 	request *adminservice.CloseShardRequest,
@@ -69,6 +89,26 @@ func (c *clientImpl) DeepHealthCheck(
 	return c.client.DeepHealthCheck(ctx, request, opts...)
 }
 
+func (c *clientImpl) DeleteSavedVisibilityQuery(
+	ctx context.Context,
+	request *adminservice.DeleteSavedVisibilityQueryRequest,
+	opts ...grpc.CallOption,
+) (*adminservice.DeleteSavedVisibilityQueryResponse, error) {
+	ctx, cancel := c.createContext(ctx)
+	defer cancel()
+	return c.client.DeleteSavedVisibilityQuery(ctx, request, opts...)
+}
+
+func (c *clientImpl) DeleteTaskQueueBacklogTasks(
+	ctx context.Context,
+	request *adminservice.DeleteTaskQueueBacklogTasksRequest,
+	opts ...grpc.CallOption,
+) (*adminservice.DeleteTaskQueueBacklogTasksResponse, error) {
+	ctx, cancel := c.createContext(ctx)
+	defer cancel()
+	return c.client.DeleteTaskQueueBacklogTasks(ctx, request, opts...)
+}
+
 func (c *clientImpl) DeleteWorkflowExecution(
 	ctx context.Context,
 	request *adminservice.DeleteWorkflowExecutionRequest,
@@ -139,6 +179,16 @@ func (c *clientImpl) ForceUnloadTaskQueuePartition(
 	return c.client.ForceUnloadTaskQueuePartition(ctx, request, opts...)
 }
 
+func (c *clientImpl) ForkWorkflowExecution(
+	ctx context.Context,
+	request *adminservice.ForkWorkflowExecutionRequest,
+	opts ...grpc.CallOption,
+) (*adminservice.ForkWorkflowExecutionResponse, error) {
+	ctx, cancel := c.createContext(ctx)
+	defer cancel()
+	return c.client.ForkWorkflowExecution(ctx, request, opts...)
+}
+
 func (c *clientImpl) GenerateLastHistoryReplicationTasks(
 	ctx context.Context,
 	request *adminservice.GenerateLastHistoryReplicationTasksRequest,
@@ -259,6 +309,26 @@ func (c *clientImpl) GetWorkflowExecutionRawHistoryV2(
 	return c.client.GetWorkflowExecutionRawHistoryV2(ctx, request, opts...)
 }
 
+func (c *clientImpl) GetWorkflowExecutionTimeline(
+	ctx context.Context,
+	request *adminservice.GetWorkflowExecutionTimelineRequest,
+	opts ...grpc.CallOption,
+) (*adminservice.GetWorkflowExecutionTimelineResponse, error) {
+	ctx, cancel := c.createContext(ctx)
+	defer cancel()
+	return c.client.GetWorkflowExecutionTimeline(ctx, request, opts...)
+}
+
+func (c *clientImpl) GetWorkflowHistoryBudget(
+	ctx context.Context,
+	request *adminservice.GetWorkflowHistoryBudgetRequest,
+	opts ...grpc.CallOption,
+) (*adminservice.GetWorkflowHistoryBudgetResponse, error) {
+	ctx, cancel := c.createContext(ctx)
+	defer cancel()
+	return c.client.GetWorkflowHistoryBudget(ctx, request, opts...)
+}
+
 func (c *clientImpl) ImportWorkflowExecution(
 	ctx context.Context,
 	request *adminservice.ImportWorkflowExecutionRequest,
@@ -309,6 +379,26 @@ func (c *clientImpl) ListQueues(
 	return c.client.ListQueues(ctx, request, opts...)
 }
 
+func (c *clientImpl) ListSavedVisibilityQueries(
+	ctx context.Context,
+	request *adminservice.ListSavedVisibilityQueriesRequest,
+	opts ...grpc.CallOption,
+) (*adminservice.ListSavedVisibilityQueriesResponse, error) {
+	ctx, cancel := c.createContext(ctx)
+	defer cancel()
+	return c.client.ListSavedVisibilityQueries(ctx, request, opts...)
+}
+
+func (c *clientImpl) ListTaskQueueBacklogTasks(
+	ctx context.Context,
+	request *adminservice.ListTaskQueueBacklogTasksRequest,
+	opts ...grpc.CallOption,
+) (*adminservice.ListTaskQueueBacklogTasksResponse, error) {
+	ctx, cancel := c.createContext(ctx)
+	defer cancel()
+	return c.client.ListTaskQueueBacklogTasks(ctx, request, opts...)
+}
+
 func (c *clientImpl) MergeDLQMessages(
 	ctx context.Context,
 	request *adminservice.MergeDLQMessagesRequest,
@@ -329,6 +419,36 @@ func (c *clientImpl) MergeDLQTasks(
 	return c.client.MergeDLQTasks(ctx, request, opts...)
 }
 
+func (c *clientImpl) MoveHistoryShard(
+	ctx context.Context,
+	request *adminservice.MoveHistoryShardRequest,
+	opts ...grpc.CallOption,
+) (*adminservice.MoveHistoryShardResponse, error) {
+	ctx, cancel := c.createContext(ctx)
+	defer cancel()
+	return c.client.MoveHistoryShard(ctx, request, opts...)
+}
+
+func (c *clientImpl) MoveTaskQueueBacklogTasks(
+	ctx context.Context,
+	request *adminservice.MoveTaskQueueBacklogTasksRequest,
+	opts ...grpc.CallOption,
+) (*adminservice.MoveTaskQueueBacklogTasksResponse, error) {
+	ctx, cancel := c.createContext(ctx)
+	defer cancel()
+	return c.client.MoveTaskQueueBacklogTasks(ctx, request, opts...)
+}
+
+func (c *clientImpl) PauseTaskQueueDispatch(
+	ctx context.Context,
+	request *adminservice.PauseTaskQueueDispatchRequest,
+	opts ...grpc.CallOption,
+) (*adminservice.PauseTaskQueueDispatchResponse, error) {
+	ctx, cancel := c.createContext(ctx)
+	defer cancel()
+	return c.client.PauseTaskQueueDispatch(ctx, request, opts...)
+}
+
 func (c *clientImpl) PurgeDLQMessages(
 	ctx context.Context,
 	request *adminservice.PurgeDLQMessagesRequest,
@@ -369,6 +489,16 @@ func (c *clientImpl) RebuildMutableState(
 	return c.client.RebuildMutableState(ctx, request, opts...)
 }
 
+func (c *clientImpl) RedactWorkflowExecution(
+	ctx context.Context,
+	request *adminservice.RedactWorkflowExecutionRequest,
+	opts ...grpc.CallOption,
+) (*adminservice.RedactWorkflowExecutionResponse, error) {
+	ctx, cancel := c.createContext(ctx)
+	defer cancel()
+	return c.client.RedactWorkflowExecution(ctx, request, opts...)
+}
+
 func (c *clientImpl) RefreshWorkflowTasks(
 	ctx context.Context,
 	request *adminservice.RefreshWorkflowTasksRequest,
@@ -419,6 +549,16 @@ func (c *clientImpl) ResendReplicationTasks(
 	return c.client.ResendReplicationTasks(ctx, request, opts...)
 }
 
+func (c *clientImpl) ResumeTaskQueueDispatch(
+	ctx context.Context,
+	request *adminservice.ResumeTaskQueueDispatchRequest,
+	opts ...grpc.CallOption,
+) (*adminservice.ResumeTaskQueueDispatchResponse, error) {
+	ctx, cancel := c.createContext(ctx)
+	defer cancel()
+	return c.client.ResumeTaskQueueDispatch(ctx, request, opts...)
+}
+
 func (c *clientImpl) SyncWorkflowState(
 	ctx context.Context,
 	request *adminservice.SyncWorkflowStateRequest,
@@ -428,3 +568,13 @@ func (c *clientImpl) SyncWorkflowState(
 	defer cancel()
 	return c.client.SyncWorkflowState(ctx, request, opts...)
 }
+
+func (c *clientImpl) UpsertSavedVisibilityQuery(
+	ctx context.Context,
+	request *adminservice.UpsertSavedVisibilityQueryRequest,
+	opts ...grpc.CallOption,
+) (*adminservice.UpsertSavedVisibilityQueryResponse, error) {
+	ctx, cancel := c.createContext(ctx)
+	defer cancel()
+	return c.client.UpsertSavedVisibilityQuery(ctx, request, opts...)
+}
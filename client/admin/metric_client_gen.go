@@ -51,6 +51,20 @@ func (c *metricClient) AddTasks(
 	return c.client.AddTasks(ctx, request, opts...)
 }
 
+func (c *metricClient) AggregateWorkflowExecutions(
+	ctx context.Context,
+	request *adminservice.AggregateWorkflowExecutionsRequest,
+	opts ...grpc.CallOption,
+) (_ *adminservice.AggregateWorkflowExecutionsResponse, retError error) {
+
+	metricsHandler, startTime := c.startMetricsRecording(ctx, "AdminClientAggregateWorkflowExecutions")
+	defer func() {
+		c.finishMetricsRecording(metricsHandler, startTime, retError)
+	}()
+
+	return c.client.AggregateWorkflowExecutions(ctx, request, opts...)
+}
+
 func (c *metricClient) CancelDLQJob(
 	ctx context.Context,
 	request *adminservice.CancelDLQJobRequest,
@@ -65,6 +79,20 @@ func (c *metricClient) CancelDLQJob(
 	return c.client.CancelDLQJob(ctx, request, opts...)
 }
 
+func (c *metricClient) CheckMutableState(
+	ctx context.Context,
+	request *adminservice.CheckMutableStateRequest,
+	opts ...grpc.CallOption,
+) (_ *adminservice.CheckMutableStateResponse, retError error) {
+
+	metricsHandler, startTime := c.startMetricsRecording(ctx, "AdminClientCheckMutableState")
+	defer func() {
+		c.finishMetricsRecording(metricsHandler, startTime, retError)
+	}()
+
+	return c.client.CheckMutableState(ctx, request, opts...)
+}
+
 func (c *metricClient) CloseShard(
 	ctx context.Context,
 	request *adminservice.CloseShardRequest,
@@ -93,6 +121,34 @@ func (c *metricClient) DeepHealthCheck(
 	return c.client.DeepHealthCheck(ctx, request, opts...)
 }
 
+func (c *metricClient) DeleteSavedVisibilityQuery(
+	ctx context.Context,
+	request *adminservice.DeleteSavedVisibilityQueryRequest,
+	opts ...grpc.CallOption,
+) (_ *adminservice.DeleteSavedVisibilityQueryResponse, retError error) {
+
+	metricsHandler, startTime := c.startMetricsRecording(ctx, "AdminClientDeleteSavedVisibilityQuery")
+	defer func() {
+		c.finishMetricsRecording(metricsHandler, startTime, retError)
+	}()
+
+	return c.client.DeleteSavedVisibilityQuery(ctx, request, opts...)
+}
+
+func (c *metricClient) DeleteTaskQueueBacklogTasks(
+	ctx context.Context,
+	request *adminservice.DeleteTaskQueueBacklogTasksRequest,
+	opts ...grpc.CallOption,
+) (_ *adminservice.DeleteTaskQueueBacklogTasksResponse, retError error) {
+
+	metricsHandler, startTime := c.startMetricsRecording(ctx, "AdminClientDeleteTaskQueueBacklogTasks")
+	defer func() {
+		c.finishMetricsRecording(metricsHandler, startTime, retError)
+	}()
+
+	return c.client.DeleteTaskQueueBacklogTasks(ctx, request, opts...)
+}
+
 func (c *metricClient) DeleteWorkflowExecution(
 	ctx context.Context,
 	request *adminservice.DeleteWorkflowExecutionRequest,
@@ -191,6 +247,20 @@ func (c *metricClient) ForceUnloadTaskQueuePartition(
 	return c.client.ForceUnloadTaskQueuePartition(ctx, request, opts...)
 }
 
+func (c *metricClient) ForkWorkflowExecution(
+	ctx context.Context,
+	request *adminservice.ForkWorkflowExecutionRequest,
+	opts ...grpc.CallOption,
+) (_ *adminservice.ForkWorkflowExecutionResponse, retError error) {
+
+	metricsHandler, startTime := c.startMetricsRecording(ctx, "AdminClientForkWorkflowExecution")
+	defer func() {
+		c.finishMetricsRecording(metricsHandler, startTime, retError)
+	}()
+
+	return c.client.ForkWorkflowExecution(ctx, request, opts...)
+}
+
 func (c *metricClient) GenerateLastHistoryReplicationTasks(
 	ctx context.Context,
 	request *adminservice.GenerateLastHistoryReplicationTasksRequest,
@@ -359,6 +429,34 @@ func (c *metricClient) GetWorkflowExecutionRawHistoryV2(
 	return c.client.GetWorkflowExecutionRawHistoryV2(ctx, request, opts...)
 }
 
+func (c *metricClient) GetWorkflowExecutionTimeline(
+	ctx context.Context,
+	request *adminservice.GetWorkflowExecutionTimelineRequest,
+	opts ...grpc.CallOption,
+) (_ *adminservice.GetWorkflowExecutionTimelineResponse, retError error) {
+
+	metricsHandler, startTime := c.startMetricsRecording(ctx, "AdminClientGetWorkflowExecutionTimeline")
+	defer func() {
+		c.finishMetricsRecording(metricsHandler, startTime, retError)
+	}()
+
+	return c.client.GetWorkflowExecutionTimeline(ctx, request, opts...)
+}
+
+func (c *metricClient) GetWorkflowHistoryBudget(
+	ctx context.Context,
+	request *adminservice.GetWorkflowHistoryBudgetRequest,
+	opts ...grpc.CallOption,
+) (_ *adminservice.GetWorkflowHistoryBudgetResponse, retError error) {
+
+	metricsHandler, startTime := c.startMetricsRecording(ctx, "AdminClientGetWorkflowHistoryBudget")
+	defer func() {
+		c.finishMetricsRecording(metricsHandler, startTime, retError)
+	}()
+
+	return c.client.GetWorkflowHistoryBudget(ctx, request, opts...)
+}
+
 func (c *metricClient) ImportWorkflowExecution(
 	ctx context.Context,
 	request *adminservice.ImportWorkflowExecutionRequest,
@@ -429,6 +527,34 @@ func (c *metricClient) ListQueues(
 	return c.client.ListQueues(ctx, request, opts...)
 }
 
+func (c *metricClient) ListSavedVisibilityQueries(
+	ctx context.Context,
+	request *adminservice.ListSavedVisibilityQueriesRequest,
+	opts ...grpc.CallOption,
+) (_ *adminservice.ListSavedVisibilityQueriesResponse, retError error) {
+
+	metricsHandler, startTime := c.startMetricsRecording(ctx, "AdminClientListSavedVisibilityQueries")
+	defer func() {
+		c.finishMetricsRecording(metricsHandler, startTime, retError)
+	}()
+
+	return c.client.ListSavedVisibilityQueries(ctx, request, opts...)
+}
+
+func (c *metricClient) ListTaskQueueBacklogTasks(
+	ctx context.Context,
+	request *adminservice.ListTaskQueueBacklogTasksRequest,
+	opts ...grpc.CallOption,
+) (_ *adminservice.ListTaskQueueBacklogTasksResponse, retError error) {
+
+	metricsHandler, startTime := c.startMetricsRecording(ctx, "AdminClientListTaskQueueBacklogTasks")
+	defer func() {
+		c.finishMetricsRecording(metricsHandler, startTime, retError)
+	}()
+
+	return c.client.ListTaskQueueBacklogTasks(ctx, request, opts...)
+}
+
 func (c *metricClient) MergeDLQMessages(
 	ctx context.Context,
 	request *adminservice.MergeDLQMessagesRequest,
@@ -457,6 +583,48 @@ func (c *metricClient) MergeDLQTasks(
 	return c.client.MergeDLQTasks(ctx, request, opts...)
 }
 
+func (c *metricClient) MoveHistoryShard(
+	ctx context.Context,
+	request *adminservice.MoveHistoryShardRequest,
+	opts ...grpc.CallOption,
+) (_ *adminservice.MoveHistoryShardResponse, retError error) {
+
+	metricsHandler, startTime := c.startMetricsRecording(ctx, "AdminClientMoveHistoryShard")
+	defer func() {
+		c.finishMetricsRecording(metricsHandler, startTime, retError)
+	}()
+
+	return c.client.MoveHistoryShard(ctx, request, opts...)
+}
+
+func (c *metricClient) MoveTaskQueueBacklogTasks(
+	ctx context.Context,
+	request *adminservice.MoveTaskQueueBacklogTasksRequest,
+	opts ...grpc.CallOption,
+) (_ *adminservice.MoveTaskQueueBacklogTasksResponse, retError error) {
+
+	metricsHandler, startTime := c.startMetricsRecording(ctx, "AdminClientMoveTaskQueueBacklogTasks")
+	defer func() {
+		c.finishMetricsRecording(metricsHandler, startTime, retError)
+	}()
+
+	return c.client.MoveTaskQueueBacklogTasks(ctx, request, opts...)
+}
+
+func (c *metricClient) PauseTaskQueueDispatch(
+	ctx context.Context,
+	request *adminservice.PauseTaskQueueDispatchRequest,
+	opts ...grpc.CallOption,
+) (_ *adminservice.PauseTaskQueueDispatchResponse, retError error) {
+
+	metricsHandler, startTime := c.startMetricsRecording(ctx, "AdminClientPauseTaskQueueDispatch")
+	defer func() {
+		c.finishMetricsRecording(metricsHandler, startTime, retError)
+	}()
+
+	return c.client.PauseTaskQueueDispatch(ctx, request, opts...)
+}
+
 func (c *metricClient) PurgeDLQMessages(
 	ctx context.Context,
 	request *adminservice.PurgeDLQMessagesRequest,
@@ -513,6 +681,20 @@ func (c *metricClient) RebuildMutableState(
 	return c.client.RebuildMutableState(ctx, request, opts...)
 }
 
+func (c *metricClient) RedactWorkflowExecution(
+	ctx context.Context,
+	request *adminservice.RedactWorkflowExecutionRequest,
+	opts ...grpc.CallOption,
+) (_ *adminservice.RedactWorkflowExecutionResponse, retError error) {
+
+	metricsHandler, startTime := c.startMetricsRecording(ctx, "AdminClientRedactWorkflowExecution")
+	defer func() {
+		c.finishMetricsRecording(metricsHandler, startTime, retError)
+	}()
+
+	return c.client.RedactWorkflowExecution(ctx, request, opts...)
+}
+
 func (c *metricClient) RefreshWorkflowTasks(
 	ctx context.Context,
 	request *adminservice.RefreshWorkflowTasksRequest,
@@ -583,6 +765,20 @@ func (c *metricClient) ResendReplicationTasks(
 	return c.client.ResendReplicationTasks(ctx, request, opts...)
 }
 
+func (c *metricClient) ResumeTaskQueueDispatch(
+	ctx context.Context,
+	request *adminservice.ResumeTaskQueueDispatchRequest,
+	opts ...grpc.CallOption,
+) (_ *adminservice.ResumeTaskQueueDispatchResponse, retError error) {
+
+	metricsHandler, startTime := c.startMetricsRecording(ctx, "AdminClientResumeTaskQueueDispatch")
+	defer func() {
+		c.finishMetricsRecording(metricsHandler, startTime, retError)
+	}()
+
+	return c.client.ResumeTaskQueueDispatch(ctx, request, opts...)
+}
+
 func (c *metricClient) SyncWorkflowState(
 	ctx context.Context,
 	request *adminservice.SyncWorkflowStateRequest,
@@ -596,3 +792,17 @@ func (c *metricClient) SyncWorkflowState(
 
 	return c.client.SyncWorkflowState(ctx, request, opts...)
 }
+
+func (c *metricClient) UpsertSavedVisibilityQuery(
+	ctx context.Context,
+	request *adminservice.UpsertSavedVisibilityQueryRequest,
+	opts ...grpc.CallOption,
+) (_ *adminservice.UpsertSavedVisibilityQueryResponse, retError error) {
+
+	metricsHandler, startTime := c.startMetricsRecording(ctx, "AdminClientUpsertSavedVisibilityQuery")
+	defer func() {
+		c.finishMetricsRecording(metricsHandler, startTime, retError)
+	}()
+
+	return c.client.UpsertSavedVisibilityQuery(ctx, request, opts...)
+}
@@ -56,6 +56,21 @@ func (c *retryableClient) AddTasks(
 	return resp, err
 }
 
+func (c *retryableClient) AggregateWorkflowExecutions(
+	ctx context.Context,
+	request *adminservice.AggregateWorkflowExecutionsRequest,
+	opts ...grpc.CallOption,
+) (*adminservice.AggregateWorkflowExecutionsResponse, error) {
+	var resp *adminservice.AggregateWorkflowExecutionsResponse
+	op := func(ctx context.Context) error {
+		var err error
+		resp, err = c.client.AggregateWorkflowExecutions(ctx, request, opts...)
+		return err
+	}
+	err := backoff.ThrottleRetryContext(ctx, op, c.policy, c.isRetryable)
+	return resp, err
+}
+
 func (c *retryableClient) CancelDLQJob(
 	ctx context.Context,
 	request *adminservice.CancelDLQJobRequest,
@@ -71,6 +86,21 @@ func (c *retryableClient) CancelDLQJob(
 	return resp, err
 }
 
+func (c *retryableClient) CheckMutableState(
+	ctx context.Context,
+	request *adminservice.CheckMutableStateRequest,
+	opts ...grpc.CallOption,
+) (*adminservice.CheckMutableStateResponse, error) {
+	var resp *adminservice.CheckMutableStateResponse
+	op := func(ctx context.Context) error {
+		var err error
+		resp, err = c.client.CheckMutableState(ctx, request, opts...)
+		return err
+	}
+	err := backoff.ThrottleRetryContext(ctx, op, c.policy, c.isRetryable)
+	return resp, err
+}
+
 func (c *retryableClient) CloseShard(
 	ctx context.Context,
 	request *adminservice.CloseShardRequest,
@@ -101,6 +131,36 @@ func (c *retryableClient) DeepHealthCheck(
 	return resp, err
 }
 
+func (c *retryableClient) DeleteSavedVisibilityQuery(
+	ctx context.Context,
+	request *adminservice.DeleteSavedVisibilityQueryRequest,
+	opts ...grpc.CallOption,
+) (*adminservice.DeleteSavedVisibilityQueryResponse, error) {
+	var resp *adminservice.DeleteSavedVisibilityQueryResponse
+	op := func(ctx context.Context) error {
+		var err error
+		resp, err = c.client.DeleteSavedVisibilityQuery(ctx, request, opts...)
+		return err
+	}
+	err := backoff.ThrottleRetryContext(ctx, op, c.policy, c.isRetryable)
+	return resp, err
+}
+
+func (c *retryableClient) DeleteTaskQueueBacklogTasks(
+	ctx context.Context,
+	request *adminservice.DeleteTaskQueueBacklogTasksRequest,
+	opts ...grpc.CallOption,
+) (*adminservice.DeleteTaskQueueBacklogTasksResponse, error) {
+	var resp *adminservice.DeleteTaskQueueBacklogTasksResponse
+	op := func(ctx context.Context) error {
+		var err error
+		resp, err = c.client.DeleteTaskQueueBacklogTasks(ctx, request, opts...)
+		return err
+	}
+	err := backoff.ThrottleRetryContext(ctx, op, c.policy, c.isRetryable)
+	return resp, err
+}
+
 func (c *retryableClient) DeleteWorkflowExecution(
 	ctx context.Context,
 	request *adminservice.DeleteWorkflowExecutionRequest,
@@ -206,6 +266,21 @@ func (c *retryableClient) ForceUnloadTaskQueuePartition(
 	return resp, err
 }
 
+func (c *retryableClient) ForkWorkflowExecution(
+	ctx context.Context,
+	request *adminservice.ForkWorkflowExecutionRequest,
+	opts ...grpc.CallOption,
+) (*adminservice.ForkWorkflowExecutionResponse, error) {
+	var resp *adminservice.ForkWorkflowExecutionResponse
+	op := func(ctx context.Context) error {
+		var err error
+		resp, err = c.client.ForkWorkflowExecution(ctx, request, opts...)
+		return err
+	}
+	err := backoff.ThrottleRetryContext(ctx, op, c.policy, c.isRetryable)
+	return resp, err
+}
+
 func (c *retryableClient) GenerateLastHistoryReplicationTasks(
 	ctx context.Context,
 	request *adminservice.GenerateLastHistoryReplicationTasksRequest,
@@ -386,6 +461,36 @@ func (c *retryableClient) GetWorkflowExecutionRawHistoryV2(
 	return resp, err
 }
 
+func (c *retryableClient) GetWorkflowExecutionTimeline(
+	ctx context.Context,
+	request *adminservice.GetWorkflowExecutionTimelineRequest,
+	opts ...grpc.CallOption,
+) (*adminservice.GetWorkflowExecutionTimelineResponse, error) {
+	var resp *adminservice.GetWorkflowExecutionTimelineResponse
+	op := func(ctx context.Context) error {
+		var err error
+		resp, err = c.client.GetWorkflowExecutionTimeline(ctx, request, opts...)
+		return err
+	}
+	err := backoff.ThrottleRetryContext(ctx, op, c.policy, c.isRetryable)
+	return resp, err
+}
+
+func (c *retryableClient) GetWorkflowHistoryBudget(
+	ctx context.Context,
+	request *adminservice.GetWorkflowHistoryBudgetRequest,
+	opts ...grpc.CallOption,
+) (*adminservice.GetWorkflowHistoryBudgetResponse, error) {
+	var resp *adminservice.GetWorkflowHistoryBudgetResponse
+	op := func(ctx context.Context) error {
+		var err error
+		resp, err = c.client.GetWorkflowHistoryBudget(ctx, request, opts...)
+		return err
+	}
+	err := backoff.ThrottleRetryContext(ctx, op, c.policy, c.isRetryable)
+	return resp, err
+}
+
 func (c *retryableClient) ImportWorkflowExecution(
 	ctx context.Context,
 	request *adminservice.ImportWorkflowExecutionRequest,
@@ -461,6 +566,36 @@ func (c *retryableClient) ListQueues(
 	return resp, err
 }
 
+func (c *retryableClient) ListSavedVisibilityQueries(
+	ctx context.Context,
+	request *adminservice.ListSavedVisibilityQueriesRequest,
+	opts ...grpc.CallOption,
+) (*adminservice.ListSavedVisibilityQueriesResponse, error) {
+	var resp *adminservice.ListSavedVisibilityQueriesResponse
+	op := func(ctx context.Context) error {
+		var err error
+		resp, err = c.client.ListSavedVisibilityQueries(ctx, request, opts...)
+		return err
+	}
+	err := backoff.ThrottleRetryContext(ctx, op, c.policy, c.isRetryable)
+	return resp, err
+}
+
+func (c *retryableClient) ListTaskQueueBacklogTasks(
+	ctx context.Context,
+	request *adminservice.ListTaskQueueBacklogTasksRequest,
+	opts ...grpc.CallOption,
+) (*adminservice.ListTaskQueueBacklogTasksResponse, error) {
+	var resp *adminservice.ListTaskQueueBacklogTasksResponse
+	op := func(ctx context.Context) error {
+		var err error
+		resp, err = c.client.ListTaskQueueBacklogTasks(ctx, request, opts...)
+		return err
+	}
+	err := backoff.ThrottleRetryContext(ctx, op, c.policy, c.isRetryable)
+	return resp, err
+}
+
 func (c *retryableClient) MergeDLQMessages(
 	ctx context.Context,
 	request *adminservice.MergeDLQMessagesRequest,
@@ -491,6 +626,51 @@ func (c *retryableClient) MergeDLQTasks(
 	return resp, err
 }
 
+func (c *retryableClient) MoveHistoryShard(
+	ctx context.Context,
+	request *adminservice.MoveHistoryShardRequest,
+	opts ...grpc.CallOption,
+) (*adminservice.MoveHistoryShardResponse, error) {
+	var resp *adminservice.MoveHistoryShardResponse
+	op := func(ctx context.Context) error {
+		var err error
+		resp, err = c.client.MoveHistoryShard(ctx, request, opts...)
+		return err
+	}
+	err := backoff.ThrottleRetryContext(ctx, op, c.policy, c.isRetryable)
+	return resp, err
+}
+
+func (c *retryableClient) MoveTaskQueueBacklogTasks(
+	ctx context.Context,
+	request *adminservice.MoveTaskQueueBacklogTasksRequest,
+	opts ...grpc.CallOption,
+) (*adminservice.MoveTaskQueueBacklogTasksResponse, error) {
+	var resp *adminservice.MoveTaskQueueBacklogTasksResponse
+	op := func(ctx context.Context) error {
+		var err error
+		resp, err = c.client.MoveTaskQueueBacklogTasks(ctx, request, opts...)
+		return err
+	}
+	err := backoff.ThrottleRetryContext(ctx, op, c.policy, c.isRetryable)
+	return resp, err
+}
+
+func (c *retryableClient) PauseTaskQueueDispatch(
+	ctx context.Context,
+	request *adminservice.PauseTaskQueueDispatchRequest,
+	opts ...grpc.CallOption,
+) (*adminservice.PauseTaskQueueDispatchResponse, error) {
+	var resp *adminservice.PauseTaskQueueDispatchResponse
+	op := func(ctx context.Context) error {
+		var err error
+		resp, err = c.client.PauseTaskQueueDispatch(ctx, request, opts...)
+		return err
+	}
+	err := backoff.ThrottleRetryContext(ctx, op, c.policy, c.isRetryable)
+	return resp, err
+}
+
 func (c *retryableClient) PurgeDLQMessages(
 	ctx context.Context,
 	request *adminservice.PurgeDLQMessagesRequest,
@@ -551,6 +731,21 @@ func (c *retryableClient) RebuildMutableState(
 	return resp, err
 }
 
+func (c *retryableClient) RedactWorkflowExecution(
+	ctx context.Context,
+	request *adminservice.RedactWorkflowExecutionRequest,
+	opts ...grpc.CallOption,
+) (*adminservice.RedactWorkflowExecutionResponse, error) {
+	var resp *adminservice.RedactWorkflowExecutionResponse
+	op := func(ctx context.Context) error {
+		var err error
+		resp, err = c.client.RedactWorkflowExecution(ctx, request, opts...)
+		return err
+	}
+	err := backoff.ThrottleRetryContext(ctx, op, c.policy, c.isRetryable)
+	return resp, err
+}
+
 func (c *retryableClient) RefreshWorkflowTasks(
 	ctx context.Context,
 	request *adminservice.RefreshWorkflowTasksRequest,
@@ -626,6 +821,21 @@ func (c *retryableClient) ResendReplicationTasks(
 	return resp, err
 }
 
+func (c *retryableClient) ResumeTaskQueueDispatch(
+	ctx context.Context,
+	request *adminservice.ResumeTaskQueueDispatchRequest,
+	opts ...grpc.CallOption,
+) (*adminservice.ResumeTaskQueueDispatchResponse, error) {
+	var resp *adminservice.ResumeTaskQueueDispatchResponse
+	op := func(ctx context.Context) error {
+		var err error
+		resp, err = c.client.ResumeTaskQueueDispatch(ctx, request, opts...)
+		return err
+	}
+	err := backoff.ThrottleRetryContext(ctx, op, c.policy, c.isRetryable)
+	return resp, err
+}
+
 func (c *retryableClient) SyncWorkflowState(
 	ctx context.Context,
 	request *adminservice.SyncWorkflowStateRequest,
@@ -640,3 +850,18 @@ func (c *retryableClient) SyncWorkflowState(
 	err := backoff.ThrottleRetryContext(ctx, op, c.policy, c.isRetryable)
 	return resp, err
 }
+
+func (c *retryableClient) UpsertSavedVisibilityQuery(
+	ctx context.Context,
+	request *adminservice.UpsertSavedVisibilityQueryRequest,
+	opts ...grpc.CallOption,
+) (*adminservice.UpsertSavedVisibilityQueryResponse, error) {
+	var resp *adminservice.UpsertSavedVisibilityQueryResponse
+	op := func(ctx context.Context) error {
+		var err error
+		resp, err = c.client.UpsertSavedVisibilityQuery(ctx, request, opts...)
+		return err
+	}
+	err := backoff.ThrottleRetryContext(ctx, op, c.policy, c.isRetryable)
+	return resp, err
+}
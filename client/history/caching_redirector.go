@@ -193,6 +193,16 @@ func (r *cachingRedirector) handleSolError(opEntry cacheEntry, solErr *serviceer
 	r.mu.Lock()
 	defer r.mu.Unlock()
 
+	if solErr.ShardID != 0 && solErr.ShardID != opEntry.shardID {
+		// The workflow is served by another shard than the one the request was routed by, e.g. while its
+		// namespace is moved between shard pools. The routed shard's owner is still correct, so only
+		// redirect to the owner of the serving shard.
+		if len(solErr.OwnerHost) == 0 {
+			return cacheEntry{}, false
+		}
+		return r.cacheAddLocked(solErr.ShardID, rpcAddress(solErr.OwnerHost)), true
+	}
+
 	if cached, ok := r.mu.cache[opEntry.shardID]; ok {
 		if cached.address == opEntry.address {
 			delete(r.mu.cache, cached.shardID)
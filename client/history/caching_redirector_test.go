@@ -317,6 +317,69 @@ func (s *cachingRedirectorSuite) TestShardOwnershipLostErrors() {
 	s.NoError(err)
 }
 
+func (s *cachingRedirectorSuite) TestShardOwnershipLostErrors_OtherShard() {
+	testAddr1 := rpcAddress("testaddr1")
+	testAddr2 := rpcAddress("testaddr2")
+	shardID := int32(1)
+	otherShardID := int32(2)
+
+	mockClient1 := historyservicemock.NewMockHistoryServiceClient(s.controller)
+	mockClient2 := historyservicemock.NewMockHistoryServiceClient(s.controller)
+	clientConn1 := clientConnection{
+		historyClient: mockClient1,
+	}
+	clientConn2 := clientConnection{
+		historyClient: mockClient2,
+	}
+	s.resolver.EXPECT().
+		Lookup(convert.Int32ToString(shardID)).
+		Return(membership.NewHostInfoFromAddress(string(testAddr1)), nil).
+		Times(1)
+	s.connections.EXPECT().
+		getOrCreateClientConn(testAddr1).
+		Return(clientConn1).
+		Times(1)
+	s.connections.EXPECT().
+		resetConnectBackoff(clientConn1).
+		Times(1)
+	s.connections.EXPECT().
+		getOrCreateClientConn(testAddr2).
+		Return(clientConn2).
+		Times(1)
+	s.connections.EXPECT().
+		resetConnectBackoff(clientConn2).
+		Times(1)
+
+	r := s.newCachingDirector(0)
+	defer r.stop()
+
+	// the owner of the routed shard redirects to the owner of the shard that serves the workflow
+	var clients []historyservice.HistoryServiceClient
+	err := r.execute(
+		context.Background(),
+		shardID,
+		func(ctx context.Context, client historyservice.HistoryServiceClient) error {
+			clients = append(clients, client)
+			if client == mockClient1 {
+				solErr := serviceerrors.NewShardOwnershipLost(string(testAddr2), string(testAddr1)).(*serviceerrors.ShardOwnershipLost)
+				solErr.ShardID = otherShardID
+				return solErr
+			}
+			return nil
+		},
+	)
+	s.NoError(err)
+	s.Equal([]historyservice.HistoryServiceClient{mockClient1, mockClient2}, clients)
+
+	// the routed shard keeps its owner, no additional lookups
+	client, err := r.clientForShardID(shardID)
+	s.NoError(err)
+	s.Equal(mockClient1, client)
+	client, err = r.clientForShardID(otherShardID)
+	s.NoError(err)
+	s.Equal(mockClient2, client)
+}
+
 func (s *cachingRedirectorSuite) TestClientForTargetByShard() {
 	testAddr := rpcAddress("testaddr")
 	shardID := int32(1)
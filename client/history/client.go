@@ -37,6 +37,7 @@ type clientImpl struct {
 	connections     connectionPool
 	logger          log.Logger
 	numberOfShards  int32
+	shardPools      dynamicconfig.TypedPropertyFn[map[string]common.NamespaceShardPool]
 	redirector      redirector
 	timeout         time.Duration
 	tokenSerializer *tasktoken.Serializer
@@ -71,6 +72,7 @@ func NewClient(
 		connections:     connections,
 		logger:          logger,
 		numberOfShards:  numberOfShards,
+		shardPools:      dynamicconfig.HistoryNamespaceShardPools.Get(dc),
 		redirector:      redirector,
 		timeout:         timeout,
 		tokenSerializer: tasktoken.NewSerializer(),
@@ -110,6 +112,18 @@ func (c *clientImpl) DescribeHistoryHost(
 	return response, nil
 }
 
+func (c *clientImpl) MoveShard(
+	ctx context.Context,
+	request *historyservice.MoveShardRequest,
+	opts ...grpc.CallOption) (*historyservice.MoveShardResponse, error) {
+
+	// The request goes to the host taking over the shard, not to its current owner.
+	clientConn := c.connections.getOrCreateClientConn(rpcAddress(request.GetTargetHostAddress()))
+	ctx, cancel := c.createContext(ctx)
+	defer cancel()
+	return clientConn.historyClient.MoveShard(ctx, request, opts...)
+}
+
 func (c *clientImpl) GetReplicationMessages(
 	ctx context.Context,
 	request *historyservice.GetReplicationMessagesRequest,
@@ -256,7 +270,7 @@ func (c *clientImpl) createContext(parent context.Context) (context.Context, con
 }
 
 func (c *clientImpl) shardIDFromWorkflowID(namespaceID, workflowID string) int32 {
-	return common.WorkflowIDToHistoryShard(namespaceID, workflowID, c.numberOfShards)
+	return common.WorkflowIDToHistoryShardInPools(namespaceID, workflowID, c.numberOfShards, c.shardPools())
 }
 
 func checkShardID(shardID int32) error {
@@ -30,6 +30,26 @@ func (c *clientImpl) AddTasks(
 	return response, nil
 }
 
+func (c *clientImpl) CheckMutableState(
+	ctx context.Context,
+	request *historyservice.CheckMutableStateRequest,
+	opts ...grpc.CallOption,
+) (*historyservice.CheckMutableStateResponse, error) {
+	shardID := c.shardIDFromWorkflowID(request.GetNamespaceId(), request.GetExecution().GetWorkflowId())
+	var response *historyservice.CheckMutableStateResponse
+	op := func(ctx context.Context, client historyservice.HistoryServiceClient) error {
+		var err error
+		ctx, cancel := c.createContext(ctx)
+		defer cancel()
+		response, err = client.CheckMutableState(ctx, request, opts...)
+		return err
+	}
+	if err := c.executeWithRedirect(ctx, shardID, op); err != nil {
+		return nil, err
+	}
+	return response, nil
+}
+
 func (c *clientImpl) CloseShard(
 	ctx context.Context,
 	request *historyservice.CloseShardRequest,
@@ -210,6 +230,26 @@ func (c *clientImpl) ForceDeleteWorkflowExecution(
 	return response, nil
 }
 
+func (c *clientImpl) ForkWorkflowExecution(
+	ctx context.Context,
+	request *historyservice.ForkWorkflowExecutionRequest,
+	opts ...grpc.CallOption,
+) (*historyservice.ForkWorkflowExecutionResponse, error) {
+	shardID := c.shardIDFromWorkflowID(request.GetNamespaceId(), request.GetForkRequest().GetNewWorkflowId())
+	var response *historyservice.ForkWorkflowExecutionResponse
+	op := func(ctx context.Context, client historyservice.HistoryServiceClient) error {
+		var err error
+		ctx, cancel := c.createContext(ctx)
+		defer cancel()
+		response, err = client.ForkWorkflowExecution(ctx, request, opts...)
+		return err
+	}
+	if err := c.executeWithRedirect(ctx, shardID, op); err != nil {
+		return nil, err
+	}
+	return response, nil
+}
+
 func (c *clientImpl) GenerateLastHistoryReplicationTasks(
 	ctx context.Context,
 	request *historyservice.GenerateLastHistoryReplicationTasksRequest,
@@ -414,6 +454,46 @@ func (c *clientImpl) GetWorkflowExecutionRawHistoryV2(
 	return response, nil
 }
 
+func (c *clientImpl) GetWorkflowExecutionTimeline(
+	ctx context.Context,
+	request *historyservice.GetWorkflowExecutionTimelineRequest,
+	opts ...grpc.CallOption,
+) (*historyservice.GetWorkflowExecutionTimelineResponse, error) {
+	shardID := c.shardIDFromWorkflowID(request.GetNamespaceId(), request.GetRequest().GetExecution().GetWorkflowId())
+	var response *historyservice.GetWorkflowExecutionTimelineResponse
+	op := func(ctx context.Context, client historyservice.HistoryServiceClient) error {
+		var err error
+		ctx, cancel := c.createContext(ctx)
+		defer cancel()
+		response, err = client.GetWorkflowExecutionTimeline(ctx, request, opts...)
+		return err
+	}
+	if err := c.executeWithRedirect(ctx, shardID, op); err != nil {
+		return nil, err
+	}
+	return response, nil
+}
+
+func (c *clientImpl) GetWorkflowHistoryBudget(
+	ctx context.Context,
+	request *historyservice.GetWorkflowHistoryBudgetRequest,
+	opts ...grpc.CallOption,
+) (*historyservice.GetWorkflowHistoryBudgetResponse, error) {
+	shardID := c.shardIDFromWorkflowID(request.GetNamespaceId(), request.GetRequest().GetExecution().GetWorkflowId())
+	var response *historyservice.GetWorkflowHistoryBudgetResponse
+	op := func(ctx context.Context, client historyservice.HistoryServiceClient) error {
+		var err error
+		ctx, cancel := c.createContext(ctx)
+		defer cancel()
+		response, err = client.GetWorkflowHistoryBudget(ctx, request, opts...)
+		return err
+	}
+	if err := c.executeWithRedirect(ctx, shardID, op); err != nil {
+		return nil, err
+	}
+	return response, nil
+}
+
 func (c *clientImpl) ImportWorkflowExecution(
 	ctx context.Context,
 	request *historyservice.ImportWorkflowExecutionRequest,
@@ -779,6 +859,46 @@ func (c *clientImpl) RecordWorkflowTaskStarted(
 	return response, nil
 }
 
+func (c *clientImpl) RedactWorkflowExecution(
+	ctx context.Context,
+	request *historyservice.RedactWorkflowExecutionRequest,
+	opts ...grpc.CallOption,
+) (*historyservice.RedactWorkflowExecutionResponse, error) {
+	shardID := c.shardIDFromWorkflowID(request.GetNamespaceId(), request.GetRedactRequest().GetExecution().GetWorkflowId())
+	var response *historyservice.RedactWorkflowExecutionResponse
+	op := func(ctx context.Context, client historyservice.HistoryServiceClient) error {
+		var err error
+		ctx, cancel := c.createContext(ctx)
+		defer cancel()
+		response, err = client.RedactWorkflowExecution(ctx, request, opts...)
+		return err
+	}
+	if err := c.executeWithRedirect(ctx, shardID, op); err != nil {
+		return nil, err
+	}
+	return response, nil
+}
+
+func (c *clientImpl) ReencryptWorkflowExecution(
+	ctx context.Context,
+	request *historyservice.ReencryptWorkflowExecutionRequest,
+	opts ...grpc.CallOption,
+) (*historyservice.ReencryptWorkflowExecutionResponse, error) {
+	shardID := c.shardIDFromWorkflowID(request.GetNamespaceId(), request.GetExecution().GetWorkflowId())
+	var response *historyservice.ReencryptWorkflowExecutionResponse
+	op := func(ctx context.Context, client historyservice.HistoryServiceClient) error {
+		var err error
+		ctx, cancel := c.createContext(ctx)
+		defer cancel()
+		response, err = client.ReencryptWorkflowExecution(ctx, request, opts...)
+		return err
+	}
+	if err := c.executeWithRedirect(ctx, shardID, op); err != nil {
+		return nil, err
+	}
+	return response, nil
+}
+
 func (c *clientImpl) RefreshWorkflowTasks(
 	ctx context.Context,
 	request *historyservice.RefreshWorkflowTasksRequest,
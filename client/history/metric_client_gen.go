@@ -23,6 +23,20 @@ func (c *metricClient) AddTasks(
 	return c.client.AddTasks(ctx, request, opts...)
 }
 
+func (c *metricClient) CheckMutableState(
+	ctx context.Context,
+	request *historyservice.CheckMutableStateRequest,
+	opts ...grpc.CallOption,
+) (_ *historyservice.CheckMutableStateResponse, retError error) {
+
+	metricsHandler, startTime := c.startMetricsRecording(ctx, "HistoryClientCheckMutableState")
+	defer func() {
+		c.finishMetricsRecording(metricsHandler, startTime, retError)
+	}()
+
+	return c.client.CheckMutableState(ctx, request, opts...)
+}
+
 func (c *metricClient) CloseShard(
 	ctx context.Context,
 	request *historyservice.CloseShardRequest,
@@ -177,6 +191,20 @@ func (c *metricClient) ForceDeleteWorkflowExecution(
 	return c.client.ForceDeleteWorkflowExecution(ctx, request, opts...)
 }
 
+func (c *metricClient) ForkWorkflowExecution(
+	ctx context.Context,
+	request *historyservice.ForkWorkflowExecutionRequest,
+	opts ...grpc.CallOption,
+) (_ *historyservice.ForkWorkflowExecutionResponse, retError error) {
+
+	metricsHandler, startTime := c.startMetricsRecording(ctx, "HistoryClientForkWorkflowExecution")
+	defer func() {
+		c.finishMetricsRecording(metricsHandler, startTime, retError)
+	}()
+
+	return c.client.ForkWorkflowExecution(ctx, request, opts...)
+}
+
 func (c *metricClient) GenerateLastHistoryReplicationTasks(
 	ctx context.Context,
 	request *historyservice.GenerateLastHistoryReplicationTasksRequest,
@@ -345,6 +373,34 @@ func (c *metricClient) GetWorkflowExecutionRawHistoryV2(
 	return c.client.GetWorkflowExecutionRawHistoryV2(ctx, request, opts...)
 }
 
+func (c *metricClient) GetWorkflowExecutionTimeline(
+	ctx context.Context,
+	request *historyservice.GetWorkflowExecutionTimelineRequest,
+	opts ...grpc.CallOption,
+) (_ *historyservice.GetWorkflowExecutionTimelineResponse, retError error) {
+
+	metricsHandler, startTime := c.startMetricsRecording(ctx, "HistoryClientGetWorkflowExecutionTimeline")
+	defer func() {
+		c.finishMetricsRecording(metricsHandler, startTime, retError)
+	}()
+
+	return c.client.GetWorkflowExecutionTimeline(ctx, request, opts...)
+}
+
+func (c *metricClient) GetWorkflowHistoryBudget(
+	ctx context.Context,
+	request *historyservice.GetWorkflowHistoryBudgetRequest,
+	opts ...grpc.CallOption,
+) (_ *historyservice.GetWorkflowHistoryBudgetResponse, retError error) {
+
+	metricsHandler, startTime := c.startMetricsRecording(ctx, "HistoryClientGetWorkflowHistoryBudget")
+	defer func() {
+		c.finishMetricsRecording(metricsHandler, startTime, retError)
+	}()
+
+	return c.client.GetWorkflowHistoryBudget(ctx, request, opts...)
+}
+
 func (c *metricClient) ImportWorkflowExecution(
 	ctx context.Context,
 	request *historyservice.ImportWorkflowExecutionRequest,
@@ -443,6 +499,20 @@ func (c *metricClient) MergeDLQMessages(
 	return c.client.MergeDLQMessages(ctx, request, opts...)
 }
 
+func (c *metricClient) MoveShard(
+	ctx context.Context,
+	request *historyservice.MoveShardRequest,
+	opts ...grpc.CallOption,
+) (_ *historyservice.MoveShardResponse, retError error) {
+
+	metricsHandler, startTime := c.startMetricsRecording(ctx, "HistoryClientMoveShard")
+	defer func() {
+		c.finishMetricsRecording(metricsHandler, startTime, retError)
+	}()
+
+	return c.client.MoveShard(ctx, request, opts...)
+}
+
 func (c *metricClient) PauseActivity(
 	ctx context.Context,
 	request *historyservice.PauseActivityRequest,
@@ -597,6 +667,34 @@ func (c *metricClient) RecordWorkflowTaskStarted(
 	return c.client.RecordWorkflowTaskStarted(ctx, request, opts...)
 }
 
+func (c *metricClient) RedactWorkflowExecution(
+	ctx context.Context,
+	request *historyservice.RedactWorkflowExecutionRequest,
+	opts ...grpc.CallOption,
+) (_ *historyservice.RedactWorkflowExecutionResponse, retError error) {
+
+	metricsHandler, startTime := c.startMetricsRecording(ctx, "HistoryClientRedactWorkflowExecution")
+	defer func() {
+		c.finishMetricsRecording(metricsHandler, startTime, retError)
+	}()
+
+	return c.client.RedactWorkflowExecution(ctx, request, opts...)
+}
+
+func (c *metricClient) ReencryptWorkflowExecution(
+	ctx context.Context,
+	request *historyservice.ReencryptWorkflowExecutionRequest,
+	opts ...grpc.CallOption,
+) (_ *historyservice.ReencryptWorkflowExecutionResponse, retError error) {
+
+	metricsHandler, startTime := c.startMetricsRecording(ctx, "HistoryClientReencryptWorkflowExecution")
+	defer func() {
+		c.finishMetricsRecording(metricsHandler, startTime, retError)
+	}()
+
+	return c.client.ReencryptWorkflowExecution(ctx, request, opts...)
+}
+
 func (c *metricClient) RefreshWorkflowTasks(
 	ctx context.Context,
 	request *historyservice.RefreshWorkflowTasksRequest,
@@ -26,6 +26,21 @@ func (c *retryableClient) AddTasks(
 	return resp, err
 }
 
+func (c *retryableClient) CheckMutableState(
+	ctx context.Context,
+	request *historyservice.CheckMutableStateRequest,
+	opts ...grpc.CallOption,
+) (*historyservice.CheckMutableStateResponse, error) {
+	var resp *historyservice.CheckMutableStateResponse
+	op := func(ctx context.Context) error {
+		var err error
+		resp, err = c.client.CheckMutableState(ctx, request, opts...)
+		return err
+	}
+	err := backoff.ThrottleRetryContext(ctx, op, c.policy, c.isRetryable)
+	return resp, err
+}
+
 func (c *retryableClient) CloseShard(
 	ctx context.Context,
 	request *historyservice.CloseShardRequest,
@@ -191,6 +206,21 @@ func (c *retryableClient) ForceDeleteWorkflowExecution(
 	return resp, err
 }
 
+func (c *retryableClient) ForkWorkflowExecution(
+	ctx context.Context,
+	request *historyservice.ForkWorkflowExecutionRequest,
+	opts ...grpc.CallOption,
+) (*historyservice.ForkWorkflowExecutionResponse, error) {
+	var resp *historyservice.ForkWorkflowExecutionResponse
+	op := func(ctx context.Context) error {
+		var err error
+		resp, err = c.client.ForkWorkflowExecution(ctx, request, opts...)
+		return err
+	}
+	err := backoff.ThrottleRetryContext(ctx, op, c.policy, c.isRetryable)
+	return resp, err
+}
+
 func (c *retryableClient) GenerateLastHistoryReplicationTasks(
 	ctx context.Context,
 	request *historyservice.GenerateLastHistoryReplicationTasksRequest,
@@ -371,6 +401,36 @@ func (c *retryableClient) GetWorkflowExecutionRawHistoryV2(
 	return resp, err
 }
 
+func (c *retryableClient) GetWorkflowExecutionTimeline(
+	ctx context.Context,
+	request *historyservice.GetWorkflowExecutionTimelineRequest,
+	opts ...grpc.CallOption,
+) (*historyservice.GetWorkflowExecutionTimelineResponse, error) {
+	var resp *historyservice.GetWorkflowExecutionTimelineResponse
+	op := func(ctx context.Context) error {
+		var err error
+		resp, err = c.client.GetWorkflowExecutionTimeline(ctx, request, opts...)
+		return err
+	}
+	err := backoff.ThrottleRetryContext(ctx, op, c.policy, c.isRetryable)
+	return resp, err
+}
+
+func (c *retryableClient) GetWorkflowHistoryBudget(
+	ctx context.Context,
+	request *historyservice.GetWorkflowHistoryBudgetRequest,
+	opts ...grpc.CallOption,
+) (*historyservice.GetWorkflowHistoryBudgetResponse, error) {
+	var resp *historyservice.GetWorkflowHistoryBudgetResponse
+	op := func(ctx context.Context) error {
+		var err error
+		resp, err = c.client.GetWorkflowHistoryBudget(ctx, request, opts...)
+		return err
+	}
+	err := backoff.ThrottleRetryContext(ctx, op, c.policy, c.isRetryable)
+	return resp, err
+}
+
 func (c *retryableClient) ImportWorkflowExecution(
 	ctx context.Context,
 	request *historyservice.ImportWorkflowExecutionRequest,
@@ -476,6 +536,21 @@ func (c *retryableClient) MergeDLQMessages(
 	return resp, err
 }
 
+func (c *retryableClient) MoveShard(
+	ctx context.Context,
+	request *historyservice.MoveShardRequest,
+	opts ...grpc.CallOption,
+) (*historyservice.MoveShardResponse, error) {
+	var resp *historyservice.MoveShardResponse
+	op := func(ctx context.Context) error {
+		var err error
+		resp, err = c.client.MoveShard(ctx, request, opts...)
+		return err
+	}
+	err := backoff.ThrottleRetryContext(ctx, op, c.policy, c.isRetryable)
+	return resp, err
+}
+
 func (c *retryableClient) PauseActivity(
 	ctx context.Context,
 	request *historyservice.PauseActivityRequest,
@@ -641,6 +716,36 @@ func (c *retryableClient) RecordWorkflowTaskStarted(
 	return resp, err
 }
 
+func (c *retryableClient) RedactWorkflowExecution(
+	ctx context.Context,
+	request *historyservice.RedactWorkflowExecutionRequest,
+	opts ...grpc.CallOption,
+) (*historyservice.RedactWorkflowExecutionResponse, error) {
+	var resp *historyservice.RedactWorkflowExecutionResponse
+	op := func(ctx context.Context) error {
+		var err error
+		resp, err = c.client.RedactWorkflowExecution(ctx, request, opts...)
+		return err
+	}
+	err := backoff.ThrottleRetryContext(ctx, op, c.policy, c.isRetryable)
+	return resp, err
+}
+
+func (c *retryableClient) ReencryptWorkflowExecution(
+	ctx context.Context,
+	request *historyservice.ReencryptWorkflowExecutionRequest,
+	opts ...grpc.CallOption,
+) (*historyservice.ReencryptWorkflowExecutionResponse, error) {
+	var resp *historyservice.ReencryptWorkflowExecutionResponse
+	op := func(ctx context.Context) error {
+		var err error
+		resp, err = c.client.ReencryptWorkflowExecution(ctx, request, opts...)
+		return err
+	}
+	err := backoff.ThrottleRetryContext(ctx, op, c.policy, c.isRetryable)
+	return resp, err
+}
+
 func (c *retryableClient) RefreshWorkflowTasks(
 	ctx context.Context,
 	request *historyservice.RefreshWorkflowTasksRequest,
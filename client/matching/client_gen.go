@@ -113,6 +113,23 @@ func (c *clientImpl) DeleteNexusEndpoint(
 	return client.DeleteNexusEndpoint(ctx, request, opts...)
 }
 
+func (c *clientImpl) DeleteTaskQueueBacklogTasks(
+	ctx context.Context,
+	request *matchingservice.DeleteTaskQueueBacklogTasksRequest,
+	opts ...grpc.CallOption,
+) (*matchingservice.DeleteTaskQueueBacklogTasksResponse, error) {
+
+	p := tqid.PartitionFromPartitionProto(request.GetTaskQueuePartition(), request.GetNamespaceId())
+
+	client, err := c.getClientForTaskQueuePartition(p)
+	if err != nil {
+		return nil, err
+	}
+	ctx, cancel := c.createContext(ctx)
+	defer cancel()
+	return client.DeleteTaskQueueBacklogTasks(ctx, request, opts...)
+}
+
 func (c *clientImpl) DescribeTaskQueue(
 	ctx context.Context,
 	request *matchingservice.DescribeTaskQueueRequest,
@@ -364,6 +381,23 @@ func (c *clientImpl) ListNexusEndpoints(
 	return client.ListNexusEndpoints(ctx, request, opts...)
 }
 
+func (c *clientImpl) ListTaskQueueBacklogTasks(
+	ctx context.Context,
+	request *matchingservice.ListTaskQueueBacklogTasksRequest,
+	opts ...grpc.CallOption,
+) (*matchingservice.ListTaskQueueBacklogTasksResponse, error) {
+
+	p := tqid.PartitionFromPartitionProto(request.GetTaskQueuePartition(), request.GetNamespaceId())
+
+	client, err := c.getClientForTaskQueuePartition(p)
+	if err != nil {
+		return nil, err
+	}
+	ctx, cancel := c.createContext(ctx)
+	defer cancel()
+	return client.ListTaskQueueBacklogTasks(ctx, request, opts...)
+}
+
 func (c *clientImpl) ListTaskQueuePartitions(
 	ctx context.Context,
 	request *matchingservice.ListTaskQueuePartitionsRequest,
@@ -404,6 +438,23 @@ func (c *clientImpl) ListWorkers(
 	return client.ListWorkers(ctx, request, opts...)
 }
 
+func (c *clientImpl) MoveTaskQueueBacklogTasks(
+	ctx context.Context,
+	request *matchingservice.MoveTaskQueueBacklogTasksRequest,
+	opts ...grpc.CallOption,
+) (*matchingservice.MoveTaskQueueBacklogTasksResponse, error) {
+
+	p := tqid.PartitionFromPartitionProto(request.GetTaskQueuePartition(), request.GetNamespaceId())
+
+	client, err := c.getClientForTaskQueuePartition(p)
+	if err != nil {
+		return nil, err
+	}
+	ctx, cancel := c.createContext(ctx)
+	defer cancel()
+	return client.MoveTaskQueueBacklogTasks(ctx, request, opts...)
+}
+
 func (c *clientImpl) PollNexusTaskQueue(
 	ctx context.Context,
 	request *matchingservice.PollNexusTaskQueueRequest,
@@ -584,6 +635,26 @@ func (c *clientImpl) UpdateTaskQueueConfig(
 	return client.UpdateTaskQueueConfig(ctx, request, opts...)
 }
 
+func (c *clientImpl) UpdateTaskQueueDispatchPause(
+	ctx context.Context,
+	request *matchingservice.UpdateTaskQueueDispatchPauseRequest,
+	opts ...grpc.CallOption,
+) (*matchingservice.UpdateTaskQueueDispatchPauseResponse, error) {
+
+	p, err := tqid.NormalPartitionFromRpcName(request.GetTaskQueue(), request.GetNamespaceId(), enumspb.TASK_QUEUE_TYPE_WORKFLOW)
+	if err != nil {
+		return nil, err
+	}
+
+	client, err := c.getClientForTaskQueuePartition(p)
+	if err != nil {
+		return nil, err
+	}
+	ctx, cancel := c.createContext(ctx)
+	defer cancel()
+	return client.UpdateTaskQueueDispatchPause(ctx, request, opts...)
+}
+
 func (c *clientImpl) UpdateTaskQueueUserData(
 	ctx context.Context,
 	request *matchingservice.UpdateTaskQueueUserDataRequest,
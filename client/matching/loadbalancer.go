@@ -4,6 +4,7 @@ import (
 	"math/rand"
 	"sync"
 
+	taskqueuespb "go.temporal.io/server/api/taskqueue/v1"
 	"go.temporal.io/server/common/dynamicconfig"
 	"go.temporal.io/server/common/namespace"
 	"go.temporal.io/server/common/testing/testhooks"
@@ -31,6 +32,14 @@ type (
 		PickReadPartition(
 			taskQueue *tqid.TaskQueue,
 		) *pollToken
+
+		// UpdatePartitionCounts records the partition counts reported by matching
+		// for a task queue whose partitions are scaled automatically. They take
+		// precedence over dynamic config until cleared by passing nil.
+		UpdatePartitionCounts(
+			taskQueue *tqid.TaskQueue,
+			counts *taskqueuespb.TaskQueuePartitionCounts,
+		)
 	}
 
 	defaultLoadBalancer struct {
@@ -48,6 +57,8 @@ type (
 		taskQueue    *tqid.TaskQueue
 		pollerCounts []int // keep track of poller count of each partition
 		lock         sync.Mutex
+		// partition counts learned from matching, nil unless partitions are auto-scaled
+		scaledCounts *taskqueuespb.TaskQueuePartitionCounts
 	}
 
 	pollToken struct {
@@ -85,7 +96,13 @@ func (lb *defaultLoadBalancer) PickWritePartition(
 		return taskQueue.RootPartition()
 	}
 
-	n := max(1, lb.nWritePartitions(nsName.String(), taskQueue.Name(), taskQueue.TaskType()))
+	n := lb.nWritePartitions(nsName.String(), taskQueue.Name(), taskQueue.TaskType())
+	if tqlb, ok := lb.lookupTaskQueueLoadBalancer(taskQueue); ok {
+		if counts := tqlb.getScaledCounts(); counts != nil {
+			n = int(counts.GetWritePartitions())
+		}
+	}
+	n = max(1, n)
 	return taskQueue.NormalPartition(rand.Intn(n))
 }
 
@@ -104,6 +121,9 @@ func (lb *defaultLoadBalancer) PickReadPartition(
 	if err == nil {
 		partitionCount = lb.nReadPartitions(string(namespaceName), taskQueue.Name(), taskQueue.TaskType())
 	}
+	if counts := tqlb.getScaledCounts(); counts != nil {
+		partitionCount = max(1, int(counts.GetReadPartitions()))
+	}
 
 	if n, ok := testhooks.Get[int](lb.testHooks, testhooks.MatchingLBForceWritePartition); ok {
 		return tqlb.forceReadPartition(partitionCount, n)
@@ -112,16 +132,38 @@ func (lb *defaultLoadBalancer) PickReadPartition(
 	return tqlb.pickReadPartition(partitionCount)
 }
 
-func (lb *defaultLoadBalancer) getTaskQueueLoadBalancer(tq *tqid.TaskQueue) *tqLoadBalancer {
+func (lb *defaultLoadBalancer) UpdatePartitionCounts(
+	taskQueue *tqid.TaskQueue,
+	counts *taskqueuespb.TaskQueuePartitionCounts,
+) {
+	var tqlb *tqLoadBalancer
+	if counts != nil {
+		tqlb = lb.getTaskQueueLoadBalancer(taskQueue)
+	} else if existing, ok := lb.lookupTaskQueueLoadBalancer(taskQueue); ok {
+		tqlb = existing
+	} else {
+		// most task queues are not auto-scaled, don't keep an entry for every task queue that is written to
+		return
+	}
+	tqlb.lock.Lock()
+	defer tqlb.lock.Unlock()
+	tqlb.scaledCounts = counts
+}
+
+func (lb *defaultLoadBalancer) lookupTaskQueueLoadBalancer(tq *tqid.TaskQueue) (*tqLoadBalancer, bool) {
 	lb.lock.RLock()
+	defer lb.lock.RUnlock()
 	tqlb, ok := lb.taskQueueLBs[*tq]
-	lb.lock.RUnlock()
-	if ok {
+	return tqlb, ok
+}
+
+func (lb *defaultLoadBalancer) getTaskQueueLoadBalancer(tq *tqid.TaskQueue) *tqLoadBalancer {
+	if tqlb, ok := lb.lookupTaskQueueLoadBalancer(tq); ok {
 		return tqlb
 	}
 
 	lb.lock.Lock()
-	tqlb, ok = lb.taskQueueLBs[*tq]
+	tqlb, ok := lb.taskQueueLBs[*tq]
 	if !ok {
 		tqlb = newTaskQueueLoadBalancer(tq)
 		lb.taskQueueLBs[*tq] = tqlb
@@ -136,6 +178,12 @@ func newTaskQueueLoadBalancer(tq *tqid.TaskQueue) *tqLoadBalancer {
 	}
 }
 
+func (b *tqLoadBalancer) getScaledCounts() *taskqueuespb.TaskQueuePartitionCounts {
+	b.lock.Lock()
+	defer b.lock.Unlock()
+	return b.scaledCounts
+}
+
 func (b *tqLoadBalancer) pickReadPartition(partitionCount int) *pollToken {
 	b.lock.Lock()
 	defer b.lock.Unlock()
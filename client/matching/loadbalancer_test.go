@@ -6,6 +6,9 @@ import (
 
 	"github.com/stretchr/testify/assert"
 	enumspb "go.temporal.io/api/enums/v1"
+	taskqueuespb "go.temporal.io/server/api/taskqueue/v1"
+	"go.temporal.io/server/common/dynamicconfig"
+	"go.temporal.io/server/common/namespace"
 	"go.temporal.io/server/common/tqid"
 )
 
@@ -163,3 +166,41 @@ func maxPollerCount(tqlb *tqLoadBalancer) int {
 	}
 	return res
 }
+
+func TestLoadBalancerScaledPartitionCounts(t *testing.T) {
+	lb := &defaultLoadBalancer{
+		namespaceIDToName: func(id namespace.ID) (namespace.Name, error) {
+			return "fake-namespace", nil
+		},
+		nReadPartitions:  dynamicconfig.GetIntPropertyFnFilteredByTaskQueue(8),
+		nWritePartitions: dynamicconfig.GetIntPropertyFnFilteredByTaskQueue(8),
+		taskQueueLBs:     make(map[tqid.TaskQueue]*tqLoadBalancer),
+	}
+
+	f, err := tqid.NewTaskQueueFamily("fake-namespace-id", "fake-taskqueue")
+	assert.NoError(t, err)
+	taskQueue := f.TaskQueue(enumspb.TASK_QUEUE_TYPE_WORKFLOW)
+
+	lb.UpdatePartitionCounts(taskQueue, &taskqueuespb.TaskQueuePartitionCounts{ReadPartitions: 3, WritePartitions: 2})
+	for range 100 {
+		assert.Less(t, lb.PickWritePartition(taskQueue).PartitionId(), 2)
+		token := lb.PickReadPartition(taskQueue)
+		assert.Less(t, token.TQPartition.PartitionId(), 3)
+		token.Release()
+	}
+
+	// other task queues are not affected, and writing to them or clearing their counts doesn't add an entry
+	other := f.TaskQueue(enumspb.TASK_QUEUE_TYPE_ACTIVITY)
+	lb.PickWritePartition(other)
+	lb.UpdatePartitionCounts(other, nil)
+	_, ok := lb.lookupTaskQueueLoadBalancer(other)
+	assert.False(t, ok)
+
+	// clearing the counts falls back to dynamic config
+	lb.UpdatePartitionCounts(taskQueue, nil)
+	seen := make(map[int]bool)
+	for range 1000 {
+		seen[lb.PickWritePartition(taskQueue).PartitionId()] = true
+	}
+	assert.Len(t, seen, 8)
+}
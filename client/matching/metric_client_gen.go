@@ -79,6 +79,20 @@ func (c *metricClient) DeleteNexusEndpoint(
 	return c.client.DeleteNexusEndpoint(ctx, request, opts...)
 }
 
+func (c *metricClient) DeleteTaskQueueBacklogTasks(
+	ctx context.Context,
+	request *matchingservice.DeleteTaskQueueBacklogTasksRequest,
+	opts ...grpc.CallOption,
+) (_ *matchingservice.DeleteTaskQueueBacklogTasksResponse, retError error) {
+
+	metricsHandler, startTime := c.startMetricsRecording(ctx, "MatchingClientDeleteTaskQueueBacklogTasks")
+	defer func() {
+		c.finishMetricsRecording(metricsHandler, startTime, retError)
+	}()
+
+	return c.client.DeleteTaskQueueBacklogTasks(ctx, request, opts...)
+}
+
 func (c *metricClient) DescribeTaskQueue(
 	ctx context.Context,
 	request *matchingservice.DescribeTaskQueueRequest,
@@ -261,6 +275,20 @@ func (c *metricClient) ListNexusEndpoints(
 	return c.client.ListNexusEndpoints(ctx, request, opts...)
 }
 
+func (c *metricClient) ListTaskQueueBacklogTasks(
+	ctx context.Context,
+	request *matchingservice.ListTaskQueueBacklogTasksRequest,
+	opts ...grpc.CallOption,
+) (_ *matchingservice.ListTaskQueueBacklogTasksResponse, retError error) {
+
+	metricsHandler, startTime := c.startMetricsRecording(ctx, "MatchingClientListTaskQueueBacklogTasks")
+	defer func() {
+		c.finishMetricsRecording(metricsHandler, startTime, retError)
+	}()
+
+	return c.client.ListTaskQueueBacklogTasks(ctx, request, opts...)
+}
+
 func (c *metricClient) ListTaskQueuePartitions(
 	ctx context.Context,
 	request *matchingservice.ListTaskQueuePartitionsRequest,
@@ -289,6 +317,20 @@ func (c *metricClient) ListWorkers(
 	return c.client.ListWorkers(ctx, request, opts...)
 }
 
+func (c *metricClient) MoveTaskQueueBacklogTasks(
+	ctx context.Context,
+	request *matchingservice.MoveTaskQueueBacklogTasksRequest,
+	opts ...grpc.CallOption,
+) (_ *matchingservice.MoveTaskQueueBacklogTasksResponse, retError error) {
+
+	metricsHandler, startTime := c.startMetricsRecording(ctx, "MatchingClientMoveTaskQueueBacklogTasks")
+	defer func() {
+		c.finishMetricsRecording(metricsHandler, startTime, retError)
+	}()
+
+	return c.client.MoveTaskQueueBacklogTasks(ctx, request, opts...)
+}
+
 func (c *metricClient) PollNexusTaskQueue(
 	ctx context.Context,
 	request *matchingservice.PollNexusTaskQueueRequest,
@@ -415,6 +457,20 @@ func (c *metricClient) UpdateTaskQueueConfig(
 	return c.client.UpdateTaskQueueConfig(ctx, request, opts...)
 }
 
+func (c *metricClient) UpdateTaskQueueDispatchPause(
+	ctx context.Context,
+	request *matchingservice.UpdateTaskQueueDispatchPauseRequest,
+	opts ...grpc.CallOption,
+) (_ *matchingservice.UpdateTaskQueueDispatchPauseResponse, retError error) {
+
+	metricsHandler, startTime := c.startMetricsRecording(ctx, "MatchingClientUpdateTaskQueueDispatchPause")
+	defer func() {
+		c.finishMetricsRecording(metricsHandler, startTime, retError)
+	}()
+
+	return c.client.UpdateTaskQueueDispatchPause(ctx, request, opts...)
+}
+
 func (c *metricClient) UpdateTaskQueueUserData(
 	ctx context.Context,
 	request *matchingservice.UpdateTaskQueueUserDataRequest,
@@ -116,6 +116,21 @@ func (c *retryableClient) DeleteNexusEndpoint(
 	return resp, err
 }
 
+func (c *retryableClient) DeleteTaskQueueBacklogTasks(
+	ctx context.Context,
+	request *matchingservice.DeleteTaskQueueBacklogTasksRequest,
+	opts ...grpc.CallOption,
+) (*matchingservice.DeleteTaskQueueBacklogTasksResponse, error) {
+	var resp *matchingservice.DeleteTaskQueueBacklogTasksResponse
+	op := func(ctx context.Context) error {
+		var err error
+		resp, err = c.client.DeleteTaskQueueBacklogTasks(ctx, request, opts...)
+		return err
+	}
+	err := backoff.ThrottleRetryContext(ctx, op, c.policy, c.isRetryable)
+	return resp, err
+}
+
 func (c *retryableClient) DescribeTaskQueue(
 	ctx context.Context,
 	request *matchingservice.DescribeTaskQueueRequest,
@@ -311,6 +326,21 @@ func (c *retryableClient) ListNexusEndpoints(
 	return resp, err
 }
 
+func (c *retryableClient) ListTaskQueueBacklogTasks(
+	ctx context.Context,
+	request *matchingservice.ListTaskQueueBacklogTasksRequest,
+	opts ...grpc.CallOption,
+) (*matchingservice.ListTaskQueueBacklogTasksResponse, error) {
+	var resp *matchingservice.ListTaskQueueBacklogTasksResponse
+	op := func(ctx context.Context) error {
+		var err error
+		resp, err = c.client.ListTaskQueueBacklogTasks(ctx, request, opts...)
+		return err
+	}
+	err := backoff.ThrottleRetryContext(ctx, op, c.policy, c.isRetryable)
+	return resp, err
+}
+
 func (c *retryableClient) ListTaskQueuePartitions(
 	ctx context.Context,
 	request *matchingservice.ListTaskQueuePartitionsRequest,
@@ -341,6 +371,21 @@ func (c *retryableClient) ListWorkers(
 	return resp, err
 }
 
+func (c *retryableClient) MoveTaskQueueBacklogTasks(
+	ctx context.Context,
+	request *matchingservice.MoveTaskQueueBacklogTasksRequest,
+	opts ...grpc.CallOption,
+) (*matchingservice.MoveTaskQueueBacklogTasksResponse, error) {
+	var resp *matchingservice.MoveTaskQueueBacklogTasksResponse
+	op := func(ctx context.Context) error {
+		var err error
+		resp, err = c.client.MoveTaskQueueBacklogTasks(ctx, request, opts...)
+		return err
+	}
+	err := backoff.ThrottleRetryContext(ctx, op, c.policy, c.isRetryable)
+	return resp, err
+}
+
 func (c *retryableClient) PollActivityTaskQueue(
 	ctx context.Context,
 	request *matchingservice.PollActivityTaskQueueRequest,
@@ -521,6 +566,21 @@ func (c *retryableClient) UpdateTaskQueueConfig(
 	return resp, err
 }
 
+func (c *retryableClient) UpdateTaskQueueDispatchPause(
+	ctx context.Context,
+	request *matchingservice.UpdateTaskQueueDispatchPauseRequest,
+	opts ...grpc.CallOption,
+) (*matchingservice.UpdateTaskQueueDispatchPauseResponse, error) {
+	var resp *matchingservice.UpdateTaskQueueDispatchPauseResponse
+	op := func(ctx context.Context) error {
+		var err error
+		resp, err = c.client.UpdateTaskQueueDispatchPause(ctx, request, opts...)
+		return err
+	}
+	err := backoff.ThrottleRetryContext(ctx, op, c.policy, c.isRetryable)
+	return resp, err
+}
+
 func (c *retryableClient) UpdateTaskQueueUserData(
 	ctx context.Context,
 	request *matchingservice.UpdateTaskQueueUserDataRequest,
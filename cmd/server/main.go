@@ -18,9 +18,10 @@ import (
 	"go.temporal.io/server/common/headers"
 	"go.temporal.io/server/common/log"
 	"go.temporal.io/server/common/log/tag"
-	_ "go.temporal.io/server/common/persistence/sql/sqlplugin/mysql"      // needed to load mysql plugin
-	_ "go.temporal.io/server/common/persistence/sql/sqlplugin/postgresql" // needed to load postgresql plugin
-	_ "go.temporal.io/server/common/persistence/sql/sqlplugin/sqlite"     // needed to load sqlite plugin
+	_ "go.temporal.io/server/common/persistence/sql/sqlplugin/cockroachdb" // needed to load cockroachdb plugin
+	_ "go.temporal.io/server/common/persistence/sql/sqlplugin/mysql"       // needed to load mysql plugin
+	_ "go.temporal.io/server/common/persistence/sql/sqlplugin/postgresql"  // needed to load postgresql plugin
+	_ "go.temporal.io/server/common/persistence/sql/sqlplugin/sqlite"      // needed to load sqlite plugin
 	"go.temporal.io/server/temporal"
 	"go.uber.org/automaxprocs/maxprocs"
 )
@@ -163,7 +163,7 @@ func (h *historyArchiver) Archive(ctx context.Context, URI archiver.URI, request
 		}
 
 		filename := constructHistoryFilenameMultipart(request.NamespaceID, request.WorkflowID, request.RunID, request.CloseFailoverVersion, part)
-		if exist, _ := h.gcloudStorage.Exist(ctx, URI, filename); !exist {
+		if exist, _ := h.gcloudStorage.Exist(ctx, URI, filename); !exist || featureCatalog.Overwrite {
 			if err := h.gcloudStorage.Upload(ctx, URI, filename, encodedHistoryPart); err != nil {
 				logger.Error(archiver.ArchiveTransientErrorMsg, tag.ArchivalArchiveFailReason(errWriteFile), tag.Error(err))
 				metrics.HistoryArchiverArchiveTransientErrorCount.With(handler).Record(1)
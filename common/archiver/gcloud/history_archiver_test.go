@@ -395,6 +395,53 @@ func (h *historyArchiverSuite) TestArchive_Success() {
 	h.NoError(err)
 }
 
+func (h *historyArchiverSuite) TestArchive_Overwrite() {
+
+	ctx := context.Background()
+
+	storageWrapper := connector.NewMockClient(h.controller)
+	storageWrapper.EXPECT().Exist(ctx, h.testArchivalURI, gomock.Any()).Return(true, nil).Times(2)
+	storageWrapper.EXPECT().Upload(ctx, h.testArchivalURI, gomock.Any(), gomock.Any()).Return(nil)
+
+	historyIterator := archiver.NewMockHistoryIterator(h.controller)
+	historyBlob := &archiverspb.HistoryBlob{
+		Header: &archiverspb.HistoryBlobHeader{
+			IsLast: true,
+		},
+		Body: []*historypb.History{
+			{
+				Events: []*historypb.HistoryEvent{
+					{
+						EventId:   testNextEventID - 1,
+						EventTime: timestamppb.New(time.Now().UTC()),
+						Version:   testCloseFailoverVersion,
+					},
+				},
+			},
+		},
+	}
+	gomock.InOrder(
+		historyIterator.EXPECT().HasNext().Return(true),
+		historyIterator.EXPECT().Next(gomock.Any()).Return(historyBlob, nil),
+		historyIterator.EXPECT().HasNext().Return(false),
+	)
+
+	historyArchiver := newHistoryArchiver(h.executionManager, h.logger, h.metricsHandler, historyIterator, storageWrapper)
+
+	request := &archiver.ArchiveHistoryRequest{
+		NamespaceID:          testNamespaceID,
+		Namespace:            testNamespace,
+		WorkflowID:           testWorkflowID,
+		RunID:                testRunID,
+		BranchToken:          testBranchToken,
+		NextEventID:          testNextEventID,
+		CloseFailoverVersion: testCloseFailoverVersion,
+	}
+
+	err := historyArchiver.Archive(ctx, h.testArchivalURI, request, archiver.GetOverwriteArchiveOption())
+	h.NoError(err)
+}
+
 func (h *historyArchiverSuite) TestGet_Fail_InvalidURI() {
 	ctx := context.Background()
 	mockStorageClient := connector.NewMockGcloudStorageClient(h.controller)
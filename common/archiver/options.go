@@ -17,6 +17,8 @@ type (
 	ArchiveFeatureCatalog struct {
 		ProgressManager   ProgressManager
 		NonRetryableError NonRetryableError
+		// Overwrite is set if previously archived data must be replaced rather than kept.
+		Overwrite bool
 	}
 
 	// NonRetryableError returns an error indicating archiver has encountered an non-retryable error
@@ -75,3 +77,11 @@ func GetNonRetryableErrorOption(nonRetryableErr error) ArchiveOption {
 		}
 	}
 }
+
+// GetOverwriteArchiveOption returns an ArchiveOption so that archiver replaces data archived by an
+// earlier attempt, e.g. after the history of the workflow was redacted.
+func GetOverwriteArchiveOption() ArchiveOption {
+	return func(catalog *ArchiveFeatureCatalog) {
+		catalog.Overwrite = true
+	}
+}
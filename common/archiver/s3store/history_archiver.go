@@ -189,7 +189,7 @@ func (h *historyArchiver) Archive(
 			return err
 		}
 		blobSize := int64(binary.Size(encodedHistoryBlob))
-		if exists {
+		if exists && !featureCatalog.Overwrite {
 			metrics.HistoryArchiverBlobExistsCount.With(handler).Record(1)
 		} else {
 			if err := Upload(ctx, h.s3cli, URI, key, encodedHistoryBlob); err != nil {
@@ -266,6 +266,33 @@ type (
 		DataStores map[string]DataStore `yaml:"datastores"`
 		// TransactionSizeLimit is the largest allowed transaction size
 		TransactionSizeLimit dynamicconfig.IntPropertyFn `yaml:"-" json:"-"`
+		// HistoryBlobStore is the object store large history node blobs are offloaded to, offloading is disabled if not set
+		HistoryBlobStore *HistoryBlobStore `yaml:"historyBlobStore"`
+		// HistoryBlobOffloadThreshold is the history node blob size in bytes above which blobs are offloaded
+		HistoryBlobOffloadThreshold dynamicconfig.IntPropertyFn `yaml:"-" json:"-"`
+		// Encryption configures encryption at rest of history and mutable state blobs, disabled if not set
+		Encryption *PersistenceEncryption `yaml:"encryption"`
+	}
+
+	// PersistenceEncryption is the configuration for encryption at rest
+	PersistenceEncryption struct {
+		// KeyringFile is the path of a local keyring file holding the key encryption keys
+		// and the active key of each namespace
+		KeyringFile string `yaml:"keyringFile"`
+	}
+
+	// HistoryBlobStore is the configuration for the object store used to offload large history node blobs
+	HistoryBlobStore struct {
+		// Filestore stores the blobs on the local (or a network mounted) file system
+		Filestore *FilestoreHistoryBlobStore `yaml:"filestore"`
+	}
+
+	// FilestoreHistoryBlobStore is the configuration for a file system backed history blob store
+	FilestoreHistoryBlobStore struct {
+		// Path is the directory blobs are stored in
+		Path     string `yaml:"path"`
+		FileMode string `yaml:"fileMode"`
+		DirMode  string `yaml:"dirMode"`
 	}
 
 	// DataStore is the configuration for a single datastore
@@ -428,6 +455,24 @@ type (
 		TaskScanPartitions int `yaml:"taskScanPartitions"`
 		// TLS is the configuration for TLS connections
 		TLS *auth.TLS `yaml:"tls"`
+		// ReadReplicas are the read replicas of the database. Reads which tolerate staleness, e.g.
+		// visibility queries, are served by the replicas while their replication lag is acceptable.
+		ReadReplicas *SQLReadReplicas `yaml:"readReplicas"`
+	}
+
+	// SQLReadReplicas is the configuration for the read replicas of a SQL backed datastore. All
+	// other connection settings, e.g. credentials and TLS, are shared with the primary.
+	SQLReadReplicas struct {
+		// ConnectAddrs are the remote addrs of the read replicas
+		ConnectAddrs []string `yaml:"connectAddrs"`
+		// MaxConns the max number of connections to each replica, defaults to the MaxConns of the primary
+		MaxConns int `yaml:"maxConns"`
+		// MaxIdleConns the max number of idle connections to each replica, defaults to the MaxIdleConns of the primary
+		MaxIdleConns int `yaml:"maxIdleConns"`
+		// MaxReplicationLag is the replication lag above which reads fall back to the primary
+		MaxReplicationLag time.Duration `yaml:"maxReplicationLag"`
+		// LagCheckInterval is the interval at which the replication lag of the replicas is checked
+		LagCheckInterval time.Duration `yaml:"lagCheckInterval"`
 	}
 
 	// CustomDatastoreConfig is the configuration for connecting to a custom datastore that is not supported by temporal core
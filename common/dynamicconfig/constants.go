@@ -7,6 +7,7 @@ import (
 
 	enumspb "go.temporal.io/api/enums/v1"
 	sdkworker "go.temporal.io/sdk/worker"
+	"go.temporal.io/server/common"
 	"go.temporal.io/server/common/debug"
 	"go.temporal.io/server/common/primitives"
 	"go.temporal.io/server/common/retrypolicy"
@@ -139,6 +140,12 @@ for signal / start / signal with start API if namespace is not active`,
 		primitives.DefaultTransactionSizeLimit,
 		`TransactionSizeLimit is the largest allowed transaction size to persistence`,
 	)
+	HistoryBlobOffloadThreshold = NewGlobalIntSetting(
+		"system.historyBlobOffloadThreshold",
+		0,
+		`HistoryBlobOffloadThreshold is the size in bytes above which history node blobs are offloaded to the
+history blob store configured in persistence.historyBlobStore. Zero or a negative value disables offloading.`,
+	)
 	DisallowQuery = NewNamespaceBoolSetting(
 		"system.disallowQuery",
 		false,
@@ -379,6 +386,14 @@ If exceeded, failure will be truncated before being stored in mutable state.`,
 		4*1024,
 		`HistoryCountSuggestContinueAsNew is the workflow execution history event count limit to
 suggest continue-as-new (in workflow task started event)`,
+	)
+	HistoryBudgets = NewNamespaceTypedSetting(
+		"limit.historyBudgets",
+		[]HistoryBudget(nil),
+		`HistoryBudgets are per workflow type history growth budgets of a namespace. As a workflow execution
+consumes its budget, it is marked via a search attribute, asked to continue-as-new and has new signals rejected,
+at the configured fractions of the budget. Budgets are enforced in addition to the limit.historySize and
+limit.historyCount limits, which still terminate workflows at the hard limit.`,
 	)
 	HistoryMaxPageSize = NewNamespaceIntSetting(
 		"limit.historyMaxPageSize",
@@ -588,6 +603,11 @@ See DynamicRateLimitingParams comments for more details.`,
 		1000,
 		`FrontendVisibilityMaxPageSize is default max size for ListWorkflowExecutions in one page`,
 	)
+	FrontendVisibilityAggregationMaxGroups = NewNamespaceIntSetting(
+		"frontend.visibilityAggregationMaxGroups",
+		1000,
+		`FrontendVisibilityAggregationMaxGroups is the max number of groups returned by AggregateWorkflowExecutions`,
+	)
 	FrontendHistoryMaxPageSize = NewNamespaceIntSetting(
 		"frontend.historyMaxPageSize",
 		primitives.GetHistoryMaxPageSize,
@@ -1062,6 +1082,13 @@ See DynamicRateLimitingParams comments for more details.`,
 		100,
 		`Reload a batch of tasks when there are this many remaining. Must be less than MatchingGetTasksBatchSize. (Requires new matcher.)`,
 	)
+	MatchingMaxUnroutableLoadedTasks = NewTaskQueueIntSetting(
+		"matching.maxUnroutableLoadedTasks",
+		1000,
+		`Maximum number of loaded backlog tasks whose worker selector no recent poller satisfies that are not counted
+toward matching.getTasksReloadAt, so that they don't keep other tasks from being loaded. Worker selectors are only
+honored by the new matcher.`,
+	)
 	MatchingLongPollExpirationInterval = NewTaskQueueDurationSetting(
 		"matching.longPollExpirationInterval",
 		time.Minute,
@@ -1136,6 +1163,44 @@ Note: this should be greater than matching.longPollExpirationInterval and matchi
 		defaultNumTaskQueuePartitions,
 		`MatchingNumTaskqueueReadPartitions is the number of read partitions for a task queue`,
 	)
+	MatchingEnablePartitionAutoScaling = NewTaskQueueBoolSetting(
+		"matching.enablePartitionAutoScaling",
+		false,
+		`MatchingEnablePartitionAutoScaling enables automatic scaling of the number of partitions of a task queue based
+on its load. Partition counts are scaled between MatchingPartitionAutoScalingMinPartitions and the configured read and
+write partition counts, which become the maximum.`,
+	)
+	MatchingPartitionAutoScalingMinPartitions = NewTaskQueueIntSetting(
+		"matching.partitionAutoScalingMinPartitions",
+		1,
+		`MatchingPartitionAutoScalingMinPartitions is the minimum number of partitions that partition auto-scaling
+scales a task queue down to`,
+	)
+	MatchingPartitionAutoScalingTargetRate = NewTaskQueueFloatSetting(
+		"matching.partitionAutoScalingTargetRate",
+		500,
+		`MatchingPartitionAutoScalingTargetRate is the rate of added or dispatched tasks per second that a single
+partition is expected to handle. Partition auto-scaling adds partitions when the load of a task queue exceeds this rate
+times its partition count.`,
+	)
+	MatchingPartitionAutoScalingMaxBacklogPerPartition = NewTaskQueueIntSetting(
+		"matching.partitionAutoScalingMaxBacklogPerPartition",
+		1000,
+		`MatchingPartitionAutoScalingMaxBacklogPerPartition is the backlog size per partition above which partition
+auto-scaling does not remove partitions from a task queue`,
+	)
+	MatchingPartitionAutoScalingInterval = NewTaskQueueDurationSetting(
+		"matching.partitionAutoScalingInterval",
+		time.Minute,
+		`MatchingPartitionAutoScalingInterval is the interval at which partition auto-scaling evaluates the load of a
+task queue, and the minimum time between two changes of its partition counts`,
+	)
+	MatchingPartitionAutoScalingScaleDownDelay = NewTaskQueueDurationSetting(
+		"matching.partitionAutoScalingScaleDownDelay",
+		10*time.Minute,
+		`MatchingPartitionAutoScalingScaleDownDelay is the minimum time since the last change of the partition counts
+of a task queue before partition auto-scaling removes partitions from it`,
+	)
 	MetricsBreakdownByTaskQueue = NewTaskQueueBoolSetting(
 		"metrics.breakdownByTaskQueue",
 		true,
@@ -1313,6 +1378,23 @@ second per poller by one physical queue manager`,
 		2000,
 		"Cache size for fairness key rate limits.",
 	)
+	MatchingWorkerRegistryPersistenceEnabled = NewGlobalBoolSetting(
+		"matching.workerRegistryPersistenceEnabled",
+		false,
+		`MatchingWorkerRegistryPersistenceEnabled persists worker heartbeats so that ListWorkers and DescribeWorker
+include the heartbeats received by every matching host and survive restarts.`,
+	)
+	MatchingWorkerRegistryFlushInterval = NewGlobalDurationSetting(
+		"matching.workerRegistryFlushInterval",
+		10*time.Second,
+		`MatchingWorkerRegistryFlushInterval is how often a matching host persists the worker heartbeats it received.`,
+	)
+	MatchingWorkerRegistryRefreshInterval = NewGlobalDurationSetting(
+		"matching.workerRegistryRefreshInterval",
+		10*time.Second,
+		`MatchingWorkerRegistryRefreshInterval is the minimum time between two reads of the persisted worker heartbeats
+of a namespace when serving ListWorkers and DescribeWorker.`,
+	)
 
 	// keys for history
 
@@ -1465,6 +1547,18 @@ This can help reduce effects of shard movement.`,
 		false,
 		`EnableHostLevelEventsCache controls if the events cache is host level`,
 	)
+	EventsCacheDiskTierPath = NewGlobalStringSetting(
+		"history.eventsCacheDiskTierPath",
+		"",
+		`EventsCacheDiskTierPath is the local directory of the second tier of the host level events cache. Events evicted
+from memory are spilled to this directory and read from it before falling back to persistence. An empty value disables the
+disk tier. Only used when EnableHostLevelEventsCache is true, changes require service restart.`,
+	)
+	EventsCacheDiskTierMaxSizeBytes = NewGlobalIntSetting(
+		"history.eventsCacheDiskTierMaxSizeBytes",
+		1024*1024*1024,
+		`EventsCacheDiskTierMaxSizeBytes is max size of the disk tier of the host level events cache in bytes`,
+	)
 	AcquireShardInterval = NewGlobalDurationSetting(
 		"history.acquireShardInterval",
 		time.Minute,
@@ -1488,6 +1582,32 @@ checks while a shard is lingering.`,
 will temporarily delay closing shards after a membership update, awaiting a
 shard ownership lost error from persistence. If set to zero, shards will not delay closing.
 Do NOT use non-zero value with persistence layers that are missing AssertShardOwnership support.`,
+	)
+	ShardPlacementMode = NewGlobalStringSetting(
+		"history.shardPlacementMode",
+		"ring",
+		`ShardPlacementMode controls how history shards are placed on history hosts. Valid values are:
+- "ring": shards are owned by their membership hash ring owner.
+- "manual": like "ring", but operators can move individual shards to other hosts using the
+  MoveHistoryShard admin API. Moves are not durable, a moved shard returns to its hash ring
+  owner when the host it was moved to leaves the cluster.
+- "loadAware": like "manual", and additionally under-loaded hosts take over hot shards from
+  over-loaded hosts, based on the shard load published by every host.
+All history hosts should use the same value.`,
+	)
+	ShardLoadReportInterval = NewGlobalDurationSetting(
+		"history.shardLoadReportInterval",
+		30*time.Second,
+		`ShardLoadReportInterval is the interval at which the shard controller computes the load of its
+shards. In "loadAware" shard placement mode it's also the interval at which hosts publish their load
+and rebalance shards.`,
+	)
+	ShardRebalanceLoadThreshold = NewGlobalFloatSetting(
+		"history.shardRebalanceLoadThreshold",
+		1.25,
+		`ShardRebalanceLoadThreshold is the ratio of a history host's load to the average load of all
+history hosts above which the host's shards are moved to less loaded hosts in "loadAware" shard
+placement mode.`,
 	)
 	ShardFinalizerTimeout = NewGlobalDurationSetting(
 		"history.shardFinalizerTimeout",
@@ -1508,6 +1628,21 @@ to this require a restart to take effect.`,
 		30*time.Second,
 		`HistoryClientOwnershipCachingStaleTTL, if non-zero, configures the TTL
 for cached shard ownership entries after a membership update.`,
+	)
+	HistoryNamespaceShardPools = NewGlobalTypedSetting(
+		"history.namespaceShardPools",
+		map[string]common.NamespaceShardPool(nil),
+		`HistoryNamespaceShardPools assigns namespaces, keyed by namespace ID, to a dedicated contiguous range of
+history shards, e.g. {"<namespace-id>": {"FirstShardID": 1, "LastShardID": 64}}. All workflows of a pooled
+namespace are routed to shards within its pool, while namespaces without a pool keep hashing across all shards.
+Pools that are empty or exceed the number of history shards are ignored, and an empty pool routes the namespace
+across all shards. The same value must be served to all services.
+Assigning, changing or removing the pool of a namespace with existing workflows must be done as a move, which sets
+"Previous" to the pool the namespace had before ({} if none). During a move, workflows that exist on their previous
+shard are served from it. Moves are started with "Prepare": true, which keeps starting new workflows on their
+previous shard, until all hosts have picked up the move. Prepare is then removed to start new workflows in the
+pool, and Previous is removed once "tdbg history-host check-shard-pool --moving" reports that no workflows remain
+on their previous shard. Removing Previous earlier makes those workflows unreachable.`,
 	)
 	ShardIOConcurrency = NewGlobalIntSetting(
 		"history.shardIOConcurrency",
@@ -1944,6 +2079,13 @@ data (eg: search attributes) will be lost after workflow is closed.`,
 		0,
 		`VisibilityProcessorRelocateAttributesMinBlobSize is the minimum size in bytes of memo or search
 attributes.`,
+	)
+	VisibilityTextIndexing = NewNamespaceTypedSetting(
+		"history.visibilityTextIndexing",
+		VisibilityTextIndexingSettings{},
+		`VisibilityTextIndexing is the opt-in extraction of values from the workflow input and memo into a Text
+search attribute when visibility records are written. This allows searching workflow executions by
+business identifiers (e.g. an order ID) the workflow didn't upsert as search attributes.`,
 	)
 	VisibilityQueueMaxReaderCount = NewGlobalIntSetting(
 		"history.visibilityQueueMaxReaderCount",
@@ -2535,6 +2677,12 @@ See DynamicRateLimitingParams comments for more details.`,
 		30*time.Second,
 		`WorkerESProcessorAckTimeout is the timeout that store will wait to get ack signal from ES processor.
 Should be at least WorkerESProcessorFlushInterval+<time to process request>.`,
+	)
+	VisibilityBackfillRPS = NewNamespaceIntSetting(
+		"worker.visibilityBackfillRPS",
+		100,
+		`VisibilityBackfillRPS is the rate at which the visibility backfill workflow writes records of a namespace
+to the secondary visibility store. It is applied per shard backfill activity and can be changed while the backfill is running.`,
 	)
 	WorkerThrottledLogRPS = NewGlobalIntSetting(
 		"worker.throttledLogRPS",
@@ -2601,11 +2749,30 @@ Should be at least WorkerESProcessorFlushInterval+<time to process request>.`,
 		false,
 		`BuildIdScavengerEnabled indicates if the build id scavenger should be started as part of worker.Scanner`,
 	)
+	SavedQueryEvaluatorEnabled = NewGlobalBoolSetting(
+		"worker.savedQueryEvaluatorEnabled",
+		false,
+		`SavedQueryEvaluatorEnabled indicates if the saved visibility query evaluator should be started as part of worker.Scanner.
+The evaluator counts the workflow executions matching each saved query of every namespace once a minute and emits
+the count as the saved_visibility_query_count gauge.`,
+	)
+	SavedQueryEvaluatorVisibilityRPS = NewGlobalFloatSetting(
+		"worker.savedQueryEvaluatorVisibilityRPS",
+		10,
+		`SavedQueryEvaluatorVisibilityRPS is the rate limit of the count requests made by the saved visibility query evaluator`,
+	)
 	HistoryScannerEnabled = NewGlobalBoolSetting(
 		"worker.historyScannerEnabled",
 		true,
 		`HistoryScannerEnabled indicates if history scanner should be started as part of worker.Scanner`,
 	)
+	HistoryReencryptionEnabled = NewGlobalBoolSetting(
+		"worker.historyReencryptionEnabled",
+		false,
+		`HistoryReencryptionEnabled indicates if the history re-encryption job should be started as part of worker.Scanner.
+Enable it after rotating the encryption key of a namespace, the job rewrites history which isn't encrypted with
+the active key of its namespace.`,
+	)
 	ExecutionsScannerEnabled = NewGlobalBoolSetting(
 		"worker.executionsScannerEnabled",
 		false,
@@ -2737,6 +2904,11 @@ WorkerActivitiesPerSecond, MaxConcurrentActivityTaskPollers.
 		10,
 		`Maximum number of workflow rules in a given namespace`,
 	)
+	MaxSavedVisibilityQueriesPerNamespace = NewNamespaceIntSetting(
+		"frontend.maxSavedVisibilityQueriesPerNamespace",
+		50,
+		`Maximum number of saved visibility queries in a given namespace`,
+	)
 
 	SlowRequestLoggingThreshold = NewGlobalDurationSetting(
 		"rpc.slowRequestLoggingThreshold",
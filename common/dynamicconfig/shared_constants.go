@@ -117,3 +117,44 @@ var DefaultHistoryCacheBackgroundEvictSettings = CacheBackgroundEvictSettings{
 	LoopInterval:    1 * time.Minute,
 	MaxEntryPerCall: 1024,
 }
+
+// HistoryBudget is a history growth budget for the workflow executions of a namespace, with graded actions
+// that are taken as the budget is consumed. The consumed fraction of a budget is the larger of the history
+// size and history event count fractions.
+type HistoryBudget struct {
+	// WorkflowType is the workflow type the budget applies to. A budget with an empty workflow type applies
+	// to all workflow types without a budget of their own.
+	WorkflowType string
+	// MaxHistorySizeBytes is the history size budget in bytes. Zero means the size is not budgeted.
+	MaxHistorySizeBytes int64
+	// MaxHistoryCount is the history event count budget. Zero means the event count is not budgeted.
+	MaxHistoryCount int64
+	// WarnRatio is the consumed fraction at which WarningSearchAttribute is set on the workflow execution.
+	// Zero disables the action.
+	WarnRatio float64
+	// SuggestContinueAsNewRatio is the consumed fraction at which continue-as-new is suggested to the
+	// workflow in workflow task started events. Zero disables the action.
+	SuggestContinueAsNewRatio float64
+	// BlockSignalsRatio is the consumed fraction at which new signals to the workflow execution are
+	// rejected. Zero disables the action.
+	BlockSignalsRatio float64
+	// WarningSearchAttribute is the name of a Keyword custom search attribute registered in the namespace.
+	// When set, it is updated with the most severe action taken whenever a workflow task is started.
+	WarningSearchAttribute string
+}
+
+// VisibilityTextIndexingSettings configures the extraction of values from the workflow input and memo of the
+// workflow executions of a namespace into a Text search attribute, so they can be searched without the
+// workflow upserting search attributes. Only payloads the server can decode (e.g. json/plain) are indexed.
+type VisibilityTextIndexingSettings struct {
+	// SearchAttribute is the name of a Text custom search attribute registered in the namespace, which the
+	// extracted values are written to. Indexing is disabled if it's empty.
+	SearchAttribute string
+	// InputPaths are dot separated paths into the workflow input, starting with the index of the argument,
+	// e.g. "0.order.id". A "*" segment matches all elements of an array or all values of an object.
+	InputPaths []string
+	// MemoPaths are dot separated paths into the memo, starting with the memo key, e.g. "customer.name".
+	MemoPaths []string
+	// MaxLength is the maximum length in bytes of the indexed text. Zero means 4KB.
+	MaxLength int
+}
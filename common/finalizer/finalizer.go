@@ -79,6 +79,17 @@ func (f *Finalizer) Deregister(
 	return nil
 }
 
+// Count returns the number of registered callbacks. Returns zero once the finalizer is/was running.
+func (f *Finalizer) Count() int {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	if f.finalized {
+		return 0
+	}
+	return len(f.callbacks)
+}
+
 // Run executes all registered callback functions within the given timeout (zero timeout skips execution).
 // It can only be invoked once; calling it again has no effect.
 // Returns the number of completed callbacks.
@@ -38,6 +38,18 @@ func TestFinalizer(t *testing.T) {
 		})
 	})
 
+	t.Run("count", func(t *testing.T) {
+		f := newFinalizer(metrics.NoopMetricsHandler)
+		require.Zero(t, f.Count())
+		require.NoError(t, f.Register("1", nil))
+		require.NoError(t, f.Register("2", nil))
+		require.Equal(t, 2, f.Count())
+		require.NoError(t, f.Deregister("1"))
+		require.Equal(t, 1, f.Count())
+		f.Run(0)
+		require.Equal(t, 1, f.Count())
+	})
+
 	t.Run("deregister", func(t *testing.T) {
 		t.Run("succeeds", func(t *testing.T) {
 			f := newFinalizer(metrics.NoopMetricsHandler)
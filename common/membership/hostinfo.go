@@ -8,6 +8,8 @@ type HostInfo interface {
 	// GetAddress returns the socket address of the host (i.e. <ip>:<port>).
 	// This must be a valid gRPC address.
 	GetAddress() string
+	// Label returns the value of a membership label of the host.
+	Label(key string) (string, bool)
 }
 
 // NewHostInfoFromAddress creates a new HostInfo instance from a socket address.
@@ -27,3 +29,8 @@ func (a hostAddress) GetAddress() string {
 func (a hostAddress) Identity() string {
 	return string(a)
 }
+
+// Label always returns false, a hostAddress has no labels.
+func (a hostAddress) Label(string) (string, bool) {
+	return "", false
+}
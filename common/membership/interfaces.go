@@ -11,6 +11,9 @@ import (
 	"go.temporal.io/server/common/primitives"
 )
 
+// MaxLabelValueSize is the maximum size of a label value set through Monitor.SetLabel
+const MaxLabelValueSize = 4096
+
 // ErrUnknownService is thrown for a service that is not tracked by this instance
 var ErrUnknownService = errors.New("service not tracked by Monitor")
 
@@ -58,6 +61,9 @@ type (
 		WaitUntilInitialized(context.Context) error
 		// SetDraining sets the draining state (synchronized through ringpop)
 		SetDraining(draining bool) error
+		// SetLabel sets a label of this host (synchronized through ringpop). Other hosts observe
+		// the label through HostInfo.Label once the change has propagated.
+		SetLabel(key string, value string) error
 		// ApproximateMaxPropagationTime returns an approximate upper bound on propagation time
 		// for updates to membership information. This is _not_ a guarantee! This value is only
 		// provided to help with startup/shutdown timing as a best-effort.
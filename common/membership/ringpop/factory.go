@@ -102,7 +102,12 @@ func (factory *factory) getMonitor() *monitor {
 		if currentClusterMetadata.UseClusterIdMembership {
 			appName = fmt.Sprintf("temporal-%s", currentClusterMetadata.GetClusterId())
 		}
-		rp, err := ringpop.New(appName, ringpop.Channel(factory.getTChannel()), ringpop.AddressResolverFunc(factory.broadcastAddressResolver))
+		rp, err := ringpop.New(
+			appName,
+			ringpop.Channel(factory.getTChannel()),
+			ringpop.AddressResolverFunc(factory.broadcastAddressResolver),
+			ringpop.LabelLimitValueSize(membership.MaxLabelValueSize),
+		)
 		if err != nil {
 			factory.Logger.Fatal("Failed to get new ringpop", tag.Error(err))
 		}
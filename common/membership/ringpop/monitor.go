@@ -452,6 +452,19 @@ func (rpo *monitor) SetDraining(draining bool) error {
 	return labels.Set(drainingKey, strconv.FormatBool(draining))
 }
 
+func (rpo *monitor) SetLabel(key string, value string) error {
+	switch key {
+	case roleKey, portKey, drainingKey, startAtKey, stopAtKey:
+		return fmt.Errorf("label %q is reserved", key)
+	}
+	labels, err := rpo.rp.Labels()
+	if err != nil {
+		// This only happens if ringpop is not bootstrapped yet.
+		return err
+	}
+	return labels.Set(key, value)
+}
+
 func (rpo *monitor) ApproximateMaxPropagationTime() time.Duration {
 	return rpo.propagationTime
 }
@@ -4,10 +4,13 @@ import (
 	"context"
 	"time"
 
+	"go.temporal.io/api/serviceerror"
 	"go.temporal.io/server/common/membership"
 	"go.temporal.io/server/common/primitives"
 )
 
+var errLabelsNotSupported = serviceerror.NewUnimplemented("static membership does not support host labels")
+
 type (
 	staticMonitor struct {
 		hosts     map[primitives.ServiceName]Hosts
@@ -72,6 +75,10 @@ func (s *staticMonitor) SetDraining(draining bool) error {
 	return nil
 }
 
+func (s *staticMonitor) SetLabel(key string, value string) error {
+	return errLabelsNotSupported
+}
+
 func (s *staticMonitor) ApproximateMaxPropagationTime() time.Duration {
 	return 0
 }
@@ -40,6 +40,7 @@ const (
 
 	MutableStateCacheTypeTagValue                     = "mutablestate"
 	EventsCacheTypeTagValue                           = "events"
+	EventsDiskCacheTypeTagValue                       = "events_disk"
 	NexusEndpointRegistryReadThroughCacheTypeTagValue = "nexus_endpoint_registry_readthrough"
 
 	InvalidHistoryURITagValue    = "invalid_history_uri"
@@ -101,6 +102,8 @@ const (
 	PersistenceDeleteHistoryBranchScope = "DeleteHistoryBranch"
 	// PersistenceTrimHistoryBranchScope tracks TrimHistoryBranch calls made by service to persistence layer
 	PersistenceTrimHistoryBranchScope = "TrimHistoryBranch"
+	// PersistenceReencryptHistoryBranchScope tracks ReencryptHistoryBranch calls made by service to persistence layer
+	PersistenceReencryptHistoryBranchScope = "ReencryptHistoryBranch"
 	// PersistenceGetAllHistoryTreeBranchesScope tracks GetAllHistoryTreeBranches calls made by service to persistence layer
 	PersistenceGetAllHistoryTreeBranchesScope = "GetAllHistoryTreeBranches"
 	// PersistenceNamespaceReplicationQueueScope is the metrics scope for namespace replication queue
@@ -282,6 +285,8 @@ const (
 	VisibilityPersistenceScanWorkflowExecutionsScope = "ScanWorkflowExecutions"
 	// VisibilityPersistenceCountWorkflowExecutionsScope tracks CountWorkflowExecutions calls made by service to visibility persistence layer
 	VisibilityPersistenceCountWorkflowExecutionsScope = "CountWorkflowExecutions"
+	// VisibilityPersistenceAggregateWorkflowExecutionsScope tracks AggregateWorkflowExecutions calls made by service to visibility persistence layer
+	VisibilityPersistenceAggregateWorkflowExecutionsScope = "AggregateWorkflowExecutions"
 	// VisibilityPersistenceGetWorkflowExecutionScope tracks GetWorkflowExecution calls made by service to visibility persistence layer
 	VisibilityPersistenceGetWorkflowExecutionScope = "GetWorkflowExecution"
 	// VisibilityPersistenceAddSearchAttributesScope tracks AddSearchAttributes calls made by service to visibility persistence layer
@@ -703,6 +708,7 @@ var (
 	CacheTtl                                     = NewTimerDef("cache_ttl")
 	CacheEntryAgeOnGet                           = NewTimerDef("cache_entry_age_on_get")
 	CacheEntryAgeOnEviction                      = NewTimerDef("cache_entry_age_on_eviction")
+	CacheSpillDropped                            = NewCounterDef("cache_spill_dropped")
 	HistoryEventNotificationQueueingLatency      = NewTimerDef("history_event_notification_queueing_latency")
 	HistoryEventNotificationFanoutLatency        = NewTimerDef("history_event_notification_fanout_latency")
 	HistoryEventNotificationInFlightMessageGauge = NewGaugeDef("history_event_notification_inflight_message_gauge")
@@ -984,6 +990,10 @@ var (
 	OutOfOrderBufferedEventsCounter                = NewCounterDef("out_of_order_buffered_events")
 	ShardLingerSuccess                             = NewTimerDef("shard_linger_success")
 	ShardLingerTimeouts                            = NewCounterDef("shard_linger_timeouts")
+	ShardClaimedCounter                            = NewCounterDef("shard_claimed")
+	ShardLoadRequestsPerSecond                     = NewGaugeDef("shard_load_requests_per_second")
+	ShardLoadQueueBacklog                          = NewGaugeDef("shard_load_queue_backlog")
+	ShardLoadCachedWorkflows                       = NewGaugeDef("shard_load_cached_workflows")
 	DynamicRateLimiterMultiplier                   = NewGaugeDef("dynamic_rate_limit_multiplier")
 	DLQWrites                                      = NewCounterDef(
 		"dlq_writes",
@@ -1140,6 +1150,28 @@ var (
 		WithDescription("The number of workflow executions that wasn't found by DeleteExecutions workflow"),
 	)
 
+	VisibilityBackfillWrittenCount = NewCounterDef(
+		"visibility_backfill_written",
+		WithDescription("The number of visibility records written to the target store by VisibilityBackfill workflow"),
+	)
+	VisibilityBackfillFailedCount = NewCounterDef(
+		"visibility_backfill_failed",
+		WithDescription("The number of visibility records rejected by the target store while backfilling by VisibilityBackfill workflow"),
+	)
+
+	SavedVisibilityQueryCount = NewGaugeDef(
+		"saved_visibility_query_count",
+		WithDescription("The number of workflow executions matching a saved visibility query, as counted by the saved query evaluator"),
+	)
+	SavedVisibilityQueryErrors = NewCounterDef(
+		"saved_visibility_query_errors",
+		WithDescription("The number of saved visibility queries which the saved query evaluator failed to count"),
+	)
+	SavedVisibilityQueryStale = NewGaugeDef(
+		"saved_visibility_query_stale",
+		WithDescription("Set to 1 while the latest evaluation of a saved visibility query failed, in which case saved_visibility_query_count is stale"),
+	)
+
 	// Batcher metrics.
 	BatcherProcessorSuccess = NewCounterDef(
 		"batcher_processor_requests",
@@ -1266,6 +1298,8 @@ var (
 	CassandraSessionRefreshFailures        = NewCounterDef("cassandra_session_refresh_failures")
 	PersistenceSessionRefreshFailures      = NewCounterDef("persistence_session_refresh_failures")
 	PersistenceSessionRefreshAttempts      = NewCounterDef("persistence_session_refresh_attempts")
+	PersistenceReadReplicaLag              = NewTimerDef("persistence_read_replica_lag")
+	PersistenceReadReplicaFallbacks        = NewCounterDef("persistence_read_replica_fallbacks")
 
 	// Common service base metrics
 	RestartCount           = NewCounterDef("restarts")
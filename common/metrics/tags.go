@@ -51,6 +51,7 @@ const (
 	fromUnversioned         = "from_unversioned"
 	toUnversioned           = "to_unversioned"
 	queryTypeTag            = "query_type"
+	savedQueryTag           = "saved_query"
 	namespaceAllValue       = "all"
 	unknownValue            = "_unknown_"
 	totalMetricSuffix       = "_total"
@@ -432,6 +433,11 @@ func QueryTypeTag(queryType string) Tag {
 	return &tagImpl{key: queryTypeTag, value: queryTypeUserDefined}
 }
 
+// SavedQueryTag returns a tag with the name of a saved visibility query.
+func SavedQueryTag(name string) Tag {
+	return &tagImpl{key: savedQueryTag, value: name}
+}
+
 func VersioningBehaviorBeforeOverrideTag(behavior enumspb.VersioningBehavior) Tag {
 	return &tagImpl{key: behaviorBefore, value: behavior.String()}
 }
@@ -151,6 +151,7 @@ func (h *taskExecutorImpl) handleNamespaceCreationReplicationTask(
 				VisibilityArchivalState:      task.Config.GetVisibilityArchivalState(),
 				VisibilityArchivalUri:        task.Config.GetVisibilityArchivalUri(),
 				CustomSearchAttributeAliases: task.Config.GetCustomSearchAttributeAliases(),
+				SavedVisibilityQueries:       task.GetSavedVisibilityQueries(),
 			},
 			ReplicationConfig: &persistencespb.NamespaceReplicationConfig{
 				ActiveClusterName: task.ReplicationConfig.GetActiveClusterName(),
@@ -282,6 +283,7 @@ func (h *taskExecutorImpl) handleNamespaceUpdateReplicationTask(
 			VisibilityArchivalState:      task.Config.GetVisibilityArchivalState(),
 			VisibilityArchivalUri:        task.Config.GetVisibilityArchivalUri(),
 			CustomSearchAttributeAliases: task.Config.GetCustomSearchAttributeAliases(),
+			SavedVisibilityQueries:       task.GetSavedVisibilityQueries(),
 		}
 		if task.Config.GetBadBinaries() != nil {
 			request.Namespace.Config.BadBinaries = task.Config.GetBadBinaries()
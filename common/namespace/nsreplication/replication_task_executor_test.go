@@ -383,6 +383,9 @@ func (s *namespaceReplicationTaskExecutorSuite) TestExecute_UpdateNamespaceTask_
 			FailoverVersion: 999,
 		},
 	}
+	savedQueries := map[string]*persistencespb.SavedVisibilityQuery{
+		"stuck": {Name: "stuck", Query: "ExecutionStatus = 'Running'"},
+	}
 	updateClusters := []*replicationpb.ClusterReplicationConfig{
 		{
 			ClusterName: updateClusterActive,
@@ -412,9 +415,10 @@ func (s *namespaceReplicationTaskExecutorSuite) TestExecute_UpdateNamespaceTask_
 			ActiveClusterName: updateClusterActive,
 			Clusters:          updateClusters,
 		},
-		ConfigVersion:   updateConfigVersion,
-		FailoverVersion: updateFailoverVersion,
-		FailoverHistory: failoverHistory,
+		ConfigVersion:          updateConfigVersion,
+		FailoverVersion:        updateFailoverVersion,
+		FailoverHistory:        failoverHistory,
+		SavedVisibilityQueries: savedQueries,
 	}
 
 	s.namespaceReplicator.currentCluster = updateClusterStandby
@@ -445,6 +449,7 @@ func (s *namespaceReplicationTaskExecutorSuite) TestExecute_UpdateNamespaceTask_
 				HistoryArchivalUri:      updateTask.Config.HistoryArchivalUri,
 				VisibilityArchivalState: updateTask.Config.VisibilityArchivalState,
 				VisibilityArchivalUri:   updateTask.Config.VisibilityArchivalUri,
+				SavedVisibilityQueries:  savedQueries,
 			},
 			ReplicationConfig: &persistencespb.NamespaceReplicationConfig{
 				ActiveClusterName: updateTask.ReplicationConfig.ActiveClusterName,
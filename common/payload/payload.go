@@ -9,6 +9,11 @@ import (
 	"go.temporal.io/server/common/util"
 )
 
+const (
+	// redactedMetadataKey marks a payload whose data was erased by a redaction.
+	redactedMetadataKey = "redacted"
+)
+
 var (
 	defaultDataConverter = converter.GetDefaultDataConverter()
 
@@ -40,6 +45,20 @@ func ToString(p *commonpb.Payload) string {
 	return defaultDataConverter.ToString(p)
 }
 
+// Redacted returns the marker that replaces a redacted payload. The marker decodes as nil so that
+// readers of a redacted history don't fail on it.
+func Redacted() *commonpb.Payload {
+	p, _ := Encode(nil)
+	p.Metadata[redactedMetadataKey] = []byte("true")
+	return p
+}
+
+// IsRedacted returns true if the payload is a redaction marker.
+func IsRedacted(p *commonpb.Payload) bool {
+	_, ok := p.GetMetadata()[redactedMetadataKey]
+	return ok
+}
+
 // MergeMapOfPayload returns a new map resulting from merging map `src` into `dst`.
 // If a key in `src` already exists in `dst`, then the value in `src` replaces
 // the value in `dst`.
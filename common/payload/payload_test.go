@@ -88,6 +88,20 @@ func TestEncodeDecode(t *testing.T) {
 	s.Equal(valueAny, decodedValueAny)
 }
 
+func TestRedacted(t *testing.T) {
+	s := assert.New(t)
+
+	p := Redacted()
+	s.True(IsRedacted(p))
+	s.False(IsRedacted(EncodeString("str")))
+	s.False(IsRedacted(nil))
+
+	decodedValue := "str"
+	err := Decode(p, &decodedValue)
+	s.NoError(err)
+	s.Empty(decodedValue)
+}
+
 func TestMergeMapOfPayload(t *testing.T) {
 	s := assert.New(t)
 
@@ -0,0 +1,113 @@
+package payloads
+
+import (
+	"bytes"
+	"encoding/json"
+	"maps"
+	"slices"
+	"strconv"
+	"strings"
+
+	commonpb "go.temporal.io/api/common/v1"
+	"go.temporal.io/sdk/converter"
+)
+
+const pathWildcard = "*"
+
+// ExtractPath returns the scalar values found at the dot separated path into the payloads, whose first
+// segment is the index of the payload, e.g. "0.order.id". A "*" segment matches all elements of an array
+// or all values of an object, and objects or arrays found at the end of the path contribute all of their
+// scalar values. Payloads that are not JSON encoded are skipped.
+func ExtractPath(ps *commonpb.Payloads, path string) []string {
+	values := make([]any, len(ps.GetPayloads()))
+	for i, p := range ps.GetPayloads() {
+		values[i] = decodeJSON(p)
+	}
+	return extractPath(values, path)
+}
+
+// ExtractMapPath is like ExtractPath for a map of payloads, such as a memo, whose first segment is the
+// key of the payload, e.g. "customer.name".
+func ExtractMapPath(fields map[string]*commonpb.Payload, path string) []string {
+	values := make(map[string]any, len(fields))
+	for k, p := range fields {
+		values[k] = decodeJSON(p)
+	}
+	return extractPath(values, path)
+}
+
+func extractPath(value any, path string) []string {
+	if path == "" {
+		return nil
+	}
+	var result []string
+	collectPath(value, strings.Split(path, "."), &result)
+	return result
+}
+
+func decodeJSON(p *commonpb.Payload) any {
+	if string(p.GetMetadata()[converter.MetadataEncoding]) != converter.MetadataEncodingJSON {
+		return nil
+	}
+	// Numbers are decoded as json.Number to index large integer IDs without loss of precision.
+	decoder := json.NewDecoder(bytes.NewReader(p.GetData()))
+	decoder.UseNumber()
+	var value any
+	if err := decoder.Decode(&value); err != nil {
+		return nil
+	}
+	return value
+}
+
+func collectPath(value any, segments []string, result *[]string) {
+	if len(segments) == 0 {
+		collectScalars(value, result)
+		return
+	}
+	segment, rest := segments[0], segments[1:]
+	switch v := value.(type) {
+	case map[string]any:
+		if segment == pathWildcard {
+			for _, k := range sortedKeys(v) {
+				collectPath(v[k], rest, result)
+			}
+			return
+		}
+		if child, ok := v[segment]; ok {
+			collectPath(child, rest, result)
+		}
+	case []any:
+		if segment == pathWildcard {
+			for _, child := range v {
+				collectPath(child, rest, result)
+			}
+			return
+		}
+		if i, err := strconv.Atoi(segment); err == nil && i >= 0 && i < len(v) {
+			collectPath(v[i], rest, result)
+		}
+	}
+}
+
+func collectScalars(value any, result *[]string) {
+	switch v := value.(type) {
+	case string:
+		*result = append(*result, v)
+	case json.Number:
+		*result = append(*result, v.String())
+	case bool:
+		*result = append(*result, strconv.FormatBool(v))
+	case map[string]any:
+		for _, k := range sortedKeys(v) {
+			collectScalars(v[k], result)
+		}
+	case []any:
+		for _, child := range v {
+			collectScalars(child, result)
+		}
+	}
+}
+
+func sortedKeys(m map[string]any) []string {
+	return slices.Sorted(maps.Keys(m))
+}
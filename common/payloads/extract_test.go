@@ -0,0 +1,52 @@
+package payloads
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	commonpb "go.temporal.io/api/common/v1"
+)
+
+func TestExtractPath(t *testing.T) {
+	assert := assert.New(t)
+
+	input, err := Encode(
+		map[string]any{
+			"order": map[string]any{
+				"id":    int64(9007199254740993),
+				"items": []any{map[string]any{"sku": "a-1"}, map[string]any{"sku": "b-2"}},
+			},
+			"express": true,
+		},
+		"second",
+		[]byte{1, 2, 3},
+	)
+	assert.NoError(err)
+
+	assert.Equal([]string{"9007199254740993"}, ExtractPath(input, "0.order.id"))
+	assert.Equal([]string{"a-1", "b-2"}, ExtractPath(input, "0.order.items.*.sku"))
+	assert.Equal([]string{"b-2"}, ExtractPath(input, "0.order.items.1.sku"))
+	assert.Equal([]string{"true", "9007199254740993", "a-1", "b-2"}, ExtractPath(input, "0"))
+	assert.Equal([]string{"second"}, ExtractPath(input, "1"))
+	assert.Empty(ExtractPath(input, "2"))
+	assert.Empty(ExtractPath(input, "0.order.missing"))
+	assert.Empty(ExtractPath(input, "0.order.items.5.sku"))
+	assert.Empty(ExtractPath(input, "3"))
+	assert.Empty(ExtractPath(input, ""))
+	assert.Empty(ExtractPath(nil, "0"))
+}
+
+func TestExtractMapPath(t *testing.T) {
+	assert := assert.New(t)
+
+	customer, err := Encode(map[string]any{"name": "Alice", "tags": []string{"vip", "eu"}})
+	assert.NoError(err)
+	fields := map[string]*commonpb.Payload{
+		"customer": customer.GetPayloads()[0],
+	}
+
+	assert.Equal([]string{"Alice"}, ExtractMapPath(fields, "customer.name"))
+	assert.Equal([]string{"vip", "eu"}, ExtractMapPath(fields, "customer.tags"))
+	assert.Equal([]string{"Alice", "vip", "eu"}, ExtractMapPath(fields, "*"))
+	assert.Empty(ExtractMapPath(fields, "other"))
+}
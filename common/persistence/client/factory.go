@@ -1,6 +1,9 @@
 package client
 
 import (
+	"fmt"
+	"os"
+	"strconv"
 	"time"
 
 	"go.temporal.io/api/serviceerror"
@@ -42,6 +45,8 @@ type (
 		NewClusterMetadataManager() (persistence.ClusterMetadataManager, error)
 		// NewHistoryTaskQueueManager returns a new manager for history task queues
 		NewHistoryTaskQueueManager() (persistence.HistoryTaskQueueManager, error)
+		// NewWorkerHeartbeatManager returns a new manager for persisted worker heartbeats
+		NewWorkerHeartbeatManager() (persistence.WorkerHeartbeatManager, error)
 		// NewNexusEndpointManager returns a new manager for nexus endpoints
 		NewNexusEndpointManager() (persistence.NexusEndpointManager, error)
 	}
@@ -193,7 +198,25 @@ func (f *factoryImpl) NewExecutionManager() (persistence.ExecutionManager, error
 		return nil, err
 	}
 
-	result := persistence.NewExecutionManager(store, f.serializer, f.eventBlobCache, f.logger, f.config.TransactionSizeLimit)
+	historyBlobStore, err := newHistoryBlobStore(f.config.HistoryBlobStore)
+	if err != nil {
+		return nil, err
+	}
+	encryptor, err := NewEncryptor(f.config.Encryption)
+	if err != nil {
+		return nil, err
+	}
+
+	result := persistence.NewExecutionManager(
+		store,
+		f.serializer,
+		f.eventBlobCache,
+		f.logger,
+		f.config.TransactionSizeLimit,
+		historyBlobStore,
+		f.config.HistoryBlobOffloadThreshold,
+		encryptor,
+	)
 	if f.systemRateLimiter != nil && f.namespaceRateLimiter != nil {
 		result = persistence.NewExecutionPersistenceRateLimitedClient(result, f.systemRateLimiter, f.namespaceRateLimiter, f.shardRateLimiter, f.logger)
 	}
@@ -228,6 +251,14 @@ func (f *factoryImpl) NewHistoryTaskQueueManager() (persistence.HistoryTaskQueue
 	return persistence.NewHistoryTaskQueueManager(q, serialization.NewSerializer()), nil
 }
 
+func (f *factoryImpl) NewWorkerHeartbeatManager() (persistence.WorkerHeartbeatManager, error) {
+	q, err := f.dataStoreFactory.NewQueueV2()
+	if err != nil {
+		return nil, err
+	}
+	return persistence.NewWorkerHeartbeatManager(q), nil
+}
+
 func (f *factoryImpl) NewNexusEndpointManager() (persistence.NexusEndpointManager, error) {
 	store, err := f.dataStoreFactory.NewNexusEndpointStore()
 	if err != nil {
@@ -285,3 +316,39 @@ func (f *factoryImpl) initDependencies() {
 	}
 	f.healthSignals.Start()
 }
+
+func newHistoryBlobStore(cfg *config.HistoryBlobStore) (persistence.HistoryBlobStore, error) {
+	if cfg == nil || cfg.Filestore == nil {
+		return nil, nil
+	}
+
+	fileMode := persistence.DefaultHistoryBlobFileMode
+	if len(cfg.Filestore.FileMode) > 0 {
+		mode, err := strconv.ParseUint(cfg.Filestore.FileMode, 0, 32)
+		if err != nil {
+			return nil, fmt.Errorf("invalid history blob store file mode %q: %w", cfg.Filestore.FileMode, err)
+		}
+		fileMode = os.FileMode(mode)
+	}
+	dirMode := persistence.DefaultHistoryBlobDirMode
+	if len(cfg.Filestore.DirMode) > 0 {
+		mode, err := strconv.ParseUint(cfg.Filestore.DirMode, 0, 32)
+		if err != nil {
+			return nil, fmt.Errorf("invalid history blob store dir mode %q: %w", cfg.Filestore.DirMode, err)
+		}
+		dirMode = os.FileMode(mode)
+	}
+	return persistence.NewFileHistoryBlobStore(cfg.Filestore.Path, fileMode, dirMode)
+}
+
+// NewEncryptor returns the encryptor for the given encryption config, or nil if encryption at rest is disabled.
+func NewEncryptor(cfg *config.PersistenceEncryption) (*serialization.Encryptor, error) {
+	if cfg == nil || len(cfg.KeyringFile) == 0 {
+		return nil, nil
+	}
+	keyProvider, err := serialization.NewFileKeyProvider(cfg.KeyringFile)
+	if err != nil {
+		return nil, err
+	}
+	return serialization.NewEncryptor(keyProvider), nil
+}
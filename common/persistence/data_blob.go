@@ -3,6 +3,7 @@ package persistence
 import (
 	commonpb "go.temporal.io/api/common/v1"
 	enumspb "go.temporal.io/api/enums/v1"
+	"go.temporal.io/server/common/persistence/serialization"
 )
 
 // NewDataBlob returns a new DataBlob.
@@ -14,8 +15,13 @@ func NewDataBlob(data []byte, encodingTypeStr string) *commonpb.DataBlob {
 
 	encodingType, err := enumspb.EncodingTypeFromString(encodingTypeStr)
 	if err != nil {
-		// encodingTypeStr not valid, an error will be returned on deserialization
-		encodingType = enumspb.ENCODING_TYPE_UNSPECIFIED
+		if encodingTypeStr == serialization.EncodingTypeEncrypted.String() {
+			// not part of the public enum, so it is persisted by its number
+			encodingType = serialization.EncodingTypeEncrypted
+		} else {
+			// encodingTypeStr not valid, an error will be returned on deserialization
+			encodingType = enumspb.ENCODING_TYPE_UNSPECIFIED
+		}
 	}
 
 	return &commonpb.DataBlob{
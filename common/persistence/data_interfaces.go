@@ -284,6 +284,9 @@ type (
 		State             *persistencespb.WorkflowMutableState
 		DBRecordVersion   int64
 		MutableStateStats MutableStateStatistics
+		// NeedsReencryption is true if encryption at rest is configured and a blob of the mutable state
+		// isn't encrypted with the active key of the namespace
+		NeedsReencryption bool
 	}
 
 	// SetWorkflowExecutionRequest is used to overwrite the info of a workflow execution
@@ -744,6 +747,9 @@ type (
 	AppendHistoryNodesRequest struct {
 		// The shard to get history node data
 		ShardID int32
+		// The namespace of the history, used to encrypt the events at rest. If not set, it is
+		// taken from Info.
+		NamespaceID string
 		// true if this is the first append request to the branch
 		IsNewBranch bool
 		// the info for clean up data in background
@@ -756,6 +762,8 @@ type (
 		PrevTransactionID int64
 		// requested TransactionID for this write operation. For the same eventID, the node with larger TransactionID always wins
 		TransactionID int64
+		// true if the events replace a node written earlier with the same node ID and TransactionID
+		Overwrite bool
 	}
 
 	// AppendHistoryNodesResponse is a response to AppendHistoryNodesRequest
@@ -768,6 +776,9 @@ type (
 	AppendRawHistoryNodesRequest struct {
 		// The shard to get history node data
 		ShardID int32
+		// The namespace of the history, used to encrypt the events at rest. If not set, it is
+		// taken from Info.
+		NamespaceID string
 		// true if this is the first append request to the branch
 		IsNewBranch bool
 		// the info for clean up data in background
@@ -928,6 +939,22 @@ type (
 	TrimHistoryBranchResponse struct {
 	}
 
+	// ReencryptHistoryBranchRequest is used to re-encrypt a history branch after a key rotation
+	ReencryptHistoryBranchRequest struct {
+		// The shard of the history branch
+		ShardID int32
+		// The namespace owning the branch, its active key is used for encryption
+		NamespaceID string
+		// branch to be re-encrypted
+		BranchToken []byte
+	}
+
+	// ReencryptHistoryBranchResponse is the response to ReencryptHistoryBranchRequest
+	ReencryptHistoryBranchResponse struct {
+		// number of history nodes which have been re-encrypted
+		ReencryptedNodes int
+	}
+
 	// HistoryBranchDetail contains detailed information of a branch
 	HistoryBranchDetail struct {
 		BranchInfo *persistencespb.HistoryBranch
@@ -1130,6 +1157,8 @@ type (
 		DeleteHistoryBranch(ctx context.Context, request *DeleteHistoryBranchRequest) error
 		// TrimHistoryBranch validate & trim a history branch
 		TrimHistoryBranch(ctx context.Context, request *TrimHistoryBranchRequest) (*TrimHistoryBranchResponse, error)
+		// ReencryptHistoryBranch re-encrypts the nodes of a history branch with the namespace's active key
+		ReencryptHistoryBranch(ctx context.Context, request *ReencryptHistoryBranchRequest) (*ReencryptHistoryBranchResponse, error)
 		// GetAllHistoryTreeBranches returns all branches of all trees
 		GetAllHistoryTreeBranches(ctx context.Context, request *GetAllHistoryTreeBranchesRequest) (*GetAllHistoryTreeBranchesResponse, error)
 	}
@@ -1230,6 +1259,19 @@ type (
 		ListQueues(ctx context.Context, request *ListQueuesRequest) (*ListQueuesResponse, error)
 	}
 
+	// WorkerHeartbeatManager stores batches of worker heartbeats in a per-namespace queue. Batches are read in the
+	// order they were appended and trimmed once all of their heartbeats have expired.
+	WorkerHeartbeatManager interface {
+		Closeable
+		// AppendWorkerHeartbeats appends a batch to the queue of the namespace, creating the queue if needed.
+		AppendWorkerHeartbeats(ctx context.Context, request *AppendWorkerHeartbeatsRequest) (*AppendWorkerHeartbeatsResponse, error)
+		// ReadWorkerHeartbeats returns the batches appended after the given message. A namespace without a queue has
+		// no batches.
+		ReadWorkerHeartbeats(ctx context.Context, request *ReadWorkerHeartbeatsRequest) (*ReadWorkerHeartbeatsResponse, error)
+		// TrimWorkerHeartbeats deletes the batches up to and including the given message.
+		TrimWorkerHeartbeats(ctx context.Context, request *TrimWorkerHeartbeatsRequest) (*TrimWorkerHeartbeatsResponse, error)
+	}
+
 	HistoryTaskQueueManagerImpl struct {
 		queue      QueueV2
 		serializer serialization.Serializer
@@ -1314,6 +1356,41 @@ type (
 		Queues        []QueueInfo
 		NextPageToken []byte
 	}
+
+	AppendWorkerHeartbeatsRequest struct {
+		NamespaceID string
+		Batch       *persistencespb.WorkerHeartbeatBatch
+	}
+
+	AppendWorkerHeartbeatsResponse struct {
+		Metadata MessageMetadata
+	}
+
+	ReadWorkerHeartbeatsRequest struct {
+		NamespaceID string
+		// ExclusiveMinMessageMetadata is the last message which was already read. It is nil to read from the
+		// beginning of the queue.
+		ExclusiveMinMessageMetadata *MessageMetadata
+		PageSize                    int
+	}
+
+	WorkerHeartbeatBatch struct {
+		MessageMetadata MessageMetadata
+		Batch           *persistencespb.WorkerHeartbeatBatch
+	}
+
+	ReadWorkerHeartbeatsResponse struct {
+		Batches []WorkerHeartbeatBatch
+	}
+
+	TrimWorkerHeartbeatsRequest struct {
+		NamespaceID                 string
+		InclusiveMaxMessageMetadata MessageMetadata
+	}
+
+	TrimWorkerHeartbeatsResponse struct {
+		MessagesDeleted int64
+	}
 )
 
 func (e *InvalidPersistenceRequestError) Error() string {
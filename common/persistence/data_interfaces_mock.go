@@ -576,6 +576,21 @@ func (mr *MockExecutionManagerMockRecorder) ReadRawHistoryBranch(ctx, request an
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReadRawHistoryBranch", reflect.TypeOf((*MockExecutionManager)(nil).ReadRawHistoryBranch), ctx, request)
 }
 
+// ReencryptHistoryBranch mocks base method.
+func (m *MockExecutionManager) ReencryptHistoryBranch(ctx context.Context, request *ReencryptHistoryBranchRequest) (*ReencryptHistoryBranchResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ReencryptHistoryBranch", ctx, request)
+	ret0, _ := ret[0].(*ReencryptHistoryBranchResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ReencryptHistoryBranch indicates an expected call of ReencryptHistoryBranch.
+func (mr *MockExecutionManagerMockRecorder) ReencryptHistoryBranch(ctx, request any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReencryptHistoryBranch", reflect.TypeOf((*MockExecutionManager)(nil).ReencryptHistoryBranch), ctx, request)
+}
+
 // SetWorkflowExecution mocks base method.
 func (m *MockExecutionManager) SetWorkflowExecution(ctx context.Context, request *SetWorkflowExecutionRequest) (*SetWorkflowExecutionResponse, error) {
 	m.ctrl.T.Helper()
@@ -1445,3 +1460,84 @@ func (mr *MockHistoryTaskQueueManagerMockRecorder) ReadTasks(ctx, request any) *
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReadTasks", reflect.TypeOf((*MockHistoryTaskQueueManager)(nil).ReadTasks), ctx, request)
 }
+
+// MockWorkerHeartbeatManager is a mock of WorkerHeartbeatManager interface.
+type MockWorkerHeartbeatManager struct {
+	ctrl     *gomock.Controller
+	recorder *MockWorkerHeartbeatManagerMockRecorder
+	isgomock struct{}
+}
+
+// MockWorkerHeartbeatManagerMockRecorder is the mock recorder for MockWorkerHeartbeatManager.
+type MockWorkerHeartbeatManagerMockRecorder struct {
+	mock *MockWorkerHeartbeatManager
+}
+
+// NewMockWorkerHeartbeatManager creates a new mock instance.
+func NewMockWorkerHeartbeatManager(ctrl *gomock.Controller) *MockWorkerHeartbeatManager {
+	mock := &MockWorkerHeartbeatManager{ctrl: ctrl}
+	mock.recorder = &MockWorkerHeartbeatManagerMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockWorkerHeartbeatManager) EXPECT() *MockWorkerHeartbeatManagerMockRecorder {
+	return m.recorder
+}
+
+// AppendWorkerHeartbeats mocks base method.
+func (m *MockWorkerHeartbeatManager) AppendWorkerHeartbeats(ctx context.Context, request *AppendWorkerHeartbeatsRequest) (*AppendWorkerHeartbeatsResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AppendWorkerHeartbeats", ctx, request)
+	ret0, _ := ret[0].(*AppendWorkerHeartbeatsResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// AppendWorkerHeartbeats indicates an expected call of AppendWorkerHeartbeats.
+func (mr *MockWorkerHeartbeatManagerMockRecorder) AppendWorkerHeartbeats(ctx, request any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AppendWorkerHeartbeats", reflect.TypeOf((*MockWorkerHeartbeatManager)(nil).AppendWorkerHeartbeats), ctx, request)
+}
+
+// Close mocks base method.
+func (m *MockWorkerHeartbeatManager) Close() {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "Close")
+}
+
+// Close indicates an expected call of Close.
+func (mr *MockWorkerHeartbeatManagerMockRecorder) Close() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Close", reflect.TypeOf((*MockWorkerHeartbeatManager)(nil).Close))
+}
+
+// ReadWorkerHeartbeats mocks base method.
+func (m *MockWorkerHeartbeatManager) ReadWorkerHeartbeats(ctx context.Context, request *ReadWorkerHeartbeatsRequest) (*ReadWorkerHeartbeatsResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ReadWorkerHeartbeats", ctx, request)
+	ret0, _ := ret[0].(*ReadWorkerHeartbeatsResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ReadWorkerHeartbeats indicates an expected call of ReadWorkerHeartbeats.
+func (mr *MockWorkerHeartbeatManagerMockRecorder) ReadWorkerHeartbeats(ctx, request any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReadWorkerHeartbeats", reflect.TypeOf((*MockWorkerHeartbeatManager)(nil).ReadWorkerHeartbeats), ctx, request)
+}
+
+// TrimWorkerHeartbeats mocks base method.
+func (m *MockWorkerHeartbeatManager) TrimWorkerHeartbeats(ctx context.Context, request *TrimWorkerHeartbeatsRequest) (*TrimWorkerHeartbeatsResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "TrimWorkerHeartbeats", ctx, request)
+	ret0, _ := ret[0].(*TrimWorkerHeartbeatsResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// TrimWorkerHeartbeats indicates an expected call of TrimWorkerHeartbeats.
+func (mr *MockWorkerHeartbeatManagerMockRecorder) TrimWorkerHeartbeats(ctx, request any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "TrimWorkerHeartbeats", reflect.TypeOf((*MockWorkerHeartbeatManager)(nil).TrimWorkerHeartbeats), ctx, request)
+}
@@ -0,0 +1,310 @@
+package persistence
+
+import (
+	"context"
+	"fmt"
+
+	commonpb "go.temporal.io/api/common/v1"
+	"go.temporal.io/server/common"
+	"go.temporal.io/server/common/persistence/serialization"
+)
+
+const (
+	reencryptHistoryBranchPageSize = 100
+)
+
+// Encryption at rest covers history nodes, buffered events and the mutable state infos.
+// The execution state blob is left in plaintext since stores decode it themselves to
+// maintain the current execution record and report conflicts. Checksums, tasks and
+// CHASM nodes are not encrypted either.
+
+// historyNamespaceID returns the namespace ID of a history append request. Requests which
+// don't carry the namespace ID explicitly fall back to the garbage cleanup info.
+func historyNamespaceID(namespaceID string, info string) string {
+	if len(namespaceID) > 0 || len(info) == 0 {
+		return namespaceID
+	}
+	namespaceID, _, _, err := SplitHistoryGarbageCleanupInfo(info)
+	if err != nil {
+		return ""
+	}
+	return namespaceID
+}
+
+func (m *executionManagerImpl) encryptBlob(namespaceID string, blob *commonpb.DataBlob) (*commonpb.DataBlob, error) {
+	if m.encryptor == nil {
+		return blob, nil
+	}
+	return m.encryptor.Encrypt(namespaceID, blob)
+}
+
+func (m *executionManagerImpl) decryptBlob(blob *commonpb.DataBlob) (*commonpb.DataBlob, error) {
+	if !serialization.IsEncrypted(blob) {
+		return blob, nil
+	}
+	if m.encryptor == nil {
+		return nil, serialization.NewDeserializationError(serialization.EncodingTypeEncrypted, serialization.ErrNoKeyProvider)
+	}
+	return m.encryptor.Decrypt(blob)
+}
+
+// encryptHistoryNode encrypts the events blob of the node. The original blob is
+// never mutated so callers may keep using it, e.g. for the XDC cache.
+func (m *executionManagerImpl) encryptHistoryNode(
+	namespaceID string,
+	request *InternalAppendHistoryNodesRequest,
+) error {
+	blob, err := m.encryptBlob(namespaceID, request.Node.Events)
+	if err != nil {
+		return err
+	}
+	request.Node.Events = blob
+	return nil
+}
+
+func (m *executionManagerImpl) decryptHistoryNodes(nodes []InternalHistoryNode) error {
+	for i := range nodes {
+		blob, err := m.decryptBlob(nodes[i].Events)
+		if err != nil {
+			return err
+		}
+		nodes[i].Events = blob
+	}
+	return nil
+}
+
+func (m *executionManagerImpl) encryptWorkflowMutation(mutation *InternalWorkflowMutation) error {
+	if m.encryptor == nil {
+		return nil
+	}
+	namespaceID := mutation.NamespaceID
+	var err error
+	if mutation.ExecutionInfoBlob, err = m.encryptBlob(namespaceID, mutation.ExecutionInfoBlob); err != nil {
+		return err
+	}
+	if mutation.NewBufferedEvents, err = m.encryptBlob(namespaceID, mutation.NewBufferedEvents); err != nil {
+		return err
+	}
+	if err := encryptBlobMap(m, namespaceID, mutation.UpsertActivityInfos); err != nil {
+		return err
+	}
+	if err := encryptBlobMap(m, namespaceID, mutation.UpsertTimerInfos); err != nil {
+		return err
+	}
+	if err := encryptBlobMap(m, namespaceID, mutation.UpsertChildExecutionInfos); err != nil {
+		return err
+	}
+	if err := encryptBlobMap(m, namespaceID, mutation.UpsertRequestCancelInfos); err != nil {
+		return err
+	}
+	return encryptBlobMap(m, namespaceID, mutation.UpsertSignalInfos)
+}
+
+func (m *executionManagerImpl) encryptWorkflowSnapshot(snapshot *InternalWorkflowSnapshot) error {
+	if m.encryptor == nil {
+		return nil
+	}
+	namespaceID := snapshot.NamespaceID
+	var err error
+	if snapshot.ExecutionInfoBlob, err = m.encryptBlob(namespaceID, snapshot.ExecutionInfoBlob); err != nil {
+		return err
+	}
+	if err := encryptBlobMap(m, namespaceID, snapshot.ActivityInfos); err != nil {
+		return err
+	}
+	if err := encryptBlobMap(m, namespaceID, snapshot.TimerInfos); err != nil {
+		return err
+	}
+	if err := encryptBlobMap(m, namespaceID, snapshot.ChildExecutionInfos); err != nil {
+		return err
+	}
+	if err := encryptBlobMap(m, namespaceID, snapshot.RequestCancelInfos); err != nil {
+		return err
+	}
+	return encryptBlobMap(m, namespaceID, snapshot.SignalInfos)
+}
+
+// decryptWorkflowMutableState decrypts the blobs of the mutable state in place
+func (m *executionManagerImpl) decryptWorkflowMutableState(state *InternalWorkflowMutableState) error {
+	var err error
+	if state.ExecutionInfo, err = m.decryptBlob(state.ExecutionInfo); err != nil {
+		return err
+	}
+	for i, blob := range state.BufferedEvents {
+		if state.BufferedEvents[i], err = m.decryptBlob(blob); err != nil {
+			return err
+		}
+	}
+	if err := decryptBlobMap(m, state.ActivityInfos); err != nil {
+		return err
+	}
+	if err := decryptBlobMap(m, state.TimerInfos); err != nil {
+		return err
+	}
+	if err := decryptBlobMap(m, state.ChildExecutionInfos); err != nil {
+		return err
+	}
+	if err := decryptBlobMap(m, state.RequestCancelInfos); err != nil {
+		return err
+	}
+	return decryptBlobMap(m, state.SignalInfos)
+}
+
+// mutableStateNeedsReencryption returns true if a blob of the still encrypted mutable state isn't
+// encrypted with the active key of the namespace
+func (m *executionManagerImpl) mutableStateNeedsReencryption(namespaceID string, state *InternalWorkflowMutableState) (bool, error) {
+	if m.encryptor == nil {
+		return false, nil
+	}
+	blobs := append([]*commonpb.DataBlob{state.ExecutionInfo}, state.BufferedEvents...)
+	blobs = appendBlobMap(blobs, state.ActivityInfos)
+	blobs = appendBlobMap(blobs, state.TimerInfos)
+	blobs = appendBlobMap(blobs, state.ChildExecutionInfos)
+	blobs = appendBlobMap(blobs, state.RequestCancelInfos)
+	blobs = appendBlobMap(blobs, state.SignalInfos)
+	for _, blob := range blobs {
+		needsReencryption, err := m.encryptor.NeedsReencryption(namespaceID, blob)
+		if err != nil || needsReencryption {
+			return needsReencryption, err
+		}
+	}
+	return false, nil
+}
+
+func appendBlobMap[K comparable](blobs []*commonpb.DataBlob, blobMap map[K]*commonpb.DataBlob) []*commonpb.DataBlob {
+	for _, blob := range blobMap {
+		blobs = append(blobs, blob)
+	}
+	return blobs
+}
+
+func encryptBlobMap[K comparable](m *executionManagerImpl, namespaceID string, blobs map[K]*commonpb.DataBlob) error {
+	for key, blob := range blobs {
+		encrypted, err := m.encryptBlob(namespaceID, blob)
+		if err != nil {
+			return err
+		}
+		blobs[key] = encrypted
+	}
+	return nil
+}
+
+func decryptBlobMap[K comparable](m *executionManagerImpl, blobs map[K]*commonpb.DataBlob) error {
+	for key, blob := range blobs {
+		decrypted, err := m.decryptBlob(blob)
+		if err != nil {
+			return err
+		}
+		blobs[key] = decrypted
+	}
+	return nil
+}
+
+// ReencryptHistoryBranch rewrites the history nodes owned by the branch which are not encrypted with
+// the active key of the namespace. Nodes of ancestor branches are left alone, they are re-encrypted
+// when their own branch is processed. History nodes are immutable, so rewriting a node with the same
+// node and transaction ID doesn't change the history.
+func (m *executionManagerImpl) ReencryptHistoryBranch(
+	ctx context.Context,
+	request *ReencryptHistoryBranchRequest,
+) (*ReencryptHistoryBranchResponse, error) {
+	response := &ReencryptHistoryBranchResponse{}
+	if m.encryptor == nil {
+		return response, nil
+	}
+
+	branch, err := m.GetHistoryBranchUtil().ParseHistoryBranchInfo(request.BranchToken)
+	if err != nil {
+		return nil, err
+	}
+
+	var pageToken []byte
+	for doContinue := true; doContinue; doContinue = len(pageToken) > 0 {
+		resp, err := m.persistence.ReadHistoryBranch(ctx, &InternalReadHistoryBranchRequest{
+			BranchToken:   request.BranchToken,
+			ShardID:       request.ShardID,
+			BranchID:      branch.BranchId,
+			MinNodeID:     GetBeginNodeID(branch),
+			MaxNodeID:     common.EndEventID,
+			NextPageToken: pageToken,
+			PageSize:      reencryptHistoryBranchPageSize,
+		})
+		if err != nil {
+			return nil, err
+		}
+		pageToken = resp.NextPageToken
+
+		for _, node := range resp.Nodes {
+			rewritten, replacedBlob, err := m.reencryptHistoryNode(ctx, request.NamespaceID, node)
+			if err != nil {
+				return nil, err
+			}
+			if rewritten == nil {
+				continue
+			}
+			if err := m.persistence.AppendHistoryNodes(ctx, &InternalAppendHistoryNodesRequest{
+				BranchToken: request.BranchToken,
+				BranchInfo:  branch,
+				Node:        *rewritten,
+				ShardID:     request.ShardID,
+			}); err != nil {
+				return nil, err
+			}
+			// the previous blob is only deleted once nothing references it anymore
+			if replacedBlob != nil {
+				if err := m.historyBlobStore.DeleteBlob(ctx, *replacedBlob); err != nil {
+					return nil, err
+				}
+			}
+			response.ReencryptedNodes++
+		}
+	}
+	return response, nil
+}
+
+// reencryptHistoryNode returns the node to write back or nil if the node is encrypted with the active key.
+// The re-encrypted data of an offloaded node is written to a new blob, the key of the replaced blob is
+// returned so it can be deleted after the node is switched to the new blob.
+func (m *executionManagerImpl) reencryptHistoryNode(
+	ctx context.Context,
+	namespaceID string,
+	node InternalHistoryNode,
+) (*InternalHistoryNode, *HistoryBlobKey, error) {
+	blobKey, offloaded, err := parseHistoryBlobRef(node.Events)
+	if err != nil {
+		return nil, nil, err
+	}
+	blob := node.Events
+	if offloaded {
+		resolved := []InternalHistoryNode{node}
+		if err := m.resolveHistoryNodes(ctx, resolved); err != nil {
+			return nil, nil, err
+		}
+		blob = resolved[0].Events
+	}
+
+	needsReencryption, err := m.encryptor.NeedsReencryption(namespaceID, blob)
+	if err != nil || !needsReencryption {
+		return nil, nil, err
+	}
+	blob, err = m.encryptor.Reencrypt(namespaceID, blob)
+	if err != nil {
+		return nil, nil, fmt.Errorf("unable to re-encrypt history node %v: %w", node.NodeID, err)
+	}
+
+	if !offloaded {
+		node.Events = blob
+		return &node, nil, nil
+	}
+	// Overwriting the offloaded blob in place would let readers decode the new data with the encoding
+	// recorded in the old reference, so the reference is pointed at a new blob instead.
+	newKey := blobKey
+	newKey.Generation++
+	if err := m.historyBlobStore.PutBlob(ctx, newKey, blob.Data); err != nil {
+		return nil, nil, err
+	}
+	if node.Events, err = newHistoryBlobRef(newKey, blob); err != nil {
+		return nil, nil, err
+	}
+	return &node, &blobKey, nil
+}
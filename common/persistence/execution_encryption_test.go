@@ -0,0 +1,256 @@
+package persistence_test
+
+import (
+	"bytes"
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	enumspb "go.temporal.io/api/enums/v1"
+	historypb "go.temporal.io/api/history/v1"
+	"go.temporal.io/api/serviceerror"
+	persistencespb "go.temporal.io/server/api/persistence/v1"
+	"go.temporal.io/server/common/dynamicconfig"
+	"go.temporal.io/server/common/log"
+	"go.temporal.io/server/common/persistence"
+	"go.temporal.io/server/common/persistence/mock"
+	"go.temporal.io/server/common/persistence/serialization"
+	"go.temporal.io/server/common/primitives"
+	"go.uber.org/mock/gomock"
+)
+
+type testKeyProvider struct {
+	active string
+}
+
+func (p *testKeyProvider) ActiveKey(string) (serialization.EncryptionKey, bool, error) {
+	key, err := p.Key(p.active)
+	return key, true, err
+}
+
+func (p *testKeyProvider) Key(keyID string) (serialization.EncryptionKey, error) {
+	return serialization.EncryptionKey{ID: keyID, Material: bytes.Repeat([]byte(keyID[:1]), 32)}, nil
+}
+
+func TestNewDataBlob_Encrypted(t *testing.T) {
+	blob := persistence.NewDataBlob([]byte("data"), serialization.EncodingTypeEncrypted.String())
+	require.Equal(t, serialization.EncodingTypeEncrypted, blob.EncodingType)
+}
+
+func TestExecutionManager_HistoryEncryption(t *testing.T) {
+	ctx := context.Background()
+	ctrl := gomock.NewController(t)
+	executionStore := mock.NewMockExecutionStore(ctrl)
+	executionStore.EXPECT().GetHistoryBranchUtil().Return(&persistence.HistoryBranchUtilImpl{}).AnyTimes()
+
+	keyProvider := &testKeyProvider{active: "a"}
+	manager := persistence.NewExecutionManager(
+		executionStore,
+		serialization.NewSerializer(),
+		nil,
+		log.NewNoopLogger(),
+		dynamicconfig.GetIntPropertyFn(4*1024*1024),
+		nil,
+		nil,
+		serialization.NewEncryptor(keyProvider),
+	)
+
+	branchToken, err := (&persistence.HistoryBranchUtilImpl{}).NewHistoryBranch("", "", "", primitives.NewUUID().String(), nil, nil, 0, 0, 0)
+	require.NoError(t, err)
+
+	stored := map[int64]persistence.InternalHistoryNode{}
+	executionStore.EXPECT().AppendHistoryNodes(gomock.Any(), gomock.Any()).DoAndReturn(
+		func(_ context.Context, request *persistence.InternalAppendHistoryNodesRequest) error {
+			stored[request.Node.TransactionID] = request.Node
+			return nil
+		},
+	).AnyTimes()
+	executionStore.EXPECT().ReadHistoryBranch(gomock.Any(), gomock.Any()).DoAndReturn(
+		func(_ context.Context, _ *persistence.InternalReadHistoryBranchRequest) (*persistence.InternalReadHistoryBranchResponse, error) {
+			return &persistence.InternalReadHistoryBranchResponse{Nodes: []persistence.InternalHistoryNode{stored[1]}}, nil
+		},
+	).AnyTimes()
+
+	_, err = manager.AppendHistoryNodes(ctx, &persistence.AppendHistoryNodesRequest{
+		NamespaceID:   "ns-1",
+		BranchToken:   branchToken,
+		TransactionID: 1,
+		Events: []*historypb.HistoryEvent{{
+			EventId:   1,
+			Version:   1,
+			EventType: enumspb.EVENT_TYPE_WORKFLOW_EXECUTION_STARTED,
+		}},
+	})
+	require.NoError(t, err)
+	require.True(t, serialization.IsEncrypted(stored[1].Events))
+
+	readHistory := func() {
+		resp, err := manager.ReadHistoryBranch(ctx, &persistence.ReadHistoryBranchRequest{
+			BranchToken: branchToken,
+			MinEventID:  1,
+			MaxEventID:  2,
+			PageSize:    10,
+		})
+		require.NoError(t, err)
+		require.Len(t, resp.HistoryEvents, 1)
+		require.Equal(t, enumspb.EVENT_TYPE_WORKFLOW_EXECUTION_STARTED, resp.HistoryEvents[0].EventType)
+	}
+	readHistory()
+
+	reencrypt := func() int {
+		resp, err := manager.ReencryptHistoryBranch(ctx, &persistence.ReencryptHistoryBranchRequest{
+			NamespaceID: "ns-1",
+			BranchToken: branchToken,
+		})
+		require.NoError(t, err)
+		return resp.ReencryptedNodes
+	}
+	require.Equal(t, 0, reencrypt())
+
+	keyProvider.active = "b"
+	before := stored[1].Events
+	require.Equal(t, 1, reencrypt())
+	require.True(t, serialization.IsEncrypted(stored[1].Events))
+	require.NotEqual(t, before.Data, stored[1].Events.Data)
+	require.Equal(t, 0, reencrypt())
+	readHistory()
+}
+
+func TestExecutionManager_HistoryEncryptionOffloaded(t *testing.T) {
+	ctx := context.Background()
+	ctrl := gomock.NewController(t)
+	executionStore := mock.NewMockExecutionStore(ctrl)
+	executionStore.EXPECT().GetHistoryBranchUtil().Return(&persistence.HistoryBranchUtilImpl{}).AnyTimes()
+
+	blobStore, err := persistence.NewFileHistoryBlobStore(t.TempDir(), persistence.DefaultHistoryBlobFileMode, persistence.DefaultHistoryBlobDirMode)
+	require.NoError(t, err)
+	keyProvider := &testKeyProvider{active: "a"}
+	manager := persistence.NewExecutionManager(
+		executionStore,
+		serialization.NewSerializer(),
+		nil,
+		log.NewNoopLogger(),
+		dynamicconfig.GetIntPropertyFn(4*1024*1024),
+		blobStore,
+		dynamicconfig.GetIntPropertyFn(1024),
+		serialization.NewEncryptor(keyProvider),
+	)
+
+	treeID := primitives.NewUUID().String()
+	branchToken, err := (&persistence.HistoryBranchUtilImpl{}).NewHistoryBranch("", "", "", treeID, nil, nil, 0, 0, 0)
+	require.NoError(t, err)
+	branch, err := (&persistence.HistoryBranchUtilImpl{}).ParseHistoryBranchInfo(branchToken)
+	require.NoError(t, err)
+
+	var stored persistence.InternalHistoryNode
+	executionStore.EXPECT().AppendHistoryNodes(gomock.Any(), gomock.Any()).DoAndReturn(
+		func(_ context.Context, request *persistence.InternalAppendHistoryNodesRequest) error {
+			stored = request.Node
+			return nil
+		},
+	).AnyTimes()
+	executionStore.EXPECT().ReadHistoryBranch(gomock.Any(), gomock.Any()).DoAndReturn(
+		func(_ context.Context, _ *persistence.InternalReadHistoryBranchRequest) (*persistence.InternalReadHistoryBranchResponse, error) {
+			return &persistence.InternalReadHistoryBranchResponse{Nodes: []persistence.InternalHistoryNode{stored}}, nil
+		},
+	).AnyTimes()
+
+	_, err = manager.AppendHistoryNodes(ctx, &persistence.AppendHistoryNodesRequest{
+		NamespaceID:   "ns-1",
+		BranchToken:   branchToken,
+		TransactionID: 1,
+		Events: []*historypb.HistoryEvent{{
+			EventId:   1,
+			Version:   1,
+			EventType: enumspb.EVENT_TYPE_WORKFLOW_EXECUTION_SIGNALED,
+			Attributes: &historypb.HistoryEvent_WorkflowExecutionSignaledEventAttributes{
+				WorkflowExecutionSignaledEventAttributes: &historypb.WorkflowExecutionSignaledEventAttributes{
+					SignalName: strings.Repeat("s", 4096),
+				},
+			},
+		}},
+	})
+	require.NoError(t, err)
+	blobKey := persistence.HistoryBlobKey{TreeID: treeID, BranchID: branch.BranchId, NodeID: 1, TransactionID: 1}
+	_, err = blobStore.GetBlob(ctx, blobKey)
+	require.NoError(t, err)
+
+	keyProvider.active = "b"
+	resp, err := manager.ReencryptHistoryBranch(ctx, &persistence.ReencryptHistoryBranchRequest{
+		NamespaceID: "ns-1",
+		BranchToken: branchToken,
+	})
+	require.NoError(t, err)
+	require.Equal(t, 1, resp.ReencryptedNodes)
+
+	// the re-encrypted data is written to a new blob and the replaced one is deleted
+	_, err = blobStore.GetBlob(ctx, blobKey)
+	var dataLoss *serviceerror.DataLoss
+	require.ErrorAs(t, err, &dataLoss)
+	blobKey.Generation = 1
+	_, err = blobStore.GetBlob(ctx, blobKey)
+	require.NoError(t, err)
+
+	readResp, err := manager.ReadHistoryBranch(ctx, &persistence.ReadHistoryBranchRequest{
+		BranchToken: branchToken,
+		MinEventID:  1,
+		MaxEventID:  2,
+		PageSize:    10,
+	})
+	require.NoError(t, err)
+	require.Len(t, readResp.HistoryEvents, 1)
+	require.Len(t, readResp.HistoryEvents[0].GetWorkflowExecutionSignaledEventAttributes().GetSignalName(), 4096)
+}
+
+func TestExecutionManager_MutableStateNeedsReencryption(t *testing.T) {
+	ctx := context.Background()
+	ctrl := gomock.NewController(t)
+	executionStore := mock.NewMockExecutionStore(ctrl)
+
+	keyProvider := &testKeyProvider{active: "a"}
+	encryptor := serialization.NewEncryptor(keyProvider)
+	serializer := serialization.NewSerializer()
+	manager := persistence.NewExecutionManager(
+		executionStore,
+		serializer,
+		nil,
+		log.NewNoopLogger(),
+		dynamicconfig.GetIntPropertyFn(4*1024*1024),
+		nil,
+		nil,
+		encryptor,
+	)
+
+	executionInfo, err := serializer.WorkflowExecutionInfoToBlob(&persistencespb.WorkflowExecutionInfo{WorkflowId: "wid"})
+	require.NoError(t, err)
+	executionInfo, err = encryptor.Encrypt("ns-1", executionInfo)
+	require.NoError(t, err)
+	executionState, err := serializer.WorkflowExecutionStateToBlob(&persistencespb.WorkflowExecutionState{RunId: "rid"})
+	require.NoError(t, err)
+	executionStore.EXPECT().GetWorkflowExecution(gomock.Any(), gomock.Any()).DoAndReturn(
+		func(_ context.Context, _ *persistence.GetWorkflowExecutionRequest) (*persistence.InternalGetWorkflowExecutionResponse, error) {
+			return &persistence.InternalGetWorkflowExecutionResponse{
+				State: &persistence.InternalWorkflowMutableState{
+					ExecutionInfo:  executionInfo,
+					ExecutionState: executionState,
+				},
+			}, nil
+		},
+	).Times(2)
+
+	getWorkflowExecution := func() *persistence.GetWorkflowExecutionResponse {
+		resp, err := manager.GetWorkflowExecution(ctx, &persistence.GetWorkflowExecutionRequest{
+			NamespaceID: "ns-1",
+			WorkflowID:  "wid",
+			RunID:       "rid",
+		})
+		require.NoError(t, err)
+		require.Equal(t, "wid", resp.State.GetExecutionInfo().GetWorkflowId())
+		return resp
+	}
+	require.False(t, getWorkflowExecution().NeedsReencryption)
+
+	keyProvider.active = "b"
+	require.True(t, getWorkflowExecution().NeedsReencryption)
+}
@@ -31,6 +31,10 @@ type (
 		logger                log.Logger
 		pagingTokenSerializer *jsonHistoryTokenSerializer
 		transactionSizeLimit  dynamicconfig.IntPropertyFn
+
+		historyBlobStore            HistoryBlobStore
+		historyBlobOffloadThreshold dynamicconfig.IntPropertyFn
+		encryptor                   *serialization.Encryptor
 	}
 )
 
@@ -43,14 +47,20 @@ func NewExecutionManager(
 	eventBlobCache XDCCache,
 	logger log.Logger,
 	transactionSizeLimit dynamicconfig.IntPropertyFn,
+	historyBlobStore HistoryBlobStore,
+	historyBlobOffloadThreshold dynamicconfig.IntPropertyFn,
+	encryptor *serialization.Encryptor,
 ) ExecutionManager {
 	return &executionManagerImpl{
-		serializer:            serializer,
-		eventBlobCache:        eventBlobCache,
-		persistence:           persistence,
-		logger:                logger,
-		pagingTokenSerializer: newJSONHistoryTokenSerializer(),
-		transactionSizeLimit:  transactionSizeLimit,
+		serializer:                  serializer,
+		eventBlobCache:              eventBlobCache,
+		persistence:                 persistence,
+		logger:                      logger,
+		pagingTokenSerializer:       newJSONHistoryTokenSerializer(),
+		transactionSizeLimit:        transactionSizeLimit,
+		historyBlobStore:            historyBlobStore,
+		historyBlobOffloadThreshold: historyBlobOffloadThreshold,
+		encryptor:                   encryptor,
 	}
 }
 
@@ -383,6 +393,10 @@ func (m *executionManagerImpl) GetWorkflowExecution(
 		// try to utilize resp as much as possible, for RebuildMutableState API
 		return nil, respErr
 	}
+	needsReencryption, err := m.mutableStateNeedsReencryption(request.NamespaceID, response.State)
+	if err != nil {
+		return nil, err
+	}
 	state, err := m.toWorkflowMutableState(response.State)
 	if err != nil {
 		return nil, err
@@ -397,6 +411,7 @@ func (m *executionManagerImpl) GetWorkflowExecution(
 		State:             state,
 		DBRecordVersion:   response.DBRecordVersion,
 		MutableStateStats: *statusOfInternalWorkflow(response.State, state, nil),
+		NeedsReencryption: needsReencryption,
 	}
 	return newResponse, respErr
 }
@@ -461,9 +476,16 @@ func (m *executionManagerImpl) serializeWorkflowEventBatches(
 			workflowEvents.Events[len(workflowEvents.Events)-1].EventId+1,
 		)
 		newEvents.ShardID = shardID
-		workflowNewEvents = append(workflowNewEvents, newEvents)
 		historyStatistics.SizeDiff += len(newEvents.Node.Events.Data)
 		historyStatistics.CountDiff += len(workflowEvents.Events)
+		// encryption and offloading replace the node's blob, the XDC cache and statistics above keep the original one
+		if err := m.encryptHistoryNode(workflowEvents.NamespaceID, newEvents); err != nil {
+			return nil, nil, nil, err
+		}
+		if err := m.offloadHistoryNode(ctx, newEvents, 0); err != nil {
+			return nil, nil, nil, err
+		}
+		workflowNewEvents = append(workflowNewEvents, newEvents)
 	}
 	return xdcKVs, workflowNewEvents, &historyStatistics, nil
 }
@@ -642,6 +664,9 @@ func (m *executionManagerImpl) SerializeWorkflowMutation( // unexport
 	if err != nil {
 		return nil, err
 	}
+	if err := m.encryptWorkflowMutation(result); err != nil {
+		return nil, err
+	}
 
 	return result, nil
 }
@@ -739,6 +764,9 @@ func (m *executionManagerImpl) SerializeWorkflowSnapshot( // unexport
 	if err != nil {
 		return nil, err
 	}
+	if err := m.encryptWorkflowSnapshot(result); err != nil {
+		return nil, err
+	}
 
 	return result, nil
 }
@@ -995,6 +1023,9 @@ func (m *executionManagerImpl) trimHistoryNode(
 }
 
 func (m *executionManagerImpl) toWorkflowMutableState(internState *InternalWorkflowMutableState) (*persistencespb.WorkflowMutableState, error) {
+	if err := m.decryptWorkflowMutableState(internState); err != nil {
+		return nil, err
+	}
 	state := &persistencespb.WorkflowMutableState{
 		ActivityInfos:       make(map[int64]*persistencespb.ActivityInfo),
 		TimerInfos:          make(map[string]*persistencespb.TimerInfo),
@@ -0,0 +1,186 @@
+package persistence
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+
+	commonpb "go.temporal.io/api/common/v1"
+	"go.temporal.io/api/serviceerror"
+	persistencespb "go.temporal.io/server/api/persistence/v1"
+)
+
+type (
+	// HistoryBlobStore is an object store used to offload large history node blobs
+	// out of the history_node table. Implementations must be safe for concurrent use.
+	HistoryBlobStore interface {
+		// PutBlob stores the blob under the given key, overwriting any existing blob
+		PutBlob(ctx context.Context, key HistoryBlobKey, data []byte) error
+		// GetBlob returns the blob stored under the given key
+		GetBlob(ctx context.Context, key HistoryBlobKey) ([]byte, error)
+		// DeleteBlob removes the blob stored under the given key, it is not an error if the blob doesn't exist
+		DeleteBlob(ctx context.Context, key HistoryBlobKey) error
+		// DeleteBranchBlobs removes all blobs of the branch with node ID greater than or equal to minNodeID
+		DeleteBranchBlobs(ctx context.Context, treeID string, branchID string, minNodeID int64) error
+	}
+
+	// HistoryBlobKey identifies an offloaded history node blob
+	HistoryBlobKey struct {
+		TreeID        string `json:"tree_id"`
+		BranchID      string `json:"branch_id"`
+		NodeID        int64  `json:"node_id"`
+		TransactionID int64  `json:"transaction_id"`
+		// Generation is bumped every time the blob of a node is replaced, e.g. when it is re-encrypted.
+		// The replacement is written under a new key so readers holding the previous reference can
+		// still resolve it until the reference is switched.
+		Generation int64 `json:"generation,omitempty"`
+	}
+)
+
+// replacedHistoryNodePageSize bounds the read of the node versions sharing the node ID of an
+// overwritten node, there are only more than one if earlier transactions failed.
+const replacedHistoryNodePageSize = 100
+
+// historyBlobRefPrefix marks the data of a history node which only holds a reference to an offloaded blob.
+// A serialized history batch can never start with a zero byte (field number 0 is invalid in proto3 and
+// JSON can't start with it either), so the prefix can't collide with an inline blob.
+var historyBlobRefPrefix = []byte("\x00temporal-history-blob-ref\x00")
+
+func newHistoryBlobRef(key HistoryBlobKey, encoding *commonpb.DataBlob) (*commonpb.DataBlob, error) {
+	data, err := json.Marshal(key)
+	if err != nil {
+		return nil, err
+	}
+	return &commonpb.DataBlob{
+		EncodingType: encoding.EncodingType,
+		Data:         append(bytes.Clone(historyBlobRefPrefix), data...),
+	}, nil
+}
+
+// parseHistoryBlobRef returns the key of the offloaded blob if the given blob is a reference
+func parseHistoryBlobRef(blob *commonpb.DataBlob) (HistoryBlobKey, bool, error) {
+	var key HistoryBlobKey
+	if blob == nil || !bytes.HasPrefix(blob.Data, historyBlobRefPrefix) {
+		return key, false, nil
+	}
+	if err := json.Unmarshal(blob.Data[len(historyBlobRefPrefix):], &key); err != nil {
+		return key, true, serviceerror.NewDataLoss(fmt.Sprintf("corrupted history blob reference: %v", err))
+	}
+	return key, true, nil
+}
+
+// offloadHistoryNode moves the events blob of the node to the history blob store if it
+// exceeds the offload threshold and replaces it with a reference.
+// The original blob is never mutated so callers may keep using it.
+func (m *executionManagerImpl) offloadHistoryNode(
+	ctx context.Context,
+	request *InternalAppendHistoryNodesRequest,
+	generation int64,
+) error {
+	if m.historyBlobStore == nil || m.historyBlobOffloadThreshold == nil {
+		return nil
+	}
+	threshold := m.historyBlobOffloadThreshold()
+	blob := request.Node.Events
+	if threshold <= 0 || blob == nil || len(blob.Data) <= threshold {
+		return nil
+	}
+
+	key := HistoryBlobKey{
+		TreeID:        request.BranchInfo.GetTreeId(),
+		BranchID:      request.BranchInfo.GetBranchId(),
+		NodeID:        request.Node.NodeID,
+		TransactionID: request.Node.TransactionID,
+		Generation:    generation,
+	}
+	// The blob is written before the history node. If the node write fails the blob is either
+	// overwritten by a retry or removed together with the branch.
+	if err := m.historyBlobStore.PutBlob(ctx, key, blob.Data); err != nil {
+		return err
+	}
+	ref, err := newHistoryBlobRef(key, blob)
+	if err != nil {
+		return err
+	}
+	request.Node.Events = ref
+	return nil
+}
+
+// replacedHistoryBlob returns the key of the offloaded blob of the node which is about to be
+// overwritten by the request, or nil if that node is stored inline.
+func (m *executionManagerImpl) replacedHistoryBlob(
+	ctx context.Context,
+	request *InternalAppendHistoryNodesRequest,
+) (*HistoryBlobKey, error) {
+	if m.historyBlobStore == nil {
+		return nil, nil
+	}
+	resp, err := m.persistence.ReadHistoryBranch(ctx, &InternalReadHistoryBranchRequest{
+		BranchToken: request.BranchToken,
+		ShardID:     request.ShardID,
+		BranchID:    request.BranchInfo.GetBranchId(),
+		MinNodeID:   request.Node.NodeID,
+		MaxNodeID:   request.Node.NodeID + 1,
+		PageSize:    replacedHistoryNodePageSize,
+	})
+	if err != nil {
+		return nil, err
+	}
+	for _, node := range resp.Nodes {
+		if node.NodeID != request.Node.NodeID || node.TransactionID != request.Node.TransactionID {
+			continue
+		}
+		key, offloaded, err := parseHistoryBlobRef(node.Events)
+		if err != nil || !offloaded {
+			return nil, err
+		}
+		return &key, nil
+	}
+	return nil, nil
+}
+
+// resolveHistoryNodes replaces blob references of the nodes with the offloaded blobs
+func (m *executionManagerImpl) resolveHistoryNodes(
+	ctx context.Context,
+	nodes []InternalHistoryNode,
+) error {
+	for i := range nodes {
+		key, ok, err := parseHistoryBlobRef(nodes[i].Events)
+		if err != nil {
+			return err
+		}
+		if !ok {
+			continue
+		}
+		if m.historyBlobStore == nil {
+			return serviceerror.NewDataLoss("history node references an offloaded blob but no history blob store is configured")
+		}
+		data, err := m.historyBlobStore.GetBlob(ctx, key)
+		if err != nil {
+			return err
+		}
+		nodes[i].Events = &commonpb.DataBlob{
+			EncodingType: nodes[i].Events.EncodingType,
+			Data:         data,
+		}
+	}
+	return nil
+}
+
+// deleteOffloadedBranchBlobs garbage collects the offloaded blobs of the deleted branch ranges
+func (m *executionManagerImpl) deleteOffloadedBranchBlobs(
+	ctx context.Context,
+	branch *persistencespb.HistoryBranch,
+	ranges []InternalDeleteHistoryBranchRange,
+) error {
+	if m.historyBlobStore == nil {
+		return nil
+	}
+	for _, r := range ranges {
+		if err := m.historyBlobStore.DeleteBranchBlobs(ctx, branch.GetTreeId(), r.BranchId, r.BeginNodeId); err != nil {
+			return err
+		}
+	}
+	return nil
+}
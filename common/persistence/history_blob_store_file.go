@@ -0,0 +1,177 @@
+package persistence
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+
+	"go.temporal.io/api/serviceerror"
+)
+
+const (
+	// DefaultHistoryBlobFileMode is the file mode used by the filestore history blob store if none is configured
+	DefaultHistoryBlobFileMode os.FileMode = 0o600
+	// DefaultHistoryBlobDirMode is the directory mode used by the filestore history blob store if none is configured
+	DefaultHistoryBlobDirMode os.FileMode = 0o700
+
+	historyBlobFileSeparator = "_"
+	historyBlobTempSuffix    = ".tmp"
+)
+
+type (
+	// fileHistoryBlobStore is a HistoryBlobStore backed by the local file system.
+	// Blobs are stored as <root>/<tree ID>/<branch ID>/<node ID>_<transaction ID>, followed by
+	// _<generation> for replaced blobs.
+	fileHistoryBlobStore struct {
+		root     string
+		fileMode os.FileMode
+		dirMode  os.FileMode
+	}
+)
+
+var _ HistoryBlobStore = (*fileHistoryBlobStore)(nil)
+
+// NewFileHistoryBlobStore returns a HistoryBlobStore which stores blobs in the given directory
+func NewFileHistoryBlobStore(
+	root string,
+	fileMode os.FileMode,
+	dirMode os.FileMode,
+) (HistoryBlobStore, error) {
+	if len(root) == 0 {
+		return nil, errors.New("history blob store path is empty")
+	}
+	if err := os.MkdirAll(root, dirMode); err != nil {
+		return nil, err
+	}
+	return &fileHistoryBlobStore{
+		root:     root,
+		fileMode: fileMode,
+		dirMode:  dirMode,
+	}, nil
+}
+
+func (s *fileHistoryBlobStore) PutBlob(
+	_ context.Context,
+	key HistoryBlobKey,
+	data []byte,
+) error {
+	dir, err := s.branchDir(key.TreeID, key.BranchID)
+	if err != nil {
+		return err
+	}
+	if err := os.MkdirAll(dir, s.dirMode); err != nil {
+		return err
+	}
+
+	// write to a temporary file first so readers never observe a partially written blob
+	path := filepath.Join(dir, blobFileName(key))
+	tmp := path + historyBlobTempSuffix
+	if err := os.WriteFile(tmp, data, s.fileMode); err != nil {
+		return err
+	}
+	return os.Rename(tmp, path)
+}
+
+func (s *fileHistoryBlobStore) GetBlob(
+	_ context.Context,
+	key HistoryBlobKey,
+) ([]byte, error) {
+	dir, err := s.branchDir(key.TreeID, key.BranchID)
+	if err != nil {
+		return nil, err
+	}
+	data, err := os.ReadFile(filepath.Join(dir, blobFileName(key)))
+	if os.IsNotExist(err) {
+		return nil, serviceerror.NewDataLoss(fmt.Sprintf("offloaded history blob not found: %+v", key))
+	}
+	return data, err
+}
+
+func (s *fileHistoryBlobStore) DeleteBlob(
+	_ context.Context,
+	key HistoryBlobKey,
+) error {
+	dir, err := s.branchDir(key.TreeID, key.BranchID)
+	if err != nil {
+		return err
+	}
+	if err := os.Remove(filepath.Join(dir, blobFileName(key))); err != nil && !os.IsNotExist(err) {
+		return err
+	}
+	return nil
+}
+
+func (s *fileHistoryBlobStore) DeleteBranchBlobs(
+	_ context.Context,
+	treeID string,
+	branchID string,
+	minNodeID int64,
+) error {
+	dir, err := s.branchDir(treeID, branchID)
+	if err != nil {
+		return err
+	}
+	entries, err := os.ReadDir(dir)
+	if os.IsNotExist(err) {
+		return nil
+	} else if err != nil {
+		return err
+	}
+
+	remaining := 0
+	for _, entry := range entries {
+		nodeID, ok := parseBlobFileNodeID(entry.Name())
+		if ok && nodeID < minNodeID {
+			remaining++
+			continue
+		}
+		if err := os.Remove(filepath.Join(dir, entry.Name())); err != nil && !os.IsNotExist(err) {
+			return err
+		}
+	}
+	if remaining > 0 {
+		return nil
+	}
+
+	// best effort clean up of the now empty directories, the tree directory
+	// may still be in use by other branches in which case removal fails
+	_ = os.Remove(dir)
+	_ = os.Remove(filepath.Dir(dir))
+	return nil
+}
+
+func (s *fileHistoryBlobStore) branchDir(treeID string, branchID string) (string, error) {
+	for _, id := range []string{treeID, branchID} {
+		if len(id) == 0 || id != filepath.Base(id) || id == "." || id == ".." {
+			return "", &InvalidPersistenceRequestError{
+				Msg: fmt.Sprintf("invalid history blob tree or branch ID: %q", id),
+			}
+		}
+	}
+	return filepath.Join(s.root, treeID, branchID), nil
+}
+
+func blobFileName(key HistoryBlobKey) string {
+	name := strconv.FormatInt(key.NodeID, 10) + historyBlobFileSeparator + strconv.FormatInt(key.TransactionID, 10)
+	if key.Generation > 0 {
+		name += historyBlobFileSeparator + strconv.FormatInt(key.Generation, 10)
+	}
+	return name
+}
+
+func parseBlobFileNodeID(name string) (int64, bool) {
+	name = strings.TrimSuffix(name, historyBlobTempSuffix)
+	nodeID, _, found := strings.Cut(name, historyBlobFileSeparator)
+	if !found {
+		return 0, false
+	}
+	id, err := strconv.ParseInt(nodeID, 10, 64)
+	if err != nil {
+		return 0, false
+	}
+	return id, true
+}
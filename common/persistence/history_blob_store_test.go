@@ -0,0 +1,354 @@
+package persistence_test
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	commonpb "go.temporal.io/api/common/v1"
+	enumspb "go.temporal.io/api/enums/v1"
+	historypb "go.temporal.io/api/history/v1"
+	"go.temporal.io/api/serviceerror"
+	persistencespb "go.temporal.io/server/api/persistence/v1"
+	"go.temporal.io/server/common/dynamicconfig"
+	"go.temporal.io/server/common/log"
+	"go.temporal.io/server/common/persistence"
+	"go.temporal.io/server/common/persistence/mock"
+	"go.temporal.io/server/common/persistence/serialization"
+	"go.temporal.io/server/common/primitives"
+	"go.uber.org/mock/gomock"
+)
+
+func TestFileHistoryBlobStore(t *testing.T) {
+	ctx := context.Background()
+	store, err := persistence.NewFileHistoryBlobStore(t.TempDir(), persistence.DefaultHistoryBlobFileMode, persistence.DefaultHistoryBlobDirMode)
+	require.NoError(t, err)
+
+	key := func(branchID string, nodeID int64) persistence.HistoryBlobKey {
+		return persistence.HistoryBlobKey{TreeID: "tree", BranchID: branchID, NodeID: nodeID, TransactionID: nodeID * 10}
+	}
+	for _, k := range []persistence.HistoryBlobKey{key("a", 1), key("a", 5), key("a", 9), key("b", 1)} {
+		require.NoError(t, store.PutBlob(ctx, k, []byte(k.BranchID)))
+	}
+
+	data, err := store.GetBlob(ctx, key("a", 5))
+	require.NoError(t, err)
+	require.Equal(t, []byte("a"), data)
+
+	require.NoError(t, store.DeleteBlob(ctx, key("a", 1)))
+	require.NoError(t, store.DeleteBlob(ctx, key("a", 1)))
+	_, err = store.GetBlob(ctx, key("a", 1))
+	var dataLoss *serviceerror.DataLoss
+	require.ErrorAs(t, err, &dataLoss)
+
+	require.NoError(t, store.DeleteBranchBlobs(ctx, "tree", "a", 6))
+	_, err = store.GetBlob(ctx, key("a", 5))
+	require.NoError(t, err)
+	_, err = store.GetBlob(ctx, key("a", 9))
+	require.ErrorAs(t, err, &dataLoss)
+	_, err = store.GetBlob(ctx, key("b", 1))
+	require.NoError(t, err)
+
+	require.NoError(t, store.DeleteBranchBlobs(ctx, "tree", "missing", 1))
+
+	err = store.PutBlob(ctx, persistence.HistoryBlobKey{TreeID: "..", BranchID: "a"}, nil)
+	var invalidRequest *persistence.InvalidPersistenceRequestError
+	require.ErrorAs(t, err, &invalidRequest)
+}
+
+func TestExecutionManager_HistoryBlobOffload(t *testing.T) {
+	ctx := context.Background()
+	ctrl := gomock.NewController(t)
+	executionStore := mock.NewMockExecutionStore(ctrl)
+	executionStore.EXPECT().GetHistoryBranchUtil().Return(&persistence.HistoryBranchUtilImpl{}).AnyTimes()
+
+	blobStore, err := persistence.NewFileHistoryBlobStore(t.TempDir(), persistence.DefaultHistoryBlobFileMode, persistence.DefaultHistoryBlobDirMode)
+	require.NoError(t, err)
+	serializer := serialization.NewSerializer()
+	manager := persistence.NewExecutionManager(
+		executionStore,
+		serializer,
+		nil,
+		log.NewNoopLogger(),
+		dynamicconfig.GetIntPropertyFn(4*1024*1024),
+		blobStore,
+		dynamicconfig.GetIntPropertyFn(1024),
+		nil,
+	)
+
+	treeID := primitives.NewUUID().String()
+	branchToken, err := (&persistence.HistoryBranchUtilImpl{}).NewHistoryBranch("", "", "", treeID, nil, nil, 0, 0, 0)
+	require.NoError(t, err)
+	branch, err := (&persistence.HistoryBranchUtilImpl{}).ParseHistoryBranchInfo(branchToken)
+	require.NoError(t, err)
+
+	newEvent := func(eventID int64, payloadSize int) *historypb.HistoryEvent {
+		return &historypb.HistoryEvent{
+			EventId:   eventID,
+			Version:   1,
+			EventType: enumspb.EVENT_TYPE_WORKFLOW_EXECUTION_SIGNALED,
+			Attributes: &historypb.HistoryEvent_WorkflowExecutionSignaledEventAttributes{
+				WorkflowExecutionSignaledEventAttributes: &historypb.WorkflowExecutionSignaledEventAttributes{
+					SignalName: strings.Repeat("s", payloadSize),
+				},
+			},
+		}
+	}
+
+	var stored []persistence.InternalHistoryNode
+	executionStore.EXPECT().AppendHistoryNodes(gomock.Any(), gomock.Any()).DoAndReturn(
+		func(_ context.Context, request *persistence.InternalAppendHistoryNodesRequest) error {
+			stored = append(stored, request.Node)
+			return nil
+		},
+	).Times(2)
+
+	smallEvents := []*historypb.HistoryEvent{newEvent(1, 10)}
+	_, err = manager.AppendHistoryNodes(ctx, &persistence.AppendHistoryNodesRequest{
+		BranchToken:   branchToken,
+		Events:        smallEvents,
+		TransactionID: 1,
+	})
+	require.NoError(t, err)
+
+	largeEvents := []*historypb.HistoryEvent{newEvent(2, 4096)}
+	resp, err := manager.AppendHistoryNodes(ctx, &persistence.AppendHistoryNodesRequest{
+		BranchToken:       branchToken,
+		Events:            largeEvents,
+		TransactionID:     2,
+		PrevTransactionID: 1,
+	})
+	require.NoError(t, err)
+	require.Greater(t, resp.Size, 4096)
+
+	// only the large node is offloaded, the history node holds a small reference instead
+	require.Len(t, stored, 2)
+	require.Less(t, len(stored[1].Events.Data), 1024)
+	offloaded, err := blobStore.GetBlob(ctx, persistence.HistoryBlobKey{
+		TreeID:        treeID,
+		BranchID:      branch.BranchId,
+		NodeID:        2,
+		TransactionID: 2,
+	})
+	require.NoError(t, err)
+	require.Len(t, offloaded, resp.Size)
+
+	executionStore.EXPECT().ReadHistoryBranch(gomock.Any(), gomock.Any()).Return(
+		&persistence.InternalReadHistoryBranchResponse{Nodes: stored},
+		nil,
+	)
+	readResp, err := manager.ReadHistoryBranch(ctx, &persistence.ReadHistoryBranchRequest{
+		BranchToken: branchToken,
+		MinEventID:  1,
+		MaxEventID:  3,
+		PageSize:    10,
+	})
+	require.NoError(t, err)
+	require.Len(t, readResp.HistoryEvents, 2)
+	require.Equal(t, largeEvents[0].GetWorkflowExecutionSignaledEventAttributes().GetSignalName(),
+		readResp.HistoryEvents[1].GetWorkflowExecutionSignaledEventAttributes().GetSignalName())
+
+	treeInfo, err := serializer.HistoryTreeInfoToBlob(&persistencespb.HistoryTreeInfo{
+		BranchToken: branchToken,
+		BranchInfo:  branch,
+	})
+	require.NoError(t, err)
+	executionStore.EXPECT().GetHistoryTreeContainingBranch(gomock.Any(), gomock.Any()).Return(
+		&persistence.InternalGetHistoryTreeContainingBranchResponse{TreeInfos: []*commonpb.DataBlob{treeInfo}},
+		nil,
+	)
+	executionStore.EXPECT().DeleteHistoryBranch(gomock.Any(), gomock.Any()).Return(nil)
+	require.NoError(t, manager.DeleteHistoryBranch(ctx, &persistence.DeleteHistoryBranchRequest{
+		BranchToken: branchToken,
+	}))
+	_, err = blobStore.GetBlob(ctx, persistence.HistoryBlobKey{
+		TreeID:        treeID,
+		BranchID:      branch.BranchId,
+		NodeID:        2,
+		TransactionID: 2,
+	})
+	var dataLoss *serviceerror.DataLoss
+	require.ErrorAs(t, err, &dataLoss)
+}
+
+func TestExecutionManager_HistoryBlobOverwrite(t *testing.T) {
+	ctx := context.Background()
+	ctrl := gomock.NewController(t)
+	executionStore := mock.NewMockExecutionStore(ctrl)
+	executionStore.EXPECT().GetHistoryBranchUtil().Return(&persistence.HistoryBranchUtilImpl{}).AnyTimes()
+	var stored *persistence.InternalHistoryNode
+	executionStore.EXPECT().AppendHistoryNodes(gomock.Any(), gomock.Any()).DoAndReturn(
+		func(_ context.Context, request *persistence.InternalAppendHistoryNodesRequest) error {
+			stored = &request.Node
+			return nil
+		},
+	).Times(3)
+	executionStore.EXPECT().ReadHistoryBranch(gomock.Any(), gomock.Any()).DoAndReturn(
+		func(_ context.Context, _ *persistence.InternalReadHistoryBranchRequest) (*persistence.InternalReadHistoryBranchResponse, error) {
+			return &persistence.InternalReadHistoryBranchResponse{Nodes: []persistence.InternalHistoryNode{*stored}}, nil
+		},
+	).Times(2)
+
+	blobStore, err := persistence.NewFileHistoryBlobStore(t.TempDir(), persistence.DefaultHistoryBlobFileMode, persistence.DefaultHistoryBlobDirMode)
+	require.NoError(t, err)
+	manager := persistence.NewExecutionManager(
+		executionStore,
+		serialization.NewSerializer(),
+		nil,
+		log.NewNoopLogger(),
+		dynamicconfig.GetIntPropertyFn(4*1024*1024),
+		blobStore,
+		dynamicconfig.GetIntPropertyFn(1024),
+		nil,
+	)
+
+	treeID := primitives.NewUUID().String()
+	branchToken, err := (&persistence.HistoryBranchUtilImpl{}).NewHistoryBranch("", "", "", treeID, nil, nil, 0, 0, 0)
+	require.NoError(t, err)
+	branch, err := (&persistence.HistoryBranchUtilImpl{}).ParseHistoryBranchInfo(branchToken)
+	require.NoError(t, err)
+	blobKey := persistence.HistoryBlobKey{
+		TreeID:        treeID,
+		BranchID:      branch.BranchId,
+		NodeID:        1,
+		TransactionID: 1,
+	}
+
+	newEvents := func(payloadSize int) []*historypb.HistoryEvent {
+		return []*historypb.HistoryEvent{{
+			EventId:   1,
+			Version:   1,
+			EventType: enumspb.EVENT_TYPE_WORKFLOW_EXECUTION_SIGNALED,
+			Attributes: &historypb.HistoryEvent_WorkflowExecutionSignaledEventAttributes{
+				WorkflowExecutionSignaledEventAttributes: &historypb.WorkflowExecutionSignaledEventAttributes{
+					SignalName: strings.Repeat("s", payloadSize),
+				},
+			},
+		}}
+	}
+
+	_, err = manager.AppendHistoryNodes(ctx, &persistence.AppendHistoryNodesRequest{
+		BranchToken:   branchToken,
+		Events:        newEvents(4096),
+		TransactionID: 1,
+	})
+	require.NoError(t, err)
+	_, err = blobStore.GetBlob(ctx, blobKey)
+	require.NoError(t, err)
+
+	// the replacing blob is written under a new key and the replaced one is deleted afterward
+	_, err = manager.AppendHistoryNodes(ctx, &persistence.AppendHistoryNodesRequest{
+		BranchToken:   branchToken,
+		Events:        newEvents(8192),
+		TransactionID: 1,
+		Overwrite:     true,
+	})
+	require.NoError(t, err)
+	_, err = blobStore.GetBlob(ctx, blobKey)
+	var dataLoss *serviceerror.DataLoss
+	require.ErrorAs(t, err, &dataLoss)
+	replacingKey := blobKey
+	replacingKey.Generation = 1
+	_, err = blobStore.GetBlob(ctx, replacingKey)
+	require.NoError(t, err)
+
+	// the node is replaced by one small enough to be stored inline, the offloaded blob goes away
+	_, err = manager.AppendHistoryNodes(ctx, &persistence.AppendHistoryNodesRequest{
+		BranchToken:   branchToken,
+		Events:        newEvents(10),
+		TransactionID: 1,
+		Overwrite:     true,
+	})
+	require.NoError(t, err)
+	_, err = blobStore.GetBlob(ctx, replacingKey)
+	require.ErrorAs(t, err, &dataLoss)
+}
+
+type countingHistoryBlobStore struct {
+	persistence.HistoryBlobStore
+	deleted []persistence.HistoryBlobKey
+}
+
+func (s *countingHistoryBlobStore) DeleteBlob(ctx context.Context, key persistence.HistoryBlobKey) error {
+	s.deleted = append(s.deleted, key)
+	return s.HistoryBlobStore.DeleteBlob(ctx, key)
+}
+
+func TestExecutionManager_HistoryBlobTrim(t *testing.T) {
+	ctx := context.Background()
+	ctrl := gomock.NewController(t)
+	executionStore := mock.NewMockExecutionStore(ctrl)
+	executionStore.EXPECT().GetHistoryBranchUtil().Return(&persistence.HistoryBranchUtilImpl{}).AnyTimes()
+
+	fileStore, err := persistence.NewFileHistoryBlobStore(t.TempDir(), persistence.DefaultHistoryBlobFileMode, persistence.DefaultHistoryBlobDirMode)
+	require.NoError(t, err)
+	blobStore := &countingHistoryBlobStore{HistoryBlobStore: fileStore}
+	manager := persistence.NewExecutionManager(
+		executionStore,
+		serialization.NewSerializer(),
+		nil,
+		log.NewNoopLogger(),
+		dynamicconfig.GetIntPropertyFn(4*1024*1024),
+		blobStore,
+		dynamicconfig.GetIntPropertyFn(1024),
+		nil,
+	)
+
+	treeID := primitives.NewUUID().String()
+	branchToken, err := (&persistence.HistoryBranchUtilImpl{}).NewHistoryBranch("", "", "", treeID, nil, nil, 0, 0, 0)
+	require.NoError(t, err)
+	branch, err := (&persistence.HistoryBranchUtilImpl{}).ParseHistoryBranchInfo(branchToken)
+	require.NoError(t, err)
+
+	var stored []persistence.InternalHistoryNode
+	executionStore.EXPECT().AppendHistoryNodes(gomock.Any(), gomock.Any()).DoAndReturn(
+		func(_ context.Context, request *persistence.InternalAppendHistoryNodesRequest) error {
+			stored = append(stored, request.Node)
+			return nil
+		},
+	).AnyTimes()
+	appendNode := func(eventID int64, transactionID int64, prevTransactionID int64, payloadSize int) {
+		_, err := manager.AppendHistoryNodes(ctx, &persistence.AppendHistoryNodesRequest{
+			BranchToken: branchToken,
+			Events: []*historypb.HistoryEvent{{
+				EventId:   eventID,
+				Version:   1,
+				EventType: enumspb.EVENT_TYPE_WORKFLOW_EXECUTION_SIGNALED,
+				Attributes: &historypb.HistoryEvent_WorkflowExecutionSignaledEventAttributes{
+					WorkflowExecutionSignaledEventAttributes: &historypb.WorkflowExecutionSignaledEventAttributes{
+						SignalName: strings.Repeat("s", payloadSize),
+					},
+				},
+			}},
+			TransactionID:     transactionID,
+			PrevTransactionID: prevTransactionID,
+		})
+		require.NoError(t, err)
+	}
+	appendNode(1, 1, 0, 10)
+	appendNode(2, 2, 1, 10)
+	// stale nodes left behind by failed transactions, only one of them is offloaded
+	appendNode(2, 3, 1, 10)
+	appendNode(2, 4, 1, 4096)
+
+	executionStore.EXPECT().ReadHistoryBranch(gomock.Any(), gomock.Any()).DoAndReturn(
+		func(_ context.Context, request *persistence.InternalReadHistoryBranchRequest) (*persistence.InternalReadHistoryBranchResponse, error) {
+			require.False(t, request.MetadataOnly)
+			return &persistence.InternalReadHistoryBranchResponse{Nodes: stored}, nil
+		},
+	)
+	executionStore.EXPECT().DeleteHistoryNodes(gomock.Any(), gomock.Any()).Return(nil).Times(2)
+	_, err = manager.TrimHistoryBranch(ctx, &persistence.TrimHistoryBranchRequest{
+		BranchToken:   branchToken,
+		NodeID:        2,
+		TransactionID: 2,
+	})
+	require.NoError(t, err)
+	require.Equal(t, []persistence.HistoryBlobKey{{
+		TreeID:        treeID,
+		BranchID:      branch.BranchId,
+		NodeID:        2,
+		TransactionID: 4,
+	}}, blobStore.deleted)
+}
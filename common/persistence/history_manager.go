@@ -18,7 +18,7 @@ const (
 	defaultLastNodeID        = common.FirstEventID - 1
 	defaultLastTransactionID = int64(0)
 
-	// TrimHistoryBranch will only dump metadata, relatively cheap
+	// TrimHistoryBranch only dumps metadata unless a history blob store is configured, relatively cheap
 	trimHistoryBranchPageSize = 1000
 	dataLossMsg               = "Potential data loss"
 	errNonContiguousEventID   = "corrupted history event batch, eventID is not contiguous"
@@ -201,7 +201,11 @@ findDeleteRanges:
 		ShardID:      request.ShardID,
 		BranchRanges: deleteRanges,
 	}
-	return m.persistence.DeleteHistoryBranch(ctx, req)
+	if err := m.persistence.DeleteHistoryBranch(ctx, req); err != nil {
+		return err
+	}
+	// offloaded blobs are only removed once the history nodes referencing them are gone
+	return m.deleteOffloadedBranchBlobs(ctx, branch, deleteRanges)
 }
 
 // TrimHistoryBranch trims a branch
@@ -251,7 +255,8 @@ func (m *executionManagerImpl) TrimHistoryBranch(
 			maxNodeID,
 			token,
 			pageSize,
-			true,
+			// node data is only needed to find out which nodes have offloaded blobs
+			m.historyBlobStore == nil,
 		)
 		if err != nil {
 			return nil, fmt.Errorf("unable to read raw history branch: %w", err)
@@ -259,6 +264,14 @@ func (m *executionManagerImpl) TrimHistoryBranch(
 
 		branchID := branchAncestors[token.CurrentRangeIndex].BranchId
 		for _, node := range nodes {
+			blobKey, offloaded, err := parseHistoryBlobRef(node.Events)
+			if err != nil {
+				return nil, err
+			}
+			var offloadedBlob *HistoryBlobKey
+			if offloaded {
+				offloadedBlob = &blobKey
+			}
 			transactionIDToNode[node.TransactionID] = historyNodeMetadata{
 				branchInfo: &persistencespb.HistoryBranch{
 					TreeId:    treeID,
@@ -268,6 +281,7 @@ func (m *executionManagerImpl) TrimHistoryBranch(
 				nodeID:            node.NodeID,
 				transactionID:     node.TransactionID,
 				prevTransactionID: node.PrevTransactionID,
+				offloadedBlob:     offloadedBlob,
 			}
 		}
 
@@ -297,6 +311,11 @@ func (m *executionManagerImpl) TrimHistoryBranch(
 		}); err != nil {
 			return nil, fmt.Errorf("unable to delete history nodes: %w", err)
 		}
+		if node.offloadedBlob != nil {
+			if err := m.historyBlobStore.DeleteBlob(ctx, *node.offloadedBlob); err != nil {
+				return nil, fmt.Errorf("unable to delete offloaded history blob: %w", err)
+			}
+		}
 	}
 
 	return &TrimHistoryBranchResponse{}, nil
@@ -484,10 +503,33 @@ func (m *executionManagerImpl) AppendHistoryNodes(
 		return nil, err
 	}
 
+	size := len(req.Node.Events.Data)
+	if err := m.encryptHistoryNode(historyNamespaceID(request.NamespaceID, request.Info), req); err != nil {
+		return nil, err
+	}
+	// An overwritten node's offloaded blob is never replaced in place, the new data goes to the next
+	// generation and the replaced blob is deleted once the node references the new data.
+	var replaced *HistoryBlobKey
+	if request.Overwrite {
+		if replaced, err = m.replacedHistoryBlob(ctx, req); err != nil {
+			return nil, err
+		}
+	}
+	var generation int64
+	if replaced != nil {
+		generation = replaced.Generation + 1
+	}
+	if err := m.offloadHistoryNode(ctx, req, generation); err != nil {
+		return nil, err
+	}
+
 	err = m.persistence.AppendHistoryNodes(ctx, req)
+	if err == nil && replaced != nil {
+		err = m.historyBlobStore.DeleteBlob(ctx, *replaced)
+	}
 
 	return &AppendHistoryNodesResponse{
-		Size: len(req.Node.Events.Data),
+		Size: size,
 	}, err
 }
 
@@ -501,6 +543,12 @@ func (m *executionManagerImpl) AppendRawHistoryNodes(
 	if err != nil {
 		return nil, err
 	}
+	if err := m.encryptHistoryNode(historyNamespaceID(request.NamespaceID, request.Info), req); err != nil {
+		return nil, err
+	}
+	if err := m.offloadHistoryNode(ctx, req, 0); err != nil {
+		return nil, err
+	}
 
 	err = m.persistence.AppendHistoryNodes(ctx, req)
 	return &AppendHistoryNodesResponse{
@@ -715,6 +763,14 @@ func (m *executionManagerImpl) readRawHistoryBranchReverse(
 	if err != nil {
 		return nil, nil, err
 	}
+	if !metadataOnly {
+		if err := m.resolveHistoryNodes(ctx, resp.Nodes); err != nil {
+			return nil, nil, err
+		}
+		if err := m.decryptHistoryNodes(resp.Nodes); err != nil {
+			return nil, nil, err
+		}
+	}
 	token.StoreToken = resp.NextPageToken
 	return resp.Nodes, token, nil
 }
@@ -770,6 +826,12 @@ func (m *executionManagerImpl) readRawHistoryBranchAndFilter(
 	if err != nil {
 		return nil, nil, nil, nil, 0, err
 	}
+	if err := m.resolveHistoryNodes(ctx, nodes); err != nil {
+		return nil, nil, nil, nil, 0, err
+	}
+	if err := m.decryptHistoryNodes(nodes); err != nil {
+		return nil, nil, nil, nil, 0, err
+	}
 	if len(nodes) == 0 && len(request.NextPageToken) == 0 {
 		return nil, nil, nil, nil, 0, serviceerror.NewNotFound("Workflow execution history not found.")
 	}
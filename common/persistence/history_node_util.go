@@ -16,6 +16,8 @@ type (
 		nodeID            int64
 		transactionID     int64
 		prevTransactionID int64
+		// offloadedBlob is the key of the node's blob in the history blob store, nil if the node is stored inline
+		offloadedBlob *HistoryBlobKey
 	}
 )
 
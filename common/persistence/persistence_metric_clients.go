@@ -905,6 +905,20 @@ func (p *executionPersistenceClient) TrimHistoryBranch(
 	return p.persistence.TrimHistoryBranch(ctx, request)
 }
 
+// ReencryptHistoryBranch re-encrypts a branch
+func (p *executionPersistenceClient) ReencryptHistoryBranch(
+	ctx context.Context,
+	request *ReencryptHistoryBranchRequest,
+) (_ *ReencryptHistoryBranchResponse, retErr error) {
+	caller := headers.GetCallerInfo(ctx).CallerName
+	startTime := time.Now().UTC()
+	defer func() {
+		p.healthSignals.Record(request.ShardID, time.Since(startTime), retErr)
+		p.recordRequestMetrics(metrics.PersistenceReencryptHistoryBranchScope, caller, time.Since(startTime), retErr)
+	}()
+	return p.persistence.ReencryptHistoryBranch(ctx, request)
+}
+
 func (p *executionPersistenceClient) GetAllHistoryTreeBranches(
 	ctx context.Context,
 	request *GetAllHistoryTreeBranchesRequest,
@@ -854,6 +854,18 @@ func (p *executionRateLimitedPersistenceClient) TrimHistoryBranch(
 	return resp, err
 }
 
+// ReencryptHistoryBranch re-encrypts a branch
+func (p *executionRateLimitedPersistenceClient) ReencryptHistoryBranch(
+	ctx context.Context,
+	request *ReencryptHistoryBranchRequest,
+) (*ReencryptHistoryBranchResponse, error) {
+	if err := allow(ctx, "ReencryptHistoryBranch", request.ShardID, p.systemRateLimiter, p.namespaceRateLimiter, p.shardRateLimiter); err != nil {
+		return nil, err
+	}
+	resp, err := p.persistence.ReencryptHistoryBranch(ctx, request)
+	return resp, err
+}
+
 func (p *executionRateLimitedPersistenceClient) GetAllHistoryTreeBranches(
 	ctx context.Context,
 	request *GetAllHistoryTreeBranchesRequest,
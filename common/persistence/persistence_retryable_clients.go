@@ -582,6 +582,22 @@ func (p *executionRetryablePersistenceClient) TrimHistoryBranch(
 	return response, err
 }
 
+// ReencryptHistoryBranch re-encrypts a branch
+func (p *executionRetryablePersistenceClient) ReencryptHistoryBranch(
+	ctx context.Context,
+	request *ReencryptHistoryBranchRequest,
+) (*ReencryptHistoryBranchResponse, error) {
+	var response *ReencryptHistoryBranchResponse
+	op := func(ctx context.Context) error {
+		var err error
+		response, err = p.persistence.ReencryptHistoryBranch(ctx, request)
+		return err
+	}
+
+	err := backoff.ThrottleRetryContext(ctx, op, p.policy, p.isRetryable)
+	return response, err
+}
+
 func (p *executionRetryablePersistenceClient) GetAllHistoryTreeBranches(
 	ctx context.Context,
 	request *GetAllHistoryTreeBranchesRequest,
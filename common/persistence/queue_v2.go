@@ -8,6 +8,8 @@ const (
 	QueueTypeUnspecified   QueueV2Type = 0
 	QueueTypeHistoryNormal QueueV2Type = 1
 	QueueTypeHistoryDLQ    QueueV2Type = 2
+	// QueueTypeWorkerHeartbeat queues hold batches of worker heartbeats, one queue per namespace.
+	QueueTypeWorkerHeartbeat QueueV2Type = 3
 
 	// FirstQueueMessageID is the ID of the first message written to a queue partition.
 	FirstQueueMessageID = 0
@@ -0,0 +1,261 @@
+package serialization
+
+import (
+	"bytes"
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/rand"
+	"encoding/binary"
+	"errors"
+	"fmt"
+
+	commonpb "go.temporal.io/api/common/v1"
+	enumspb "go.temporal.io/api/enums/v1"
+)
+
+// EncodingTypeEncrypted is the encoding type of blobs which were envelope encrypted by an Encryptor.
+// It is not part of the public API and only ever appears in persistence, the encoding type of the
+// plaintext is recorded inside the envelope. Encrypted and plaintext blobs can be mixed freely since
+// every blob carries its own encoding type.
+const EncodingTypeEncrypted enumspb.EncodingType = 1001
+
+const (
+	envelopeVersion = byte(1)
+	dataKeySize     = 32 // AES-256
+)
+
+var (
+	// ErrNoKeyProvider is returned when an encrypted blob is read but encryption isn't configured
+	ErrNoKeyProvider = errors.New("blob is encrypted but no encryption key provider is configured")
+
+	errMalformedEnvelope = errors.New("malformed encryption envelope")
+)
+
+type (
+	// KeyProvider supplies the key encryption keys used for envelope encryption.
+	// Keys must be 16, 24 or 32 bytes long (AES-128, AES-192 or AES-256).
+	KeyProvider interface {
+		// ActiveKey returns the key new data of the namespace is encrypted with.
+		// It returns false if data of the namespace should not be encrypted.
+		ActiveKey(namespaceID string) (EncryptionKey, bool, error)
+		// Key returns the key with the given ID. Retired keys must remain available
+		// for as long as data encrypted with them exists.
+		Key(keyID string) (EncryptionKey, error)
+	}
+
+	// EncryptionKey is a key encryption key
+	EncryptionKey struct {
+		ID       string
+		Material []byte
+	}
+
+	// Encryptor envelope encrypts blobs. Every blob is encrypted with a fresh data key which
+	// is in turn encrypted (wrapped) with the namespace's active key from the KeyProvider.
+	Encryptor struct {
+		keyProvider KeyProvider
+	}
+
+	envelope struct {
+		keyID      string
+		wrappedKey []byte
+		encoding   enumspb.EncodingType
+		ciphertext []byte
+	}
+)
+
+// NewEncryptor returns a new Encryptor using keys from the given KeyProvider
+func NewEncryptor(keyProvider KeyProvider) *Encryptor {
+	return &Encryptor{
+		keyProvider: keyProvider,
+	}
+}
+
+// IsEncrypted returns true if the blob was encrypted by an Encryptor
+func IsEncrypted(blob *commonpb.DataBlob) bool {
+	return blob != nil && blob.EncodingType == EncodingTypeEncrypted
+}
+
+// Encrypt encrypts the blob with the active key of the namespace. The blob is returned
+// unchanged if it is empty, already encrypted or if the namespace has no active key.
+func (e *Encryptor) Encrypt(namespaceID string, blob *commonpb.DataBlob) (*commonpb.DataBlob, error) {
+	if blob == nil || len(blob.Data) == 0 || IsEncrypted(blob) {
+		return blob, nil
+	}
+	key, ok, err := e.keyProvider.ActiveKey(namespaceID)
+	if err != nil || !ok {
+		return blob, err
+	}
+	return e.encrypt(key, blob)
+}
+
+// Decrypt returns the plaintext blob of an encrypted blob. Blobs which are not encrypted are returned unchanged.
+func (e *Encryptor) Decrypt(blob *commonpb.DataBlob) (*commonpb.DataBlob, error) {
+	if !IsEncrypted(blob) {
+		return blob, nil
+	}
+	env, err := parseEnvelope(blob.Data)
+	if err != nil {
+		return nil, NewDeserializationError(EncodingTypeEncrypted, err)
+	}
+	key, err := e.keyProvider.Key(env.keyID)
+	if err != nil {
+		return nil, err
+	}
+
+	dataKey, err := open(key.Material, env.wrappedKey, []byte(env.keyID))
+	if err != nil {
+		return nil, NewDeserializationError(EncodingTypeEncrypted, fmt.Errorf("unable to unwrap data key with key %q: %w", env.keyID, err))
+	}
+	data, err := open(dataKey, env.ciphertext, env.additionalData())
+	if err != nil {
+		return nil, NewDeserializationError(EncodingTypeEncrypted, err)
+	}
+	return &commonpb.DataBlob{
+		EncodingType: env.encoding,
+		Data:         data,
+	}, nil
+}
+
+// NeedsReencryption returns true if the blob is not encrypted with the active key of the namespace,
+// which is the case after the namespace's key was rotated or encryption was turned on or off.
+func (e *Encryptor) NeedsReencryption(namespaceID string, blob *commonpb.DataBlob) (bool, error) {
+	if blob == nil || len(blob.Data) == 0 {
+		return false, nil
+	}
+	key, ok, err := e.keyProvider.ActiveKey(namespaceID)
+	if err != nil {
+		return false, err
+	}
+	if !IsEncrypted(blob) {
+		return ok, nil
+	}
+	if !ok {
+		return true, nil
+	}
+	env, err := parseEnvelope(blob.Data)
+	if err != nil {
+		return false, NewDeserializationError(EncodingTypeEncrypted, err)
+	}
+	return env.keyID != key.ID, nil
+}
+
+// Reencrypt decrypts the blob and encrypts it again with the active key of the namespace
+func (e *Encryptor) Reencrypt(namespaceID string, blob *commonpb.DataBlob) (*commonpb.DataBlob, error) {
+	plaintext, err := e.Decrypt(blob)
+	if err != nil {
+		return nil, err
+	}
+	return e.Encrypt(namespaceID, plaintext)
+}
+
+func (e *Encryptor) encrypt(key EncryptionKey, blob *commonpb.DataBlob) (*commonpb.DataBlob, error) {
+	dataKey := make([]byte, dataKeySize)
+	if _, err := rand.Read(dataKey); err != nil {
+		return nil, NewSerializationError(EncodingTypeEncrypted, err)
+	}
+	wrappedKey, err := seal(key.Material, dataKey, []byte(key.ID))
+	if err != nil {
+		return nil, NewSerializationError(EncodingTypeEncrypted, fmt.Errorf("unable to wrap data key with key %q: %w", key.ID, err))
+	}
+
+	env := envelope{
+		keyID:      key.ID,
+		wrappedKey: wrappedKey,
+		encoding:   blob.EncodingType,
+	}
+	env.ciphertext, err = seal(dataKey, blob.Data, env.additionalData())
+	if err != nil {
+		return nil, NewSerializationError(EncodingTypeEncrypted, err)
+	}
+	return &commonpb.DataBlob{
+		EncodingType: EncodingTypeEncrypted,
+		Data:         env.marshal(),
+	}, nil
+}
+
+// additionalData binds the ciphertext to the envelope header so it can't be tampered with
+func (env *envelope) additionalData() []byte {
+	return binary.AppendUvarint([]byte(env.keyID), uint64(env.encoding))
+}
+
+// marshal encodes the envelope as
+// version | len(keyID) keyID | len(wrappedKey) wrappedKey | encoding | ciphertext
+func (env *envelope) marshal() []byte {
+	buf := make([]byte, 0, 1+3*binary.MaxVarintLen64+len(env.keyID)+len(env.wrappedKey)+len(env.ciphertext))
+	buf = append(buf, envelopeVersion)
+	buf = binary.AppendUvarint(buf, uint64(len(env.keyID)))
+	buf = append(buf, env.keyID...)
+	buf = binary.AppendUvarint(buf, uint64(len(env.wrappedKey)))
+	buf = append(buf, env.wrappedKey...)
+	buf = binary.AppendUvarint(buf, uint64(env.encoding))
+	return append(buf, env.ciphertext...)
+}
+
+func parseEnvelope(data []byte) (*envelope, error) {
+	r := bytes.NewReader(data)
+	version, err := r.ReadByte()
+	if err != nil || version != envelopeVersion {
+		return nil, errMalformedEnvelope
+	}
+	readBytes := func() ([]byte, error) {
+		n, err := binary.ReadUvarint(r)
+		if err != nil || n > uint64(r.Len()) {
+			return nil, errMalformedEnvelope
+		}
+		b := make([]byte, n)
+		_, _ = r.Read(b)
+		return b, nil
+	}
+
+	keyID, err := readBytes()
+	if err != nil {
+		return nil, err
+	}
+	wrappedKey, err := readBytes()
+	if err != nil {
+		return nil, err
+	}
+	encoding, err := binary.ReadUvarint(r)
+	if err != nil {
+		return nil, errMalformedEnvelope
+	}
+	return &envelope{
+		keyID:      string(keyID),
+		wrappedKey: wrappedKey,
+		encoding:   enumspb.EncodingType(encoding),
+		ciphertext: data[len(data)-r.Len():],
+	}, nil
+}
+
+// seal encrypts plaintext with AES-GCM and prepends the random nonce
+func seal(key []byte, plaintext []byte, additionalData []byte) ([]byte, error) {
+	aead, err := newAEAD(key)
+	if err != nil {
+		return nil, err
+	}
+	nonce := make([]byte, aead.NonceSize(), aead.NonceSize()+len(plaintext)+aead.Overhead())
+	if _, err := rand.Read(nonce); err != nil {
+		return nil, err
+	}
+	return aead.Seal(nonce, nonce, plaintext, additionalData), nil
+}
+
+func open(key []byte, ciphertext []byte, additionalData []byte) ([]byte, error) {
+	aead, err := newAEAD(key)
+	if err != nil {
+		return nil, err
+	}
+	if len(ciphertext) < aead.NonceSize() {
+		return nil, errMalformedEnvelope
+	}
+	nonce, ciphertext := ciphertext[:aead.NonceSize()], ciphertext[aead.NonceSize():]
+	return aead.Open(nil, nonce, ciphertext, additionalData)
+}
+
+func newAEAD(key []byte) (cipher.AEAD, error) {
+	block, err := aes.NewCipher(key)
+	if err != nil {
+		return nil, err
+	}
+	return cipher.NewGCM(block)
+}
@@ -0,0 +1,142 @@
+package serialization
+
+import (
+	"bytes"
+	"encoding/base64"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	commonpb "go.temporal.io/api/common/v1"
+	enumspb "go.temporal.io/api/enums/v1"
+)
+
+func newTestKeyProvider(t *testing.T, namespaces map[string]string, defaultKey string) KeyProvider {
+	provider, err := newFileKeyProvider(keyringFile{
+		Keys: map[string]string{
+			"key-1": base64.StdEncoding.EncodeToString(bytes.Repeat([]byte{1}, 32)),
+			"key-2": base64.StdEncoding.EncodeToString(bytes.Repeat([]byte{2}, 32)),
+		},
+		Namespaces: namespaces,
+		DefaultKey: defaultKey,
+	})
+	require.NoError(t, err)
+	return provider
+}
+
+func TestEncryptor_RoundTrip(t *testing.T) {
+	encryptor := NewEncryptor(newTestKeyProvider(t, map[string]string{"ns-1": "key-1"}, ""))
+	plaintext := &commonpb.DataBlob{
+		EncodingType: enumspb.ENCODING_TYPE_PROTO3,
+		Data:         []byte("some history"),
+	}
+
+	encrypted, err := encryptor.Encrypt("ns-1", plaintext)
+	require.NoError(t, err)
+	require.True(t, IsEncrypted(encrypted))
+	require.NotContains(t, string(encrypted.Data), "some history")
+
+	decrypted, err := encryptor.Decrypt(encrypted)
+	require.NoError(t, err)
+	require.Equal(t, plaintext.EncodingType, decrypted.EncodingType)
+	require.Equal(t, plaintext.Data, decrypted.Data)
+
+	// namespaces without a key are not encrypted and plaintext blobs pass through decryption
+	unencrypted, err := encryptor.Encrypt("ns-2", plaintext)
+	require.NoError(t, err)
+	require.Same(t, plaintext, unencrypted)
+	decrypted, err = encryptor.Decrypt(unencrypted)
+	require.NoError(t, err)
+	require.Same(t, plaintext, decrypted)
+}
+
+func TestEncryptor_Tampered(t *testing.T) {
+	encryptor := NewEncryptor(newTestKeyProvider(t, nil, "key-1"))
+	encrypted, err := encryptor.Encrypt("ns-1", &commonpb.DataBlob{
+		EncodingType: enumspb.ENCODING_TYPE_PROTO3,
+		Data:         []byte("some history"),
+	})
+	require.NoError(t, err)
+
+	encrypted.Data[len(encrypted.Data)-1] ^= 0xff
+	_, err = encryptor.Decrypt(encrypted)
+	var deserializationErr *DeserializationError
+	require.ErrorAs(t, err, &deserializationErr)
+
+	_, err = encryptor.Decrypt(&commonpb.DataBlob{EncodingType: EncodingTypeEncrypted, Data: []byte{1, 200}})
+	require.ErrorIs(t, err, errMalformedEnvelope)
+}
+
+func TestEncryptor_KeyRotation(t *testing.T) {
+	before := NewEncryptor(newTestKeyProvider(t, map[string]string{"ns-1": "key-1"}, ""))
+	after := NewEncryptor(newTestKeyProvider(t, map[string]string{"ns-1": "key-2"}, ""))
+	plaintext := &commonpb.DataBlob{
+		EncodingType: enumspb.ENCODING_TYPE_PROTO3,
+		Data:         []byte("some history"),
+	}
+
+	encrypted, err := before.Encrypt("ns-1", plaintext)
+	require.NoError(t, err)
+	needsReencryption, err := before.NeedsReencryption("ns-1", encrypted)
+	require.NoError(t, err)
+	require.False(t, needsReencryption)
+
+	// the retired key is still used for reading
+	needsReencryption, err = after.NeedsReencryption("ns-1", encrypted)
+	require.NoError(t, err)
+	require.True(t, needsReencryption)
+	decrypted, err := after.Decrypt(encrypted)
+	require.NoError(t, err)
+	require.Equal(t, plaintext.Data, decrypted.Data)
+
+	reencrypted, err := after.Reencrypt("ns-1", encrypted)
+	require.NoError(t, err)
+	needsReencryption, err = after.NeedsReencryption("ns-1", reencrypted)
+	require.NoError(t, err)
+	require.False(t, needsReencryption)
+
+	// data written before encryption was turned on needs to be encrypted as well
+	needsReencryption, err = after.NeedsReencryption("ns-1", plaintext)
+	require.NoError(t, err)
+	require.True(t, needsReencryption)
+}
+
+func TestNewFileKeyProvider(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "keyring.yaml")
+	key := base64.StdEncoding.EncodeToString(bytes.Repeat([]byte{1}, 32))
+	require.NoError(t, os.WriteFile(path, []byte(`
+keys:
+  key-1: `+key+`
+namespaces:
+  ns-1: key-1
+`), 0o600))
+
+	provider, err := NewFileKeyProvider(path)
+	require.NoError(t, err)
+	active, ok, err := provider.ActiveKey("ns-1")
+	require.NoError(t, err)
+	require.True(t, ok)
+	require.Equal(t, "key-1", active.ID)
+	_, ok, err = provider.ActiveKey("ns-2")
+	require.NoError(t, err)
+	require.False(t, ok)
+	_, err = provider.Key("key-2")
+	require.Error(t, err)
+
+	require.NoError(t, os.WriteFile(path, []byte(`
+keys:
+  key-1: `+key+`
+namespaces:
+  ns-1: key-2
+`), 0o600))
+	_, err = NewFileKeyProvider(path)
+	require.ErrorContains(t, err, `key "key-2" of namespace ns-1 is not in the keyring`)
+
+	require.NoError(t, os.WriteFile(path, []byte(`
+keys:
+  key-1: c2hvcnQ=
+`), 0o600))
+	_, err = NewFileKeyProvider(path)
+	require.ErrorContains(t, err, `key "key-1" is invalid`)
+}
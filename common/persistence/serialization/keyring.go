@@ -0,0 +1,101 @@
+package serialization
+
+import (
+	"encoding/base64"
+	"fmt"
+	"os"
+
+	"gopkg.in/yaml.v3"
+)
+
+type (
+	// fileKeyProvider is a KeyProvider backed by a local keyring file. It is mainly meant for
+	// tests and development; production deployments should plug in a KMS backed KeyProvider.
+	//
+	// The keyring file has the following format:
+	//
+	//	keys:
+	//	  key-2024-01: <base64 encoded key>
+	//	  key-2024-07: <base64 encoded key>
+	//	namespaces:
+	//	  <namespace ID>: key-2024-07
+	//	defaultKey: key-2024-07
+	//
+	// A namespace is encrypted with its own key if listed under namespaces and with defaultKey
+	// otherwise. If neither is set, data of the namespace is not encrypted. Keys are rotated by
+	// adding a new key and pointing the namespace at it. The old key must be kept until all data
+	// encrypted with it has been re-encrypted.
+	fileKeyProvider struct {
+		keys       map[string]EncryptionKey
+		namespaces map[string]string
+		defaultKey string
+	}
+
+	keyringFile struct {
+		Keys       map[string]string `yaml:"keys"`
+		Namespaces map[string]string `yaml:"namespaces"`
+		DefaultKey string            `yaml:"defaultKey"`
+	}
+)
+
+var _ KeyProvider = (*fileKeyProvider)(nil)
+
+// NewFileKeyProvider returns a KeyProvider which reads its keys from the given keyring file
+func NewFileKeyProvider(path string) (KeyProvider, error) {
+	content, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("unable to read keyring file: %w", err)
+	}
+	var file keyringFile
+	if err := yaml.Unmarshal(content, &file); err != nil {
+		return nil, fmt.Errorf("unable to parse keyring file: %w", err)
+	}
+	return newFileKeyProvider(file)
+}
+
+func newFileKeyProvider(file keyringFile) (*fileKeyProvider, error) {
+	provider := &fileKeyProvider{
+		keys:       make(map[string]EncryptionKey, len(file.Keys)),
+		namespaces: file.Namespaces,
+		defaultKey: file.DefaultKey,
+	}
+	for id, encoded := range file.Keys {
+		material, err := base64.StdEncoding.DecodeString(encoded)
+		if err != nil {
+			return nil, fmt.Errorf("key %q is not base64 encoded: %w", id, err)
+		}
+		if _, err := newAEAD(material); err != nil {
+			return nil, fmt.Errorf("key %q is invalid: %w", id, err)
+		}
+		provider.keys[id] = EncryptionKey{ID: id, Material: material}
+	}
+
+	if _, ok := provider.keys[provider.defaultKey]; len(provider.defaultKey) > 0 && !ok {
+		return nil, fmt.Errorf("default key %q is not in the keyring", provider.defaultKey)
+	}
+	for namespaceID, keyID := range provider.namespaces {
+		if _, ok := provider.keys[keyID]; !ok {
+			return nil, fmt.Errorf("key %q of namespace %v is not in the keyring", keyID, namespaceID)
+		}
+	}
+	return provider, nil
+}
+
+func (p *fileKeyProvider) ActiveKey(namespaceID string) (EncryptionKey, bool, error) {
+	keyID, ok := p.namespaces[namespaceID]
+	if !ok {
+		keyID = p.defaultKey
+	}
+	if len(keyID) == 0 {
+		return EncryptionKey{}, false, nil
+	}
+	return p.keys[keyID], true, nil
+}
+
+func (p *fileKeyProvider) Key(keyID string) (EncryptionKey, error) {
+	key, ok := p.keys[keyID]
+	if !ok {
+		return EncryptionKey{}, fmt.Errorf("encryption key %q not found in keyring", keyID)
+	}
+	return key, nil
+}
@@ -7,9 +7,11 @@ import (
 	"encoding/binary"
 	"encoding/gob"
 	"encoding/json"
+	"errors"
 	"fmt"
 
 	"go.temporal.io/api/serviceerror"
+	"go.temporal.io/server/common/backoff"
 	"go.temporal.io/server/common/log"
 	"go.temporal.io/server/common/log/tag"
 	"go.temporal.io/server/common/persistence"
@@ -46,11 +48,63 @@ func (m *SqlStore) Close() {
 	}
 }
 
+// txError is a failed transaction along with the driver errors which caused
+// the failure, as the returned error does not necessarily wrap them
+type txError struct {
+	err       error
+	driverErr error
+}
+
+func (e *txError) Error() string {
+	return e.err.Error()
+}
+
+func (e *txError) Unwrap() error {
+	return e.err
+}
+
+func unwrapTxError(err error) error {
+	var txErr *txError
+	if errors.As(err, &txErr) {
+		return txErr.err
+	}
+	return err
+}
+
 func (m *SqlStore) txExecute(ctx context.Context, operation string, f func(tx sqlplugin.Tx) error) error {
+	retrier, ok := m.Db.(sqlplugin.TxRetrier)
+	if !ok {
+		return unwrapTxError(m.txExecuteOnce(ctx, operation, f))
+	}
+	// The database may abort a transaction which conflicts with a concurrent one,
+	// in which case the whole transaction including f has to be re-run.
+	err := backoff.ThrottleRetryContext(
+		ctx,
+		func(ctx context.Context) error {
+			return m.txExecuteOnce(ctx, operation, f)
+		},
+		retrier.TxRetryPolicy(),
+		func(err error) bool {
+			var txErr *txError
+			return errors.As(err, &txErr) && retrier.IsRetryableTxError(txErr.driverErr)
+		},
+	)
+	return unwrapTxError(err)
+}
+
+func (m *SqlStore) txExecuteOnce(ctx context.Context, operation string, f func(tx sqlplugin.Tx) error) (retError error) {
 	tx, err := m.Db.BeginTx(ctx)
 	if err != nil {
-		return serviceerror.NewUnavailablef("%s failed. Failed to start transaction. Error: %v", operation, err)
+		return &txError{
+			err:       serviceerror.NewUnavailablef("%s failed. Failed to start transaction. Error: %v", operation, err),
+			driverErr: err,
+		}
 	}
+	defer func() {
+		if recorder, ok := tx.(sqlplugin.TxErrorRecorder); ok && retError != nil {
+			retError = &txError{err: retError, driverErr: recorder.TxError()}
+		}
+	}()
 	err = f(tx)
 	if err != nil {
 		rollBackErr := tx.Rollback()
@@ -0,0 +1,108 @@
+package sql
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"go.temporal.io/api/serviceerror"
+	"go.temporal.io/server/common/backoff"
+	"go.temporal.io/server/common/log"
+	"go.temporal.io/server/common/persistence/sql/sqlplugin"
+)
+
+var (
+	errSerializationFailure = errors.New("serialization failure")
+	errConstraintViolation  = errors.New("constraint violation")
+)
+
+type (
+	retryingDB struct {
+		sqlplugin.DB
+		commitErrs []error
+		txs        int
+	}
+
+	recordingTx struct {
+		sqlplugin.Tx
+		db  *retryingDB
+		err error
+	}
+)
+
+func (d *retryingDB) BeginTx(context.Context) (sqlplugin.Tx, error) {
+	d.txs++
+	return &recordingTx{db: d}, nil
+}
+
+func (d *retryingDB) IsRetryableTxError(err error) bool {
+	return errors.Is(err, errSerializationFailure)
+}
+
+func (d *retryingDB) TxRetryPolicy() backoff.RetryPolicy {
+	return backoff.NewExponentialRetryPolicy(time.Millisecond).WithMaximumAttempts(5)
+}
+
+// exec fails a statement with the given driver error and returns it flattened like the stores do
+func (tx *recordingTx) exec(err error) error {
+	tx.err = errors.Join(tx.err, err)
+	return serviceerror.NewUnavailablef("statement failed: %v", err)
+}
+
+func (tx *recordingTx) Commit() error {
+	if len(tx.db.commitErrs) == 0 {
+		return nil
+	}
+	err := tx.db.commitErrs[0]
+	tx.db.commitErrs = tx.db.commitErrs[1:]
+	if err != nil {
+		tx.err = errors.Join(tx.err, err)
+	}
+	return err
+}
+
+func (tx *recordingTx) Rollback() error {
+	return nil
+}
+
+func (tx *recordingTx) TxError() error {
+	return tx.err
+}
+
+func TestTxExecute_RetriesAbortedTransactions(t *testing.T) {
+	db := &retryingDB{}
+	store := NewSqlStore(db, log.NewNoopLogger())
+
+	driverErrs := []error{errSerializationFailure, errConstraintViolation}
+	err := store.txExecute(context.Background(), "Test", func(tx sqlplugin.Tx) error {
+		driverErr := driverErrs[0]
+		driverErrs = driverErrs[1:]
+		return tx.(*recordingTx).exec(driverErr)
+	})
+	var unavailable *serviceerror.Unavailable
+	require.ErrorAs(t, err, &unavailable)
+	require.ErrorContains(t, err, errConstraintViolation.Error())
+	require.Equal(t, 2, db.txs)
+	require.Empty(t, driverErrs)
+}
+
+func TestTxExecute_RetriesAbortedCommits(t *testing.T) {
+	db := &retryingDB{commitErrs: []error{errSerializationFailure, nil}}
+	store := NewSqlStore(db, log.NewNoopLogger())
+
+	err := store.txExecute(context.Background(), "Test", func(tx sqlplugin.Tx) error {
+		return nil
+	})
+	require.NoError(t, err)
+	require.Equal(t, 2, db.txs)
+
+	db = &retryingDB{commitErrs: []error{errConstraintViolation}}
+	store = NewSqlStore(db, log.NewNoopLogger())
+	err = store.txExecute(context.Background(), "Test", func(tx sqlplugin.Tx) error {
+		return nil
+	})
+	require.ErrorContains(t, err, errConstraintViolation.Error())
+	require.Equal(t, 1, db.txs)
+}
@@ -0,0 +1,74 @@
+package cockroachdb
+
+import (
+	"fmt"
+
+	"go.temporal.io/server/common/backoff"
+	"go.temporal.io/server/common/persistence/schema"
+	"go.temporal.io/server/common/persistence/sql/sqlplugin"
+	"go.temporal.io/server/common/persistence/sql/sqlplugin/cockroachdb/session"
+	"go.temporal.io/server/common/persistence/sql/sqlplugin/postgresql"
+	"go.temporal.io/server/common/persistence/sql/sqlplugin/postgresql/driver"
+	cockroachdbschemaV23 "go.temporal.io/server/schema/cockroachdb/v23"
+)
+
+// db represents a logical connection to cockroachdb database. CockroachDB speaks
+// the postgresql wire protocol and dialect, so all table operations are served
+// by the postgresql plugin.
+type db struct {
+	postgresql.DB
+
+	dbKind sqlplugin.DbKind
+}
+
+var _ sqlplugin.DB = (*db)(nil)
+var _ sqlplugin.AdminDB = (*db)(nil)
+var _ sqlplugin.TxRetrier = (*db)(nil)
+
+// newDB returns an instance of DB, which is a logical
+// connection to the underlying cockroachdb database
+func newDB(
+	dbKind sqlplugin.DbKind,
+	dbName string,
+	dbDriver driver.Driver,
+	handle *sqlplugin.DatabaseHandle,
+) *db {
+	return &db{
+		DB:     postgresql.NewDB(dbKind, dbName, dbDriver, handle),
+		dbKind: dbKind,
+	}
+}
+
+// PluginName returns the name of the cockroachdb plugin
+func (cdb *db) PluginName() string {
+	return PluginName
+}
+
+// ExpectedVersion returns expected version.
+func (cdb *db) ExpectedVersion() string {
+	switch cdb.dbKind {
+	case sqlplugin.DbKindMain:
+		return cockroachdbschemaV23.Version
+	case sqlplugin.DbKindVisibility:
+		return cockroachdbschemaV23.VisibilityVersion
+	default:
+		panic(fmt.Sprintf("unknown db kind %v", cdb.dbKind))
+	}
+}
+
+// VerifyVersion verify schema version is up to date
+func (cdb *db) VerifyVersion() error {
+	expectedVersion := cdb.ExpectedVersion()
+	return schema.VerifyCompatibleVersion(cdb, cdb.DbName(), expectedVersion)
+}
+
+// IsRetryableTxError returns true if the transaction was aborted by a
+// serialization conflict and should be retried from the beginning
+func (cdb *db) IsRetryableTxError(err error) bool {
+	return session.IsRetryableTxError(err)
+}
+
+// TxRetryPolicy returns the policy for retrying aborted transactions
+func (cdb *db) TxRetryPolicy() backoff.RetryPolicy {
+	return session.TxRetryPolicy
+}
@@ -0,0 +1,30 @@
+package cockroachdb
+
+import (
+	"errors"
+
+	"github.com/jackc/pgx/v5/pgconn"
+	"go.temporal.io/server/common/persistence/sql/sqlplugin/postgresql/driver"
+)
+
+const (
+	// check https://www.cockroachlabs.com/docs/stable/transaction-retry-error-reference
+	adminShutdownCode = "57P01"
+)
+
+// crdbDriver talks to CockroachDB over the postgresql wire protocol using pgx.
+// On top of the postgresql error handling it also refreshes connections to
+// nodes which are being drained.
+type crdbDriver struct {
+	driver.PGXDriver
+}
+
+var _ driver.Driver = (*crdbDriver)(nil)
+
+func (d *crdbDriver) IsConnNeedsRefreshError(err error) bool {
+	if d.PGXDriver.IsConnNeedsRefreshError(err) {
+		return true
+	}
+	var pgErr *pgconn.PgError
+	return errors.As(err, &pgErr) && pgErr.Code == adminShutdownCode
+}
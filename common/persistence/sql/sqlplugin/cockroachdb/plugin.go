@@ -0,0 +1,62 @@
+package cockroachdb
+
+import (
+	"github.com/jmoiron/sqlx"
+	"go.temporal.io/server/common/clock"
+	"go.temporal.io/server/common/config"
+	"go.temporal.io/server/common/log"
+	"go.temporal.io/server/common/metrics"
+	"go.temporal.io/server/common/persistence/sql"
+	"go.temporal.io/server/common/persistence/sql/sqlplugin"
+	"go.temporal.io/server/common/persistence/sql/sqlplugin/cockroachdb/session"
+	"go.temporal.io/server/common/resolver"
+)
+
+const (
+	// PluginName is the name of the plugin
+	PluginName = "cockroachdb"
+)
+
+type plugin struct {
+	d *crdbDriver
+}
+
+var _ sqlplugin.Plugin = (*plugin)(nil)
+
+func init() {
+	sql.RegisterPlugin(PluginName, &plugin{&crdbDriver{}})
+}
+
+// CreateDB initialize the db object
+func (d *plugin) CreateDB(
+	dbKind sqlplugin.DbKind,
+	cfg *config.SQL,
+	r resolver.ServiceResolver,
+	logger log.Logger,
+	metricsHandler metrics.Handler,
+) (sqlplugin.GenericDB, error) {
+	connect := func() (*sqlx.DB, error) {
+		if cfg.Connect != nil {
+			return cfg.Connect(cfg)
+		}
+		return d.createDBConnection(cfg, r)
+	}
+	needsRefresh := d.d.IsConnNeedsRefreshError
+	handle := sqlplugin.NewDatabaseHandle(connect, needsRefresh, logger, metricsHandler, clock.NewRealTimeSource())
+	db := newDB(dbKind, cfg.DatabaseName, d.d, handle)
+	return db, nil
+}
+
+// createDBConnection creates a returns a reference to a logical connection to the
+// underlying SQL database. Unlike PostgreSQL, CockroachDB always has a database
+// named defaultdb so there is no need to probe for a default database name.
+func (d *plugin) createDBConnection(
+	cfg *config.SQL,
+	resolver resolver.ServiceResolver,
+) (*sqlx.DB, error) {
+	crdbSession, err := session.NewSession(cfg, d.d, resolver)
+	if err != nil {
+		return nil, err
+	}
+	return crdbSession.DB, nil
+}
@@ -0,0 +1,158 @@
+package session
+
+import (
+	"errors"
+	"fmt"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/iancoleman/strcase"
+	"github.com/jackc/pgx/v5/pgconn"
+	"github.com/jmoiron/sqlx"
+	"go.temporal.io/server/common/backoff"
+	"go.temporal.io/server/common/config"
+	"go.temporal.io/server/common/persistence/sql/sqlplugin/postgresql/driver"
+	"go.temporal.io/server/common/resolver"
+)
+
+const (
+	dsnFmt = "postgres://%v:%v@%v/%v?%v"
+)
+
+const (
+	sslMode        = "sslmode"
+	sslModeNoop    = "disable"
+	sslModeRequire = "require"
+	sslModeFull    = "verify-full"
+
+	sslCA   = "sslrootcert"
+	sslKey  = "sslkey"
+	sslCert = "sslcert"
+)
+
+const (
+	// CockroachDB runs every transaction with SERIALIZABLE isolation and aborts
+	// transactions which cannot be serialized with this code. The client is
+	// expected to retry the whole transaction.
+	// check https://www.cockroachlabs.com/docs/stable/transaction-retry-error-reference
+	serializationFailureCode = "40001"
+
+	txRetryInitialInterval = 10 * time.Millisecond
+	txRetryMaximumInterval = time.Second
+	txRetryMaximumAttempts = 5
+)
+
+// TxRetryPolicy is the policy used to retry transactions aborted with a
+// serialization failure
+var TxRetryPolicy = backoff.NewExponentialRetryPolicy(txRetryInitialInterval).
+	WithMaximumInterval(txRetryMaximumInterval).
+	WithMaximumAttempts(txRetryMaximumAttempts)
+
+type Session struct {
+	*sqlx.DB
+}
+
+func NewSession(
+	cfg *config.SQL,
+	d driver.Driver,
+	resolver resolver.ServiceResolver,
+) (*Session, error) {
+	db, err := createConnection(cfg, d, resolver)
+	if err != nil {
+		return nil, err
+	}
+	return &Session{DB: db}, nil
+}
+
+func (s *Session) Close() {
+	if s.DB != nil {
+		_ = s.DB.Close()
+	}
+}
+
+// IsRetryableTxError returns true if the transaction was aborted by CockroachDB
+// and can be retried from the beginning
+func IsRetryableTxError(err error) bool {
+	if err == nil {
+		return false
+	}
+	var pgErr *pgconn.PgError
+	return errors.As(err, &pgErr) && pgErr.Code == serializationFailureCode
+}
+
+func createConnection(
+	cfg *config.SQL,
+	d driver.Driver,
+	resolver resolver.ServiceResolver,
+) (*sqlx.DB, error) {
+	db, err := d.CreateConnection(buildDSN(cfg, resolver))
+	if err != nil {
+		return nil, err
+	}
+	if cfg.MaxConns > 0 {
+		db.SetMaxOpenConns(cfg.MaxConns)
+	}
+	if cfg.MaxIdleConns > 0 {
+		db.SetMaxIdleConns(cfg.MaxIdleConns)
+	}
+	if cfg.MaxConnLifetime > 0 {
+		db.SetConnMaxLifetime(cfg.MaxConnLifetime)
+	}
+
+	// Maps struct names in CamelCase to snake without need for db struct tags.
+	db.MapperFunc(strcase.ToSnake)
+	return db, nil
+}
+
+func buildDSN(
+	cfg *config.SQL,
+	r resolver.ServiceResolver,
+) string {
+	attrs := buildDSNAttr(cfg).Encode()
+	resolvedAddr := r.Resolve(cfg.ConnectAddr)[0]
+	dsn := fmt.Sprintf(
+		dsnFmt,
+		cfg.User,
+		url.QueryEscape(cfg.Password),
+		resolvedAddr,
+		cfg.DatabaseName,
+		attrs,
+	)
+	return dsn
+}
+
+func buildDSNAttr(cfg *config.SQL) url.Values {
+	parameters := url.Values{}
+	if cfg.TLS != nil && cfg.TLS.Enabled {
+		if !cfg.TLS.EnableHostVerification {
+			parameters.Set(sslMode, sslModeRequire)
+		} else {
+			parameters.Set(sslMode, sslModeFull)
+		}
+
+		if cfg.TLS.CaFile != "" {
+			parameters.Set(sslCA, cfg.TLS.CaFile)
+		}
+		if cfg.TLS.KeyFile != "" && cfg.TLS.CertFile != "" {
+			parameters.Set(sslKey, cfg.TLS.KeyFile)
+			parameters.Set(sslCert, cfg.TLS.CertFile)
+		}
+	} else {
+		parameters.Set(sslMode, sslModeNoop)
+	}
+
+	for k, v := range cfg.ConnectAttributes {
+		key := strings.TrimSpace(k)
+		value := strings.TrimSpace(v)
+		if parameters.Get(key) != "" {
+			panic(fmt.Sprintf("duplicate connection attr: %v:%v, %v:%v",
+				key,
+				parameters.Get(key),
+				key, value,
+			))
+		}
+		parameters.Set(key, value)
+	}
+	return parameters
+}
@@ -0,0 +1,63 @@
+package session
+
+import (
+	"database/sql"
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/jackc/pgx/v5/pgconn"
+	"github.com/stretchr/testify/require"
+	"go.temporal.io/api/serviceerror"
+	"go.temporal.io/server/common/auth"
+	"go.temporal.io/server/common/config"
+)
+
+func TestIsRetryableTxError(t *testing.T) {
+	serializationFailure := &pgconn.PgError{
+		Code:    serializationFailureCode,
+		Message: "restart transaction: TransactionRetryWithProtoRefreshError: WriteTooOldError",
+	}
+
+	testCases := []struct {
+		name      string
+		err       error
+		retryable bool
+	}{
+		{name: "nil", err: nil, retryable: false},
+		{name: "serialization failure", err: serializationFailure, retryable: true},
+		{name: "wrapped serialization failure", err: fmt.Errorf("commit: %w", serializationFailure), retryable: true},
+		{name: "joined serialization failure", err: errors.Join(sql.ErrNoRows, serializationFailure), retryable: true},
+		{
+			name:      "flattened serialization failure",
+			err:       serviceerror.NewUnavailablef("UpdateWorkflowExecution: %v", serializationFailure),
+			retryable: false,
+		},
+		{name: "duplicate entry", err: &pgconn.PgError{Code: "23505"}, retryable: false},
+		{name: "other error", err: errors.New("connection refused"), retryable: false},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			require.Equal(t, tc.retryable, IsRetryableTxError(tc.err))
+		})
+	}
+}
+
+func TestBuildDSNAttr(t *testing.T) {
+	attrs := buildDSNAttr(&config.SQL{
+		ConnectAttributes: map[string]string{"application_name": " temporal "},
+	})
+	require.Equal(t, sslModeNoop, attrs.Get(sslMode))
+	require.Equal(t, "temporal", attrs.Get("application_name"))
+
+	attrs = buildDSNAttr(&config.SQL{
+		TLS: &auth.TLS{
+			Enabled:                true,
+			EnableHostVerification: true,
+			CaFile:                 "ca.pem",
+		},
+	})
+	require.Equal(t, sslModeFull, attrs.Get(sslMode))
+	require.Equal(t, "ca.pem", attrs.Get(sslCA))
+	require.Empty(t, attrs.Get(sslKey))
+}
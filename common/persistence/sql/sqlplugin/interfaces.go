@@ -5,6 +5,7 @@ import (
 	"database/sql"
 
 	"github.com/jmoiron/sqlx"
+	"go.temporal.io/server/common/backoff"
 	"go.temporal.io/server/common/config"
 	"go.temporal.io/server/common/log"
 	"go.temporal.io/server/common/metrics"
@@ -81,6 +82,8 @@ type (
 		UpdateSchemaVersion(database string, newVersion string, minCompatibleVersion string) error
 		WriteSchemaUpdateLog(oldVersion string, newVersion string, manifestMD5 string, desc string) error
 		ListTables(database string) ([]string, error)
+		ListColumns(database string, table string) ([]string, error)
+		ListIndexes(database string, table string) ([]string, error)
 		DropTable(table string) error
 		DropAllTables(database string) error
 		CreateDatabase(database string) error
@@ -103,6 +106,21 @@ type (
 		IsDupEntryError(err error) bool
 	}
 
+	// TxRetrier is implemented by DBs whose transactions may be aborted by the
+	// database with an error that requires the client to retry the whole
+	// transaction, e.g. serialization failures in distributed SQL databases
+	TxRetrier interface {
+		IsRetryableTxError(err error) bool
+		TxRetryPolicy() backoff.RetryPolicy
+	}
+
+	// TxErrorRecorder is implemented by transactions which keep the driver
+	// errors returned by their statements, as stores usually flatten errors
+	// into service errors before they reach the transaction retry loop
+	TxErrorRecorder interface {
+		TxError() error
+	}
+
 	// AdminDB defines the API for admin SQL operations for CLI and testing suites
 	AdminDB interface {
 		AdminCRUD
@@ -40,6 +40,12 @@ const (
 
 	listTablesQuery = "SHOW TABLES FROM %v"
 
+	listColumnsQuery = "SELECT column_name FROM information_schema.columns WHERE table_schema = ? AND table_name = ?"
+
+	// Inline UNIQUE column constraints create a unique index named after the column, which is not a schema index.
+	listIndexesQuery = "SELECT DISTINCT index_name FROM information_schema.statistics " +
+		"WHERE table_schema = ? AND table_name = ? AND index_name <> 'PRIMARY' AND NOT (non_unique = 0 AND index_name = column_name)"
+
 	dropTableQuery = "DROP TABLE %v"
 )
 
@@ -94,6 +100,28 @@ func (mdb *db) ListTables(database string) ([]string, error) {
 	return tables, mdb.handle.ConvertError(err)
 }
 
+// ListColumns returns a list of columns of the given table
+func (mdb *db) ListColumns(database string, table string) ([]string, error) {
+	var columns []string
+	db, err := mdb.handle.DB()
+	if err != nil {
+		return nil, err
+	}
+	err = db.Select(&columns, listColumnsQuery, database, table)
+	return columns, mdb.handle.ConvertError(err)
+}
+
+// ListIndexes returns a list of secondary indexes of the given table
+func (mdb *db) ListIndexes(database string, table string) ([]string, error) {
+	var indexes []string
+	db, err := mdb.handle.DB()
+	if err != nil {
+		return nil, err
+	}
+	err = db.Select(&indexes, listIndexesQuery, database, table)
+	return indexes, mdb.handle.ConvertError(err)
+}
+
 // DropTable drops a given table from the database
 func (mdb *db) DropTable(name string) error {
 	return mdb.Exec(fmt.Sprintf(dropTableQuery, name))
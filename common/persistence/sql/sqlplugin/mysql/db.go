@@ -7,6 +7,7 @@ import (
 
 	"github.com/go-sql-driver/mysql"
 	"github.com/jmoiron/sqlx"
+	"go.temporal.io/server/common/persistence"
 	"go.temporal.io/server/common/persistence/schema"
 	"go.temporal.io/server/common/persistence/sql/sqlplugin"
 	mysqlschemaV8 "go.temporal.io/server/schema/mysql/v8"
@@ -32,6 +33,7 @@ type db struct {
 	dbName string
 
 	handle    *sqlplugin.DatabaseHandle
+	replicas  *sqlplugin.ReadReplicas
 	tx        *sqlx.Tx
 	converter DataConverter
 }
@@ -103,6 +105,7 @@ func (mdb *db) Rollback() error {
 
 // Close closes the connection to the mysql db
 func (mdb *db) Close() error {
+	mdb.replicas.Close()
 	mdb.handle.Close()
 	return nil
 }
@@ -164,3 +167,36 @@ func (mdb *db) PrepareNamedContext(ctx context.Context, query string) (*sqlx.Nam
 func (mdb *db) Rebind(query string) string {
 	return mdb.conn().Rebind(query)
 }
+
+// Reads which tolerate stale data, see persistence.WithStaleReads, are served by a read replica if one is usable
+
+func (mdb *db) staleSelectContext(ctx context.Context, dest any, query string, args ...any) error {
+	if mdb.tx == nil && persistence.StaleReadsAllowed(ctx) {
+		if ok, err := mdb.replicas.SelectContext(ctx, dest, query, args...); ok {
+			return err
+		}
+	}
+	return mdb.SelectContext(ctx, dest, query, args...)
+}
+
+func (mdb *db) staleGetContext(ctx context.Context, dest any, query string, args ...any) error {
+	if mdb.tx == nil && persistence.StaleReadsAllowed(ctx) {
+		if ok, err := mdb.replicas.GetContext(ctx, dest, query, args...); ok {
+			return err
+		}
+	}
+	return mdb.GetContext(ctx, dest, query, args...)
+}
+
+func (mdb *db) staleQueryContext(ctx context.Context, query string, args ...any) (*sql.Rows, error) {
+	if mdb.tx == nil && persistence.StaleReadsAllowed(ctx) {
+		if rows, ok, err := mdb.replicas.QueryContext(ctx, query, args...); ok {
+			return rows, err
+		}
+	}
+	db, err := mdb.handle.DB()
+	if err != nil {
+		return nil, err
+	}
+	return db.QueryContext(ctx, query, args...)
+}
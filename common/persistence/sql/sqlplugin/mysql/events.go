@@ -181,7 +181,7 @@ func (mdb *db) PaginateBranchesFromHistoryTree(
 	page sqlplugin.HistoryTreeBranchPage,
 ) ([]sqlplugin.HistoryTreeRow, error) {
 	var rows []sqlplugin.HistoryTreeRow
-	err := mdb.SelectContext(
+	err := mdb.staleSelectContext(
 		ctx,
 		&rows,
 		paginateBranchesQuery,
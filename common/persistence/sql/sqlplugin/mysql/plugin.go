@@ -1,6 +1,11 @@
 package mysql
 
 import (
+	"context"
+	"errors"
+	"strconv"
+	"time"
+
 	"github.com/jmoiron/sqlx"
 	"go.temporal.io/server/common/clock"
 	"go.temporal.io/server/common/config"
@@ -41,9 +46,53 @@ func (p *plugin) CreateDB(
 	}
 	handle := sqlplugin.NewDatabaseHandle(connect, isConnNeedsRefreshError, logger, metricsHandler, clock.NewRealTimeSource())
 	db := newDB(dbKind, cfg.DatabaseName, handle, nil)
+	db.replicas = sqlplugin.NewReadReplicas(
+		cfg,
+		func(replicaCfg *config.SQL) (*sqlx.DB, error) {
+			if replicaCfg.Connect != nil {
+				return replicaCfg.Connect(replicaCfg)
+			}
+			return p.createDBConnection(dbKind, replicaCfg, r)
+		},
+		isConnNeedsRefreshError,
+		replicationLag,
+		logger,
+		metricsHandler,
+	)
 	return db, nil
 }
 
+// replicationLag returns the replication lag reported by the replica. A database which
+// isn't replicating from a source is not lagging behind.
+func replicationLag(ctx context.Context, db *sqlx.DB) (time.Duration, error) {
+	rows, err := db.QueryxContext(ctx, "SHOW REPLICA STATUS")
+	if err != nil {
+		return 0, err
+	}
+	defer func() { _ = rows.Close() }()
+	if !rows.Next() {
+		return 0, rows.Err()
+	}
+
+	status := make(map[string]any)
+	if err := rows.MapScan(status); err != nil {
+		return 0, err
+	}
+	var seconds int64
+	switch value := status["Seconds_Behind_Source"].(type) {
+	case int64:
+		seconds = value
+	case []byte:
+		if seconds, err = strconv.ParseInt(string(value), 10, 64); err != nil {
+			return 0, err
+		}
+	default:
+		// NULL if the replication threads are not running
+		return 0, errors.New("replication is not running")
+	}
+	return time.Duration(seconds) * time.Second, nil
+}
+
 // CreateDBConnection creates a returns a reference to a logical connection to the
 // underlying SQL database. The returned object is to tied to a single
 // SQL database and the object can be used to perform CRUD operations on
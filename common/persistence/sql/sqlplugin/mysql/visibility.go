@@ -201,7 +201,7 @@ func (mdb *db) SelectFromVisibility(
 	}
 
 	var rows []sqlplugin.VisibilityRow
-	err := mdb.SelectContext(ctx, &rows, filter.Query, filter.QueryArgs...)
+	err := mdb.staleSelectContext(ctx, &rows, filter.Query, filter.QueryArgs...)
 	if err != nil {
 		return nil, err
 	}
@@ -240,7 +240,7 @@ func (mdb *db) CountFromVisibility(
 	filter sqlplugin.VisibilitySelectFilter,
 ) (int64, error) {
 	var count int64
-	err := mdb.GetContext(ctx, &count, filter.Query, filter.QueryArgs...)
+	err := mdb.staleGetContext(ctx, &count, filter.Query, filter.QueryArgs...)
 	if err != nil {
 		return 0, err
 	}
@@ -254,16 +254,27 @@ func (mdb *db) CountGroupByFromVisibility(
 	defer func() {
 		retError = mdb.handle.ConvertError(retError)
 	}()
-	db, err := mdb.handle.DB()
+	rows, err := mdb.staleQueryContext(ctx, filter.Query, filter.QueryArgs...)
 	if err != nil {
 		return nil, err
 	}
-	rows, err := db.QueryContext(ctx, filter.Query, filter.QueryArgs...)
+	defer rows.Close()
+	return sqlplugin.ParseCountGroupByRows(rows, filter.GroupBy)
+}
+
+func (mdb *db) AggregateFromVisibility(
+	ctx context.Context,
+	filter sqlplugin.VisibilitySelectFilter,
+) (_ []sqlplugin.VisibilityAggregationRow, retError error) {
+	defer func() {
+		retError = mdb.handle.ConvertError(retError)
+	}()
+	rows, err := mdb.staleQueryContext(ctx, filter.Query, filter.QueryArgs...)
 	if err != nil {
 		return nil, err
 	}
 	defer rows.Close()
-	return sqlplugin.ParseCountGroupByRows(rows, filter.GroupBy)
+	return sqlplugin.ParseAggregationRows(rows, filter.GroupBy)
 }
 
 func (mdb *db) prepareRowForDB(row *sqlplugin.VisibilityRow) *sqlplugin.VisibilityRow {
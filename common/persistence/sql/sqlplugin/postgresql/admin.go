@@ -44,6 +44,12 @@ const (
 
 	listTablesQuery = "select table_name from information_schema.tables where table_schema='public'"
 
+	listColumnsQuery = "select column_name from information_schema.columns where table_schema='public' and table_name=$1"
+
+	// Indexes backing primary key and unique constraints are not schema indexes.
+	listIndexesQuery = "select indexname from pg_indexes where schemaname='public' and tablename=$1 " +
+		"and indexname not in (select conname from pg_constraint)"
+
 	dropTableQuery = "DROP TABLE %v"
 )
 
@@ -95,6 +101,20 @@ func (pdb *db) ListTables(database string) ([]string, error) {
 	return tables, pdb.handle.ConvertError(err)
 }
 
+// ListColumns returns a list of columns of the given table
+func (pdb *db) ListColumns(database string, table string) ([]string, error) {
+	var columns []string
+	err := pdb.Select(&columns, listColumnsQuery, table)
+	return columns, pdb.handle.ConvertError(err)
+}
+
+// ListIndexes returns a list of secondary indexes of the given table
+func (pdb *db) ListIndexes(database string, table string) ([]string, error) {
+	var indexes []string
+	err := pdb.Select(&indexes, listIndexesQuery, table)
+	return indexes, pdb.handle.ConvertError(err)
+}
+
 // DropTable drops a given table from the database
 func (pdb *db) DropTable(name string) error {
 	return pdb.Exec(fmt.Sprintf(dropTableQuery, name))
@@ -3,10 +3,12 @@ package postgresql
 import (
 	"context"
 	"database/sql"
+	"errors"
 	"fmt"
 
 	"github.com/jmoiron/sqlx"
 	"go.temporal.io/server/common/config"
+	"go.temporal.io/server/common/persistence"
 	"go.temporal.io/server/common/persistence/schema"
 	"go.temporal.io/server/common/persistence/sql/sqlplugin"
 	"go.temporal.io/server/common/persistence/sql/sqlplugin/postgresql/driver"
@@ -33,11 +35,32 @@ type db struct {
 	resolver  resolver.ServiceResolver
 	converter DataConverter
 
-	handle *sqlplugin.DatabaseHandle
-	tx     *sqlx.Tx
+	handle   *sqlplugin.DatabaseHandle
+	replicas *sqlplugin.ReadReplicas
+	tx       *sqlx.Tx
+	txErr    error
 }
 
-var _ sqlplugin.DB = (*db)(nil)
+// DB is a logical connection to a postgresql database which implements both
+// the regular and the admin SQL APIs. It is exposed so that plugins for
+// postgresql wire compatible databases can reuse the postgresql queries.
+type DB interface {
+	sqlplugin.DB
+	sqlplugin.AdminDB
+}
+
+var _ DB = (*db)(nil)
+var _ sqlplugin.TxErrorRecorder = (*db)(nil)
+
+// NewDB returns an instance of DB on top of the given database handle
+func NewDB(
+	dbKind sqlplugin.DbKind,
+	dbName string,
+	dbDriver driver.Driver,
+	handle *sqlplugin.DatabaseHandle,
+) DB {
+	return newDB(dbKind, dbName, dbDriver, handle, nil)
+}
 
 // newDB returns an instance of DB, which is a logical
 // connection to the underlying postgresql database
@@ -82,6 +105,7 @@ func (pdb *db) BeginTx(ctx context.Context) (sqlplugin.Tx, error) {
 
 // Close closes the connection to the mysql db
 func (pdb *db) Close() error {
+	pdb.replicas.Close()
 	pdb.handle.Close()
 	return nil
 }
@@ -116,7 +140,19 @@ func (pdb *db) VerifyVersion() error {
 
 // Commit commits a previously started transaction
 func (pdb *db) Commit() error {
-	return pdb.tx.Commit()
+	return pdb.convertError(pdb.tx.Commit())
+}
+
+// TxError returns the driver errors returned so far inside the transaction
+func (pdb *db) TxError() error {
+	return pdb.txErr
+}
+
+func (pdb *db) convertError(err error) error {
+	if err != nil && pdb.tx != nil {
+		pdb.txErr = errors.Join(pdb.txErr, err)
+	}
+	return pdb.handle.ConvertError(err)
 }
 
 // Rollback triggers rollback of a previously started transaction
@@ -127,12 +163,12 @@ func (pdb *db) Rollback() error {
 // Helper methods to hide common error handling
 func (pdb *db) ExecContext(ctx context.Context, stmt string, args ...any) (sql.Result, error) {
 	res, err := pdb.conn().ExecContext(ctx, stmt, args...)
-	return res, pdb.handle.ConvertError(err)
+	return res, pdb.convertError(err)
 }
 
 func (pdb *db) GetContext(ctx context.Context, dest any, query string, args ...any) error {
 	err := pdb.conn().GetContext(ctx, dest, query, args...)
-	return pdb.handle.ConvertError(err)
+	return pdb.convertError(err)
 }
 
 func (pdb *db) Select(dest any, query string, args ...any) error {
@@ -146,17 +182,17 @@ func (pdb *db) Select(dest any, query string, args ...any) error {
 
 func (pdb *db) SelectContext(ctx context.Context, dest any, query string, args ...any) error {
 	err := pdb.conn().SelectContext(ctx, dest, query, args...)
-	return pdb.handle.ConvertError(err)
+	return pdb.convertError(err)
 }
 
 func (pdb *db) NamedExecContext(ctx context.Context, query string, arg any) (sql.Result, error) {
 	res, err := pdb.conn().NamedExecContext(ctx, query, arg)
-	return res, pdb.handle.ConvertError(err)
+	return res, pdb.convertError(err)
 }
 
 func (pdb *db) PrepareNamedContext(ctx context.Context, query string) (*sqlx.NamedStmt, error) {
 	stmt, err := pdb.conn().PrepareNamedContext(ctx, query)
-	return stmt, pdb.handle.ConvertError(err)
+	return stmt, pdb.convertError(err)
 }
 
 func (pdb *db) QueryContext(ctx context.Context, query string, args ...any) (*sql.Rows, error) {
@@ -171,3 +207,32 @@ func (pdb *db) QueryContext(ctx context.Context, query string, args ...any) (*sq
 func (pdb *db) Rebind(query string) string {
 	return pdb.conn().Rebind(query)
 }
+
+// Reads which tolerate stale data, see persistence.WithStaleReads, are served by a read replica if one is usable
+
+func (pdb *db) staleSelectContext(ctx context.Context, dest any, query string, args ...any) error {
+	if pdb.tx == nil && persistence.StaleReadsAllowed(ctx) {
+		if ok, err := pdb.replicas.SelectContext(ctx, dest, query, args...); ok {
+			return err
+		}
+	}
+	return pdb.SelectContext(ctx, dest, query, args...)
+}
+
+func (pdb *db) staleGetContext(ctx context.Context, dest any, query string, args ...any) error {
+	if pdb.tx == nil && persistence.StaleReadsAllowed(ctx) {
+		if ok, err := pdb.replicas.GetContext(ctx, dest, query, args...); ok {
+			return err
+		}
+	}
+	return pdb.GetContext(ctx, dest, query, args...)
+}
+
+func (pdb *db) staleQueryContext(ctx context.Context, query string, args ...any) (*sql.Rows, error) {
+	if pdb.tx == nil && persistence.StaleReadsAllowed(ctx) {
+		if rows, ok, err := pdb.replicas.QueryContext(ctx, query, args...); ok {
+			return rows, err
+		}
+	}
+	return pdb.QueryContext(ctx, query, args...)
+}
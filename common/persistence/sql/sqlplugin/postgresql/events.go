@@ -181,7 +181,7 @@ func (pdb *db) PaginateBranchesFromHistoryTree(
 	page sqlplugin.HistoryTreeBranchPage,
 ) ([]sqlplugin.HistoryTreeRow, error) {
 	var rows []sqlplugin.HistoryTreeRow
-	err := pdb.SelectContext(ctx,
+	err := pdb.staleSelectContext(ctx,
 		&rows,
 		paginateBranchesQuery,
 		page.ShardID,
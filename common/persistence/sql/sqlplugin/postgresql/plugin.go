@@ -1,8 +1,11 @@
 package postgresql
 
 import (
+	"context"
+	"errors"
 	"fmt"
 	"strings"
+	"time"
 
 	"github.com/jmoiron/sqlx"
 	"go.temporal.io/api/serviceerror"
@@ -23,6 +26,20 @@ const (
 	PluginNamePGX = "postgres12_pgx"
 )
 
+const (
+	// A replica which replayed all the WAL it received is only caught up if it is
+	// still streaming from its upstream, otherwise the lag is measured from the
+	// last replayed transaction. The wal receiver status is only visible to roles
+	// with pg_read_all_stats, so a running receiver is assumed to be streaming.
+	replicationLagQuery = `SELECT CASE
+		WHEN NOT pg_is_in_recovery() THEN 0
+		WHEN pg_last_wal_receive_lsn() = pg_last_wal_replay_lsn() AND EXISTS (
+			SELECT 1 FROM pg_stat_wal_receiver WHERE COALESCE(status, 'streaming') = 'streaming'
+		) THEN 0
+		ELSE EXTRACT(EPOCH FROM now() - pg_last_xact_replay_timestamp())::float8
+	END`
+)
+
 var (
 	defaultDatabaseNames = []string{
 		"postgres",  // normal PostgreSQL default DB name
@@ -58,9 +75,36 @@ func (d *plugin) CreateDB(
 	needsRefresh := d.d.IsConnNeedsRefreshError
 	handle := sqlplugin.NewDatabaseHandle(connect, needsRefresh, logger, metricsHandler, clock.NewRealTimeSource())
 	db := newDB(dbKind, cfg.DatabaseName, d.d, handle, nil)
+	db.replicas = sqlplugin.NewReadReplicas(
+		cfg,
+		func(replicaCfg *config.SQL) (*sqlx.DB, error) {
+			if replicaCfg.Connect != nil {
+				return replicaCfg.Connect(replicaCfg)
+			}
+			return d.createDBConnection(replicaCfg, r)
+		},
+		needsRefresh,
+		replicationLag,
+		logger,
+		metricsHandler,
+	)
 	return db, nil
 }
 
+// replicationLag returns the time since the last transaction replayed by the replica. A
+// replica which has replayed all WAL it received is not lagging behind, regardless of
+// when the last transaction happened.
+func replicationLag(ctx context.Context, db *sqlx.DB) (time.Duration, error) {
+	var seconds *float64
+	if err := db.GetContext(ctx, &seconds, replicationLagQuery); err != nil {
+		return 0, err
+	}
+	if seconds == nil {
+		return 0, errors.New("replica is not streaming and has not replayed any transaction")
+	}
+	return time.Duration(*seconds * float64(time.Second)), nil
+}
+
 // CreateDBConnection creates a returns a reference to a logical connection to the
 // underlying SQL database. The returned object is to tied to a single
 // SQL database and the object can be used to perform CRUD operations on
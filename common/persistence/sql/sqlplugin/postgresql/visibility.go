@@ -97,7 +97,7 @@ func (pdb *db) SelectFromVisibility(
 	}
 	filter.Query = db.Rebind(filter.Query)
 	var rows []sqlplugin.VisibilityRow
-	err = db.SelectContext(ctx, &rows, filter.Query, filter.QueryArgs...)
+	err = pdb.staleSelectContext(ctx, &rows, filter.Query, filter.QueryArgs...)
 	if err != nil {
 		return nil, err
 	}
@@ -137,7 +137,7 @@ func (pdb *db) CountFromVisibility(
 ) (int64, error) {
 	var count int64
 	filter.Query = pdb.Rebind(filter.Query)
-	err := pdb.GetContext(ctx, &count, filter.Query, filter.QueryArgs...)
+	err := pdb.staleGetContext(ctx, &count, filter.Query, filter.QueryArgs...)
 	if err != nil {
 		return 0, err
 	}
@@ -149,7 +149,7 @@ func (pdb *db) CountGroupByFromVisibility(
 	filter sqlplugin.VisibilitySelectFilter,
 ) ([]sqlplugin.VisibilityCountRow, error) {
 	filter.Query = pdb.Rebind(filter.Query)
-	rows, err := pdb.QueryContext(ctx, filter.Query, filter.QueryArgs...)
+	rows, err := pdb.staleQueryContext(ctx, filter.Query, filter.QueryArgs...)
 	if err != nil {
 		return nil, err
 	}
@@ -157,6 +157,19 @@ func (pdb *db) CountGroupByFromVisibility(
 	return sqlplugin.ParseCountGroupByRows(rows, filter.GroupBy)
 }
 
+func (pdb *db) AggregateFromVisibility(
+	ctx context.Context,
+	filter sqlplugin.VisibilitySelectFilter,
+) ([]sqlplugin.VisibilityAggregationRow, error) {
+	filter.Query = pdb.Rebind(filter.Query)
+	rows, err := pdb.staleQueryContext(ctx, filter.Query, filter.QueryArgs...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	return sqlplugin.ParseAggregationRows(rows, filter.GroupBy)
+}
+
 func (pdb *db) prepareRowForDB(row *sqlplugin.VisibilityRow) *sqlplugin.VisibilityRow {
 	if row == nil {
 		return nil
@@ -0,0 +1,236 @@
+package sqlplugin
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"reflect"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/jmoiron/sqlx"
+	"go.temporal.io/api/serviceerror"
+	"go.temporal.io/server/common/clock"
+	"go.temporal.io/server/common/config"
+	"go.temporal.io/server/common/log"
+	"go.temporal.io/server/common/log/tag"
+	"go.temporal.io/server/common/metrics"
+)
+
+const (
+	defaultMaxReplicationLag = 10 * time.Second
+	defaultLagCheckInterval  = 5 * time.Second
+)
+
+type (
+	// ReplicationLagFn returns the replication lag of the read replica behind the connection
+	ReplicationLagFn func(ctx context.Context, db *sqlx.DB) (time.Duration, error)
+
+	// ReadReplicas routes reads which tolerate staleness to the read replicas of a database.
+	// A replica is only used while its replication lag is known and within the configured
+	// maximum; reads fall back to the primary if no replica is usable. A nil *ReadReplicas
+	// is valid and never serves any reads.
+	ReadReplicas struct {
+		replicas          []*readReplica
+		next              atomic.Uint32
+		maxReplicationLag time.Duration
+		lagCheckInterval  time.Duration
+		replicationLag    ReplicationLagFn
+		metrics           metrics.Handler
+		logger            log.Logger
+
+		shutdownOnce sync.Once
+		shutdownChan chan struct{}
+	}
+
+	readReplica struct {
+		addr    string
+		handle  *DatabaseHandle
+		healthy atomic.Bool
+	}
+)
+
+// NewReadReplicas returns the read replicas configured for the database or nil if there
+// are none. connect is called with a copy of the config which points at the replica.
+func NewReadReplicas(
+	cfg *config.SQL,
+	connect func(cfg *config.SQL) (*sqlx.DB, error),
+	needsRefresh func(error) bool,
+	replicationLag ReplicationLagFn,
+	logger log.Logger,
+	metricsHandler metrics.Handler,
+) *ReadReplicas {
+	if cfg.ReadReplicas == nil || len(cfg.ReadReplicas.ConnectAddrs) == 0 {
+		return nil
+	}
+
+	replicasCfg := cfg.ReadReplicas
+	r := &ReadReplicas{
+		maxReplicationLag: replicasCfg.MaxReplicationLag,
+		lagCheckInterval:  replicasCfg.LagCheckInterval,
+		replicationLag:    replicationLag,
+		metrics:           metricsHandler,
+		logger:            logger,
+		shutdownChan:      make(chan struct{}),
+	}
+	if r.maxReplicationLag <= 0 {
+		r.maxReplicationLag = defaultMaxReplicationLag
+	}
+	if r.lagCheckInterval <= 0 {
+		r.lagCheckInterval = defaultLagCheckInterval
+	}
+
+	for _, addr := range replicasCfg.ConnectAddrs {
+		replicaCfg := *cfg
+		replicaCfg.ConnectAddr = addr
+		replicaCfg.ReadReplicas = nil
+		if replicasCfg.MaxConns > 0 {
+			replicaCfg.MaxConns = replicasCfg.MaxConns
+		}
+		if replicasCfg.MaxIdleConns > 0 {
+			replicaCfg.MaxIdleConns = replicasCfg.MaxIdleConns
+		}
+		r.replicas = append(r.replicas, &readReplica{
+			addr: addr,
+			handle: NewDatabaseHandle(
+				func() (*sqlx.DB, error) { return connect(&replicaCfg) },
+				needsRefresh,
+				log.With(logger, tag.Address(addr)),
+				metricsHandler,
+				clock.NewRealTimeSource(),
+			),
+		})
+	}
+
+	go r.checkReplicationLagLoop()
+	return r
+}
+
+// SelectContext runs the query on a read replica. It returns false if no replica was
+// usable, in which case the caller is expected to run the query on the primary.
+func (r *ReadReplicas) SelectContext(ctx context.Context, dest any, query string, args ...any) (bool, error) {
+	return r.run(dest, func(db *sqlx.DB) error {
+		return db.SelectContext(ctx, dest, query, args...)
+	})
+}
+
+// GetContext runs the query on a read replica. It returns false if no replica was
+// usable, in which case the caller is expected to run the query on the primary.
+func (r *ReadReplicas) GetContext(ctx context.Context, dest any, query string, args ...any) (bool, error) {
+	return r.run(dest, func(db *sqlx.DB) error {
+		return db.GetContext(ctx, dest, query, args...)
+	})
+}
+
+// QueryContext runs the query on a read replica. It returns false if no replica was
+// usable, in which case the caller is expected to run the query on the primary.
+func (r *ReadReplicas) QueryContext(ctx context.Context, query string, args ...any) (*sql.Rows, bool, error) {
+	var rows *sql.Rows
+	ok, err := r.run(nil, func(db *sqlx.DB) error {
+		var err error
+		rows, err = db.QueryContext(ctx, query, args...)
+		return err
+	})
+	return rows, ok, err
+}
+
+// Close closes the connections to all replicas
+func (r *ReadReplicas) Close() {
+	if r == nil {
+		return
+	}
+	r.shutdownOnce.Do(func() {
+		close(r.shutdownChan)
+		for _, replica := range r.replicas {
+			replica.handle.Close()
+		}
+	})
+}
+
+func (r *ReadReplicas) run(dest any, fn func(db *sqlx.DB) error) (bool, error) {
+	if r == nil {
+		return false, nil
+	}
+	replica := r.pick()
+	if replica == nil {
+		return false, nil
+	}
+
+	db, err := replica.handle.DB()
+	if err == nil {
+		if err = fn(db); err == nil {
+			return true, nil
+		}
+		if err = replica.handle.ConvertError(err); !errors.As(err, new(*serviceerror.Unavailable)) {
+			return true, err
+		}
+	}
+
+	// The replica is unreachable, it is skipped until the next lag check succeeds.
+	if replica.healthy.Swap(false) {
+		r.logger.Warn("sql read replica is unreachable, falling back to primary", tag.Address(replica.addr), tag.Error(err))
+	}
+	metrics.PersistenceReadReplicaFallbacks.With(r.metrics).Record(1)
+	if dest != nil {
+		reflect.ValueOf(dest).Elem().SetZero()
+	}
+	return false, nil
+}
+
+// pick returns a healthy replica in a round-robin fashion or nil if there is none
+func (r *ReadReplicas) pick() *readReplica {
+	n := uint32(len(r.replicas))
+	start := r.next.Add(1)
+	for i := uint32(0); i < n; i++ {
+		if replica := r.replicas[(start+i)%n]; replica.healthy.Load() {
+			return replica
+		}
+	}
+	return nil
+}
+
+func (r *ReadReplicas) checkReplicationLagLoop() {
+	ticker := time.NewTicker(r.lagCheckInterval)
+	defer ticker.Stop()
+
+	for {
+		r.checkReplicationLag()
+		select {
+		case <-r.shutdownChan:
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+func (r *ReadReplicas) checkReplicationLag() {
+	for _, replica := range r.replicas {
+		lag, err := r.replicaLag(replica)
+		healthy := err == nil && lag <= r.maxReplicationLag
+		if err == nil {
+			metrics.PersistenceReadReplicaLag.With(r.metrics).Record(lag)
+		}
+
+		if wasHealthy := replica.healthy.Swap(healthy); wasHealthy && !healthy {
+			r.logger.Warn("sql read replica is lagging behind, falling back to primary",
+				tag.Address(replica.addr), tag.NewDurationTag("replication-lag", lag), tag.Error(err))
+		} else if !wasHealthy && healthy {
+			r.logger.Info("sql read replica is serving reads", tag.Address(replica.addr))
+		}
+	}
+}
+
+func (r *ReadReplicas) replicaLag(replica *readReplica) (time.Duration, error) {
+	db, err := replica.handle.DB()
+	if err != nil {
+		return 0, err
+	}
+	ctx, cancel := context.WithTimeout(context.Background(), r.lagCheckInterval)
+	defer cancel()
+	lag, err := r.replicationLag(ctx, db)
+	if err != nil {
+		return 0, replica.handle.ConvertError(err)
+	}
+	return lag, nil
+}
@@ -0,0 +1,98 @@
+package sqlplugin
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/jmoiron/sqlx"
+	"github.com/stretchr/testify/require"
+	"go.temporal.io/server/common/config"
+	"go.temporal.io/server/common/log"
+	"go.temporal.io/server/common/metrics"
+	_ "modernc.org/sqlite"
+)
+
+func TestReadReplicas_NotConfigured(t *testing.T) {
+	replicas := NewReadReplicas(&config.SQL{}, nil, nil, nil, log.NewNoopLogger(), metrics.NoopMetricsHandler)
+	require.Nil(t, replicas)
+
+	var value string
+	ok, err := replicas.GetContext(context.Background(), &value, "SELECT 1")
+	require.NoError(t, err)
+	require.False(t, ok)
+	replicas.Close()
+}
+
+func TestReadReplicas_Routing(t *testing.T) {
+	ctx := context.Background()
+	dbs := make(map[string]*sqlx.DB)
+	connect := func(cfg *config.SQL) (*sqlx.DB, error) {
+		db, err := sqlx.Open("sqlite", ":memory:")
+		if err != nil {
+			return nil, err
+		}
+		// every connection to an in-memory database opens a new database
+		db.SetMaxOpenConns(1)
+		lag := map[string]int{"replica-1": 1, "replica-2": 3600}[cfg.ConnectAddr]
+		if _, err := db.Exec(`CREATE TABLE replica (name TEXT, lag INTEGER)`); err != nil {
+			return nil, err
+		}
+		if _, err := db.Exec(`INSERT INTO replica VALUES (?, ?)`, cfg.ConnectAddr, lag); err != nil {
+			return nil, err
+		}
+		dbs[cfg.ConnectAddr] = db
+		return db, nil
+	}
+	errClosed := errors.New("sql: database is closed")
+	needsRefresh := func(err error) bool { return err.Error() == errClosed.Error() }
+	replicationLag := func(ctx context.Context, db *sqlx.DB) (time.Duration, error) {
+		var seconds int
+		err := db.GetContext(ctx, &seconds, `SELECT lag FROM replica`)
+		return time.Duration(seconds) * time.Second, err
+	}
+
+	replicas := NewReadReplicas(
+		&config.SQL{
+			ConnectAddr: "primary",
+			ReadReplicas: &config.SQLReadReplicas{
+				ConnectAddrs:      []string{"replica-1", "replica-2"},
+				MaxReplicationLag: time.Minute,
+				LagCheckInterval:  time.Hour,
+			},
+		},
+		connect,
+		needsRefresh,
+		replicationLag,
+		log.NewNoopLogger(),
+		metrics.NoopMetricsHandler,
+	)
+	defer replicas.Close()
+	require.Eventually(t, func() bool { return replicas.pick() != nil }, 5*time.Second, 10*time.Millisecond)
+
+	// only the replica within the lag limit serves reads
+	for range 4 {
+		var names []string
+		ok, err := replicas.SelectContext(ctx, &names, `SELECT name FROM replica`)
+		require.NoError(t, err)
+		require.True(t, ok)
+		require.Equal(t, []string{"replica-1"}, names)
+	}
+
+	// query errors are returned as is
+	var name string
+	ok, err := replicas.GetContext(ctx, &name, `SELECT name FROM missing`)
+	require.True(t, ok)
+	require.Error(t, err)
+
+	// reads fall back to the primary once the replica becomes unreachable
+	require.NoError(t, dbs["replica-1"].Close())
+	ok, err = replicas.GetContext(ctx, &name, `SELECT name FROM replica`)
+	require.NoError(t, err)
+	require.False(t, ok)
+	require.Empty(t, name)
+	_, ok, err = replicas.QueryContext(ctx, `SELECT name FROM replica`)
+	require.NoError(t, err)
+	require.False(t, ok)
+}
@@ -151,6 +151,18 @@ func (mdb *db) CountGroupByFromVisibility(
 	return sqlplugin.ParseCountGroupByRows(rows, filter.GroupBy)
 }
 
+func (mdb *db) AggregateFromVisibility(
+	ctx context.Context,
+	filter sqlplugin.VisibilitySelectFilter,
+) ([]sqlplugin.VisibilityAggregationRow, error) {
+	rows, err := mdb.db.QueryContext(ctx, filter.Query, filter.QueryArgs...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	return sqlplugin.ParseAggregationRows(rows, filter.GroupBy)
+}
+
 func (mdb *db) prepareRowForDB(row *sqlplugin.VisibilityRow) *sqlplugin.VisibilityRow {
 	if row == nil {
 		return nil
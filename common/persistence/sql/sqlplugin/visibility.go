@@ -88,6 +88,13 @@ type (
 		Count       int64
 	}
 
+	// VisibilityAggregationRow is a row of an aggregation query, made of the group by columns
+	// followed by the aggregated values.
+	VisibilityAggregationRow struct {
+		GroupValues []any
+		Values      []any
+	}
+
 	Visibility interface {
 		// InsertIntoVisibility inserts a row into visibility table. If a row already exist,
 		// no changes will be made by this API
@@ -107,6 +114,7 @@ type (
 		DeleteFromVisibility(ctx context.Context, filter VisibilityDeleteFilter) (sql.Result, error)
 		CountFromVisibility(ctx context.Context, filter VisibilitySelectFilter) (int64, error)
 		CountGroupByFromVisibility(ctx context.Context, filter VisibilitySelectFilter) ([]VisibilityCountRow, error)
+		AggregateFromVisibility(ctx context.Context, filter VisibilitySelectFilter) ([]VisibilityAggregationRow, error)
 	}
 )
 
@@ -169,6 +177,45 @@ func ParseCountGroupByRows(rows *sql.Rows, groupBy []string) ([]VisibilityCountR
 	return res, nil
 }
 
+func ParseAggregationRows(rows *sql.Rows, groupBy []string) ([]VisibilityAggregationRow, error) {
+	columns, err := rows.Columns()
+	if err != nil {
+		return nil, err
+	}
+	rowValues := make([]any, len(columns))
+	for i := range rowValues {
+		rowValues[i] = new(any)
+	}
+
+	var res []VisibilityAggregationRow
+	for rows.Next() {
+		err := rows.Scan(rowValues...)
+		if err != nil {
+			return nil, err
+		}
+		groupValues := make([]any, len(groupBy))
+		for i := range groupBy {
+			value := *(rowValues[i].(*any))
+			if bytesValue, ok := value.([]byte); ok {
+				value = string(bytesValue)
+			}
+			groupValues[i], err = parseCountGroupByGroupValue(groupBy[i], value)
+			if err != nil {
+				return nil, err
+			}
+		}
+		values := make([]any, len(columns)-len(groupBy))
+		for i := range values {
+			values[i] = *(rowValues[len(groupBy)+i].(*any))
+		}
+		res = append(res, VisibilityAggregationRow{
+			GroupValues: groupValues,
+			Values:      values,
+		})
+	}
+	return res, rows.Err()
+}
+
 func parseCountGroupByGroupValue(fieldName string, value any) (any, error) {
 	switch fieldName {
 	case searchattribute.ExecutionStatus:
@@ -0,0 +1,19 @@
+package persistence
+
+import (
+	"context"
+)
+
+type staleReadsContextKey struct{}
+
+// WithStaleReads marks the reads made with the returned context as tolerating stale data,
+// which allows stores to serve them from read replicas.
+func WithStaleReads(ctx context.Context) context.Context {
+	return context.WithValue(ctx, staleReadsContextKey{}, true)
+}
+
+// StaleReadsAllowed returns true if the reads made with the context tolerate stale data
+func StaleReadsAllowed(ctx context.Context) bool {
+	allowed, _ := ctx.Value(staleReadsContextKey{}).(bool)
+	return allowed
+}
@@ -72,6 +72,9 @@ func NewExecutionMutableStateSuite(
 			nil,
 			logger,
 			dynamicconfig.GetIntPropertyFn(4*1024*1024),
+			nil,
+			nil,
+			nil,
 		),
 		historyBranchUtil: historyBranchUtil,
 		Logger:            logger,
@@ -82,6 +82,9 @@ func NewExecutionMutableStateTaskSuite(
 			nil,
 			logger,
 			dynamicconfig.GetIntPropertyFn(4*1024*1024),
+			nil,
+			nil,
+			nil,
 		),
 		Logger: logger,
 	}
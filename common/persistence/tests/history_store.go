@@ -65,6 +65,9 @@ func NewHistoryEventsSuite(
 			nil,
 			logger,
 			dynamicconfig.GetIntPropertyFn(4*1024*1024),
+			nil,
+			nil,
+			nil,
 		),
 		serializer: eventSerializer,
 		logger:     logger,
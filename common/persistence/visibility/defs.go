@@ -2,6 +2,7 @@ package visibility
 
 import (
 	"go.temporal.io/server/common/dynamicconfig"
+	"go.temporal.io/server/common/persistence/sql/sqlplugin/cockroachdb"
 	"go.temporal.io/server/common/persistence/sql/sqlplugin/mysql"
 	"go.temporal.io/server/common/persistence/sql/sqlplugin/postgresql"
 	"go.temporal.io/server/common/persistence/sql/sqlplugin/sqlite"
@@ -25,7 +26,7 @@ func AllowListForValidation(
 	}
 
 	switch storeNames[0] {
-	case mysql.PluginName, postgresql.PluginName, postgresql.PluginNamePGX, sqlite.PluginName:
+	case mysql.PluginName, postgresql.PluginName, postgresql.PluginNamePGX, cockroachdb.PluginName, sqlite.PluginName:
 		// Advanced visibility with SQL DB don't support list of values
 		return dynamicconfig.GetBoolPropertyFnFilteredByNamespace(false)
 	default:
@@ -11,6 +11,7 @@ import (
 	enumspb "go.temporal.io/api/enums/v1"
 	workflowpb "go.temporal.io/api/workflow/v1"
 	"go.temporal.io/api/workflowservice/v1"
+	"go.temporal.io/server/api/adminservice/v1"
 	"go.temporal.io/server/common/namespace"
 	"go.temporal.io/server/common/persistence"
 )
@@ -35,6 +36,7 @@ type (
 		ListWorkflowExecutions(ctx context.Context, request *ListWorkflowExecutionsRequestV2) (*ListWorkflowExecutionsResponse, error)
 		ScanWorkflowExecutions(ctx context.Context, request *ListWorkflowExecutionsRequestV2) (*ListWorkflowExecutionsResponse, error)
 		CountWorkflowExecutions(ctx context.Context, request *CountWorkflowExecutionsRequest) (*CountWorkflowExecutionsResponse, error)
+		AggregateWorkflowExecutions(ctx context.Context, request *AggregateWorkflowExecutionsRequest) (*AggregateWorkflowExecutionsResponse, error)
 		GetWorkflowExecution(ctx context.Context, request *GetWorkflowExecutionRequest) (*GetWorkflowExecutionResponse, error)
 
 		// Admin APIs
@@ -124,6 +126,21 @@ type (
 		Groups []*workflowservice.CountWorkflowExecutionsResponse_AggregationGroup
 	}
 
+	// AggregateWorkflowExecutionsRequest is request from AggregateWorkflowExecutions
+	AggregateWorkflowExecutionsRequest struct {
+		NamespaceID  namespace.ID
+		Namespace    namespace.Name // namespace.Name is not persisted.
+		Query        string
+		GroupBy      []string // search attributes to group by, must be of Keyword type
+		Aggregations []*adminservice.VisibilityAggregation
+		MaxGroups    int // maximum number of groups returned, the ones with the highest count are kept
+	}
+
+	// AggregateWorkflowExecutionsResponse is response to AggregateWorkflowExecutions
+	AggregateWorkflowExecutionsResponse struct {
+		Groups []*adminservice.VisibilityAggregationGroup
+	}
+
 	// VisibilityDeleteWorkflowExecutionRequest contains the request params for DeleteWorkflowExecution call
 	VisibilityDeleteWorkflowExecutionRequest struct {
 		NamespaceID namespace.ID
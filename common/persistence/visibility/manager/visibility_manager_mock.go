@@ -55,6 +55,21 @@ func (mr *MockVisibilityManagerMockRecorder) AddSearchAttributes(ctx, request an
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddSearchAttributes", reflect.TypeOf((*MockVisibilityManager)(nil).AddSearchAttributes), ctx, request)
 }
 
+// AggregateWorkflowExecutions mocks base method.
+func (m *MockVisibilityManager) AggregateWorkflowExecutions(ctx context.Context, request *AggregateWorkflowExecutionsRequest) (*AggregateWorkflowExecutionsResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AggregateWorkflowExecutions", ctx, request)
+	ret0, _ := ret[0].(*AggregateWorkflowExecutionsResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// AggregateWorkflowExecutions indicates an expected call of AggregateWorkflowExecutions.
+func (mr *MockVisibilityManagerMockRecorder) AggregateWorkflowExecutions(ctx, request any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AggregateWorkflowExecutions", reflect.TypeOf((*MockVisibilityManager)(nil).AggregateWorkflowExecutions), ctx, request)
+}
+
 // Close mocks base method.
 func (m *MockVisibilityManager) Close() {
 	m.ctrl.T.Helper()
@@ -0,0 +1,281 @@
+package elasticsearch
+
+import (
+	"bytes"
+	"cmp"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"slices"
+	"strconv"
+
+	"github.com/olivere/elastic/v7"
+	commonpb "go.temporal.io/api/common/v1"
+	"go.temporal.io/api/serviceerror"
+	"go.temporal.io/server/api/adminservice/v1"
+	enumsspb "go.temporal.io/server/api/enums/v1"
+	"go.temporal.io/server/common/persistence/visibility/manager"
+	"go.temporal.io/server/common/persistence/visibility/store/query"
+	"go.temporal.io/server/common/searchattribute"
+)
+
+// allExecutionsAggName is the name of the aggregation holding the metric aggregations when
+// workflow executions are not grouped.
+const allExecutionsAggName = "executions"
+
+func (s *VisibilityStore) AggregateWorkflowExecutions(
+	ctx context.Context,
+	request *manager.AggregateWorkflowExecutionsRequest,
+) (*manager.AggregateWorkflowExecutionsResponse, error) {
+	queryParams, err := s.convertQuery(request.Namespace, request.NamespaceID, request.Query)
+	if err != nil {
+		return nil, err
+	}
+	if len(queryParams.GroupBy) > 0 {
+		return nil, serviceerror.NewInvalidArgument("GROUP BY clause is not supported")
+	}
+	if len(queryParams.Sorter) > 0 {
+		return nil, serviceerror.NewInvalidArgument("ORDER BY clause is not supported")
+	}
+
+	saTypeMap, err := s.searchAttributesProvider.GetSearchAttributes(s.index, false)
+	if err != nil {
+		return nil, serviceerror.NewUnavailablef("unable to read search attribute types: %v", err)
+	}
+	nameInterceptor := NewNameInterceptor(request.Namespace, saTypeMap, s.searchAttributesMapperProvider)
+	groupBy, aggregations, err := query.ConvertAggregations(
+		request.GroupBy,
+		request.Aggregations,
+		func(name string) (query.AggregationField, error) {
+			fieldName, err := nameInterceptor.Name(name, query.FieldNameAggregation)
+			if err != nil {
+				return query.AggregationField{}, err
+			}
+			fieldType, err := saTypeMap.GetType(fieldName)
+			if err != nil {
+				return query.AggregationField{}, query.NewConverterError("invalid search attribute: %s", name)
+			}
+			return query.AggregationField{FieldName: fieldName, Type: fieldType}, nil
+		},
+	)
+	if err != nil {
+		// Convert ConverterError to InvalidArgument and pass through all other errors (which should be only mapper errors).
+		var converterErr *query.ConverterError
+		if errors.As(err, &converterErr) {
+			return nil, converterErr.ToInvalidArgument()
+		}
+		return nil, err
+	}
+
+	aggName, agg := buildAggregation(groupBy, aggregations, request.MaxGroups)
+	esResponse, err := s.esClient.CountGroupBy(ctx, s.index, queryParams.Query, aggName, agg)
+	if err != nil {
+		return nil, ConvertElasticsearchClientError("AggregateWorkflowExecutions failed", err)
+	}
+	groups, err := parseAggregationResponse(esResponse, aggName, groupBy, aggregations)
+	if err != nil {
+		return nil, err
+	}
+
+	// Nested terms aggregations keep the groups with the highest count at each level only.
+	slices.SortStableFunc(groups, func(a, b *adminservice.VisibilityAggregationGroup) int {
+		return cmp.Compare(b.GetCount(), a.GetCount())
+	})
+	if request.MaxGroups > 0 && len(groups) > request.MaxGroups {
+		groups = groups[:request.MaxGroups]
+	}
+	return &manager.AggregateWorkflowExecutionsResponse{Groups: groups}, nil
+}
+
+// buildAggregation builds nested terms aggregations over the group by fields, with the metric
+// aggregations at the deepest level, the same way as for CountWorkflowExecutions.
+func buildAggregation(
+	groupBy []query.AggregationField,
+	aggregations []query.Aggregation,
+	maxGroups int,
+) (string, elastic.Aggregation) {
+	addMetricAggregations := func(add func(name string, agg elastic.Aggregation)) {
+		for i, aggregation := range aggregations {
+			field := aggregation.Field.FieldName
+			var metricAgg elastic.Aggregation
+			switch aggregation.Function {
+			case enumsspb.VISIBILITY_AGGREGATION_FUNCTION_MIN:
+				metricAgg = elastic.NewMinAggregation().Field(field)
+			case enumsspb.VISIBILITY_AGGREGATION_FUNCTION_MAX:
+				metricAgg = elastic.NewMaxAggregation().Field(field)
+			case enumsspb.VISIBILITY_AGGREGATION_FUNCTION_AVG:
+				metricAgg = elastic.NewAvgAggregation().Field(field)
+			case enumsspb.VISIBILITY_AGGREGATION_FUNCTION_SUM:
+				metricAgg = elastic.NewSumAggregation().Field(field)
+			case enumsspb.VISIBILITY_AGGREGATION_FUNCTION_PERCENTILES:
+				metricAgg = elastic.NewPercentilesAggregation().Field(field).Percentiles(aggregation.Percentiles...)
+			}
+			add(getMetricAggName(i), metricAgg)
+		}
+	}
+
+	if len(groupBy) == 0 {
+		filterAgg := elastic.NewFilterAggregation().Filter(elastic.NewMatchAllQuery())
+		addMetricAggregations(func(name string, agg elastic.Aggregation) { filterAgg.SubAggregation(name, agg) })
+		return allExecutionsAggName, filterAgg
+	}
+
+	newTermsAggregation := func(field string) *elastic.TermsAggregation {
+		termsAgg := elastic.NewTermsAggregation().Field(field)
+		if maxGroups > 0 {
+			termsAgg.Size(maxGroups)
+		}
+		return termsAgg
+	}
+	termsAgg := newTermsAggregation(groupBy[len(groupBy)-1].FieldName)
+	addMetricAggregations(func(name string, agg elastic.Aggregation) { termsAgg.SubAggregation(name, agg) })
+	for i := len(groupBy) - 2; i >= 0; i-- {
+		termsAgg = newTermsAggregation(groupBy[i].FieldName).SubAggregation(groupBy[i+1].FieldName, termsAgg)
+	}
+	return groupBy[0].FieldName, termsAgg
+}
+
+func getMetricAggName(aggregationIdx int) string {
+	return "aggregation_" + strconv.Itoa(aggregationIdx)
+}
+
+// parseAggregationResponse flattens the nested aggregation buckets into groups.
+func parseAggregationResponse(
+	searchResult *elastic.SearchResult,
+	aggName string,
+	groupBy []query.AggregationField,
+	aggregations []query.Aggregation,
+) ([]*adminservice.VisibilityAggregationGroup, error) {
+	var aggJson map[string]any
+	dec := json.NewDecoder(bytes.NewReader(searchResult.Aggregations[aggName]))
+	dec.UseNumber()
+	if err := dec.Decode(&aggJson); err != nil {
+		return nil, serviceerror.NewInternalf("unable to unmarshal json response: %v", err)
+	}
+
+	var groups []*adminservice.VisibilityAggregationGroup
+	var parseInternal func(map[string]any, []*commonpb.Payload) error
+	parseInternal = func(aggs map[string]any, bucketValues []*commonpb.Payload) error {
+		if len(bucketValues) == len(groupBy) {
+			group, err := parseAggregationGroup(aggs, aggregations)
+			if err != nil {
+				return err
+			}
+			group.GroupValues = slices.Clone(bucketValues)
+			groups = append(groups, group)
+			return nil
+		}
+
+		index := len(bucketValues)
+		field := groupBy[index]
+		fieldAgg, ok := aggs[field.FieldName].(map[string]any)
+		if !ok {
+			return fmt.Errorf("%w: missing aggregation of %s", errUnexpectedJSONFieldType, field.FieldName)
+		}
+		buckets, ok := fieldAgg["buckets"].([]any)
+		if !ok {
+			return fmt.Errorf("%w: missing buckets of %s", errUnexpectedJSONFieldType, field.FieldName)
+		}
+		for _, b := range buckets {
+			bucket, ok := b.(map[string]any)
+			if !ok {
+				return fmt.Errorf("%w: expected bucket object, got %T", errUnexpectedJSONFieldType, b)
+			}
+			value, err := finishParseJSONValue(bucket["key"], field.Type)
+			if err != nil {
+				return fmt.Errorf("unable to parse value %v: %w", bucket["key"], err)
+			}
+			payload, err := searchattribute.EncodeValue(value, field.Type)
+			if err != nil {
+				return fmt.Errorf("unable to encode value %v: %w", value, err)
+			}
+			if err := parseInternal(bucket, append(bucketValues, payload)); err != nil {
+				return err
+			}
+		}
+		return nil
+	}
+
+	if len(groupBy) == 0 {
+		group, err := parseAggregationGroup(aggJson, aggregations)
+		if err != nil {
+			return nil, serviceerror.NewInternalf("unable to parse aggregation response: %v", err)
+		}
+		return []*adminservice.VisibilityAggregationGroup{group}, nil
+	}
+	if err := parseInternal(map[string]any{aggName: aggJson}, nil); err != nil {
+		return nil, serviceerror.NewInternalf("unable to parse aggregation response: %v", err)
+	}
+	return groups, nil
+}
+
+func parseAggregationGroup(
+	bucket map[string]any,
+	aggregations []query.Aggregation,
+) (*adminservice.VisibilityAggregationGroup, error) {
+	count, err := parseJSONNumber(bucket["doc_count"])
+	if err != nil {
+		return nil, fmt.Errorf("unable to parse 'doc_count' field: %w", err)
+	}
+	group := &adminservice.VisibilityAggregationGroup{
+		Count:   int64(count),
+		Results: make([]*adminservice.VisibilityAggregationResult, len(aggregations)),
+	}
+	for i, aggregation := range aggregations {
+		metricAgg, ok := bucket[getMetricAggName(i)].(map[string]any)
+		if !ok {
+			return nil, fmt.Errorf("%w: missing aggregation %d", errUnexpectedJSONFieldType, i)
+		}
+		result := &adminservice.VisibilityAggregationResult{}
+		group.Results[i] = result
+		if aggregation.Function != enumsspb.VISIBILITY_AGGREGATION_FUNCTION_PERCENTILES {
+			if metricAgg["value"] == nil {
+				continue
+			}
+			result.Value, err = parseJSONNumber(metricAgg["value"])
+			if err != nil {
+				return nil, fmt.Errorf("unable to parse value of aggregation %d: %w", i, err)
+			}
+			result.HasValue = true
+			continue
+		}
+
+		// Percentiles are keyed by their string representation, e.g. "99.0".
+		values, ok := metricAgg["values"].(map[string]any)
+		if !ok {
+			return nil, fmt.Errorf("%w: missing percentiles of aggregation %d", errUnexpectedJSONFieldType, i)
+		}
+		percentileValues := make(map[float64]any, len(values))
+		for key, value := range values {
+			percentile, err := strconv.ParseFloat(key, 64)
+			if err != nil {
+				return nil, fmt.Errorf("unable to parse percentile %q: %w", key, err)
+			}
+			percentileValues[percentile] = value
+		}
+		result.PercentileValues = make([]float64, len(aggregation.Percentiles))
+		for j, percentile := range aggregation.Percentiles {
+			value := percentileValues[percentile]
+			if value == nil {
+				// Percentiles have no value if none of the workflow executions has a value.
+				result.PercentileValues = nil
+				break
+			}
+			result.PercentileValues[j], err = parseJSONNumber(value)
+			if err != nil {
+				return nil, fmt.Errorf("unable to parse percentile %v of aggregation %d: %w", percentile, i, err)
+			}
+		}
+		result.HasValue = result.PercentileValues != nil
+	}
+	return group, nil
+}
+
+func parseJSONNumber(val any) (float64, error) {
+	numberVal, isNumber := val.(json.Number)
+	if !isNumber {
+		return 0, fmt.Errorf("%w: expected json.Number, got %T", errUnexpectedJSONFieldType, val)
+	}
+	return numberVal.Float64()
+}
@@ -12,6 +12,7 @@ import (
 
 const (
 	versionTypeExternal                 = "external"
+	versionOpenSearch2                  = "opensearch2"
 	minimumCloseIdleConnectionsInterval = 15 * time.Second
 )
 
@@ -11,6 +11,8 @@ func NewClient(config *Config, httpClient *http.Client, logger log.Logger) (Clie
 	switch config.Version {
 	case "v8", "v7", "":
 		return newClient(config, httpClient, logger)
+	case versionOpenSearch2:
+		return newOpenSearchClient(config, httpClient, logger)
 	default:
 		return nil, fmt.Errorf("not supported Elasticsearch version: %v", config.Version)
 	}
@@ -20,6 +22,8 @@ func NewCLIClient(config *Config, logger log.Logger) (CLIClient, error) {
 	switch config.Version {
 	case "v8", "v7", "":
 		return newClient(config, nil, logger)
+	case versionOpenSearch2:
+		return newOpenSearchClient(config, nil, logger)
 	default:
 		return nil, fmt.Errorf("not supported Elasticsearch version: %v", config.Version)
 	}
@@ -29,6 +33,8 @@ func NewFunctionalTestsClient(config *Config, logger log.Logger) (IntegrationTes
 	switch config.Version {
 	case "v8", "v7", "":
 		return newClient(config, nil, logger)
+	case versionOpenSearch2:
+		return newOpenSearchClient(config, nil, logger)
 	default:
 		return nil, fmt.Errorf("not supported Elasticsearch version: %v", config.Version)
 	}
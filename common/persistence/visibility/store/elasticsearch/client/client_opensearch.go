@@ -0,0 +1,173 @@
+package client
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/url"
+	"slices"
+	"sync"
+
+	"github.com/blang/semver/v4"
+	"github.com/olivere/elastic/v7"
+	"github.com/olivere/elastic/v7/uritemplates"
+	"go.temporal.io/server/common/log"
+	"go.temporal.io/server/common/searchattribute"
+)
+
+type (
+	// openSearchClient implements Client for OpenSearch 2.x. The search, count, bulk and mapping APIs
+	// are wire compatible with Elasticsearch 7 and are shared with clientImpl, while point in time
+	// and index templates use the OpenSearch APIs.
+	openSearchClient struct {
+		*clientImpl
+
+		initIsPointInTimeSupported sync.Once
+		isPointInTimeSupported     bool
+	}
+
+	openSearchInfoResponse struct {
+		Version struct {
+			Distribution string `json:"distribution"`
+			Number       string `json:"number"`
+		} `json:"version"`
+	}
+
+	openSearchCreatePitResponse struct {
+		PitID string `json:"pit_id"`
+	}
+
+	openSearchDeletePitResponse struct {
+		Pits []struct {
+			PitID      string `json:"pit_id"`
+			Successful bool   `json:"successful"`
+		} `json:"pits"`
+	}
+
+	openSearchAcknowledgedResponse struct {
+		Acknowledged bool `json:"acknowledged"`
+	}
+)
+
+const (
+	openSearchDistribution = "opensearch"
+)
+
+var (
+	openSearchPointInTimeSupportedIn = semver.MustParseRange(">=2.4.0")
+)
+
+var _ Client = (*openSearchClient)(nil)
+
+// newOpenSearchClient create an OpenSearch client
+func newOpenSearchClient(cfg *Config, httpClient *http.Client, logger log.Logger) (*openSearchClient, error) {
+	client, err := newClient(cfg, httpClient, logger)
+	if err != nil {
+		return nil, err
+	}
+	return &openSearchClient{
+		clientImpl: client,
+	}, nil
+}
+
+// Search adds a RunId tiebreaker to the sort of point in time searches. Unlike Elasticsearch, OpenSearch
+// doesn't add an implicit _shard_doc tiebreaker, and _doc alone isn't unique across shards, which
+// would make search_after skip or repeat documents.
+func (c *openSearchClient) Search(ctx context.Context, p *SearchParameters) (*elastic.SearchResult, error) {
+	if p.PointInTime != nil {
+		params := *p
+		params.Sorter = append(slices.Clone(p.Sorter), elastic.NewFieldSort(searchattribute.RunID))
+		p = &params
+	}
+	return c.clientImpl.Search(ctx, p)
+}
+
+func (c *openSearchClient) IsPointInTimeSupported(ctx context.Context) bool {
+	c.initIsPointInTimeSupported.Do(func() {
+		c.isPointInTimeSupported = c.queryPointInTimeSupported(ctx)
+	})
+	return c.isPointInTimeSupported
+}
+
+func (c *openSearchClient) queryPointInTimeSupported(ctx context.Context) bool {
+	var info openSearchInfoResponse
+	if err := c.performRequest(ctx, http.MethodGet, "/", nil, nil, &info); err != nil {
+		return false
+	}
+	if info.Version.Distribution != openSearchDistribution {
+		return false
+	}
+	version, err := semver.ParseTolerant(info.Version.Number)
+	if err != nil {
+		return false
+	}
+	return openSearchPointInTimeSupportedIn(version)
+}
+
+func (c *openSearchClient) OpenPointInTime(ctx context.Context, index string, keepAliveInterval string) (string, error) {
+	path, err := uritemplates.Expand("/{index}/_search/point_in_time", map[string]string{
+		"index": index,
+	})
+	if err != nil {
+		return "", err
+	}
+	var resp openSearchCreatePitResponse
+	params := url.Values{"keep_alive": []string{keepAliveInterval}}
+	if err := c.performRequest(ctx, http.MethodPost, path, params, nil, &resp); err != nil {
+		return "", err
+	}
+	return resp.PitID, nil
+}
+
+func (c *openSearchClient) ClosePointInTime(ctx context.Context, id string) (bool, error) {
+	var resp openSearchDeletePitResponse
+	body := map[string]any{"pit_id": []string{id}}
+	if err := c.performRequest(ctx, http.MethodDelete, "/_search/point_in_time", nil, body, &resp); err != nil {
+		return false, err
+	}
+	for _, pit := range resp.Pits {
+		if pit.PitID == id {
+			return pit.Successful, nil
+		}
+	}
+	return false, nil
+}
+
+// IndexPutTemplate puts a composable index template, legacy index templates are deprecated in OpenSearch.
+func (c *openSearchClient) IndexPutTemplate(ctx context.Context, templateName string, bodyString string) (bool, error) {
+	path, err := uritemplates.Expand("/_index_template/{name}", map[string]string{
+		"name": templateName,
+	})
+	if err != nil {
+		return false, err
+	}
+	var resp openSearchAcknowledgedResponse
+	if err := c.performRequest(ctx, http.MethodPut, path, nil, bodyString, &resp); err != nil {
+		return false, err
+	}
+	return resp.Acknowledged, nil
+}
+
+func (c *openSearchClient) performRequest(
+	ctx context.Context,
+	method string,
+	path string,
+	params url.Values,
+	body any,
+	result any,
+) error {
+	if params == nil {
+		params = url.Values{}
+	}
+	res, err := c.esClient.PerformRequest(ctx, elastic.PerformRequestOptions{
+		Method:  method,
+		Path:    path,
+		Params:  params,
+		Body:    body,
+		Headers: http.Header{},
+	})
+	if err != nil {
+		return err
+	}
+	return json.Unmarshal(res.Body, result)
+}
@@ -0,0 +1,128 @@
+package client
+
+import (
+	"compress/gzip"
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"testing"
+
+	"github.com/olivere/elastic/v7"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.temporal.io/server/common/log"
+)
+
+func newTestOpenSearchClient(t *testing.T, handler http.HandlerFunc) *openSearchClient {
+	server := httptest.NewServer(handler)
+	t.Cleanup(server.Close)
+	serverURL, err := url.Parse(server.URL)
+	require.NoError(t, err)
+
+	client, err := NewClient(&Config{Version: versionOpenSearch2, URL: *serverURL}, nil, log.NewNoopLogger())
+	require.NoError(t, err)
+	require.IsType(t, &openSearchClient{}, client)
+	return client.(*openSearchClient)
+}
+
+// decodeRequestBody decodes the JSON body of the request, which is gzipped by the client.
+func decodeRequestBody(t *testing.T, r *http.Request, body any) {
+	reader := io.Reader(r.Body)
+	if r.Header.Get("Content-Encoding") == "gzip" {
+		gzipReader, err := gzip.NewReader(r.Body)
+		if !assert.NoError(t, err) {
+			return
+		}
+		reader = gzipReader
+	}
+	assert.NoError(t, json.NewDecoder(reader).Decode(body))
+}
+
+func TestOpenSearchClient_IsPointInTimeSupported(t *testing.T) {
+	testCases := []struct {
+		name         string
+		distribution string
+		number       string
+		expected     bool
+	}{
+		{name: "opensearch 2.11", distribution: "opensearch", number: "2.11.0", expected: true},
+		{name: "opensearch 2.4", distribution: "opensearch", number: "2.4.0", expected: true},
+		{name: "opensearch 2.3", distribution: "opensearch", number: "2.3.0", expected: false},
+		{name: "elasticsearch", distribution: "", number: "7.10.2", expected: false},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			client := newTestOpenSearchClient(t, func(w http.ResponseWriter, r *http.Request) {
+				assert.Equal(t, "/", r.URL.Path)
+				_, _ = io.WriteString(w, `{"version":{"distribution":"`+tc.distribution+`","number":"`+tc.number+`"}}`)
+			})
+			require.Equal(t, tc.expected, client.IsPointInTimeSupported(context.Background()))
+		})
+	}
+}
+
+func TestOpenSearchClient_PointInTime(t *testing.T) {
+	client := newTestOpenSearchClient(t, func(w http.ResponseWriter, r *http.Request) {
+		switch {
+		case r.Method == http.MethodPost && r.URL.Path == "/test-index/_search/point_in_time":
+			assert.Equal(t, "1m", r.URL.Query().Get("keep_alive"))
+			_, _ = io.WriteString(w, `{"pit_id":"pit-id","creation_time":1}`)
+		case r.Method == http.MethodDelete && r.URL.Path == "/_search/point_in_time":
+			var body struct {
+				PitID []string `json:"pit_id"`
+			}
+			decodeRequestBody(t, r, &body)
+			assert.Equal(t, []string{"pit-id"}, body.PitID)
+			_, _ = io.WriteString(w, `{"pits":[{"pit_id":"pit-id","successful":true}]}`)
+		default:
+			t.Errorf("unexpected request %s %s", r.Method, r.URL.Path)
+			w.WriteHeader(http.StatusBadRequest)
+		}
+	})
+
+	pitID, err := client.OpenPointInTime(context.Background(), "test-index", "1m")
+	require.NoError(t, err)
+	require.Equal(t, "pit-id", pitID)
+
+	succeeded, err := client.ClosePointInTime(context.Background(), pitID)
+	require.NoError(t, err)
+	require.True(t, succeeded)
+}
+
+func TestOpenSearchClient_Search_PointInTimeTiebreaker(t *testing.T) {
+	client := newTestOpenSearchClient(t, func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/_search", r.URL.Path)
+		var body struct {
+			Sort []any `json:"sort"`
+		}
+		decodeRequestBody(t, r, &body)
+		assert.Equal(t, []any{"_doc", map[string]any{"RunId": map[string]any{"order": "asc"}}}, body.Sort)
+		_, _ = io.WriteString(w, `{"pit_id":"pit-id","hits":{"hits":[]}}`)
+	})
+
+	sorter := []elastic.Sorter{elastic.SortByDoc{}}
+	result, err := client.Search(context.Background(), &SearchParameters{
+		Index:       "test-index",
+		Query:       elastic.NewMatchAllQuery(),
+		Sorter:      sorter,
+		PointInTime: elastic.NewPointInTimeWithKeepAlive("pit-id", "1m"),
+	})
+	require.NoError(t, err)
+	require.Equal(t, "pit-id", result.PitId)
+	require.Len(t, sorter, 1)
+}
+
+func TestOpenSearchClient_IndexPutTemplate(t *testing.T) {
+	client := newTestOpenSearchClient(t, func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodPut, r.Method)
+		assert.Equal(t, "/_index_template/test-template", r.URL.Path)
+		_, _ = io.WriteString(w, `{"acknowledged":true}`)
+	})
+
+	acknowledged, err := client.IndexPutTemplate(context.Background(), "test-template", `{"index_patterns":["test*"]}`)
+	require.NoError(t, err)
+	require.True(t, acknowledged)
+}
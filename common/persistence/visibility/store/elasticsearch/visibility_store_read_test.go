@@ -18,6 +18,8 @@ import (
 	"go.temporal.io/api/serviceerror"
 	"go.temporal.io/api/temporalproto"
 	"go.temporal.io/api/workflowservice/v1"
+	"go.temporal.io/server/api/adminservice/v1"
+	enumsspb "go.temporal.io/server/api/enums/v1"
 	"go.temporal.io/server/common/debug"
 	"go.temporal.io/server/common/dynamicconfig"
 	"go.temporal.io/server/common/metrics"
@@ -1568,6 +1570,159 @@ func (s *ESVisibilitySuite) TestCountGroupByWorkflowExecutions() {
 	}
 }
 
+func (s *ESVisibilitySuite) TestAggregateWorkflowExecutions() {
+	request := &manager.AggregateWorkflowExecutionsRequest{
+		NamespaceID: testNamespaceID,
+		Namespace:   testNamespace,
+		GroupBy:     []string{searchattribute.WorkflowType},
+		Aggregations: []*adminservice.VisibilityAggregation{
+			{
+				Function: enumsspb.VISIBILITY_AGGREGATION_FUNCTION_AVG,
+				Field:    searchattribute.ExecutionDuration,
+			},
+			{
+				Function:    enumsspb.VISIBILITY_AGGREGATION_FUNCTION_PERCENTILES,
+				Field:       searchattribute.CloseTime,
+				Percentiles: []float64{50, 99.9},
+			},
+		},
+		MaxGroups: 10,
+	}
+	s.mockESClient.EXPECT().
+		CountGroupBy(
+			gomock.Any(),
+			testIndex,
+			elastic.NewBoolQuery().
+				Filter(elastic.NewTermQuery(searchattribute.NamespaceID, testNamespaceID.String())).
+				MustNot(namespaceDivisionExists),
+			searchattribute.WorkflowType,
+			elastic.NewTermsAggregation().
+				Field(searchattribute.WorkflowType).
+				Size(10).
+				SubAggregation("aggregation_0", elastic.NewAvgAggregation().Field(searchattribute.ExecutionDuration)).
+				SubAggregation("aggregation_1", elastic.NewPercentilesAggregation().Field(searchattribute.CloseTime).Percentiles(50, 99.9)),
+		).
+		Return(
+			&elastic.SearchResult{
+				Aggregations: map[string]json.RawMessage{
+					searchattribute.WorkflowType: json.RawMessage(
+						`{
+							"buckets":[
+								{
+									"key": "wf-type-1",
+									"doc_count": 100,
+									"aggregation_0": {"value": 1500.5},
+									"aggregation_1": {"values": {"50.0": 1000, "99.9": 2000}}
+								},
+								{
+									"key": "wf-type-2",
+									"doc_count": 10,
+									"aggregation_0": {"value": null},
+									"aggregation_1": {"values": {"50.0": null, "99.9": null}}
+								}
+							]
+						}`,
+					),
+				},
+			},
+			nil,
+		)
+	resp, err := s.visibilityStore.AggregateWorkflowExecutions(context.Background(), request)
+	s.NoError(err)
+	wfType1Payload, _ := searchattribute.EncodeValue("wf-type-1", enumspb.INDEXED_VALUE_TYPE_KEYWORD)
+	wfType2Payload, _ := searchattribute.EncodeValue("wf-type-2", enumspb.INDEXED_VALUE_TYPE_KEYWORD)
+	s.True(temporalproto.DeepEqual(
+		&manager.AggregateWorkflowExecutionsResponse{
+			Groups: []*adminservice.VisibilityAggregationGroup{
+				{
+					GroupValues: []*commonpb.Payload{wfType1Payload},
+					Count:       100,
+					Results: []*adminservice.VisibilityAggregationResult{
+						{HasValue: true, Value: 1500.5},
+						{HasValue: true, PercentileValues: []float64{1000, 2000}},
+					},
+				},
+				{
+					GroupValues: []*commonpb.Payload{wfType2Payload},
+					Count:       10,
+					Results: []*adminservice.VisibilityAggregationResult{
+						{},
+						{},
+					},
+				},
+			},
+		},
+		resp),
+	)
+
+	// test only allowed to group by keyword search attributes
+	request.GroupBy = []string{searchattribute.ExecutionDuration}
+	_, err = s.visibilityStore.AggregateWorkflowExecutions(context.Background(), request)
+	var invalidArgumentErr *serviceerror.InvalidArgument
+	s.ErrorAs(err, &invalidArgumentErr)
+
+	// test not allowed to sum datetime search attributes
+	request.GroupBy = nil
+	request.Aggregations = []*adminservice.VisibilityAggregation{
+		{
+			Function: enumsspb.VISIBILITY_AGGREGATION_FUNCTION_SUM,
+			Field:    searchattribute.StartTime,
+		},
+	}
+	_, err = s.visibilityStore.AggregateWorkflowExecutions(context.Background(), request)
+	s.ErrorAs(err, &invalidArgumentErr)
+}
+
+func (s *ESVisibilitySuite) TestAggregateWorkflowExecutions_NoGroupBy() {
+	request := &manager.AggregateWorkflowExecutionsRequest{
+		NamespaceID: testNamespaceID,
+		Namespace:   testNamespace,
+		Query:       "ExecutionStatus = 'Completed'",
+		Aggregations: []*adminservice.VisibilityAggregation{
+			{
+				Function: enumsspb.VISIBILITY_AGGREGATION_FUNCTION_MAX,
+				Field:    searchattribute.HistoryLength,
+			},
+		},
+	}
+	s.mockESClient.EXPECT().
+		CountGroupBy(
+			gomock.Any(),
+			testIndex,
+			gomock.Any(),
+			allExecutionsAggName,
+			elastic.NewFilterAggregation().
+				Filter(elastic.NewMatchAllQuery()).
+				SubAggregation("aggregation_0", elastic.NewMaxAggregation().Field(searchattribute.HistoryLength)),
+		).
+		Return(
+			&elastic.SearchResult{
+				Aggregations: map[string]json.RawMessage{
+					allExecutionsAggName: json.RawMessage(`{"doc_count": 42, "aggregation_0": {"value": 7}}`),
+				},
+			},
+			nil,
+		)
+	resp, err := s.visibilityStore.AggregateWorkflowExecutions(context.Background(), request)
+	s.NoError(err)
+	s.True(temporalproto.DeepEqual(
+		&manager.AggregateWorkflowExecutionsResponse{
+			Groups: []*adminservice.VisibilityAggregationGroup{
+				{
+					Count:   42,
+					Results: []*adminservice.VisibilityAggregationResult{{HasValue: true, Value: 7}},
+				},
+			},
+		},
+		resp),
+	)
+
+	request.Query = "ORDER BY StartTime"
+	_, err = s.visibilityStore.AggregateWorkflowExecutions(context.Background(), request)
+	var invalidArgumentErr *serviceerror.InvalidArgument
+	s.ErrorAs(err, &invalidArgumentErr)
+}
+
 func (s *ESVisibilitySuite) TestGetWorkflowExecution() {
 	s.mockESClient.EXPECT().Get(gomock.Any(), testIndex, gomock.Any()).DoAndReturn(
 		func(ctx context.Context, index string, docID string) (*elastic.GetResult, error) {
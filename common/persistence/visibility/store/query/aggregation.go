@@ -0,0 +1,118 @@
+package query
+
+import (
+	enumspb "go.temporal.io/api/enums/v1"
+	"go.temporal.io/server/api/adminservice/v1"
+	enumsspb "go.temporal.io/server/api/enums/v1"
+)
+
+type (
+	// AggregationField is a search attribute resolved to its field name and type.
+	AggregationField struct {
+		FieldName string
+		Type      enumspb.IndexedValueType
+	}
+
+	// Aggregation is a validated aggregation over a search attribute.
+	Aggregation struct {
+		Function    enumsspb.VisibilityAggregationFunction
+		Field       AggregationField
+		Percentiles []float64
+	}
+)
+
+// ConvertAggregations resolves the group by search attributes and the aggregated search attributes
+// with resolveName, and validates them against the functions applied to them.
+func ConvertAggregations(
+	groupBy []string,
+	aggregations []*adminservice.VisibilityAggregation,
+	resolveName func(name string) (AggregationField, error),
+) ([]AggregationField, []Aggregation, error) {
+	groupByFields := make([]AggregationField, 0, len(groupBy))
+	seen := make(map[string]struct{}, len(groupBy))
+	for _, name := range groupBy {
+		field, err := resolveName(name)
+		if err != nil {
+			return nil, nil, err
+		}
+		if field.Type != enumspb.INDEXED_VALUE_TYPE_KEYWORD {
+			return nil, nil, NewConverterError(
+				"%s: unable to group by search attribute '%s' of %s type, use a search attribute of %s type",
+				NotSupportedErrMessage,
+				name,
+				field.Type,
+				enumspb.INDEXED_VALUE_TYPE_KEYWORD,
+			)
+		}
+		if _, ok := seen[field.FieldName]; ok {
+			return nil, nil, NewConverterError("%s: duplicate group by search attribute '%s'", InvalidExpressionErrMessage, name)
+		}
+		seen[field.FieldName] = struct{}{}
+		groupByFields = append(groupByFields, field)
+	}
+
+	result := make([]Aggregation, 0, len(aggregations))
+	for _, aggregation := range aggregations {
+		field, err := resolveName(aggregation.GetField())
+		if err != nil {
+			return nil, nil, err
+		}
+		if err := validateAggregation(aggregation, field); err != nil {
+			return nil, nil, err
+		}
+		result = append(result, Aggregation{
+			Function:    aggregation.GetFunction(),
+			Field:       field,
+			Percentiles: aggregation.GetPercentiles(),
+		})
+	}
+	return groupByFields, result, nil
+}
+
+func validateAggregation(aggregation *adminservice.VisibilityAggregation, field AggregationField) error {
+	switch field.Type {
+	case enumspb.INDEXED_VALUE_TYPE_INT, enumspb.INDEXED_VALUE_TYPE_DOUBLE, enumspb.INDEXED_VALUE_TYPE_DATETIME:
+	default:
+		return NewConverterError(
+			"%s: unable to aggregate search attribute '%s' of %s type",
+			NotSupportedErrMessage,
+			aggregation.GetField(),
+			field.Type,
+		)
+	}
+
+	switch aggregation.GetFunction() {
+	case enumsspb.VISIBILITY_AGGREGATION_FUNCTION_MIN,
+		enumsspb.VISIBILITY_AGGREGATION_FUNCTION_MAX,
+		enumsspb.VISIBILITY_AGGREGATION_FUNCTION_AVG:
+	case enumsspb.VISIBILITY_AGGREGATION_FUNCTION_SUM:
+		if field.Type == enumspb.INDEXED_VALUE_TYPE_DATETIME {
+			return NewConverterError(
+				"%s: unable to sum search attribute '%s' of %s type",
+				NotSupportedErrMessage,
+				aggregation.GetField(),
+				field.Type,
+			)
+		}
+	case enumsspb.VISIBILITY_AGGREGATION_FUNCTION_PERCENTILES:
+		if len(aggregation.GetPercentiles()) == 0 {
+			return NewConverterError("%s: percentiles of '%s' are not set", InvalidExpressionErrMessage, aggregation.GetField())
+		}
+		for _, percentile := range aggregation.GetPercentiles() {
+			if percentile < 0 || percentile > 100 {
+				return NewConverterError("%s: percentile %v is not between 0 and 100", InvalidExpressionErrMessage, percentile)
+			}
+		}
+		return nil
+	default:
+		return NewConverterError("%s: aggregation function %s", NotSupportedErrMessage, aggregation.GetFunction())
+	}
+	if len(aggregation.GetPercentiles()) > 0 {
+		return NewConverterError(
+			"%s: percentiles are only supported by the %s function",
+			InvalidExpressionErrMessage,
+			enumsspb.VISIBILITY_AGGREGATION_FUNCTION_PERCENTILES,
+		)
+	}
+	return nil
+}
@@ -0,0 +1,75 @@
+package sql
+
+import (
+	"errors"
+	"fmt"
+	"math"
+	"strconv"
+	"strings"
+
+	"go.temporal.io/api/serviceerror"
+	"go.temporal.io/server/common/persistence/visibility/store/query"
+)
+
+// maxPercentileSamples is the maximum number of values percentiles are computed from.
+const maxPercentileSamples = 100000
+
+// convertConverterError converts ConverterError to InvalidArgument and passes through all other
+// errors (which should be only mapper errors).
+func convertConverterError(err error) error {
+	var converterErr *query.ConverterError
+	if errors.As(err, &converterErr) {
+		return converterErr.ToInvalidArgument()
+	}
+	return err
+}
+
+// parseAggregationValue parses a value computed by the database, which depending on the driver
+// and the column type can be returned as a number, or as a string for decimals.
+func parseAggregationValue(value any) (float64, bool, error) {
+	switch v := value.(type) {
+	case nil:
+		return 0, false, nil
+	case int64:
+		return float64(v), true, nil
+	case int32:
+		return float64(v), true, nil
+	case int:
+		return float64(v), true, nil
+	case float64:
+		return v, true, nil
+	case float32:
+		return float64(v), true, nil
+	case []byte:
+		return parseAggregationString(string(v))
+	case string:
+		return parseAggregationString(v)
+	default:
+		return 0, false, serviceerror.NewInternalf("unable to parse aggregation value %v of type %T", value, value)
+	}
+}
+
+func parseAggregationString(value string) (float64, bool, error) {
+	f, err := strconv.ParseFloat(value, 64)
+	if err != nil {
+		return 0, false, serviceerror.NewInternalf("unable to parse aggregation value %q: %v", value, err)
+	}
+	return f, true, nil
+}
+
+func getAggregationGroupKey(groupValues []any) string {
+	parts := make([]string, len(groupValues))
+	for i, value := range groupValues {
+		parts[i] = fmt.Sprint(value)
+	}
+	return strings.Join(parts, "\x00")
+}
+
+// computePercentile returns the percentile of sorted values, interpolating linearly between the
+// closest ranks like the percentile_cont SQL function.
+func computePercentile(sortedValues []float64, percentile float64) float64 {
+	rank := percentile / 100 * float64(len(sortedValues)-1)
+	lower := int(math.Floor(rank))
+	upper := int(math.Ceil(rank))
+	return sortedValues[lower] + (sortedValues[upper]-sortedValues[lower])*(rank-float64(lower))
+}
@@ -0,0 +1,52 @@
+package sql
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseAggregationValue(t *testing.T) {
+	testCases := []struct {
+		value    any
+		expected float64
+		hasValue bool
+	}{
+		{value: nil},
+		{value: int64(3), expected: 3, hasValue: true},
+		{value: 1.5, expected: 1.5, hasValue: true},
+		{value: []byte("2.25"), expected: 2.25, hasValue: true},
+		{value: "-4", expected: -4, hasValue: true},
+	}
+	for _, tc := range testCases {
+		value, hasValue, err := parseAggregationValue(tc.value)
+		require.NoError(t, err)
+		require.Equal(t, tc.hasValue, hasValue)
+		require.Equal(t, tc.expected, value)
+	}
+
+	_, _, err := parseAggregationValue("abc")
+	require.Error(t, err)
+	_, _, err = parseAggregationValue(true)
+	require.Error(t, err)
+}
+
+func TestComputePercentile(t *testing.T) {
+	values := []float64{1, 2, 3, 4, 5}
+	require.Equal(t, 1.0, computePercentile(values, 0))
+	require.Equal(t, 3.0, computePercentile(values, 50))
+	require.Equal(t, 5.0, computePercentile(values, 100))
+	require.InDelta(t, 4.6, computePercentile(values, 90), 1e-9)
+	require.Equal(t, 7.0, computePercentile([]float64{7}, 99))
+}
+
+func TestGetAggregationGroupKey(t *testing.T) {
+	require.Equal(t,
+		getAggregationGroupKey([]any{"a", "b"}),
+		getAggregationGroupKey([]any{"a", "b"}),
+	)
+	require.NotEqual(t,
+		getAggregationGroupKey([]any{"a", "bc"}),
+		getAggregationGroupKey([]any{"ab", "c"}),
+	)
+}
@@ -3,12 +3,15 @@ package sql
 import (
 	"errors"
 	"fmt"
+	"slices"
 	"strconv"
 	"strings"
 	"time"
 
 	"github.com/temporalio/sqlparser"
 	enumspb "go.temporal.io/api/enums/v1"
+	"go.temporal.io/server/api/adminservice/v1"
+	enumsspb "go.temporal.io/server/api/enums/v1"
 	"go.temporal.io/server/common/namespace"
 	"go.temporal.io/server/common/persistence/sql/sqlplugin"
 	"go.temporal.io/server/common/persistence/visibility/store/query"
@@ -32,6 +35,18 @@ type (
 
 		buildCountStmt(namespaceID namespace.ID, queryString string, groupBy []string) (string, []any)
 
+		buildAggregationStmt(
+			namespaceID namespace.ID,
+			queryString string,
+			selectExprs []string,
+			groupBy []string,
+			orderBy string,
+			limit int,
+		) (string, []any)
+
+		// getEpochMillisExpr returns the expression converting a datetime column to Unix epoch milliseconds.
+		getEpochMillisExpr(colName string) string
+
 		getDatetimeFormat() string
 
 		getCoalesceCloseTimeExpr() sqlparser.Expr
@@ -172,6 +187,131 @@ func (c *QueryConverter) BuildCountStmt() (*sqlplugin.VisibilitySelectFilter, er
 	}, nil
 }
 
+// ConvertAggregations resolves and validates the group by search attributes and the aggregations.
+func (c *QueryConverter) ConvertAggregations(
+	groupBy []string,
+	aggregations []*adminservice.VisibilityAggregation,
+) ([]query.AggregationField, []query.Aggregation, error) {
+	return query.ConvertAggregations(groupBy, aggregations, func(name string) (query.AggregationField, error) {
+		var expr sqlparser.Expr = &sqlparser.ColName{Name: sqlparser.NewColIdent(name)}
+		colName, err := c.convertColName(&expr)
+		if err != nil {
+			return query.AggregationField{}, err
+		}
+		return query.AggregationField{FieldName: colName.fieldName, Type: colName.valueType}, nil
+	})
+}
+
+// BuildAggregationStmt builds the statement selecting the group by columns, the count and the
+// values of the aggregations, except for the PERCENTILES ones, of the groups with the highest count.
+func (c *QueryConverter) BuildAggregationStmt(
+	groupBy []query.AggregationField,
+	aggregations []query.Aggregation,
+	maxGroups int,
+) (*sqlplugin.VisibilitySelectFilter, error) {
+	queryString, err := c.buildAggregationWhereString(groupBy)
+	if err != nil {
+		return nil, err
+	}
+	groupByFieldNames, groupByDbNames := c.getAggregationGroupBy(groupBy)
+	selectExprs := append(slices.Clone(groupByDbNames), "COUNT(*)")
+	for _, aggregation := range aggregations {
+		valueExpr := c.getAggregationValueExpr(aggregation.Field)
+		switch aggregation.Function {
+		case enumsspb.VISIBILITY_AGGREGATION_FUNCTION_MIN:
+			selectExprs = append(selectExprs, fmt.Sprintf("MIN(%s)", valueExpr))
+		case enumsspb.VISIBILITY_AGGREGATION_FUNCTION_MAX:
+			selectExprs = append(selectExprs, fmt.Sprintf("MAX(%s)", valueExpr))
+		case enumsspb.VISIBILITY_AGGREGATION_FUNCTION_AVG:
+			selectExprs = append(selectExprs, fmt.Sprintf("AVG(%s)", valueExpr))
+		case enumsspb.VISIBILITY_AGGREGATION_FUNCTION_SUM:
+			selectExprs = append(selectExprs, fmt.Sprintf("SUM(%s)", valueExpr))
+		}
+	}
+	queryString, queryArgs := c.buildAggregationStmt(
+		c.namespaceID,
+		queryString,
+		selectExprs,
+		groupByDbNames,
+		"COUNT(*) DESC",
+		maxGroups,
+	)
+	return &sqlplugin.VisibilitySelectFilter{
+		Query:     queryString,
+		QueryArgs: queryArgs,
+		GroupBy:   groupByFieldNames,
+	}, nil
+}
+
+// BuildAggregationSamplesStmt builds the statement selecting the group by columns and the value of
+// the aggregated search attribute of up to limit workflow executions, to compute percentiles from.
+func (c *QueryConverter) BuildAggregationSamplesStmt(
+	groupBy []query.AggregationField,
+	field query.AggregationField,
+	limit int,
+) (*sqlplugin.VisibilitySelectFilter, error) {
+	queryString, err := c.buildAggregationWhereString(groupBy)
+	if err != nil {
+		return nil, err
+	}
+	groupByFieldNames, groupByDbNames := c.getAggregationGroupBy(groupBy)
+	valueExpr := c.getAggregationValueExpr(field)
+	if queryString != "" {
+		queryString += " AND "
+	}
+	queryString += fmt.Sprintf("%s IS NOT NULL", valueExpr)
+	queryString, queryArgs := c.buildAggregationStmt(
+		c.namespaceID,
+		queryString,
+		append(slices.Clone(groupByDbNames), valueExpr),
+		nil,
+		"",
+		limit,
+	)
+	return &sqlplugin.VisibilitySelectFilter{
+		Query:     queryString,
+		QueryArgs: queryArgs,
+		GroupBy:   groupByFieldNames,
+	}, nil
+}
+
+func (c *QueryConverter) buildAggregationWhereString(groupBy []query.AggregationField) (string, error) {
+	qp, err := c.convertWhereString(c.queryString)
+	if err != nil {
+		return "", err
+	}
+	if len(qp.groupBy) > 0 {
+		return "", query.NewConverterError("%s: 'group by' clause", query.NotSupportedErrMessage)
+	}
+	// Workflow executions without a value for a group by search attribute are not part of any group.
+	var whereClauses []string
+	if qp.queryString != "" {
+		whereClauses = append(whereClauses, qp.queryString)
+	}
+	for _, field := range groupBy {
+		whereClauses = append(whereClauses, fmt.Sprintf("%s IS NOT NULL", searchattribute.GetSqlDbColName(field.FieldName)))
+	}
+	return strings.Join(whereClauses, " AND "), nil
+}
+
+func (c *QueryConverter) getAggregationGroupBy(groupBy []query.AggregationField) ([]string, []string) {
+	fieldNames := make([]string, len(groupBy))
+	dbNames := make([]string, len(groupBy))
+	for i, field := range groupBy {
+		fieldNames[i] = field.FieldName
+		dbNames[i] = searchattribute.GetSqlDbColName(field.FieldName)
+	}
+	return fieldNames, dbNames
+}
+
+func (c *QueryConverter) getAggregationValueExpr(field query.AggregationField) string {
+	dbName := searchattribute.GetSqlDbColName(field.FieldName)
+	if field.Type == enumspb.INDEXED_VALUE_TYPE_DATETIME {
+		return c.getEpochMillisExpr(dbName)
+	}
+	return dbName
+}
+
 func (c *QueryConverter) convertWhereString(queryString string) (*queryParams, error) {
 	where := strings.TrimSpace(queryString)
 	if where != "" &&
@@ -2,6 +2,7 @@ package sql
 
 import (
 	"go.temporal.io/server/common/namespace"
+	"go.temporal.io/server/common/persistence/sql/sqlplugin/cockroachdb"
 	"go.temporal.io/server/common/persistence/sql/sqlplugin/mysql"
 	"go.temporal.io/server/common/persistence/sql/sqlplugin/postgresql"
 	"go.temporal.io/server/common/persistence/sql/sqlplugin/sqlite"
@@ -19,7 +20,9 @@ func NewQueryConverter(
 	switch pluginName {
 	case mysql.PluginName:
 		return newMySQLQueryConverter(namespaceName, namespaceID, saTypeMap, saMapper, queryString)
-	case postgresql.PluginName, postgresql.PluginNamePGX:
+	case postgresql.PluginName, postgresql.PluginNamePGX, cockroachdb.PluginName:
+		// CockroachDB supports the same JSONB containment and full text search
+		// operators as PostgreSQL.
 		return newPostgreSQLQueryConverter(namespaceName, namespaceID, saTypeMap, saMapper, queryString)
 	case sqlite.PluginName:
 		return newSqliteQueryConverter(namespaceName, namespaceID, saTypeMap, saMapper, queryString)
@@ -285,3 +285,57 @@ func (c *mysqlQueryConverter) buildCountStmt(
 		groupByClause,
 	), queryArgs
 }
+
+func (c *mysqlQueryConverter) buildAggregationStmt(
+	namespaceID namespace.ID,
+	queryString string,
+	selectExprs []string,
+	groupBy []string,
+	orderBy string,
+	limit int,
+) (string, []any) {
+	var whereClauses []string
+	var queryArgs []any
+
+	whereClauses = append(
+		whereClauses,
+		fmt.Sprintf("(%s = ?)", searchattribute.GetSqlDbColName(searchattribute.NamespaceID)),
+	)
+	queryArgs = append(queryArgs, namespaceID.String())
+
+	if len(queryString) > 0 {
+		whereClauses = append(whereClauses, queryString)
+	}
+
+	groupByClause := ""
+	if len(groupBy) > 0 {
+		groupByClause = fmt.Sprintf("GROUP BY %s", strings.Join(groupBy, ", "))
+	}
+
+	orderByClause := ""
+	if len(orderBy) > 0 {
+		orderByClause = fmt.Sprintf("ORDER BY %s", orderBy)
+	}
+	queryArgs = append(queryArgs, limit)
+
+	return fmt.Sprintf(
+		`SELECT %s
+		FROM executions_visibility ev
+		LEFT JOIN custom_search_attributes
+		USING (%s, %s)
+		WHERE %s
+		%s
+		%s
+		LIMIT ?`,
+		strings.Join(selectExprs, ", "),
+		searchattribute.GetSqlDbColName(searchattribute.NamespaceID),
+		searchattribute.GetSqlDbColName(searchattribute.RunID),
+		strings.Join(whereClauses, " AND "),
+		groupByClause,
+		orderByClause,
+	), queryArgs
+}
+
+func (c *mysqlQueryConverter) getEpochMillisExpr(colName string) string {
+	return fmt.Sprintf("TIMESTAMPDIFF(MICROSECOND, '1970-01-01 00:00:00', %s) / 1000", colName)
+}
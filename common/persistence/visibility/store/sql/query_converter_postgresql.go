@@ -281,3 +281,48 @@ func (c *pgQueryConverter) buildCountStmt(
 		groupByClause,
 	), queryArgs
 }
+
+func (c *pgQueryConverter) buildAggregationStmt(
+	namespaceID namespace.ID,
+	queryString string,
+	selectExprs []string,
+	groupBy []string,
+	orderBy string,
+	limit int,
+) (string, []any) {
+	var whereClauses []string
+	var queryArgs []any
+
+	whereClauses = append(
+		whereClauses,
+		fmt.Sprintf("(%s = ?)", searchattribute.GetSqlDbColName(searchattribute.NamespaceID)),
+	)
+	queryArgs = append(queryArgs, namespaceID.String())
+
+	if len(queryString) > 0 {
+		whereClauses = append(whereClauses, queryString)
+	}
+
+	groupByClause := ""
+	if len(groupBy) > 0 {
+		groupByClause = fmt.Sprintf("GROUP BY %s", strings.Join(groupBy, ", "))
+	}
+
+	orderByClause := ""
+	if len(orderBy) > 0 {
+		orderByClause = fmt.Sprintf("ORDER BY %s", orderBy)
+	}
+	queryArgs = append(queryArgs, limit)
+
+	return fmt.Sprintf(
+		"SELECT %s FROM executions_visibility WHERE %s %s %s LIMIT ?",
+		strings.Join(selectExprs, ", "),
+		strings.Join(whereClauses, " AND "),
+		groupByClause,
+		orderByClause,
+	), queryArgs
+}
+
+func (c *pgQueryConverter) getEpochMillisExpr(colName string) string {
+	return fmt.Sprintf("EXTRACT(EPOCH FROM %s) * 1000", colName)
+}
@@ -6,6 +6,8 @@ import (
 
 	"github.com/stretchr/testify/suite"
 	"github.com/temporalio/sqlparser"
+	"go.temporal.io/server/api/adminservice/v1"
+	enumsspb "go.temporal.io/server/api/enums/v1"
 	"go.temporal.io/server/common/persistence/visibility/store/query"
 )
 
@@ -133,3 +135,35 @@ func (s *postgresqlQueryConverterSuite) TestConvertTextComparisonExpr() {
 		})
 	}
 }
+
+func (s *postgresqlQueryConverterSuite) TestBuildAggregationStmt() {
+	groupBy, aggregations, err := s.queryConverter.ConvertAggregations(
+		[]string{"WorkflowType"},
+		[]*adminservice.VisibilityAggregation{
+			{Function: enumsspb.VISIBILITY_AGGREGATION_FUNCTION_AVG, Field: "ExecutionDuration"},
+			{Function: enumsspb.VISIBILITY_AGGREGATION_FUNCTION_MAX, Field: "CloseTime"},
+		},
+	)
+	s.NoError(err)
+	filter, err := s.queryConverter.BuildAggregationStmt(groupBy, aggregations, 10)
+	s.NoError(err)
+	s.Equal(
+		"SELECT workflow_type_name, COUNT(*), AVG(execution_duration), MAX(EXTRACT(EPOCH FROM close_time) * 1000) "+
+			"FROM executions_visibility "+
+			"WHERE (namespace_id = ?) AND TemporalNamespaceDivision is null AND workflow_type_name IS NOT NULL "+
+			"GROUP BY workflow_type_name ORDER BY COUNT(*) DESC LIMIT ?",
+		filter.Query,
+	)
+	s.Equal([]any{testNamespaceID.String(), 10}, filter.QueryArgs)
+
+	_, _, err = s.queryConverter.ConvertAggregations([]string{"StartTime"}, nil)
+	s.Error(err)
+	_, _, err = s.queryConverter.ConvertAggregations(nil, []*adminservice.VisibilityAggregation{
+		{Function: enumsspb.VISIBILITY_AGGREGATION_FUNCTION_SUM, Field: "CloseTime"},
+	})
+	s.Error(err)
+	_, _, err = s.queryConverter.ConvertAggregations(nil, []*adminservice.VisibilityAggregation{
+		{Function: enumsspb.VISIBILITY_AGGREGATION_FUNCTION_PERCENTILES, Field: "ExecutionDuration", Percentiles: []float64{101}},
+	})
+	s.Error(err)
+}
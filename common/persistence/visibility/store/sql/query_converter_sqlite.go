@@ -322,6 +322,51 @@ func (c *sqliteQueryConverter) buildCountStmt(
 	), queryArgs
 }
 
+func (c *sqliteQueryConverter) buildAggregationStmt(
+	namespaceID namespace.ID,
+	queryString string,
+	selectExprs []string,
+	groupBy []string,
+	orderBy string,
+	limit int,
+) (string, []any) {
+	var whereClauses []string
+	var queryArgs []any
+
+	whereClauses = append(
+		whereClauses,
+		fmt.Sprintf("(%s = ?)", searchattribute.GetSqlDbColName(searchattribute.NamespaceID)),
+	)
+	queryArgs = append(queryArgs, namespaceID.String())
+
+	if len(queryString) > 0 {
+		whereClauses = append(whereClauses, queryString)
+	}
+
+	groupByClause := ""
+	if len(groupBy) > 0 {
+		groupByClause = fmt.Sprintf("GROUP BY %s", strings.Join(groupBy, ", "))
+	}
+
+	orderByClause := ""
+	if len(orderBy) > 0 {
+		orderByClause = fmt.Sprintf("ORDER BY %s", orderBy)
+	}
+	queryArgs = append(queryArgs, limit)
+
+	return fmt.Sprintf(
+		"SELECT %s FROM executions_visibility WHERE %s %s %s LIMIT ?",
+		strings.Join(selectExprs, ", "),
+		strings.Join(whereClauses, " AND "),
+		groupByClause,
+		orderByClause,
+	), queryArgs
+}
+
+func (c *sqliteQueryConverter) getEpochMillisExpr(colName string) string {
+	return fmt.Sprintf("(JULIANDAY(%s) - 2440587.5) * 86400000.0", colName)
+}
+
 func buildFtsQueryString(colname string, values ...string) string {
 	// FTS query format: 'colname : ("token1" OR "token2" OR ...)'
 	return fmt.Sprintf(`%s : ("%s")`, colname, strings.Join(values, `" OR "`))
@@ -4,12 +4,15 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"slices"
 	"time"
 
 	commonpb "go.temporal.io/api/common/v1"
 	enumspb "go.temporal.io/api/enums/v1"
 	"go.temporal.io/api/serviceerror"
 	"go.temporal.io/api/workflowservice/v1"
+	"go.temporal.io/server/api/adminservice/v1"
+	enumsspb "go.temporal.io/server/api/enums/v1"
 	"go.temporal.io/server/common/config"
 	"go.temporal.io/server/common/log"
 	"go.temporal.io/server/common/metrics"
@@ -189,7 +192,7 @@ func (s *VisibilityStore) ListWorkflowExecutions(
 		return nil, err
 	}
 
-	rows, err := s.sqlStore.Db.SelectFromVisibility(ctx, *selectFilter)
+	rows, err := s.sqlStore.Db.SelectFromVisibility(persistence.WithStaleReads(ctx), *selectFilter)
 	if err != nil {
 		return nil, serviceerror.NewUnavailable(
 			fmt.Sprintf("ListWorkflowExecutions operation failed. Select failed: %v", err))
@@ -271,7 +274,7 @@ func (s *VisibilityStore) CountWorkflowExecutions(
 		return s.countGroupByWorkflowExecutions(ctx, selectFilter, saTypeMap)
 	}
 
-	count, err := s.sqlStore.Db.CountFromVisibility(ctx, *selectFilter)
+	count, err := s.sqlStore.Db.CountFromVisibility(persistence.WithStaleReads(ctx), *selectFilter)
 	if err != nil {
 		return nil, serviceerror.NewUnavailable(
 			fmt.Sprintf("CountWorkflowExecutions operation failed. Query failed: %v", err))
@@ -294,7 +297,7 @@ func (s *VisibilityStore) countGroupByWorkflowExecutions(
 		}
 	}
 
-	rows, err := s.sqlStore.Db.CountGroupByFromVisibility(ctx, *selectFilter)
+	rows, err := s.sqlStore.Db.CountGroupByFromVisibility(persistence.WithStaleReads(ctx), *selectFilter)
 	if err != nil {
 		return nil, serviceerror.NewUnavailable(
 			fmt.Sprintf("CountWorkflowExecutions operation failed. Query failed: %v", err))
@@ -323,6 +326,157 @@ func (s *VisibilityStore) countGroupByWorkflowExecutions(
 	return resp, nil
 }
 
+func (s *VisibilityStore) AggregateWorkflowExecutions(
+	ctx context.Context,
+	request *manager.AggregateWorkflowExecutionsRequest,
+) (*manager.AggregateWorkflowExecutionsResponse, error) {
+	saTypeMap, err := s.searchAttributesProvider.GetSearchAttributes(s.GetIndexName(), false)
+	if err != nil {
+		return nil, err
+	}
+
+	saMapper, err := s.searchAttributesMapperProvider.GetMapper(request.Namespace)
+	if err != nil {
+		return nil, err
+	}
+
+	converter := NewQueryConverter(
+		s.GetName(),
+		request.Namespace,
+		request.NamespaceID,
+		saTypeMap,
+		saMapper,
+		request.Query,
+	)
+	groupBy, aggregations, err := converter.ConvertAggregations(request.GroupBy, request.Aggregations)
+	if err != nil {
+		return nil, convertConverterError(err)
+	}
+	selectFilter, err := converter.BuildAggregationStmt(groupBy, aggregations, request.MaxGroups)
+	if err != nil {
+		return nil, convertConverterError(err)
+	}
+	return s.aggregateWorkflowExecutions(ctx, converter, selectFilter, groupBy, aggregations)
+}
+
+func (s *VisibilityStore) aggregateWorkflowExecutions(
+	ctx context.Context,
+	converter *QueryConverter,
+	selectFilter *sqlplugin.VisibilitySelectFilter,
+	groupBy []query.AggregationField,
+	aggregations []query.Aggregation,
+) (*manager.AggregateWorkflowExecutionsResponse, error) {
+	rows, err := s.sqlStore.Db.AggregateFromVisibility(persistence.WithStaleReads(ctx), *selectFilter)
+	if err != nil {
+		return nil, serviceerror.NewUnavailable(
+			fmt.Sprintf("AggregateWorkflowExecutions operation failed. Query failed: %v", err))
+	}
+
+	resp := &manager.AggregateWorkflowExecutionsResponse{
+		Groups: make([]*adminservice.VisibilityAggregationGroup, 0, len(rows)),
+	}
+	groupsByKey := make(map[string]*adminservice.VisibilityAggregationGroup, len(rows))
+	for _, row := range rows {
+		group := &adminservice.VisibilityAggregationGroup{
+			GroupValues: make([]*commonpb.Payload, len(row.GroupValues)),
+			Results:     make([]*adminservice.VisibilityAggregationResult, len(aggregations)),
+		}
+		for i, val := range row.GroupValues {
+			group.GroupValues[i], err = searchattribute.EncodeValue(val, groupBy[i].Type)
+			if err != nil {
+				return nil, err
+			}
+		}
+		count, _, err := parseAggregationValue(row.Values[0])
+		if err != nil {
+			return nil, err
+		}
+		group.Count = int64(count)
+
+		// The first value is the count, and PERCENTILES aggregations have no value in the row.
+		valueIdx := 1
+		for i, aggregation := range aggregations {
+			result := &adminservice.VisibilityAggregationResult{}
+			group.Results[i] = result
+			if aggregation.Function == enumsspb.VISIBILITY_AGGREGATION_FUNCTION_PERCENTILES {
+				continue
+			}
+			result.Value, result.HasValue, err = parseAggregationValue(row.Values[valueIdx])
+			if err != nil {
+				return nil, err
+			}
+			// Be consistent with Elasticsearch which sums no value to zero.
+			if aggregation.Function == enumsspb.VISIBILITY_AGGREGATION_FUNCTION_SUM {
+				result.HasValue = true
+			}
+			valueIdx++
+		}
+		resp.Groups = append(resp.Groups, group)
+		groupsByKey[getAggregationGroupKey(row.GroupValues)] = group
+	}
+
+	for i, aggregation := range aggregations {
+		if aggregation.Function != enumsspb.VISIBILITY_AGGREGATION_FUNCTION_PERCENTILES {
+			continue
+		}
+		if err := s.aggregatePercentiles(ctx, converter, groupBy, aggregation, i, groupsByKey); err != nil {
+			return nil, err
+		}
+	}
+	return resp, nil
+}
+
+// aggregatePercentiles computes the percentiles of an aggregation from the values of the matching
+// workflow executions, since not all SQL databases support percentile functions.
+func (s *VisibilityStore) aggregatePercentiles(
+	ctx context.Context,
+	converter *QueryConverter,
+	groupBy []query.AggregationField,
+	aggregation query.Aggregation,
+	aggregationIdx int,
+	groupsByKey map[string]*adminservice.VisibilityAggregationGroup,
+) error {
+	selectFilter, err := converter.BuildAggregationSamplesStmt(groupBy, aggregation.Field, maxPercentileSamples+1)
+	if err != nil {
+		return convertConverterError(err)
+	}
+	rows, err := s.sqlStore.Db.AggregateFromVisibility(persistence.WithStaleReads(ctx), *selectFilter)
+	if err != nil {
+		return serviceerror.NewUnavailable(
+			fmt.Sprintf("AggregateWorkflowExecutions operation failed. Query failed: %v", err))
+	}
+	if len(rows) > maxPercentileSamples {
+		return serviceerror.NewInvalidArgumentf(
+			"unable to compute percentiles of more than %d values, use a more selective query",
+			maxPercentileSamples,
+		)
+	}
+
+	valuesByKey := make(map[string][]float64)
+	for _, row := range rows {
+		value, _, err := parseAggregationValue(row.Values[0])
+		if err != nil {
+			return err
+		}
+		key := getAggregationGroupKey(row.GroupValues)
+		valuesByKey[key] = append(valuesByKey[key], value)
+	}
+	for key, group := range groupsByKey {
+		values := valuesByKey[key]
+		if len(values) == 0 {
+			continue
+		}
+		slices.Sort(values)
+		result := group.Results[aggregationIdx]
+		result.HasValue = true
+		result.PercentileValues = make([]float64, len(aggregation.Percentiles))
+		for i, percentile := range aggregation.Percentiles {
+			result.PercentileValues[i] = computePercentile(values, percentile)
+		}
+	}
+	return nil
+}
+
 func (s *VisibilityStore) GetWorkflowExecution(
 	ctx context.Context,
 	request *manager.GetWorkflowExecutionRequest,
@@ -185,6 +185,19 @@ func (v *VisibilityManagerDual) CountWorkflowExecutions(
 	)
 }
 
+func (v *VisibilityManagerDual) AggregateWorkflowExecutions(
+	ctx context.Context,
+	request *manager.AggregateWorkflowExecutionsRequest,
+) (*manager.AggregateWorkflowExecutionsResponse, error) {
+	return dualReadWrapper(
+		ctx,
+		v,
+		request,
+		request.Namespace,
+		manager.VisibilityManager.AggregateWorkflowExecutions,
+	)
+}
+
 func (v *VisibilityManagerDual) GetWorkflowExecution(
 	ctx context.Context,
 	request *manager.GetWorkflowExecutionRequest,
@@ -164,6 +164,13 @@ func (p *visibilityManagerImpl) CountWorkflowExecutions(
 	return response, err
 }
 
+func (p *visibilityManagerImpl) AggregateWorkflowExecutions(
+	ctx context.Context,
+	request *manager.AggregateWorkflowExecutionsRequest,
+) (*manager.AggregateWorkflowExecutionsResponse, error) {
+	return p.store.AggregateWorkflowExecutions(ctx, request)
+}
+
 func (p *visibilityManagerImpl) GetWorkflowExecution(
 	ctx context.Context,
 	request *manager.GetWorkflowExecutionRequest,
@@ -157,6 +157,16 @@ func (m *visibilityManagerMetrics) CountWorkflowExecutions(
 	return response, m.updateErrorMetric(handler, err)
 }
 
+func (m *visibilityManagerMetrics) AggregateWorkflowExecutions(
+	ctx context.Context,
+	request *manager.AggregateWorkflowExecutionsRequest,
+) (*manager.AggregateWorkflowExecutionsResponse, error) {
+	handler, startTime := m.tagScope(metrics.VisibilityPersistenceAggregateWorkflowExecutionsScope)
+	response, err := m.delegate.AggregateWorkflowExecutions(ctx, request)
+	metrics.VisibilityPersistenceLatency.With(handler).Record(time.Since(startTime))
+	return response, m.updateErrorMetric(handler, err)
+}
+
 func (m *visibilityManagerMetrics) GetWorkflowExecution(
 	ctx context.Context,
 	request *manager.GetWorkflowExecutionRequest,
@@ -0,0 +1,140 @@
+package persistence
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	commonpb "go.temporal.io/api/common/v1"
+	enumspb "go.temporal.io/api/enums/v1"
+	"go.temporal.io/api/serviceerror"
+	persistencespb "go.temporal.io/server/api/persistence/v1"
+	"go.temporal.io/server/common/persistence/serialization"
+)
+
+const (
+	ErrMsgDeserializeWorkerHeartbeatBatch = "failed to deserialize worker heartbeat batch"
+)
+
+var (
+	ErrWorkerHeartbeatBatchIsNil        = errors.New("worker heartbeat batch is nil")
+	ErrReadWorkerHeartbeatsNonPositive  = errors.New("page size to read worker heartbeats must be positive")
+	ErrWorkerHeartbeatNamespaceIDNotSet = errors.New("namespace ID of worker heartbeats is not set")
+)
+
+type (
+	workerHeartbeatManagerImpl struct {
+		queue QueueV2
+	}
+)
+
+func NewWorkerHeartbeatManager(queue QueueV2) WorkerHeartbeatManager {
+	return &workerHeartbeatManagerImpl{
+		queue: queue,
+	}
+}
+
+func (m *workerHeartbeatManagerImpl) AppendWorkerHeartbeats(
+	ctx context.Context,
+	request *AppendWorkerHeartbeatsRequest,
+) (*AppendWorkerHeartbeatsResponse, error) {
+	if request.NamespaceID == "" {
+		return nil, ErrWorkerHeartbeatNamespaceIDNotSet
+	}
+	if request.Batch == nil {
+		return nil, ErrWorkerHeartbeatBatchIsNil
+	}
+	data, err := request.Batch.Marshal()
+	if err != nil {
+		return nil, err
+	}
+	enqueueRequest := &InternalEnqueueMessageRequest{
+		QueueType: QueueTypeWorkerHeartbeat,
+		QueueName: request.NamespaceID,
+		Blob: &commonpb.DataBlob{
+			EncodingType: enumspb.ENCODING_TYPE_PROTO3,
+			Data:         data,
+		},
+	}
+
+	resp, err := m.queue.EnqueueMessage(ctx, enqueueRequest)
+	var notFound *serviceerror.NotFound
+	if errors.As(err, &notFound) {
+		// The queue of a namespace is created lazily by the first append.
+		if _, err := m.queue.CreateQueue(ctx, &InternalCreateQueueRequest{
+			QueueType: QueueTypeWorkerHeartbeat,
+			QueueName: request.NamespaceID,
+		}); err != nil && !errors.Is(err, ErrQueueAlreadyExists) {
+			return nil, err
+		}
+		resp, err = m.queue.EnqueueMessage(ctx, enqueueRequest)
+	}
+	if err != nil {
+		return nil, err
+	}
+	return &AppendWorkerHeartbeatsResponse{Metadata: resp.Metadata}, nil
+}
+
+func (m *workerHeartbeatManagerImpl) ReadWorkerHeartbeats(
+	ctx context.Context,
+	request *ReadWorkerHeartbeatsRequest,
+) (*ReadWorkerHeartbeatsResponse, error) {
+	if request.NamespaceID == "" {
+		return nil, ErrWorkerHeartbeatNamespaceIDNotSet
+	}
+	if request.PageSize <= 0 {
+		return nil, fmt.Errorf("%w: %v", ErrReadWorkerHeartbeatsNonPositive, request.PageSize)
+	}
+	var nextPageToken []byte
+	if request.ExclusiveMinMessageMetadata != nil {
+		nextPageToken = GetNextPageTokenForReadMessages([]QueueV2Message{{MetaData: *request.ExclusiveMinMessageMetadata}})
+	}
+
+	resp, err := m.queue.ReadMessages(ctx, &InternalReadMessagesRequest{
+		QueueType:     QueueTypeWorkerHeartbeat,
+		QueueName:     request.NamespaceID,
+		PageSize:      request.PageSize,
+		NextPageToken: nextPageToken,
+	})
+	var notFound *serviceerror.NotFound
+	if errors.As(err, &notFound) {
+		return &ReadWorkerHeartbeatsResponse{}, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	batches := make([]WorkerHeartbeatBatch, len(resp.Messages))
+	for i, message := range resp.Messages {
+		batch := &persistencespb.WorkerHeartbeatBatch{}
+		if err := serialization.Decode(message.Data, batch); err != nil {
+			return nil, fmt.Errorf("%v: %w", ErrMsgDeserializeWorkerHeartbeatBatch, err)
+		}
+		batches[i] = WorkerHeartbeatBatch{
+			MessageMetadata: message.MetaData,
+			Batch:           batch,
+		}
+	}
+	return &ReadWorkerHeartbeatsResponse{Batches: batches}, nil
+}
+
+func (m *workerHeartbeatManagerImpl) TrimWorkerHeartbeats(
+	ctx context.Context,
+	request *TrimWorkerHeartbeatsRequest,
+) (*TrimWorkerHeartbeatsResponse, error) {
+	if request.NamespaceID == "" {
+		return nil, ErrWorkerHeartbeatNamespaceIDNotSet
+	}
+	resp, err := m.queue.RangeDeleteMessages(ctx, &InternalRangeDeleteMessagesRequest{
+		QueueType:                   QueueTypeWorkerHeartbeat,
+		QueueName:                   request.NamespaceID,
+		InclusiveMaxMessageMetadata: request.InclusiveMaxMessageMetadata,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &TrimWorkerHeartbeatsResponse{MessagesDeleted: resp.MessagesDeleted}, nil
+}
+
+func (m *workerHeartbeatManagerImpl) Close() {
+}
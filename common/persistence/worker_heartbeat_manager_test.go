@@ -0,0 +1,93 @@
+package persistence_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"go.temporal.io/api/serviceerror"
+	workerpb "go.temporal.io/api/worker/v1"
+	persistencespb "go.temporal.io/server/api/persistence/v1"
+	"go.temporal.io/server/common/persistence"
+	"go.temporal.io/server/common/persistence/mock"
+	"go.uber.org/mock/gomock"
+)
+
+func TestWorkerHeartbeatManager_AppendCreatesQueue(t *testing.T) {
+	queue := mock.NewMockQueueV2(gomock.NewController(t))
+	manager := persistence.NewWorkerHeartbeatManager(queue)
+
+	gomock.InOrder(
+		queue.EXPECT().EnqueueMessage(gomock.Any(), gomock.Any()).
+			Return(nil, persistence.NewQueueNotFoundError(persistence.QueueTypeWorkerHeartbeat, "ns1")),
+		queue.EXPECT().CreateQueue(gomock.Any(), &persistence.InternalCreateQueueRequest{
+			QueueType: persistence.QueueTypeWorkerHeartbeat,
+			QueueName: "ns1",
+		}).Return(nil, persistence.ErrQueueAlreadyExists),
+		queue.EXPECT().EnqueueMessage(gomock.Any(), gomock.Any()).
+			Return(&persistence.InternalEnqueueMessageResponse{Metadata: persistence.MessageMetadata{ID: 3}}, nil),
+	)
+
+	resp, err := manager.AppendWorkerHeartbeats(context.Background(), &persistence.AppendWorkerHeartbeatsRequest{
+		NamespaceID: "ns1",
+		Batch:       &persistencespb.WorkerHeartbeatBatch{HostIdentity: "host1"},
+	})
+	require.NoError(t, err)
+	require.Equal(t, int64(3), resp.Metadata.ID)
+}
+
+func TestWorkerHeartbeatManager_ReadRoundTrip(t *testing.T) {
+	queue := mock.NewMockQueueV2(gomock.NewController(t))
+	manager := persistence.NewWorkerHeartbeatManager(queue)
+
+	var enqueued *persistence.InternalEnqueueMessageRequest
+	queue.EXPECT().EnqueueMessage(gomock.Any(), gomock.Any()).DoAndReturn(
+		func(_ context.Context, request *persistence.InternalEnqueueMessageRequest) (*persistence.InternalEnqueueMessageResponse, error) {
+			enqueued = request
+			return &persistence.InternalEnqueueMessageResponse{Metadata: persistence.MessageMetadata{ID: 7}}, nil
+		})
+	_, err := manager.AppendWorkerHeartbeats(context.Background(), &persistence.AppendWorkerHeartbeatsRequest{
+		NamespaceID: "ns1",
+		Batch: &persistencespb.WorkerHeartbeatBatch{
+			HostIdentity: "host1",
+			Heartbeats:   []*workerpb.WorkerHeartbeat{{WorkerInstanceKey: "worker1"}},
+		},
+	})
+	require.NoError(t, err)
+
+	queue.EXPECT().ReadMessages(gomock.Any(), gomock.Any()).DoAndReturn(
+		func(_ context.Context, request *persistence.InternalReadMessagesRequest) (*persistence.InternalReadMessagesResponse, error) {
+			require.Equal(t, persistence.QueueTypeWorkerHeartbeat, request.QueueType)
+			minMessageID, err := persistence.GetMinMessageIDToReadForQueueV2(request.QueueType, request.QueueName, request.NextPageToken, nil)
+			require.NoError(t, err)
+			require.Equal(t, int64(7), minMessageID)
+			return &persistence.InternalReadMessagesResponse{
+				Messages: []persistence.QueueV2Message{{
+					MetaData: persistence.MessageMetadata{ID: 7},
+					Data:     enqueued.Blob,
+				}},
+			}, nil
+		})
+	resp, err := manager.ReadWorkerHeartbeats(context.Background(), &persistence.ReadWorkerHeartbeatsRequest{
+		NamespaceID:                 "ns1",
+		ExclusiveMinMessageMetadata: &persistence.MessageMetadata{ID: 6},
+		PageSize:                    10,
+	})
+	require.NoError(t, err)
+	require.Len(t, resp.Batches, 1)
+	require.Equal(t, "host1", resp.Batches[0].Batch.GetHostIdentity())
+	require.Equal(t, "worker1", resp.Batches[0].Batch.GetHeartbeats()[0].GetWorkerInstanceKey())
+}
+
+func TestWorkerHeartbeatManager_ReadMissingQueue(t *testing.T) {
+	queue := mock.NewMockQueueV2(gomock.NewController(t))
+	manager := persistence.NewWorkerHeartbeatManager(queue)
+
+	queue.EXPECT().ReadMessages(gomock.Any(), gomock.Any()).Return(nil, serviceerror.NewNotFound("queue not found"))
+	resp, err := manager.ReadWorkerHeartbeats(context.Background(), &persistence.ReadWorkerHeartbeatsRequest{
+		NamespaceID: "ns1",
+		PageSize:    10,
+	})
+	require.NoError(t, err)
+	require.Empty(t, resp.Batches)
+}
@@ -278,6 +278,7 @@ func MatchingClientProvider(matchingRawClient MatchingRawClient) MatchingClient
 
 func PersistenceConfigProvider(persistenceConfig config.Persistence, dc *dynamicconfig.Collection) *config.Persistence {
 	persistenceConfig.TransactionSizeLimit = dynamicconfig.TransactionSizeLimit.Get(dc)
+	persistenceConfig.HistoryBlobOffloadThreshold = dynamicconfig.HistoryBlobOffloadThreshold.Get(dc)
 	return &persistenceConfig
 }
 
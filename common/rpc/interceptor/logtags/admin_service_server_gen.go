@@ -21,10 +21,21 @@ func (wt *WorkflowTags) extractFromAdminServiceServerMessage(message any) []tag.
 		return nil
 	case *adminservice.AddTasksResponse:
 		return nil
+	case *adminservice.AggregateWorkflowExecutionsRequest:
+		return nil
+	case *adminservice.AggregateWorkflowExecutionsResponse:
+		return nil
 	case *adminservice.CancelDLQJobRequest:
 		return nil
 	case *adminservice.CancelDLQJobResponse:
 		return nil
+	case *adminservice.CheckMutableStateRequest:
+		return []tag.Tag{
+			tag.WorkflowID(r.GetExecution().GetWorkflowId()),
+			tag.WorkflowRunID(r.GetExecution().GetRunId()),
+		}
+	case *adminservice.CheckMutableStateResponse:
+		return nil
 	case *adminservice.CloseShardRequest:
 		return nil
 	case *adminservice.CloseShardResponse:
@@ -33,6 +44,14 @@ func (wt *WorkflowTags) extractFromAdminServiceServerMessage(message any) []tag.
 		return nil
 	case *adminservice.DeepHealthCheckResponse:
 		return nil
+	case *adminservice.DeleteSavedVisibilityQueryRequest:
+		return nil
+	case *adminservice.DeleteSavedVisibilityQueryResponse:
+		return nil
+	case *adminservice.DeleteTaskQueueBacklogTasksRequest:
+		return nil
+	case *adminservice.DeleteTaskQueueBacklogTasksResponse:
+		return nil
 	case *adminservice.DeleteWorkflowExecutionRequest:
 		return []tag.Tag{
 			tag.WorkflowID(r.GetExecution().GetWorkflowId()),
@@ -70,6 +89,15 @@ func (wt *WorkflowTags) extractFromAdminServiceServerMessage(message any) []tag.
 		return nil
 	case *adminservice.ForceUnloadTaskQueuePartitionResponse:
 		return nil
+	case *adminservice.ForkWorkflowExecutionRequest:
+		return []tag.Tag{
+			tag.WorkflowID(r.GetExecution().GetWorkflowId()),
+			tag.WorkflowRunID(r.GetExecution().GetRunId()),
+		}
+	case *adminservice.ForkWorkflowExecutionResponse:
+		return []tag.Tag{
+			tag.WorkflowRunID(r.GetRunId()),
+		}
 	case *adminservice.GenerateLastHistoryReplicationTasksRequest:
 		return []tag.Tag{
 			tag.WorkflowID(r.GetExecution().GetWorkflowId()),
@@ -127,6 +155,20 @@ func (wt *WorkflowTags) extractFromAdminServiceServerMessage(message any) []tag.
 		}
 	case *adminservice.GetWorkflowExecutionRawHistoryV2Response:
 		return nil
+	case *adminservice.GetWorkflowExecutionTimelineRequest:
+		return []tag.Tag{
+			tag.WorkflowID(r.GetExecution().GetWorkflowId()),
+			tag.WorkflowRunID(r.GetExecution().GetRunId()),
+		}
+	case *adminservice.GetWorkflowExecutionTimelineResponse:
+		return nil
+	case *adminservice.GetWorkflowHistoryBudgetRequest:
+		return []tag.Tag{
+			tag.WorkflowID(r.GetExecution().GetWorkflowId()),
+			tag.WorkflowRunID(r.GetExecution().GetRunId()),
+		}
+	case *adminservice.GetWorkflowHistoryBudgetResponse:
+		return nil
 	case *adminservice.ImportWorkflowExecutionRequest:
 		return []tag.Tag{
 			tag.WorkflowID(r.GetExecution().GetWorkflowId()),
@@ -150,6 +192,14 @@ func (wt *WorkflowTags) extractFromAdminServiceServerMessage(message any) []tag.
 		return nil
 	case *adminservice.ListQueuesResponse:
 		return nil
+	case *adminservice.ListSavedVisibilityQueriesRequest:
+		return nil
+	case *adminservice.ListSavedVisibilityQueriesResponse:
+		return nil
+	case *adminservice.ListTaskQueueBacklogTasksRequest:
+		return nil
+	case *adminservice.ListTaskQueueBacklogTasksResponse:
+		return nil
 	case *adminservice.MergeDLQMessagesRequest:
 		return nil
 	case *adminservice.MergeDLQMessagesResponse:
@@ -158,6 +208,18 @@ func (wt *WorkflowTags) extractFromAdminServiceServerMessage(message any) []tag.
 		return nil
 	case *adminservice.MergeDLQTasksResponse:
 		return nil
+	case *adminservice.MoveHistoryShardRequest:
+		return nil
+	case *adminservice.MoveHistoryShardResponse:
+		return nil
+	case *adminservice.MoveTaskQueueBacklogTasksRequest:
+		return nil
+	case *adminservice.MoveTaskQueueBacklogTasksResponse:
+		return nil
+	case *adminservice.PauseTaskQueueDispatchRequest:
+		return nil
+	case *adminservice.PauseTaskQueueDispatchResponse:
+		return nil
 	case *adminservice.PurgeDLQMessagesRequest:
 		return nil
 	case *adminservice.PurgeDLQMessagesResponse:
@@ -180,6 +242,13 @@ func (wt *WorkflowTags) extractFromAdminServiceServerMessage(message any) []tag.
 		}
 	case *adminservice.RebuildMutableStateResponse:
 		return nil
+	case *adminservice.RedactWorkflowExecutionRequest:
+		return []tag.Tag{
+			tag.WorkflowID(r.GetExecution().GetWorkflowId()),
+			tag.WorkflowRunID(r.GetExecution().GetRunId()),
+		}
+	case *adminservice.RedactWorkflowExecutionResponse:
+		return nil
 	case *adminservice.RefreshWorkflowTasksRequest:
 		return []tag.Tag{
 			tag.WorkflowID(r.GetExecution().GetWorkflowId()),
@@ -206,6 +275,10 @@ func (wt *WorkflowTags) extractFromAdminServiceServerMessage(message any) []tag.
 		}
 	case *adminservice.ResendReplicationTasksResponse:
 		return nil
+	case *adminservice.ResumeTaskQueueDispatchRequest:
+		return nil
+	case *adminservice.ResumeTaskQueueDispatchResponse:
+		return nil
 	case *adminservice.SyncWorkflowStateRequest:
 		return []tag.Tag{
 			tag.WorkflowID(r.GetExecution().GetWorkflowId()),
@@ -213,6 +286,10 @@ func (wt *WorkflowTags) extractFromAdminServiceServerMessage(message any) []tag.
 		}
 	case *adminservice.SyncWorkflowStateResponse:
 		return nil
+	case *adminservice.UpsertSavedVisibilityQueryRequest:
+		return nil
+	case *adminservice.UpsertSavedVisibilityQueryResponse:
+		return nil
 	default:
 		return nil
 	}
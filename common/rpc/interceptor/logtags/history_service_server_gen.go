@@ -13,6 +13,13 @@ func (wt *WorkflowTags) extractFromHistoryServiceServerMessage(message any) []ta
 		return nil
 	case *historyservice.AddTasksResponse:
 		return nil
+	case *historyservice.CheckMutableStateRequest:
+		return []tag.Tag{
+			tag.WorkflowID(r.GetExecution().GetWorkflowId()),
+			tag.WorkflowRunID(r.GetExecution().GetRunId()),
+		}
+	case *historyservice.CheckMutableStateResponse:
+		return nil
 	case *historyservice.CloseShardRequest:
 		return nil
 	case *historyservice.CloseShardResponse:
@@ -80,6 +87,15 @@ func (wt *WorkflowTags) extractFromHistoryServiceServerMessage(message any) []ta
 		}
 	case *historyservice.ForceDeleteWorkflowExecutionResponse:
 		return nil
+	case *historyservice.ForkWorkflowExecutionRequest:
+		return []tag.Tag{
+			tag.WorkflowID(r.GetForkRequest().GetExecution().GetWorkflowId()),
+			tag.WorkflowRunID(r.GetForkRequest().GetExecution().GetRunId()),
+		}
+	case *historyservice.ForkWorkflowExecutionResponse:
+		return []tag.Tag{
+			tag.WorkflowRunID(r.GetRunId()),
+		}
 	case *historyservice.GenerateLastHistoryReplicationTasksRequest:
 		return []tag.Tag{
 			tag.WorkflowID(r.GetExecution().GetWorkflowId()),
@@ -149,6 +165,20 @@ func (wt *WorkflowTags) extractFromHistoryServiceServerMessage(message any) []ta
 		}
 	case *historyservice.GetWorkflowExecutionRawHistoryV2Response:
 		return nil
+	case *historyservice.GetWorkflowExecutionTimelineRequest:
+		return []tag.Tag{
+			tag.WorkflowID(r.GetRequest().GetExecution().GetWorkflowId()),
+			tag.WorkflowRunID(r.GetRequest().GetExecution().GetRunId()),
+		}
+	case *historyservice.GetWorkflowExecutionTimelineResponse:
+		return nil
+	case *historyservice.GetWorkflowHistoryBudgetRequest:
+		return []tag.Tag{
+			tag.WorkflowID(r.GetRequest().GetExecution().GetWorkflowId()),
+			tag.WorkflowRunID(r.GetRequest().GetExecution().GetRunId()),
+		}
+	case *historyservice.GetWorkflowHistoryBudgetResponse:
+		return nil
 	case *historyservice.ImportWorkflowExecutionRequest:
 		return []tag.Tag{
 			tag.WorkflowID(r.GetExecution().GetWorkflowId()),
@@ -189,6 +219,10 @@ func (wt *WorkflowTags) extractFromHistoryServiceServerMessage(message any) []ta
 		return nil
 	case *historyservice.MergeDLQMessagesResponse:
 		return nil
+	case *historyservice.MoveShardRequest:
+		return nil
+	case *historyservice.MoveShardResponse:
+		return nil
 	case *historyservice.PauseActivityRequest:
 		return []tag.Tag{
 			tag.WorkflowID(r.GetFrontendRequest().GetExecution().GetWorkflowId()),
@@ -263,6 +297,20 @@ func (wt *WorkflowTags) extractFromHistoryServiceServerMessage(message any) []ta
 		}
 	case *historyservice.RecordWorkflowTaskStartedResponseWithRawHistory:
 		return nil
+	case *historyservice.RedactWorkflowExecutionRequest:
+		return []tag.Tag{
+			tag.WorkflowID(r.GetRedactRequest().GetExecution().GetWorkflowId()),
+			tag.WorkflowRunID(r.GetRedactRequest().GetExecution().GetRunId()),
+		}
+	case *historyservice.RedactWorkflowExecutionResponse:
+		return nil
+	case *historyservice.ReencryptWorkflowExecutionRequest:
+		return []tag.Tag{
+			tag.WorkflowID(r.GetExecution().GetWorkflowId()),
+			tag.WorkflowRunID(r.GetExecution().GetRunId()),
+		}
+	case *historyservice.ReencryptWorkflowExecutionResponse:
+		return nil
 	case *historyservice.RefreshWorkflowTasksRequest:
 		return []tag.Tag{
 			tag.WorkflowID(r.GetRequest().GetExecution().GetWorkflowId()),
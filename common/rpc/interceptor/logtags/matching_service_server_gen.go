@@ -43,6 +43,10 @@ func (wt *WorkflowTags) extractFromMatchingServiceServerMessage(message any) []t
 		return nil
 	case *matchingservice.DeleteNexusEndpointResponse:
 		return nil
+	case *matchingservice.DeleteTaskQueueBacklogTasksRequest:
+		return nil
+	case *matchingservice.DeleteTaskQueueBacklogTasksResponse:
+		return nil
 	case *matchingservice.DescribeTaskQueueRequest:
 		return nil
 	case *matchingservice.DescribeTaskQueueResponse:
@@ -95,6 +99,10 @@ func (wt *WorkflowTags) extractFromMatchingServiceServerMessage(message any) []t
 		return nil
 	case *matchingservice.ListNexusEndpointsResponse:
 		return nil
+	case *matchingservice.ListTaskQueueBacklogTasksRequest:
+		return nil
+	case *matchingservice.ListTaskQueueBacklogTasksResponse:
+		return nil
 	case *matchingservice.ListTaskQueuePartitionsRequest:
 		return nil
 	case *matchingservice.ListTaskQueuePartitionsResponse:
@@ -103,6 +111,10 @@ func (wt *WorkflowTags) extractFromMatchingServiceServerMessage(message any) []t
 		return nil
 	case *matchingservice.ListWorkersResponse:
 		return nil
+	case *matchingservice.MoveTaskQueueBacklogTasksRequest:
+		return nil
+	case *matchingservice.MoveTaskQueueBacklogTasksResponse:
+		return nil
 	case *matchingservice.PollActivityTaskQueueRequest:
 		return nil
 	case *matchingservice.PollActivityTaskQueueResponse:
@@ -160,6 +172,10 @@ func (wt *WorkflowTags) extractFromMatchingServiceServerMessage(message any) []t
 		return nil
 	case *matchingservice.UpdateTaskQueueConfigResponse:
 		return nil
+	case *matchingservice.UpdateTaskQueueDispatchPauseRequest:
+		return nil
+	case *matchingservice.UpdateTaskQueueDispatchPauseResponse:
+		return nil
 	case *matchingservice.UpdateTaskQueueUserDataRequest:
 		return nil
 	case *matchingservice.UpdateTaskQueueUserDataResponse:
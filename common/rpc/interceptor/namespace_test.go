@@ -41,6 +41,7 @@ var (
 
 	historyAPIExcluded = map[string]struct{}{
 		"CloseShard":                {},
+		"MoveShard":                 {},
 		"GetShard":                  {},
 		"GetDLQMessages":            {},
 		"GetDLQReplicationMessages": {},
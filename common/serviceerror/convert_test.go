@@ -13,6 +13,7 @@ func TestFromToStatus(t *testing.T) {
 		Message:     "mess",
 		OwnerHost:   "owner",
 		CurrentHost: "current",
+		ShardID:     2,
 	}
 
 	st := serviceerror.ToStatus(err)
@@ -23,4 +24,5 @@ func TestFromToStatus(t *testing.T) {
 	}
 	assert.Equal(t, err.Message, solErr.Message)
 	assert.Equal(t, err.OwnerHost, solErr.OwnerHost)
+	assert.Equal(t, err.ShardID, solErr.ShardID)
 }
@@ -14,7 +14,10 @@ type (
 		Message     string
 		OwnerHost   string
 		CurrentHost string
-		st          *status.Status
+		// ShardID is set when the request was routed by another shard than the one that serves the workflow,
+		// so that clients don't cache the owner of this shard for the shard they routed by.
+		ShardID int32
+		st      *status.Status
 	}
 )
 
@@ -42,6 +45,7 @@ func (e *ShardOwnershipLost) Status() *status.Status {
 		&errordetailsspb.ShardOwnershipLostFailure{
 			OwnerHost:   e.OwnerHost,
 			CurrentHost: e.CurrentHost,
+			ShardId:     e.ShardID,
 		},
 	)
 	return st
@@ -52,6 +56,7 @@ func newShardOwnershipLost(st *status.Status, errDetails *errordetailsspb.ShardO
 		Message:     st.Message(),
 		OwnerHost:   errDetails.GetOwnerHost(),
 		CurrentHost: errDetails.GetCurrentHost(),
+		ShardID:     errDetails.GetShardId(),
 		st:          st,
 	}
 }
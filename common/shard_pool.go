@@ -0,0 +1,75 @@
+package common
+
+type (
+	// NamespaceShardPool is a contiguous, inclusive range of history shards that all workflows
+	// of a namespace are routed to, instead of being spread across every shard in the cluster.
+	NamespaceShardPool struct {
+		FirstShardID int32
+		LastShardID  int32
+		// Previous is set while the namespace is moved to this pool from another one, or from all
+		// shards if Previous is empty. Workflows are looked up on both shards during the move, so
+		// Previous must be kept until no workflows of the namespace remain on their previous shard.
+		Previous *NamespaceShardPool
+		// Prepare keeps starting new workflows on their previous shard while they are already looked
+		// up on both. It is set until all hosts have picked up the move, so that no workflow is
+		// started on a shard that some hosts don't look at yet.
+		Prepare bool
+	}
+)
+
+// IsValid returns true if the pool is non-empty and fits within the given number of shards.
+func (p NamespaceShardPool) IsValid(numberOfShards int32) bool {
+	return p.FirstShardID >= 1 && p.FirstShardID <= p.LastShardID && p.LastShardID <= numberOfShards
+}
+
+// Contains returns true if the given shard belongs to the pool.
+func (p NamespaceShardPool) Contains(shardID int32) bool {
+	return shardID >= p.FirstShardID && shardID <= p.LastShardID
+}
+
+func (p NamespaceShardPool) shardID(namespaceID string, workflowID string, numberOfShards int32) int32 {
+	if !p.IsValid(numberOfShards) {
+		return WorkflowIDToHistoryShard(namespaceID, workflowID, numberOfShards)
+	}
+	poolSize := p.LastShardID - p.FirstShardID + 1
+	return WorkflowIDToHistoryShard(namespaceID, workflowID, poolSize) + p.FirstShardID - 1
+}
+
+// WorkflowIDToHistoryShardInPools is used to map namespaceID-workflowID pair to a shardID, respecting
+// namespace shard pools keyed by namespace ID. Namespaces without a valid pool are routed the same way
+// as WorkflowIDToHistoryShard. This is the shard new workflows are started on, existing workflows of a
+// namespace that is being moved between pools may also be on WorkflowIDToAlternateHistoryShard.
+func WorkflowIDToHistoryShardInPools(
+	namespaceID string,
+	workflowID string,
+	numberOfShards int32,
+	pools map[string]NamespaceShardPool,
+) int32 {
+	pool := pools[namespaceID]
+	if pool.Previous != nil && pool.Prepare {
+		return pool.Previous.shardID(namespaceID, workflowID, numberOfShards)
+	}
+	return pool.shardID(namespaceID, workflowID, numberOfShards)
+}
+
+// WorkflowIDToAlternateHistoryShard returns the other shard an existing workflow may be on while its
+// namespace is moved between shard pools, or 0 if there is none.
+func WorkflowIDToAlternateHistoryShard(
+	namespaceID string,
+	workflowID string,
+	numberOfShards int32,
+	pools map[string]NamespaceShardPool,
+) int32 {
+	pool := pools[namespaceID]
+	if pool.Previous == nil {
+		return 0
+	}
+	alternateShardID := pool.Previous.shardID(namespaceID, workflowID, numberOfShards)
+	if pool.Prepare {
+		alternateShardID = pool.shardID(namespaceID, workflowID, numberOfShards)
+	}
+	if alternateShardID == WorkflowIDToHistoryShardInPools(namespaceID, workflowID, numberOfShards, pools) {
+		return 0
+	}
+	return alternateShardID
+}
@@ -0,0 +1,103 @@
+package common
+
+import (
+	"testing"
+
+	"github.com/pborman/uuid"
+	"github.com/stretchr/testify/require"
+)
+
+func TestWorkflowIDToHistoryShardInPools(t *testing.T) {
+	pooledNamespaceID := uuid.New()
+	otherNamespaceID := uuid.New()
+	pools := map[string]NamespaceShardPool{
+		pooledNamespaceID: {FirstShardID: 5, LastShardID: 8},
+	}
+
+	seen := make(map[int32]struct{})
+	for i := 0; i < 1000; i++ {
+		workflowID := uuid.New()
+		shardID := WorkflowIDToHistoryShardInPools(pooledNamespaceID, workflowID, 16, pools)
+		require.True(t, pools[pooledNamespaceID].Contains(shardID), "shard %d outside of pool", shardID)
+		seen[shardID] = struct{}{}
+
+		require.Equal(t,
+			WorkflowIDToHistoryShard(otherNamespaceID, workflowID, 16),
+			WorkflowIDToHistoryShardInPools(otherNamespaceID, workflowID, 16, pools),
+		)
+	}
+	require.Len(t, seen, 4)
+}
+
+func TestWorkflowIDToHistoryShardInPools_InvalidPool(t *testing.T) {
+	namespaceID := uuid.New()
+	workflowID := uuid.New()
+	expected := WorkflowIDToHistoryShard(namespaceID, workflowID, 16)
+
+	for _, pool := range []NamespaceShardPool{
+		{FirstShardID: 0, LastShardID: 4},
+		{FirstShardID: 8, LastShardID: 4},
+		{FirstShardID: 12, LastShardID: 17},
+	} {
+		pools := map[string]NamespaceShardPool{namespaceID: pool}
+		require.Equal(t, expected, WorkflowIDToHistoryShardInPools(namespaceID, workflowID, 16, pools))
+	}
+	require.Equal(t, expected, WorkflowIDToHistoryShardInPools(namespaceID, workflowID, 16, nil))
+}
+
+func TestWorkflowIDToHistoryShardInPools_SingleShard(t *testing.T) {
+	namespaceID := uuid.New()
+	pools := map[string]NamespaceShardPool{
+		namespaceID: {FirstShardID: 3, LastShardID: 3},
+	}
+	for i := 0; i < 100; i++ {
+		require.Equal(t, int32(3), WorkflowIDToHistoryShardInPools(namespaceID, uuid.New(), 16, pools))
+	}
+}
+
+func TestWorkflowIDToHistoryShardInPools_Move(t *testing.T) {
+	namespaceID := uuid.New()
+	previous := NamespaceShardPool{FirstShardID: 1, LastShardID: 4}
+	pool := NamespaceShardPool{FirstShardID: 5, LastShardID: 8, Previous: &previous}
+
+	for i := 0; i < 100; i++ {
+		workflowID := uuid.New()
+		previousShardID := WorkflowIDToHistoryShardInPools(namespaceID, workflowID, 16, map[string]NamespaceShardPool{namespaceID: previous})
+		poolShardID := WorkflowIDToHistoryShardInPools(namespaceID, workflowID, 16, map[string]NamespaceShardPool{namespaceID: {FirstShardID: 5, LastShardID: 8}})
+
+		// new workflows are started on their previous shard until the move is prepared on all hosts
+		prepared := pool
+		prepared.Prepare = true
+		pools := map[string]NamespaceShardPool{namespaceID: prepared}
+		require.Equal(t, previousShardID, WorkflowIDToHistoryShardInPools(namespaceID, workflowID, 16, pools))
+		require.Equal(t, poolShardID, WorkflowIDToAlternateHistoryShard(namespaceID, workflowID, 16, pools))
+
+		pools = map[string]NamespaceShardPool{namespaceID: pool}
+		require.Equal(t, poolShardID, WorkflowIDToHistoryShardInPools(namespaceID, workflowID, 16, pools))
+		require.Equal(t, previousShardID, WorkflowIDToAlternateHistoryShard(namespaceID, workflowID, 16, pools))
+	}
+}
+
+func TestWorkflowIDToAlternateHistoryShard(t *testing.T) {
+	namespaceID := uuid.New()
+	workflowID := uuid.New()
+
+	// no move
+	pools := map[string]NamespaceShardPool{namespaceID: {FirstShardID: 5, LastShardID: 8}}
+	require.Zero(t, WorkflowIDToAlternateHistoryShard(namespaceID, workflowID, 16, pools))
+	require.Zero(t, WorkflowIDToAlternateHistoryShard(namespaceID, workflowID, 16, nil))
+
+	// moving between pools that route the workflow to the same shard
+	pools = map[string]NamespaceShardPool{namespaceID: {FirstShardID: 3, LastShardID: 3, Previous: &NamespaceShardPool{FirstShardID: 3, LastShardID: 3}}}
+	require.Zero(t, WorkflowIDToAlternateHistoryShard(namespaceID, workflowID, 16, pools))
+
+	// removing a pool moves the namespace back to all shards
+	pools = map[string]NamespaceShardPool{namespaceID: {Previous: &NamespaceShardPool{FirstShardID: 3, LastShardID: 3}}}
+	expected := WorkflowIDToHistoryShard(namespaceID, workflowID, 16)
+	require.Equal(t, expected, WorkflowIDToHistoryShardInPools(namespaceID, workflowID, 16, pools))
+	if expected == 3 {
+		require.Zero(t, WorkflowIDToAlternateHistoryShard(namespaceID, workflowID, 16, pools))
+	} else {
+		require.Equal(t, int32(3), WorkflowIDToAlternateHistoryShard(namespaceID, workflowID, 16, pools))
+	}
+}
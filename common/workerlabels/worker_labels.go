@@ -0,0 +1,96 @@
+package workerlabels
+
+import (
+	"fmt"
+	"strings"
+
+	commonpb "go.temporal.io/api/common/v1"
+	"go.temporal.io/api/serviceerror"
+	"go.temporal.io/server/common/payload"
+)
+
+const (
+	// HeaderName is the gRPC header in which workers advertise their labels when polling for activity tasks, as a
+	// comma separated list of key=value pairs, e.g. "region=eu,gpu=false".
+	HeaderName = "temporal-worker-labels"
+	// SelectorHeaderField is the activity header field holding the worker selector of an activity: a payload
+	// encoding a map of label keys to the values a worker must advertise to be dispatched the activity.
+	SelectorHeaderField = "__temporal_worker_selector"
+
+	maxLabels      = 32
+	maxKeyLength   = 64
+	maxValueLength = 256
+)
+
+var (
+	ErrTooManyLabels = serviceerror.NewInvalidArgument(fmt.Sprintf("at most %d worker labels are allowed", maxLabels))
+)
+
+// Parse parses the value of the worker labels header. An empty value means no labels.
+func Parse(value string) (map[string]string, error) {
+	if strings.TrimSpace(value) == "" {
+		return nil, nil
+	}
+	labels := make(map[string]string)
+	for _, pair := range strings.Split(value, ",") {
+		key, val, ok := strings.Cut(pair, "=")
+		key, val = strings.TrimSpace(key), strings.TrimSpace(val)
+		if !ok || key == "" {
+			return nil, serviceerror.NewInvalidArgumentf("invalid worker label %q, expected key=value", pair)
+		}
+		if _, dup := labels[key]; dup {
+			return nil, serviceerror.NewInvalidArgumentf("duplicate worker label %q", key)
+		}
+		labels[key] = val
+	}
+	if err := Validate(labels); err != nil {
+		return nil, err
+	}
+	return labels, nil
+}
+
+// SelectorFromHeader returns the worker selector stored in an activity header, or nil if there is none.
+func SelectorFromHeader(header *commonpb.Header) (map[string]string, error) {
+	p, ok := header.GetFields()[SelectorHeaderField]
+	if !ok {
+		return nil, nil
+	}
+	var selector map[string]string
+	if err := payload.Decode(p, &selector); err != nil {
+		return nil, serviceerror.NewInvalidArgumentf("invalid worker selector in header field %s: %v", SelectorHeaderField, err)
+	}
+	if err := Validate(selector); err != nil {
+		return nil, err
+	}
+	if len(selector) == 0 {
+		return nil, nil
+	}
+	return selector, nil
+}
+
+// Validate checks the number and the size of labels or selector entries.
+func Validate(labels map[string]string) error {
+	if len(labels) > maxLabels {
+		return ErrTooManyLabels
+	}
+	for key, val := range labels {
+		if key == "" || len(key) > maxKeyLength {
+			return serviceerror.NewInvalidArgumentf("worker label key %q must be between 1 and %d bytes", key, maxKeyLength)
+		}
+		if len(val) > maxValueLength {
+			return serviceerror.NewInvalidArgumentf("value of worker label %q exceeds %d bytes", key, maxValueLength)
+		}
+	}
+	return nil
+}
+
+// Matches returns true if the labels have every key of the selector with the same value. An empty selector matches
+// any labels.
+func Matches(selector, labels map[string]string) bool {
+	for key, val := range selector {
+		if v, ok := labels[key]; !ok || v != val {
+			return false
+		}
+	}
+	return true
+}
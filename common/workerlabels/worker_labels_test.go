@@ -0,0 +1,55 @@
+package workerlabels
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	commonpb "go.temporal.io/api/common/v1"
+	"go.temporal.io/server/common/payload"
+)
+
+func TestParse(t *testing.T) {
+	labels, err := Parse(" region = eu,gpu=false ")
+	require.NoError(t, err)
+	require.Equal(t, map[string]string{"region": "eu", "gpu": "false"}, labels)
+
+	labels, err = Parse("")
+	require.NoError(t, err)
+	require.Nil(t, labels)
+
+	labels, err = Parse("empty=")
+	require.NoError(t, err)
+	require.Equal(t, map[string]string{"empty": ""}, labels)
+
+	for _, invalid := range []string{"region", "=eu", "region=eu,region=us", "a=b,,c=d", strings.Repeat("k", 65) + "=v"} {
+		_, err = Parse(invalid)
+		require.Error(t, err, invalid)
+	}
+}
+
+func TestSelectorFromHeader(t *testing.T) {
+	selector, err := SelectorFromHeader(nil)
+	require.NoError(t, err)
+	require.Nil(t, selector)
+
+	p, err := payload.Encode(map[string]string{"region": "eu"})
+	require.NoError(t, err)
+	selector, err = SelectorFromHeader(&commonpb.Header{Fields: map[string]*commonpb.Payload{SelectorHeaderField: p}})
+	require.NoError(t, err)
+	require.Equal(t, map[string]string{"region": "eu"}, selector)
+
+	_, err = SelectorFromHeader(&commonpb.Header{Fields: map[string]*commonpb.Payload{SelectorHeaderField: payload.EncodeString("eu")}})
+	require.Error(t, err)
+}
+
+func TestMatches(t *testing.T) {
+	labels := map[string]string{"region": "eu", "gpu": "false"}
+	require.True(t, Matches(nil, labels))
+	require.True(t, Matches(nil, nil))
+	require.True(t, Matches(map[string]string{"region": "eu"}, labels))
+	require.True(t, Matches(labels, labels))
+	require.False(t, Matches(map[string]string{"region": "us"}, labels))
+	require.False(t, Matches(map[string]string{"zone": "a"}, labels))
+	require.False(t, Matches(map[string]string{"region": "eu"}, nil))
+}
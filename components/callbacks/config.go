@@ -27,14 +27,32 @@ var RetryPolicyMaximumInterval = dynamicconfig.NewGlobalDurationSetting(
 	`The maximum backoff interval between every callback request attempt for a given callback.`,
 )
 
+var WebhookSigningKey = dynamicconfig.NewNamespaceStringSetting(
+	"component.callbacks.webhook.signingKey",
+	"",
+	`The per-namespace secret used to sign webhook callback requests with HMAC-SHA256. Webhook callbacks are not
+delivered while no key is configured for the namespace.`,
+)
+
+var WebhookMaxAttempts = dynamicconfig.NewNamespaceIntSetting(
+	"component.callbacks.webhook.maxAttempts",
+	0,
+	`The maximum number of attempts to deliver a webhook callback before it is marked as failed. Zero or a negative
+value means unlimited attempts.`,
+)
+
 type Config struct {
-	RequestTimeout dynamicconfig.DurationPropertyFnWithDestinationFilter
-	RetryPolicy    func() backoff.RetryPolicy
+	RequestTimeout     dynamicconfig.DurationPropertyFnWithDestinationFilter
+	RetryPolicy        func() backoff.RetryPolicy
+	WebhookSigningKey  dynamicconfig.StringPropertyFnWithNamespaceFilter
+	WebhookMaxAttempts dynamicconfig.IntPropertyFnWithNamespaceFilter
 }
 
 func ConfigProvider(dc *dynamicconfig.Collection) *Config {
 	return &Config{
-		RequestTimeout: RequestTimeout.Get(dc),
+		RequestTimeout:     RequestTimeout.Get(dc),
+		WebhookSigningKey:  WebhookSigningKey.Get(dc),
+		WebhookMaxAttempts: WebhookMaxAttempts.Get(dc),
 		RetryPolicy: func() backoff.RetryPolicy {
 			return backoff.NewExponentialRetryPolicy(
 				RetryPolicyInitialInterval.Get(dc)(),
@@ -56,17 +74,21 @@ URLs are checked against each in order when starting a workflow with attached ca
 Default is no address rules, meaning all callbacks will be rejected. Any invalid entries are ignored. Each entry is a map with possible values:
 	 - "Pattern":string (required) the host:port pattern to which this config applies.
 		Wildcards, '*', are supported and can match any number of characters (e.g. '*' matches everything, 'prefix.*.domain' matches 'prefix.a.domain' as well as 'prefix.a.b.domain').
-	 - "AllowInsecure":bool (optional, default=false) indicates whether https is required`)
+	 - "AllowInsecure":bool (optional, default=false) indicates whether https is required
+	 - "Webhook":bool (optional, default=false) indicates that callbacks to this address are delivered as signed HTTP
+		webhooks instead of Nexus completion requests`)
 
 type AddressMatchRule struct {
 	Regexp        *regexp.Regexp
 	AllowInsecure bool
+	Webhook       bool
 }
 
 func allowedAddressConverter(val any) ([]AddressMatchRule, error) {
 	type entry struct {
 		Pattern       string
 		AllowInsecure bool
+		Webhook       bool
 	}
 	intermediate, err := dynamicconfig.ConvertStructure([]entry{})(val)
 	if err != nil {
@@ -87,6 +109,7 @@ func allowedAddressConverter(val any) ([]AddressMatchRule, error) {
 		configs = append(configs, AddressMatchRule{
 			Regexp:        re,
 			AllowInsecure: e.AllowInsecure,
+			Webhook:       e.Webhook,
 		})
 	}
 	return configs, nil
@@ -168,6 +168,24 @@ func (e taskExecutor) loadInvocationArgs(
 				return err
 			}
 			invokable = hsmInvokable
+		case *persistencespb.Callback_Webhook_:
+			target, err := hsm.MachineData[CanGetCompletionEvent](node.Parent)
+			if err != nil {
+				return err
+			}
+			// variant struct is immutable and ok to reference without copying
+			webhookInvokable := webhookInvocation{}
+			webhookInvokable.webhook = variant.Webhook
+			webhookInvokable.completionEvent, err = target.GetCompletionEvent(ctx)
+			if err != nil {
+				return err
+			}
+			// The ID is stable across attempts to allow receivers to deduplicate deliveries.
+			webhookInvokable.webhookID = ref.WorkflowKey.RunID + "/" + node.Key.ID
+			webhookInvokable.workflowID = ref.WorkflowKey.WorkflowID
+			webhookInvokable.runID = ref.WorkflowKey.RunID
+			webhookInvokable.attempt = callback.Attempt
+			invokable = webhookInvokable
 		default:
 			return queues.NewUnprocessableTaskError(
 				fmt.Sprintf("unprocessable callback variant: %v", variant),
@@ -2,8 +2,10 @@ package callbacks_test
 
 import (
 	"context"
+	"encoding/json"
 	"errors"
 	"fmt"
+	"io"
 	"net/http"
 	"testing"
 	"time"
@@ -62,6 +64,10 @@ func (ms mutableState) GetHSMCompletionCallbackArg(ctx context.Context) (*persis
 	return ms.completionHsm, nil
 }
 
+func (ms mutableState) GetCompletionEvent(ctx context.Context) (*historypb.HistoryEvent, error) {
+	return ms.completionHsm.LastEvent, nil
+}
+
 func TestProcessInvocationTaskNexus_Outcomes(t *testing.T) {
 	cases := []struct {
 		name                  string
@@ -367,6 +373,162 @@ func TestProcessInvocationTaskHsm_Outcomes(t *testing.T) {
 	}
 }
 
+func TestProcessInvocationTaskWebhook_Outcomes(t *testing.T) {
+	cases := []struct {
+		name            string
+		signingKey      string
+		maxAttempts     int
+		statusCode      int
+		destinationDown bool
+		expectedState   enumsspb.CallbackState
+	}{
+		{
+			name:          "success",
+			signingKey:    "secret",
+			statusCode:    200,
+			expectedState: enumsspb.CALLBACK_STATE_SUCCEEDED,
+		},
+		{
+			name:            "retryable-error",
+			signingKey:      "secret",
+			statusCode:      503,
+			destinationDown: true,
+			expectedState:   enumsspb.CALLBACK_STATE_BACKING_OFF,
+		},
+		{
+			name:          "max-attempts-exceeded",
+			signingKey:    "secret",
+			maxAttempts:   1,
+			statusCode:    503,
+			expectedState: enumsspb.CALLBACK_STATE_FAILED,
+		},
+		{
+			name:          "non-retryable-error",
+			signingKey:    "secret",
+			statusCode:    400,
+			expectedState: enumsspb.CALLBACK_STATE_FAILED,
+		},
+		{
+			name:          "signing-key-not-set",
+			maxAttempts:   1,
+			expectedState: enumsspb.CALLBACK_STATE_BACKING_OFF,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			namespaceRegistryMock := namespace.NewMockRegistry(ctrl)
+			namespaceRegistryMock.EXPECT().GetNamespaceByID(namespace.ID("namespace-id")).Return(
+				namespace.FromPersistentState(&persistencespb.NamespaceDetail{
+					Info: &persistencespb.NamespaceInfo{
+						Id:   "namespace-id",
+						Name: "namespace-name",
+					},
+					Config: &persistencespb.NamespaceConfig{},
+				}),
+				nil,
+			)
+
+			root := newRoot(t)
+			cb := callbacks.Callback{
+				CallbackInfo: &persistencespb.CallbackInfo{
+					Callback: &persistencespb.Callback{
+						Variant: &persistencespb.Callback_Webhook_{
+							Webhook: &persistencespb.Callback_Webhook{
+								Url:    "http://localhost/done",
+								Header: map[string]string{"key": "value"},
+							},
+						},
+					},
+					State: enumsspb.CALLBACK_STATE_SCHEDULED,
+				},
+			}
+			coll := callbacks.MachineCollection(root)
+			node, err := coll.Add("ID", cb)
+			require.NoError(t, err)
+			env := fakeEnv{node}
+
+			caller := func(r *http.Request) (*http.Response, error) {
+				require.Equal(t, http.MethodPost, r.Method)
+				require.Equal(t, "value", r.Header.Get("key"))
+				require.Equal(t, "application/json", r.Header.Get("Content-Type"))
+				require.Equal(t, "run-id/ID", r.Header.Get("webhook-id"))
+
+				body, err := io.ReadAll(r.Body)
+				require.NoError(t, err)
+				require.Equal(t,
+					callbacks.SignWebhook(tc.signingKey, "run-id/ID", r.Header.Get("webhook-timestamp"), body),
+					r.Header.Get("webhook-signature"),
+				)
+				var completion callbacks.WebhookCompletion
+				require.NoError(t, json.Unmarshal(body, &completion))
+				require.Equal(t, "namespace-name", completion.Namespace)
+				require.Equal(t, "workflow-id", completion.WorkflowID)
+				require.Equal(t, "run-id", completion.RunID)
+				require.Equal(t, "completed", completion.Status)
+				return &http.Response{StatusCode: tc.statusCode, Status: http.StatusText(tc.statusCode), Body: http.NoBody}, nil
+			}
+			if tc.signingKey == "" {
+				caller = func(r *http.Request) (*http.Response, error) {
+					require.FailNow(t, "unsigned webhook must not be sent")
+					return nil, nil
+				}
+			}
+
+			key := definition.NewWorkflowKey("namespace-id", "workflow-id", "run-id")
+			reg := hsm.NewRegistry()
+			require.NoError(t, callbacks.RegisterExecutor(
+				reg,
+				callbacks.TaskExecutorOptions{
+					NamespaceRegistry: namespaceRegistryMock,
+					MetricsHandler:    metrics.NoopMetricsHandler,
+					HTTPCallerProvider: func(nid queues.NamespaceIDAndDestination) callbacks.HTTPCaller {
+						return caller
+					},
+					Logger: log.NewNoopLogger(),
+					Config: &callbacks.Config{
+						RequestTimeout: dynamicconfig.GetDurationPropertyFnFilteredByDestination(time.Second),
+						RetryPolicy: func() backoff.RetryPolicy {
+							return backoff.NewExponentialRetryPolicy(time.Second)
+						},
+						WebhookSigningKey:  dynamicconfig.GetStringPropertyFnFilteredByNamespace(tc.signingKey),
+						WebhookMaxAttempts: dynamicconfig.GetIntPropertyFnFilteredByNamespace(tc.maxAttempts),
+					},
+				},
+			))
+
+			err = reg.ExecuteImmediateTask(
+				context.Background(),
+				env,
+				hsm.Ref{
+					WorkflowKey: key,
+					StateMachineRef: &persistencespb.StateMachineRef{
+						Path: []*persistencespb.StateMachineKey{
+							{
+								Type: callbacks.StateMachineType,
+								Id:   "ID",
+							},
+						},
+					},
+				},
+				callbacks.NewInvocationTask("http://localhost"),
+			)
+
+			if tc.destinationDown {
+				var destinationDownErr *queues.DestinationDownError
+				require.ErrorAs(t, err, &destinationDownErr)
+			} else {
+				require.NoError(t, err)
+			}
+
+			cb, err = coll.Data("ID")
+			require.NoError(t, err)
+			require.Equal(t, tc.expectedState, cb.State())
+		})
+	}
+}
+
 func TestProcessBackoffTask(t *testing.T) {
 	root := newRoot(t)
 	cb := callbacks.Callback{
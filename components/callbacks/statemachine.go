@@ -86,6 +86,12 @@ func (c Callback) RegenerateTasks(*hsm.Node) ([]hsm.Task, error) {
 				return nil, fmt.Errorf("failed to parse URL: %v: %w", &c, err)
 			}
 			return []hsm.Task{InvocationTask{destination: u.Scheme + "://" + u.Host}}, nil
+		case *persistencespb.Callback_Webhook_:
+			u, err := url.Parse(c.Callback.GetWebhook().Url)
+			if err != nil {
+				return nil, fmt.Errorf("failed to parse URL: %v: %w", &c, err)
+			}
+			return []hsm.Task{InvocationTask{destination: u.Scheme + "://" + u.Host}}, nil
 		case *persistencespb.Callback_Hsm:
 			// Destination is empty on the internal queue.
 			return []hsm.Task{InvocationTask{"TODO(bergundy): make this empty"}}, nil
@@ -0,0 +1,229 @@
+package callbacks
+
+import (
+	"bytes"
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptrace"
+	"strconv"
+	"time"
+
+	commonpb "go.temporal.io/api/common/v1"
+	enumspb "go.temporal.io/api/enums/v1"
+	historypb "go.temporal.io/api/history/v1"
+	persistencespb "go.temporal.io/server/api/persistence/v1"
+	"go.temporal.io/server/common/log"
+	"go.temporal.io/server/common/log/tag"
+	"go.temporal.io/server/common/metrics"
+	"go.temporal.io/server/common/namespace"
+	"go.temporal.io/server/service/history/queues"
+	"google.golang.org/protobuf/encoding/protojson"
+)
+
+// Headers of a webhook request, following the Standard Webhooks specification (https://www.standardwebhooks.com).
+const (
+	webhookIDHeader        = "webhook-id"
+	webhookTimestampHeader = "webhook-timestamp"
+	webhookSignatureHeader = "webhook-signature"
+
+	webhookSignatureVersion = "v1"
+)
+
+var errWebhookSigningKeyNotSet = errors.New("no webhook signing key is configured for the namespace")
+
+type CanGetCompletionEvent interface {
+	GetCompletionEvent(ctx context.Context) (*historypb.HistoryEvent, error)
+}
+
+// WebhookCompletion is the JSON document posted to webhook callbacks when a workflow closes.
+// Completions may be sent to arbitrary third parties, failures only expose their message.
+type WebhookCompletion struct {
+	Namespace  string    `json:"namespace"`
+	WorkflowID string    `json:"workflowId"`
+	RunID      string    `json:"runId"`
+	Status     string    `json:"status"`
+	CloseTime  time.Time `json:"closeTime"`
+	// Result is set to the workflow result if it is JSON encoded.
+	Result json.RawMessage `json:"result,omitempty"`
+	// ResultPayload is set to the protojson encoding of the workflow result if it is not JSON encoded.
+	ResultPayload json.RawMessage `json:"resultPayload,omitempty"`
+	// Failure is set to the failure message of workflows that did not complete successfully.
+	Failure string `json:"failure,omitempty"`
+}
+
+type webhookInvocation struct {
+	webhook           *persistencespb.Callback_Webhook
+	completionEvent   *historypb.HistoryEvent
+	webhookID         string
+	workflowID, runID string
+	attempt           int32
+}
+
+func (w webhookInvocation) WrapError(result invocationResult, err error) error {
+	// a missing signing key is a problem of the namespace, not of the destination
+	if failure, ok := result.(invocationResultRetry); ok && !errors.Is(failure.err, errWebhookSigningKeyNotSet) {
+		return queues.NewDestinationDownError(failure.err.Error(), err)
+	}
+	return err
+}
+
+func (w webhookInvocation) Invoke(ctx context.Context, ns *namespace.Namespace, e taskExecutor, task InvocationTask) invocationResult {
+	result := w.invoke(ctx, ns, e, task)
+	// callbacks wait for the signing key to be configured however long it takes
+	if retry, ok := result.(invocationResultRetry); ok && !errors.Is(retry.err, errWebhookSigningKeyNotSet) {
+		// attempt does not include the current attempt yet
+		maxAttempts := e.Config.WebhookMaxAttempts(ns.Name().String())
+		if maxAttempts > 0 && int(w.attempt)+1 >= maxAttempts {
+			return invocationResultFail{fmt.Errorf("webhook callback exceeded %d attempts: %w", maxAttempts, retry.err)}
+		}
+	}
+	return result
+}
+
+func (w webhookInvocation) invoke(ctx context.Context, ns *namespace.Namespace, e taskExecutor, task InvocationTask) invocationResult {
+	signingKey := e.Config.WebhookSigningKey(ns.Name().String())
+	if signingKey == "" {
+		e.Logger.Error("Webhook callback cannot be signed", tag.WorkflowNamespace(ns.Name().String()), tag.Error(errWebhookSigningKeyNotSet))
+		return invocationResultRetry{errWebhookSigningKeyNotSet}
+	}
+
+	if e.HTTPTraceProvider != nil {
+		traceLogger := log.With(e.Logger,
+			tag.WorkflowNamespace(ns.Name().String()),
+			tag.Operation("WebhookCallback"),
+			tag.NewStringTag("destination", task.destination),
+			tag.WorkflowID(w.workflowID),
+			tag.WorkflowRunID(w.runID),
+			tag.AttemptStart(time.Now().UTC()),
+			tag.Attempt(w.attempt),
+		)
+		if trace := e.HTTPTraceProvider.NewTrace(w.attempt, traceLogger); trace != nil {
+			ctx = httptrace.WithClientTrace(ctx, trace)
+		}
+	}
+
+	completion, err := newWebhookCompletion(ns, w.workflowID, w.runID, w.completionEvent)
+	if err != nil {
+		return invocationResultFail{queues.NewUnprocessableTaskError(
+			fmt.Sprintf("failed to construct webhook completion: %v", err),
+		)}
+	}
+	body, err := json.Marshal(completion)
+	if err != nil {
+		return invocationResultFail{queues.NewUnprocessableTaskError(
+			fmt.Sprintf("failed to serialize webhook completion: %v", err),
+		)}
+	}
+	request, err := http.NewRequestWithContext(ctx, http.MethodPost, w.webhook.Url, bytes.NewReader(body))
+	if err != nil {
+		return invocationResultFail{queues.NewUnprocessableTaskError(
+			fmt.Sprintf("failed to construct webhook request: %v", err),
+		)}
+	}
+	for k, v := range w.webhook.Header {
+		request.Header.Set(k, v)
+	}
+	request.Header.Set("Content-Type", "application/json")
+	timestamp := strconv.FormatInt(time.Now().Unix(), 10)
+	request.Header.Set(webhookIDHeader, w.webhookID)
+	request.Header.Set(webhookTimestampHeader, timestamp)
+	request.Header.Set(webhookSignatureHeader, SignWebhook(signingKey, w.webhookID, timestamp, body))
+
+	caller := e.HTTPCallerProvider(queues.NamespaceIDAndDestination{
+		NamespaceID: ns.ID().String(),
+		Destination: task.Destination(),
+	})
+	// Make the call and record metrics.
+	startTime := time.Now()
+	response, err := caller(request)
+
+	namespaceTag := metrics.NamespaceTag(ns.Name().String())
+	destTag := metrics.DestinationTag(task.Destination())
+	statusCodeTag := metrics.OutcomeTag(outcomeTag(ctx, response, err))
+	e.MetricsHandler.Counter(RequestCounter.Name()).Record(1, namespaceTag, destTag, statusCodeTag)
+	e.MetricsHandler.Timer(RequestLatencyHistogram.Name()).Record(time.Since(startTime), namespaceTag, destTag, statusCodeTag)
+
+	if err != nil {
+		e.Logger.Error("Callback request failed with error", tag.Error(err))
+		return invocationResultRetry{err}
+	}
+
+	// Body is not read but should be discarded to keep the underlying TCP connection alive.
+	_, _ = io.Copy(io.Discard, response.Body)
+	_ = response.Body.Close()
+
+	if response.StatusCode >= 200 && response.StatusCode < 300 {
+		return invocationResultOK{}
+	}
+
+	retryable := isRetryableHTTPResponse(response)
+	err = fmt.Errorf("request failed with: %v", response.Status)
+	e.Logger.Error("Callback request failed", tag.Error(err), tag.NewStringTag("status", response.Status), tag.NewBoolTag("retryable", retryable))
+	if retryable {
+		return invocationResultRetry{err}
+	}
+	return invocationResultFail{err}
+}
+
+// SignWebhook returns the value of the signature header of a webhook request with the given ID, timestamp and body.
+func SignWebhook(signingKey, webhookID, timestamp string, body []byte) string {
+	mac := hmac.New(sha256.New, []byte(signingKey))
+	_, _ = mac.Write([]byte(webhookID + "." + timestamp + "."))
+	_, _ = mac.Write(body)
+	return webhookSignatureVersion + "," + base64.StdEncoding.EncodeToString(mac.Sum(nil))
+}
+
+func newWebhookCompletion(
+	ns *namespace.Namespace,
+	workflowID, runID string,
+	event *historypb.HistoryEvent,
+) (WebhookCompletion, error) {
+	completion := WebhookCompletion{
+		Namespace:  ns.Name().String(),
+		WorkflowID: workflowID,
+		RunID:      runID,
+		CloseTime:  event.GetEventTime().AsTime(),
+	}
+	switch event.GetEventType() {
+	case enumspb.EVENT_TYPE_WORKFLOW_EXECUTION_COMPLETED:
+		completion.Status = "completed"
+		payloads := event.GetWorkflowExecutionCompletedEventAttributes().GetResult().GetPayloads()
+		if len(payloads) > 0 {
+			// Workflows return a single value, see GetNexusCompletion.
+			completion.Result, completion.ResultPayload = encodeWebhookResult(payloads[0])
+		}
+	case enumspb.EVENT_TYPE_WORKFLOW_EXECUTION_FAILED:
+		completion.Status = "failed"
+		completion.Failure = event.GetWorkflowExecutionFailedEventAttributes().GetFailure().GetMessage()
+	case enumspb.EVENT_TYPE_WORKFLOW_EXECUTION_CANCELED:
+		completion.Status = "canceled"
+		completion.Failure = "workflow canceled"
+	case enumspb.EVENT_TYPE_WORKFLOW_EXECUTION_TERMINATED:
+		completion.Status = "terminated"
+		completion.Failure = "workflow terminated"
+	case enumspb.EVENT_TYPE_WORKFLOW_EXECUTION_TIMED_OUT:
+		completion.Status = "timedOut"
+		completion.Failure = "workflow exceeded internal timeout"
+	default:
+		return WebhookCompletion{}, fmt.Errorf("invalid workflow execution status: %v", event.GetEventType())
+	}
+	return completion, nil
+}
+
+func encodeWebhookResult(p *commonpb.Payload) (json.RawMessage, json.RawMessage) {
+	if string(p.GetMetadata()["encoding"]) == "json/plain" && json.Valid(p.GetData()) {
+		return p.GetData(), nil
+	}
+	data, err := protojson.Marshal(p)
+	if err != nil {
+		return nil, nil
+	}
+	return nil, data
+}
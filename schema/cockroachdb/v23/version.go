@@ -0,0 +1,11 @@
+package v23
+
+// NOTE: whenever there is a new database schema update, plz update the following versions
+
+// Version is the CockroachDB database release version
+// The CockroachDB schema mirrors the PostgreSQL schema, so upgrades for PostgreSQL should be ported here as well
+const Version = "1.0"
+
+// VisibilityVersion is the CockroachDB visibility database release version
+// The CockroachDB schema mirrors the PostgreSQL schema, so upgrades for PostgreSQL should be ported here as well
+const VisibilityVersion = "1.0"
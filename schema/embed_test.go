@@ -23,6 +23,12 @@ func TestSchemaDirs(t *testing.T) {
 		"postgresql/v12/temporal",
 		"postgresql/v12/visibility",
 	}, dirs)
+
+	dirs = PathsByDir("cockroachdb")
+	requireContains(t, []string{
+		"cockroachdb/v23/temporal",
+		"cockroachdb/v23/visibility",
+	}, dirs)
 }
 
 func requireContains(t *testing.T, expected []string, actual []string) {
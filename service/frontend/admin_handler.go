@@ -8,6 +8,7 @@ import (
 	"maps"
 	"math"
 	"net"
+	"slices"
 	"strings"
 	"sync/atomic"
 	"time"
@@ -29,6 +30,7 @@ import (
 	"go.temporal.io/server/api/matchingservice/v1"
 	persistencespb "go.temporal.io/server/api/persistence/v1"
 	replicationspb "go.temporal.io/server/api/replication/v1"
+	taskqueuespb "go.temporal.io/server/api/taskqueue/v1"
 	serverClient "go.temporal.io/server/client"
 	"go.temporal.io/server/client/admin"
 	"go.temporal.io/server/client/frontend"
@@ -81,6 +83,7 @@ type (
 		numberOfHistoryShards      int32
 		config                     *Config
 		namespaceDLQHandler        nsreplication.DLQMessageHandler
+		namespaceReplicator        nsreplication.Replicator
 		eventSerializer            serialization.Serializer
 		visibilityMgr              manager.VisibilityManager
 		persistenceExecutionName   string
@@ -104,6 +107,7 @@ type (
 		clusterMetadata            cluster.Metadata
 		healthServer               *health.Server
 		historyHealthChecker       HealthChecker
+		timeSource                 clock.TimeSource
 
 		// DEPRECATED: only history service on server side is supposed to
 		// use the following components.
@@ -187,6 +191,7 @@ func NewAdminHandler(
 			args.NamespaceReplicationQueue,
 			args.Logger,
 		),
+		namespaceReplicator:        nsreplication.NewReplicator(args.ReplicatorNamespaceReplicationQueue, args.Logger),
 		eventSerializer:            args.EventSerializer,
 		visibilityMgr:              args.visibilityMgr,
 		persistenceExecutionName:   args.PersistenceExecutionManager.GetName(),
@@ -206,6 +211,7 @@ func NewAdminHandler(
 		saProvider:                 args.SaProvider,
 		saManager:                  args.SaManager,
 		saMapperProvider:           args.SaMapperProvider,
+		timeSource:                 args.TimeSource,
 		saValidator: searchattribute.NewValidator(
 			args.SaProvider,
 			args.SaMapperProvider,
@@ -674,6 +680,37 @@ func (adh *AdminHandler) RebuildMutableState(
 	return &adminservice.RebuildMutableStateResponse{}, nil
 }
 
+func (adh *AdminHandler) CheckMutableState(
+	ctx context.Context,
+	request *adminservice.CheckMutableStateRequest,
+) (_ *adminservice.CheckMutableStateResponse, retError error) {
+	defer log.CapturePanic(adh.logger, &retError)
+
+	if request == nil {
+		return nil, errRequestNotSet
+	}
+
+	if err := validateExecution(request.Execution); err != nil {
+		return nil, err
+	}
+
+	namespaceID, err := adh.namespaceRegistry.GetNamespaceID(namespace.Name(request.GetNamespace()))
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := adh.historyClient.CheckMutableState(ctx, &historyservice.CheckMutableStateRequest{
+		NamespaceId: namespaceID.String(),
+		Execution:   request.Execution,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &adminservice.CheckMutableStateResponse{
+		Inconsistencies: resp.GetInconsistencies(),
+	}, nil
+}
+
 func (adh *AdminHandler) ImportWorkflowExecution(
 	ctx context.Context,
 	request *adminservice.ImportWorkflowExecutionRequest,
@@ -712,6 +749,358 @@ func (adh *AdminHandler) ImportWorkflowExecution(
 	}, nil
 }
 
+// ForkWorkflowExecution copies the history of a workflow up to the given workflow task into a new workflow execution
+func (adh *AdminHandler) ForkWorkflowExecution(
+	ctx context.Context,
+	request *adminservice.ForkWorkflowExecutionRequest,
+) (_ *adminservice.ForkWorkflowExecutionResponse, retError error) {
+	defer log.CapturePanic(adh.logger, &retError)
+
+	if request == nil {
+		return nil, errRequestNotSet
+	}
+	if err := validateExecution(request.Execution); err != nil {
+		return nil, err
+	}
+	if request.GetNewWorkflowId() == "" {
+		return nil, errWorkflowIDNotSet
+	}
+
+	sourceNamespaceID, err := adh.namespaceRegistry.GetNamespaceID(namespace.Name(request.GetNamespace()))
+	if err != nil {
+		return nil, err
+	}
+	namespaceID := sourceNamespaceID
+	if request.GetNewNamespace() != "" {
+		namespaceID, err = adh.namespaceRegistry.GetNamespaceID(namespace.Name(request.GetNewNamespace()))
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	request = common.CloneProto(request)
+	if request.GetRequestId() == "" {
+		request.RequestId = uuid.New()
+	}
+	resp, err := adh.historyClient.ForkWorkflowExecution(ctx, &historyservice.ForkWorkflowExecutionRequest{
+		NamespaceId:       namespaceID.String(),
+		SourceNamespaceId: sourceNamespaceID.String(),
+		ForkRequest:       request,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &adminservice.ForkWorkflowExecutionResponse{
+		RunId: resp.GetRunId(),
+	}, nil
+}
+
+// RedactWorkflowExecution erases the selected payloads from the history of a closed workflow execution.
+func (adh *AdminHandler) RedactWorkflowExecution(
+	ctx context.Context,
+	request *adminservice.RedactWorkflowExecutionRequest,
+) (_ *adminservice.RedactWorkflowExecutionResponse, retError error) {
+	defer log.CapturePanic(adh.logger, &retError)
+
+	if request == nil {
+		return nil, errRequestNotSet
+	}
+	if err := validateExecution(request.Execution); err != nil {
+		return nil, err
+	}
+
+	namespaceID, err := adh.namespaceRegistry.GetNamespaceID(namespace.Name(request.GetNamespace()))
+	if err != nil {
+		return nil, err
+	}
+
+	if _, err := adh.historyClient.RedactWorkflowExecution(ctx, &historyservice.RedactWorkflowExecutionRequest{
+		NamespaceId:   namespaceID.String(),
+		RedactRequest: request,
+	}); err != nil {
+		return nil, err
+	}
+	return &adminservice.RedactWorkflowExecutionResponse{}, nil
+}
+
+// GetWorkflowExecutionTimeline analyzes the history of a workflow execution and returns it as timed spans.
+func (adh *AdminHandler) GetWorkflowExecutionTimeline(
+	ctx context.Context,
+	request *adminservice.GetWorkflowExecutionTimelineRequest,
+) (_ *adminservice.GetWorkflowExecutionTimelineResponse, retError error) {
+	defer log.CapturePanic(adh.logger, &retError)
+
+	if request == nil {
+		return nil, errRequestNotSet
+	}
+	if err := validateExecution(request.Execution); err != nil {
+		return nil, err
+	}
+
+	namespaceID, err := adh.namespaceRegistry.GetNamespaceID(namespace.Name(request.GetNamespace()))
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := adh.historyClient.GetWorkflowExecutionTimeline(ctx, &historyservice.GetWorkflowExecutionTimelineRequest{
+		NamespaceId: namespaceID.String(),
+		Request:     request,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &adminservice.GetWorkflowExecutionTimelineResponse{
+		Timeline: resp.GetTimeline(),
+	}, nil
+}
+
+// GetWorkflowHistoryBudget returns the consumption of a workflow execution against its history budget.
+func (adh *AdminHandler) GetWorkflowHistoryBudget(
+	ctx context.Context,
+	request *adminservice.GetWorkflowHistoryBudgetRequest,
+) (_ *adminservice.GetWorkflowHistoryBudgetResponse, retError error) {
+	defer log.CapturePanic(adh.logger, &retError)
+
+	if request == nil {
+		return nil, errRequestNotSet
+	}
+	if err := validateExecution(request.Execution); err != nil {
+		return nil, err
+	}
+
+	namespaceID, err := adh.namespaceRegistry.GetNamespaceID(namespace.Name(request.GetNamespace()))
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := adh.historyClient.GetWorkflowHistoryBudget(ctx, &historyservice.GetWorkflowHistoryBudgetRequest{
+		NamespaceId: namespaceID.String(),
+		Request:     request,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &adminservice.GetWorkflowHistoryBudgetResponse{
+		Usage: resp.GetUsage(),
+	}, nil
+}
+
+// AggregateWorkflowExecutions groups the workflow executions matching a visibility query and computes
+// aggregations over search attributes per group.
+func (adh *AdminHandler) AggregateWorkflowExecutions(
+	ctx context.Context,
+	request *adminservice.AggregateWorkflowExecutionsRequest,
+) (_ *adminservice.AggregateWorkflowExecutionsResponse, retError error) {
+	defer log.CapturePanic(adh.logger, &retError)
+
+	if request == nil {
+		return nil, errRequestNotSet
+	}
+	if request.GetNamespace() == "" {
+		return nil, errNamespaceNotSet
+	}
+
+	namespaceName := namespace.Name(request.GetNamespace())
+	namespaceID, err := adh.namespaceRegistry.GetNamespaceID(namespaceName)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := adh.visibilityMgr.AggregateWorkflowExecutions(ctx, &manager.AggregateWorkflowExecutionsRequest{
+		NamespaceID:  namespaceID,
+		Namespace:    namespaceName,
+		Query:        request.GetQuery(),
+		GroupBy:      request.GetGroupBy(),
+		Aggregations: request.GetAggregations(),
+		MaxGroups:    adh.config.VisibilityAggregationMaxGroups(namespaceName.String()),
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &adminservice.AggregateWorkflowExecutionsResponse{
+		Groups: resp.Groups,
+	}, nil
+}
+
+// UpsertSavedVisibilityQuery creates or replaces a named visibility query in the namespace metadata.
+func (adh *AdminHandler) UpsertSavedVisibilityQuery(
+	ctx context.Context,
+	request *adminservice.UpsertSavedVisibilityQueryRequest,
+) (_ *adminservice.UpsertSavedVisibilityQueryResponse, retError error) {
+	defer log.CapturePanic(adh.logger, &retError)
+
+	if request == nil {
+		return nil, errRequestNotSet
+	}
+	if request.GetNamespace() == "" {
+		return nil, errNamespaceNotSet
+	}
+	if request.GetName() == "" {
+		return nil, serviceerror.NewInvalidArgument("Saved query name is not set.")
+	}
+	if len(request.GetName()) > adh.config.MaxIDLengthLimit() {
+		return nil, serviceerror.NewInvalidArgument("Saved query name exceeds length limit.")
+	}
+	if request.GetQuery() == "" {
+		return nil, serviceerror.NewInvalidArgument("Saved query is not set.")
+	}
+
+	namespaceName := namespace.Name(request.GetNamespace())
+	namespaceID, err := adh.namespaceRegistry.GetNamespaceID(namespaceName)
+	if err != nil {
+		return nil, err
+	}
+	// Count once to reject queries which can't be evaluated.
+	if _, err := adh.visibilityMgr.CountWorkflowExecutions(ctx, &manager.CountWorkflowExecutionsRequest{
+		NamespaceID: namespaceID,
+		Namespace:   namespaceName,
+		Query:       request.GetQuery(),
+	}); err != nil {
+		return nil, err
+	}
+
+	var savedQuery *persistencespb.SavedVisibilityQuery
+	err = adh.updateNamespaceConfig(ctx, namespaceName, func(nsConfig *persistencespb.NamespaceConfig) error {
+		now := timestamppb.New(adh.timeSource.Now())
+		existing, ok := nsConfig.SavedVisibilityQueries[request.GetName()]
+		if !ok {
+			maxQueries := adh.config.MaxSavedVisibilityQueriesPerNamespace(namespaceName.String())
+			if len(nsConfig.SavedVisibilityQueries) >= maxQueries {
+				return serviceerror.NewInvalidArgumentf("Saved query limit exceeded. Max: %v", maxQueries)
+			}
+		}
+		savedQuery = &persistencespb.SavedVisibilityQuery{
+			Name:        request.GetName(),
+			Query:       request.GetQuery(),
+			Description: request.GetDescription(),
+			CreateTime:  now,
+			UpdateTime:  now,
+			Identity:    request.GetIdentity(),
+		}
+		if ok {
+			savedQuery.CreateTime = existing.GetCreateTime()
+		}
+		if nsConfig.SavedVisibilityQueries == nil {
+			nsConfig.SavedVisibilityQueries = make(map[string]*persistencespb.SavedVisibilityQuery)
+		}
+		nsConfig.SavedVisibilityQueries[request.GetName()] = savedQuery
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &adminservice.UpsertSavedVisibilityQueryResponse{SavedQuery: savedQuery}, nil
+}
+
+// DeleteSavedVisibilityQuery deletes a named visibility query from the namespace metadata.
+func (adh *AdminHandler) DeleteSavedVisibilityQuery(
+	ctx context.Context,
+	request *adminservice.DeleteSavedVisibilityQueryRequest,
+) (_ *adminservice.DeleteSavedVisibilityQueryResponse, retError error) {
+	defer log.CapturePanic(adh.logger, &retError)
+
+	if request == nil {
+		return nil, errRequestNotSet
+	}
+	if request.GetNamespace() == "" {
+		return nil, errNamespaceNotSet
+	}
+	if request.GetName() == "" {
+		return nil, serviceerror.NewInvalidArgument("Saved query name is not set.")
+	}
+
+	err := adh.updateNamespaceConfig(ctx, namespace.Name(request.GetNamespace()), func(nsConfig *persistencespb.NamespaceConfig) error {
+		if _, ok := nsConfig.SavedVisibilityQueries[request.GetName()]; !ok {
+			return serviceerror.NewNotFoundf("Saved query %s not found.", request.GetName())
+		}
+		delete(nsConfig.SavedVisibilityQueries, request.GetName())
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &adminservice.DeleteSavedVisibilityQueryResponse{}, nil
+}
+
+// ListSavedVisibilityQueries returns the named visibility queries of the namespace ordered by name.
+func (adh *AdminHandler) ListSavedVisibilityQueries(
+	ctx context.Context,
+	request *adminservice.ListSavedVisibilityQueriesRequest,
+) (_ *adminservice.ListSavedVisibilityQueriesResponse, retError error) {
+	defer log.CapturePanic(adh.logger, &retError)
+
+	if request == nil {
+		return nil, errRequestNotSet
+	}
+	if request.GetNamespace() == "" {
+		return nil, errNamespaceNotSet
+	}
+
+	resp, err := adh.persistenceMetadataManager.GetNamespace(ctx, &persistence.GetNamespaceRequest{Name: request.GetNamespace()})
+	if err != nil {
+		return nil, err
+	}
+	savedQueries := resp.Namespace.GetConfig().GetSavedVisibilityQueries()
+	result := make([]*persistencespb.SavedVisibilityQuery, 0, len(savedQueries))
+	for _, name := range slices.Sorted(maps.Keys(savedQueries)) {
+		result = append(result, savedQueries[name])
+	}
+	return &adminservice.ListSavedVisibilityQueriesResponse{SavedQueries: result}, nil
+}
+
+// updateNamespaceConfig applies fn to the persisted config of the namespace, writes it back and replicates it to
+// the other clusters of a global namespace.
+func (adh *AdminHandler) updateNamespaceConfig(
+	ctx context.Context,
+	namespaceName namespace.Name,
+	fn func(nsConfig *persistencespb.NamespaceConfig) error,
+) error {
+	metadata, err := adh.persistenceMetadataManager.GetMetadata(ctx)
+	if err != nil {
+		return err
+	}
+	resp, err := adh.persistenceMetadataManager.GetNamespace(ctx, &persistence.GetNamespaceRequest{Name: namespaceName.String()})
+	if err != nil {
+		return err
+	}
+	existingNamespace := resp.Namespace
+	if existingNamespace.Config == nil {
+		existingNamespace.Config = &persistencespb.NamespaceConfig{}
+	}
+	if err := fn(existingNamespace.Config); err != nil {
+		return err
+	}
+	configVersion := existingNamespace.ConfigVersion + 1
+	err = adh.persistenceMetadataManager.UpdateNamespace(ctx, &persistence.UpdateNamespaceRequest{
+		Namespace: &persistencespb.NamespaceDetail{
+			Info:                        existingNamespace.Info,
+			Config:                      existingNamespace.Config,
+			ReplicationConfig:           existingNamespace.ReplicationConfig,
+			ConfigVersion:               configVersion,
+			FailoverVersion:             existingNamespace.FailoverVersion,
+			FailoverNotificationVersion: existingNamespace.FailoverNotificationVersion,
+			FailoverEndTime:             existingNamespace.FailoverEndTime,
+		},
+		IsGlobalNamespace:   resp.IsGlobalNamespace,
+		NotificationVersion: metadata.NotificationVersion,
+	})
+	if err != nil {
+		return err
+	}
+	return adh.namespaceReplicator.HandleTransmissionTask(
+		ctx,
+		enumsspb.NAMESPACE_OPERATION_UPDATE,
+		existingNamespace.Info,
+		existingNamespace.Config,
+		existingNamespace.ReplicationConfig,
+		false,
+		configVersion,
+		existingNamespace.FailoverVersion,
+		resp.IsGlobalNamespace,
+		existingNamespace.ReplicationConfig.GetFailoverHistory(),
+	)
+}
+
 func (adh *AdminHandler) unaliasAndValidateSearchAttributes(historyBatches []*commonpb.DataBlob, nsName namespace.Name) ([]*commonpb.DataBlob, error) {
 	var unaliasedBatches []*commonpb.DataBlob
 	for _, historyBatch := range historyBatches {
@@ -777,7 +1166,20 @@ func (adh *AdminHandler) DescribeMutableState(ctx context.Context, request *admi
 		return nil, err
 	}
 
-	shardID := common.WorkflowIDToHistoryShard(namespaceID.String(), request.Execution.WorkflowId, adh.numberOfHistoryShards)
+	historyResponse, err := adh.historyClient.DescribeMutableState(ctx, &historyservice.DescribeMutableStateRequest{
+		NamespaceId:     namespaceID.String(),
+		Execution:       request.Execution,
+		SkipForceReload: request.GetSkipForceReload(),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	// the workflow may not be on the shard it's routed to while its namespace is moved between shard pools
+	shardID := historyResponse.GetShardId()
+	if shardID == 0 {
+		shardID = common.WorkflowIDToHistoryShardInPools(namespaceID.String(), request.Execution.WorkflowId, adh.numberOfHistoryShards, adh.config.HistoryNamespaceShardPools())
+	}
 	shardIDStr := convert.Int32ToString(shardID)
 
 	resolver, err := adh.membershipMonitor.GetResolver(primitives.HistoryService)
@@ -790,15 +1192,6 @@ func (adh *AdminHandler) DescribeMutableState(ctx context.Context, request *admi
 	}
 
 	historyAddr := historyHost.GetAddress()
-	historyResponse, err := adh.historyClient.DescribeMutableState(ctx, &historyservice.DescribeMutableStateRequest{
-		NamespaceId:     namespaceID.String(),
-		Execution:       request.Execution,
-		SkipForceReload: request.GetSkipForceReload(),
-	})
-
-	if err != nil {
-		return nil, err
-	}
 	return &adminservice.DescribeMutableStateResponse{
 		ShardId:              shardIDStr,
 		HistoryAddr:          historyAddr,
@@ -927,9 +1320,41 @@ func (adh *AdminHandler) DescribeHistoryHost(ctx context.Context, request *admin
 		ShardIds:       resp.GetShardIds(),
 		NamespaceCache: resp.GetNamespaceCache(),
 		Address:        resp.GetAddress(),
+		ShardLoads:     resp.GetShardLoads(),
 	}, err
 }
 
+// MoveHistoryShard moves a history shard to the given history host
+func (adh *AdminHandler) MoveHistoryShard(ctx context.Context, request *adminservice.MoveHistoryShardRequest) (_ *adminservice.MoveHistoryShardResponse, retError error) {
+	defer log.CapturePanic(adh.logger, &retError)
+
+	if request == nil {
+		return nil, errRequestNotSet
+	}
+	if request.GetShardId() <= 0 || request.GetShardId() > adh.numberOfHistoryShards {
+		return nil, serviceerror.NewInvalidArgumentf("shard id must be between 1 and %d", adh.numberOfHistoryShards)
+	}
+
+	resolver, err := adh.membershipMonitor.GetResolver(primitives.HistoryService)
+	if err != nil {
+		return nil, err
+	}
+	if !slices.ContainsFunc(resolver.Members(), func(host membership.HostInfo) bool {
+		return host.GetAddress() == request.GetTargetHostAddress()
+	}) {
+		return nil, serviceerror.NewInvalidArgumentf("%q is not a history host", request.GetTargetHostAddress())
+	}
+
+	_, err = adh.historyClient.MoveShard(ctx, &historyservice.MoveShardRequest{
+		ShardId:           request.GetShardId(),
+		TargetHostAddress: request.GetTargetHostAddress(),
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &adminservice.MoveHistoryShardResponse{}, nil
+}
+
 func (adh *AdminHandler) GetWorkflowExecutionRawHistory(
 	ctx context.Context,
 	request *adminservice.GetWorkflowExecutionRawHistoryRequest,
@@ -1635,6 +2060,7 @@ func (adh *AdminHandler) DescribeTaskQueuePartition(
 
 	return &adminservice.DescribeTaskQueuePartitionResponse{
 		VersionsInfoInternal: resp.VersionsInfoInternal,
+		DispatchPause:        resp.DispatchPause,
 	}, nil
 }
 
@@ -1673,6 +2099,186 @@ func (adh *AdminHandler) ForceUnloadTaskQueuePartition(
 	}, nil
 }
 
+// PauseTaskQueueDispatch pauses dispatch of tasks of a task queue. Tasks are still accepted into the backlog.
+func (adh *AdminHandler) PauseTaskQueueDispatch(
+	ctx context.Context,
+	request *adminservice.PauseTaskQueueDispatchRequest,
+) (_ *adminservice.PauseTaskQueueDispatchResponse, err error) {
+	defer log.CapturePanic(adh.logger, &err)
+
+	if request == nil {
+		return nil, errRequestNotSet
+	}
+	if len(request.GetReason()) > adh.config.MaxIDLengthLimit() {
+		return nil, serviceerror.NewInvalidArgument("Reason length exceeds limit.")
+	}
+	resp, err := adh.updateTaskQueueDispatchPause(ctx, &matchingservice.UpdateTaskQueueDispatchPauseRequest{
+		TaskQueue:      request.GetTaskQueue(),
+		TaskQueueTypes: request.GetTaskQueueTypes(),
+		Pause:          true,
+		PriorityKeys:   request.GetPriorityKeys(),
+		Reason:         request.GetReason(),
+		Identity:       request.GetIdentity(),
+	}, request.GetNamespace())
+	if err != nil {
+		return nil, err
+	}
+	return &adminservice.PauseTaskQueueDispatchResponse{
+		DispatchPause: resp.GetDispatchPause(),
+	}, nil
+}
+
+// ResumeTaskQueueDispatch resumes dispatch of tasks paused by PauseTaskQueueDispatch.
+func (adh *AdminHandler) ResumeTaskQueueDispatch(
+	ctx context.Context,
+	request *adminservice.ResumeTaskQueueDispatchRequest,
+) (_ *adminservice.ResumeTaskQueueDispatchResponse, err error) {
+	defer log.CapturePanic(adh.logger, &err)
+
+	if request == nil {
+		return nil, errRequestNotSet
+	}
+	resp, err := adh.updateTaskQueueDispatchPause(ctx, &matchingservice.UpdateTaskQueueDispatchPauseRequest{
+		TaskQueue:      request.GetTaskQueue(),
+		TaskQueueTypes: request.GetTaskQueueTypes(),
+		Pause:          false,
+		PriorityKeys:   request.GetPriorityKeys(),
+		Identity:       request.GetIdentity(),
+	}, request.GetNamespace())
+	if err != nil {
+		return nil, err
+	}
+	return &adminservice.ResumeTaskQueueDispatchResponse{
+		DispatchPause: resp.GetDispatchPause(),
+	}, nil
+}
+
+func (adh *AdminHandler) updateTaskQueueDispatchPause(
+	ctx context.Context,
+	request *matchingservice.UpdateTaskQueueDispatchPauseRequest,
+	namespaceName string,
+) (*matchingservice.UpdateTaskQueueDispatchPauseResponse, error) {
+	if namespaceName == "" {
+		return nil, errNamespaceNotSet
+	}
+	if request.GetTaskQueue() == "" {
+		return nil, errTaskQueueNotSet
+	}
+	if len(request.GetIdentity()) > adh.config.MaxIDLengthLimit() {
+		return nil, errIdentityTooLong
+	}
+
+	namespaceID, err := adh.namespaceRegistry.GetNamespaceID(namespace.Name(namespaceName))
+	if err != nil {
+		return nil, err
+	}
+	request.NamespaceId = namespaceID.String()
+	return adh.matchingClient.UpdateTaskQueueDispatchPause(ctx, request)
+}
+
+// ListTaskQueueBacklogTasks lists the backlog tasks of a task queue partition.
+func (adh *AdminHandler) ListTaskQueueBacklogTasks(
+	ctx context.Context,
+	request *adminservice.ListTaskQueueBacklogTasksRequest,
+) (_ *adminservice.ListTaskQueueBacklogTasksResponse, err error) {
+	defer log.CapturePanic(adh.logger, &err)
+
+	if request == nil {
+		return nil, errRequestNotSet
+	}
+	namespaceID, err := adh.getTaskQueuePartitionNamespaceID(request.GetNamespace(), request.GetTaskQueuePartition())
+	if err != nil {
+		return nil, err
+	}
+	resp, err := adh.matchingClient.ListTaskQueueBacklogTasks(ctx, &matchingservice.ListTaskQueueBacklogTasksRequest{
+		NamespaceId:        namespaceID.String(),
+		TaskQueuePartition: request.GetTaskQueuePartition(),
+		PageSize:           request.GetPageSize(),
+		NextPageToken:      request.GetNextPageToken(),
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &adminservice.ListTaskQueueBacklogTasksResponse{
+		Tasks:         resp.GetTasks(),
+		NextPageToken: resp.GetNextPageToken(),
+	}, nil
+}
+
+// DeleteTaskQueueBacklogTasks deletes tasks from the backlog of a task queue partition.
+func (adh *AdminHandler) DeleteTaskQueueBacklogTasks(
+	ctx context.Context,
+	request *adminservice.DeleteTaskQueueBacklogTasksRequest,
+) (_ *adminservice.DeleteTaskQueueBacklogTasksResponse, err error) {
+	defer log.CapturePanic(adh.logger, &err)
+
+	if request == nil {
+		return nil, errRequestNotSet
+	}
+	if len(request.GetTasks()) == 0 {
+		return nil, serviceerror.NewInvalidArgument("Tasks are not set on request.")
+	}
+	namespaceID, err := adh.getTaskQueuePartitionNamespaceID(request.GetNamespace(), request.GetTaskQueuePartition())
+	if err != nil {
+		return nil, err
+	}
+	_, err = adh.matchingClient.DeleteTaskQueueBacklogTasks(ctx, &matchingservice.DeleteTaskQueueBacklogTasksRequest{
+		NamespaceId:        namespaceID.String(),
+		TaskQueuePartition: request.GetTaskQueuePartition(),
+		Tasks:              request.GetTasks(),
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &adminservice.DeleteTaskQueueBacklogTasksResponse{}, nil
+}
+
+// MoveTaskQueueBacklogTasks moves a page of backlog tasks of a task queue partition to another task queue.
+func (adh *AdminHandler) MoveTaskQueueBacklogTasks(
+	ctx context.Context,
+	request *adminservice.MoveTaskQueueBacklogTasksRequest,
+) (_ *adminservice.MoveTaskQueueBacklogTasksResponse, err error) {
+	defer log.CapturePanic(adh.logger, &err)
+
+	if request == nil {
+		return nil, errRequestNotSet
+	}
+	if request.GetDestinationTaskQueue() == "" {
+		return nil, serviceerror.NewInvalidArgument("DestinationTaskQueue is not set on request.")
+	}
+	namespaceID, err := adh.getTaskQueuePartitionNamespaceID(request.GetNamespace(), request.GetTaskQueuePartition())
+	if err != nil {
+		return nil, err
+	}
+	resp, err := adh.matchingClient.MoveTaskQueueBacklogTasks(ctx, &matchingservice.MoveTaskQueueBacklogTasksRequest{
+		NamespaceId:          namespaceID.String(),
+		TaskQueuePartition:   request.GetTaskQueuePartition(),
+		DestinationTaskQueue: request.GetDestinationTaskQueue(),
+		PageSize:             request.GetPageSize(),
+		NextPageToken:        request.GetNextPageToken(),
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &adminservice.MoveTaskQueueBacklogTasksResponse{
+		MovedTasks:    resp.GetMovedTasks(),
+		NextPageToken: resp.GetNextPageToken(),
+	}, nil
+}
+
+func (adh *AdminHandler) getTaskQueuePartitionNamespaceID(
+	namespaceName string,
+	partition *taskqueuespb.TaskQueuePartition,
+) (namespace.ID, error) {
+	if namespaceName == "" {
+		return "", errNamespaceNotSet
+	}
+	if partition.GetTaskQueue() == "" {
+		return "", errTaskQueueNotSet
+	}
+	return adh.namespaceRegistry.GetNamespaceID(namespace.Name(namespaceName))
+}
+
 func (adh *AdminHandler) DeleteWorkflowExecution(
 	ctx context.Context,
 	request *adminservice.DeleteWorkflowExecutionRequest,
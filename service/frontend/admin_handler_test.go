@@ -4,10 +4,13 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"maps"
 	"math/rand"
+	"slices"
 	"strings"
 	"sync"
 	"testing"
+	"time"
 
 	"github.com/pborman/uuid"
 	"github.com/stretchr/testify/assert"
@@ -30,6 +33,7 @@ import (
 	"go.temporal.io/server/api/matchingservice/v1"
 	"go.temporal.io/server/api/matchingservicemock/v1"
 	persistencespb "go.temporal.io/server/api/persistence/v1"
+	replicationspb "go.temporal.io/server/api/replication/v1"
 	taskqueuespb "go.temporal.io/server/api/taskqueue/v1"
 	clientmocks "go.temporal.io/server/client"
 	historyclient "go.temporal.io/server/client/history"
@@ -60,6 +64,7 @@ import (
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/health"
 	"google.golang.org/grpc/metadata"
+	"google.golang.org/protobuf/types/known/timestamppb"
 )
 
 type (
@@ -144,6 +149,9 @@ func (s *adminHandlerSuite) SetupTest() {
 		SearchAttributesTotalSizeLimit:        dynamicconfig.GetIntPropertyFnFilteredByNamespace(10),
 		VisibilityAllowList:                   dynamicconfig.GetBoolPropertyFnFilteredByNamespace(false),
 		SuppressErrorSetSystemSearchAttribute: dynamicconfig.GetBoolPropertyFnFilteredByNamespace(false),
+		VisibilityAggregationMaxGroups:        dynamicconfig.GetIntPropertyFnFilteredByNamespace(100),
+		MaxIDLengthLimit:                      dynamicconfig.GetIntPropertyFn(100),
+		MaxSavedVisibilityQueriesPerNamespace: dynamicconfig.GetIntPropertyFnFilteredByNamespace(2),
 	}
 	args := NewAdminHandlerArgs{
 		persistenceConfig,
@@ -1629,6 +1637,385 @@ func (s *adminHandlerSuite) TestAddDLQTasks_Err() {
 	s.ErrorIs(err, assert.AnError)
 }
 
+func (s *adminHandlerSuite) TestForkWorkflowExecution() {
+	newNamespaceID := namespace.ID(uuid.New())
+	s.mockNamespaceCache.EXPECT().GetNamespaceID(s.namespace).Return(s.namespaceID, nil)
+	s.mockNamespaceCache.EXPECT().GetNamespaceID(namespace.Name("new namespace")).Return(newNamespaceID, nil)
+	s.mockHistoryClient.EXPECT().ForkWorkflowExecution(gomock.Any(), gomock.Any()).DoAndReturn(
+		func(_ context.Context, request *historyservice.ForkWorkflowExecutionRequest, _ ...grpc.CallOption) (*historyservice.ForkWorkflowExecutionResponse, error) {
+			s.Equal(newNamespaceID.String(), request.GetNamespaceId())
+			s.Equal(s.namespaceID.String(), request.GetSourceNamespaceId())
+			s.Equal("new workflow ID", request.GetForkRequest().GetNewWorkflowId())
+			s.NotEmpty(request.GetForkRequest().GetRequestId())
+			return &historyservice.ForkWorkflowExecutionResponse{RunId: "new run ID"}, nil
+		},
+	)
+
+	resp, err := s.handler.ForkWorkflowExecution(context.Background(), &adminservice.ForkWorkflowExecutionRequest{
+		Namespace:                 s.namespace.String(),
+		Execution:                 &commonpb.WorkflowExecution{WorkflowId: "workflow ID"},
+		WorkflowTaskFinishEventId: 4,
+		NewNamespace:              "new namespace",
+		NewWorkflowId:             "new workflow ID",
+	})
+	s.NoError(err)
+	s.Equal("new run ID", resp.GetRunId())
+
+	_, err = s.handler.ForkWorkflowExecution(context.Background(), &adminservice.ForkWorkflowExecutionRequest{
+		Namespace: s.namespace.String(),
+		Execution: &commonpb.WorkflowExecution{WorkflowId: "workflow ID"},
+	})
+	s.ErrorIs(err, errWorkflowIDNotSet)
+}
+
+func (s *adminHandlerSuite) TestRedactWorkflowExecution() {
+	s.mockNamespaceCache.EXPECT().GetNamespaceID(s.namespace).Return(s.namespaceID, nil)
+	s.mockHistoryClient.EXPECT().RedactWorkflowExecution(gomock.Any(), gomock.Any()).DoAndReturn(
+		func(_ context.Context, request *historyservice.RedactWorkflowExecutionRequest, _ ...grpc.CallOption) (*historyservice.RedactWorkflowExecutionResponse, error) {
+			s.Equal(s.namespaceID.String(), request.GetNamespaceId())
+			s.Equal([]int64{5}, request.GetRedactRequest().GetEventIds())
+			s.Equal([]string{"email"}, request.GetRedactRequest().GetMemoKeys())
+			return &historyservice.RedactWorkflowExecutionResponse{}, nil
+		},
+	)
+
+	_, err := s.handler.RedactWorkflowExecution(context.Background(), &adminservice.RedactWorkflowExecutionRequest{
+		Namespace: s.namespace.String(),
+		Execution: &commonpb.WorkflowExecution{WorkflowId: "workflow ID", RunId: uuid.New()},
+		EventIds:  []int64{5},
+		MemoKeys:  []string{"email"},
+	})
+	s.NoError(err)
+
+	_, err = s.handler.RedactWorkflowExecution(context.Background(), &adminservice.RedactWorkflowExecutionRequest{
+		Namespace: s.namespace.String(),
+	})
+	s.Error(err)
+}
+
+func (s *adminHandlerSuite) TestCheckMutableState() {
+	inconsistencies := []*adminservice.MutableStateInconsistency{{
+		Field:         "next_event_id",
+		DatabaseValue: "12",
+		HistoryValue:  "10",
+	}}
+	s.mockNamespaceCache.EXPECT().GetNamespaceID(s.namespace).Return(s.namespaceID, nil)
+	s.mockHistoryClient.EXPECT().CheckMutableState(gomock.Any(), gomock.Any()).DoAndReturn(
+		func(_ context.Context, request *historyservice.CheckMutableStateRequest, _ ...grpc.CallOption) (*historyservice.CheckMutableStateResponse, error) {
+			s.Equal(s.namespaceID.String(), request.GetNamespaceId())
+			s.Equal("workflow ID", request.GetExecution().GetWorkflowId())
+			return &historyservice.CheckMutableStateResponse{Inconsistencies: inconsistencies}, nil
+		},
+	)
+
+	resp, err := s.handler.CheckMutableState(context.Background(), &adminservice.CheckMutableStateRequest{
+		Namespace: s.namespace.String(),
+		Execution: &commonpb.WorkflowExecution{WorkflowId: "workflow ID"},
+	})
+	s.NoError(err)
+	s.Equal(inconsistencies, resp.GetInconsistencies())
+
+	_, err = s.handler.CheckMutableState(context.Background(), &adminservice.CheckMutableStateRequest{
+		Namespace: s.namespace.String(),
+	})
+	s.ErrorIs(err, errExecutionNotSet)
+}
+
+func (s *adminHandlerSuite) TestGetWorkflowExecutionTimeline() {
+	timeline := &adminservice.WorkflowExecutionTimeline{
+		Spans:        []*adminservice.TimelineSpan{{EventId: 2}},
+		CriticalPath: []int32{0},
+	}
+	s.mockNamespaceCache.EXPECT().GetNamespaceID(s.namespace).Return(s.namespaceID, nil)
+	s.mockHistoryClient.EXPECT().GetWorkflowExecutionTimeline(gomock.Any(), gomock.Any()).DoAndReturn(
+		func(_ context.Context, request *historyservice.GetWorkflowExecutionTimelineRequest, _ ...grpc.CallOption) (*historyservice.GetWorkflowExecutionTimelineResponse, error) {
+			s.Equal(s.namespaceID.String(), request.GetNamespaceId())
+			s.Equal("workflow ID", request.GetRequest().GetExecution().GetWorkflowId())
+			return &historyservice.GetWorkflowExecutionTimelineResponse{Timeline: timeline}, nil
+		},
+	)
+
+	resp, err := s.handler.GetWorkflowExecutionTimeline(context.Background(), &adminservice.GetWorkflowExecutionTimelineRequest{
+		Namespace: s.namespace.String(),
+		Execution: &commonpb.WorkflowExecution{WorkflowId: "workflow ID"},
+	})
+	s.NoError(err)
+	s.Equal(timeline, resp.GetTimeline())
+
+	_, err = s.handler.GetWorkflowExecutionTimeline(context.Background(), &adminservice.GetWorkflowExecutionTimelineRequest{
+		Namespace: s.namespace.String(),
+	})
+	s.ErrorIs(err, errExecutionNotSet)
+}
+
+func (s *adminHandlerSuite) TestGetWorkflowHistoryBudget() {
+	usage := &adminservice.WorkflowHistoryBudgetUsage{
+		WorkflowType:    "workflow type",
+		HasBudget:       true,
+		HistoryCount:    90,
+		MaxHistoryCount: 100,
+		ConsumedRatio:   0.9,
+		Actions:         []enumsspb.HistoryBudgetAction{enumsspb.HISTORY_BUDGET_ACTION_WARN},
+	}
+	s.mockNamespaceCache.EXPECT().GetNamespaceID(s.namespace).Return(s.namespaceID, nil)
+	s.mockHistoryClient.EXPECT().GetWorkflowHistoryBudget(gomock.Any(), gomock.Any()).DoAndReturn(
+		func(_ context.Context, request *historyservice.GetWorkflowHistoryBudgetRequest, _ ...grpc.CallOption) (*historyservice.GetWorkflowHistoryBudgetResponse, error) {
+			s.Equal(s.namespaceID.String(), request.GetNamespaceId())
+			s.Equal("workflow ID", request.GetRequest().GetExecution().GetWorkflowId())
+			return &historyservice.GetWorkflowHistoryBudgetResponse{Usage: usage}, nil
+		},
+	)
+
+	resp, err := s.handler.GetWorkflowHistoryBudget(context.Background(), &adminservice.GetWorkflowHistoryBudgetRequest{
+		Namespace: s.namespace.String(),
+		Execution: &commonpb.WorkflowExecution{WorkflowId: "workflow ID"},
+	})
+	s.NoError(err)
+	s.Equal(usage, resp.GetUsage())
+
+	_, err = s.handler.GetWorkflowHistoryBudget(context.Background(), &adminservice.GetWorkflowHistoryBudgetRequest{
+		Namespace: s.namespace.String(),
+	})
+	s.ErrorIs(err, errExecutionNotSet)
+}
+
+func (s *adminHandlerSuite) TestAggregateWorkflowExecutions() {
+	aggregations := []*adminservice.VisibilityAggregation{
+		{
+			Function: enumsspb.VISIBILITY_AGGREGATION_FUNCTION_AVG,
+			Field:    "ExecutionDuration",
+		},
+	}
+	groups := []*adminservice.VisibilityAggregationGroup{
+		{
+			Count:   2,
+			Results: []*adminservice.VisibilityAggregationResult{{HasValue: true, Value: 1.5}},
+		},
+	}
+	s.mockNamespaceCache.EXPECT().GetNamespaceID(s.namespace).Return(s.namespaceID, nil)
+	s.mockVisibilityMgr.EXPECT().AggregateWorkflowExecutions(gomock.Any(), &manager.AggregateWorkflowExecutionsRequest{
+		NamespaceID:  s.namespaceID,
+		Namespace:    s.namespace,
+		Query:        "ExecutionStatus = 'Completed'",
+		GroupBy:      []string{"WorkflowType"},
+		Aggregations: aggregations,
+		MaxGroups:    100,
+	}).Return(&manager.AggregateWorkflowExecutionsResponse{Groups: groups}, nil)
+
+	resp, err := s.handler.AggregateWorkflowExecutions(context.Background(), &adminservice.AggregateWorkflowExecutionsRequest{
+		Namespace:    s.namespace.String(),
+		Query:        "ExecutionStatus = 'Completed'",
+		GroupBy:      []string{"WorkflowType"},
+		Aggregations: aggregations,
+	})
+	s.NoError(err)
+	s.Equal(groups, resp.GetGroups())
+
+	_, err = s.handler.AggregateWorkflowExecutions(context.Background(), &adminservice.AggregateWorkflowExecutionsRequest{})
+	s.ErrorIs(err, errNamespaceNotSet)
+}
+
+func (s *adminHandlerSuite) TestUpsertSavedVisibilityQuery() {
+	existing := &persistencespb.SavedVisibilityQuery{
+		Name:       "stuck",
+		Query:      "ExecutionStatus = 'Running'",
+		CreateTime: timestamppb.New(time.Unix(1000, 0)),
+		UpdateTime: timestamppb.New(time.Unix(1000, 0)),
+	}
+	s.mockNamespaceCache.EXPECT().GetNamespaceID(s.namespace).Return(s.namespaceID, nil)
+	s.mockVisibilityMgr.EXPECT().CountWorkflowExecutions(gomock.Any(), &manager.CountWorkflowExecutionsRequest{
+		NamespaceID: s.namespaceID,
+		Namespace:   s.namespace,
+		Query:       "ExecutionStatus = 'Running' AND StartTime < '2024-01-01T00:00:00Z'",
+	}).Return(&manager.CountWorkflowExecutionsResponse{Count: 3}, nil)
+	s.mockResource.MetadataMgr.EXPECT().GetMetadata(gomock.Any()).Return(&persistence.GetMetadataResponse{NotificationVersion: 5}, nil)
+	s.mockResource.MetadataMgr.EXPECT().GetNamespace(gomock.Any(), &persistence.GetNamespaceRequest{Name: s.namespace.String()}).Return(
+		&persistence.GetNamespaceResponse{
+			Namespace: &persistencespb.NamespaceDetail{
+				Info: &persistencespb.NamespaceInfo{Id: s.namespaceID.String(), Name: s.namespace.String()},
+				Config: &persistencespb.NamespaceConfig{
+					SavedVisibilityQueries: map[string]*persistencespb.SavedVisibilityQuery{"stuck": existing},
+				},
+				ConfigVersion: 7,
+			},
+		}, nil)
+	s.mockResource.MetadataMgr.EXPECT().UpdateNamespace(gomock.Any(), gomock.Any()).DoAndReturn(
+		func(_ context.Context, request *persistence.UpdateNamespaceRequest) error {
+			s.Equal(int64(8), request.Namespace.ConfigVersion)
+			s.Equal(int64(5), request.NotificationVersion)
+			saved := request.Namespace.Config.SavedVisibilityQueries["stuck"]
+			s.Equal("ExecutionStatus = 'Running' AND StartTime < '2024-01-01T00:00:00Z'", saved.GetQuery())
+			s.Equal(existing.GetCreateTime().AsTime(), saved.GetCreateTime().AsTime())
+			s.True(saved.GetUpdateTime().AsTime().After(existing.GetUpdateTime().AsTime()))
+			return nil
+		})
+
+	resp, err := s.handler.UpsertSavedVisibilityQuery(context.Background(), &adminservice.UpsertSavedVisibilityQueryRequest{
+		Namespace: s.namespace.String(),
+		Name:      "stuck",
+		Query:     "ExecutionStatus = 'Running' AND StartTime < '2024-01-01T00:00:00Z'",
+		Identity:  "operator",
+	})
+	s.NoError(err)
+	s.Equal("stuck", resp.GetSavedQuery().GetName())
+	s.Equal("operator", resp.GetSavedQuery().GetIdentity())
+
+	_, err = s.handler.UpsertSavedVisibilityQuery(context.Background(), &adminservice.UpsertSavedVisibilityQueryRequest{
+		Namespace: s.namespace.String(),
+		Query:     "ExecutionStatus = 'Running'",
+	})
+	var invalidArgument *serviceerror.InvalidArgument
+	s.ErrorAs(err, &invalidArgument)
+}
+
+func (s *adminHandlerSuite) TestUpsertSavedVisibilityQuery_LimitExceeded() {
+	savedQueries := map[string]*persistencespb.SavedVisibilityQuery{
+		"a": {Name: "a", Query: "WorkflowType = 'a'"},
+		"b": {Name: "b", Query: "WorkflowType = 'b'"},
+	}
+	s.mockNamespaceCache.EXPECT().GetNamespaceID(s.namespace).Return(s.namespaceID, nil)
+	s.mockVisibilityMgr.EXPECT().CountWorkflowExecutions(gomock.Any(), gomock.Any()).Return(&manager.CountWorkflowExecutionsResponse{}, nil)
+	s.mockResource.MetadataMgr.EXPECT().GetMetadata(gomock.Any()).Return(&persistence.GetMetadataResponse{}, nil)
+	s.mockResource.MetadataMgr.EXPECT().GetNamespace(gomock.Any(), gomock.Any()).Return(
+		&persistence.GetNamespaceResponse{
+			Namespace: &persistencespb.NamespaceDetail{
+				Info:   &persistencespb.NamespaceInfo{Id: s.namespaceID.String(), Name: s.namespace.String()},
+				Config: &persistencespb.NamespaceConfig{SavedVisibilityQueries: savedQueries},
+			},
+		}, nil)
+
+	_, err := s.handler.UpsertSavedVisibilityQuery(context.Background(), &adminservice.UpsertSavedVisibilityQueryRequest{
+		Namespace: s.namespace.String(),
+		Name:      "c",
+		Query:     "WorkflowType = 'c'",
+	})
+	var invalidArgument *serviceerror.InvalidArgument
+	s.ErrorAs(err, &invalidArgument)
+}
+
+func (s *adminHandlerSuite) TestDeleteSavedVisibilityQuery() {
+	s.mockResource.MetadataMgr.EXPECT().GetMetadata(gomock.Any()).Return(&persistence.GetMetadataResponse{}, nil).Times(2)
+	s.mockResource.MetadataMgr.EXPECT().GetNamespace(gomock.Any(), &persistence.GetNamespaceRequest{Name: s.namespace.String()}).DoAndReturn(
+		func(_ context.Context, _ *persistence.GetNamespaceRequest) (*persistence.GetNamespaceResponse, error) {
+			return &persistence.GetNamespaceResponse{
+				Namespace: &persistencespb.NamespaceDetail{
+					Info: &persistencespb.NamespaceInfo{Id: s.namespaceID.String(), Name: s.namespace.String()},
+					Config: &persistencespb.NamespaceConfig{
+						SavedVisibilityQueries: map[string]*persistencespb.SavedVisibilityQuery{
+							"stuck": {Name: "stuck", Query: "ExecutionStatus = 'Running'"},
+						},
+					},
+				},
+			}, nil
+		}).Times(2)
+	s.mockResource.MetadataMgr.EXPECT().UpdateNamespace(gomock.Any(), gomock.Any()).DoAndReturn(
+		func(_ context.Context, request *persistence.UpdateNamespaceRequest) error {
+			s.Empty(request.Namespace.Config.SavedVisibilityQueries)
+			return nil
+		})
+
+	_, err := s.handler.DeleteSavedVisibilityQuery(context.Background(), &adminservice.DeleteSavedVisibilityQueryRequest{
+		Namespace: s.namespace.String(),
+		Name:      "stuck",
+	})
+	s.NoError(err)
+
+	_, err = s.handler.DeleteSavedVisibilityQuery(context.Background(), &adminservice.DeleteSavedVisibilityQueryRequest{
+		Namespace: s.namespace.String(),
+		Name:      "missing",
+	})
+	var notFound *serviceerror.NotFound
+	s.ErrorAs(err, &notFound)
+}
+
+func (s *adminHandlerSuite) TestDeleteSavedVisibilityQuery_GlobalNamespace() {
+	s.mockResource.MetadataMgr.EXPECT().GetMetadata(gomock.Any()).Return(&persistence.GetMetadataResponse{}, nil)
+	s.mockResource.MetadataMgr.EXPECT().GetNamespace(gomock.Any(), &persistence.GetNamespaceRequest{Name: s.namespace.String()}).Return(
+		&persistence.GetNamespaceResponse{
+			Namespace: &persistencespb.NamespaceDetail{
+				Info: &persistencespb.NamespaceInfo{Id: s.namespaceID.String(), Name: s.namespace.String()},
+				Config: &persistencespb.NamespaceConfig{
+					SavedVisibilityQueries: map[string]*persistencespb.SavedVisibilityQuery{
+						"stuck": {Name: "stuck", Query: "ExecutionStatus = 'Running'"},
+						"old":   {Name: "old", Query: "StartTime < '2024-01-01T00:00:00Z'"},
+					},
+				},
+				ReplicationConfig: &persistencespb.NamespaceReplicationConfig{
+					ActiveClusterName: "active",
+					Clusters:          []string{"active", "standby"},
+				},
+				ConfigVersion: 3,
+			},
+			IsGlobalNamespace: true,
+		}, nil)
+	s.mockResource.MetadataMgr.EXPECT().UpdateNamespace(gomock.Any(), gomock.Any()).Return(nil)
+	s.mockProducer.EXPECT().Publish(gomock.Any(), gomock.Any()).DoAndReturn(
+		func(_ context.Context, task *replicationspb.ReplicationTask) error {
+			attributes := task.GetNamespaceTaskAttributes()
+			s.Equal(int64(4), attributes.GetConfigVersion())
+			s.Equal([]string{"old"}, slices.Collect(maps.Keys(attributes.GetSavedVisibilityQueries())))
+			return nil
+		})
+
+	_, err := s.handler.DeleteSavedVisibilityQuery(context.Background(), &adminservice.DeleteSavedVisibilityQueryRequest{
+		Namespace: s.namespace.String(),
+		Name:      "stuck",
+	})
+	s.NoError(err)
+}
+
+func (s *adminHandlerSuite) TestListSavedVisibilityQueries() {
+	s.mockResource.MetadataMgr.EXPECT().GetNamespace(gomock.Any(), &persistence.GetNamespaceRequest{Name: s.namespace.String()}).Return(
+		&persistence.GetNamespaceResponse{
+			Namespace: &persistencespb.NamespaceDetail{
+				Info: &persistencespb.NamespaceInfo{Id: s.namespaceID.String(), Name: s.namespace.String()},
+				Config: &persistencespb.NamespaceConfig{
+					SavedVisibilityQueries: map[string]*persistencespb.SavedVisibilityQuery{
+						"b": {Name: "b", Query: "WorkflowType = 'b'"},
+						"a": {Name: "a", Query: "WorkflowType = 'a'"},
+					},
+				},
+			},
+		}, nil)
+
+	resp, err := s.handler.ListSavedVisibilityQueries(context.Background(), &adminservice.ListSavedVisibilityQueriesRequest{
+		Namespace: s.namespace.String(),
+	})
+	s.NoError(err)
+	s.Len(resp.GetSavedQueries(), 2)
+	s.Equal("a", resp.GetSavedQueries()[0].GetName())
+	s.Equal("b", resp.GetSavedQueries()[1].GetName())
+}
+
+func (s *adminHandlerSuite) TestMoveHistoryShard() {
+	hostAddress := "127.0.0.1:7234"
+	s.mockResource.HistoryServiceResolver.EXPECT().Members().Return([]membership.HostInfo{
+		membership.NewHostInfoFromAddress(hostAddress),
+	}).AnyTimes()
+
+	_, err := s.handler.MoveHistoryShard(context.Background(), &adminservice.MoveHistoryShardRequest{
+		ShardId:           2,
+		TargetHostAddress: hostAddress,
+	})
+	s.IsType(&serviceerror.InvalidArgument{}, err)
+
+	_, err = s.handler.MoveHistoryShard(context.Background(), &adminservice.MoveHistoryShardRequest{
+		ShardId:           1,
+		TargetHostAddress: "127.0.0.1:1",
+	})
+	s.IsType(&serviceerror.InvalidArgument{}, err)
+
+	s.mockHistoryClient.EXPECT().MoveShard(gomock.Any(), &historyservice.MoveShardRequest{
+		ShardId:           1,
+		TargetHostAddress: hostAddress,
+	}).Return(&historyservice.MoveShardResponse{}, nil)
+	_, err = s.handler.MoveHistoryShard(context.Background(), &adminservice.MoveHistoryShardRequest{
+		ShardId:           1,
+		TargetHostAddress: hostAddress,
+	})
+	s.NoError(err)
+}
+
 func (s *adminHandlerSuite) TestListQueues_Ok() {
 	s.mockHistoryClient.EXPECT().ListQueues(gomock.Any(), &historyservice.ListQueuesRequest{
 		QueueType:     int32(persistence.QueueTypeHistoryDLQ),
@@ -1717,6 +2104,105 @@ func (s *adminHandlerSuite) TestForceUnloadTaskQueuePartition() {
 	s.True(resp.WasLoaded)
 }
 
+func (s *adminHandlerSuite) TestPauseAndResumeTaskQueueDispatch() {
+	handler := s.handler
+	ctx := context.Background()
+	s.mockNamespaceCache.EXPECT().GetNamespaceID(gomock.Any()).Return(s.namespaceID, nil).AnyTimes()
+
+	_, err := handler.PauseTaskQueueDispatch(ctx, &adminservice.PauseTaskQueueDispatchRequest{
+		Namespace: s.namespace.String(),
+	})
+	s.Equal(errTaskQueueNotSet, err)
+
+	pause := &persistencespb.TaskQueueDispatchPause{PriorityKeys: []int32{1}, Reason: "outage", Identity: "operator"}
+	s.mockMatchingClient.EXPECT().UpdateTaskQueueDispatchPause(ctx, &matchingservice.UpdateTaskQueueDispatchPauseRequest{
+		NamespaceId:    s.namespaceID.String(),
+		TaskQueue:      "hello-world",
+		TaskQueueTypes: []enumspb.TaskQueueType{enumspb.TASK_QUEUE_TYPE_ACTIVITY},
+		Pause:          true,
+		PriorityKeys:   []int32{1},
+		Reason:         "outage",
+		Identity:       "operator",
+	}).Return(&matchingservice.UpdateTaskQueueDispatchPauseResponse{
+		DispatchPause: map[int32]*persistencespb.TaskQueueDispatchPause{int32(enumspb.TASK_QUEUE_TYPE_ACTIVITY): pause},
+	}, nil)
+	pauseResp, err := handler.PauseTaskQueueDispatch(ctx, &adminservice.PauseTaskQueueDispatchRequest{
+		Namespace:      s.namespace.String(),
+		TaskQueue:      "hello-world",
+		TaskQueueTypes: []enumspb.TaskQueueType{enumspb.TASK_QUEUE_TYPE_ACTIVITY},
+		PriorityKeys:   []int32{1},
+		Reason:         "outage",
+		Identity:       "operator",
+	})
+	s.NoError(err)
+	s.Equal(pause, pauseResp.GetDispatchPause()[int32(enumspb.TASK_QUEUE_TYPE_ACTIVITY)])
+
+	s.mockMatchingClient.EXPECT().UpdateTaskQueueDispatchPause(ctx, &matchingservice.UpdateTaskQueueDispatchPauseRequest{
+		NamespaceId: s.namespaceID.String(),
+		TaskQueue:   "hello-world",
+		Identity:    "operator",
+	}).Return(&matchingservice.UpdateTaskQueueDispatchPauseResponse{}, nil)
+	resumeResp, err := handler.ResumeTaskQueueDispatch(ctx, &adminservice.ResumeTaskQueueDispatchRequest{
+		Namespace: s.namespace.String(),
+		TaskQueue: "hello-world",
+		Identity:  "operator",
+	})
+	s.NoError(err)
+	s.Empty(resumeResp.GetDispatchPause())
+}
+
+func (s *adminHandlerSuite) TestDeleteAndMoveTaskQueueBacklogTasks() {
+	handler := s.handler
+	ctx := context.Background()
+	s.mockNamespaceCache.EXPECT().GetNamespaceID(gomock.Any()).Return(s.namespaceID, nil).AnyTimes()
+	partition := &taskqueuespb.TaskQueuePartition{
+		TaskQueue:     "hello-world",
+		TaskQueueType: enumspb.TASK_QUEUE_TYPE_ACTIVITY,
+	}
+	keys := []*taskqueuespb.BacklogTaskKey{{TaskId: 42}}
+
+	_, err := handler.DeleteTaskQueueBacklogTasks(ctx, &adminservice.DeleteTaskQueueBacklogTasksRequest{
+		Namespace: s.namespace.String(),
+		Tasks:     keys,
+	})
+	s.Equal(errTaskQueueNotSet, err)
+
+	s.mockMatchingClient.EXPECT().DeleteTaskQueueBacklogTasks(ctx, &matchingservice.DeleteTaskQueueBacklogTasksRequest{
+		NamespaceId:        s.namespaceID.String(),
+		TaskQueuePartition: partition,
+		Tasks:              keys,
+	}).Return(&matchingservice.DeleteTaskQueueBacklogTasksResponse{}, nil)
+	_, err = handler.DeleteTaskQueueBacklogTasks(ctx, &adminservice.DeleteTaskQueueBacklogTasksRequest{
+		Namespace:          s.namespace.String(),
+		TaskQueuePartition: partition,
+		Tasks:              keys,
+	})
+	s.NoError(err)
+
+	_, err = handler.MoveTaskQueueBacklogTasks(ctx, &adminservice.MoveTaskQueueBacklogTasksRequest{
+		Namespace:          s.namespace.String(),
+		TaskQueuePartition: partition,
+	})
+	s.Error(err)
+
+	moved := []*taskqueuespb.BacklogTaskInfo{{Key: keys[0], WorkflowId: "wf"}}
+	s.mockMatchingClient.EXPECT().MoveTaskQueueBacklogTasks(ctx, &matchingservice.MoveTaskQueueBacklogTasksRequest{
+		NamespaceId:          s.namespaceID.String(),
+		TaskQueuePartition:   partition,
+		DestinationTaskQueue: "hello-world-fixed",
+		PageSize:             10,
+	}).Return(&matchingservice.MoveTaskQueueBacklogTasksResponse{MovedTasks: moved}, nil)
+	moveResp, err := handler.MoveTaskQueueBacklogTasks(ctx, &adminservice.MoveTaskQueueBacklogTasksRequest{
+		Namespace:            s.namespace.String(),
+		TaskQueuePartition:   partition,
+		DestinationTaskQueue: "hello-world-fixed",
+		PageSize:             10,
+	})
+	s.NoError(err)
+	s.Equal(moved, moveResp.GetMovedTasks())
+	s.Empty(moveResp.GetNextPageToken())
+}
+
 func (s *adminHandlerSuite) TestDescribeTaskQueuePartition() {
 	handler := s.handler
 	ctx := context.Background()
@@ -43,6 +43,7 @@ type HTTPAPIServer struct {
 	listener                      net.Listener
 	logger                        log.Logger
 	serveMux                      *runtime.ServeMux
+	workflowClient                workflowservice.WorkflowServiceClient
 	stopped                       chan struct{}
 	allowedHosts                  *dynamicconfig.GlobalCachedTypedValue[*regexp.Regexp]
 	matchAdditionalHeaders        map[string]bool
@@ -155,11 +156,12 @@ func NewHTTPAPIServer(
 
 	// Create serve mux
 	h.serveMux = runtime.NewServeMux(opts...)
+	h.workflowClient = workflowservice.NewWorkflowServiceClient(clientConn)
 
 	err = workflowservice.RegisterWorkflowServiceHandlerClient(
 		context.Background(),
 		h.serveMux,
-		workflowservice.NewWorkflowServiceClient(clientConn),
+		h.workflowClient,
 	)
 	if err != nil {
 		return nil, fmt.Errorf("failed registering workflowservice HTTP API handler: %w", err)
@@ -174,6 +176,8 @@ func NewHTTPAPIServer(
 		return nil, fmt.Errorf("failed registering operatorservice HTTP API handler: %w", err)
 	}
 
+	// Streaming routes are served outside of the gRPC gateway, which only supports unary calls.
+	router.Path(historyStreamPath).Methods(http.MethodGet).HandlerFunc(h.streamWorkflowHistory)
 	// Set the / handler as our function that wraps serve mux.
 	router.PathPrefix("/").HandlerFunc(h.serveHTTP)
 	// Register the router as the HTTP server handler.
@@ -252,23 +256,26 @@ func (h *HTTPAPIServer) serveHTTP(w http.ResponseWriter, r *http.Request) {
 		r.Header.Set("Accept", "application/json"+acceptHeaderSuffix)
 	}
 
-	// Put the TLS info on the peer context
-	if r.TLS != nil {
-		var addr net.Addr
-		if conn, _ := r.Context().Value(httpRemoteAddrContextKey{}).(net.Conn); conn != nil {
-			addr = conn.RemoteAddr()
-		}
-		r = r.WithContext(peer.NewContext(r.Context(), &peer.Peer{
-			Addr: addr,
-			AuthInfo: credentials.TLSInfo{
-				State:          *r.TLS,
-				CommonAuthInfo: credentials.CommonAuthInfo{SecurityLevel: credentials.PrivacyAndIntegrity},
-			},
-		}))
-	}
-
 	// Call gRPC gateway mux
-	h.serveMux.ServeHTTP(w, r)
+	h.serveMux.ServeHTTP(w, withTLSPeer(r))
+}
+
+// withTLSPeer puts the TLS info of the request on the peer context.
+func withTLSPeer(r *http.Request) *http.Request {
+	if r.TLS == nil {
+		return r
+	}
+	var addr net.Addr
+	if conn, _ := r.Context().Value(httpRemoteAddrContextKey{}).(net.Conn); conn != nil {
+		addr = conn.RemoteAddr()
+	}
+	return r.WithContext(peer.NewContext(r.Context(), &peer.Peer{
+		Addr: addr,
+		AuthInfo: credentials.TLSInfo{
+			State:          *r.TLS,
+			CommonAuthInfo: credentials.CommonAuthInfo{SecurityLevel: credentials.PrivacyAndIntegrity},
+		},
+	}))
 }
 
 func (h *HTTPAPIServer) allowedHostsMiddleware(hf runtime.HandlerFunc) runtime.HandlerFunc {
@@ -0,0 +1,178 @@
+package frontend
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strconv"
+	"time"
+
+	"github.com/gorilla/mux"
+	"github.com/grpc-ecosystem/grpc-gateway/v2/runtime"
+	commonpb "go.temporal.io/api/common/v1"
+	enumspb "go.temporal.io/api/enums/v1"
+	"go.temporal.io/api/serviceerror"
+	"go.temporal.io/api/workflowservice/v1"
+	"go.temporal.io/server/client/frontend"
+	"go.temporal.io/server/common"
+	"go.temporal.io/server/common/log/tag"
+)
+
+const (
+	// historyStreamPath is the HTTP API route streaming the history of a workflow as Server-Sent Events.
+	historyStreamPath = "/api/v1/namespaces/{namespace}/workflows/{workflow_id}/history-stream"
+
+	historyStreamMethod = "/temporal.api.workflowservice.v1.WorkflowService/GetWorkflowExecutionHistory"
+
+	historyStreamEventHistory = "history"
+	historyStreamEventError   = "error"
+	historyStreamEventEnd     = "end"
+)
+
+// streamWorkflowHistory streams the history events of a workflow as Server-Sent Events. Events are sent as they are
+// appended, using long polls of GetWorkflowExecutionHistory that go through the same interceptors as other HTTP API
+// routes. Every event carries its event ID as SSE ID, so clients reconnecting with a Last-Event-ID header (or a
+// last_event_id query parameter) resume after it. The stream ends with an "end" event once the workflow is closed.
+func (h *HTTPAPIServer) streamWorkflowHistory(w http.ResponseWriter, r *http.Request) {
+	if !h.allowedHosts.Get().MatchString(r.Host) {
+		w.WriteHeader(http.StatusForbidden)
+		// PermissionDenied gRPC code is 7.
+		_, _ = w.Write([]byte(`{"code": 7, "message": "Host not allowed"}`))
+		return
+	}
+	r = withTLSPeer(r)
+	_, noPayloadShorthand := r.URL.Query()["noPayloadShorthand"]
+	_, marshaler := newTemporalProtoMarshaler("", !noPayloadShorthand)
+
+	request, lastEventID, err := parseHistoryStreamRequest(r)
+	if err != nil {
+		h.errorHandler(r.Context(), h.serveMux, marshaler, w, r, err)
+		return
+	}
+	ctx, err := runtime.AnnotateContext(r.Context(), h.serveMux, r, historyStreamMethod)
+	if err != nil {
+		h.errorHandler(r.Context(), h.serveMux, marshaler, w, r, serviceerror.NewInvalidArgument(err.Error()))
+		return
+	}
+
+	responseController := http.NewResponseController(w)
+	started := false
+	for {
+		callCtx, cancel := context.WithTimeout(ctx, frontend.DefaultLongPollTimeout)
+		response, err := h.workflowClient.GetWorkflowExecutionHistory(callCtx, request)
+		cancel()
+		if r.Context().Err() != nil {
+			// client went away
+			return
+		}
+		if err != nil && !started {
+			h.errorHandler(ctx, h.serveMux, marshaler, w, r, err)
+			return
+		}
+		if !started {
+			w.Header().Set("Content-Type", "text/event-stream")
+			w.Header().Set("Cache-Control", "no-cache")
+			w.WriteHeader(http.StatusOK)
+			// The stream outlives the write timeout of the server, dead connections are detected by failing writes
+			// of the keepalive comments sent after every long poll instead.
+			_ = responseController.SetWriteDeadline(time.Time{})
+			started = true
+		}
+
+		if err != nil {
+			if common.IsContextDeadlineExceededErr(err) {
+				err = writeStreamKeepalive(w)
+			} else {
+				err = writeStreamEvent(w, marshaler, "", historyStreamEventError, serviceerror.ToStatus(err).Proto())
+				if err == nil {
+					_ = responseController.Flush()
+				}
+				return
+			}
+		} else {
+			for _, event := range response.GetHistory().GetEvents() {
+				if event.GetEventId() <= lastEventID {
+					continue
+				}
+				if err = writeStreamEvent(w, marshaler, strconv.FormatInt(event.GetEventId(), 10), historyStreamEventHistory, event); err != nil {
+					break
+				}
+				lastEventID = event.GetEventId()
+			}
+			if err == nil && len(response.GetNextPageToken()) == 0 {
+				if err = writeStreamEvent(w, marshaler, "", historyStreamEventEnd, nil); err == nil {
+					_ = responseController.Flush()
+				}
+				return
+			}
+			if err == nil && len(response.GetHistory().GetEvents()) == 0 {
+				err = writeStreamKeepalive(w)
+			}
+			request.NextPageToken = response.GetNextPageToken()
+		}
+		if err == nil {
+			err = responseController.Flush()
+		}
+		if err != nil {
+			h.logger.Debug("Failed to write workflow history stream", tag.Error(err))
+			return
+		}
+	}
+}
+
+func parseHistoryStreamRequest(r *http.Request) (*workflowservice.GetWorkflowExecutionHistoryRequest, int64, error) {
+	vars := mux.Vars(r)
+	namespaceName, err := url.PathUnescape(vars["namespace"])
+	if err != nil {
+		return nil, 0, serviceerror.NewInvalidArgumentf("invalid namespace: %v", err)
+	}
+	workflowID, err := url.PathUnescape(vars["workflow_id"])
+	if err != nil {
+		return nil, 0, serviceerror.NewInvalidArgumentf("invalid workflow ID: %v", err)
+	}
+
+	var lastEventID int64
+	rawLastEventID := r.Header.Get("Last-Event-ID")
+	if rawLastEventID == "" {
+		rawLastEventID = r.URL.Query().Get("last_event_id")
+	}
+	if rawLastEventID != "" {
+		if lastEventID, err = strconv.ParseInt(rawLastEventID, 10, 64); err != nil {
+			return nil, 0, serviceerror.NewInvalidArgumentf("invalid last event ID: %v", rawLastEventID)
+		}
+	}
+
+	return &workflowservice.GetWorkflowExecutionHistoryRequest{
+		Namespace: namespaceName,
+		Execution: &commonpb.WorkflowExecution{
+			WorkflowId: workflowID,
+			RunId:      r.URL.Query().Get("execution.run_id"),
+		},
+		WaitNewEvent:           true,
+		HistoryEventFilterType: enumspb.HISTORY_EVENT_FILTER_TYPE_ALL_EVENT,
+	}, lastEventID, nil
+}
+
+func writeStreamEvent(w io.Writer, marshaler runtime.Marshaler, id string, event string, message any) error {
+	data := []byte("{}")
+	if message != nil {
+		var err error
+		if data, err = marshaler.Marshal(message); err != nil {
+			return err
+		}
+	}
+	if id != "" {
+		if _, err := fmt.Fprintf(w, "id: %s\n", id); err != nil {
+			return err
+		}
+	}
+	_, err := fmt.Fprintf(w, "event: %s\ndata: %s\n\n", event, data)
+	return err
+}
+
+func writeStreamKeepalive(w io.Writer) error {
+	_, err := io.WriteString(w, ": keepalive\n\n")
+	return err
+}
@@ -0,0 +1,126 @@
+package frontend
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"regexp"
+	"strings"
+	"testing"
+
+	"github.com/gorilla/mux"
+	"github.com/grpc-ecosystem/grpc-gateway/v2/runtime"
+	"github.com/stretchr/testify/require"
+	enumspb "go.temporal.io/api/enums/v1"
+	historypb "go.temporal.io/api/history/v1"
+	"go.temporal.io/api/serviceerror"
+	"go.temporal.io/api/workflowservice/v1"
+	"go.temporal.io/server/common/dynamicconfig"
+	"go.temporal.io/server/common/log"
+	"google.golang.org/grpc"
+	"google.golang.org/protobuf/proto"
+)
+
+type historyStreamWorkflowClient struct {
+	workflowservice.WorkflowServiceClient
+	getHistory func(context.Context, *workflowservice.GetWorkflowExecutionHistoryRequest) (*workflowservice.GetWorkflowExecutionHistoryResponse, error)
+}
+
+func (c *historyStreamWorkflowClient) GetWorkflowExecutionHistory(
+	ctx context.Context,
+	request *workflowservice.GetWorkflowExecutionHistoryRequest,
+	_ ...grpc.CallOption,
+) (*workflowservice.GetWorkflowExecutionHistoryResponse, error) {
+	return c.getHistory(ctx, request)
+}
+
+func newHistoryStreamTestServer() (*mux.Router, *historyStreamWorkflowClient) {
+	workflowClient := &historyStreamWorkflowClient{}
+	h := &HTTPAPIServer{
+		logger:   log.NewTestLogger(),
+		serveMux: runtime.NewServeMux(),
+		allowedHosts: dynamicconfig.NewGlobalCachedTypedValue(
+			dynamicconfig.NewNoopCollection(),
+			dynamicconfig.FrontendHTTPAllowedHosts,
+			func([]string) (*regexp.Regexp, error) { return regexp.MustCompile(".*"), nil },
+		),
+		workflowClient: workflowClient,
+	}
+	router := mux.NewRouter().UseEncodedPath()
+	router.Path(historyStreamPath).Methods(http.MethodGet).HandlerFunc(h.streamWorkflowHistory)
+	return router, workflowClient
+}
+
+func newHistoryEvents(eventIDs ...int64) *historypb.History {
+	history := &historypb.History{}
+	for _, eventID := range eventIDs {
+		history.Events = append(history.Events, &historypb.HistoryEvent{
+			EventId:   eventID,
+			EventType: enumspb.EVENT_TYPE_WORKFLOW_EXECUTION_SIGNALED,
+		})
+	}
+	return history
+}
+
+func TestStreamWorkflowHistory(t *testing.T) {
+	router, workflowClient := newHistoryStreamTestServer()
+
+	var requests []*workflowservice.GetWorkflowExecutionHistoryRequest
+	responses := []struct {
+		response *workflowservice.GetWorkflowExecutionHistoryResponse
+		err      error
+	}{
+		{response: &workflowservice.GetWorkflowExecutionHistoryResponse{History: newHistoryEvents(1, 2), NextPageToken: []byte("token")}},
+		{err: context.DeadlineExceeded},
+		{response: &workflowservice.GetWorkflowExecutionHistoryResponse{History: newHistoryEvents(3)}},
+	}
+	workflowClient.getHistory = func(_ context.Context, request *workflowservice.GetWorkflowExecutionHistoryRequest) (*workflowservice.GetWorkflowExecutionHistoryResponse, error) {
+		requests = append(requests, proto.Clone(request).(*workflowservice.GetWorkflowExecutionHistoryRequest))
+		next := responses[0]
+		responses = responses[1:]
+		return next.response, next.err
+	}
+
+	request := httptest.NewRequest(http.MethodGet, "/api/v1/namespaces/test-namespace/workflows/workflow%2Fid/history-stream?execution.run_id=run-id", nil)
+	request.Header.Set("Last-Event-ID", "1")
+	recorder := httptest.NewRecorder()
+	router.ServeHTTP(recorder, request)
+
+	require.Equal(t, http.StatusOK, recorder.Code)
+	require.Equal(t, "text/event-stream", recorder.Header().Get("Content-Type"))
+	require.Len(t, requests, 3)
+	require.Equal(t, "test-namespace", requests[0].GetNamespace())
+	require.Equal(t, "workflow/id", requests[0].GetExecution().GetWorkflowId())
+	require.Equal(t, "run-id", requests[0].GetExecution().GetRunId())
+	require.True(t, requests[0].GetWaitNewEvent())
+	require.Empty(t, requests[0].GetNextPageToken())
+	require.Equal(t, []byte("token"), requests[2].GetNextPageToken())
+
+	body := recorder.Body.String()
+	// the event before Last-Event-ID is not sent again
+	require.NotContains(t, body, "id: 1\n")
+	require.Contains(t, body, "id: 2\nevent: history\ndata: {")
+	require.Contains(t, body, ": keepalive\n\n")
+	require.Contains(t, body, "id: 3\nevent: history\ndata: {")
+	require.True(t, strings.HasSuffix(body, "event: end\ndata: {}\n\n"))
+}
+
+func TestStreamWorkflowHistory_Error(t *testing.T) {
+	router, workflowClient := newHistoryStreamTestServer()
+	workflowClient.getHistory = func(context.Context, *workflowservice.GetWorkflowExecutionHistoryRequest) (*workflowservice.GetWorkflowExecutionHistoryResponse, error) {
+		return nil, serviceerror.NewPermissionDenied("denied", "")
+	}
+
+	request := httptest.NewRequest(http.MethodGet, "/api/v1/namespaces/test-namespace/workflows/workflow-id/history-stream", nil)
+	recorder := httptest.NewRecorder()
+	router.ServeHTTP(recorder, request)
+
+	// errors before the stream starts are returned as for other HTTP API routes
+	require.Equal(t, http.StatusForbidden, recorder.Code)
+	require.Contains(t, recorder.Body.String(), "denied")
+
+	request = httptest.NewRequest(http.MethodGet, "/api/v1/namespaces/test-namespace/workflows/workflow-id/history-stream?last_event_id=abc", nil)
+	recorder = httptest.NewRecorder()
+	router.ServeHTTP(recorder, request)
+	require.Equal(t, http.StatusBadRequest, recorder.Code)
+}
@@ -8,6 +8,7 @@ import (
 	"errors"
 	"fmt"
 	"net/url"
+	"slices"
 	"strings"
 	"sync/atomic"
 	"time"
@@ -31,6 +32,7 @@ import (
 	deploymentspb "go.temporal.io/server/api/deployment/v1"
 	"go.temporal.io/server/api/historyservice/v1"
 	"go.temporal.io/server/api/matchingservice/v1"
+	persistencespb "go.temporal.io/server/api/persistence/v1"
 	schedulespb "go.temporal.io/server/api/schedule/v1"
 	taskqueuespb "go.temporal.io/server/api/taskqueue/v1"
 	"go.temporal.io/server/client/frontend"
@@ -69,6 +71,7 @@ import (
 	"go.temporal.io/server/common/tqid"
 	"go.temporal.io/server/common/util"
 	"go.temporal.io/server/common/worker_versioning"
+	"go.temporal.io/server/common/workerlabels"
 	"go.temporal.io/server/service/history/api"
 	"go.temporal.io/server/service/worker/batcher"
 	"go.temporal.io/server/service/worker/deployment"
@@ -498,6 +501,17 @@ func (wh *WorkflowHandler) prepareStartWorkflowRequest(
 
 	request.Links = dedupLinksFromCallbacks(request.GetLinks(), request.GetCompletionCallbacks())
 
+	completionCallbacks, err := wh.webhookCallbacksFrom(namespaceName, request.GetCompletionCallbacks())
+	if err != nil {
+		return nil, err
+	}
+	if completionCallbacks != nil {
+		// Since webhookCallbacksFrom is not idempotent, we need to clone the request so that
+		// in case of retries, the field is set to the original value.
+		request = common.CloneProto(request)
+		request.CompletionCallbacks = completionCallbacks
+	}
+
 	allLinks := make([]*commonpb.Link, 0, len(request.GetLinks())+len(request.GetCompletionCallbacks()))
 	allLinks = append(allLinks, request.GetLinks()...)
 	for _, cb := range request.GetCompletionCallbacks() {
@@ -1126,6 +1140,10 @@ func (wh *WorkflowHandler) PollActivityTaskQueue(ctx context.Context, request *w
 	if err := wh.validateVersioningInfo(request.Namespace, request.WorkerVersionCapabilities, request.TaskQueue); err != nil {
 		return nil, err
 	}
+	workerLabels, err := workerlabels.Parse(headers.NewGRPCHeaderGetter(ctx).Get(workerlabels.HeaderName))
+	if err != nil {
+		return nil, err
+	}
 
 	namespaceID, err := wh.namespaceRegistry.GetNamespaceID(namespaceName)
 	if err != nil {
@@ -1140,9 +1158,10 @@ func (wh *WorkflowHandler) PollActivityTaskQueue(ctx context.Context, request *w
 	childCtx := wh.registerOutstandingPollContext(ctx, pollerID, namespaceID.String())
 	defer wh.unregisterOutstandingPollContext(pollerID, namespaceID.String())
 	matchingResponse, err := wh.matchingClient.PollActivityTaskQueue(childCtx, &matchingservice.PollActivityTaskQueueRequest{
-		NamespaceId: namespaceID.String(),
-		PollerId:    pollerID,
-		PollRequest: request,
+		NamespaceId:  namespaceID.String(),
+		PollerId:     pollerID,
+		PollRequest:  request,
+		WorkerLabels: workerLabels,
 	})
 	if err != nil {
 		contextWasCanceled := wh.cancelOutstandingPoll(childCtx, namespaceID, enumspb.TASK_QUEUE_TYPE_ACTIVITY, request.TaskQueue, pollerID)
@@ -5209,23 +5228,13 @@ func (wh *WorkflowHandler) validateWorkflowCompletionCallbacks(
 			if err := wh.validateCallbackURL(ns, cb.Nexus.GetUrl()); err != nil {
 				return err
 			}
-
-			headerSize := 0
-			for k, v := range cb.Nexus.GetHeader() {
-				headerSize += len(k) + len(v)
-			}
-			if headerSize > wh.config.CallbackHeaderMaxSize(ns.String()) {
-				return status.Error(
-					codes.InvalidArgument,
-					fmt.Sprintf(
-						"invalid header: header size longer than max allowed size of %d",
-						wh.config.CallbackHeaderMaxSize(ns.String()),
-					),
-				)
+			if err := wh.validateCallbackHeader(ns, cb.Nexus.GetHeader()); err != nil {
+				return err
 			}
 		case *commonpb.Callback_Internal_:
-			// TODO(Tianyu): For now, there is nothing to validate given that this is an internal field.
-			continue
+			if err := wh.validateInternalCallback(ns, cb.Internal); err != nil {
+				return err
+			}
 		default:
 			return status.Error(codes.Unimplemented, fmt.Sprintf("unknown callback variant: %T", cb))
 		}
@@ -5233,6 +5242,92 @@ func (wh *WorkflowHandler) validateWorkflowCompletionCallbacks(
 	return nil
 }
 
+func (wh *WorkflowHandler) validateCallbackHeader(ns namespace.Name, header map[string]string) error {
+	headerSize := 0
+	for k, v := range header {
+		headerSize += len(k) + len(v)
+	}
+	if headerSize > wh.config.CallbackHeaderMaxSize(ns.String()) {
+		return status.Error(
+			codes.InvalidArgument,
+			fmt.Sprintf(
+				"invalid header: header size longer than max allowed size of %d",
+				wh.config.CallbackHeaderMaxSize(ns.String()),
+			),
+		)
+	}
+	return nil
+}
+
+// validateInternalCallback validates the webhook callbacks a caller may pass as internal callbacks, like the
+// Nexus callbacks they are converted from, so that they cannot target addresses which are not allowed.
+func (wh *WorkflowHandler) validateInternalCallback(ns namespace.Name, callback *commonpb.Callback_Internal) error {
+	internal := &persistencespb.Callback{}
+	if err := proto.Unmarshal(callback.GetData(), internal); err != nil {
+		return status.Error(codes.InvalidArgument, "invalid internal callback")
+	}
+	webhook := internal.GetWebhook()
+	if webhook == nil {
+		return nil
+	}
+	if err := wh.validateCallbackURL(ns, webhook.GetUrl()); err != nil {
+		return err
+	}
+	if !wh.isWebhookCallbackURL(ns, webhook.GetUrl()) {
+		return status.Errorf(codes.InvalidArgument, "invalid url: callback address does not allow webhooks: %v", webhook.GetUrl())
+	}
+	return wh.validateCallbackHeader(ns, webhook.GetHeader())
+}
+
+// webhookCallbacksFrom converts the Nexus callbacks targeting an address configured for webhooks into webhook
+// callbacks. Returns nil if no callback was converted.
+func (wh *WorkflowHandler) webhookCallbacksFrom(
+	ns namespace.Name,
+	callbacks []*commonpb.Callback,
+) ([]*commonpb.Callback, error) {
+	var result []*commonpb.Callback
+	for i, callback := range callbacks {
+		if callback.GetNexus() == nil || !wh.isWebhookCallbackURL(ns, callback.GetNexus().GetUrl()) {
+			continue
+		}
+		if result == nil {
+			result = slices.Clone(callbacks)
+		}
+		data, err := proto.Marshal(&persistencespb.Callback{
+			Variant: &persistencespb.Callback_Webhook_{
+				Webhook: &persistencespb.Callback_Webhook{
+					Url:    callback.GetNexus().GetUrl(),
+					Header: callback.GetNexus().GetHeader(),
+				},
+			},
+			Links: callback.GetLinks(),
+		})
+		if err != nil {
+			return nil, serviceerror.NewInternalf("failed to serialize webhook callback: %v", err)
+		}
+		result[i] = &commonpb.Callback{
+			Variant: &commonpb.Callback_Internal_{
+				Internal: &commonpb.Callback_Internal{Data: data},
+			},
+			Links: callback.GetLinks(),
+		}
+	}
+	return result, nil
+}
+
+func (wh *WorkflowHandler) isWebhookCallbackURL(ns namespace.Name, rawURL string) bool {
+	u, err := url.Parse(rawURL)
+	if err != nil {
+		return false
+	}
+	for _, cfg := range wh.config.CallbackEndpointConfigs(ns.String()) {
+		if cfg.Regexp.MatchString(u.Host) {
+			return cfg.Webhook
+		}
+	}
+	return false
+}
+
 func (wh *WorkflowHandler) validateCallbackURL(ns namespace.Name, rawURL string) error {
 	if len(rawURL) > wh.config.CallbackURLMaxLength(ns.String()) {
 		return status.Errorf(codes.InvalidArgument, "invalid url: url length longer than max length allowed of %d", wh.config.CallbackURLMaxLength(ns.String()))
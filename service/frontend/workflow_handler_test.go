@@ -64,7 +64,10 @@ import (
 	"go.temporal.io/server/service/worker/scheduler"
 	"go.uber.org/mock/gomock"
 	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/health"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
 	"google.golang.org/protobuf/types/known/durationpb"
 	"google.golang.org/protobuf/types/known/fieldmaskpb"
 	"google.golang.org/protobuf/types/known/timestamppb"
@@ -909,6 +912,97 @@ func (s *WorkflowHandlerSuite) TestStartWorkflowExecution_Failed_InvalidAggregat
 	s.ErrorContains(err, "cannot attach more than 10 links per request, got 11")
 }
 
+func (s *WorkflowHandlerSuite) TestWebhookCallbacksFrom() {
+	config := s.newConfig()
+	config.CallbackEndpointConfigs = dc.GetTypedPropertyFnFilteredByNamespace([]callbacks.AddressMatchRule{
+		{
+			Regexp:  regexp.MustCompile(`^webhooks\.example\.com$`),
+			Webhook: true,
+		},
+		{
+			Regexp:        regexp.MustCompile(`.*`),
+			AllowInsecure: true,
+		},
+	})
+	wh := s.getWorkflowHandler(config)
+
+	nexusCallback := &commonpb.Callback{
+		Variant: &commonpb.Callback_Nexus_{
+			Nexus: &commonpb.Callback_Nexus{Url: "http://localhost/test"},
+		},
+	}
+	converted, err := wh.webhookCallbacksFrom("test-namespace", []*commonpb.Callback{nexusCallback})
+	s.NoError(err)
+	s.Nil(converted)
+
+	webhookCallback := &commonpb.Callback{
+		Variant: &commonpb.Callback_Nexus_{
+			Nexus: &commonpb.Callback_Nexus{
+				Url:    "https://webhooks.example.com/done",
+				Header: map[string]string{"key": "value"},
+			},
+		},
+	}
+	callbacks := []*commonpb.Callback{nexusCallback, webhookCallback}
+	converted, err = wh.webhookCallbacksFrom("test-namespace", callbacks)
+	s.NoError(err)
+	s.Len(converted, 2)
+	s.Same(nexusCallback, converted[0])
+	// the input slice is left untouched
+	s.Same(webhookCallback, callbacks[1])
+
+	persistenceCallback := &persistencespb.Callback{}
+	s.NoError(proto.Unmarshal(converted[1].GetInternal().GetData(), persistenceCallback))
+	s.Equal("https://webhooks.example.com/done", persistenceCallback.GetWebhook().GetUrl())
+	s.Equal(map[string]string{"key": "value"}, persistenceCallback.GetWebhook().GetHeader())
+}
+
+func (s *WorkflowHandlerSuite) TestValidateWorkflowCompletionCallbacks_InternalWebhook() {
+	config := s.newConfig()
+	config.EnableNexusAPIs = dc.GetBoolPropertyFn(true)
+	config.CallbackEndpointConfigs = dc.GetTypedPropertyFnFilteredByNamespace([]callbacks.AddressMatchRule{
+		{
+			Regexp:  regexp.MustCompile(`^webhooks\.example\.com$`),
+			Webhook: true,
+		},
+		{
+			Regexp: regexp.MustCompile(`^nexus\.example\.com$`),
+		},
+	})
+	wh := s.getWorkflowHandler(config)
+
+	internalWebhook := func(url string) []*commonpb.Callback {
+		data, err := proto.Marshal(&persistencespb.Callback{
+			Variant: &persistencespb.Callback_Webhook_{
+				Webhook: &persistencespb.Callback_Webhook{Url: url},
+			},
+		})
+		s.NoError(err)
+		return []*commonpb.Callback{{
+			Variant: &commonpb.Callback_Internal_{
+				Internal: &commonpb.Callback_Internal{Data: data},
+			},
+		}}
+	}
+
+	s.NoError(wh.validateWorkflowCompletionCallbacks("test-namespace", internalWebhook("https://webhooks.example.com/done")))
+
+	err := wh.validateWorkflowCompletionCallbacks("test-namespace", internalWebhook("http://169.254.169.254/latest/meta-data"))
+	s.Equal(codes.InvalidArgument, status.Code(err))
+	s.ErrorContains(err, "url does not match any configured callback address")
+
+	err = wh.validateWorkflowCompletionCallbacks("test-namespace", internalWebhook("https://nexus.example.com/done"))
+	s.Equal(codes.InvalidArgument, status.Code(err))
+	s.ErrorContains(err, "callback address does not allow webhooks")
+
+	err = wh.validateWorkflowCompletionCallbacks("test-namespace", []*commonpb.Callback{{
+		Variant: &commonpb.Callback_Internal_{
+			Internal: &commonpb.Callback_Internal{Data: []byte("invalid")},
+		},
+	}})
+	s.Equal(codes.InvalidArgument, status.Code(err))
+}
+
 func (s *WorkflowHandlerSuite) TestSignalWithStartWorkflowExecution_InvalidWorkflowIdConflictPolicy() {
 	config := s.newConfig()
 	wh := s.getWorkflowHandler(config)
@@ -6,6 +6,7 @@ import (
 	commonpb "go.temporal.io/api/common/v1"
 	"go.temporal.io/api/serviceerror"
 	"go.temporal.io/server/api/historyservice/v1"
+	"go.temporal.io/server/common"
 	"go.temporal.io/server/common/definition"
 	"go.temporal.io/server/common/persistence"
 	"go.temporal.io/server/service/history/api"
@@ -37,6 +38,7 @@ func Invoke(
 	shardContext historyi.ShardContext,
 	deserializer TaskDeserializer,
 	numShards int,
+	shardPools map[string]common.NamespaceShardPool,
 	req *historyservice.AddTasksRequest,
 	taskRegistry tasks.TaskCategoryRegistry,
 ) (*historyservice.AddTasksResponse, error) {
@@ -76,8 +78,8 @@ func Invoke(
 			return nil, err
 		}
 
-		shardID := tasks.GetShardIDForTask(deserializedTask, numShards)
-		if shardID != int(req.ShardId) {
+		shardID := tasks.GetShardIDForTaskInPools(deserializedTask, numShards, shardPools)
+		if shardID != int(req.ShardId) && tasks.GetAlternateShardIDForTaskInPools(deserializedTask, numShards, shardPools) != int(req.ShardId) {
 			return nil, serviceerror.NewInvalidArgumentf(
 				"Task is for wrong shard: index = %d, task shard = %d, request shard = %d",
 				i, shardID, req.ShardId,
@@ -11,6 +11,7 @@ import (
 	commonpb "go.temporal.io/api/common/v1"
 	"go.temporal.io/api/serviceerror"
 	"go.temporal.io/server/api/historyservice/v1"
+	"go.temporal.io/server/common"
 	"go.temporal.io/server/common/definition"
 	"go.temporal.io/server/common/persistence"
 	"go.temporal.io/server/common/persistence/serialization"
@@ -27,6 +28,7 @@ type (
 		shardContext historyi.ShardContext
 		deserializer addtasks.TaskDeserializer
 		numShards    int
+		shardPools   map[string]common.NamespaceShardPool
 		req          *historyservice.AddTasksRequest
 		// expectation is invoked with the result of addtasks.Invoke.
 		expectation func(*historyservice.AddTasksResponse, error)
@@ -203,6 +205,40 @@ func TestInvoke(t *testing.T) {
 				}
 			},
 		},
+		{
+			name: "wrong shard in namespace shard pool",
+			configure: func(t *testing.T, params *testParams) {
+				params.req.ShardId = 2
+				params.numShards = 2
+				params.shardPools = map[string]common.NamespaceShardPool{
+					string(tests.NamespaceID): {FirstShardID: 1, LastShardID: 1},
+				}
+				params.expectation = func(resp *historyservice.AddTasksResponse, err error) {
+					require.ErrorAs(t, err, new(*serviceerror.InvalidArgument))
+					assert.ErrorContains(t, err, "Task is for wrong shard")
+					assert.ErrorContains(t, err, "task shard = 1")
+					assert.ErrorContains(t, err, "request shard = 2")
+				}
+			},
+		},
+		{
+			name: "previous shard while namespace is moved to a shard pool",
+			configure: func(t *testing.T, params *testParams) {
+				params.req.ShardId = 2
+				params.numShards = 2
+				params.shardPools = map[string]common.NamespaceShardPool{
+					string(tests.NamespaceID): {FirstShardID: 1, LastShardID: 1, Previous: &common.NamespaceShardPool{}},
+				}
+				params.shardContext.(*historyi.MockShardContext).EXPECT().AddTasks(
+					gomock.Any(),
+					gomock.Any(),
+				).Return(nil)
+				params.expectation = func(resp *historyservice.AddTasksResponse, err error) {
+					require.NoError(t, err)
+					assert.NotNil(t, resp)
+				}
+			},
+		},
 		{
 			name: "add tasks error",
 			configure: func(t *testing.T, params *testParams) {
@@ -225,6 +261,7 @@ func TestInvoke(t *testing.T) {
 				params.shardContext,
 				params.deserializer,
 				params.numShards,
+				params.shardPools,
 				params.req,
 				tasks.NewDefaultTaskCategoryRegistry(),
 			)
@@ -19,6 +19,7 @@ import (
 	"go.temporal.io/server/common/retrypolicy"
 	"go.temporal.io/server/common/searchattribute"
 	"go.temporal.io/server/common/tqid"
+	"go.temporal.io/server/common/workerlabels"
 	"go.temporal.io/server/service/history/configs"
 	"google.golang.org/protobuf/types/known/durationpb"
 )
@@ -51,6 +52,15 @@ func NewCommandAttrValidator(
 	}
 }
 
+func (v *CommandAttrValidator) usesPriorityMatcher(namespaceID namespace.ID, taskQueue string) (bool, error) {
+	namespaceName, err := v.namespaceRegistry.GetNamespaceName(namespaceID)
+	if err != nil {
+		return false, err
+	}
+	return v.config.MatchingUseNewMatcher(namespaceName.String(), taskQueue, enumspb.TASK_QUEUE_TYPE_ACTIVITY) ||
+		v.config.MatchingEnableFairness(namespaceName.String(), taskQueue, enumspb.TASK_QUEUE_TYPE_ACTIVITY), nil
+}
+
 func (v *CommandAttrValidator) ValidateProtocolMessageAttributes(
 	namespaceID namespace.ID,
 	attributes *commandpb.ProtocolMessageCommandAttributes,
@@ -134,6 +144,20 @@ func (v *CommandAttrValidator) ValidateActivityScheduleAttributes(
 	if err := priorities.Validate(attributes.Priority); err != nil {
 		return failedCause, err
 	}
+	selector, err := workerlabels.SelectorFromHeader(attributes.GetHeader())
+	if err != nil {
+		return failedCause, fmt.Errorf("%w. ActivityId=%s ActivityType=%s", err, activityID, activityType)
+	}
+	if len(selector) > 0 {
+		// matching rejects the task otherwise, which would only be noticed when the activity task is pushed
+		usesPriorityMatcher, err := v.usesPriorityMatcher(namespaceID, attributes.TaskQueue.GetName())
+		if err != nil {
+			return failedCause, err
+		}
+		if !usesPriorityMatcher {
+			return failedCause, serviceerror.NewInvalidArgumentf("Worker selector on ScheduleActivityTaskCommand requires the priority matcher on the task queue. ActivityId=%s ActivityType=%s TaskQueue=%s", activityID, activityType, attributes.TaskQueue.GetName())
+		}
+	}
 
 	ScheduleToCloseSet := attributes.GetScheduleToCloseTimeout().AsDuration() > 0
 	ScheduleToStartSet := attributes.GetScheduleToStartTimeout().AsDuration() > 0
@@ -13,15 +13,18 @@ import (
 	commonpb "go.temporal.io/api/common/v1"
 	enumspb "go.temporal.io/api/enums/v1"
 	"go.temporal.io/api/serviceerror"
+	taskqueuepb "go.temporal.io/api/taskqueue/v1"
 	persistencespb "go.temporal.io/server/api/persistence/v1"
 	"go.temporal.io/server/common/cluster"
 	"go.temporal.io/server/common/dynamicconfig"
 	"go.temporal.io/server/common/namespace"
+	"go.temporal.io/server/common/payload"
 	"go.temporal.io/server/common/payloads"
 	"go.temporal.io/server/common/persistence/visibility/manager"
 	"go.temporal.io/server/common/primitives"
 	"go.temporal.io/server/common/retrypolicy"
 	"go.temporal.io/server/common/searchattribute"
+	"go.temporal.io/server/common/workerlabels"
 	"go.temporal.io/server/service/history/configs"
 	"go.temporal.io/server/service/history/tests"
 	"go.uber.org/mock/gomock"
@@ -105,6 +108,10 @@ func (s *commandAttrValidatorSuite) SetupTest() {
 		DefaultWorkflowRetryPolicy:        func(string) retrypolicy.DefaultRetrySettings { return retrypolicy.DefaultDefaultRetrySettings },
 		EnableCrossNamespaceCommands:      dynamicconfig.GetBoolPropertyFn(true),
 		DefaultWorkflowTaskTimeout:        dynamicconfig.GetDurationPropertyFnFilteredByNamespace(primitives.DefaultWorkflowTaskTimeout),
+		MatchingUseNewMatcher: func(_ string, taskQueue string, _ enumspb.TaskQueueType) bool {
+			return taskQueue == "priority-matcher-tq"
+		},
+		MatchingEnableFairness: dynamicconfig.GetBoolPropertyFnFilteredByTaskQueue(false),
 	}
 	s.validator = NewCommandAttrValidator(
 		s.mockNamespaceCache,
@@ -709,6 +716,31 @@ func (s *commandAttrValidatorSuite) TestValidateActivityRetryPolicy() {
 	}
 }
 
+func (s *commandAttrValidatorSuite) TestValidateActivityScheduleAttributes_WorkerSelector() {
+	s.mockNamespaceCache.EXPECT().GetNamespaceName(s.testNamespaceID).Return(namespace.Name("test-namespace"), nil).AnyTimes()
+	selector, err := payload.Encode(map[string]string{"region": "eu"})
+	s.NoError(err)
+	attributes := func(taskQueue string) *commandpb.ScheduleActivityTaskCommandAttributes {
+		return &commandpb.ScheduleActivityTaskCommandAttributes{
+			ActivityId:          "activity-id",
+			ActivityType:        &commonpb.ActivityType{Name: "activity-type"},
+			TaskQueue:           &taskqueuepb.TaskQueue{Name: taskQueue, Kind: enumspb.TASK_QUEUE_KIND_NORMAL},
+			StartToCloseTimeout: durationpb.New(time.Minute),
+			Header: &commonpb.Header{
+				Fields: map[string]*commonpb.Payload{workerlabels.SelectorHeaderField: selector},
+			},
+		}
+	}
+
+	_, err = s.validator.ValidateActivityScheduleAttributes(s.testNamespaceID, attributes("priority-matcher-tq"), nil)
+	s.NoError(err)
+
+	failedCause, err := s.validator.ValidateActivityScheduleAttributes(s.testNamespaceID, attributes("classic-matcher-tq"), nil)
+	var invalidArgument *serviceerror.InvalidArgument
+	s.ErrorAs(err, &invalidArgument)
+	s.Equal(enumspb.WORKFLOW_TASK_FAILED_CAUSE_BAD_SCHEDULE_ACTIVITY_ATTRIBUTES, failedCause)
+}
+
 func (s *commandAttrValidatorSuite) TestValidateCommandSequence_NoTerminalCommand() {
 	err := s.validator.ValidateCommandSequence(nonTerminalCommands)
 	s.NoError(err)
@@ -41,7 +41,7 @@ func Invoke(
 	}
 	defer func() { chasmLease.GetReleaseFn()(retError) }()
 
-	response := &historyservice.DescribeMutableStateResponse{}
+	response := &historyservice.DescribeMutableStateResponse{ShardId: shardContext.GetShardID()}
 	if chasmLease.GetContext().(*workflow.ContextImpl).MutableState != nil {
 		msb := chasmLease.GetContext().(*workflow.ContextImpl).MutableState
 		response.CacheMutableState = msb.CloneToProto()
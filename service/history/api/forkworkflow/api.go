@@ -0,0 +1,194 @@
+package forkworkflow
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+	enumspb "go.temporal.io/api/enums/v1"
+	historypb "go.temporal.io/api/history/v1"
+	"go.temporal.io/api/serviceerror"
+	"go.temporal.io/server/api/adminservice/v1"
+	"go.temporal.io/server/api/historyservice/v1"
+	"go.temporal.io/server/common"
+	"go.temporal.io/server/common/definition"
+	"go.temporal.io/server/common/log/tag"
+	"go.temporal.io/server/common/namespace"
+	"go.temporal.io/server/common/persistence"
+	"go.temporal.io/server/common/persistence/versionhistory"
+	"go.temporal.io/server/service/history/api"
+	"go.temporal.io/server/service/history/api/resetworkflow"
+	"go.temporal.io/server/service/history/consts"
+	historyi "go.temporal.io/server/service/history/interfaces"
+	"go.temporal.io/server/service/history/ndc"
+)
+
+const (
+	readHistoryPageSize = 256
+)
+
+func Invoke(
+	ctx context.Context,
+	forkRequest *historyservice.ForkWorkflowExecutionRequest,
+	shardContext historyi.ShardContext,
+	workflowConsistencyChecker api.WorkflowConsistencyChecker,
+) (*historyservice.ForkWorkflowExecutionResponse, error) {
+	namespaceID := namespace.ID(forkRequest.GetNamespaceId())
+	if err := api.ValidateNamespaceUUID(namespaceID); err != nil {
+		return nil, err
+	}
+	sourceNamespaceID := namespace.ID(forkRequest.GetSourceNamespaceId())
+	if err := api.ValidateNamespaceUUID(sourceNamespaceID); err != nil {
+		return nil, err
+	}
+
+	request := forkRequest.ForkRequest
+	workflowID := request.GetNewWorkflowId()
+	if workflowID == "" {
+		return nil, consts.ErrWorkflowIDNotSet
+	}
+	if _, err := api.GetActiveNamespace(shardContext, namespaceID); err != nil {
+		return nil, err
+	}
+
+	// dedup by requestID, the new workflow ID must not be in use otherwise
+	currentExecution, err := shardContext.GetCurrentExecution(ctx, &persistence.GetCurrentExecutionRequest{
+		ShardID:     shardContext.GetShardID(),
+		NamespaceID: namespaceID.String(),
+		WorkflowID:  workflowID,
+	})
+	switch err.(type) {
+	case nil:
+		if currentExecution.StartRequestID == request.GetRequestId() {
+			shardContext.GetLogger().Info("Duplicated fork request",
+				tag.WorkflowID(workflowID),
+				tag.WorkflowRunID(currentExecution.RunID),
+				tag.WorkflowNamespaceID(namespaceID.String()))
+			return &historyservice.ForkWorkflowExecutionResponse{
+				RunId: currentExecution.RunID,
+			}, nil
+		}
+		return nil, serviceerror.NewWorkflowExecutionAlreadyStarted(
+			"Workflow execution already exists.",
+			currentExecution.StartRequestID,
+			currentExecution.RunID,
+		)
+	case *serviceerror.NotFound:
+		// noop
+	default:
+		return nil, err
+	}
+
+	sourceMutableState, err := shardContext.GetHistoryClient().GetMutableState(ctx, &historyservice.GetMutableStateRequest{
+		NamespaceId: sourceNamespaceID.String(),
+		Execution:   request.GetExecution(),
+	})
+	if err != nil {
+		return nil, err
+	}
+	if request.GetWorkflowTaskFinishEventId() <= common.FirstEventID ||
+		request.GetWorkflowTaskFinishEventId() >= sourceMutableState.GetNextEventId() {
+		return nil, serviceerror.NewInvalidArgument("Workflow task finish ID must be > 1 && <= workflow last event ID.")
+	}
+
+	baseEvents, reapplyEvents, err := readSourceHistory(
+		ctx,
+		shardContext,
+		sourceNamespaceID,
+		sourceMutableState,
+		request.GetWorkflowTaskFinishEventId()-1,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	forkRunID := uuid.New().String()
+	if err := ndc.NewWorkflowForker(
+		shardContext,
+		workflowConsistencyChecker.GetWorkflowCache(),
+		shardContext.GetLogger(),
+	).ForkWorkflow(
+		ctx,
+		namespaceID,
+		workflowID,
+		forkRunID,
+		request.GetRequestId(),
+		definition.NewWorkflowKey(
+			sourceNamespaceID.String(),
+			sourceMutableState.GetExecution().GetWorkflowId(),
+			sourceMutableState.GetExecution().GetRunId(),
+		),
+		baseEvents,
+		reapplyEvents,
+		request.GetReason(),
+		resetworkflow.GetResetReapplyExcludeTypes(request.GetResetReapplyExcludeTypes(), enumspb.RESET_REAPPLY_TYPE_UNSPECIFIED),
+	); err != nil {
+		return nil, err
+	}
+	return &historyservice.ForkWorkflowExecutionResponse{
+		RunId: forkRunID,
+	}, nil
+}
+
+// readSourceHistory reads the current branch of the source workflow and splits it into the
+// batches to be copied, up to and including lastEventID, and the events after them.
+func readSourceHistory(
+	ctx context.Context,
+	shardContext historyi.ShardContext,
+	sourceNamespaceID namespace.ID,
+	sourceMutableState *historyservice.GetMutableStateResponse,
+	lastEventID int64,
+) ([][]*historypb.HistoryEvent, []*historypb.HistoryEvent, error) {
+	currentVersionHistory, err := versionhistory.GetCurrentVersionHistory(sourceMutableState.GetVersionHistories())
+	if err != nil {
+		return nil, nil, err
+	}
+	lastItem, err := versionhistory.GetLastVersionHistoryItem(currentVersionHistory)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var baseEvents [][]*historypb.HistoryEvent
+	var reapplyEvents []*historypb.HistoryEvent
+	var nextPageToken []byte
+	for {
+		resp, err := shardContext.GetHistoryClient().GetWorkflowExecutionRawHistory(ctx, &historyservice.GetWorkflowExecutionRawHistoryRequest{
+			NamespaceId: sourceNamespaceID.String(),
+			Request: &adminservice.GetWorkflowExecutionRawHistoryRequest{
+				NamespaceId:     sourceNamespaceID.String(),
+				Execution:       sourceMutableState.GetExecution(),
+				EndEventId:      lastItem.GetEventId(),
+				EndEventVersion: lastItem.GetVersion(),
+				MaximumPageSize: readHistoryPageSize,
+				NextPageToken:   nextPageToken,
+			},
+		})
+		if err != nil {
+			return nil, nil, err
+		}
+		for _, blob := range resp.GetResponse().GetHistoryBatches() {
+			events, err := shardContext.GetPayloadSerializer().DeserializeEvents(blob)
+			if err != nil {
+				return nil, nil, err
+			}
+			if len(events) == 0 {
+				continue
+			}
+			switch {
+			case events[len(events)-1].GetEventId() <= lastEventID:
+				baseEvents = append(baseEvents, events)
+			case events[0].GetEventId() > lastEventID:
+				reapplyEvents = append(reapplyEvents, events...)
+			default:
+				return nil, nil, serviceerror.NewInvalidArgumentf(
+					"Unable to fork workflow at event ID: %v, this event must be at the boundary",
+					lastEventID+1,
+				)
+			}
+		}
+		nextPageToken = resp.GetResponse().GetNextPageToken()
+		if len(nextPageToken) == 0 {
+			break
+		}
+	}
+	return baseEvents, reapplyEvents, nil
+}
@@ -0,0 +1,95 @@
+package forkworkflow
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	commonpb "go.temporal.io/api/common/v1"
+	enumspb "go.temporal.io/api/enums/v1"
+	historypb "go.temporal.io/api/history/v1"
+	"go.temporal.io/api/serviceerror"
+	"go.temporal.io/server/api/adminservice/v1"
+	historyspb "go.temporal.io/server/api/history/v1"
+	"go.temporal.io/server/api/historyservice/v1"
+	"go.temporal.io/server/api/historyservicemock/v1"
+	"go.temporal.io/server/common/persistence/serialization"
+	"go.temporal.io/server/common/persistence/versionhistory"
+	historyi "go.temporal.io/server/service/history/interfaces"
+	"go.temporal.io/server/service/history/tests"
+	"go.uber.org/mock/gomock"
+)
+
+func TestReadSourceHistory(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	serializer := serialization.NewSerializer()
+	historyClient := historyservicemock.NewMockHistoryServiceClient(ctrl)
+	shardContext := historyi.NewMockShardContext(ctrl)
+	shardContext.EXPECT().GetHistoryClient().Return(historyClient).AnyTimes()
+	shardContext.EXPECT().GetPayloadSerializer().Return(serializer).AnyTimes()
+
+	newBatch := func(eventIDs ...int64) *commonpb.DataBlob {
+		var events []*historypb.HistoryEvent
+		for _, eventID := range eventIDs {
+			events = append(events, &historypb.HistoryEvent{
+				EventId:   eventID,
+				EventType: enumspb.EVENT_TYPE_WORKFLOW_EXECUTION_SIGNALED,
+			})
+		}
+		blob, err := serializer.SerializeEvents(events)
+		require.NoError(t, err)
+		return blob
+	}
+	execution := &commonpb.WorkflowExecution{WorkflowId: "workflow ID", RunId: "run ID"}
+	sourceMutableState := &historyservice.GetMutableStateResponse{
+		Execution: execution,
+		VersionHistories: versionhistory.NewVersionHistories(versionhistory.NewVersionHistory(
+			nil,
+			[]*historyspb.VersionHistoryItem{versionhistory.NewVersionHistoryItem(6, 0)},
+		)),
+	}
+
+	historyClient.EXPECT().GetWorkflowExecutionRawHistory(gomock.Any(), gomock.Any()).DoAndReturn(
+		func(_ context.Context, request *historyservice.GetWorkflowExecutionRawHistoryRequest, _ ...any) (*historyservice.GetWorkflowExecutionRawHistoryResponse, error) {
+			require.Equal(t, int64(6), request.GetRequest().GetEndEventId())
+			if request.GetRequest().GetNextPageToken() == nil {
+				return &historyservice.GetWorkflowExecutionRawHistoryResponse{
+					Response: &adminservice.GetWorkflowExecutionRawHistoryResponse{
+						HistoryBatches: []*commonpb.DataBlob{newBatch(1, 2), newBatch(3)},
+						NextPageToken:  []byte("next page"),
+					},
+				}, nil
+			}
+			return &historyservice.GetWorkflowExecutionRawHistoryResponse{
+				Response: &adminservice.GetWorkflowExecutionRawHistoryResponse{
+					HistoryBatches: []*commonpb.DataBlob{newBatch(4, 5), newBatch(6)},
+				},
+			}, nil
+		},
+	).Times(4)
+
+	baseEvents, reapplyEvents, err := readSourceHistory(
+		context.Background(),
+		shardContext,
+		tests.NamespaceID,
+		sourceMutableState,
+		3,
+	)
+	require.NoError(t, err)
+	require.Len(t, baseEvents, 2)
+	require.Len(t, baseEvents[0], 2)
+	require.Len(t, baseEvents[1], 1)
+	require.Len(t, reapplyEvents, 3)
+	require.Equal(t, int64(4), reapplyEvents[0].GetEventId())
+
+	// the fork point must be at a batch boundary
+	_, _, err = readSourceHistory(
+		context.Background(),
+		shardContext,
+		tests.NamespaceID,
+		sourceMutableState,
+		4,
+	)
+	var invalidArgument *serviceerror.InvalidArgument
+	require.ErrorAs(t, err, &invalidArgument)
+}
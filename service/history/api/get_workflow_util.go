@@ -360,6 +360,11 @@ func MutableStateToGetResponse(
 		}
 	}
 
+	var completionEventBatchID int64
+	if executionInfo.CompletionEventId != 0 {
+		completionEventBatchID = executionInfo.CompletionEventBatchId
+	}
+
 	return &historyservice.GetMutableStateResponse{
 		Execution: &commonpb.WorkflowExecution{
 			WorkflowId: mutableState.GetExecutionInfo().WorkflowId,
@@ -393,5 +398,7 @@ func MutableStateToGetResponse(
 		MostRecentWorkerVersionStamp: mostRecentWorkerVersionStamp,
 		TransitionHistory:            transitionhistory.CopyVersionedTransitions(mutableState.GetExecutionInfo().TransitionHistory),
 		VersioningInfo:               mutableState.GetExecutionInfo().VersioningInfo,
+		CompletionEventBatchId:       completionEventBatchID,
+		CompletionEventId:            executionInfo.CompletionEventId,
 	}, nil
 }
@@ -113,11 +113,17 @@ func Invoke(
 			return nil, "", 0, 0, false, nil, nil, err
 		}
 
+		lastFirstEventID, nextEventID := response.GetLastFirstEventId(), response.GetNextEventId()
+		if isCloseEventOnly && response.GetCompletionEventId() != 0 {
+			// events were added after the completion event, e.g. by a redaction
+			lastFirstEventID, nextEventID = response.GetCompletionEventBatchId(), response.GetCompletionEventId()+1
+		}
+
 		lastVersionedTransition := transitionhistory.LastVersionedTransition(response.GetTransitionHistory())
 		return response.CurrentBranchToken,
 			response.Execution.GetRunId(),
-			response.GetLastFirstEventId(),
-			response.GetNextEventId(),
+			lastFirstEventID,
+			nextEventID,
 			isWorkflowRunning,
 			lastVersionHistoryItem,
 			lastVersionedTransition,
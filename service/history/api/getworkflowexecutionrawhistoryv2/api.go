@@ -97,11 +97,7 @@ func Invoke(
 		}, nil
 	}
 	pageSize := int(req.GetMaximumPageSize())
-	shardID := common.WorkflowIDToHistoryShard(
-		ns.ID().String(),
-		execution.GetWorkflowId(),
-		shardContext.GetConfig().NumberOfShards,
-	)
+	shardID := shardContext.GetShardID()
 	rawHistoryResponse, err := shardContext.GetExecutionManager().ReadRawHistoryBranch(ctx, &persistence.ReadHistoryBranchRequest{
 		BranchToken: targetVersionHistory.GetBranchToken(),
 		// GetWorkflowExecutionRawHistoryV2 is exclusive exclusive.
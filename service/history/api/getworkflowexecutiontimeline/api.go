@@ -0,0 +1,78 @@
+package getworkflowexecutiontimeline
+
+import (
+	"context"
+
+	enumspb "go.temporal.io/api/enums/v1"
+	"go.temporal.io/server/api/historyservice/v1"
+	"go.temporal.io/server/common"
+	"go.temporal.io/server/common/namespace"
+	"go.temporal.io/server/common/persistence"
+	"go.temporal.io/server/service/history/api"
+	"go.temporal.io/server/service/history/consts"
+	"go.temporal.io/server/service/history/events"
+	historyi "go.temporal.io/server/service/history/interfaces"
+)
+
+const (
+	readHistoryPageSize = 256
+)
+
+func Invoke(
+	ctx context.Context,
+	request *historyservice.GetWorkflowExecutionTimelineRequest,
+	shardContext historyi.ShardContext,
+	workflowConsistencyChecker api.WorkflowConsistencyChecker,
+	eventNotifier events.Notifier,
+) (*historyservice.GetWorkflowExecutionTimelineResponse, error) {
+	namespaceID := namespace.ID(request.GetNamespaceId())
+	if err := api.ValidateNamespaceUUID(namespaceID); err != nil {
+		return nil, err
+	}
+	execution := common.CloneProto(request.GetRequest().GetExecution())
+	if execution.GetWorkflowId() == "" {
+		return nil, consts.ErrWorkflowIDNotSet
+	}
+
+	mutableState, err := api.GetOrPollMutableState(
+		ctx,
+		shardContext,
+		&historyservice.GetMutableStateRequest{
+			NamespaceId: namespaceID.String(),
+			Execution:   execution,
+		},
+		workflowConsistencyChecker,
+		eventNotifier,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	builder := newTimelineBuilder()
+	var nextPageToken []byte
+	for {
+		resp, err := shardContext.GetExecutionManager().ReadHistoryBranch(ctx, &persistence.ReadHistoryBranchRequest{
+			ShardID:       shardContext.GetShardID(),
+			BranchToken:   mutableState.GetCurrentBranchToken(),
+			MinEventID:    common.FirstEventID,
+			MaxEventID:    mutableState.GetNextEventId(),
+			PageSize:      readHistoryPageSize,
+			NextPageToken: nextPageToken,
+		})
+		if err != nil {
+			return nil, err
+		}
+		for _, event := range resp.HistoryEvents {
+			builder.addEvent(event)
+		}
+		nextPageToken = resp.NextPageToken
+		if len(nextPageToken) == 0 {
+			break
+		}
+	}
+
+	running := mutableState.GetWorkflowStatus() == enumspb.WORKFLOW_EXECUTION_STATUS_RUNNING
+	return &historyservice.GetWorkflowExecutionTimelineResponse{
+		Timeline: builder.build(running, shardContext.GetTimeSource().Now()),
+	}, nil
+}
@@ -0,0 +1,291 @@
+package getworkflowexecutiontimeline
+
+import (
+	"math"
+	"sort"
+	"time"
+
+	enumspb "go.temporal.io/api/enums/v1"
+	historypb "go.temporal.io/api/history/v1"
+	"go.temporal.io/server/api/adminservice/v1"
+	enumsspb "go.temporal.io/server/api/enums/v1"
+	"google.golang.org/protobuf/types/known/durationpb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+type (
+	// timelineBuilder turns history events, in order, into the spans of a workflow execution timeline.
+	timelineBuilder struct {
+		timeline *adminservice.WorkflowExecutionTimeline
+
+		// spanIndexes maps the ID of the event that opened a span to its index.
+		spanIndexes map[int64]int
+		// spanIndexesByEndEventID maps the ID of the event that closed a span to its index.
+		spanIndexesByEndEventID map[int64]int
+		// closedWorkflowTasks and closedSpans are the indexes of closed workflow task spans and of the other
+		// closed spans, ordered by end event ID since events are added in order.
+		closedWorkflowTasks []int
+		closedSpans         []int
+		// workflowTaskStartedEventIDs maps the index of workflow task spans to their started event ID.
+		workflowTaskStartedEventIDs map[int]int64
+		// closeWorkflowTaskCompletedEventID is the workflow task that closed the workflow, if any.
+		closeWorkflowTaskCompletedEventID int64
+	}
+)
+
+func newTimelineBuilder() *timelineBuilder {
+	return &timelineBuilder{
+		timeline:                    &adminservice.WorkflowExecutionTimeline{},
+		spanIndexes:                 make(map[int64]int),
+		spanIndexesByEndEventID:     make(map[int64]int),
+		workflowTaskStartedEventIDs: make(map[int]int64),
+	}
+}
+
+func (b *timelineBuilder) addEvent(event *historypb.HistoryEvent) {
+	switch event.GetEventType() {
+	case enumspb.EVENT_TYPE_WORKFLOW_EXECUTION_STARTED:
+		b.timeline.StartTime = event.GetEventTime()
+
+	case enumspb.EVENT_TYPE_WORKFLOW_TASK_SCHEDULED:
+		span := b.openSpan(enumsspb.TIMELINE_SPAN_TYPE_WORKFLOW_TASK, "", "", event, 0)
+		span.Attempt = event.GetWorkflowTaskScheduledEventAttributes().GetAttempt()
+	case enumspb.EVENT_TYPE_WORKFLOW_TASK_STARTED:
+		scheduledEventID := event.GetWorkflowTaskStartedEventAttributes().GetScheduledEventId()
+		if b.startSpan(scheduledEventID, event) != nil {
+			b.workflowTaskStartedEventIDs[b.spanIndexes[scheduledEventID]] = event.GetEventId()
+		}
+	case enumspb.EVENT_TYPE_WORKFLOW_TASK_COMPLETED:
+		b.closeSpan(event.GetWorkflowTaskCompletedEventAttributes().GetScheduledEventId(), event)
+	case enumspb.EVENT_TYPE_WORKFLOW_TASK_FAILED:
+		b.closeSpan(event.GetWorkflowTaskFailedEventAttributes().GetScheduledEventId(), event)
+	case enumspb.EVENT_TYPE_WORKFLOW_TASK_TIMED_OUT:
+		b.closeSpan(event.GetWorkflowTaskTimedOutEventAttributes().GetScheduledEventId(), event)
+
+	case enumspb.EVENT_TYPE_ACTIVITY_TASK_SCHEDULED:
+		attributes := event.GetActivityTaskScheduledEventAttributes()
+		b.openSpan(enumsspb.TIMELINE_SPAN_TYPE_ACTIVITY, attributes.GetActivityType().GetName(), attributes.GetActivityId(), event, attributes.GetWorkflowTaskCompletedEventId())
+	case enumspb.EVENT_TYPE_ACTIVITY_TASK_STARTED:
+		attributes := event.GetActivityTaskStartedEventAttributes()
+		if span := b.startSpan(attributes.GetScheduledEventId(), event); span != nil {
+			span.Attempt = attributes.GetAttempt()
+		}
+	case enumspb.EVENT_TYPE_ACTIVITY_TASK_COMPLETED:
+		b.closeSpan(event.GetActivityTaskCompletedEventAttributes().GetScheduledEventId(), event)
+	case enumspb.EVENT_TYPE_ACTIVITY_TASK_FAILED:
+		b.closeSpan(event.GetActivityTaskFailedEventAttributes().GetScheduledEventId(), event)
+	case enumspb.EVENT_TYPE_ACTIVITY_TASK_TIMED_OUT:
+		b.closeSpan(event.GetActivityTaskTimedOutEventAttributes().GetScheduledEventId(), event)
+	case enumspb.EVENT_TYPE_ACTIVITY_TASK_CANCELED:
+		b.closeSpan(event.GetActivityTaskCanceledEventAttributes().GetScheduledEventId(), event)
+
+	case enumspb.EVENT_TYPE_TIMER_STARTED:
+		attributes := event.GetTimerStartedEventAttributes()
+		b.openSpan(enumsspb.TIMELINE_SPAN_TYPE_TIMER, attributes.GetTimerId(), "", event, attributes.GetWorkflowTaskCompletedEventId())
+	case enumspb.EVENT_TYPE_TIMER_FIRED:
+		b.closeSpan(event.GetTimerFiredEventAttributes().GetStartedEventId(), event)
+	case enumspb.EVENT_TYPE_TIMER_CANCELED:
+		b.closeSpan(event.GetTimerCanceledEventAttributes().GetStartedEventId(), event)
+
+	case enumspb.EVENT_TYPE_START_CHILD_WORKFLOW_EXECUTION_INITIATED:
+		attributes := event.GetStartChildWorkflowExecutionInitiatedEventAttributes()
+		b.openSpan(enumsspb.TIMELINE_SPAN_TYPE_CHILD_WORKFLOW, attributes.GetWorkflowType().GetName(), attributes.GetWorkflowId(), event, attributes.GetWorkflowTaskCompletedEventId())
+	case enumspb.EVENT_TYPE_START_CHILD_WORKFLOW_EXECUTION_FAILED:
+		b.closeSpan(event.GetStartChildWorkflowExecutionFailedEventAttributes().GetInitiatedEventId(), event)
+	case enumspb.EVENT_TYPE_CHILD_WORKFLOW_EXECUTION_STARTED:
+		b.startSpan(event.GetChildWorkflowExecutionStartedEventAttributes().GetInitiatedEventId(), event)
+	case enumspb.EVENT_TYPE_CHILD_WORKFLOW_EXECUTION_COMPLETED:
+		b.closeSpan(event.GetChildWorkflowExecutionCompletedEventAttributes().GetInitiatedEventId(), event)
+	case enumspb.EVENT_TYPE_CHILD_WORKFLOW_EXECUTION_FAILED:
+		b.closeSpan(event.GetChildWorkflowExecutionFailedEventAttributes().GetInitiatedEventId(), event)
+	case enumspb.EVENT_TYPE_CHILD_WORKFLOW_EXECUTION_CANCELED:
+		b.closeSpan(event.GetChildWorkflowExecutionCanceledEventAttributes().GetInitiatedEventId(), event)
+	case enumspb.EVENT_TYPE_CHILD_WORKFLOW_EXECUTION_TIMED_OUT:
+		b.closeSpan(event.GetChildWorkflowExecutionTimedOutEventAttributes().GetInitiatedEventId(), event)
+	case enumspb.EVENT_TYPE_CHILD_WORKFLOW_EXECUTION_TERMINATED:
+		b.closeSpan(event.GetChildWorkflowExecutionTerminatedEventAttributes().GetInitiatedEventId(), event)
+
+	case enumspb.EVENT_TYPE_NEXUS_OPERATION_SCHEDULED:
+		attributes := event.GetNexusOperationScheduledEventAttributes()
+		b.openSpan(enumsspb.TIMELINE_SPAN_TYPE_NEXUS_OPERATION, attributes.GetService()+"/"+attributes.GetOperation(), "", event, attributes.GetWorkflowTaskCompletedEventId())
+	case enumspb.EVENT_TYPE_NEXUS_OPERATION_STARTED:
+		attributes := event.GetNexusOperationStartedEventAttributes()
+		if span := b.startSpan(attributes.GetScheduledEventId(), event); span != nil {
+			span.Id = attributes.GetOperationToken()
+		}
+	case enumspb.EVENT_TYPE_NEXUS_OPERATION_COMPLETED:
+		b.closeSpan(event.GetNexusOperationCompletedEventAttributes().GetScheduledEventId(), event)
+	case enumspb.EVENT_TYPE_NEXUS_OPERATION_FAILED:
+		b.closeSpan(event.GetNexusOperationFailedEventAttributes().GetScheduledEventId(), event)
+	case enumspb.EVENT_TYPE_NEXUS_OPERATION_CANCELED:
+		b.closeSpan(event.GetNexusOperationCanceledEventAttributes().GetScheduledEventId(), event)
+	case enumspb.EVENT_TYPE_NEXUS_OPERATION_TIMED_OUT:
+		b.closeSpan(event.GetNexusOperationTimedOutEventAttributes().GetScheduledEventId(), event)
+
+	case enumspb.EVENT_TYPE_WORKFLOW_EXECUTION_COMPLETED:
+		b.closeWorkflow(event, event.GetWorkflowExecutionCompletedEventAttributes().GetWorkflowTaskCompletedEventId())
+	case enumspb.EVENT_TYPE_WORKFLOW_EXECUTION_FAILED:
+		b.closeWorkflow(event, event.GetWorkflowExecutionFailedEventAttributes().GetWorkflowTaskCompletedEventId())
+	case enumspb.EVENT_TYPE_WORKFLOW_EXECUTION_CANCELED:
+		b.closeWorkflow(event, event.GetWorkflowExecutionCanceledEventAttributes().GetWorkflowTaskCompletedEventId())
+	case enumspb.EVENT_TYPE_WORKFLOW_EXECUTION_CONTINUED_AS_NEW:
+		b.closeWorkflow(event, event.GetWorkflowExecutionContinuedAsNewEventAttributes().GetWorkflowTaskCompletedEventId())
+	case enumspb.EVENT_TYPE_WORKFLOW_EXECUTION_TERMINATED,
+		enumspb.EVENT_TYPE_WORKFLOW_EXECUTION_TIMED_OUT:
+		b.closeWorkflow(event, 0)
+	}
+}
+
+// build completes the timeline. The end of running workflows is set to now, pending spans are left open.
+func (b *timelineBuilder) build(running bool, now time.Time) *adminservice.WorkflowExecutionTimeline {
+	if running {
+		b.timeline.Running = true
+		b.timeline.EndTime = timestamppb.New(now)
+	}
+	b.timeline.CriticalPath = b.criticalPath()
+	return b.timeline
+}
+
+func (b *timelineBuilder) openSpan(
+	spanType enumsspb.TimelineSpanType,
+	name string,
+	id string,
+	event *historypb.HistoryEvent,
+	workflowTaskCompletedEventID int64,
+) *adminservice.TimelineSpan {
+	span := &adminservice.TimelineSpan{
+		Type:                         spanType,
+		Name:                         name,
+		Id:                           id,
+		EventId:                      event.GetEventId(),
+		WorkflowTaskCompletedEventId: workflowTaskCompletedEventID,
+		ScheduleTime:                 event.GetEventTime(),
+	}
+	b.spanIndexes[event.GetEventId()] = len(b.timeline.Spans)
+	b.timeline.Spans = append(b.timeline.Spans, span)
+	return span
+}
+
+func (b *timelineBuilder) startSpan(eventID int64, event *historypb.HistoryEvent) *adminservice.TimelineSpan {
+	index, ok := b.spanIndexes[eventID]
+	if !ok {
+		return nil
+	}
+	span := b.timeline.Spans[index]
+	span.StartTime = event.GetEventTime()
+	span.ScheduleToStartLatency = latency(span.GetScheduleTime(), span.GetStartTime())
+	return span
+}
+
+func (b *timelineBuilder) closeSpan(eventID int64, event *historypb.HistoryEvent) {
+	index, ok := b.spanIndexes[eventID]
+	if !ok {
+		return
+	}
+	span := b.timeline.Spans[index]
+	span.EndEventId = event.GetEventId()
+	span.EndEventType = event.GetEventType()
+	span.EndTime = event.GetEventTime()
+	if span.StartTime != nil {
+		span.StartToCloseLatency = latency(span.GetStartTime(), span.GetEndTime())
+	}
+	b.spanIndexesByEndEventID[event.GetEventId()] = index
+	if span.GetType() == enumsspb.TIMELINE_SPAN_TYPE_WORKFLOW_TASK {
+		b.closedWorkflowTasks = append(b.closedWorkflowTasks, index)
+	} else {
+		b.closedSpans = append(b.closedSpans, index)
+	}
+}
+
+func (b *timelineBuilder) closeWorkflow(event *historypb.HistoryEvent, workflowTaskCompletedEventID int64) {
+	b.timeline.EndTime = event.GetEventTime()
+	b.closeWorkflowTaskCompletedEventID = workflowTaskCompletedEventID
+}
+
+// criticalPath walks back from the end of the workflow. A workflow task waited on the last span that closed
+// between the start of the previous workflow task and its own scheduling, or on the previous workflow task if
+// there is no such span (e.g. it was scheduled for a signal or a workflow task retry). Any other span waited on
+// the workflow task that opened it.
+func (b *timelineBuilder) criticalPath() []int32 {
+	current := b.lastSpan()
+	var path []int32
+	for current >= 0 {
+		path = append(path, int32(current))
+		span := b.timeline.Spans[current]
+		if span.GetType() == enumsspb.TIMELINE_SPAN_TYPE_WORKFLOW_TASK {
+			current = b.workflowTaskCause(current)
+		} else {
+			current = b.spanClosedBy(span.GetWorkflowTaskCompletedEventId(), enumsspb.TIMELINE_SPAN_TYPE_WORKFLOW_TASK)
+		}
+	}
+	for i, j := 0, len(path)-1; i < j; i, j = i+1, j-1 {
+		path[i], path[j] = path[j], path[i]
+	}
+	return path
+}
+
+// lastSpan returns the span the workflow ended with: the workflow task that closed it, the latest pending span
+// or the span that closed last, in that order.
+func (b *timelineBuilder) lastSpan() int {
+	if b.closeWorkflowTaskCompletedEventID != 0 {
+		if index := b.spanClosedBy(b.closeWorkflowTaskCompletedEventID, enumsspb.TIMELINE_SPAN_TYPE_WORKFLOW_TASK); index >= 0 {
+			return index
+		}
+	}
+	last := -1
+	for index, span := range b.timeline.Spans {
+		if span.GetEndEventId() == 0 {
+			last = index
+		}
+	}
+	if last >= 0 {
+		return last
+	}
+	return b.lastClosedBefore(math.MaxInt64, b.closedWorkflowTasks, b.closedSpans)
+}
+
+func (b *timelineBuilder) workflowTaskCause(workflowTask int) int {
+	scheduledEventID := b.timeline.Spans[workflowTask].GetEventId()
+
+	previous := b.lastClosedBefore(scheduledEventID, b.closedWorkflowTasks)
+	// spans that closed before the previous workflow task started were already seen by it
+	var previousStartedEventID int64
+	if previous >= 0 {
+		previousStartedEventID = b.workflowTaskStartedEventIDs[previous]
+		if previousStartedEventID == 0 {
+			previousStartedEventID = b.timeline.Spans[previous].GetEventId()
+		}
+	}
+
+	cause := b.lastClosedBefore(scheduledEventID, b.closedSpans)
+	if cause >= 0 && b.timeline.Spans[cause].GetEndEventId() > previousStartedEventID {
+		return cause
+	}
+	return previous
+}
+
+// lastClosedBefore returns the span of the given lists that closed last before the event, or -1.
+func (b *timelineBuilder) lastClosedBefore(eventID int64, spanLists ...[]int) int {
+	last := -1
+	for _, indexes := range spanLists {
+		n := sort.Search(len(indexes), func(i int) bool {
+			return b.timeline.Spans[indexes[i]].GetEndEventId() >= eventID
+		})
+		if n > 0 && (last < 0 || b.timeline.Spans[indexes[n-1]].GetEndEventId() > b.timeline.Spans[last].GetEndEventId()) {
+			last = indexes[n-1]
+		}
+	}
+	return last
+}
+
+func (b *timelineBuilder) spanClosedBy(endEventID int64, spanType enumsspb.TimelineSpanType) int {
+	index, ok := b.spanIndexesByEndEventID[endEventID]
+	if !ok || endEventID == 0 || b.timeline.Spans[index].GetType() != spanType {
+		return -1
+	}
+	return index
+}
+
+func latency(from *timestamppb.Timestamp, to *timestamppb.Timestamp) *durationpb.Duration {
+	return durationpb.New(to.AsTime().Sub(from.AsTime()))
+}
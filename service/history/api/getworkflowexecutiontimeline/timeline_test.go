@@ -0,0 +1,138 @@
+package getworkflowexecutiontimeline
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	commonpb "go.temporal.io/api/common/v1"
+	enumspb "go.temporal.io/api/enums/v1"
+	historypb "go.temporal.io/api/history/v1"
+	enumsspb "go.temporal.io/server/api/enums/v1"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+type testHistory struct {
+	startTime time.Time
+	events    []*historypb.HistoryEvent
+}
+
+func (h *testHistory) add(offset time.Duration, eventType enumspb.EventType, attributes any) {
+	event := &historypb.HistoryEvent{
+		EventId:   int64(len(h.events) + 1),
+		EventTime: timestamppb.New(h.startTime.Add(offset)),
+		EventType: eventType,
+	}
+	switch a := attributes.(type) {
+	case *historypb.WorkflowExecutionStartedEventAttributes:
+		event.Attributes = &historypb.HistoryEvent_WorkflowExecutionStartedEventAttributes{WorkflowExecutionStartedEventAttributes: a}
+	case *historypb.WorkflowTaskScheduledEventAttributes:
+		event.Attributes = &historypb.HistoryEvent_WorkflowTaskScheduledEventAttributes{WorkflowTaskScheduledEventAttributes: a}
+	case *historypb.WorkflowTaskStartedEventAttributes:
+		event.Attributes = &historypb.HistoryEvent_WorkflowTaskStartedEventAttributes{WorkflowTaskStartedEventAttributes: a}
+	case *historypb.WorkflowTaskCompletedEventAttributes:
+		event.Attributes = &historypb.HistoryEvent_WorkflowTaskCompletedEventAttributes{WorkflowTaskCompletedEventAttributes: a}
+	case *historypb.ActivityTaskScheduledEventAttributes:
+		event.Attributes = &historypb.HistoryEvent_ActivityTaskScheduledEventAttributes{ActivityTaskScheduledEventAttributes: a}
+	case *historypb.ActivityTaskStartedEventAttributes:
+		event.Attributes = &historypb.HistoryEvent_ActivityTaskStartedEventAttributes{ActivityTaskStartedEventAttributes: a}
+	case *historypb.ActivityTaskCompletedEventAttributes:
+		event.Attributes = &historypb.HistoryEvent_ActivityTaskCompletedEventAttributes{ActivityTaskCompletedEventAttributes: a}
+	case *historypb.TimerStartedEventAttributes:
+		event.Attributes = &historypb.HistoryEvent_TimerStartedEventAttributes{TimerStartedEventAttributes: a}
+	case *historypb.TimerFiredEventAttributes:
+		event.Attributes = &historypb.HistoryEvent_TimerFiredEventAttributes{TimerFiredEventAttributes: a}
+	case *historypb.WorkflowExecutionCompletedEventAttributes:
+		event.Attributes = &historypb.HistoryEvent_WorkflowExecutionCompletedEventAttributes{WorkflowExecutionCompletedEventAttributes: a}
+	}
+	h.events = append(h.events, event)
+}
+
+// addWorkflowTask adds a completed workflow task and returns the ID of its completed event.
+func (h *testHistory) addWorkflowTask(offset time.Duration) int64 {
+	scheduledEventID := int64(len(h.events) + 1)
+	h.add(offset, enumspb.EVENT_TYPE_WORKFLOW_TASK_SCHEDULED, &historypb.WorkflowTaskScheduledEventAttributes{Attempt: 1})
+	h.add(offset, enumspb.EVENT_TYPE_WORKFLOW_TASK_STARTED, &historypb.WorkflowTaskStartedEventAttributes{ScheduledEventId: scheduledEventID})
+	h.add(offset+time.Second, enumspb.EVENT_TYPE_WORKFLOW_TASK_COMPLETED, &historypb.WorkflowTaskCompletedEventAttributes{ScheduledEventId: scheduledEventID})
+	return int64(len(h.events))
+}
+
+func (h *testHistory) build(running bool, now time.Time) *timelineBuilder {
+	builder := newTimelineBuilder()
+	for _, event := range h.events {
+		builder.addEvent(event)
+	}
+	builder.build(running, now)
+	return builder
+}
+
+func TestTimeline_CriticalPath(t *testing.T) {
+	h := &testHistory{startTime: time.Unix(1000, 0).UTC()}
+	h.add(0, enumspb.EVENT_TYPE_WORKFLOW_EXECUTION_STARTED, &historypb.WorkflowExecutionStartedEventAttributes{})
+	completedEventID := h.addWorkflowTask(0)
+	h.add(time.Second, enumspb.EVENT_TYPE_ACTIVITY_TASK_SCHEDULED, &historypb.ActivityTaskScheduledEventAttributes{
+		ActivityId:                   "activity-id",
+		ActivityType:                 &commonpb.ActivityType{Name: "activity-type"},
+		WorkflowTaskCompletedEventId: completedEventID,
+	})
+	h.add(time.Second, enumspb.EVENT_TYPE_TIMER_STARTED, &historypb.TimerStartedEventAttributes{
+		TimerId:                      "timer-id",
+		WorkflowTaskCompletedEventId: completedEventID,
+	})
+	h.add(3*time.Second, enumspb.EVENT_TYPE_ACTIVITY_TASK_STARTED, &historypb.ActivityTaskStartedEventAttributes{ScheduledEventId: 5, Attempt: 3})
+	h.add(8*time.Second, enumspb.EVENT_TYPE_ACTIVITY_TASK_COMPLETED, &historypb.ActivityTaskCompletedEventAttributes{ScheduledEventId: 5})
+	h.addWorkflowTask(8 * time.Second)
+	// the timer outlasts the activity
+	h.add(20*time.Second, enumspb.EVENT_TYPE_TIMER_FIRED, &historypb.TimerFiredEventAttributes{TimerId: "timer-id", StartedEventId: 6})
+	completedEventID = h.addWorkflowTask(20 * time.Second)
+	h.add(21*time.Second, enumspb.EVENT_TYPE_WORKFLOW_EXECUTION_COMPLETED, &historypb.WorkflowExecutionCompletedEventAttributes{
+		WorkflowTaskCompletedEventId: completedEventID,
+	})
+
+	timeline := h.build(false, time.Time{}).timeline
+	require.False(t, timeline.GetRunning())
+	require.Equal(t, h.startTime, timeline.GetStartTime().AsTime())
+	require.Equal(t, h.startTime.Add(21*time.Second), timeline.GetEndTime().AsTime())
+	require.Len(t, timeline.GetSpans(), 5)
+
+	activity := timeline.GetSpans()[1]
+	require.Equal(t, enumsspb.TIMELINE_SPAN_TYPE_ACTIVITY, activity.GetType())
+	require.Equal(t, "activity-type", activity.GetName())
+	require.Equal(t, "activity-id", activity.GetId())
+	require.Equal(t, int64(5), activity.GetEventId())
+	require.Equal(t, int64(8), activity.GetEndEventId())
+	require.Equal(t, enumspb.EVENT_TYPE_ACTIVITY_TASK_COMPLETED, activity.GetEndEventType())
+	require.Equal(t, int32(3), activity.GetAttempt())
+	require.Equal(t, 2*time.Second, activity.GetScheduleToStartLatency().AsDuration())
+	require.Equal(t, 5*time.Second, activity.GetStartToCloseLatency().AsDuration())
+
+	timer := timeline.GetSpans()[2]
+	require.Equal(t, enumsspb.TIMELINE_SPAN_TYPE_TIMER, timer.GetType())
+	require.Equal(t, "timer-id", timer.GetName())
+	require.Equal(t, enumspb.EVENT_TYPE_TIMER_FIRED, timer.GetEndEventType())
+	require.Nil(t, timer.GetStartTime())
+
+	// first workflow task -> timer -> last workflow task
+	require.Equal(t, []int32{0, 2, 4}, timeline.GetCriticalPath())
+}
+
+func TestTimeline_Running(t *testing.T) {
+	h := &testHistory{startTime: time.Unix(1000, 0).UTC()}
+	h.add(0, enumspb.EVENT_TYPE_WORKFLOW_EXECUTION_STARTED, &historypb.WorkflowExecutionStartedEventAttributes{})
+	completedEventID := h.addWorkflowTask(0)
+	h.add(time.Second, enumspb.EVENT_TYPE_ACTIVITY_TASK_SCHEDULED, &historypb.ActivityTaskScheduledEventAttributes{
+		ActivityId:                   "activity-id",
+		ActivityType:                 &commonpb.ActivityType{Name: "activity-type"},
+		WorkflowTaskCompletedEventId: completedEventID,
+	})
+
+	now := h.startTime.Add(time.Minute)
+	timeline := h.build(true, now).timeline
+	require.True(t, timeline.GetRunning())
+	require.Equal(t, now, timeline.GetEndTime().AsTime())
+	require.Len(t, timeline.GetSpans(), 2)
+	require.Zero(t, timeline.GetSpans()[1].GetEndEventId())
+	require.Nil(t, timeline.GetSpans()[1].GetStartToCloseLatency())
+	// the workflow is waiting on the pending activity
+	require.Equal(t, []int32{0, 1}, timeline.GetCriticalPath())
+}
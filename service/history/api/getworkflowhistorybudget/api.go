@@ -0,0 +1,61 @@
+package getworkflowhistorybudget
+
+import (
+	"context"
+
+	"go.temporal.io/server/api/adminservice/v1"
+	"go.temporal.io/server/api/historyservice/v1"
+	"go.temporal.io/server/common/definition"
+	"go.temporal.io/server/common/locks"
+	"go.temporal.io/server/common/namespace"
+	"go.temporal.io/server/service/history/api"
+	"go.temporal.io/server/service/history/consts"
+	historyi "go.temporal.io/server/service/history/interfaces"
+	"go.temporal.io/server/service/history/workflow"
+)
+
+func Invoke(
+	ctx context.Context,
+	request *historyservice.GetWorkflowHistoryBudgetRequest,
+	shardContext historyi.ShardContext,
+	workflowConsistencyChecker api.WorkflowConsistencyChecker,
+) (_ *historyservice.GetWorkflowHistoryBudgetResponse, retError error) {
+	namespaceID := namespace.ID(request.GetNamespaceId())
+	if err := api.ValidateNamespaceUUID(namespaceID); err != nil {
+		return nil, err
+	}
+	execution := request.GetRequest().GetExecution()
+	if execution.GetWorkflowId() == "" {
+		return nil, consts.ErrWorkflowIDNotSet
+	}
+
+	workflowLease, err := workflowConsistencyChecker.GetWorkflowLease(
+		ctx,
+		nil,
+		definition.NewWorkflowKey(
+			namespaceID.String(),
+			execution.GetWorkflowId(),
+			execution.GetRunId(),
+		),
+		locks.PriorityHigh,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer func() { workflowLease.GetReleaseFn()(retError) }()
+
+	mutableState := workflowLease.GetMutableState()
+	usage := workflow.GetHistoryBudgetUsage(shardContext.GetConfig(), mutableState)
+	return &historyservice.GetWorkflowHistoryBudgetResponse{
+		Usage: &adminservice.WorkflowHistoryBudgetUsage{
+			WorkflowType:        mutableState.GetExecutionInfo().GetWorkflowTypeName(),
+			HasBudget:           usage.HasBudget,
+			HistorySizeBytes:    usage.HistorySize,
+			MaxHistorySizeBytes: usage.Budget.MaxHistorySizeBytes,
+			HistoryCount:        usage.HistoryCount,
+			MaxHistoryCount:     usage.Budget.MaxHistoryCount,
+			ConsumedRatio:       usage.ConsumedRatio,
+			Actions:             usage.Actions(),
+		},
+	}, nil
+}
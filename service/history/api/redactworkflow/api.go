@@ -0,0 +1,353 @@
+package redactworkflow
+
+import (
+	"context"
+
+	commonpb "go.temporal.io/api/common/v1"
+	historypb "go.temporal.io/api/history/v1"
+	"go.temporal.io/api/proxy"
+	sdkpb "go.temporal.io/api/sdk/v1"
+	"go.temporal.io/api/serviceerror"
+	"go.temporal.io/server/api/adminservice/v1"
+	"go.temporal.io/server/api/historyservice/v1"
+	persistencespb "go.temporal.io/server/api/persistence/v1"
+	"go.temporal.io/server/common"
+	"go.temporal.io/server/common/definition"
+	"go.temporal.io/server/common/locks"
+	"go.temporal.io/server/common/namespace"
+	"go.temporal.io/server/common/payload"
+	"go.temporal.io/server/common/persistence"
+	"go.temporal.io/server/service/history/api"
+	"go.temporal.io/server/service/history/events"
+	historyi "go.temporal.io/server/service/history/interfaces"
+	"go.temporal.io/server/service/history/workflow"
+)
+
+const (
+	readHistoryPageSize = 256
+)
+
+var (
+	errNothingToRedact     = serviceerror.NewInvalidArgument("Either event IDs or memo keys must be set.")
+	errWorkflowNotClosed   = serviceerror.NewFailedPrecondition("Only closed workflow executions can be redacted.")
+	errMemoNotInVisibility = serviceerror.NewFailedPrecondition("Unable to fetch the memo of the workflow from visibility.")
+)
+
+type (
+	// redactionDetails are the details of the event recording a redaction.
+	redactionDetails struct {
+		Identity string   `json:"identity,omitempty"`
+		EventIDs []int64  `json:"eventIds,omitempty"`
+		MemoKeys []string `json:"memoKeys,omitempty"`
+	}
+
+	// redactor replaces the selected payloads of history events with the redaction marker.
+	redactor struct {
+		eventIDs map[int64]struct{}
+		memoKeys []string
+	}
+)
+
+func Invoke(
+	ctx context.Context,
+	request *historyservice.RedactWorkflowExecutionRequest,
+	shardContext historyi.ShardContext,
+	workflowConsistencyChecker api.WorkflowConsistencyChecker,
+	relocatableAttributesFetcher workflow.RelocatableAttributesFetcher,
+) (_ *historyservice.RedactWorkflowExecutionResponse, retError error) {
+	namespaceID := namespace.ID(request.GetNamespaceId())
+	if err := api.ValidateNamespaceUUID(namespaceID); err != nil {
+		return nil, err
+	}
+	if _, err := api.GetActiveNamespace(shardContext, namespaceID); err != nil {
+		return nil, err
+	}
+	redactRequest := request.GetRedactRequest()
+	if len(redactRequest.GetEventIds()) == 0 && len(redactRequest.GetMemoKeys()) == 0 {
+		return nil, errNothingToRedact
+	}
+
+	workflowLease, err := workflowConsistencyChecker.GetWorkflowLease(
+		ctx,
+		nil,
+		definition.NewWorkflowKey(
+			namespaceID.String(),
+			redactRequest.GetExecution().GetWorkflowId(),
+			redactRequest.GetExecution().GetRunId(),
+		),
+		locks.PriorityHigh,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer func() { workflowLease.GetReleaseFn()(retError) }()
+
+	mutableState := workflowLease.GetMutableState()
+	if mutableState.IsWorkflowExecutionRunning() {
+		return nil, errWorkflowNotClosed
+	}
+	r := &redactor{
+		eventIDs: make(map[int64]struct{}, len(redactRequest.GetEventIds())),
+		memoKeys: redactRequest.GetMemoKeys(),
+	}
+	for _, eventID := range redactRequest.GetEventIds() {
+		if eventID < common.FirstEventID || eventID >= mutableState.GetNextEventID() {
+			return nil, serviceerror.NewInvalidArgumentf("Event ID %v is not in the history of the workflow.", eventID)
+		}
+		r.eventIDs[eventID] = struct{}{}
+	}
+
+	// history is rewritten first, a failure afterward leaves the memo and the visibility record to
+	// be redacted when the request is retried.
+	if err := redactHistory(ctx, shardContext, mutableState, r); err != nil {
+		return nil, err
+	}
+	if len(r.memoKeys) > 0 {
+		if err := redactVisibilityMemo(ctx, mutableState, relocatableAttributesFetcher, r.memoKeys); err != nil {
+			return nil, err
+		}
+	}
+
+	if err := addRedactionEvent(mutableState, redactRequest); err != nil {
+		return nil, err
+	}
+	now := shardContext.GetTimeSource().Now()
+	if err := workflow.NewTaskGenerator(
+		shardContext.GetNamespaceRegistry(),
+		mutableState,
+		shardContext.GetConfig(),
+		shardContext.GetArchivalMetadata(),
+	).GenerateWorkflowRedactionTasks(now); err != nil {
+		return nil, err
+	}
+	if err := workflowLease.GetContext().UpdateWorkflowExecutionAsActive(ctx, shardContext); err != nil {
+		return nil, err
+	}
+	return &historyservice.RedactWorkflowExecutionResponse{}, nil
+}
+
+// addRedactionEvent records the redaction in a system event appended to the history of the
+// workflow. The event carries the redacted memo fields, and the reason and details of the
+// redaction in its user metadata.
+func addRedactionEvent(
+	mutableState historyi.MutableState,
+	redactRequest *adminservice.RedactWorkflowExecutionRequest,
+) error {
+	redactedMemo := make(map[string]*commonpb.Payload)
+	for _, key := range redactRequest.GetMemoKeys() {
+		redactedMemo[key] = payload.Redacted()
+	}
+	details, err := payload.Encode(redactionDetails{
+		Identity: redactRequest.GetIdentity(),
+		EventIDs: redactRequest.GetEventIds(),
+		MemoKeys: redactRequest.GetMemoKeys(),
+	})
+	if err != nil {
+		return err
+	}
+	_, err = mutableState.AddWorkflowPropertiesModifiedExternallyEvent(
+		&commonpb.Memo{Fields: redactedMemo},
+		&sdkpb.UserMetadata{
+			Summary: payload.EncodeString(redactRequest.GetReason()),
+			Details: details,
+		},
+	)
+	return err
+}
+
+// redactHistory overwrites the batches of the current history branch that contain a redacted
+// payload. Batches are written back with their original node and transaction IDs, so the stores
+// replace the original data rather than superseding it.
+func redactHistory(
+	ctx context.Context,
+	shardContext historyi.ShardContext,
+	mutableState historyi.MutableState,
+	r *redactor,
+) error {
+	branchToken, err := mutableState.GetCurrentBranchToken()
+	if err != nil {
+		return err
+	}
+	executionManager := shardContext.GetExecutionManager()
+	branchInfo, err := executionManager.GetHistoryBranchUtil().ParseHistoryBranchInfo(branchToken)
+	if err != nil {
+		return err
+	}
+	workflowKey := mutableState.GetWorkflowKey()
+
+	prevTransactionID := common.EmptyEventTaskID
+	var nextPageToken []byte
+	for {
+		resp, err := executionManager.ReadHistoryBranchByBatch(ctx, &persistence.ReadHistoryBranchRequest{
+			ShardID:       shardContext.GetShardID(),
+			BranchToken:   branchToken,
+			MinEventID:    common.FirstEventID,
+			MaxEventID:    mutableState.GetNextEventID(),
+			PageSize:      readHistoryPageSize,
+			NextPageToken: nextPageToken,
+		})
+		if err != nil {
+			return err
+		}
+		for i, batch := range resp.History {
+			transactionID := resp.TransactionIDs[i]
+			redactedEvents, redacted, err := r.redactEvents(ctx, batch.GetEvents())
+			if err != nil {
+				return err
+			}
+			if redacted {
+				if err := rewriteHistoryBatch(
+					ctx,
+					shardContext,
+					workflowKey,
+					branchToken,
+					branchInfo,
+					redactedEvents,
+					prevTransactionID,
+					transactionID,
+				); err != nil {
+					return err
+				}
+			}
+			prevTransactionID = transactionID
+		}
+		nextPageToken = resp.NextPageToken
+		if len(nextPageToken) == 0 {
+			return nil
+		}
+	}
+}
+
+func rewriteHistoryBatch(
+	ctx context.Context,
+	shardContext historyi.ShardContext,
+	workflowKey definition.WorkflowKey,
+	branchToken []byte,
+	branchInfo *persistencespb.HistoryBranch,
+	batch []*historypb.HistoryEvent,
+	prevTransactionID int64,
+	transactionID int64,
+) error {
+	// nodes before the fork point of the branch are stored in the branch of the run this
+	// workflow was reset from
+	if batch[0].GetEventId() < persistence.GetBeginNodeID(branchInfo) {
+		return serviceerror.NewInvalidArgumentf(
+			"Event ID %v belongs to the history of the run this workflow was reset from, redact that run instead.",
+			batch[0].GetEventId(),
+		)
+	}
+
+	if _, err := shardContext.AppendHistoryEvents(
+		ctx,
+		&persistence.AppendHistoryNodesRequest{
+			Info:              persistence.BuildHistoryGarbageCleanupInfo(workflowKey.NamespaceID, workflowKey.WorkflowID, workflowKey.RunID),
+			BranchToken:       branchToken,
+			Events:            batch,
+			PrevTransactionID: prevTransactionID,
+			TransactionID:     transactionID,
+			Overwrite:         true,
+		},
+		namespace.ID(workflowKey.NamespaceID),
+		&commonpb.WorkflowExecution{
+			WorkflowId: workflowKey.WorkflowID,
+			RunId:      workflowKey.RunID,
+		},
+	); err != nil {
+		return err
+	}
+
+	for _, event := range batch {
+		shardContext.GetEventsCache().DeleteEvent(events.EventKey{
+			NamespaceID: namespace.ID(workflowKey.NamespaceID),
+			WorkflowID:  workflowKey.WorkflowID,
+			RunID:       workflowKey.RunID,
+			EventID:     event.GetEventId(),
+			Version:     event.GetVersion(),
+		})
+	}
+	return nil
+}
+
+// redactVisibilityMemo replaces the redacted memo fields in mutable state. Memo and search attributes
+// of closed workflows may already have been moved to visibility, in which case they are brought back
+// so that the visibility record can be rewritten.
+func redactVisibilityMemo(
+	ctx context.Context,
+	mutableState historyi.MutableState,
+	relocatableAttributesFetcher workflow.RelocatableAttributesFetcher,
+	memoKeys []string,
+) error {
+	executionInfo := mutableState.GetExecutionInfo()
+	if executionInfo.GetRelocatableAttributesRemoved() {
+		attributes, err := relocatableAttributesFetcher.Fetch(ctx, mutableState)
+		if err != nil {
+			return err
+		}
+		if attributes.Memo == nil && attributes.SearchAttributes == nil {
+			return errMemoNotInVisibility
+		}
+		executionInfo.Memo = attributes.Memo.GetFields()
+		executionInfo.SearchAttributes = attributes.SearchAttributes.GetIndexedFields()
+		executionInfo.RelocatableAttributesRemoved = false
+	}
+	redactMemo(executionInfo.Memo, memoKeys)
+	return nil
+}
+
+// redactEvents returns a copy of the batch with the selected payloads replaced by the redaction
+// marker, and whether any payload was replaced. Search attributes are left untouched.
+func (r *redactor) redactEvents(
+	ctx context.Context,
+	batch []*historypb.HistoryEvent,
+) ([]*historypb.HistoryEvent, bool, error) {
+	redacted := false
+	result := make([]*historypb.HistoryEvent, 0, len(batch))
+	for _, event := range batch {
+		event = common.CloneProto(event)
+		if _, ok := r.eventIDs[event.GetEventId()]; ok {
+			if err := proxy.VisitPayloads(ctx, event, proxy.VisitPayloadsOptions{
+				Visitor: func(_ *proxy.VisitPayloadsContext, payloads []*commonpb.Payload) ([]*commonpb.Payload, error) {
+					for i := range payloads {
+						payloads[i] = payload.Redacted()
+						redacted = true
+					}
+					return payloads, nil
+				},
+				SkipSearchAttributes: true,
+			}); err != nil {
+				return nil, false, err
+			}
+		}
+		if redactMemo(eventMemo(event), r.memoKeys) {
+			redacted = true
+		}
+		result = append(result, event)
+	}
+	return result, redacted, nil
+}
+
+// eventMemo returns the memo of the workflow set by the event, if any.
+func eventMemo(event *historypb.HistoryEvent) map[string]*commonpb.Payload {
+	switch attributes := event.GetAttributes().(type) {
+	case *historypb.HistoryEvent_WorkflowExecutionStartedEventAttributes:
+		return attributes.WorkflowExecutionStartedEventAttributes.GetMemo().GetFields()
+	case *historypb.HistoryEvent_WorkflowPropertiesModifiedEventAttributes:
+		return attributes.WorkflowPropertiesModifiedEventAttributes.GetUpsertedMemo().GetFields()
+	case *historypb.HistoryEvent_WorkflowPropertiesModifiedExternallyEventAttributes:
+		return attributes.WorkflowPropertiesModifiedExternallyEventAttributes.GetUpsertedMemo().GetFields()
+	default:
+		return nil
+	}
+}
+
+// redactMemo replaces the given memo fields with the redaction marker and returns true if any was present.
+func redactMemo(fields map[string]*commonpb.Payload, memoKeys []string) bool {
+	redacted := false
+	for _, key := range memoKeys {
+		if _, ok := fields[key]; ok {
+			fields[key] = payload.Redacted()
+			redacted = true
+		}
+	}
+	return redacted
+}
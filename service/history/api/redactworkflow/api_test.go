@@ -0,0 +1,135 @@
+package redactworkflow
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	commonpb "go.temporal.io/api/common/v1"
+	enumspb "go.temporal.io/api/enums/v1"
+	historypb "go.temporal.io/api/history/v1"
+	"go.temporal.io/server/common/definition"
+	"go.temporal.io/server/common/namespace"
+	"go.temporal.io/server/common/payload"
+	"go.temporal.io/server/common/persistence"
+	"go.temporal.io/server/common/primitives"
+	"go.temporal.io/server/service/history/events"
+	historyi "go.temporal.io/server/service/history/interfaces"
+	"go.temporal.io/server/service/history/tests"
+	"go.uber.org/mock/gomock"
+)
+
+func TestRedactEvents(t *testing.T) {
+	r := &redactor{
+		eventIDs: map[int64]struct{}{2: {}},
+		memoKeys: []string{"email"},
+	}
+	batch := []*historypb.HistoryEvent{
+		{
+			EventId:   1,
+			EventType: enumspb.EVENT_TYPE_WORKFLOW_EXECUTION_STARTED,
+			Attributes: &historypb.HistoryEvent_WorkflowExecutionStartedEventAttributes{WorkflowExecutionStartedEventAttributes: &historypb.WorkflowExecutionStartedEventAttributes{
+				Input: &commonpb.Payloads{Payloads: []*commonpb.Payload{payload.EncodeString("input")}},
+				Memo: &commonpb.Memo{Fields: map[string]*commonpb.Payload{
+					"email": payload.EncodeString("someone@example.com"),
+					"plan":  payload.EncodeString("free"),
+				}},
+			}},
+		},
+		{
+			EventId:   2,
+			EventType: enumspb.EVENT_TYPE_WORKFLOW_EXECUTION_SIGNALED,
+			Attributes: &historypb.HistoryEvent_WorkflowExecutionSignaledEventAttributes{WorkflowExecutionSignaledEventAttributes: &historypb.WorkflowExecutionSignaledEventAttributes{
+				Input: &commonpb.Payloads{Payloads: []*commonpb.Payload{payload.EncodeString("a"), payload.EncodeString("b")}},
+			}},
+		},
+	}
+
+	redactedBatch, redacted, err := r.redactEvents(context.Background(), batch)
+	require.NoError(t, err)
+	require.True(t, redacted)
+
+	startedAttributes := redactedBatch[0].GetWorkflowExecutionStartedEventAttributes()
+	require.False(t, payload.IsRedacted(startedAttributes.GetInput().GetPayloads()[0]))
+	require.True(t, payload.IsRedacted(startedAttributes.GetMemo().GetFields()["email"]))
+	require.False(t, payload.IsRedacted(startedAttributes.GetMemo().GetFields()["plan"]))
+	for _, p := range redactedBatch[1].GetWorkflowExecutionSignaledEventAttributes().GetInput().GetPayloads() {
+		require.True(t, payload.IsRedacted(p))
+	}
+
+	// the original batch is left untouched
+	require.False(t, payload.IsRedacted(batch[0].GetWorkflowExecutionStartedEventAttributes().GetMemo().GetFields()["email"]))
+	require.False(t, payload.IsRedacted(batch[1].GetWorkflowExecutionSignaledEventAttributes().GetInput().GetPayloads()[0]))
+
+	_, redacted, err = r.redactEvents(context.Background(), []*historypb.HistoryEvent{
+		{EventId: 3, EventType: enumspb.EVENT_TYPE_WORKFLOW_TASK_SCHEDULED},
+	})
+	require.NoError(t, err)
+	require.False(t, redacted)
+}
+
+func TestRedactHistory(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	workflowKey := definition.NewWorkflowKey(tests.NamespaceID.String(), tests.WorkflowID, tests.RunID)
+	branchToken, err := (&persistence.HistoryBranchUtilImpl{}).NewHistoryBranch(
+		workflowKey.NamespaceID, workflowKey.WorkflowID, workflowKey.RunID, primitives.NewUUID().String(), nil, nil, 0, 0, 0,
+	)
+	require.NoError(t, err)
+
+	mutableState := historyi.NewMockMutableState(ctrl)
+	mutableState.EXPECT().GetCurrentBranchToken().Return(branchToken, nil).AnyTimes()
+	mutableState.EXPECT().GetWorkflowKey().Return(workflowKey).AnyTimes()
+	mutableState.EXPECT().GetNextEventID().Return(int64(4)).AnyTimes()
+
+	executionManager := persistence.NewMockExecutionManager(ctrl)
+	executionManager.EXPECT().GetHistoryBranchUtil().Return(&persistence.HistoryBranchUtilImpl{}).AnyTimes()
+	executionManager.EXPECT().ReadHistoryBranchByBatch(gomock.Any(), gomock.Any()).Return(&persistence.ReadHistoryBranchByBatchResponse{
+		History: []*historypb.History{
+			{Events: []*historypb.HistoryEvent{
+				{EventId: 1, Version: 5, EventType: enumspb.EVENT_TYPE_WORKFLOW_EXECUTION_STARTED},
+				{EventId: 2, Version: 5, EventType: enumspb.EVENT_TYPE_WORKFLOW_TASK_SCHEDULED},
+			}},
+			{Events: []*historypb.HistoryEvent{
+				{
+					EventId:   3,
+					Version:   5,
+					EventType: enumspb.EVENT_TYPE_WORKFLOW_EXECUTION_SIGNALED,
+					Attributes: &historypb.HistoryEvent_WorkflowExecutionSignaledEventAttributes{WorkflowExecutionSignaledEventAttributes: &historypb.WorkflowExecutionSignaledEventAttributes{
+						Input: &commonpb.Payloads{Payloads: []*commonpb.Payload{payload.EncodeString("signal")}},
+					}},
+				},
+			}},
+		},
+		TransactionIDs: []int64{10, 20},
+	}, nil)
+
+	eventsCache := events.NewMockCache(ctrl)
+	eventsCache.EXPECT().DeleteEvent(events.EventKey{
+		NamespaceID: namespace.ID(workflowKey.NamespaceID),
+		WorkflowID:  workflowKey.WorkflowID,
+		RunID:       workflowKey.RunID,
+		EventID:     3,
+		Version:     5,
+	})
+
+	shardContext := historyi.NewMockShardContext(ctrl)
+	shardContext.EXPECT().GetShardID().Return(int32(1)).AnyTimes()
+	shardContext.EXPECT().GetExecutionManager().Return(executionManager).AnyTimes()
+	shardContext.EXPECT().GetEventsCache().Return(eventsCache).AnyTimes()
+	shardContext.EXPECT().AppendHistoryEvents(gomock.Any(), gomock.Any(), namespace.ID(workflowKey.NamespaceID), gomock.Any()).DoAndReturn(
+		func(_ context.Context, request *persistence.AppendHistoryNodesRequest, _ namespace.ID, _ *commonpb.WorkflowExecution) (int, error) {
+			// the batch is overwritten in place
+			require.True(t, request.Overwrite)
+			require.Equal(t, int64(10), request.PrevTransactionID)
+			require.Equal(t, int64(20), request.TransactionID)
+			require.Len(t, request.Events, 1)
+			require.True(t, payload.IsRedacted(request.Events[0].GetWorkflowExecutionSignaledEventAttributes().GetInput().GetPayloads()[0]))
+			return 0, nil
+		},
+	)
+
+	err = redactHistory(context.Background(), shardContext, mutableState, &redactor{
+		eventIDs: map[int64]struct{}{3: {}},
+	})
+	require.NoError(t, err)
+}
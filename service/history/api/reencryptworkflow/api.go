@@ -0,0 +1,54 @@
+package reencryptworkflow
+
+import (
+	"context"
+
+	"go.temporal.io/server/api/historyservice/v1"
+	"go.temporal.io/server/common/definition"
+	"go.temporal.io/server/common/locks"
+	"go.temporal.io/server/common/namespace"
+	"go.temporal.io/server/service/history/api"
+	historyi "go.temporal.io/server/service/history/interfaces"
+)
+
+// Invoke writes the mutable state of a closed workflow back as a snapshot, which encrypts all of
+// its blobs with the active key of the namespace. Running workflows are left alone, they are
+// re-encrypted once they close.
+func Invoke(
+	ctx context.Context,
+	request *historyservice.ReencryptWorkflowExecutionRequest,
+	shardContext historyi.ShardContext,
+	workflowConsistencyChecker api.WorkflowConsistencyChecker,
+) (_ *historyservice.ReencryptWorkflowExecutionResponse, retError error) {
+	namespaceID := namespace.ID(request.GetNamespaceId())
+	if err := api.ValidateNamespaceUUID(namespaceID); err != nil {
+		return nil, err
+	}
+
+	workflowLease, err := workflowConsistencyChecker.GetWorkflowLease(
+		ctx,
+		nil,
+		definition.NewWorkflowKey(
+			namespaceID.String(),
+			request.GetExecution().GetWorkflowId(),
+			request.GetExecution().GetRunId(),
+		),
+		locks.PriorityLow,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer func() { workflowLease.GetReleaseFn()(retError) }()
+
+	if workflowLease.GetMutableState().IsWorkflowExecutionRunning() {
+		return &historyservice.ReencryptWorkflowExecutionResponse{}, nil
+	}
+	if err := workflowLease.GetContext().SubmitClosedWorkflowSnapshot(
+		ctx,
+		shardContext,
+		historyi.TransactionPolicyPassive,
+	); err != nil {
+		return nil, err
+	}
+	return &historyservice.ReencryptWorkflowExecutionResponse{}, nil
+}
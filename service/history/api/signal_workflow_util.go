@@ -4,12 +4,14 @@ import (
 	"context"
 
 	enumspb "go.temporal.io/api/enums/v1"
+	enumsspb "go.temporal.io/server/api/enums/v1"
 	"go.temporal.io/server/common"
 	"go.temporal.io/server/common/log/tag"
 	"go.temporal.io/server/common/metrics"
 	"go.temporal.io/server/common/rpc/interceptor"
 	"go.temporal.io/server/service/history/consts"
 	historyi "go.temporal.io/server/service/history/interfaces"
+	"go.temporal.io/server/service/history/workflow"
 )
 
 func ValidateSignal(
@@ -66,5 +68,14 @@ func ValidateSignal(
 		return consts.ErrWorkflowClosing
 	}
 
+	if workflow.GetHistoryBudgetUsage(config, mutableState).HasAction(enumsspb.HISTORY_BUDGET_ACTION_BLOCK_SIGNALS) {
+		shard.GetThrottledLogger().Info("Signal rejected because workflow consumed its history budget",
+			tag.WorkflowNamespaceID(namespaceID),
+			tag.WorkflowID(workflowID),
+			tag.WorkflowRunID(runID),
+		)
+		return consts.ErrSignalsBlockedByHistoryBudget
+	}
+
 	return nil
 }
@@ -75,6 +75,7 @@ func (s *signalWithStartWorkflowSuite) SetupTest() {
 	s.currentMutableState.EXPECT().GetExecutionState().Return(&persistencespb.WorkflowExecutionState{
 		RunId: s.currentRunID,
 	}).AnyTimes()
+	s.currentMutableState.EXPECT().GetNextEventID().Return(int64(10)).AnyTimes()
 }
 
 func (s *signalWithStartWorkflowSuite) TearDownTest() {
@@ -40,6 +40,8 @@ type (
 		CloseFailoverVersion int64
 		// HistoryURI is the URI of the history archival backend.
 		HistoryURI carchiver.URI
+		// OverwriteHistory is set to replace a previously archived copy of the history, e.g. after it was redacted.
+		OverwriteHistory bool
 
 		// visibility archival
 		WorkflowTypeName  string
@@ -189,6 +191,10 @@ func (a *archiver) archiveHistory(ctx context.Context, request *Request, logger
 		return err
 	}
 
+	var opts []carchiver.ArchiveOption
+	if request.OverwriteHistory {
+		opts = append(opts, carchiver.GetOverwriteArchiveOption())
+	}
 	return historyArchiver.Archive(ctx, request.HistoryURI, &carchiver.ArchiveHistoryRequest{
 		ShardID:              request.ShardID,
 		NamespaceID:          request.NamespaceID,
@@ -198,7 +204,7 @@ func (a *archiver) archiveHistory(ctx context.Context, request *Request, logger
 		BranchToken:          request.BranchToken,
 		NextEventID:          request.NextEventID,
 		CloseFailoverVersion: request.CloseFailoverVersion,
-	})
+	}, opts...)
 }
 
 func (a *archiver) archiveVisibility(ctx context.Context, request *Request, logger log.Logger) (err error) {
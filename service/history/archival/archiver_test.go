@@ -36,6 +36,7 @@ func TestArchiver(t *testing.T) {
 		NameTypeMap          searchattribute.NameTypeMap
 		NameTypeMapErr       error
 		NilHistoryUri        bool
+		OverwriteHistory     bool
 
 		ExpectArchiveHistory    bool
 		ExpectArchiveVisibility bool
@@ -60,6 +61,13 @@ func TestArchiver(t *testing.T) {
 
 			ExpectArchiveHistory: true,
 		},
+		{
+			Name:             "History archival overwrites previous copy",
+			Targets:          []Target{TargetHistory},
+			OverwriteHistory: true,
+
+			ExpectArchiveHistory: true,
+		},
 		{
 			Name:              "History archival fails",
 			Targets:           []Target{TargetHistory},
@@ -173,7 +181,16 @@ func TestArchiver(t *testing.T) {
 
 			if c.ExpectArchiveHistory {
 				archiverProvider.EXPECT().GetHistoryArchiver(gomock.Any()).Return(historyArchiver, nil)
-				historyArchiver.EXPECT().Archive(gomock.Any(), historyURI, gomock.Any()).Return(c.ArchiveHistoryErr)
+				if c.OverwriteHistory {
+					historyArchiver.EXPECT().Archive(gomock.Any(), historyURI, gomock.Any(), gomock.Any()).DoAndReturn(
+						func(_ context.Context, _ carchiver.URI, _ *carchiver.ArchiveHistoryRequest, opts ...carchiver.ArchiveOption) error {
+							assert.True(t, carchiver.GetFeatureCatalog(opts...).Overwrite)
+							return c.ArchiveHistoryErr
+						},
+					)
+				} else {
+					historyArchiver.EXPECT().Archive(gomock.Any(), historyURI, gomock.Any()).Return(c.ArchiveHistoryErr)
+				}
 			}
 
 			visibilityURI, err := carchiver.NewURI("test:///visibility/archival")
@@ -236,6 +253,7 @@ func TestArchiver(t *testing.T) {
 			searchAttributes := c.SearchAttributes
 			_, err = archiver.Archive(ctx, &Request{
 				HistoryURI:       historyURI,
+				OverwriteHistory: c.OverwriteHistory,
 				VisibilityURI:    visibilityURI,
 				Targets:          c.Targets,
 				SearchAttributes: searchAttributes,
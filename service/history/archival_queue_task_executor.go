@@ -202,6 +202,7 @@ func (e *archivalQueueTaskExecutor) getArchiveTaskRequest(
 		NextEventID:          nextEventID,
 		CloseFailoverVersion: mutableState.CloseVersion,
 		HistoryURI:           historyURI,
+		OverwriteHistory:     executionInfo.GetCompletionEventId() != 0,
 		VisibilityURI:        visibilityURI,
 		WorkflowTypeName:     executionInfo.GetWorkflowTypeName(),
 		StartTime:            executionState.GetStartTime(),
@@ -9,7 +9,8 @@ import (
 
 // Config represents configuration for history service
 type Config struct {
-	NumberOfShards int32
+	NumberOfShards      int32
+	NamespaceShardPools dynamicconfig.TypedPropertyFn[map[string]common.NamespaceShardPool]
 
 	EnableReplicationStream dynamicconfig.BoolPropertyFn
 	HistoryReplicationDLQV2 dynamicconfig.BoolPropertyFn
@@ -70,6 +71,8 @@ type Config struct {
 	// Change of these configs require service restart
 	EnableHostLevelEventsCache       dynamicconfig.BoolPropertyFn
 	EventsHostLevelCacheMaxSizeBytes dynamicconfig.IntPropertyFn
+	EventsCacheDiskTierPath          dynamicconfig.StringPropertyFn
+	EventsCacheDiskTierMaxSizeBytes  dynamicconfig.IntPropertyFn
 
 	// ShardController settings
 	RangeSizeBits                uint
@@ -80,6 +83,9 @@ type Config struct {
 	ShardLingerOwnershipCheckQPS dynamicconfig.IntPropertyFn
 	ShardLingerTimeLimit         dynamicconfig.DurationPropertyFn
 	ShardFinalizerTimeout        dynamicconfig.DurationPropertyFn
+	ShardPlacementMode           dynamicconfig.StringPropertyFn
+	ShardLoadReportInterval      dynamicconfig.DurationPropertyFn
+	ShardRebalanceLoadThreshold  dynamicconfig.FloatPropertyFn
 
 	HistoryClientOwnershipCachingEnabled dynamicconfig.BoolPropertyFn
 
@@ -212,6 +218,7 @@ type Config struct {
 	HistoryCountLimitError                    dynamicconfig.IntPropertyFnWithNamespaceFilter
 	HistoryCountLimitWarn                     dynamicconfig.IntPropertyFnWithNamespaceFilter
 	HistoryCountSuggestContinueAsNew          dynamicconfig.IntPropertyFnWithNamespaceFilter
+	HistoryBudgets                            dynamicconfig.TypedPropertyFnWithNamespaceFilter[[]dynamicconfig.HistoryBudget]
 	HistoryMaxPageSize                        dynamicconfig.IntPropertyFnWithNamespaceFilter
 	MutableStateActivityFailureSizeLimitError dynamicconfig.IntPropertyFnWithNamespaceFilter
 	MutableStateActivityFailureSizeLimitWarn  dynamicconfig.IntPropertyFnWithNamespaceFilter
@@ -309,6 +316,7 @@ type Config struct {
 	VisibilityProcessorEnableCloseWorkflowCleanup         dynamicconfig.BoolPropertyFnWithNamespaceFilter
 	VisibilityProcessorRelocateAttributesMinBlobSize      dynamicconfig.IntPropertyFnWithNamespaceFilter
 	VisibilityQueueMaxReaderCount                         dynamicconfig.IntPropertyFn
+	VisibilityTextIndexing                                dynamicconfig.TypedPropertyFnWithNamespaceFilter[dynamicconfig.VisibilityTextIndexingSettings]
 
 	// Disable fetching memo and search attributes from visibility in the event that they were removed
 	// from the mutable state in the close execution visibility task clean up.
@@ -362,6 +370,11 @@ type Config struct {
 	HealthRPCErrorRatio             dynamicconfig.FloatPropertyFn
 	BreakdownMetricsByTaskQueue     dynamicconfig.BoolPropertyFnWithTaskQueueFilter
 
+	// MatchingUseNewMatcher and MatchingEnableFairness are the matching settings that enable the priority matcher,
+	// which activities with a worker selector require.
+	MatchingUseNewMatcher  dynamicconfig.BoolPropertyFnWithTaskQueueFilter
+	MatchingEnableFairness dynamicconfig.BoolPropertyFnWithTaskQueueFilter
+
 	LogAllReqErrors dynamicconfig.BoolPropertyFnWithNamespaceFilter
 
 	MaxLocalParentWorkflowVerificationDuration dynamicconfig.DurationPropertyFn
@@ -373,7 +386,8 @@ func NewConfig(
 	numberOfShards int32,
 ) *Config {
 	cfg := &Config{
-		NumberOfShards: numberOfShards,
+		NumberOfShards:      numberOfShards,
+		NamespaceShardPools: dynamicconfig.HistoryNamespaceShardPools.Get(dc),
 
 		EnableReplicationStream: dynamicconfig.EnableReplicationStream.Get(dc),
 		HistoryReplicationDLQV2: dynamicconfig.EnableHistoryReplicationDLQV2.Get(dc),
@@ -428,6 +442,8 @@ func NewConfig(
 		EventsHostLevelCacheMaxSizeBytes:  dynamicconfig.EventsHostLevelCacheMaxSizeBytes.Get(dc), // 256MB
 		EventsCacheTTL:                    dynamicconfig.EventsCacheTTL.Get(dc),
 		EnableHostLevelEventsCache:        dynamicconfig.EnableHostLevelEventsCache.Get(dc),
+		EventsCacheDiskTierPath:           dynamicconfig.EventsCacheDiskTierPath.Get(dc),
+		EventsCacheDiskTierMaxSizeBytes:   dynamicconfig.EventsCacheDiskTierMaxSizeBytes.Get(dc),
 
 		RangeSizeBits: 20, // 20 bits for sequencer, 2^20 sequence number for any range
 
@@ -438,6 +454,9 @@ func NewConfig(
 		ShardLingerOwnershipCheckQPS: dynamicconfig.ShardLingerOwnershipCheckQPS.Get(dc),
 		ShardLingerTimeLimit:         dynamicconfig.ShardLingerTimeLimit.Get(dc),
 		ShardFinalizerTimeout:        dynamicconfig.ShardFinalizerTimeout.Get(dc),
+		ShardPlacementMode:           dynamicconfig.ShardPlacementMode.Get(dc),
+		ShardLoadReportInterval:      dynamicconfig.ShardLoadReportInterval.Get(dc),
+		ShardRebalanceLoadThreshold:  dynamicconfig.ShardRebalanceLoadThreshold.Get(dc),
 
 		HistoryClientOwnershipCachingEnabled: dynamicconfig.HistoryClientOwnershipCachingEnabled.Get(dc),
 
@@ -573,6 +592,7 @@ func NewConfig(
 		HistoryCountLimitError:                    dynamicconfig.HistoryCountLimitError.Get(dc),
 		HistoryCountLimitWarn:                     dynamicconfig.HistoryCountLimitWarn.Get(dc),
 		HistoryCountSuggestContinueAsNew:          dynamicconfig.HistoryCountSuggestContinueAsNew.Get(dc),
+		HistoryBudgets:                            dynamicconfig.HistoryBudgets.Get(dc),
 		HistoryMaxPageSize:                        dynamicconfig.HistoryMaxPageSize.Get(dc),
 		MutableStateActivityFailureSizeLimitError: dynamicconfig.MutableStateActivityFailureSizeLimitError.Get(dc),
 		MutableStateActivityFailureSizeLimitWarn:  dynamicconfig.MutableStateActivityFailureSizeLimitWarn.Get(dc),
@@ -631,6 +651,7 @@ func NewConfig(
 		VisibilityProcessorEnableCloseWorkflowCleanup:         dynamicconfig.VisibilityProcessorEnableCloseWorkflowCleanup.Get(dc),
 		VisibilityProcessorRelocateAttributesMinBlobSize:      dynamicconfig.VisibilityProcessorRelocateAttributesMinBlobSize.Get(dc),
 		VisibilityQueueMaxReaderCount:                         dynamicconfig.VisibilityQueueMaxReaderCount.Get(dc),
+		VisibilityTextIndexing:                                dynamicconfig.VisibilityTextIndexing.Get(dc),
 
 		DisableFetchRelocatableAttributesFromVisibility: dynamicconfig.DisableFetchRelocatableAttributesFromVisibility.Get(dc),
 
@@ -687,13 +708,23 @@ func NewConfig(
 
 		BreakdownMetricsByTaskQueue: dynamicconfig.MetricsBreakdownByTaskQueue.Get(dc),
 
+		MatchingUseNewMatcher:  dynamicconfig.MatchingUseNewMatcher.Get(dc),
+		MatchingEnableFairness: dynamicconfig.MatchingEnableFairness.Get(dc),
+
 		LogAllReqErrors: dynamicconfig.LogAllReqErrors.Get(dc),
 	}
 
 	return cfg
 }
 
-// GetShardID return the corresponding shard ID for a given namespaceID and workflowID pair
+// GetShardID return the corresponding shard ID for a given namespaceID and workflowID pair,
+// respecting the namespace's dedicated shard pool if it has one
 func (config *Config) GetShardID(namespaceID namespace.ID, workflowID string) int32 {
-	return common.WorkflowIDToHistoryShard(namespaceID.String(), workflowID, config.NumberOfShards)
+	return common.WorkflowIDToHistoryShardInPools(namespaceID.String(), workflowID, config.NumberOfShards, config.NamespaceShardPools())
+}
+
+// GetAlternateShardID returns the other shard an existing workflow may be on while its namespace is moved between
+// shard pools, or 0 if there is none
+func (config *Config) GetAlternateShardID(namespaceID namespace.ID, workflowID string) int32 {
+	return common.WorkflowIDToAlternateHistoryShard(namespaceID.String(), workflowID, config.NumberOfShards, config.NamespaceShardPools())
 }
@@ -59,6 +59,8 @@ var (
 	ErrDeserializingToken = serviceerror.NewInvalidArgument("error deserializing task token")
 	// ErrSignalsLimitExceeded is the error indicating limit reached for maximum number of signal events
 	ErrSignalsLimitExceeded = serviceerror.NewInvalidArgument("exceeded workflow execution limit for signal events")
+	// ErrSignalsBlockedByHistoryBudget is the error indicating signals are blocked because the workflow execution consumed its history budget
+	ErrSignalsBlockedByHistoryBudget = serviceerror.NewFailedPrecondition("signals are blocked because workflow execution consumed its history budget")
 	// ErrWorkflowClosing is the error indicating requests to workflow can not be applied as workflow is closing
 	ErrWorkflowClosing = &serviceerror.ResourceExhausted{
 		Cause:   enumspb.RESOURCE_EXHAUSTED_CAUSE_BUSY_WORKFLOW,
@@ -40,9 +40,12 @@ type (
 		metricsHandler   metrics.Handler
 		logger           log.Logger
 		disabled         bool
+		// diskTier is the optional second tier events evicted from memory are spilled to.
+		diskTier *diskCache
 	}
 
 	historyEventCacheItemImpl struct {
+		key   EventKey
 		event *historypb.HistoryEvent
 	}
 )
@@ -60,7 +63,7 @@ func NewHostLevelEventsCache(
 	logger log.Logger,
 	disabled bool,
 ) Cache {
-	return newEventsCache(executionManager, handler, logger, config.EventsHostLevelCacheMaxSizeBytes(), config.EventsCacheTTL(), disabled)
+	return newEventsCache(executionManager, handler, logger, config.EventsHostLevelCacheMaxSizeBytes(), config.EventsCacheTTL(), disabled, nil)
 }
 
 func NewShardLevelEventsCache(
@@ -70,7 +73,7 @@ func NewShardLevelEventsCache(
 	logger log.Logger,
 	disabled bool,
 ) Cache {
-	return newEventsCache(executionManager, handler, logger, config.EventsShardLevelCacheMaxSizeBytes(), config.EventsCacheTTL(), disabled)
+	return newEventsCache(executionManager, handler, logger, config.EventsShardLevelCacheMaxSizeBytes(), config.EventsCacheTTL(), disabled, nil)
 }
 
 func newEventsCache(
@@ -80,9 +83,16 @@ func newEventsCache(
 	maxSize int,
 	ttl time.Duration,
 	disabled bool,
+	diskTier *diskCache,
 ) *CacheImpl {
 	opts := &cache.Options{}
 	opts.TTL = ttl
+	if diskTier != nil {
+		opts.OnEvict = func(val any) {
+			item := val.(*historyEventCacheItemImpl)
+			diskTier.spill(item.key, item.event)
+		}
+	}
 
 	taggedMetricHandler := metricsHandler.WithTags(metrics.CacheTypeTag(metrics.EventsCacheTypeTagValue))
 	return &CacheImpl{
@@ -91,6 +101,7 @@ func newEventsCache(
 		metricsHandler:   taggedMetricHandler,
 		logger:           logger,
 		disabled:         disabled,
+		diskTier:         diskTier,
 	}
 }
 
@@ -121,6 +132,14 @@ func (e *CacheImpl) GetEvent(ctx context.Context, shardID int32, key EventKey, f
 		if cacheHit {
 			return eventItem.event, nil
 		}
+		if e.diskTier != nil && validKey {
+			if event, ok := e.diskTier.get(key); ok {
+				// a miss of the in-memory tier
+				metrics.CacheMissCounter.With(handler).Record(1)
+				e.put(key, event)
+				return event, nil
+			}
+		}
 	}
 
 	metrics.CacheMissCounter.With(handler).Record(1)
@@ -163,6 +182,10 @@ func (e *CacheImpl) DeleteEvent(key EventKey) {
 
 	e.validateKey(key) // just for log message, delete anyway
 	e.Delete(key)
+	if e.diskTier != nil {
+		// deleting from memory spills the event, which is canceled here
+		e.diskTier.delete(key)
+	}
 }
 
 func (e *CacheImpl) getHistoryEventFromStore(
@@ -213,15 +236,17 @@ func (e *CacheImpl) getHistoryEventFromStore(
 }
 
 func (e *CacheImpl) put(key EventKey, event *historypb.HistoryEvent) interface{} {
-	return e.Put(key, newHistoryEventCacheItem(event))
+	return e.Put(key, newHistoryEventCacheItem(key, event))
 }
 
 var _ cache.SizeGetter = (*historyEventCacheItemImpl)(nil)
 
 func newHistoryEventCacheItem(
+	key EventKey,
 	event *historypb.HistoryEvent,
 ) *historyEventCacheItemImpl {
 	return &historyEventCacheItemImpl{
+		key:   key,
 		event: event,
 	}
 }
@@ -66,7 +66,8 @@ func (s *eventsCacheSuite) newTestEventsCache() *CacheImpl {
 		s.logger,
 		32,
 		time.Minute,
-		false)
+		false,
+		nil)
 }
 
 func (s *eventsCacheSuite) TestEventsCacheHitSuccess() {
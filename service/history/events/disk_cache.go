@@ -0,0 +1,280 @@
+package events
+
+import (
+	"container/list"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sync"
+
+	commonpb "go.temporal.io/api/common/v1"
+	enumspb "go.temporal.io/api/enums/v1"
+	historypb "go.temporal.io/api/history/v1"
+	"go.temporal.io/server/common/dynamicconfig"
+	"go.temporal.io/server/common/log"
+	"go.temporal.io/server/common/log/tag"
+	"go.temporal.io/server/common/metrics"
+	"go.temporal.io/server/common/persistence/serialization"
+	"google.golang.org/protobuf/proto"
+)
+
+const (
+	// diskCacheDirName is the directory created under the configured path. It is cleared on startup, the index of the
+	// disk tier is kept in memory only.
+	diskCacheDirName = "temporal-events-cache"
+	// maxPendingSpills bounds the events waiting to be written to disk. Spills are dropped once reached.
+	maxPendingSpills = 1024
+)
+
+type (
+	// diskCache is the second tier of the events cache. Events evicted from memory are written to files in a local
+	// directory by a background goroutine, and the least recently used files are removed to stay within the size cap.
+	// If encryption at rest is configured, events are encrypted with the key of their namespace like in persistence.
+	diskCache struct {
+		dir            string
+		maxSizeBytes   dynamicconfig.IntPropertyFn
+		encryptor      *serialization.Encryptor
+		metricsHandler metrics.Handler
+		logger         log.Logger
+
+		spillCh chan EventKey
+		stopCh  chan struct{}
+		doneCh  chan struct{}
+
+		mu       sync.Mutex
+		pending  map[EventKey]*historypb.HistoryEvent
+		entries  map[EventKey]*list.Element
+		byAccess *list.List
+		currSize int
+	}
+
+	diskCacheEntry struct {
+		key  EventKey
+		size int
+	}
+)
+
+func newDiskCache(
+	path string,
+	maxSizeBytes dynamicconfig.IntPropertyFn,
+	encryptor *serialization.Encryptor,
+	metricsHandler metrics.Handler,
+	logger log.Logger,
+) (*diskCache, error) {
+	dir := filepath.Join(path, diskCacheDirName)
+	if err := os.RemoveAll(dir); err != nil {
+		return nil, fmt.Errorf("failed to clear events cache disk tier directory: %w", err)
+	}
+	if err := os.MkdirAll(dir, 0o700); err != nil {
+		return nil, fmt.Errorf("failed to create events cache disk tier directory: %w", err)
+	}
+
+	c := &diskCache{
+		dir:            dir,
+		maxSizeBytes:   maxSizeBytes,
+		encryptor:      encryptor,
+		metricsHandler: metricsHandler.WithTags(metrics.CacheTypeTag(metrics.EventsDiskCacheTypeTagValue)),
+		logger:         logger,
+		spillCh:        make(chan EventKey, maxPendingSpills),
+		stopCh:         make(chan struct{}),
+		doneCh:         make(chan struct{}),
+		pending:        make(map[EventKey]*historypb.HistoryEvent),
+		entries:        make(map[EventKey]*list.Element),
+		byAccess:       list.New(),
+	}
+	go c.spillLoop()
+	return c, nil
+}
+
+func (c *diskCache) stop() {
+	close(c.stopCh)
+	<-c.doneCh
+}
+
+// get returns the event from disk. Disk tier hits are moved to the front of the access list.
+func (c *diskCache) get(key EventKey) (*historypb.HistoryEvent, bool) {
+	handler := c.metricsHandler.WithTags(metrics.OperationTag(metrics.EventsCacheGetEventScope), metrics.NamespaceIDTag(key.NamespaceID.String()))
+	metrics.CacheRequests.With(handler).Record(1)
+
+	c.mu.Lock()
+	if event, ok := c.pending[key]; ok {
+		c.mu.Unlock()
+		return event, true
+	}
+	element, ok := c.entries[key]
+	if ok {
+		c.byAccess.MoveToFront(element)
+	}
+	c.mu.Unlock()
+	if !ok {
+		metrics.CacheMissCounter.With(handler).Record(1)
+		return nil, false
+	}
+
+	// The file may be removed concurrently, which is handled as a miss.
+	data, err := os.ReadFile(c.fileName(key))
+	if err != nil {
+		metrics.CacheMissCounter.With(handler).Record(1)
+		return nil, false
+	}
+	event, err := c.decode(data)
+	if err != nil || event.GetEventId() != key.EventID || event.GetVersion() != key.Version {
+		metrics.CacheFailures.With(handler).Record(1)
+		c.logger.Warn("Corrupted event in events cache disk tier", tag.Error(err), tag.WorkflowEventID(key.EventID))
+		c.delete(key)
+		return nil, false
+	}
+	return event, true
+}
+
+// spill queues the event to be written to disk. This is called with the lock of the in-memory tier held, so disk
+// operations are left to the background goroutine.
+func (c *diskCache) spill(key EventKey, event *historypb.HistoryEvent) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if _, ok := c.entries[key]; ok {
+		return
+	}
+	if _, ok := c.pending[key]; ok {
+		return
+	}
+	select {
+	case c.spillCh <- key:
+		c.pending[key] = event
+	default:
+		metrics.CacheSpillDropped.With(c.metricsHandler).Record(1)
+	}
+}
+
+// delete removes the event from disk, including a pending spill of the event.
+func (c *diskCache) delete(key EventKey) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	delete(c.pending, key)
+	if element, ok := c.entries[key]; ok {
+		c.removeLocked(element)
+	}
+}
+
+func (c *diskCache) spillLoop() {
+	defer close(c.doneCh)
+	for {
+		select {
+		case <-c.stopCh:
+			return
+		case key := <-c.spillCh:
+			c.write(key)
+		}
+	}
+}
+
+func (c *diskCache) write(key EventKey) {
+	c.mu.Lock()
+	event, ok := c.pending[key]
+	c.mu.Unlock()
+	if !ok {
+		return
+	}
+
+	handler := c.metricsHandler.WithTags(metrics.OperationTag(metrics.EventsCachePutEventScope), metrics.NamespaceIDTag(key.NamespaceID.String()))
+	metrics.CacheRequests.With(handler).Record(1)
+	data, err := c.encode(key, event)
+	if err == nil && len(data) <= c.maxSizeBytes() {
+		err = c.writeFile(key, data)
+	}
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if _, ok := c.pending[key]; !ok {
+		// deleted while being written
+		if err == nil {
+			_ = os.Remove(c.fileName(key))
+		}
+		return
+	}
+	delete(c.pending, key)
+	if err != nil {
+		metrics.CacheFailures.With(handler).Record(1)
+		c.logger.Warn("Failed to write event to events cache disk tier", tag.Error(err))
+		return
+	}
+	maxSize := c.maxSizeBytes()
+	if len(data) > maxSize {
+		return
+	}
+	for c.currSize+len(data) > maxSize && c.byAccess.Len() > 0 {
+		c.removeLocked(c.byAccess.Back())
+	}
+	c.entries[key] = c.byAccess.PushFront(&diskCacheEntry{key: key, size: len(data)})
+	c.currSize += len(data)
+	metrics.CacheUsage.With(c.metricsHandler).Record(float64(c.currSize))
+}
+
+// encode serializes the event into a data blob, which records whether the event is encrypted
+func (c *diskCache) encode(key EventKey, event *historypb.HistoryEvent) ([]byte, error) {
+	data, err := proto.Marshal(event)
+	if err != nil {
+		return nil, err
+	}
+	blob := &commonpb.DataBlob{EncodingType: enumspb.ENCODING_TYPE_PROTO3, Data: data}
+	if c.encryptor != nil {
+		if blob, err = c.encryptor.Encrypt(key.NamespaceID.String(), blob); err != nil {
+			return nil, err
+		}
+	}
+	return proto.Marshal(blob)
+}
+
+func (c *diskCache) decode(data []byte) (*historypb.HistoryEvent, error) {
+	blob := &commonpb.DataBlob{}
+	if err := proto.Unmarshal(data, blob); err != nil {
+		return nil, err
+	}
+	if serialization.IsEncrypted(blob) {
+		if c.encryptor == nil {
+			return nil, serialization.ErrNoKeyProvider
+		}
+		var err error
+		if blob, err = c.encryptor.Decrypt(blob); err != nil {
+			return nil, err
+		}
+	}
+	event := &historypb.HistoryEvent{}
+	if err := proto.Unmarshal(blob.Data, event); err != nil {
+		return nil, err
+	}
+	return event, nil
+}
+
+func (c *diskCache) writeFile(key EventKey, data []byte) error {
+	fileName := c.fileName(key)
+	tmpFileName := fileName + ".tmp"
+	if err := os.WriteFile(tmpFileName, data, 0o600); err != nil {
+		_ = os.Remove(tmpFileName)
+		return err
+	}
+	if err := os.Rename(tmpFileName, fileName); err != nil {
+		_ = os.Remove(tmpFileName)
+		return err
+	}
+	return nil
+}
+
+func (c *diskCache) removeLocked(element *list.Element) {
+	entry := c.byAccess.Remove(element).(*diskCacheEntry)
+	delete(c.entries, entry.key)
+	c.currSize -= entry.size
+	metrics.CacheUsage.With(c.metricsHandler).Record(float64(c.currSize))
+	if err := os.Remove(c.fileName(entry.key)); err != nil && !os.IsNotExist(err) {
+		c.logger.Warn("Failed to remove event from events cache disk tier", tag.Error(err))
+	}
+}
+
+func (c *diskCache) fileName(key EventKey) string {
+	hash := sha256.Sum256(fmt.Appendf(nil, "%s/%s/%s/%d/%d", key.NamespaceID, key.WorkflowID, key.RunID, key.EventID, key.Version))
+	return filepath.Join(c.dir, hex.EncodeToString(hash[:]))
+}
@@ -0,0 +1,194 @@
+package events
+
+import (
+	"bytes"
+	"context"
+	"os"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	enumspb "go.temporal.io/api/enums/v1"
+	historypb "go.temporal.io/api/history/v1"
+	"go.temporal.io/server/common/dynamicconfig"
+	"go.temporal.io/server/common/log"
+	"go.temporal.io/server/common/metrics"
+	"go.temporal.io/server/common/namespace"
+	"go.temporal.io/server/common/persistence"
+	"go.temporal.io/server/common/persistence/serialization"
+	"go.uber.org/mock/gomock"
+	"google.golang.org/protobuf/proto"
+)
+
+func newDiskCacheTestEvent(eventID int64) (EventKey, *historypb.HistoryEvent) {
+	key := EventKey{
+		NamespaceID: namespace.ID("disk-cache-namespace"),
+		WorkflowID:  "disk-cache-workflow-id",
+		RunID:       "disk-cache-run-id",
+		EventID:     eventID,
+		Version:     1,
+	}
+	return key, &historypb.HistoryEvent{
+		EventId:   eventID,
+		Version:   1,
+		EventType: enumspb.EVENT_TYPE_ACTIVITY_TASK_SCHEDULED,
+	}
+}
+
+type testKeyProvider struct{}
+
+func (p testKeyProvider) ActiveKey(string) (serialization.EncryptionKey, bool, error) {
+	key, err := p.Key("a")
+	return key, true, err
+}
+
+func (testKeyProvider) Key(keyID string) (serialization.EncryptionKey, error) {
+	return serialization.EncryptionKey{ID: keyID, Material: bytes.Repeat([]byte(keyID[:1]), 32)}, nil
+}
+
+func newTestDiskCache(t *testing.T, maxSizeBytes int) *diskCache {
+	return newTestDiskCacheWithEncryptor(t, maxSizeBytes, nil)
+}
+
+func newTestDiskCacheWithEncryptor(t *testing.T, maxSizeBytes int, encryptor *serialization.Encryptor) *diskCache {
+	c, err := newDiskCache(t.TempDir(), dynamicconfig.GetIntPropertyFn(maxSizeBytes), encryptor, metrics.NoopMetricsHandler, log.NewTestLogger())
+	require.NoError(t, err)
+	t.Cleanup(c.stop)
+	return c
+}
+
+func waitForSpills(t *testing.T, c *diskCache) {
+	require.Eventually(t, func() bool {
+		c.mu.Lock()
+		defer c.mu.Unlock()
+		return len(c.pending) == 0
+	}, 5*time.Second, 10*time.Millisecond)
+}
+
+func TestDiskCache_SpillGetDelete(t *testing.T) {
+	c := newTestDiskCache(t, 1024*1024)
+	key, event := newDiskCacheTestEvent(10)
+
+	_, ok := c.get(key)
+	require.False(t, ok)
+
+	c.spill(key, event)
+	waitForSpills(t, c)
+	_, err := os.Stat(c.fileName(key))
+	require.NoError(t, err)
+
+	actual, ok := c.get(key)
+	require.True(t, ok)
+	require.True(t, proto.Equal(event, actual))
+
+	otherVersion := key
+	otherVersion.Version = 2
+	_, ok = c.get(otherVersion)
+	require.False(t, ok)
+
+	c.delete(key)
+	_, ok = c.get(key)
+	require.False(t, ok)
+	_, err = os.Stat(c.fileName(key))
+	require.True(t, os.IsNotExist(err))
+}
+
+func TestDiskCache_Encrypted(t *testing.T) {
+	c := newTestDiskCacheWithEncryptor(t, 1024*1024, serialization.NewEncryptor(testKeyProvider{}))
+	key, event := newDiskCacheTestEvent(10)
+	event.Attributes = &historypb.HistoryEvent_ActivityTaskScheduledEventAttributes{
+		ActivityTaskScheduledEventAttributes: &historypb.ActivityTaskScheduledEventAttributes{ActivityId: "secret-activity-id"},
+	}
+
+	c.spill(key, event)
+	waitForSpills(t, c)
+	data, err := os.ReadFile(c.fileName(key))
+	require.NoError(t, err)
+	require.NotContains(t, string(data), "secret-activity-id")
+
+	actual, ok := c.get(key)
+	require.True(t, ok)
+	require.True(t, proto.Equal(event, actual))
+
+	// a file written with encryption can't be read back without it
+	_, err = newTestDiskCache(t, 1024*1024).decode(data)
+	require.ErrorIs(t, err, serialization.ErrNoKeyProvider)
+}
+
+func TestDiskCache_DeletePendingSpill(t *testing.T) {
+	c := newTestDiskCache(t, 1024*1024)
+	key, event := newDiskCacheTestEvent(10)
+
+	c.spill(key, event)
+	c.delete(key)
+	waitForSpills(t, c)
+
+	_, ok := c.get(key)
+	require.False(t, ok)
+	require.Eventually(t, func() bool {
+		_, err := os.Stat(c.fileName(key))
+		return os.IsNotExist(err)
+	}, 5*time.Second, 10*time.Millisecond)
+}
+
+func TestDiskCache_EvictsLeastRecentlyUsed(t *testing.T) {
+	key1, event1 := newDiskCacheTestEvent(10)
+	key2, event2 := newDiskCacheTestEvent(11)
+	key3, event3 := newDiskCacheTestEvent(12)
+	c := newTestDiskCache(t, 0)
+	data, err := c.encode(key1, event1)
+	require.NoError(t, err)
+	// room for two events
+	c.maxSizeBytes = dynamicconfig.GetIntPropertyFn(2 * len(data))
+
+	c.spill(key1, event1)
+	c.spill(key2, event2)
+	waitForSpills(t, c)
+	_, ok := c.get(key1)
+	require.True(t, ok)
+
+	c.spill(key3, event3)
+	waitForSpills(t, c)
+
+	_, ok = c.get(key1)
+	require.True(t, ok)
+	_, ok = c.get(key2)
+	require.False(t, ok)
+	_, ok = c.get(key3)
+	require.True(t, ok)
+	c.mu.Lock()
+	require.Equal(t, 2*len(data), c.currSize)
+	c.mu.Unlock()
+}
+
+func TestEventsCache_DiskTier(t *testing.T) {
+	controller := gomock.NewController(t)
+	executionManager := persistence.NewMockExecutionManager(controller)
+	key1, event1 := newDiskCacheTestEvent(10)
+	key2, event2 := newDiskCacheTestEvent(11)
+
+	diskTier := newTestDiskCache(t, 1024*1024)
+	// room for one event in memory
+	cache := newEventsCache(executionManager, metrics.NoopMetricsHandler, log.NewTestLogger(), event1.Size(), time.Minute, false, diskTier)
+
+	cache.PutEvent(key1, event1)
+	cache.PutEvent(key2, event2)
+	waitForSpills(t, diskTier)
+
+	// served from the disk tier, not the store
+	actual, err := cache.GetEvent(context.Background(), 1, key1, key1.EventID, []byte("branch-token"))
+	require.NoError(t, err)
+	require.True(t, proto.Equal(event1, actual))
+
+	cache.DeleteEvent(key2)
+	waitForSpills(t, diskTier)
+	_, ok := diskTier.get(key2)
+	require.False(t, ok)
+
+	executionManager.EXPECT().ReadHistoryBranch(gomock.Any(), gomock.Any()).Return(&persistence.ReadHistoryBranchResponse{
+		HistoryEvents: []*historypb.HistoryEvent{event2},
+	}, nil)
+	actual, err = cache.GetEvent(context.Background(), 1, key2, key2.EventID, []byte("branch-token"))
+	require.NoError(t, err)
+	require.True(t, proto.Equal(event2, actual))
+}
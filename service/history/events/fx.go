@@ -1,15 +1,47 @@
 package events
 
 import (
+	"go.temporal.io/server/common/config"
 	"go.temporal.io/server/common/log"
 	"go.temporal.io/server/common/metrics"
 	"go.temporal.io/server/common/persistence"
+	"go.temporal.io/server/common/persistence/client"
 	"go.temporal.io/server/service/history/configs"
 	"go.uber.org/fx"
 )
 
 var Module = fx.Options(
-	fx.Provide(func(executionManager persistence.ExecutionManager, config *configs.Config, handler metrics.Handler, logger log.Logger) Cache {
-		return NewHostLevelEventsCache(executionManager, config, handler, logger, false)
-	}),
+	fx.Provide(HostLevelEventsCacheProvider),
 )
+
+func HostLevelEventsCacheProvider(
+	lc fx.Lifecycle,
+	executionManager persistence.ExecutionManager,
+	config *configs.Config,
+	persistenceConfig *config.Persistence,
+	handler metrics.Handler,
+	logger log.Logger,
+) (Cache, error) {
+	var diskTier *diskCache
+	if path := config.EventsCacheDiskTierPath(); path != "" {
+		// spilled events are encrypted with the same keys as the events in persistence
+		encryptor, err := client.NewEncryptor(persistenceConfig.Encryption)
+		if err != nil {
+			return nil, err
+		}
+		diskTier, err = newDiskCache(path, config.EventsCacheDiskTierMaxSizeBytes, encryptor, handler, logger)
+		if err != nil {
+			return nil, err
+		}
+		lc.Append(fx.StopHook(diskTier.stop))
+	}
+	return newEventsCache(
+		executionManager,
+		handler,
+		logger,
+		config.EventsHostLevelCacheMaxSizeBytes(),
+		config.EventsCacheTTL(),
+		false,
+		diskTier,
+	), nil
+}
@@ -703,7 +703,7 @@ func (h *Handler) DescribeHistoryHost(_ context.Context, req *historyservice.Des
 	// if option 2/3 is provided, we want to check on the shard ownership to return the correct host address.
 	shardID := req.GetShardId()
 	if len(req.GetNamespaceId()) != 0 && req.GetWorkflowExecution() != nil {
-		shardID = common.WorkflowIDToHistoryShard(req.GetNamespaceId(), req.GetWorkflowExecution().GetWorkflowId(), h.config.NumberOfShards)
+		shardID = h.config.GetShardID(namespace.ID(req.GetNamespaceId()), req.GetWorkflowExecution().GetWorkflowId())
 	}
 	if shardID > 0 {
 		_, err := h.controller.GetShardByID(shardID)
@@ -721,11 +721,27 @@ func (h *Handler) DescribeHistoryHost(_ context.Context, req *historyservice.Des
 			ItemsInCacheByIdCount:   itemsInRegistryByIDCount,
 			ItemsInCacheByNameCount: itemsInRegistryByNameCount,
 		},
-		Address: h.hostInfoProvider.HostInfo().GetAddress(),
+		Address:    h.hostInfoProvider.HostInfo().GetAddress(),
+		ShardLoads: h.controller.ShardLoads(),
 	}
 	return resp, nil
 }
 
+// MoveShard takes over a shard from the history host currently owning it
+func (h *Handler) MoveShard(_ context.Context, request *historyservice.MoveShardRequest) (_ *historyservice.MoveShardResponse, retError error) {
+	defer metrics.CapturePanic(h.logger, h.metricsHandler, &retError)
+	h.startWG.Wait()
+
+	if h.isStopped() {
+		return nil, errShuttingDown
+	}
+
+	if err := h.controller.ClaimShard(request.GetShardId()); err != nil {
+		return nil, err
+	}
+	return &historyservice.MoveShardResponse{}, nil
+}
+
 // RemoveTask returns information about the internal states of a history host
 func (h *Handler) RemoveTask(ctx context.Context, request *historyservice.RemoveTaskRequest) (_ *historyservice.RemoveTaskResponse, retError error) {
 	var err error
@@ -804,6 +820,37 @@ func (h *Handler) RebuildMutableState(ctx context.Context, request *historyservi
 	return &historyservice.RebuildMutableStateResponse{}, nil
 }
 
+// CheckMutableState validates the mutable state of a workflow execution against its history
+func (h *Handler) CheckMutableState(ctx context.Context, request *historyservice.CheckMutableStateRequest) (_ *historyservice.CheckMutableStateResponse, retError error) {
+	defer metrics.CapturePanic(h.logger, h.metricsHandler, &retError)
+	h.startWG.Wait()
+
+	if h.isStopped() {
+		return nil, errShuttingDown
+	}
+
+	namespaceID := namespace.ID(request.GetNamespaceId())
+	if namespaceID == "" {
+		return nil, h.convertError(errNamespaceNotSet)
+	}
+
+	workflowID := request.GetExecution().GetWorkflowId()
+	shardContext, err := h.controller.GetShardByNamespaceWorkflow(namespaceID, workflowID)
+	if err != nil {
+		return nil, h.convertError(err)
+	}
+	engine, err := shardContext.GetEngine(ctx)
+	if err != nil {
+		return nil, h.convertError(err)
+	}
+
+	resp, err := engine.CheckMutableState(ctx, request)
+	if err != nil {
+		return nil, h.convertError(err)
+	}
+	return resp, nil
+}
+
 // ImportWorkflowExecution attempts to workflow execution according to persisted history events
 func (h *Handler) ImportWorkflowExecution(ctx context.Context, request *historyservice.ImportWorkflowExecutionRequest) (_ *historyservice.ImportWorkflowExecutionResponse, retError error) {
 	defer metrics.CapturePanic(h.logger, h.metricsHandler, &retError)
@@ -1245,6 +1292,166 @@ func (h *Handler) ResetWorkflowExecution(ctx context.Context, request *historyse
 	return resp, nil
 }
 
+// ForkWorkflowExecution copies the history of a workflow up to the given workflow task into a new workflow execution
+func (h *Handler) ForkWorkflowExecution(ctx context.Context, request *historyservice.ForkWorkflowExecutionRequest) (_ *historyservice.ForkWorkflowExecutionResponse, retError error) {
+	defer metrics.CapturePanic(h.logger, h.metricsHandler, &retError)
+	h.startWG.Wait()
+
+	if h.isStopped() {
+		return nil, errShuttingDown
+	}
+
+	namespaceID := namespace.ID(request.GetNamespaceId())
+	if namespaceID == "" {
+		return nil, h.convertError(errNamespaceNotSet)
+	}
+
+	workflowID := request.GetForkRequest().GetNewWorkflowId()
+	shardContext, err := h.controller.GetShardByNamespaceWorkflow(namespaceID, workflowID)
+	if err != nil {
+		return nil, h.convertError(err)
+	}
+	engine, err := shardContext.GetEngine(ctx)
+	if err != nil {
+		return nil, h.convertError(err)
+	}
+
+	resp, err2 := engine.ForkWorkflowExecution(ctx, request)
+	if err2 != nil {
+		return nil, h.convertError(err2)
+	}
+
+	return resp, nil
+}
+
+// RedactWorkflowExecution erases the selected payloads from the history of a closed workflow execution
+func (h *Handler) RedactWorkflowExecution(ctx context.Context, request *historyservice.RedactWorkflowExecutionRequest) (_ *historyservice.RedactWorkflowExecutionResponse, retError error) {
+	defer metrics.CapturePanic(h.logger, h.metricsHandler, &retError)
+	h.startWG.Wait()
+
+	if h.isStopped() {
+		return nil, errShuttingDown
+	}
+
+	namespaceID := namespace.ID(request.GetNamespaceId())
+	if namespaceID == "" {
+		return nil, h.convertError(errNamespaceNotSet)
+	}
+
+	workflowID := request.GetRedactRequest().GetExecution().GetWorkflowId()
+	shardContext, err := h.controller.GetShardByNamespaceWorkflow(namespaceID, workflowID)
+	if err != nil {
+		return nil, h.convertError(err)
+	}
+	engine, err := shardContext.GetEngine(ctx)
+	if err != nil {
+		return nil, h.convertError(err)
+	}
+
+	resp, err2 := engine.RedactWorkflowExecution(ctx, request)
+	if err2 != nil {
+		return nil, h.convertError(err2)
+	}
+
+	return resp, nil
+}
+
+// ReencryptWorkflowExecution rewrites the mutable state of a closed workflow execution with the active encryption key
+func (h *Handler) ReencryptWorkflowExecution(ctx context.Context, request *historyservice.ReencryptWorkflowExecutionRequest) (_ *historyservice.ReencryptWorkflowExecutionResponse, retError error) {
+	defer metrics.CapturePanic(h.logger, h.metricsHandler, &retError)
+	h.startWG.Wait()
+
+	if h.isStopped() {
+		return nil, errShuttingDown
+	}
+
+	namespaceID := namespace.ID(request.GetNamespaceId())
+	if namespaceID == "" {
+		return nil, h.convertError(errNamespaceNotSet)
+	}
+
+	workflowID := request.GetExecution().GetWorkflowId()
+	shardContext, err := h.controller.GetShardByNamespaceWorkflow(namespaceID, workflowID)
+	if err != nil {
+		return nil, h.convertError(err)
+	}
+	engine, err := shardContext.GetEngine(ctx)
+	if err != nil {
+		return nil, h.convertError(err)
+	}
+
+	resp, err2 := engine.ReencryptWorkflowExecution(ctx, request)
+	if err2 != nil {
+		return nil, h.convertError(err2)
+	}
+
+	return resp, nil
+}
+
+// GetWorkflowExecutionTimeline analyzes the history of a workflow execution and returns it as timed spans
+func (h *Handler) GetWorkflowExecutionTimeline(ctx context.Context, request *historyservice.GetWorkflowExecutionTimelineRequest) (_ *historyservice.GetWorkflowExecutionTimelineResponse, retError error) {
+	defer metrics.CapturePanic(h.logger, h.metricsHandler, &retError)
+	h.startWG.Wait()
+
+	if h.isStopped() {
+		return nil, errShuttingDown
+	}
+
+	namespaceID := namespace.ID(request.GetNamespaceId())
+	if namespaceID == "" {
+		return nil, h.convertError(errNamespaceNotSet)
+	}
+
+	workflowID := request.GetRequest().GetExecution().GetWorkflowId()
+	shardContext, err := h.controller.GetShardByNamespaceWorkflow(namespaceID, workflowID)
+	if err != nil {
+		return nil, h.convertError(err)
+	}
+	engine, err := shardContext.GetEngine(ctx)
+	if err != nil {
+		return nil, h.convertError(err)
+	}
+
+	resp, err2 := engine.GetWorkflowExecutionTimeline(ctx, request)
+	if err2 != nil {
+		return nil, h.convertError(err2)
+	}
+
+	return resp, nil
+}
+
+// GetWorkflowHistoryBudget returns the consumption of a workflow execution against its history budget
+func (h *Handler) GetWorkflowHistoryBudget(ctx context.Context, request *historyservice.GetWorkflowHistoryBudgetRequest) (_ *historyservice.GetWorkflowHistoryBudgetResponse, retError error) {
+	defer metrics.CapturePanic(h.logger, h.metricsHandler, &retError)
+	h.startWG.Wait()
+
+	if h.isStopped() {
+		return nil, errShuttingDown
+	}
+
+	namespaceID := namespace.ID(request.GetNamespaceId())
+	if namespaceID == "" {
+		return nil, h.convertError(errNamespaceNotSet)
+	}
+
+	workflowID := request.GetRequest().GetExecution().GetWorkflowId()
+	shardContext, err := h.controller.GetShardByNamespaceWorkflow(namespaceID, workflowID)
+	if err != nil {
+		return nil, h.convertError(err)
+	}
+	engine, err := shardContext.GetEngine(ctx)
+	if err != nil {
+		return nil, h.convertError(err)
+	}
+
+	resp, err2 := engine.GetWorkflowHistoryBudget(ctx, request)
+	if err2 != nil {
+		return nil, h.convertError(err2)
+	}
+
+	return resp, nil
+}
+
 // UpdateWorkflowExecutionOptions updates the options of a workflow execution.
 // Can be used to set and unset versioning behavior override.
 func (h *Handler) UpdateWorkflowExecutionOptions(ctx context.Context, request *historyservice.UpdateWorkflowExecutionOptionsRequest) (_ *historyservice.UpdateWorkflowExecutionOptionsResponse, retError error) {
@@ -2285,11 +2492,11 @@ func (h *Handler) ForceDeleteWorkflowExecution(
 	if err != nil {
 		return nil, err
 	}
-	shardID := common.WorkflowIDToHistoryShard(
-		namespaceID.String(),
-		request.Request.Execution.WorkflowId,
-		h.config.NumberOfShards,
-	)
+	shardContext, err := h.controller.GetShardByNamespaceWorkflow(namespaceID, request.Request.Execution.WorkflowId)
+	if err != nil {
+		return nil, h.convertError(err)
+	}
+	shardID := shardContext.GetShardID()
 
 	workflowExecution := request.GetRequest().GetExecution()
 	h.logger.Info("ForceDeleteWorkflowExecution requested",
@@ -6,6 +6,7 @@ import (
 	"testing"
 
 	"github.com/stretchr/testify/assert"
+	historyspb "go.temporal.io/server/api/history/v1"
 	"go.temporal.io/server/api/historyservice/v1"
 	persistencespb "go.temporal.io/server/api/persistence/v1"
 	"go.temporal.io/server/common/log"
@@ -63,10 +64,13 @@ func TestDescribeHistoryHost(t *testing.T) {
 	)
 	controller.EXPECT().GetShardByID(int32(2)).Return(mockShard2, nil)
 	controller.EXPECT().ShardIDs().Return([]int32{2})
+	controller.EXPECT().ShardLoads().Return([]*historyspb.ShardLoad{{ShardId: 2, RequestsPerSecond: 1.5}})
 	namespaceRegistry.EXPECT().GetRegistrySize().Return(int64(0), int64(0))
 	hostInfoProvider.EXPECT().HostInfo().Return(membership.NewHostInfoFromAddress("0.0.0.0"))
-	_, err = h.DescribeHistoryHost(context.Background(), &historyservice.DescribeHistoryHostRequest{
+	resp, err := h.DescribeHistoryHost(context.Background(), &historyservice.DescribeHistoryHostRequest{
 		ShardId: 2,
 	})
 	assert.NoError(t, err)
+	assert.Len(t, resp.ShardLoads, 1)
+	assert.Equal(t, 1.5, resp.ShardLoads[0].RequestsPerSecond)
 }
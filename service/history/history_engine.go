@@ -38,10 +38,13 @@ import (
 	"go.temporal.io/server/service/history/api/deleteworkflow"
 	"go.temporal.io/server/service/history/api/describemutablestate"
 	"go.temporal.io/server/service/history/api/describeworkflow"
+	"go.temporal.io/server/service/history/api/forkworkflow"
 	"go.temporal.io/server/service/history/api/getworkflowexecutionhistory"
 	"go.temporal.io/server/service/history/api/getworkflowexecutionhistoryreverse"
 	"go.temporal.io/server/service/history/api/getworkflowexecutionrawhistory"
 	"go.temporal.io/server/service/history/api/getworkflowexecutionrawhistoryv2"
+	"go.temporal.io/server/service/history/api/getworkflowexecutiontimeline"
+	"go.temporal.io/server/service/history/api/getworkflowhistorybudget"
 	"go.temporal.io/server/service/history/api/isactivitytaskvalid"
 	"go.temporal.io/server/service/history/api/isworkflowtaskvalid"
 	"go.temporal.io/server/service/history/api/listtasks"
@@ -54,6 +57,8 @@ import (
 	"go.temporal.io/server/service/history/api/recordactivitytaskstarted"
 	"go.temporal.io/server/service/history/api/recordchildworkflowcompleted"
 	"go.temporal.io/server/service/history/api/recordworkflowtaskstarted"
+	"go.temporal.io/server/service/history/api/redactworkflow"
+	"go.temporal.io/server/service/history/api/reencryptworkflow"
 	"go.temporal.io/server/service/history/api/refreshworkflow"
 	"go.temporal.io/server/service/history/api/removesignalmutablestate"
 	replicationapi "go.temporal.io/server/service/history/api/replication"
@@ -822,6 +827,52 @@ func (e *historyEngineImpl) ResetWorkflowExecution(
 	return resetworkflow.Invoke(ctx, req, e.shardContext, e.workflowConsistencyChecker)
 }
 
+// ForkWorkflowExecution copies the history of a workflow into a new workflow owned by this shard
+func (e *historyEngineImpl) ForkWorkflowExecution(
+	ctx context.Context,
+	req *historyservice.ForkWorkflowExecutionRequest,
+) (*historyservice.ForkWorkflowExecutionResponse, error) {
+	return forkworkflow.Invoke(ctx, req, e.shardContext, e.workflowConsistencyChecker)
+}
+
+// RedactWorkflowExecution erases the selected payloads from the history of a closed workflow
+func (e *historyEngineImpl) RedactWorkflowExecution(
+	ctx context.Context,
+	req *historyservice.RedactWorkflowExecutionRequest,
+) (*historyservice.RedactWorkflowExecutionResponse, error) {
+	return redactworkflow.Invoke(
+		ctx,
+		req,
+		e.shardContext,
+		e.workflowConsistencyChecker,
+		workflow.RelocatableAttributesFetcherProvider(e.config, e.persistenceVisibilityMgr),
+	)
+}
+
+// ReencryptWorkflowExecution rewrites the mutable state of a closed workflow with the active encryption key
+func (e *historyEngineImpl) ReencryptWorkflowExecution(
+	ctx context.Context,
+	req *historyservice.ReencryptWorkflowExecutionRequest,
+) (*historyservice.ReencryptWorkflowExecutionResponse, error) {
+	return reencryptworkflow.Invoke(ctx, req, e.shardContext, e.workflowConsistencyChecker)
+}
+
+// GetWorkflowExecutionTimeline analyzes the history of a workflow and returns it as timed spans
+func (e *historyEngineImpl) GetWorkflowExecutionTimeline(
+	ctx context.Context,
+	req *historyservice.GetWorkflowExecutionTimelineRequest,
+) (*historyservice.GetWorkflowExecutionTimelineResponse, error) {
+	return getworkflowexecutiontimeline.Invoke(ctx, req, e.shardContext, e.workflowConsistencyChecker, e.eventNotifier)
+}
+
+// GetWorkflowHistoryBudget returns the consumption of a workflow against its history budget
+func (e *historyEngineImpl) GetWorkflowHistoryBudget(
+	ctx context.Context,
+	req *historyservice.GetWorkflowHistoryBudgetRequest,
+) (*historyservice.GetWorkflowHistoryBudgetResponse, error) {
+	return getworkflowhistorybudget.Invoke(ctx, req, e.shardContext, e.workflowConsistencyChecker)
+}
+
 // UpdateWorkflowExecutionOptions updates the options of a specific workflow execution.
 // Can be used to set and unset versioning behavior override.
 func (e *historyEngineImpl) UpdateWorkflowExecutionOptions(
@@ -960,6 +1011,26 @@ func (e *historyEngineImpl) RebuildMutableState(
 	)
 }
 
+func (e *historyEngineImpl) CheckMutableState(
+	ctx context.Context,
+	request *historyservice.CheckMutableStateRequest,
+) (*historyservice.CheckMutableStateResponse, error) {
+	inconsistencies, err := e.workflowRebuilder.check(
+		ctx,
+		definition.NewWorkflowKey(
+			request.GetNamespaceId(),
+			request.GetExecution().GetWorkflowId(),
+			request.GetExecution().GetRunId(),
+		),
+	)
+	if err != nil {
+		return nil, err
+	}
+	return &historyservice.CheckMutableStateResponse{
+		Inconsistencies: inconsistencies,
+	}, nil
+}
+
 func (e *historyEngineImpl) RefreshWorkflowTasks(
 	ctx context.Context,
 	namespaceUUID namespace.ID,
@@ -1021,6 +1092,7 @@ func (e *historyEngineImpl) AddTasks(
 		e.shardContext,
 		e.eventSerializer,
 		int(e.config.NumberOfShards),
+		e.config.NamespaceShardPools(),
 		request,
 		e.taskCategoryRegistry,
 	)
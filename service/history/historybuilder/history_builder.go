@@ -413,6 +413,15 @@ func (b *HistoryBuilder) AddWorkflowExecutionOptionsUpdatedEvent(
 	return event
 }
 
+func (b *HistoryBuilder) AddWorkflowPropertiesModifiedExternallyEvent(
+	upsertedMemo *commonpb.Memo,
+	userMetadata *sdkpb.UserMetadata,
+) *historypb.HistoryEvent {
+	event := b.EventFactory.CreateWorkflowPropertiesModifiedExternallyEvent(upsertedMemo, userMetadata)
+	event, _ = b.EventStore.add(event)
+	return event
+}
+
 func (b *HistoryBuilder) AddWorkflowExecutionUpdateAcceptedEvent(
 	protocolInstanceID string,
 	acceptedRequestMessageId string,
@@ -2254,6 +2254,11 @@ func (s *historyBuilderSuite) TestBufferEvent() {
 		enumspb.EVENT_TYPE_WORKFLOW_EXECUTION_UPDATE_COMPLETED: true,
 	}
 
+	// events added to closed workflows will be assigned an event ID immediately
+	closedWorkflowEvents := map[enumspb.EventType]bool{
+		enumspb.EVENT_TYPE_WORKFLOW_PROPERTIES_MODIFIED_EXTERNALLY: true,
+	}
+
 	// other events will not be assigned an event ID immediately (created automatically)
 	otherEvents := map[enumspb.EventType]bool{}
 	for _, eventType := range enumspb.EventType_value {
@@ -2269,6 +2274,9 @@ func (s *historyBuilderSuite) TestBufferEvent() {
 		if _, ok := messageEvents[enumspb.EventType(eventType)]; ok {
 			continue
 		}
+		if _, ok := closedWorkflowEvents[enumspb.EventType(eventType)]; ok {
+			continue
+		}
 		otherEvents[enumspb.EventType(eventType)] = true
 	}
 
@@ -2285,6 +2293,9 @@ func (s *historyBuilderSuite) TestBufferEvent() {
 	for eventType := range messageEvents {
 		s.False(s.historyBuilder.bufferEvent(eventType))
 	}
+	for eventType := range closedWorkflowEvents {
+		s.False(s.historyBuilder.bufferEvent(eventType))
+	}
 	// other events will return false
 	for eventType := range otherEvents {
 		s.True(s.historyBuilder.bufferEvent(eventType))
@@ -46,6 +46,11 @@ type (
 		TerminateWorkflowExecution(ctx context.Context, request *historyservice.TerminateWorkflowExecutionRequest) (*historyservice.TerminateWorkflowExecutionResponse, error)
 		DeleteWorkflowExecution(ctx context.Context, deleteRequest *historyservice.DeleteWorkflowExecutionRequest) (*historyservice.DeleteWorkflowExecutionResponse, error)
 		ResetWorkflowExecution(ctx context.Context, request *historyservice.ResetWorkflowExecutionRequest) (*historyservice.ResetWorkflowExecutionResponse, error)
+		ForkWorkflowExecution(ctx context.Context, request *historyservice.ForkWorkflowExecutionRequest) (*historyservice.ForkWorkflowExecutionResponse, error)
+		RedactWorkflowExecution(ctx context.Context, request *historyservice.RedactWorkflowExecutionRequest) (*historyservice.RedactWorkflowExecutionResponse, error)
+		ReencryptWorkflowExecution(ctx context.Context, request *historyservice.ReencryptWorkflowExecutionRequest) (*historyservice.ReencryptWorkflowExecutionResponse, error)
+		GetWorkflowExecutionTimeline(ctx context.Context, request *historyservice.GetWorkflowExecutionTimelineRequest) (*historyservice.GetWorkflowExecutionTimelineResponse, error)
+		GetWorkflowHistoryBudget(ctx context.Context, request *historyservice.GetWorkflowHistoryBudgetRequest) (*historyservice.GetWorkflowHistoryBudgetResponse, error)
 		UpdateWorkflowExecutionOptions(ctx context.Context, request *historyservice.UpdateWorkflowExecutionOptionsRequest) (*historyservice.UpdateWorkflowExecutionOptionsResponse, error)
 		ScheduleWorkflowTask(ctx context.Context, request *historyservice.ScheduleWorkflowTaskRequest) error
 		IsActivityTaskValid(ctx context.Context, request *historyservice.IsActivityTaskValidRequest) (*historyservice.IsActivityTaskValidResponse, error)
@@ -78,6 +83,7 @@ type (
 		PurgeDLQMessages(ctx context.Context, messagesRequest *historyservice.PurgeDLQMessagesRequest) (*historyservice.PurgeDLQMessagesResponse, error)
 		MergeDLQMessages(ctx context.Context, messagesRequest *historyservice.MergeDLQMessagesRequest) (*historyservice.MergeDLQMessagesResponse, error)
 		RebuildMutableState(ctx context.Context, namespaceUUID namespace.ID, execution *commonpb.WorkflowExecution) error
+		CheckMutableState(ctx context.Context, request *historyservice.CheckMutableStateRequest) (*historyservice.CheckMutableStateResponse, error)
 		ImportWorkflowExecution(ctx context.Context, request *historyservice.ImportWorkflowExecutionRequest) (*historyservice.ImportWorkflowExecutionResponse, error)
 		RefreshWorkflowTasks(ctx context.Context, namespaceUUID namespace.ID, execution *commonpb.WorkflowExecution) error
 		GenerateLastHistoryReplicationTasks(ctx context.Context, request *historyservice.GenerateLastHistoryReplicationTasksRequest) (*historyservice.GenerateLastHistoryReplicationTasksResponse, error)
@@ -83,6 +83,21 @@ func (mr *MockEngineMockRecorder) BackfillHistoryEvents(ctx, request any) *gomoc
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "BackfillHistoryEvents", reflect.TypeOf((*MockEngine)(nil).BackfillHistoryEvents), ctx, request)
 }
 
+// CheckMutableState mocks base method.
+func (m *MockEngine) CheckMutableState(ctx context.Context, request *historyservice.CheckMutableStateRequest) (*historyservice.CheckMutableStateResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CheckMutableState", ctx, request)
+	ret0, _ := ret[0].(*historyservice.CheckMutableStateResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CheckMutableState indicates an expected call of CheckMutableState.
+func (mr *MockEngineMockRecorder) CheckMutableState(ctx, request any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CheckMutableState", reflect.TypeOf((*MockEngine)(nil).CheckMutableState), ctx, request)
+}
+
 // ConvertReplicationTask mocks base method.
 func (m *MockEngine) ConvertReplicationTask(ctx context.Context, task tasks.Task, clusterID int32) (*repication.ReplicationTask, error) {
 	m.ctrl.T.Helper()
@@ -158,6 +173,21 @@ func (mr *MockEngineMockRecorder) ExecuteMultiOperation(ctx, request any) *gomoc
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ExecuteMultiOperation", reflect.TypeOf((*MockEngine)(nil).ExecuteMultiOperation), ctx, request)
 }
 
+// ForkWorkflowExecution mocks base method.
+func (m *MockEngine) ForkWorkflowExecution(ctx context.Context, request *historyservice.ForkWorkflowExecutionRequest) (*historyservice.ForkWorkflowExecutionResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ForkWorkflowExecution", ctx, request)
+	ret0, _ := ret[0].(*historyservice.ForkWorkflowExecutionResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ForkWorkflowExecution indicates an expected call of ForkWorkflowExecution.
+func (mr *MockEngineMockRecorder) ForkWorkflowExecution(ctx, request any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ForkWorkflowExecution", reflect.TypeOf((*MockEngine)(nil).ForkWorkflowExecution), ctx, request)
+}
+
 // GenerateLastHistoryReplicationTasks mocks base method.
 func (m *MockEngine) GenerateLastHistoryReplicationTasks(ctx context.Context, request *historyservice.GenerateLastHistoryReplicationTasksRequest) (*historyservice.GenerateLastHistoryReplicationTasksResponse, error) {
 	m.ctrl.T.Helper()
@@ -338,6 +368,36 @@ func (mr *MockEngineMockRecorder) GetWorkflowExecutionRawHistoryV2(ctx, request
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetWorkflowExecutionRawHistoryV2", reflect.TypeOf((*MockEngine)(nil).GetWorkflowExecutionRawHistoryV2), ctx, request)
 }
 
+// GetWorkflowExecutionTimeline mocks base method.
+func (m *MockEngine) GetWorkflowExecutionTimeline(ctx context.Context, request *historyservice.GetWorkflowExecutionTimelineRequest) (*historyservice.GetWorkflowExecutionTimelineResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetWorkflowExecutionTimeline", ctx, request)
+	ret0, _ := ret[0].(*historyservice.GetWorkflowExecutionTimelineResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetWorkflowExecutionTimeline indicates an expected call of GetWorkflowExecutionTimeline.
+func (mr *MockEngineMockRecorder) GetWorkflowExecutionTimeline(ctx, request any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetWorkflowExecutionTimeline", reflect.TypeOf((*MockEngine)(nil).GetWorkflowExecutionTimeline), ctx, request)
+}
+
+// GetWorkflowHistoryBudget mocks base method.
+func (m *MockEngine) GetWorkflowHistoryBudget(ctx context.Context, request *historyservice.GetWorkflowHistoryBudgetRequest) (*historyservice.GetWorkflowHistoryBudgetResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetWorkflowHistoryBudget", ctx, request)
+	ret0, _ := ret[0].(*historyservice.GetWorkflowHistoryBudgetResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetWorkflowHistoryBudget indicates an expected call of GetWorkflowHistoryBudget.
+func (mr *MockEngineMockRecorder) GetWorkflowHistoryBudget(ctx, request any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetWorkflowHistoryBudget", reflect.TypeOf((*MockEngine)(nil).GetWorkflowHistoryBudget), ctx, request)
+}
+
 // ImportWorkflowExecution mocks base method.
 func (m *MockEngine) ImportWorkflowExecution(ctx context.Context, request *historyservice.ImportWorkflowExecutionRequest) (*historyservice.ImportWorkflowExecutionResponse, error) {
 	m.ctrl.T.Helper()
@@ -600,6 +660,36 @@ func (mr *MockEngineMockRecorder) RecordWorkflowTaskStarted(ctx, request any) *g
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RecordWorkflowTaskStarted", reflect.TypeOf((*MockEngine)(nil).RecordWorkflowTaskStarted), ctx, request)
 }
 
+// RedactWorkflowExecution mocks base method.
+func (m *MockEngine) RedactWorkflowExecution(ctx context.Context, request *historyservice.RedactWorkflowExecutionRequest) (*historyservice.RedactWorkflowExecutionResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RedactWorkflowExecution", ctx, request)
+	ret0, _ := ret[0].(*historyservice.RedactWorkflowExecutionResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RedactWorkflowExecution indicates an expected call of RedactWorkflowExecution.
+func (mr *MockEngineMockRecorder) RedactWorkflowExecution(ctx, request any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RedactWorkflowExecution", reflect.TypeOf((*MockEngine)(nil).RedactWorkflowExecution), ctx, request)
+}
+
+// ReencryptWorkflowExecution mocks base method.
+func (m *MockEngine) ReencryptWorkflowExecution(ctx context.Context, request *historyservice.ReencryptWorkflowExecutionRequest) (*historyservice.ReencryptWorkflowExecutionResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ReencryptWorkflowExecution", ctx, request)
+	ret0, _ := ret[0].(*historyservice.ReencryptWorkflowExecutionResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ReencryptWorkflowExecution indicates an expected call of ReencryptWorkflowExecution.
+func (mr *MockEngineMockRecorder) ReencryptWorkflowExecution(ctx, request any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReencryptWorkflowExecution", reflect.TypeOf((*MockEngine)(nil).ReencryptWorkflowExecution), ctx, request)
+}
+
 // RefreshWorkflowTasks mocks base method.
 func (m *MockEngine) RefreshWorkflowTasks(ctx context.Context, namespaceUUID namespace.ID, execution *common.WorkflowExecution) error {
 	m.ctrl.T.Helper()
@@ -20,6 +20,7 @@ import (
 	enums "go.temporal.io/api/enums/v1"
 	failure "go.temporal.io/api/failure/v1"
 	history "go.temporal.io/api/history/v1"
+	sdk "go.temporal.io/api/sdk/v1"
 	taskqueue "go.temporal.io/api/taskqueue/v1"
 	update "go.temporal.io/api/update/v1"
 	workflow "go.temporal.io/api/workflow/v1"
@@ -786,6 +787,21 @@ func (mr *MockMutableStateMockRecorder) AddWorkflowPropertiesModifiedEvent(arg0,
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddWorkflowPropertiesModifiedEvent", reflect.TypeOf((*MockMutableState)(nil).AddWorkflowPropertiesModifiedEvent), arg0, arg1)
 }
 
+// AddWorkflowPropertiesModifiedExternallyEvent mocks base method.
+func (m *MockMutableState) AddWorkflowPropertiesModifiedExternallyEvent(upsertedMemo *common.Memo, userMetadata *sdk.UserMetadata) (*history.HistoryEvent, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AddWorkflowPropertiesModifiedExternallyEvent", upsertedMemo, userMetadata)
+	ret0, _ := ret[0].(*history.HistoryEvent)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// AddWorkflowPropertiesModifiedExternallyEvent indicates an expected call of AddWorkflowPropertiesModifiedExternallyEvent.
+func (mr *MockMutableStateMockRecorder) AddWorkflowPropertiesModifiedExternallyEvent(upsertedMemo, userMetadata any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddWorkflowPropertiesModifiedExternallyEvent", reflect.TypeOf((*MockMutableState)(nil).AddWorkflowPropertiesModifiedExternallyEvent), upsertedMemo, userMetadata)
+}
+
 // AddWorkflowTaskCompletedEvent mocks base method.
 func (m *MockMutableState) AddWorkflowTaskCompletedEvent(arg0 *WorkflowTaskInfo, arg1 *workflowservice.RespondWorkflowTaskCompletedRequest, arg2 WorkflowTaskCompletionLimits) (*history.HistoryEvent, error) {
 	m.ctrl.T.Helper()
@@ -1496,6 +1512,20 @@ func (mr *MockMutableStateMockRecorder) ApplyWorkflowPropertiesModifiedEvent(arg
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ApplyWorkflowPropertiesModifiedEvent", reflect.TypeOf((*MockMutableState)(nil).ApplyWorkflowPropertiesModifiedEvent), arg0)
 }
 
+// ApplyWorkflowPropertiesModifiedExternallyEvent mocks base method.
+func (m *MockMutableState) ApplyWorkflowPropertiesModifiedExternallyEvent(event *history.HistoryEvent) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ApplyWorkflowPropertiesModifiedExternallyEvent", event)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ApplyWorkflowPropertiesModifiedExternallyEvent indicates an expected call of ApplyWorkflowPropertiesModifiedExternallyEvent.
+func (mr *MockMutableStateMockRecorder) ApplyWorkflowPropertiesModifiedExternallyEvent(event any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ApplyWorkflowPropertiesModifiedExternallyEvent", reflect.TypeOf((*MockMutableState)(nil).ApplyWorkflowPropertiesModifiedExternallyEvent), event)
+}
+
 // ApplyWorkflowTaskCompletedEvent mocks base method.
 func (m *MockMutableState) ApplyWorkflowTaskCompletedEvent(arg0 *history.HistoryEvent) error {
 	m.ctrl.T.Helper()
@@ -67,6 +67,7 @@ type (
 		GetQueueExclusiveHighReadWatermark(category tasks.Category) tasks.Key
 		GetQueueState(category tasks.Category) (*persistencespb.QueueState, bool)
 		SetQueueState(category tasks.Category, tasksCompleted int, state *persistencespb.QueueState) error
+		SetQueuePendingTaskCount(category tasks.Category, pendingTaskCount int)
 		UpdateReplicationQueueReaderState(readerID int64, readerState *persistencespb.QueueReaderState) error
 
 		GetReplicatorDLQAckLevel(sourceCluster string) int64
@@ -116,5 +117,6 @@ type (
 
 		IsValid() bool
 		FinishStop()
+		GetQueuePendingTaskCount() int64
 	}
 )
@@ -681,6 +681,18 @@ func (mr *MockShardContextMockRecorder) SetCurrentTime(cluster, currentTime any)
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetCurrentTime", reflect.TypeOf((*MockShardContext)(nil).SetCurrentTime), cluster, currentTime)
 }
 
+// SetQueuePendingTaskCount mocks base method.
+func (m *MockShardContext) SetQueuePendingTaskCount(category tasks.Category, pendingTaskCount int) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "SetQueuePendingTaskCount", category, pendingTaskCount)
+}
+
+// SetQueuePendingTaskCount indicates an expected call of SetQueuePendingTaskCount.
+func (mr *MockShardContextMockRecorder) SetQueuePendingTaskCount(category, pendingTaskCount any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetQueuePendingTaskCount", reflect.TypeOf((*MockShardContext)(nil).SetQueuePendingTaskCount), category, pendingTaskCount)
+}
+
 // SetQueueState mocks base method.
 func (m *MockShardContext) SetQueueState(category tasks.Category, tasksCompleted int, state *persistence.QueueState) error {
 	m.ctrl.T.Helper()
@@ -1298,6 +1310,20 @@ func (mr *MockControllableContextMockRecorder) GetQueueExclusiveHighReadWatermar
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetQueueExclusiveHighReadWatermark", reflect.TypeOf((*MockControllableContext)(nil).GetQueueExclusiveHighReadWatermark), category)
 }
 
+// GetQueuePendingTaskCount mocks base method.
+func (m *MockControllableContext) GetQueuePendingTaskCount() int64 {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetQueuePendingTaskCount")
+	ret0, _ := ret[0].(int64)
+	return ret0
+}
+
+// GetQueuePendingTaskCount indicates an expected call of GetQueuePendingTaskCount.
+func (mr *MockControllableContextMockRecorder) GetQueuePendingTaskCount() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetQueuePendingTaskCount", reflect.TypeOf((*MockControllableContext)(nil).GetQueuePendingTaskCount))
+}
+
 // GetQueueState mocks base method.
 func (m *MockControllableContext) GetQueueState(category tasks.Category) (*persistence.QueueState, bool) {
 	m.ctrl.T.Helper()
@@ -1498,6 +1524,18 @@ func (mr *MockControllableContextMockRecorder) SetCurrentTime(cluster, currentTi
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetCurrentTime", reflect.TypeOf((*MockControllableContext)(nil).SetCurrentTime), cluster, currentTime)
 }
 
+// SetQueuePendingTaskCount mocks base method.
+func (m *MockControllableContext) SetQueuePendingTaskCount(category tasks.Category, pendingTaskCount int) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "SetQueuePendingTaskCount", category, pendingTaskCount)
+}
+
+// SetQueuePendingTaskCount indicates an expected call of SetQueuePendingTaskCount.
+func (mr *MockControllableContextMockRecorder) SetQueuePendingTaskCount(category, pendingTaskCount any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetQueuePendingTaskCount", reflect.TypeOf((*MockControllableContext)(nil).SetQueuePendingTaskCount), category, pendingTaskCount)
+}
+
 // SetQueueState mocks base method.
 func (m *MockControllableContext) SetQueueState(category tasks.Category, tasksCompleted int, state *persistence.QueueState) error {
 	m.ctrl.T.Helper()
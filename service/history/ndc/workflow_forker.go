@@ -0,0 +1,262 @@
+//go:generate mockgen -package $GOPACKAGE -source $GOFILE -destination workflow_forker_mock.go
+
+package ndc
+
+import (
+	"context"
+	"errors"
+
+	commonpb "go.temporal.io/api/common/v1"
+	enumspb "go.temporal.io/api/enums/v1"
+	historypb "go.temporal.io/api/history/v1"
+	"go.temporal.io/api/serviceerror"
+	"go.temporal.io/server/common"
+	"go.temporal.io/server/common/definition"
+	"go.temporal.io/server/common/log"
+	"go.temporal.io/server/common/namespace"
+	"go.temporal.io/server/common/persistence"
+	historyi "go.temporal.io/server/service/history/interfaces"
+	"go.temporal.io/server/service/history/workflow"
+	wcache "go.temporal.io/server/service/history/workflow/cache"
+	"google.golang.org/protobuf/proto"
+)
+
+type (
+	WorkflowForker interface {
+		ForkWorkflow(
+			ctx context.Context,
+			namespaceID namespace.ID,
+			workflowID string,
+			forkRunID string,
+			forkRequestID string,
+			baseWorkflowKey definition.WorkflowKey,
+			baseEvents [][]*historypb.HistoryEvent,
+			reapplyEvents []*historypb.HistoryEvent,
+			forkReason string,
+			resetReapplyExcludeTypes map[enumspb.ResetReapplyExcludeType]struct{},
+		) error
+	}
+
+	workflowForkerImpl struct {
+		*workflowResetterImpl
+	}
+)
+
+var _ WorkflowForker = (*workflowForkerImpl)(nil)
+
+func NewWorkflowForker(
+	shardContext historyi.ShardContext,
+	workflowCache wcache.Cache,
+	logger log.Logger,
+) *workflowForkerImpl {
+	return &workflowForkerImpl{
+		workflowResetterImpl: NewWorkflowResetter(shardContext, workflowCache, logger),
+	}
+}
+
+// ForkWorkflow creates a brand new workflow from the history of a base workflow which may belong
+// to another shard or namespace. It does the following
+// - copies the given base events into a new history branch, rewriting them so the new workflow has
+// no relation to the base run (e.g. parent, continue-as-new chain, completion callbacks).
+// - rebuilds the mutable state from the copied history and fails the pending workflow task, like reset does.
+// - cherry-picks the relevant events from the remaining base events and reapplies them to the new run.
+func (r *workflowForkerImpl) ForkWorkflow(
+	ctx context.Context,
+	namespaceID namespace.ID,
+	workflowID string,
+	forkRunID string,
+	forkRequestID string,
+	baseWorkflowKey definition.WorkflowKey,
+	baseEvents [][]*historypb.HistoryEvent,
+	reapplyEvents []*historypb.HistoryEvent,
+	forkReason string,
+	resetReapplyExcludeTypes map[enumspb.ResetReapplyExcludeType]struct{},
+) error {
+	if len(baseEvents) == 0 || len(baseEvents[0]) == 0 ||
+		baseEvents[0][0].GetEventType() != enumspb.EVENT_TYPE_WORKFLOW_EXECUTION_STARTED {
+		return serviceerror.NewInvalidArgument("WorkflowForker requires history events from the beginning of the workflow.")
+	}
+
+	namespaceEntry, err := r.namespaceRegistry.GetNamespaceByID(namespaceID)
+	if err != nil {
+		return err
+	}
+	forkVersion := namespaceEntry.FailoverVersion()
+	forkWorkflowKey := definition.NewWorkflowKey(namespaceID.String(), workflowID, forkRunID)
+
+	forkBranchToken, forkLastEventID, err := r.copyHistory(
+		ctx,
+		namespaceEntry,
+		forkWorkflowKey,
+		baseEvents,
+		forkVersion,
+	)
+	if err != nil {
+		return err
+	}
+
+	forkMutableState, forkHistorySize, err := r.stateRebuilder.Rebuild(
+		ctx,
+		r.shardContext.GetTimeSource().Now(),
+		forkWorkflowKey,
+		forkBranchToken,
+		forkLastEventID,
+		&forkVersion,
+		forkWorkflowKey,
+		forkBranchToken,
+		forkRequestID,
+	)
+	if err != nil {
+		return err
+	}
+	forkMutableState.AddHistorySize(forkHistorySize)
+
+	if len(forkMutableState.GetPendingChildExecutionInfos()) > 0 {
+		return serviceerror.NewInvalidArgument("WorkflowForker encountered pending child workflows.")
+	}
+	if err := forkMutableState.RefreshExpirationTimeoutTask(ctx); err != nil {
+		return err
+	}
+	if err := forkMutableState.UpdateCurrentVersion(forkVersion, false); err != nil {
+		return err
+	}
+	if err := r.failWorkflowTask(
+		forkMutableState,
+		baseWorkflowKey.RunID,
+		forkLastEventID,
+		forkVersion,
+		forkRunID,
+		forkReason,
+	); err != nil {
+		return err
+	}
+	if err := r.failInflightActivity(
+		forkMutableState.GetExecutionState().StartTime.AsTime(),
+		forkMutableState,
+		forkReason,
+	); err != nil {
+		return err
+	}
+	if !r.shouldExcludeAllReapplyEvents(resetReapplyExcludeTypes) {
+		if _, err := r.reapplyEvents(ctx, forkMutableState, reapplyEvents, resetReapplyExcludeTypes); err != nil {
+			return err
+		}
+	}
+	if err := workflow.ScheduleWorkflowTask(forkMutableState); err != nil {
+		return err
+	}
+
+	forkSnapshot, forkEventsSeq, err := forkMutableState.CloseTransactionAsSnapshot(
+		historyi.TransactionPolicyActive,
+	)
+	if err != nil {
+		return err
+	}
+	_, err = r.transaction.CreateWorkflowExecution(
+		ctx,
+		persistence.CreateWorkflowModeBrandNew,
+		forkMutableState.GetCurrentVersion(),
+		forkSnapshot,
+		forkEventsSeq,
+	)
+	var currentWorkflowConditionFailed *persistence.CurrentWorkflowConditionFailedError
+	if errors.As(err, &currentWorkflowConditionFailed) {
+		return serviceerror.NewWorkflowExecutionAlreadyStarted(
+			"Workflow execution already exists.",
+			"",
+			currentWorkflowConditionFailed.RunID,
+		)
+	}
+	return err
+}
+
+// copyHistory writes the base events, rewritten for the forked workflow, into a new history branch
+// and returns its token along with the ID of the last event copied.
+func (r *workflowForkerImpl) copyHistory(
+	ctx context.Context,
+	namespaceEntry *namespace.Namespace,
+	forkWorkflowKey definition.WorkflowKey,
+	baseEvents [][]*historypb.HistoryEvent,
+	forkVersion int64,
+) ([]byte, int64, error) {
+	startAttributes := baseEvents[0][0].GetWorkflowExecutionStartedEventAttributes()
+	forkBranchToken, err := r.executionMgr.GetHistoryBranchUtil().NewHistoryBranch(
+		forkWorkflowKey.NamespaceID,
+		forkWorkflowKey.WorkflowID,
+		forkWorkflowKey.RunID,
+		forkWorkflowKey.RunID,
+		nil,
+		nil,
+		startAttributes.GetWorkflowRunTimeout().AsDuration(),
+		startAttributes.GetWorkflowExecutionTimeout().AsDuration(),
+		namespaceEntry.Retention(),
+	)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	lastEventID := common.EmptyEventID
+	prevTransactionID := common.EmptyEventTaskID
+	for i, batch := range baseEvents {
+		events := make([]*historypb.HistoryEvent, 0, len(batch))
+		for _, event := range batch {
+			events = append(events, forkEvent(event, forkWorkflowKey.RunID, forkVersion))
+		}
+		transactionID, err := r.shardContext.GenerateTaskID()
+		if err != nil {
+			return nil, 0, err
+		}
+		if _, err := r.shardContext.AppendHistoryEvents(
+			ctx,
+			&persistence.AppendHistoryNodesRequest{
+				IsNewBranch:       i == 0,
+				Info:              persistence.BuildHistoryGarbageCleanupInfo(forkWorkflowKey.NamespaceID, forkWorkflowKey.WorkflowID, forkWorkflowKey.RunID),
+				BranchToken:       forkBranchToken,
+				Events:            events,
+				PrevTransactionID: prevTransactionID,
+				TransactionID:     transactionID,
+			},
+			namespaceEntry.ID(),
+			&commonpb.WorkflowExecution{
+				WorkflowId: forkWorkflowKey.WorkflowID,
+				RunId:      forkWorkflowKey.RunID,
+			},
+		); err != nil {
+			return nil, 0, err
+		}
+		prevTransactionID = transactionID
+		lastEventID = events[len(events)-1].GetEventId()
+	}
+	return forkBranchToken, lastEventID, nil
+}
+
+// forkEvent returns a copy of the given base event for the forked workflow. All events are written
+// with the version of the forked workflow and references to the base run are dropped.
+func forkEvent(
+	event *historypb.HistoryEvent,
+	forkRunID string,
+	forkVersion int64,
+) *historypb.HistoryEvent {
+	event = proto.Clone(event).(*historypb.HistoryEvent)
+	event.Version = forkVersion
+	switch event.GetEventType() { // nolint:exhaustive
+	case enumspb.EVENT_TYPE_WORKFLOW_EXECUTION_STARTED:
+		attributes := event.GetWorkflowExecutionStartedEventAttributes()
+		attributes.ParentWorkflowNamespace = ""
+		attributes.ParentWorkflowNamespaceId = ""
+		attributes.ParentWorkflowExecution = nil
+		attributes.ParentInitiatedEventId = 0
+		attributes.ParentInitiatedEventVersion = 0
+		attributes.RootWorkflowExecution = nil
+		attributes.ContinuedExecutionRunId = ""
+		attributes.OriginalExecutionRunId = forkRunID
+		attributes.FirstExecutionRunId = forkRunID
+		attributes.PrevAutoResetPoints = nil
+		attributes.CompletionCallbacks = nil
+	case enumspb.EVENT_TYPE_WORKFLOW_EXECUTION_OPTIONS_UPDATED:
+		attributes := event.GetWorkflowExecutionOptionsUpdatedEventAttributes()
+		attributes.AttachedRequestId = ""
+		attributes.AttachedCompletionCallbacks = nil
+	}
+	return event
+}
@@ -0,0 +1,59 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: workflow_forker.go
+//
+// Generated by this command:
+//
+//	mockgen -package ndc -source workflow_forker.go -destination workflow_forker_mock.go
+//
+
+// Package ndc is a generated GoMock package.
+package ndc
+
+import (
+	context "context"
+	reflect "reflect"
+
+	enums "go.temporal.io/api/enums/v1"
+	history "go.temporal.io/api/history/v1"
+	definition "go.temporal.io/server/common/definition"
+	namespace "go.temporal.io/server/common/namespace"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockWorkflowForker is a mock of WorkflowForker interface.
+type MockWorkflowForker struct {
+	ctrl     *gomock.Controller
+	recorder *MockWorkflowForkerMockRecorder
+	isgomock struct{}
+}
+
+// MockWorkflowForkerMockRecorder is the mock recorder for MockWorkflowForker.
+type MockWorkflowForkerMockRecorder struct {
+	mock *MockWorkflowForker
+}
+
+// NewMockWorkflowForker creates a new mock instance.
+func NewMockWorkflowForker(ctrl *gomock.Controller) *MockWorkflowForker {
+	mock := &MockWorkflowForker{ctrl: ctrl}
+	mock.recorder = &MockWorkflowForkerMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockWorkflowForker) EXPECT() *MockWorkflowForkerMockRecorder {
+	return m.recorder
+}
+
+// ForkWorkflow mocks base method.
+func (m *MockWorkflowForker) ForkWorkflow(ctx context.Context, namespaceID namespace.ID, workflowID, forkRunID, forkRequestID string, baseWorkflowKey definition.WorkflowKey, baseEvents [][]*history.HistoryEvent, reapplyEvents []*history.HistoryEvent, forkReason string, resetReapplyExcludeTypes map[enums.ResetReapplyExcludeType]struct{}) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ForkWorkflow", ctx, namespaceID, workflowID, forkRunID, forkRequestID, baseWorkflowKey, baseEvents, reapplyEvents, forkReason, resetReapplyExcludeTypes)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ForkWorkflow indicates an expected call of ForkWorkflow.
+func (mr *MockWorkflowForkerMockRecorder) ForkWorkflow(ctx, namespaceID, workflowID, forkRunID, forkRequestID, baseWorkflowKey, baseEvents, reapplyEvents, forkReason, resetReapplyExcludeTypes any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ForkWorkflow", reflect.TypeOf((*MockWorkflowForker)(nil).ForkWorkflow), ctx, namespaceID, workflowID, forkRunID, forkRequestID, baseWorkflowKey, baseEvents, reapplyEvents, forkReason, resetReapplyExcludeTypes)
+}
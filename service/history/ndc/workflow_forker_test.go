@@ -0,0 +1,160 @@
+package ndc
+
+import (
+	"context"
+	"testing"
+
+	"github.com/pborman/uuid"
+	"github.com/stretchr/testify/require"
+	"github.com/stretchr/testify/suite"
+	commonpb "go.temporal.io/api/common/v1"
+	enumspb "go.temporal.io/api/enums/v1"
+	historypb "go.temporal.io/api/history/v1"
+	persistencespb "go.temporal.io/server/api/persistence/v1"
+	"go.temporal.io/server/common/definition"
+	"go.temporal.io/server/common/log"
+	"go.temporal.io/server/common/metrics"
+	"go.temporal.io/server/common/persistence"
+	"go.temporal.io/server/service/history/shard"
+	"go.temporal.io/server/service/history/tests"
+	wcache "go.temporal.io/server/service/history/workflow/cache"
+	"go.uber.org/mock/gomock"
+)
+
+type (
+	workflowForkerSuite struct {
+		suite.Suite
+		*require.Assertions
+
+		controller       *gomock.Controller
+		mockShard        *shard.ContextTest
+		mockExecutionMgr *persistence.MockExecutionManager
+
+		forkWorkflowKey definition.WorkflowKey
+		workflowForker  *workflowForkerImpl
+	}
+)
+
+func TestWorkflowForkerSuite(t *testing.T) {
+	s := new(workflowForkerSuite)
+	suite.Run(t, s)
+}
+
+func (s *workflowForkerSuite) SetupTest() {
+	s.Assertions = require.New(s.T())
+
+	s.controller = gomock.NewController(s.T())
+	s.mockShard = shard.NewTestContext(
+		s.controller,
+		&persistencespb.ShardInfo{
+			ShardId: 0,
+			RangeId: 1,
+		},
+		tests.NewDynamicConfig(),
+	)
+	s.mockExecutionMgr = s.mockShard.Resource.ExecutionMgr
+
+	s.forkWorkflowKey = definition.NewWorkflowKey(tests.NamespaceID.String(), "fork workflow ID", uuid.New())
+	s.workflowForker = NewWorkflowForker(
+		s.mockShard,
+		wcache.NewHostLevelCache(s.mockShard.GetConfig(), s.mockShard.GetLogger(), metrics.NoopMetricsHandler),
+		log.NewTestLogger(),
+	)
+}
+
+func (s *workflowForkerSuite) TearDownTest() {
+	s.controller.Finish()
+	s.mockShard.StopForTest()
+}
+
+func (s *workflowForkerSuite) TestForkEvent() {
+	baseRunID := uuid.New()
+	startEvent := &historypb.HistoryEvent{
+		EventId:   1,
+		Version:   123,
+		EventType: enumspb.EVENT_TYPE_WORKFLOW_EXECUTION_STARTED,
+		Attributes: &historypb.HistoryEvent_WorkflowExecutionStartedEventAttributes{WorkflowExecutionStartedEventAttributes: &historypb.WorkflowExecutionStartedEventAttributes{
+			ParentWorkflowNamespace:   "parent namespace",
+			ParentWorkflowNamespaceId: uuid.New(),
+			ParentWorkflowExecution:   &commonpb.WorkflowExecution{WorkflowId: "parent workflow ID", RunId: uuid.New()},
+			ParentInitiatedEventId:    5,
+			RootWorkflowExecution:     &commonpb.WorkflowExecution{WorkflowId: "root workflow ID", RunId: uuid.New()},
+			ContinuedExecutionRunId:   uuid.New(),
+			OriginalExecutionRunId:    baseRunID,
+			FirstExecutionRunId:       baseRunID,
+			CompletionCallbacks:       []*commonpb.Callback{{}},
+			Identity:                  testIdentity,
+		}},
+	}
+
+	forkedEvent := forkEvent(startEvent, s.forkWorkflowKey.RunID, 456)
+	s.Equal(int64(456), forkedEvent.GetVersion())
+	attributes := forkedEvent.GetWorkflowExecutionStartedEventAttributes()
+	s.Empty(attributes.GetParentWorkflowNamespace())
+	s.Empty(attributes.GetParentWorkflowNamespaceId())
+	s.Nil(attributes.GetParentWorkflowExecution())
+	s.Zero(attributes.GetParentInitiatedEventId())
+	s.Nil(attributes.GetRootWorkflowExecution())
+	s.Empty(attributes.GetContinuedExecutionRunId())
+	s.Equal(s.forkWorkflowKey.RunID, attributes.GetOriginalExecutionRunId())
+	s.Equal(s.forkWorkflowKey.RunID, attributes.GetFirstExecutionRunId())
+	s.Empty(attributes.GetCompletionCallbacks())
+	s.Equal(testIdentity, attributes.GetIdentity())
+
+	// the base event is left untouched
+	s.Equal(int64(123), startEvent.GetVersion())
+	s.Equal(baseRunID, startEvent.GetWorkflowExecutionStartedEventAttributes().GetFirstExecutionRunId())
+}
+
+func (s *workflowForkerSuite) TestCopyHistory() {
+	baseEvents := [][]*historypb.HistoryEvent{
+		{
+			{
+				EventId:   1,
+				Version:   123,
+				EventType: enumspb.EVENT_TYPE_WORKFLOW_EXECUTION_STARTED,
+				Attributes: &historypb.HistoryEvent_WorkflowExecutionStartedEventAttributes{
+					WorkflowExecutionStartedEventAttributes: &historypb.WorkflowExecutionStartedEventAttributes{},
+				},
+			},
+			{EventId: 2, Version: 123, EventType: enumspb.EVENT_TYPE_WORKFLOW_TASK_SCHEDULED},
+		},
+		{
+			{EventId: 3, Version: 123, EventType: enumspb.EVENT_TYPE_WORKFLOW_TASK_STARTED},
+		},
+	}
+	namespaceEntry := tests.GlobalNamespaceEntry
+	forkVersion := namespaceEntry.FailoverVersion()
+
+	s.mockShard.Resource.NamespaceCache.EXPECT().GetNamespaceByID(namespaceEntry.ID()).Return(namespaceEntry, nil).AnyTimes()
+	var requests []*persistence.AppendHistoryNodesRequest
+	s.mockExecutionMgr.EXPECT().AppendHistoryNodes(gomock.Any(), gomock.Any()).DoAndReturn(
+		func(_ context.Context, request *persistence.AppendHistoryNodesRequest) (*persistence.AppendHistoryNodesResponse, error) {
+			requests = append(requests, request)
+			return &persistence.AppendHistoryNodesResponse{}, nil
+		},
+	).Times(2)
+
+	branchToken, lastEventID, err := s.workflowForker.copyHistory(
+		context.Background(),
+		namespaceEntry,
+		s.forkWorkflowKey,
+		baseEvents,
+		forkVersion,
+	)
+	s.NoError(err)
+	s.NotEmpty(branchToken)
+	s.Equal(int64(3), lastEventID)
+
+	s.Len(requests, 2)
+	s.True(requests[0].IsNewBranch)
+	s.False(requests[1].IsNewBranch)
+	s.Equal(requests[0].TransactionID, requests[1].PrevTransactionID)
+	for i, request := range requests {
+		s.Equal(branchToken, request.BranchToken)
+		s.Len(request.Events, len(baseEvents[i]))
+		for _, event := range request.Events {
+			s.Equal(forkVersion, event.GetVersion())
+		}
+	}
+}
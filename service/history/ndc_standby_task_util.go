@@ -134,6 +134,7 @@ type (
 		activityTaskScheduleToStartTimeout time.Duration
 		versionDirective                   *taskqueuespb.TaskVersionDirective
 		priority                           *commonpb.Priority
+		workerSelector                     map[string]string
 	}
 
 	verifyCompletionRecordedPostActionInfo struct {
@@ -167,6 +168,7 @@ func newActivityTaskPostActionInfo(
 		activityTaskScheduleToStartTimeout: activityInfo.ScheduleToStartTimeout.AsDuration(),
 		versionDirective:                   directive,
 		priority:                           priority,
+		workerSelector:                     activityInfo.WorkerSelector,
 	}, nil
 }
 
@@ -184,6 +186,7 @@ func newActivityRetryTimePostActionInfo(
 		activityTaskScheduleToStartTimeout: activityScheduleToStartTimeout,
 		versionDirective:                   directive,
 		priority:                           priority,
+		workerSelector:                     activityInfo.WorkerSelector,
 	}, nil
 }
 
@@ -249,6 +249,7 @@ func (f *outboundQueueFactory) CreateQueue(
 		f.Config.TaskDLQUnexpectedErrorAttempts,
 		f.Config.TaskDLQInternalErrors,
 		f.Config.TaskDLQErrorPattern,
+		f.Config.NamespaceShardPools,
 	)
 	return queues.NewImmediateQueue(
 		shardContext,
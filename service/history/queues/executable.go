@@ -159,6 +159,7 @@ type (
 		maxUnexpectedErrorAttempts dynamicconfig.IntPropertyFn
 		dlqInternalErrors          dynamicconfig.BoolPropertyFn
 		dlqErrorPattern            dynamicconfig.StringPropertyFn
+		namespaceShardPools        dynamicconfig.TypedPropertyFn[map[string]common.NamespaceShardPool]
 	}
 	ExecutableParams struct {
 		DLQEnabled                 dynamicconfig.BoolPropertyFn
@@ -166,6 +167,7 @@ type (
 		MaxUnexpectedErrorAttempts dynamicconfig.IntPropertyFn
 		DLQInternalErrors          dynamicconfig.BoolPropertyFn
 		DLQErrorPattern            dynamicconfig.StringPropertyFn
+		NamespaceShardPools        dynamicconfig.TypedPropertyFn[map[string]common.NamespaceShardPool]
 	}
 	ExecutableOption func(*ExecutableParams)
 )
@@ -199,6 +201,9 @@ func NewExecutable(
 		DLQErrorPattern: func() string {
 			return ""
 		},
+		NamespaceShardPools: func() map[string]common.NamespaceShardPool {
+			return nil
+		},
 	}
 	for _, opt := range opts {
 		opt(&params)
@@ -229,6 +234,7 @@ func NewExecutable(
 		maxUnexpectedErrorAttempts: params.MaxUnexpectedErrorAttempts,
 		dlqInternalErrors:          params.DLQInternalErrors,
 		dlqErrorPattern:            params.DLQErrorPattern,
+		namespaceShardPools:        params.NamespaceShardPools,
 	}
 	executable.updatePriority()
 	return executable
@@ -373,7 +379,7 @@ func (e *executableImpl) writeToDLQ(ctx context.Context) error {
 		ctx,
 		currentClusterName,
 		currentClusterName,
-		tasks.GetShardIDForTask(e.Task, int(numShards)),
+		tasks.GetShardIDForTaskInPools(e.Task, int(numShards), e.namespaceShardPools()),
 		e.GetTask(),
 		e.lastActiveness,
 	)
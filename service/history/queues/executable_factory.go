@@ -2,6 +2,7 @@ package queues
 
 import (
 	"go.opentelemetry.io/otel/trace"
+	"go.temporal.io/server/common"
 	"go.temporal.io/server/common/clock"
 	"go.temporal.io/server/common/cluster"
 	"go.temporal.io/server/common/dynamicconfig"
@@ -35,6 +36,7 @@ type (
 		attemptsBeforeSendingToDlq dynamicconfig.IntPropertyFn
 		dlqInternalErrors          dynamicconfig.BoolPropertyFn
 		dlqErrorPattern            dynamicconfig.StringPropertyFn
+		namespaceShardPools        dynamicconfig.TypedPropertyFn[map[string]common.NamespaceShardPool]
 	}
 )
 
@@ -58,6 +60,7 @@ func NewExecutableFactory(
 	attemptsBeforeSendingToDlq dynamicconfig.IntPropertyFn,
 	dlqInternalErrors dynamicconfig.BoolPropertyFn,
 	dlqErrorPattern dynamicconfig.StringPropertyFn,
+	namespaceShardPools dynamicconfig.TypedPropertyFn[map[string]common.NamespaceShardPool],
 ) *executableFactoryImpl {
 	return &executableFactoryImpl{
 		executor:                   executor,
@@ -75,6 +78,7 @@ func NewExecutableFactory(
 		attemptsBeforeSendingToDlq: attemptsBeforeSendingToDlq,
 		dlqInternalErrors:          dlqInternalErrors,
 		dlqErrorPattern:            dlqErrorPattern,
+		namespaceShardPools:        namespaceShardPools,
 	}
 }
 
@@ -98,6 +102,7 @@ func (f *executableFactoryImpl) NewExecutable(task tasks.Task, readerID int64) E
 			params.MaxUnexpectedErrorAttempts = f.attemptsBeforeSendingToDlq
 			params.DLQInternalErrors = f.dlqInternalErrors
 			params.DLQErrorPattern = f.dlqErrorPattern
+			params.NamespaceShardPools = f.namespaceShardPools
 		},
 	)
 }
@@ -307,7 +307,9 @@ func (p *queueBase) checkpoint() {
 	}
 	metrics.QueueReaderCountHistogram.With(p.metricsHandler).Record(int64(len(readerScopes)))
 	metrics.QueueSliceCountHistogram.With(p.metricsHandler).Record(int64(p.monitor.GetTotalSliceCount()))
-	metrics.PendingTasksCounter.With(p.metricsHandler).Record(int64(p.monitor.GetTotalPendingTaskCount()))
+	pendingTaskCount := p.monitor.GetTotalPendingTaskCount()
+	metrics.PendingTasksCounter.With(p.metricsHandler).Record(int64(pendingTaskCount))
+	p.shard.SetQueuePendingTaskCount(p.category, pendingTaskCount)
 
 	// NOTE: Must range-complete task first.
 	// Otherwise, if state is updated first, later deletion fails and the shard gets reloaded.
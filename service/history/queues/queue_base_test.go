@@ -12,6 +12,7 @@ import (
 	"github.com/stretchr/testify/suite"
 	enumsspb "go.temporal.io/server/api/enums/v1"
 	persistencespb "go.temporal.io/server/api/persistence/v1"
+	"go.temporal.io/server/common"
 	"go.temporal.io/server/common/cluster"
 	"go.temporal.io/server/common/collection"
 	"go.temporal.io/server/common/dynamicconfig"
@@ -558,6 +559,9 @@ func (s *queueBaseSuite) newQueueBase(
 		func() string {
 			return ""
 		},
+		func() map[string]common.NamespaceShardPool {
+			return nil
+		},
 	)
 	return newQueueBase(
 		mockShard,
@@ -12,6 +12,7 @@ import (
 	"github.com/stretchr/testify/require"
 	"github.com/stretchr/testify/suite"
 	persistencespb "go.temporal.io/server/api/persistence/v1"
+	"go.temporal.io/server/common"
 	"go.temporal.io/server/common/cluster"
 	"go.temporal.io/server/common/log"
 	"go.temporal.io/server/common/metrics"
@@ -130,6 +131,9 @@ func (s *scheduledQueueSuite) SetupTest() {
 		func() string {
 			return ""
 		},
+		func() map[string]common.NamespaceShardPool {
+			return nil
+		},
 	)
 	s.scheduledQueue = NewScheduledQueue(
 		s.mockShard,
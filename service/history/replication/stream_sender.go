@@ -666,7 +666,18 @@ func (s *StreamSenderImpl) sendToStream(payload *historyservice.StreamWorkflowRe
 }
 
 func (s *StreamSenderImpl) shouldProcessTask(item tasks.Task) bool {
-	clientShardID := common.WorkflowIDToHistoryShard(item.GetNamespaceID(), item.GetWorkflowID(), s.clientClusterShardCount)
+	// namespace shard pools are expected to be configured identically on all clusters of a namespace, so a workflow
+	// that is on its alternate shard while its namespace is moved between pools is there on both clusters
+	shardID := common.WorkflowIDToHistoryShardInPools
+	if s.config.GetAlternateShardID(namespace.ID(item.GetNamespaceID()), item.GetWorkflowID()) == s.serverShardKey.ShardID {
+		shardID = common.WorkflowIDToAlternateHistoryShard
+	}
+	clientShardID := shardID(
+		item.GetNamespaceID(),
+		item.GetWorkflowID(),
+		s.clientClusterShardCount,
+		s.config.NamespaceShardPools(),
+	)
 	if clientShardID != s.clientShardKey.ShardID {
 		return false
 	}
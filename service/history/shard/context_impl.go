@@ -144,6 +144,7 @@ type (
 		// exist only in memory
 		remoteClusterInfos      map[string]*remoteClusterInfo
 		handoverNamespaces      map[namespace.Name]*namespaceHandOverInfo // keyed on namespace name
+		queuePendingTaskCounts  map[int]int                               // keyed on task category ID
 		acquireShardRetryPolicy backoff.RetryPolicy
 
 		stateMachineRegistry *hsm.Registry
@@ -376,6 +377,32 @@ func (s *ContextImpl) SetQueueState(
 		})
 }
 
+func (s *ContextImpl) SetQueuePendingTaskCount(
+	category tasks.Category,
+	pendingTaskCount int,
+) {
+	s.wLock()
+	defer s.wUnlock()
+
+	if s.queuePendingTaskCounts == nil {
+		s.queuePendingTaskCounts = make(map[int]int)
+	}
+	s.queuePendingTaskCounts[category.ID()] = pendingTaskCount
+}
+
+// GetQueuePendingTaskCount returns the number of pending tasks of all queues of the shard,
+// as last reported by the queues.
+func (s *ContextImpl) GetQueuePendingTaskCount() int64 {
+	s.rLock()
+	defer s.rUnlock()
+
+	var total int64
+	for _, count := range s.queuePendingTaskCounts {
+		total += int64(count)
+	}
+	return total
+}
+
 func (s *ContextImpl) UpdateReplicationQueueReaderState(
 	readerID int64,
 	readerState *persistencespb.QueueReaderState,
@@ -909,6 +936,7 @@ func (s *ContextImpl) AppendHistoryEvents(
 	}
 
 	request.ShardID = s.shardID
+	request.NamespaceID = namespaceID.String()
 
 	size := 0
 	defer func() {
@@ -3,6 +3,7 @@ package shard
 import (
 	"context"
 
+	historyspb "go.temporal.io/server/api/history/v1"
 	"go.temporal.io/server/common/namespace"
 	"go.temporal.io/server/common/pingable"
 	historyi "go.temporal.io/server/service/history/interfaces"
@@ -17,7 +18,11 @@ type (
 		GetShardByID(shardID int32) (historyi.ShardContext, error)
 		GetShardByNamespaceWorkflow(namespaceID namespace.ID, workflowID string) (historyi.ShardContext, error)
 		CloseShardByID(shardID int32)
+		// ClaimShard makes this host take over the shard from its current owner.
+		ClaimShard(shardID int32) error
 		ShardIDs() []int32
+		// ShardLoads returns the load of the shards owned by this host.
+		ShardLoads() []*historyspb.ShardLoad
 		Start()
 		Stop()
 		// InitialShardsAcquired blocks until initial shard acquisition is complete, context timeout,
@@ -2,6 +2,7 @@ package shard
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"math/rand"
 	"sync"
@@ -9,7 +10,9 @@ import (
 	"time"
 
 	"go.temporal.io/api/serviceerror"
+	historyspb "go.temporal.io/server/api/history/v1"
 	"go.temporal.io/server/common"
+	"go.temporal.io/server/common/cache"
 	"go.temporal.io/server/common/future"
 	"go.temporal.io/server/common/headers"
 	"go.temporal.io/server/common/log"
@@ -28,6 +31,9 @@ import (
 
 const (
 	shardLingerMaxTimeLimit = 1 * time.Minute
+
+	shardPoolMoveCacheSize = 10000
+	shardPoolMoveCacheTTL  = 1 * time.Minute
 )
 
 var (
@@ -45,11 +51,15 @@ type (
 			shards map[historyi.ControllableContext]struct{}
 		}
 
-		config               *configs.Config
-		contextFactory       ContextFactory
-		contextTaggedLogger  log.Logger
-		hostInfoProvider     membership.HostInfoProvider
-		ownership            *ownership
+		config              *configs.Config
+		contextFactory      ContextFactory
+		contextTaggedLogger log.Logger
+		executionManager    persistence.ExecutionManager
+		hostInfoProvider    membership.HostInfoProvider
+		loadTracker         *shardLoadTracker
+		ownership           *ownership
+		// shardPoolMoves caches the shard that serves a workflow of a namespace moved between shard pools
+		shardPoolMoves       cache.Cache
 		status               int32
 		taggedMetricsHandler metrics.Handler
 		// shardCountSubscriptions is a set of subscriptions that receive shard count updates whenever the set of
@@ -58,6 +68,12 @@ type (
 		initialShardsAcquired   *future.FutureImpl[struct{}]
 		shardReadinessCancel    atomic.Value // context.CancelFunc
 	}
+	shardPoolMoveKey struct {
+		namespaceID      namespace.ID
+		workflowID       string
+		shardID          int32
+		alternateShardID int32
+	}
 	// shardCountSubscription is a subscription to shard count updates.
 	shardCountSubscription struct {
 		controller *ControllerImpl
@@ -71,9 +87,11 @@ func ControllerProvider(
 	config *configs.Config,
 	logger log.Logger,
 	historyServiceResolver membership.ServiceResolver,
+	membershipMonitor membership.Monitor,
 	metricsHandler metrics.Handler,
 	hostInfoProvider membership.HostInfoProvider,
 	contextFactory ContextFactory,
+	executionManager persistence.ExecutionManager,
 ) *ControllerImpl {
 	hostIdentity := hostInfoProvider.HostInfo().Identity()
 	contextTaggedLogger := log.With(logger, tag.ComponentShardController, tag.Address(hostIdentity))
@@ -83,6 +101,7 @@ func ControllerProvider(
 		config,
 		historyServiceResolver,
 		hostInfoProvider,
+		membershipMonitor,
 		contextTaggedLogger,
 		taggedMetricsHandler,
 	)
@@ -91,9 +110,12 @@ func ControllerProvider(
 		config:                  config,
 		contextFactory:          contextFactory,
 		contextTaggedLogger:     contextTaggedLogger,
+		executionManager:        executionManager,
 		historyShards:           make(map[int32]historyi.ControllableContext),
 		hostInfoProvider:        hostInfoProvider,
+		loadTracker:             newShardLoadTracker(time.Now()),
 		ownership:               ownership,
+		shardPoolMoves:          cache.New(shardPoolMoveCacheSize, &cache.Options{TTL: shardPoolMoveCacheTTL}),
 		taggedMetricsHandler:    taggedMetricsHandler,
 		shardCountSubscriptions: map[*shardCountSubscription]struct{}{},
 		initialShardsAcquired:   future.NewFuture[struct{}](),
@@ -168,8 +190,75 @@ func (c *ControllerImpl) GetShardByNamespaceWorkflow(
 	namespaceID namespace.ID,
 	workflowID string,
 ) (historyi.ShardContext, error) {
-	shardID := c.config.GetShardID(namespaceID, workflowID)
-	return c.GetShardByID(shardID)
+	routedShardID := c.config.GetShardID(namespaceID, workflowID)
+	shardID, err := c.resolveShardPoolMove(namespaceID, workflowID, routedShardID)
+	if err != nil {
+		return nil, err
+	}
+	shard, err := c.GetShardByID(shardID)
+	var solErr *serviceerrors.ShardOwnershipLost
+	if shardID != routedShardID && errors.As(err, &solErr) {
+		// clients route by the shard the workflow would be started on, tell them which shard the redirect is for
+		solErr.ShardID = shardID
+	}
+	return shard, err
+}
+
+// resolveShardPoolMove returns the shard that serves the workflow. While the namespace is moved between shard pools,
+// workflows stay on the shard they already exist on.
+func (c *ControllerImpl) resolveShardPoolMove(
+	namespaceID namespace.ID,
+	workflowID string,
+	shardID int32,
+) (int32, error) {
+	alternateShardID := c.config.GetAlternateShardID(namespaceID, workflowID)
+	if alternateShardID == 0 {
+		return shardID, nil
+	}
+	key := shardPoolMoveKey{
+		namespaceID:      namespaceID,
+		workflowID:       workflowID,
+		shardID:          shardID,
+		alternateShardID: alternateShardID,
+	}
+	if resolvedShardID, ok := c.shardPoolMoves.Get(key).(int32); ok {
+		return resolvedShardID, nil
+	}
+	exists, err := c.workflowExists(alternateShardID, namespaceID, workflowID)
+	if err != nil {
+		return 0, err
+	}
+	if exists {
+		shardID = alternateShardID
+	}
+	// workflows are never started on the alternate shard, so the decision holds for as long as the move does
+	c.shardPoolMoves.Put(key, shardID)
+	return shardID, nil
+}
+
+// workflowExists returns true if the workflow has a current execution on the given shard.
+func (c *ControllerImpl) workflowExists(
+	shardID int32,
+	namespaceID namespace.ID,
+	workflowID string,
+) (bool, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), c.config.ShardIOTimeout())
+	defer cancel()
+	ctx = headers.SetCallerInfo(ctx, headers.SystemBackgroundHighCallerInfo)
+
+	_, err := c.executionManager.GetCurrentExecution(ctx, &persistence.GetCurrentExecutionRequest{
+		ShardID:     shardID,
+		NamespaceID: namespaceID.String(),
+		WorkflowID:  workflowID,
+	})
+	switch err.(type) {
+	case nil:
+		return true, nil
+	case *serviceerror.NotFound:
+		return false, nil
+	default:
+		return false, err
+	}
 }
 
 // GetShardByID returns a shard context for the given shard id.
@@ -183,7 +272,12 @@ func (c *ControllerImpl) GetShardByID(
 		metrics.GetEngineForShardLatency.With(c.taggedMetricsHandler).Record(time.Since(startTime))
 	}()
 
-	return c.getOrCreateShardContext(shardID)
+	shard, err := c.getOrCreateShardContext(shardID)
+	if err != nil {
+		return nil, err
+	}
+	c.loadTracker.recordRequest(shardID)
+	return shard, nil
 }
 
 func (c *ControllerImpl) CloseShardByID(shardID int32) {
@@ -200,6 +294,46 @@ func (c *ControllerImpl) CloseShardByID(shardID int32) {
 	}
 }
 
+// ClaimShard makes this host take over the shard from its current owner. The current owner
+// lingers on the shard until this host acquires it, if shard lingering is enabled.
+func (c *ControllerImpl) ClaimShard(shardID int32) error {
+	if err := c.validateShardId(shardID); err != nil {
+		return err
+	}
+	return c.ownership.claimShard(shardID)
+}
+
+// ShardLoads returns the load of the shards owned by this host, as of the latest load report.
+func (c *ControllerImpl) ShardLoads() []*historyspb.ShardLoad {
+	return c.loadTracker.lastLoads()
+}
+
+// reportShardLoads computes the load of the shards owned by this host since the previous
+// report and emits it as metrics.
+func (c *ControllerImpl) reportShardLoads() []*historyspb.ShardLoad {
+	c.RLock()
+	shards := make([]historyi.ControllableContext, 0, len(c.historyShards))
+	for _, shard := range c.historyShards {
+		if shard.IsValid() {
+			shards = append(shards, shard)
+		}
+	}
+	c.RUnlock()
+
+	loads := c.loadTracker.report(time.Now(), shards)
+	var requestsPerSecond float64
+	var queueBacklog, cachedWorkflows int64
+	for _, load := range loads {
+		requestsPerSecond += load.RequestsPerSecond
+		queueBacklog += load.QueueBacklog
+		cachedWorkflows += load.CachedWorkflows
+	}
+	metrics.ShardLoadRequestsPerSecond.With(c.taggedMetricsHandler).Record(requestsPerSecond)
+	metrics.ShardLoadQueueBacklog.With(c.taggedMetricsHandler).Record(float64(queueBacklog))
+	metrics.ShardLoadCachedWorkflows.With(c.taggedMetricsHandler).Record(float64(cachedWorkflows))
+	return loads
+}
+
 func (c *ControllerImpl) ShardIDs() []int32 {
 	c.RLock()
 	defer c.RUnlock()
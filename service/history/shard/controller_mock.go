@@ -13,6 +13,7 @@ import (
 	context "context"
 	reflect "reflect"
 
+	history "go.temporal.io/server/api/history/v1"
 	namespace "go.temporal.io/server/common/namespace"
 	pingable "go.temporal.io/server/common/pingable"
 	interfaces "go.temporal.io/server/service/history/interfaces"
@@ -43,6 +44,20 @@ func (m *MockController) EXPECT() *MockControllerMockRecorder {
 	return m.recorder
 }
 
+// ClaimShard mocks base method.
+func (m *MockController) ClaimShard(shardID int32) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ClaimShard", shardID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ClaimShard indicates an expected call of ClaimShard.
+func (mr *MockControllerMockRecorder) ClaimShard(shardID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ClaimShard", reflect.TypeOf((*MockController)(nil).ClaimShard), shardID)
+}
+
 // CloseShardByID mocks base method.
 func (m *MockController) CloseShardByID(shardID int32) {
 	m.ctrl.T.Helper()
@@ -127,6 +142,20 @@ func (mr *MockControllerMockRecorder) ShardIDs() *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ShardIDs", reflect.TypeOf((*MockController)(nil).ShardIDs))
 }
 
+// ShardLoads mocks base method.
+func (m *MockController) ShardLoads() []*history.ShardLoad {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ShardLoads")
+	ret0, _ := ret[0].([]*history.ShardLoad)
+	return ret0
+}
+
+// ShardLoads indicates an expected call of ShardLoads.
+func (mr *MockControllerMockRecorder) ShardLoads() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ShardLoads", reflect.TypeOf((*MockController)(nil).ShardLoads))
+}
+
 // Start mocks base method.
 func (m *MockController) Start() {
 	m.ctrl.T.Helper()
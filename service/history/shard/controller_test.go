@@ -31,6 +31,7 @@ import (
 	"go.temporal.io/server/common/primitives"
 	"go.temporal.io/server/common/primitives/timestamp"
 	"go.temporal.io/server/common/resourcetest"
+	serviceerrors "go.temporal.io/server/common/serviceerror"
 	"go.temporal.io/server/service/history/configs"
 	historyi "go.temporal.io/server/service/history/interfaces"
 	"go.temporal.io/server/service/history/tasks"
@@ -96,9 +97,11 @@ func NewTestController(
 		config,
 		resource.GetLogger(),
 		resource.GetHistoryServiceResolver(),
+		resource.GetMembershipMonitor(),
 		metricsTestHandler,
 		resource.GetHostInfoProvider(),
 		contextFactory,
+		resource.GetExecutionManager(),
 	)
 }
 
@@ -709,6 +712,63 @@ func (s *controllerSuite) Test_GetOrCreateShard_InvalidShardID() {
 	s.ErrorIs(err, invalidShardIdUpperBound)
 }
 
+func (s *controllerSuite) TestGetShardByNamespaceWorkflow_ShardPoolMove() {
+	s.config.NumberOfShards = 2
+	namespaceID := tests.NamespaceID
+	// the namespace is moved from all shards to a pool of shard 1, find workflows that were on shard 2
+	var workflowIDs []string
+	for i := 0; len(workflowIDs) < 2; i++ {
+		workflowID := fmt.Sprintf("workflow-id-%d", i)
+		if common.WorkflowIDToHistoryShard(namespaceID.String(), workflowID, 2) == 2 {
+			workflowIDs = append(workflowIDs, workflowID)
+		}
+	}
+	s.config.NamespaceShardPools = func() map[string]common.NamespaceShardPool {
+		return map[string]common.NamespaceShardPool{
+			namespaceID.String(): {FirstShardID: 1, LastShardID: 1, Previous: &common.NamespaceShardPool{}},
+		}
+	}
+	s.mockServiceResolver.EXPECT().Lookup("1").Return(membership.NewHostInfoFromAddress("pool-host"), nil).AnyTimes()
+	s.mockServiceResolver.EXPECT().Lookup("2").Return(membership.NewHostInfoFromAddress("previous-host"), nil).AnyTimes()
+	currentExecutionRequest := func(workflowID string) *persistence.GetCurrentExecutionRequest {
+		return &persistence.GetCurrentExecutionRequest{
+			ShardID:     2,
+			NamespaceID: namespaceID.String(),
+			WorkflowID:  workflowID,
+		}
+	}
+
+	// existing workflows are served from their previous shard, the redirect names that shard
+	s.mockResource.ExecutionMgr.EXPECT().GetCurrentExecution(gomock.Any(), currentExecutionRequest(workflowIDs[0])).
+		Return(&persistence.GetCurrentExecutionResponse{}, nil).
+		Times(1)
+	for range 2 {
+		_, err := s.shardController.GetShardByNamespaceWorkflow(namespaceID, workflowIDs[0])
+		var solErr *serviceerrors.ShardOwnershipLost
+		s.ErrorAs(err, &solErr)
+		s.Equal("previous-host", solErr.OwnerHost)
+		s.Equal(int32(2), solErr.ShardID)
+	}
+
+	// lookup errors are not cached
+	s.mockResource.ExecutionMgr.EXPECT().GetCurrentExecution(gomock.Any(), currentExecutionRequest(workflowIDs[1])).
+		Return(nil, serviceerror.NewUnavailable("unavailable"))
+	_, err := s.shardController.GetShardByNamespaceWorkflow(namespaceID, workflowIDs[1])
+	s.ErrorAs(err, new(*serviceerror.Unavailable))
+
+	// new workflows are started in the pool
+	s.mockResource.ExecutionMgr.EXPECT().GetCurrentExecution(gomock.Any(), currentExecutionRequest(workflowIDs[1])).
+		Return(nil, serviceerror.NewNotFound("workflow not found")).
+		Times(1)
+	for range 2 {
+		_, err := s.shardController.GetShardByNamespaceWorkflow(namespaceID, workflowIDs[1])
+		var solErr *serviceerrors.ShardOwnershipLost
+		s.ErrorAs(err, &solErr)
+		s.Equal("pool-host", solErr.OwnerHost)
+		s.Zero(solErr.ShardID)
+	}
+}
+
 func (s *controllerSuite) TestShardLingerTimeout() {
 	shardID := int32(1)
 	s.config.NumberOfShards = 1
@@ -0,0 +1,80 @@
+package shard
+
+import (
+	"slices"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	historyspb "go.temporal.io/server/api/history/v1"
+	historyi "go.temporal.io/server/service/history/interfaces"
+)
+
+type (
+	// shardLoadTracker tracks the load of the shards owned by the shard controller.
+	// Request counts are collected continuously and turned into a rate on every report.
+	shardLoadTracker struct {
+		requests sync.Map // shard ID -> *atomic.Int64
+
+		sync.Mutex
+		lastReportTime time.Time
+		lastReport     []*historyspb.ShardLoad
+	}
+)
+
+func newShardLoadTracker(now time.Time) *shardLoadTracker {
+	return &shardLoadTracker{
+		lastReportTime: now,
+	}
+}
+
+func (t *shardLoadTracker) recordRequest(shardID int32) {
+	counter, ok := t.requests.Load(shardID)
+	if !ok {
+		counter, _ = t.requests.LoadOrStore(shardID, &atomic.Int64{})
+	}
+	counter.(*atomic.Int64).Add(1)
+}
+
+// report computes the load of the given shards since the previous report. The result is sorted by
+// shard ID.
+func (t *shardLoadTracker) report(now time.Time, shards []historyi.ControllableContext) []*historyspb.ShardLoad {
+	t.Lock()
+	defer t.Unlock()
+
+	elapsed := now.Sub(t.lastReportTime).Seconds()
+	t.lastReportTime = now
+
+	requests := make(map[int32]int64)
+	t.requests.Range(func(key, value any) bool {
+		requests[key.(int32)] = value.(*atomic.Int64).Swap(0)
+		return true
+	})
+
+	loads := make([]*historyspb.ShardLoad, 0, len(shards))
+	for _, shard := range shards {
+		shardID := shard.GetShardID()
+		load := &historyspb.ShardLoad{
+			ShardId:         shardID,
+			QueueBacklog:    shard.GetQueuePendingTaskCount(),
+			CachedWorkflows: int64(shard.GetFinalizer().Count()),
+		}
+		if elapsed > 0 {
+			load.RequestsPerSecond = float64(requests[shardID]) / elapsed
+		}
+		loads = append(loads, load)
+	}
+	slices.SortFunc(loads, func(a, b *historyspb.ShardLoad) int {
+		return int(a.ShardId - b.ShardId)
+	})
+
+	t.lastReport = loads
+	return loads
+}
+
+// lastLoads returns the result of the latest report.
+func (t *shardLoadTracker) lastLoads() []*historyspb.ShardLoad {
+	t.Lock()
+	defer t.Unlock()
+	return t.lastReport
+}
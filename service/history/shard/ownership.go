@@ -2,9 +2,9 @@ package shard
 
 import (
 	"context"
+	"sync"
 	"time"
 
-	"go.temporal.io/server/common/convert"
 	"go.temporal.io/server/common/goro"
 	"go.temporal.io/server/common/log"
 	"go.temporal.io/server/common/log/tag"
@@ -25,6 +25,9 @@ type (
 	// The controller calls its verifyOwnership method when asked to
 	// acquire a shard, to check that membership believes this host should
 	// own the shard.
+	// Unless the shard placement mode is "ring", hosts can take over shards
+	// from their hash ring owner by publishing a claim in their membership
+	// labels, see placement.go.
 	ownership struct {
 		acquireCh              chan struct{}
 		config                 *configs.Config
@@ -32,8 +35,17 @@ type (
 		historyServiceResolver membership.ServiceResolver
 		hostInfoProvider       membership.HostInfoProvider
 		logger                 log.Logger
+		membershipMonitor      membership.Monitor
 		membershipUpdateCh     chan *membership.ChangedEvent
 		metricsHandler         metrics.Handler
+
+		claimsLock   sync.RWMutex
+		ownClaims    map[int32]int64      // claims of this host, by shard ID
+		latestClaims map[int32]shardClaim // latest claim of every claimed shard, by shard ID
+
+		// only accessed by the load report loop
+		lastPublishedLoad *hostLoad
+		lastRebalance     time.Time
 	}
 )
 
@@ -41,6 +53,7 @@ func newOwnership(
 	config *configs.Config,
 	historyServiceResolver membership.ServiceResolver,
 	hostInfoProvider membership.HostInfoProvider,
+	membershipMonitor membership.Monitor,
 	logger log.Logger,
 	metricsHandler metrics.Handler,
 ) *ownership {
@@ -52,8 +65,11 @@ func newOwnership(
 		historyServiceResolver: historyServiceResolver,
 		hostInfoProvider:       hostInfoProvider,
 		logger:                 logger,
+		membershipMonitor:      membershipMonitor,
 		membershipUpdateCh:     make(chan *membership.ChangedEvent, 1),
 		metricsHandler:         metricsHandler,
+		ownClaims:              make(map[int32]int64),
+		latestClaims:           make(map[int32]shardClaim),
 	}
 }
 
@@ -68,6 +84,11 @@ func (o *ownership) start(controller *ControllerImpl) {
 		return nil
 	})
 
+	o.goros.Go(func(ctx context.Context) error {
+		o.loadReportLoop(ctx, controller)
+		return nil
+	})
+
 	if err := o.historyServiceResolver.AddListener(
 		shardControllerMembershipUpdateListenerName,
 		o.membershipUpdateCh,
@@ -85,6 +106,7 @@ func (o *ownership) eventLoop(ctx context.Context) {
 		case <-ctx.Done():
 			return
 		case <-acquireTicker.C:
+			o.refreshPlacement()
 			o.scheduleAcquire()
 		case changedEvent := <-o.membershipUpdateCh:
 			metrics.MembershipChangedCounter.With(o.metricsHandler).Record(1)
@@ -95,6 +117,7 @@ func (o *ownership) eventLoop(ctx context.Context) {
 				tag.NumberChanged(len(changedEvent.HostsChanged)),
 			)
 
+			o.refreshPlacement()
 			o.scheduleAcquire()
 		}
 	}
@@ -118,6 +141,21 @@ func (o *ownership) acquireLoop(ctx context.Context, controller *ControllerImpl)
 	}
 }
 
+func (o *ownership) loadReportLoop(ctx context.Context, controller *ControllerImpl) {
+	timer := time.NewTimer(o.config.ShardLoadReportInterval())
+	defer timer.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-timer.C:
+			o.reportLoad(controller.reportShardLoads())
+			timer.Reset(o.config.ShardLoadReportInterval())
+		}
+	}
+}
+
 func (o *ownership) stop() {
 	if err := o.historyServiceResolver.RemoveListener(
 		shardControllerMembershipUpdateListenerName,
@@ -133,7 +171,7 @@ func (o *ownership) stop() {
 // controller. If membership lists another host as the owner, it returns a
 // ShardOwnershipLost error with the correct owner.
 func (o *ownership) verifyOwnership(shardID int32) error {
-	ownerInfo, err := o.historyServiceResolver.Lookup(convert.Int32ToString(shardID))
+	ownerInfo, err := o.lookupOwner(shardID)
 	if err != nil {
 		return err
 	}
@@ -1,12 +1,15 @@
 package shard
 
 import (
+	"maps"
 	"testing"
 	"time"
 
 	"github.com/stretchr/testify/require"
 	"github.com/stretchr/testify/suite"
+	"go.temporal.io/api/serviceerror"
 	"go.temporal.io/server/common/convert"
+	"go.temporal.io/server/common/dynamicconfig"
 	"go.temporal.io/server/common/membership"
 	"go.temporal.io/server/common/primitives"
 	"go.temporal.io/server/common/resourcetest"
@@ -51,9 +54,11 @@ func (s *ownershipSuite) newController(contextFactory ContextFactory) *Controlle
 		s.config,
 		s.resource.GetLogger(),
 		s.resource.GetHistoryServiceResolver(),
+		s.resource.GetMembershipMonitor(),
 		s.resource.GetMetricsHandler(),
 		s.resource.GetHostInfoProvider(),
 		contextFactory,
+		s.resource.GetExecutionManager(),
 	)
 }
 
@@ -201,3 +206,105 @@ func (s *ownershipSuite) TestAttemptAcquireUnowned() {
 
 	shardController.Stop()
 }
+
+func (s *ownershipSuite) TestClaimShard_RingPlacement() {
+	shardController := s.newController(NewMockContextFactory(s.controller))
+
+	err := shardController.ClaimShard(1)
+	s.ErrorAs(err, new(*serviceerror.FailedPrecondition))
+}
+
+func (s *ownershipSuite) TestClaimShard() {
+	s.config.NumberOfShards = 2
+	s.config.ShardPlacementMode = dynamicconfig.GetStringPropertyFn(ShardPlacementModeManual)
+	self := s.resource.GetHostInfo()
+	otherHost := &labeledHost{address: "otherHost", labels: map[string]string{shardClaimsLabel: "2:100"}}
+
+	s.resource.HistoryServiceResolver.EXPECT().Members().Return([]membership.HostInfo{self, otherHost}).AnyTimes()
+	s.resource.HistoryServiceResolver.EXPECT().
+		Lookup(gomock.Any()).
+		Return(self, nil).AnyTimes()
+
+	shardController := s.newController(NewMockContextFactory(s.controller))
+	ownership := shardController.ownership
+
+	// the other host claimed shard 2 from this host
+	ownership.refreshPlacement()
+	s.NoError(ownership.verifyOwnership(1))
+	var solErr *serviceerrors.ShardOwnershipLost
+	s.ErrorAs(ownership.verifyOwnership(2), &solErr)
+	s.Equal(otherHost.address, solErr.OwnerHost)
+
+	// claiming it back takes precedence over the older claim
+	s.resource.MembershipMonitor.EXPECT().SetLabel(shardClaimsLabel, gomock.Any()).Return(nil).Times(1)
+	s.NoError(shardController.ClaimShard(2))
+	ownership.refreshPlacement()
+	s.NoError(ownership.verifyOwnership(2))
+
+	// a newer claim of the other host wins, and this host drops its claim
+	otherHost.labels[shardClaimsLabel] = encodeShardClaims(map[int32]int64{2: time.Now().Add(time.Hour).UnixNano()})
+	s.resource.MembershipMonitor.EXPECT().SetLabel(shardClaimsLabel, "").Return(nil).Times(1)
+	ownership.refreshPlacement()
+	s.ErrorAs(ownership.verifyOwnership(2), &solErr)
+	s.Equal(otherHost.address, solErr.OwnerHost)
+	s.Empty(ownership.ownClaims)
+}
+
+func (s *ownershipSuite) TestClaimShard_ReleasedWhenNotNeeded() {
+	s.config.NumberOfShards = 1
+	s.config.ShardPlacementMode = dynamicconfig.GetStringPropertyFn(ShardPlacementModeManual)
+	self := s.resource.GetHostInfo()
+
+	s.resource.HistoryServiceResolver.EXPECT().Members().Return([]membership.HostInfo{self}).AnyTimes()
+	s.resource.HistoryServiceResolver.EXPECT().
+		Lookup(convert.Int32ToString(1)).
+		Return(self, nil).AnyTimes()
+
+	shardController := s.newController(NewMockContextFactory(s.controller))
+	ownership := shardController.ownership
+
+	s.resource.MembershipMonitor.EXPECT().SetLabel(shardClaimsLabel, gomock.Any()).Return(nil).Times(1)
+	s.NoError(shardController.ClaimShard(1))
+	s.Len(ownership.ownClaims, 1)
+
+	// no other host claims the shard and this host is its hash ring owner
+	s.resource.MembershipMonitor.EXPECT().SetLabel(shardClaimsLabel, "").Return(nil).Times(1)
+	ownership.refreshPlacement()
+	s.Empty(ownership.ownClaims)
+	s.NoError(ownership.verifyOwnership(1))
+}
+
+func (s *ownershipSuite) TestClaimShard_LabelSizeExceeded() {
+	s.config.NumberOfShards = 16384
+	s.config.ShardPlacementMode = dynamicconfig.GetStringPropertyFn(ShardPlacementModeManual)
+
+	shardController := s.newController(NewMockContextFactory(s.controller))
+	ownership := shardController.ownership
+	now := time.Now().UnixNano()
+	for shardID := int32(1); len(encodeShardClaims(ownership.ownClaims)) < membership.MaxLabelValueSize-10; shardID++ {
+		ownership.ownClaims[shardID] = now
+	}
+	claims := maps.Clone(ownership.ownClaims)
+
+	var failedPrecondition *serviceerror.FailedPrecondition
+	s.ErrorAs(shardController.ClaimShard(16384), &failedPrecondition)
+	s.Equal(claims, ownership.ownClaims)
+}
+
+type labeledHost struct {
+	address string
+	labels  map[string]string
+}
+
+func (h *labeledHost) Identity() string {
+	return h.address
+}
+
+func (h *labeledHost) GetAddress() string {
+	return h.address
+}
+
+func (h *labeledHost) Label(key string) (string, bool) {
+	value, ok := h.labels[key]
+	return value, ok
+}
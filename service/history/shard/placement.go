@@ -0,0 +1,393 @@
+package shard
+
+import (
+	"cmp"
+	"fmt"
+	"math"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+
+	"go.temporal.io/api/serviceerror"
+	historyspb "go.temporal.io/server/api/history/v1"
+	"go.temporal.io/server/common/convert"
+	"go.temporal.io/server/common/log/tag"
+	"go.temporal.io/server/common/membership"
+	"go.temporal.io/server/common/metrics"
+)
+
+const (
+	// ShardPlacementModeRing places every shard on its membership hash ring owner.
+	ShardPlacementModeRing = "ring"
+	// ShardPlacementModeManual additionally allows operators to move shards to other hosts.
+	ShardPlacementModeManual = "manual"
+	// ShardPlacementModeLoadAware additionally moves hot shards from over-loaded hosts to
+	// under-loaded hosts.
+	ShardPlacementModeLoadAware = "loadAware"
+
+	// shardClaimsLabel is the membership label in which a history host publishes the shards it
+	// took over from their hash ring owner, as a list of "<shard ID>:<base 36 claim version>".
+	// Each claim takes about 20 bytes, so a host can hold about 200 claims within
+	// membership.MaxLabelValueSize.
+	shardClaimsLabel = "historyShardClaims"
+	// shardLoadLabel is the membership label in which a history host publishes its load in
+	// "loadAware" placement mode, as "<requests per second>;<shard ID>:<requests per second>,..."
+	// listing its hottest shards.
+	shardLoadLabel = "historyShardLoad"
+
+	// hotShardsInLoadLabel is the number of hottest shards a host publishes with its load.
+	hotShardsInLoadLabel = 5
+	// loadLabelChangeThreshold is the relative change of a host's load which causes the load
+	// label to be republished. It avoids gossiping minor load changes across the cluster.
+	loadLabelChangeThreshold = 0.1
+	// rebalanceCooldownIntervals is the number of load report intervals a host waits after taking
+	// over a shard, so the load published by all hosts reflects the move before the next one.
+	rebalanceCooldownIntervals = 3
+)
+
+type (
+	// shardClaim records that a host took over a shard. Claims are versioned by the time
+	// they were made, the latest claim of a shard determines its owner.
+	shardClaim struct {
+		host    membership.HostInfo
+		version int64
+	}
+
+	// hostLoad is the load a history host publishes in its membership labels.
+	hostLoad struct {
+		host              membership.HostInfo
+		requestsPerSecond float64
+		hotShards         []*historyspb.ShardLoad
+	}
+)
+
+func (c shardClaim) newerThan(other shardClaim) bool {
+	if c.version != other.version {
+		return c.version > other.version
+	}
+	return c.host.Identity() > other.host.Identity()
+}
+
+func encodeShardClaims(claims map[int32]int64) string {
+	shardIDs := make([]int32, 0, len(claims))
+	for shardID := range claims {
+		shardIDs = append(shardIDs, shardID)
+	}
+	slices.Sort(shardIDs)
+
+	parts := make([]string, 0, len(shardIDs))
+	for _, shardID := range shardIDs {
+		parts = append(parts, fmt.Sprintf("%d:%s", shardID, strconv.FormatInt(claims[shardID], 36)))
+	}
+	return strings.Join(parts, ",")
+}
+
+func decodeShardClaims(value string) (map[int32]int64, error) {
+	claims := make(map[int32]int64)
+	if value == "" {
+		return claims, nil
+	}
+	for _, part := range strings.Split(value, ",") {
+		shardIDStr, versionStr, ok := strings.Cut(part, ":")
+		if !ok {
+			return nil, fmt.Errorf("malformed shard claim %q", part)
+		}
+		shardID, err := strconv.ParseInt(shardIDStr, 10, 32)
+		if err != nil {
+			return nil, fmt.Errorf("malformed shard claim %q: %w", part, err)
+		}
+		version, err := strconv.ParseInt(versionStr, 36, 64)
+		if err != nil {
+			return nil, fmt.Errorf("malformed shard claim %q: %w", part, err)
+		}
+		claims[int32(shardID)] = version
+	}
+	return claims, nil
+}
+
+func encodeHostLoad(load hostLoad) string {
+	parts := make([]string, 0, len(load.hotShards))
+	for _, shard := range load.hotShards {
+		parts = append(parts, fmt.Sprintf("%d:%s", shard.ShardId, formatRate(shard.RequestsPerSecond)))
+	}
+	return formatRate(load.requestsPerSecond) + ";" + strings.Join(parts, ",")
+}
+
+func decodeHostLoad(host membership.HostInfo, value string) (hostLoad, error) {
+	totalStr, hotShardsStr, ok := strings.Cut(value, ";")
+	if !ok {
+		return hostLoad{}, fmt.Errorf("malformed host load %q", value)
+	}
+	total, err := strconv.ParseFloat(totalStr, 64)
+	if err != nil {
+		return hostLoad{}, fmt.Errorf("malformed host load %q: %w", value, err)
+	}
+	load := hostLoad{host: host, requestsPerSecond: total}
+	if hotShardsStr == "" {
+		return load, nil
+	}
+	for _, part := range strings.Split(hotShardsStr, ",") {
+		shardIDStr, rpsStr, ok := strings.Cut(part, ":")
+		if !ok {
+			return hostLoad{}, fmt.Errorf("malformed host load %q", value)
+		}
+		shardID, err := strconv.ParseInt(shardIDStr, 10, 32)
+		if err != nil {
+			return hostLoad{}, fmt.Errorf("malformed host load %q: %w", value, err)
+		}
+		rps, err := strconv.ParseFloat(rpsStr, 64)
+		if err != nil {
+			return hostLoad{}, fmt.Errorf("malformed host load %q: %w", value, err)
+		}
+		load.hotShards = append(load.hotShards, &historyspb.ShardLoad{ShardId: int32(shardID), RequestsPerSecond: rps})
+	}
+	return load, nil
+}
+
+func formatRate(rps float64) string {
+	return strconv.FormatFloat(rps, 'f', 2, 64)
+}
+
+func newHostLoad(host membership.HostInfo, loads []*historyspb.ShardLoad) hostLoad {
+	load := hostLoad{host: host}
+	for _, shard := range loads {
+		load.requestsPerSecond += shard.RequestsPerSecond
+	}
+	hotShards := slices.Clone(loads)
+	slices.SortFunc(hotShards, func(a, b *historyspb.ShardLoad) int {
+		return cmp.Compare(b.RequestsPerSecond, a.RequestsPerSecond)
+	})
+	load.hotShards = hotShards[:min(len(hotShards), hotShardsInLoadLabel)]
+	return load
+}
+
+func (o *ownership) placementMode() string {
+	switch mode := o.config.ShardPlacementMode(); mode {
+	case ShardPlacementModeManual, ShardPlacementModeLoadAware:
+		return mode
+	default:
+		return ShardPlacementModeRing
+	}
+}
+
+// lookupOwner returns the host which should own the shard: the host with the latest claim
+// on the shard if shards can be moved, otherwise the shard's hash ring owner.
+func (o *ownership) lookupOwner(shardID int32) (membership.HostInfo, error) {
+	if o.placementMode() != ShardPlacementModeRing {
+		o.claimsLock.RLock()
+		claim, ok := o.latestClaims[shardID]
+		o.claimsLock.RUnlock()
+		if ok {
+			return claim.host, nil
+		}
+	}
+	return o.historyServiceResolver.Lookup(convert.Int32ToString(shardID))
+}
+
+// claimShard makes this host the owner of the shard. The claim is published through
+// membership, the current owner of the shard closes it once it observes the claim.
+func (o *ownership) claimShard(shardID int32) error {
+	if o.placementMode() == ShardPlacementModeRing {
+		return serviceerror.NewFailedPreconditionf("moving shards requires shard placement mode %q or %q",
+			ShardPlacementModeManual, ShardPlacementModeLoadAware)
+	}
+
+	self := o.hostInfoProvider.HostInfo()
+	o.claimsLock.Lock()
+	claim := shardClaim{host: self, version: time.Now().UnixNano()}
+	if current, ok := o.latestClaims[shardID]; ok && current.version >= claim.version {
+		// make sure the claim wins even if clocks are skewed between hosts
+		claim.version = current.version + 1
+	}
+	prevVersion, hadClaim := o.ownClaims[shardID]
+	o.ownClaims[shardID] = claim.version
+	if err := o.publishClaimsLocked(); err != nil {
+		if hadClaim {
+			o.ownClaims[shardID] = prevVersion
+		} else {
+			delete(o.ownClaims, shardID)
+		}
+		o.claimsLock.Unlock()
+		return err
+	}
+	o.latestClaims[shardID] = claim
+	o.claimsLock.Unlock()
+
+	metrics.ShardClaimedCounter.With(o.metricsHandler).Record(1)
+	o.logger.Info("Claimed shard", tag.ShardID(shardID))
+	o.scheduleAcquire()
+	return nil
+}
+
+// refreshPlacement rebuilds the view of shard claims from the membership labels of all
+// history hosts, and drops the claims of this host which are no longer needed.
+func (o *ownership) refreshPlacement() {
+	if o.placementMode() == ShardPlacementModeRing {
+		o.claimsLock.Lock()
+		defer o.claimsLock.Unlock()
+		if len(o.ownClaims) > 0 {
+			o.ownClaims = make(map[int32]int64)
+			o.logPublishClaimsErrorLocked()
+		}
+		o.latestClaims = make(map[int32]shardClaim)
+		return
+	}
+
+	self := o.hostInfoProvider.HostInfo()
+	latestClaims := make(map[int32]shardClaim)
+	claimants := make(map[int32]int)
+	for _, host := range o.historyServiceResolver.Members() {
+		if host.Identity() == self.Identity() {
+			// own claims are tracked locally, the label might not be up to date yet
+			continue
+		}
+		value, ok := host.Label(shardClaimsLabel)
+		if !ok {
+			continue
+		}
+		claims, err := decodeShardClaims(value)
+		if err != nil {
+			o.logger.Warn("Ignoring shard claims of history host", tag.Address(host.GetAddress()), tag.Error(err))
+			continue
+		}
+		for shardID, version := range claims {
+			claimants[shardID]++
+			claim := shardClaim{host: host, version: version}
+			if current, ok := latestClaims[shardID]; !ok || claim.newerThan(current) {
+				latestClaims[shardID] = claim
+			}
+		}
+	}
+
+	o.claimsLock.Lock()
+	defer o.claimsLock.Unlock()
+
+	ownClaims := make(map[int32]int64, len(o.ownClaims))
+	for shardID, version := range o.ownClaims {
+		claim := shardClaim{host: self, version: version}
+		if current, ok := latestClaims[shardID]; ok && !claim.newerThan(current) {
+			// another host took over the shard after this host did
+			continue
+		}
+		if claimants[shardID] == 0 {
+			// the claim is not needed if no other host claims the shard and this host owns it anyway
+			if ringOwner, err := o.historyServiceResolver.Lookup(convert.Int32ToString(shardID)); err == nil &&
+				ringOwner.Identity() == self.Identity() {
+				continue
+			}
+		}
+		ownClaims[shardID] = version
+		latestClaims[shardID] = claim
+	}
+
+	if len(ownClaims) != len(o.ownClaims) {
+		o.ownClaims = ownClaims
+		o.logPublishClaimsErrorLocked()
+	}
+	o.latestClaims = latestClaims
+}
+
+func (o *ownership) publishClaimsLocked() error {
+	value := encodeShardClaims(o.ownClaims)
+	if len(value) > membership.MaxLabelValueSize {
+		return serviceerror.NewFailedPreconditionf("%d shard claims exceed the membership label size", len(o.ownClaims))
+	}
+	return o.membershipMonitor.SetLabel(shardClaimsLabel, value)
+}
+
+func (o *ownership) logPublishClaimsErrorLocked() {
+	if err := o.publishClaimsLocked(); err != nil {
+		o.logger.Warn("Unable to publish shard claims", tag.Error(err))
+	}
+}
+
+// reportLoad publishes the load of this host in "loadAware" placement mode and moves a hot
+// shard to this host if it's the least loaded one.
+func (o *ownership) reportLoad(loads []*historyspb.ShardLoad) {
+	if o.placementMode() != ShardPlacementModeLoadAware {
+		return
+	}
+
+	self := newHostLoad(o.hostInfoProvider.HostInfo(), loads)
+	o.publishLoad(self)
+
+	if time.Since(o.lastRebalance) < rebalanceCooldownIntervals*o.config.ShardLoadReportInterval() {
+		return
+	}
+	if shardID, ok := o.pickShardToTakeOver(self); ok {
+		o.lastRebalance = time.Now()
+		if err := o.claimShard(shardID); err != nil {
+			o.logger.Warn("Unable to take over hot shard", tag.ShardID(shardID), tag.Error(err))
+		}
+	}
+}
+
+func (o *ownership) publishLoad(load hostLoad) {
+	if prev := o.lastPublishedLoad; prev != nil && slices.EqualFunc(prev.hotShards, load.hotShards,
+		func(a, b *historyspb.ShardLoad) bool { return a.ShardId == b.ShardId }) {
+		change := math.Abs(load.requestsPerSecond-prev.requestsPerSecond) / max(prev.requestsPerSecond, 1)
+		if change < loadLabelChangeThreshold {
+			return
+		}
+	}
+	if err := o.membershipMonitor.SetLabel(shardLoadLabel, encodeHostLoad(load)); err != nil {
+		o.logger.Warn("Unable to publish shard load", tag.Error(err))
+		return
+	}
+	o.lastPublishedLoad = &load
+}
+
+// pickShardToTakeOver returns a hot shard of the most loaded history host which this host
+// should take over. Only the least loaded host takes over shards, so that hosts don't race
+// for the same shard, and only if the most loaded host is above the rebalance threshold.
+func (o *ownership) pickShardToTakeOver(self hostLoad) (int32, bool) {
+	hosts := []hostLoad{self}
+	for _, host := range o.historyServiceResolver.Members() {
+		if host.Identity() == self.host.Identity() {
+			continue
+		}
+		value, ok := host.Label(shardLoadLabel)
+		if !ok {
+			continue
+		}
+		load, err := decodeHostLoad(host, value)
+		if err != nil {
+			o.logger.Warn("Ignoring shard load of history host", tag.Address(host.GetAddress()), tag.Error(err))
+			continue
+		}
+		hosts = append(hosts, load)
+	}
+	if len(hosts) < 2 {
+		return 0, false
+	}
+
+	var total float64
+	for _, host := range hosts {
+		total += host.requestsPerSecond
+	}
+	limit := total / float64(len(hosts)) * o.config.ShardRebalanceLoadThreshold()
+
+	byLoad := func(a, b hostLoad) int {
+		if c := cmp.Compare(a.requestsPerSecond, b.requestsPerSecond); c != 0 {
+			return c
+		}
+		return cmp.Compare(a.host.Identity(), b.host.Identity())
+	}
+	if slices.MinFunc(hosts, byLoad).host.Identity() != self.host.Identity() {
+		return 0, false
+	}
+	busiest := slices.MaxFunc(hosts, byLoad)
+	if busiest.requestsPerSecond <= limit {
+		return 0, false
+	}
+
+	for _, shard := range busiest.hotShards {
+		// Moving the shard must not overload this host, nor turn it into the new busiest host.
+		if self.requestsPerSecond+shard.RequestsPerSecond <= limit &&
+			shard.RequestsPerSecond < busiest.requestsPerSecond-self.requestsPerSecond {
+			return shard.ShardId, true
+		}
+	}
+	return 0, false
+}
@@ -0,0 +1,149 @@
+package shard
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	historyspb "go.temporal.io/server/api/history/v1"
+	"go.temporal.io/server/common/dynamicconfig"
+	"go.temporal.io/server/common/finalizer"
+	"go.temporal.io/server/common/log"
+	"go.temporal.io/server/common/membership"
+	"go.temporal.io/server/common/metrics"
+	"go.temporal.io/server/common/testing/protorequire"
+	historyi "go.temporal.io/server/service/history/interfaces"
+	"go.temporal.io/server/service/history/tests"
+	"go.uber.org/mock/gomock"
+)
+
+func TestShardClaimsEncoding(t *testing.T) {
+	claims := map[int32]int64{7: 1700000000000000000, 3: 42}
+	value := encodeShardClaims(claims)
+	require.Equal(t, "3:16,7:cwyvpelgpse8", value)
+
+	decoded, err := decodeShardClaims(value)
+	require.NoError(t, err)
+	require.Equal(t, claims, decoded)
+
+	decoded, err = decodeShardClaims("")
+	require.NoError(t, err)
+	require.Empty(t, decoded)
+
+	_, err = decodeShardClaims("3")
+	require.Error(t, err)
+	_, err = decodeShardClaims("3:x!")
+	require.Error(t, err)
+}
+
+func TestShardClaimsEncoding_LabelSize(t *testing.T) {
+	// a host of a cluster with 16K shards holding 200 claims made over the last hour
+	claims := make(map[int32]int64)
+	now := time.Now()
+	for i := range 200 {
+		claims[int32(16384-i)] = now.Add(-time.Duration(i) * time.Minute / 4).UnixNano()
+	}
+	value := encodeShardClaims(claims)
+	require.LessOrEqual(t, len(value), membership.MaxLabelValueSize)
+
+	decoded, err := decodeShardClaims(value)
+	require.NoError(t, err)
+	require.Equal(t, claims, decoded)
+}
+
+func TestHostLoadEncoding(t *testing.T) {
+	host := membership.NewHostInfoFromAddress("host")
+	load := newHostLoad(host, []*historyspb.ShardLoad{
+		{ShardId: 1, RequestsPerSecond: 1},
+		{ShardId: 2, RequestsPerSecond: 30},
+		{ShardId: 3, RequestsPerSecond: 0.5},
+		{ShardId: 4, RequestsPerSecond: 10},
+		{ShardId: 5, RequestsPerSecond: 2},
+		{ShardId: 6, RequestsPerSecond: 4},
+	})
+	value := encodeHostLoad(load)
+	require.Equal(t, "47.50;2:30.00,4:10.00,6:4.00,5:2.00,1:1.00", value)
+
+	decoded, err := decodeHostLoad(host, value)
+	require.NoError(t, err)
+	require.Equal(t, load.requestsPerSecond, decoded.requestsPerSecond)
+	require.Len(t, decoded.hotShards, hotShardsInLoadLabel)
+	require.Equal(t, int32(2), decoded.hotShards[0].ShardId)
+
+	decoded, err = decodeHostLoad(host, "0.00;")
+	require.NoError(t, err)
+	require.Empty(t, decoded.hotShards)
+
+	_, err = decodeHostLoad(host, "12")
+	require.Error(t, err)
+}
+
+func TestPickShardToTakeOver(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	resolver := membership.NewMockServiceResolver(ctrl)
+	config := tests.NewDynamicConfig()
+	config.ShardRebalanceLoadThreshold = dynamicconfig.GetFloatPropertyFn(1.25)
+	o := &ownership{
+		config:                 config,
+		historyServiceResolver: resolver,
+		logger:                 log.NewNoopLogger(),
+	}
+
+	self := hostLoad{host: membership.NewHostInfoFromAddress("self"), requestsPerSecond: 10}
+	busy := &labeledHost{address: "busy", labels: map[string]string{shardLoadLabel: "100.00;5:60.00,6:30.00,7:10.00"}}
+	other := &labeledHost{address: "other", labels: map[string]string{shardLoadLabel: "40.00;8:40.00"}}
+	resolver.EXPECT().Members().Return([]membership.HostInfo{self.host, busy, other}).AnyTimes()
+
+	// average load is 50, so this host can take up to 62.5 requests per second
+	shardID, ok := o.pickShardToTakeOver(self)
+	require.True(t, ok)
+	require.Equal(t, int32(6), shardID)
+
+	// only the least loaded host takes over shards
+	self.requestsPerSecond = 45
+	_, ok = o.pickShardToTakeOver(self)
+	require.False(t, ok)
+
+	// no host is above the threshold
+	self.requestsPerSecond = 30
+	busy.labels[shardLoadLabel] = "40.00;5:30.00,6:10.00"
+	_, ok = o.pickShardToTakeOver(self)
+	require.False(t, ok)
+}
+
+func TestShardLoadTracker(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	now := time.Now()
+	tracker := newShardLoadTracker(now)
+
+	newShard := func(shardID int32, pendingTasks int64, cachedWorkflows int) historyi.ControllableContext {
+		f := finalizer.New(log.NewNoopLogger(), metrics.NoopMetricsHandler)
+		for i := range cachedWorkflows {
+			require.NoError(t, f.Register(string(rune('a'+i)), nil))
+		}
+		shard := historyi.NewMockControllableContext(ctrl)
+		shard.EXPECT().GetShardID().Return(shardID).AnyTimes()
+		shard.EXPECT().GetQueuePendingTaskCount().Return(pendingTasks).AnyTimes()
+		shard.EXPECT().GetFinalizer().Return(f).AnyTimes()
+		return shard
+	}
+	shards := []historyi.ControllableContext{newShard(2, 5, 1), newShard(1, 0, 3)}
+
+	for range 20 {
+		tracker.recordRequest(1)
+	}
+	tracker.recordRequest(2)
+	tracker.recordRequest(3)
+
+	loads := tracker.report(now.Add(10*time.Second), shards)
+	protorequire.ProtoSliceEqual(t, []*historyspb.ShardLoad{
+		{ShardId: 1, RequestsPerSecond: 2, QueueBacklog: 0, CachedWorkflows: 3},
+		{ShardId: 2, RequestsPerSecond: 0.1, QueueBacklog: 5, CachedWorkflows: 1},
+	}, loads)
+	protorequire.ProtoSliceEqual(t, loads, tracker.lastLoads())
+
+	// request counts are reset after every report
+	loads = tracker.report(now.Add(20*time.Second), shards)
+	require.Zero(t, loads[0].RequestsPerSecond)
+	require.Zero(t, loads[1].RequestsPerSecond)
+}
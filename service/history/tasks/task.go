@@ -51,3 +51,14 @@ type (
 func GetShardIDForTask(task Task, numShards int) int {
 	return int(common.WorkflowIDToHistoryShard(task.GetNamespaceID(), task.GetWorkflowID(), int32(numShards)))
 }
+
+// GetShardIDForTaskInPools is like GetShardIDForTask, but respects the dedicated shard pool of the task's namespace.
+func GetShardIDForTaskInPools(task Task, numShards int, pools map[string]common.NamespaceShardPool) int {
+	return int(common.WorkflowIDToHistoryShardInPools(task.GetNamespaceID(), task.GetWorkflowID(), int32(numShards), pools))
+}
+
+// GetAlternateShardIDForTaskInPools returns the other shard the task's workflow may be on while its namespace is moved
+// between shard pools, or 0 if there is none.
+func GetAlternateShardIDForTaskInPools(task Task, numShards int, pools map[string]common.NamespaceShardPool) int {
+	return int(common.WorkflowIDToAlternateHistoryShard(task.GetNamespaceID(), task.GetWorkflowID(), int32(numShards), pools))
+}
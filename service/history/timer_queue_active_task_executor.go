@@ -558,6 +558,7 @@ func (t *timerQueueActiveTaskExecutor) executeActivityRetryTimerTask(
 	directive := MakeDirectiveForActivityTask(mutableState, activityInfo)
 	useWfBuildId := activityInfo.GetUseWorkflowBuildIdInfo() != nil
 	priority := priorities.Merge(mutableState.GetExecutionInfo().Priority, activityInfo.Priority)
+	workerSelector := activityInfo.WorkerSelector
 
 	// NOTE: do not access anything related mutable state after this lock release
 	release(nil) // release earlier as we don't need the lock anymore
@@ -575,6 +576,7 @@ func (t *timerQueueActiveTaskExecutor) executeActivityRetryTimerTask(
 		VersionDirective:       directive,
 		Stamp:                  task.Stamp,
 		Priority:               priority,
+		WorkerSelector:         workerSelector,
 	})
 	if err != nil {
 		return err
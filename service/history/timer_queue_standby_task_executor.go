@@ -712,6 +712,7 @@ func (t *timerQueueStandbyTaskExecutor) pushActivity(
 		Clock:                  vclock.NewVectorClock(t.shardContext.GetClusterMetadata().GetClusterID(), t.shardContext.GetShardID(), activityTask.TaskID),
 		VersionDirective:       pushActivityInfo.versionDirective,
 		Stamp:                  activityTask.Stamp,
+		WorkerSelector:         pushActivityInfo.workerSelector,
 	})
 
 	if err != nil {
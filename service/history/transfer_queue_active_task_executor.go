@@ -253,7 +253,7 @@ func (t *transferQueueActiveTaskExecutor) processActivityTask(
 	// the rest of logic is making RPC call, which takes time.
 	release(nil)
 
-	return t.pushActivity(ctx, task, timeout, directive, priority, historyi.TransactionPolicyActive)
+	return t.pushActivity(ctx, task, timeout, directive, priority, ai.WorkerSelector, historyi.TransactionPolicyActive)
 }
 
 func (t *transferQueueActiveTaskExecutor) processWorkflowTask(
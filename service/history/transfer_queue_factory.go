@@ -154,6 +154,7 @@ func (f *transferQueueFactory) CreateQueue(
 		f.Config.TaskDLQUnexpectedErrorAttempts,
 		f.Config.TaskDLQInternalErrors,
 		f.Config.TaskDLQErrorPattern,
+		f.Config.NamespaceShardPools,
 	)
 	return queues.NewImmediateQueue(
 		shardContext,
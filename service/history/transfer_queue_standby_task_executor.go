@@ -565,6 +565,7 @@ func (t *transferQueueStandbyTaskExecutor) pushActivity(
 		pushActivityInfo.activityTaskScheduleToStartTimeout,
 		pushActivityInfo.versionDirective,
 		pushActivityInfo.priority,
+		pushActivityInfo.workerSelector,
 		historyi.TransactionPolicyPassive,
 	)
 }
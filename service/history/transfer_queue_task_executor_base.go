@@ -98,6 +98,7 @@ func (t *transferQueueTaskExecutorBase) pushActivity(
 	activityScheduleToStartTimeout time.Duration,
 	directive *taskqueuespb.TaskVersionDirective,
 	priority *commonpb.Priority,
+	workerSelector map[string]string,
 	transactionPolicy historyi.TransactionPolicy,
 ) error {
 	resp, err := t.matchingRawClient.AddActivityTask(ctx, &matchingservice.AddActivityTaskRequest{
@@ -116,6 +117,7 @@ func (t *transferQueueTaskExecutorBase) pushActivity(
 		VersionDirective:       directive,
 		Stamp:                  task.Stamp,
 		Priority:               priority,
+		WorkerSelector:         workerSelector,
 	})
 	if _, isNotFound := err.(*serviceerror.NotFound); isNotFound {
 		// NotFound error is not expected for AddTasks calls
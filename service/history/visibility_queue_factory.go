@@ -122,6 +122,7 @@ func (f *visibilityQueueFactory) CreateQueue(
 		f.Config.TaskDLQUnexpectedErrorAttempts,
 		f.Config.TaskDLQInternalErrors,
 		f.Config.TaskDLQErrorPattern,
+		f.Config.NamespaceShardPools,
 	)
 	return queues.NewImmediateQueue(
 		shard,
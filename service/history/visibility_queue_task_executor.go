@@ -2,7 +2,9 @@ package history
 
 import (
 	"context"
+	"strings"
 	"time"
+	"unicode/utf8"
 
 	commonpb "go.temporal.io/api/common/v1"
 	enumspb "go.temporal.io/api/enums/v1"
@@ -12,9 +14,11 @@ import (
 	"go.temporal.io/server/common/definition"
 	"go.temporal.io/server/common/dynamicconfig"
 	"go.temporal.io/server/common/log"
+	"go.temporal.io/server/common/log/tag"
 	"go.temporal.io/server/common/metrics"
 	"go.temporal.io/server/common/namespace"
 	"go.temporal.io/server/common/payload"
+	"go.temporal.io/server/common/payloads"
 	"go.temporal.io/server/common/persistence/visibility/manager"
 	"go.temporal.io/server/common/primitives/timestamp"
 	"go.temporal.io/server/common/searchattribute"
@@ -39,6 +43,8 @@ type (
 	}
 )
 
+const defaultVisibilityTextIndexingMaxLength = 4096
+
 var errUnknownVisibilityTask = serviceerror.NewInternal("unknown visibility task")
 
 func newVisibilityQueueTaskExecutor(
@@ -163,6 +169,9 @@ func (t *visibilityQueueTaskExecutor) processStartExecution(
 		mutableState.GetExecutionInfo().Memo,
 		mutableState.GetExecutionInfo().SearchAttributes,
 	)
+	if err := t.addIndexedText(ctx, namespaceEntry, mutableState, requestBase); err != nil {
+		return err
+	}
 
 	// NOTE: do not access anything related mutable state after this lock release
 	// release the context lock since we no longer need mutable state and
@@ -211,6 +220,9 @@ func (t *visibilityQueueTaskExecutor) processUpsertExecution(
 		mutableState.GetExecutionInfo().Memo,
 		mutableState.GetExecutionInfo().SearchAttributes,
 	)
+	if err := t.addIndexedText(ctx, namespaceEntry, mutableState, requestBase); err != nil {
+		return err
+	}
 
 	// NOTE: do not access anything related mutable state after this lock release
 	// release the context lock since we no longer need mutable state and
@@ -268,6 +280,9 @@ func (t *visibilityQueueTaskExecutor) processCloseExecution(
 		mutableState.GetExecutionInfo().Memo,
 		mutableState.GetExecutionInfo().SearchAttributes,
 	)
+	if err := t.addIndexedText(ctx, namespaceEntry, mutableState, requestBase); err != nil {
+		return err
+	}
 	closedRequest, err := t.getClosedVisibilityRequest(ctx, requestBase, mutableState)
 	if err != nil {
 		return err
@@ -481,6 +496,73 @@ func (t *visibilityQueueTaskExecutor) getVisibilityRequestBase(
 	}
 }
 
+// addIndexedText adds the values extracted from the workflow input and memo, as configured by the
+// visibility text indexing of the namespace, to the Text search attribute of the request. Values upserted
+// by the workflow itself take precedence.
+func (t *visibilityQueueTaskExecutor) addIndexedText(
+	ctx context.Context,
+	namespaceEntry *namespace.Namespace,
+	mutableState historyi.MutableState,
+	base *manager.VisibilityRequestBase,
+) error {
+	nsName := namespaceEntry.Name().String()
+	indexing := t.shardContext.GetConfig().VisibilityTextIndexing(nsName)
+	if indexing.SearchAttribute == "" || (len(indexing.InputPaths) == 0 && len(indexing.MemoPaths) == 0) {
+		return nil
+	}
+
+	saName := indexing.SearchAttribute
+	mapper, err := t.shardContext.GetSearchAttributesMapperProvider().GetMapper(namespaceEntry.Name())
+	if err != nil {
+		return err
+	}
+	if mapper != nil {
+		fieldName, err := mapper.GetFieldName(saName, nsName)
+		if err != nil {
+			// Misconfigured indexing must not block the visibility queue.
+			t.logger.Warn("Unable to map visibility text indexing search attribute", tag.WorkflowNamespace(nsName), tag.Value(saName), tag.Error(err))
+			return nil
+		}
+		saName = fieldName
+	}
+	typeMap, err := t.shardContext.GetSearchAttributesProvider().GetSearchAttributes(t.visibilityMgr.GetIndexName(), false)
+	if err != nil {
+		return err
+	}
+	if saType, err := typeMap.GetType(saName); err != nil || saType != enumspb.INDEXED_VALUE_TYPE_TEXT {
+		t.logger.Warn("Visibility text indexing search attribute is not a Text search attribute", tag.WorkflowNamespace(nsName), tag.Value(indexing.SearchAttribute))
+		return nil
+	}
+	if _, ok := base.SearchAttributes.GetIndexedFields()[saName]; ok {
+		return nil
+	}
+
+	var input *commonpb.Payloads
+	if len(indexing.InputPaths) > 0 {
+		startEvent, err := mutableState.GetStartEvent(ctx)
+		if err != nil {
+			return err
+		}
+		input = startEvent.GetWorkflowExecutionStartedEventAttributes().GetInput()
+	}
+	text := getIndexedText(indexing, input, base.Memo.GetFields())
+	if text == "" {
+		return nil
+	}
+	textPayload, err := searchattribute.EncodeValue(text, enumspb.INDEXED_VALUE_TYPE_TEXT)
+	if err != nil {
+		return err
+	}
+	if base.SearchAttributes == nil {
+		base.SearchAttributes = &commonpb.SearchAttributes{}
+	}
+	if base.SearchAttributes.IndexedFields == nil {
+		base.SearchAttributes.IndexedFields = make(map[string]*commonpb.Payload, 1)
+	}
+	base.SearchAttributes.IndexedFields[saName] = textPayload
+	return nil
+}
+
 func (t *visibilityQueueTaskExecutor) getClosedVisibilityRequest(
 	ctx context.Context,
 	base *manager.VisibilityRequestBase,
@@ -589,6 +671,37 @@ func getSearchAttributes(
 	return &commonpb.SearchAttributes{IndexedFields: indexedFields}
 }
 
+// getIndexedText joins the values at the configured paths of the workflow input and memo, truncated to
+// the configured max length.
+func getIndexedText(
+	indexing dynamicconfig.VisibilityTextIndexingSettings,
+	input *commonpb.Payloads,
+	memoFields map[string]*commonpb.Payload,
+) string {
+	var values []string
+	for _, path := range indexing.InputPaths {
+		values = append(values, payloads.ExtractPath(input, path)...)
+	}
+	for _, path := range indexing.MemoPaths {
+		values = append(values, payloads.ExtractMapPath(memoFields, path)...)
+	}
+	text := strings.Join(values, " ")
+
+	maxLength := indexing.MaxLength
+	if maxLength <= 0 {
+		maxLength = defaultVisibilityTextIndexingMaxLength
+	}
+	if len(text) <= maxLength {
+		return text
+	}
+	text = text[:maxLength]
+	// Don't cut a multi-byte character in half.
+	for len(text) > 0 && !utf8.ValidString(text) {
+		text = text[:len(text)-1]
+	}
+	return text
+}
+
 func copyMapPayload(input map[string]*commonpb.Payload) map[string]*commonpb.Payload {
 	if input == nil {
 		return nil
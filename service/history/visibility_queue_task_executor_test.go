@@ -10,6 +10,7 @@ import (
 	"github.com/stretchr/testify/suite"
 	commonpb "go.temporal.io/api/common/v1"
 	enumspb "go.temporal.io/api/enums/v1"
+	historypb "go.temporal.io/api/history/v1"
 	taskqueuepb "go.temporal.io/api/taskqueue/v1"
 	"go.temporal.io/api/workflowservice/v1"
 	enumsspb "go.temporal.io/server/api/enums/v1"
@@ -26,6 +27,7 @@ import (
 	"go.temporal.io/server/common/metrics"
 	"go.temporal.io/server/common/namespace"
 	"go.temporal.io/server/common/payload"
+	"go.temporal.io/server/common/payloads"
 	"go.temporal.io/server/common/persistence"
 	"go.temporal.io/server/common/persistence/versionhistory"
 	"go.temporal.io/server/common/persistence/visibility/manager"
@@ -399,6 +401,89 @@ func (s *visibilityQueueTaskExecutorSuite) TestProcessRecordWorkflowStartedTask(
 	s.Nil(resp.ExecutionErr)
 }
 
+func (s *visibilityQueueTaskExecutorSuite) TestProcessRecordWorkflowStartedTask_TextIndexing() {
+	execution := &commonpb.WorkflowExecution{
+		WorkflowId: "some random workflow ID",
+		RunId:      uuid.New(),
+	}
+	s.mockShard.GetConfig().VisibilityTextIndexing = dynamicconfig.GetTypedPropertyFnFilteredByNamespace(
+		dynamicconfig.VisibilityTextIndexingSettings{
+			SearchAttribute: "CustomTextField",
+			InputPaths:      []string{"0.order.id"},
+			MemoPaths:       []string{"customer.name"},
+		},
+	)
+
+	input, err := payloads.Encode(map[string]any{"order": map[string]any{"id": 12345}})
+	s.NoError(err)
+	memo, err := payload.Encode(map[string]any{"name": "Alice"})
+	s.NoError(err)
+
+	mutableState := workflow.TestGlobalMutableState(s.mockShard, s.mockShard.GetEventsCache(), s.logger, s.version, execution.GetWorkflowId(), execution.GetRunId())
+	startEvent, err := mutableState.AddWorkflowExecutionStartedEvent(
+		execution,
+		&historyservice.StartWorkflowExecutionRequest{
+			Attempt:     1,
+			NamespaceId: s.namespaceID.String(),
+			StartRequest: &workflowservice.StartWorkflowExecutionRequest{
+				WorkflowType:             &commonpb.WorkflowType{Name: "some random workflow type"},
+				TaskQueue:                &taskqueuepb.TaskQueue{Name: "some random task queue"},
+				Input:                    input,
+				Memo:                     &commonpb.Memo{Fields: map[string]*commonpb.Payload{"customer": memo}},
+				WorkflowExecutionTimeout: durationpb.New(2 * time.Second),
+				WorkflowTaskTimeout:      durationpb.New(1 * time.Second),
+			},
+		},
+	)
+	s.NoError(err)
+	wt := addWorkflowTaskScheduledEvent(mutableState)
+
+	visibilityTask := &tasks.StartExecutionVisibilityTask{
+		WorkflowKey: definition.NewWorkflowKey(
+			s.namespaceID.String(),
+			execution.GetWorkflowId(),
+			execution.GetRunId(),
+		),
+		VisibilityTimestamp: time.Now().UTC(),
+		Version:             s.version,
+		TaskID:              int64(59),
+	}
+
+	persistenceMutableState := s.createPersistenceMutableState(mutableState, wt.ScheduledEventID, wt.Version)
+	s.mockExecutionMgr.EXPECT().GetWorkflowExecution(gomock.Any(), gomock.Any()).Return(&persistence.GetWorkflowExecutionResponse{State: persistenceMutableState}, nil)
+	s.mockExecutionMgr.EXPECT().ReadHistoryBranch(gomock.Any(), gomock.Any()).Return(&persistence.ReadHistoryBranchResponse{
+		HistoryEvents: []*historypb.HistoryEvent{startEvent},
+	}, nil).AnyTimes()
+	s.mockShard.Resource.SearchAttributesMapperProvider.EXPECT().GetMapper(s.namespace).Return(nil, nil)
+	s.mockShard.Resource.SearchAttributesProvider.EXPECT().GetSearchAttributes(gomock.Any(), false).Return(searchattribute.TestNameTypeMap, nil)
+	s.mockVisibilityMgr.EXPECT().GetIndexName().Return("")
+	s.mockVisibilityMgr.EXPECT().RecordWorkflowExecutionStarted(gomock.Any(), gomock.Any()).DoAndReturn(
+		func(_ context.Context, request *manager.RecordWorkflowExecutionStartedRequest) error {
+			var text string
+			s.NoError(payload.Decode(request.SearchAttributes.GetIndexedFields()["CustomTextField"], &text))
+			s.Equal("12345 Alice", text)
+			return nil
+		},
+	)
+
+	resp := s.visibilityQueueTaskExecutor.Execute(context.Background(), s.newTaskExecutable(visibilityTask))
+	s.Nil(resp.ExecutionErr)
+}
+
+func (s *visibilityQueueTaskExecutorSuite) TestGetIndexedText() {
+	input, err := payloads.Encode("héllo wörld")
+	s.NoError(err)
+	indexing := dynamicconfig.VisibilityTextIndexingSettings{InputPaths: []string{"0"}}
+	s.Equal("héllo wörld", getIndexedText(indexing, input, nil))
+
+	// Truncation doesn't cut multi-byte characters in half.
+	indexing.MaxLength = 2
+	s.Equal("h", getIndexedText(indexing, input, nil))
+
+	indexing.InputPaths = []string{"1"}
+	s.Empty(getIndexedText(indexing, input, nil))
+}
+
 func (s *visibilityQueueTaskExecutorSuite) TestProcessUpsertWorkflowSearchAttributes() {
 	execution := &commonpb.WorkflowExecution{
 		WorkflowId: "some random workflow ID",
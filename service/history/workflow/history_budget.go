@@ -0,0 +1,96 @@
+package workflow
+
+import (
+	"cmp"
+	"slices"
+
+	enumsspb "go.temporal.io/server/api/enums/v1"
+	"go.temporal.io/server/common/dynamicconfig"
+	"go.temporal.io/server/service/history/configs"
+	historyi "go.temporal.io/server/service/history/interfaces"
+)
+
+// HistoryBudgetUsage is the consumption of a workflow execution against the history budget of its
+// namespace and workflow type.
+type HistoryBudgetUsage struct {
+	Budget       dynamicconfig.HistoryBudget
+	HasBudget    bool
+	HistorySize  int64
+	HistoryCount int64
+	// ConsumedRatio is the larger of the consumed fractions of the size and count budgets.
+	ConsumedRatio float64
+}
+
+// GetHistoryBudgetUsage returns the consumption of the workflow execution against its history budget.
+func GetHistoryBudgetUsage(config *configs.Config, mutableState historyi.MutableState) HistoryBudgetUsage {
+	executionInfo := mutableState.GetExecutionInfo()
+	usage := HistoryBudgetUsage{
+		HistorySize:  executionInfo.GetExecutionStats().GetHistorySize(),
+		HistoryCount: mutableState.GetNextEventID() - 1,
+	}
+
+	budgets := config.HistoryBudgets(mutableState.GetNamespaceEntry().Name().String())
+	budget, ok := findHistoryBudget(budgets, executionInfo.GetWorkflowTypeName())
+	if !ok {
+		return usage
+	}
+	usage.Budget = budget
+	usage.HasBudget = true
+	if budget.MaxHistorySizeBytes > 0 {
+		usage.ConsumedRatio = max(usage.ConsumedRatio, float64(usage.HistorySize)/float64(budget.MaxHistorySizeBytes))
+	}
+	if budget.MaxHistoryCount > 0 {
+		usage.ConsumedRatio = max(usage.ConsumedRatio, float64(usage.HistoryCount)/float64(budget.MaxHistoryCount))
+	}
+	return usage
+}
+
+// Actions returns the actions taken on the workflow execution, ordered by the consumed fraction of
+// the budget they are taken at.
+func (u HistoryBudgetUsage) Actions() []enumsspb.HistoryBudgetAction {
+	if !u.HasBudget {
+		return nil
+	}
+	type threshold struct {
+		action enumsspb.HistoryBudgetAction
+		ratio  float64
+	}
+	thresholds := []threshold{
+		{action: enumsspb.HISTORY_BUDGET_ACTION_WARN, ratio: u.Budget.WarnRatio},
+		{action: enumsspb.HISTORY_BUDGET_ACTION_SUGGEST_CONTINUE_AS_NEW, ratio: u.Budget.SuggestContinueAsNewRatio},
+		{action: enumsspb.HISTORY_BUDGET_ACTION_BLOCK_SIGNALS, ratio: u.Budget.BlockSignalsRatio},
+	}
+	slices.SortStableFunc(thresholds, func(a, b threshold) int {
+		return cmp.Compare(a.ratio, b.ratio)
+	})
+
+	var actions []enumsspb.HistoryBudgetAction
+	for _, t := range thresholds {
+		if t.ratio > 0 && u.ConsumedRatio >= t.ratio {
+			actions = append(actions, t.action)
+		}
+	}
+	return actions
+}
+
+// HasAction returns true if the given action is taken on the workflow execution.
+func (u HistoryBudgetUsage) HasAction(action enumsspb.HistoryBudgetAction) bool {
+	return slices.Contains(u.Actions(), action)
+}
+
+// findHistoryBudget returns the budget of the workflow type, falling back to the first budget
+// without a workflow type.
+func findHistoryBudget(budgets []dynamicconfig.HistoryBudget, workflowType string) (dynamicconfig.HistoryBudget, bool) {
+	var fallback dynamicconfig.HistoryBudget
+	hasFallback := false
+	for _, budget := range budgets {
+		if budget.WorkflowType == workflowType {
+			return budget, true
+		}
+		if budget.WorkflowType == "" && !hasFallback {
+			fallback = budget
+			hasFallback = true
+		}
+	}
+	return fallback, hasFallback
+}
@@ -0,0 +1,84 @@
+package workflow
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	enumsspb "go.temporal.io/server/api/enums/v1"
+	"go.temporal.io/server/common/dynamicconfig"
+)
+
+func TestFindHistoryBudget(t *testing.T) {
+	budgets := []dynamicconfig.HistoryBudget{
+		{MaxHistoryCount: 1},
+		{WorkflowType: "typed", MaxHistoryCount: 2},
+		{MaxHistoryCount: 3},
+	}
+
+	budget, ok := findHistoryBudget(budgets, "typed")
+	require.True(t, ok)
+	require.Equal(t, int64(2), budget.MaxHistoryCount)
+
+	budget, ok = findHistoryBudget(budgets, "other")
+	require.True(t, ok)
+	require.Equal(t, int64(1), budget.MaxHistoryCount)
+
+	_, ok = findHistoryBudget(budgets[1:2], "other")
+	require.False(t, ok)
+	_, ok = findHistoryBudget(nil, "typed")
+	require.False(t, ok)
+}
+
+func TestHistoryBudgetUsage_Actions(t *testing.T) {
+	budget := dynamicconfig.HistoryBudget{
+		WarnRatio:                 0.5,
+		SuggestContinueAsNewRatio: 0.9,
+		BlockSignalsRatio:         0.8,
+	}
+
+	testCases := []struct {
+		name     string
+		usage    HistoryBudgetUsage
+		expected []enumsspb.HistoryBudgetAction
+	}{
+		{
+			name:  "no budget",
+			usage: HistoryBudgetUsage{ConsumedRatio: 1},
+		},
+		{
+			name:  "below all thresholds",
+			usage: HistoryBudgetUsage{Budget: budget, HasBudget: true, ConsumedRatio: 0.4},
+		},
+		{
+			name:     "warn",
+			usage:    HistoryBudgetUsage{Budget: budget, HasBudget: true, ConsumedRatio: 0.5},
+			expected: []enumsspb.HistoryBudgetAction{enumsspb.HISTORY_BUDGET_ACTION_WARN},
+		},
+		{
+			name:  "ordered by threshold",
+			usage: HistoryBudgetUsage{Budget: budget, HasBudget: true, ConsumedRatio: 1.2},
+			expected: []enumsspb.HistoryBudgetAction{
+				enumsspb.HISTORY_BUDGET_ACTION_WARN,
+				enumsspb.HISTORY_BUDGET_ACTION_BLOCK_SIGNALS,
+				enumsspb.HISTORY_BUDGET_ACTION_SUGGEST_CONTINUE_AS_NEW,
+			},
+		},
+		{
+			name: "disabled actions",
+			usage: HistoryBudgetUsage{
+				Budget:        dynamicconfig.HistoryBudget{BlockSignalsRatio: 1},
+				HasBudget:     true,
+				ConsumedRatio: 1,
+			},
+			expected: []enumsspb.HistoryBudgetAction{enumsspb.HISTORY_BUDGET_ACTION_BLOCK_SIGNALS},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			require.Equal(t, tc.expected, tc.usage.Actions())
+		})
+	}
+	require.True(t, testCases[3].usage.HasAction(enumsspb.HISTORY_BUDGET_ACTION_BLOCK_SIGNALS))
+	require.False(t, testCases[2].usage.HasAction(enumsspb.HISTORY_BUDGET_ACTION_BLOCK_SIGNALS))
+}
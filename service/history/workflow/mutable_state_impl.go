@@ -20,6 +20,7 @@ import (
 	failurepb "go.temporal.io/api/failure/v1"
 	historypb "go.temporal.io/api/history/v1"
 	rulespb "go.temporal.io/api/rules/v1"
+	sdkpb "go.temporal.io/api/sdk/v1"
 	"go.temporal.io/api/serviceerror"
 	taskqueuepb "go.temporal.io/api/taskqueue/v1"
 	updatepb "go.temporal.io/api/update/v1"
@@ -57,6 +58,7 @@ import (
 	serviceerrors "go.temporal.io/server/common/serviceerror"
 	"go.temporal.io/server/common/util"
 	"go.temporal.io/server/common/worker_versioning"
+	"go.temporal.io/server/common/workerlabels"
 	"go.temporal.io/server/components/callbacks"
 	"go.temporal.io/server/components/nexusoperations"
 	"go.temporal.io/server/service/history/configs"
@@ -1569,9 +1571,13 @@ func (ms *MutableStateImpl) GetCompletionEvent(
 		return nil, ErrMissingWorkflowCompletionEvent
 	}
 
-	// Completion EventID is always one less than NextEventID after workflow is completed
+	// Completion EventID is one less than NextEventID after workflow is completed, unless
+	// events were added to the closed workflow afterward
 	nextEventID := ms.hBuilder.NextEventID()
 	completionEventID := nextEventID - 1
+	if ms.executionInfo.CompletionEventId != 0 {
+		completionEventID = ms.executionInfo.CompletionEventId
+	}
 	firstEventID := ms.executionInfo.CompletionEventBatchId
 
 	currentBranchToken, version, err := ms.getCurrentBranchTokenAndEventVersion(completionEventID)
@@ -3457,6 +3463,8 @@ func (ms *MutableStateImpl) ApplyActivityTaskScheduledEvent(
 		ActivityType:            attributes.GetActivityType(),
 		Priority:                attributes.Priority,
 	}
+	// The selector is validated when the activity is scheduled.
+	ai.WorkerSelector, _ = workerlabels.SelectorFromHeader(attributes.GetHeader())
 
 	if attributes.UseWorkflowBuildId {
 		if ms.GetAssignedBuildId() != "" {
@@ -4910,6 +4918,40 @@ func (ms *MutableStateImpl) ApplyWorkflowExecutionOptionsUpdatedEvent(event *his
 	return nil
 }
 
+// AddWorkflowPropertiesModifiedExternallyEvent records a change made to a closed workflow, e.g. a
+// redaction of its history.
+func (ms *MutableStateImpl) AddWorkflowPropertiesModifiedExternallyEvent(
+	upsertedMemo *commonpb.Memo,
+	userMetadata *sdkpb.UserMetadata,
+) (*historypb.HistoryEvent, error) {
+	if ms.IsWorkflowExecutionRunning() {
+		return nil, serviceerror.NewFailedPrecondition("Only closed workflow executions can be modified externally.")
+	}
+	event := ms.hBuilder.AddWorkflowPropertiesModifiedExternallyEvent(upsertedMemo, userMetadata)
+	if err := ms.ApplyWorkflowPropertiesModifiedExternallyEvent(event); err != nil {
+		return nil, err
+	}
+	return event, nil
+}
+
+func (ms *MutableStateImpl) ApplyWorkflowPropertiesModifiedExternallyEvent(event *historypb.HistoryEvent) error {
+	if ms.IsWorkflowExecutionRunning() {
+		return serviceerror.NewUnimplemented("Workflow property modification of running workflows not implemented")
+	}
+	if ms.executionInfo.CompletionEventId == 0 {
+		// the completion event is no longer the last event of the history
+		ms.executionInfo.CompletionEventId = event.GetEventId() - 1
+	}
+	// Only memo fields which are still present are replaced, the memo of a closed workflow may
+	// have been moved to visibility already.
+	for key, value := range event.GetWorkflowPropertiesModifiedExternallyEventAttributes().GetUpsertedMemo().GetFields() {
+		if _, ok := ms.executionInfo.Memo[key]; ok {
+			ms.executionInfo.Memo[key] = value
+		}
+	}
+	return nil
+}
+
 func (ms *MutableStateImpl) updateVersioningOverride(
 	override *workflowpb.VersioningOverride,
 ) error {
@@ -5872,6 +5914,49 @@ func (ms *MutableStateImpl) updatePauseInfoSearchAttribute() error {
 	return ms.taskGenerator.GenerateUpsertVisibilityTask()
 }
 
+// updateHistoryBudgetSearchAttribute records the most severe action taken on the workflow execution
+// as it consumes its history budget in the search attribute configured by the budget.
+func (ms *MutableStateImpl) updateHistoryBudgetSearchAttribute() error {
+	usage := GetHistoryBudgetUsage(ms.config, ms)
+	saName := usage.Budget.WarningSearchAttribute
+	if saName == "" {
+		return nil
+	}
+
+	namespaceName := ms.namespaceEntry.Name()
+	mapper, err := ms.shard.GetSearchAttributesMapperProvider().GetMapper(namespaceName)
+	if err != nil {
+		return err
+	}
+	if mapper != nil {
+		fieldName, err := mapper.GetFieldName(saName, namespaceName.String())
+		if err != nil {
+			// Misconfigured budgets must not fail workflow tasks.
+			ms.logger.Warn("Unable to map history budget search attribute", tag.Value(saName), tag.Error(err))
+			return nil
+		}
+		saName = fieldName
+	}
+
+	var value any
+	if actions := usage.Actions(); len(actions) > 0 {
+		value = actions[len(actions)-1].String()
+	}
+	if value == nil && ms.executionInfo.SearchAttributes[saName] == nil {
+		return nil
+	}
+	valuePayload, err := payload.Encode(value)
+	if err != nil {
+		return err
+	}
+	if proto.Equal(ms.executionInfo.SearchAttributes[saName], valuePayload) {
+		return nil // unchanged
+	}
+
+	ms.updateSearchAttributes(map[string]*commonpb.Payload{saName: valuePayload})
+	return ms.taskGenerator.GenerateUpsertVisibilityTask()
+}
+
 func (ms *MutableStateImpl) truncateRetryableActivityFailure(
 	activityFailure *failurepb.Failure,
 ) *failurepb.Failure {
@@ -7121,7 +7206,9 @@ func (ms *MutableStateImpl) validateNoEventsAfterWorkflowFinish(
 		enumspb.EVENT_TYPE_WORKFLOW_EXECUTION_TIMED_OUT,
 		enumspb.EVENT_TYPE_WORKFLOW_EXECUTION_TERMINATED,
 		enumspb.EVENT_TYPE_WORKFLOW_EXECUTION_CONTINUED_AS_NEW,
-		enumspb.EVENT_TYPE_WORKFLOW_EXECUTION_CANCELED:
+		enumspb.EVENT_TYPE_WORKFLOW_EXECUTION_CANCELED,
+		// changes made to a closed workflow are recorded after its finish event
+		enumspb.EVENT_TYPE_WORKFLOW_PROPERTIES_MODIFIED_EXTERNALLY:
 		return nil
 
 	default:
@@ -40,6 +40,7 @@ import (
 	"go.temporal.io/server/common/failure"
 	"go.temporal.io/server/common/log"
 	"go.temporal.io/server/common/namespace"
+	"go.temporal.io/server/common/payload"
 	"go.temporal.io/server/common/payloads"
 	"go.temporal.io/server/common/persistence/transitionhistory"
 	"go.temporal.io/server/common/persistence/versionhistory"
@@ -3440,6 +3441,69 @@ func (s *mutableStateSuite) TestGetCloseVersion() {
 	s.Equal(expectedVersion, closeVersion)
 }
 
+func (s *mutableStateSuite) TestAddWorkflowPropertiesModifiedExternallyEvent() {
+	s.mockEventsCache.EXPECT().PutEvent(gomock.Any(), gomock.Any()).AnyTimes()
+
+	_, err := s.mutableState.AddWorkflowExecutionStartedEvent(
+		&commonpb.WorkflowExecution{
+			WorkflowId: tests.WorkflowID,
+			RunId:      tests.RunID,
+		},
+		&historyservice.StartWorkflowExecutionRequest{
+			StartRequest: &workflowservice.StartWorkflowExecutionRequest{
+				Memo: &commonpb.Memo{Fields: map[string]*commonpb.Payload{"key": payload.EncodeString("value")}},
+			},
+		},
+	)
+	s.NoError(err)
+	_, err = s.mutableState.AddWorkflowTaskScheduledEvent(false, enumsspb.WORKFLOW_TASK_TYPE_NORMAL)
+	s.NoError(err)
+	_, _, err = s.mutableState.CloseTransactionAsMutation(historyi.TransactionPolicyActive)
+	s.NoError(err)
+	_, err = s.mutableState.AddWorkflowPropertiesModifiedExternallyEvent(nil, nil)
+	s.Error(err) // workflow still open
+
+	completionEvent, err := s.mutableState.AddCompletedWorkflowEvent(
+		s.mutableState.GetNextEventID(),
+		&commandpb.CompleteWorkflowExecutionCommandAttributes{},
+		"",
+	)
+	s.NoError(err)
+	_, _, err = s.mutableState.CloseTransactionAsMutation(historyi.TransactionPolicyActive)
+	s.NoError(err)
+
+	event, err := s.mutableState.AddWorkflowPropertiesModifiedExternallyEvent(
+		&commonpb.Memo{Fields: map[string]*commonpb.Payload{"key": payload.Redacted(), "other": payload.Redacted()}},
+		nil,
+	)
+	s.NoError(err)
+	s.True(event.GetWorkerMayIgnore())
+	s.Equal(completionEvent.GetEventId()+1, event.GetEventId())
+	s.Equal(completionEvent.GetEventId(), s.mutableState.GetExecutionInfo().GetCompletionEventId())
+	s.Equal(map[string]*commonpb.Payload{"key": payload.Redacted()}, s.mutableState.GetExecutionInfo().GetMemo())
+	_, workflowEvents, err := s.mutableState.CloseTransactionAsMutation(historyi.TransactionPolicyActive)
+	s.NoError(err)
+	s.Len(workflowEvents, 1)
+
+	// the completion event is no longer the last event
+	s.mockEventsCache.EXPECT().GetEvent(
+		gomock.Any(),
+		gomock.Any(),
+		events.EventKey{
+			NamespaceID: tests.NamespaceID,
+			WorkflowID:  tests.WorkflowID,
+			RunID:       tests.RunID,
+			EventID:     completionEvent.GetEventId(),
+			Version:     completionEvent.GetVersion(),
+		},
+		completionEvent.GetEventId(),
+		gomock.Any(),
+	).Return(completionEvent, nil)
+	loadedEvent, err := s.mutableState.GetCompletionEvent(context.Background())
+	s.NoError(err)
+	s.Equal(completionEvent, loadedEvent)
+}
+
 func (s *mutableStateSuite) TestCloseTransactionPrepareReplicationTasks_HistoryTask() {
 	version := int64(777)
 	firstEventID := int64(2)
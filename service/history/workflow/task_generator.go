@@ -66,6 +66,9 @@ type (
 		) error
 		GenerateUpsertVisibilityTask() error
 		GenerateWorkflowResetTasks() error
+		// GenerateWorkflowRedactionTasks adds the tasks that propagate a redaction of a closed workflow to its
+		// visibility record and, if archival is enabled, its archived copies.
+		GenerateWorkflowRedactionTasks(redactTime time.Time) error
 
 		// these 2 APIs should only be called when mutable state transaction is being closed
 		GenerateActivityTimerTasks() error
@@ -677,6 +680,31 @@ func (r *TaskGeneratorImpl) GenerateWorkflowResetTasks() error {
 	return nil
 }
 
+func (r *TaskGeneratorImpl) GenerateWorkflowRedactionTasks(redactTime time.Time) error {
+	closeVersion, err := r.mutableState.GetCloseVersion()
+	if err != nil {
+		return err
+	}
+
+	redactionTasks := []tasks.Task{
+		&tasks.CloseExecutionVisibilityTask{
+			// TaskID, VisibilityTimestamp is set by shard
+			WorkflowKey: r.mutableState.GetWorkflowKey(),
+			Version:     closeVersion,
+		},
+	}
+	if r.archivalEnabled() {
+		redactionTasks = append(redactionTasks, &tasks.ArchiveExecutionTask{
+			// TaskID is set by the shard
+			WorkflowKey:         r.mutableState.GetWorkflowKey(),
+			VisibilityTimestamp: redactTime,
+			Version:             closeVersion,
+		})
+	}
+	r.mutableState.AddTasks(redactionTasks...)
+	return nil
+}
+
 func (r *TaskGeneratorImpl) GenerateActivityTimerTasks() error {
 	_, err := r.getTimerSequence().CreateNextActivityTimer()
 	return err
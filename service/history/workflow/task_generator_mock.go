@@ -315,6 +315,20 @@ func (mr *MockTaskGeneratorMockRecorder) GenerateWorkflowCloseTasks(closedTime,
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GenerateWorkflowCloseTasks", reflect.TypeOf((*MockTaskGenerator)(nil).GenerateWorkflowCloseTasks), closedTime, deleteAfterClose)
 }
 
+// GenerateWorkflowRedactionTasks mocks base method.
+func (m *MockTaskGenerator) GenerateWorkflowRedactionTasks(redactTime time.Time) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GenerateWorkflowRedactionTasks", redactTime)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// GenerateWorkflowRedactionTasks indicates an expected call of GenerateWorkflowRedactionTasks.
+func (mr *MockTaskGeneratorMockRecorder) GenerateWorkflowRedactionTasks(redactTime any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GenerateWorkflowRedactionTasks", reflect.TypeOf((*MockTaskGenerator)(nil).GenerateWorkflowRedactionTasks), redactTime)
+}
+
 // GenerateWorkflowResetTasks mocks base method.
 func (m *MockTaskGenerator) GenerateWorkflowResetTasks() error {
 	m.ctrl.T.Helper()
@@ -309,6 +309,67 @@ func TestTaskGeneratorImpl_GenerateWorkflowCloseTasks(t *testing.T) {
 	}
 }
 
+func TestTaskGeneratorImpl_GenerateWorkflowRedactionTasks(t *testing.T) {
+	t.Parallel()
+
+	for _, archivalState := range []enumspb.ArchivalState{
+		enumspb.ARCHIVAL_STATE_ENABLED,
+		enumspb.ARCHIVAL_STATE_DISABLED,
+	} {
+		t.Run(archivalState.String(), func(t *testing.T) {
+			t.Parallel()
+
+			ctrl := gomock.NewController(t)
+			namespaceEntry := namespace.NewGlobalNamespaceForTest(
+				&persistencespb.NamespaceInfo{Id: tests.NamespaceID.String(), Name: tests.Namespace.String()},
+				&persistencespb.NamespaceConfig{
+					Retention:               durationpb.New(24 * time.Hour),
+					HistoryArchivalState:    archivalState,
+					HistoryArchivalUri:      "test:///history/archival/",
+					VisibilityArchivalState: enumspb.ARCHIVAL_STATE_DISABLED,
+				},
+				&persistencespb.NamespaceReplicationConfig{
+					ActiveClusterName: cluster.TestCurrentClusterName,
+					Clusters:          []string{cluster.TestCurrentClusterName},
+				},
+				tests.Version,
+			)
+
+			mutableState := historyi.NewMockMutableState(ctrl)
+			mutableState.EXPECT().GetNamespaceEntry().Return(namespaceEntry).AnyTimes()
+			mutableState.EXPECT().GetCloseVersion().Return(tests.Version, nil).AnyTimes()
+			mutableState.EXPECT().GetWorkflowKey().Return(definition.NewWorkflowKey(
+				namespaceEntry.ID().String(), tests.WorkflowID, tests.RunID,
+			)).AnyTimes()
+			var allTasks []tasks.Task
+			mutableState.EXPECT().AddTasks(gomock.Any()).Do(func(ts ...tasks.Task) {
+				allTasks = append(allTasks, ts...)
+			}).AnyTimes()
+
+			archivalConfig := archiver.NewMockArchivalConfig(ctrl)
+			archivalConfig.EXPECT().ClusterConfiguredForArchival().Return(true).AnyTimes()
+			archivalMetadata := archiver.NewMockArchivalMetadata(ctrl)
+			archivalMetadata.EXPECT().GetHistoryConfig().Return(archivalConfig).AnyTimes()
+			archivalMetadata.EXPECT().GetVisibilityConfig().Return(archivalConfig).AnyTimes()
+
+			redactTime := time.Unix(0, 0).UTC()
+			taskGenerator := NewTaskGenerator(namespace.NewMockRegistry(ctrl), mutableState, &configs.Config{}, archivalMetadata)
+			require.NoError(t, taskGenerator.GenerateWorkflowRedactionTasks(redactTime))
+
+			require.IsType(t, &tasks.CloseExecutionVisibilityTask{}, allTasks[0])
+			if archivalState != enumspb.ARCHIVAL_STATE_ENABLED {
+				require.Len(t, allTasks, 1)
+				return
+			}
+			require.Len(t, allTasks, 2)
+			archiveExecutionTask, ok := allTasks[1].(*tasks.ArchiveExecutionTask)
+			require.True(t, ok)
+			assert.Equal(t, redactTime, archiveExecutionTask.VisibilityTimestamp)
+			assert.Equal(t, tests.Version, archiveExecutionTask.Version)
+		})
+	}
+}
+
 func TestTaskGenerator_GenerateDirtySubStateMachineTasks(t *testing.T) {
 	ctrl := gomock.NewController(t)
 	namespaceRegistry := namespace.NewMockRegistry(ctrl)
@@ -547,6 +547,13 @@ func (m *workflowTaskStateMachine) AddWorkflowTaskStartedEvent(
 		return nil, nil, err
 	}
 
+	// Only when events were written, as speculative workflow tasks might never be persisted.
+	if workflowTaskScheduledEventCreated {
+		if err = m.ms.updateHistoryBudgetSearchAttribute(); err != nil {
+			return nil, nil, err
+		}
+	}
+
 	return startedEvent, workflowTask, nil
 }
 
@@ -1272,7 +1279,8 @@ func (m *workflowTaskStateMachine) getHistorySizeInfo() (bool, int64) {
 	namespaceName := m.ms.GetNamespaceEntry().Name().String()
 	sizeLimit := int64(config.HistorySizeSuggestContinueAsNew(namespaceName))
 	countLimit := int64(config.HistoryCountSuggestContinueAsNew(namespaceName))
-	suggestContinueAsNew := historySize >= sizeLimit || historyCount >= countLimit
+	suggestContinueAsNew := historySize >= sizeLimit || historyCount >= countLimit ||
+		GetHistoryBudgetUsage(config, m.ms).HasAction(enumsspb.HISTORY_BUDGET_ACTION_SUGGEST_CONTINUE_AS_NEW)
 	return suggestContinueAsNew, historySize
 }
 
@@ -3,11 +3,19 @@
 package history
 
 import (
+	"cmp"
 	"context"
+	"fmt"
+	"maps"
 	"math"
+	"slices"
+	"strconv"
+	"strings"
 
 	commonpb "go.temporal.io/api/common/v1"
 	"go.temporal.io/api/serviceerror"
+	"go.temporal.io/server/api/adminservice/v1"
+	historyspb "go.temporal.io/server/api/history/v1"
 	persistencespb "go.temporal.io/server/api/persistence/v1"
 	"go.temporal.io/server/common/definition"
 	"go.temporal.io/server/common/locks"
@@ -36,6 +44,11 @@ type (
 			ctx context.Context,
 			workflowKey definition.WorkflowKey,
 		) error
+		// check rebuilds a workflow in memory and returns the differences with the persisted mutable state
+		check(
+			ctx context.Context,
+			workflowKey definition.WorkflowKey,
+		) ([]*adminservice.MutableStateInconsistency, error)
 	}
 
 	workflowRebuilderImpl struct {
@@ -103,6 +116,58 @@ func (r *workflowRebuilderImpl) rebuild(
 	return r.overwriteToDB(ctx, rebuildMutableState)
 }
 
+func (r *workflowRebuilderImpl) check(
+	ctx context.Context,
+	workflowKey definition.WorkflowKey,
+) (_ []*adminservice.MutableStateInconsistency, retError error) {
+
+	if workflowKey.RunID == "" {
+		runID, err := r.workflowConsistencyChecker.GetCurrentRunID(
+			ctx,
+			workflowKey.NamespaceID,
+			workflowKey.WorkflowID,
+			locks.PriorityHigh,
+		)
+		if err != nil {
+			return nil, err
+		}
+		workflowKey.RunID = runID
+	}
+	// the workflow is locked while the persisted mutable state is compared, so that it is not updated concurrently
+	_, releaseFn, err := r.workflowConsistencyChecker.GetWorkflowCache().GetOrCreateWorkflowExecution(
+		ctx,
+		r.shard,
+		namespace.ID(workflowKey.NamespaceID),
+		&commonpb.WorkflowExecution{
+			WorkflowId: workflowKey.WorkflowID,
+			RunId:      workflowKey.RunID,
+		},
+		locks.PriorityHigh,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer func() { releaseFn(retError) }()
+
+	rebuildSpec, err := r.getRebuildSpecFromMutableState(ctx, &workflowKey)
+	if err != nil {
+		return nil, err
+	}
+	rebuildMutableState, err := r.replayResetWorkflow(
+		ctx,
+		workflowKey,
+		rebuildSpec.branchToken,
+		rebuildSpec.stateTransitionCount,
+		rebuildSpec.dbRecordVersion,
+		rebuildSpec.requestID,
+		rebuildSpec.mutableState,
+	)
+	if err != nil {
+		return nil, err
+	}
+	return compareMutableStates(rebuildSpec.mutableState, rebuildMutableState.CloneToProto()), nil
+}
+
 func (r *workflowRebuilderImpl) getRebuildSpecFromMutableState(
 	ctx context.Context,
 	workflowKey *definition.WorkflowKey,
@@ -201,3 +266,86 @@ func (r *workflowRebuilderImpl) overwriteToDB(
 		resetWorkflowSnapshot,
 	)
 }
+
+// compareMutableStates returns the differences of the fields of a persisted mutable state that are derived from
+// history, i.e. the pending activities, timers, child workflows, cancel and signal requests, the version histories
+// and the state of the workflow.
+func compareMutableStates(
+	database *persistencespb.WorkflowMutableState,
+	history *persistencespb.WorkflowMutableState,
+) []*adminservice.MutableStateInconsistency {
+	var result []*adminservice.MutableStateInconsistency
+	compare := func(field string, databaseValue string, historyValue string) {
+		if databaseValue != historyValue {
+			result = append(result, &adminservice.MutableStateInconsistency{
+				Field:         field,
+				DatabaseValue: databaseValue,
+				HistoryValue:  historyValue,
+			})
+		}
+	}
+
+	compare("next_event_id", strconv.FormatInt(database.GetNextEventId(), 10), strconv.FormatInt(history.GetNextEventId(), 10))
+	compare("execution_state.state", database.GetExecutionState().GetState().String(), history.GetExecutionState().GetState().String())
+	compare("execution_state.status", database.GetExecutionState().GetStatus().String(), history.GetExecutionState().GetStatus().String())
+	compare(
+		"execution_info.version_histories",
+		versionHistoriesString(database.GetExecutionInfo().GetVersionHistories()),
+		versionHistoriesString(history.GetExecutionInfo().GetVersionHistories()),
+	)
+
+	compareInfos(compare, "activity_infos", database.GetActivityInfos(), history.GetActivityInfos(), func(info *persistencespb.ActivityInfo) string {
+		return fmt.Sprintf("activity_id=%v", info.GetActivityId())
+	})
+	compareInfos(compare, "timer_infos", database.GetTimerInfos(), history.GetTimerInfos(), func(info *persistencespb.TimerInfo) string {
+		return fmt.Sprintf("started_event_id=%v", info.GetStartedEventId())
+	})
+	compareInfos(compare, "child_execution_infos", database.GetChildExecutionInfos(), history.GetChildExecutionInfos(), func(info *persistencespb.ChildExecutionInfo) string {
+		return fmt.Sprintf("started_event_id=%v started_workflow_id=%v started_run_id=%v", info.GetStartedEventId(), info.GetStartedWorkflowId(), info.GetStartedRunId())
+	})
+	compareInfos(compare, "request_cancel_infos", database.GetRequestCancelInfos(), history.GetRequestCancelInfos(), func(info *persistencespb.RequestCancelInfo) string {
+		return fmt.Sprintf("initiated_event_id=%v", info.GetInitiatedEventId())
+	})
+	compareInfos(compare, "signal_infos", database.GetSignalInfos(), history.GetSignalInfos(), func(info *persistencespb.SignalInfo) string {
+		return fmt.Sprintf("initiated_event_id=%v", info.GetInitiatedEventId())
+	})
+	return result
+}
+
+func compareInfos[K cmp.Ordered, V any](
+	compare func(field string, databaseValue string, historyValue string),
+	field string,
+	database map[K]V,
+	history map[K]V,
+	describe func(V) string,
+) {
+	keys := slices.Collect(maps.Keys(database))
+	for key := range history {
+		if _, ok := database[key]; !ok {
+			keys = append(keys, key)
+		}
+	}
+	slices.Sort(keys)
+	for _, key := range keys {
+		var databaseValue, historyValue string
+		if info, ok := database[key]; ok {
+			databaseValue = describe(info)
+		}
+		if info, ok := history[key]; ok {
+			historyValue = describe(info)
+		}
+		compare(fmt.Sprintf("%v[%v]", field, key), databaseValue, historyValue)
+	}
+}
+
+func versionHistoriesString(versionHistories *historyspb.VersionHistories) string {
+	var histories []string
+	for _, versionHistory := range versionHistories.GetHistories() {
+		var items []string
+		for _, item := range versionHistory.GetItems() {
+			items = append(items, fmt.Sprintf("%v:%v", item.GetEventId(), item.GetVersion()))
+		}
+		histories = append(histories, "["+strings.Join(items, " ")+"]")
+	}
+	return fmt.Sprintf("current=%v %v", versionHistories.GetCurrentVersionHistoryIndex(), strings.Join(histories, " "))
+}
@@ -13,6 +13,7 @@ import (
 	context "context"
 	reflect "reflect"
 
+	adminservice "go.temporal.io/server/api/adminservice/v1"
 	definition "go.temporal.io/server/common/definition"
 	gomock "go.uber.org/mock/gomock"
 )
@@ -41,6 +42,21 @@ func (m *MockworkflowRebuilder) EXPECT() *MockworkflowRebuilderMockRecorder {
 	return m.recorder
 }
 
+// check mocks base method.
+func (m *MockworkflowRebuilder) check(ctx context.Context, workflowKey definition.WorkflowKey) ([]*adminservice.MutableStateInconsistency, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "check", ctx, workflowKey)
+	ret0, _ := ret[0].([]*adminservice.MutableStateInconsistency)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// check indicates an expected call of check.
+func (mr *MockworkflowRebuilderMockRecorder) check(ctx, workflowKey any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "check", reflect.TypeOf((*MockworkflowRebuilder)(nil).check), ctx, workflowKey)
+}
+
 // rebuild mocks base method.
 func (m *MockworkflowRebuilder) rebuild(ctx context.Context, workflowKey definition.WorkflowKey) error {
 	m.ctrl.T.Helper()
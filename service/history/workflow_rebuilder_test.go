@@ -0,0 +1,60 @@
+package history
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	enumsspb "go.temporal.io/server/api/enums/v1"
+	historyspb "go.temporal.io/server/api/history/v1"
+	persistencespb "go.temporal.io/server/api/persistence/v1"
+	"go.temporal.io/server/common"
+)
+
+func TestCompareMutableStates(t *testing.T) {
+	newMutableState := func() *persistencespb.WorkflowMutableState {
+		return &persistencespb.WorkflowMutableState{
+			NextEventId: 10,
+			ExecutionState: &persistencespb.WorkflowExecutionState{
+				State: enumsspb.WORKFLOW_EXECUTION_STATE_RUNNING,
+			},
+			ExecutionInfo: &persistencespb.WorkflowExecutionInfo{
+				VersionHistories: &historyspb.VersionHistories{
+					Histories: []*historyspb.VersionHistory{{
+						Items: []*historyspb.VersionHistoryItem{{EventId: 9, Version: common.EmptyVersion}},
+					}},
+				},
+			},
+			ActivityInfos: map[int64]*persistencespb.ActivityInfo{
+				5: {ActivityId: "activity-1"},
+			},
+			TimerInfos: map[string]*persistencespb.TimerInfo{
+				"timer-1": {StartedEventId: 6},
+			},
+		}
+	}
+
+	require.Empty(t, compareMutableStates(newMutableState(), newMutableState()))
+
+	database := newMutableState()
+	database.NextEventId = 12
+	database.ActivityInfos[7] = &persistencespb.ActivityInfo{ActivityId: "activity-2"}
+	history := newMutableState()
+	history.TimerInfos["timer-1"].StartedEventId = 8
+	history.ChildExecutionInfos = map[int64]*persistencespb.ChildExecutionInfo{
+		8: {StartedWorkflowId: "child"},
+	}
+
+	inconsistencies := compareMutableStates(database, history)
+	require.Len(t, inconsistencies, 4)
+	require.Equal(t, "next_event_id", inconsistencies[0].GetField())
+	require.Equal(t, "12", inconsistencies[0].GetDatabaseValue())
+	require.Equal(t, "10", inconsistencies[0].GetHistoryValue())
+	require.Equal(t, "activity_infos[7]", inconsistencies[1].GetField())
+	require.Equal(t, "activity_id=activity-2", inconsistencies[1].GetDatabaseValue())
+	require.Empty(t, inconsistencies[1].GetHistoryValue())
+	require.Equal(t, "timer_infos[timer-1]", inconsistencies[2].GetField())
+	require.Equal(t, "started_event_id=6", inconsistencies[2].GetDatabaseValue())
+	require.Equal(t, "started_event_id=8", inconsistencies[2].GetHistoryValue())
+	require.Equal(t, "child_execution_infos[8]", inconsistencies[3].GetField())
+	require.Empty(t, inconsistencies[3].GetDatabaseValue())
+}
@@ -0,0 +1,398 @@
+package matching
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"time"
+
+	enumspb "go.temporal.io/api/enums/v1"
+	"go.temporal.io/api/serviceerror"
+	persistencespb "go.temporal.io/server/api/persistence/v1"
+	taskqueuespb "go.temporal.io/server/api/taskqueue/v1"
+	"go.temporal.io/server/common/persistence"
+	"google.golang.org/protobuf/types/known/durationpb"
+)
+
+const (
+	// Deleted tasks are kept in task queue metadata until the ack level passes them, so their number is bounded to
+	// keep the metadata small.
+	maxDeletedBacklogTasks = 1000
+
+	defaultBacklogTasksPageSize = 100
+	maxBacklogTasksPageSize     = 1000
+)
+
+type (
+	// backlogTask is a task read from a subqueue of the backlog.
+	backlogTask struct {
+		subqueue subqueueIndex
+		*persistencespb.AllocatedTaskInfo
+	}
+
+	// deletedTaskValidator discards backlog tasks that were deleted by an operator before asking the wrapped
+	// validator.
+	deletedTaskValidator struct {
+		taskValidator
+		pqMgr *physicalTaskQueueManagerImpl
+	}
+)
+
+var errTooManyDeletedBacklogTasks = serviceerror.NewFailedPrecondition(fmt.Sprintf(
+	"more than %d deleted tasks are waiting to be cleaned up from this backlog, retry later", maxDeletedBacklogTasks))
+
+func backlogTasksPageSize(requested int32) int {
+	if requested <= 0 {
+		return defaultBacklogTasksPageSize
+	}
+	return min(int(requested), maxBacklogTasksPageSize)
+}
+
+func (v *deletedTaskValidator) maybeValidate(
+	task *persistencespb.AllocatedTaskInfo,
+	taskType enumspb.TaskQueueType,
+) bool {
+	if v.pqMgr.backlogMgr.getDB().isTaskDeleted(task.GetTaskId()) {
+		return false
+	}
+	return v.taskValidator.maybeValidate(task, taskType)
+}
+
+func (t backlogTask) key() *taskqueuespb.BacklogTaskKey {
+	return &taskqueuespb.BacklogTaskKey{
+		Subqueue: int32(t.subqueue),
+		TaskPass: t.TaskPass,
+		TaskId:   t.TaskId,
+	}
+}
+
+func (t backlogTask) info(taskType enumspb.TaskQueueType, now time.Time) *taskqueuespb.BacklogTaskInfo {
+	data := t.GetData()
+	info := &taskqueuespb.BacklogTaskInfo{
+		Key:              t.key(),
+		TaskQueueType:    taskType,
+		WorkflowId:       data.GetWorkflowId(),
+		RunId:            data.GetRunId(),
+		ScheduledEventId: data.GetScheduledEventId(),
+		PriorityKey:      data.GetPriority().GetPriorityKey(),
+		FairnessKey:      data.GetPriority().GetFairnessKey(),
+		CreateTime:       data.GetCreateTime(),
+		ExpiryTime:       data.GetExpiryTime(),
+	}
+	if data.GetCreateTime() != nil {
+		info.Age = durationpb.New(now.Sub(data.GetCreateTime().AsTime()))
+	}
+	return info
+}
+
+// isTaskDeleted returns true if the task with the given id was deleted by an operator. It does not take the db lock
+// since it's called on every dispatch.
+func (db *taskQueueDB) isTaskDeleted(taskID int64) bool {
+	ids := db.deletedTaskIDs.Load()
+	if ids == nil {
+		return false
+	}
+	_, ok := (*ids)[taskID]
+	return ok
+}
+
+// refreshDeletedTasksLocked rebuilds the set of deleted task ids checked by isTaskDeleted. Task ids are unique across
+// subqueues so a single set is enough.
+func (db *taskQueueDB) refreshDeletedTasksLocked() {
+	ids := make(map[int64]struct{})
+	for _, s := range db.subqueues {
+		for _, level := range s.DeletedTasks {
+			ids[level.TaskId] = struct{}{}
+		}
+	}
+	if len(ids) == 0 {
+		db.deletedTaskIDs.Store(nil)
+		return
+	}
+	db.deletedTaskIDs.Store(&ids)
+}
+
+// pruneDeletedTasksLocked forgets deleted tasks that the ack level of the subqueue has passed. Call this whenever the
+// ack level moves.
+func (db *taskQueueDB) pruneDeletedTasksLocked(subqueue subqueueIndex) {
+	s := db.subqueues[subqueue]
+	if len(s.DeletedTasks) == 0 {
+		return
+	}
+	before := len(s.DeletedTasks)
+	s.DeletedTasks = slices.DeleteFunc(s.DeletedTasks, func(level *taskqueuespb.FairLevel) bool {
+		return s.isAcked(fairLevelFromProto(level))
+	})
+	if len(s.DeletedTasks) != before {
+		db.lastChange = time.Now()
+		db.refreshDeletedTasksLocked()
+	}
+}
+
+// isAcked returns true if the task at the given level is at or below the ack level. Fair tasks always have a non-zero
+// pass, fifo tasks have a zero pass.
+func (s *dbSubqueue) isAcked(level fairLevel) bool {
+	if level.pass == 0 {
+		return level.id <= s.AckLevel
+	}
+	return !fairLevelFromProto(s.FairAckLevel).less(level)
+}
+
+func (s *dbSubqueue) ackLevel(fair bool) fairLevel {
+	if fair {
+		return fairLevelFromProto(s.FairAckLevel)
+	}
+	return fairLevel{id: s.AckLevel}
+}
+
+// ListBacklogTasks returns up to pageSize tasks above the ack level, going through subqueues in order and skipping
+// deleted tasks. Tasks that were dispatched but not acked yet are included.
+func (db *taskQueueDB) ListBacklogTasks(
+	ctx context.Context,
+	fair bool,
+	pageToken []byte,
+	pageSize int,
+) ([]backlogTask, []byte, error) {
+	var token taskqueuespb.BacklogTasksPageToken
+	if len(pageToken) > 0 {
+		if err := token.Unmarshal(pageToken); err != nil {
+			return nil, nil, serviceerror.NewInvalidArgument("invalid page token")
+		}
+	}
+	subqueue := subqueueIndex(token.Subqueue)
+	after := fairLevelFromProto(token.Level)
+
+	numSubqueues := db.numSubqueues()
+	var result []backlogTask
+	for int(subqueue) < numSubqueues && len(result) < pageSize {
+		tasks, more, err := db.getBacklogTasksAfter(ctx, fair, subqueue, after, pageSize-len(result))
+		if err != nil {
+			return nil, nil, err
+		}
+		for _, t := range tasks {
+			after = fairLevelFromAllocatedTask(t)
+			if !db.isTaskDeleted(t.TaskId) {
+				result = append(result, backlogTask{subqueue: subqueue, AllocatedTaskInfo: t})
+			}
+		}
+		if !more {
+			subqueue++
+			after = fairLevel{}
+		}
+	}
+
+	if int(subqueue) >= numSubqueues {
+		return result, nil, nil
+	}
+	token = taskqueuespb.BacklogTasksPageToken{Subqueue: int32(subqueue), Level: after.toProto()}
+	nextPageToken, err := token.Marshal()
+	if err != nil {
+		return nil, nil, err
+	}
+	return result, nextPageToken, nil
+}
+
+// getBacklogTasksAfter reads up to batchSize tasks of the subqueue above both the given level and the ack level. The
+// returned bool is false if the end of the subqueue was reached.
+func (db *taskQueueDB) getBacklogTasksAfter(
+	ctx context.Context,
+	fair bool,
+	subqueue subqueueIndex,
+	after fairLevel,
+	batchSize int,
+) ([]*persistencespb.AllocatedTaskInfo, bool, error) {
+	db.Lock()
+	if int(subqueue) >= len(db.subqueues) {
+		db.Unlock()
+		return nil, false, nil
+	}
+	s := db.subqueues[subqueue]
+	from := after.max(s.ackLevel(fair))
+	maxReadLevel := s.maxReadLevel
+	db.Unlock()
+
+	var resp *persistence.GetTasksResponse
+	var err error
+	if fair {
+		resp, err = db.GetFairTasks(ctx, subqueue, from.max(fairLevel{pass: 1, id: 0}).inc(), batchSize)
+	} else {
+		resp, err = db.GetTasks(ctx, subqueue, from.id+1, maxReadLevel+1, batchSize)
+	}
+	if err != nil {
+		return nil, false, err
+	}
+	return resp.Tasks, len(resp.Tasks) >= batchSize, nil
+}
+
+// DeleteBacklogTasks records the given tasks as deleted in the task queue metadata. Deleted tasks are discarded
+// instead of being dispatched, and are removed from persistence with the rest of the acked tasks.
+func (db *taskQueueDB) DeleteBacklogTasks(
+	ctx context.Context,
+	fair bool,
+	keys []*taskqueuespb.BacklogTaskKey,
+) error {
+	numSubqueues := db.numSubqueues()
+	for _, key := range keys {
+		if key.GetSubqueue() < 0 || int(key.GetSubqueue()) >= numSubqueues {
+			return serviceerror.NewInvalidArgument(fmt.Sprintf("subqueue %d does not exist", key.GetSubqueue()))
+		}
+		if fair != (key.GetTaskPass() > 0) {
+			return serviceerror.NewInvalidArgument(
+				fmt.Sprintf("task pass must be set if and only if the backlog uses fairness (fairness: %v)", fair))
+		}
+		if err := db.checkBacklogTaskExists(ctx, fair, key); err != nil {
+			return err
+		}
+	}
+	return db.deleteBacklogTasks(ctx, keys, 0)
+}
+
+// MoveBacklogTasks lists a page of up to pageSize tasks like ListBacklogTasks, passes each of them to add until it
+// fails, and deletes the added tasks. Room for deleting the whole page is reserved before any task is added, so that
+// an added task is not left in the backlog because too many deleted tasks are waiting to be cleaned up. The added
+// tasks are returned along with the error of add, if any.
+func (db *taskQueueDB) MoveBacklogTasks(
+	ctx context.Context,
+	fair bool,
+	pageToken []byte,
+	pageSize int,
+	add func(task backlogTask) error,
+) ([]backlogTask, []byte, error) {
+	reserved := db.reserveDeletedTasks(pageSize)
+	if reserved == 0 {
+		return nil, nil, errTooManyDeletedBacklogTasks
+	}
+	tasks, nextPageToken, err := db.ListBacklogTasks(ctx, fair, pageToken, reserved)
+	if err != nil {
+		db.releaseDeletedTasks(reserved)
+		return nil, nil, err
+	}
+
+	var added []backlogTask
+	var addErr error
+	for _, task := range tasks {
+		if addErr = add(task); addErr != nil {
+			break
+		}
+		added = append(added, task)
+	}
+	if len(added) == 0 {
+		db.releaseDeletedTasks(reserved)
+		return nil, nil, addErr
+	}
+	keys := make([]*taskqueuespb.BacklogTaskKey, len(added))
+	for i, task := range added {
+		keys[i] = task.key()
+	}
+	// tasks that were dispatched since they were listed are acked or will be, so they are not checked
+	if err := db.deleteBacklogTasks(ctx, keys, reserved); err != nil {
+		return nil, nil, err
+	}
+	if addErr != nil {
+		return added, nil, addErr
+	}
+	return added, nextPageToken, nil
+}
+
+// reserveDeletedTasks reserves room for up to n deleted tasks and returns the reserved number, which must be passed
+// to deleteBacklogTasks or releaseDeletedTasks.
+func (db *taskQueueDB) reserveDeletedTasks(n int) int {
+	db.Lock()
+	defer db.Unlock()
+
+	available := maxDeletedBacklogTasks - db.reservedDeletedTasks
+	for _, s := range db.subqueues {
+		available -= len(s.DeletedTasks)
+	}
+	reserved := max(0, min(n, available))
+	db.reservedDeletedTasks += reserved
+	return reserved
+}
+
+func (db *taskQueueDB) releaseDeletedTasks(reserved int) {
+	db.Lock()
+	defer db.Unlock()
+	db.reservedDeletedTasks -= reserved
+}
+
+// deleteBacklogTasks deletes the given tasks of existing subqueues, using the room reserved by reserveDeletedTasks,
+// if any. Tasks that are already acked or deleted are skipped. The reservation is released in any case.
+func (db *taskQueueDB) deleteBacklogTasks(
+	ctx context.Context,
+	keys []*taskqueuespb.BacklogTaskKey,
+	reserved int,
+) error {
+	if reserved > 0 {
+		defer db.releaseDeletedTasks(reserved)
+	}
+
+	db.Lock()
+	defer db.Unlock()
+
+	prevDeletedTasks := make([][]*taskqueuespb.FairLevel, len(db.subqueues))
+	// room reserved by other moves is not available
+	total := db.reservedDeletedTasks - reserved
+	for i, s := range db.subqueues {
+		prevDeletedTasks[i] = s.DeletedTasks
+		total += len(s.DeletedTasks)
+	}
+	for _, key := range keys {
+		s := db.subqueues[key.GetSubqueue()]
+		level := fairLevel{pass: key.GetTaskPass(), id: key.GetTaskId()}
+		if s.isAcked(level) || slices.ContainsFunc(s.DeletedTasks, func(l *taskqueuespb.FairLevel) bool {
+			return fairLevelFromProto(l) == level
+		}) {
+			continue
+		}
+		if total >= maxDeletedBacklogTasks {
+			db.restoreDeletedTasksLocked(prevDeletedTasks)
+			return errTooManyDeletedBacklogTasks
+		}
+		// copy on write so that prevDeletedTasks is not modified
+		s.DeletedTasks = append(slices.Clip(s.DeletedTasks), &taskqueuespb.FairLevel{TaskPass: level.pass, TaskId: level.id})
+		total++
+	}
+
+	db.lastChange = time.Now()
+	if err := db.updateTaskQueueLocked(ctx, false); err != nil {
+		db.restoreDeletedTasksLocked(prevDeletedTasks)
+		return err
+	}
+	db.refreshDeletedTasksLocked()
+	return nil
+}
+
+func (db *taskQueueDB) restoreDeletedTasksLocked(deletedTasks [][]*taskqueuespb.FairLevel) {
+	for i, s := range db.subqueues {
+		s.DeletedTasks = deletedTasks[i]
+	}
+}
+
+func (db *taskQueueDB) checkBacklogTaskExists(
+	ctx context.Context,
+	fair bool,
+	key *taskqueuespb.BacklogTaskKey,
+) error {
+	subqueue := subqueueIndex(key.GetSubqueue())
+	var resp *persistence.GetTasksResponse
+	var err error
+	if fair {
+		resp, err = db.GetFairTasks(ctx, subqueue, fairLevel{pass: key.GetTaskPass(), id: key.GetTaskId()}, 1)
+	} else {
+		resp, err = db.GetTasks(ctx, subqueue, key.GetTaskId(), key.GetTaskId()+1, 1)
+	}
+	if err != nil {
+		return err
+	}
+	if len(resp.Tasks) == 0 || resp.Tasks[0].TaskId != key.GetTaskId() || resp.Tasks[0].TaskPass != key.GetTaskPass() {
+		return serviceerror.NewNotFound(fmt.Sprintf(
+			"task %d (pass %d) not found in subqueue %d", key.GetTaskId(), key.GetTaskPass(), key.GetSubqueue()))
+	}
+	return nil
+}
+
+func (db *taskQueueDB) numSubqueues() int {
+	db.Lock()
+	defer db.Unlock()
+	return len(db.subqueues)
+}
@@ -0,0 +1,203 @@
+package matching
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	commonpb "go.temporal.io/api/common/v1"
+	enumspb "go.temporal.io/api/enums/v1"
+	"go.temporal.io/api/serviceerror"
+	persistencespb "go.temporal.io/server/api/persistence/v1"
+	taskqueuespb "go.temporal.io/server/api/taskqueue/v1"
+	"go.temporal.io/server/common/dynamicconfig"
+	"go.temporal.io/server/common/log"
+	"go.temporal.io/server/common/metrics"
+	"go.temporal.io/server/common/persistence"
+	"go.temporal.io/server/common/tqid"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+func newBacklogTasksTestDB(t *testing.T, numTasks int) (*taskQueueDB, *testTaskManager) {
+	logger := log.NewTestLogger()
+	tm := newTestTaskManager(logger)
+	cfg := NewConfig(dynamicconfig.NewNoopCollection())
+	f, err := tqid.NewTaskQueueFamily("nsid", "test-queue")
+	require.NoError(t, err)
+	prtn := f.TaskQueue(enumspb.TASK_QUEUE_TYPE_ACTIVITY).NormalPartition(0)
+	tlCfg := newTaskQueueConfig(prtn.TaskQueue(), cfg, "test-namespace")
+	db := newTaskQueueDB(tlCfg, tm, UnversionedQueueKey(prtn), logger, metrics.NoopMetricsHandler)
+
+	_, err = db.RenewLease(context.Background())
+	require.NoError(t, err)
+
+	reqs := make([]*writeTaskRequest, numTasks)
+	for i := range reqs {
+		reqs[i] = &writeTaskRequest{
+			taskInfo: &persistencespb.TaskInfo{
+				NamespaceId: "nsid",
+				WorkflowId:  "wf",
+				CreateTime:  timestamppb.New(time.Now().Add(-time.Minute)),
+				Priority:    &commonpb.Priority{PriorityKey: 2, FairnessKey: "tenant"},
+			},
+			fairLevel: fairLevel{id: int64(i + 1)},
+		}
+	}
+	_, err = db.CreateTasks(context.Background(), reqs)
+	require.NoError(t, err)
+	return db, tm
+}
+
+func backlogTaskIDs(tasks []backlogTask) []int64 {
+	ids := make([]int64, len(tasks))
+	for i, t := range tasks {
+		ids[i] = t.TaskId
+	}
+	return ids
+}
+
+func TestBacklogTasks_ListPages(t *testing.T) {
+	db, _ := newBacklogTasksTestDB(t, 3)
+	ctx := context.Background()
+
+	tasks, token, err := db.ListBacklogTasks(ctx, false, nil, 2)
+	require.NoError(t, err)
+	require.Equal(t, []int64{1, 2}, backlogTaskIDs(tasks))
+	require.NotEmpty(t, token)
+
+	tasks, token, err = db.ListBacklogTasks(ctx, false, token, 2)
+	require.NoError(t, err)
+	require.Equal(t, []int64{3}, backlogTaskIDs(tasks))
+	require.Empty(t, token)
+
+	info := tasks[0].info(enumspb.TASK_QUEUE_TYPE_ACTIVITY, time.Now())
+	require.Equal(t, "wf", info.GetWorkflowId())
+	require.Equal(t, int32(2), info.GetPriorityKey())
+	require.Equal(t, "tenant", info.GetFairnessKey())
+	require.GreaterOrEqual(t, info.GetAge().AsDuration(), time.Minute)
+}
+
+func TestBacklogTasks_DeleteAndPrune(t *testing.T) {
+	db, tm := newBacklogTasksTestDB(t, 3)
+	ctx := context.Background()
+
+	err := db.DeleteBacklogTasks(ctx, false, []*taskqueuespb.BacklogTaskKey{{TaskId: 2}})
+	require.NoError(t, err)
+	require.True(t, db.isTaskDeleted(2))
+	require.False(t, db.isTaskDeleted(1))
+
+	tasks, _, err := db.ListBacklogTasks(ctx, false, nil, 10)
+	require.NoError(t, err)
+	require.Equal(t, []int64{1, 3}, backlogTaskIDs(tasks))
+
+	// deleted tasks are persisted with the metadata
+	resp, err := tm.GetTaskQueue(ctx, &persistence.GetTaskQueueRequest{
+		NamespaceID: "nsid",
+		TaskQueue:   db.queue.PersistenceName(),
+		TaskType:    enumspb.TASK_QUEUE_TYPE_ACTIVITY,
+	})
+	require.NoError(t, err)
+	require.Len(t, resp.TaskQueueInfo.GetSubqueues()[0].GetDeletedTasks(), 1)
+
+	// forgotten once the ack level passes them
+	db.updateAckLevelAndBacklogStats(subqueueZero, 1, -1, time.Time{})
+	require.True(t, db.isTaskDeleted(2))
+	db.updateAckLevelAndBacklogStats(subqueueZero, 2, -1, time.Time{})
+	require.False(t, db.isTaskDeleted(2))
+	require.Empty(t, db.subqueues[subqueueZero].DeletedTasks)
+}
+
+func TestBacklogTasks_DeleteValidation(t *testing.T) {
+	db, _ := newBacklogTasksTestDB(t, 1)
+	ctx := context.Background()
+
+	var notFound *serviceerror.NotFound
+	err := db.DeleteBacklogTasks(ctx, false, []*taskqueuespb.BacklogTaskKey{{TaskId: 5}})
+	require.ErrorAs(t, err, &notFound)
+
+	var invalidArgument *serviceerror.InvalidArgument
+	err = db.DeleteBacklogTasks(ctx, false, []*taskqueuespb.BacklogTaskKey{{Subqueue: 3, TaskId: 1}})
+	require.ErrorAs(t, err, &invalidArgument)
+	err = db.DeleteBacklogTasks(ctx, false, []*taskqueuespb.BacklogTaskKey{{TaskPass: 1, TaskId: 1}})
+	require.ErrorAs(t, err, &invalidArgument)
+	require.False(t, db.isTaskDeleted(1))
+}
+
+func TestBacklogTasks_MoveReservesDeletedTasks(t *testing.T) {
+	db, _ := newBacklogTasksTestDB(t, 4)
+	ctx := context.Background()
+
+	// leave room for two more deleted tasks
+	for i := range maxDeletedBacklogTasks - 2 {
+		db.subqueues[subqueueZero].DeletedTasks = append(db.subqueues[subqueueZero].DeletedTasks,
+			&taskqueuespb.FairLevel{TaskId: int64(1000 + i)})
+	}
+
+	var added []int64
+	tasks, token, err := db.MoveBacklogTasks(ctx, false, nil, 4, func(task backlogTask) error {
+		added = append(added, task.TaskId)
+		// the room is reserved for the move
+		err := db.DeleteBacklogTasks(ctx, false, []*taskqueuespb.BacklogTaskKey{{TaskId: 4}})
+		require.ErrorIs(t, err, errTooManyDeletedBacklogTasks)
+		return nil
+	})
+	require.NoError(t, err)
+	require.Equal(t, []int64{1, 2}, added, "the page is limited to the room left for deleted tasks")
+	require.Equal(t, []int64{1, 2}, backlogTaskIDs(tasks))
+	require.NotEmpty(t, token)
+	require.True(t, db.isTaskDeleted(1))
+	require.True(t, db.isTaskDeleted(2))
+	require.Zero(t, db.reservedDeletedTasks)
+
+	// no task is added when none can be deleted
+	_, _, err = db.MoveBacklogTasks(ctx, false, token, 4, func(task backlogTask) error {
+		require.Fail(t, "no task must be added")
+		return nil
+	})
+	require.ErrorIs(t, err, errTooManyDeletedBacklogTasks)
+	require.False(t, db.isTaskDeleted(3))
+	require.Zero(t, db.reservedDeletedTasks)
+}
+
+func TestBacklogTasks_MoveDeletesAddedTasksOnError(t *testing.T) {
+	db, _ := newBacklogTasksTestDB(t, 3)
+	ctx := context.Background()
+
+	addErr := serviceerror.NewUnavailable("destination unavailable")
+	tasks, _, err := db.MoveBacklogTasks(ctx, false, nil, 3, func(task backlogTask) error {
+		if task.TaskId == 2 {
+			return addErr
+		}
+		return nil
+	})
+	require.ErrorIs(t, err, addErr)
+	require.Equal(t, []int64{1}, backlogTaskIDs(tasks))
+	require.True(t, db.isTaskDeleted(1))
+	require.False(t, db.isTaskDeleted(2))
+	require.Zero(t, db.reservedDeletedTasks)
+}
+
+func TestBacklogTasks_MoveSkipsDispatchedTasks(t *testing.T) {
+	db, tm := newBacklogTasksTestDB(t, 3)
+	ctx := context.Background()
+	dbq := db.queue
+
+	getTasksCount := tm.getGetTasksCount(dbq)
+	tasks, _, err := db.MoveBacklogTasks(ctx, false, nil, 3, func(task backlogTask) error {
+		if task.TaskId == 2 {
+			// tasks 1 and 2 are dispatched and acked while the page is moved
+			_, err := db.CompleteTasksLessThan(ctx, 3, 10, subqueueZero)
+			require.NoError(t, err)
+			db.updateAckLevelAndBacklogStats(subqueueZero, 2, -2, time.Time{})
+		}
+		return nil
+	})
+	require.NoError(t, err)
+	require.Equal(t, []int64{1, 2, 3}, backlogTaskIDs(tasks))
+	require.False(t, db.isTaskDeleted(1))
+	require.False(t, db.isTaskDeleted(2))
+	require.True(t, db.isTaskDeleted(3))
+	require.Equal(t, getTasksCount+1, tm.getGetTasksCount(dbq), "only the page is read")
+	require.Zero(t, db.reservedDeletedTasks)
+}
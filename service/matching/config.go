@@ -41,10 +41,17 @@ type (
 		EnableFairness                           dynamicconfig.TypedSubscribableWithTaskQueueFilter[bool]
 		GetTasksBatchSize                        dynamicconfig.IntPropertyFnWithTaskQueueFilter
 		GetTasksReloadAt                         dynamicconfig.IntPropertyFnWithTaskQueueFilter
+		MaxUnroutableLoadedTasks                 dynamicconfig.IntPropertyFnWithTaskQueueFilter
 		UpdateAckInterval                        dynamicconfig.DurationPropertyFnWithTaskQueueFilter
 		MaxTaskQueueIdleTime                     dynamicconfig.DurationPropertyFnWithTaskQueueFilter
 		NumTaskqueueWritePartitions              dynamicconfig.IntPropertyFnWithTaskQueueFilter
 		NumTaskqueueReadPartitions               dynamicconfig.IntPropertyFnWithTaskQueueFilter
+		EnablePartitionAutoScaling               dynamicconfig.BoolPropertyFnWithTaskQueueFilter
+		PartitionAutoScalingMinPartitions        dynamicconfig.IntPropertyFnWithTaskQueueFilter
+		PartitionAutoScalingTargetRate           dynamicconfig.FloatPropertyFnWithTaskQueueFilter
+		PartitionAutoScalingMaxBacklog           dynamicconfig.IntPropertyFnWithTaskQueueFilter
+		PartitionAutoScalingInterval             dynamicconfig.DurationPropertyFnWithTaskQueueFilter
+		PartitionAutoScalingScaleDownDelay       dynamicconfig.DurationPropertyFnWithTaskQueueFilter
 		BreakdownMetricsByTaskQueue              dynamicconfig.BoolPropertyFnWithTaskQueueFilter
 		BreakdownMetricsByPartition              dynamicconfig.BoolPropertyFnWithTaskQueueFilter
 		BreakdownMetricsByBuildID                dynamicconfig.BoolPropertyFnWithTaskQueueFilter
@@ -112,6 +119,10 @@ type (
 		FairnessCounter dynamicconfig.TypedPropertyFnWithTaskQueueFilter[counter.CounterParams]
 
 		LogAllReqErrors dynamicconfig.BoolPropertyFnWithNamespaceFilter
+
+		WorkerRegistryPersistenceEnabled dynamicconfig.BoolPropertyFn
+		WorkerRegistryFlushInterval      dynamicconfig.DurationPropertyFn
+		WorkerRegistryRefreshInterval    dynamicconfig.DurationPropertyFn
 	}
 
 	forwarderConfig struct {
@@ -135,6 +146,7 @@ type (
 		EnableFairness             func(func(bool)) (bool, func())
 		GetTasksBatchSize          func() int
 		GetTasksReloadAt           func() int
+		MaxUnroutableLoadedTasks   func() int
 		UpdateAckInterval          func() time.Duration
 		MaxTaskQueueIdleTime       func() time.Duration
 		MinTaskThrottlingBurstSize func() int
@@ -154,6 +166,14 @@ type (
 		NumWritePartitions              func() int
 		NumReadPartitions               func() int
 
+		// partition auto-scaling
+		EnablePartitionAutoScaling         func() bool
+		PartitionAutoScalingMinPartitions  func() int
+		PartitionAutoScalingTargetRate     func() float64
+		PartitionAutoScalingMaxBacklog     func() int
+		PartitionAutoScalingInterval       func() time.Duration
+		PartitionAutoScalingScaleDownDelay func() time.Duration
+
 		// partition qps = AdminNamespaceToPartitionDispatchRate(namespace)
 		AdminNamespaceToPartitionDispatchRate func() float64
 		AdminNamespaceToPartitionRateSub      func(func(float64)) (float64, func())
@@ -240,6 +260,7 @@ func NewConfig(
 		EnableFairness:                           dynamicconfig.MatchingEnableFairness.Subscribe(dc),
 		GetTasksBatchSize:                        dynamicconfig.MatchingGetTasksBatchSize.Get(dc),
 		GetTasksReloadAt:                         dynamicconfig.MatchingGetTasksReloadAt.Get(dc),
+		MaxUnroutableLoadedTasks:                 dynamicconfig.MatchingMaxUnroutableLoadedTasks.Get(dc),
 		UpdateAckInterval:                        dynamicconfig.MatchingUpdateAckInterval.Get(dc),
 		MaxTaskQueueIdleTime:                     dynamicconfig.MatchingMaxTaskQueueIdleTime.Get(dc),
 		LongPollExpirationInterval:               dynamicconfig.MatchingLongPollExpirationInterval.Get(dc),
@@ -252,6 +273,12 @@ func NewConfig(
 		ThrottledLogRPS:                          dynamicconfig.MatchingThrottledLogRPS.Get(dc),
 		NumTaskqueueWritePartitions:              dynamicconfig.MatchingNumTaskqueueWritePartitions.Get(dc),
 		NumTaskqueueReadPartitions:               dynamicconfig.MatchingNumTaskqueueReadPartitions.Get(dc),
+		EnablePartitionAutoScaling:               dynamicconfig.MatchingEnablePartitionAutoScaling.Get(dc),
+		PartitionAutoScalingMinPartitions:        dynamicconfig.MatchingPartitionAutoScalingMinPartitions.Get(dc),
+		PartitionAutoScalingTargetRate:           dynamicconfig.MatchingPartitionAutoScalingTargetRate.Get(dc),
+		PartitionAutoScalingMaxBacklog:           dynamicconfig.MatchingPartitionAutoScalingMaxBacklogPerPartition.Get(dc),
+		PartitionAutoScalingInterval:             dynamicconfig.MatchingPartitionAutoScalingInterval.Get(dc),
+		PartitionAutoScalingScaleDownDelay:       dynamicconfig.MatchingPartitionAutoScalingScaleDownDelay.Get(dc),
 		BreakdownMetricsByTaskQueue:              dynamicconfig.MetricsBreakdownByTaskQueue.Get(dc),
 		BreakdownMetricsByPartition:              dynamicconfig.MetricsBreakdownByPartition.Get(dc),
 		BreakdownMetricsByBuildID:                dynamicconfig.MetricsBreakdownByBuildID.Get(dc),
@@ -308,6 +335,10 @@ func NewConfig(
 		FairnessCounter: dynamicconfig.MatchingFairnessCounter.Get(dc),
 
 		LogAllReqErrors: dynamicconfig.LogAllReqErrors.Get(dc),
+
+		WorkerRegistryPersistenceEnabled: dynamicconfig.MatchingWorkerRegistryPersistenceEnabled.Get(dc),
+		WorkerRegistryFlushInterval:      dynamicconfig.MatchingWorkerRegistryFlushInterval.Get(dc),
+		WorkerRegistryRefreshInterval:    dynamicconfig.MatchingWorkerRegistryRefreshInterval.Get(dc),
 	}
 }
 
@@ -329,6 +360,9 @@ func newTaskQueueConfig(tq *tqid.TaskQueue, config *Config, ns namespace.Name) *
 		GetTasksReloadAt: func() int {
 			return config.GetTasksReloadAt(ns.String(), taskQueueName, taskType)
 		},
+		MaxUnroutableLoadedTasks: func() int {
+			return config.MaxUnroutableLoadedTasks(ns.String(), taskQueueName, taskType)
+		},
 		UpdateAckInterval: func() time.Duration {
 			return config.UpdateAckInterval(ns.String(), taskQueueName, taskType)
 		},
@@ -380,6 +414,24 @@ func newTaskQueueConfig(tq *tqid.TaskQueue, config *Config, ns namespace.Name) *
 		NumReadPartitions: func() int {
 			return max(1, config.NumTaskqueueReadPartitions(ns.String(), taskQueueName, taskType))
 		},
+		EnablePartitionAutoScaling: func() bool {
+			return config.EnablePartitionAutoScaling(ns.String(), taskQueueName, taskType)
+		},
+		PartitionAutoScalingMinPartitions: func() int {
+			return max(1, config.PartitionAutoScalingMinPartitions(ns.String(), taskQueueName, taskType))
+		},
+		PartitionAutoScalingTargetRate: func() float64 {
+			return config.PartitionAutoScalingTargetRate(ns.String(), taskQueueName, taskType)
+		},
+		PartitionAutoScalingMaxBacklog: func() int {
+			return config.PartitionAutoScalingMaxBacklog(ns.String(), taskQueueName, taskType)
+		},
+		PartitionAutoScalingInterval: func() time.Duration {
+			return config.PartitionAutoScalingInterval(ns.String(), taskQueueName, taskType)
+		},
+		PartitionAutoScalingScaleDownDelay: func() time.Duration {
+			return config.PartitionAutoScalingScaleDownDelay(ns.String(), taskQueueName, taskType)
+		},
 		BreakdownMetricsByTaskQueue: func() bool {
 			return config.BreakdownMetricsByTaskQueue(ns.String(), taskQueueName, taskType)
 		},
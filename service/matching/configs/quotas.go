@@ -49,6 +49,10 @@ var (
 		"/temporal.server.api.matchingservice.v1.MatchingService/RecordWorkerHeartbeat":                  1,
 		"/temporal.server.api.matchingservice.v1.MatchingService/ListWorkers":                            1,
 		"/temporal.server.api.matchingservice.v1.MatchingService/UpdateTaskQueueConfig":                  1,
+		"/temporal.server.api.matchingservice.v1.MatchingService/UpdateTaskQueueDispatchPause":           1,
+		"/temporal.server.api.matchingservice.v1.MatchingService/ListTaskQueueBacklogTasks":              1,
+		"/temporal.server.api.matchingservice.v1.MatchingService/DeleteTaskQueueBacklogTasks":            1,
+		"/temporal.server.api.matchingservice.v1.MatchingService/MoveTaskQueueBacklogTasks":              1,
 		"/temporal.server.api.matchingservice.v1.MatchingService/DescribeWorker":                         1,
 	}
 
@@ -6,6 +6,7 @@ import (
 	"math"
 	"slices"
 	"sync"
+	"sync/atomic"
 	"time"
 
 	enumspb "go.temporal.io/api/enums/v1"
@@ -44,6 +45,11 @@ type (
 		// used to avoid unnecessary metadata writes:
 		lastChange time.Time // updated when metadata is changed in memory
 		lastWrite  time.Time // updated when metadata is successfully written to db
+
+		// ids of tasks deleted by an operator, from the subqueues' DeletedTasks (nil if none)
+		deletedTaskIDs atomic.Pointer[map[int64]struct{}]
+		// room reserved for tasks which are moved to another task queue and deleted once moved
+		reservedDeletedTasks int
 	}
 
 	dbSubqueue struct {
@@ -175,6 +181,7 @@ func (db *taskQueueDB) takeOverTaskQueueLocked(
 			response.TaskQueueInfo.AckLevel,
 			response.TaskQueueInfo.ApproximateBacklogCount,
 		)
+		db.refreshDeletedTasksLocked()
 		err := db.updateTaskQueueLocked(ctx, true)
 		if err != nil {
 			db.rangeID = 0
@@ -250,6 +257,7 @@ func (db *taskQueueDB) OldUpdateState(
 
 	prevAckLevel := db.subqueues[subqueueZero].AckLevel
 	db.subqueues[subqueueZero].AckLevel = ackLevel
+	db.pruneDeletedTasksLocked(subqueueZero)
 
 	err := db.updateTaskQueueLocked(ctx, false)
 	if err != nil {
@@ -288,6 +296,7 @@ func (db *taskQueueDB) updateAckLevelAndBacklogStats(subqueue subqueueIndex, new
 	if dbQueue.AckLevel != newAckLevel {
 		db.lastChange = time.Now()
 		dbQueue.AckLevel = newAckLevel
+		db.pruneDeletedTasksLocked(subqueue)
 	}
 
 	if newAckLevel == db.getMaxReadLevelLocked(subqueue) {
@@ -315,6 +324,7 @@ func (db *taskQueueDB) updateFairAckLevel(subqueue subqueueIndex, newAckLevel fa
 				subqueue, prev, newAckLevel))
 	}
 	dbQueue.FairAckLevel = newAckLevel.toProto()
+	db.pruneDeletedTasksLocked(subqueue)
 
 	if knownCount >= 0 {
 		// Reset approximateBacklogCount to fix the count divergence issue
@@ -0,0 +1,120 @@
+package matching
+
+import (
+	"fmt"
+	"slices"
+
+	"go.temporal.io/api/serviceerror"
+	persistencespb "go.temporal.io/server/api/persistence/v1"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+type (
+	// dispatchPause is the dispatch pause state of a task queue type, derived from its user data. A nil
+	// dispatchPause means that dispatch is not paused.
+	dispatchPause struct {
+		allPriorities      bool
+		priorityKeys       map[priorityKey]struct{}
+		defaultPriorityKey priorityKey
+	}
+)
+
+var errResumeSomePrioritiesOfAllPaused = serviceerror.NewInvalidArgument(
+	"dispatch of all priority levels is paused, resume all priority levels instead")
+
+func newDispatchPause(state *persistencespb.TaskQueueDispatchPause, defaultPriorityKey priorityKey) *dispatchPause {
+	if !state.GetAllPriorities() && len(state.GetPriorityKeys()) == 0 {
+		return nil
+	}
+	p := &dispatchPause{
+		allPriorities:      state.GetAllPriorities(),
+		priorityKeys:       make(map[priorityKey]struct{}, len(state.GetPriorityKeys())),
+		defaultPriorityKey: defaultPriorityKey,
+	}
+	for _, key := range state.GetPriorityKeys() {
+		p.priorityKeys[priorityKey(key)] = struct{}{}
+	}
+	return p
+}
+
+// isPaused returns true if the task must not be dispatched to pollers. Queries and forwarded polls are never paused
+// since they are not backlogged.
+func (p *dispatchPause) isPaused(task *internalTask) bool {
+	if p == nil || task.isQuery() || task.isPollForwarder {
+		return false
+	}
+	if p.allPriorities {
+		return true
+	}
+	key := priorityKey(task.getPriority().GetPriorityKey())
+	if key == 0 {
+		key = p.defaultPriorityKey
+	}
+	_, ok := p.priorityKeys[key]
+	return ok
+}
+
+// pausesAllPriorities returns true if dispatch of all priority levels is paused.
+func (p *dispatchPause) pausesAllPriorities() bool {
+	return p != nil && p.allPriorities
+}
+
+// updateDispatchPauseState returns the dispatch pause state after pausing or resuming the given priority keys, or all
+// priority levels if no keys are given. It returns nil if nothing remains paused.
+func updateDispatchPauseState(
+	state *persistencespb.TaskQueueDispatchPause,
+	pause bool,
+	keys []int32,
+	reason string,
+	identity string,
+	updateTime *timestamppb.Timestamp,
+) (*persistencespb.TaskQueueDispatchPause, error) {
+	var newState *persistencespb.TaskQueueDispatchPause
+	switch {
+	case pause && len(keys) == 0:
+		newState = &persistencespb.TaskQueueDispatchPause{AllPriorities: true}
+	case pause:
+		if state.GetAllPriorities() {
+			return state, nil
+		}
+		merged := slices.Clone(state.GetPriorityKeys())
+		for _, key := range keys {
+			if !slices.Contains(merged, key) {
+				merged = append(merged, key)
+			}
+		}
+		slices.Sort(merged)
+		newState = &persistencespb.TaskQueueDispatchPause{PriorityKeys: merged}
+	case len(keys) == 0:
+		return nil, nil
+	default:
+		if state.GetAllPriorities() {
+			return nil, errResumeSomePrioritiesOfAllPaused
+		}
+		remaining := slices.DeleteFunc(slices.Clone(state.GetPriorityKeys()), func(key int32) bool {
+			return slices.Contains(keys, key)
+		})
+		if len(remaining) == 0 {
+			return nil, nil
+		}
+		newState = &persistencespb.TaskQueueDispatchPause{PriorityKeys: remaining}
+	}
+	if pause {
+		newState.Reason = reason
+	} else {
+		newState.Reason = state.GetReason()
+	}
+	newState.Identity = identity
+	newState.UpdateTime = updateTime
+	return newState, nil
+}
+
+func validateDispatchPausePriorityKeys(keys []int32, priorityLevels priorityKey) error {
+	for _, key := range keys {
+		if key < 1 || priorityKey(key) > priorityLevels {
+			return serviceerror.NewInvalidArgument(
+				fmt.Sprintf("priority key %d is out of range, must be between 1 and %d", key, priorityLevels))
+		}
+	}
+	return nil
+}
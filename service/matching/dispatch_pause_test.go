@@ -0,0 +1,88 @@
+package matching
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	commonpb "go.temporal.io/api/common/v1"
+	"go.temporal.io/api/serviceerror"
+	"go.temporal.io/server/api/matchingservice/v1"
+	persistencespb "go.temporal.io/server/api/persistence/v1"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+func TestDispatchPause_IsPaused(t *testing.T) {
+	t.Parallel()
+
+	taskWithPriority := func(key int32) *internalTask {
+		return newInternalTaskForSyncMatch(&persistencespb.TaskInfo{
+			Priority: &commonpb.Priority{PriorityKey: key},
+		}, nil)
+	}
+
+	var notPaused *dispatchPause
+	require.False(t, notPaused.isPaused(taskWithPriority(1)))
+	require.Nil(t, newDispatchPause(&persistencespb.TaskQueueDispatchPause{}, 3))
+
+	all := newDispatchPause(&persistencespb.TaskQueueDispatchPause{AllPriorities: true}, 3)
+	require.True(t, all.isPaused(taskWithPriority(1)))
+	require.True(t, all.isPaused(taskWithPriority(0)))
+	require.False(t, all.isPaused(newInternalQueryTask("query", &matchingservice.QueryWorkflowRequest{})))
+
+	some := newDispatchPause(&persistencespb.TaskQueueDispatchPause{PriorityKeys: []int32{1, 2}}, 2)
+	require.True(t, some.isPaused(taskWithPriority(1)))
+	require.True(t, some.isPaused(taskWithPriority(0)), "tasks without priority key have the default priority")
+	require.False(t, some.isPaused(taskWithPriority(3)))
+}
+
+func TestUpdateDispatchPauseState(t *testing.T) {
+	t.Parallel()
+	now := timestamppb.Now()
+
+	state, err := updateDispatchPauseState(nil, true, []int32{3, 1}, "outage", "me", now)
+	require.NoError(t, err)
+	require.Equal(t, []int32{1, 3}, state.GetPriorityKeys())
+	require.False(t, state.GetAllPriorities())
+	require.Equal(t, "outage", state.GetReason())
+	require.Equal(t, "me", state.GetIdentity())
+
+	state, err = updateDispatchPauseState(state, true, []int32{2, 3}, "outage", "me", now)
+	require.NoError(t, err)
+	require.Equal(t, []int32{1, 2, 3}, state.GetPriorityKeys())
+
+	state, err = updateDispatchPauseState(state, false, []int32{1, 3}, "", "you", now)
+	require.NoError(t, err)
+	require.Equal(t, []int32{2}, state.GetPriorityKeys())
+	require.Equal(t, "outage", state.GetReason(), "resuming keeps the reason of the remaining pause")
+	require.Equal(t, "you", state.GetIdentity())
+
+	state, err = updateDispatchPauseState(state, false, []int32{2}, "", "you", now)
+	require.NoError(t, err)
+	require.Nil(t, state)
+
+	state, err = updateDispatchPauseState(nil, true, nil, "outage", "me", now)
+	require.NoError(t, err)
+	require.True(t, state.GetAllPriorities())
+	require.Empty(t, state.GetPriorityKeys())
+
+	// pausing some priority levels while all are paused is a no-op
+	unchanged, err := updateDispatchPauseState(state, true, []int32{1}, "other", "you", now)
+	require.NoError(t, err)
+	require.Equal(t, state, unchanged)
+
+	_, err = updateDispatchPauseState(state, false, []int32{1}, "", "you", now)
+	var invalidArgument *serviceerror.InvalidArgument
+	require.ErrorAs(t, err, &invalidArgument)
+
+	state, err = updateDispatchPauseState(state, false, nil, "", "you", now)
+	require.NoError(t, err)
+	require.Nil(t, state)
+}
+
+func TestValidateDispatchPausePriorityKeys(t *testing.T) {
+	t.Parallel()
+
+	require.NoError(t, validateDispatchPausePriorityKeys([]int32{1, 5}, 5))
+	require.Error(t, validateDispatchPausePriorityKeys([]int32{0}, 5))
+	require.Error(t, validateDispatchPausePriorityKeys([]int32{6}, 5))
+}
@@ -189,7 +189,7 @@ func (tr *fairTaskReader) shouldReadMoreLocked() bool {
 	if tr.atEnd {
 		// If we have the whole backlog in memory, we don't need to read anything.
 		return false
-	} else if tr.loadedTasks > tr.backlogMgr.config.GetTasksReloadAt() {
+	} else if tr.loadedTasks-tr.backlogMgr.pqMgr.UnroutableBacklogTasks() > tr.backlogMgr.config.GetTasksReloadAt() {
 		// Too many loaded already. We'll get called again when loadedTasks drops.
 		return false
 	}
@@ -402,8 +402,9 @@ func (tr *fairTaskReader) mergeTasksLocked(tasks []*persistencespb.AllocatedTask
 	}
 
 	// Take as many of those as we want to keep in memory. The ones that are not already in the
-	// matcher, we have to add to the matcher.
-	batchSize := tr.backlogMgr.config.GetTasksBatchSize()
+	// matcher, we have to add to the matcher. Tasks that no poller can take don't count.
+	// Lock order: task reader lock < matcher lock so this is okay.
+	batchSize := tr.backlogMgr.config.GetTasksBatchSize() + tr.backlogMgr.pqMgr.UnroutableBacklogTasks()
 	it := merged.Iterator()
 	var highestLevel fairLevel
 	tasks = tasks[:0] // reuse incoming slice to avoid an allocation
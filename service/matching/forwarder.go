@@ -149,6 +149,7 @@ func (fwdr *Forwarder) ForwardTask(ctx context.Context, task *internalTask) erro
 				Stamp:                  task.event.Data.GetStamp(),
 				VersionDirective:       task.event.Data.GetVersionDirective(),
 				Priority:               task.event.Data.GetPriority(),
+				WorkerSelector:         task.event.Data.GetWorkerSelector(),
 			},
 		)
 	default:
@@ -268,6 +269,7 @@ func (fwdr *Forwarder) ForwardPoll(ctx context.Context, pollMetadata *pollMetada
 				DeploymentOptions:         pollMetadata.deploymentOptions,
 			},
 			ForwardedSource: fwdr.partition.RpcName(),
+			WorkerLabels:    pollMetadata.workerLabels,
 		})
 		if err != nil {
 			return nil, fwdr.handleErr(err)
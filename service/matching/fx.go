@@ -39,7 +39,7 @@ var Module = fx.Options(
 	fx.Provide(TelemetryInterceptorProvider),
 	fx.Provide(RateLimitInterceptorProvider),
 	fx.Provide(VisibilityManagerProvider),
-	fx.Provide(workers.NewRegistry),
+	fx.Provide(WorkersRegistryProvider),
 	fx.Provide(NewHandler),
 	fx.Provide(service.GrpcServerOptionsProvider),
 	fx.Provide(NamespaceReplicationQueueProvider),
@@ -49,6 +49,26 @@ var Module = fx.Options(
 	fx.Invoke(ServiceLifetimeHooks),
 )
 
+func WorkersRegistryProvider(
+	lc fx.Lifecycle,
+	serviceConfig *Config,
+	workerHeartbeatManager persistence.WorkerHeartbeatManager,
+	hostInfoProvider membership.HostInfoProvider,
+	logger log.Logger,
+) workers.Registry {
+	return workers.NewRegistry(
+		lc,
+		workers.PersistenceConfig{
+			Enabled:         serviceConfig.WorkerRegistryPersistenceEnabled,
+			FlushInterval:   serviceConfig.WorkerRegistryFlushInterval,
+			RefreshInterval: serviceConfig.WorkerRegistryRefreshInterval,
+		},
+		workerHeartbeatManager,
+		hostInfoProvider,
+		logger,
+	)
+}
+
 func ServerProvider(grpcServerOptions []grpc.ServerOption) *grpc.Server {
 	return grpc.NewServer(grpcServerOptions...)
 }
@@ -173,7 +173,10 @@ func (h *Handler) AddActivityTask(
 	if syncMatch {
 		metrics.SyncMatchLatencyPerTaskQueue.With(opMetrics).Record(time.Since(startT))
 	}
-	return &matchingservice.AddActivityTaskResponse{AssignedBuildId: assignedBuildId}, err
+	return &matchingservice.AddActivityTaskResponse{
+		AssignedBuildId: assignedBuildId,
+		PartitionCounts: h.engine.ScaledPartitionCounts(request.GetNamespaceId(), request.GetTaskQueue(), enumspb.TASK_QUEUE_TYPE_ACTIVITY),
+	}, err
 }
 
 // AddWorkflowTask - adds a workflow task.
@@ -198,7 +201,10 @@ func (h *Handler) AddWorkflowTask(
 	if syncMatch {
 		metrics.SyncMatchLatencyPerTaskQueue.With(opMetrics).Record(time.Since(startT))
 	}
-	return &matchingservice.AddWorkflowTaskResponse{AssignedBuildId: assignedBuildId}, err
+	return &matchingservice.AddWorkflowTaskResponse{
+		AssignedBuildId: assignedBuildId,
+		PartitionCounts: h.engine.ScaledPartitionCounts(request.GetNamespaceId(), request.GetTaskQueue(), enumspb.TASK_QUEUE_TYPE_WORKFLOW),
+	}, err
 }
 
 // PollActivityTaskQueue - long poll for an activity task.
@@ -226,7 +232,18 @@ func (h *Handler) PollActivityTaskQueue(
 		return nil, err
 	}
 
-	return h.engine.PollActivityTaskQueue(ctx, request, opMetrics)
+	resp, err := h.engine.PollActivityTaskQueue(ctx, request, opMetrics)
+	if err != nil {
+		return nil, err
+	}
+	if counts := h.engine.ScaledPartitionCounts(request.GetNamespaceId(), request.GetPollRequest().GetTaskQueue(), enumspb.TASK_QUEUE_TYPE_ACTIVITY); counts != nil {
+		// the empty response is shared
+		if resp == emptyPollActivityTaskQueueResponse {
+			resp = &matchingservice.PollActivityTaskQueueResponse{}
+		}
+		resp.PartitionCounts = counts
+	}
+	return resp, nil
 }
 
 // PollWorkflowTaskQueue - long poll for a workflow task.
@@ -254,7 +271,18 @@ func (h *Handler) PollWorkflowTaskQueue(
 		return nil, err
 	}
 
-	return h.engine.PollWorkflowTaskQueue(ctx, request, opMetrics)
+	resp, err := h.engine.PollWorkflowTaskQueue(ctx, request, opMetrics)
+	if err != nil {
+		return nil, err
+	}
+	if counts := h.engine.ScaledPartitionCounts(request.GetNamespaceId(), request.GetPollRequest().GetTaskQueue(), enumspb.TASK_QUEUE_TYPE_WORKFLOW); counts != nil {
+		// the empty response is shared
+		if resp == emptyPollWorkflowTaskQueueResponse {
+			resp = &matchingservice.PollWorkflowTaskQueueResponse{}
+		}
+		resp.PartitionCounts = counts
+	}
+	return resp, nil
 }
 
 // QueryWorkflow queries a given workflow synchronously and return the query result.
@@ -559,11 +587,16 @@ func (h *Handler) RecordWorkerHeartbeat(
 
 // ListWorkers retrieves a list of workers in the specified namespace that match the provided filters.
 func (h *Handler) ListWorkers(
-	_ context.Context, request *matchingservice.ListWorkersRequest,
+	ctx context.Context, request *matchingservice.ListWorkersRequest,
 ) (*matchingservice.ListWorkersResponse, error) {
 	nsID := namespace.ID(request.GetNamespaceId())
-	workersHeartbeats, err := h.workersRegistry.ListWorkers(
-		nsID, request.GetListRequest().GetQuery(), request.GetListRequest().GetNextPageToken())
+	workersHeartbeats, nextPageToken, err := h.workersRegistry.ListWorkers(
+		ctx,
+		nsID,
+		request.GetListRequest().GetQuery(),
+		int(request.GetListRequest().GetPageSize()),
+		request.GetListRequest().GetNextPageToken(),
+	)
 	if err != nil {
 		return nil, err
 	}
@@ -574,7 +607,8 @@ func (h *Handler) ListWorkers(
 		})
 	}
 	return &matchingservice.ListWorkersResponse{
-		WorkersInfo: workersInfo,
+		WorkersInfo:   workersInfo,
+		NextPageToken: nextPageToken,
 	}, nil
 }
 
@@ -602,12 +636,36 @@ func (h *Handler) UpdateTaskQueueConfig(
 	return h.engine.UpdateTaskQueueConfig(ctx, request)
 }
 
+func (h *Handler) UpdateTaskQueueDispatchPause(
+	ctx context.Context, request *matchingservice.UpdateTaskQueueDispatchPauseRequest,
+) (*matchingservice.UpdateTaskQueueDispatchPauseResponse, error) {
+	return h.engine.UpdateTaskQueueDispatchPause(ctx, request)
+}
+
+func (h *Handler) ListTaskQueueBacklogTasks(
+	ctx context.Context, request *matchingservice.ListTaskQueueBacklogTasksRequest,
+) (*matchingservice.ListTaskQueueBacklogTasksResponse, error) {
+	return h.engine.ListTaskQueueBacklogTasks(ctx, request)
+}
+
+func (h *Handler) DeleteTaskQueueBacklogTasks(
+	ctx context.Context, request *matchingservice.DeleteTaskQueueBacklogTasksRequest,
+) (*matchingservice.DeleteTaskQueueBacklogTasksResponse, error) {
+	return h.engine.DeleteTaskQueueBacklogTasks(ctx, request)
+}
+
+func (h *Handler) MoveTaskQueueBacklogTasks(
+	ctx context.Context, request *matchingservice.MoveTaskQueueBacklogTasksRequest,
+) (*matchingservice.MoveTaskQueueBacklogTasksResponse, error) {
+	return h.engine.MoveTaskQueueBacklogTasks(ctx, request)
+}
+
 func (h *Handler) DescribeWorker(
-	_ context.Context, request *matchingservice.DescribeWorkerRequest,
+	ctx context.Context, request *matchingservice.DescribeWorkerRequest,
 ) (*matchingservice.DescribeWorkerResponse, error) {
 	nsID := namespace.ID(request.GetNamespaceId())
 	hb, err := h.workersRegistry.DescribeWorker(
-		nsID, request.Request.GetWorkerInstanceKey())
+		ctx, nsID, request.Request.GetWorkerInstanceKey())
 	if err != nil {
 		return nil, err
 	}
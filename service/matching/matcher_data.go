@@ -3,7 +3,9 @@ package matching
 import (
 	"container/heap"
 	"context"
+	"maps"
 	"slices"
+	"strings"
 	"sync"
 	"time"
 
@@ -13,6 +15,7 @@ import (
 	"go.temporal.io/server/common/log"
 	"go.temporal.io/server/common/softassert"
 	"go.temporal.io/server/common/util"
+	"go.temporal.io/server/common/workerlabels"
 )
 
 const invalidHeapIndex = -13 // use unusual value to stand out in panics
@@ -99,6 +102,18 @@ type taskPQ struct {
 	perKeyLimit     simpleLimiterParams
 	perKeyOverrides fairnessWeightOverrides // TODO(fairness): get this from config
 	perKeyReady     cache.Cache
+
+	// labels of recent pollers by their canonical encoding, with the last poll start time
+	pollerLabels map[string]recentPollerLabels
+	// earliest time at which one of pollerLabels may expire, zero if there are none
+	pollerLabelsExpiry time.Time
+	// number of tasks from local backlogs whose worker selector none of pollerLabels satisfies
+	unroutable int
+}
+
+type recentPollerLabels struct {
+	labels   map[string]string
+	lastPoll time.Time
 }
 
 func (t *taskPQ) Add(task *internalTask) {
@@ -219,6 +234,10 @@ func (t *taskPQ) Push(x any) {
 
 	if task.source == enumsspb.TASK_SOURCE_DB_BACKLOG && task.forwardInfo == nil {
 		t.ages.record(task.event.Data.CreateTime, 1)
+		if selector := task.workerSelector(); len(selector) > 0 && !t.isRoutable(selector) {
+			task.unroutable = true
+			t.unroutable++
+		}
 	}
 }
 
@@ -232,6 +251,7 @@ func (t *taskPQ) Pop() any {
 	if task.source == enumsspb.TASK_SOURCE_DB_BACKLOG && task.forwardInfo == nil {
 		t.ages.record(task.event.Data.CreateTime, -1)
 	}
+	t.forgetUnroutable(task)
 
 	return task
 }
@@ -248,6 +268,7 @@ func (t *taskPQ) ForEachTask(pred func(*internalTask) bool, post func(*internalT
 		if task.source == enumsspb.TASK_SOURCE_DB_BACKLOG && task.forwardInfo == nil {
 			t.ages.record(task.event.Data.CreateTime, -1)
 		}
+		t.forgetUnroutable(task)
 		post(task)
 		return true
 	})
@@ -258,6 +279,84 @@ func (t *taskPQ) ForEachTask(pred func(*internalTask) bool, post func(*internalT
 	heap.Init(t)
 }
 
+func (t *taskPQ) forgetUnroutable(task *internalTask) {
+	if task.unroutable {
+		task.unroutable = false
+		t.unroutable--
+	}
+}
+
+func (t *taskPQ) isRoutable(selector map[string]string) bool {
+	for _, recent := range t.pollerLabels {
+		if workerlabels.Matches(selector, recent.labels) {
+			return true
+		}
+	}
+	return false
+}
+
+// recordPollerLabels remembers the labels of a poller for ttl, tasks that only these labels satisfy are no longer
+// counted as unroutable.
+func (t *taskPQ) recordPollerLabels(labels map[string]string, pollTime time.Time, ttl time.Duration) {
+	keys := slices.Sorted(maps.Keys(labels))
+	var b strings.Builder
+	for _, k := range keys {
+		b.WriteString(k)
+		b.WriteByte('=')
+		b.WriteString(labels[k])
+		b.WriteByte(',')
+	}
+	if t.pollerLabels == nil {
+		t.pollerLabels = make(map[string]recentPollerLabels)
+	}
+	_, known := t.pollerLabels[b.String()]
+	t.pollerLabels[b.String()] = recentPollerLabels{labels: labels, lastPoll: pollTime}
+	if expiry := pollTime.Add(ttl); t.pollerLabelsExpiry.IsZero() || expiry.Before(t.pollerLabelsExpiry) {
+		t.pollerLabelsExpiry = expiry
+	}
+
+	if known || t.unroutable == 0 {
+		return
+	}
+	for _, task := range t.heap {
+		if task.unroutable && workerlabels.Matches(task.workerSelector(), labels) {
+			t.forgetUnroutable(task)
+		}
+	}
+}
+
+// expirePollerLabels forgets the labels of pollers that were not seen within ttl, tasks that only these labels
+// satisfied are counted as unroutable again.
+func (t *taskPQ) expirePollerLabels(now time.Time, ttl time.Duration) {
+	if t.pollerLabelsExpiry.IsZero() || now.Before(t.pollerLabelsExpiry) {
+		return
+	}
+	cutoff := now.Add(-ttl)
+	expired := false
+	t.pollerLabelsExpiry = time.Time{}
+	for key, recent := range t.pollerLabels {
+		if recent.lastPoll.Before(cutoff) {
+			delete(t.pollerLabels, key)
+			expired = true
+		} else if expiry := recent.lastPoll.Add(ttl); t.pollerLabelsExpiry.IsZero() || expiry.Before(t.pollerLabelsExpiry) {
+			t.pollerLabelsExpiry = expiry
+		}
+	}
+
+	if !expired {
+		return
+	}
+	for _, task := range t.heap {
+		if task.unroutable || task.source != enumsspb.TASK_SOURCE_DB_BACKLOG || task.forwardInfo != nil {
+			continue
+		}
+		if selector := task.workerSelector(); len(selector) > 0 && !t.isRoutable(selector) {
+			task.unroutable = true
+			t.unroutable++
+		}
+	}
+}
+
 type matcherData struct {
 	config     *taskQueueConfig
 	logger     log.Logger
@@ -275,6 +374,8 @@ type matcherData struct {
 	tasks   taskPQ
 
 	lastPoller time.Time // most recent poll start time
+
+	dispatchPause *dispatchPause // tasks paused by an operator are not matched
 }
 
 func newMatcherData(config *taskQueueConfig, logger log.Logger, timeSource clock.TimeSource, canForward bool) matcherData {
@@ -332,6 +433,15 @@ func (d *matcherData) UpdatePerKeyRateLimit(rate float64, burstDuration time.Dur
 	}
 }
 
+func (d *matcherData) UpdateDispatchPause(pause *dispatchPause) {
+	d.lock.Lock()
+	defer d.lock.Unlock()
+
+	d.dispatchPause = pause
+	// Tasks which are no longer paused may be matched now.
+	d.findAndWakeMatches()
+}
+
 func (d *matcherData) EnqueueTaskNoWait(task *internalTask) {
 	d.lock.Lock()
 	defer d.lock.Unlock()
@@ -397,6 +507,9 @@ func (d *matcherData) EnqueuePollerAndWait(ctxs []context.Context, poller *waiti
 
 	// update this for timeSinceLastPoll
 	d.lastPoller = util.MaxTime(d.lastPoller, poller.startTime)
+	if !poller.isTaskForwarder {
+		d.tasks.recordPollerLabels(poller.workerLabels, poller.startTime, d.config.PollerHistoryTTL())
+	}
 
 	// add and look for match
 	poller.initMatch(d)
@@ -481,6 +594,9 @@ func (d *matcherData) findMatch(allowForwarding bool) (*internalTask, *waitingPo
 		if !allowForwarding && task.isPollForwarder {
 			continue
 		}
+		if d.dispatchPause.isPaused(task) {
+			continue
+		}
 
 		for _, poller := range d.pollers.heap {
 			// can't match cases:
@@ -492,6 +608,9 @@ func (d *matcherData) findMatch(allowForwarding bool) (*internalTask, *waitingPo
 				continue
 			} else if poller.isTaskValidator && task.forwardCtx != nil {
 				continue
+			} else if !poller.isTaskForwarder && !workerlabels.Matches(task.workerSelector(), poller.workerLabels) {
+				// forwarders and validators are not workers, the task may find a matching poller after them
+				continue
 			}
 
 			return task, poller
@@ -602,6 +721,17 @@ func (d *matcherData) FinishMatchAfterPollForward(poller *waitingPoller, task *i
 	}
 }
 
+// UnroutableBacklogTasks returns the number of tasks from local backlogs whose worker selector is not satisfied by
+// the labels of any poller seen within the poller history TTL. These tasks may stay in the matcher for a long time, so
+// task readers don't count them toward the tasks they keep loaded.
+func (d *matcherData) UnroutableBacklogTasks() int {
+	d.lock.Lock()
+	defer d.lock.Unlock()
+
+	d.tasks.expirePollerLabels(d.timeSource.Now(), d.config.PollerHistoryTTL())
+	return d.tasks.unroutable
+}
+
 // isBacklogNegligible returns true if the age of the task backlog is less than the threshold.
 // call with lock held.
 func (d *matcherData) isBacklogNegligible() bool {
@@ -165,6 +165,79 @@ func (s *MatcherDataSuite) TestMatchBacklogTask() {
 	s.Equal(1, pres.ctxErrIdx, "deadline context was index 1")
 }
 
+func (s *MatcherDataSuite) TestDispatchPause() {
+	s.md.UpdateDispatchPause(newDispatchPause(&persistencespb.TaskQueueDispatchPause{PriorityKeys: []int32{1}}, 3))
+
+	paused := s.newBacklogTaskWithPriority(1, 0, nil, &commonpb.Priority{PriorityKey: 1})
+	s.md.EnqueueTaskNoWait(paused)
+	unpaused := s.newBacklogTask(2, 0, nil)
+	s.md.EnqueueTaskNoWait(unpaused)
+
+	// the higher priority task is paused, so the other one is matched
+	pres := s.pollRealTime(time.Millisecond)
+	s.NoError(pres.ctxErr)
+	s.Equal(unpaused, pres.task)
+
+	pres = s.pollRealTime(time.Millisecond)
+	s.Error(pres.ctxErr)
+
+	// a waiting poller gets the task once dispatch is resumed
+	go func() {
+		s.waitForPollers(1)
+		s.md.UpdateDispatchPause(nil)
+	}()
+	pres = s.pollRealTime(time.Second)
+	s.NoError(pres.ctxErr)
+	s.Equal(paused, pres.task)
+}
+
+func (s *MatcherDataSuite) TestWorkerSelector() {
+	newSelectedTask := func(id int64) *internalTask {
+		return newInternalTaskFromBacklog(&persistencespb.AllocatedTaskInfo{
+			Data: &persistencespb.TaskInfo{
+				CreateTime:     timestamppb.New(s.now()),
+				WorkerSelector: map[string]string{"region": "eu"},
+			},
+			TaskId: id,
+		}, nil)
+	}
+	selected := newSelectedTask(1)
+	s.md.EnqueueTaskNoWait(selected)
+
+	pollWithLabels := func(labels map[string]string) *matchResult {
+		ctx, cancel := context.WithTimeout(context.Background(), time.Millisecond)
+		defer cancel()
+		return s.md.EnqueuePollerAndWait([]context.Context{ctx}, &waitingPoller{
+			startTime:    s.now(),
+			forwardCtx:   ctx,
+			pollMetadata: &pollMetadata{},
+			workerLabels: labels,
+		})
+	}
+
+	// pollers without the selected labels don't get the task
+	s.Error(s.pollRealTime(time.Millisecond).ctxErr)
+	s.Error(pollWithLabels(map[string]string{"region": "us"}).ctxErr)
+	s.Equal(1, s.md.UnroutableBacklogTasks())
+
+	pres := pollWithLabels(map[string]string{"region": "eu", "gpu": "false"})
+	s.NoError(pres.ctxErr)
+	s.Equal(selected, pres.task)
+	s.Equal(0, s.md.UnroutableBacklogTasks())
+
+	// a task is routable while a poller with matching labels was seen recently
+	selected = newSelectedTask(2)
+	s.md.EnqueueTaskNoWait(selected)
+	s.Equal(0, s.md.UnroutableBacklogTasks())
+	s.ts.Advance(s.md.config.PollerHistoryTTL() + time.Second)
+	s.Equal(1, s.md.UnroutableBacklogTasks())
+
+	pres = pollWithLabels(map[string]string{"region": "eu"})
+	s.NoError(pres.ctxErr)
+	s.Equal(selected, pres.task)
+	s.Equal(0, s.md.UnroutableBacklogTasks())
+}
+
 func (s *MatcherDataSuite) TestMatchTaskImmediately() {
 	t := s.newSyncTask(nil)
 
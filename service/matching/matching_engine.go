@@ -58,6 +58,7 @@ import (
 	"go.temporal.io/server/service/history/api"
 	"go.temporal.io/server/service/worker/deployment"
 	"go.temporal.io/server/service/worker/workerdeployment"
+	"google.golang.org/protobuf/types/known/durationpb"
 	"google.golang.org/protobuf/types/known/timestamppb"
 )
 
@@ -88,6 +89,7 @@ type (
 		taskQueueMetadata         *taskqueuepb.TaskQueueMetadata
 		workerVersionCapabilities *commonpb.WorkerVersionCapabilities
 		deploymentOptions         *deploymentpb.WorkerDeploymentOptions
+		workerLabels              map[string]string
 		forwardedFrom             string
 		localPollStartTime        time.Time
 	}
@@ -465,6 +467,26 @@ func (e *matchingEngineImpl) getTaskQueuePartitionManager(
 	return newPM, true, nil
 }
 
+// ScaledPartitionCounts returns the partition counts chosen by partition auto-scaling for the task queue of the given
+// partition, or nil if the partition is not loaded or its task queue uses the configured counts.
+func (e *matchingEngineImpl) ScaledPartitionCounts(
+	namespaceID string,
+	taskQueue *taskqueuepb.TaskQueue,
+	taskType enumspb.TaskQueueType,
+) *taskqueuespb.TaskQueuePartitionCounts {
+	partition, err := tqid.PartitionFromProto(taskQueue, namespaceID, taskType)
+	if err != nil {
+		return nil
+	}
+	e.partitionsLock.RLock()
+	pm, ok := e.partitions[partition.Key()]
+	e.partitionsLock.RUnlock()
+	if !ok {
+		return nil
+	}
+	return pm.ScaledPartitionCounts()
+}
+
 func (e *matchingEngineImpl) loggerAndMetricsForPartition(
 	nsName namespace.Name,
 	partition tqid.Partition,
@@ -514,6 +536,13 @@ func (e *matchingEngineImpl) AddWorkflowTask(
 	} else if sticky && !stickyWorkerAvailable(pm) {
 		return "", false, serviceerrors.NewStickyWorkerUnavailable()
 	}
+	if target := pm.ScaledDownAddRedirect(addRequest.ForwardInfo); target != nil {
+		addRequest = common.CloneProto(addRequest)
+		addRequest.TaskQueue.Name = target.RpcName()
+		addRequest.ForwardInfo = scaledDownRedirectInfo(partition)
+		resp, err := e.matchingRawClient.AddWorkflowTask(ctx, addRequest)
+		return resp.GetAssignedBuildId(), false, err
+	}
 
 	// This needs to move to history see - https://go.temporal.io/server/issues/181
 	var expirationTime *timestamppb.Timestamp
@@ -536,7 +565,7 @@ func (e *matchingEngineImpl) AddWorkflowTask(
 
 	return pm.AddTask(ctx, addTaskParams{
 		taskInfo:    taskInfo,
-		forwardInfo: addRequest.ForwardInfo,
+		forwardInfo: addTaskForwardInfo(addRequest.ForwardInfo),
 	})
 }
 
@@ -553,6 +582,19 @@ func (e *matchingEngineImpl) AddActivityTask(
 	if err != nil {
 		return "", false, err
 	}
+	// The classic matcher would dispatch the task to any poller, so the task is rejected until the priority matcher
+	// is enabled for the task queue.
+	if len(addRequest.GetWorkerSelector()) > 0 &&
+		!e.usesPriorityMatcher(pm.Namespace().Name(), partition.TaskQueue().Name(), enumspb.TASK_QUEUE_TYPE_ACTIVITY) {
+		return "", false, serviceerror.NewInvalidArgument("activity tasks with a worker selector require the priority matcher")
+	}
+	if target := pm.ScaledDownAddRedirect(addRequest.ForwardInfo); target != nil {
+		addRequest = common.CloneProto(addRequest)
+		addRequest.TaskQueue.Name = target.RpcName()
+		addRequest.ForwardInfo = scaledDownRedirectInfo(partition)
+		resp, err := e.matchingRawClient.AddActivityTask(ctx, addRequest)
+		return resp.GetAssignedBuildId(), false, err
+	}
 
 	var expirationTime *timestamppb.Timestamp
 	now := time.Now().UTC()
@@ -571,11 +613,12 @@ func (e *matchingEngineImpl) AddActivityTask(
 		VersionDirective: addRequest.VersionDirective,
 		Stamp:            addRequest.Stamp,
 		Priority:         addRequest.Priority,
+		WorkerSelector:   addRequest.WorkerSelector,
 	}
 
 	return pm.AddTask(ctx, addTaskParams{
 		taskInfo:    taskInfo,
-		forwardInfo: addRequest.ForwardInfo,
+		forwardInfo: addTaskForwardInfo(addRequest.ForwardInfo),
 	})
 }
 
@@ -881,6 +924,7 @@ pollLoop:
 			taskQueueMetadata:         request.TaskQueueMetadata,
 			workerVersionCapabilities: request.WorkerVersionCapabilities,
 			deploymentOptions:         request.DeploymentOptions,
+			workerLabels:              req.GetWorkerLabels(),
 			forwardedFrom:             req.GetForwardedSource(),
 		}
 		task, versionSetUsed, err := e.pollTask(pollerCtx, partition, pollMetadata)
@@ -1372,6 +1416,13 @@ func (e *matchingEngineImpl) DescribeTaskQueue(
 		descrResp.DescResponse.Config = userData.GetData().GetPerType()[int32(req.GetTaskQueueType())].GetConfig()
 	}
 
+	userData, _, err := pm.GetUserDataManager().GetUserData()
+	if err != nil {
+		return nil, err
+	}
+	// The public API has no dispatch pause state, so it is only reported in the internal response.
+	descrResp.DispatchPause = userData.GetData().GetPerType()[int32(req.GetTaskQueueType())].GetDispatchPause()
+
 	effectiveRPS, sourceForEffectiveRPS := pm.GetRateLimitManager().GetEffectiveRPSAndSource()
 	descrResp.DescResponse.EffectiveRateLimit = &workflowservice.DescribeTaskQueueResponse_EffectiveRateLimit{
 		RequestsPerSecond: float32(effectiveRPS),
@@ -1451,15 +1502,26 @@ func (e *matchingEngineImpl) DescribeTaskQueuePartition(
 	if request.GetVersions() == nil {
 		return nil, serviceerror.NewInvalidArgument("versions must not be nil, to describe the default queue, pass the default build ID as a member of the BuildIds list")
 	}
-	pm, _, err := e.getTaskQueuePartitionManager(ctx, tqid.PartitionFromPartitionProto(request.GetTaskQueuePartition(), request.GetNamespaceId()), true, loadCauseDescribe)
+	pm, _, err := e.getTaskQueuePartitionManager(ctx, tqid.PartitionFromPartitionProto(request.GetTaskQueuePartition(), request.GetNamespaceId()), !request.GetOnlyIfLoaded(), loadCauseDescribe)
 	if err != nil {
 		return nil, err
+	} else if pm == nil {
+		return &matchingservice.DescribeTaskQueuePartitionResponse{}, nil
 	}
 	buildIds, err := e.getBuildIds(request.GetVersions())
 	if err != nil {
 		return nil, err
 	}
-	return pm.Describe(ctx, buildIds, request.GetVersions().GetAllActive(), request.GetReportStats(), request.GetReportPollers(), request.GetReportInternalTaskQueueStatus())
+	resp, err := pm.Describe(ctx, buildIds, request.GetVersions().GetAllActive(), request.GetReportStats(), request.GetReportPollers(), request.GetReportInternalTaskQueueStatus(), !request.GetOnlyIfLoaded())
+	if err != nil {
+		return nil, err
+	}
+	userData, _, err := pm.GetUserDataManager().GetUserData()
+	if err != nil {
+		return nil, err
+	}
+	resp.DispatchPause = userData.GetData().GetPerType()[int32(pm.Partition().TaskType())].GetDispatchPause()
+	return resp, nil
 }
 
 func (e *matchingEngineImpl) getBuildIds(versions *taskqueuepb.TaskQueueVersionSelection) (map[string]bool, error) {
@@ -2034,6 +2096,7 @@ func (e *matchingEngineImpl) ApplyTaskQueueUserDataReplicationEvent(
 			}
 			mergedUserData.PerType = req.GetUserData().GetPerType()
 		}
+		mergedUserData.PerType = keepLocalPartitionScaling(mergedUserData.GetPerType(), current.GetPerType())
 
 		for _, buildId := range buildIdsToRevive {
 			setIdx, buildIdIdx := worker_versioning.FindBuildId(mergedData, buildId)
@@ -3048,3 +3111,256 @@ func (e *matchingEngineImpl) UpdateTaskQueueConfig(ctx context.Context, request
 		UpdatedTaskqueueConfig: userData.GetData().GetPerType()[int32(taskQueueType)].GetConfig(),
 	}, nil
 }
+
+func (e *matchingEngineImpl) UpdateTaskQueueDispatchPause(
+	ctx context.Context,
+	request *matchingservice.UpdateTaskQueueDispatchPauseRequest,
+) (*matchingservice.UpdateTaskQueueDispatchPauseResponse, error) {
+	taskQueueFamily, err := tqid.NewTaskQueueFamily(request.GetNamespaceId(), request.GetTaskQueue())
+	if err != nil {
+		return nil, err
+	}
+	nsName, err := e.namespaceRegistry.GetNamespaceName(namespace.ID(request.GetNamespaceId()))
+	if err != nil {
+		return nil, err
+	}
+	taskQueueTypes := request.GetTaskQueueTypes()
+	if len(taskQueueTypes) == 0 {
+		taskQueueTypes = []enumspb.TaskQueueType{
+			enumspb.TASK_QUEUE_TYPE_WORKFLOW,
+			enumspb.TASK_QUEUE_TYPE_ACTIVITY,
+		}
+	}
+	for _, taskQueueType := range taskQueueTypes {
+		// Nexus tasks are never backlogged, so pausing their dispatch would only make them time out.
+		if taskQueueType != enumspb.TASK_QUEUE_TYPE_WORKFLOW && taskQueueType != enumspb.TASK_QUEUE_TYPE_ACTIVITY {
+			return nil, serviceerror.NewInvalidArgument(
+				fmt.Sprintf("dispatch can only be paused for workflow and activity task queues, got %v", taskQueueType))
+		}
+		priorityLevels := priorityKey(e.config.PriorityLevels(nsName.String(), request.GetTaskQueue(), taskQueueType))
+		if err := validateDispatchPausePriorityKeys(request.GetPriorityKeys(), priorityLevels); err != nil {
+			return nil, err
+		}
+		// The classic matcher dispatches the backlog in order, so it can only pause all priority levels.
+		if request.GetPause() && len(request.GetPriorityKeys()) > 0 &&
+			!e.usesPriorityMatcher(nsName, request.GetTaskQueue(), taskQueueType) {
+			return nil, serviceerror.NewInvalidArgument(
+				fmt.Sprintf("dispatch of %v tasks can only be paused for all priority levels since the priority matcher is not enabled", taskQueueType))
+		}
+	}
+
+	// Like configuration updates, the pause state is stored in the user data of the root workflow partition and
+	// propagates to all partitions of the task queue family.
+	tqm, _, err := e.getTaskQueuePartitionManager(ctx,
+		taskQueueFamily.TaskQueue(enumspb.TASK_QUEUE_TYPE_WORKFLOW).RootPartition(),
+		true, loadCauseOtherWrite)
+	if err != nil {
+		return nil, err
+	}
+	updateOptions := UserDataUpdateOptions{Source: "UpdateTaskQueueDispatchPause"}
+	_, err = tqm.GetUserDataManager().UpdateUserData(ctx, updateOptions,
+		func(tqud *persistencespb.TaskQueueUserData) (*persistencespb.TaskQueueUserData, bool, error) {
+			data := common.CloneProto(tqud)
+			if data == nil {
+				data = &persistencespb.TaskQueueUserData{}
+			}
+			if data.PerType == nil {
+				data.PerType = make(map[int32]*persistencespb.TaskQueueTypeUserData)
+			}
+			existingClock := data.Clock
+			if existingClock == nil {
+				existingClock = hlc.Zero(e.clusterMeta.GetClusterID())
+			}
+			now := hlc.Next(existingClock, e.timeSource)
+			for _, taskQueueType := range taskQueueTypes {
+				perType := data.PerType[int32(taskQueueType)]
+				if perType == nil {
+					perType = &persistencespb.TaskQueueTypeUserData{}
+					data.PerType[int32(taskQueueType)] = perType
+				}
+				pause, err := updateDispatchPauseState(
+					perType.GetDispatchPause(),
+					request.GetPause(),
+					request.GetPriorityKeys(),
+					request.GetReason(),
+					request.GetIdentity(),
+					hlc.ProtoTimestamp(now),
+				)
+				if err != nil {
+					return nil, false, err
+				}
+				perType.DispatchPause = pause
+			}
+			data.Clock = now
+			return data, true, nil
+		},
+	)
+	if err != nil {
+		return nil, err
+	}
+	userData, _, err := tqm.GetUserDataManager().GetUserData()
+	if err != nil {
+		return nil, err
+	}
+	resp := &matchingservice.UpdateTaskQueueDispatchPauseResponse{
+		DispatchPause: make(map[int32]*persistencespb.TaskQueueDispatchPause),
+	}
+	for tqType, perType := range userData.GetData().GetPerType() {
+		if perType.GetDispatchPause() != nil {
+			resp.DispatchPause[tqType] = perType.GetDispatchPause()
+		}
+	}
+	return resp, nil
+}
+
+func (e *matchingEngineImpl) ListTaskQueueBacklogTasks(
+	ctx context.Context,
+	request *matchingservice.ListTaskQueueBacklogTasksRequest,
+) (*matchingservice.ListTaskQueueBacklogTasksResponse, error) {
+	partition := tqid.PartitionFromPartitionProto(request.GetTaskQueuePartition(), request.GetNamespaceId())
+	pm, _, err := e.getTaskQueuePartitionManager(ctx, partition, true, loadCauseOtherRead)
+	if err != nil {
+		return nil, err
+	}
+	tasks, nextPageToken, err := pm.ListBacklogTasks(ctx, request.GetNextPageToken(), backlogTasksPageSize(request.GetPageSize()))
+	if err != nil {
+		return nil, err
+	}
+	now := e.timeSource.Now()
+	infos := make([]*taskqueuespb.BacklogTaskInfo, len(tasks))
+	for i, task := range tasks {
+		infos[i] = task.info(partition.TaskType(), now)
+	}
+	return &matchingservice.ListTaskQueueBacklogTasksResponse{
+		Tasks:         infos,
+		NextPageToken: nextPageToken,
+	}, nil
+}
+
+func (e *matchingEngineImpl) DeleteTaskQueueBacklogTasks(
+	ctx context.Context,
+	request *matchingservice.DeleteTaskQueueBacklogTasksRequest,
+) (*matchingservice.DeleteTaskQueueBacklogTasksResponse, error) {
+	if len(request.GetTasks()) == 0 {
+		return nil, serviceerror.NewInvalidArgument("no tasks to delete")
+	}
+	partition := tqid.PartitionFromPartitionProto(request.GetTaskQueuePartition(), request.GetNamespaceId())
+	pm, _, err := e.getTaskQueuePartitionManager(ctx, partition, true, loadCauseOtherWrite)
+	if err != nil {
+		return nil, err
+	}
+	if err := pm.DeleteBacklogTasks(ctx, request.GetTasks()); err != nil {
+		return nil, err
+	}
+	return &matchingservice.DeleteTaskQueueBacklogTasksResponse{}, nil
+}
+
+func (e *matchingEngineImpl) MoveTaskQueueBacklogTasks(
+	ctx context.Context,
+	request *matchingservice.MoveTaskQueueBacklogTasksRequest,
+) (*matchingservice.MoveTaskQueueBacklogTasksResponse, error) {
+	partition := tqid.PartitionFromPartitionProto(request.GetTaskQueuePartition(), request.GetNamespaceId())
+	taskType := partition.TaskType()
+	if taskType != enumspb.TASK_QUEUE_TYPE_WORKFLOW && taskType != enumspb.TASK_QUEUE_TYPE_ACTIVITY {
+		return nil, serviceerror.NewInvalidArgument(
+			fmt.Sprintf("only workflow and activity tasks can be moved, got %v", taskType))
+	}
+	destination := request.GetDestinationTaskQueue()
+	if destination == "" {
+		return nil, serviceerror.NewInvalidArgument("destination task queue is not set")
+	}
+	if destination == partition.TaskQueue().Name() {
+		return nil, serviceerror.NewInvalidArgument("destination task queue must differ from the source task queue")
+	}
+	if _, err := tqid.NewTaskQueueFamily(request.GetNamespaceId(), destination); err != nil {
+		return nil, serviceerror.NewInvalidArgument(fmt.Sprintf("invalid destination task queue: %v", err))
+	}
+
+	pm, _, err := e.getTaskQueuePartitionManager(ctx, partition, true, loadCauseOtherWrite)
+	if err != nil {
+		return nil, err
+	}
+	// Tasks are added to the destination before being deleted from the source, so a failure in between can only
+	// result in a task being dispatched twice, which history rejects. The page is limited to the room left for
+	// deleted tasks, so that retries do not add the same tasks again when the source can't delete them.
+	tasks, nextPageToken, err := pm.MoveBacklogTasks(ctx, request.GetNextPageToken(), backlogTasksPageSize(request.GetPageSize()),
+		func(task backlogTask) error {
+			return e.addBacklogTaskToTaskQueue(ctx, taskType, destination, task.AllocatedTaskInfo)
+		})
+	if err != nil {
+		return nil, err
+	}
+	now := e.timeSource.Now()
+	moved := make([]*taskqueuespb.BacklogTaskInfo, len(tasks))
+	for i, task := range tasks {
+		moved[i] = task.info(taskType, now)
+	}
+	return &matchingservice.MoveTaskQueueBacklogTasksResponse{
+		MovedTasks:    moved,
+		NextPageToken: nextPageToken,
+	}, nil
+}
+
+// addBacklogTaskToTaskQueue adds a copy of a backlog task to another task queue, as if it was added by history.
+func (e *matchingEngineImpl) addBacklogTaskToTaskQueue(
+	ctx context.Context,
+	taskType enumspb.TaskQueueType,
+	taskQueue string,
+	task *persistencespb.AllocatedTaskInfo,
+) error {
+	data := task.GetData()
+	var scheduleToStartTimeout *durationpb.Duration
+	if data.GetExpiryTime() != nil {
+		remaining := data.GetExpiryTime().AsTime().Sub(e.timeSource.Now())
+		if remaining <= 0 {
+			// expired tasks would be dropped by the destination anyway
+			return nil
+		}
+		scheduleToStartTimeout = durationpb.New(remaining)
+	}
+	execution := &commonpb.WorkflowExecution{WorkflowId: data.GetWorkflowId(), RunId: data.GetRunId()}
+	tq := &taskqueuepb.TaskQueue{Name: taskQueue, Kind: enumspb.TASK_QUEUE_KIND_NORMAL}
+
+	var err error
+	// nolint:exhaustive // validated by the caller
+	switch taskType {
+	case enumspb.TASK_QUEUE_TYPE_WORKFLOW:
+		_, err = e.matchingRawClient.AddWorkflowTask(ctx, &matchingservice.AddWorkflowTaskRequest{
+			NamespaceId:            data.GetNamespaceId(),
+			Execution:              execution,
+			TaskQueue:              tq,
+			ScheduledEventId:       data.GetScheduledEventId(),
+			ScheduleToStartTimeout: scheduleToStartTimeout,
+			Clock:                  data.GetClock(),
+			VersionDirective:       data.GetVersionDirective(),
+			Priority:               data.GetPriority(),
+		})
+	case enumspb.TASK_QUEUE_TYPE_ACTIVITY:
+		_, err = e.matchingRawClient.AddActivityTask(ctx, &matchingservice.AddActivityTaskRequest{
+			NamespaceId:            data.GetNamespaceId(),
+			Execution:              execution,
+			TaskQueue:              tq,
+			ScheduledEventId:       data.GetScheduledEventId(),
+			ScheduleToStartTimeout: scheduleToStartTimeout,
+			Clock:                  data.GetClock(),
+			VersionDirective:       data.GetVersionDirective(),
+			Stamp:                  data.GetStamp(),
+			Priority:               data.GetPriority(),
+		})
+	default:
+		return errInvalidTaskQueueType
+	}
+	return err
+}
+
+// usesPriorityMatcher returns true if the task queue uses the priority or fairness matcher, which can pause dispatch
+// of some priority levels and honors worker selectors.
+func (e *matchingEngineImpl) usesPriorityMatcher(
+	nsName namespace.Name,
+	taskQueue string,
+	taskQueueType enumspb.TaskQueueType,
+) bool {
+	newMatcher, _ := e.config.NewMatcher(nsName.String(), taskQueue, taskQueueType, nil)
+	fairness, _ := e.config.EnableFairness(nsName.String(), taskQueue, taskQueueType, nil)
+	return newMatcher || fairness
+}
@@ -3,7 +3,10 @@ package matching
 import (
 	"context"
 
+	enumspb "go.temporal.io/api/enums/v1"
+	taskqueuepb "go.temporal.io/api/taskqueue/v1"
 	"go.temporal.io/server/api/matchingservice/v1"
+	taskqueuespb "go.temporal.io/server/api/taskqueue/v1"
 	"go.temporal.io/server/common/metrics"
 )
 
@@ -16,6 +19,7 @@ type (
 		AddActivityTask(ctx context.Context, addRequest *matchingservice.AddActivityTaskRequest) (buildId string, syncMatch bool, err error)
 		PollWorkflowTaskQueue(ctx context.Context, request *matchingservice.PollWorkflowTaskQueueRequest, opMetrics metrics.Handler) (*matchingservice.PollWorkflowTaskQueueResponse, error)
 		PollActivityTaskQueue(ctx context.Context, request *matchingservice.PollActivityTaskQueueRequest, opMetrics metrics.Handler) (*matchingservice.PollActivityTaskQueueResponse, error)
+		ScaledPartitionCounts(namespaceID string, taskQueue *taskqueuepb.TaskQueue, taskType enumspb.TaskQueueType) *taskqueuespb.TaskQueuePartitionCounts
 		QueryWorkflow(ctx context.Context, request *matchingservice.QueryWorkflowRequest) (*matchingservice.QueryWorkflowResponse, error)
 		RespondQueryTaskCompleted(ctx context.Context, request *matchingservice.RespondQueryTaskCompletedRequest, opMetrics metrics.Handler) error
 		CancelOutstandingPoll(ctx context.Context, request *matchingservice.CancelOutstandingPollRequest) error
@@ -46,5 +50,9 @@ type (
 		GetWorkerVersioningRules(ctx context.Context, request *matchingservice.GetWorkerVersioningRulesRequest) (*matchingservice.GetWorkerVersioningRulesResponse, error)
 		DescribeVersionedTaskQueues(ctx context.Context, request *matchingservice.DescribeVersionedTaskQueuesRequest) (*matchingservice.DescribeVersionedTaskQueuesResponse, error)
 		UpdateTaskQueueConfig(ctx context.Context, request *matchingservice.UpdateTaskQueueConfigRequest) (*matchingservice.UpdateTaskQueueConfigResponse, error)
+		UpdateTaskQueueDispatchPause(ctx context.Context, request *matchingservice.UpdateTaskQueueDispatchPauseRequest) (*matchingservice.UpdateTaskQueueDispatchPauseResponse, error)
+		ListTaskQueueBacklogTasks(ctx context.Context, request *matchingservice.ListTaskQueueBacklogTasksRequest) (*matchingservice.ListTaskQueueBacklogTasksResponse, error)
+		DeleteTaskQueueBacklogTasks(ctx context.Context, request *matchingservice.DeleteTaskQueueBacklogTasksRequest) (*matchingservice.DeleteTaskQueueBacklogTasksResponse, error)
+		MoveTaskQueueBacklogTasks(ctx context.Context, request *matchingservice.MoveTaskQueueBacklogTasksRequest) (*matchingservice.MoveTaskQueueBacklogTasksResponse, error)
 	}
 )
@@ -6,9 +6,11 @@ import (
 	"fmt"
 	"math"
 	"math/rand"
+	"slices"
 	"strconv"
 	"strings"
 	"sync"
+	"sync/atomic"
 	"testing"
 	"time"
 
@@ -36,6 +38,7 @@ import (
 	persistencespb "go.temporal.io/server/api/persistence/v1"
 	taskqueuespb "go.temporal.io/server/api/taskqueue/v1"
 	tokenspb "go.temporal.io/server/api/token/v1"
+	matchingclient "go.temporal.io/server/client/matching"
 	"go.temporal.io/server/common"
 	"go.temporal.io/server/common/clock"
 	hlc "go.temporal.io/server/common/clock/hybrid_logical_clock"
@@ -59,11 +62,13 @@ import (
 	serviceerrors "go.temporal.io/server/common/serviceerror"
 	"go.temporal.io/server/common/tasktoken"
 	"go.temporal.io/server/common/testing/protoassert"
+	"go.temporal.io/server/common/testing/testhooks"
 	"go.temporal.io/server/common/testing/testlogger"
 	"go.temporal.io/server/common/tqid"
 	"go.temporal.io/server/common/worker_versioning"
 	"go.temporal.io/server/service/history/consts"
 	"go.uber.org/mock/gomock"
+	"google.golang.org/grpc"
 	"google.golang.org/protobuf/types/known/durationpb"
 	"google.golang.org/protobuf/types/known/timestamppb"
 	"google.golang.org/protobuf/types/known/wrapperspb"
@@ -1163,6 +1168,187 @@ func (s *matchingEngineSuite) TestSyncMatchActivities() {
 		(defaultTaskDispatchRPS - 1))
 }
 
+func (s *matchingEngineSuite) TestUpdateTaskQueueDispatchPause() {
+	// The root workflow partition owns the user data, other partitions would fetch it from it.
+	tq := "dispatch-pause-tq"
+	pause := func(priorityKeys ...int32) error {
+		_, err := s.matchingEngine.UpdateTaskQueueDispatchPause(context.Background(), &matchingservice.UpdateTaskQueueDispatchPauseRequest{
+			NamespaceId:    s.ns.ID().String(),
+			TaskQueue:      tq,
+			TaskQueueTypes: []enumspb.TaskQueueType{enumspb.TASK_QUEUE_TYPE_WORKFLOW},
+			Pause:          true,
+			PriorityKeys:   priorityKeys,
+			Identity:       "operator",
+		})
+		return err
+	}
+
+	err := pause(1)
+	if s.newMatcher {
+		s.NoError(err)
+	} else {
+		var invalidArgument *serviceerror.InvalidArgument
+		s.ErrorAs(err, &invalidArgument, "the classic matcher can only pause all priority levels")
+	}
+
+	describe := func() *matchingservice.DescribeTaskQueueResponse {
+		resp, err := s.matchingEngine.DescribeTaskQueue(context.Background(), &matchingservice.DescribeTaskQueueRequest{
+			NamespaceId: s.ns.ID().String(),
+			DescRequest: &workflowservice.DescribeTaskQueueRequest{
+				TaskQueue:     &taskqueuepb.TaskQueue{Name: tq, Kind: enumspb.TASK_QUEUE_KIND_NORMAL},
+				TaskQueueType: enumspb.TASK_QUEUE_TYPE_WORKFLOW,
+			},
+		})
+		s.NoError(err)
+		return resp
+	}
+	rateLimit := describe().GetDescResponse().GetEffectiveRateLimit()
+	s.NotZero(rateLimit.GetRequestsPerSecond())
+
+	s.NoError(pause())
+	s.Eventually(func() bool {
+		return describe().GetDispatchPause().GetAllPriorities()
+	}, 5*time.Second, 50*time.Millisecond)
+	resp := describe()
+	s.Equal("operator", resp.GetDispatchPause().GetIdentity())
+	s.Equal(rateLimit.GetRequestsPerSecond(), resp.GetDescResponse().GetEffectiveRateLimit().GetRequestsPerSecond(), "a pause does not change the rate limit")
+	s.Equal(rateLimit.GetRateLimitSource(), resp.GetDescResponse().GetEffectiveRateLimit().GetRateLimitSource())
+}
+
+func (s *matchingEngineSuite) TestAddActivityTaskWithWorkerSelector() {
+	_, _, err := s.matchingEngine.AddActivityTask(context.Background(), &matchingservice.AddActivityTaskRequest{
+		NamespaceId:            s.ns.ID().String(),
+		Execution:              &commonpb.WorkflowExecution{WorkflowId: "workflow", RunId: uuid.NewRandom().String()},
+		ScheduledEventId:       5,
+		TaskQueue:              &taskqueuepb.TaskQueue{Name: "worker-selector-tq", Kind: enumspb.TASK_QUEUE_KIND_NORMAL},
+		ScheduleToStartTimeout: timestamp.DurationFromSeconds(100),
+		WorkerSelector:         map[string]string{"region": "eu"},
+	})
+	if s.newMatcher {
+		s.NoError(err)
+	} else {
+		var invalidArgument *serviceerror.InvalidArgument
+		s.ErrorAs(err, &invalidArgument, "the classic matcher does not honor worker selectors")
+	}
+}
+
+func (s *matchingEngineSuite) TestScaleDownDrainsRemovedPartitions() {
+	// Route the engine's matching client to the engine itself through the real client, so that redirects and
+	// forwarding between partitions and the partition counts learned by the client are exercised.
+	var loadBalancer matchingclient.LoadBalancer
+	var hooks testhooks.TestHooks
+	loadBalancer = matchingclient.NewLoadBalancer(func(namespace.ID) (namespace.Name, error) {
+		return s.ns.Name(), nil
+	}, dynamicconfig.NewNoopCollection(), hooks)
+	engineClient := &engineMatchingClient{MatchingServiceClient: s.mockMatchingClient, engine: s.matchingEngine}
+	s.matchingEngine.matchingRawClient = matchingclient.NewClient(time.Minute, time.Minute,
+		&singleClientCache{client: engineClient}, metrics.NoopMetricsHandler, s.logger, loadBalancer)
+
+	var autoScaling atomic.Bool
+	config := s.matchingEngine.config
+	config.NumTaskqueueReadPartitions = dynamicconfig.GetIntPropertyFnFilteredByTaskQueue(4)
+	config.NumTaskqueueWritePartitions = dynamicconfig.GetIntPropertyFnFilteredByTaskQueue(4)
+	config.EnablePartitionAutoScaling = func(string, string, enumspb.TaskQueueType) bool { return autoScaling.Load() }
+	config.PartitionAutoScalingTargetRate = dynamicconfig.GetFloatPropertyFnFilteredByTaskQueue(1000)
+	config.PartitionAutoScalingMaxBacklog = dynamicconfig.GetIntPropertyFnFilteredByTaskQueue(1000)
+	config.PartitionAutoScalingInterval = dynamicconfig.GetDurationPropertyFnFilteredByTaskQueue(10 * time.Millisecond)
+	config.PartitionAutoScalingScaleDownDelay = dynamicconfig.GetDurationPropertyFnFilteredByTaskQueue(0)
+
+	var lock sync.Mutex
+	started := make(map[int64]struct{})
+	s.mockHistoryClient.EXPECT().RecordActivityTaskStarted(gomock.Any(), gomock.Any(), gomock.Any()).DoAndReturn(
+		func(ctx context.Context, req *historyservice.RecordActivityTaskStartedRequest, _ ...any) (*historyservice.RecordActivityTaskStartedResponse, error) {
+			lock.Lock()
+			started[req.GetScheduledEventId()] = struct{}{}
+			lock.Unlock()
+			return &historyservice.RecordActivityTaskStartedResponse{
+				Attempt: 1,
+				ScheduledEvent: newActivityTaskScheduledEvent(req.GetScheduledEventId(), 0,
+					&commandpb.ScheduleActivityTaskCommandAttributes{
+						ActivityId:             "activity",
+						ActivityType:           &commonpb.ActivityType{Name: "activity"},
+						ScheduleToCloseTimeout: durationpb.New(100 * time.Second),
+						StartToCloseTimeout:    durationpb.New(50 * time.Second),
+					}),
+				StartedTime: timestamp.TimeNowPtrUtc(),
+			}, nil
+		}).AnyTimes()
+
+	tl := "scale-down-tq"
+	ns := s.ns.ID().String()
+	taskQueue := newTestTaskQueue(ns, tl, enumspb.TASK_QUEUE_TYPE_ACTIVITY)
+	var scheduledEventID int64
+	addTask := func(ctx context.Context, client matchingservice.MatchingServiceClient, partition string) {
+		scheduledEventID++
+		_, err := client.AddActivityTask(ctx, &matchingservice.AddActivityTaskRequest{
+			NamespaceId:            ns,
+			Execution:              &commonpb.WorkflowExecution{WorkflowId: "workflow", RunId: uuid.NewRandom().String()},
+			ScheduledEventId:       scheduledEventID,
+			TaskQueue:              &taskqueuepb.TaskQueue{Name: partition, Kind: enumspb.TASK_QUEUE_KIND_NORMAL},
+			ScheduleToStartTimeout: timestamp.DurationFromSeconds(100),
+		})
+		s.NoError(err)
+	}
+	counts := func(partition int) *taskqueuespb.TaskQueuePartitionCounts {
+		return s.matchingEngine.ScaledPartitionCounts(ns,
+			&taskqueuepb.TaskQueue{Name: taskQueue.NormalPartition(partition).RpcName()}, enumspb.TASK_QUEUE_TYPE_ACTIVITY)
+	}
+
+	// Build a backlog on the partitions that will be removed, nobody is polling yet.
+	ctx := context.Background()
+	for i := 1; i < 4; i++ {
+		for range 2 {
+			addTask(ctx, s.matchingEngine.matchingRawClient, taskQueue.NormalPartition(i).RpcName())
+		}
+	}
+	_, _, err := s.matchingEngine.getTaskQueuePartitionManager(ctx,
+		taskQueue.Family().TaskQueue(enumspb.TASK_QUEUE_TYPE_WORKFLOW).RootPartition(), true, loadCauseTask)
+	s.NoError(err)
+
+	// Without load the write partitions are removed first, the read partitions stay while they have a backlog.
+	autoScaling.Store(true)
+	s.Eventually(func() bool {
+		return counts(0).GetWritePartitions() == 1 && counts(2).GetWritePartitions() == 1
+	}, 10*time.Second, 10*time.Millisecond)
+	s.EqualValues(4, counts(0).GetReadPartitions())
+
+	// A client that picked a removed partition before learning the new counts is redirected to the root partition.
+	addTask(ctx, s.matchingEngine.matchingRawClient, taskQueue.NormalPartition(2).RpcName())
+	redirects := engineClient.redirectedAdds()
+	s.Len(redirects, 1)
+	s.Equal(taskQueue.RootPartition().RpcName(), redirects[0].GetTaskQueue().GetName())
+	s.Equal(taskQueue.NormalPartition(2).RpcName(), redirects[0].GetForwardInfo().GetSourcePartition())
+
+	// A fresh client learns the counts from its first add and only writes to the root partition after that.
+	for range 10 {
+		addTask(ctx, s.matchingEngine.matchingRawClient, tl)
+	}
+	for range 10 {
+		s.EqualValues(0, loadBalancer.PickWritePartition(taskQueue).PartitionId())
+	}
+	s.LessOrEqual(len(engineClient.redirectedAdds()), 2)
+
+	// Polling the root partition gets every task, the removed partitions forward their backlog to it.
+	s.Eventually(func() bool {
+		_, err := s.matchingEngine.PollActivityTaskQueue(ctx, &matchingservice.PollActivityTaskQueueRequest{
+			NamespaceId: ns,
+			PollRequest: &workflowservice.PollActivityTaskQueueRequest{
+				TaskQueue: &taskqueuepb.TaskQueue{Name: tl, Kind: enumspb.TASK_QUEUE_KIND_NORMAL},
+				Identity:  "worker",
+			},
+		}, metrics.NoopMetricsHandler)
+		s.NoError(err)
+		lock.Lock()
+		defer lock.Unlock()
+		return int64(len(started)) == scheduledEventID
+	}, 30*time.Second, time.Millisecond)
+
+	// Once their backlog is drained the removed partitions are no longer read.
+	s.Eventually(func() bool {
+		return counts(0).GetReadPartitions() == 1
+	}, 10*time.Second, 10*time.Millisecond)
+}
+
 func (s *matchingEngineSuite) TestRateLimiterAcrossVersionedQueues() {
 	/*
 		1. Start a versioned poller with maxTasksPerSecond = defaultTaskDispatchRPS
@@ -4125,3 +4311,72 @@ func useFairness(config *Config) {
 		return true, func() {}
 	}
 }
+
+type (
+	// engineMatchingClient serves the calls between partitions with the engine, like the handler does.
+	engineMatchingClient struct {
+		matchingservice.MatchingServiceClient
+		engine *matchingEngineImpl
+
+		lock      sync.Mutex
+		redirects []*matchingservice.AddActivityTaskRequest
+	}
+
+	singleClientCache struct {
+		client any
+	}
+)
+
+func (c *engineMatchingClient) AddActivityTask(
+	ctx context.Context,
+	request *matchingservice.AddActivityTaskRequest,
+	_ ...grpc.CallOption,
+) (*matchingservice.AddActivityTaskResponse, error) {
+	if request.GetForwardInfo().GetScaledDownRedirect() {
+		c.lock.Lock()
+		c.redirects = append(c.redirects, request)
+		c.lock.Unlock()
+	}
+	assignedBuildId, _, err := c.engine.AddActivityTask(ctx, request)
+	if err != nil {
+		return nil, err
+	}
+	return &matchingservice.AddActivityTaskResponse{
+		AssignedBuildId: assignedBuildId,
+		PartitionCounts: c.engine.ScaledPartitionCounts(request.GetNamespaceId(), request.GetTaskQueue(), enumspb.TASK_QUEUE_TYPE_ACTIVITY),
+	}, nil
+}
+
+func (c *engineMatchingClient) redirectedAdds() []*matchingservice.AddActivityTaskRequest {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+	return slices.Clone(c.redirects)
+}
+
+func (c *engineMatchingClient) DescribeTaskQueuePartition(
+	ctx context.Context,
+	request *matchingservice.DescribeTaskQueuePartitionRequest,
+	_ ...grpc.CallOption,
+) (*matchingservice.DescribeTaskQueuePartitionResponse, error) {
+	return c.engine.DescribeTaskQueuePartition(ctx, request)
+}
+
+func (c *engineMatchingClient) GetTaskQueueUserData(
+	ctx context.Context,
+	request *matchingservice.GetTaskQueueUserDataRequest,
+	_ ...grpc.CallOption,
+) (*matchingservice.GetTaskQueueUserDataResponse, error) {
+	return c.engine.GetTaskQueueUserData(ctx, request)
+}
+
+func (c *singleClientCache) GetClientForKey(string) (any, error) {
+	return c.client, nil
+}
+
+func (c *singleClientCache) GetClientForClientKey(string) (any, error) {
+	return c.client, nil
+}
+
+func (c *singleClientCache) GetAllClients() ([]any, error) {
+	return []any{c.client}, nil
+}
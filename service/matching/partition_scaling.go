@@ -0,0 +1,406 @@
+package matching
+
+import (
+	"context"
+	"math"
+	"time"
+
+	enumspb "go.temporal.io/api/enums/v1"
+	taskqueuepb "go.temporal.io/api/taskqueue/v1"
+	enumsspb "go.temporal.io/server/api/enums/v1"
+	"go.temporal.io/server/api/matchingservice/v1"
+	persistencespb "go.temporal.io/server/api/persistence/v1"
+	taskqueuespb "go.temporal.io/server/api/taskqueue/v1"
+	"go.temporal.io/server/common"
+	"go.temporal.io/server/common/backoff"
+	"go.temporal.io/server/common/goro"
+	"go.temporal.io/server/common/log/tag"
+	"go.temporal.io/server/common/tqid"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// Partitions are removed only if the remaining ones would be loaded below this fraction of the target rate, so that
+// the partition counts don't flap around the target.
+const partitionScaleDownUtilization = 0.75
+
+type (
+	partitionCounts struct {
+		read  int
+		write int
+	}
+
+	// partitionLoad is the load of a single partition, summed over its physical queues.
+	partitionLoad struct {
+		rate    float64 // tasks per second, the higher of the add and dispatch rates
+		backlog int64
+	}
+
+	partitionScalingParams struct {
+		minPartitions          int
+		maxRead                int
+		maxWrite               int
+		targetRate             float64
+		maxBacklogPerPartition int
+		interval               time.Duration
+		scaleDownDelay         time.Duration
+	}
+
+	// partitionScaler runs in the root workflow partition, which owns the user data of the task queue family, and
+	// scales the partition counts of the workflow and activity task queues of the family.
+	partitionScaler struct {
+		pm        *taskQueuePartitionManagerImpl
+		goroGroup goro.Group
+		// Partitions from the read count up to this count were removed and may still have a backlog, by task type.
+		// Only accessed by the run goroutine.
+		drainUpTo map[enumspb.TaskQueueType]int
+	}
+)
+
+func newPartitionScaler(pm *taskQueuePartitionManagerImpl) *partitionScaler {
+	return &partitionScaler{
+		pm:        pm,
+		drainUpTo: make(map[enumspb.TaskQueueType]int),
+	}
+}
+
+func (s *partitionScaler) Start() {
+	s.goroGroup.Go(s.run)
+}
+
+func (s *partitionScaler) Stop() {
+	s.goroGroup.Cancel()
+}
+
+func (s *partitionScaler) run(ctx context.Context) error {
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-time.After(backoff.Jitter(s.pm.config.PartitionAutoScalingInterval(), 0.1)):
+		}
+		for _, taskType := range []enumspb.TaskQueueType{enumspb.TASK_QUEUE_TYPE_WORKFLOW, enumspb.TASK_QUEUE_TYPE_ACTIVITY} {
+			if err := s.scale(ctx, taskType); err != nil && ctx.Err() == nil {
+				s.pm.logger.Warn("Failed to scale task queue partitions",
+					tag.WorkflowTaskQueueType(taskType), tag.Error(err))
+			}
+		}
+	}
+}
+
+func (s *partitionScaler) scale(ctx context.Context, taskType enumspb.TaskQueueType) error {
+	taskQueue := s.pm.partition.TaskQueue().Family().TaskQueue(taskType)
+	config := newTaskQueueConfig(taskQueue, s.pm.engine.config, s.pm.ns.Name())
+
+	userData, _, err := s.pm.userDataManager.GetUserData()
+	if err != nil {
+		return err
+	}
+	scaling := userData.GetData().GetPerType()[int32(taskType)].GetPartitionScaling()
+	if !config.EnablePartitionAutoScaling() {
+		// Forget the partition counts so that scaling starts from the configured counts if it's enabled again.
+		if scaling != nil {
+			return s.update(ctx, taskType, nil)
+		}
+		return nil
+	}
+
+	current := scaledPartitionCounts(scaling, config.NumReadPartitions(), config.NumWritePartitions())
+	current.read = max(current.read, current.write)
+	if err := s.drainRemovedPartitions(ctx, taskQueue, current.read, config.NumReadPartitions()); err != nil {
+		return err
+	}
+	loads, err := s.getPartitionLoads(ctx, taskQueue, current)
+	if err != nil {
+		return err
+	}
+	params := partitionScalingParams{
+		minPartitions:          config.PartitionAutoScalingMinPartitions(),
+		maxRead:                config.NumReadPartitions(),
+		maxWrite:               config.NumWritePartitions(),
+		targetRate:             config.PartitionAutoScalingTargetRate(),
+		maxBacklogPerPartition: config.PartitionAutoScalingMaxBacklog(),
+		interval:               config.PartitionAutoScalingInterval(),
+		scaleDownDelay:         config.PartitionAutoScalingScaleDownDelay(),
+	}
+	now := s.pm.engine.timeSource.Now()
+	sinceLastChange := time.Duration(math.MaxInt64)
+	if scaling.GetUpdateTime() != nil {
+		sinceLastChange = now.Sub(scaling.GetUpdateTime().AsTime())
+	}
+	next, changed := nextPartitionCounts(current, loads, params, sinceLastChange)
+	if !changed {
+		return nil
+	}
+
+	s.pm.logger.Info("Scaling task queue partitions",
+		tag.WorkflowTaskQueueType(taskType),
+		tag.NewInt("read-partitions", next.read),
+		tag.NewInt("write-partitions", next.write),
+		tag.NewInt("previous-read-partitions", current.read),
+		tag.NewInt("previous-write-partitions", current.write),
+	)
+	err = s.update(ctx, taskType, &persistencespb.TaskQueuePartitionScaling{
+		ReadPartitions:  int32(next.read),
+		WritePartitions: int32(next.write),
+		UpdateTime:      timestamppb.New(now),
+	})
+	if err == nil && next.read < current.read {
+		s.drainUpTo[taskType] = max(s.drainUpTo[taskType], current.read)
+	}
+	return err
+}
+
+// drainRemovedPartitions describes the partitions that were removed from the read partitions until they have no
+// backlog. A task may still be added to them by a client that did not learn the new counts yet, and describing them
+// keeps them loaded so that they forward their backlog to the root partition, since pollers don't reach them anymore.
+// Which partitions were removed is not known after the root partition is loaded, so all of them are checked then.
+func (s *partitionScaler) drainRemovedPartitions(
+	ctx context.Context,
+	taskQueue *tqid.TaskQueue,
+	read int,
+	maxRead int,
+) error {
+	drainUpTo, ok := s.drainUpTo[taskQueue.TaskType()]
+	if !ok {
+		drainUpTo = maxRead
+	}
+	var backlog int64
+	for i := read; i < drainUpTo; i++ {
+		load, err := s.describePartition(ctx, taskQueue, i, false)
+		if err != nil {
+			return err
+		}
+		backlog += load.backlog
+	}
+	if backlog == 0 {
+		drainUpTo = read
+	}
+	s.drainUpTo[taskQueue.TaskType()] = drainUpTo
+	return nil
+}
+
+// getPartitionLoads describes every read partition of the task queue. Partitions that are being removed are loaded if
+// needed so that their backlog is known, other partitions are only described if they are loaded, so that scaling
+// does not keep an idle task queue loaded.
+func (s *partitionScaler) getPartitionLoads(
+	ctx context.Context,
+	taskQueue *tqid.TaskQueue,
+	current partitionCounts,
+) ([]partitionLoad, error) {
+	loads := make([]partitionLoad, current.read)
+	for i := range loads {
+		load, err := s.describePartition(ctx, taskQueue, i, i < current.write)
+		if err != nil {
+			return nil, err
+		}
+		loads[i] = load
+	}
+	return loads, nil
+}
+
+func (s *partitionScaler) describePartition(
+	ctx context.Context,
+	taskQueue *tqid.TaskQueue,
+	partitionID int,
+	onlyIfLoaded bool,
+) (partitionLoad, error) {
+	describeCtx, cancel := context.WithTimeout(s.pm.callerInfoContext(ctx), ioTimeout)
+	defer cancel()
+	resp, err := s.pm.matchingClient.DescribeTaskQueuePartition(describeCtx, &matchingservice.DescribeTaskQueuePartitionRequest{
+		NamespaceId: taskQueue.NamespaceId(),
+		TaskQueuePartition: &taskqueuespb.TaskQueuePartition{
+			TaskQueue:     taskQueue.Name(),
+			TaskQueueType: taskQueue.TaskType(),
+			PartitionId:   &taskqueuespb.TaskQueuePartition_NormalPartitionId{NormalPartitionId: int32(partitionID)},
+		},
+		Versions:     &taskqueuepb.TaskQueueVersionSelection{Unversioned: true, AllActive: true},
+		ReportStats:  true,
+		OnlyIfLoaded: onlyIfLoaded,
+	})
+	if err != nil {
+		return partitionLoad{}, err
+	}
+	var load partitionLoad
+	for _, info := range resp.GetVersionsInfoInternal() {
+		stats := info.GetPhysicalTaskQueueInfo().GetTaskQueueStats()
+		load.rate += float64(max(stats.GetTasksAddRate(), stats.GetTasksDispatchRate()))
+		load.backlog += stats.GetApproximateBacklogCount()
+	}
+	return load, nil
+}
+
+func (s *partitionScaler) update(
+	ctx context.Context,
+	taskType enumspb.TaskQueueType,
+	scaling *persistencespb.TaskQueuePartitionScaling,
+) error {
+	updateOptions := UserDataUpdateOptions{Source: "PartitionScaling"}
+	_, err := s.pm.userDataManager.UpdateUserData(ctx, updateOptions,
+		func(tqud *persistencespb.TaskQueueUserData) (*persistencespb.TaskQueueUserData, bool, error) {
+			data := common.CloneProto(tqud)
+			if data == nil {
+				data = &persistencespb.TaskQueueUserData{}
+			}
+			if data.PerType == nil {
+				data.PerType = make(map[int32]*persistencespb.TaskQueueTypeUserData)
+			}
+			perType := data.PerType[int32(taskType)]
+			if perType == nil {
+				perType = &persistencespb.TaskQueueTypeUserData{}
+				data.PerType[int32(taskType)] = perType
+			}
+			perType.PartitionScaling = scaling
+			// Scaling is not a user action and is local to this cluster: the clock is left alone and the change is
+			// not replicated.
+			return data, false, nil
+		},
+	)
+	return err
+}
+
+// nextPartitionCounts returns the partition counts to move to given the load of each read partition, and false if the
+// counts should not change. It takes one step at a time, at most once per interval:
+//   - Partitions are added by raising the read count first, and then the write count once the new partitions have
+//     pollers.
+//   - Partitions are removed by lowering the write count first, and then the read count once the removed partitions
+//     have no backlog.
+func nextPartitionCounts(
+	current partitionCounts,
+	loads []partitionLoad,
+	params partitionScalingParams,
+	sinceLastChange time.Duration,
+) (partitionCounts, bool) {
+	if sinceLastChange < params.interval || params.targetRate <= 0 {
+		return current, false
+	}
+
+	var rate float64
+	var backlog, removedBacklog int64
+	for i, load := range loads {
+		rate += load.rate
+		backlog += load.backlog
+		if i >= current.write {
+			removedBacklog += load.backlog
+		}
+	}
+	maxPartitions := min(params.maxRead, params.maxWrite)
+	clamp := func(n int) int {
+		return min(max(n, params.minPartitions), maxPartitions)
+	}
+	wanted := clamp(int(math.Ceil(rate / params.targetRate)))
+
+	next := current
+	switch {
+	case wanted > current.write && current.read > current.write:
+		// Start writing to partitions that are already polled.
+		next.write = min(wanted, current.read)
+	case wanted > current.write:
+		next.read = wanted
+	case current.read > current.write:
+		if removedBacklog == 0 {
+			next.read = current.write
+		}
+	case sinceLastChange >= params.scaleDownDelay && backlog <= int64(params.maxBacklogPerPartition*current.write):
+		if lower := clamp(int(math.Ceil(rate / (params.targetRate * partitionScaleDownUtilization)))); lower < current.write {
+			next.write = lower
+		}
+	}
+	return next, next != current
+}
+
+// scaledPartitionCounts returns the partition counts chosen by partition auto-scaling, bounded by the configured
+// counts, or the configured counts if there are none.
+func scaledPartitionCounts(scaling *persistencespb.TaskQueuePartitionScaling, maxRead, maxWrite int) partitionCounts {
+	if scaling == nil {
+		return partitionCounts{read: maxRead, write: maxWrite}
+	}
+	write := max(1, min(int(scaling.GetWritePartitions()), maxWrite))
+	read := max(write, min(int(scaling.GetReadPartitions()), maxRead))
+	return partitionCounts{read: read, write: write}
+}
+
+// getPartitionScaling returns the partition scaling state of the task queue, or nil if partition auto-scaling is
+// disabled or has not chosen partition counts yet.
+func getPartitionScaling(
+	config *taskQueueConfig,
+	userDataManager userDataManager,
+	taskType enumspb.TaskQueueType,
+) *persistencespb.TaskQueuePartitionScaling {
+	if !config.EnablePartitionAutoScaling() {
+		return nil
+	}
+	userData, _, err := userDataManager.GetUserData()
+	if err != nil {
+		return nil
+	}
+	return userData.GetData().GetPerType()[int32(taskType)].GetPartitionScaling()
+}
+
+// useScaledPartitionCounts makes the partition counts of the config follow the counts chosen by partition
+// auto-scaling. The configured counts become the maximum.
+func useScaledPartitionCounts(config *taskQueueConfig, userDataManager userDataManager, taskType enumspb.TaskQueueType) {
+	maxRead, maxWrite := config.NumReadPartitions, config.NumWritePartitions
+	counts := func() partitionCounts {
+		return scaledPartitionCounts(getPartitionScaling(config, userDataManager, taskType), maxRead(), maxWrite())
+	}
+	config.NumReadPartitions = func() int { return counts().read }
+	config.NumWritePartitions = func() int { return counts().write }
+}
+
+// keepLocalPartitionScaling returns the per-type user data with the partition scaling state of local, since partition
+// scaling is not replicated between clusters.
+func keepLocalPartitionScaling(
+	merged map[int32]*persistencespb.TaskQueueTypeUserData,
+	local map[int32]*persistencespb.TaskQueueTypeUserData,
+) map[int32]*persistencespb.TaskQueueTypeUserData {
+	result := make(map[int32]*persistencespb.TaskQueueTypeUserData, len(merged))
+	for t, perType := range merged {
+		if !proto.Equal(perType.GetPartitionScaling(), local[t].GetPartitionScaling()) {
+			perType = common.CloneProto(perType)
+			perType.PartitionScaling = local[t].GetPartitionScaling()
+		}
+		result[t] = perType
+	}
+	for t, perType := range local {
+		if _, ok := result[t]; !ok && perType.GetPartitionScaling() != nil {
+			result[t] = &persistencespb.TaskQueueTypeUserData{PartitionScaling: perType.GetPartitionScaling()}
+		}
+	}
+	if len(result) == 0 {
+		return merged
+	}
+	return result
+}
+
+func (pm *taskQueuePartitionManagerImpl) ScaledDownAddRedirect(forwardInfo *taskqueuespb.TaskForwardInfo) *tqid.NormalPartition {
+	partition, ok := pm.partition.(*tqid.NormalPartition)
+	if !ok || forwardInfo != nil || getPartitionScaling(pm.config, pm.userDataManager, partition.TaskType()) == nil {
+		return nil
+	}
+	write := pm.config.NumWritePartitions()
+	if partition.PartitionId() < write {
+		return nil
+	}
+	return partition.TaskQueue().NormalPartition(partition.PartitionId() % write)
+}
+
+// scaledDownRedirectInfo returns the forward info of a task redirected by the given partition, which partition
+// auto-scaling removed from the write partitions. It keeps the matching client from load balancing adds to the root
+// partition again, with counts that may include the removed partitions.
+func scaledDownRedirectInfo(source tqid.Partition) *taskqueuespb.TaskForwardInfo {
+	return &taskqueuespb.TaskForwardInfo{
+		SourcePartition:    source.RpcName(),
+		TaskSource:         enumsspb.TASK_SOURCE_HISTORY,
+		ScaledDownRedirect: true,
+	}
+}
+
+// addTaskForwardInfo returns the forward info to add a task with. Tasks redirected by a removed partition are added
+// as if they came from history, so that they are spooled if they are not sync matched.
+func addTaskForwardInfo(forwardInfo *taskqueuespb.TaskForwardInfo) *taskqueuespb.TaskForwardInfo {
+	if forwardInfo.GetScaledDownRedirect() {
+		return nil
+	}
+	return forwardInfo
+}
@@ -0,0 +1,184 @@
+package matching
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	persistencespb "go.temporal.io/server/api/persistence/v1"
+	"google.golang.org/protobuf/proto"
+)
+
+func TestNextPartitionCounts(t *testing.T) {
+	t.Parallel()
+
+	params := partitionScalingParams{
+		minPartitions:          1,
+		maxRead:                8,
+		maxWrite:               8,
+		targetRate:             100,
+		maxBacklogPerPartition: 1000,
+		interval:               time.Minute,
+		scaleDownDelay:         10 * time.Minute,
+	}
+	loads := func(rate float64, backlogs ...int64) []partitionLoad {
+		result := make([]partitionLoad, len(backlogs))
+		for i, backlog := range backlogs {
+			result[i] = partitionLoad{rate: rate / float64(len(backlogs)), backlog: backlog}
+		}
+		return result
+	}
+	counts := func(read, write int) partitionCounts {
+		return partitionCounts{read: read, write: write}
+	}
+
+	testCases := []struct {
+		name            string
+		current         partitionCounts
+		loads           []partitionLoad
+		sinceLastChange time.Duration
+		want            partitionCounts
+		changed         bool
+	}{
+		{
+			name:            "too soon after last change",
+			current:         counts(1, 1),
+			loads:           loads(1000, 0),
+			sinceLastChange: 30 * time.Second,
+			want:            counts(1, 1),
+		},
+		{
+			name:            "scale up adds read partitions first",
+			current:         counts(2, 2),
+			loads:           loads(450, 0, 0),
+			sinceLastChange: time.Hour,
+			want:            counts(5, 2),
+			changed:         true,
+		},
+		{
+			name:            "scale up then adds write partitions",
+			current:         counts(5, 2),
+			loads:           loads(450, 0, 0, 0, 0, 0),
+			sinceLastChange: time.Minute,
+			want:            counts(5, 5),
+			changed:         true,
+		},
+		{
+			name:            "scale up is bounded by configured counts",
+			current:         counts(4, 4),
+			loads:           loads(5000, 0, 0, 0, 0),
+			sinceLastChange: time.Hour,
+			want:            counts(8, 4),
+			changed:         true,
+		},
+		{
+			name:            "no change at target",
+			current:         counts(4, 4),
+			loads:           loads(350, 0, 0, 0, 0),
+			sinceLastChange: time.Hour,
+			want:            counts(4, 4),
+		},
+		{
+			name:            "scale down waits for delay",
+			current:         counts(4, 4),
+			loads:           loads(50, 0, 0, 0, 0),
+			sinceLastChange: 5 * time.Minute,
+			want:            counts(4, 4),
+		},
+		{
+			name:            "scale down waits for backlog",
+			current:         counts(4, 4),
+			loads:           loads(50, 2000, 2000, 1000, 0),
+			sinceLastChange: time.Hour,
+			want:            counts(4, 4),
+		},
+		{
+			name:            "scale down removes write partitions first",
+			current:         counts(4, 4),
+			loads:           loads(50, 10, 10, 10, 10),
+			sinceLastChange: time.Hour,
+			want:            counts(4, 1),
+			changed:         true,
+		},
+		{
+			name:            "scale down leaves headroom",
+			current:         counts(4, 4),
+			loads:           loads(290, 0, 0, 0, 0),
+			sinceLastChange: time.Hour,
+			want:            counts(4, 4),
+		},
+		{
+			name:            "removed partitions are drained before reads are removed",
+			current:         counts(4, 1),
+			loads:           loads(50, 0, 0, 5, 0),
+			sinceLastChange: time.Hour,
+			want:            counts(4, 1),
+		},
+		{
+			name:            "read partitions are removed once drained",
+			current:         counts(4, 1),
+			loads:           loads(50, 20, 0, 0, 0),
+			sinceLastChange: time.Minute,
+			want:            counts(1, 1),
+			changed:         true,
+		},
+		{
+			name:            "scale up while draining reuses polled partitions",
+			current:         counts(4, 1),
+			loads:           loads(250, 0, 0, 5, 0),
+			sinceLastChange: time.Hour,
+			want:            counts(4, 3),
+			changed:         true,
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+			got, changed := nextPartitionCounts(tc.current, tc.loads, params, tc.sinceLastChange)
+			require.Equal(t, tc.want, got)
+			require.Equal(t, tc.changed, changed)
+		})
+	}
+}
+
+func TestScaledPartitionCounts(t *testing.T) {
+	t.Parallel()
+
+	require.Equal(t, partitionCounts{read: 4, write: 3}, scaledPartitionCounts(nil, 4, 3))
+	require.Equal(t, partitionCounts{read: 3, write: 2}, scaledPartitionCounts(
+		&persistencespb.TaskQueuePartitionScaling{ReadPartitions: 3, WritePartitions: 2}, 4, 4))
+	// configured counts are the maximum
+	require.Equal(t, partitionCounts{read: 4, write: 2}, scaledPartitionCounts(
+		&persistencespb.TaskQueuePartitionScaling{ReadPartitions: 8, WritePartitions: 8}, 4, 2))
+	// never fewer read than write partitions
+	require.Equal(t, partitionCounts{read: 2, write: 2}, scaledPartitionCounts(
+		&persistencespb.TaskQueuePartitionScaling{ReadPartitions: 1, WritePartitions: 2}, 4, 4))
+	require.Equal(t, partitionCounts{read: 1, write: 1}, scaledPartitionCounts(
+		&persistencespb.TaskQueuePartitionScaling{}, 4, 4))
+}
+
+func TestKeepLocalPartitionScaling(t *testing.T) {
+	t.Parallel()
+
+	localScaling := &persistencespb.TaskQueuePartitionScaling{ReadPartitions: 3, WritePartitions: 2}
+	remoteScaling := &persistencespb.TaskQueuePartitionScaling{ReadPartitions: 8, WritePartitions: 8}
+	merged := map[int32]*persistencespb.TaskQueueTypeUserData{
+		1: {DispatchPause: &persistencespb.TaskQueueDispatchPause{}, PartitionScaling: remoteScaling},
+		2: {DispatchPause: &persistencespb.TaskQueueDispatchPause{}},
+	}
+	local := map[int32]*persistencespb.TaskQueueTypeUserData{
+		1: {PartitionScaling: localScaling},
+		3: {PartitionScaling: localScaling},
+	}
+
+	result := keepLocalPartitionScaling(merged, local)
+	require.Len(t, result, 3)
+	require.True(t, proto.Equal(localScaling, result[1].GetPartitionScaling()))
+	require.NotNil(t, result[1].GetDispatchPause())
+	require.Same(t, merged[2], result[2])
+	require.True(t, proto.Equal(localScaling, result[3].GetPartitionScaling()))
+	// the merged user data is not modified
+	require.True(t, proto.Equal(remoteScaling, merged[1].GetPartitionScaling()))
+
+	require.Nil(t, keepLocalPartitionScaling(nil, nil))
+}
@@ -168,12 +168,15 @@ func newPhysicalTaskQueueManager(
 		func() { pqMgr.UnloadFromPartitionManager(unloadCauseIdle) },
 	)
 
-	pqMgr.taskValidator = newTaskValidator(
-		tqCtx,
-		pqMgr.clusterMeta,
-		pqMgr.namespaceRegistry,
-		pqMgr.partitionMgr.engine.historyClient,
-	)
+	pqMgr.taskValidator = &deletedTaskValidator{
+		taskValidator: newTaskValidator(
+			tqCtx,
+			pqMgr.clusterMeta,
+			pqMgr.namespaceRegistry,
+			pqMgr.partitionMgr.engine.historyClient,
+		),
+		pqMgr: pqMgr,
+	}
 
 	isSticky := queue.Partition().Kind() == enumspb.TASK_QUEUE_KIND_STICKY
 	isChild := !isSticky && !queue.Partition().IsRoot()
@@ -308,6 +311,7 @@ func (c *physicalTaskQueueManagerImpl) Start() {
 		return
 	}
 	c.liveness.Start()
+	c.updateDispatchPause()
 	c.backlogMgr.Start()
 	c.matcher.Start()
 	c.logger.Info("Started physicalTaskQueueManager", tag.LifeCycleStarted, tag.Cause(c.config.loadCause.String()))
@@ -407,6 +411,13 @@ func (c *physicalTaskQueueManagerImpl) PollTask(
 			continue
 		}
 
+		// Tasks deleted by an operator may also be waiting in the matcher.
+		if task.event != nil && !task.isSyncMatchTask() && c.backlogMgr.getDB().isTaskDeleted(task.event.GetTaskId()) {
+			c.metricsHandler.Counter(metrics.ExpiredTasksPerTaskQueueCounter.Name()).Record(1, metrics.TaskInvalidTag)
+			task.finish(nil, false)
+			continue
+		}
+
 		task.namespace = c.partitionMgr.ns.Name()
 		task.backlogCountHint = c.backlogCountHint
 
@@ -466,10 +477,63 @@ func (c *physicalTaskQueueManagerImpl) AddSpooledTaskToMatcher(task *internalTas
 	c.priMatcher.AddTask(task)
 }
 
+func (c *physicalTaskQueueManagerImpl) ListBacklogTasks(
+	ctx context.Context,
+	pageToken []byte,
+	pageSize int,
+) ([]backlogTask, []byte, error) {
+	if err := c.WaitUntilInitialized(ctx); err != nil {
+		return nil, nil, err
+	}
+	_, fair := c.backlogMgr.(*fairBacklogManagerImpl)
+	return c.backlogMgr.getDB().ListBacklogTasks(ctx, fair, pageToken, pageSize)
+}
+
+func (c *physicalTaskQueueManagerImpl) DeleteBacklogTasks(
+	ctx context.Context,
+	keys []*taskqueuespb.BacklogTaskKey,
+) error {
+	if err := c.WaitUntilInitialized(ctx); err != nil {
+		return err
+	}
+	_, fair := c.backlogMgr.(*fairBacklogManagerImpl)
+	return c.backlogMgr.getDB().DeleteBacklogTasks(ctx, fair, keys)
+}
+
+func (c *physicalTaskQueueManagerImpl) MoveBacklogTasks(
+	ctx context.Context,
+	pageToken []byte,
+	pageSize int,
+	add func(task backlogTask) error,
+) ([]backlogTask, []byte, error) {
+	if err := c.WaitUntilInitialized(ctx); err != nil {
+		return nil, nil, err
+	}
+	_, fair := c.backlogMgr.(*fairBacklogManagerImpl)
+	return c.backlogMgr.getDB().MoveBacklogTasks(ctx, fair, pageToken, pageSize, add)
+}
+
+func (c *physicalTaskQueueManagerImpl) UnroutableBacklogTasks() int {
+	// The classic matcher does not honor worker selectors.
+	if c.priMatcher == nil {
+		return 0
+	}
+	return min(c.priMatcher.UnroutableBacklogTasks(), c.config.MaxUnroutableLoadedTasks())
+}
+
 func (c *physicalTaskQueueManagerImpl) UserDataChanged() {
+	c.updateDispatchPause()
 	c.matcher.ReprocessAllTasks()
 }
 
+// updateDispatchPause passes the dispatch pause state to the priority matcher. The classic matcher does not need it
+// since the partition manager holds paused tasks before they reach the matcher.
+func (c *physicalTaskQueueManagerImpl) updateDispatchPause() {
+	if c.priMatcher != nil {
+		c.priMatcher.UpdateDispatchPause(c.partitionMgr.getDispatchPause())
+	}
+}
+
 // DispatchQueryTask will dispatch query to local or remote poller. If forwarded then result or error is returned,
 // if dispatched to local poller then nil and nil is returned.
 func (c *physicalTaskQueueManagerImpl) DispatchQueryTask(
@@ -35,7 +35,16 @@ type (
 		DispatchSpooledTask(ctx context.Context, task *internalTask, userDataChanged <-chan struct{}) error
 		AddSpooledTask(task *internalTask) error
 		AddSpooledTaskToMatcher(task *internalTask)
+		// UnroutableBacklogTasks returns the number of loaded backlog tasks that no recent poller satisfies the worker
+		// selector of, up to a configured maximum. Task readers load more tasks in place of these.
+		UnroutableBacklogTasks() int
 		UserDataChanged()
+		// ListBacklogTasks returns a page of the tasks in the backlog, skipping deleted tasks.
+		ListBacklogTasks(ctx context.Context, pageToken []byte, pageSize int) ([]backlogTask, []byte, error)
+		// DeleteBacklogTasks deletes the given tasks from the backlog. They are discarded instead of being dispatched.
+		DeleteBacklogTasks(ctx context.Context, keys []*taskqueuespb.BacklogTaskKey) error
+		// MoveBacklogTasks passes a page of the tasks in the backlog to add, and deletes the added ones.
+		MoveBacklogTasks(ctx context.Context, pageToken []byte, pageSize int, add func(task backlogTask) error) ([]backlogTask, []byte, error)
 		// DispatchQueryTask will dispatch query to local or remote poller. If forwarded then result or error is returned,
 		// if dispatched to local poller then nil and nil is returned.
 		DispatchQueryTask(ctx context.Context, taskId string, request *matchingservice.QueryWorkflowRequest) (*matchingservice.QueryWorkflowResponse, error)
@@ -71,6 +71,20 @@ func (mr *MockphysicalTaskQueueManagerMockRecorder) AddSpooledTaskToMatcher(task
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddSpooledTaskToMatcher", reflect.TypeOf((*MockphysicalTaskQueueManager)(nil).AddSpooledTaskToMatcher), task)
 }
 
+// DeleteBacklogTasks mocks base method.
+func (m *MockphysicalTaskQueueManager) DeleteBacklogTasks(ctx context.Context, keys []*taskqueue0.BacklogTaskKey) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteBacklogTasks", ctx, keys)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteBacklogTasks indicates an expected call of DeleteBacklogTasks.
+func (mr *MockphysicalTaskQueueManagerMockRecorder) DeleteBacklogTasks(ctx, keys any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteBacklogTasks", reflect.TypeOf((*MockphysicalTaskQueueManager)(nil).DeleteBacklogTasks), ctx, keys)
+}
+
 // DispatchNexusTask mocks base method.
 func (m *MockphysicalTaskQueueManager) DispatchNexusTask(ctx context.Context, taskId string, request *matchingservice.DispatchNexusTaskRequest) (*matchingservice.DispatchNexusTaskResponse, error) {
 	m.ctrl.T.Helper()
@@ -185,6 +199,22 @@ func (mr *MockphysicalTaskQueueManagerMockRecorder) LegacyDescribeTaskQueue(incl
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "LegacyDescribeTaskQueue", reflect.TypeOf((*MockphysicalTaskQueueManager)(nil).LegacyDescribeTaskQueue), includeTaskQueueStatus)
 }
 
+// ListBacklogTasks mocks base method.
+func (m *MockphysicalTaskQueueManager) ListBacklogTasks(ctx context.Context, pageToken []byte, pageSize int) ([]backlogTask, []byte, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListBacklogTasks", ctx, pageToken, pageSize)
+	ret0, _ := ret[0].([]backlogTask)
+	ret1, _ := ret[1].([]byte)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// ListBacklogTasks indicates an expected call of ListBacklogTasks.
+func (mr *MockphysicalTaskQueueManagerMockRecorder) ListBacklogTasks(ctx, pageToken, pageSize any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListBacklogTasks", reflect.TypeOf((*MockphysicalTaskQueueManager)(nil).ListBacklogTasks), ctx, pageToken, pageSize)
+}
+
 // MakePollerScalingDecision mocks base method.
 func (m *MockphysicalTaskQueueManager) MakePollerScalingDecision(pollStartTime time.Time) *taskqueue.PollerScalingDecision {
 	m.ctrl.T.Helper()
@@ -211,6 +241,22 @@ func (mr *MockphysicalTaskQueueManagerMockRecorder) MarkAlive() *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MarkAlive", reflect.TypeOf((*MockphysicalTaskQueueManager)(nil).MarkAlive))
 }
 
+// MoveBacklogTasks mocks base method.
+func (m *MockphysicalTaskQueueManager) MoveBacklogTasks(ctx context.Context, pageToken []byte, pageSize int, add func(backlogTask) error) ([]backlogTask, []byte, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "MoveBacklogTasks", ctx, pageToken, pageSize, add)
+	ret0, _ := ret[0].([]backlogTask)
+	ret1, _ := ret[1].([]byte)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// MoveBacklogTasks indicates an expected call of MoveBacklogTasks.
+func (mr *MockphysicalTaskQueueManagerMockRecorder) MoveBacklogTasks(ctx, pageToken, pageSize, add any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MoveBacklogTasks", reflect.TypeOf((*MockphysicalTaskQueueManager)(nil).MoveBacklogTasks), ctx, pageToken, pageSize, add)
+}
+
 // PollTask mocks base method.
 func (m *MockphysicalTaskQueueManager) PollTask(ctx context.Context, pollMetadata *pollMetadata) (*internalTask, error) {
 	m.ctrl.T.Helper()
@@ -319,6 +365,20 @@ func (mr *MockphysicalTaskQueueManagerMockRecorder) UnloadFromPartitionManager(a
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UnloadFromPartitionManager", reflect.TypeOf((*MockphysicalTaskQueueManager)(nil).UnloadFromPartitionManager), arg0)
 }
 
+// UnroutableBacklogTasks mocks base method.
+func (m *MockphysicalTaskQueueManager) UnroutableBacklogTasks() int {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UnroutableBacklogTasks")
+	ret0, _ := ret[0].(int)
+	return ret0
+}
+
+// UnroutableBacklogTasks indicates an expected call of UnroutableBacklogTasks.
+func (mr *MockphysicalTaskQueueManagerMockRecorder) UnroutableBacklogTasks() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UnroutableBacklogTasks", reflect.TypeOf((*MockphysicalTaskQueueManager)(nil).UnroutableBacklogTasks))
+}
+
 // UpdatePollerInfo mocks base method.
 func (m *MockphysicalTaskQueueManager) UpdatePollerInfo(arg0 pollerIdentity, arg1 *pollMetadata) {
 	m.ctrl.T.Helper()
@@ -107,6 +107,7 @@ func (f *priForwarder) ForwardTask(ctx context.Context, task *internalTask) erro
 				Stamp:                  task.event.Data.GetStamp(),
 				VersionDirective:       task.event.Data.GetVersionDirective(),
 				Priority:               task.event.Data.GetPriority(),
+				WorkerSelector:         task.event.Data.GetWorkerSelector(),
 			},
 		)
 	default:
@@ -226,6 +227,7 @@ func (f *priForwarder) ForwardPoll(ctx context.Context, pollMetadata *pollMetada
 				DeploymentOptions:         pollMetadata.deploymentOptions,
 			},
 			ForwardedSource: f.partition.RpcName(),
+			WorkerLabels:    pollMetadata.workerLabels,
 		})
 		if err != nil {
 			return nil, err
@@ -52,9 +52,10 @@ type priTaskMatcher struct {
 type waitingPoller struct {
 	waitableMatchResult
 	startTime       time.Time
-	forwardCtx      context.Context // non-nil iff poll can be forwarded
-	pollMetadata    *pollMetadata   // non-nil iff poll can be forwarded
-	queryOnly       bool            // if true, poller can be given only query task, otherwise any task
+	forwardCtx      context.Context   // non-nil iff poll can be forwarded
+	pollMetadata    *pollMetadata     // non-nil iff poll can be forwarded
+	workerLabels    map[string]string // only tasks whose worker selector these satisfy can be matched
+	queryOnly       bool              // if true, poller can be given only query task, otherwise any task
 	isTaskForwarder bool
 	isTaskValidator bool
 }
@@ -537,6 +538,16 @@ func (tm *priTaskMatcher) setLimitLocked() {
 	tm.data.UpdateRateLimit(rate, burstDuration)
 }
 
+// UpdateDispatchPause updates the dispatch pause state. Paused tasks stay in the matcher until dispatch is resumed.
+func (tm *priTaskMatcher) UpdateDispatchPause(pause *dispatchPause) {
+	tm.data.UpdateDispatchPause(pause)
+}
+
+// UnroutableBacklogTasks returns the number of backlog tasks in the matcher that no recent poller can be dispatched.
+func (tm *priTaskMatcher) UnroutableBacklogTasks() int {
+	return tm.data.UnroutableBacklogTasks()
+}
+
 // Rate returns the current dynamic rate setting
 func (tm *priTaskMatcher) Rate() float64 {
 	tm.limiterLock.Lock()
@@ -569,6 +580,7 @@ func (tm *priTaskMatcher) poll(
 		queryOnly:    queryOnly,
 		forwardCtx:   ctx,
 		pollMetadata: pollMetadata,
+		workerLabels: pollMetadata.workerLabels,
 	}
 	res := tm.data.EnqueuePollerAndWait(ctxs, poller)
 
@@ -134,6 +134,8 @@ func (tr *priTaskReader) completeTask(task *internalTask, res taskResponse) {
 		}
 	}
 
+	unroutable := tr.backlogMgr.pqMgr.UnroutableBacklogTasks()
+
 	tr.lock.Lock()
 	defer tr.lock.Unlock()
 
@@ -145,7 +147,10 @@ func (tr *priTaskReader) completeTask(task *internalTask, res taskResponse) {
 
 	// use == so we just signal once when we cross this threshold
 	// TODO(pri): is this safe? maybe we need to improve this
-	if tr.loadedTasks == tr.backlogMgr.config.GetTasksReloadAt() {
+	// With unroutable tasks loaded, loadedTasks may never get down to the threshold, so signal
+	// whenever the routable tasks are below it.
+	reloadAt := tr.backlogMgr.config.GetTasksReloadAt()
+	if tr.loadedTasks == reloadAt || (unroutable > 0 && tr.loadedTasks-unroutable <= reloadAt) {
 		tr.SignalTaskLoading()
 	}
 
@@ -164,7 +169,9 @@ func (tr *priTaskReader) getTasksPump() {
 		case <-tr.notifyC:
 		}
 
-		if tr.getLoadedTasks() > tr.backlogMgr.config.GetTasksReloadAt() {
+		// Tasks that no poller can take are not counted, so that they don't keep other tasks
+		// from being loaded.
+		if tr.getLoadedTasks()-tr.backlogMgr.pqMgr.UnroutableBacklogTasks() > tr.backlogMgr.config.GetTasksReloadAt() {
 			// Too many loaded already, ignore this signal. We'll get another signal when
 			// loadedTasks drops low enough.
 			continue
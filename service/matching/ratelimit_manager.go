@@ -18,6 +18,7 @@ type (
 		workerRPS       *float64                // RPS set by worker at the time of polling, if available.
 		apiConfigRPS    *float64                // RPS set via API, if available.
 		systemRPS       float64                 // Min of partition level dispatch rates times the number of read partitions.
+		nPartitions     float64                 // Number of read partitions the partition wise rate was last computed with.
 		config          *taskQueueConfig        // Dynamic configuration for task queues set by system.
 		taskQueueType   enumspb.TaskQueueType   // Task queue type
 
@@ -48,6 +49,7 @@ func newRateLimitManager(userDataManager userDataManager,
 		r.dynamicRateBurst,
 		config.RateLimiterRefreshInterval,
 	)
+	r.nPartitions = r.getNumberOfReadPartitions()
 	r.computeEffectiveRPSAndSource()
 	return r
 }
@@ -170,11 +172,14 @@ func (r *rateLimitManager) updateRatelimitLocked() {
 	oldRPS := r.effectiveRPS
 	r.computeEffectiveRPSAndSourceLocked()
 	newRPS := r.effectiveRPS
-	if oldRPS == newRPS {
+	// The number of read partitions can change with partition auto-scaling.
+	oldPartitions := r.nPartitions
+	r.nPartitions = r.getNumberOfReadPartitions()
+	if oldRPS == newRPS && oldPartitions == r.nPartitions {
 		// No update required
 		return
 	}
-	effectiveRPSPartitionWise := r.effectiveRPS / r.getNumberOfReadPartitions()
+	effectiveRPSPartitionWise := r.effectiveRPS / r.nPartitions
 	burst := int(math.Ceil(effectiveRPSPartitionWise))
 	burst = max(burst, r.config.MinTaskThrottlingBurstSize())
 	r.dynamicRateBurst.SetRPS(effectiveRPSPartitionWise)
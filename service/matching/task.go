@@ -70,6 +70,7 @@ type (
 		waitableMatchResult
 		forwardCtx      context.Context // non-nil for sync match task only
 		isPollForwarder bool
+		unroutable      bool // backlog task whose worker selector no recent poller satisfies
 	}
 
 	// taskResponse is used to report the result of either a match with a local poller,
@@ -277,6 +278,15 @@ func (task *internalTask) getPriority() *commonpb.Priority {
 	return nil
 }
 
+// workerSelector returns the labels a poller must advertise to be dispatched the task. Only activity tasks can have
+// a worker selector.
+func (task *internalTask) workerSelector() map[string]string {
+	if task.event != nil {
+		return task.event.AllocatedTaskInfo.GetData().GetWorkerSelector()
+	}
+	return nil
+}
+
 func (task *internalTask) fairLevel() fairLevel {
 	return fairLevelFromAllocatedTask(task.event.AllocatedTaskInfo)
 }
@@ -64,6 +64,8 @@ type (
 
 		// rateLimitManager is used to manage the rate limit for task queues.
 		rateLimitManager *rateLimitManager
+		// non-nil for the root workflow partition, which owns the user data of its task queue family
+		partitionScaler *partitionScaler
 	}
 )
 
@@ -87,6 +89,7 @@ func newTaskQueuePartitionManager(
 	metricsHandler metrics.Handler,
 	userDataManager userDataManager,
 ) (*taskQueuePartitionManagerImpl, error) {
+	useScaledPartitionCounts(tqConfig, userDataManager, partition.TaskType())
 	rateLimitManager := newRateLimitManager(
 		userDataManager,
 		tqConfig,
@@ -110,6 +113,10 @@ func newTaskQueuePartitionManager(
 			TTL: max(1, tqConfig.TaskQueueInfoByBuildIdTTL())}, // ensure TTL is never zero (which would disable TTL)
 		)
 	}
+	if pm.partition.IsRoot() && pm.partition.Kind() == enumspb.TASK_QUEUE_KIND_NORMAL &&
+		pm.partition.TaskType() == enumspb.TASK_QUEUE_TYPE_WORKFLOW {
+		pm.partitionScaler = newPartitionScaler(pm)
+	}
 
 	defaultQ, err := newPhysicalTaskQueueManager(pm, UnversionedQueueKey(partition))
 	if err != nil {
@@ -123,6 +130,9 @@ func (pm *taskQueuePartitionManagerImpl) Start() {
 	pm.engine.updateTaskQueuePartitionGauge(pm.Namespace(), pm.partition, 1)
 	pm.userDataManager.Start()
 	pm.defaultQueue.Start()
+	if pm.partitionScaler != nil {
+		pm.partitionScaler.Start()
+	}
 }
 
 func (pm *taskQueuePartitionManagerImpl) GetRateLimitManager() *rateLimitManager {
@@ -134,6 +144,9 @@ func (pm *taskQueuePartitionManagerImpl) GetRateLimitManager() *rateLimitManager
 func (pm *taskQueuePartitionManagerImpl) Stop(unloadCause unloadCause) {
 	pm.versionedQueuesLock.Lock()
 	defer pm.versionedQueuesLock.Unlock()
+	if pm.partitionScaler != nil {
+		pm.partitionScaler.Stop()
+	}
 	for _, vq := range pm.versionedQueues {
 		vq.Stop(unloadCause)
 	}
@@ -195,7 +208,8 @@ reredirectTask:
 		return "", false, err
 	}
 
-	if isActive {
+	// Tasks are not sync matched while their dispatch is paused, so they go to the backlog.
+	if isActive && !pm.getDispatchPause().isPaused(syncMatchTask) {
 		syncMatched, err = syncMatchQueue.TrySyncMatch(ctx, syncMatchTask)
 		if syncMatched && !pm.shouldBacklogSyncMatchTaskOnError(err) {
 
@@ -410,6 +424,18 @@ func (pm *taskQueuePartitionManagerImpl) ProcessSpooledTask(
 			task.finish(nil, false)
 			return nil
 		}
+		// The classic backlog is dispatched in order, so holding a task of a paused priority level would hold every
+		// task behind it. Pauses of some priority levels are rejected when the classic matcher is used, and ignored
+		// here if the matcher was switched after pausing.
+		if pause := pm.getDispatchPause(); pause.pausesAllPriorities() && pause.isPaused(task) {
+			// Hold the task until dispatch is resumed, which is a user data change.
+			select {
+			case <-userDataChanged:
+				continue
+			case <-ctx.Done():
+				return ctx.Err()
+			}
+		}
 		err = syncMatchQueue.DispatchSpooledTask(ctx, task, userDataChanged)
 		if err != errInterrupted {
 			return err
@@ -625,11 +651,35 @@ func (pm *taskQueuePartitionManagerImpl) LegacyDescribeTaskQueue(includeTaskQueu
 	return resp, nil
 }
 
+func (pm *taskQueuePartitionManagerImpl) ListBacklogTasks(
+	ctx context.Context,
+	pageToken []byte,
+	pageSize int,
+) ([]backlogTask, []byte, error) {
+	return pm.defaultQueue.ListBacklogTasks(ctx, pageToken, pageSize)
+}
+
+func (pm *taskQueuePartitionManagerImpl) DeleteBacklogTasks(
+	ctx context.Context,
+	keys []*taskqueuespb.BacklogTaskKey,
+) error {
+	return pm.defaultQueue.DeleteBacklogTasks(ctx, keys)
+}
+
+func (pm *taskQueuePartitionManagerImpl) MoveBacklogTasks(
+	ctx context.Context,
+	pageToken []byte,
+	pageSize int,
+	add func(task backlogTask) error,
+) ([]backlogTask, []byte, error) {
+	return pm.defaultQueue.MoveBacklogTasks(ctx, pageToken, pageSize, add)
+}
+
 // In order to accommodate the changes brought in by versioning-3.1, `buildIDs` will now also accept versionID's that represent worker-deployment versions.
 func (pm *taskQueuePartitionManagerImpl) Describe(
 	ctx context.Context,
 	buildIds map[string]bool,
-	includeAllActive, reportStats, reportPollers, internalTaskQueueStatus bool,
+	includeAllActive, reportStats, reportPollers, internalTaskQueueStatus, markAlive bool,
 ) (*matchingservice.DescribeTaskQueuePartitionResponse, error) {
 	pm.versionedQueuesLock.RLock()
 
@@ -713,7 +763,9 @@ func (pm *taskQueuePartitionManagerImpl) Describe(
 		}
 		versionsInfo[bid] = vInfo
 
-		physicalQueue.MarkAlive() // Count Describe for liveness
+		if markAlive {
+			physicalQueue.MarkAlive() // Count Describe for liveness
+		}
 	}
 
 	return &matchingservice.DescribeTaskQueuePartitionResponse{
@@ -729,6 +781,17 @@ func (pm *taskQueuePartitionManagerImpl) PartitionCount() int {
 	return max(pm.config.NumWritePartitions(), pm.config.NumReadPartitions())
 }
 
+func (pm *taskQueuePartitionManagerImpl) ScaledPartitionCounts() *taskqueuespb.TaskQueuePartitionCounts {
+	if pm.partition.Kind() == enumspb.TASK_QUEUE_KIND_STICKY ||
+		getPartitionScaling(pm.config, pm.userDataManager, pm.partition.TaskType()) == nil {
+		return nil
+	}
+	return &taskqueuespb.TaskQueuePartitionCounts{
+		ReadPartitions:  int32(pm.config.NumReadPartitions()),
+		WritePartitions: int32(pm.config.NumWritePartitions()),
+	}
+}
+
 func (pm *taskQueuePartitionManagerImpl) LongPollExpirationInterval() time.Duration {
 	return pm.config.LongPollExpirationInterval()
 }
@@ -1193,6 +1256,16 @@ func (pm *taskQueuePartitionManagerImpl) getPerTypeUserData() (*persistencespb.T
 	return perType, userDataChanged, nil
 }
 
+// getDispatchPause returns the dispatch pause state of the partition's task queue type, or nil if dispatch is not
+// paused.
+func (pm *taskQueuePartitionManagerImpl) getDispatchPause() *dispatchPause {
+	perType, _, err := pm.getPerTypeUserData()
+	if err != nil {
+		return nil
+	}
+	return newDispatchPause(perType.GetDispatchPause(), defaultPriorityLevel(pm.config.PriorityLevels()))
+}
+
 func (pm *taskQueuePartitionManagerImpl) userDataChanged() {
 	// Update rateLimits if any change is userData.
 	pm.rateLimitManager.UserDataChanged()
@@ -8,6 +8,7 @@ import (
 
 	taskqueuepb "go.temporal.io/api/taskqueue/v1"
 	"go.temporal.io/server/api/matchingservice/v1"
+	taskqueuespb "go.temporal.io/server/api/taskqueue/v1"
 	"go.temporal.io/server/common/namespace"
 	"go.temporal.io/server/common/tqid"
 )
@@ -56,9 +57,23 @@ type (
 		HasAnyPollerAfter(accessTime time.Time) bool
 		// LegacyDescribeTaskQueue returns information about all pollers of this partition and the status of its unversioned physical queue
 		LegacyDescribeTaskQueue(includeTaskQueueStatus bool) (*matchingservice.DescribeTaskQueueResponse, error)
-		Describe(ctx context.Context, buildIds map[string]bool, includeAllActive, reportStats, reportPollers, internalTaskQueueStatus bool) (*matchingservice.DescribeTaskQueuePartitionResponse, error)
+		Describe(ctx context.Context, buildIds map[string]bool, includeAllActive, reportStats, reportPollers, internalTaskQueueStatus, markAlive bool) (*matchingservice.DescribeTaskQueuePartitionResponse, error)
+		// ListBacklogTasks returns a page of the tasks in the backlog of the unversioned queue.
+		ListBacklogTasks(ctx context.Context, pageToken []byte, pageSize int) ([]backlogTask, []byte, error)
+		// DeleteBacklogTasks deletes the given tasks from the backlog of the unversioned queue.
+		DeleteBacklogTasks(ctx context.Context, keys []*taskqueuespb.BacklogTaskKey) error
+		// MoveBacklogTasks passes a page of the tasks in the backlog of the unversioned queue to add, and deletes the
+		// added ones.
+		MoveBacklogTasks(ctx context.Context, pageToken []byte, pageSize int, add func(task backlogTask) error) ([]backlogTask, []byte, error)
 		Partition() tqid.Partition
 		PartitionCount() int
+		// ScaledPartitionCounts returns the partition counts of the task queue if they are chosen by partition
+		// auto-scaling, or nil if the configured counts are used.
+		ScaledPartitionCounts() *taskqueuespb.TaskQueuePartitionCounts
+		// ScaledDownAddRedirect returns a write partition to add a task to instead of this partition, if partition
+		// auto-scaling removed this partition from the write partitions. This happens when the client adding the
+		// task has not learned about the new partition counts yet. Forwarded and redirected tasks are never redirected.
+		ScaledDownAddRedirect(forwardInfo *taskqueuespb.TaskForwardInfo) *tqid.NormalPartition
 		LongPollExpirationInterval() time.Duration
 		PutCache(key any, value any)
 		GetCache(key any) any
@@ -16,6 +16,7 @@ import (
 
 	taskqueue "go.temporal.io/api/taskqueue/v1"
 	matchingservice "go.temporal.io/server/api/matchingservice/v1"
+	taskqueue0 "go.temporal.io/server/api/taskqueue/v1"
 	namespace "go.temporal.io/server/common/namespace"
 	tqid "go.temporal.io/server/common/tqid"
 	gomock "go.uber.org/mock/gomock"
@@ -75,19 +76,33 @@ func (mr *MocktaskQueuePartitionManagerMockRecorder) AddTask(ctx, params any) *g
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddTask", reflect.TypeOf((*MocktaskQueuePartitionManager)(nil).AddTask), ctx, params)
 }
 
+// DeleteBacklogTasks mocks base method.
+func (m *MocktaskQueuePartitionManager) DeleteBacklogTasks(ctx context.Context, keys []*taskqueue0.BacklogTaskKey) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteBacklogTasks", ctx, keys)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteBacklogTasks indicates an expected call of DeleteBacklogTasks.
+func (mr *MocktaskQueuePartitionManagerMockRecorder) DeleteBacklogTasks(ctx, keys any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteBacklogTasks", reflect.TypeOf((*MocktaskQueuePartitionManager)(nil).DeleteBacklogTasks), ctx, keys)
+}
+
 // Describe mocks base method.
-func (m *MocktaskQueuePartitionManager) Describe(ctx context.Context, buildIds map[string]bool, includeAllActive, reportStats, reportPollers, internalTaskQueueStatus bool) (*matchingservice.DescribeTaskQueuePartitionResponse, error) {
+func (m *MocktaskQueuePartitionManager) Describe(ctx context.Context, buildIds map[string]bool, includeAllActive, reportStats, reportPollers, internalTaskQueueStatus, markAlive bool) (*matchingservice.DescribeTaskQueuePartitionResponse, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "Describe", ctx, buildIds, includeAllActive, reportStats, reportPollers, internalTaskQueueStatus)
+	ret := m.ctrl.Call(m, "Describe", ctx, buildIds, includeAllActive, reportStats, reportPollers, internalTaskQueueStatus, markAlive)
 	ret0, _ := ret[0].(*matchingservice.DescribeTaskQueuePartitionResponse)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // Describe indicates an expected call of Describe.
-func (mr *MocktaskQueuePartitionManagerMockRecorder) Describe(ctx, buildIds, includeAllActive, reportStats, reportPollers, internalTaskQueueStatus any) *gomock.Call {
+func (mr *MocktaskQueuePartitionManagerMockRecorder) Describe(ctx, buildIds, includeAllActive, reportStats, reportPollers, internalTaskQueueStatus, markAlive any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Describe", reflect.TypeOf((*MocktaskQueuePartitionManager)(nil).Describe), ctx, buildIds, includeAllActive, reportStats, reportPollers, internalTaskQueueStatus)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Describe", reflect.TypeOf((*MocktaskQueuePartitionManager)(nil).Describe), ctx, buildIds, includeAllActive, reportStats, reportPollers, internalTaskQueueStatus, markAlive)
 }
 
 // DispatchNexusTask mocks base method.
@@ -219,6 +234,22 @@ func (mr *MocktaskQueuePartitionManagerMockRecorder) LegacyDescribeTaskQueue(inc
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "LegacyDescribeTaskQueue", reflect.TypeOf((*MocktaskQueuePartitionManager)(nil).LegacyDescribeTaskQueue), includeTaskQueueStatus)
 }
 
+// ListBacklogTasks mocks base method.
+func (m *MocktaskQueuePartitionManager) ListBacklogTasks(ctx context.Context, pageToken []byte, pageSize int) ([]backlogTask, []byte, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListBacklogTasks", ctx, pageToken, pageSize)
+	ret0, _ := ret[0].([]backlogTask)
+	ret1, _ := ret[1].([]byte)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// ListBacklogTasks indicates an expected call of ListBacklogTasks.
+func (mr *MocktaskQueuePartitionManagerMockRecorder) ListBacklogTasks(ctx, pageToken, pageSize any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListBacklogTasks", reflect.TypeOf((*MocktaskQueuePartitionManager)(nil).ListBacklogTasks), ctx, pageToken, pageSize)
+}
+
 // LongPollExpirationInterval mocks base method.
 func (m *MocktaskQueuePartitionManager) LongPollExpirationInterval() time.Duration {
 	m.ctrl.T.Helper()
@@ -245,6 +276,22 @@ func (mr *MocktaskQueuePartitionManagerMockRecorder) MarkAlive() *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MarkAlive", reflect.TypeOf((*MocktaskQueuePartitionManager)(nil).MarkAlive))
 }
 
+// MoveBacklogTasks mocks base method.
+func (m *MocktaskQueuePartitionManager) MoveBacklogTasks(ctx context.Context, pageToken []byte, pageSize int, add func(backlogTask) error) ([]backlogTask, []byte, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "MoveBacklogTasks", ctx, pageToken, pageSize, add)
+	ret0, _ := ret[0].([]backlogTask)
+	ret1, _ := ret[1].([]byte)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// MoveBacklogTasks indicates an expected call of MoveBacklogTasks.
+func (mr *MocktaskQueuePartitionManagerMockRecorder) MoveBacklogTasks(ctx, pageToken, pageSize, add any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MoveBacklogTasks", reflect.TypeOf((*MocktaskQueuePartitionManager)(nil).MoveBacklogTasks), ctx, pageToken, pageSize, add)
+}
+
 // Namespace mocks base method.
 func (m *MocktaskQueuePartitionManager) Namespace() *namespace.Namespace {
 	m.ctrl.T.Helper()
@@ -329,6 +376,34 @@ func (mr *MocktaskQueuePartitionManagerMockRecorder) PutCache(key, value any) *g
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PutCache", reflect.TypeOf((*MocktaskQueuePartitionManager)(nil).PutCache), key, value)
 }
 
+// ScaledDownAddRedirect mocks base method.
+func (m *MocktaskQueuePartitionManager) ScaledDownAddRedirect(forwardInfo *taskqueue0.TaskForwardInfo) *tqid.NormalPartition {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ScaledDownAddRedirect", forwardInfo)
+	ret0, _ := ret[0].(*tqid.NormalPartition)
+	return ret0
+}
+
+// ScaledDownAddRedirect indicates an expected call of ScaledDownAddRedirect.
+func (mr *MocktaskQueuePartitionManagerMockRecorder) ScaledDownAddRedirect(forwardInfo any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ScaledDownAddRedirect", reflect.TypeOf((*MocktaskQueuePartitionManager)(nil).ScaledDownAddRedirect), forwardInfo)
+}
+
+// ScaledPartitionCounts mocks base method.
+func (m *MocktaskQueuePartitionManager) ScaledPartitionCounts() *taskqueue0.TaskQueuePartitionCounts {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ScaledPartitionCounts")
+	ret0, _ := ret[0].(*taskqueue0.TaskQueuePartitionCounts)
+	return ret0
+}
+
+// ScaledPartitionCounts indicates an expected call of ScaledPartitionCounts.
+func (mr *MocktaskQueuePartitionManagerMockRecorder) ScaledPartitionCounts() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ScaledPartitionCounts", reflect.TypeOf((*MocktaskQueuePartitionManager)(nil).ScaledPartitionCounts))
+}
+
 // Start mocks base method.
 func (m *MocktaskQueuePartitionManager) Start() {
 	m.ctrl.T.Helper()
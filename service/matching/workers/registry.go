@@ -1,6 +1,8 @@
 package workers
 
 import (
+	"context"
+
 	workerpb "go.temporal.io/api/worker/v1"
 	"go.temporal.io/server/common/namespace"
 )
@@ -8,7 +10,9 @@ import (
 type (
 	Registry interface {
 		RecordWorkerHeartbeats(nsID namespace.ID, workerHeartbeat []*workerpb.WorkerHeartbeat)
-		ListWorkers(nsID namespace.ID, queue string, nextPageToken []byte) ([]*workerpb.WorkerHeartbeat, error)
-		DescribeWorker(nsID namespace.ID, workerInstanceKey string) (*workerpb.WorkerHeartbeat, error)
+		// ListWorkers returns the workers of the namespace matching the query ordered by worker instance key. A
+		// non-positive page size returns all matching workers.
+		ListWorkers(ctx context.Context, nsID namespace.ID, query string, pageSize int, nextPageToken []byte) ([]*workerpb.WorkerHeartbeat, []byte, error)
+		DescribeWorker(ctx context.Context, nsID namespace.ID, workerInstanceKey string) (*workerpb.WorkerHeartbeat, error)
 	}
 )
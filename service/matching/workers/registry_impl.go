@@ -1,15 +1,22 @@
 package workers
 
 import (
-	"container/list"
+	"container/heap"
+	"context"
 	"hash/maphash"
+	"slices"
+	"strings"
 	"sync"
 	"sync/atomic"
 	"time"
 
 	"go.temporal.io/api/serviceerror"
 	workerpb "go.temporal.io/api/worker/v1"
+	"go.temporal.io/server/common/log"
+	"go.temporal.io/server/common/log/tag"
+	"go.temporal.io/server/common/membership"
 	"go.temporal.io/server/common/namespace"
+	"go.temporal.io/server/common/persistence"
 	"go.uber.org/fx"
 )
 
@@ -27,14 +34,17 @@ type (
 		nsID     namespace.ID
 		hb       *workerpb.WorkerHeartbeat
 		lastSeen time.Time
-		elem     *list.Element
+		index    int // index in the bucket's eviction heap
 	}
+	// entryHeap is a min-heap of entries by lastSeen. Merged heartbeats may be older than the newest entries, so
+	// entries are kept in a heap rather than in a list ordered by insertion.
+	entryHeap []*entry
 	// bucket holds part of the keyspace: a map from namespace → (map of instanceKey → entry),
-	// plus a recency list for eviction.
+	// plus a heap of entries by recency for eviction.
 	bucket struct {
 		mu         sync.Mutex
 		namespaces map[namespace.ID]map[string]*entry
-		order      *list.List // front = oldest, back = newest
+		order      entryHeap // order[0] = oldest
 	}
 
 	// registryImpl implements Registry interface. It contains all worker heartbeats.
@@ -49,13 +59,38 @@ type (
 		total            atomic.Int64  // atomic counter of total entries
 		quit             chan struct{} // channel to signal shutdown of the eviction loop
 		seed             maphash.Seed  // seed for the hasher, used to ensure consistent hashing
+
+		persistence *registryPersistence // shares heartbeats between matching hosts, nil if not configured
+		logger      log.Logger
 	}
 )
 
+func (h entryHeap) Len() int           { return len(h) }
+func (h entryHeap) Less(i, j int) bool { return h[i].lastSeen.Before(h[j].lastSeen) }
+func (h entryHeap) Swap(i, j int) {
+	h[i], h[j] = h[j], h[i]
+	h[i].index = i
+	h[j].index = j
+}
+
+func (h *entryHeap) Push(x any) {
+	e := x.(*entry) //nolint:revive
+	e.index = len(*h)
+	*h = append(*h, e)
+}
+
+func (h *entryHeap) Pop() any {
+	old := *h
+	n := len(old)
+	e := old[n-1]
+	old[n-1] = nil
+	*h = old[:n-1]
+	return e
+}
+
 func newBucket() *bucket {
 	return &bucket{
 		namespaces: make(map[namespace.ID]map[string]*entry),
-		order:      list.New(),
 	}
 }
 
@@ -79,14 +114,52 @@ func (b *bucket) upsertHeartbeats(nsID namespace.ID, heartbeats []*workerpb.Work
 		if e, exists := mp[key]; exists {
 			e.hb = hb
 			e.lastSeen = now
-			b.order.MoveToBack(e.elem)
+			heap.Fix(&b.order, e.index)
 		} else {
 			e = &entry{
 				nsID:     nsID,
 				hb:       hb,
 				lastSeen: now,
 			}
-			e.elem = b.order.PushBack(e)
+			heap.Push(&b.order, e)
+			mp[key] = e
+			newEntries += 1
+		}
+	}
+
+	return newEntries
+}
+
+// mergeHeartbeats inserts WorkerHeartbeats last seen at the given time, unless a more recent heartbeat of the
+// same worker is already present. Returns the number of new entries.
+func (b *bucket) mergeHeartbeats(nsID namespace.ID, heartbeats []*workerpb.WorkerHeartbeat, lastSeen time.Time) int64 {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	var newEntries int64
+
+	mp, ok := b.namespaces[nsID]
+	if !ok {
+		mp = make(map[string]*entry)
+		b.namespaces[nsID] = mp
+	}
+
+	for _, hb := range heartbeats {
+		key := hb.WorkerInstanceKey
+		e, exists := mp[key]
+		if exists {
+			if !e.lastSeen.Before(lastSeen) {
+				continue
+			}
+			e.hb = hb
+			e.lastSeen = lastSeen
+			heap.Fix(&b.order, e.index)
+		} else {
+			e = &entry{
+				nsID:     nsID,
+				hb:       hb,
+				lastSeen: lastSeen,
+			}
+			heap.Push(&b.order, e)
 			mp[key] = e
 			newEntries += 1
 		}
@@ -141,16 +214,8 @@ func (b *bucket) evictByTTL(expireBefore time.Time) int {
 	b.mu.Lock()
 	defer b.mu.Unlock()
 
-	for {
-		front := b.order.Front()
-		if front == nil {
-			break
-		}
-		e := front.Value.(*entry) //nolint:revive
-		if !e.lastSeen.Before(expireBefore) {
-			break
-		}
-		b.order.Remove(front)
+	for len(b.order) > 0 && b.order[0].lastSeen.Before(expireBefore) {
+		e := heap.Pop(&b.order).(*entry) //nolint:revive
 		delete(b.namespaces[e.nsID], e.hb.WorkerInstanceKey)
 		removed++
 	}
@@ -160,22 +225,22 @@ func (b *bucket) evictByTTL(expireBefore time.Time) int {
 func (b *bucket) evictByCapacity(threshold time.Time) bool {
 	b.mu.Lock()
 	defer b.mu.Unlock()
-	front := b.order.Front()
-	if front == nil {
+	if len(b.order) == 0 || !b.order[0].lastSeen.Before(threshold) {
 		return false
 	}
-
-	e := front.Value.(*entry) //nolint:revive
-	if !e.lastSeen.Before(threshold) {
-		return false
-	}
-	b.order.Remove(front)
+	e := heap.Pop(&b.order).(*entry) //nolint:revive
 	delete(b.namespaces[e.nsID], e.hb.WorkerInstanceKey)
 	return true
 }
 
 // NewRegistry creates a workers heartbeat registry with the given parameters.
-func NewRegistry(lc fx.Lifecycle) Registry {
+func NewRegistry(
+	lc fx.Lifecycle,
+	config PersistenceConfig,
+	manager persistence.WorkerHeartbeatManager,
+	hostInfoProvider membership.HostInfoProvider,
+	logger log.Logger,
+) Registry {
 	m := newRegistryImpl(
 		defaultBuckets,
 		defaultEntryTTL,
@@ -183,6 +248,8 @@ func NewRegistry(lc fx.Lifecycle) Registry {
 		defaultMaxEntries,
 		defaultEvictionInterval,
 	)
+	m.persistence = newRegistryPersistence(config, manager, hostInfoProvider, logger)
+	m.logger = logger
 
 	lc.Append(fx.StartStopHook(m.Start, m.Stop))
 
@@ -291,9 +358,12 @@ func (m *registryImpl) evictByCapacity() {
 	}
 }
 
-// Start begins the background eviction process.
+// Start begins the background eviction process, and the flushing of heartbeats if persistence is configured.
 func (m *registryImpl) Start() {
 	go m.evictLoop()
+	if m.persistence != nil {
+		go m.persistence.flushLoop(m.quit, m.ttl)
+	}
 }
 
 // Stop halts background eviction.
@@ -301,31 +371,78 @@ func (m *registryImpl) Stop() {
 	close(m.quit)
 }
 
+// refreshFromPersistence merges the heartbeats persisted by all matching hosts into the registry. Failures are
+// logged and the heartbeats known to this host are served.
+func (m *registryImpl) refreshFromPersistence(ctx context.Context, nsID namespace.ID) {
+	if m.persistence == nil {
+		return
+	}
+	b := m.getBucket(nsID)
+	err := m.persistence.refresh(ctx, nsID, m.ttl, func(heartbeats []*workerpb.WorkerHeartbeat, lastSeen time.Time) {
+		m.total.Add(b.mergeHeartbeats(nsID, heartbeats, lastSeen))
+	})
+	if err != nil {
+		m.logger.Warn("Failed to read persisted worker heartbeats.",
+			tag.WorkflowNamespaceID(nsID.String()),
+			tag.Error(err))
+	}
+}
+
 func (m *registryImpl) RecordWorkerHeartbeats(nsID namespace.ID, workerHeartbeat []*workerpb.WorkerHeartbeat) {
 	m.upsertHeartbeats(nsID, workerHeartbeat)
+	if m.persistence != nil {
+		m.persistence.addPending(nsID, workerHeartbeat)
+	}
 }
 
-func (m *registryImpl) ListWorkers(nsID namespace.ID, query string, _ []byte) ([]*workerpb.WorkerHeartbeat, error) {
-	if query == "" {
-		return m.filterWorkers(nsID, func(_ *workerpb.WorkerHeartbeat) bool { return true }), nil
+// ListWorkers returns a page of the workers matching the query. The page token is the instance key of the last
+// worker of the previous page.
+func (m *registryImpl) ListWorkers(
+	ctx context.Context,
+	nsID namespace.ID,
+	query string,
+	pageSize int,
+	nextPageToken []byte,
+) ([]*workerpb.WorkerHeartbeat, []byte, error) {
+	predicate := func(_ *workerpb.WorkerHeartbeat) bool { return true }
+	if query != "" {
+		queryEngine, err := newWorkerQueryEngine(nsID.String(), query)
+		if err != nil {
+			return nil, nil, err
+		}
+		predicate = func(heartbeat *workerpb.WorkerHeartbeat) bool {
+			result, err := queryEngine.EvaluateWorker(heartbeat)
+			return err == nil && result
+		}
 	}
-
-	queryEngine, err := newWorkerQueryEngine(nsID.String(), query)
-	if err != nil {
-		return nil, err
+	if len(nextPageToken) > 0 {
+		queryPredicate := predicate
+		predicate = func(heartbeat *workerpb.WorkerHeartbeat) bool {
+			return heartbeat.WorkerInstanceKey > string(nextPageToken) && queryPredicate(heartbeat)
+		}
 	}
 
-	predicate := func(heartbeat *workerpb.WorkerHeartbeat) bool {
-		result, err := queryEngine.EvaluateWorker(heartbeat)
-		return err == nil && result
+	m.refreshFromPersistence(ctx, nsID)
+	heartbeats := m.filterWorkers(nsID, predicate)
+	slices.SortFunc(heartbeats, func(a, b *workerpb.WorkerHeartbeat) int {
+		return strings.Compare(a.WorkerInstanceKey, b.WorkerInstanceKey)
+	})
+	if pageSize <= 0 || len(heartbeats) <= pageSize {
+		return heartbeats, nil, nil
 	}
-	return m.filterWorkers(nsID, predicate), nil
+	heartbeats = heartbeats[:pageSize]
+	return heartbeats, []byte(heartbeats[pageSize-1].WorkerInstanceKey), nil
 }
 
-func (m *registryImpl) DescribeWorker(nsID namespace.ID, workerInstanceKey string) (*workerpb.WorkerHeartbeat, error) {
+func (m *registryImpl) DescribeWorker(
+	ctx context.Context,
+	nsID namespace.ID,
+	workerInstanceKey string,
+) (*workerpb.WorkerHeartbeat, error) {
 	b := m.getBucket(nsID)
 	if b == nil {
 		return nil, serviceerror.NewNotFoundf("namespace not found: %s", nsID.String())
 	}
+	m.refreshFromPersistence(ctx, nsID)
 	return b.getWorkerHeartbeat(nsID, workerInstanceKey)
 }
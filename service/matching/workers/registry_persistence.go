@@ -0,0 +1,263 @@
+package workers
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	workerpb "go.temporal.io/api/worker/v1"
+	persistencespb "go.temporal.io/server/api/persistence/v1"
+	"go.temporal.io/server/common/dynamicconfig"
+	"go.temporal.io/server/common/log"
+	"go.temporal.io/server/common/log/tag"
+	"go.temporal.io/server/common/membership"
+	"go.temporal.io/server/common/namespace"
+	"go.temporal.io/server/common/persistence"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+const (
+	persistenceReadPageSize = 100
+	persistenceTimeout      = 10 * time.Second
+)
+
+type (
+	// PersistenceConfig controls how the registry persists worker heartbeats.
+	PersistenceConfig struct {
+		Enabled         dynamicconfig.BoolPropertyFn
+		FlushInterval   dynamicconfig.DurationPropertyFn
+		RefreshInterval dynamicconfig.DurationPropertyFn
+	}
+
+	// registryPersistence shares worker heartbeats between matching hosts through a queue per namespace. Each host
+	// periodically appends the heartbeats it received since its previous flush, and merges the batches appended by
+	// all hosts into its registry before serving reads. Each host trims the expired batches from the head of the
+	// queues it appended to, independently of reads.
+	registryPersistence struct {
+		config           PersistenceConfig
+		manager          persistence.WorkerHeartbeatManager
+		hostInfoProvider membership.HostInfoProvider
+		logger           log.Logger
+
+		pendingLock sync.Mutex
+		pending     map[namespace.ID]map[string]*workerpb.WorkerHeartbeat
+		appended    map[namespace.ID]struct{} // namespaces whose queue may hold batches to trim
+
+		readStatesLock sync.Mutex
+		readStates     map[namespace.ID]*namespaceReadState
+	}
+
+	// namespaceReadState tracks how far the persisted batches of a namespace were merged into the registry.
+	namespaceReadState struct {
+		sync.Mutex
+		lastRead    *persistence.MessageMetadata
+		lastRefresh time.Time
+	}
+)
+
+func newRegistryPersistence(
+	config PersistenceConfig,
+	manager persistence.WorkerHeartbeatManager,
+	hostInfoProvider membership.HostInfoProvider,
+	logger log.Logger,
+) *registryPersistence {
+	return &registryPersistence{
+		config:           config,
+		manager:          manager,
+		hostInfoProvider: hostInfoProvider,
+		logger:           logger,
+		pending:          make(map[namespace.ID]map[string]*workerpb.WorkerHeartbeat),
+		appended:         make(map[namespace.ID]struct{}),
+		readStates:       make(map[namespace.ID]*namespaceReadState),
+	}
+}
+
+// addPending records heartbeats to be appended by the next flush. Only the latest heartbeat of a worker is kept.
+func (p *registryPersistence) addPending(nsID namespace.ID, heartbeats []*workerpb.WorkerHeartbeat) {
+	if !p.config.Enabled() {
+		return
+	}
+	p.pendingLock.Lock()
+	defer p.pendingLock.Unlock()
+
+	mp, ok := p.pending[nsID]
+	if !ok {
+		mp = make(map[string]*workerpb.WorkerHeartbeat, len(heartbeats))
+		p.pending[nsID] = mp
+	}
+	for _, hb := range heartbeats {
+		mp[hb.WorkerInstanceKey] = hb
+	}
+}
+
+// flush appends the pending heartbeats of every namespace to its queue. Heartbeats which fail to be appended are
+// dropped; workers heartbeat frequently, so they are persisted again by a following flush.
+func (p *registryPersistence) flush(ctx context.Context) {
+	p.pendingLock.Lock()
+	pending := p.pending
+	p.pending = make(map[namespace.ID]map[string]*workerpb.WorkerHeartbeat, len(pending))
+	p.pendingLock.Unlock()
+
+	recordTime := timestamppb.Now()
+	for nsID, mp := range pending {
+		batch := &persistencespb.WorkerHeartbeatBatch{
+			HostIdentity: p.hostInfoProvider.HostInfo().Identity(),
+			RecordTime:   recordTime,
+			Heartbeats:   make([]*workerpb.WorkerHeartbeat, 0, len(mp)),
+		}
+		for _, hb := range mp {
+			batch.Heartbeats = append(batch.Heartbeats, hb)
+		}
+		if _, err := p.manager.AppendWorkerHeartbeats(ctx, &persistence.AppendWorkerHeartbeatsRequest{
+			NamespaceID: nsID.String(),
+			Batch:       batch,
+		}); err != nil {
+			p.logger.Warn("Failed to persist worker heartbeats.",
+				tag.WorkflowNamespaceID(nsID.String()),
+				tag.Counter(len(batch.Heartbeats)),
+				tag.Error(err))
+			continue
+		}
+		p.pendingLock.Lock()
+		p.appended[nsID] = struct{}{}
+		p.pendingLock.Unlock()
+	}
+}
+
+// trimExpired trims the batches older than the TTL from the head of the queues this host appended to. A queue is
+// no longer tracked once all of its batches are trimmed, until the host appends to it again.
+func (p *registryPersistence) trimExpired(ctx context.Context, ttl time.Duration) {
+	p.pendingLock.Lock()
+	nsIDs := make([]namespace.ID, 0, len(p.appended))
+	for nsID := range p.appended {
+		nsIDs = append(nsIDs, nsID)
+	}
+	p.pendingLock.Unlock()
+
+	expireBefore := time.Now().Add(-ttl)
+	for _, nsID := range nsIDs {
+		trimUpTo, drained, err := p.findExpired(ctx, nsID, expireBefore)
+		if err == nil && trimUpTo != nil {
+			_, err = p.manager.TrimWorkerHeartbeats(ctx, &persistence.TrimWorkerHeartbeatsRequest{
+				NamespaceID:                 nsID.String(),
+				InclusiveMaxMessageMetadata: *trimUpTo,
+			})
+		}
+		if err != nil {
+			p.logger.Warn("Failed to trim expired worker heartbeats.",
+				tag.WorkflowNamespaceID(nsID.String()),
+				tag.Error(err))
+			continue
+		}
+		if drained {
+			p.pendingLock.Lock()
+			delete(p.appended, nsID)
+			p.pendingLock.Unlock()
+		}
+	}
+}
+
+// findExpired reads the queue of the namespace from its head up to the first unexpired batch, and returns the last
+// expired batch, if any, and whether every batch of the queue is expired.
+func (p *registryPersistence) findExpired(
+	ctx context.Context,
+	nsID namespace.ID,
+	expireBefore time.Time,
+) (*persistence.MessageMetadata, bool, error) {
+	var lastExpired *persistence.MessageMetadata
+	for {
+		resp, err := p.manager.ReadWorkerHeartbeats(ctx, &persistence.ReadWorkerHeartbeatsRequest{
+			NamespaceID:                 nsID.String(),
+			ExclusiveMinMessageMetadata: lastExpired,
+			PageSize:                    persistenceReadPageSize,
+		})
+		if err != nil {
+			return nil, false, err
+		}
+		for _, b := range resp.Batches {
+			if !b.Batch.GetRecordTime().AsTime().Before(expireBefore) {
+				return lastExpired, false, nil
+			}
+			lastExpired = &b.MessageMetadata
+		}
+		if len(resp.Batches) < persistenceReadPageSize {
+			return lastExpired, true, nil
+		}
+	}
+}
+
+func (p *registryPersistence) getReadState(nsID namespace.ID) *namespaceReadState {
+	p.readStatesLock.Lock()
+	defer p.readStatesLock.Unlock()
+
+	state, ok := p.readStates[nsID]
+	if !ok {
+		state = &namespaceReadState{}
+		p.readStates[nsID] = state
+	}
+	return state
+}
+
+// refresh merges the batches appended since the previous refresh of the namespace into the registry, unless the
+// namespace was refreshed less than the refresh interval ago. Expired batches are skipped.
+func (p *registryPersistence) refresh(
+	ctx context.Context,
+	nsID namespace.ID,
+	ttl time.Duration,
+	merge func(heartbeats []*workerpb.WorkerHeartbeat, lastSeen time.Time),
+) error {
+	if !p.config.Enabled() {
+		return nil
+	}
+	state := p.getReadState(nsID)
+	state.Lock()
+	defer state.Unlock()
+
+	now := time.Now()
+	if !state.lastRefresh.IsZero() && now.Sub(state.lastRefresh) < p.config.RefreshInterval() {
+		return nil
+	}
+
+	expireBefore := now.Add(-ttl)
+	for {
+		resp, err := p.manager.ReadWorkerHeartbeats(ctx, &persistence.ReadWorkerHeartbeatsRequest{
+			NamespaceID:                 nsID.String(),
+			ExclusiveMinMessageMetadata: state.lastRead,
+			PageSize:                    persistenceReadPageSize,
+		})
+		if err != nil {
+			return err
+		}
+		for _, b := range resp.Batches {
+			if lastSeen := b.Batch.GetRecordTime().AsTime(); !lastSeen.Before(expireBefore) {
+				merge(b.Batch.GetHeartbeats(), lastSeen)
+			}
+			state.lastRead = &b.MessageMetadata
+		}
+		if len(resp.Batches) < persistenceReadPageSize {
+			break
+		}
+	}
+	state.lastRefresh = now
+	return nil
+}
+
+// flushLoop periodically flushes the pending heartbeats and trims the expired batches until quit is closed.
+func (p *registryPersistence) flushLoop(quit <-chan struct{}, ttl time.Duration) {
+	timer := time.NewTimer(p.config.FlushInterval())
+	defer timer.Stop()
+	for {
+		select {
+		case <-timer.C:
+			if p.config.Enabled() {
+				ctx, cancel := context.WithTimeout(context.Background(), persistenceTimeout)
+				p.flush(ctx)
+				p.trimExpired(ctx, ttl)
+				cancel()
+			}
+			timer.Reset(p.config.FlushInterval())
+		case <-quit:
+			return
+		}
+	}
+}
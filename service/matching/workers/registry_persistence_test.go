@@ -0,0 +1,190 @@
+package workers
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	workerpb "go.temporal.io/api/worker/v1"
+	persistencespb "go.temporal.io/server/api/persistence/v1"
+	"go.temporal.io/server/common/dynamicconfig"
+	"go.temporal.io/server/common/log"
+	"go.temporal.io/server/common/membership"
+	"go.temporal.io/server/common/persistence"
+	"go.uber.org/mock/gomock"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+func newPersistedRegistry(t *testing.T, refreshInterval time.Duration) (*registryImpl, *persistence.MockWorkerHeartbeatManager) {
+	manager := persistence.NewMockWorkerHeartbeatManager(gomock.NewController(t))
+	r := newRegistryImpl(
+		defaultBuckets, time.Hour, defaultMinEvictAge, defaultMaxEntries, defaultEvictionInterval,
+	)
+	r.logger = log.NewTestLogger()
+	r.persistence = newRegistryPersistence(
+		PersistenceConfig{
+			Enabled:         dynamicconfig.GetBoolPropertyFn(true),
+			FlushInterval:   dynamicconfig.GetDurationPropertyFn(time.Hour),
+			RefreshInterval: dynamicconfig.GetDurationPropertyFn(refreshInterval),
+		},
+		manager,
+		membership.NewHostInfoProvider(membership.NewHostInfoFromAddress("host1")),
+		r.logger,
+	)
+	return r, manager
+}
+
+func TestRegistryPersistence_Flush(t *testing.T) {
+	r, manager := newPersistedRegistry(t, time.Minute)
+
+	r.RecordWorkerHeartbeats("ns1", []*workerpb.WorkerHeartbeat{{WorkerInstanceKey: "worker1", TaskQueue: "tq1"}})
+	r.RecordWorkerHeartbeats("ns1", []*workerpb.WorkerHeartbeat{{WorkerInstanceKey: "worker1", TaskQueue: "tq2"}})
+
+	manager.EXPECT().AppendWorkerHeartbeats(gomock.Any(), gomock.Any()).DoAndReturn(
+		func(_ context.Context, request *persistence.AppendWorkerHeartbeatsRequest) (*persistence.AppendWorkerHeartbeatsResponse, error) {
+			require.Equal(t, "ns1", request.NamespaceID)
+			require.Equal(t, "host1", request.Batch.GetHostIdentity())
+			require.Len(t, request.Batch.GetHeartbeats(), 1)
+			require.Equal(t, "tq2", request.Batch.GetHeartbeats()[0].GetTaskQueue())
+			return &persistence.AppendWorkerHeartbeatsResponse{}, nil
+		})
+	r.persistence.flush(context.Background())
+
+	// Nothing is pending after a flush.
+	r.persistence.flush(context.Background())
+}
+
+func TestRegistryPersistence_RefreshMergesUnexpired(t *testing.T) {
+	r, manager := newPersistedRegistry(t, time.Minute)
+	now := time.Now()
+
+	r.RecordWorkerHeartbeats("ns1", []*workerpb.WorkerHeartbeat{{WorkerInstanceKey: "local", TaskQueue: "local-tq"}})
+
+	manager.EXPECT().ReadWorkerHeartbeats(gomock.Any(), &persistence.ReadWorkerHeartbeatsRequest{
+		NamespaceID: "ns1",
+		PageSize:    persistenceReadPageSize,
+	}).Return(&persistence.ReadWorkerHeartbeatsResponse{
+		Batches: []persistence.WorkerHeartbeatBatch{
+			{
+				MessageMetadata: persistence.MessageMetadata{ID: 0},
+				Batch: &persistencespb.WorkerHeartbeatBatch{
+					HostIdentity: "host2",
+					RecordTime:   timestamppb.New(now.Add(-2 * time.Hour)),
+					Heartbeats:   []*workerpb.WorkerHeartbeat{{WorkerInstanceKey: "expired"}},
+				},
+			},
+			{
+				MessageMetadata: persistence.MessageMetadata{ID: 1},
+				Batch: &persistencespb.WorkerHeartbeatBatch{
+					HostIdentity: "host2",
+					RecordTime:   timestamppb.New(now.Add(-time.Minute)),
+					Heartbeats: []*workerpb.WorkerHeartbeat{
+						{WorkerInstanceKey: "remote", TaskQueue: "remote-tq"},
+						{WorkerInstanceKey: "local", TaskQueue: "stale-tq"},
+					},
+				},
+			},
+		},
+	}, nil)
+
+	// Reads never trim the queue.
+	workers, _, err := r.ListWorkers(context.Background(), "ns1", "", 0, nil)
+	require.NoError(t, err)
+	require.Len(t, workers, 2)
+	require.Equal(t, "local", workers[0].GetWorkerInstanceKey())
+	require.Equal(t, "local-tq", workers[0].GetTaskQueue(), "a more recent local heartbeat must not be replaced")
+	require.Equal(t, "remote", workers[1].GetWorkerInstanceKey())
+
+	// Persisted heartbeats are not read again within the refresh interval.
+	hb, err := r.DescribeWorker(context.Background(), "ns1", "remote")
+	require.NoError(t, err)
+	require.Equal(t, "remote-tq", hb.GetTaskQueue())
+}
+
+func TestRegistryPersistence_RefreshResumesAfterLastRead(t *testing.T) {
+	r, manager := newPersistedRegistry(t, 0)
+
+	manager.EXPECT().ReadWorkerHeartbeats(gomock.Any(), &persistence.ReadWorkerHeartbeatsRequest{
+		NamespaceID: "ns1",
+		PageSize:    persistenceReadPageSize,
+	}).Return(&persistence.ReadWorkerHeartbeatsResponse{
+		Batches: []persistence.WorkerHeartbeatBatch{{
+			MessageMetadata: persistence.MessageMetadata{ID: 5},
+			Batch: &persistencespb.WorkerHeartbeatBatch{
+				RecordTime: timestamppb.Now(),
+				Heartbeats: []*workerpb.WorkerHeartbeat{{WorkerInstanceKey: "worker1"}},
+			},
+		}},
+	}, nil)
+	manager.EXPECT().ReadWorkerHeartbeats(gomock.Any(), &persistence.ReadWorkerHeartbeatsRequest{
+		NamespaceID:                 "ns1",
+		ExclusiveMinMessageMetadata: &persistence.MessageMetadata{ID: 5},
+		PageSize:                    persistenceReadPageSize,
+	}).Return(&persistence.ReadWorkerHeartbeatsResponse{}, nil)
+
+	_, err := r.DescribeWorker(context.Background(), "ns1", "worker1")
+	require.NoError(t, err)
+	_, err = r.DescribeWorker(context.Background(), "ns1", "worker1")
+	require.NoError(t, err)
+}
+
+func TestRegistryPersistence_TrimExpiredBatchesReadWhileFresh(t *testing.T) {
+	r, manager := newPersistedRegistry(t, 0)
+	now := time.Now()
+	batch := func(id int64, recordTime time.Time) persistence.WorkerHeartbeatBatch {
+		return persistence.WorkerHeartbeatBatch{
+			MessageMetadata: persistence.MessageMetadata{ID: id},
+			Batch: &persistencespb.WorkerHeartbeatBatch{
+				RecordTime: timestamppb.New(recordTime),
+				Heartbeats: []*workerpb.WorkerHeartbeat{{WorkerInstanceKey: "worker1"}},
+			},
+		}
+	}
+	readFromHead := &persistence.ReadWorkerHeartbeatsRequest{NamespaceID: "ns1", PageSize: persistenceReadPageSize}
+
+	r.RecordWorkerHeartbeats("ns1", []*workerpb.WorkerHeartbeat{{WorkerInstanceKey: "worker1"}})
+	manager.EXPECT().AppendWorkerHeartbeats(gomock.Any(), gomock.Any()).Return(&persistence.AppendWorkerHeartbeatsResponse{}, nil)
+	r.persistence.flush(context.Background())
+
+	// Every batch was merged by a refresh while still fresh; the expired ones are trimmed up to the first unexpired.
+	manager.EXPECT().ReadWorkerHeartbeats(gomock.Any(), readFromHead).Return(&persistence.ReadWorkerHeartbeatsResponse{
+		Batches: []persistence.WorkerHeartbeatBatch{
+			batch(0, now.Add(-3*time.Hour)),
+			batch(1, now.Add(-2*time.Hour)),
+			batch(2, now.Add(-time.Minute)),
+		},
+	}, nil)
+	manager.EXPECT().TrimWorkerHeartbeats(gomock.Any(), &persistence.TrimWorkerHeartbeatsRequest{
+		NamespaceID:                 "ns1",
+		InclusiveMaxMessageMetadata: persistence.MessageMetadata{ID: 1},
+	}).Return(&persistence.TrimWorkerHeartbeatsResponse{MessagesDeleted: 2}, nil)
+	r.persistence.trimExpired(context.Background(), time.Hour)
+
+	// Once the whole queue is trimmed, it is not read again until the host appends to it.
+	manager.EXPECT().ReadWorkerHeartbeats(gomock.Any(), readFromHead).Return(&persistence.ReadWorkerHeartbeatsResponse{
+		Batches: []persistence.WorkerHeartbeatBatch{batch(2, now.Add(-2*time.Hour))},
+	}, nil)
+	manager.EXPECT().TrimWorkerHeartbeats(gomock.Any(), &persistence.TrimWorkerHeartbeatsRequest{
+		NamespaceID:                 "ns1",
+		InclusiveMaxMessageMetadata: persistence.MessageMetadata{ID: 2},
+	}).Return(&persistence.TrimWorkerHeartbeatsResponse{MessagesDeleted: 1}, nil)
+	r.persistence.trimExpired(context.Background(), time.Hour)
+	r.persistence.trimExpired(context.Background(), time.Hour)
+}
+
+func TestBucket_MergeHeartbeatsKeepsOrder(t *testing.T) {
+	b := newBucket()
+	now := time.Now()
+
+	require.Equal(t, int64(1), b.mergeHeartbeats("ns", []*workerpb.WorkerHeartbeat{{WorkerInstanceKey: "new"}}, now))
+	require.Equal(t, int64(1), b.mergeHeartbeats("ns", []*workerpb.WorkerHeartbeat{{WorkerInstanceKey: "old"}}, now.Add(-time.Hour)))
+	require.Equal(t, int64(0), b.mergeHeartbeats("ns", []*workerpb.WorkerHeartbeat{{WorkerInstanceKey: "new", TaskQueue: "ignored"}}, now.Add(-time.Minute)))
+
+	require.Equal(t, "old", b.order[0].hb.GetWorkerInstanceKey())
+
+	require.Equal(t, 1, b.evictByTTL(now.Add(-time.Minute)))
+	require.Len(t, b.order, 1)
+	require.Equal(t, "new", b.order[0].hb.GetWorkerInstanceKey())
+	require.Empty(t, b.order[0].hb.GetTaskQueue())
+}
@@ -1,6 +1,7 @@
 package workers
 
 import (
+	"context"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
@@ -168,7 +169,7 @@ func TestRegistryImpl_ListWorkers(t *testing.T) {
 			)
 			tt.setup(r)
 
-			result, err := r.ListWorkers(tt.nsID, "", nil)
+			result, _, err := r.ListWorkers(context.Background(), tt.nsID, "", 0, nil)
 			if tt.expectError {
 				assert.Error(t, err, "expected an error for non-existent namespace")
 				assert.Nil(t, result, "result should be nil when an error occurs")
@@ -274,7 +275,7 @@ func TestRegistryImpl_DescribeWorker(t *testing.T) {
 			)
 			tt.setup(r)
 
-			result, err := r.DescribeWorker(tt.nsID, tt.workerInstanceKey)
+			result, err := r.DescribeWorker(context.Background(), tt.nsID, tt.workerInstanceKey)
 			if tt.expectError {
 				assert.Error(t, err, "expected an error for non-existent namespace")
 				assert.Nil(t, result, "result should be nil when an error occurs")
@@ -286,3 +287,30 @@ func TestRegistryImpl_DescribeWorker(t *testing.T) {
 		})
 	}
 }
+
+func TestRegistryImpl_ListWorkersPagination(t *testing.T) {
+	r := newRegistryImpl(
+		defaultBuckets, defaultEntryTTL, defaultMinEvictAge, defaultMaxEntries, defaultEvictionInterval,
+	)
+	r.upsertHeartbeats("namespace1", []*workerpb.WorkerHeartbeat{
+		{WorkerInstanceKey: "worker3", TaskQueue: "tq"},
+		{WorkerInstanceKey: "worker1", TaskQueue: "tq"},
+		{WorkerInstanceKey: "worker2", TaskQueue: "other"},
+		{WorkerInstanceKey: "worker4", TaskQueue: "tq"},
+	})
+
+	var keys []string
+	var nextPageToken []byte
+	for {
+		result, token, err := r.ListWorkers(context.Background(), "namespace1", "TaskQueue = 'tq'", 2, nextPageToken)
+		assert.NoError(t, err)
+		for _, hb := range result {
+			keys = append(keys, hb.WorkerInstanceKey)
+		}
+		if len(token) == 0 {
+			break
+		}
+		nextPageToken = token
+	}
+	assert.Equal(t, []string{"worker1", "worker3", "worker4"}, keys)
+}
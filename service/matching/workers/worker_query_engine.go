@@ -17,11 +17,15 @@ const (
 	workerHostNameColName       = "HostName"
 	workerTaskQueueColName      = "TaskQueue"
 	workerDeploymentNameColName = "DeploymentName"
+	workerBuildIdColName        = "BuildId"
 	workerSdkNameColName        = "SdkName"
 	workerSdkVersionColName     = "SdkVersion"
 	workerStartTimeColName      = "StartTime"
 	workerHeartbeatTimeColName  = "HeartbeatTime"
 	workerStatusColName         = "WorkerStatus"
+
+	// workerLastHeartbeatTimeColName is the name of the heartbeat time documented by the ListWorkers API.
+	workerLastHeartbeatTimeColName = "LastHeartbeatTime"
 )
 
 const (
@@ -111,6 +115,12 @@ var (
 			}
 			return hb.DeploymentVersion.DeploymentName
 		},
+		workerBuildIdColName: func(hb *workerpb.WorkerHeartbeat) string {
+			if hb.DeploymentVersion == nil {
+				return ""
+			}
+			return hb.DeploymentVersion.BuildId
+		},
 		workerSdkNameColName: func(hb *workerpb.WorkerHeartbeat) string {
 			return hb.SdkName
 		},
@@ -263,6 +273,7 @@ func (w *workerQueryEngine) evaluateComparison(expr *sqlparser.ComparisonExpr) (
 		workerHostNameColName,
 		workerTaskQueueColName,
 		workerDeploymentNameColName,
+		workerBuildIdColName,
 		workerSdkNameColName,
 		workerSdkVersionColName,
 		workerStatusColName:
@@ -283,7 +294,7 @@ func (w *workerQueryEngine) evaluateComparison(expr *sqlparser.ComparisonExpr) (
 		}
 		receivedTime := w.currentWorker.GetStartTime().AsTime()
 		return w.compareTime(receivedTime, expectedTime, expr.Operator)
-	case workerHeartbeatTimeColName:
+	case workerHeartbeatTimeColName, workerLastHeartbeatTimeColName:
 		expectedTime, err := sqlquery.ConvertToTime(valStr)
 		if err != nil {
 			return false, serviceerror.NewInvalidArgumentf("invalid value for %s: %v", colName, err)
@@ -306,7 +317,7 @@ func (w *workerQueryEngine) evaluateRange(expr *sqlparser.RangeCond) (bool, erro
 	colNameStr := sqlparser.String(colName)
 
 	switch colNameStr {
-	case workerStartTimeColName, workerHeartbeatTimeColName:
+	case workerStartTimeColName, workerHeartbeatTimeColName, workerLastHeartbeatTimeColName:
 		fromValue, err := sqlquery.ConvertToTime(sqlparser.String(expr.From))
 		if err != nil {
 			return false, err
@@ -368,7 +379,7 @@ func (w *workerQueryEngine) getTimeValue(colName string) (time.Time, error) {
 			return zeroTime, nil
 		}
 		return w.currentWorker.GetStartTime().AsTime(), nil
-	case workerHeartbeatTimeColName:
+	case workerHeartbeatTimeColName, workerLastHeartbeatTimeColName:
 		if w.currentWorker.GetHeartbeatTime() == nil {
 			return zeroTime, nil
 		}
@@ -140,6 +140,16 @@ func TestActivityInfoMatchEvaluator_SupportedFields(t *testing.T) {
 			query:         fmt.Sprintf("%s = 'deployment_name_unknown'", workerDeploymentNameColName),
 			expectedMatch: false,
 		},
+		{
+			name:          "BuildId, true",
+			query:         fmt.Sprintf("%s = 'build_id'", workerBuildIdColName),
+			expectedMatch: true,
+		},
+		{
+			name:          "BuildId, false",
+			query:         fmt.Sprintf("%s = 'build_id_unknown'", workerBuildIdColName),
+			expectedMatch: false,
+		},
 		{
 			name:          "SdkName, true",
 			query:         fmt.Sprintf("%s = 'sdk_name'", workerSdkNameColName),
@@ -317,7 +327,7 @@ func TestActivityInfoMatchEvaluator_SupportedTimeFields(t *testing.T) {
 
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			for _, colName := range []string{workerStartTimeColName, workerHeartbeatTimeColName} {
+			for _, colName := range []string{workerStartTimeColName, workerHeartbeatTimeColName, workerLastHeartbeatTimeColName} {
 				query := fmt.Sprintf(tt.query, colName)
 				engine, err := newWorkerQueryEngine("nsID", query)
 				assert.NoError(t, err)
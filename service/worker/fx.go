@@ -29,6 +29,7 @@ import (
 	"go.temporal.io/server/service/worker/dlq"
 	"go.temporal.io/server/service/worker/migration"
 	"go.temporal.io/server/service/worker/scheduler"
+	"go.temporal.io/server/service/worker/visibilitybackfill"
 	"go.temporal.io/server/service/worker/workerdeployment"
 	"go.uber.org/fx"
 )
@@ -42,6 +43,7 @@ var Module = fx.Options(
 	deployment.Module, // [cleanup-wv-pre-release]
 	workerdeployment.Module,
 	dlq.Module,
+	visibilitybackfill.Module,
 	fx.Provide(
 		func(c resource.HistoryClient) dlq.HistoryClient {
 			return c
@@ -0,0 +1,190 @@
+package history
+
+import (
+	"context"
+
+	commonpb "go.temporal.io/api/common/v1"
+	"go.temporal.io/sdk/activity"
+	enumsspb "go.temporal.io/server/api/enums/v1"
+	"go.temporal.io/server/api/historyservice/v1"
+	"go.temporal.io/server/common"
+	"go.temporal.io/server/common/collection"
+	"go.temporal.io/server/common/dynamicconfig"
+	"go.temporal.io/server/common/log"
+	"go.temporal.io/server/common/log/tag"
+	"go.temporal.io/server/common/persistence"
+	"go.temporal.io/server/common/persistence/serialization"
+	"go.temporal.io/server/common/quotas"
+)
+
+type (
+	// ReencryptorHeartbeatDetails is the heartbeat detail for HistoryReencryptionActivity
+	ReencryptorHeartbeatDetails struct {
+		BranchCount           int
+		ReencryptedNodes      int
+		ReencryptedExecutions int
+		ErrorCount            int
+		CurrentPage           int
+
+		NextPageToken []byte
+	}
+
+	// Reencryptor walks all history branches and re-encrypts history nodes which are not
+	// encrypted with the active key of their namespace, e.g. after a key rotation.
+	// The mutable state of the branch's workflow is rewritten by the history service if the
+	// workflow is closed. Running workflows only rewrite the parts of their mutable state which
+	// change, so they are fully re-encrypted by the first run after they close.
+	Reencryptor struct {
+		numShards     int32
+		shardPools    dynamicconfig.TypedPropertyFn[map[string]common.NamespaceShardPool]
+		db            persistence.ExecutionManager
+		historyClient historyservice.HistoryServiceClient
+		rateLimiter   quotas.RateLimiter
+		logger        log.Logger
+		isInTest      bool
+
+		hbd ReencryptorHeartbeatDetails
+	}
+)
+
+// NewReencryptor returns an instance of the history re-encryption daemon.
+// Calling Run results in one complete iteration over all history branches.
+func NewReencryptor(
+	numShards int32,
+	shardPools dynamicconfig.TypedPropertyFn[map[string]common.NamespaceShardPool],
+	db persistence.ExecutionManager,
+	historyClient historyservice.HistoryServiceClient,
+	rps int,
+	hbd ReencryptorHeartbeatDetails,
+	logger log.Logger,
+) *Reencryptor {
+	return &Reencryptor{
+		numShards:     numShards,
+		shardPools:    shardPools,
+		db:            db,
+		historyClient: historyClient,
+		rateLimiter: quotas.NewDefaultOutgoingRateLimiter(
+			func() float64 { return float64(rps) },
+		),
+		logger: logger,
+		hbd:    hbd,
+	}
+}
+
+// Run runs the re-encryption
+func (r *Reencryptor) Run(ctx context.Context) (ReencryptorHeartbeatDetails, error) {
+	iter := collection.NewPagingIteratorWithToken(r.getPaginationFn(ctx), r.hbd.NextPageToken)
+	for iter.HasNext() {
+		if err := r.rateLimiter.Wait(ctx); err != nil {
+			return r.hbd, err
+		}
+		branch, err := iter.Next()
+		if err != nil {
+			return r.hbd, err
+		}
+
+		r.hbd.BranchCount++
+		if err := r.reencryptBranch(ctx, branch); err != nil {
+			r.hbd.ErrorCount++
+			r.logger.Error("unable to re-encrypt history branch", tag.DetailInfo(branch.Info), tag.Error(err))
+		}
+		r.heartbeat(ctx)
+	}
+	return r.hbd, nil
+}
+
+func (r *Reencryptor) reencryptBranch(
+	ctx context.Context,
+	branch persistence.HistoryBranchDetail,
+) error {
+	namespaceID, workflowID, runID, err := persistence.SplitHistoryGarbageCleanupInfo(branch.Info)
+	if err != nil {
+		return err
+	}
+	branchToken, err := serialization.HistoryBranchToBlob(branch.BranchInfo)
+	if err != nil {
+		return err
+	}
+	shardIDs := []int32{common.WorkflowIDToHistoryShardInPools(namespaceID, workflowID, r.numShards, r.shardPools())}
+	// while the namespace is moved between shard pools, the workflow may be on either shard
+	if alternateShardID := common.WorkflowIDToAlternateHistoryShard(namespaceID, workflowID, r.numShards, r.shardPools()); alternateShardID != 0 {
+		shardIDs = append(shardIDs, alternateShardID)
+	}
+
+	for _, shardID := range shardIDs {
+		resp, err := r.db.ReencryptHistoryBranch(ctx, &persistence.ReencryptHistoryBranchRequest{
+			ShardID:     shardID,
+			NamespaceID: namespaceID,
+			BranchToken: branchToken.Data,
+		})
+		if err != nil {
+			return err
+		}
+		r.hbd.ReencryptedNodes += resp.ReencryptedNodes
+		if err := r.reencryptMutableState(ctx, shardID, namespaceID, workflowID, runID); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// reencryptMutableState asks the history service to rewrite the mutable state of a closed workflow
+// if it isn't encrypted with the active key. Workflows with several branches are only rewritten for
+// the first one.
+func (r *Reencryptor) reencryptMutableState(
+	ctx context.Context,
+	shardID int32,
+	namespaceID string,
+	workflowID string,
+	runID string,
+) error {
+	resp, err := r.db.GetWorkflowExecution(ctx, &persistence.GetWorkflowExecutionRequest{
+		ShardID:     shardID,
+		NamespaceID: namespaceID,
+		WorkflowID:  workflowID,
+		RunID:       runID,
+	})
+	if common.IsNotFoundError(err) {
+		// the branch outlived its workflow, the history scavenger cleans it up
+		return nil
+	} else if err != nil {
+		return err
+	}
+	if !resp.NeedsReencryption || resp.State.GetExecutionState().GetState() != enumsspb.WORKFLOW_EXECUTION_STATE_COMPLETED {
+		return nil
+	}
+	if _, err := r.historyClient.ReencryptWorkflowExecution(ctx, &historyservice.ReencryptWorkflowExecutionRequest{
+		NamespaceId: namespaceID,
+		Execution: &commonpb.WorkflowExecution{
+			WorkflowId: workflowID,
+			RunId:      runID,
+		},
+	}); err != nil {
+		return err
+	}
+	r.hbd.ReencryptedExecutions++
+	return nil
+}
+
+func (r *Reencryptor) heartbeat(ctx context.Context) {
+	if !r.isInTest {
+		activity.RecordHeartbeat(ctx, r.hbd)
+	}
+}
+
+func (r *Reencryptor) getPaginationFn(
+	ctx context.Context,
+) collection.PaginationFn[persistence.HistoryBranchDetail] {
+	return func(paginationToken []byte) ([]persistence.HistoryBranchDetail, []byte, error) {
+		resp, err := r.db.GetAllHistoryTreeBranches(persistence.WithStaleReads(ctx), &persistence.GetAllHistoryTreeBranchesRequest{
+			PageSize:      pageSize,
+			NextPageToken: paginationToken,
+		})
+		if err != nil {
+			return nil, nil, err
+		}
+		r.hbd.CurrentPage++
+		r.hbd.NextPageToken = resp.NextPageToken
+		return resp.Branches, resp.NextPageToken, nil
+	}
+}
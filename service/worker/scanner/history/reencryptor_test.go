@@ -0,0 +1,94 @@
+package history
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	commonpb "go.temporal.io/api/common/v1"
+	"go.temporal.io/api/serviceerror"
+	enumsspb "go.temporal.io/server/api/enums/v1"
+	"go.temporal.io/server/api/historyservice/v1"
+	"go.temporal.io/server/api/historyservicemock/v1"
+	persistencespb "go.temporal.io/server/api/persistence/v1"
+	"go.temporal.io/server/common"
+	"go.temporal.io/server/common/dynamicconfig"
+	"go.temporal.io/server/common/log"
+	"go.temporal.io/server/common/persistence"
+	"go.temporal.io/server/common/testing/protomock"
+	"go.uber.org/mock/gomock"
+)
+
+func TestReencryptor(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	executionManager := persistence.NewMockExecutionManager(ctrl)
+	historyClient := historyservicemock.NewMockHistoryServiceClient(ctrl)
+
+	branch := func(treeID string, workflowID string) persistence.HistoryBranchDetail {
+		return persistence.HistoryBranchDetail{
+			BranchInfo: &persistencespb.HistoryBranch{TreeId: treeID, BranchId: treeID},
+			Info:       persistence.BuildHistoryGarbageCleanupInfo("namespaceID", workflowID, "runID"),
+		}
+	}
+	executionManager.EXPECT().GetAllHistoryTreeBranches(gomock.Any(), gomock.Any()).Return(
+		&persistence.GetAllHistoryTreeBranchesResponse{
+			Branches: []persistence.HistoryBranchDetail{
+				branch(treeID1, "closed"),
+				branch(treeID2, "running"),
+				branch(treeID3, "reencrypted"),
+				branch(treeID4, "deleted"),
+				{BranchInfo: &persistencespb.HistoryBranch{TreeId: treeID5, BranchId: treeID5}, Info: "invalid"},
+			},
+		}, nil,
+	)
+	executionManager.EXPECT().ReencryptHistoryBranch(gomock.Any(), gomock.Any()).Return(
+		&persistence.ReencryptHistoryBranchResponse{ReencryptedNodes: 2}, nil,
+	).Times(4)
+
+	mutableState := func(state enumsspb.WorkflowExecutionState, needsReencryption bool) *persistence.GetWorkflowExecutionResponse {
+		return &persistence.GetWorkflowExecutionResponse{
+			State: &persistencespb.WorkflowMutableState{
+				ExecutionState: &persistencespb.WorkflowExecutionState{State: state},
+			},
+			NeedsReencryption: needsReencryption,
+		}
+	}
+	executionManager.EXPECT().GetWorkflowExecution(gomock.Any(), gomock.Any()).DoAndReturn(
+		func(_ context.Context, request *persistence.GetWorkflowExecutionRequest) (*persistence.GetWorkflowExecutionResponse, error) {
+			switch request.WorkflowID {
+			case "closed":
+				return mutableState(enumsspb.WORKFLOW_EXECUTION_STATE_COMPLETED, true), nil
+			case "running":
+				return mutableState(enumsspb.WORKFLOW_EXECUTION_STATE_RUNNING, true), nil
+			case "reencrypted":
+				return mutableState(enumsspb.WORKFLOW_EXECUTION_STATE_COMPLETED, false), nil
+			default:
+				return nil, serviceerror.NewNotFound("workflow not found")
+			}
+		},
+	).Times(4)
+	// only the closed workflow whose mutable state uses an old key is rewritten
+	historyClient.EXPECT().ReencryptWorkflowExecution(gomock.Any(), protomock.Eq(&historyservice.ReencryptWorkflowExecutionRequest{
+		NamespaceId: "namespaceID",
+		Execution:   &commonpb.WorkflowExecution{WorkflowId: "closed", RunId: "runID"},
+	})).Return(&historyservice.ReencryptWorkflowExecutionResponse{}, nil)
+
+	reencryptor := NewReencryptor(
+		512,
+		dynamicconfig.GetTypedPropertyFn(map[string]common.NamespaceShardPool(nil)),
+		executionManager,
+		historyClient,
+		100,
+		ReencryptorHeartbeatDetails{},
+		log.NewTestLogger(),
+	)
+	reencryptor.isInTest = true
+
+	hbd, err := reencryptor.Run(context.Background())
+	require.NoError(t, err)
+	require.Equal(t, 5, hbd.BranchCount)
+	require.Equal(t, 8, hbd.ReencryptedNodes)
+	require.Equal(t, 1, hbd.ReencryptedExecutions)
+	require.Equal(t, 1, hbd.ErrorCount)
+	require.Equal(t, 1, hbd.CurrentPage)
+}
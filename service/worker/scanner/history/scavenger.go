@@ -39,6 +39,7 @@ type (
 	// Scavenger is the type that holds the state for history scavenger daemon
 	Scavenger struct {
 		numShards      int32
+		shardPools     dynamicconfig.TypedPropertyFn[map[string]common.NamespaceShardPool]
 		db             persistence.ExecutionManager
 		client         historyservice.HistoryServiceClient
 		adminClient    adminservice.AdminServiceClient
@@ -59,11 +60,14 @@ type (
 	}
 
 	taskDetail struct {
-		shardID     int32
-		namespaceID string
-		workflowID  string
-		runID       string
-		branchToken []byte
+		shardID int32
+		// alternateShardID is the other shard the workflow may be on while its namespace is moved between
+		// shard pools, or 0
+		alternateShardID int32
+		namespaceID      string
+		workflowID       string
+		runID            string
+		branchToken      []byte
 	}
 )
 
@@ -81,6 +85,7 @@ const (
 //   - deletion of history itself, if there are no workflow execution
 func NewScavenger(
 	numShards int32,
+	shardPools dynamicconfig.TypedPropertyFn[map[string]common.NamespaceShardPool],
 	db persistence.ExecutionManager,
 	rps int,
 	client historyservice.HistoryServiceClient,
@@ -96,6 +101,7 @@ func NewScavenger(
 
 	return &Scavenger{
 		numShards:   numShards,
+		shardPools:  shardPools,
 		db:          db,
 		client:      client,
 		adminClient: adminClient,
@@ -224,7 +230,8 @@ func (s *Scavenger) filterTask(
 		s.hbd.ErrorCount++
 		return nil
 	}
-	shardID := common.WorkflowIDToHistoryShard(namespaceID, workflowID, s.numShards)
+	shardID := common.WorkflowIDToHistoryShardInPools(namespaceID, workflowID, s.numShards, s.shardPools())
+	alternateShardID := common.WorkflowIDToAlternateHistoryShard(namespaceID, workflowID, s.numShards, s.shardPools())
 
 	branchToken, err := serialization.HistoryBranchToBlob(branch.BranchInfo)
 	if err != nil {
@@ -238,11 +245,12 @@ func (s *Scavenger) filterTask(
 	}
 
 	return &taskDetail{
-		shardID:     shardID,
-		namespaceID: namespaceID,
-		workflowID:  workflowID,
-		runID:       runID,
-		branchToken: branchToken.Data,
+		shardID:          shardID,
+		alternateShardID: alternateShardID,
+		namespaceID:      namespaceID,
+		workflowID:       workflowID,
+		runID:            runID,
+		branchToken:      branchToken.Data,
 	}
 }
 
@@ -277,6 +285,12 @@ func (s *Scavenger) handleTask(
 		ShardID:     task.shardID,
 		BranchToken: task.branchToken,
 	})
+	if err == nil && task.alternateShardID != 0 {
+		err = s.db.DeleteHistoryBranch(ctx, &persistence.DeleteHistoryBranchRequest{
+			ShardID:     task.alternateShardID,
+			BranchToken: task.branchToken,
+		})
+	}
 	if err != nil {
 		s.logger.Error("encountered error when deleting garbage history branch", getTaskLoggingTags(err, task)...)
 	} else {
@@ -308,7 +322,8 @@ func (s *Scavenger) getPaginationFn(
 			PageSize:      pageSize,
 			NextPageToken: paginationToken,
 		}
-		resp, err := s.db.GetAllHistoryTreeBranches(ctx, req)
+		// branches created after the replica caught up are picked up by the next run
+		resp, err := s.db.GetAllHistoryTreeBranches(persistence.WithStaleReads(ctx), req)
 		if err != nil {
 			return nil, nil, err
 		}
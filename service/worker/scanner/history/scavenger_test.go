@@ -91,6 +91,7 @@ func (s *ScavengerTestSuite) createTestScavenger(
 	enableRetentionVerification := dynamicconfig.GetBoolPropertyFn(true)
 	s.scavenger = NewScavenger(
 		s.numShards,
+		dynamicconfig.GetTypedPropertyFn(map[string]common.NamespaceShardPool(nil)),
 		s.mockExecutionManager,
 		rps,
 		s.mockHistoryClient,
@@ -0,0 +1,191 @@
+package savedqueries
+
+import (
+	"context"
+	"maps"
+	"slices"
+	"sync"
+	"time"
+
+	enumspb "go.temporal.io/api/enums/v1"
+	"go.temporal.io/sdk/activity"
+	"go.temporal.io/sdk/client"
+	"go.temporal.io/sdk/temporal"
+	"go.temporal.io/sdk/workflow"
+	"go.temporal.io/server/common/dynamicconfig"
+	"go.temporal.io/server/common/log"
+	"go.temporal.io/server/common/log/tag"
+	"go.temporal.io/server/common/metrics"
+	"go.temporal.io/server/common/namespace"
+	"go.temporal.io/server/common/persistence"
+	"go.temporal.io/server/common/persistence/visibility/manager"
+	"go.temporal.io/server/common/quotas"
+)
+
+const (
+	EvaluatorWorkflowName = "temporal-sys-saved-query-evaluator-workflow"
+	EvaluatorActivityName = "temporal-sys-saved-query-evaluator-activity"
+
+	EvaluatorWFID          = "temporal-sys-saved-query-evaluator"
+	EvaluatorTaskQueueName = "temporal-sys-saved-query-evaluator-taskqueue-0"
+
+	namespaceListPageSize = 100
+)
+
+var (
+	EvaluatorWFStartOptions = client.StartWorkflowOptions{
+		ID:                    EvaluatorWFID,
+		TaskQueue:             EvaluatorTaskQueueName,
+		WorkflowIDReusePolicy: enumspb.WORKFLOW_ID_REUSE_POLICY_ALLOW_DUPLICATE,
+		CronSchedule:          "* * * * *",
+	}
+)
+
+type (
+	Activities struct {
+		metadataManager   persistence.MetadataManager
+		visibilityManager manager.VisibilityManager
+		visibilityRPS     dynamicconfig.FloatPropertyFn
+		metricsHandler    metrics.Handler
+		logger            log.Logger
+
+		lock sync.Mutex
+		// series are the saved queries whose gauges were recorded by the last complete evaluation
+		series map[savedQuerySeries]struct{}
+	}
+
+	// savedQuerySeries identifies the metric series of a saved query.
+	savedQuerySeries struct {
+		namespace namespace.Name
+		name      string
+	}
+
+	// EvaluatorResult is the result of a single evaluation of all saved queries.
+	EvaluatorResult struct {
+		EvaluatedCount int
+		ErrorCount     int
+	}
+)
+
+func NewActivities(
+	metadataManager persistence.MetadataManager,
+	visibilityManager manager.VisibilityManager,
+	visibilityRPS dynamicconfig.FloatPropertyFn,
+	metricsHandler metrics.Handler,
+	logger log.Logger,
+) *Activities {
+	return &Activities{
+		metadataManager:   metadataManager,
+		visibilityManager: visibilityManager,
+		visibilityRPS:     visibilityRPS,
+		metricsHandler:    metricsHandler,
+		logger:            logger,
+	}
+}
+
+// EvaluatorWorkflow counts the workflow executions matching the saved visibility queries of all namespaces.
+// It runs on a cron schedule; a failed run is not retried because the next run evaluates the queries again.
+func EvaluatorWorkflow(ctx workflow.Context) (EvaluatorResult, error) {
+	activityCtx := workflow.WithActivityOptions(ctx, workflow.ActivityOptions{
+		StartToCloseTimeout: 10 * time.Minute,
+		HeartbeatTimeout:    30 * time.Second,
+		RetryPolicy: &temporal.RetryPolicy{
+			MaximumAttempts: 1,
+		},
+	})
+	var result EvaluatorResult
+	err := workflow.ExecuteActivity(activityCtx, EvaluatorActivityName).Get(ctx, &result)
+	return result, err
+}
+
+// EvaluateSavedQueries counts the workflow executions matching each saved visibility query and records the
+// count as a gauge tagged with the namespace and the name of the query. Queries which fail to be counted are
+// logged and marked as stale, and the gauges of queries which were deleted since the last evaluation are reset.
+func (a *Activities) EvaluateSavedQueries(ctx context.Context) (EvaluatorResult, error) {
+	var result EvaluatorResult
+	evaluated := make(map[savedQuerySeries]struct{})
+	rateLimiter := quotas.NewDefaultOutgoingRateLimiter(quotas.RateFn(a.visibilityRPS))
+
+	var nextPageToken []byte
+	for {
+		resp, err := a.metadataManager.ListNamespaces(ctx, &persistence.ListNamespacesRequest{
+			PageSize:       namespaceListPageSize,
+			NextPageToken:  nextPageToken,
+			IncludeDeleted: false,
+		})
+		if err != nil {
+			return result, err
+		}
+		for _, ns := range resp.Namespaces {
+			info := ns.Namespace.GetInfo()
+			if info.GetState() != enumspb.NAMESPACE_STATE_REGISTERED {
+				continue
+			}
+			savedQueries := ns.Namespace.GetConfig().GetSavedVisibilityQueries()
+			for _, name := range slices.Sorted(maps.Keys(savedQueries)) {
+				if err := rateLimiter.Wait(ctx); err != nil {
+					return result, err
+				}
+				evaluated[savedQuerySeries{namespace: namespace.Name(info.GetName()), name: name}] = struct{}{}
+				if a.evaluate(ctx, namespace.ID(info.GetId()), namespace.Name(info.GetName()), name, savedQueries[name].GetQuery()) {
+					result.EvaluatedCount++
+				} else {
+					result.ErrorCount++
+				}
+			}
+			activity.RecordHeartbeat(ctx, result)
+		}
+		nextPageToken = resp.NextPageToken
+		if len(nextPageToken) == 0 {
+			a.resetDeletedSeries(evaluated)
+			return result, nil
+		}
+	}
+}
+
+// resetDeletedSeries resets the gauges of saved queries which no longer exist, which would report their last
+// value forever otherwise.
+func (a *Activities) resetDeletedSeries(evaluated map[savedQuerySeries]struct{}) {
+	a.lock.Lock()
+	defer a.lock.Unlock()
+	for series := range a.series {
+		if _, ok := evaluated[series]; ok {
+			continue
+		}
+		handler := a.seriesHandler(series.namespace, series.name)
+		metrics.SavedVisibilityQueryCount.With(handler).Record(0)
+		metrics.SavedVisibilityQueryStale.With(handler).Record(0)
+	}
+	a.series = evaluated
+}
+
+func (a *Activities) seriesHandler(nsName namespace.Name, name string) metrics.Handler {
+	return a.metricsHandler.WithTags(metrics.NamespaceTag(nsName.String()), metrics.SavedQueryTag(name))
+}
+
+func (a *Activities) evaluate(
+	ctx context.Context,
+	nsID namespace.ID,
+	nsName namespace.Name,
+	name string,
+	query string,
+) bool {
+	handler := a.seriesHandler(nsName, name)
+	resp, err := a.visibilityManager.CountWorkflowExecutions(ctx, &manager.CountWorkflowExecutionsRequest{
+		NamespaceID: nsID,
+		Namespace:   nsName,
+		Query:       query,
+	})
+	if err != nil {
+		metrics.SavedVisibilityQueryErrors.With(handler).Record(1)
+		metrics.SavedVisibilityQueryStale.With(handler).Record(1)
+		a.logger.Warn("Unable to count workflow executions of saved query.",
+			tag.WorkflowNamespace(nsName.String()),
+			tag.Name(name),
+			tag.Error(err))
+		return false
+	}
+	metrics.SavedVisibilityQueryCount.With(handler).Record(float64(resp.Count))
+	metrics.SavedVisibilityQueryStale.With(handler).Record(0)
+	return true
+}
@@ -0,0 +1,192 @@
+package savedqueries
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	enumspb "go.temporal.io/api/enums/v1"
+	"go.temporal.io/sdk/activity"
+	"go.temporal.io/sdk/testsuite"
+	persistencespb "go.temporal.io/server/api/persistence/v1"
+	"go.temporal.io/server/common/dynamicconfig"
+	"go.temporal.io/server/common/log"
+	"go.temporal.io/server/common/metrics"
+	"go.temporal.io/server/common/metrics/metricstest"
+	"go.temporal.io/server/common/persistence"
+	"go.temporal.io/server/common/persistence/visibility/manager"
+	"go.uber.org/mock/gomock"
+)
+
+var activityRegisterOptions = activity.RegisterOptions{Name: EvaluatorActivityName}
+
+func newNamespace(id string, state enumspb.NamespaceState, queries ...string) *persistence.GetNamespaceResponse {
+	savedQueries := make(map[string]*persistencespb.SavedVisibilityQuery, len(queries))
+	for _, q := range queries {
+		savedQueries[q] = &persistencespb.SavedVisibilityQuery{
+			Name:  q,
+			Query: "WorkflowType = '" + q + "'",
+		}
+	}
+	return &persistence.GetNamespaceResponse{
+		Namespace: &persistencespb.NamespaceDetail{
+			Info: &persistencespb.NamespaceInfo{
+				Id:    id + "-id",
+				Name:  id,
+				State: state,
+			},
+			Config: &persistencespb.NamespaceConfig{
+				SavedVisibilityQueries: savedQueries,
+			},
+		},
+	}
+}
+
+func Test_EvaluateSavedQueries(t *testing.T) {
+	testSuite := &testsuite.WorkflowTestSuite{}
+	env := testSuite.NewTestActivityEnvironment()
+
+	ctrl := gomock.NewController(t)
+	metadataManager := persistence.NewMockMetadataManager(ctrl)
+	visibilityManager := manager.NewMockVisibilityManager(ctrl)
+	captureHandler := metricstest.NewCaptureHandler()
+	capture := captureHandler.StartCapture()
+
+	a := NewActivities(
+		metadataManager,
+		visibilityManager,
+		dynamicconfig.GetFloatPropertyFn(1000),
+		captureHandler,
+		log.NewTestLogger(),
+	)
+	env.RegisterActivityWithOptions(a.EvaluateSavedQueries, activityRegisterOptions)
+
+	secondPageToken := []byte{0x01}
+	metadataManager.EXPECT().ListNamespaces(gomock.Any(), &persistence.ListNamespacesRequest{
+		PageSize: namespaceListPageSize,
+	}).Return(&persistence.ListNamespacesResponse{
+		Namespaces: []*persistence.GetNamespaceResponse{
+			newNamespace("ns1", enumspb.NAMESPACE_STATE_REGISTERED, "stuck", "failing"),
+			newNamespace("ns2", enumspb.NAMESPACE_STATE_DELETED, "ignored"),
+		},
+		NextPageToken: secondPageToken,
+	}, nil)
+	metadataManager.EXPECT().ListNamespaces(gomock.Any(), &persistence.ListNamespacesRequest{
+		PageSize:      namespaceListPageSize,
+		NextPageToken: secondPageToken,
+	}).Return(&persistence.ListNamespacesResponse{
+		Namespaces: []*persistence.GetNamespaceResponse{
+			newNamespace("ns3", enumspb.NAMESPACE_STATE_REGISTERED, "stuck"),
+		},
+	}, nil)
+
+	visibilityManager.EXPECT().CountWorkflowExecutions(gomock.Any(), &manager.CountWorkflowExecutionsRequest{
+		NamespaceID: "ns1-id",
+		Namespace:   "ns1",
+		Query:       "WorkflowType = 'failing'",
+	}).Return(nil, errors.New("invalid query"))
+	visibilityManager.EXPECT().CountWorkflowExecutions(gomock.Any(), &manager.CountWorkflowExecutionsRequest{
+		NamespaceID: "ns1-id",
+		Namespace:   "ns1",
+		Query:       "WorkflowType = 'stuck'",
+	}).Return(&manager.CountWorkflowExecutionsResponse{Count: 7}, nil)
+	visibilityManager.EXPECT().CountWorkflowExecutions(gomock.Any(), &manager.CountWorkflowExecutionsRequest{
+		NamespaceID: "ns3-id",
+		Namespace:   "ns3",
+		Query:       "WorkflowType = 'stuck'",
+	}).Return(&manager.CountWorkflowExecutionsResponse{Count: 0}, nil)
+
+	val, err := env.ExecuteActivity(EvaluatorActivityName)
+	require.NoError(t, err)
+	var result EvaluatorResult
+	require.NoError(t, val.Get(&result))
+	require.Equal(t, EvaluatorResult{EvaluatedCount: 2, ErrorCount: 1}, result)
+
+	snapshot := capture.Snapshot()
+	gauges := snapshot[metrics.SavedVisibilityQueryCount.Name()]
+	require.Len(t, gauges, 2)
+	require.Equal(t, float64(7), gauges[0].Value)
+	require.Equal(t, map[string]string{"namespace": "ns1", "saved_query": "stuck"}, gauges[0].Tags)
+	require.Equal(t, float64(0), gauges[1].Value)
+	require.Equal(t, map[string]string{"namespace": "ns3", "saved_query": "stuck"}, gauges[1].Tags)
+
+	errs := snapshot[metrics.SavedVisibilityQueryErrors.Name()]
+	require.Len(t, errs, 1)
+	require.Equal(t, map[string]string{"namespace": "ns1", "saved_query": "failing"}, errs[0].Tags)
+
+	stale := snapshot[metrics.SavedVisibilityQueryStale.Name()]
+	require.Len(t, stale, 3)
+	require.Equal(t, float64(1), stale[0].Value)
+	require.Equal(t, map[string]string{"namespace": "ns1", "saved_query": "failing"}, stale[0].Tags)
+	require.Equal(t, float64(0), stale[1].Value)
+	require.Equal(t, float64(0), stale[2].Value)
+}
+
+func Test_EvaluateSavedQueries_ResetsDeletedQueries(t *testing.T) {
+	testSuite := &testsuite.WorkflowTestSuite{}
+	env := testSuite.NewTestActivityEnvironment()
+
+	ctrl := gomock.NewController(t)
+	metadataManager := persistence.NewMockMetadataManager(ctrl)
+	visibilityManager := manager.NewMockVisibilityManager(ctrl)
+	captureHandler := metricstest.NewCaptureHandler()
+
+	a := NewActivities(
+		metadataManager,
+		visibilityManager,
+		dynamicconfig.GetFloatPropertyFn(1000),
+		captureHandler,
+		log.NewTestLogger(),
+	)
+	env.RegisterActivityWithOptions(a.EvaluateSavedQueries, activityRegisterOptions)
+
+	gomock.InOrder(
+		metadataManager.EXPECT().ListNamespaces(gomock.Any(), gomock.Any()).Return(&persistence.ListNamespacesResponse{
+			Namespaces: []*persistence.GetNamespaceResponse{
+				newNamespace("ns1", enumspb.NAMESPACE_STATE_REGISTERED, "kept", "deleted"),
+				newNamespace("ns2", enumspb.NAMESPACE_STATE_REGISTERED, "deleted"),
+			},
+		}, nil),
+		// the query is deleted from ns1 and ns2 is deleted
+		metadataManager.EXPECT().ListNamespaces(gomock.Any(), gomock.Any()).Return(&persistence.ListNamespacesResponse{
+			Namespaces: []*persistence.GetNamespaceResponse{
+				newNamespace("ns1", enumspb.NAMESPACE_STATE_REGISTERED, "kept"),
+				newNamespace("ns2", enumspb.NAMESPACE_STATE_DELETED, "deleted"),
+			},
+		}, nil),
+	)
+	visibilityManager.EXPECT().CountWorkflowExecutions(gomock.Any(), gomock.Any()).
+		Return(&manager.CountWorkflowExecutionsResponse{Count: 5}, nil).Times(4)
+
+	_, err := env.ExecuteActivity(EvaluatorActivityName)
+	require.NoError(t, err)
+
+	capture := captureHandler.StartCapture()
+	_, err = env.ExecuteActivity(EvaluatorActivityName)
+	require.NoError(t, err)
+
+	gauges := capture.Snapshot()[metrics.SavedVisibilityQueryCount.Name()]
+	values := make(map[string]any, len(gauges))
+	for _, gauge := range gauges {
+		values[gauge.Tags["namespace"]+"/"+gauge.Tags["saved_query"]] = gauge.Value
+	}
+	require.Equal(t, map[string]any{"ns1/kept": float64(5), "ns1/deleted": float64(0), "ns2/deleted": float64(0)}, values)
+}
+
+func Test_EvaluatorWorkflow(t *testing.T) {
+	testSuite := &testsuite.WorkflowTestSuite{}
+	env := testSuite.NewTestWorkflowEnvironment()
+
+	var a *Activities
+	env.RegisterActivityWithOptions(a.EvaluateSavedQueries, activityRegisterOptions)
+	env.OnActivity(EvaluatorActivityName, mock.Anything).Return(EvaluatorResult{EvaluatedCount: 3}, nil).Once()
+
+	env.ExecuteWorkflow(EvaluatorWorkflow)
+
+	require.True(t, env.IsWorkflowCompleted())
+	require.NoError(t, env.GetWorkflowError())
+	var result EvaluatorResult
+	require.NoError(t, env.GetWorkflowResult(&result))
+	require.Equal(t, EvaluatorResult{EvaluatedCount: 3}, result)
+}
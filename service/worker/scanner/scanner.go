@@ -27,6 +27,7 @@ import (
 	"go.temporal.io/server/common/persistence/visibility/manager"
 	"go.temporal.io/server/common/sdk"
 	"go.temporal.io/server/service/worker/scanner/build_ids"
+	"go.temporal.io/server/service/worker/scanner/savedqueries"
 )
 
 type (
@@ -41,6 +42,8 @@ type (
 		PersistenceMaxQPS dynamicconfig.IntPropertyFn
 		// Persistence contains the persistence configuration
 		Persistence *config.Persistence
+		// HistoryNamespaceShardPools contains the dedicated history shard pools of namespaces
+		HistoryNamespaceShardPools dynamicconfig.TypedPropertyFn[map[string]common.NamespaceShardPool]
 		// TaskQueueScannerEnabled indicates if taskQueue scanner should be started as part of scanner
 		TaskQueueScannerEnabled dynamicconfig.BoolPropertyFn
 		// BuildIdScavengerEnabled indicates if the build ID scavenger should be started as part of scanner
@@ -49,6 +52,8 @@ type (
 		HistoryScannerEnabled dynamicconfig.BoolPropertyFn
 		// ExecutionsScannerEnabled indicates if executions scanner should be started as part of scanner
 		ExecutionsScannerEnabled dynamicconfig.BoolPropertyFn
+		// HistoryReencryptionEnabled indicates if history re-encryption should be started as part of scanner
+		HistoryReencryptionEnabled dynamicconfig.BoolPropertyFn
 		// HistoryScannerDataMinAge indicates the cleanup threshold of history branch data
 		// Only clean up history branches that older than this threshold
 		HistoryScannerDataMinAge dynamicconfig.DurationPropertyFn
@@ -70,6 +75,11 @@ type (
 		RemovableBuildIdDurationSinceDefault dynamicconfig.DurationPropertyFn
 		// BuildIdScavengerVisibilityRPS is the rate limit for visibility calls from the build ID scavenger
 		BuildIdScavengerVisibilityRPS dynamicconfig.FloatPropertyFn
+
+		// SavedQueryEvaluatorEnabled indicates if the saved visibility query evaluator should be started as part of scanner
+		SavedQueryEvaluatorEnabled dynamicconfig.BoolPropertyFn
+		// SavedQueryEvaluatorVisibilityRPS is the rate limit for visibility calls from the saved query evaluator
+		SavedQueryEvaluatorVisibilityRPS dynamicconfig.FloatPropertyFn
 	}
 
 	// scannerContext is the context object that gets
@@ -179,6 +189,12 @@ func (s *Scanner) Start() error {
 		workerTaskQueueNames = append(workerTaskQueueNames, historyScannerTaskQueueName)
 	}
 
+	if s.context.cfg.HistoryReencryptionEnabled() {
+		s.wg.Add(1)
+		go s.startWorkflowWithRetry(ctx, historyReencryptionWFStartOptions, historyReencryptionWFTypeName)
+		workerTaskQueueNames = append(workerTaskQueueNames, historyReencryptionTaskQueueName)
+	}
+
 	if s.context.cfg.BuildIdScavengerEnabled() {
 		s.wg.Add(1)
 		go s.startWorkflowWithRetry(ctx, build_ids.BuildIdScavengerWFStartOptions, build_ids.BuildIdScavangerWorkflowName)
@@ -205,6 +221,28 @@ func (s *Scanner) Start() error {
 		}
 	}
 
+	if s.context.cfg.SavedQueryEvaluatorEnabled() {
+		s.wg.Add(1)
+		go s.startWorkflowWithRetry(ctx, savedqueries.EvaluatorWFStartOptions, savedqueries.EvaluatorWorkflowName)
+
+		savedQueriesActivities := savedqueries.NewActivities(
+			s.context.metadataManager,
+			s.context.visibilityManager,
+			s.context.cfg.SavedQueryEvaluatorVisibilityRPS,
+			s.context.metricsHandler,
+			s.context.logger,
+		)
+
+		work := s.context.sdkClientFactory.NewWorker(s.context.sdkClientFactory.GetSystemClient(), savedqueries.EvaluatorTaskQueueName, workerOpts)
+		work.RegisterWorkflowWithOptions(savedqueries.EvaluatorWorkflow, workflow.RegisterOptions{Name: savedqueries.EvaluatorWorkflowName})
+		work.RegisterActivityWithOptions(savedQueriesActivities.EvaluateSavedQueries, activity.RegisterOptions{Name: savedqueries.EvaluatorActivityName})
+
+		// TODO: Nothing is gracefully stopping these workers or listening for fatal errors.
+		if err := work.Start(); err != nil {
+			return err
+		}
+	}
+
 	// TODO: There's no reason to register all activities and workflows on every task queue.
 	for _, tl := range workerTaskQueueNames {
 		work := s.context.sdkClientFactory.NewWorker(s.context.sdkClientFactory.GetSystemClient(), tl, workerOpts)
@@ -212,9 +250,11 @@ func (s *Scanner) Start() error {
 		work.RegisterWorkflowWithOptions(TaskQueueScannerWorkflow, workflow.RegisterOptions{Name: tqScannerWFTypeName})
 		work.RegisterWorkflowWithOptions(HistoryScannerWorkflow, workflow.RegisterOptions{Name: historyScannerWFTypeName})
 		work.RegisterWorkflowWithOptions(ExecutionsScannerWorkflow, workflow.RegisterOptions{Name: executionsScannerWFTypeName})
+		work.RegisterWorkflowWithOptions(HistoryReencryptionWorkflow, workflow.RegisterOptions{Name: historyReencryptionWFTypeName})
 		work.RegisterActivityWithOptions(TaskQueueScavengerActivity, activity.RegisterOptions{Name: taskQueueScavengerActivityName})
 		work.RegisterActivityWithOptions(HistoryScavengerActivity, activity.RegisterOptions{Name: historyScavengerActivityName})
 		work.RegisterActivityWithOptions(ExecutionsScavengerActivity, activity.RegisterOptions{Name: executionsScavengerActivityName})
+		work.RegisterActivityWithOptions(HistoryReencryptionActivity, activity.RegisterOptions{Name: historyReencryptionActivityName})
 
 		// TODO: Nothing is gracefully stopping these workers or listening for fatal errors.
 		if err := work.Start(); err != nil {
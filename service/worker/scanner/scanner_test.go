@@ -19,6 +19,7 @@ import (
 	"go.temporal.io/server/common/sdk"
 	"go.temporal.io/server/common/testing/mocksdk"
 	"go.temporal.io/server/service/worker/scanner/build_ids"
+	"go.temporal.io/server/service/worker/scanner/savedqueries"
 	"go.uber.org/mock/gomock"
 )
 
@@ -52,6 +53,10 @@ func (s *scannerTestSuite) TestScannerEnabled() {
 		WFTypeName:    build_ids.BuildIdScavangerWorkflowName,
 		TaskQueueName: build_ids.BuildIdScavengerTaskQueueName,
 	}
+	savedQueryEvaluator := expectedScanner{
+		WFTypeName:    savedqueries.EvaluatorWorkflowName,
+		TaskQueueName: savedqueries.EvaluatorTaskQueueName,
+	}
 
 	type testCase struct {
 		Name                     string
@@ -59,6 +64,7 @@ func (s *scannerTestSuite) TestScannerEnabled() {
 		TaskQueueScannerEnabled  bool
 		HistoryScannerEnabled    bool
 		BuildIdScavengerEnabled  bool
+		SavedQueryEvaluator      bool
 		DefaultStore             string
 		ExpectedScanners         []expectedScanner
 	}
@@ -154,6 +160,16 @@ func (s *scannerTestSuite) TestScannerEnabled() {
 			DefaultStore:             config.StoreTypeSQL,
 			ExpectedScanners:         []expectedScanner{buildIdScavenger},
 		},
+		{
+			Name:                     "SavedQueryEvaluator",
+			ExecutionsScannerEnabled: false,
+			TaskQueueScannerEnabled:  false,
+			HistoryScannerEnabled:    false,
+			BuildIdScavengerEnabled:  false,
+			SavedQueryEvaluator:      true,
+			DefaultStore:             config.StoreTypeSQL,
+			ExpectedScanners:         []expectedScanner{savedQueryEvaluator},
+		},
 		{
 			Name:                     "AllScannersSQL",
 			ExecutionsScannerEnabled: true,
@@ -190,6 +206,9 @@ func (s *scannerTestSuite) TestScannerEnabled() {
 					BuildIdScavengerEnabled:                dynamicconfig.GetBoolPropertyFn(c.BuildIdScavengerEnabled),
 					ExecutionsScannerEnabled:               dynamicconfig.GetBoolPropertyFn(c.ExecutionsScannerEnabled),
 					TaskQueueScannerEnabled:                dynamicconfig.GetBoolPropertyFn(c.TaskQueueScannerEnabled),
+					HistoryReencryptionEnabled:             dynamicconfig.GetBoolPropertyFn(false),
+					SavedQueryEvaluatorEnabled:             dynamicconfig.GetBoolPropertyFn(c.SavedQueryEvaluator),
+					SavedQueryEvaluatorVisibilityRPS:       dynamicconfig.GetFloatPropertyFn(10),
 					Persistence: &config.Persistence{
 						DefaultStore: c.DefaultStore,
 						DataStores: map[string]config.DataStore{
@@ -269,6 +288,8 @@ func (s *scannerTestSuite) TestScannerShutdown() {
 			ExecutionsScannerEnabled:               dynamicconfig.GetBoolPropertyFn(false),
 			TaskQueueScannerEnabled:                dynamicconfig.GetBoolPropertyFn(false),
 			BuildIdScavengerEnabled:                dynamicconfig.GetBoolPropertyFn(false),
+			HistoryReencryptionEnabled:             dynamicconfig.GetBoolPropertyFn(false),
+			SavedQueryEvaluatorEnabled:             dynamicconfig.GetBoolPropertyFn(false),
 			Persistence: &config.Persistence{
 				DefaultStore: config.StoreTypeNoSQL,
 				DataStores: map[string]config.DataStore{
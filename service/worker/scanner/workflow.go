@@ -32,6 +32,11 @@ const (
 	executionsScannerWFTypeName     = "temporal-sys-executions-scanner-workflow"
 	executionsScannerTaskQueueName  = "temporal-sys-executions-scanner-taskqueue-0"
 	executionsScavengerActivityName = "temporal-sys-executions-scanner-scvg-activity"
+
+	historyReencryptionWFID          = "temporal-sys-history-reencryption"
+	historyReencryptionWFTypeName    = "temporal-sys-history-reencryption-workflow"
+	historyReencryptionTaskQueueName = "temporal-sys-history-reencryption-taskqueue-0"
+	historyReencryptionActivityName  = "temporal-sys-history-reencryption-activity"
 )
 
 type (
@@ -73,6 +78,12 @@ var (
 		WorkflowIDReusePolicy: enumspb.WORKFLOW_ID_REUSE_POLICY_ALLOW_DUPLICATE,
 		CronSchedule:          "0 */12 * * *",
 	}
+	historyReencryptionWFStartOptions = client.StartWorkflowOptions{
+		ID:                    historyReencryptionWFID,
+		TaskQueue:             historyReencryptionTaskQueueName,
+		WorkflowIDReusePolicy: enumspb.WORKFLOW_ID_REUSE_POLICY_ALLOW_DUPLICATE,
+		CronSchedule:          "0 */12 * * *",
+	}
 )
 
 // TaskQueueScannerWorkflow is the workflow that runs the task queue scanner background daemon
@@ -104,6 +115,42 @@ func ExecutionsScannerWorkflow(
 	return future.Get(ctx, nil)
 }
 
+// HistoryReencryptionWorkflow is the workflow that re-encrypts history after encryption key rotations
+func HistoryReencryptionWorkflow(
+	ctx workflow.Context,
+) error {
+	future := workflow.ExecuteActivity(
+		workflow.WithActivityOptions(ctx, activityOptions),
+		historyReencryptionActivityName,
+	)
+	return future.Get(ctx, nil)
+}
+
+// HistoryReencryptionActivity is the activity that runs the history re-encryption
+func HistoryReencryptionActivity(
+	activityCtx context.Context,
+) (history.ReencryptorHeartbeatDetails, error) {
+	ctx := activityCtx.Value(scannerContextKey).(scannerContext)
+
+	hbd := history.ReencryptorHeartbeatDetails{}
+	if activity.HasHeartbeatDetails(activityCtx) {
+		if err := activity.GetHeartbeatDetails(activityCtx, &hbd); err != nil {
+			ctx.logger.Error("Failed to recover from last heartbeat, start over from beginning", tag.Error(err))
+		}
+	}
+
+	reencryptor := history.NewReencryptor(
+		ctx.cfg.Persistence.NumHistoryShards,
+		ctx.cfg.HistoryNamespaceShardPools,
+		ctx.executionManager,
+		ctx.historyClient,
+		ctx.cfg.PersistenceMaxQPS(),
+		hbd,
+		ctx.logger,
+	)
+	return reencryptor.Run(activityCtx)
+}
+
 // HistoryScavengerActivity is the activity that runs history scavenger
 func HistoryScavengerActivity(
 	activityCtx context.Context,
@@ -122,6 +169,7 @@ func HistoryScavengerActivity(
 
 	scavenger := history.NewScavenger(
 		numShards,
+		ctx.cfg.HistoryNamespaceShardPools,
 		ctx.executionManager,
 		rps,
 		ctx.historyClient,
@@ -168,9 +168,11 @@ func NewConfig(
 
 			PersistenceMaxQPS:                       dynamicconfig.ScannerPersistenceMaxQPS.Get(dc),
 			Persistence:                             persistenceConfig,
+			HistoryNamespaceShardPools:              dynamicconfig.HistoryNamespaceShardPools.Get(dc),
 			TaskQueueScannerEnabled:                 dynamicconfig.TaskQueueScannerEnabled.Get(dc),
 			BuildIdScavengerEnabled:                 dynamicconfig.BuildIdScavengerEnabled.Get(dc),
 			HistoryScannerEnabled:                   dynamicconfig.HistoryScannerEnabled.Get(dc),
+			HistoryReencryptionEnabled:              dynamicconfig.HistoryReencryptionEnabled.Get(dc),
 			ExecutionsScannerEnabled:                dynamicconfig.ExecutionsScannerEnabled.Get(dc),
 			HistoryScannerDataMinAge:                dynamicconfig.HistoryScannerDataMinAge.Get(dc),
 			HistoryScannerVerifyRetention:           dynamicconfig.HistoryScannerVerifyRetention.Get(dc),
@@ -181,6 +183,8 @@ func NewConfig(
 			ExecutionScannerHistoryEventIdValidator: dynamicconfig.ExecutionScannerHistoryEventIdValidator.Get(dc),
 			RemovableBuildIdDurationSinceDefault:    dynamicconfig.RemovableBuildIdDurationSinceDefault.Get(dc),
 			BuildIdScavengerVisibilityRPS:           dynamicconfig.BuildIdScavengerVisibilityRPS.Get(dc),
+			SavedQueryEvaluatorEnabled:              dynamicconfig.SavedQueryEvaluatorEnabled.Get(dc),
+			SavedQueryEvaluatorVisibilityRPS:        dynamicconfig.SavedQueryEvaluatorVisibilityRPS.Get(dc),
 		},
 		BatcherRPS:                           dynamicconfig.BatcherRPS.Get(dc),
 		BatcherConcurrency:                   dynamicconfig.BatcherConcurrency.Get(dc),
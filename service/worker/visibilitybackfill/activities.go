@@ -0,0 +1,375 @@
+package visibilitybackfill
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	commonpb "go.temporal.io/api/common/v1"
+	"go.temporal.io/api/serviceerror"
+	"go.temporal.io/sdk/activity"
+	"go.temporal.io/sdk/temporal"
+	enumsspb "go.temporal.io/server/api/enums/v1"
+	persistencespb "go.temporal.io/server/api/persistence/v1"
+	"go.temporal.io/server/common/dynamicconfig"
+	"go.temporal.io/server/common/headers"
+	"go.temporal.io/server/common/log"
+	"go.temporal.io/server/common/log/tag"
+	"go.temporal.io/server/common/metrics"
+	"go.temporal.io/server/common/namespace"
+	"go.temporal.io/server/common/persistence"
+	"go.temporal.io/server/common/persistence/visibility/manager"
+	"go.temporal.io/server/common/primitives/timestamp"
+	"go.temporal.io/server/common/quotas"
+	"go.temporal.io/server/common/searchattribute"
+)
+
+type (
+	// VisibilityManagersFn returns the source and the target visibility managers of the backfill.
+	VisibilityManagersFn func() (source manager.VisibilityManager, target manager.VisibilityManager, err error)
+
+	Activities struct {
+		executionManager   persistence.ExecutionManager
+		visibilityManagers VisibilityManagersFn
+
+		backfillRPS dynamicconfig.IntPropertyFnWithNamespaceFilter
+
+		metricsHandler metrics.Handler
+		logger         log.Logger
+	}
+
+	LocalActivities struct {
+		namespaceRegistry namespace.Registry
+		numberOfShards    int32
+		logger            log.Logger
+	}
+
+	GetBackfillInfoResult struct {
+		NamespaceID    namespace.ID
+		NumberOfShards int32
+	}
+
+	BackfillShardParams struct {
+		Namespace   namespace.Name
+		NamespaceID namespace.ID
+		ShardID     int32
+		PageSize    int
+	}
+
+	BackfillShardResult struct {
+		// Number of executions of the namespace found in the shard.
+		ScannedCount int
+		// Number of visibility records written to the target store.
+		WrittenCount int
+		// Number of executions which don't have a visibility record, e.g. zombie executions.
+		SkippedCount int
+		// Number of visibility records rejected by the target store.
+		FailedCount int
+	}
+
+	// backfillShardProgress is recorded as heartbeat details. It points to the page which is being processed,
+	// and holds the result of all the previous pages, so a retried activity resumes from that page.
+	backfillShardProgress struct {
+		PageToken []byte
+		Result    BackfillShardResult
+	}
+
+	VerifyParams struct {
+		Namespace   namespace.Name
+		NamespaceID namespace.ID
+	}
+
+	VerifyResult struct {
+		SourceCount int64
+		TargetCount int64
+		Matched     bool
+	}
+)
+
+var errSecondaryVisibilityStoreNotConfigured = temporal.NewNonRetryableApplicationError(
+	"secondary visibility store is not configured", "FailedPrecondition", nil)
+
+func NewActivities(
+	executionManager persistence.ExecutionManager,
+	visibilityManagers VisibilityManagersFn,
+	backfillRPS dynamicconfig.IntPropertyFnWithNamespaceFilter,
+	metricsHandler metrics.Handler,
+	logger log.Logger,
+) *Activities {
+	return &Activities{
+		executionManager:   executionManager,
+		visibilityManagers: visibilityManagers,
+		backfillRPS:        backfillRPS,
+		metricsHandler:     metricsHandler,
+		logger:             logger,
+	}
+}
+
+func NewLocalActivities(
+	namespaceRegistry namespace.Registry,
+	numberOfShards int32,
+	logger log.Logger,
+) *LocalActivities {
+	return &LocalActivities{
+		namespaceRegistry: namespaceRegistry,
+		numberOfShards:    numberOfShards,
+		logger:            logger,
+	}
+}
+
+func (r *BackfillShardResult) add(other BackfillShardResult) {
+	r.ScannedCount += other.ScannedCount
+	r.WrittenCount += other.WrittenCount
+	r.SkippedCount += other.SkippedCount
+	r.FailedCount += other.FailedCount
+}
+
+func (a *LocalActivities) GetBackfillInfoActivity(_ context.Context, nsName namespace.Name) (GetBackfillInfoResult, error) {
+	ns, err := a.namespaceRegistry.GetNamespace(nsName)
+	if err != nil {
+		var nfErr *serviceerror.NamespaceNotFound
+		if errors.As(err, &nfErr) {
+			return GetBackfillInfoResult{}, temporal.NewNonRetryableApplicationError(err.Error(), invalidArgumentErrType, err)
+		}
+		a.logger.Error("Unable to get namespace.", tag.WorkflowNamespace(nsName.String()), tag.Error(err))
+		return GetBackfillInfoResult{}, err
+	}
+	return GetBackfillInfoResult{
+		NamespaceID:    ns.ID(),
+		NumberOfShards: a.numberOfShards,
+	}, nil
+}
+
+func (a *Activities) BackfillShardActivity(ctx context.Context, params BackfillShardParams) (BackfillShardResult, error) {
+	ctx = headers.SetCallerName(ctx, params.Namespace.String())
+	logger := log.With(a.logger,
+		tag.WorkflowNamespace(params.Namespace.String()),
+		tag.WorkflowNamespaceID(params.NamespaceID.String()),
+		tag.ShardID(params.ShardID))
+
+	source, target, err := a.visibilityManagers()
+	if err != nil {
+		logger.Error("Unable to create visibility managers.", tag.Error(err))
+		return BackfillShardResult{}, err
+	}
+
+	var progress backfillShardProgress
+	if activity.HasHeartbeatDetails(ctx) {
+		if err := activity.GetHeartbeatDetails(ctx, &progress); err != nil {
+			// Start the shard over: records which are already written are not overwritten.
+			logger.Warn("Unable to get heartbeat details from previous attempt while backfilling visibility records.", tag.Error(err))
+			progress = backfillShardProgress{}
+		}
+	}
+
+	rateLimiter := quotas.NewDefaultOutgoingRateLimiter(func() float64 {
+		return float64(a.backfillRPS(params.Namespace.String()))
+	})
+	handler := a.metricsHandler.WithTags(metrics.NamespaceTag(params.Namespace.String()))
+
+	for {
+		activity.RecordHeartbeat(ctx, progress)
+		resp, err := a.executionManager.ListConcreteExecutions(ctx, &persistence.ListConcreteExecutionsRequest{
+			ShardID:   params.ShardID,
+			PageSize:  params.PageSize,
+			PageToken: progress.PageToken,
+		})
+		if err != nil {
+			logger.Error("Unable to list workflow executions.", tag.Error(err))
+			return progress.Result, err
+		}
+
+		pageResult := progress.Result
+		for _, state := range resp.States {
+			if state.GetExecutionInfo().GetNamespaceId() != params.NamespaceID.String() {
+				continue
+			}
+			pageResult.ScannedCount++
+
+			if err := rateLimiter.Wait(ctx); err != nil {
+				return progress.Result, fmt.Errorf("rate limiter error: %w", err)
+			}
+
+			written, err := a.backfillExecution(ctx, params, source, target, state)
+			switch {
+			case err == nil && written:
+				pageResult.WrittenCount++
+				metrics.VisibilityBackfillWrittenCount.With(handler).Record(1)
+			case err == nil:
+				pageResult.SkippedCount++
+			case isPermanentError(err):
+				pageResult.FailedCount++
+				metrics.VisibilityBackfillFailedCount.With(handler).Record(1)
+				logger.Warn("Unable to write visibility record to the target store.",
+					tag.WorkflowID(state.GetExecutionInfo().GetWorkflowId()),
+					tag.WorkflowRunID(state.GetExecutionState().GetRunId()),
+					tag.Error(err))
+			default:
+				logger.Error("Unable to backfill visibility record.",
+					tag.WorkflowID(state.GetExecutionInfo().GetWorkflowId()),
+					tag.WorkflowRunID(state.GetExecutionState().GetRunId()),
+					tag.Error(err))
+				return progress.Result, err
+			}
+			activity.RecordHeartbeat(ctx, progress)
+		}
+
+		progress = backfillShardProgress{
+			PageToken: resp.PageToken,
+			Result:    pageResult,
+		}
+		if len(resp.PageToken) == 0 {
+			return progress.Result, nil
+		}
+	}
+}
+
+// backfillExecution writes the visibility record of the execution to the target store. It returns false
+// if the execution doesn't have a visibility record.
+func (a *Activities) backfillExecution(
+	ctx context.Context,
+	params BackfillShardParams,
+	source manager.VisibilityManager,
+	target manager.VisibilityManager,
+	state *persistencespb.WorkflowMutableState,
+) (bool, error) {
+	executionInfo := state.GetExecutionInfo()
+	executionState := state.GetExecutionState()
+	switch executionState.GetState() {
+	case enumsspb.WORKFLOW_EXECUTION_STATE_RUNNING, enumsspb.WORKFLOW_EXECUTION_STATE_COMPLETED:
+	default:
+		return false, nil
+	}
+
+	memo := executionInfo.GetMemo()
+	searchAttributes := executionInfo.GetSearchAttributes()
+	if executionInfo.GetRelocatableAttributesRemoved() {
+		// Memo and search attributes were moved from mutable state to the source visibility store
+		// when the execution was closed.
+		resp, err := source.GetWorkflowExecution(ctx, &manager.GetWorkflowExecutionRequest{
+			NamespaceID: params.NamespaceID,
+			Namespace:   params.Namespace,
+			RunID:       executionState.GetRunId(),
+			WorkflowID:  executionInfo.GetWorkflowId(),
+		})
+		if err != nil {
+			var nfErr *serviceerror.NotFound
+			if errors.As(err, &nfErr) {
+				return false, nil
+			}
+			return false, err
+		}
+		memo = resp.Execution.GetMemo().GetFields()
+		searchAttributes = resp.Execution.GetSearchAttributes().GetIndexedFields()
+	}
+
+	base := newVisibilityRequestBase(params, state, memo, searchAttributes)
+	if executionState.GetState() == enumsspb.WORKFLOW_EXECUTION_STATE_RUNNING {
+		return true, target.UpsertWorkflowExecution(ctx, &manager.UpsertWorkflowExecutionRequest{
+			VisibilityRequestBase: base,
+		})
+	}
+
+	closeTime := timestamp.TimeValue(executionInfo.GetCloseTime())
+	executionDuration := closeTime.Sub(base.ExecutionTime)
+	if executionDuration < 0 {
+		executionDuration = 0
+	}
+	return true, target.RecordWorkflowExecutionClosed(ctx, &manager.RecordWorkflowExecutionClosedRequest{
+		VisibilityRequestBase: base,
+		CloseTime:             closeTime,
+		ExecutionDuration:     executionDuration,
+		HistoryLength:         state.GetNextEventId() - 1,
+		HistorySizeBytes:      executionInfo.GetExecutionStats().GetHistorySize(),
+		StateTransitionCount:  executionInfo.GetStateTransitionCount(),
+	})
+}
+
+// newVisibilityRequestBase builds the visibility request from mutable state the same way
+// the history visibility queue does.
+func newVisibilityRequestBase(
+	params BackfillShardParams,
+	state *persistencespb.WorkflowMutableState,
+	memo map[string]*commonpb.Payload,
+	searchAttributes map[string]*commonpb.Payload,
+) *manager.VisibilityRequestBase {
+	executionInfo := state.GetExecutionInfo()
+	executionState := state.GetExecutionState()
+
+	var parentExecution *commonpb.WorkflowExecution
+	if executionInfo.GetParentWorkflowId() != "" && executionInfo.GetParentRunId() != "" {
+		parentExecution = &commonpb.WorkflowExecution{
+			WorkflowId: executionInfo.GetParentWorkflowId(),
+			RunId:      executionInfo.GetParentRunId(),
+		}
+	}
+
+	base := &manager.VisibilityRequestBase{
+		NamespaceID: params.NamespaceID,
+		Namespace:   params.Namespace,
+		Execution: &commonpb.WorkflowExecution{
+			WorkflowId: executionInfo.GetWorkflowId(),
+			RunId:      executionState.GetRunId(),
+		},
+		WorkflowTypeName: executionInfo.GetWorkflowTypeName(),
+		StartTime:        timestamp.TimeValue(executionState.GetStartTime()),
+		Status:           executionState.GetStatus(),
+		ExecutionTime:    timestamp.TimeValue(executionInfo.GetExecutionTime()),
+		// Records are written with the lowest version, so they never overwrite a record
+		// which was written to the target store by the history service.
+		TaskID:          0,
+		ShardID:         params.ShardID,
+		TaskQueue:       executionInfo.GetTaskQueue(),
+		ParentExecution: parentExecution,
+		RootExecution: &commonpb.WorkflowExecution{
+			WorkflowId: executionInfo.GetRootWorkflowId(),
+			RunId:      executionInfo.GetRootRunId(),
+		},
+	}
+	if memo != nil {
+		base.Memo = &commonpb.Memo{Fields: memo}
+	}
+	if searchAttributes != nil {
+		base.SearchAttributes = &commonpb.SearchAttributes{IndexedFields: searchAttributes}
+	}
+	return base
+}
+
+// isPermanentError returns true if the target store rejected the record itself, so retrying won't help.
+func isPermanentError(err error) bool {
+	var invalidArgumentErr *serviceerror.InvalidArgument
+	return errors.As(err, &invalidArgumentErr)
+}
+
+func (a *Activities) VerifyActivity(ctx context.Context, params VerifyParams) (VerifyResult, error) {
+	ctx = headers.SetCallerName(ctx, params.Namespace.String())
+
+	source, target, err := a.visibilityManagers()
+	if err != nil {
+		return VerifyResult{}, err
+	}
+
+	count := func(visibilityManager manager.VisibilityManager) (int64, error) {
+		resp, err := visibilityManager.CountWorkflowExecutions(ctx, &manager.CountWorkflowExecutionsRequest{
+			NamespaceID: params.NamespaceID,
+			Namespace:   params.Namespace,
+			Query:       searchattribute.QueryWithAnyNamespaceDivision(""),
+		})
+		if err != nil {
+			return 0, err
+		}
+		return resp.Count, nil
+	}
+
+	var result VerifyResult
+	if result.SourceCount, err = count(source); err != nil {
+		a.logger.Error("Unable to count workflow executions in the source visibility store.", tag.WorkflowNamespace(params.Namespace.String()), tag.Error(err))
+		return result, err
+	}
+	if result.TargetCount, err = count(target); err != nil {
+		a.logger.Error("Unable to count workflow executions in the target visibility store.", tag.WorkflowNamespace(params.Namespace.String()), tag.Error(err))
+		return result, err
+	}
+	result.Matched = result.SourceCount == result.TargetCount
+	return result, nil
+}
@@ -0,0 +1,219 @@
+package visibilitybackfill
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	commonpb "go.temporal.io/api/common/v1"
+	enumspb "go.temporal.io/api/enums/v1"
+	"go.temporal.io/api/serviceerror"
+	workflowpb "go.temporal.io/api/workflow/v1"
+	"go.temporal.io/sdk/testsuite"
+	enumsspb "go.temporal.io/server/api/enums/v1"
+	persistencespb "go.temporal.io/server/api/persistence/v1"
+	"go.temporal.io/server/common/dynamicconfig"
+	"go.temporal.io/server/common/log"
+	"go.temporal.io/server/common/metrics"
+	"go.temporal.io/server/common/payload"
+	"go.temporal.io/server/common/persistence"
+	"go.temporal.io/server/common/persistence/visibility/manager"
+	"go.uber.org/mock/gomock"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+var (
+	testStartTime = time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)
+	testCloseTime = testStartTime.Add(time.Minute)
+)
+
+func newTestMutableState(
+	namespaceID string,
+	workflowID string,
+	state enumsspb.WorkflowExecutionState,
+	status enumspb.WorkflowExecutionStatus,
+) *persistencespb.WorkflowMutableState {
+	ms := &persistencespb.WorkflowMutableState{
+		ExecutionInfo: &persistencespb.WorkflowExecutionInfo{
+			NamespaceId:      namespaceID,
+			WorkflowId:       workflowID,
+			WorkflowTypeName: "workflow-type",
+			TaskQueue:        "task-queue",
+			ExecutionTime:    timestamppb.New(testStartTime),
+			Memo:             map[string]*commonpb.Payload{"key": payload.EncodeString("value")},
+		},
+		ExecutionState: &persistencespb.WorkflowExecutionState{
+			RunId:     workflowID + "-run",
+			State:     state,
+			Status:    status,
+			StartTime: timestamppb.New(testStartTime),
+		},
+		NextEventId: 11,
+	}
+	if state == enumsspb.WORKFLOW_EXECUTION_STATE_COMPLETED {
+		ms.ExecutionInfo.CloseTime = timestamppb.New(testCloseTime)
+	}
+	return ms
+}
+
+func newTestActivities(
+	executionManager persistence.ExecutionManager,
+	source manager.VisibilityManager,
+	target manager.VisibilityManager,
+) *Activities {
+	return NewActivities(
+		executionManager,
+		func() (manager.VisibilityManager, manager.VisibilityManager, error) {
+			return source, target, nil
+		},
+		dynamicconfig.GetIntPropertyFnFilteredByNamespace(1000),
+		metrics.NoopMetricsHandler,
+		log.NewTestLogger(),
+	)
+}
+
+func Test_BackfillShardActivity(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	executionManager := persistence.NewMockExecutionManager(ctrl)
+	source := manager.NewMockVisibilityManager(ctrl)
+	target := manager.NewMockVisibilityManager(ctrl)
+
+	relocated := newTestMutableState("namespace-id", "relocated", enumsspb.WORKFLOW_EXECUTION_STATE_COMPLETED, enumspb.WORKFLOW_EXECUTION_STATUS_FAILED)
+	relocated.ExecutionInfo.Memo = nil
+	relocated.ExecutionInfo.RelocatableAttributesRemoved = true
+
+	executionManager.EXPECT().ListConcreteExecutions(gomock.Any(), &persistence.ListConcreteExecutionsRequest{
+		ShardID:  1,
+		PageSize: 10,
+	}).Return(&persistence.ListConcreteExecutionsResponse{
+		States: []*persistencespb.WorkflowMutableState{
+			newTestMutableState("namespace-id", "running", enumsspb.WORKFLOW_EXECUTION_STATE_RUNNING, enumspb.WORKFLOW_EXECUTION_STATUS_RUNNING),
+			newTestMutableState("other-namespace-id", "other", enumsspb.WORKFLOW_EXECUTION_STATE_RUNNING, enumspb.WORKFLOW_EXECUTION_STATUS_RUNNING),
+			newTestMutableState("namespace-id", "zombie", enumsspb.WORKFLOW_EXECUTION_STATE_ZOMBIE, enumspb.WORKFLOW_EXECUTION_STATUS_RUNNING),
+		},
+		PageToken: []byte("page-token"),
+	}, nil)
+	executionManager.EXPECT().ListConcreteExecutions(gomock.Any(), &persistence.ListConcreteExecutionsRequest{
+		ShardID:   1,
+		PageSize:  10,
+		PageToken: []byte("page-token"),
+	}).Return(&persistence.ListConcreteExecutionsResponse{
+		States: []*persistencespb.WorkflowMutableState{
+			newTestMutableState("namespace-id", "closed", enumsspb.WORKFLOW_EXECUTION_STATE_COMPLETED, enumspb.WORKFLOW_EXECUTION_STATUS_COMPLETED),
+			relocated,
+		},
+	}, nil)
+
+	target.EXPECT().UpsertWorkflowExecution(gomock.Any(), gomock.Any()).DoAndReturn(
+		func(_ any, request *manager.UpsertWorkflowExecutionRequest) error {
+			require.Equal(t, "running", request.Execution.GetWorkflowId())
+			require.Equal(t, int64(0), request.TaskID)
+			require.Equal(t, int32(1), request.ShardID)
+			require.Equal(t, enumspb.WORKFLOW_EXECUTION_STATUS_RUNNING, request.Status)
+			require.Equal(t, testStartTime, request.StartTime)
+			require.Contains(t, request.Memo.GetFields(), "key")
+			return nil
+		})
+	target.EXPECT().RecordWorkflowExecutionClosed(gomock.Any(), gomock.Any()).DoAndReturn(
+		func(_ any, request *manager.RecordWorkflowExecutionClosedRequest) error {
+			require.Equal(t, "closed", request.Execution.GetWorkflowId())
+			require.Equal(t, testCloseTime, request.CloseTime)
+			require.Equal(t, time.Minute, request.ExecutionDuration)
+			require.Equal(t, int64(10), request.HistoryLength)
+			return nil
+		})
+	source.EXPECT().GetWorkflowExecution(gomock.Any(), &manager.GetWorkflowExecutionRequest{
+		NamespaceID: "namespace-id",
+		Namespace:   "namespace",
+		WorkflowID:  "relocated",
+		RunID:       "relocated-run",
+	}).Return(&manager.GetWorkflowExecutionResponse{
+		Execution: &workflowpb.WorkflowExecutionInfo{
+			Memo: &commonpb.Memo{Fields: map[string]*commonpb.Payload{"relocated-key": payload.EncodeString("value")}},
+		},
+	}, nil)
+	target.EXPECT().RecordWorkflowExecutionClosed(gomock.Any(), gomock.Any()).DoAndReturn(
+		func(_ any, request *manager.RecordWorkflowExecutionClosedRequest) error {
+			require.Equal(t, "relocated", request.Execution.GetWorkflowId())
+			require.Contains(t, request.Memo.GetFields(), "relocated-key")
+			return serviceerror.NewInvalidArgument("invalid record")
+		})
+
+	testSuite := &testsuite.WorkflowTestSuite{}
+	env := testSuite.NewTestActivityEnvironment()
+	a := newTestActivities(executionManager, source, target)
+	env.RegisterActivity(a)
+
+	value, err := env.ExecuteActivity(a.BackfillShardActivity, BackfillShardParams{
+		Namespace:   "namespace",
+		NamespaceID: "namespace-id",
+		ShardID:     1,
+		PageSize:    10,
+	})
+	require.NoError(t, err)
+	var result BackfillShardResult
+	require.NoError(t, value.Get(&result))
+	require.Equal(t, BackfillShardResult{
+		ScannedCount: 4,
+		WrittenCount: 2,
+		SkippedCount: 1,
+		FailedCount:  1,
+	}, result)
+}
+
+func Test_BackfillShardActivity_ResumeFromHeartbeat(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	executionManager := persistence.NewMockExecutionManager(ctrl)
+	target := manager.NewMockVisibilityManager(ctrl)
+
+	executionManager.EXPECT().ListConcreteExecutions(gomock.Any(), &persistence.ListConcreteExecutionsRequest{
+		ShardID:   1,
+		PageSize:  10,
+		PageToken: []byte("page-token"),
+	}).Return(&persistence.ListConcreteExecutionsResponse{
+		States: []*persistencespb.WorkflowMutableState{
+			newTestMutableState("namespace-id", "running", enumsspb.WORKFLOW_EXECUTION_STATE_RUNNING, enumspb.WORKFLOW_EXECUTION_STATUS_RUNNING),
+		},
+	}, nil)
+	target.EXPECT().UpsertWorkflowExecution(gomock.Any(), gomock.Any()).Return(serviceerror.NewUnavailable("unavailable"))
+
+	testSuite := &testsuite.WorkflowTestSuite{}
+	env := testSuite.NewTestActivityEnvironment()
+	a := newTestActivities(executionManager, nil, target)
+	env.RegisterActivity(a)
+	env.SetHeartbeatDetails(backfillShardProgress{
+		PageToken: []byte("page-token"),
+		Result:    BackfillShardResult{ScannedCount: 5, WrittenCount: 5},
+	})
+
+	_, err := env.ExecuteActivity(a.BackfillShardActivity, BackfillShardParams{
+		Namespace:   "namespace",
+		NamespaceID: "namespace-id",
+		ShardID:     1,
+		PageSize:    10,
+	})
+	require.ErrorContains(t, err, "unavailable")
+}
+
+func Test_VerifyActivity(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	source := manager.NewMockVisibilityManager(ctrl)
+	target := manager.NewMockVisibilityManager(ctrl)
+
+	source.EXPECT().CountWorkflowExecutions(gomock.Any(), gomock.Any()).Return(&manager.CountWorkflowExecutionsResponse{Count: 10}, nil)
+	target.EXPECT().CountWorkflowExecutions(gomock.Any(), gomock.Any()).Return(&manager.CountWorkflowExecutionsResponse{Count: 9}, nil)
+
+	testSuite := &testsuite.WorkflowTestSuite{}
+	env := testSuite.NewTestActivityEnvironment()
+	a := newTestActivities(nil, source, target)
+	env.RegisterActivity(a)
+
+	value, err := env.ExecuteActivity(a.VerifyActivity, VerifyParams{
+		Namespace:   "namespace",
+		NamespaceID: "namespace-id",
+	})
+	require.NoError(t, err)
+	var result VerifyResult
+	require.NoError(t, value.Get(&result))
+	require.Equal(t, VerifyResult{SourceCount: 10, TargetCount: 9, Matched: false}, result)
+}
@@ -0,0 +1,159 @@
+package visibilitybackfill
+
+import (
+	"context"
+	"sync"
+
+	sdkworker "go.temporal.io/sdk/worker"
+	"go.temporal.io/sdk/workflow"
+	"go.temporal.io/server/common/config"
+	"go.temporal.io/server/common/dynamicconfig"
+	"go.temporal.io/server/common/headers"
+	"go.temporal.io/server/common/log"
+	"go.temporal.io/server/common/metrics"
+	"go.temporal.io/server/common/namespace"
+	"go.temporal.io/server/common/persistence"
+	"go.temporal.io/server/common/persistence/visibility"
+	"go.temporal.io/server/common/persistence/visibility/manager"
+	"go.temporal.io/server/common/persistence/visibility/store/elasticsearch"
+	"go.temporal.io/server/common/primitives"
+	"go.temporal.io/server/common/resolver"
+	"go.temporal.io/server/common/searchattribute"
+	workercommon "go.temporal.io/server/service/worker/common"
+	"go.uber.org/fx"
+)
+
+type (
+	// visibilityBackfillComponent copies visibility records of existing executions to the secondary visibility store.
+	visibilityBackfillComponent struct {
+		componentParams
+
+		backfillRPS dynamicconfig.IntPropertyFnWithNamespaceFilter
+
+		managersLock sync.Mutex
+		source       manager.VisibilityManager
+		target       manager.VisibilityManager
+	}
+
+	componentParams struct {
+		fx.In
+		DynamicCollection              *dynamicconfig.Collection
+		PersistenceConfig              *config.Persistence
+		PersistenceServiceResolver     resolver.ServiceResolver
+		VisibilityStoreFactory         visibility.VisibilityStoreFactory
+		SearchAttributesProvider       searchattribute.Provider
+		SearchAttributesMapperProvider searchattribute.MapperProvider
+		NamespaceRegistry              namespace.Registry
+		ExecutionManager               persistence.ExecutionManager
+		MetricsHandler                 metrics.Handler
+		Logger                         log.Logger
+	}
+)
+
+var Module = workercommon.AnnotateWorkerComponentProvider(newComponent)
+
+func newComponent(
+	params componentParams,
+) workercommon.WorkerComponent {
+	return &visibilityBackfillComponent{
+		componentParams: params,
+		backfillRPS:     dynamicconfig.VisibilityBackfillRPS.Get(params.DynamicCollection),
+	}
+}
+
+func (wc *visibilityBackfillComponent) RegisterWorkflow(registry sdkworker.Registry) {
+	registry.RegisterWorkflowWithOptions(VisibilityBackfillWorkflow, workflow.RegisterOptions{Name: WorkflowName})
+	registry.RegisterActivity(wc.localActivities())
+}
+
+func (wc *visibilityBackfillComponent) DedicatedWorkflowWorkerOptions() *workercommon.DedicatedWorkerOptions {
+	// use default worker
+	return nil
+}
+
+func (wc *visibilityBackfillComponent) RegisterActivities(registry sdkworker.Registry) {
+	registry.RegisterActivity(wc.activities())
+}
+
+func (wc *visibilityBackfillComponent) DedicatedActivityWorkerOptions() *workercommon.DedicatedWorkerOptions {
+	return &workercommon.DedicatedWorkerOptions{
+		TaskQueue: primitives.VisibilityBackfillActivityTQ,
+		Options: sdkworker.Options{
+			BackgroundActivityContext: headers.SetCallerType(context.Background(), headers.CallerTypePreemptable),
+		},
+	}
+}
+
+func (wc *visibilityBackfillComponent) activities() *Activities {
+	return NewActivities(
+		wc.ExecutionManager,
+		wc.visibilityManagers,
+		wc.backfillRPS,
+		wc.MetricsHandler,
+		wc.Logger,
+	)
+}
+
+func (wc *visibilityBackfillComponent) localActivities() *LocalActivities {
+	return NewLocalActivities(
+		wc.NamespaceRegistry,
+		wc.PersistenceConfig.NumHistoryShards,
+		wc.Logger,
+	)
+}
+
+// visibilityManagers lazily creates a visibility manager for each of the configured visibility stores.
+// The primary store is the source of the backfill and the secondary store is the target. Unlike the
+// worker visibility manager, the target manager is able to write, so it is created only when a backfill
+// actually runs.
+func (wc *visibilityBackfillComponent) visibilityManagers() (manager.VisibilityManager, manager.VisibilityManager, error) {
+	wc.managersLock.Lock()
+	defer wc.managersLock.Unlock()
+
+	if wc.target != nil {
+		return wc.source, wc.target, nil
+	}
+	if wc.PersistenceConfig.SecondaryVisibilityStore == "" {
+		return nil, nil, errSecondaryVisibilityStoreNotConfigured
+	}
+
+	dc := wc.DynamicCollection
+	visibilityManager, err := visibility.NewManager(
+		*wc.PersistenceConfig,
+		wc.PersistenceServiceResolver,
+		wc.VisibilityStoreFactory,
+		&elasticsearch.ProcessorConfig{
+			IndexerConcurrency:       dynamicconfig.WorkerIndexerConcurrency.Get(dc),
+			ESProcessorNumOfWorkers:  dynamicconfig.WorkerESProcessorNumOfWorkers.Get(dc),
+			ESProcessorBulkActions:   dynamicconfig.WorkerESProcessorBulkActions.Get(dc),
+			ESProcessorBulkSize:      dynamicconfig.WorkerESProcessorBulkSize.Get(dc),
+			ESProcessorFlushInterval: dynamicconfig.WorkerESProcessorFlushInterval.Get(dc),
+			ESProcessorAckTimeout:    dynamicconfig.WorkerESProcessorAckTimeout.Get(dc),
+		},
+		wc.SearchAttributesProvider,
+		wc.SearchAttributesMapperProvider,
+		wc.NamespaceRegistry,
+		dynamicconfig.VisibilityPersistenceMaxReadQPS.Get(dc),
+		dynamicconfig.VisibilityPersistenceMaxWriteQPS.Get(dc),
+		dynamicconfig.OperatorRPSRatio.Get(dc),
+		dynamicconfig.VisibilityPersistenceSlowQueryThreshold.Get(dc),
+		dynamicconfig.GetBoolPropertyFnFilteredByNamespace(false),
+		dynamicconfig.GetBoolPropertyFn(false),
+		dynamicconfig.GetStringPropertyFn(visibility.SecondaryVisibilityWritingModeOn),
+		dynamicconfig.VisibilityDisableOrderByClause.Get(dc),
+		dynamicconfig.VisibilityEnableManualPagination.Get(dc),
+		wc.MetricsHandler,
+		wc.Logger,
+	)
+	if err != nil {
+		return nil, nil, err
+	}
+	dualManager, ok := visibilityManager.(*visibility.VisibilityManagerDual)
+	if !ok {
+		visibilityManager.Close()
+		return nil, nil, errSecondaryVisibilityStoreNotConfigured
+	}
+	wc.source = dualManager.GetPrimaryVisibility()
+	wc.target = dualManager.GetSecondaryVisibility()
+	return wc.source, wc.target, nil
+}
@@ -0,0 +1,205 @@
+package visibilitybackfill
+
+import (
+	"encoding/json"
+	"time"
+
+	"go.temporal.io/sdk/log"
+	"go.temporal.io/sdk/temporal"
+	"go.temporal.io/sdk/workflow"
+	"go.temporal.io/server/common/log/tag"
+	"go.temporal.io/server/common/namespace"
+	"go.temporal.io/server/common/primitives"
+)
+
+const (
+	WorkflowName = "temporal-sys-visibility-backfill-workflow"
+	StatsQuery   = "stats"
+
+	defaultPageSize           = 500
+	defaultShardsPerExecution = 64
+
+	invalidArgumentErrType = "InvalidArgument"
+)
+
+type (
+	VisibilityBackfillParams struct {
+		Namespace namespace.Name
+		// Page size to read executions from the execution store.
+		PageSize int
+		// Number of shards processed before returning ContinueAsNew.
+		ShardsPerExecution int
+		// Skip the final pass which compares the number of executions in the source and target stores.
+		SkipVerification bool
+
+		// To carry over progress with ContinueAsNew.
+		NamespaceID        namespace.ID
+		NumberOfShards     int32
+		NextShardID        int32
+		PreviousResult     BackfillShardResult
+		ContinueAsNewCount int
+		// Time when the first run (in a chain of CANs) of VisibilityBackfillWorkflow has started.
+		FirstRunStartTime time.Time
+	}
+
+	VisibilityBackfillResult struct {
+		BackfillShardResult
+		Verification *VerifyResult
+	}
+
+	VisibilityBackfillStats struct {
+		BackfillShardResult
+		NumberOfShards     int32
+		CompletedShards    int32
+		ContinueAsNewCount int
+		StartTime          time.Time
+		Verification       *VerifyResult
+	}
+)
+
+var (
+	retryPolicy = &temporal.RetryPolicy{
+		InitialInterval: 1 * time.Second,
+		MaximumInterval: 10 * time.Second,
+	}
+
+	localActivityOptions = workflow.LocalActivityOptions{
+		RetryPolicy:            retryPolicy,
+		StartToCloseTimeout:    30 * time.Second,
+		ScheduleToCloseTimeout: 5 * time.Minute,
+	}
+
+	backfillShardActivityOptions = workflow.ActivityOptions{
+		RetryPolicy:         retryPolicy,
+		StartToCloseTimeout: 60 * time.Minute,
+		HeartbeatTimeout:    30 * time.Second,
+	}
+
+	verifyActivityOptions = workflow.ActivityOptions{
+		RetryPolicy:         retryPolicy,
+		StartToCloseTimeout: 5 * time.Minute,
+	}
+)
+
+func (p *VisibilityBackfillParams) String() string {
+	paramsBytes, _ := json.Marshal(p)
+	return string(paramsBytes)
+}
+
+func validateParams(ctx workflow.Context, params *VisibilityBackfillParams) error {
+	if params.Namespace.IsEmpty() {
+		return temporal.NewNonRetryableApplicationError("namespace is required", invalidArgumentErrType, nil)
+	}
+	if params.PageSize <= 0 {
+		params.PageSize = defaultPageSize
+	}
+	if params.ShardsPerExecution <= 0 {
+		params.ShardsPerExecution = defaultShardsPerExecution
+	}
+	if params.NextShardID <= 0 {
+		// Shard IDs start from 1.
+		params.NextShardID = 1
+	}
+	if params.FirstRunStartTime.IsZero() {
+		params.FirstRunStartTime = workflow.Now(ctx).UTC()
+	}
+	return nil
+}
+
+// VisibilityBackfillWorkflow rebuilds visibility records of all executions of a namespace from their mutable state
+// and writes them to the secondary visibility store. Shards are processed one by one and each shard activity
+// checkpoints its progress with heartbeats. When all shards are processed, the number of executions in the primary
+// and secondary visibility stores is compared.
+func VisibilityBackfillWorkflow(ctx workflow.Context, params VisibilityBackfillParams) (VisibilityBackfillResult, error) {
+	logger := log.With(
+		workflow.GetLogger(ctx),
+		tag.WorkflowType(WorkflowName),
+		tag.WorkflowNamespace(params.Namespace.String()))
+
+	result := VisibilityBackfillResult{
+		BackfillShardResult: params.PreviousResult,
+	}
+
+	if err := validateParams(ctx, &params); err != nil {
+		return result, err
+	}
+	logger.Info("Workflow started.", tag.Value(params.String()))
+
+	if err := workflow.SetQueryHandler(ctx, StatsQuery, func() (VisibilityBackfillStats, error) {
+		return VisibilityBackfillStats{
+			BackfillShardResult: result.BackfillShardResult,
+			NumberOfShards:      params.NumberOfShards,
+			CompletedShards:     params.NextShardID - 1,
+			ContinueAsNewCount:  params.ContinueAsNewCount,
+			StartTime:           params.FirstRunStartTime,
+			Verification:        result.Verification,
+		}, nil
+	}); err != nil {
+		return result, err
+	}
+
+	var a *Activities
+	var la *LocalActivities
+
+	if params.NamespaceID.IsEmpty() {
+		ctx1 := workflow.WithLocalActivityOptions(ctx, localActivityOptions)
+		var info GetBackfillInfoResult
+		if err := workflow.ExecuteLocalActivity(ctx1, la.GetBackfillInfoActivity, params.Namespace).Get(ctx, &info); err != nil {
+			return result, err
+		}
+		params.NamespaceID = info.NamespaceID
+		params.NumberOfShards = info.NumberOfShards
+	}
+
+	ctx = workflow.WithTaskQueue(ctx, primitives.VisibilityBackfillActivityTQ)
+
+	for i := 0; i < params.ShardsPerExecution && params.NextShardID <= params.NumberOfShards; i++ {
+		ctx1 := workflow.WithActivityOptions(ctx, backfillShardActivityOptions)
+		var shardResult BackfillShardResult
+		err := workflow.ExecuteActivity(ctx1, a.BackfillShardActivity, BackfillShardParams{
+			Namespace:   params.Namespace,
+			NamespaceID: params.NamespaceID,
+			ShardID:     params.NextShardID,
+			PageSize:    params.PageSize,
+		}).Get(ctx, &shardResult)
+		if err != nil {
+			return result, err
+		}
+		result.add(shardResult)
+		params.NextShardID++
+	}
+
+	if params.NextShardID <= params.NumberOfShards {
+		// Continue as new to prevent workflow history size explosion.
+		params.PreviousResult = result.BackfillShardResult
+		params.ContinueAsNewCount++
+		logger.Info("There are more shards to backfill. Continuing workflow as new.", tag.ShardID(params.NextShardID), tag.Counter(params.ContinueAsNewCount))
+		return result, workflow.NewContinueAsNewError(ctx, VisibilityBackfillWorkflow, params)
+	}
+
+	logger.Info("Finished backfilling visibility records.",
+		tag.NewInt("scanned", result.ScannedCount),
+		tag.NewInt("written", result.WrittenCount),
+		tag.NewInt("skipped", result.SkippedCount),
+		tag.NewInt("failed", result.FailedCount))
+
+	if params.SkipVerification {
+		return result, nil
+	}
+
+	ctx2 := workflow.WithActivityOptions(ctx, verifyActivityOptions)
+	var verifyResult VerifyResult
+	if err := workflow.ExecuteActivity(ctx2, a.VerifyActivity, VerifyParams{
+		Namespace:   params.Namespace,
+		NamespaceID: params.NamespaceID,
+	}).Get(ctx, &verifyResult); err != nil {
+		return result, err
+	}
+	result.Verification = &verifyResult
+	if !verifyResult.Matched {
+		logger.Warn("Number of executions in the target visibility store doesn't match the source visibility store.",
+			tag.NewInt64("source-count", verifyResult.SourceCount),
+			tag.NewInt64("target-count", verifyResult.TargetCount))
+	}
+	return result, nil
+}
@@ -0,0 +1,105 @@
+package visibilitybackfill
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"go.temporal.io/sdk/testsuite"
+	"go.temporal.io/sdk/workflow"
+	"go.temporal.io/server/common/log"
+	"go.temporal.io/server/common/payloads"
+)
+
+func Test_VisibilityBackfillWorkflow_Success(t *testing.T) {
+	testSuite := &testsuite.WorkflowTestSuite{}
+	testSuite.SetLogger(log.NewSdkLogger(log.NewTestLogger()))
+	env := testSuite.NewTestWorkflowEnvironment()
+
+	var a *Activities
+	var la *LocalActivities
+
+	env.OnActivity(la.GetBackfillInfoActivity, mock.Anything, mock.Anything).Return(GetBackfillInfoResult{
+		NamespaceID:    "namespace-id",
+		NumberOfShards: 2,
+	}, nil).Once()
+	for shardID := int32(1); shardID <= 2; shardID++ {
+		env.OnActivity(a.BackfillShardActivity, mock.Anything, BackfillShardParams{
+			Namespace:   "namespace",
+			NamespaceID: "namespace-id",
+			ShardID:     shardID,
+			PageSize:    defaultPageSize,
+		}).Return(BackfillShardResult{
+			ScannedCount: 3,
+			WrittenCount: 2,
+			SkippedCount: 1,
+		}, nil).Once()
+	}
+	env.OnActivity(a.VerifyActivity, mock.Anything, VerifyParams{
+		Namespace:   "namespace",
+		NamespaceID: "namespace-id",
+	}).Return(VerifyResult{SourceCount: 4, TargetCount: 4, Matched: true}, nil).Once()
+
+	env.ExecuteWorkflow(VisibilityBackfillWorkflow, VisibilityBackfillParams{
+		Namespace: "namespace",
+	})
+
+	require.True(t, env.IsWorkflowCompleted())
+	require.NoError(t, env.GetWorkflowError())
+	var result VisibilityBackfillResult
+	require.NoError(t, env.GetWorkflowResult(&result))
+	require.Equal(t, BackfillShardResult{ScannedCount: 6, WrittenCount: 4, SkippedCount: 2}, result.BackfillShardResult)
+	require.Equal(t, &VerifyResult{SourceCount: 4, TargetCount: 4, Matched: true}, result.Verification)
+
+	stats, err := env.QueryWorkflow(StatsQuery)
+	require.NoError(t, err)
+	var s VisibilityBackfillStats
+	require.NoError(t, stats.Get(&s))
+	require.Equal(t, int32(2), s.NumberOfShards)
+	require.Equal(t, int32(2), s.CompletedShards)
+	require.Equal(t, 6, s.ScannedCount)
+}
+
+func Test_VisibilityBackfillWorkflow_ContinueAsNew(t *testing.T) {
+	testSuite := &testsuite.WorkflowTestSuite{}
+	testSuite.SetLogger(log.NewSdkLogger(log.NewTestLogger()))
+	env := testSuite.NewTestWorkflowEnvironment()
+
+	var a *Activities
+
+	env.OnActivity(a.BackfillShardActivity, mock.Anything, mock.Anything).Return(BackfillShardResult{
+		ScannedCount: 1,
+		WrittenCount: 1,
+	}, nil).Twice()
+
+	env.ExecuteWorkflow(VisibilityBackfillWorkflow, VisibilityBackfillParams{
+		Namespace:          "namespace",
+		NamespaceID:        "namespace-id",
+		NumberOfShards:     4,
+		ShardsPerExecution: 2,
+		PreviousResult:     BackfillShardResult{ScannedCount: 10, WrittenCount: 10},
+	})
+
+	require.True(t, env.IsWorkflowCompleted())
+	err := env.GetWorkflowError()
+	require.Error(t, err)
+	var canErr *workflow.ContinueAsNewError
+	require.ErrorAs(t, err, &canErr)
+
+	var params VisibilityBackfillParams
+	require.NoError(t, payloads.Decode(canErr.Input, &params))
+	require.Equal(t, int32(3), params.NextShardID)
+	require.Equal(t, 1, params.ContinueAsNewCount)
+	require.Equal(t, BackfillShardResult{ScannedCount: 12, WrittenCount: 12}, params.PreviousResult)
+}
+
+func Test_VisibilityBackfillWorkflow_NoNamespace(t *testing.T) {
+	testSuite := &testsuite.WorkflowTestSuite{}
+	testSuite.SetLogger(log.NewSdkLogger(log.NewTestLogger()))
+	env := testSuite.NewTestWorkflowEnvironment()
+
+	env.ExecuteWorkflow(VisibilityBackfillWorkflow, VisibilityBackfillParams{})
+
+	require.True(t, env.IsWorkflowCompleted())
+	require.ErrorContains(t, env.GetWorkflowError(), "namespace is required")
+}
@@ -53,6 +53,8 @@ const (
 	readSchemaVersionCQL        = `SELECT curr_version from schema_version where keyspace_name=?`
 	listTablesCQL               = `SELECT table_name from system_schema.tables where keyspace_name=?`
 	listTypesCQL                = `SELECT type_name from system_schema.types where keyspace_name=?`
+	listColumnsCQL              = `SELECT table_name, column_name from system_schema.columns where keyspace_name=?`
+	listIndexesCQL              = `SELECT table_name, index_name from system_schema.indexes where keyspace_name=?`
 	writeSchemaVersionCQL       = `INSERT into schema_version(keyspace_name, creation_time, curr_version, min_compatible_version) VALUES (?,?,?,?)`
 	writeSchemaUpdateHistoryCQL = `INSERT into schema_update_history(year, month, update_time, old_version, new_version, manifest_md5, description) VALUES(?,?,?,?,?,?,?)`
 
@@ -79,6 +81,7 @@ const (
 )
 
 var _ schema.DB = (*cqlClient)(nil)
+var _ schema.SchemaDescriber = (*cqlClient)(nil)
 
 // newCQLClient returns a new instance of CQLClient
 func newCQLClient(cfg *CQLClientConfig, logger log.Logger) (*cqlClient, error) {
@@ -229,6 +232,36 @@ func (client *cqlClient) ListTables() ([]string, error) {
 	return names, nil
 }
 
+// DescribeSchema returns the tables, columns and indexes of the Keyspace
+func (client *cqlClient) DescribeSchema() (*schema.SchemaModel, error) {
+	tables, err := client.ListTables()
+	if err != nil {
+		return nil, err
+	}
+	model := schema.NewSchemaModel()
+	for _, table := range tables {
+		model.AddTable(table)
+	}
+
+	var table, name string
+	iter := client.session.Query(listColumnsCQL, client.keyspace).Iter()
+	for iter.Scan(&table, &name) {
+		model.AddTable(table).AddColumn(name)
+	}
+	if err := iter.Close(); err != nil {
+		return nil, err
+	}
+
+	iter = client.session.Query(listIndexesCQL, client.keyspace).Iter()
+	for iter.Scan(&table, &name) {
+		model.AddTable(table).AddIndex(name)
+	}
+	if err := iter.Close(); err != nil {
+		return nil, err
+	}
+	return model, nil
+}
+
 // listTypes lists the User defined types in a Keyspace
 func (client *cqlClient) listTypes() ([]string, error) {
 	qry := client.session.Query(listTypesCQL, client.keyspace)
@@ -64,6 +64,27 @@ func updateSchema(cli *cli.Context, logger log.Logger) error {
 	return nil
 }
 
+// planSchema executes the planSchemaTask
+// using the given command line args as input
+func planSchema(cli *cli.Context, logger log.Logger) error {
+	config, err := newCQLClientConfig(cli)
+	if err != nil {
+		logger.Error("Unable to read config.", tag.Error(schema.NewConfigError(err.Error())))
+		return err
+	}
+	client, err := newCQLClient(config, logger)
+	if err != nil {
+		logger.Error("Unable to establish CQL session.", tag.Error(err))
+		return err
+	}
+	defer client.Close()
+	if err := schema.Plan(cli, client, logger); err != nil {
+		logger.Error("Unable to plan CQL schema update.", tag.Error(err))
+		return err
+	}
+	return nil
+}
+
 func createKeyspace(cli *cli.Context, logger log.Logger) error {
 	config, err := newCQLClientConfig(cli)
 	if err != nil {
@@ -2,6 +2,7 @@ package schema
 
 import (
 	"fmt"
+	"os"
 	"slices"
 	"strings"
 
@@ -28,6 +29,16 @@ func Update(cli *cli.Context, db DB, logger log.Logger) error {
 	return NewUpdateSchemaTask(db, cfg, logger).Run()
 }
 
+// Plan prints the schema drift and the statements an update of the specified
+// database would execute, without applying anything
+func Plan(cli *cli.Context, db DB, logger log.Logger) error {
+	cfg, err := newUpdateConfig(cli, db)
+	if err != nil {
+		return err
+	}
+	return NewPlanSchemaTask(db, cfg, logger, os.Stdout).Run()
+}
+
 func newUpdateConfig(cli *cli.Context, db DB) (*UpdateConfig, error) {
 	config := new(UpdateConfig)
 	config.SchemaDir = cli.String(CLIOptSchemaDir)
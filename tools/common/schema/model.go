@@ -0,0 +1,346 @@
+package schema
+
+import (
+	"fmt"
+	"regexp"
+	"slices"
+	"strings"
+)
+
+type (
+	// SchemaModel is a simplified model of a database schema which only tracks
+	// tables together with their columns and indexes. It is built either by
+	// replaying the DDL of the versioned schema directories or by introspecting
+	// a live database, which makes it possible to detect drift between the two.
+	SchemaModel struct {
+		Tables map[string]*TableModel
+	}
+
+	// TableModel holds the column and index names of a single table
+	TableModel struct {
+		Columns map[string]struct{}
+		Indexes map[string]struct{}
+	}
+
+	// SchemaDiff lists the differences between an expected and an actual schema.
+	// Columns and indexes are reported as <table>.<name>.
+	SchemaDiff struct {
+		MissingTables  []string
+		ExtraTables    []string
+		MissingColumns []string
+		ExtraColumns   []string
+		MissingIndexes []string
+		ExtraIndexes   []string
+	}
+)
+
+var (
+	createTableRegex = regexp.MustCompile(`(?is)^create\s+table\s+(?:if\s+not\s+exists\s+)?([^\s(]+)\s*\(`)
+	createIndexRegex = regexp.MustCompile(`(?is)^create\s+(?:unique\s+|inverted\s+|custom\s+)?index\s+(?:concurrently\s+)?(?:if\s+not\s+exists\s+)?([^\s(]+)\s+on\s+([^\s(]+)`)
+	alterTableRegex  = regexp.MustCompile(`(?is)^alter\s+table\s+(?:if\s+exists\s+)?([^\s(]+)\s+(.*)$`)
+	dropTableRegex   = regexp.MustCompile(`(?is)^drop\s+table\s+(?:if\s+exists\s+)?([^\s;]+)`)
+	dropIndexRegex   = regexp.MustCompile(`(?is)^drop\s+index\s+(?:concurrently\s+)?(?:if\s+exists\s+)?([^\s;]+)(?:\s+on\s+([^\s;]+))?`)
+
+	// Schema version bookkeeping tables are created by the schema tool itself
+	// and never show up in the versioned schema directories.
+	ignoredTables = []string{"schema_version", "schema_update_history"}
+)
+
+// NewSchemaModel returns an empty SchemaModel
+func NewSchemaModel() *SchemaModel {
+	return &SchemaModel{
+		Tables: make(map[string]*TableModel),
+	}
+}
+
+// AddTable adds a table to the model if it doesn't exist yet and returns it
+func (m *SchemaModel) AddTable(name string) *TableModel {
+	name = normalizeIdentifier(name)
+	if t, ok := m.Tables[name]; ok {
+		return t
+	}
+	t := &TableModel{
+		Columns: make(map[string]struct{}),
+		Indexes: make(map[string]struct{}),
+	}
+	m.Tables[name] = t
+	return t
+}
+
+// AddColumn adds a column to the table
+func (t *TableModel) AddColumn(name string) {
+	t.Columns[normalizeIdentifier(name)] = struct{}{}
+}
+
+// AddIndex adds an index to the table
+func (t *TableModel) AddIndex(name string) {
+	t.Indexes[normalizeIdentifier(name)] = struct{}{}
+}
+
+// Apply updates the model with the effect of a single DDL statement.
+// Statements which don't change tables, columns or indexes are ignored.
+func (m *SchemaModel) Apply(stmt string) {
+	stmt = strings.TrimSpace(stmt)
+	switch {
+	case createTableRegex.MatchString(stmt):
+		m.applyCreateTable(stmt)
+	case createIndexRegex.MatchString(stmt):
+		match := createIndexRegex.FindStringSubmatch(stmt)
+		m.AddTable(match[2]).AddIndex(match[1])
+	case alterTableRegex.MatchString(stmt):
+		match := alterTableRegex.FindStringSubmatch(stmt)
+		m.applyAlterTable(match[1], match[2])
+	case dropTableRegex.MatchString(stmt):
+		match := dropTableRegex.FindStringSubmatch(stmt)
+		delete(m.Tables, normalizeIdentifier(match[1]))
+	case dropIndexRegex.MatchString(stmt):
+		match := dropIndexRegex.FindStringSubmatch(stmt)
+		index := normalizeIdentifier(match[1])
+		if len(match[2]) > 0 {
+			if t, ok := m.Tables[normalizeIdentifier(match[2])]; ok {
+				delete(t.Indexes, index)
+			}
+			return
+		}
+		for _, t := range m.Tables {
+			delete(t.Indexes, index)
+		}
+	}
+}
+
+func (m *SchemaModel) applyCreateTable(stmt string) {
+	loc := createTableRegex.FindStringSubmatchIndex(stmt)
+	table := m.AddTable(stmt[loc[2]:loc[3]])
+	open := loc[1] - 1
+	end := matchingParenthesis(stmt, open)
+	if end < 0 {
+		return
+	}
+	for _, def := range splitTopLevel(stmt[open+1 : end]) {
+		words := strings.Fields(def)
+		if len(words) == 0 {
+			continue
+		}
+		switch strings.ToLower(words[0]) {
+		case "primary", "constraint", "foreign", "check":
+			// Constraints are not tracked.
+		case "key", "index":
+			// MySQL inline index: KEY|INDEX name (...)
+			if len(words) > 1 && !strings.HasPrefix(words[1], "(") {
+				table.AddIndex(words[1])
+			}
+		case "unique":
+			// MySQL inline unique index: UNIQUE [KEY|INDEX] name (...)
+			rest := words[1:]
+			if len(rest) > 0 && (strings.EqualFold(rest[0], "key") || strings.EqualFold(rest[0], "index")) {
+				rest = rest[1:]
+			}
+			if len(rest) > 0 && !strings.HasPrefix(rest[0], "(") {
+				table.AddIndex(rest[0])
+			}
+		default:
+			table.AddColumn(words[0])
+		}
+	}
+}
+
+func (m *SchemaModel) applyAlterTable(tableName string, clauses string) {
+	table := m.AddTable(tableName)
+	for _, clause := range splitTopLevel(clauses) {
+		words := strings.Fields(clause)
+		if len(words) < 2 {
+			continue
+		}
+		action := strings.ToLower(words[0])
+		words = words[1:]
+		switch action {
+		case "add":
+			switch strings.ToLower(words[0]) {
+			case "index", "key":
+				if len(words) > 1 {
+					table.AddIndex(words[1])
+				}
+				continue
+			case "unique":
+				if len(words) > 2 {
+					table.AddIndex(words[2])
+				}
+				continue
+			case "constraint", "primary", "foreign", "check":
+				continue
+			}
+			words = skipWords(words, "column", "if", "not", "exists")
+			if len(words) > 0 {
+				table.AddColumn(words[0])
+			}
+		case "drop":
+			switch strings.ToLower(words[0]) {
+			case "index", "key":
+				if len(words) > 1 {
+					delete(table.Indexes, normalizeIdentifier(words[1]))
+				}
+				continue
+			case "constraint", "primary", "foreign", "check", "default":
+				continue
+			}
+			words = skipWords(words, "column", "if", "exists")
+			if len(words) > 0 {
+				delete(table.Columns, normalizeIdentifier(words[0]))
+			}
+		case "rename":
+			// RENAME [COLUMN] old TO new
+			words = skipWords(words, "column")
+			if len(words) == 3 && strings.EqualFold(words[1], "to") {
+				old := normalizeIdentifier(words[0])
+				if _, ok := table.Columns[old]; ok {
+					delete(table.Columns, old)
+					table.AddColumn(words[2])
+				}
+			}
+		}
+	}
+}
+
+// DiffSchema compares the expected schema with the actual one
+func DiffSchema(expected *SchemaModel, actual *SchemaModel) SchemaDiff {
+	var diff SchemaDiff
+	for name, expectedTable := range expected.Tables {
+		if slices.Contains(ignoredTables, name) {
+			continue
+		}
+		actualTable, ok := actual.Tables[name]
+		if !ok {
+			diff.MissingTables = append(diff.MissingTables, name)
+			continue
+		}
+		diff.MissingColumns = append(diff.MissingColumns, missingNames(name, expectedTable.Columns, actualTable.Columns)...)
+		diff.ExtraColumns = append(diff.ExtraColumns, missingNames(name, actualTable.Columns, expectedTable.Columns)...)
+		diff.MissingIndexes = append(diff.MissingIndexes, missingNames(name, expectedTable.Indexes, actualTable.Indexes)...)
+		diff.ExtraIndexes = append(diff.ExtraIndexes, missingNames(name, actualTable.Indexes, expectedTable.Indexes)...)
+	}
+	for name := range actual.Tables {
+		if slices.Contains(ignoredTables, name) {
+			continue
+		}
+		if _, ok := expected.Tables[name]; !ok {
+			diff.ExtraTables = append(diff.ExtraTables, name)
+		}
+	}
+
+	for _, names := range [][]string{
+		diff.MissingTables, diff.ExtraTables,
+		diff.MissingColumns, diff.ExtraColumns,
+		diff.MissingIndexes, diff.ExtraIndexes,
+	} {
+		slices.Sort(names)
+	}
+	return diff
+}
+
+// IsEmpty returns true if there is no difference between the schemas
+func (d SchemaDiff) IsEmpty() bool {
+	return len(d.MissingTables) == 0 && len(d.ExtraTables) == 0 &&
+		len(d.MissingColumns) == 0 && len(d.ExtraColumns) == 0 &&
+		len(d.MissingIndexes) == 0 && len(d.ExtraIndexes) == 0
+}
+
+// Lines renders the diff as human readable lines, one per difference
+func (d SchemaDiff) Lines() []string {
+	var lines []string
+	for _, item := range []struct {
+		kind  string
+		names []string
+	}{
+		{"missing table", d.MissingTables},
+		{"extra table", d.ExtraTables},
+		{"missing column", d.MissingColumns},
+		{"extra column", d.ExtraColumns},
+		{"missing index", d.MissingIndexes},
+		{"extra index", d.ExtraIndexes},
+	} {
+		for _, name := range item.names {
+			lines = append(lines, fmt.Sprintf("%s: %s", item.kind, name))
+		}
+	}
+	return lines
+}
+
+func missingNames(table string, from map[string]struct{}, in map[string]struct{}) []string {
+	var result []string
+	for name := range from {
+		if _, ok := in[name]; !ok {
+			result = append(result, table+"."+name)
+		}
+	}
+	return result
+}
+
+// normalizeIdentifier lower cases an identifier and strips quotes and keyspace or schema qualifiers
+func normalizeIdentifier(name string) string {
+	name = strings.Trim(strings.TrimSpace(name), ";")
+	if i := strings.LastIndex(name, "."); i >= 0 {
+		name = name[i+1:]
+	}
+	return strings.ToLower(strings.Trim(name, "`\""))
+}
+
+func skipWords(words []string, skip ...string) []string {
+	for len(words) > 0 && slices.Contains(skip, strings.ToLower(words[0])) {
+		words = words[1:]
+	}
+	return words
+}
+
+// matchingParenthesis returns the index of the parenthesis closing the one at open, or -1
+func matchingParenthesis(s string, open int) int {
+	depth := 0
+	for i := open; i < len(s); i++ {
+		switch s[i] {
+		case '\'', '"', '`':
+			if j := strings.IndexByte(s[i+1:], s[i]); j >= 0 {
+				i += j + 1
+			}
+		case '(':
+			depth++
+		case ')':
+			depth--
+			if depth == 0 {
+				return i
+			}
+		}
+	}
+	return -1
+}
+
+// splitTopLevel splits s on commas which are not nested in parenthesis, angle brackets or quotes
+func splitTopLevel(s string) []string {
+	var parts []string
+	depth := 0
+	start := 0
+	for i := 0; i < len(s); i++ {
+		switch s[i] {
+		case '\'', '"', '`':
+			if j := strings.IndexByte(s[i+1:], s[i]); j >= 0 {
+				i += j + 1
+			}
+		case '(', '<':
+			depth++
+		case ')':
+			depth--
+		case '>':
+			// Don't confuse JSON operators (-> and ->>) with closing a CQL collection type.
+			if !strings.HasSuffix(s[:i], "-") && !strings.HasSuffix(s[:i], "->") {
+				depth--
+			}
+		case ',':
+			if depth == 0 {
+				parts = append(parts, strings.TrimSpace(s[start:i]))
+				start = i + 1
+			}
+		}
+	}
+	if last := strings.TrimSpace(s[start:]); len(last) > 0 {
+		parts = append(parts, last)
+	}
+	return parts
+}
@@ -0,0 +1,128 @@
+package schema
+
+import (
+	"bytes"
+	"io"
+	"io/fs"
+	"path"
+	"slices"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"go.temporal.io/server/common/log"
+	"go.temporal.io/server/common/persistence"
+	dbschemas "go.temporal.io/server/schema"
+)
+
+func TestSchemaModel_Apply(t *testing.T) {
+	model := NewSchemaModel()
+	for _, stmt := range []string{
+		"CREATE TABLE executions (shard_id INTEGER NOT NULL, `run_id` BINARY(16) NOT NULL, data MEDIUMBLOB, PRIMARY KEY (shard_id, run_id))",
+		"CREATE TABLE IF NOT EXISTS temporal.queue (queue_type int, message_payload map<text, blob>, KEY by_type (queue_type), UNIQUE KEY by_payload (message_payload))",
+		"CREATE INDEX by_data ON executions (shard_id, (COALESCE(data, 'a,b')) DESC)",
+		"CREATE UNIQUE INDEX by_run_id ON executions (run_id)",
+		"ALTER TABLE executions ADD COLUMN search_attributes JSON NULL, ADD COLUMN BatcherUser VARCHAR(255) GENERATED ALWAYS AS (search_attributes->>\"$.BatcherUser\"), ADD INDEX by_batcher (BatcherUser)",
+		"ALTER TABLE executions DROP data",
+		"ALTER TABLE executions RENAME COLUMN run_id TO execution_run_id",
+		"DROP INDEX by_data ON executions",
+		"DROP INDEX IF EXISTS by_type",
+		"INSERT INTO executions (shard_id) VALUES (1)",
+	} {
+		model.Apply(stmt)
+	}
+
+	require.Len(t, model.Tables, 2)
+	require.Equal(t, map[string]struct{}{
+		"shard_id":          {},
+		"execution_run_id":  {},
+		"search_attributes": {},
+		"batcheruser":       {},
+	}, model.Tables["executions"].Columns)
+	require.Equal(t, map[string]struct{}{
+		"by_run_id":  {},
+		"by_batcher": {},
+	}, model.Tables["executions"].Indexes)
+	require.Equal(t, map[string]struct{}{
+		"queue_type":      {},
+		"message_payload": {},
+	}, model.Tables["queue"].Columns)
+	require.Equal(t, map[string]struct{}{
+		"by_payload": {},
+	}, model.Tables["queue"].Indexes)
+
+	model.Apply("DROP TABLE queue")
+	require.Len(t, model.Tables, 1)
+}
+
+func TestDiffSchema(t *testing.T) {
+	expected := NewSchemaModel()
+	expected.Apply("CREATE TABLE executions (shard_id INTEGER, run_id BYTEA)")
+	expected.Apply("CREATE INDEX by_run_id ON executions (run_id)")
+	expected.Apply("CREATE TABLE queue (queue_type INTEGER)")
+
+	actual := NewSchemaModel()
+	actual.AddTable("executions").AddColumn("shard_id")
+	actual.AddTable("executions").AddColumn("hotfix")
+	actual.AddTable("executions").AddIndex("by_hotfix")
+	actual.AddTable("manual_backup")
+	actual.AddTable("schema_version").AddColumn("curr_version")
+
+	diff := DiffSchema(expected, actual)
+	require.False(t, diff.IsEmpty())
+	require.Equal(t, []string{
+		"missing table: queue",
+		"extra table: manual_backup",
+		"missing column: executions.run_id",
+		"extra column: executions.hotfix",
+		"missing index: executions.by_run_id",
+		"extra index: executions.by_hotfix",
+	}, diff.Lines())
+
+	require.True(t, DiffSchema(expected, expected).IsEmpty())
+}
+
+// The schema file used to set up a fresh database must describe the same schema
+// as replaying all versioned updates, otherwise plan-schema reports false drift.
+func TestSchemaModel_VersionedSchemaMatchesSchemaFile(t *testing.T) {
+	logger := log.NewNoopLogger()
+	fsys := dbschemas.Assets()
+	for _, dir := range []string{
+		"mysql/v8/temporal",
+		"mysql/v8/visibility",
+		"postgresql/v12/temporal",
+		"postgresql/v12/visibility",
+		"cockroachdb/v23/temporal",
+		"cockroachdb/v23/visibility",
+		"cassandra/temporal",
+	} {
+		t.Run(dir, func(t *testing.T) {
+			task := NewUpdateSchemaTask(nil, &UpdateConfig{SchemaName: dir}, logger)
+			changes, err := task.buildChangeSet(initialSchemaVersion)
+			require.NoError(t, err)
+			versioned := NewSchemaModel()
+			for _, cs := range changes {
+				for _, stmt := range cs.cqlStmts {
+					versioned.Apply(stmt)
+				}
+			}
+
+			schemaFile := path.Join(dir, "schema"+schemaFileEnding(dir))
+			content, err := fs.ReadFile(fsys, schemaFile)
+			require.NoError(t, err)
+			stmts, err := persistence.LoadAndSplitQueryFromReaders([]io.Reader{bytes.NewReader(content)})
+			require.NoError(t, err)
+			fromFile := NewSchemaModel()
+			for _, stmt := range stmts {
+				fromFile.Apply(stmt)
+			}
+
+			// cluster_metadata was superseded by cluster_metadata_info but is never
+			// dropped by the versioned updates, so it only exists on upgraded databases.
+			diff := DiffSchema(fromFile, versioned)
+			diff.ExtraTables = slices.DeleteFunc(diff.ExtraTables, func(table string) bool {
+				return table == "cluster_metadata"
+			})
+			require.Empty(t, diff.Lines())
+		})
+	}
+}
@@ -0,0 +1,140 @@
+package schema
+
+import (
+	"fmt"
+	"io"
+	"strings"
+
+	"go.temporal.io/server/common/log"
+	"go.temporal.io/server/common/log/tag"
+)
+
+const (
+	initialSchemaVersion = "0.0"
+)
+
+// PlanTask represents a task that
+// reports what a schema upgrade would do
+// without applying anything
+type PlanTask struct {
+	db     DB
+	config *UpdateConfig
+	logger log.Logger
+	out    io.Writer
+}
+
+// NewPlanSchemaTask returns a new instance of PlanTask
+func NewPlanSchemaTask(db DB, config *UpdateConfig, logger log.Logger, out io.Writer) *PlanTask {
+	return &PlanTask{
+		db:     db,
+		config: config,
+		logger: logger,
+		out:    out,
+	}
+}
+
+// Run executes the task
+func (task *PlanTask) Run() error {
+	config := task.config
+
+	task.logger.Info("PlanSchemaTask started", tag.NewAnyTag("config", config))
+
+	currVer, err := task.db.ReadSchemaVersion()
+	if err != nil {
+		return fmt.Errorf("error reading current schema version:%v", err.Error())
+	}
+
+	updates, err := NewUpdateSchemaTask(task.db, config, task.logger).buildChangeSet(currVer)
+	if err != nil {
+		return err
+	}
+
+	targetVer := currVer
+	if len(updates) > 0 {
+		targetVer = updates[len(updates)-1].version
+	}
+	task.printf("Current schema version: %v\n", currVer)
+	task.printf("Target schema version: %v\n\n", targetVer)
+
+	if err := task.reportDrift(currVer); err != nil {
+		return err
+	}
+
+	task.printUpdates(updates)
+
+	task.logger.Info("PlanSchemaTask done")
+	return nil
+}
+
+// reportDrift compares the live schema with the schema the versioned
+// schema directories describe for the current version
+func (task *PlanTask) reportDrift(currVer string) error {
+	describer, ok := task.db.(SchemaDescriber)
+	if !ok {
+		task.printf("Schema drift detection is not supported for %v.\n\n", task.db.Type())
+		return nil
+	}
+
+	expected, err := task.expectedSchema(currVer)
+	if err != nil {
+		// The database may have been set up at a version which isn't part of the
+		// versioned schema directory, in which case there is nothing to compare to.
+		task.logger.Warn("Unable to build expected schema, skipping drift detection.", tag.Error(err))
+		task.printf("Schema drift detection skipped: %v\n\n", err)
+		return nil
+	}
+
+	actual, err := describer.DescribeSchema()
+	if err != nil {
+		return fmt.Errorf("error describing current schema:%v", err.Error())
+	}
+
+	diff := DiffSchema(expected, actual)
+	if diff.IsEmpty() {
+		task.printf("No schema drift detected at version %v.\n\n", currVer)
+		return nil
+	}
+	task.printf("Schema drift detected at version %v:\n", currVer)
+	for _, line := range diff.Lines() {
+		task.printf("  %v\n", line)
+	}
+	task.printf("\n")
+	return nil
+}
+
+// expectedSchema replays all versioned schema changes up to and including version
+func (task *PlanTask) expectedSchema(version string) (*SchemaModel, error) {
+	config := *task.config
+	config.TargetVersion = version
+	changes, err := NewUpdateSchemaTask(task.db, &config, task.logger).buildChangeSet(initialSchemaVersion)
+	if err != nil {
+		return nil, err
+	}
+
+	model := NewSchemaModel()
+	for _, cs := range changes {
+		for _, stmt := range cs.cqlStmts {
+			model.Apply(stmt)
+		}
+	}
+	return model, nil
+}
+
+func (task *PlanTask) printUpdates(updates []changeSet) {
+	if len(updates) == 0 {
+		task.printf("Schema is up to date, no statements would be executed.\n")
+		return
+	}
+
+	task.printf("Statements which would be executed:\n")
+	for _, cs := range updates {
+		task.printf("\n-- version %v: %v\n", cs.version, cs.manifest.Description)
+		for _, stmt := range cs.cqlStmts {
+			task.printf("%v;\n", strings.TrimSuffix(strings.TrimSpace(stmt), ";"))
+		}
+	}
+}
+
+func (task *PlanTask) printf(format string, args ...any) {
+	_, _ = fmt.Fprintf(task.out, format, args...)
+}
@@ -0,0 +1,89 @@
+package schema
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"go.temporal.io/server/common/log"
+	"go.temporal.io/server/tests/testutils"
+)
+
+type planTestDB struct {
+	mockSQLDB
+	version string
+	actual  *SchemaModel
+}
+
+func (db *planTestDB) ReadSchemaVersion() (string, error) {
+	return db.version, nil
+}
+
+func (db *planTestDB) DescribeSchema() (*SchemaModel, error) {
+	return db.actual, nil
+}
+
+func TestPlanTask(t *testing.T) {
+	dir := testutils.MkdirTemp(t, "", "plan_schema_test")
+	writeVersion := func(version string, stmts string) {
+		versionDir := filepath.Join(dir, "v"+version)
+		require.NoError(t, os.Mkdir(versionDir, 0755))
+		require.NoError(t, os.WriteFile(filepath.Join(versionDir, "manifest.json"), []byte(`{
+			"CurrVersion": "`+version+`",
+			"MinCompatibleVersion": "1.0",
+			"Description": "update to `+version+`",
+			"SchemaUpdateCqlFiles": ["schema.sql"]
+		}`), 0644))
+		require.NoError(t, os.WriteFile(filepath.Join(versionDir, "schema.sql"), []byte(stmts), 0644))
+	}
+	writeVersion("1.0", "CREATE TABLE executions (shard_id INTEGER, run_id BYTEA);")
+	writeVersion("1.1", "CREATE INDEX by_run_id ON executions (run_id);")
+	writeVersion("1.2", "ALTER TABLE executions ADD COLUMN data BYTEA;")
+
+	actual := NewSchemaModel()
+	actual.AddTable("executions").AddColumn("shard_id")
+	actual.AddTable("executions").AddColumn("run_id")
+	actual.AddTable("executions").AddColumn("hotfix")
+
+	var out strings.Builder
+	db := &planTestDB{version: "1.1", actual: actual}
+	task := NewPlanSchemaTask(db, &UpdateConfig{SchemaDir: dir}, log.NewNoopLogger(), &out)
+	require.NoError(t, task.Run())
+
+	require.Equal(t, `Current schema version: 1.1
+Target schema version: 1.2
+
+Schema drift detected at version 1.1:
+  extra column: executions.hotfix
+  missing index: executions.by_run_id
+
+Statements which would be executed:
+
+-- version 1.2: update to 1.2
+ALTER TABLE executions ADD COLUMN data BYTEA;
+`, out.String())
+}
+
+func TestPlanTask_UpToDate(t *testing.T) {
+	dir := testutils.MkdirTemp(t, "", "plan_schema_test")
+	versionDir := filepath.Join(dir, "v1.0")
+	require.NoError(t, os.Mkdir(versionDir, 0755))
+	require.NoError(t, os.WriteFile(filepath.Join(versionDir, "manifest.json"), []byte(`{
+		"CurrVersion": "1.0",
+		"MinCompatibleVersion": "1.0",
+		"Description": "base",
+		"SchemaUpdateCqlFiles": ["schema.sql"]
+	}`), 0644))
+	require.NoError(t, os.WriteFile(filepath.Join(versionDir, "schema.sql"), []byte("CREATE TABLE queue (id BIGINT);"), 0644))
+
+	actual := NewSchemaModel()
+	actual.AddTable("queue").AddColumn("id")
+
+	var out strings.Builder
+	db := &planTestDB{version: "1.0", actual: actual}
+	require.NoError(t, NewPlanSchemaTask(db, &UpdateConfig{SchemaDir: dir}, log.NewNoopLogger(), &out).Run())
+	require.Contains(t, out.String(), "No schema drift detected at version 1.0.")
+	require.Contains(t, out.String(), "Schema is up to date, no statements would be executed.")
+}
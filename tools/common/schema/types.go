@@ -50,6 +50,13 @@ type (
 		// Type gives the type of db (e.g. "cassandra", "sql")
 		Type() string
 	}
+
+	// SchemaDescriber is implemented by databases which can describe their live
+	// schema; it is required to report schema drift when planning an update
+	SchemaDescriber interface {
+		// DescribeSchema returns the tables, columns and indexes of the database
+		DescribeSchema() (*SchemaModel, error)
+	}
 )
 
 const (
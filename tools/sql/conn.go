@@ -21,6 +21,7 @@ type (
 const dbType = "sql"
 
 var _ schema.DB = (*Connection)(nil)
+var _ schema.SchemaDescriber = (*Connection)(nil)
 
 // NewConnection creates a new connection to database
 func NewConnection(cfg *config.SQL, logger log.Logger) (*Connection, error) {
@@ -65,6 +66,33 @@ func (c *Connection) ListTables() ([]string, error) {
 	return c.adminDb.ListTables(c.dbName)
 }
 
+// DescribeSchema returns the tables, columns and indexes of this database
+func (c *Connection) DescribeSchema() (*schema.SchemaModel, error) {
+	tables, err := c.ListTables()
+	if err != nil {
+		return nil, err
+	}
+	model := schema.NewSchemaModel()
+	for _, tableName := range tables {
+		table := model.AddTable(tableName)
+		columns, err := c.adminDb.ListColumns(c.dbName, tableName)
+		if err != nil {
+			return nil, err
+		}
+		for _, column := range columns {
+			table.AddColumn(column)
+		}
+		indexes, err := c.adminDb.ListIndexes(c.dbName, tableName)
+		if err != nil {
+			return nil, err
+		}
+		for _, index := range indexes {
+			table.AddIndex(index)
+		}
+	}
+	return model, nil
+}
+
 // DropTable drops a given table from the database
 func (c *Connection) DropTable(name string) error {
 	return c.adminDb.DropTable(name)
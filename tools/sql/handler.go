@@ -56,6 +56,27 @@ func updateSchema(cli *cli.Context, logger log.Logger) error {
 	return nil
 }
 
+// planSchema executes the planSchemaTask
+// using the given command line args as input
+func planSchema(cli *cli.Context, logger log.Logger) error {
+	cfg, err := parseConnectConfig(cli)
+	if err != nil {
+		logger.Error("Unable to read config.", tag.Error(schema.NewConfigError(err.Error())))
+		return err
+	}
+	conn, err := NewConnection(cfg, logger)
+	if err != nil {
+		logger.Error("Unable to connect to SQL database.", tag.Error(err))
+		return err
+	}
+	defer conn.Close()
+	if err := schema.Plan(cli, conn, logger); err != nil {
+		logger.Error("Unable to plan SQL schema update.", tag.Error(err))
+		return err
+	}
+	return nil
+}
+
 // createDatabase creates a sql database
 func createDatabase(cli *cli.Context, logger log.Logger) error {
 	cfg, err := parseConnectConfig(cli)
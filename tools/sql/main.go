@@ -172,6 +172,29 @@ func BuildCLIOptions() *cli.App {
 				cliHandler(c, updateSchema, logger)
 			},
 		},
+		{
+			Name:    "plan-schema",
+			Aliases: []string{"plan"},
+			Usage:   "report sql schema drift and print the statements an update would execute, without applying them",
+			Flags: []cli.Flag{
+				cli.StringFlag{
+					Name:  schema.CLIFlagTargetVersion,
+					Usage: "target version for the schema update, defaults to latest",
+				},
+				cli.StringFlag{
+					Name:  schema.CLIFlagSchemaDir,
+					Usage: "path to directory containing versioned schema",
+				},
+				cli.StringFlag{
+					Name: schema.CLIFlagSchemaName,
+					Usage: fmt.Sprintf("name of embedded versioned schema, one of: %v",
+						dbschemas.PathsByDB("sql")),
+				},
+			},
+			Action: func(c *cli.Context) {
+				cliHandler(c, planSchema, logger)
+			},
+		},
 		{
 			Name:    "create-database",
 			Aliases: []string{"create"},
@@ -4,13 +4,17 @@ import (
 	"errors"
 	"fmt"
 	"os"
+	"strconv"
 	"strings"
 	"time"
 
 	"github.com/temporalio/tctl-kit/pkg/color"
 	"github.com/urfave/cli/v2"
 	commonpb "go.temporal.io/api/common/v1"
+	enumspb "go.temporal.io/api/enums/v1"
 	historypb "go.temporal.io/api/history/v1"
+	"go.temporal.io/api/serviceerror"
+	"go.temporal.io/api/workflowservice/v1"
 	"go.temporal.io/server/api/adminservice/v1"
 	enumsspb "go.temporal.io/server/api/enums/v1"
 	historyspb "go.temporal.io/server/api/history/v1"
@@ -361,11 +365,119 @@ func AdminGetShardID(c *cli.Context) error {
 	if numberOfShards <= 0 {
 		return fmt.Errorf("missing required parameter number of Shards")
 	}
-	shardID := common.WorkflowIDToHistoryShard(namespaceID, wid, numberOfShards)
+	var pools map[string]common.NamespaceShardPool
+	if c.IsSet(FlagFirstShardID) || c.IsSet(FlagLastShardID) {
+		pool, err := getShardPool(c, numberOfShards)
+		if err != nil {
+			return err
+		}
+		pools = map[string]common.NamespaceShardPool{namespaceID: pool}
+	}
+	shardID := common.WorkflowIDToHistoryShardInPools(namespaceID, wid, numberOfShards, pools)
 	fmt.Fprintf(c.App.Writer, "ShardId for namespace, workflowId: %v, %v is %v \n", namespaceID, wid, shardID)
 	return nil
 }
 
+// AdminCheckShardPool lists the workflows of a namespace which would move to a different shard
+// if the namespace was assigned to the given shard pool. While the namespace is moved to the pool,
+// it only lists the workflows which are still on their previous shard.
+func AdminCheckShardPool(c *cli.Context, clientFactory ClientFactory) error {
+	nsName, err := getRequiredOption(c, FlagNamespace)
+	if err != nil {
+		return err
+	}
+	numberOfShards := int32(c.Int(FlagNumberOfShards))
+	if numberOfShards <= 0 {
+		return fmt.Errorf("missing required parameter number of Shards")
+	}
+	pool, err := getShardPool(c, numberOfShards)
+	if err != nil {
+		return err
+	}
+	nsID, err := getNamespaceID(c, clientFactory, namespace.Name(nsName))
+	if err != nil {
+		return err
+	}
+	pools := map[string]common.NamespaceShardPool{nsID.String(): pool}
+	moving := c.Bool(FlagMoving)
+
+	wfClient := clientFactory.WorkflowClient(c)
+	adminClient := clientFactory.AdminClient(c)
+	var token []byte
+	var moved int
+	for {
+		ctx, cancel := newContext(c)
+		resp, err := wfClient.ListWorkflowExecutions(ctx, &workflowservice.ListWorkflowExecutionsRequest{
+			Namespace:     nsName,
+			PageSize:      int32(c.Int(FlagPageSize)),
+			NextPageToken: token,
+		})
+		cancel()
+		if err != nil {
+			return fmt.Errorf("unable to list workflow executions: %w", err)
+		}
+		for _, execution := range resp.GetExecutions() {
+			workflowID := execution.GetExecution().GetWorkflowId()
+			currentShardID := common.WorkflowIDToHistoryShard(nsID.String(), workflowID, numberOfShards)
+			poolShardID := common.WorkflowIDToHistoryShardInPools(nsID.String(), workflowID, numberOfShards, pools)
+			if currentShardID == poolShardID {
+				continue
+			}
+			if moving {
+				ctx, cancel := newContext(c)
+				resp, err := adminClient.DescribeMutableState(ctx, &adminservice.DescribeMutableStateRequest{
+					Namespace: nsName,
+					Execution: execution.GetExecution(),
+				})
+				cancel()
+				var notFound *serviceerror.NotFound
+				if errors.As(err, &notFound) {
+					continue
+				}
+				if err != nil {
+					return fmt.Errorf("unable to describe workflow execution %s: %w", workflowID, err)
+				}
+				if resp.GetShardId() == strconv.Itoa(int(poolShardID)) {
+					continue
+				}
+			}
+			moved++
+			fmt.Fprintf(c.App.Writer, "%s %s %s: shard %d -> %d\n",
+				workflowID, execution.GetExecution().GetRunId(), execution.GetStatus(), currentShardID, poolShardID)
+		}
+		token = resp.GetNextPageToken()
+		if len(token) == 0 {
+			break
+		}
+	}
+
+	if moving {
+		if moved == 0 {
+			fmt.Fprintln(c.App.Writer, "No workflow executions remain on their previous shard, the namespace's previous shard pool can be removed.")
+			return nil
+		}
+		fmt.Fprintf(c.App.Writer, "%d workflow executions remain on their previous shard, the namespace's previous shard pool must be kept.\n", moved)
+		return nil
+	}
+	if moved == 0 {
+		fmt.Fprintln(c.App.Writer, "No workflow executions would change shard, the namespace can be assigned to the shard pool.")
+		return nil
+	}
+	fmt.Fprintf(c.App.Writer, "%d workflow executions would change shard and must be moved to the shard pool through its previous shard pool.\n", moved)
+	return nil
+}
+
+func getShardPool(c *cli.Context, numberOfShards int32) (common.NamespaceShardPool, error) {
+	pool := common.NamespaceShardPool{
+		FirstShardID: int32(c.Int(FlagFirstShardID)),
+		LastShardID:  int32(c.Int(FlagLastShardID)),
+	}
+	if !pool.IsValid(numberOfShards) {
+		return pool, fmt.Errorf("invalid shard pool [%d, %d] for %d shards", pool.FirstShardID, pool.LastShardID, numberOfShards)
+	}
+	return pool, nil
+}
+
 // getCategory first searches the registry for the category by the [tasks.Category.Name].
 func getCategory(registry tasks.TaskCategoryRegistry, key string) (tasks.Category, error) {
 	for _, category := range registry.GetCategories() {
@@ -510,6 +622,23 @@ func AdminShardManagement(c *cli.Context, clientFactory ClientFactory) error {
 	return nil
 }
 
+// AdminMoveShard moves a shard to another history host
+func AdminMoveShard(c *cli.Context, clientFactory ClientFactory) error {
+	adminClient := clientFactory.AdminClient(c)
+
+	ctx, cancel := newContext(c)
+	defer cancel()
+
+	_, err := adminClient.MoveHistoryShard(ctx, &adminservice.MoveHistoryShardRequest{
+		ShardId:           int32(c.Int(FlagShardID)),
+		TargetHostAddress: c.String(FlagHistoryAddress),
+	})
+	if err != nil {
+		return fmt.Errorf("unable to move shard: %s", err)
+	}
+	return nil
+}
+
 // AdminListGossipMembers outputs a list of gossip members
 func AdminListGossipMembers(c *cli.Context, clientFactory ClientFactory) error {
 	roleFlag := c.String(FlagClusterMembershipRole)
@@ -687,6 +816,353 @@ func AdminRebuildMutableState(c *cli.Context, clientFactory ClientFactory) error
 	return nil
 }
 
+// AdminCheckMutableState validates a workflow mutable state against its history and optionally repairs it by
+// rebuilding the mutable state and refreshing its tasks
+func AdminCheckMutableState(c *cli.Context, clientFactory ClientFactory, prompter *Prompter) error {
+	adminClient := clientFactory.AdminClient(c)
+
+	nsName, err := getRequiredOption(c, FlagNamespace)
+	if err != nil {
+		return err
+	}
+	wid, err := getRequiredOption(c, FlagWorkflowID)
+	if err != nil {
+		return err
+	}
+	rid := c.String(FlagRunID)
+	execution := &commonpb.WorkflowExecution{
+		WorkflowId: wid,
+		RunId:      rid,
+	}
+
+	checkCtx, checkCancel := newContext(c)
+	resp, err := adminClient.CheckMutableState(checkCtx, &adminservice.CheckMutableStateRequest{
+		Namespace: nsName,
+		Execution: execution,
+	})
+	checkCancel()
+	if err != nil {
+		return fmt.Errorf("check mutable state failed: %s", err)
+	}
+	if len(resp.GetInconsistencies()) == 0 {
+		fmt.Fprintln(c.App.Writer, "mutable state is consistent with history.")
+		return nil
+	}
+	for _, inconsistency := range resp.GetInconsistencies() {
+		fmt.Fprintf(c.App.Writer, "%s\n  - database: %s\n  + history:  %s\n",
+			inconsistency.GetField(),
+			valueOrMissing(inconsistency.GetDatabaseValue()),
+			valueOrMissing(inconsistency.GetHistoryValue()),
+		)
+	}
+	if !c.Bool(FlagRepair) {
+		return nil
+	}
+
+	prompter.Prompt(fmt.Sprintf("Namespace: %s WorkflowID: %s RunID: %s\nRebuild mutable state from history and refresh its tasks?", nsName, wid, rid))
+	// the prompt waits on the operator, so the repair gets its own context
+	ctx, cancel := newContext(c)
+	defer cancel()
+	if _, err := adminClient.RebuildMutableState(ctx, &adminservice.RebuildMutableStateRequest{
+		Namespace: nsName,
+		Execution: execution,
+	}); err != nil {
+		return fmt.Errorf("rebuild mutable state failed: %s", err)
+	}
+	nsID, err := getNamespaceID(c, clientFactory, namespace.Name(nsName))
+	if err != nil {
+		return err
+	}
+	if _, err := adminClient.RefreshWorkflowTasks(ctx, &adminservice.RefreshWorkflowTasksRequest{
+		NamespaceId: nsID.String(),
+		Execution:   execution,
+	}); err != nil {
+		return fmt.Errorf("unable to refresh Workflow Task: %s", err)
+	}
+	fmt.Fprintln(c.App.Writer, "repair mutable state succeeded.")
+	return nil
+}
+
+func valueOrMissing(value string) string {
+	if value == "" {
+		return "<missing>"
+	}
+	return value
+}
+
+// AdminForkWorkflow copies the history of a workflow into a new workflow execution
+func AdminForkWorkflow(c *cli.Context, clientFactory ClientFactory) error {
+	adminClient := clientFactory.AdminClient(c)
+
+	namespace, err := getRequiredOption(c, FlagNamespace)
+	if err != nil {
+		return err
+	}
+	wid, err := getRequiredOption(c, FlagWorkflowID)
+	if err != nil {
+		return err
+	}
+	rid := c.String(FlagRunID)
+
+	var excludeTypes []enumspb.ResetReapplyExcludeType
+	for _, value := range c.StringSlice(FlagReapplyExclude) {
+		excludeType, err := enumspb.ResetReapplyExcludeTypeFromString(value)
+		if err != nil {
+			return fmt.Errorf("invalid %s: %s", FlagReapplyExclude, err)
+		}
+		excludeTypes = append(excludeTypes, excludeType)
+	}
+
+	ctx, cancel := newContext(c)
+	defer cancel()
+
+	resp, err := adminClient.ForkWorkflowExecution(ctx, &adminservice.ForkWorkflowExecutionRequest{
+		Namespace: namespace,
+		Execution: &commonpb.WorkflowExecution{
+			WorkflowId: wid,
+			RunId:      rid,
+		},
+		WorkflowTaskFinishEventId: c.Int64(FlagEventID),
+		NewNamespace:              c.String(FlagNewNamespace),
+		NewWorkflowId:             c.String(FlagNewWorkflowID),
+		Reason:                    c.String(FlagReason),
+		ResetReapplyExcludeTypes:  excludeTypes,
+	})
+	if err != nil {
+		return fmt.Errorf("fork workflow failed: %s", err)
+	}
+	fmt.Fprintf(c.App.Writer, "fork workflow succeeded, run ID: %s\n", resp.GetRunId())
+	return nil
+}
+
+// AdminRedactWorkflow erases payloads from the history of a closed workflow execution
+func AdminRedactWorkflow(c *cli.Context, clientFactory ClientFactory) error {
+	adminClient := clientFactory.AdminClient(c)
+
+	namespace, err := getRequiredOption(c, FlagNamespace)
+	if err != nil {
+		return err
+	}
+	wid, err := getRequiredOption(c, FlagWorkflowID)
+	if err != nil {
+		return err
+	}
+	rid := c.String(FlagRunID)
+
+	ctx, cancel := newContext(c)
+	defer cancel()
+
+	_, err = adminClient.RedactWorkflowExecution(ctx, &adminservice.RedactWorkflowExecutionRequest{
+		Namespace: namespace,
+		Execution: &commonpb.WorkflowExecution{
+			WorkflowId: wid,
+			RunId:      rid,
+		},
+		EventIds: c.Int64Slice(FlagEventID),
+		MemoKeys: c.StringSlice(FlagMemoKey),
+		Reason:   c.String(FlagReason),
+		Identity: "tdbg",
+	})
+	if err != nil {
+		return fmt.Errorf("redact workflow failed: %s", err)
+	}
+	fmt.Fprintln(c.App.Writer, "redact workflow succeeded")
+	return nil
+}
+
+// AdminGetWorkflowTimeline prints the timeline of a workflow execution
+func AdminGetWorkflowTimeline(c *cli.Context, clientFactory ClientFactory) error {
+	adminClient := clientFactory.AdminClient(c)
+
+	namespace, err := getRequiredOption(c, FlagNamespace)
+	if err != nil {
+		return err
+	}
+	wid, err := getRequiredOption(c, FlagWorkflowID)
+	if err != nil {
+		return err
+	}
+	rid := c.String(FlagRunID)
+
+	ctx, cancel := newContext(c)
+	defer cancel()
+
+	resp, err := adminClient.GetWorkflowExecutionTimeline(ctx, &adminservice.GetWorkflowExecutionTimelineRequest{
+		Namespace: namespace,
+		Execution: &commonpb.WorkflowExecution{
+			WorkflowId: wid,
+			RunId:      rid,
+		},
+	})
+	if err != nil {
+		return fmt.Errorf("get workflow timeline failed: %s", err)
+	}
+	prettyPrintJSONObject(c, resp.GetTimeline())
+	return nil
+}
+
+// AdminGetWorkflowHistoryBudget prints the consumption of a workflow execution against its history budget
+func AdminGetWorkflowHistoryBudget(c *cli.Context, clientFactory ClientFactory) error {
+	adminClient := clientFactory.AdminClient(c)
+
+	namespace, err := getRequiredOption(c, FlagNamespace)
+	if err != nil {
+		return err
+	}
+	wid, err := getRequiredOption(c, FlagWorkflowID)
+	if err != nil {
+		return err
+	}
+	rid := c.String(FlagRunID)
+
+	ctx, cancel := newContext(c)
+	defer cancel()
+
+	resp, err := adminClient.GetWorkflowHistoryBudget(ctx, &adminservice.GetWorkflowHistoryBudgetRequest{
+		Namespace: namespace,
+		Execution: &commonpb.WorkflowExecution{
+			WorkflowId: wid,
+			RunId:      rid,
+		},
+	})
+	if err != nil {
+		return fmt.Errorf("get workflow history budget failed: %s", err)
+	}
+	prettyPrintJSONObject(c, resp.GetUsage())
+	return nil
+}
+
+// AdminAggregateWorkflowExecutions prints the aggregations of the workflow executions matching a visibility query
+func AdminAggregateWorkflowExecutions(c *cli.Context, clientFactory ClientFactory) error {
+	adminClient := clientFactory.AdminClient(c)
+
+	namespace, err := getRequiredOption(c, FlagNamespace)
+	if err != nil {
+		return err
+	}
+	var aggregations []*adminservice.VisibilityAggregation
+	for _, spec := range c.StringSlice(FlagAggregation) {
+		aggregation, err := parseVisibilityAggregation(spec)
+		if err != nil {
+			return err
+		}
+		aggregations = append(aggregations, aggregation)
+	}
+
+	ctx, cancel := newContext(c)
+	defer cancel()
+
+	resp, err := adminClient.AggregateWorkflowExecutions(ctx, &adminservice.AggregateWorkflowExecutionsRequest{
+		Namespace:    namespace,
+		Query:        c.String(FlagQuery),
+		GroupBy:      c.StringSlice(FlagGroupBy),
+		Aggregations: aggregations,
+	})
+	if err != nil {
+		return fmt.Errorf("aggregate workflow executions failed: %s", err)
+	}
+	prettyPrintJSONObject(c, resp)
+	return nil
+}
+
+// AdminUpsertSavedVisibilityQuery creates or replaces a saved visibility query of a namespace
+func AdminUpsertSavedVisibilityQuery(c *cli.Context, clientFactory ClientFactory) error {
+	adminClient := clientFactory.AdminClient(c)
+
+	namespace, err := getRequiredOption(c, FlagNamespace)
+	if err != nil {
+		return err
+	}
+
+	ctx, cancel := newContext(c)
+	defer cancel()
+
+	resp, err := adminClient.UpsertSavedVisibilityQuery(ctx, &adminservice.UpsertSavedVisibilityQueryRequest{
+		Namespace:   namespace,
+		Name:        c.String(FlagSavedQueryName),
+		Query:       c.String(FlagQuery),
+		Description: c.String(FlagDescription),
+		Identity:    "tdbg",
+	})
+	if err != nil {
+		return fmt.Errorf("upsert saved query failed: %s", err)
+	}
+	prettyPrintJSONObject(c, resp.GetSavedQuery())
+	return nil
+}
+
+// AdminDeleteSavedVisibilityQuery deletes a saved visibility query of a namespace
+func AdminDeleteSavedVisibilityQuery(c *cli.Context, clientFactory ClientFactory) error {
+	adminClient := clientFactory.AdminClient(c)
+
+	namespace, err := getRequiredOption(c, FlagNamespace)
+	if err != nil {
+		return err
+	}
+
+	ctx, cancel := newContext(c)
+	defer cancel()
+
+	_, err = adminClient.DeleteSavedVisibilityQuery(ctx, &adminservice.DeleteSavedVisibilityQueryRequest{
+		Namespace: namespace,
+		Name:      c.String(FlagSavedQueryName),
+	})
+	if err != nil {
+		return fmt.Errorf("delete saved query failed: %s", err)
+	}
+	fmt.Fprintln(c.App.Writer, "delete saved query succeeded")
+	return nil
+}
+
+// AdminListSavedVisibilityQueries prints the saved visibility queries of a namespace
+func AdminListSavedVisibilityQueries(c *cli.Context, clientFactory ClientFactory) error {
+	adminClient := clientFactory.AdminClient(c)
+
+	namespace, err := getRequiredOption(c, FlagNamespace)
+	if err != nil {
+		return err
+	}
+
+	ctx, cancel := newContext(c)
+	defer cancel()
+
+	resp, err := adminClient.ListSavedVisibilityQueries(ctx, &adminservice.ListSavedVisibilityQueriesRequest{
+		Namespace: namespace,
+	})
+	if err != nil {
+		return fmt.Errorf("list saved queries failed: %s", err)
+	}
+	prettyPrintJSONObject(c, resp)
+	return nil
+}
+
+func parseVisibilityAggregation(spec string) (*adminservice.VisibilityAggregation, error) {
+	parts := strings.Split(spec, ":")
+	if len(parts) < 2 || len(parts) > 3 {
+		return nil, fmt.Errorf("invalid aggregation %q, expected <function>:<search attribute>[:<percentile>,...]", spec)
+	}
+	function, err := enumsspb.VisibilityAggregationFunctionFromString(
+		"VISIBILITY_AGGREGATION_FUNCTION_" + strings.ToUpper(parts[0]),
+	)
+	if err != nil || function == enumsspb.VISIBILITY_AGGREGATION_FUNCTION_UNSPECIFIED {
+		return nil, fmt.Errorf("invalid aggregation function %q", parts[0])
+	}
+	aggregation := &adminservice.VisibilityAggregation{
+		Function: function,
+		Field:    parts[1],
+	}
+	if len(parts) == 3 {
+		for _, p := range strings.Split(parts[2], ",") {
+			percentile, err := strconv.ParseFloat(p, 64)
+			if err != nil {
+				return nil, fmt.Errorf("invalid percentile %q: %s", p, err)
+			}
+			aggregation.Percentiles = append(aggregation.Percentiles, percentile)
+		}
+	}
+	return aggregation, nil
+}
+
 // AdminReplicateWorkflow force replicates a workflow by generating replication tasks
 func AdminReplicateWorkflow(
 	c *cli.Context,
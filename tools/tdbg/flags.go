@@ -70,4 +70,22 @@ var (
 	FlagAllActive                  = "select-all-active"
 	FlagFair                       = "fair"
 	FlagMinPass                    = "min-pass"
+	FlagEventID                    = "event-id"
+	FlagNewNamespace               = "new-namespace"
+	FlagNewWorkflowID              = "new-workflow-id"
+	FlagReapplyExclude             = "reapply-exclude"
+	FlagMemoKey                    = "memo-key"
+	FlagRepair                     = "repair"
+	FlagFirstShardID               = "first-shard-id"
+	FlagLastShardID                = "last-shard-id"
+	FlagMoving                     = "moving"
+	FlagQuery                      = "query"
+	FlagQueryAlias                 = []string{"q"}
+	FlagGroupBy                    = "group-by"
+	FlagAggregation                = "aggregation"
+	FlagSavedQueryName             = "name"
+	FlagDescription                = "description"
+	FlagPriorityKey                = "priority-key"
+	FlagBacklogTask                = "task"
+	FlagDestinationTaskQueue       = "destination-task-queue"
 )
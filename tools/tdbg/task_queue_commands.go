@@ -3,6 +3,8 @@ package tdbg
 import (
 	"errors"
 	"fmt"
+	"strconv"
+	"strings"
 
 	"github.com/urfave/cli/v2"
 	enumspb "go.temporal.io/api/enums/v1"
@@ -249,3 +251,257 @@ func AdminForceUnloadTaskQueuePartition(c *cli.Context, clientFactory ClientFact
 	}
 	return nil
 }
+
+// AdminPauseTaskQueueDispatch pauses dispatch of tasks of a task queue
+func AdminPauseTaskQueueDispatch(c *cli.Context, clientFactory ClientFactory) error {
+	namespace, err := getRequiredOption(c, FlagNamespace)
+	if err != nil {
+		return err
+	}
+	tqName, err := getRequiredOption(c, FlagTaskQueue)
+	if err != nil {
+		return err
+	}
+	tqTypes, err := parseTaskQueueTypes(c.StringSlice(FlagTaskQueueType))
+	if err != nil {
+		return err
+	}
+
+	client := clientFactory.AdminClient(c)
+	ctx, cancel := newContext(c)
+	defer cancel()
+	response, err := client.PauseTaskQueueDispatch(ctx, &adminservice.PauseTaskQueueDispatchRequest{
+		Namespace:      namespace,
+		TaskQueue:      tqName,
+		TaskQueueTypes: tqTypes,
+		PriorityKeys:   toInt32Slice(c.IntSlice(FlagPriorityKey)),
+		Reason:         c.String(FlagReason),
+		Identity:       "tdbg",
+	})
+	if err != nil {
+		return fmt.Errorf("unable to pause Task Queue dispatch: %w", err)
+	}
+	prettyPrintJSONObject(c, response)
+	return nil
+}
+
+// AdminResumeTaskQueueDispatch resumes dispatch of tasks of a task queue
+func AdminResumeTaskQueueDispatch(c *cli.Context, clientFactory ClientFactory) error {
+	namespace, err := getRequiredOption(c, FlagNamespace)
+	if err != nil {
+		return err
+	}
+	tqName, err := getRequiredOption(c, FlagTaskQueue)
+	if err != nil {
+		return err
+	}
+	tqTypes, err := parseTaskQueueTypes(c.StringSlice(FlagTaskQueueType))
+	if err != nil {
+		return err
+	}
+
+	client := clientFactory.AdminClient(c)
+	ctx, cancel := newContext(c)
+	defer cancel()
+	response, err := client.ResumeTaskQueueDispatch(ctx, &adminservice.ResumeTaskQueueDispatchRequest{
+		Namespace:      namespace,
+		TaskQueue:      tqName,
+		TaskQueueTypes: tqTypes,
+		PriorityKeys:   toInt32Slice(c.IntSlice(FlagPriorityKey)),
+		Identity:       "tdbg",
+	})
+	if err != nil {
+		return fmt.Errorf("unable to resume Task Queue dispatch: %w", err)
+	}
+	prettyPrintJSONObject(c, response)
+	return nil
+}
+
+func parseTaskQueueTypes(values []string) ([]enumspb.TaskQueueType, error) {
+	tqTypes := make([]enumspb.TaskQueueType, 0, len(values))
+	for _, value := range values {
+		tqTypeInt, err := StringToEnum(value, enumspb.TaskQueueType_value)
+		if err != nil {
+			return nil, fmt.Errorf("invalid task queue type: %w", err)
+		}
+		tqTypes = append(tqTypes, enumspb.TaskQueueType(tqTypeInt))
+	}
+	return tqTypes, nil
+}
+
+func toInt32Slice(values []int) []int32 {
+	result := make([]int32, len(values))
+	for i, value := range values {
+		result[i] = int32(value)
+	}
+	return result
+}
+
+// AdminListTaskQueueBacklogTasks lists the backlog tasks of a task queue partition
+func AdminListTaskQueueBacklogTasks(c *cli.Context, clientFactory ClientFactory) error {
+	namespace, err := getRequiredOption(c, FlagNamespace)
+	if err != nil {
+		return err
+	}
+	tqPartition, err := parseTaskQueuePartition(c)
+	if err != nil {
+		return err
+	}
+	pageSize := c.Int(FlagPageSize)
+	client := clientFactory.AdminClient(c)
+
+	paginationFunc := func(paginationToken []byte) ([]interface{}, []byte, error) {
+		ctx, cancel := newContext(c)
+		defer cancel()
+
+		response, err := client.ListTaskQueueBacklogTasks(ctx, &adminservice.ListTaskQueueBacklogTasksRequest{
+			Namespace:          namespace,
+			TaskQueuePartition: tqPartition,
+			PageSize:           int32(pageSize),
+			NextPageToken:      paginationToken,
+		})
+		if err != nil {
+			return nil, nil, err
+		}
+		var items []interface{}
+		for _, task := range response.Tasks {
+			items = append(items, task)
+		}
+		return items, response.NextPageToken, nil
+	}
+
+	if err := paginate(c, paginationFunc, pageSize); err != nil {
+		return fmt.Errorf("unable to list Task Queue backlog tasks: %w", err)
+	}
+	return nil
+}
+
+// AdminDeleteTaskQueueBacklogTasks deletes tasks from the backlog of a task queue partition
+func AdminDeleteTaskQueueBacklogTasks(c *cli.Context, clientFactory ClientFactory, prompter *Prompter) error {
+	namespace, err := getRequiredOption(c, FlagNamespace)
+	if err != nil {
+		return err
+	}
+	tqPartition, err := parseTaskQueuePartition(c)
+	if err != nil {
+		return err
+	}
+	var keys []*taskqueuespb.BacklogTaskKey
+	for _, value := range c.StringSlice(FlagBacklogTask) {
+		key, err := parseBacklogTaskKey(value)
+		if err != nil {
+			return err
+		}
+		keys = append(keys, key)
+	}
+
+	prompter.Prompt(fmt.Sprintf("Delete %d tasks from the backlog of task queue %s? Their workflows will not make progress until they are reset.",
+		len(keys), tqPartition.GetTaskQueue()))
+
+	client := clientFactory.AdminClient(c)
+	ctx, cancel := newContext(c)
+	defer cancel()
+	_, err = client.DeleteTaskQueueBacklogTasks(ctx, &adminservice.DeleteTaskQueueBacklogTasksRequest{
+		Namespace:          namespace,
+		TaskQueuePartition: tqPartition,
+		Tasks:              keys,
+	})
+	if err != nil {
+		return fmt.Errorf("unable to delete Task Queue backlog tasks: %w", err)
+	}
+	fmt.Fprintf(c.App.Writer, "Deleted %d tasks.\n", len(keys))
+	return nil
+}
+
+// AdminMoveTaskQueueBacklogTasks moves all backlog tasks of a task queue partition to another task queue
+func AdminMoveTaskQueueBacklogTasks(c *cli.Context, clientFactory ClientFactory, prompter *Prompter) error {
+	namespace, err := getRequiredOption(c, FlagNamespace)
+	if err != nil {
+		return err
+	}
+	tqPartition, err := parseTaskQueuePartition(c)
+	if err != nil {
+		return err
+	}
+	destination, err := getRequiredOption(c, FlagDestinationTaskQueue)
+	if err != nil {
+		return err
+	}
+
+	prompter.Prompt(fmt.Sprintf("Move all backlog tasks of task queue %s to task queue %s?", tqPartition.GetTaskQueue(), destination))
+
+	client := clientFactory.AdminClient(c)
+	var moved int
+	var pageToken []byte
+	for {
+		ctx, cancel := newContext(c)
+		response, err := client.MoveTaskQueueBacklogTasks(ctx, &adminservice.MoveTaskQueueBacklogTasksRequest{
+			Namespace:            namespace,
+			TaskQueuePartition:   tqPartition,
+			DestinationTaskQueue: destination,
+			PageSize:             int32(c.Int(FlagPageSize)),
+			NextPageToken:        pageToken,
+		})
+		cancel()
+		if err != nil {
+			return fmt.Errorf("unable to move Task Queue backlog tasks after moving %d tasks: %w", moved, err)
+		}
+		moved += len(response.MovedTasks)
+		pageToken = response.NextPageToken
+		if len(pageToken) == 0 {
+			break
+		}
+	}
+	fmt.Fprintf(c.App.Writer, "Moved %d tasks.\n", moved)
+	return nil
+}
+
+func parseTaskQueuePartition(c *cli.Context) (*taskqueuespb.TaskQueuePartition, error) {
+	tqName, err := getRequiredOption(c, FlagTaskQueue)
+	if err != nil {
+		return nil, err
+	}
+	tqTypeInt, err := StringToEnum(c.String(FlagTaskQueueType), enumspb.TaskQueueType_value)
+	if err != nil {
+		return nil, fmt.Errorf("invalid task queue type: %w", err)
+	}
+	tqType := enumspb.TaskQueueType(tqTypeInt)
+	if tqType == enumspb.TASK_QUEUE_TYPE_UNSPECIFIED {
+		return nil, errors.New("invalid task queue type") // nolint
+	}
+	tqPartition := &taskqueuespb.TaskQueuePartition{
+		TaskQueue:     tqName,
+		TaskQueueType: tqType,
+	}
+	if stickyName := c.String(FlagStickyName); stickyName != "" {
+		tqPartition.PartitionId = &taskqueuespb.TaskQueuePartition_StickyName{StickyName: stickyName}
+	} else {
+		tqPartition.PartitionId = &taskqueuespb.TaskQueuePartition_NormalPartitionId{NormalPartitionId: int32(c.Int(FlagPartitionID))}
+	}
+	return tqPartition, nil
+}
+
+// parseBacklogTaskKey parses a task key in the <subqueue>:<pass>:<task id> format.
+func parseBacklogTaskKey(value string) (*taskqueuespb.BacklogTaskKey, error) {
+	parts := strings.Split(value, ":")
+	if len(parts) != 3 {
+		return nil, fmt.Errorf("invalid task %q, expected <subqueue>:<pass>:<task id>", value)
+	}
+	subqueue, err := strconv.ParseInt(parts[0], 10, 32)
+	if err != nil {
+		return nil, fmt.Errorf("invalid subqueue in task %q: %w", value, err)
+	}
+	pass, err := strconv.ParseInt(parts[1], 10, 64)
+	if err != nil {
+		return nil, fmt.Errorf("invalid pass in task %q: %w", value, err)
+	}
+	taskID, err := strconv.ParseInt(parts[2], 10, 64)
+	if err != nil {
+		return nil, fmt.Errorf("invalid task id in task %q: %w", value, err)
+	}
+	return &taskqueuespb.BacklogTaskKey{
+		Subqueue: int32(subqueue),
+		TaskPass: pass,
+		TaskId:   taskID,
+	}, nil
+}
@@ -43,7 +43,7 @@ func getCommands(
 			Name:        "taskqueue",
 			Aliases:     []string{"tq"},
 			Usage:       "Run admin operation on taskQueue",
-			Subcommands: newAdminTaskQueueCommands(clientFactory),
+			Subcommands: newAdminTaskQueueCommands(clientFactory, prompterFactory),
 		},
 		{
 			Name:        "membership",
@@ -51,6 +51,12 @@ func getCommands(
 			Usage:       "Run admin operation on membership",
 			Subcommands: newAdminMembershipCommands(clientFactory),
 		},
+		{
+			Name:        "saved-query",
+			Aliases:     []string{"sq"},
+			Usage:       "Run admin operation on saved visibility queries",
+			Subcommands: newAdminSavedQueryCommands(clientFactory),
+		},
 		{
 			Name:        "dlq",
 			Usage:       "Run admin operation on DLQ",
@@ -194,6 +200,168 @@ func newAdminWorkflowCommands(clientFactory ClientFactory, prompterFactory Promp
 				return AdminRebuildMutableState(c, clientFactory)
 			},
 		},
+		{
+			Name:    "check",
+			Aliases: []string{},
+			Usage:   "Validate a workflow mutable state against its history and optionally repair it",
+			Flags: []cli.Flag{
+				&cli.StringFlag{
+					Name:    FlagWorkflowID,
+					Aliases: FlagWorkflowIDAlias,
+					Usage:   "Workflow ID",
+				},
+				&cli.StringFlag{
+					Name:    FlagRunID,
+					Aliases: FlagRunIDAlias,
+					Usage:   "Run ID",
+				},
+				&cli.BoolFlag{
+					Name:  FlagRepair,
+					Usage: "Rebuild the mutable state from history and refresh its tasks if it is inconsistent",
+				},
+			},
+			Action: func(c *cli.Context) error {
+				return AdminCheckMutableState(c, clientFactory, prompterFactory(c))
+			},
+		},
+		{
+			Name:    "fork",
+			Aliases: []string{},
+			Usage:   "Copy the history of a workflow up to a workflow task into a new workflow execution",
+			Flags: []cli.Flag{
+				&cli.StringFlag{
+					Name:    FlagWorkflowID,
+					Aliases: FlagWorkflowIDAlias,
+					Usage:   "Workflow ID",
+				},
+				&cli.StringFlag{
+					Name:    FlagRunID,
+					Aliases: FlagRunIDAlias,
+					Usage:   "Run ID",
+				},
+				&cli.Int64Flag{
+					Name:     FlagEventID,
+					Usage:    "The ID of a WorkflowTaskCompleted, WorkflowTaskFailed, WorkflowTaskTimedOut or WorkflowTaskStarted event to fork at",
+					Required: true,
+				},
+				&cli.StringFlag{
+					Name:  FlagNewNamespace,
+					Usage: "Namespace of the new workflow execution, defaults to the namespace of the forked workflow",
+				},
+				&cli.StringFlag{
+					Name:     FlagNewWorkflowID,
+					Usage:    "Workflow ID of the new workflow execution",
+					Required: true,
+				},
+				&cli.StringFlag{
+					Name:  FlagReason,
+					Usage: "Reason for the fork",
+				},
+				&cli.StringSliceFlag{
+					Name:  FlagReapplyExclude,
+					Usage: "Event types not to be reapplied to the new workflow execution, options: Signal, Update, Nexus",
+				},
+			},
+			Action: func(c *cli.Context) error {
+				return AdminForkWorkflow(c, clientFactory)
+			},
+		},
+		{
+			Name:    "redact",
+			Aliases: []string{},
+			Usage:   "Erase payloads from the history of a closed workflow execution",
+			Flags: []cli.Flag{
+				&cli.StringFlag{
+					Name:    FlagWorkflowID,
+					Aliases: FlagWorkflowIDAlias,
+					Usage:   "Workflow ID",
+				},
+				&cli.StringFlag{
+					Name:    FlagRunID,
+					Aliases: FlagRunIDAlias,
+					Usage:   "Run ID",
+				},
+				&cli.Int64SliceFlag{
+					Name:  FlagEventID,
+					Usage: "ID of an event whose payloads are redacted",
+				},
+				&cli.StringSliceFlag{
+					Name:  FlagMemoKey,
+					Usage: "Memo field to be redacted",
+				},
+				&cli.StringFlag{
+					Name:  FlagReason,
+					Usage: "Reason for the redaction",
+				},
+			},
+			Action: func(c *cli.Context) error {
+				return AdminRedactWorkflow(c, clientFactory)
+			},
+		},
+		{
+			Name:    "timeline",
+			Aliases: []string{},
+			Usage:   "Show the activities, timers and child workflows of a workflow execution as a timeline, along with its critical path",
+			Flags: []cli.Flag{
+				&cli.StringFlag{
+					Name:    FlagWorkflowID,
+					Aliases: FlagWorkflowIDAlias,
+					Usage:   "Workflow ID",
+				},
+				&cli.StringFlag{
+					Name:    FlagRunID,
+					Aliases: FlagRunIDAlias,
+					Usage:   "Run ID",
+				},
+			},
+			Action: func(c *cli.Context) error {
+				return AdminGetWorkflowTimeline(c, clientFactory)
+			},
+		},
+		{
+			Name:    "budget",
+			Aliases: []string{},
+			Usage:   "Show the history size and count of a workflow execution against its history budget, along with the actions taken",
+			Flags: []cli.Flag{
+				&cli.StringFlag{
+					Name:    FlagWorkflowID,
+					Aliases: FlagWorkflowIDAlias,
+					Usage:   "Workflow ID",
+				},
+				&cli.StringFlag{
+					Name:    FlagRunID,
+					Aliases: FlagRunIDAlias,
+					Usage:   "Run ID",
+				},
+			},
+			Action: func(c *cli.Context) error {
+				return AdminGetWorkflowHistoryBudget(c, clientFactory)
+			},
+		},
+		{
+			Name:    "aggregate",
+			Aliases: []string{},
+			Usage:   "Aggregate search attributes of the workflow executions matching a visibility query",
+			Flags: []cli.Flag{
+				&cli.StringFlag{
+					Name:    FlagQuery,
+					Aliases: FlagQueryAlias,
+					Usage:   "Visibility query of the workflow executions to aggregate",
+				},
+				&cli.StringSliceFlag{
+					Name:  FlagGroupBy,
+					Usage: "Keyword search attribute to group workflow executions by, can be repeated",
+				},
+				&cli.StringSliceFlag{
+					Name: FlagAggregation,
+					Usage: "Aggregation in the format <function>:<search attribute>[:<percentile>,...], e.g. avg:ExecutionDuration " +
+						"or percentiles:ExecutionDuration:50,99, can be repeated. Functions are min, max, avg, sum and percentiles",
+				},
+			},
+			Action: func(c *cli.Context) error {
+				return AdminAggregateWorkflowExecutions(c, clientFactory)
+			},
+		},
 		{
 			Name:    "replicate",
 			Aliases: []string{},
@@ -322,6 +490,25 @@ func newAdminShardManagementCommands(clientFactory ClientFactory, taskCategoryRe
 				return AdminShardManagement(c, clientFactory)
 			},
 		},
+		{
+			Name:  "move-shard",
+			Usage: "move a shard to another history host, requires shard placement mode manual or loadAware",
+			Flags: []cli.Flag{
+				&cli.IntFlag{
+					Name:     FlagShardID,
+					Usage:    "The ID of the shard to move",
+					Required: true,
+				},
+				&cli.StringFlag{
+					Name:     FlagHistoryAddress,
+					Usage:    "Address of the history host to move the shard to (ip:port)",
+					Required: true,
+				},
+			},
+			Action: func(c *cli.Context) error {
+				return AdminMoveShard(c, clientFactory)
+			},
+		},
 		{
 			Name:    "remove-task",
 			Aliases: []string{"rmtk"},
@@ -450,15 +637,79 @@ func newAdminHistoryHostCommands(clientFactory ClientFactory) []*cli.Command {
 					Name:  FlagNumberOfShards,
 					Usage: "NumberOfShards for the temporal cluster(see config for numHistoryShards)",
 				},
+				&cli.IntFlag{
+					Name:  FlagFirstShardID,
+					Usage: "First shard ID of the namespace's shard pool (see history.namespaceShardPools)",
+				},
+				&cli.IntFlag{
+					Name:  FlagLastShardID,
+					Usage: "Last shard ID of the namespace's shard pool (see history.namespaceShardPools)",
+				},
 			},
 			Action: func(c *cli.Context) error {
 				return AdminGetShardID(c)
 			},
 		},
+		{
+			Name:  "check-shard-pool",
+			Usage: "List workflow executions of a namespace that would change shard if the namespace was assigned to a shard pool",
+			Flags: []cli.Flag{
+				&cli.IntFlag{
+					Name:     FlagNumberOfShards,
+					Usage:    "NumberOfShards for the temporal cluster(see config for numHistoryShards)",
+					Required: true,
+				},
+				&cli.IntFlag{
+					Name:     FlagFirstShardID,
+					Usage:    "First shard ID of the shard pool",
+					Required: true,
+				},
+				&cli.IntFlag{
+					Name:     FlagLastShardID,
+					Usage:    "Last shard ID of the shard pool",
+					Required: true,
+				},
+				&cli.IntFlag{
+					Name:  FlagPageSize,
+					Value: 1000,
+					Usage: "Page size for listing workflow executions",
+				},
+				&cli.BoolFlag{
+					Name:  FlagMoving,
+					Usage: "List the workflow executions that are still on their previous shard while the namespace is moved to the shard pool",
+				},
+			},
+			Action: func(c *cli.Context) error {
+				return AdminCheckShardPool(c, clientFactory)
+			},
+		},
 	}
 }
 
-func newAdminTaskQueueCommands(clientFactory ClientFactory) []*cli.Command {
+func newAdminTaskQueueCommands(clientFactory ClientFactory, prompterFactory PrompterFactory) []*cli.Command {
+	partitionFlags := []cli.Flag{
+		&cli.StringFlag{
+			Name:     FlagTaskQueue,
+			Usage:    "Task Queue name",
+			Required: true,
+		},
+		&cli.StringFlag{
+			Name:  FlagTaskQueueType,
+			Value: "TASK_QUEUE_TYPE_WORKFLOW",
+			Usage: "Task Queue type: activity, workflow",
+		},
+		&cli.IntFlag{
+			Name:  FlagPartitionID,
+			Usage: "Partition ID",
+			Value: 0,
+		},
+		&cli.StringFlag{
+			Name:  FlagStickyName,
+			Usage: "Sticky Name for a task queue partition, if present",
+			Value: "",
+		},
+	}
+
 	return []*cli.Command{
 		{
 			Name:  "list-tasks",
@@ -597,6 +848,155 @@ func newAdminTaskQueueCommands(clientFactory ClientFactory) []*cli.Command {
 				return AdminForceUnloadTaskQueuePartition(c, clientFactory)
 			},
 		},
+		{
+			Name:  "pause-dispatch",
+			Usage: "Pause dispatch of tasks of a task queue, tasks are still added to the backlog",
+			Flags: []cli.Flag{
+				&cli.StringFlag{
+					Name:     FlagTaskQueue,
+					Usage:    "Task Queue name",
+					Required: true,
+				},
+				&cli.StringSliceFlag{
+					Name:  FlagTaskQueueType,
+					Usage: "Task Queue types: TASK_QUEUE_TYPE_WORKFLOW, TASK_QUEUE_TYPE_ACTIVITY. Both if not set",
+				},
+				&cli.IntSliceFlag{
+					Name:  FlagPriorityKey,
+					Usage: "Priority keys to pause, requires the priority matcher. All priority levels if not set",
+				},
+				&cli.StringFlag{
+					Name:  FlagReason,
+					Usage: "Reason for pausing dispatch",
+				},
+			},
+			Action: func(c *cli.Context) error {
+				return AdminPauseTaskQueueDispatch(c, clientFactory)
+			},
+		},
+		{
+			Name:  "resume-dispatch",
+			Usage: "Resume dispatch of tasks of a task queue",
+			Flags: []cli.Flag{
+				&cli.StringFlag{
+					Name:     FlagTaskQueue,
+					Usage:    "Task Queue name",
+					Required: true,
+				},
+				&cli.StringSliceFlag{
+					Name:  FlagTaskQueueType,
+					Usage: "Task Queue types: TASK_QUEUE_TYPE_WORKFLOW, TASK_QUEUE_TYPE_ACTIVITY. Both if not set",
+				},
+				&cli.IntSliceFlag{
+					Name:  FlagPriorityKey,
+					Usage: "Priority keys to resume. All priority levels if not set",
+				},
+			},
+			Action: func(c *cli.Context) error {
+				return AdminResumeTaskQueueDispatch(c, clientFactory)
+			},
+		},
+		{
+			Name:  "list-backlog-tasks",
+			Usage: "List the backlog tasks of a task queue partition with their workflow, priority, fairness key and age",
+			Flags: append([]cli.Flag{
+				&cli.BoolFlag{
+					Name:  FlagMore,
+					Usage: "List more pages, default is to list one page of default page size 10",
+				},
+				&cli.IntFlag{
+					Name:  FlagPageSize,
+					Value: 10,
+					Usage: "Result page size",
+				},
+			}, partitionFlags...),
+			Action: func(c *cli.Context) error {
+				return AdminListTaskQueueBacklogTasks(c, clientFactory)
+			},
+		},
+		{
+			Name:  "delete-backlog-tasks",
+			Usage: "Delete tasks from the backlog of a task queue partition, the tasks are never dispatched",
+			Flags: append([]cli.Flag{
+				&cli.StringSliceFlag{
+					Name:     FlagBacklogTask,
+					Usage:    "Task to delete as <subqueue>:<pass>:<task id>, as listed by list-backlog-tasks. Pass is 0 unless the backlog uses fairness",
+					Required: true,
+				},
+			}, partitionFlags...),
+			Action: func(c *cli.Context) error {
+				return AdminDeleteTaskQueueBacklogTasks(c, clientFactory, prompterFactory(c))
+			},
+		},
+		{
+			Name:  "move-backlog-tasks",
+			Usage: "Move all backlog tasks of a task queue partition to another task queue",
+			Flags: append([]cli.Flag{
+				&cli.StringFlag{
+					Name:     FlagDestinationTaskQueue,
+					Usage:    "Task Queue to move the tasks to",
+					Required: true,
+				},
+				&cli.IntFlag{
+					Name:  FlagPageSize,
+					Value: 100,
+					Usage: "Number of tasks moved per request",
+				},
+			}, partitionFlags...),
+			Action: func(c *cli.Context) error {
+				return AdminMoveTaskQueueBacklogTasks(c, clientFactory, prompterFactory(c))
+			},
+		},
+	}
+}
+
+func newAdminSavedQueryCommands(clientFactory ClientFactory) []*cli.Command {
+	return []*cli.Command{
+		{
+			Name:  "upsert",
+			Usage: "Create or replace a saved visibility query of a namespace",
+			Flags: []cli.Flag{
+				&cli.StringFlag{
+					Name:     FlagSavedQueryName,
+					Usage:    "Name of the saved query",
+					Required: true,
+				},
+				&cli.StringFlag{
+					Name:     FlagQuery,
+					Aliases:  FlagQueryAlias,
+					Usage:    "Visibility query",
+					Required: true,
+				},
+				&cli.StringFlag{
+					Name:  FlagDescription,
+					Usage: "Description of the saved query",
+				},
+			},
+			Action: func(c *cli.Context) error {
+				return AdminUpsertSavedVisibilityQuery(c, clientFactory)
+			},
+		},
+		{
+			Name:  "delete",
+			Usage: "Delete a saved visibility query of a namespace",
+			Flags: []cli.Flag{
+				&cli.StringFlag{
+					Name:     FlagSavedQueryName,
+					Usage:    "Name of the saved query",
+					Required: true,
+				},
+			},
+			Action: func(c *cli.Context) error {
+				return AdminDeleteSavedVisibilityQuery(c, clientFactory)
+			},
+		},
+		{
+			Name:  "list",
+			Usage: "List the saved visibility queries of a namespace",
+			Action: func(c *cli.Context) error {
+				return AdminListSavedVisibilityQueries(c, clientFactory)
+			},
+		},
 	}
 }
 